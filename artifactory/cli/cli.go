@@ -1,60 +1,159 @@
 package cli
 
 import (
+	"encoding/json"
+	"fmt"
 	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
+	"time"
 
 	ioutils "github.com/jfrog/gofrog/io"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/commands/accesstoken"
 	"github.com/jfrog/jfrog-cli-artifactory/artifactory/commands/buildinfo"
 	"github.com/jfrog/jfrog-cli-artifactory/artifactory/commands/container"
 	"github.com/jfrog/jfrog-cli-artifactory/artifactory/commands/curl"
 	"github.com/jfrog/jfrog-cli-artifactory/artifactory/commands/dotnet"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/commands/evidence"
 	"github.com/jfrog/jfrog-cli-artifactory/artifactory/commands/generic"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/commands/linuxpackage"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/commands/mvn"
 	"github.com/jfrog/jfrog-cli-artifactory/artifactory/commands/oc"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/commands/ociartifact"
 	containerutils "github.com/jfrog/jfrog-cli-artifactory/artifactory/commands/ocicontainer"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/commands/permissiontarget"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/commands/project"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/commands/provenance"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/commands/proxy"
 	"github.com/jfrog/jfrog-cli-artifactory/artifactory/commands/replication"
 	"github.com/jfrog/jfrog-cli-artifactory/artifactory/commands/repository"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/commands/retention"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/commands/storage"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/commands/transfer"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/commands/usersmanagement"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/commands/webhook"
 	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/buildadddependencies"
 	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/buildaddgit"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/buildaffectedmodules"
 	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/buildappend"
 	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/buildclean"
 	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/buildcollectenv"
 	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/builddiscard"
 	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/builddockercreate"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/buildexport"
 	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/buildpromote"
 	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/buildpublish"
 	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/buildscan"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/buildscangate"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/cleanup"
 	copydocs "github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/copy"
 	curldocs "github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/curl"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/debdeploy"
 	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/delete"
 	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/deleteprops"
 	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/directdownload"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/dockercleanup"
 	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/dockerpromote"
 	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/dockerpull"
 	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/dockerpush"
 	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/download"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/evidencecreate"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/evidencecreatebulk"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/evidenceexport"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/evidenceexportgithub"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/evidenceimportgithub"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/evidencekeygenerate"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/evidencekeylist"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/evidencekeyrotate"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/evidencekeyupload"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/evidenceverify"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/federationconvert"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/federationmemberadd"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/federationmemberremove"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/federationstatus"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/federationsync"
 	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/gitlfsclean"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/groupaddusers"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/groupcreate"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/groupdelete"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/groupslist"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/groupupdate"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/healthcheck"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/immutable"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/legalhold"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/mavendeployfile"
 	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/move"
 	nugettree "github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/nugetdepstree"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/ocipull"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/ocipush"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/ocireferrers"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/ocisign"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/ociverify"
 	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/ocstartbuild"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/oidctokenexchange"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/permissiontargetexport"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/permissiontargetimport"
 	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/ping"
 	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/podmanpull"
 	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/podmanpush"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/projectapply"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/projectdelete"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/projectexport"
+	proxydocs "github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/proxy"
 	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/replicationcreate"
 	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/replicationdelete"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/replicationstatus"
 	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/replicationtemplate"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/replicationupdate"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/repoapply"
 	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/repocreate"
 	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/repodelete"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/repoexport"
 	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/repotemplate"
 	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/repoupdate"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/resolvetrace"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/rpmdeploy"
 	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/search"
 	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/setprops"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/storagereport"
+	syncdocs "github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/sync"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/tokencreate"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/tokenrefresh"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/tokenrevoke"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/transferfilesstatus"
 	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/upload"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/usercreate"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/userscreate"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/usersdelete"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/usersexport"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/usersimport"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/userslist"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/userupdate"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/verifydownload"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/webhookcreate"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/webhookdelete"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/webhooklist"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/webhooklisten"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/docs/whence"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/formats"
 	artifactoryUtils "github.com/jfrog/jfrog-cli-artifactory/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/buildstate"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/cienv"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clientconfig"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clierrors"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/credentials"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/downloadcache"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/encryption"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/ratelimit"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/specv2"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/telemetry"
 	"github.com/jfrog/jfrog-cli-artifactory/cliutils/commandWrappers"
 	"github.com/jfrog/jfrog-cli-artifactory/cliutils/flagkit"
+	"github.com/jfrog/jfrog-cli-artifactory/evidence/subject"
 	coregeneric "github.com/jfrog/jfrog-cli-core/v2/artifactory/commands/generic"
+	coreusers "github.com/jfrog/jfrog-cli-core/v2/artifactory/commands/usersmanagement"
+	commandUtils "github.com/jfrog/jfrog-cli-core/v2/artifactory/commands/utils"
 	"github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
 	"github.com/jfrog/jfrog-cli-core/v2/common/build"
 	commonCliUtils "github.com/jfrog/jfrog-cli-core/v2/common/cliutils"
@@ -79,10 +178,21 @@ const (
 	buildCategory    = "Build Info"
 	repoCategory     = "Repository Management"
 	replicCategory   = "Replication"
+	accessCategory   = "Projects and Permissions"
 	otherCategory    = "Other"
 	releaseBundlesV2 = "release-bundles-v2"
 )
 
+// The operations in the summary output of the commands.
+const (
+	uploadOperation       = "upload"
+	downloadOperation     = "download"
+	copyOperation         = "copy"
+	moveOperation         = "move"
+	deleteOperation       = "delete"
+	buildPublishOperation = "build-publish"
+)
+
 func GetCommands() []components.Command {
 	commands := []components.Command{
 		{
@@ -112,6 +222,23 @@ func GetCommands() []components.Command {
 			Action:      directDownloadCmd,
 			Category:    filesCategory,
 		},
+		{
+			Name:        "verify-download",
+			Flags:       flagkit.GetCommandFlags(flagkit.VerifyDownload),
+			Aliases:     []string{"vdl"},
+			Description: verifydownload.GetDescription(),
+			Arguments:   verifydownload.GetArguments(),
+			Action:      verifyDownloadCmd,
+			Category:    filesCategory,
+		},
+		{
+			Name:        "sync",
+			Flags:       flagkit.GetCommandFlags(flagkit.SyncCmd),
+			Description: syncdocs.GetDescription(),
+			Arguments:   syncdocs.GetArguments(),
+			Action:      syncCmd,
+			Category:    filesCategory,
+		},
 		{
 			Name:        "move",
 			Flags:       flagkit.GetCommandFlags(flagkit.Move),
@@ -148,6 +275,102 @@ func GetCommands() []components.Command {
 			Action:      searchCmd,
 			Category:    filesCategory,
 		},
+		{
+			Name:        "whence",
+			Flags:       flagkit.GetCommandFlags(flagkit.Whence),
+			Description: whence.GetDescription(),
+			Arguments:   whence.GetArguments(),
+			Action:      whenceCmd,
+			Category:    filesCategory,
+		},
+		{
+			Name:        "evidence-create",
+			Flags:       flagkit.GetCommandFlags(flagkit.EvidenceCreate),
+			Aliases:     []string{"ec"},
+			Description: evidencecreate.GetDescription(),
+			Arguments:   evidencecreate.GetArguments(),
+			Action:      evidenceCreateCmd,
+			Category:    filesCategory,
+		},
+		{
+			Name:        "evidence-create-bulk",
+			Flags:       flagkit.GetCommandFlags(flagkit.EvidenceCreateBulk),
+			Aliases:     []string{"ecb"},
+			Description: evidencecreatebulk.GetDescription(),
+			Arguments:   evidencecreatebulk.GetArguments(),
+			Action:      evidenceCreateBulkCmd,
+			Category:    filesCategory,
+		},
+		{
+			Name:        "evidence-export",
+			Flags:       flagkit.GetCommandFlags(flagkit.EvidenceExport),
+			Aliases:     []string{"eex"},
+			Description: evidenceexport.GetDescription(),
+			Arguments:   evidenceexport.GetArguments(),
+			Action:      evidenceExportCmd,
+			Category:    filesCategory,
+		},
+		{
+			Name:        "evidence-verify",
+			Flags:       flagkit.GetCommandFlags(flagkit.EvidenceVerify),
+			Aliases:     []string{"evv"},
+			Description: evidenceverify.GetDescription(),
+			Action:      evidenceVerifyCmd,
+			Category:    filesCategory,
+		},
+		{
+			Name:        "evidence-import-github",
+			Flags:       flagkit.GetCommandFlags(flagkit.EvidenceImportGithub),
+			Aliases:     []string{"eig"},
+			Description: evidenceimportgithub.GetDescription(),
+			Arguments:   evidenceimportgithub.GetArguments(),
+			Action:      evidenceImportGithubCmd,
+			Category:    filesCategory,
+		},
+		{
+			Name:        "evidence-export-github",
+			Flags:       flagkit.GetCommandFlags(flagkit.EvidenceExportGithub),
+			Aliases:     []string{"eeg"},
+			Description: evidenceexportgithub.GetDescription(),
+			Arguments:   evidenceexportgithub.GetArguments(),
+			Action:      evidenceExportGithubCmd,
+			Category:    filesCategory,
+		},
+		{
+			Name:        "evidence-key-generate",
+			Flags:       flagkit.GetCommandFlags(flagkit.EvidenceKeyGenerate),
+			Aliases:     []string{"ekg"},
+			Description: evidencekeygenerate.GetDescription(),
+			Arguments:   evidencekeygenerate.GetArguments(),
+			Action:      evidenceKeyGenerateCmd,
+			Category:    otherCategory,
+		},
+		{
+			Name:        "evidence-key-upload",
+			Flags:       flagkit.GetCommandFlags(flagkit.EvidenceKeyUpload),
+			Aliases:     []string{"eku"},
+			Description: evidencekeyupload.GetDescription(),
+			Arguments:   evidencekeyupload.GetArguments(),
+			Action:      evidenceKeyUploadCmd,
+			Category:    otherCategory,
+		},
+		{
+			Name:        "evidence-key-list",
+			Flags:       flagkit.GetCommandFlags(flagkit.EvidenceKeyList),
+			Aliases:     []string{"ekl"},
+			Description: evidencekeylist.GetDescription(),
+			Action:      evidenceKeyListCmd,
+			Category:    otherCategory,
+		},
+		{
+			Name:        "evidence-key-rotate",
+			Flags:       flagkit.GetCommandFlags(flagkit.EvidenceKeyRotate),
+			Aliases:     []string{"ekr"},
+			Description: evidencekeyrotate.GetDescription(),
+			Arguments:   evidencekeyrotate.GetArguments(),
+			Action:      evidenceKeyRotateCmd,
+			Category:    otherCategory,
+		},
 		{
 			Name:        "set-props",
 			Flags:       flagkit.GetCommandFlags(flagkit.Properties),
@@ -211,6 +434,15 @@ func GetCommands() []components.Command {
 			Action:      buildAddGitCmd,
 			Category:    buildCategory,
 		},
+		{
+			Name:        "build-affected-modules",
+			Flags:       flagkit.GetCommandFlags(flagkit.BuildAffectedModules),
+			Aliases:     []string{"bam"},
+			Description: buildaffectedmodules.GetDescription(),
+			Arguments:   buildaffectedmodules.GetArguments(),
+			Action:      buildAffectedModulesCmd,
+			Category:    buildCategory,
+		},
 		{
 			Name:        "build-scan",
 			Hidden:      true,
@@ -248,6 +480,24 @@ func GetCommands() []components.Command {
 			Action:      buildDiscardCmd,
 			Category:    buildCategory,
 		},
+		{
+			Name:        "build-export",
+			Flags:       flagkit.GetCommandFlags(flagkit.BuildExport),
+			Aliases:     []string{"be"},
+			Description: buildexport.GetDescription(),
+			Arguments:   buildexport.GetArguments(),
+			Action:      buildExportCmd,
+			Category:    buildCategory,
+		},
+		{
+			Name:        "build-scan-gate",
+			Flags:       flagkit.GetCommandFlags(flagkit.BuildScanGate),
+			Aliases:     []string{"bsg"},
+			Description: buildscangate.GetDescription(),
+			Arguments:   buildscangate.GetArguments(),
+			Action:      buildScanGateCmd,
+			Category:    buildCategory,
+		},
 		{
 			Name:        "git-lfs-clean",
 			Flags:       flagkit.GetCommandFlags(flagkit.GitLfsClean),
@@ -266,6 +516,88 @@ func GetCommands() []components.Command {
 			Action:      dockerPromoteCmd,
 			Category:    buildCategory,
 		},
+		{
+			Name:        "docker-cleanup",
+			Flags:       flagkit.GetCommandFlags(flagkit.DockerCleanup),
+			Aliases:     []string{"dcl"},
+			Description: dockercleanup.GetDescription(),
+			Arguments:   dockercleanup.GetArguments(),
+			Action:      dockerCleanupCmd,
+		},
+		{
+			Name:        "oci-push",
+			Flags:       flagkit.GetCommandFlags(flagkit.OciPush),
+			Aliases:     []string{"ocip"},
+			Description: ocipush.GetDescription(),
+			Arguments:   ocipush.GetArguments(),
+			Action:      ociPushCmd,
+		},
+		{
+			Name:        "oci-pull",
+			Flags:       flagkit.GetCommandFlags(flagkit.OciPull),
+			Aliases:     []string{"ocipl"},
+			Description: ocipull.GetDescription(),
+			Arguments:   ocipull.GetArguments(),
+			Action:      ociPullCmd,
+		},
+		{
+			Name:        "oci-referrers",
+			Flags:       flagkit.GetCommandFlags(flagkit.OciReferrers),
+			Aliases:     []string{"ocir"},
+			Description: ocireferrers.GetDescription(),
+			Arguments:   ocireferrers.GetArguments(),
+			Action:      ociReferrersCmd,
+		},
+		{
+			Name:        "oci-sign",
+			Flags:       flagkit.GetCommandFlags(flagkit.OciSign),
+			Aliases:     []string{"ocis"},
+			Description: ocisign.GetDescription(),
+			Arguments:   ocisign.GetArguments(),
+			Action:      ociSignCmd,
+		},
+		{
+			Name:        "oci-verify",
+			Flags:       flagkit.GetCommandFlags(flagkit.OciVerify),
+			Aliases:     []string{"ociv"},
+			Description: ociverify.GetDescription(),
+			Arguments:   ociverify.GetArguments(),
+			Action:      ociVerifyCmd,
+		},
+		{
+			Name:        "maven-deploy-file",
+			Flags:       flagkit.GetCommandFlags(flagkit.MvnDeployFile),
+			Aliases:     []string{"mvndf"},
+			Description: mavendeployfile.GetDescription(),
+			Arguments:   mavendeployfile.GetArguments(),
+			Action:      mvnDeployFileCmd,
+		},
+		{
+			Name:        "cleanup",
+			Flags:       flagkit.GetCommandFlags(flagkit.Cleanup),
+			Aliases:     []string{"cln"},
+			Description: cleanup.GetDescription(),
+			Arguments:   cleanup.GetArguments(),
+			Action:      cleanupCmd,
+		},
+		{
+			Name:        "immutable",
+			Flags:       flagkit.GetCommandFlags(flagkit.Immutable),
+			Aliases:     []string{"imm"},
+			Description: immutable.GetDescription(),
+			Arguments:   immutable.GetArguments(),
+			Action:      immutableCmd,
+			Category:    filesCategory,
+		},
+		{
+			Name:        "legal-hold",
+			Flags:       flagkit.GetCommandFlags(flagkit.LegalHold),
+			Aliases:     []string{"lh"},
+			Description: legalhold.GetDescription(),
+			Arguments:   legalhold.GetArguments(),
+			Action:      legalHoldCmd,
+			Category:    filesCategory,
+		},
 		{
 			Name:        "docker-push",
 			Hidden:      true,
@@ -342,6 +674,20 @@ func GetCommands() []components.Command {
 			Description: ping.GetDescription(),
 			Action:      pingCmd,
 		},
+		{
+			Name:        "health-check",
+			Flags:       flagkit.GetCommandFlags(flagkit.HealthCheck),
+			Aliases:     []string{"hc"},
+			Description: healthcheck.GetDescription(),
+			Action:      healthCheckCmd,
+		},
+		{
+			Name:        "proxy",
+			Flags:       flagkit.GetCommandFlags(flagkit.RtProxy),
+			Description: proxydocs.GetDescription(),
+			Arguments:   proxydocs.GetArguments(),
+			Action:      proxyCmd,
+		},
 		{
 			Name:            "curl",
 			Flags:           flagkit.GetCommandFlags(flagkit.RtCurl),
@@ -386,6 +732,78 @@ func GetCommands() []components.Command {
 			Action:      repoDeleteCmd,
 			Category:    repoCategory,
 		},
+		{
+			Name:        "repo-apply",
+			Aliases:     []string{"rap"},
+			Flags:       flagkit.GetCommandFlags(flagkit.RepoApply),
+			Description: repoapply.GetDescription(),
+			Arguments:   repoapply.GetArguments(),
+			Action:      repoApplyCmd,
+			Category:    repoCategory,
+		},
+		{
+			Name:        "repo-export",
+			Aliases:     []string{"rex"},
+			Flags:       flagkit.GetCommandFlags(flagkit.RepoExport),
+			Description: repoexport.GetDescription(),
+			Arguments:   repoexport.GetArguments(),
+			Action:      repoExportCmd,
+			Category:    repoCategory,
+		},
+		{
+			Name:        "federation-convert",
+			Aliases:     []string{"fedc"},
+			Flags:       flagkit.GetCommandFlags(flagkit.FederationConvert),
+			Description: federationconvert.GetDescription(),
+			Arguments:   federationconvert.GetArguments(),
+			Action:      federationConvertCmd,
+			Category:    repoCategory,
+		},
+		{
+			Name:        "federation-member-add",
+			Aliases:     []string{"fedma"},
+			Flags:       flagkit.GetCommandFlags(flagkit.FederationMemberAdd),
+			Description: federationmemberadd.GetDescription(),
+			Arguments:   federationmemberadd.GetArguments(),
+			Action:      federationMemberAddCmd,
+			Category:    repoCategory,
+		},
+		{
+			Name:        "federation-member-remove",
+			Aliases:     []string{"fedmr"},
+			Flags:       flagkit.GetCommandFlags(flagkit.FederationMemberRemove),
+			Description: federationmemberremove.GetDescription(),
+			Arguments:   federationmemberremove.GetArguments(),
+			Action:      federationMemberRemoveCmd,
+			Category:    repoCategory,
+		},
+		{
+			Name:        "federation-sync",
+			Aliases:     []string{"feds"},
+			Flags:       flagkit.GetCommandFlags(flagkit.FederationSync),
+			Description: federationsync.GetDescription(),
+			Arguments:   federationsync.GetArguments(),
+			Action:      federationSyncCmd,
+			Category:    repoCategory,
+		},
+		{
+			Name:        "federation-status",
+			Aliases:     []string{"fedst"},
+			Flags:       flagkit.GetCommandFlags(flagkit.FederationStatus),
+			Description: federationstatus.GetDescription(),
+			Arguments:   federationstatus.GetArguments(),
+			Action:      federationStatusCmd,
+			Category:    repoCategory,
+		},
+		{
+			Name:        "resolve-trace",
+			Aliases:     []string{"rtr"},
+			Flags:       flagkit.GetCommandFlags(flagkit.ResolveTrace),
+			Description: resolvetrace.GetDescription(),
+			Arguments:   resolvetrace.GetArguments(),
+			Action:      resolveTraceCmd,
+			Category:    repoCategory,
+		},
 		{
 			Name:        "replication-template",
 			Aliases:     []string{"rplt"},
@@ -404,6 +822,15 @@ func GetCommands() []components.Command {
 			Action:      replicationCreateCmd,
 			Category:    replicCategory,
 		},
+		{
+			Name:        "replication-update",
+			Aliases:     []string{"rplu"},
+			Flags:       flagkit.GetCommandFlags(flagkit.TemplateConsumer),
+			Description: replicationupdate.GetDescription(),
+			Arguments:   replicationupdate.GetArguments(),
+			Action:      replicationUpdateCmd,
+			Category:    replicCategory,
+		},
 		{
 			Name:        "replication-delete",
 			Aliases:     []string{"rpldel"},
@@ -413,6 +840,274 @@ func GetCommands() []components.Command {
 			Action:      replicationDeleteCmd,
 			Category:    replicCategory,
 		},
+		{
+			Name:        "replication-status",
+			Aliases:     []string{"rpls"},
+			Flags:       flagkit.GetCommandFlags(flagkit.ReplicationStatus),
+			Description: replicationstatus.GetDescription(),
+			Arguments:   replicationstatus.GetArguments(),
+			Action:      replicationStatusCmd,
+			Category:    replicCategory,
+		},
+		{
+			Name:        "transfer-files-status",
+			Aliases:     []string{"tfs"},
+			Flags:       flagkit.GetCommandFlags(flagkit.TransferFilesStatus),
+			Description: transferfilesstatus.GetDescription(),
+			Arguments:   transferfilesstatus.GetArguments(),
+			Action:      transferFilesStatusCmd,
+			Category:    otherCategory,
+		},
+		{
+			Name:        "project-apply",
+			Aliases:     []string{"pja"},
+			Flags:       flagkit.GetCommandFlags(flagkit.ProjectApply),
+			Description: projectapply.GetDescription(),
+			Arguments:   projectapply.GetArguments(),
+			Action:      projectApplyCmd,
+			Category:    accessCategory,
+		},
+		{
+			Name:        "project-export",
+			Aliases:     []string{"pjex"},
+			Flags:       flagkit.GetCommandFlags(flagkit.ProjectExport),
+			Description: projectexport.GetDescription(),
+			Arguments:   projectexport.GetArguments(),
+			Action:      projectExportCmd,
+			Category:    accessCategory,
+		},
+		{
+			Name:        "project-delete",
+			Aliases:     []string{"pjdel"},
+			Flags:       flagkit.GetCommandFlags(flagkit.ProjectDelete),
+			Description: projectdelete.GetDescription(),
+			Arguments:   projectdelete.GetArguments(),
+			Action:      projectDeleteCmd,
+			Category:    accessCategory,
+		},
+		{
+			Name:        "permission-target-import",
+			Aliases:     []string{"ptim"},
+			Flags:       flagkit.GetCommandFlags(flagkit.PermissionTargetImport),
+			Description: permissiontargetimport.GetDescription(),
+			Arguments:   permissiontargetimport.GetArguments(),
+			Action:      permissionTargetImportCmd,
+			Category:    accessCategory,
+		},
+		{
+			Name:        "permission-target-export",
+			Aliases:     []string{"ptex"},
+			Flags:       flagkit.GetCommandFlags(flagkit.PermissionTargetExport),
+			Description: permissiontargetexport.GetDescription(),
+			Arguments:   permissiontargetexport.GetArguments(),
+			Action:      permissionTargetExportCmd,
+			Category:    accessCategory,
+		},
+		{
+			Name:        "user-create",
+			Flags:       flagkit.GetCommandFlags(flagkit.UserCreate),
+			Description: usercreate.GetDescription(),
+			Arguments:   usercreate.GetArguments(),
+			Action:      userCreateCmd,
+			Category:    accessCategory,
+		},
+		{
+			Name:        "users-create",
+			Aliases:     []string{"uc"},
+			Flags:       flagkit.GetCommandFlags(flagkit.UsersCreate),
+			Description: userscreate.GetDescription(),
+			Action:      usersCreateCmd,
+			Category:    accessCategory,
+		},
+		{
+			Name:        "user-update",
+			Aliases:     []string{"uu"},
+			Flags:       flagkit.GetCommandFlags(flagkit.UserUpdate),
+			Description: userupdate.GetDescription(),
+			Arguments:   userupdate.GetArguments(),
+			Action:      userUpdateCmd,
+			Category:    accessCategory,
+		},
+		{
+			Name:        "users-delete",
+			Aliases:     []string{"udel"},
+			Flags:       flagkit.GetCommandFlags(flagkit.UsersDelete),
+			Description: usersdelete.GetDescription(),
+			Arguments:   usersdelete.GetArguments(),
+			Action:      usersDeleteCmd,
+			Category:    accessCategory,
+		},
+		{
+			Name:        "users-list",
+			Aliases:     []string{"ul"},
+			Flags:       flagkit.GetCommandFlags(flagkit.UsersList),
+			Description: userslist.GetDescription(),
+			Arguments:   userslist.GetArguments(),
+			Action:      usersListCmd,
+			Category:    accessCategory,
+		},
+		{
+			Name:        "users-export",
+			Aliases:     []string{"uex"},
+			Flags:       flagkit.GetCommandFlags(flagkit.UsersExport),
+			Description: usersexport.GetDescription(),
+			Arguments:   usersexport.GetArguments(),
+			Action:      usersExportCmd,
+			Category:    accessCategory,
+		},
+		{
+			Name:        "users-import",
+			Aliases:     []string{"uim"},
+			Flags:       flagkit.GetCommandFlags(flagkit.UsersImport),
+			Description: usersimport.GetDescription(),
+			Arguments:   usersimport.GetArguments(),
+			Action:      usersImportCmd,
+			Category:    accessCategory,
+		},
+		{
+			Name:        "group-create",
+			Aliases:     []string{"gc"},
+			Flags:       flagkit.GetCommandFlags(flagkit.GroupCreate),
+			Description: groupcreate.GetDescription(),
+			Arguments:   groupcreate.GetArguments(),
+			Action:      groupCreateCmd,
+			Category:    accessCategory,
+		},
+		{
+			Name:        "group-update",
+			Aliases:     []string{"gu"},
+			Flags:       flagkit.GetCommandFlags(flagkit.GroupUpdate),
+			Description: groupupdate.GetDescription(),
+			Arguments:   groupupdate.GetArguments(),
+			Action:      groupUpdateCmd,
+			Category:    accessCategory,
+		},
+		{
+			Name:        "group-add-users",
+			Aliases:     []string{"gau"},
+			Flags:       flagkit.GetCommandFlags(flagkit.GroupAddUsers),
+			Description: groupaddusers.GetDescription(),
+			Arguments:   groupaddusers.GetArguments(),
+			Action:      groupAddUsersCmd,
+			Category:    accessCategory,
+		},
+		{
+			Name:        "group-delete",
+			Aliases:     []string{"gdel"},
+			Flags:       flagkit.GetCommandFlags(flagkit.GroupDelete),
+			Description: groupdelete.GetDescription(),
+			Arguments:   groupdelete.GetArguments(),
+			Action:      groupDeleteCmd,
+			Category:    accessCategory,
+		},
+		{
+			Name:        "groups-list",
+			Aliases:     []string{"gl"},
+			Flags:       flagkit.GetCommandFlags(flagkit.GroupsList),
+			Description: groupslist.GetDescription(),
+			Arguments:   groupslist.GetArguments(),
+			Action:      groupsListCmd,
+			Category:    accessCategory,
+		},
+		{
+			Name:        "token-create",
+			Aliases:     []string{"tkc"},
+			Flags:       flagkit.GetCommandFlags(flagkit.TokenCreate),
+			Description: tokencreate.GetDescription(),
+			Arguments:   tokencreate.GetArguments(),
+			Action:      tokenCreateCmd,
+			Category:    accessCategory,
+		},
+		{
+			Name:        "oidc-token-exchange",
+			Aliases:     []string{"ote"},
+			Flags:       flagkit.GetCommandFlags(flagkit.OidcTokenExchange),
+			Description: oidctokenexchange.GetDescription(),
+			Arguments:   oidctokenexchange.GetArguments(),
+			Action:      oidcTokenExchangeCmd,
+			Category:    accessCategory,
+		},
+		{
+			Name:        "token-refresh",
+			Aliases:     []string{"tkr"},
+			Flags:       flagkit.GetCommandFlags(flagkit.TokenRefresh),
+			Description: tokenrefresh.GetDescription(),
+			Arguments:   tokenrefresh.GetArguments(),
+			Action:      tokenRefreshCmd,
+			Category:    accessCategory,
+		},
+		{
+			Name:        "token-revoke",
+			Aliases:     []string{"tkrv"},
+			Flags:       flagkit.GetCommandFlags(flagkit.TokenRevoke),
+			Description: tokenrevoke.GetDescription(),
+			Arguments:   tokenrevoke.GetArguments(),
+			Action:      tokenRevokeCmd,
+			Category:    accessCategory,
+		},
+		{
+			Name:        "webhook-create",
+			Aliases:     []string{"whc"},
+			Flags:       flagkit.GetCommandFlags(flagkit.WebhookCreate),
+			Description: webhookcreate.GetDescription(),
+			Arguments:   webhookcreate.GetArguments(),
+			Action:      webhookCreateCmd,
+			Category:    otherCategory,
+		},
+		{
+			Name:        "webhook-list",
+			Aliases:     []string{"whl"},
+			Flags:       flagkit.GetCommandFlags(flagkit.WebhookList),
+			Description: webhooklist.GetDescription(),
+			Arguments:   webhooklist.GetArguments(),
+			Action:      webhookListCmd,
+			Category:    otherCategory,
+		},
+		{
+			Name:        "webhook-delete",
+			Aliases:     []string{"whd"},
+			Flags:       flagkit.GetCommandFlags(flagkit.WebhookDelete),
+			Description: webhookdelete.GetDescription(),
+			Arguments:   webhookdelete.GetArguments(),
+			Action:      webhookDeleteCmd,
+			Category:    otherCategory,
+		},
+		{
+			Name:        "webhook-listen",
+			Aliases:     []string{"whlsn"},
+			Flags:       flagkit.GetCommandFlags(flagkit.WebhookListen),
+			Description: webhooklisten.GetDescription(),
+			Arguments:   webhooklisten.GetArguments(),
+			Action:      webhookListenCmd,
+			Category:    otherCategory,
+		},
+		{
+			Name:        "storage-report",
+			Aliases:     []string{"stor"},
+			Flags:       flagkit.GetCommandFlags(flagkit.StorageReport),
+			Description: storagereport.GetDescription(),
+			Arguments:   storagereport.GetArguments(),
+			Action:      storageReportCmd,
+			Category:    otherCategory,
+		},
+		{
+			Name:        "deb-deploy",
+			Aliases:     []string{"debd"},
+			Flags:       flagkit.GetCommandFlags(flagkit.DebDeploy),
+			Description: debdeploy.GetDescription(),
+			Arguments:   debdeploy.GetArguments(),
+			Action:      debDeployCmd,
+			Category:    otherCategory,
+		},
+		{
+			Name:        "rpm-deploy",
+			Aliases:     []string{"rpmd"},
+			Flags:       flagkit.GetCommandFlags(flagkit.RpmDeploy),
+			Description: rpmdeploy.GetDescription(),
+			Arguments:   rpmdeploy.GetArguments(),
+			Action:      rpmDeployCmd,
+			Category:    otherCategory,
+		},
 	}
 
 	return commands
@@ -462,100 +1157,326 @@ func getRetryWaitTime(c *components.Context) (waitMilliSecs int, err error) {
 	return
 }
 
+// getRateLimits extracts the given '--rate-limit' and '--global-rate-limit' values, in bytes per second.
+func getRateLimits(c *components.Context) (rateLimit, globalRateLimit int64, err error) {
+	if rateLimit, err = ratelimit.ParseRate(c.GetStringFlagValue("rate-limit")); err != nil {
+		return 0, 0, errorutils.CheckErrorf("invalid '--rate-limit' option value: %s", err.Error())
+	}
+	if globalRateLimit, err = ratelimit.ParseRate(c.GetStringFlagValue("global-rate-limit")); err != nil {
+		return 0, 0, errorutils.CheckErrorf("invalid '--global-rate-limit' option value: %s", err.Error())
+	}
+	return
+}
+
+// getMinThreads extracts the given '--min-threads' value, which is 1 by default, and can't exceed the threads.
+// It's only valid with the '--adaptive-threads' option.
+func getMinThreads(c *components.Context, threads int) (int, error) {
+	if !c.IsFlagSet("min-threads") {
+		return 1, nil
+	}
+	if !c.GetBoolFlagValue("adaptive-threads") {
+		return 0, errorutils.CheckErrorf("the '--min-threads' option can be used only with --adaptive-threads")
+	}
+	minThreads, err := strconv.Atoi(c.GetStringFlagValue("min-threads"))
+	if err != nil || minThreads < 1 {
+		return 0, errorutils.CheckErrorf("the '--min-threads' option should have a numeric positive value")
+	}
+	if minThreads > threads {
+		return 0, errorutils.CheckErrorf("the '--min-threads' option value (%d) can't exceed the '--threads' option value (%d)", minThreads, threads)
+	}
+	return minThreads, nil
+}
+
+// getEncryptionKeyProvider returns the key provider configured by the '--encryption-key' or '--encryption-key-command' options, or nil if none is configured.
+func getEncryptionKeyProvider(c *components.Context) (encryption.KeyProvider, error) {
+	keyFile, keyCommand := c.GetStringFlagValue("encryption-key"), c.GetStringFlagValue("encryption-key-command")
+	switch {
+	case keyFile != "" && keyCommand != "":
+		return nil, errorutils.CheckErrorf("the '--encryption-key' and '--encryption-key-command' options cannot be used together")
+	case keyFile != "":
+		return encryption.NewLocalKeyProvider(keyFile)
+	case keyCommand != "":
+		return encryption.NewCommandKeyProvider(keyCommand)
+	}
+	return nil, nil
+}
+
 func getRetryWaitTimeVerificationError() error {
 	return errorutils.CheckError(errors.New("The '--retry-wait-time' option should have a numeric value with 's'/'ms' suffix. " + common.GetDocumentationMessage()))
 }
 
-func dockerPromoteCmd(c *components.Context) error {
-	if c.GetNumberOfArgs() != 3 {
+func dockerCleanupCmd(c *components.Context) error {
+	if c.GetNumberOfArgs() != 1 {
 		return common.WrongNumberOfArgumentsHandler(c)
 	}
-	artDetails, err := common.CreateArtifactoryDetailsByFlags(c)
+	rules, err := getDockerCleanupKeepRules(c)
 	if err != nil {
 		return err
 	}
-	params := services.NewDockerPromoteParams(c.GetArgumentAt(0), c.GetArgumentAt(1), c.GetArgumentAt(2))
-	params.TargetDockerImage = c.GetStringFlagValue("target-docker-image")
-	params.SourceTag = c.GetStringFlagValue("source-tag")
-	params.TargetTag = c.GetStringFlagValue("target-tag")
-	params.Copy = c.GetBoolFlagValue("copy")
-	dockerPromoteCommand := container.NewDockerPromoteCommand()
-	dockerPromoteCommand.SetParams(params).SetServerDetails(artDetails)
+	artDetails, err := credentials.CreateArtifactoryDetailsByFlags(c)
+	if err != nil {
+		return err
+	}
+	dockerCleanupCmd := container.NewDockerCleanupCommand().SetServerDetails(artDetails).SetRepo(c.GetArgumentAt(0)).SetRules(rules).
+		SetDryRun(c.GetBoolFlagValue("dry-run")).SetEvidenceRecord(c.GetStringFlagValue("evidence-record"))
+	return commands.Exec(dockerCleanupCmd)
+}
 
-	return commands.Exec(dockerPromoteCommand)
+// getDockerCleanupKeepRules reads the keep rules from the '--rules' file if provided, and overrides them with the rules provided as options.
+func getDockerCleanupKeepRules(c *components.Context) (rules *container.KeepRules, err error) {
+	rules = new(container.KeepRules)
+	if c.GetStringFlagValue("rules") != "" {
+		if rules, err = container.ReadKeepRules(c.GetStringFlagValue("rules")); err != nil {
+			return nil, err
+		}
+	}
+	if c.GetStringFlagValue("keep-last") != "" {
+		if rules.Last, err = strconv.Atoi(c.GetStringFlagValue("keep-last")); err != nil {
+			return nil, errors.New("The '--keep-last' option should have a numeric value. " + common.GetDocumentationMessage())
+		}
+	}
+	if c.GetBoolFlagValue("keep-semver") {
+		rules.Semver = true
+	}
+	if c.GetStringFlagValue("keep-pulled-within") != "" {
+		rules.PulledWithin = c.GetStringFlagValue("keep-pulled-within")
+	}
+	if c.GetStringFlagValue("keep-tags") != "" {
+		rules.Tags = strings.Split(c.GetStringFlagValue("keep-tags"), ";")
+	}
+	return rules, nil
 }
 
-func containerPushCmd(c *components.Context, containerManagerType containerutils.ContainerManagerType) (err error) {
-	if c.GetNumberOfArgs() != 2 {
+func cleanupCmd(c *components.Context) error {
+	if c.GetNumberOfArgs() != 1 {
 		return common.WrongNumberOfArgumentsHandler(c)
 	}
-	artDetails, err := common.CreateArtifactoryDetailsByFlags(c)
+	policies, err := retention.ReadPolicies(c.GetArgumentAt(0))
 	if err != nil {
-		return
+		return err
 	}
-	imageTag := c.GetArgumentAt(0)
-	targetRepo := c.GetArgumentAt(1)
-	skipLogin := c.GetBoolFlagValue("skip-login")
-	validateSha := c.GetBoolFlagValue("validate-sha")
-
-	buildConfiguration, err := common.CreateBuildConfigurationWithModule(c)
+	artDetails, err := credentials.CreateArtifactoryDetailsByFlags(c)
 	if err != nil {
-		return
+		return err
 	}
-	dockerPushCommand := container.NewPushCommand(containerManagerType)
 	threads, err := common.GetThreadsCount(c)
 	if err != nil {
-		return
+		return err
 	}
-	printDeploymentView, detailedSummary := log.IsStdErrTerminal(), c.GetBoolFlagValue("detailed-summary")
-	dockerPushCommand.SetThreads(threads).SetDetailedSummary(detailedSummary || printDeploymentView).SetCmdParams([]string{"push", imageTag}).SetSkipLogin(skipLogin).SetBuildConfiguration(buildConfiguration).SetRepo(targetRepo).SetServerDetails(artDetails).SetImageTag(imageTag).SetValidateSha(validateSha)
-	err = commandWrappers.ShowDockerDeprecationMessageIfNeeded(containerManagerType, dockerPushCommand.IsGetRepoSupported)
-	if err != nil {
-		return
+	deletesPerSecond := 0
+	if c.GetStringFlagValue("max-deletes-per-second") != "" {
+		if deletesPerSecond, err = strconv.Atoi(c.GetStringFlagValue("max-deletes-per-second")); err != nil || deletesPerSecond <= 0 {
+			return errors.New("The '--max-deletes-per-second' option should have a positive numeric value. " + common.GetDocumentationMessage())
+		}
 	}
-	err = commands.Exec(dockerPushCommand)
-	result := dockerPushCommand.Result()
-
-	// Cleanup.
-	defer common.CleanupResult(result, &err)
-	err = common.PrintCommandSummary(dockerPushCommand.Result(), detailedSummary, printDeploymentView, false, err)
-	return
+	cleanupCmd := retention.NewCleanupCommand().SetServerDetails(artDetails).SetPolicies(policies).SetDryRun(c.GetBoolFlagValue("dry-run")).
+		SetThreads(threads).SetDeletesPerSecond(deletesPerSecond).SetAuditTarget(c.GetStringFlagValue("audit-target"))
+	return commands.Exec(cleanupCmd)
 }
 
-func containerPullCmd(c *components.Context, containerManagerType containerutils.ContainerManagerType) error {
-	if c.GetNumberOfArgs() != 2 {
+func ociPushCmd(c *components.Context) error {
+	if c.GetNumberOfArgs() < 2 {
 		return common.WrongNumberOfArgumentsHandler(c)
 	}
-	artDetails, err := common.CreateArtifactoryDetailsByFlags(c)
+	rtDetails, err := credentials.CreateArtifactoryDetailsByFlags(c)
 	if err != nil {
 		return err
 	}
-	imageTag := c.GetArgumentAt(0)
-	sourceRepo := c.GetArgumentAt(1)
-	skipLogin := c.GetBoolFlagValue("skip-login")
-	buildConfiguration, err := common.CreateBuildConfigurationWithModule(c)
+	annotations, err := ociartifact.ParseAnnotations(c.GetStringFlagValue("annotations"))
 	if err != nil {
 		return err
 	}
-	dockerPullCommand := container.NewPullCommand(containerManagerType)
-	dockerPullCommand.SetCmdParams([]string{"pull", imageTag}).SetSkipLogin(skipLogin).SetImageTag(imageTag).SetRepo(sourceRepo).SetServerDetails(artDetails).SetBuildConfiguration(buildConfiguration)
-	err = commandWrappers.ShowDockerDeprecationMessageIfNeeded(containerManagerType, dockerPullCommand.IsGetRepoSupported)
+	ociPushCmd := ociartifact.NewOciPushCommand()
+	if c.GetStringFlagValue("artifact-type") != "" {
+		ociPushCmd.SetArtifactType(c.GetStringFlagValue("artifact-type"))
+	}
+	ociPushCmd.SetReference(c.GetArgumentAt(0)).SetFiles(c.Arguments[1:]).SetAnnotations(annotations).
+		SetSubject(c.GetStringFlagValue("subject")).SetServerDetails(rtDetails)
+	return commands.Exec(ociPushCmd)
+}
+
+func ociPullCmd(c *components.Context) error {
+	if c.GetNumberOfArgs() != 1 && c.GetNumberOfArgs() != 2 {
+		return common.WrongNumberOfArgumentsHandler(c)
+	}
+	rtDetails, err := credentials.CreateArtifactoryDetailsByFlags(c)
 	if err != nil {
 		return err
 	}
-	return commands.Exec(dockerPullCommand)
+	ociPullCmd := ociartifact.NewOciPullCommand()
+	if c.GetNumberOfArgs() == 2 {
+		ociPullCmd.SetTargetDir(c.GetArgumentAt(1))
+	}
+	ociPullCmd.SetReference(c.GetArgumentAt(0)).SetServerDetails(rtDetails)
+	return commands.Exec(ociPullCmd)
 }
 
-func BuildDockerCreateCmd(c *components.Context) error {
+func ociReferrersCmd(c *components.Context) error {
 	if c.GetNumberOfArgs() != 1 {
 		return common.WrongNumberOfArgumentsHandler(c)
 	}
-	artDetails, err := common.CreateArtifactoryDetailsByFlags(c)
+	rtDetails, err := credentials.CreateArtifactoryDetailsByFlags(c)
 	if err != nil {
 		return err
 	}
-	sourceRepo := c.GetArgumentAt(0)
-	imageNameWithDigestFile := c.GetStringFlagValue("image-file")
+	ociReferrersCmd := ociartifact.NewOciReferrersCommand()
+	ociReferrersCmd.SetReference(c.GetArgumentAt(0)).SetArtifactType(c.GetStringFlagValue("artifact-type")).SetServerDetails(rtDetails)
+	return commands.Exec(ociReferrersCmd)
+}
+
+func ociSignCmd(c *components.Context) error {
+	if c.GetNumberOfArgs() != 1 {
+		return common.WrongNumberOfArgumentsHandler(c)
+	}
+	rtDetails, err := credentials.CreateArtifactoryDetailsByFlags(c)
+	if err != nil {
+		return err
+	}
+	annotations, err := ociartifact.ParseAnnotations(c.GetStringFlagValue("annotations"))
+	if err != nil {
+		return err
+	}
+	ociSignCmd := ociartifact.NewOciSignCommand()
+	ociSignCmd.SetReference(c.GetArgumentAt(0)).SetKeyPath(c.GetStringFlagValue("key")).SetAnnotations(annotations).SetServerDetails(rtDetails)
+	return commands.Exec(ociSignCmd)
+}
+
+func ociVerifyCmd(c *components.Context) error {
+	if c.GetNumberOfArgs() != 1 {
+		return common.WrongNumberOfArgumentsHandler(c)
+	}
+	rtDetails, err := credentials.CreateArtifactoryDetailsByFlags(c)
+	if err != nil {
+		return err
+	}
+	ociVerifyCmd := ociartifact.NewOciVerifyCommand()
+	ociVerifyCmd.SetReference(c.GetArgumentAt(0)).SetKeyPath(c.GetStringFlagValue("key")).SetServerDetails(rtDetails)
+	return commands.Exec(ociVerifyCmd)
+}
+
+func mvnDeployFileCmd(c *components.Context) error {
+	if c.GetNumberOfArgs() != 2 {
+		return common.WrongNumberOfArgumentsHandler(c)
+	}
+	rtDetails, err := credentials.CreateArtifactoryDetailsByFlags(c)
+	if err != nil {
+		return err
+	}
+	buildConfiguration, err := common.CreateBuildConfigurationWithModule(c)
+	if err != nil {
+		return err
+	}
+	attachments, err := mvn.ParseAttachments(c.GetStringFlagValue("attach"))
+	if err != nil {
+		return err
+	}
+	coordinates := mvn.MavenCoordinates{
+		GroupId:    c.GetStringFlagValue("group-id"),
+		ArtifactId: c.GetStringFlagValue("artifact-id"),
+		Version:    c.GetStringFlagValue("version"),
+		Classifier: c.GetStringFlagValue("classifier"),
+		Packaging:  c.GetStringFlagValue("packaging"),
+	}
+	mvnDeployFileCmd := mvn.NewMvnDeployFileCommand()
+	if c.GetStringFlagValue("layout") != "" {
+		mvnDeployFileCmd.SetLayout(c.GetStringFlagValue("layout"))
+	}
+	mvnDeployFileCmd.SetFile(c.GetArgumentAt(0)).SetTargetRepo(c.GetArgumentAt(1)).SetCoordinates(coordinates).
+		SetAttachments(attachments).SetGeneratePom(c.GetBoolTFlagValue("generate-pom")).SetDryRun(c.GetBoolFlagValue("dry-run")).
+		SetBuildConfiguration(buildConfiguration).SetServerDetails(rtDetails)
+	return commands.Exec(mvnDeployFileCmd)
+}
+
+func dockerPromoteCmd(c *components.Context) error {
+	if c.GetNumberOfArgs() != 3 {
+		return common.WrongNumberOfArgumentsHandler(c)
+	}
+	artDetails, err := credentials.CreateArtifactoryDetailsByFlags(c)
+	if err != nil {
+		return err
+	}
+	params := services.NewDockerPromoteParams(c.GetArgumentAt(0), c.GetArgumentAt(1), c.GetArgumentAt(2))
+	params.TargetDockerImage = c.GetStringFlagValue("target-docker-image")
+	params.SourceTag = c.GetStringFlagValue("source-tag")
+	params.TargetTag = c.GetStringFlagValue("target-tag")
+	params.Copy = c.GetBoolFlagValue("copy")
+	dockerPromoteCommand := container.NewDockerPromoteCommand()
+	dockerPromoteCommand.SetParams(params).SetServerDetails(artDetails).SetDryRun(c.GetBoolFlagValue("dry-run"))
+
+	return commands.Exec(dockerPromoteCommand)
+}
+
+func containerPushCmd(c *components.Context, containerManagerType containerutils.ContainerManagerType) (err error) {
+	if c.GetNumberOfArgs() != 2 {
+		return common.WrongNumberOfArgumentsHandler(c)
+	}
+	artDetails, err := credentials.CreateArtifactoryDetailsByFlags(c)
+	if err != nil {
+		return
+	}
+	imageTag := c.GetArgumentAt(0)
+	targetRepo := c.GetArgumentAt(1)
+	skipLogin := c.GetBoolFlagValue("skip-login")
+	validateSha := c.GetBoolFlagValue("validate-sha")
+
+	buildConfiguration, err := common.CreateBuildConfigurationWithModule(c)
+	if err != nil {
+		return
+	}
+	dockerPushCommand := container.NewPushCommand(containerManagerType)
+	threads, err := common.GetThreadsCount(c)
+	if err != nil {
+		return
+	}
+	printDeploymentView, detailedSummary := log.IsStdErrTerminal(), c.GetBoolFlagValue("detailed-summary")
+	dockerPushCommand.SetThreads(threads).SetDetailedSummary(detailedSummary || printDeploymentView).SetCmdParams([]string{"push", imageTag}).SetSkipLogin(skipLogin).SetBuildConfiguration(buildConfiguration).SetRepo(targetRepo).SetServerDetails(artDetails).SetImageTag(imageTag).SetValidateSha(validateSha)
+	err = commandWrappers.ShowDockerDeprecationMessageIfNeeded(containerManagerType, dockerPushCommand.IsGetRepoSupported)
+	if err != nil {
+		return
+	}
+	err = commands.Exec(dockerPushCommand)
+	result := dockerPushCommand.Result()
+
+	// Cleanup.
+	defer common.CleanupResult(result, &err)
+	err = common.PrintCommandSummary(dockerPushCommand.Result(), detailedSummary, printDeploymentView, false, err)
+	return
+}
+
+func containerPullCmd(c *components.Context, containerManagerType containerutils.ContainerManagerType) error {
+	if c.GetNumberOfArgs() != 2 {
+		return common.WrongNumberOfArgumentsHandler(c)
+	}
+	artDetails, err := credentials.CreateArtifactoryDetailsByFlags(c)
+	if err != nil {
+		return err
+	}
+	imageTag := c.GetArgumentAt(0)
+	sourceRepo := c.GetArgumentAt(1)
+	skipLogin := c.GetBoolFlagValue("skip-login")
+	buildConfiguration, err := common.CreateBuildConfigurationWithModule(c)
+	if err != nil {
+		return err
+	}
+	dockerPullCommand := container.NewPullCommand(containerManagerType)
+	dockerPullCommand.SetCmdParams([]string{"pull", imageTag}).SetSkipLogin(skipLogin).SetImageTag(imageTag).SetRepo(sourceRepo).SetServerDetails(artDetails).SetBuildConfiguration(buildConfiguration)
+	err = commandWrappers.ShowDockerDeprecationMessageIfNeeded(containerManagerType, dockerPullCommand.IsGetRepoSupported)
+	if err != nil {
+		return err
+	}
+	return commands.Exec(dockerPullCommand)
+}
+
+func BuildDockerCreateCmd(c *components.Context) error {
+	if c.GetNumberOfArgs() != 1 {
+		return common.WrongNumberOfArgumentsHandler(c)
+	}
+	artDetails, err := credentials.CreateArtifactoryDetailsByFlags(c)
+	if err != nil {
+		return err
+	}
+	sourceRepo := c.GetArgumentAt(0)
+	imageNameWithDigestFile := c.GetStringFlagValue("image-file")
 	if imageNameWithDigestFile == "" {
 		return common.PrintHelpAndReturnError("The '--image-file' command option was not provided.", c)
 	}
@@ -567,6 +1488,13 @@ func BuildDockerCreateCmd(c *components.Context) error {
 	if err = buildDockerCreateCommand.SetImageNameWithDigest(imageNameWithDigestFile); err != nil {
 		return err
 	}
+	var baseImages []containerutils.DockerImage
+	for _, baseImage := range getCommaSeparatedFlagValue(c, "base-images") {
+		if baseImage = strings.TrimSpace(baseImage); baseImage != "" {
+			baseImages = append(baseImages, containerutils.DockerImage{Image: baseImage})
+		}
+	}
+	buildDockerCreateCommand.SetBaseImages(baseImages)
 	buildDockerCreateCommand.SetRepo(sourceRepo).SetServerDetails(artDetails).SetBuildConfiguration(buildConfiguration)
 	return commands.Exec(buildDockerCreateCommand)
 }
@@ -626,11 +1554,31 @@ func nugetDepsTreeCmd(c *components.Context) error {
 	return dotnet.DependencyTreeCmd()
 }
 
+func healthCheckCmd(c *components.Context) error {
+	if c.GetNumberOfArgs() > 0 {
+		return common.PrintHelpAndReturnError("No arguments should be sent.", c)
+	}
+	artDetails, err := credentials.CreateArtifactoryDetailsByFlags(c)
+	if err != nil {
+		return err
+	}
+	healthCheckCmd := generic.NewHealthCheckCommand()
+	healthCheckCmd.SetServerDetails(artDetails).SetRepo(c.GetStringFlagValue("repo")).SetFormat(c.GetStringFlagValue("format"))
+	if c.IsFlagSet("max-clock-skew") {
+		maxClockSkew, err := time.ParseDuration(c.GetStringFlagValue("max-clock-skew"))
+		if err != nil || maxClockSkew <= 0 {
+			return errorutils.CheckErrorf("the --max-clock-skew option should be a positive duration, such as 30s or 2m, but got '%s'", c.GetStringFlagValue("max-clock-skew"))
+		}
+		healthCheckCmd.SetMaxClockSkew(maxClockSkew)
+	}
+	return commands.Exec(healthCheckCmd)
+}
+
 func pingCmd(c *components.Context) error {
 	if c.GetNumberOfArgs() > 0 {
 		return common.PrintHelpAndReturnError("No arguments should be sent.", c)
 	}
-	artDetails, err := common.CreateArtifactoryDetailsByFlags(c)
+	artDetails, err := credentials.CreateArtifactoryDetailsByFlags(c)
 	if err != nil {
 		return err
 	}
@@ -646,7 +1594,44 @@ func pingCmd(c *components.Context) error {
 	return err
 }
 
+func proxyCmd(c *components.Context) error {
+	if c.GetNumberOfArgs() > 0 {
+		return common.PrintHelpAndReturnError("No arguments should be sent.", c)
+	}
+	// Artifactory itself commonly listens on 8081, so the port has no default.
+	if c.GetStringFlagValue("port") == "" {
+		return common.PrintHelpAndReturnError("The '--port' option is mandatory.", c)
+	}
+	port, err := strconv.Atoi(c.GetStringFlagValue("port"))
+	if err != nil {
+		return errors.New("The '--port' option should have a numeric value. " + common.GetDocumentationMessage())
+	}
+	buildConfiguration, err := common.CreateBuildConfigurationWithModule(c)
+	if err != nil {
+		return err
+	}
+	artDetails, err := credentials.CreateArtifactoryDetailsByFlags(c)
+	if err != nil {
+		return err
+	}
+	proxyCmd := proxy.NewProxyCommand().SetServerDetails(artDetails).SetBuildConfiguration(buildConfiguration).
+		SetPort(port).SetRepo(c.GetStringFlagValue("repo")).SetAllowWrites(c.GetBoolFlagValue("allow-writes"))
+	return commands.Exec(proxyCmd)
+}
+
 func prepareDownloadCommand(c *components.Context) (*spec.SpecFiles, error) {
+	if c.IsFlagSet("aql-file") {
+		if c.GetNumberOfArgs() > 1 || c.IsFlagSet("spec") {
+			return nil, common.PrintHelpAndReturnError("Only the target path argument may be sent when the aql-file option is used.", c)
+		}
+		return spec.NewBuilder().
+			Target(c.GetArgumentAt(0)).
+			Flat(c.GetBoolFlagValue("flat")).
+			Explode(strconv.FormatBool(c.GetBoolFlagValue("explode"))).
+			BypassArchiveInspection(c.GetBoolFlagValue("bypass-archive-inspection")).
+			ValidateSymlinks(c.GetBoolFlagValue("validate-symlinks")).
+			BuildSpec(), nil
+	}
 	if c.GetNumberOfArgs() > 0 && c.IsFlagSet("spec") {
 		return nil, common.PrintHelpAndReturnError("No arguments should be sent when the spec option is used.", c)
 	}
@@ -658,7 +1643,7 @@ func prepareDownloadCommand(c *components.Context) (*spec.SpecFiles, error) {
 	var err error
 
 	if c.IsFlagSet("spec") {
-		downloadSpec, err = commonCliUtils.GetSpec(c, true, true)
+		downloadSpec, err = specv2.GetSpec(c, true, true)
 	} else {
 		downloadSpec, err = createDefaultDownloadSpec(c)
 	}
@@ -689,7 +1674,7 @@ func prepareDirectDownloadCommand(c *components.Context) (*spec.SpecFiles, error
 	)
 
 	if c.IsFlagSet("spec") {
-		downloadSpec, err = commonCliUtils.GetSpec(c, true, true)
+		downloadSpec, err = specv2.GetSpec(c, true, true)
 	} else {
 		downloadSpec = createDirectDownloadSpec(c)
 	}
@@ -756,7 +1741,7 @@ func directDownloadCmd(c *components.Context) error {
 	if err != nil {
 		return err
 	}
-	serverDetails, err := common.CreateArtifactoryDetailsByFlags(c)
+	serverDetails, err := credentials.CreateArtifactoryDetailsByFlags(c)
 	if err != nil {
 		return err
 	}
@@ -773,8 +1758,14 @@ func directDownloadCmd(c *components.Context) error {
 		return err
 	}
 
+	rateLimit, globalRateLimit, err := getRateLimits(c)
+	if err != nil {
+		return err
+	}
+
 	directDownloadCommand := generic.NewDirectDownloadCommand()
 	directDownloadCommand.SetConfiguration(configuration).SetBuildConfiguration(buildConfiguration).SetSpec(downloadSpec).SetServerDetails(serverDetails).SetDryRun(c.GetBoolFlagValue("dry-run")).SetSyncDeletesPath(c.GetStringFlagValue("sync-deletes")).SetQuiet(common.GetQuietValue(c)).SetDetailedSummary(c.GetBoolFlagValue("detailed-summary")).SetRetries(retries).SetRetryWaitMilliSecs(retryWaitTime)
+	directDownloadCommand.SetRateLimit(rateLimit).SetGlobalRateLimit(globalRateLimit)
 
 	if directDownloadCommand.ShouldPrompt() && !coreutils.AskYesNo("Sync-deletes may delete some files in your local file system. Are you sure you want to continue?\n"+
 		"You can avoid this confirmation message by adding --quiet to the command.", false) {
@@ -784,12 +1775,12 @@ func directDownloadCmd(c *components.Context) error {
 	err = progressbar.ExecWithProgress(directDownloadCommand)
 	result := directDownloadCommand.Result()
 	defer common.CleanupResult(result, &err)
-	basicSummary, err := common.CreateSummaryReportString(result.SuccessCount(), result.FailCount(), common.IsFailNoOp(c), err)
+	basicSummary, err := common.CreateSummaryReportString(result.SuccessCount(), result.FailCount(), clierrors.IsFailNoOp(c), err)
 	if err != nil {
 		return err
 	}
 	err = common.PrintDetailedSummaryReport(basicSummary, result.Reader(), false, err)
-	return common.GetCliError(err, result.SuccessCount(), result.FailCount(), common.IsFailNoOp(c))
+	return clierrors.GetCliError(err, result.SuccessCount(), result.FailCount(), clierrors.IsFailNoOp(c))
 }
 
 func downloadCmd(c *components.Context) error {
@@ -797,13 +1788,17 @@ func downloadCmd(c *components.Context) error {
 	if err != nil {
 		return err
 	}
+	outputFormat, err := formats.ParseFormat(c.GetStringFlagValue("format"))
+	if err != nil {
+		return err
+	}
 
 	fixWinPathsForDownloadCmd(downloadSpec, c)
 	configuration, err := artifactoryUtils.CreateDownloadConfiguration(c)
 	if err != nil {
 		return err
 	}
-	serverDetails, err := common.CreateArtifactoryDetailsByFlags(c)
+	serverDetails, err := credentials.CreateArtifactoryDetailsByFlags(c)
 	if err != nil {
 		return err
 	}
@@ -819,23 +1814,145 @@ func downloadCmd(c *components.Context) error {
 	if err != nil {
 		return err
 	}
+	rateLimit, globalRateLimit, err := getRateLimits(c)
+	if err != nil {
+		return err
+	}
+	minThreads, err := getMinThreads(c, configuration.Threads)
+	if err != nil {
+		return err
+	}
 	downloadCommand := generic.NewDownloadCommand()
-	downloadCommand.SetConfiguration(configuration).SetBuildConfiguration(buildConfiguration).SetSpec(downloadSpec).SetServerDetails(serverDetails).SetDryRun(c.GetBoolFlagValue("dry-run")).SetSyncDeletesPath(c.GetStringFlagValue("sync-deletes")).SetQuiet(common.GetQuietValue(c)).SetDetailedSummary(c.GetBoolFlagValue("detailed-summary")).SetRetries(retries).SetRetryWaitMilliSecs(retryWaitTime)
+	// The detailed summary is also collected for the checksums and the sizes of the files in the summary output.
+	detailedSummary := c.GetBoolFlagValue("detailed-summary")
+	downloadCommand.SetConfiguration(configuration).SetBuildConfiguration(buildConfiguration).SetSpec(downloadSpec).SetServerDetails(serverDetails).SetDryRun(c.GetBoolFlagValue("dry-run")).SetSyncDeletesPath(c.GetStringFlagValue("sync-deletes")).SetQuiet(common.GetQuietValue(c)).SetDetailedSummary(detailedSummary || c.IsFlagSet("summary-output")).SetRetries(retries).SetRetryWaitMilliSecs(retryWaitTime)
+	downloadCommand.SetDelta(c.GetBoolFlagValue("delta"))
+	if err = setDownloadVerification(c, downloadCommand); err != nil {
+		return err
+	}
+	downloadCommand.SetPreserveSymlinks(c.GetBoolFlagValue("preserve-symlinks"))
+	downloadCommand.SetLatest(c.GetBoolFlagValue("latest")).SetVersionRange(c.GetStringFlagValue("version-range"))
+	cache, err := getDownloadCache(c)
+	if err != nil {
+		return err
+	}
+	if cache != nil {
+		downloadCommand.SetCache(cache)
+	}
+	if c.IsFlagSet("extract-entries") {
+		downloadCommand.SetArchiveEntryPatterns(c.GetStringsArrFlagValue("extract-entries"))
+	}
+	downloadCommand.SetRateLimit(rateLimit).SetGlobalRateLimit(globalRateLimit)
+	downloadCommand.SetAdaptiveThreads(c.GetBoolFlagValue("adaptive-threads")).SetMinThreads(minThreads)
+	rawAql, err := getRawAql(c)
+	if err != nil {
+		return err
+	}
+	if rawAql != "" && (c.GetBoolFlagValue("latest") || c.IsFlagSet("version-range")) {
+		return errorutils.CheckErrorf("the --latest and --version-range options cannot be used with a raw AQL query")
+	}
+	downloadCommand.SetRawAql(rawAql)
+	keyProvider, err := getEncryptionKeyProvider(c)
+	if err != nil {
+		return err
+	}
+	if keyProvider != nil {
+		downloadCommand.SetEncryptionKeyProvider(keyProvider)
+	}
+
+	if c.IsFlagSet("output") {
+		return downloadToStdout(c, downloadCommand)
+	}
 
 	if downloadCommand.ShouldPrompt() && !coreutils.AskYesNo("Sync-deletes may delete some files in your local file system. Are you sure you want to continue?\n"+
 		"You can avoid this confirmation message by adding --quiet to the command.", false) {
 		return nil
 	}
 	// This error is being checked later on because we need to generate summary report before return.
+	startedOn := time.Now()
 	err = progressbar.ExecWithProgress(downloadCommand)
 	result := downloadCommand.Result()
 	defer common.CleanupResult(result, &err)
-	basicSummary, err := common.CreateSummaryReportString(result.SuccessCount(), result.FailCount(), common.IsFailNoOp(c), err)
+	logDownloadVerification(downloadCommand.Verification())
+	if summaryErr := writeCommandSummary(c, downloadOperation, serverDetails, startedOn, result, downloadCommand.Verification(), err); summaryErr != nil && err == nil {
+		err = summaryErr
+	}
+	if outputFormat != "" {
+		return printTransferSummary(c, result, detailedSummary, outputFormat, err)
+	}
+	basicSummary, err := common.CreateSummaryReportString(result.SuccessCount(), result.FailCount(), clierrors.IsFailNoOp(c), err)
 	if err != nil {
 		return err
 	}
-	err = common.PrintDetailedSummaryReport(basicSummary, result.Reader(), false, err)
-	return common.GetCliError(err, result.SuccessCount(), result.FailCount(), common.IsFailNoOp(c))
+	detailsReader := result.Reader()
+	if !detailedSummary {
+		detailsReader = nil
+	}
+	err = common.PrintDetailedSummaryReport(basicSummary, detailsReader, false, err)
+	return clierrors.GetCliError(err, result.SuccessCount(), result.FailCount(), clierrors.IsFailNoOp(c))
+}
+
+// setDownloadVerification sets the verification of the downloaded files by the --verify options.
+func setDownloadVerification(c *components.Context, downloadCommand *generic.DownloadCommand) error {
+	algorithm, policy, retries := generic.VerifySha256, generic.FailOnMismatch, generic.DefaultVerifyRetries
+	var err error
+	if c.IsFlagSet("verify") {
+		if algorithm, err = generic.ParseVerifyAlgorithm(c.GetStringFlagValue("verify")); err != nil {
+			return err
+		}
+	}
+	if c.IsFlagSet("verify-failure") {
+		if policy, err = generic.ParseVerifyFailurePolicy(c.GetStringFlagValue("verify-failure")); err != nil {
+			return err
+		}
+	}
+	if c.GetStringFlagValue("verify-retries") != "" {
+		if retries, err = strconv.Atoi(c.GetStringFlagValue("verify-retries")); err != nil || retries < 1 {
+			return errorutils.CheckErrorf("the --verify-retries option should be a positive number")
+		}
+	}
+	downloadCommand.SetVerification(algorithm, policy, retries)
+	return nil
+}
+
+// getDownloadCache returns the download cache in the directory of the --cache-dir option or the JFROG_CLI_DOWNLOAD_CACHE
+// environment variable, or nil if neither is set.
+func getDownloadCache(c *components.Context) (*downloadcache.Cache, error) {
+	cacheDir := c.GetStringFlagValue("cache-dir")
+	if cacheDir == "" {
+		cacheDir = os.Getenv(downloadcache.DownloadCacheEnv)
+	}
+	maxSizeMB := downloadcache.DefaultMaxSizeMB
+	if c.GetStringFlagValue("cache-max-size") != "" {
+		var err error
+		if maxSizeMB, err = strconv.Atoi(c.GetStringFlagValue("cache-max-size")); err != nil || maxSizeMB < 1 {
+			return nil, errorutils.CheckErrorf("the --cache-max-size option should be a positive number of megabytes")
+		}
+	}
+	if cacheDir == "" {
+		return nil, nil
+	}
+	return downloadcache.New(cacheDir, int64(maxSizeMB)*1024*1024), nil
+}
+
+func logDownloadVerification(verification *formats.DownloadVerification) {
+	if verification == nil {
+		return
+	}
+	log.Info(fmt.Sprintf("Verified the %s checksums of %d downloaded files: %d downloaded again, %d skipped, %d mismatched and %d quarantined.",
+		verification.Algorithm, verification.Verified, verification.Redownloaded, verification.Skipped, len(verification.Mismatched), len(verification.Quarantined)))
+}
+
+// downloadToStdout streams the downloaded artifact to the standard output.
+// Neither the progress bar nor the summary are displayed, since they would be mixed with the streamed content.
+func downloadToStdout(c *components.Context, downloadCommand *generic.DownloadCommand) error {
+	if c.GetStringFlagValue("output") != generic.StdoutOutput {
+		return errorutils.CheckErrorf("the --output option only supports '%s', for streaming the artifact to the standard output", generic.StdoutOutput)
+	}
+	downloadCommand.SetOutputWriter(os.Stdout)
+	err := commands.Exec(downloadCommand)
+	result := downloadCommand.Result()
+	return clierrors.GetCliError(err, result.SuccessCount(), result.FailCount(), clierrors.IsFailNoOp(c))
 }
 
 func checkRbExistenceInV2(c *components.Context) (bool, error) {
@@ -849,7 +1966,7 @@ func checkRbExistenceInV2(c *components.Context) (bool, error) {
 		return false, err
 	}
 
-	lcServicesManager, err := utils.CreateLifecycleServiceManager(lcDetails, false)
+	lcServicesManager, err := clientconfig.CreateLifecycleServiceManager(lcDetails, false)
 	if err != nil {
 		return false, err
 	}
@@ -858,7 +1975,7 @@ func checkRbExistenceInV2(c *components.Context) (bool, error) {
 }
 
 func createLifecycleDetailsByFlags(c *components.Context) (*config.ServerDetails, error) {
-	lcDetails, err := common.CreateServerDetailsWithConfigOffer(c, true, commonCliUtils.Platform)
+	lcDetails, err := credentials.CreateServerDetailsWithConfigOffer(c, true, commonCliUtils.Platform)
 	if err != nil {
 		return nil, err
 	}
@@ -886,6 +2003,19 @@ func PlatformToLifecycleUrls(lcDetails *config.ServerDetails) {
 	lcDetails.Url = ""
 }
 
+// validateUploadSpec validates the upload spec.
+// The spec validation only accepts zip archives, while tar.gz archives are also supported, since they are streamed by the CLI.
+func validateUploadSpec(uploadSpec *spec.SpecFiles) error {
+	files := make([]spec.File, len(uploadSpec.Files))
+	copy(files, uploadSpec.Files)
+	for i := range files {
+		if files[i].Archive == generic.ArchiveFormatTarGz {
+			files[i].Archive = generic.ArchiveFormatZip
+		}
+	}
+	return spec.ValidateSpec(files, true, false)
+}
+
 func uploadCmd(c *components.Context) (err error) {
 	if c.GetNumberOfArgs() > 0 && c.IsFlagSet("spec") {
 		return common.PrintHelpAndReturnError("No arguments should be sent when the spec option is used.", c)
@@ -896,14 +2026,14 @@ func uploadCmd(c *components.Context) (err error) {
 
 	var uploadSpec *spec.SpecFiles
 	if c.IsFlagSet("spec") {
-		uploadSpec, err = commonCliUtils.GetSpec(c, false, true)
+		uploadSpec, err = specv2.GetSpec(c, false, true)
 	} else {
 		uploadSpec, err = createDefaultUploadSpec(c)
 	}
 	if err != nil {
 		return
 	}
-	err = spec.ValidateSpec(uploadSpec.Files, true, false)
+	err = validateUploadSpec(uploadSpec)
 	if err != nil {
 		return
 	}
@@ -924,58 +2054,243 @@ func uploadCmd(c *components.Context) (err error) {
 	if err != nil {
 		return
 	}
-	uploadCmd := generic.NewUploadCommand()
-	rtDetails, err := common.CreateArtifactoryDetailsByFlags(c)
+	rateLimit, globalRateLimit, err := getRateLimits(c)
+	if err != nil {
+		return
+	}
+	minThreads, err := getMinThreads(c, configuration.Threads)
+	if err != nil {
+		return
+	}
+	outputFormat, err := formats.ParseFormat(c.GetStringFlagValue("format"))
+	if err != nil {
+		return
+	}
+	keyProvider, err := getEncryptionKeyProvider(c)
+	if err != nil {
+		return
+	}
+	if c.IsFlagSet("staging-repo") && !c.GetBoolFlagValue("atomic") {
+		return errorutils.CheckErrorf("the --staging-repo option can be used only with --atomic")
+	}
+	// The deployment view isn't printed with the structured output.
+	printDeploymentView, detailedSummary := log.IsStdErrTerminal() && outputFormat == "", common.GetDetailedSummary(c)
+	newUploadCommand := func(serverDetails *config.ServerDetails) *generic.UploadCommand {
+		uploadCmd := generic.NewUploadCommand()
+		uploadCmd.SetUploadConfiguration(configuration).SetBuildConfiguration(buildConfiguration).SetSpec(uploadSpec).SetServerDetails(serverDetails).SetDryRun(c.GetBoolFlagValue("dry-run")).SetSyncDeletesPath(c.GetStringFlagValue("sync-deletes")).SetQuiet(common.GetQuietValue(c)).SetDetailedSummary(detailedSummary || printDeploymentView || c.IsFlagSet("summary-output")).SetRetries(retries).SetRetryWaitMilliSecs(retryWaitTime)
+		uploadCmd.SetDeltaManifest(c.GetBoolFlagValue("delta-manifest"))
+		uploadCmd.SetPreserveSymlinks(c.GetBoolFlagValue("preserve-symlinks"))
+		uploadCmd.SetProjectKey(c.GetStringFlagValue("project"))
+		uploadCmd.SetDedup(c.GetBoolFlagValue("dedup"))
+		if c.IsFlagSet("dedup-repos") {
+			uploadCmd.SetDedupRepos(c.GetStringsArrFlagValue("dedup-repos"))
+		}
+		uploadCmd.SetAtomic(c.GetBoolFlagValue("atomic")).SetStagingRepo(c.GetStringFlagValue("staging-repo"))
+		uploadCmd.SetRateLimit(rateLimit).SetGlobalRateLimit(globalRateLimit)
+		uploadCmd.SetAdaptiveThreads(c.GetBoolFlagValue("adaptive-threads")).SetMinThreads(minThreads)
+		if keyProvider != nil {
+			uploadCmd.SetEncryptionKeyProvider(keyProvider)
+		}
+		return uploadCmd
+	}
+	if c.GetBoolFlagValue("watch") {
+		return uploadWatchCmd(c, newUploadCommand)
+	}
+	if c.IsFlagSet("watch-debounce") {
+		return errorutils.CheckErrorf("the --watch-debounce option can be used only with --watch")
+	}
+	if c.IsFlagSet("servers") {
+		return multiServerUploadCmd(c, newUploadCommand, outputFormat, detailedSummary)
+	}
+	if c.GetBoolFlagValue("all-or-nothing") {
+		return errorutils.CheckErrorf("the --all-or-nothing option can be used only with --servers")
+	}
+	rtDetails, err := credentials.CreateArtifactoryDetailsByFlags(c)
 	if err != nil {
 		return
 	}
-	printDeploymentView, detailedSummary := log.IsStdErrTerminal(), common.GetDetailedSummary(c)
-	uploadCmd.SetUploadConfiguration(configuration).SetBuildConfiguration(buildConfiguration).SetSpec(uploadSpec).SetServerDetails(rtDetails).SetDryRun(c.GetBoolFlagValue("dry-run")).SetSyncDeletesPath(c.GetStringFlagValue("sync-deletes")).SetQuiet(common.GetQuietValue(c)).SetDetailedSummary(detailedSummary || printDeploymentView).SetRetries(retries).SetRetryWaitMilliSecs(retryWaitTime)
+	uploadCmd := newUploadCommand(rtDetails)
 
 	if uploadCmd.ShouldPrompt() && !coreutils.AskYesNo("Sync-deletes may delete some artifacts in Artifactory. Are you sure you want to continue?\n"+
 		"You can avoid this confirmation message by adding --quiet to the command.", false) {
 		return nil
 	}
 	// This error is being checked later on because we need to generate summary report before return.
+	startedOn := time.Now()
 	err = progressbar.ExecWithProgress(uploadCmd)
 	result := uploadCmd.Result()
 	defer common.CleanupResult(result, &err)
-	err = common.PrintCommandSummary(uploadCmd.Result(), detailedSummary, printDeploymentView, common.IsFailNoOp(c), err)
-	return
-}
-
-func prepareCopyMoveCommand(c *components.Context) (*spec.SpecFiles, error) {
-	if c.GetNumberOfArgs() > 0 && c.IsFlagSet("spec") {
-		return nil, common.PrintHelpAndReturnError("No arguments should be sent when the spec option is used.", c)
+	if summaryErr := writeCommandSummary(c, uploadOperation, rtDetails, startedOn, result, nil, err); summaryErr != nil && err == nil {
+		err = summaryErr
 	}
-	if c.GetNumberOfArgs() != 2 && (c.GetNumberOfArgs() != 0 || !c.IsFlagSet("spec")) {
-		return nil, common.WrongNumberOfArgumentsHandler(c)
+	if outputFormat != "" {
+		return printTransferSummary(c, result, detailedSummary, outputFormat, err)
 	}
+	failNoOp := clierrors.IsFailNoOp(c)
+	err = common.PrintCommandSummary(result, detailedSummary, printDeploymentView, failNoOp, err)
+	if result != nil {
+		err = clierrors.GetCliError(err, result.SuccessCount(), result.FailCount(), failNoOp)
+	}
+	return
+}
 
-	var copyMoveSpec *spec.SpecFiles
-	var err error
-	if c.IsFlagSet("spec") {
-		copyMoveSpec, err = commonCliUtils.GetSpec(c, false, true)
-	} else {
-		copyMoveSpec, err = createDefaultCopyMoveSpec(c)
+// uploadWatchCmd uploads the spec, and then uploads the changed files until interrupted.
+func uploadWatchCmd(c *components.Context, newUploadCommand func(*config.ServerDetails) *generic.UploadCommand) error {
+	if c.IsFlagSet("servers") {
+		return errorutils.CheckErrorf("the --watch option can't be used with --servers")
 	}
-	if err != nil {
-		return nil, err
+	debounce := generic.DefaultWatchDebounce
+	if c.IsFlagSet("watch-debounce") {
+		var err error
+		if debounce, err = time.ParseDuration(c.GetStringFlagValue("watch-debounce")); err != nil || debounce <= 0 {
+			return errorutils.CheckErrorf("the --watch-debounce option should be a positive duration, such as 500ms or 2s, but got '%s'", c.GetStringFlagValue("watch-debounce"))
+		}
 	}
-	err = spec.ValidateSpec(copyMoveSpec.Files, true, true)
+	rtDetails, err := credentials.CreateArtifactoryDetailsByFlags(c)
 	if err != nil {
-		return nil, err
+		return err
 	}
-	return copyMoveSpec, nil
+	return commands.Exec(generic.NewUploadWatchCommand(newUploadCommand(rtDetails)).SetDebounce(debounce))
 }
 
-func moveCmd(c *components.Context) error {
+// multiServerUploadCmd uploads the spec to the servers of the --servers option in parallel, and prints the result of
+// the upload to each server.
+func multiServerUploadCmd(c *components.Context, newUploadCommand func(*config.ServerDetails) *generic.UploadCommand, outputFormat formats.Format, detailedSummary bool) (err error) {
+	if c.IsFlagSet("server-id") || c.IsFlagSet("url") {
+		return errorutils.CheckErrorf("the --servers option can't be used with --server-id or --url")
+	}
+	if c.IsFlagSet("build-name") || c.IsFlagSet("build-number") {
+		return errorutils.CheckErrorf("the --servers option can't be used with build-info collection, since the artifacts of each server would be added to the build-info")
+	}
+	multiServerUploadCmd := generic.NewMultiServerUploadCommand().SetAllOrNothing(c.GetBoolFlagValue("all-or-nothing"))
+	for _, serverId := range strings.Split(c.GetStringFlagValue("servers"), ",") {
+		if serverId = strings.TrimSpace(serverId); serverId == "" {
+			continue
+		}
+		serverDetails, err := credentials.GetSpecificConfig(serverId, false, true)
+		if err != nil {
+			return err
+		}
+		multiServerUploadCmd.AddServer(serverId, newUploadCommand(serverDetails))
+	}
+	uploads := multiServerUploadCmd.Uploads()
+	if len(uploads) > 0 && uploads[0].Command.ShouldPrompt() && !coreutils.AskYesNo("Sync-deletes may delete some artifacts in Artifactory. Are you sure you want to continue?\n"+
+		"You can avoid this confirmation message by adding --quiet to the command.", false) {
+		return nil
+	}
+	startedOn := time.Now()
+	err = commands.Exec(multiServerUploadCmd)
+	multiServerUpload := formats.MultiServerUpload{}
+	for _, upload := range uploads {
+		result := upload.Command.Result()
+		defer common.CleanupResult(result, &err)
+		serverDetails, _ := upload.Command.ServerDetails()
+		if summaryErr := writeServerCommandSummary(c, upload.ServerId, serverDetails, startedOn, result, upload.Err); summaryErr != nil && err == nil {
+			err = summaryErr
+		}
+		transferSummary, summaryErr := formats.NewTransferSummary(result, detailedSummary && outputFormat != "", upload.Err)
+		if summaryErr != nil {
+			if err == nil {
+				err = summaryErr
+			}
+			return
+		}
+		serverSummary := formats.ServerTransferSummary{ServerId: upload.ServerId, TransferSummary: *transferSummary, RolledBack: upload.RolledBack}
+		if upload.Err != nil {
+			serverSummary.Error = upload.Err.Error()
+		}
+		multiServerUpload = append(multiServerUpload, serverSummary)
+	}
+	if outputFormat == "" {
+		outputFormat = formats.TableFormat
+	}
+	if printErr := formats.Print(outputFormat, formats.MultiServerUploadKind, multiServerUpload); printErr != nil && err == nil {
+		err = printErr
+	}
+	return
+}
+
+// writeServerCommandSummary writes the summary of the upload to a server of the --servers option, next to the file of
+// the --summary-output option, with the ID of the server added to its name.
+func writeServerCommandSummary(c *components.Context, serverId string, serverDetails *config.ServerDetails, startedOn time.Time, result *commandUtils.Result, commandErr error) error {
+	telemetry.AddTransferredFiles(uploadOperation, result.SuccessCount(), result.FailCount())
+	summaryPath := c.GetStringFlagValue("summary-output")
+	if summaryPath == "" {
+		return nil
+	}
+	extension := filepath.Ext(summaryPath)
+	summary := formats.NewCommandSummary(uploadOperation, serverDetails, startedOn, result.SuccessCount(), result.FailCount(), commandErr)
+	if err := summary.AddTransferredFiles(result, false); err != nil {
+		return err
+	}
+	return formats.WriteCommandSummary(strings.TrimSuffix(summaryPath, extension)+"-"+serverId+extension, summary)
+}
+
+// printTransferSummary prints the summary of the upload or download in the structured output format, and returns the
+// error of the command.
+func printTransferSummary(c *components.Context, result *commandUtils.Result, detailedSummary bool, outputFormat formats.Format, transferErr error) error {
+	transferSummary, err := formats.NewTransferSummary(result, detailedSummary, transferErr)
+	if err != nil {
+		return err
+	}
+	if err = formats.Print(outputFormat, formats.TransferSummaryKind, transferSummary); err != nil {
+		return err
+	}
+	return clierrors.GetCliError(transferErr, transferSummary.Totals.Success, transferSummary.Totals.Failure, clierrors.IsFailNoOp(c))
+}
+
+// writeCommandSummary writes the summary of the operation to the file of the --summary-output option, if it's set.
+// The checksums and the sizes of the files of an upload or a download are added to the summary.
+func writeCommandSummary(c *components.Context, operation string, serverDetails *config.ServerDetails, startedOn time.Time, result *commandUtils.Result, verification *formats.DownloadVerification, commandErr error) error {
+	if result != nil {
+		telemetry.AddTransferredFiles(operation, result.SuccessCount(), result.FailCount())
+	}
+	summaryPath := c.GetStringFlagValue("summary-output")
+	if summaryPath == "" || result == nil {
+		return nil
+	}
+	summary := formats.NewCommandSummary(operation, serverDetails, startedOn, result.SuccessCount(), result.FailCount(), commandErr)
+	summary.Verification = verification
+	if operation == uploadOperation || operation == downloadOperation {
+		if err := summary.AddTransferredFiles(result, operation == downloadOperation); err != nil {
+			return err
+		}
+	}
+	return formats.WriteCommandSummary(summaryPath, summary)
+}
+
+func prepareCopyMoveCommand(c *components.Context) (*spec.SpecFiles, error) {
+	if c.GetNumberOfArgs() > 0 && c.IsFlagSet("spec") {
+		return nil, common.PrintHelpAndReturnError("No arguments should be sent when the spec option is used.", c)
+	}
+	if c.GetNumberOfArgs() != 2 && (c.GetNumberOfArgs() != 0 || !c.IsFlagSet("spec")) {
+		return nil, common.WrongNumberOfArgumentsHandler(c)
+	}
+
+	var copyMoveSpec *spec.SpecFiles
+	var err error
+	if c.IsFlagSet("spec") {
+		copyMoveSpec, err = specv2.GetSpec(c, false, true)
+	} else {
+		copyMoveSpec, err = createDefaultCopyMoveSpec(c)
+	}
+	if err != nil {
+		return nil, err
+	}
+	err = spec.ValidateSpec(copyMoveSpec.Files, true, true)
+	if err != nil {
+		return nil, err
+	}
+	return copyMoveSpec, nil
+}
+
+func moveCmd(c *components.Context) error {
 	moveSpec, err := prepareCopyMoveCommand(c)
 	if err != nil {
 		return err
 	}
 	moveCmd := generic.NewMoveCommand()
-	rtDetails, err := common.CreateArtifactoryDetailsByFlags(c)
+	rtDetails, err := credentials.CreateArtifactoryDetailsByFlags(c)
 	if err != nil {
 		return err
 	}
@@ -991,10 +2306,14 @@ func moveCmd(c *components.Context) error {
 	if err != nil {
 		return err
 	}
-	moveCmd.SetThreads(threads).SetDryRun(c.GetBoolFlagValue("dry-run")).SetServerDetails(rtDetails).SetSpec(moveSpec).SetRetries(retries).SetRetryWaitMilliSecs(retryWaitTime)
+	moveCmd.SetThreads(threads).SetOptions(getCopyMoveOptions(c)).SetDryRun(c.GetBoolFlagValue("dry-run")).SetServerDetails(rtDetails).SetSpec(moveSpec).SetRetries(retries).SetRetryWaitMilliSecs(retryWaitTime)
+	startedOn := time.Now()
 	err = commands.Exec(moveCmd)
 	result := moveCmd.Result()
-	return printBriefSummaryAndGetError(result.SuccessCount(), result.FailCount(), common.IsFailNoOp(c), err)
+	if summaryErr := writeCommandSummary(c, moveOperation, rtDetails, startedOn, result, nil, err); summaryErr != nil && err == nil {
+		err = summaryErr
+	}
+	return printBriefSummaryAndGetError(result.SuccessCount(), result.FailCount(), clierrors.IsFailNoOp(c), err)
 }
 
 func copyCmd(c *components.Context) error {
@@ -1004,7 +2323,7 @@ func copyCmd(c *components.Context) error {
 	}
 
 	copyCommand := generic.NewCopyCommand()
-	rtDetails, err := common.CreateArtifactoryDetailsByFlags(c)
+	rtDetails, err := credentials.CreateArtifactoryDetailsByFlags(c)
 	if err != nil {
 		return err
 	}
@@ -1020,19 +2339,37 @@ func copyCmd(c *components.Context) error {
 	if err != nil {
 		return err
 	}
-	copyCommand.SetThreads(threads).SetSpec(copySpec).SetDryRun(c.GetBoolFlagValue("dry-run")).SetServerDetails(rtDetails).SetRetries(retries).SetRetryWaitMilliSecs(retryWaitTime)
+	copyCommand.SetThreads(threads).SetOptions(getCopyMoveOptions(c)).SetSpec(copySpec).SetDryRun(c.GetBoolFlagValue("dry-run")).SetServerDetails(rtDetails).SetRetries(retries).SetRetryWaitMilliSecs(retryWaitTime)
+	startedOn := time.Now()
 	err = commands.Exec(copyCommand)
 	result := copyCommand.Result()
-	return printBriefSummaryAndGetError(result.SuccessCount(), result.FailCount(), common.IsFailNoOp(c), err)
+	if summaryErr := writeCommandSummary(c, copyOperation, rtDetails, startedOn, result, nil, err); summaryErr != nil && err == nil {
+		err = summaryErr
+	}
+	return printBriefSummaryAndGetError(result.SuccessCount(), result.FailCount(), clierrors.IsFailNoOp(c), err)
+}
+
+func getCopyMoveOptions(c *components.Context) generic.CopyMoveOptions {
+	return generic.CopyMoveOptions{
+		OnConflict:    c.GetStringFlagValue("on-conflict"),
+		MergeProps:    c.GetBoolFlagValue("merge-props"),
+		PreserveStats: c.GetBoolFlagValue("preserve-stats"),
+	}
 }
 
 // Prints a 'brief' (not detailed) summary and returns the appropriate exit error.
 func printBriefSummaryAndGetError(succeeded, failed int, failNoOp bool, originalErr error) error {
 	err := common.PrintBriefSummaryReport(succeeded, failed, failNoOp, originalErr)
-	return common.GetCliError(err, succeeded, failed, failNoOp)
+	return clierrors.GetCliError(err, succeeded, failed, failNoOp)
 }
 
 func prepareDeleteCommand(c *components.Context) (*spec.SpecFiles, error) {
+	if c.IsFlagSet("aql-file") {
+		if c.GetNumberOfArgs() > 0 || c.IsFlagSet("spec") {
+			return nil, common.PrintHelpAndReturnError("No arguments or spec should be sent when the aql-file option is used.", c)
+		}
+		return new(spec.SpecFiles), nil
+	}
 	if c.GetNumberOfArgs() > 0 && c.IsFlagSet("spec") {
 		return nil, common.PrintHelpAndReturnError("No arguments should be sent when the spec option is used.", c)
 	}
@@ -1043,7 +2380,7 @@ func prepareDeleteCommand(c *components.Context) (*spec.SpecFiles, error) {
 	var deleteSpec *spec.SpecFiles
 	var err error
 	if c.IsFlagSet("spec") {
-		deleteSpec, err = commonCliUtils.GetSpec(c, false, true)
+		deleteSpec, err = specv2.GetSpec(c, false, true)
 	} else {
 		deleteSpec, err = createDefaultDeleteSpec(c)
 	}
@@ -1064,7 +2401,7 @@ func deleteCmd(c *components.Context) error {
 	}
 
 	deleteCommand := generic.NewDeleteCommand()
-	rtDetails, err := common.CreateArtifactoryDetailsByFlags(c)
+	rtDetails, err := credentials.CreateArtifactoryDetailsByFlags(c)
 	if err != nil {
 		return err
 	}
@@ -1082,12 +2419,27 @@ func deleteCmd(c *components.Context) error {
 		return err
 	}
 	deleteCommand.SetThreads(threads).SetQuiet(common.GetQuietValue(c)).SetDryRun(c.GetBoolFlagValue("dry-run")).SetServerDetails(rtDetails).SetSpec(deleteSpec).SetRetries(retries).SetRetryWaitMilliSecs(retryWaitTime)
+	rawAql, err := getRawAql(c)
+	if err != nil {
+		return err
+	}
+	deleteCommand.SetRawAql(rawAql)
+	startedOn := time.Now()
 	err = commands.Exec(deleteCommand)
 	result := deleteCommand.Result()
-	return printBriefSummaryAndGetError(result.SuccessCount(), result.FailCount(), common.IsFailNoOp(c), err)
+	if summaryErr := writeCommandSummary(c, deleteOperation, rtDetails, startedOn, result, nil, err); summaryErr != nil && err == nil {
+		err = summaryErr
+	}
+	return printBriefSummaryAndGetError(result.SuccessCount(), result.FailCount(), clierrors.IsFailNoOp(c), err)
 }
 
 func prepareSearchCommand(c *components.Context) (*spec.SpecFiles, error) {
+	if c.IsFlagSet("aql-file") {
+		if c.GetNumberOfArgs() > 0 || c.IsFlagSet("spec") {
+			return nil, common.PrintHelpAndReturnError("No arguments or spec should be sent when the aql-file option is used.", c)
+		}
+		return new(spec.SpecFiles), nil
+	}
 	if c.GetNumberOfArgs() > 0 && c.IsFlagSet("spec") {
 		return nil, common.PrintHelpAndReturnError("No arguments should be sent when the spec option is used.", c)
 	}
@@ -1098,7 +2450,7 @@ func prepareSearchCommand(c *components.Context) (*spec.SpecFiles, error) {
 	var searchSpec *spec.SpecFiles
 	var err error
 	if c.IsFlagSet("spec") {
-		searchSpec, err = commonCliUtils.GetSpec(c, false, true)
+		searchSpec, err = specv2.GetSpec(c, false, true)
 	} else {
 		searchSpec, err = createDefaultSearchSpec(c)
 	}
@@ -1112,12 +2464,206 @@ func prepareSearchCommand(c *components.Context) (*spec.SpecFiles, error) {
 	return searchSpec, err
 }
 
+func verifyDownloadCmd(c *components.Context) error {
+	if c.GetNumberOfArgs() != 2 {
+		return common.WrongNumberOfArgumentsHandler(c)
+	}
+	artDetails, err := credentials.CreateArtifactoryDetailsByFlags(c)
+	if err != nil {
+		return err
+	}
+	verifyDownloadCmd := generic.NewVerifyDownloadCommand().SetServerDetails(artDetails).SetLocalPath(c.GetArgumentAt(0)).SetRepoPath(c.GetArgumentAt(1))
+	return commands.Exec(verifyDownloadCmd)
+}
+
+func syncCmd(c *components.Context) error {
+	if c.GetNumberOfArgs() != 2 {
+		return common.WrongNumberOfArgumentsHandler(c)
+	}
+	artDetails, err := credentials.CreateArtifactoryDetailsByFlags(c)
+	if err != nil {
+		return err
+	}
+	threads, err := common.GetThreadsCount(c)
+	if err != nil {
+		return err
+	}
+	syncCmd := generic.NewSyncCommand().SetServerDetails(artDetails).SetLocalPath(c.GetArgumentAt(0)).SetRepoPath(c.GetArgumentAt(1)).
+		SetDeleteMissing(c.GetBoolFlagValue("delete-missing")).SetDryRun(c.GetBoolFlagValue("dry-run")).SetThreads(threads)
+	if c.IsFlagSet("direction") {
+		syncCmd.SetDirection(c.GetStringFlagValue("direction"))
+	}
+	return commands.Exec(syncCmd)
+}
+
+func whenceCmd(c *components.Context) error {
+	if c.GetNumberOfArgs() != 1 {
+		return common.WrongNumberOfArgumentsHandler(c)
+	}
+	artDetails, err := credentials.CreateArtifactoryDetailsByFlags(c)
+	if err != nil {
+		return err
+	}
+	whenceCmd := provenance.NewWhenceCommand().SetServerDetails(artDetails).SetQuery(c.GetArgumentAt(0)).SetFormat(c.GetStringFlagValue("format"))
+	return commands.Exec(whenceCmd)
+}
+
+func evidenceCreateCmd(c *components.Context) error {
+	if c.GetNumberOfArgs() != 1 {
+		return common.WrongNumberOfArgumentsHandler(c)
+	}
+	subjectPackage, err := subject.NewPackage(c.GetStringFlagValue("package-type"), c.GetStringFlagValue("package-repo"),
+		c.GetStringFlagValue("package-name"), c.GetStringFlagValue("package-version"))
+	if err != nil {
+		return err
+	}
+	artDetails, err := credentials.CreateArtifactoryDetailsByFlags(c)
+	if err != nil {
+		return err
+	}
+	createCmd := evidence.NewCreateCommand().SetServerDetails(artDetails).SetPredicatePath(c.GetArgumentAt(0)).
+		SetPredicateType(c.GetStringFlagValue("predicate-type")).SetSubjectRepoPath(c.GetStringFlagValue("subject-repo-path")).
+		SetSubjectPackage(subjectPackage).SetKeyPath(c.GetStringFlagValue("key")).SetKeyAlias(c.GetStringFlagValue("key-alias"))
+	return commands.Exec(createCmd)
+}
+
+func evidenceCreateBulkCmd(c *components.Context) error {
+	if c.GetNumberOfArgs() != 1 {
+		return common.WrongNumberOfArgumentsHandler(c)
+	}
+	artDetails, err := credentials.CreateArtifactoryDetailsByFlags(c)
+	if err != nil {
+		return err
+	}
+	threads, err := common.GetThreadsCount(c)
+	if err != nil {
+		return err
+	}
+	createBulkCmd := evidence.NewCreateBulkCommand().SetServerDetails(artDetails).SetManifestPath(c.GetArgumentAt(0)).
+		SetKeyPath(c.GetStringFlagValue("key")).SetKeyAlias(c.GetStringFlagValue("key-alias")).SetThreads(threads).
+		SetFormat(c.GetStringFlagValue("format"))
+	return commands.Exec(createBulkCmd)
+}
+
+func evidenceExportCmd(c *components.Context) error {
+	if c.GetNumberOfArgs() != 1 {
+		return common.WrongNumberOfArgumentsHandler(c)
+	}
+	artDetails, err := credentials.CreateArtifactoryDetailsByFlags(c)
+	if err != nil {
+		return err
+	}
+	exportCmd := evidence.NewExportCommand().SetServerDetails(artDetails).SetSubjectRepoPath(c.GetArgumentAt(0)).SetBundlePath(c.GetStringFlagValue("bundle"))
+	return commands.Exec(exportCmd)
+}
+
+func evidenceVerifyCmd(c *components.Context) error {
+	if c.GetNumberOfArgs() != 0 {
+		return common.WrongNumberOfArgumentsHandler(c)
+	}
+	if !c.IsFlagSet("bundle") {
+		return errorutils.CheckErrorf("the --bundle option is mandatory")
+	}
+	var publicKeyPaths []string
+	if c.IsFlagSet("public-keys") {
+		publicKeyPaths = strings.Split(c.GetStringFlagValue("public-keys"), ",")
+	}
+	verifyCmd := evidence.NewVerifyBundleCommand().SetBundlePath(c.GetStringFlagValue("bundle")).SetSubjectPath(c.GetStringFlagValue("subject-file")).
+		SetPublicKeyPaths(publicKeyPaths).SetFormat(c.GetStringFlagValue("format"))
+	return commands.Exec(verifyCmd)
+}
+
+func evidenceImportGithubCmd(c *components.Context) error {
+	if c.GetNumberOfArgs() != 1 {
+		return common.WrongNumberOfArgumentsHandler(c)
+	}
+	if c.IsFlagSet("subject-repo-path") && c.IsFlagSet("repo") {
+		return errorutils.CheckErrorf("the --subject-repo-path and --repo options can't be used together")
+	}
+	artDetails, err := credentials.CreateArtifactoryDetailsByFlags(c)
+	if err != nil {
+		return err
+	}
+	importCmd := evidence.NewImportGithubCommand().SetServerDetails(artDetails).SetBundlePath(c.GetArgumentAt(0)).
+		SetSubjectRepoPath(c.GetStringFlagValue("subject-repo-path")).SetRepo(c.GetStringFlagValue("repo")).SetFormat(c.GetStringFlagValue("format"))
+	return commands.Exec(importCmd)
+}
+
+func evidenceExportGithubCmd(c *components.Context) error {
+	if c.GetNumberOfArgs() != 1 {
+		return common.WrongNumberOfArgumentsHandler(c)
+	}
+	artDetails, err := credentials.CreateArtifactoryDetailsByFlags(c)
+	if err != nil {
+		return err
+	}
+	exportCmd := evidence.NewExportGithubCommand().SetServerDetails(artDetails).SetSubjectRepoPath(c.GetArgumentAt(0)).SetOutputPath(c.GetStringFlagValue("output"))
+	return commands.Exec(exportCmd)
+}
+
+func evidenceKeyGenerateCmd(c *components.Context) error {
+	if c.GetNumberOfArgs() != 1 {
+		return common.WrongNumberOfArgumentsHandler(c)
+	}
+	keyGenerateCmd := evidence.NewKeyGenerateCommand().SetPrivateKeyPath(c.GetArgumentAt(0))
+	if c.IsFlagSet("key-type") {
+		keyGenerateCmd.SetKeyType(c.GetStringFlagValue("key-type"))
+	}
+	return commands.Exec(keyGenerateCmd)
+}
+
+func evidenceKeyUploadCmd(c *components.Context) error {
+	if c.GetNumberOfArgs() != 2 {
+		return common.WrongNumberOfArgumentsHandler(c)
+	}
+	artDetails, err := credentials.CreateArtifactoryDetailsByFlags(c)
+	if err != nil {
+		return err
+	}
+	keyUploadCmd := evidence.NewKeyUploadCommand().SetServerDetails(artDetails).SetAlias(c.GetArgumentAt(0)).SetPublicKeyPath(c.GetArgumentAt(1))
+	return commands.Exec(keyUploadCmd)
+}
+
+func evidenceKeyListCmd(c *components.Context) error {
+	if c.GetNumberOfArgs() != 0 {
+		return common.WrongNumberOfArgumentsHandler(c)
+	}
+	artDetails, err := credentials.CreateArtifactoryDetailsByFlags(c)
+	if err != nil {
+		return err
+	}
+	keyListCmd := evidence.NewKeyListCommand().SetServerDetails(artDetails).SetFormat(c.GetStringFlagValue("format"))
+	return commands.Exec(keyListCmd)
+}
+
+func evidenceKeyRotateCmd(c *components.Context) error {
+	if c.GetNumberOfArgs() != 2 {
+		return common.WrongNumberOfArgumentsHandler(c)
+	}
+	artDetails, err := credentials.CreateArtifactoryDetailsByFlags(c)
+	if err != nil {
+		return err
+	}
+	keyRotateCmd := evidence.NewKeyRotateCommand().SetServerDetails(artDetails).SetAlias(c.GetArgumentAt(0)).SetPrivateKeyPath(c.GetArgumentAt(1))
+	if c.IsFlagSet("key-type") {
+		keyRotateCmd.SetKeyType(c.GetStringFlagValue("key-type"))
+	}
+	return commands.Exec(keyRotateCmd)
+}
+
 func searchCmd(c *components.Context) (err error) {
 	searchSpec, err := prepareSearchCommand(c)
 	if err != nil {
 		return
 	}
-	artDetails, err := common.CreateArtifactoryDetailsByFlags(c)
+	outputFormat, err := formats.ParseFormat(c.GetStringFlagValue("format"))
+	if err != nil {
+		return
+	}
+	if outputFormat != "" && (c.GetBoolFlagValue("jsonl") || c.GetBoolFlagValue("count-only")) {
+		return errorutils.CheckErrorf("the --format option can't be used with the --jsonl and --count-only options")
+	}
+	artDetails, err := credentials.CreateArtifactoryDetailsByFlags(c)
 	if err != nil {
 		return
 	}
@@ -1130,42 +2676,77 @@ func searchCmd(c *components.Context) (err error) {
 		return
 	}
 	searchCmd := generic.NewSearchCommand()
-	searchCmd.SetServerDetails(artDetails).SetSpec(searchSpec).SetRetries(retries).SetRetryWaitMilliSecs(retryWaitTime)
+	searchCmd.SetServerDetails(artDetails).SetSpec(searchSpec).SetRetries(retries).SetRetryWaitMilliSecs(retryWaitTime).SetProjectKey(c.GetStringFlagValue("project"))
+	rawAql, err := getRawAql(c)
+	if err != nil {
+		return
+	}
+	searchCmd.SetRawAql(rawAql)
+	if c.GetBoolFlagValue("jsonl") {
+		searchCmd.SetFormat(generic.SearchFormatJsonl)
+	}
+	searchCmd.SetCountOnly(c.GetBoolFlagValue("count-only"))
 	err = commands.Exec(searchCmd)
 	if err != nil {
 		return
 	}
+	if searchCmd.IsStreaming() {
+		if err = clierrors.GetCliError(nil, searchCmd.Count(), 0, clierrors.IsFailNoOp(c)); err != nil {
+			return err
+		}
+		if c.GetBoolFlagValue("count-only") {
+			log.Output(searchCmd.Count())
+		}
+		return nil
+	}
 	reader := searchCmd.Result().Reader()
 	defer ioutils.Close(reader, &err)
 	length, err := reader.Length()
 	if err != nil {
 		return err
 	}
-	err = common.GetCliError(err, length, 0, common.IsFailNoOp(c))
+	err = clierrors.GetCliError(err, length, 0, clierrors.IsFailNoOp(c))
 	if err != nil {
 		return err
 	}
 	if !c.GetBoolFlagValue("count") {
+		if outputFormat != "" {
+			return printSearchResults(searchCmd.Result(), outputFormat)
+		}
 		return utils.PrintSearchResults(reader)
 	}
 	log.Output(length)
 	return nil
 }
 
+func printSearchResults(result *commandUtils.Result, outputFormat formats.Format) error {
+	searchResults, err := formats.ReadSearchResults(result)
+	if err != nil {
+		return err
+	}
+	return formats.Print(outputFormat, formats.SearchResultsKind, searchResults)
+}
+
 func preparePropsCmd(c *components.Context) (*generic.PropsCommand, error) {
+	if c.IsFlagSet("aql-file") && (c.GetNumberOfArgs() != 1 || c.IsFlagSet("spec")) {
+		return nil, common.PrintHelpAndReturnError("Only the 'artifact properties' argument should be sent when the aql-file option is used.", c)
+	}
 	if c.GetNumberOfArgs() > 1 && c.IsFlagSet("spec") {
 		return nil, common.PrintHelpAndReturnError("Only the 'artifact properties' argument should be sent when the spec option is used.", c)
 	}
-	if c.GetNumberOfArgs() != 2 && (c.GetNumberOfArgs() != 1 || (!c.IsFlagSet("spec") && !c.IsFlagSet("build") && !c.IsFlagSet("bundle"))) {
+	if c.GetNumberOfArgs() != 2 && (c.GetNumberOfArgs() != 1 || (!c.IsFlagSet("spec") && !c.IsFlagSet("build") && !c.IsFlagSet("bundle") && !c.IsFlagSet("aql-file"))) {
 		return nil, common.WrongNumberOfArgumentsHandler(c)
 	}
 
 	var propsSpec *spec.SpecFiles
 	var err error
 	var props string
-	if c.IsFlagSet("spec") {
+	if c.IsFlagSet("aql-file") {
+		props = c.GetArgumentAt(0)
+		propsSpec = new(spec.SpecFiles)
+	} else if c.IsFlagSet("spec") {
 		props = c.GetArgumentAt(0)
-		propsSpec, err = commonCliUtils.GetSpec(c, false, true)
+		propsSpec, err = specv2.GetSpec(c, false, true)
 	} else {
 		propsSpec, err = createDefaultPropertiesSpec(c)
 		if c.GetNumberOfArgs() == 1 {
@@ -1178,13 +2759,18 @@ func preparePropsCmd(c *components.Context) (*generic.PropsCommand, error) {
 	if err != nil {
 		return nil, err
 	}
-	err = spec.ValidateSpec(propsSpec.Files, false, true)
+	if !c.IsFlagSet("aql-file") {
+		if err = spec.ValidateSpec(propsSpec.Files, false, true); err != nil {
+			return nil, err
+		}
+	}
+	rawAql, err := getRawAql(c)
 	if err != nil {
 		return nil, err
 	}
 
 	command := generic.NewPropsCommand()
-	rtDetails, err := common.CreateArtifactoryDetailsByFlags(c)
+	rtDetails, err := credentials.CreateArtifactoryDetailsByFlags(c)
 	if err != nil {
 		return nil, err
 	}
@@ -1194,10 +2780,23 @@ func preparePropsCmd(c *components.Context) (*generic.PropsCommand, error) {
 	}
 
 	cmd := command.SetProps(props)
-	cmd.SetThreads(threads).SetSpec(propsSpec).SetDryRun(c.GetBoolFlagValue("dry-run")).SetServerDetails(rtDetails)
+	cmd.SetThreads(threads).SetSpec(propsSpec).SetDryRun(c.GetBoolFlagValue("dry-run")).SetServerDetails(rtDetails).SetRawAql(rawAql)
 	return cmd, nil
 }
 
+// Prints the per-item outcome of a properties command as JSON, if a detailed summary was requested.
+func printPropsSummary(c *components.Context, summary *generic.PropsBatchSummary) error {
+	if summary == nil || !c.GetBoolFlagValue("detailed-summary") {
+		return nil
+	}
+	content, err := json.Marshal(summary)
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	log.Output(clientutils.IndentJson(content))
+	return nil
+}
+
 func setPropsCmd(c *components.Context) error {
 	cmd, err := preparePropsCmd(c)
 	if err != nil {
@@ -1214,8 +2813,11 @@ func setPropsCmd(c *components.Context) error {
 	propsCmd := generic.NewSetPropsCommand().SetPropsCommand(*cmd).SetRepoOnly(c.GetBoolFlagValue("repo-only"))
 	propsCmd.SetRetries(retries).SetRetryWaitMilliSecs(retryWaitTime)
 	err = commands.Exec(propsCmd)
+	if summaryErr := printPropsSummary(c, propsCmd.PropsSummary()); err == nil {
+		err = summaryErr
+	}
 	result := propsCmd.Result()
-	return printBriefSummaryAndGetError(result.SuccessCount(), result.FailCount(), common.IsFailNoOp(c), err)
+	return printBriefSummaryAndGetError(result.SuccessCount(), result.FailCount(), clierrors.IsFailNoOp(c), err)
 }
 
 func deletePropsCmd(c *components.Context) error {
@@ -1234,361 +2836,1369 @@ func deletePropsCmd(c *components.Context) error {
 	propsCmd := generic.NewDeletePropsCommand().DeletePropsCommand(*cmd).SetRepoOnly(c.GetBoolFlagValue("repo-only"))
 	propsCmd.SetRetries(retries).SetRetryWaitMilliSecs(retryWaitTime)
 	err = commands.Exec(propsCmd)
+	if summaryErr := printPropsSummary(c, propsCmd.PropsSummary()); err == nil {
+		err = summaryErr
+	}
 	result := propsCmd.Result()
-	return printBriefSummaryAndGetError(result.SuccessCount(), result.FailCount(), common.IsFailNoOp(c), err)
+	return printBriefSummaryAndGetError(result.SuccessCount(), result.FailCount(), clierrors.IsFailNoOp(c), err)
 }
 
-func buildPublishCmd(c *components.Context) error {
-	if c.GetNumberOfArgs() > 2 {
+func immutableCmd(c *components.Context) error {
+	return protectCmd(c, generic.NewImmutableCommand())
+}
+
+func legalHoldCmd(c *components.Context) error {
+	return protectCmd(c, generic.NewLegalHoldCommand(c.GetStringFlagValue("case")))
+}
+
+// Sets or removes the protection of the files, which are selected as the properties commands select them.
+func protectCmd(c *components.Context, protectCommand *generic.ProtectCommand) error {
+	if c.GetNumberOfArgs() > 1 || (c.GetNumberOfArgs() == 0 && !c.IsFlagSet("spec") && !c.IsFlagSet("build") && !c.IsFlagSet("bundle") && !c.IsFlagSet("aql-file")) {
 		return common.WrongNumberOfArgumentsHandler(c)
 	}
-	buildConfiguration := common.CreateBuildConfiguration(c)
-	if err := buildConfiguration.ValidateBuildParams(); err != nil {
-		return err
+	if c.GetNumberOfArgs() == 1 && (c.IsFlagSet("spec") || c.IsFlagSet("aql-file")) {
+		return common.PrintHelpAndReturnError("No arguments should be sent when the spec or aql-file option is used.", c)
+	}
+	var protectSpec *spec.SpecFiles
+	var err error
+	switch {
+	case c.IsFlagSet("aql-file"):
+		protectSpec = new(spec.SpecFiles)
+	case c.IsFlagSet("spec"):
+		protectSpec, err = specv2.GetSpec(c, false, true)
+	default:
+		protectSpec, err = createDefaultPropertiesSpec(c)
+		if err == nil && c.GetNumberOfArgs() == 0 {
+			protectSpec.Get(0).Pattern = "*"
+		}
 	}
-	buildInfoConfiguration := createBuildInfoConfiguration(c)
-	rtDetails, err := common.CreateArtifactoryDetailsByFlags(c)
 	if err != nil {
 		return err
 	}
-	buildPublishCmd := buildinfo.NewBuildPublishCommand().SetServerDetails(rtDetails).SetBuildConfiguration(buildConfiguration).SetConfig(buildInfoConfiguration).SetDetailedSummary(common.GetDetailedSummary(c))
-	buildPublishCmd.SetCollectEnv(c.GetBoolFlagValue("collect-env"))
-	buildPublishCmd.SetCollectGitInfo(c.GetBoolFlagValue("collect-git-info"))
-	buildPublishCmd.SetDotGitPath(c.GetStringFlagValue("dot-git-path"))
-	buildPublishCmd.SetConfigFilePath(c.GetStringFlagValue("git-config-file-path"))
-
-	err = commands.Exec(buildPublishCmd)
-	if buildPublishCmd.IsDetailedSummary() {
-		if publishedSummary := buildPublishCmd.GetSummary(); publishedSummary != nil {
-			return summary.PrintBuildInfoSummaryReport(publishedSummary.IsSucceeded(), publishedSummary.GetSha256(), err)
+	if !c.IsFlagSet("aql-file") {
+		if err = spec.ValidateSpec(protectSpec.Files, false, true); err != nil {
+			return err
 		}
 	}
-	return err
+	rawAql, err := getRawAql(c)
+	if err != nil {
+		return err
+	}
+	rtDetails, err := credentials.CreateArtifactoryDetailsByFlags(c)
+	if err != nil {
+		return err
+	}
+	threads, err := common.GetThreadsCount(c)
+	if err != nil {
+		return err
+	}
+	retries, err := getRetries(c)
+	if err != nil {
+		return err
+	}
+	retryWaitTime, err := getRetryWaitTime(c)
+	if err != nil {
+		return err
+	}
+	propsCommand := generic.NewPropsCommand().SetThreads(threads)
+	propsCommand.SetSpec(protectSpec).SetDryRun(c.GetBoolFlagValue("dry-run")).SetServerDetails(rtDetails).SetRawAql(rawAql).
+		SetRetries(retries).SetRetryWaitMilliSecs(retryWaitTime)
+	protectCommand.SetPropsCommand(*propsCommand).SetRelease(c.GetBoolFlagValue("release"))
+	err = commands.Exec(protectCommand)
+	if summaryErr := printPropsSummary(c, protectCommand.PropsSummary()); err == nil {
+		err = summaryErr
+	}
+	result := protectCommand.Result()
+	return printBriefSummaryAndGetError(result.SuccessCount(), result.FailCount(), clierrors.IsFailNoOp(c), err)
+}
+
+// Creates the build configuration of the build commands. In a supported CI system, the build name and number of the CI job
+// are used if not provided.
+func createBuildConfiguration(c *components.Context) *build.BuildConfiguration {
+	buildConfiguration := common.CreateBuildConfiguration(c)
+	cienv.ApplyToBuildConfiguration(buildConfiguration)
+	return buildConfiguration
+}
+
+func buildPublishCmd(c *components.Context) error {
+	if c.GetNumberOfArgs() > 2 {
+		return common.WrongNumberOfArgumentsHandler(c)
+	}
+	buildConfiguration := createBuildConfiguration(c)
+	if err := buildConfiguration.ValidateBuildParams(); err != nil {
+		return err
+	}
+	buildInfoConfiguration := createBuildInfoConfiguration(c)
+	rtDetails, err := credentials.CreateArtifactoryDetailsByFlags(c)
+	if err != nil {
+		return err
+	}
+	outputFormat, err := formats.ParseFormat(c.GetStringFlagValue("format"))
+	if err != nil {
+		return err
+	}
+	buildPublishCmd := buildinfo.NewBuildPublishCommand().SetServerDetails(rtDetails).SetBuildConfiguration(buildConfiguration).SetConfig(buildInfoConfiguration).SetDetailedSummary(common.GetDetailedSummary(c))
+	buildPublishCmd.SetOutputFormat(outputFormat)
+	buildPublishCmd.SetCollectEnv(c.GetBoolFlagValue("collect-env"))
+	buildPublishCmd.SetCollectGitInfo(c.GetBoolFlagValue("collect-git-info"))
+	buildPublishCmd.SetDotGitPath(c.GetStringFlagValue("dot-git-path"))
+	buildPublishCmd.SetConfigFilePath(c.GetStringFlagValue("git-config-file-path"))
+	if c.IsFlagSet("max-builds") || c.IsFlagSet("max-days") {
+		retention := createBuildDiscardConfiguration(c)
+		buildPublishCmd.SetRetention(&retention)
+	}
+
+	startedOn := time.Now()
+	err = runWithBuildState(c, buildConfiguration, !buildInfoConfiguration.DryRun, func() error {
+		return commands.Exec(buildPublishCmd)
+	})
+	if summaryErr := writeBuildPublishSummary(c, buildPublishCmd, startedOn, err); summaryErr != nil && err == nil {
+		err = summaryErr
+	}
+	if buildPublishCmd.IsDetailedSummary() && outputFormat == "" {
+		if publishedSummary := buildPublishCmd.GetSummary(); publishedSummary != nil {
+			return summary.PrintBuildInfoSummaryReport(publishedSummary.IsSucceeded(), publishedSummary.GetSha256(), err)
+		}
+	}
+	return err
+}
+
+// writeBuildPublishSummary writes the summary of the build-info publishing to the file of the --summary-output option,
+// if it's set.
+func writeBuildPublishSummary(c *components.Context, buildPublishCmd *buildinfo.BuildPublishCommand, startedOn time.Time, publishErr error) error {
+	summaryPath := c.GetStringFlagValue("summary-output")
+	if summaryPath == "" {
+		return nil
+	}
+	serverDetails, err := buildPublishCmd.ServerDetails()
+	if err != nil {
+		return err
+	}
+	succeeded, failed := 0, 0
+	publishedSummary := buildPublishCmd.GetSummary()
+	if publishErr == nil && (publishedSummary == nil || publishedSummary.IsSucceeded()) {
+		succeeded = 1
+	} else {
+		failed = 1
+	}
+	summary := formats.NewCommandSummary(buildPublishOperation, serverDetails, startedOn, succeeded, failed, publishErr)
+	if publishedSummary != nil && publishedSummary.GetSha256() != "" {
+		summary.Sha256s = []string{publishedSummary.GetSha256()}
+	}
+	return formats.WriteCommandSummary(summaryPath, summary)
+}
+
+func buildAppendCmd(c *components.Context) error {
+	if c.GetNumberOfArgs() != 4 && (!c.IsFlagSet("children") || c.GetNumberOfArgs() != 2) {
+		return common.WrongNumberOfArgumentsHandler(c)
+	}
+	buildConfiguration := createBuildConfiguration(c)
+	if err := buildConfiguration.ValidateBuildParams(); err != nil {
+		return err
+	}
+	rtDetails, err := credentials.CreateArtifactoryDetailsByFlags(c)
+	if err != nil {
+		return err
+	}
+	buildAppendCmd := buildinfo.NewBuildAppendCommand().SetServerDetails(rtDetails).SetBuildConfiguration(buildConfiguration)
+	if c.GetNumberOfArgs() == 4 {
+		buildAppendCmd.SetBuildNameToAppend(c.GetArgumentAt(2)).SetBuildNumberToAppend(c.GetArgumentAt(3))
+	}
+	if c.IsFlagSet("children") {
+		children, err := buildinfo.ParseChildBuilds(c.GetStringFlagValue("children"))
+		if err != nil {
+			return err
+		}
+		buildAppendCmd.SetChildBuilds(children)
+	}
+	return commands.Exec(buildAppendCmd)
+}
+
+func buildAddDependenciesCmd(c *components.Context) error {
+	if c.GetNumberOfArgs() > 2 && c.IsFlagSet("spec") {
+		return common.PrintHelpAndReturnError("Only path or spec is allowed, not both.", c)
+	}
+	if c.IsFlagSet("regexp") && c.IsFlagSet("from-rt") {
+		return common.PrintHelpAndReturnError("The --regexp option is not supported when --from-rt is set to true.", c)
+	}
+	buildConfiguration := createBuildConfiguration(c)
+	if err := buildConfiguration.ValidateBuildParams(); err != nil {
+		return err
+	}
+	if c.IsFlagSet("lockfile") {
+		return buildAddLockfileDependencies(c, buildConfiguration)
+	}
+	// Odd number of args - Use pattern arg
+	// Even number of args - Use spec flag
+	if c.GetNumberOfArgs() > 3 || (c.GetNumberOfArgs()%2 != 1 && (c.GetNumberOfArgs()%2 != 0 || !c.IsFlagSet("spec"))) {
+		return common.WrongNumberOfArgumentsHandler(c)
+	}
+
+	var dependenciesSpec *spec.SpecFiles
+	var rtDetails *config.ServerDetails
+	var err error
+	if c.IsFlagSet("spec") {
+		dependenciesSpec, err = specv2.GetSpec(c, true, true)
+		if err != nil {
+			return err
+		}
+	} else {
+		dependenciesSpec = createDefaultBuildAddDependenciesSpec(c)
+	}
+	if c.GetBoolFlagValue("from-rt") {
+		rtDetails, err = credentials.CreateArtifactoryDetailsByFlags(c)
+		if err != nil {
+			return err
+		}
+	} else {
+		common.FixWinPathsForFileSystemSourcedCmds(dependenciesSpec, c)
+	}
+	buildAddDependenciesCmd := buildinfo.NewBuildAddDependenciesCommand().SetDryRun(c.GetBoolFlagValue("dry-run")).SetBuildConfiguration(buildConfiguration).SetDependenciesSpec(dependenciesSpec).SetServerDetails(rtDetails)
+	err = runWithBuildState(c, buildConfiguration, false, func() error {
+		return commands.Exec(buildAddDependenciesCmd)
+	})
+	result := buildAddDependenciesCmd.Result()
+	return printBriefSummaryAndGetError(result.SuccessCount(), result.FailCount(), clierrors.IsFailNoOp(c), err)
+}
+
+func buildAddLockfileDependencies(c *components.Context, buildConfiguration *build.BuildConfiguration) error {
+	if c.GetNumberOfArgs() > 2 || c.IsFlagSet("spec") {
+		return common.PrintHelpAndReturnError("The --lockfile option can't be used with a pattern or a spec.", c)
+	}
+	rtDetails, err := credentials.CreateArtifactoryDetailsByFlags(c)
+	if err != nil {
+		return err
+	}
+	var repos []string
+	if c.IsFlagSet("lockfile-repos") {
+		repos = strings.Split(c.GetStringFlagValue("lockfile-repos"), ",")
+	}
+	buildAddDependenciesCmd := buildinfo.NewBuildAddDependenciesCommand().SetDryRun(c.GetBoolFlagValue("dry-run")).SetBuildConfiguration(buildConfiguration).
+		SetServerDetails(rtDetails).SetLockfile(c.GetStringFlagValue("lockfile")).SetLockfileRepos(repos)
+	err = runWithBuildState(c, buildConfiguration, false, func() error {
+		return commands.Exec(buildAddDependenciesCmd)
+	})
+	result := buildAddDependenciesCmd.Result()
+	return printBriefSummaryAndGetError(result.SuccessCount(), result.FailCount(), clierrors.IsFailNoOp(c), err)
+}
+
+func buildCollectEnvCmd(c *components.Context) error {
+	if c.GetNumberOfArgs() > 2 {
+		return common.WrongNumberOfArgumentsHandler(c)
+	}
+	buildConfiguration := createBuildConfiguration(c)
+	if err := buildConfiguration.ValidateBuildParams(); err != nil {
+		return err
+	}
+	envFilter, err := buildinfo.NewEnvFilter(strings.Split(c.GetStringFlagValue("env-include-regex"), ";"),
+		strings.Split(c.GetStringFlagValue("env-exclude-regex"), ";"), c.GetBoolTFlagValue("mask-secrets"))
+	if err != nil {
+		return err
+	}
+	buildCollectEnvCmd := buildinfo.NewBuildCollectEnvCommand().SetBuildConfiguration(buildConfiguration).SetEnvFilter(envFilter)
+
+	return runWithBuildState(c, buildConfiguration, false, func() error {
+		return commands.Exec(buildCollectEnvCmd)
+	})
+}
+
+// Runs a build command with the build-info state shared through the backend set by the --build-state option or the
+// JFROG_CLI_BUILD_STATE environment variable. The state is pulled before the command runs, and then either pushed,
+// or removed if the build is published.
+func runWithBuildState(c *components.Context, buildConfiguration *build.BuildConfiguration, removeAfterRun bool, run func() error) error {
+	stateUrl := c.GetStringFlagValue("build-state")
+	if stateUrl == "" {
+		stateUrl = os.Getenv(buildstate.BuildStateEnv)
+	}
+	if stateUrl == "" {
+		return run()
+	}
+	var serverDetails *config.ServerDetails
+	var err error
+	if strings.HasPrefix(stateUrl, "artifactory://") {
+		if serverDetails, err = credentials.CreateArtifactoryDetailsByFlags(c); err != nil {
+			return err
+		}
+	}
+	backend, err := buildstate.NewBackend(stateUrl, serverDetails)
+	if err != nil {
+		return err
+	}
+	buildName, err := buildConfiguration.GetBuildName()
+	if err != nil {
+		return err
+	}
+	buildNumber, err := buildConfiguration.GetBuildNumber()
+	if err != nil {
+		return err
+	}
+	if err = buildstate.Pull(backend, buildName, buildNumber, buildConfiguration.GetProject()); err != nil {
+		return err
+	}
+	if err = run(); err != nil {
+		return err
+	}
+	if removeAfterRun {
+		return buildstate.Remove(backend, buildName, buildNumber, buildConfiguration.GetProject())
+	}
+	return buildstate.Push(backend, buildName, buildNumber, buildConfiguration.GetProject())
+}
+
+func buildAddGitCmd(c *components.Context) error {
+	if c.GetNumberOfArgs() > 3 {
+		return common.WrongNumberOfArgumentsHandler(c)
+	}
+	buildConfiguration := createBuildConfiguration(c)
+	if err := buildConfiguration.ValidateBuildParams(); err != nil {
+		return err
+	}
+
+	buildAddGitConfigurationCmd := buildinfo.NewBuildAddGitCommand().SetBuildConfiguration(buildConfiguration).SetConfigFilePath(c.GetStringFlagValue("config")).SetServerId(c.GetStringFlagValue("server-id"))
+	buildAddGitConfigurationCmd.SetFromRef(c.GetStringFlagValue("from-ref")).SetFromTagPattern(c.GetStringFlagValue("from-tag-pattern"))
+	buildAddGitConfigurationCmd.SetChangelogProperty(c.GetStringFlagValue("changelog-property")).SetChangelogTarget(c.GetStringFlagValue("changelog-target"))
+	if c.GetNumberOfArgs() == 3 {
+		buildAddGitConfigurationCmd.SetDotGitPath(c.GetArgumentAt(2))
+	} else if c.GetNumberOfArgs() == 1 {
+		buildAddGitConfigurationCmd.SetDotGitPath(c.GetArgumentAt(0))
+	}
+	return commands.Exec(buildAddGitConfigurationCmd)
+}
+
+func buildAffectedModulesCmd(c *components.Context) error {
+	if c.GetNumberOfArgs() < 1 || c.GetNumberOfArgs() > 2 {
+		return common.WrongNumberOfArgumentsHandler(c)
+	}
+	buildConfiguration := new(build.BuildConfiguration)
+	buildConfiguration.SetBuildName(c.GetArgumentAt(0)).SetProject(c.GetStringFlagValue("project"))
+	rtDetails, err := credentials.CreateArtifactoryDetailsByFlags(c)
+	if err != nil {
+		return err
+	}
+	buildAffectedModulesCmd := buildinfo.NewBuildAffectedModulesCommand().SetBuildConfiguration(buildConfiguration).SetServerDetails(rtDetails).SetManifestPath(c.GetStringFlagValue("manifest"))
+	buildAffectedModulesCmd.SetFromRef(c.GetStringFlagValue("from-ref")).SetFromTagPattern(c.GetStringFlagValue("from-tag-pattern"))
+	if c.GetNumberOfArgs() == 2 {
+		buildAffectedModulesCmd.SetDotGitPath(c.GetArgumentAt(1))
+	}
+	return commands.Exec(buildAffectedModulesCmd)
+}
+
+func buildScanLegacyCmd(c *components.Context) error {
+	if c.GetNumberOfArgs() > 2 {
+		return common.WrongNumberOfArgumentsHandler(c)
+	}
+	buildConfiguration := createBuildConfiguration(c)
+	if err := buildConfiguration.ValidateBuildParams(); err != nil {
+		return err
+	}
+	rtDetails, err := credentials.CreateArtifactoryDetailsByFlags(c)
+	if err != nil {
+		return err
+	}
+	buildScanCmd := buildinfo.NewBuildScanLegacyCommand().SetServerDetails(rtDetails).SetFailBuild(c.GetBoolTFlagValue("fail")).SetBuildConfiguration(buildConfiguration)
+	err = commands.Exec(buildScanCmd)
+
+	return checkBuildScanError(err)
+}
+
+func checkBuildScanError(err error) error {
+	// If the build was found vulnerable, exit with ExitCodeVulnerableBuild.
+	if errors.Is(err, utils.GetBuildScanError()) {
+		return coreutils.CliError{ExitCode: coreutils.ExitCodeVulnerableBuild, ErrorMsg: err.Error()}
+	}
+	// If the scan operation failed, for example due to HTTP timeout, exit with ExitCodeError.
+	if err != nil {
+		return coreutils.CliError{ExitCode: coreutils.ExitCodeError, ErrorMsg: err.Error()}
+	}
+	return nil
+}
+
+func buildScanGateCmd(c *components.Context) error {
+	if c.GetNumberOfArgs() > 2 {
+		return common.WrongNumberOfArgumentsHandler(c)
+	}
+	buildConfiguration := createBuildConfiguration(c)
+	if err := buildConfiguration.ValidateBuildParams(); err != nil {
+		return err
+	}
+	serverDetails, err := credentials.CreateArtifactoryDetailsByFlags(c)
+	if err != nil {
+		return err
+	}
+	buildScanGateCmd := buildinfo.NewBuildScanGateCommand().SetServerDetails(serverDetails).SetBuildConfiguration(buildConfiguration).
+		SetIncludeVulnerabilities(c.GetBoolFlagValue("vuln")).SetRescan(c.GetBoolFlagValue("rescan"))
+	if c.GetStringFlagValue("format") != "" {
+		buildScanGateCmd.SetFormat(c.GetStringFlagValue("format"))
+	}
+	if c.GetStringFlagValue("fail-on-severity") != "" {
+		severity, err := buildinfo.ParseSeverityThreshold(c.GetStringFlagValue("fail-on-severity"))
+		if err != nil {
+			return err
+		}
+		buildScanGateCmd.SetSeverityThreshold(severity)
+	}
+	if c.GetStringFlagValue("scan-timeout") != "" {
+		minutes, err := strconv.Atoi(c.GetStringFlagValue("scan-timeout"))
+		if err != nil || minutes <= 0 {
+			return errors.New("The '--scan-timeout' option should have a positive numeric value. " + common.GetDocumentationMessage())
+		}
+		buildScanGateCmd.SetTimeout(time.Duration(minutes) * time.Minute)
+	}
+	return checkBuildScanError(commands.Exec(buildScanGateCmd))
+}
+
+func buildCleanCmd(c *components.Context) error {
+	if c.GetNumberOfArgs() > 2 {
+		return common.WrongNumberOfArgumentsHandler(c)
+	}
+	buildConfiguration := createBuildConfiguration(c)
+	if err := buildConfiguration.ValidateBuildParams(); err != nil {
+		return err
+	}
+	buildCleanCmd := buildinfo.NewBuildCleanCommand().SetBuildConfiguration(buildConfiguration)
+	return commands.Exec(buildCleanCmd)
+}
+
+func buildPromoteCmd(c *components.Context) error {
+	if c.GetNumberOfArgs() > 3 {
+		return common.WrongNumberOfArgumentsHandler(c)
+	}
+	configuration := createBuildPromoteConfiguration(c)
+	rtDetails, err := credentials.CreateArtifactoryDetailsByFlags(c)
+	if err != nil {
+		return err
+	}
+	buildConfiguration := createBuildConfiguration(c)
+	if err := buildConfiguration.ValidateBuildParams(); err != nil {
+		return err
+	}
+	buildPromotionCmd := buildinfo.NewBuildPromotionCommand().SetDryRun(c.GetBoolFlagValue("dry-run")).SetServerDetails(rtDetails).SetPromotionParams(configuration).SetBuildConfiguration(buildConfiguration)
+	if c.IsFlagSet("evidence-key-alias") && !c.IsFlagSet("evidence-key") {
+		return errorutils.CheckErrorf("the --evidence-key-alias option can be used only with --evidence-key")
+	}
+	buildPromotionCmd.SetEvidenceKeyPath(c.GetStringFlagValue("evidence-key")).SetEvidenceKeyAlias(c.GetStringFlagValue("evidence-key-alias"))
+	if c.IsFlagSet("chain") {
+		if c.GetNumberOfArgs() != 0 && c.GetNumberOfArgs() != 2 {
+			return common.PrintHelpAndReturnError("The target repository is not expected with the --chain option.", c)
+		}
+		chain, err := buildinfo.ParsePromotionChain(c.GetStringFlagValue("chain"))
+		if err != nil {
+			return err
+		}
+		buildPromotionCmd.SetPromotionChain(chain)
+	}
+	return commands.Exec(buildPromotionCmd)
+}
+
+func buildDiscardCmd(c *components.Context) error {
+	if c.GetNumberOfArgs() > 1 {
+		return common.WrongNumberOfArgumentsHandler(c)
+	}
+	configuration := createBuildDiscardConfiguration(c)
+	if configuration.BuildName == "" {
+		return common.PrintHelpAndReturnError("Build name is expected as a command argument or environment variable.", c)
+	}
+	buildDiscardCmd := buildinfo.NewBuildDiscardCommand()
+	rtDetails, err := credentials.CreateArtifactoryDetailsByFlags(c)
+	if err != nil {
+		return err
+	}
+	buildDiscardCmd.SetServerDetails(rtDetails).SetDiscardBuildsParams(configuration).SetDryRun(c.GetBoolFlagValue("dry-run"))
+
+	return commands.Exec(buildDiscardCmd)
+}
+
+func buildExportCmd(c *components.Context) error {
+	if c.GetNumberOfArgs() == 1 || c.GetNumberOfArgs() > 3 {
+		return common.WrongNumberOfArgumentsHandler(c)
+	}
+	buildConfiguration := createBuildConfiguration(c)
+	if err := buildConfiguration.ValidateBuildParams(); err != nil {
+		return err
+	}
+	targetDir := "."
+	if c.GetNumberOfArgs() == 3 {
+		targetDir = c.GetArgumentAt(2)
+	}
+	rtDetails, err := credentials.CreateArtifactoryDetailsByFlags(c)
+	if err != nil {
+		return err
+	}
+	threads, err := common.GetThreadsCount(c)
+	if err != nil {
+		return err
+	}
+	buildExportCmd := buildinfo.NewBuildExportCommand().SetServerDetails(rtDetails).SetBuildConfiguration(buildConfiguration).
+		SetTargetDir(targetDir).SetIncludeArtifacts(c.GetBoolFlagValue("include-artifacts")).SetThreads(threads)
+	return commands.Exec(buildExportCmd)
+}
+
+func gitLfsCleanCmd(c *components.Context) error {
+	if c.GetNumberOfArgs() > 1 {
+		return common.WrongNumberOfArgumentsHandler(c)
+	}
+	configuration := createGitLfsCleanConfiguration(c)
+	retries, err := getRetries(c)
+	if err != nil {
+		return err
+	}
+	retryWaitTime, err := getRetryWaitTime(c)
+	if err != nil {
+		return err
+	}
+	gitLfsCmd := generic.NewGitLfsCommand()
+	rtDetails, err := credentials.CreateArtifactoryDetailsByFlags(c)
+	if err != nil {
+		return err
+	}
+	gitLfsCmd.SetConfiguration(configuration).SetServerDetails(rtDetails).SetDryRun(c.GetBoolFlagValue("dry-run")).SetRetries(retries).SetRetryWaitMilliSecs(retryWaitTime)
+
+	return commands.Exec(gitLfsCmd)
+}
+
+func curlCmd(c *components.Context) error {
+	if show, err := common.ShowCmdHelpIfNeeded(c, c.Arguments); show || err != nil {
+		return err
+	}
+	if c.GetNumberOfArgs() < 1 {
+		return common.WrongNumberOfArgumentsHandler(c)
+	}
+	if flagIndex, _, _, err := coreutils.FindFlag("--spec", common.ExtractCommand(c)); err != nil || flagIndex != -1 {
+		if err != nil {
+			return err
+		}
+		return curlSpecCmd(c)
+	}
+	rtCurlCommand, err := newRtCurlCommand(c)
+	if err != nil {
+		return err
+	}
+
+	// Check if --server-id is explicitly passed in arguments
+	flagIndex, _, _, err := coreutils.FindFlag("--server-id", common.ExtractCommand(c))
+	if err != nil {
+		return err
+	}
+	// If --server-id is NOT present, then we check for JFROG_CLI_SERVER_ID env variable
+	if flagIndex == -1 {
+		if artDetails, err := credentials.CreateArtifactoryDetailsByFlags(c); err == nil && artDetails.ArtifactoryUrl != "" {
+			rtCurlCommand.SetServerDetails(artDetails)
+			rtCurlCommand.SetUrl(artDetails.ArtifactoryUrl)
+		}
+	}
+	return commands.Exec(rtCurlCommand)
+}
+
+// curlSpecCmd sends the request described by the curl spec, rather than running cUrl.
+func curlSpecCmd(c *components.Context) error {
+	args, specPath, err := coreutils.ExtractStringOptionFromArgs(common.ExtractCommand(c), "spec")
+	if err != nil {
+		return err
+	}
+	args, specVars, err := coreutils.ExtractStringOptionFromArgs(args, "spec-vars")
+	if err != nil {
+		return err
+	}
+	args, serverId, err := coreutils.ExtractServerIdFromCommand(args)
+	if err != nil {
+		return err
+	}
+	if len(args) > 0 {
+		return errorutils.CheckErrorf("the curl command must not include the arguments '%s' when --spec is provided", strings.Join(args, " "))
+	}
+	spec, err := curl.ReadCurlSpec(specPath, coreutils.SpecVarsStringToMap(specVars))
+	if err != nil {
+		return err
+	}
+	var rtDetails *config.ServerDetails
+	if serverId != "" {
+		rtDetails, err = credentials.GetSpecificConfig(serverId, true, true)
+	} else {
+		rtDetails, err = credentials.CreateArtifactoryDetailsByFlags(c)
+	}
+	if err != nil {
+		return err
+	}
+	return commands.Exec(curl.NewRtCurlSpecCommand().SetServerDetails(rtDetails).SetSpec(spec))
+}
+
+func newRtCurlCommand(c *components.Context) (*curl.RtCurlCommand, error) {
+	curlCommand := commands.NewCurlCommand().SetArguments(common.ExtractCommand(c))
+	rtCurlCommand := curl.NewRtCurlCommand(*curlCommand)
+	rtDetails, err := rtCurlCommand.GetServerDetails()
+	if err != nil {
+		return nil, err
+	}
+	if rtDetails.ArtifactoryUrl == "" {
+		return nil, errorutils.CheckErrorf("No Artifactory servers configured. Use the 'jf c add' command to set the Artifactory server details.")
+	}
+	rtCurlCommand.SetServerDetails(rtDetails)
+	rtCurlCommand.SetUrl(rtDetails.ArtifactoryUrl)
+	return rtCurlCommand, err
+}
+
+func repoTemplateCmd(c *components.Context) error {
+	if c.GetNumberOfArgs() != 1 {
+		return common.WrongNumberOfArgumentsHandler(c)
+	}
+
+	// Run command.
+	repoTemplateCmd := repository.NewRepoTemplateCommand()
+	repoTemplateCmd.SetTemplatePath(c.GetArgumentAt(0))
+	return commands.Exec(repoTemplateCmd)
+}
+
+func repoCreateCmd(c *components.Context) error {
+	if c.GetNumberOfArgs() != 1 {
+		return common.WrongNumberOfArgumentsHandler(c)
+	}
+
+	rtDetails, err := credentials.CreateArtifactoryDetailsByFlags(c)
+	if err != nil {
+		return err
+	}
+
+	// Run command.
+	repoCreateCmd := repository.NewRepoCreateCommand()
+	repoCreateCmd.SetTemplatePath(c.GetArgumentAt(0)).SetServerDetails(rtDetails).SetVars(c.GetStringFlagValue("vars")).SetProjectKey(c.GetStringFlagValue("project"))
+	return commands.Exec(repoCreateCmd)
+}
+
+func repoUpdateCmd(c *components.Context) error {
+	if c.GetNumberOfArgs() != 1 {
+		return common.WrongNumberOfArgumentsHandler(c)
+	}
+
+	rtDetails, err := credentials.CreateArtifactoryDetailsByFlags(c)
+	if err != nil {
+		return err
+	}
+
+	// Run command.
+	repoUpdateCmd := repository.NewRepoUpdateCommand()
+	repoUpdateCmd.SetTemplatePath(c.GetArgumentAt(0)).SetServerDetails(rtDetails).SetVars(c.GetStringFlagValue("vars")).SetProjectKey(c.GetStringFlagValue("project"))
+	return commands.Exec(repoUpdateCmd)
+}
+
+func repoDeleteCmd(c *components.Context) error {
+	if c.GetNumberOfArgs() != 1 {
+		return common.WrongNumberOfArgumentsHandler(c)
+	}
+
+	rtDetails, err := credentials.CreateArtifactoryDetailsByFlags(c)
+	if err != nil {
+		return err
+	}
+
+	repoDeleteCmd := repository.NewRepoDeleteCommand()
+	repoDeleteCmd.SetRepoPattern(c.GetArgumentAt(0)).SetServerDetails(rtDetails).SetQuiet(common.GetQuietValue(c)).SetDryRun(c.GetBoolFlagValue("dry-run"))
+	return commands.Exec(repoDeleteCmd)
+}
+
+func repoApplyCmd(c *components.Context) error {
+	if c.GetNumberOfArgs() != 1 {
+		return common.WrongNumberOfArgumentsHandler(c)
+	}
+	rtDetails, err := credentials.CreateArtifactoryDetailsByFlags(c)
+	if err != nil {
+		return err
+	}
+	repoApplyCmd := repository.NewRepoApplyCommand()
+	repoApplyCmd.SetConfigDir(c.GetArgumentAt(0)).SetVars(c.GetStringFlagValue("vars")).SetDryRun(c.GetBoolFlagValue("dry-run")).SetServerDetails(rtDetails)
+	return commands.Exec(repoApplyCmd)
+}
+
+func repoExportCmd(c *components.Context) error {
+	if c.GetNumberOfArgs() != 2 {
+		return common.WrongNumberOfArgumentsHandler(c)
+	}
+	rtDetails, err := credentials.CreateArtifactoryDetailsByFlags(c)
+	if err != nil {
+		return err
+	}
+	repoExportCmd := repository.NewRepoExportCommand()
+	repoExportCmd.SetRepoPattern(c.GetArgumentAt(0)).SetTargetDir(c.GetArgumentAt(1)).SetServerDetails(rtDetails)
+	return commands.Exec(repoExportCmd)
+}
+
+func federationConvertCmd(c *components.Context) error {
+	if c.GetNumberOfArgs() != 1 {
+		return common.WrongNumberOfArgumentsHandler(c)
+	}
+	rtDetails, err := credentials.CreateArtifactoryDetailsByFlags(c)
+	if err != nil {
+		return err
+	}
+	federationConvertCmd := repository.NewFederationConvertCommand()
+	federationConvertCmd.SetRepoKey(c.GetArgumentAt(0)).SetServerDetails(rtDetails)
+	return commands.Exec(federationConvertCmd)
+}
+
+func federationMemberAddCmd(c *components.Context) error {
+	return federationMemberCmd(c, false)
+}
+
+func federationMemberRemoveCmd(c *components.Context) error {
+	return federationMemberCmd(c, true)
+}
+
+func federationMemberCmd(c *components.Context, remove bool) error {
+	if c.GetNumberOfArgs() != 2 {
+		return common.WrongNumberOfArgumentsHandler(c)
+	}
+	rtDetails, err := credentials.CreateArtifactoryDetailsByFlags(c)
+	if err != nil {
+		return err
+	}
+	federationMemberCmd := repository.NewFederationMemberCommand()
+	federationMemberCmd.SetRepoKey(c.GetArgumentAt(0)).SetMemberUrl(c.GetArgumentAt(1)).SetRemove(remove).SetServerDetails(rtDetails).
+		SetDryRun(c.GetBoolFlagValue("dry-run"))
+	if remove {
+		federationMemberCmd.SetQuiet(common.GetQuietValue(c))
+	}
+	return commands.Exec(federationMemberCmd)
+}
+
+func federationSyncCmd(c *components.Context) error {
+	if c.GetNumberOfArgs() != 1 {
+		return common.WrongNumberOfArgumentsHandler(c)
+	}
+	rtDetails, err := credentials.CreateArtifactoryDetailsByFlags(c)
+	if err != nil {
+		return err
+	}
+	federationSyncCmd := repository.NewFederationSyncCommand()
+	federationSyncCmd.SetRepoKey(c.GetArgumentAt(0)).SetMirrorUrl(c.GetStringFlagValue("mirror")).SetServerDetails(rtDetails)
+	return commands.Exec(federationSyncCmd)
+}
+
+func federationStatusCmd(c *components.Context) error {
+	if c.GetNumberOfArgs() != 1 {
+		return common.WrongNumberOfArgumentsHandler(c)
+	}
+	rtDetails, err := credentials.CreateArtifactoryDetailsByFlags(c)
+	if err != nil {
+		return err
+	}
+	federationStatusCmd := repository.NewFederationStatusCommand()
+	federationStatusCmd.SetRepoKey(c.GetArgumentAt(0)).SetServerDetails(rtDetails)
+	return commands.Exec(federationStatusCmd)
+}
+
+func resolveTraceCmd(c *components.Context) error {
+	if c.GetNumberOfArgs() != 2 {
+		return common.WrongNumberOfArgumentsHandler(c)
+	}
+	rtDetails, err := credentials.CreateArtifactoryDetailsByFlags(c)
+	if err != nil {
+		return err
+	}
+	resolveTraceCmd := repository.NewResolveTraceCommand()
+	resolveTraceCmd.SetRepoKey(c.GetArgumentAt(0)).SetPath(c.GetArgumentAt(1)).SetFormat(c.GetStringFlagValue("format")).SetServerDetails(rtDetails)
+	return commands.Exec(resolveTraceCmd)
+}
+
+func replicationTemplateCmd(c *components.Context) error {
+	if c.GetNumberOfArgs() != 1 {
+		return common.WrongNumberOfArgumentsHandler(c)
+	}
+	replicationTemplateCmd := replication.NewReplicationTemplateCommand()
+	replicationTemplateCmd.SetTemplatePath(c.GetArgumentAt(0))
+	return commands.Exec(replicationTemplateCmd)
+}
+
+func replicationCreateCmd(c *components.Context) error {
+	if c.GetNumberOfArgs() != 1 {
+		return common.WrongNumberOfArgumentsHandler(c)
+	}
+	rtDetails, err := credentials.CreateArtifactoryDetailsByFlags(c)
+	if err != nil {
+		return err
+	}
+	replicationCreateCmd := replication.NewReplicationCreateCommand()
+	replicationCreateCmd.SetTemplatePath(c.GetArgumentAt(0)).SetServerDetails(rtDetails).SetVars(c.GetStringFlagValue("vars"))
+	return commands.Exec(replicationCreateCmd)
+}
+
+func replicationUpdateCmd(c *components.Context) error {
+	if c.GetNumberOfArgs() != 1 {
+		return common.WrongNumberOfArgumentsHandler(c)
+	}
+	rtDetails, err := credentials.CreateArtifactoryDetailsByFlags(c)
+	if err != nil {
+		return err
+	}
+	replicationUpdateCmd := replication.NewReplicationUpdateCommand()
+	replicationUpdateCmd.SetTemplatePath(c.GetArgumentAt(0)).SetServerDetails(rtDetails).SetVars(c.GetStringFlagValue("vars"))
+	return commands.Exec(replicationUpdateCmd)
+}
+
+func replicationDeleteCmd(c *components.Context) error {
+	if c.GetNumberOfArgs() != 1 {
+		return common.WrongNumberOfArgumentsHandler(c)
+	}
+	rtDetails, err := credentials.CreateArtifactoryDetailsByFlags(c)
+	if err != nil {
+		return err
+	}
+	replicationDeleteCmd := replication.NewReplicationDeleteCommand()
+	replicationDeleteCmd.SetRepoKey(c.GetArgumentAt(0)).SetServerDetails(rtDetails).SetQuiet(common.GetQuietValue(c)).SetDryRun(c.GetBoolFlagValue("dry-run"))
+	return commands.Exec(replicationDeleteCmd)
+}
+
+func replicationStatusCmd(c *components.Context) error {
+	if c.GetNumberOfArgs() != 1 {
+		return common.WrongNumberOfArgumentsHandler(c)
+	}
+	rtDetails, err := credentials.CreateArtifactoryDetailsByFlags(c)
+	if err != nil {
+		return err
+	}
+	replicationStatusCmd := replication.NewReplicationStatusCommand()
+	replicationStatusCmd.SetRepoKey(c.GetArgumentAt(0)).SetServerDetails(rtDetails)
+	return commands.Exec(replicationStatusCmd)
+}
+
+func transferFilesStatusCmd(c *components.Context) error {
+	if c.GetNumberOfArgs() != 0 {
+		return common.WrongNumberOfArgumentsHandler(c)
+	}
+	statusCmd := transfer.NewStatusCommand().SetFormat(c.GetStringFlagValue("format"))
+	return commands.Exec(statusCmd)
+}
+
+func projectApplyCmd(c *components.Context) error {
+	if c.GetNumberOfArgs() != 1 {
+		return common.WrongNumberOfArgumentsHandler(c)
+	}
+	rtDetails, err := credentials.CreateArtifactoryDetailsByFlags(c)
+	if err != nil {
+		return err
+	}
+	projectApplyCmd := project.NewProjectApplyCommand()
+	projectApplyCmd.SetConfigPath(c.GetArgumentAt(0)).SetServerDetails(rtDetails)
+	return commands.Exec(projectApplyCmd)
+}
+
+func projectExportCmd(c *components.Context) error {
+	if c.GetNumberOfArgs() != 2 {
+		return common.WrongNumberOfArgumentsHandler(c)
+	}
+	rtDetails, err := credentials.CreateArtifactoryDetailsByFlags(c)
+	if err != nil {
+		return err
+	}
+	projectExportCmd := project.NewProjectExportCommand()
+	projectExportCmd.SetProjectKey(c.GetArgumentAt(0)).SetConfigPath(c.GetArgumentAt(1)).SetServerDetails(rtDetails)
+	return commands.Exec(projectExportCmd)
+}
+
+func projectDeleteCmd(c *components.Context) error {
+	if c.GetNumberOfArgs() != 1 {
+		return common.WrongNumberOfArgumentsHandler(c)
+	}
+	rtDetails, err := credentials.CreateArtifactoryDetailsByFlags(c)
+	if err != nil {
+		return err
+	}
+	projectDeleteCmd := project.NewProjectDeleteCommand()
+	projectDeleteCmd.SetProjectKey(c.GetArgumentAt(0)).SetQuiet(common.GetQuietValue(c)).SetServerDetails(rtDetails).SetDryRun(c.GetBoolFlagValue("dry-run"))
+	return commands.Exec(projectDeleteCmd)
+}
+
+func permissionTargetImportCmd(c *components.Context) error {
+	if c.GetNumberOfArgs() != 1 {
+		return common.WrongNumberOfArgumentsHandler(c)
+	}
+	rtDetails, err := credentials.CreateArtifactoryDetailsByFlags(c)
+	if err != nil {
+		return err
+	}
+	permissionTargetImportCmd := permissiontarget.NewPermissionTargetImportCommand()
+	permissionTargetImportCmd.SetConfigPath(c.GetArgumentAt(0)).SetServerDetails(rtDetails)
+	return commands.Exec(permissionTargetImportCmd)
+}
+
+func permissionTargetExportCmd(c *components.Context) error {
+	if c.GetNumberOfArgs() != 2 {
+		return common.WrongNumberOfArgumentsHandler(c)
+	}
+	rtDetails, err := credentials.CreateArtifactoryDetailsByFlags(c)
+	if err != nil {
+		return err
+	}
+	permissionTargetExportCmd := permissiontarget.NewPermissionTargetExportCommand()
+	permissionTargetExportCmd.SetNamePattern(c.GetArgumentAt(0)).SetConfigPath(c.GetArgumentAt(1)).SetServerDetails(rtDetails)
+	return commands.Exec(permissionTargetExportCmd)
+}
+
+func userCreateCmd(c *components.Context) error {
+	if c.GetNumberOfArgs() != 3 {
+		return common.WrongNumberOfArgumentsHandler(c)
+	}
+	rtDetails, err := credentials.CreateArtifactoryDetailsByFlags(c)
+	if err != nil {
+		return err
+	}
+	user := services.User{Name: c.GetArgumentAt(0), Password: c.GetArgumentAt(1), Email: c.GetArgumentAt(2)}
+	if c.GetBoolFlagValue(flagkit.Admin) {
+		admin := true
+		user.Admin = &admin
+	}
+	usersCreateCmd := coreusers.NewUsersCreateCommand()
+	usersCreateCmd.SetUsers([]services.User{user}).SetUsersGroups(getUsersGroups(c)).SetReplaceIfExists(c.GetBoolFlagValue(flagkit.Replace)).SetServerDetails(rtDetails)
+	return commands.Exec(usersCreateCmd)
+}
+
+func usersCreateCmd(c *components.Context) error {
+	if c.GetNumberOfArgs() != 0 {
+		return common.WrongNumberOfArgumentsHandler(c)
+	}
+	rtDetails, err := credentials.CreateArtifactoryDetailsByFlags(c)
+	if err != nil {
+		return err
+	}
+	users, err := usersmanagement.ReadUsersCsv(c.GetStringFlagValue("csv"))
+	if err != nil {
+		return err
+	}
+	if len(users) == 0 {
+		return errors.New("the CSV file doesn't contain any users")
+	}
+	usersCreateCmd := coreusers.NewUsersCreateCommand()
+	usersCreateCmd.SetUsers(users).SetUsersGroups(getUsersGroups(c)).SetReplaceIfExists(c.GetBoolFlagValue(flagkit.Replace)).SetServerDetails(rtDetails)
+	return commands.Exec(usersCreateCmd)
+}
+
+// Returns the groups of the '--users-groups' option, or nil if it isn't set.
+func getUsersGroups(c *components.Context) *[]string {
+	if c.GetStringFlagValue(flagkit.UsersGroups) == "" {
+		return nil
+	}
+	groups := strings.Split(c.GetStringFlagValue(flagkit.UsersGroups), ",")
+	return &groups
 }
 
-func buildAppendCmd(c *components.Context) error {
-	if c.GetNumberOfArgs() != 4 {
+func userUpdateCmd(c *components.Context) error {
+	if c.GetNumberOfArgs() != 1 {
 		return common.WrongNumberOfArgumentsHandler(c)
 	}
-	buildConfiguration := common.CreateBuildConfiguration(c)
-	if err := buildConfiguration.ValidateBuildParams(); err != nil {
+	rtDetails, err := credentials.CreateArtifactoryDetailsByFlags(c)
+	if err != nil {
 		return err
 	}
-	buildNameToAppend, buildNumberToAppend := c.GetArgumentAt(2), c.GetArgumentAt(3)
-	rtDetails, err := common.CreateArtifactoryDetailsByFlags(c)
+	admin, err := usersmanagement.ParseOptionalBool("admin", c.GetStringFlagValue("admin"))
 	if err != nil {
 		return err
 	}
-	buildAppendCmd := buildinfo.NewBuildAppendCommand().SetServerDetails(rtDetails).SetBuildConfiguration(buildConfiguration).SetBuildNameToAppend(buildNameToAppend).SetBuildNumberToAppend(buildNumberToAppend)
-	return commands.Exec(buildAppendCmd)
+	userUpdateCmd := usersmanagement.NewUserUpdateCommand().SetName(c.GetArgumentAt(0)).SetEmail(c.GetStringFlagValue("email")).
+		SetPassword(c.GetStringFlagValue("new-password")).SetAdmin(admin).SetServerDetails(rtDetails)
+	if c.IsFlagSet(flagkit.UsersGroups) {
+		groups := []string{}
+		if groupsList := c.GetStringFlagValue(flagkit.UsersGroups); groupsList != "" {
+			groups = strings.Split(groupsList, ",")
+		}
+		userUpdateCmd.SetGroups(&groups)
+	}
+	return commands.Exec(userUpdateCmd)
 }
 
-func buildAddDependenciesCmd(c *components.Context) error {
-	if c.GetNumberOfArgs() > 2 && c.IsFlagSet("spec") {
-		return common.PrintHelpAndReturnError("Only path or spec is allowed, not both.", c)
-	}
-	if c.IsFlagSet("regexp") && c.IsFlagSet("from-rt") {
-		return common.PrintHelpAndReturnError("The --regexp option is not supported when --from-rt is set to true.", c)
+func usersDeleteCmd(c *components.Context) error {
+	if c.GetNumberOfArgs() > 1 || (c.GetNumberOfArgs() == 1) == c.IsFlagSet("csv") {
+		return common.PrintHelpAndReturnError("Either a list of usernames or the --csv option should be provided.", c)
 	}
-	buildConfiguration := common.CreateBuildConfiguration(c)
-	if err := buildConfiguration.ValidateBuildParams(); err != nil {
+	rtDetails, err := credentials.CreateArtifactoryDetailsByFlags(c)
+	if err != nil {
 		return err
 	}
-	// Odd number of args - Use pattern arg
-	// Even number of args - Use spec flag
-	if c.GetNumberOfArgs() > 3 || (c.GetNumberOfArgs()%2 != 1 && (c.GetNumberOfArgs()%2 != 0 || !c.IsFlagSet("spec"))) {
-		return common.WrongNumberOfArgumentsHandler(c)
-	}
-
-	var dependenciesSpec *spec.SpecFiles
-	var rtDetails *config.ServerDetails
-	var err error
-	if c.IsFlagSet("spec") {
-		dependenciesSpec, err = commonCliUtils.GetSpec(c, true, true)
+	var names []string
+	if c.IsFlagSet("csv") {
+		users, err := usersmanagement.ReadUsersCsv(c.GetStringFlagValue("csv"))
 		if err != nil {
 			return err
 		}
-	} else {
-		dependenciesSpec = createDefaultBuildAddDependenciesSpec(c)
-	}
-	if c.GetBoolFlagValue("from-rt") {
-		rtDetails, err = common.CreateArtifactoryDetailsByFlags(c)
-		if err != nil {
-			return err
+		for _, user := range users {
+			names = append(names, user.Name)
 		}
 	} else {
-		common.FixWinPathsForFileSystemSourcedCmds(dependenciesSpec, c)
+		names = strings.Split(c.GetArgumentAt(0), ",")
 	}
-	buildAddDependenciesCmd := buildinfo.NewBuildAddDependenciesCommand().SetDryRun(c.GetBoolFlagValue("dry-run")).SetBuildConfiguration(buildConfiguration).SetDependenciesSpec(dependenciesSpec).SetServerDetails(rtDetails)
-	err = commands.Exec(buildAddDependenciesCmd)
-	result := buildAddDependenciesCmd.Result()
-	return printBriefSummaryAndGetError(result.SuccessCount(), result.FailCount(), common.IsFailNoOp(c), err)
+	if !common.GetQuietValue(c) && !coreutils.AskYesNo(fmt.Sprintf("Are you sure you want to delete the users %s?", strings.Join(names, ", ")), false) {
+		return nil
+	}
+	usersDeleteCmd := coreusers.NewUsersDeleteCommand()
+	usersDeleteCmd.SetUsers(names).SetServerDetails(rtDetails)
+	return commands.Exec(usersDeleteCmd)
 }
 
-func buildCollectEnvCmd(c *components.Context) error {
-	if c.GetNumberOfArgs() > 2 {
+// Returns the filter of the users-list and users-export commands.
+func getUserFilter(c *components.Context, namePattern string) usersmanagement.UserFilter {
+	return usersmanagement.UserFilter{
+		NamePattern: namePattern,
+		Group:       c.GetStringFlagValue("group"),
+		Realm:       c.GetStringFlagValue("realm"),
+		AdminsOnly:  c.GetBoolFlagValue("admins"),
+	}
+}
+
+func usersListCmd(c *components.Context) error {
+	if c.GetNumberOfArgs() > 1 {
 		return common.WrongNumberOfArgumentsHandler(c)
 	}
-	buildConfiguration := common.CreateBuildConfiguration(c)
-	if err := buildConfiguration.ValidateBuildParams(); err != nil {
+	rtDetails, err := credentials.CreateArtifactoryDetailsByFlags(c)
+	if err != nil {
 		return err
 	}
-	buildCollectEnvCmd := buildinfo.NewBuildCollectEnvCommand().SetBuildConfiguration(buildConfiguration)
-
-	return commands.Exec(buildCollectEnvCmd)
+	usersListCmd := usersmanagement.NewUsersListCommand().SetFilter(getUserFilter(c, c.GetArgumentAt(0))).SetServerDetails(rtDetails)
+	if c.GetStringFlagValue("format") != "" {
+		usersListCmd.SetFormat(c.GetStringFlagValue("format"))
+	}
+	return commands.Exec(usersListCmd)
 }
 
-func buildAddGitCmd(c *components.Context) error {
-	if c.GetNumberOfArgs() > 3 {
+func usersExportCmd(c *components.Context) error {
+	if c.GetNumberOfArgs() != 2 {
 		return common.WrongNumberOfArgumentsHandler(c)
 	}
-	buildConfiguration := common.CreateBuildConfiguration(c)
-	if err := buildConfiguration.ValidateBuildParams(); err != nil {
+	rtDetails, err := credentials.CreateArtifactoryDetailsByFlags(c)
+	if err != nil {
 		return err
 	}
-
-	buildAddGitConfigurationCmd := buildinfo.NewBuildAddGitCommand().SetBuildConfiguration(buildConfiguration).SetConfigFilePath(c.GetStringFlagValue("config")).SetServerId(c.GetStringFlagValue("server-id"))
-	if c.GetNumberOfArgs() == 3 {
-		buildAddGitConfigurationCmd.SetDotGitPath(c.GetArgumentAt(2))
-	} else if c.GetNumberOfArgs() == 1 {
-		buildAddGitConfigurationCmd.SetDotGitPath(c.GetArgumentAt(0))
+	namePattern := c.GetArgumentAt(0)
+	if namePattern == "*" {
+		namePattern = ""
 	}
-	return commands.Exec(buildAddGitConfigurationCmd)
+	usersExportCmd := usersmanagement.NewUsersExportCommand().SetFilter(getUserFilter(c, namePattern)).SetFilePath(c.GetArgumentAt(1)).SetServerDetails(rtDetails)
+	return commands.Exec(usersExportCmd)
 }
 
-func buildScanLegacyCmd(c *components.Context) error {
-	if c.GetNumberOfArgs() > 2 {
+func usersImportCmd(c *components.Context) error {
+	if c.GetNumberOfArgs() != 1 {
 		return common.WrongNumberOfArgumentsHandler(c)
 	}
-	buildConfiguration := common.CreateBuildConfiguration(c)
-	if err := buildConfiguration.ValidateBuildParams(); err != nil {
+	rtDetails, err := credentials.CreateArtifactoryDetailsByFlags(c)
+	if err != nil {
 		return err
 	}
-	rtDetails, err := common.CreateArtifactoryDetailsByFlags(c)
+	usersImportCmd := usersmanagement.NewUsersImportCommand().SetFilePath(c.GetArgumentAt(0)).SetServerDetails(rtDetails)
+	return commands.Exec(usersImportCmd)
+}
+
+func groupCreateCmd(c *components.Context) error {
+	if c.GetNumberOfArgs() != 1 {
+		return common.WrongNumberOfArgumentsHandler(c)
+	}
+	rtDetails, err := credentials.CreateArtifactoryDetailsByFlags(c)
 	if err != nil {
 		return err
 	}
-	buildScanCmd := buildinfo.NewBuildScanLegacyCommand().SetServerDetails(rtDetails).SetFailBuild(c.GetBoolTFlagValue("fail")).SetBuildConfiguration(buildConfiguration)
-	err = commands.Exec(buildScanCmd)
-
-	return checkBuildScanError(err)
+	groupCreateCmd := coreusers.NewGroupCreateCommand()
+	groupCreateCmd.SetName(c.GetArgumentAt(0)).SetReplaceIfExists(c.GetBoolFlagValue(flagkit.Replace)).SetServerDetails(rtDetails)
+	return commands.Exec(groupCreateCmd)
 }
 
-func checkBuildScanError(err error) error {
-	// If the build was found vulnerable, exit with ExitCodeVulnerableBuild.
-	if errors.Is(err, utils.GetBuildScanError()) {
-		return coreutils.CliError{ExitCode: coreutils.ExitCodeVulnerableBuild, ErrorMsg: err.Error()}
+func groupUpdateCmd(c *components.Context) error {
+	if c.GetNumberOfArgs() != 1 {
+		return common.WrongNumberOfArgumentsHandler(c)
 	}
-	// If the scan operation failed, for example due to HTTP timeout, exit with ExitCodeError.
+	rtDetails, err := credentials.CreateArtifactoryDetailsByFlags(c)
 	if err != nil {
-		return coreutils.CliError{ExitCode: coreutils.ExitCodeError, ErrorMsg: err.Error()}
+		return err
 	}
-	return nil
+	autoJoin, err := usersmanagement.ParseOptionalBool("auto-join", c.GetStringFlagValue("auto-join"))
+	if err != nil {
+		return err
+	}
+	adminPrivileges, err := usersmanagement.ParseOptionalBool("admin-privileges", c.GetStringFlagValue("admin-privileges"))
+	if err != nil {
+		return err
+	}
+	groupUpdateCmd := usersmanagement.NewGroupUpdateCommand().SetName(c.GetArgumentAt(0)).SetDescription(c.GetStringFlagValue("description")).
+		SetAutoJoin(autoJoin).SetAdminPrivileges(adminPrivileges).SetServerDetails(rtDetails)
+	return commands.Exec(groupUpdateCmd)
 }
 
-func buildCleanCmd(c *components.Context) error {
-	if c.GetNumberOfArgs() > 2 {
+func groupAddUsersCmd(c *components.Context) error {
+	if c.GetNumberOfArgs() != 2 {
 		return common.WrongNumberOfArgumentsHandler(c)
 	}
-	buildConfiguration := common.CreateBuildConfiguration(c)
-	if err := buildConfiguration.ValidateBuildParams(); err != nil {
+	rtDetails, err := credentials.CreateArtifactoryDetailsByFlags(c)
+	if err != nil {
 		return err
 	}
-	buildCleanCmd := buildinfo.NewBuildCleanCommand().SetBuildConfiguration(buildConfiguration)
-	return commands.Exec(buildCleanCmd)
+	groupAddUsersCmd := usersmanagement.NewGroupAddUsersCommand().SetName(c.GetArgumentAt(0)).SetUsers(strings.Split(c.GetArgumentAt(1), ",")).SetServerDetails(rtDetails)
+	return commands.Exec(groupAddUsersCmd)
 }
 
-func buildPromoteCmd(c *components.Context) error {
-	if c.GetNumberOfArgs() > 3 {
+func groupDeleteCmd(c *components.Context) error {
+	if c.GetNumberOfArgs() != 1 {
 		return common.WrongNumberOfArgumentsHandler(c)
 	}
-	configuration := createBuildPromoteConfiguration(c)
-	rtDetails, err := common.CreateArtifactoryDetailsByFlags(c)
+	rtDetails, err := credentials.CreateArtifactoryDetailsByFlags(c)
 	if err != nil {
 		return err
 	}
-	buildConfiguration := common.CreateBuildConfiguration(c)
-	if err := buildConfiguration.ValidateBuildParams(); err != nil {
-		return err
+	if !common.GetQuietValue(c) && !coreutils.AskYesNo("Are you sure you want to delete the group "+c.GetArgumentAt(0)+"?", false) {
+		return nil
 	}
-	buildPromotionCmd := buildinfo.NewBuildPromotionCommand().SetDryRun(c.GetBoolFlagValue("dry-run")).SetServerDetails(rtDetails).SetPromotionParams(configuration).SetBuildConfiguration(buildConfiguration)
-	return commands.Exec(buildPromotionCmd)
+	groupDeleteCmd := coreusers.NewGroupDeleteCommand()
+	groupDeleteCmd.SetName(c.GetArgumentAt(0)).SetServerDetails(rtDetails)
+	return commands.Exec(groupDeleteCmd)
 }
 
-func buildDiscardCmd(c *components.Context) error {
+func groupsListCmd(c *components.Context) error {
 	if c.GetNumberOfArgs() > 1 {
 		return common.WrongNumberOfArgumentsHandler(c)
 	}
-	configuration := createBuildDiscardConfiguration(c)
-	if configuration.BuildName == "" {
-		return common.PrintHelpAndReturnError("Build name is expected as a command argument or environment variable.", c)
-	}
-	buildDiscardCmd := buildinfo.NewBuildDiscardCommand()
-	rtDetails, err := common.CreateArtifactoryDetailsByFlags(c)
+	rtDetails, err := credentials.CreateArtifactoryDetailsByFlags(c)
 	if err != nil {
 		return err
 	}
-	buildDiscardCmd.SetServerDetails(rtDetails).SetDiscardBuildsParams(configuration)
-
-	return commands.Exec(buildDiscardCmd)
+	groupsListCmd := usersmanagement.NewGroupsListCommand().SetNamePattern(c.GetArgumentAt(0)).SetServerDetails(rtDetails)
+	if c.GetStringFlagValue("format") != "" {
+		groupsListCmd.SetFormat(c.GetStringFlagValue("format"))
+	}
+	return commands.Exec(groupsListCmd)
 }
 
-func gitLfsCleanCmd(c *components.Context) error {
+func tokenCreateCmd(c *components.Context) error {
 	if c.GetNumberOfArgs() > 1 {
 		return common.WrongNumberOfArgumentsHandler(c)
 	}
-	configuration := createGitLfsCleanConfiguration(c)
-	retries, err := getRetries(c)
+	rtDetails, err := credentials.CreateArtifactoryDetailsByFlags(c)
 	if err != nil {
 		return err
 	}
-	retryWaitTime, err := getRetryWaitTime(c)
+	expiry, err := getTokenExpiry(c)
 	if err != nil {
 		return err
 	}
-	gitLfsCmd := generic.NewGitLfsCommand()
-	rtDetails, err := common.CreateArtifactoryDetailsByFlags(c)
-	if err != nil {
-		return err
+	tokenCreateCmd := accesstoken.NewTokenCreateCommand()
+	if c.GetNumberOfArgs() == 1 {
+		tokenCreateCmd.SetUsername(c.GetArgumentAt(0))
 	}
-	gitLfsCmd.SetConfiguration(configuration).SetServerDetails(rtDetails).SetDryRun(c.GetBoolFlagValue("dry-run")).SetRetries(retries).SetRetryWaitMilliSecs(retryWaitTime)
+	tokenCreateCmd.SetScope(c.GetStringFlagValue("scope")).SetProjectKey(c.GetStringFlagValue(flagkit.Project)).
+		SetDescription(c.GetStringFlagValue("description")).SetAudience(c.GetStringFlagValue(flagkit.Audience)).SetExpiry(expiry).
+		SetRefreshable(c.GetBoolFlagValue(flagkit.Refreshable)).SetSaveServerId(c.GetStringFlagValue("save-as")).SetServerDetails(rtDetails)
+	return commands.Exec(tokenCreateCmd)
+}
 
-	return commands.Exec(gitLfsCmd)
+// getTokenExpiry returns the value of the '--expiry' option, or nil if not set.
+func getTokenExpiry(c *components.Context) (*uint, error) {
+	if c.GetStringFlagValue(flagkit.Expiry) == "" {
+		return nil, nil
+	}
+	expiry, err := strconv.ParseUint(c.GetStringFlagValue(flagkit.Expiry), 10, 32)
+	if err != nil {
+		return nil, errors.New("The '--expiry' option should have a numeric value. " + common.GetDocumentationMessage())
+	}
+	return clientutils.Pointer(uint(expiry)), nil
 }
 
-func curlCmd(c *components.Context) error {
-	if show, err := common.ShowCmdHelpIfNeeded(c, c.Arguments); show || err != nil {
+func oidcTokenExchangeCmd(c *components.Context) error {
+	if c.GetNumberOfArgs() != 0 {
+		return common.WrongNumberOfArgumentsHandler(c)
+	}
+	rtDetails, err := credentials.CreateArtifactoryDetailsByFlags(c)
+	if err != nil {
 		return err
 	}
-	if c.GetNumberOfArgs() < 1 {
+	oidcTokenExchangeCmd := accesstoken.NewOidcTokenExchangeCommand()
+	oidcTokenExchangeCmd.SetProvider(c.GetStringFlagValue("oidc-provider")).SetProviderName(c.GetStringFlagValue("provider-name")).
+		SetAudience(c.GetStringFlagValue(flagkit.Audience)).SetProjectKey(c.GetStringFlagValue(flagkit.Project)).
+		SetIdTokenEnv(c.GetStringFlagValue("id-token-env")).SetSaveServerId(c.GetStringFlagValue("save-as")).SetServerDetails(rtDetails)
+	return commands.Exec(oidcTokenExchangeCmd)
+}
+
+func tokenRefreshCmd(c *components.Context) error {
+	if c.GetNumberOfArgs() != 0 {
 		return common.WrongNumberOfArgumentsHandler(c)
 	}
-	rtCurlCommand, err := newRtCurlCommand(c)
+	rtDetails, err := credentials.CreateArtifactoryDetailsByFlags(c)
 	if err != nil {
 		return err
 	}
+	tokenRefreshCmd := accesstoken.NewTokenRefreshCommand()
+	tokenRefreshCmd.SetRefreshToken(c.GetStringFlagValue("refresh-token")).SetSaveServerId(c.GetStringFlagValue("save-as")).SetServerDetails(rtDetails)
+	return commands.Exec(tokenRefreshCmd)
+}
 
-	// Check if --server-id is explicitly passed in arguments
-	flagIndex, _, _, err := coreutils.FindFlag("--server-id", common.ExtractCommand(c))
+func tokenRevokeCmd(c *components.Context) error {
+	if c.GetNumberOfArgs() > 1 {
+		return common.WrongNumberOfArgumentsHandler(c)
+	}
+	rtDetails, err := credentials.CreateArtifactoryDetailsByFlags(c)
 	if err != nil {
 		return err
 	}
-	// If --server-id is NOT present, then we check for JFROG_CLI_SERVER_ID env variable
-	if flagIndex == -1 {
-		if artDetails, err := common.CreateArtifactoryDetailsByFlags(c); err == nil && artDetails.ArtifactoryUrl != "" {
-			rtCurlCommand.SetServerDetails(artDetails)
-			rtCurlCommand.SetUrl(artDetails.ArtifactoryUrl)
-		}
+	tokenRevokeCmd := accesstoken.NewTokenRevokeCommand()
+	if c.GetNumberOfArgs() == 1 {
+		tokenRevokeCmd.SetTokenId(c.GetArgumentAt(0))
 	}
-	return commands.Exec(rtCurlCommand)
+	tokenRevokeCmd.SetServerDetails(rtDetails).SetDryRun(c.GetBoolFlagValue("dry-run"))
+	return commands.Exec(tokenRevokeCmd)
 }
 
-func newRtCurlCommand(c *components.Context) (*curl.RtCurlCommand, error) {
-	curlCommand := commands.NewCurlCommand().SetArguments(common.ExtractCommand(c))
-	rtCurlCommand := curl.NewRtCurlCommand(*curlCommand)
-	rtDetails, err := rtCurlCommand.GetServerDetails()
-	if err != nil {
-		return nil, err
+func webhookCreateCmd(c *components.Context) error {
+	if c.GetNumberOfArgs() != 2 {
+		return common.WrongNumberOfArgumentsHandler(c)
 	}
-	if rtDetails.ArtifactoryUrl == "" {
-		return nil, errorutils.CheckErrorf("No Artifactory servers configured. Use the 'jf c add' command to set the Artifactory server details.")
+	rtDetails, err := credentials.CreateArtifactoryDetailsByFlags(c)
+	if err != nil {
+		return err
 	}
-	rtCurlCommand.SetServerDetails(rtDetails)
-	rtCurlCommand.SetUrl(rtDetails.ArtifactoryUrl)
-	return rtCurlCommand, err
+	webhookCreateCmd := webhook.NewWebhookCreateCommand()
+	webhookCreateCmd.SetParams(webhook.WebhookParams{
+		Key:         c.GetArgumentAt(0),
+		Url:         c.GetArgumentAt(1),
+		Description: c.GetStringFlagValue("description"),
+		Secret:      c.GetStringFlagValue("secret"),
+		Domain:      getWebhookDomain(c),
+		EventTypes:  getCommaSeparatedFlagValue(c, "event-types"),
+		Names:       getCommaSeparatedFlagValue(c, "names"),
+	}).SetServerDetails(rtDetails)
+	return commands.Exec(webhookCreateCmd)
 }
 
-func repoTemplateCmd(c *components.Context) error {
-	if c.GetNumberOfArgs() != 1 {
+func webhookListCmd(c *components.Context) error {
+	if c.GetNumberOfArgs() != 0 {
 		return common.WrongNumberOfArgumentsHandler(c)
 	}
-
-	// Run command.
-	repoTemplateCmd := repository.NewRepoTemplateCommand()
-	repoTemplateCmd.SetTemplatePath(c.GetArgumentAt(0))
-	return commands.Exec(repoTemplateCmd)
+	rtDetails, err := credentials.CreateArtifactoryDetailsByFlags(c)
+	if err != nil {
+		return err
+	}
+	webhookListCmd := webhook.NewWebhookListCommand()
+	webhookListCmd.SetServerDetails(rtDetails)
+	return commands.Exec(webhookListCmd)
 }
 
-func repoCreateCmd(c *components.Context) error {
+func webhookDeleteCmd(c *components.Context) error {
 	if c.GetNumberOfArgs() != 1 {
 		return common.WrongNumberOfArgumentsHandler(c)
 	}
-
-	rtDetails, err := common.CreateArtifactoryDetailsByFlags(c)
+	rtDetails, err := credentials.CreateArtifactoryDetailsByFlags(c)
 	if err != nil {
 		return err
 	}
-
-	// Run command.
-	repoCreateCmd := repository.NewRepoCreateCommand()
-	repoCreateCmd.SetTemplatePath(c.GetArgumentAt(0)).SetServerDetails(rtDetails).SetVars(c.GetStringFlagValue("vars"))
-	return commands.Exec(repoCreateCmd)
+	webhookDeleteCmd := webhook.NewWebhookDeleteCommand()
+	webhookDeleteCmd.SetKey(c.GetArgumentAt(0)).SetQuiet(common.GetQuietValue(c)).SetServerDetails(rtDetails).SetDryRun(c.GetBoolFlagValue("dry-run"))
+	return commands.Exec(webhookDeleteCmd)
 }
 
-func repoUpdateCmd(c *components.Context) error {
-	if c.GetNumberOfArgs() != 1 {
+func webhookListenCmd(c *components.Context) error {
+	if c.GetNumberOfArgs() != 0 {
 		return common.WrongNumberOfArgumentsHandler(c)
 	}
-
-	rtDetails, err := common.CreateArtifactoryDetailsByFlags(c)
+	rtDetails, err := credentials.CreateArtifactoryDetailsByFlags(c)
 	if err != nil {
 		return err
 	}
-
-	// Run command.
-	repoUpdateCmd := repository.NewRepoUpdateCommand()
-	repoUpdateCmd.SetTemplatePath(c.GetArgumentAt(0)).SetServerDetails(rtDetails).SetVars(c.GetStringFlagValue("vars"))
-	return commands.Exec(repoUpdateCmd)
+	port := webhook.DefaultListenPort
+	if c.GetStringFlagValue("port") != "" {
+		if port, err = strconv.Atoi(c.GetStringFlagValue("port")); err != nil {
+			return errors.New("The '--port' option should have a numeric value. " + common.GetDocumentationMessage())
+		}
+	}
+	webhookListenCmd := webhook.NewWebhookListenCommand()
+	webhookListenCmd.SetTunnelUrl(c.GetStringFlagValue("tunnel-url")).SetPort(port).SetDomain(getWebhookDomain(c)).
+		SetEventTypes(getCommaSeparatedFlagValue(c, "event-types")).SetNames(getCommaSeparatedFlagValue(c, "names")).
+		SetServerDetails(rtDetails)
+	return commands.Exec(webhookListenCmd)
 }
 
-func repoDeleteCmd(c *components.Context) error {
-	if c.GetNumberOfArgs() != 1 {
+func storageReportCmd(c *components.Context) error {
+	if c.GetNumberOfArgs() != 0 {
 		return common.WrongNumberOfArgumentsHandler(c)
 	}
-
-	rtDetails, err := common.CreateArtifactoryDetailsByFlags(c)
+	rtDetails, err := credentials.CreateArtifactoryDetailsByFlags(c)
 	if err != nil {
 		return err
 	}
+	storageReportCmd := storage.NewStorageReportCommand()
+	if c.GetStringFlagValue("group-by") != "" {
+		storageReportCmd.SetGroupBy(c.GetStringFlagValue("group-by"))
+	}
+	if c.GetStringFlagValue("sort") != "" {
+		storageReportCmd.SetSortBy(c.GetStringFlagValue("sort"))
+	}
+	if c.GetStringFlagValue("format") != "" {
+		storageReportCmd.SetFormat(c.GetStringFlagValue("format"))
+	}
+	if c.GetStringFlagValue("min-size") != "" {
+		minSize, err := storage.ParseSize(c.GetStringFlagValue("min-size"))
+		if err != nil {
+			return err
+		}
+		storageReportCmd.SetMinSize(minSize)
+	}
+	if c.GetStringFlagValue("fail-above") != "" {
+		failAbove, err := storage.ParseSize(c.GetStringFlagValue("fail-above"))
+		if err != nil {
+			return err
+		}
+		storageReportCmd.SetFailAbove(failAbove)
+	}
+	if c.GetStringFlagValue("wait-timeout") != "" {
+		minutes, err := strconv.Atoi(c.GetStringFlagValue("wait-timeout"))
+		if err != nil || minutes <= 0 {
+			return errors.New("The '--wait-timeout' option should have a positive numeric value. " + common.GetDocumentationMessage())
+		}
+		storageReportCmd.SetRecalculateTimeout(time.Duration(minutes) * time.Minute)
+	}
+	storageReportCmd.SetRecalculate(c.GetBoolFlagValue("recalculate")).SetServerDetails(rtDetails)
+	return commands.Exec(storageReportCmd)
+}
 
-	repoDeleteCmd := repository.NewRepoDeleteCommand()
-	repoDeleteCmd.SetRepoPattern(c.GetArgumentAt(0)).SetServerDetails(rtDetails).SetQuiet(common.GetQuietValue(c))
-	return commands.Exec(repoDeleteCmd)
+func debDeployCmd(c *components.Context) error {
+	return packageDeployCmd(c, linuxpackage.Debian)
 }
 
-func replicationTemplateCmd(c *components.Context) error {
-	if c.GetNumberOfArgs() != 1 {
-		return common.WrongNumberOfArgumentsHandler(c)
-	}
-	replicationTemplateCmd := replication.NewReplicationTemplateCommand()
-	replicationTemplateCmd.SetTemplatePath(c.GetArgumentAt(0))
-	return commands.Exec(replicationTemplateCmd)
+func rpmDeployCmd(c *components.Context) error {
+	return packageDeployCmd(c, linuxpackage.Rpm)
 }
 
-func replicationCreateCmd(c *components.Context) error {
-	if c.GetNumberOfArgs() != 1 {
+func packageDeployCmd(c *components.Context, packageType string) error {
+	if c.GetNumberOfArgs() != 2 {
 		return common.WrongNumberOfArgumentsHandler(c)
 	}
-	rtDetails, err := common.CreateArtifactoryDetailsByFlags(c)
+	rtDetails, err := credentials.CreateArtifactoryDetailsByFlags(c)
 	if err != nil {
 		return err
 	}
-	replicationCreateCmd := replication.NewReplicationCreateCommand()
-	replicationCreateCmd.SetTemplatePath(c.GetArgumentAt(0)).SetServerDetails(rtDetails).SetVars(c.GetStringFlagValue("vars"))
-	return commands.Exec(replicationCreateCmd)
+	packageDeployCmd := linuxpackage.NewPackageDeployCommand(packageType)
+	if packageType == linuxpackage.Debian {
+		if c.GetStringFlagValue("distribution") == "" {
+			return errors.New("The '--distribution' option is mandatory. " + common.GetDocumentationMessage())
+		}
+		packageDeployCmd.SetDistributions(getCommaSeparatedFlagValue(c, "distribution")).SetArchitecture(c.GetStringFlagValue("architecture"))
+		if c.GetStringFlagValue("component") != "" {
+			packageDeployCmd.SetComponent(c.GetStringFlagValue("component"))
+		}
+	}
+	if c.GetStringFlagValue("wait-timeout") != "" {
+		minutes, err := strconv.Atoi(c.GetStringFlagValue("wait-timeout"))
+		if err != nil || minutes <= 0 {
+			return errors.New("The '--wait-timeout' option should have a positive numeric value. " + common.GetDocumentationMessage())
+		}
+		packageDeployCmd.SetWaitTimeout(time.Duration(minutes) * time.Minute)
+	}
+	packageDeployCmd.SetPattern(c.GetArgumentAt(0)).SetTarget(c.GetArgumentAt(1)).SetWait(!c.GetBoolFlagValue("no-wait")).SetServerDetails(rtDetails)
+	return commands.Exec(packageDeployCmd)
 }
 
-func replicationDeleteCmd(c *components.Context) error {
-	if c.GetNumberOfArgs() != 1 {
-		return common.WrongNumberOfArgumentsHandler(c)
+// getCommaSeparatedFlagValue returns the values of a comma-separated option, or nil if not set.
+func getCommaSeparatedFlagValue(c *components.Context, flagName string) []string {
+	if !c.IsFlagSet(flagName) {
+		return nil
 	}
-	rtDetails, err := common.CreateArtifactoryDetailsByFlags(c)
-	if err != nil {
-		return err
+	return strings.Split(c.GetStringFlagValue(flagName), ",")
+}
+
+func getWebhookDomain(c *components.Context) string {
+	if domain := c.GetStringFlagValue("domain"); domain != "" {
+		return domain
 	}
-	replicationDeleteCmd := replication.NewReplicationDeleteCommand()
-	replicationDeleteCmd.SetRepoKey(c.GetArgumentAt(0)).SetServerDetails(rtDetails).SetQuiet(common.GetQuietValue(c))
-	return commands.Exec(replicationDeleteCmd)
+	return webhook.ArtifactDomain
 }
 
 func createDefaultCopyMoveSpec(c *components.Context) (*spec.SpecFiles, error) {
@@ -1920,6 +4530,18 @@ func fixWinPathsForDownloadCmd(uploadSpec *spec.SpecFiles, c *components.Context
 	}
 }
 
+// Returns the raw AQL query held by the file of the aql-file option, or an empty string if the option isn't set.
+func getRawAql(c *components.Context) (string, error) {
+	if !c.IsFlagSet("aql-file") {
+		return "", nil
+	}
+	query, err := os.ReadFile(c.GetStringFlagValue("aql-file"))
+	if err != nil {
+		return "", errorutils.CheckError(err)
+	}
+	return strings.TrimSpace(string(query)), nil
+}
+
 func getOffsetAndLimitValues(c *components.Context) (offset, limit int, err error) {
 	offset, err = c.WithDefaultIntFlagValue("offset", 0)
 	if err != nil {
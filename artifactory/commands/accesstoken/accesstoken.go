@@ -0,0 +1,107 @@
+// Package accesstoken manages the lifecycle of the access tokens of the JFrog Platform: creating scoped tokens,
+// exchanging the OIDC identity tokens of CI jobs for access tokens, refreshing and revoking them. The obtained tokens
+// can be saved as the credentials of a server in the JFrog CLI configuration.
+package accesstoken
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+
+	artifactoryutils "github.com/jfrog/jfrog-cli-artifactory/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/auth"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const tokensApi = "api/v1/tokens/"
+
+// handleToken saves the token to the CLI configuration if saveServerId is set, and prints the response otherwise.
+func handleToken(serverDetails *config.ServerDetails, saveServerId string, token auth.CommonTokenParams, username string, response any) error {
+	if saveServerId == "" {
+		content, err := json.MarshalIndent(response, "", "  ")
+		if err != nil {
+			return errorutils.CheckError(err)
+		}
+		log.Output(string(content))
+		return nil
+	}
+	if err := saveToken(serverDetails, saveServerId, token, username); err != nil {
+		return err
+	}
+	log.Info("The access token was saved to the server", saveServerId)
+	return nil
+}
+
+// saveToken sets the token as the credentials of a server in the CLI configuration. A server which isn't configured yet
+// is added with the URLs of the command's server, and becomes the default server if no other server is configured.
+func saveToken(serverDetails *config.ServerDetails, serverId string, token auth.CommonTokenParams, username string) error {
+	configs, err := config.GetAllServersConfigs()
+	if err != nil {
+		return err
+	}
+	var saved *config.ServerDetails
+	for _, serverConfig := range configs {
+		if serverConfig.ServerId == serverId {
+			saved = serverConfig
+			break
+		}
+	}
+	if saved == nil {
+		saved = &config.ServerDetails{
+			ServerId:       serverId,
+			Url:            artifactoryutils.WithPlatformUrl(serverDetails).Url,
+			ArtifactoryUrl: serverDetails.ArtifactoryUrl,
+			IsDefault:      len(configs) == 0,
+		}
+		configs = append(configs, saved)
+	}
+	if username == "" {
+		username = auth.ExtractUsernameFromAccessToken(token.AccessToken)
+	}
+	saved.User = username
+	saved.Password = ""
+	saved.AccessToken = token.AccessToken
+	saved.RefreshToken = token.RefreshToken
+	saved.ArtifactoryRefreshToken = ""
+	return config.SaveServersConf(configs)
+}
+
+// removeToken removes the token from the credentials of a server in the CLI configuration, if the server uses it.
+func removeToken(serverId, accessToken string) error {
+	configs, err := config.GetAllServersConfigs()
+	if err != nil {
+		return err
+	}
+	for _, serverConfig := range configs {
+		if serverConfig.ServerId == serverId && serverConfig.AccessToken == accessToken {
+			serverConfig.AccessToken = ""
+			serverConfig.RefreshToken = ""
+			return config.SaveServersConf(configs)
+		}
+	}
+	return nil
+}
+
+// extractTokenId returns the ID of an access token, which is kept in its 'jti' claim.
+func extractTokenId(accessToken string) (string, error) {
+	tokenParts := strings.Split(accessToken, ".")
+	if len(tokenParts) != 3 {
+		return "", errorutils.CheckErrorf("the access token isn't a JSON web token, and its ID can't be extracted. Provide the token ID instead")
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(tokenParts[1], "="))
+	if err != nil {
+		return "", errorutils.CheckError(err)
+	}
+	var claims struct {
+		JwtId string `json:"jti"`
+	}
+	if err = json.Unmarshal(payload, &claims); err != nil {
+		return "", errorutils.CheckError(err)
+	}
+	if claims.JwtId == "" {
+		return "", errorutils.CheckErrorf("the access token has no ID")
+	}
+	return claims.JwtId, nil
+}
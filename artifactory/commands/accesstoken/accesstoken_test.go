@@ -0,0 +1,132 @@
+package accesstoken
+
+import (
+	"encoding/base64"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/cienv"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
+	"github.com/jfrog/jfrog-client-go/auth"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestToken(payload string) string {
+	return "header." + base64.RawURLEncoding.EncodeToString([]byte(payload)) + ".signature"
+}
+
+func TestExtractTokenId(t *testing.T) {
+	tokenId, err := extractTokenId(createTestToken(`{"sub":"jfac@01/users/alice","jti":"8a6f-41c2"}`))
+	require.NoError(t, err)
+	assert.Equal(t, "8a6f-41c2", tokenId)
+
+	_, err = extractTokenId("reference-token")
+	assert.ErrorContains(t, err, "isn't a JSON web token")
+	_, err = extractTokenId(createTestToken(`{"sub":"jfac@01/users/alice"}`))
+	assert.ErrorContains(t, err, "has no ID")
+}
+
+func TestSaveToken(t *testing.T) {
+	t.Setenv(coreutils.HomeDir, t.TempDir())
+	serverDetails := &config.ServerDetails{ArtifactoryUrl: "https://acme.jfrog.io/artifactory/"}
+	require.NoError(t, saveToken(serverDetails, "ci", auth.CommonTokenParams{AccessToken: "token-1", RefreshToken: "refresh-1"}, "alice"))
+
+	saved, err := config.GetSpecificConfig("ci", false, false)
+	require.NoError(t, err)
+	assert.Equal(t, "https://acme.jfrog.io/", saved.Url)
+	assert.Equal(t, "https://acme.jfrog.io/artifactory/", saved.ArtifactoryUrl)
+	assert.Equal(t, "alice", saved.User)
+	assert.Equal(t, "token-1", saved.AccessToken)
+	assert.Equal(t, "refresh-1", saved.RefreshToken)
+	assert.True(t, saved.IsDefault)
+
+	// The credentials of a configured server are replaced.
+	require.NoError(t, saveToken(serverDetails, "ci", auth.CommonTokenParams{AccessToken: "token-2"}, "alice"))
+	saved, err = config.GetSpecificConfig("ci", false, false)
+	require.NoError(t, err)
+	assert.Equal(t, "token-2", saved.AccessToken)
+	assert.Empty(t, saved.RefreshToken)
+
+	require.NoError(t, removeToken("ci", "token-2"))
+	saved, err = config.GetSpecificConfig("ci", false, false)
+	require.NoError(t, err)
+	assert.Empty(t, saved.AccessToken)
+}
+
+func TestGetExchangeParams(t *testing.T) {
+	idTokenServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer request-token", r.Header.Get("Authorization"))
+		assert.Equal(t, "jfrog-github", r.URL.Query().Get("audience"))
+		_, err := w.Write([]byte(`{"value":"github-id-token"}`))
+		assert.NoError(t, err)
+	}))
+	defer idTokenServer.Close()
+	t.Setenv("ACTIONS_ID_TOKEN_REQUEST_URL", idTokenServer.URL+"/token?api-version=2.0")
+	t.Setenv("ACTIONS_ID_TOKEN_REQUEST_TOKEN", "request-token")
+	t.Setenv("GITHUB_RUN_ID", "99")
+	t.Setenv("GITHUB_JOB", "build")
+
+	ciEnv := &cienv.Environment{System: "github", VcsUrl: "https://github.com/org/app.git", VcsRevision: "abc", VcsBranch: "main"}
+	command := NewOidcTokenExchangeCommand().SetProviderName("github-integration").SetAudience("jfrog-github").SetProjectKey("acme")
+	params, err := command.getExchangeParams(ciEnv)
+	require.NoError(t, err)
+	assert.Equal(t, "github-id-token", params.OidcTokenID)
+	assert.Equal(t, "GitHub", params.ProviderType)
+	assert.Equal(t, "github-integration", params.ProviderName)
+	assert.Equal(t, tokenExchangeGrantType, params.GrantType)
+	assert.Equal(t, "acme", params.ProjectKey)
+	assert.Equal(t, "99", params.RunId)
+	assert.Equal(t, "build", params.JobId)
+	assert.Equal(t, "https://github.com/org/app.git", params.Repo)
+	assert.Equal(t, "main", params.Branch)
+
+	t.Setenv(DefaultGitLabIdTokenEnv, "gitlab-id-token")
+	params, err = command.SetProvider(GitLabProvider).getExchangeParams(nil)
+	require.NoError(t, err)
+	assert.Equal(t, "gitlab-id-token", params.OidcTokenID)
+	assert.Equal(t, "GenericOidc", params.ProviderType)
+
+	t.Setenv("CIRCLE_OIDC_TOKEN", "circleci-id-token")
+	t.Setenv("CIRCLE_OIDC_TOKEN_V2", "")
+	params, err = command.SetProvider(CircleCIProvider).getExchangeParams(nil)
+	require.NoError(t, err)
+	assert.Equal(t, "circleci-id-token", params.OidcTokenID)
+
+	t.Setenv("CUSTOM_ID_TOKEN", "custom-id-token")
+	params, err = command.SetIdTokenEnv("CUSTOM_ID_TOKEN").getExchangeParams(nil)
+	require.NoError(t, err)
+	assert.Equal(t, "custom-id-token", params.OidcTokenID)
+
+	_, err = NewOidcTokenExchangeCommand().SetProvider("jenkins").getExchangeParams(nil)
+	assert.ErrorContains(t, err, "unsupported OIDC provider 'jenkins'")
+	_, err = NewOidcTokenExchangeCommand().getExchangeParams(&cienv.Environment{System: "jenkins"})
+	assert.ErrorContains(t, err, "couldn't detect a CI system")
+}
+
+func TestTokenRefresh(t *testing.T) {
+	t.Setenv(coreutils.HomeDir, t.TempDir())
+	oldToken := createTestToken(`{"sub":"jfac@01/users/alice","exp":4102444800,"jti":"old"}`)
+	accessServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/access/api/v1/tokens", r.URL.Path)
+		content, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"access_token":"`+oldToken+`","refresh_token":"old-refresh","grant_type":"refresh_token","refreshable":true}`, string(content))
+		_, err = w.Write([]byte(`{"access_token":"new-token","refresh_token":"new-refresh"}`))
+		assert.NoError(t, err)
+	}))
+	defer accessServer.Close()
+
+	serverDetails := &config.ServerDetails{ServerId: "ci", Url: accessServer.URL + "/", User: "alice", AccessToken: oldToken, RefreshToken: "old-refresh"}
+	require.NoError(t, NewTokenRefreshCommand().SetServerDetails(serverDetails).Run())
+	saved, err := config.GetSpecificConfig("ci", false, false)
+	require.NoError(t, err)
+	assert.Equal(t, "new-token", saved.AccessToken)
+	assert.Equal(t, "new-refresh", saved.RefreshToken)
+
+	err = NewTokenRefreshCommand().SetServerDetails(&config.ServerDetails{Url: accessServer.URL + "/", AccessToken: oldToken}).Run()
+	assert.ErrorContains(t, err, "requires the access token and its refresh token")
+}
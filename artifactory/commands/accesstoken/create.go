@@ -0,0 +1,116 @@
+package accesstoken
+
+import (
+	"strings"
+
+	artifactoryutils "github.com/jfrog/jfrog-cli-artifactory/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/access/services"
+	"github.com/jfrog/jfrog-client-go/auth"
+	clientutils "github.com/jfrog/jfrog-client-go/utils"
+)
+
+// TokenCreateCommand creates a scoped access token with the Access API.
+type TokenCreateCommand struct {
+	serverDetails *config.ServerDetails
+	username      string
+	scope         string
+	projectKey    string
+	description   string
+	audience      string
+	expiry        *uint
+	refreshable   bool
+	saveServerId  string
+}
+
+func NewTokenCreateCommand() *TokenCreateCommand {
+	return &TokenCreateCommand{}
+}
+
+// SetUsername sets the subject of the token. If not set, the token is created for the user of the command.
+func (tcc *TokenCreateCommand) SetUsername(username string) *TokenCreateCommand {
+	tcc.username = username
+	return tcc
+}
+
+// SetScope sets the scope of the token, such as 'applied-permissions/groups:readers'. If not set, the token is
+// user-scoped.
+func (tcc *TokenCreateCommand) SetScope(scope string) *TokenCreateCommand {
+	tcc.scope = scope
+	return tcc
+}
+
+func (tcc *TokenCreateCommand) SetProjectKey(projectKey string) *TokenCreateCommand {
+	tcc.projectKey = projectKey
+	return tcc
+}
+
+func (tcc *TokenCreateCommand) SetDescription(description string) *TokenCreateCommand {
+	tcc.description = description
+	return tcc
+}
+
+func (tcc *TokenCreateCommand) SetAudience(audience string) *TokenCreateCommand {
+	tcc.audience = audience
+	return tcc
+}
+
+// SetExpiry sets the time in seconds for which the token is valid. If nil, the default expiry of the platform is used.
+func (tcc *TokenCreateCommand) SetExpiry(expiry *uint) *TokenCreateCommand {
+	tcc.expiry = expiry
+	return tcc
+}
+
+func (tcc *TokenCreateCommand) SetRefreshable(refreshable bool) *TokenCreateCommand {
+	tcc.refreshable = refreshable
+	return tcc
+}
+
+// SetSaveServerId sets the ID of the server to save the token to in the CLI configuration. If not set, the token is printed.
+func (tcc *TokenCreateCommand) SetSaveServerId(saveServerId string) *TokenCreateCommand {
+	tcc.saveServerId = saveServerId
+	return tcc
+}
+
+func (tcc *TokenCreateCommand) SetServerDetails(serverDetails *config.ServerDetails) *TokenCreateCommand {
+	tcc.serverDetails = serverDetails
+	return tcc
+}
+
+func (tcc *TokenCreateCommand) ServerDetails() (*config.ServerDetails, error) {
+	return tcc.serverDetails, nil
+}
+
+func (tcc *TokenCreateCommand) CommandName() string {
+	return "rt_token_create"
+}
+
+func (tcc *TokenCreateCommand) Run() error {
+	accessManager, _, err := artifactoryutils.CreateAccessServiceManager(tcc.serverDetails)
+	if err != nil {
+		return err
+	}
+	response, err := accessManager.CreateAccessToken(tcc.getTokenParams())
+	if err != nil {
+		return err
+	}
+	return handleToken(tcc.serverDetails, tcc.saveServerId, response.CommonTokenParams, tcc.username, response)
+}
+
+func (tcc *TokenCreateCommand) getTokenParams() services.CreateTokenParams {
+	tokenParams := services.CreateTokenParams{
+		CommonTokenParams: auth.CommonTokenParams{
+			Scope:     tcc.scope,
+			ExpiresIn: tcc.expiry,
+			Audience:  tcc.audience,
+		},
+		// Access expects the username to be lower-cased.
+		Username:    strings.ToLower(tcc.username),
+		ProjectKey:  tcc.projectKey,
+		Description: tcc.description,
+	}
+	if tcc.refreshable {
+		tokenParams.Refreshable = clientutils.Pointer(true)
+	}
+	return tokenParams
+}
@@ -0,0 +1,220 @@
+package accesstoken
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+
+	artifactoryutils "github.com/jfrog/jfrog-cli-artifactory/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/cienv"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clierrors"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/access/services"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const (
+	GitHubProvider   = "github"
+	GitLabProvider   = "gitlab"
+	CircleCIProvider = "circleci"
+
+	// The environment variable from which the GitLab identity token is read, unless another variable is set. GitLab
+	// provides the identity tokens declared by the 'id_tokens' of the job in the environment variables they are named by.
+	DefaultGitLabIdTokenEnv = "JFROG_OIDC_TOKEN"
+
+	// #nosec G101 -- Not credentials, the OAuth token exchange identifiers.
+	tokenExchangeGrantType = "urn:ietf:params:oauth:grant-type:token-exchange"
+	idTokenType            = "urn:ietf:params:oauth:token-type:id_token"
+)
+
+// oidcProvider describes how a CI system provides the identity token and the IDs of the running job.
+type oidcProvider struct {
+	// The provider type of the OIDC integration in the JFrog Platform.
+	providerType string
+	runIdEnv     string
+	jobIdEnv     string
+	getIdToken   func(audience string) (string, error)
+}
+
+var oidcProviders = map[string]oidcProvider{
+	GitHubProvider:   {providerType: "GitHub", runIdEnv: "GITHUB_RUN_ID", jobIdEnv: "GITHUB_JOB", getIdToken: getGitHubIdToken},
+	GitLabProvider:   {providerType: "GenericOidc", runIdEnv: "CI_PIPELINE_ID", jobIdEnv: "CI_JOB_ID", getIdToken: getEnvIdToken(DefaultGitLabIdTokenEnv)},
+	CircleCIProvider: {providerType: "GenericOidc", runIdEnv: "CIRCLE_WORKFLOW_ID", jobIdEnv: "CIRCLE_JOB", getIdToken: getCircleCIIdToken},
+}
+
+// OidcTokenExchangeCommand exchanges the OIDC identity token of the running CI job for an access token, so that pipelines
+// access the JFrog Platform without stored secrets.
+type OidcTokenExchangeCommand struct {
+	serverDetails *config.ServerDetails
+	provider      string
+	providerName  string
+	audience      string
+	projectKey    string
+	idTokenEnv    string
+	saveServerId  string
+}
+
+func NewOidcTokenExchangeCommand() *OidcTokenExchangeCommand {
+	return &OidcTokenExchangeCommand{}
+}
+
+// SetProvider sets the CI system which issues the identity token: github, gitlab or circleci. If not set, the CI system
+// the CLI runs in is detected.
+func (otec *OidcTokenExchangeCommand) SetProvider(provider string) *OidcTokenExchangeCommand {
+	otec.provider = provider
+	return otec
+}
+
+// SetProviderName sets the name of the OIDC integration in the JFrog Platform.
+func (otec *OidcTokenExchangeCommand) SetProviderName(providerName string) *OidcTokenExchangeCommand {
+	otec.providerName = providerName
+	return otec
+}
+
+// SetAudience sets the audience of the identity token, as expected by the OIDC integration.
+func (otec *OidcTokenExchangeCommand) SetAudience(audience string) *OidcTokenExchangeCommand {
+	otec.audience = audience
+	return otec
+}
+
+func (otec *OidcTokenExchangeCommand) SetProjectKey(projectKey string) *OidcTokenExchangeCommand {
+	otec.projectKey = projectKey
+	return otec
+}
+
+// SetIdTokenEnv sets the environment variable which holds the identity token, instead of obtaining it from the CI system.
+func (otec *OidcTokenExchangeCommand) SetIdTokenEnv(idTokenEnv string) *OidcTokenExchangeCommand {
+	otec.idTokenEnv = idTokenEnv
+	return otec
+}
+
+// SetSaveServerId sets the ID of the server to save the token to in the CLI configuration. If not set, the token is printed.
+func (otec *OidcTokenExchangeCommand) SetSaveServerId(saveServerId string) *OidcTokenExchangeCommand {
+	otec.saveServerId = saveServerId
+	return otec
+}
+
+func (otec *OidcTokenExchangeCommand) SetServerDetails(serverDetails *config.ServerDetails) *OidcTokenExchangeCommand {
+	otec.serverDetails = serverDetails
+	return otec
+}
+
+func (otec *OidcTokenExchangeCommand) ServerDetails() (*config.ServerDetails, error) {
+	return otec.serverDetails, nil
+}
+
+func (otec *OidcTokenExchangeCommand) CommandName() string {
+	return "rt_oidc_token_exchange"
+}
+
+func (otec *OidcTokenExchangeCommand) Run() error {
+	if otec.providerName == "" {
+		return errorutils.CheckErrorf("the name of the OIDC integration in the JFrog Platform is required")
+	}
+	params, err := otec.getExchangeParams(cienv.Detect())
+	if err != nil {
+		return err
+	}
+	accessManager, _, err := artifactoryutils.CreateAccessServiceManager(otec.serverDetails)
+	if err != nil {
+		return err
+	}
+	response, err := accessManager.ExchangeOidcToken(params)
+	if err != nil {
+		return err
+	}
+	log.Info("Exchanged the identity token for an access token of", response.Username)
+	return handleToken(otec.serverDetails, otec.saveServerId, response.CommonTokenParams, response.Username, response)
+}
+
+func (otec *OidcTokenExchangeCommand) getExchangeParams(ciEnv *cienv.Environment) (params services.CreateOidcTokenParams, err error) {
+	providerKey := otec.provider
+	if providerKey == "" && ciEnv != nil {
+		providerKey = ciEnv.System
+	}
+	provider, ok := oidcProviders[providerKey]
+	if !ok {
+		if otec.provider == "" {
+			return params, errorutils.CheckErrorf("couldn't detect a CI system which provides OIDC identity tokens. Set the provider to github, gitlab or circleci")
+		}
+		return params, errorutils.CheckErrorf("unsupported OIDC provider '%s'. The supported providers are github, gitlab and circleci", otec.provider)
+	}
+	params = services.CreateOidcTokenParams{
+		GrantType:        tokenExchangeGrantType,
+		SubjectTokenType: idTokenType,
+		ProviderName:     otec.providerName,
+		ProviderType:     provider.providerType,
+		ProjectKey:       otec.projectKey,
+		Audience:         otec.audience,
+		RunId:            os.Getenv(provider.runIdEnv),
+		JobId:            os.Getenv(provider.jobIdEnv),
+	}
+	if ciEnv != nil {
+		params.Repo = ciEnv.VcsUrl
+		params.Revision = ciEnv.VcsRevision
+		params.Branch = ciEnv.VcsBranch
+	}
+	if otec.idTokenEnv != "" {
+		params.OidcTokenID, err = getEnvIdToken(otec.idTokenEnv)(otec.audience)
+	} else {
+		params.OidcTokenID, err = provider.getIdToken(otec.audience)
+	}
+	return
+}
+
+func getEnvIdToken(idTokenEnv string) func(string) (string, error) {
+	return func(string) (string, error) {
+		if idToken := os.Getenv(idTokenEnv); idToken != "" {
+			return idToken, nil
+		}
+		return "", errorutils.CheckErrorf("the identity token wasn't found in the %s environment variable", idTokenEnv)
+	}
+}
+
+// CircleCI provides the identity token of the job in environment variables. The second version includes the VCS details.
+func getCircleCIIdToken(audience string) (string, error) {
+	if idToken := os.Getenv("CIRCLE_OIDC_TOKEN_V2"); idToken != "" {
+		return idToken, nil
+	}
+	return getEnvIdToken("CIRCLE_OIDC_TOKEN")(audience)
+}
+
+// GitHub Actions issues identity tokens on request, to jobs which are granted the 'id-token: write' permission.
+func getGitHubIdToken(audience string) (string, error) {
+	requestUrl, requestToken := os.Getenv("ACTIONS_ID_TOKEN_REQUEST_URL"), os.Getenv("ACTIONS_ID_TOKEN_REQUEST_TOKEN")
+	if requestUrl == "" || requestToken == "" {
+		return "", errorutils.CheckErrorf("GitHub Actions didn't provide an identity token request URL. Make sure the workflow has the 'id-token: write' permission")
+	}
+	if audience != "" {
+		requestUrl += "&audience=" + url.QueryEscape(audience)
+	}
+	req, err := http.NewRequest(http.MethodGet, requestUrl, nil)
+	if err != nil {
+		return "", errorutils.CheckError(err)
+	}
+	req.Header.Set("Authorization", "Bearer "+requestToken)
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", errorutils.CheckError(err)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", errorutils.CheckError(err)
+	}
+	if err = clierrors.CheckResponseStatusWithBody(resp, body, http.StatusOK); err != nil {
+		return "", err
+	}
+	var idToken struct {
+		Value string `json:"value"`
+	}
+	if err = json.Unmarshal(body, &idToken); err != nil {
+		return "", errorutils.CheckError(err)
+	}
+	return idToken.Value, nil
+}
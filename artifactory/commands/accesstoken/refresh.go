@@ -0,0 +1,71 @@
+package accesstoken
+
+import (
+	artifactoryutils "github.com/jfrog/jfrog-cli-artifactory/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/access/services"
+	"github.com/jfrog/jfrog-client-go/auth"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+)
+
+// TokenRefreshCommand replaces an access token of the server with a new one, using its refresh token.
+type TokenRefreshCommand struct {
+	serverDetails *config.ServerDetails
+	refreshToken  string
+	saveServerId  string
+}
+
+func NewTokenRefreshCommand() *TokenRefreshCommand {
+	return &TokenRefreshCommand{}
+}
+
+// SetRefreshToken sets the refresh token to use. If not set, the refresh token of the server is used.
+func (trc *TokenRefreshCommand) SetRefreshToken(refreshToken string) *TokenRefreshCommand {
+	trc.refreshToken = refreshToken
+	return trc
+}
+
+// SetSaveServerId sets the ID of the server to save the new token to in the CLI configuration. If not set, the token is
+// saved to the configured server of the command, or printed if the server isn't configured.
+func (trc *TokenRefreshCommand) SetSaveServerId(saveServerId string) *TokenRefreshCommand {
+	trc.saveServerId = saveServerId
+	return trc
+}
+
+func (trc *TokenRefreshCommand) SetServerDetails(serverDetails *config.ServerDetails) *TokenRefreshCommand {
+	trc.serverDetails = serverDetails
+	return trc
+}
+
+func (trc *TokenRefreshCommand) ServerDetails() (*config.ServerDetails, error) {
+	return trc.serverDetails, nil
+}
+
+func (trc *TokenRefreshCommand) CommandName() string {
+	return "rt_token_refresh"
+}
+
+func (trc *TokenRefreshCommand) Run() error {
+	refreshToken := trc.refreshToken
+	if refreshToken == "" {
+		refreshToken = trc.serverDetails.RefreshToken
+	}
+	if refreshToken == "" || trc.serverDetails.AccessToken == "" {
+		return errorutils.CheckErrorf("refreshing a token requires the access token and its refresh token")
+	}
+	accessManager, _, err := artifactoryutils.CreateAccessServiceManager(trc.serverDetails)
+	if err != nil {
+		return err
+	}
+	response, err := accessManager.RefreshAccessToken(services.CreateTokenParams{
+		CommonTokenParams: auth.CommonTokenParams{AccessToken: trc.serverDetails.AccessToken, RefreshToken: refreshToken},
+	})
+	if err != nil {
+		return err
+	}
+	saveServerId := trc.saveServerId
+	if saveServerId == "" {
+		saveServerId = trc.serverDetails.ServerId
+	}
+	return handleToken(trc.serverDetails, saveServerId, response.CommonTokenParams, trc.serverDetails.User, response)
+}
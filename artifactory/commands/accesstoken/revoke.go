@@ -0,0 +1,90 @@
+package accesstoken
+
+import (
+	"net/http"
+	"net/url"
+
+	artifactoryutils "github.com/jfrog/jfrog-cli-artifactory/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clierrors"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// TokenRevokeCommand revokes an access token by its ID. If no ID is set, the access token of the server is revoked and
+// removed from the CLI configuration.
+type TokenRevokeCommand struct {
+	serverDetails *config.ServerDetails
+	tokenId       string
+	dryRun        bool
+}
+
+func NewTokenRevokeCommand() *TokenRevokeCommand {
+	return &TokenRevokeCommand{}
+}
+
+func (trc *TokenRevokeCommand) SetTokenId(tokenId string) *TokenRevokeCommand {
+	trc.tokenId = tokenId
+	return trc
+}
+
+// SetDryRun sets the command to resolve the ID of the token, without revoking it.
+func (trc *TokenRevokeCommand) SetDryRun(dryRun bool) *TokenRevokeCommand {
+	trc.dryRun = dryRun
+	return trc
+}
+
+func (trc *TokenRevokeCommand) SetServerDetails(serverDetails *config.ServerDetails) *TokenRevokeCommand {
+	trc.serverDetails = serverDetails
+	return trc
+}
+
+func (trc *TokenRevokeCommand) ServerDetails() (*config.ServerDetails, error) {
+	return trc.serverDetails, nil
+}
+
+func (trc *TokenRevokeCommand) CommandName() string {
+	return "rt_token_revoke"
+}
+
+func (trc *TokenRevokeCommand) Run() error {
+	tokenId := trc.tokenId
+	revokesOwnToken := tokenId == ""
+	if revokesOwnToken {
+		if trc.serverDetails.AccessToken == "" {
+			return errorutils.CheckErrorf("no token ID was provided, and the server has no access token to revoke")
+		}
+		var err error
+		if tokenId, err = extractTokenId(trc.serverDetails.AccessToken); err != nil {
+			return err
+		}
+	}
+	if trc.dryRun {
+		artifactoryutils.LogDryRun("Revoking the access token", tokenId)
+		if revokesOwnToken && trc.serverDetails.ServerId != "" {
+			artifactoryutils.LogDryRun("Removing the access token from the configuration of", trc.serverDetails.ServerId)
+		}
+		return nil
+	}
+	if err := revokeToken(trc.serverDetails, tokenId); err != nil {
+		return err
+	}
+	log.Info("Revoked the access token", tokenId)
+	if revokesOwnToken && trc.serverDetails.ServerId != "" {
+		return removeToken(trc.serverDetails.ServerId, trc.serverDetails.AccessToken)
+	}
+	return nil
+}
+
+func revokeToken(serverDetails *config.ServerDetails, tokenId string) error {
+	accessManager, serviceDetails, err := artifactoryutils.CreateAccessServiceManager(serverDetails)
+	if err != nil {
+		return err
+	}
+	httpClientDetails := serviceDetails.CreateHttpClientDetails()
+	resp, body, err := accessManager.Client().SendDelete(serviceDetails.GetUrl()+tokensApi+url.PathEscape(tokenId), nil, &httpClientDetails)
+	if err != nil {
+		return err
+	}
+	return clierrors.CheckResponseStatusWithBody(resp, body, http.StatusOK, http.StatusNoContent)
+}
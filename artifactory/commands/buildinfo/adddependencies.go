@@ -8,6 +8,10 @@ import (
 	ioutils "github.com/jfrog/gofrog/io"
 
 	buildinfo "github.com/jfrog/build-info-go/entities"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/checksumcache"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clientconfig"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/credentials"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/lockfile"
 	commandsutils "github.com/jfrog/jfrog-cli-core/v2/artifactory/commands/utils"
 	"github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
 	"github.com/jfrog/jfrog-cli-core/v2/common/build"
@@ -30,6 +34,10 @@ type BuildAddDependenciesCommand struct {
 	dryRun             bool
 	result             *commandsutils.Result
 	serverDetails      *config.ServerDetails
+	// A lockfile of a package manager, whose packages are added as dependencies instead of the files matching the spec.
+	lockfile string
+	// The repositories in which the packages of the lockfile are searched. If empty, all the repositories are searched.
+	lockfileRepos []string
 }
 
 func NewBuildAddDependenciesCommand() *BuildAddDependenciesCommand {
@@ -48,7 +56,7 @@ func (badc *BuildAddDependenciesCommand) ServerDetails() (*config.ServerDetails,
 	if badc.serverDetails != nil {
 		return badc.serverDetails, nil
 	}
-	return config.GetDefaultServerConf()
+	return credentials.GetDefaultServerConf()
 }
 
 func (badc *BuildAddDependenciesCommand) Run() error {
@@ -69,7 +77,10 @@ func (badc *BuildAddDependenciesCommand) Run() error {
 			return err
 		}
 	}
-	if badc.serverDetails != nil {
+	if badc.lockfile != "" {
+		log.Debug("Resolving the packages of", badc.lockfile, "on Artifactory...")
+		success, fail, err = badc.collectLockfileDependencies()
+	} else if badc.serverDetails != nil {
 		log.Debug("Searching dependencies on Artifactory...")
 		success, fail, err = badc.collectRemoteDependencies()
 	} else {
@@ -96,6 +107,16 @@ func (badc *BuildAddDependenciesCommand) SetServerDetails(serverDetails *config.
 	return badc
 }
 
+func (badc *BuildAddDependenciesCommand) SetLockfile(lockfile string) *BuildAddDependenciesCommand {
+	badc.lockfile = lockfile
+	return badc
+}
+
+func (badc *BuildAddDependenciesCommand) SetLockfileRepos(lockfileRepos []string) *BuildAddDependenciesCommand {
+	badc.lockfileRepos = lockfileRepos
+	return badc
+}
+
 func (badc *BuildAddDependenciesCommand) SetBuildConfiguration(buildConfiguration *build.BuildConfiguration) *BuildAddDependenciesCommand {
 	badc.buildConfiguration = buildConfiguration
 	return badc
@@ -103,6 +124,8 @@ func (badc *BuildAddDependenciesCommand) SetBuildConfiguration(buildConfiguratio
 
 func collectDependenciesChecksums(dependenciesPaths map[string]string) (map[string]*fileutils.FileDetails, int) {
 	failures := 0
+	cache := checksumcache.Load()
+	defer cache.SaveOrLog()
 	dependenciesDetails := make(map[string]*fileutils.FileDetails)
 	for _, dependencyPath := range dependenciesPaths {
 		var details *fileutils.FileDetails
@@ -112,7 +135,7 @@ func collectDependenciesChecksums(dependenciesPaths map[string]string) (map[stri
 			details, err = fspatterns.CreateSymlinkFileDetails()
 		} else {
 			log.Info("Adding dependency:", dependencyPath)
-			details, err = fileutils.GetFileDetails(dependencyPath, true)
+			details, err = cache.GetFileDetails(dependencyPath)
 		}
 		if err != nil {
 			log.Error(err)
@@ -145,7 +168,7 @@ func (badc *BuildAddDependenciesCommand) collectLocalDependencies() (success, fa
 }
 
 func (badc *BuildAddDependenciesCommand) collectRemoteDependencies() (success, fail int, err error) {
-	servicesManager, err := utils.CreateServiceManager(badc.serverDetails, -1, 0, false)
+	servicesManager, err := clientconfig.CreateServiceManager(badc.serverDetails, -1, 0, false)
 	if err != nil {
 		return
 	}
@@ -158,6 +181,44 @@ func (badc *BuildAddDependenciesCommand) collectRemoteDependencies() (success, f
 	return
 }
 
+// Adds the packages of the lockfile as dependencies, with the checksums of their files in Artifactory.
+// The packages which aren't found in Artifactory are counted as failures.
+func (badc *BuildAddDependenciesCommand) collectLockfileDependencies() (success, fail int, err error) {
+	if badc.serverDetails == nil {
+		return 0, 0, errorutils.CheckErrorf("adding the dependencies of a lockfile requires a server configuration")
+	}
+	packages, err := lockfile.Parse(badc.lockfile)
+	if err != nil {
+		return
+	}
+	servicesManager, err := clientconfig.CreateServiceManager(badc.serverDetails, -1, 0, false)
+	if err != nil {
+		return
+	}
+	dependencies, unresolved, err := lockfile.Resolve(servicesManager, packages, badc.lockfileRepos)
+	if err != nil {
+		return
+	}
+	for _, dependency := range dependencies {
+		log.Info("Adding dependency:", dependency.Id)
+	}
+	for _, unresolvedPackage := range unresolved {
+		log.Error("The", unresolvedPackage.Type, "package", unresolvedPackage.Id(), "was not found in Artifactory")
+	}
+	fail = len(unresolved)
+	if !badc.dryRun && len(dependencies) > 0 {
+		if err = badc.savePartialBuildInfo(dependencies); err != nil {
+			fail += len(dependencies)
+			return
+		}
+	}
+	success = len(dependencies)
+	if fail > 0 {
+		err = errors.New("build Add Dependencies command finished with errors. Please review the logs")
+	}
+	return
+}
+
 func (badc *BuildAddDependenciesCommand) doCollectLocalDependencies() (map[string]string, bool) {
 	errorOccurred := false
 	dependenciesPaths := make(map[string]string)
@@ -6,6 +6,7 @@ import (
 	buildinfo "github.com/jfrog/build-info-go/entities"
 	gofrogcmd "github.com/jfrog/gofrog/io"
 	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/credentials"
 	"github.com/jfrog/jfrog-cli-core/v2/common/build"
 	"github.com/jfrog/jfrog-cli-core/v2/common/project"
 	utilsconfig "github.com/jfrog/jfrog-cli-core/v2/utils/config"
@@ -30,6 +31,10 @@ type BuildAddGitCommand struct {
 	configFilePath     string
 	serverId           string
 	issuesConfig       *IssuesConfiguration
+	fromRef            string
+	fromTagPattern     string
+	changelogProperty  string
+	changelogTarget    string
 }
 
 func NewBuildAddGitCommand() *BuildAddGitCommand {
@@ -51,6 +56,32 @@ func (config *BuildAddGitCommand) SetDotGitPath(dotGitPath string) *BuildAddGitC
 	return config
 }
 
+// SetFromRef sets the git revision, tag or ref expression which the issues are collected from, instead of the revision
+// of the latest published build.
+func (config *BuildAddGitCommand) SetFromRef(fromRef string) *BuildAddGitCommand {
+	config.fromRef = fromRef
+	return config
+}
+
+// SetFromTagPattern sets the glob pattern of the tags, the latest of which the issues are collected from.
+func (config *BuildAddGitCommand) SetFromTagPattern(fromTagPattern string) *BuildAddGitCommand {
+	config.fromTagPattern = fromTagPattern
+	return config
+}
+
+// SetChangelogProperty sets the name of the build property which the changelog of the commit range is published as.
+func (config *BuildAddGitCommand) SetChangelogProperty(changelogProperty string) *BuildAddGitCommand {
+	config.changelogProperty = changelogProperty
+	return config
+}
+
+// SetChangelogTarget sets the path in Artifactory which the changelog of the commit range is uploaded to as a markdown
+// artifact of the build. A path ending with a slash is a folder, which the changelog is uploaded to as CHANGELOG.md.
+func (config *BuildAddGitCommand) SetChangelogTarget(changelogTarget string) *BuildAddGitCommand {
+	config.changelogTarget = changelogTarget
+	return config
+}
+
 func (config *BuildAddGitCommand) SetBuildConfiguration(buildConfiguration *build.BuildConfiguration) *BuildAddGitCommand {
 	config.buildConfiguration = buildConfiguration
 	return config
@@ -98,6 +129,16 @@ func (config *BuildAddGitCommand) Run() error {
 		}
 	}
 
+	// Collect and upload the changelog if required.
+	var changelog *Changelog
+	var changelogArtifacts []buildinfo.Artifact
+	if config.changelogProperty != "" || config.changelogTarget != "" {
+		changelog, changelogArtifacts, err = config.publishChangelog(buildName, buildNumber)
+		if err != nil {
+			return err
+		}
+	}
+
 	// Populate partials with VCS info.
 	populateFunc := func(partial *buildinfo.Partial) {
 		partial.VcsList = append(partial.VcsList, buildinfo.Vcs{
@@ -115,6 +156,18 @@ func (config *BuildAddGitCommand) Run() error {
 				AffectedIssues:         issues,
 			}
 		}
+
+		if config.changelogProperty != "" {
+			partial.Env = buildinfo.Env{config.changelogProperty: changelog.Markdown()}
+		}
+		if len(changelogArtifacts) > 0 {
+			partial.Artifacts = changelogArtifacts
+			partial.ModuleType = buildinfo.Generic
+			partial.ModuleId = config.buildConfiguration.GetModule()
+			if partial.ModuleId == "" {
+				partial.ModuleId = buildName
+			}
+		}
 	}
 	err = build.SavePartialBuildInfo(buildName, buildNumber, config.buildConfiguration.GetProject(), populateFunc)
 	if err != nil {
@@ -143,7 +196,7 @@ func (config *BuildAddGitCommand) ServerDetails() (*utilsconfig.ServerDetails, e
 		}
 		serverId = vConfig.GetString(ConfigIssuesPrefix + "serverID")
 	}
-	return utilsconfig.GetSpecificConfig(serverId, true, false)
+	return credentials.GetSpecificConfig(serverId, true, false)
 }
 
 func (config *BuildAddGitCommand) CommandName() string {
@@ -169,7 +222,7 @@ func (config *BuildAddGitCommand) collectBuildIssues() ([]buildinfo.AffectedIssu
 	}
 
 	// Run issues collection.
-	gitDetails := utils.GitLogDetails{DotGitPath: config.dotGitPath, LogLimit: config.issuesConfig.LogLimit, PrettyFormat: gitParsingPrettyFormat}
+	gitDetails := utils.GitLogDetails{DotGitPath: config.dotGitPath, LogLimit: config.issuesConfig.LogLimit, PrettyFormat: gitParsingPrettyFormat, FromRef: config.fromRef, FromTagPattern: config.fromTagPattern}
 	err = utils.ParseGitLogFromLastBuild(config.issuesConfig.ServerDetails, config.buildConfiguration, gitDetails, logRegExp)
 	if err != nil {
 		return nil, err
@@ -309,7 +362,7 @@ func (ic *IssuesConfiguration) populateIssuesConfigsFromSpec(configFilePath stri
 
 func (ic *IssuesConfiguration) setServerDetails() error {
 	// If no server-id provided, use default server.
-	serverDetails, err := utilsconfig.GetSpecificConfig(ic.ServerID, true, false)
+	serverDetails, err := credentials.GetSpecificConfig(ic.ServerID, true, false)
 	if err != nil {
 		return err
 	}
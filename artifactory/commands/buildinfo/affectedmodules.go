@@ -0,0 +1,260 @@
+package buildinfo
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-core/v2/common/build"
+	"github.com/jfrog/jfrog-cli-core/v2/common/project"
+	utilsconfig "github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const DefaultModulesManifestPath = ".jfrog/modules.yaml"
+
+// ModuleDefinition describes a module of a monorepo in the modules manifest.
+type ModuleDefinition struct {
+	Name string `mapstructure:"name"`
+	// Path globs, relative to the root of the repository. '**' matches any number of directories.
+	Paths []string `mapstructure:"paths"`
+	// Names of the modules this module depends on. A module is affected by changes to any of its dependencies.
+	DependsOn []string `mapstructure:"dependsOn"`
+}
+
+// BuildAffectedModulesCommand maps the files changed since the latest published build through the modules manifest,
+// and outputs the modules which need to be rebuilt.
+type BuildAffectedModulesCommand struct {
+	buildConfiguration *build.BuildConfiguration
+	serverDetails      *utilsconfig.ServerDetails
+	manifestPath       string
+	dotGitPath         string
+	fromRef            string
+	fromTagPattern     string
+	affectedModules    []string
+}
+
+func NewBuildAffectedModulesCommand() *BuildAffectedModulesCommand {
+	return &BuildAffectedModulesCommand{manifestPath: DefaultModulesManifestPath}
+}
+
+func (bamc *BuildAffectedModulesCommand) SetBuildConfiguration(buildConfiguration *build.BuildConfiguration) *BuildAffectedModulesCommand {
+	bamc.buildConfiguration = buildConfiguration
+	return bamc
+}
+
+func (bamc *BuildAffectedModulesCommand) SetServerDetails(serverDetails *utilsconfig.ServerDetails) *BuildAffectedModulesCommand {
+	bamc.serverDetails = serverDetails
+	return bamc
+}
+
+func (bamc *BuildAffectedModulesCommand) SetManifestPath(manifestPath string) *BuildAffectedModulesCommand {
+	if manifestPath != "" {
+		bamc.manifestPath = manifestPath
+	}
+	return bamc
+}
+
+// SetFromRef sets the git revision, tag or ref expression which the changed files are collected from, instead of the
+// revision of the latest published build.
+func (bamc *BuildAffectedModulesCommand) SetFromRef(fromRef string) *BuildAffectedModulesCommand {
+	bamc.fromRef = fromRef
+	return bamc
+}
+
+// SetFromTagPattern sets the glob pattern of the tags, the latest of which the changed files are collected from.
+func (bamc *BuildAffectedModulesCommand) SetFromTagPattern(fromTagPattern string) *BuildAffectedModulesCommand {
+	bamc.fromTagPattern = fromTagPattern
+	return bamc
+}
+
+func (bamc *BuildAffectedModulesCommand) SetDotGitPath(dotGitPath string) *BuildAffectedModulesCommand {
+	bamc.dotGitPath = dotGitPath
+	return bamc
+}
+
+func (bamc *BuildAffectedModulesCommand) AffectedModules() []string {
+	return bamc.affectedModules
+}
+
+func (bamc *BuildAffectedModulesCommand) CommandName() string {
+	return "rt_build_affected_modules"
+}
+
+func (bamc *BuildAffectedModulesCommand) ServerDetails() (*utilsconfig.ServerDetails, error) {
+	return bamc.serverDetails, nil
+}
+
+func (bamc *BuildAffectedModulesCommand) Run() error {
+	modules, err := ReadModulesManifest(bamc.manifestPath)
+	if err != nil {
+		return err
+	}
+
+	gitDetails := utils.GitLogDetails{DotGitPath: bamc.dotGitPath, FromRef: bamc.fromRef, FromTagPattern: bamc.fromTagPattern}
+	changedFiles, err := utils.GetChangedFilesFromLastBuild(bamc.serverDetails, bamc.buildConfiguration, gitDetails)
+	if err != nil {
+		var revisionRangeError utils.RevisionRangeError
+		if !errors.As(err, &revisionRangeError) {
+			return err
+		}
+		// The git history was modified since the latest build, so all modules are considered affected.
+		log.Warn(err.Error(), "All modules will be rebuilt.")
+		for _, module := range modules {
+			bamc.affectedModules = append(bamc.affectedModules, module.Name)
+		}
+	} else {
+		since := "the latest published build"
+		if bamc.fromRef != "" {
+			since = bamc.fromRef
+		} else if bamc.fromTagPattern != "" {
+			since = "the latest tag matching '" + bamc.fromTagPattern + "'"
+		}
+		log.Info(fmt.Sprintf("Found %d files changed since %s.", len(changedFiles), since))
+		if bamc.affectedModules, err = GetAffectedModules(modules, changedFiles); err != nil {
+			return err
+		}
+	}
+
+	for _, module := range bamc.affectedModules {
+		log.Output(module)
+	}
+	return nil
+}
+
+// ReadModulesManifest reads the modules from a YAML manifest of the following form:
+//
+//	modules:
+//	  - name: common
+//	    paths: ["libs/common/**"]
+//	  - name: api
+//	    paths: ["services/api/**"]
+//	    dependsOn: ["common"]
+func ReadModulesManifest(manifestPath string) ([]ModuleDefinition, error) {
+	vConfig, err := project.ReadConfigFile(manifestPath, project.YAML)
+	if err != nil {
+		return nil, err
+	}
+	if !vConfig.IsSet("modules") {
+		return nil, errorutils.CheckErrorf(MissingConfigurationError, "modules")
+	}
+	var modules []ModuleDefinition
+	if err = vConfig.UnmarshalKey("modules", &modules); err != nil {
+		return nil, errorutils.CheckErrorf(ConfigParseValueError, "modules", err.Error())
+	}
+	return modules, validateModules(modules)
+}
+
+func validateModules(modules []ModuleDefinition) error {
+	names := make(map[string]bool, len(modules))
+	for _, module := range modules {
+		if module.Name == "" {
+			return errorutils.CheckErrorf("all modules in the modules manifest must have a name")
+		}
+		if names[module.Name] {
+			return errorutils.CheckErrorf("module '%s' is defined more than once in the modules manifest", module.Name)
+		}
+		names[module.Name] = true
+	}
+	for _, module := range modules {
+		for _, dependency := range module.DependsOn {
+			if !names[dependency] {
+				return errorutils.CheckErrorf("module '%s' depends on the undefined module '%s'", module.Name, dependency)
+			}
+		}
+	}
+	return nil
+}
+
+// GetAffectedModules returns the names of the modules that own at least one of the changed files,
+// and of the modules which directly or transitively depend on them. Modules are returned in the manifest's order.
+func GetAffectedModules(modules []ModuleDefinition, changedFiles []string) ([]string, error) {
+	affected := make(map[string]bool)
+	dependents := make(map[string][]string)
+	for _, module := range modules {
+		for _, dependency := range module.DependsOn {
+			dependents[dependency] = append(dependents[dependency], module.Name)
+		}
+		matched, err := matchesAnyFile(module.Paths, changedFiles)
+		if err != nil {
+			return nil, err
+		}
+		if matched {
+			affected[module.Name] = true
+		}
+	}
+
+	// Propagate to the dependent modules.
+	var queue []string
+	for name := range affected {
+		queue = append(queue, name)
+	}
+	for len(queue) > 0 {
+		name := queue[0]
+		queue = queue[1:]
+		for _, dependent := range dependents[name] {
+			if !affected[dependent] {
+				affected[dependent] = true
+				queue = append(queue, dependent)
+			}
+		}
+	}
+
+	var result []string
+	for _, module := range modules {
+		if affected[module.Name] {
+			result = append(result, module.Name)
+		}
+	}
+	return result, nil
+}
+
+func matchesAnyFile(globs, files []string) (bool, error) {
+	for _, glob := range globs {
+		globRegExp, err := pathGlobToRegExp(glob)
+		if err != nil {
+			return false, err
+		}
+		for _, file := range files {
+			if globRegExp.MatchString(file) {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+// Converts a path glob to a regular expression. '**' matches any sequence of characters including '/',
+// '*' matches any sequence of characters except '/' and '?' matches a single character except '/'.
+// A glob ending with '/' matches everything under the directory.
+func pathGlobToRegExp(glob string) (*regexp.Regexp, error) {
+	glob = strings.TrimPrefix(glob, "./")
+	if strings.HasSuffix(glob, "/") {
+		glob += "**"
+	}
+	var pattern strings.Builder
+	pattern.WriteString("^")
+	for i := 0; i < len(glob); i++ {
+		switch {
+		case strings.HasPrefix(glob[i:], "**/"):
+			// Matches zero or more directories.
+			pattern.WriteString("(.*/)?")
+			i += 2
+		case strings.HasPrefix(glob[i:], "**"):
+			pattern.WriteString(".*")
+			i++
+		case glob[i] == '*':
+			pattern.WriteString("[^/]*")
+		case glob[i] == '?':
+			pattern.WriteString("[^/]")
+		default:
+			pattern.WriteString(regexp.QuoteMeta(string(glob[i])))
+		}
+	}
+	pattern.WriteString("$")
+	globRegExp, err := regexp.Compile(pattern.String())
+	return globRegExp, errorutils.CheckError(err)
+}
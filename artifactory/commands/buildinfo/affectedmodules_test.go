@@ -0,0 +1,90 @@
+package buildinfo
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testModules = []ModuleDefinition{
+	{Name: "common", Paths: []string{"libs/common/"}},
+	{Name: "api", Paths: []string{"services/api/**"}, DependsOn: []string{"common"}},
+	{Name: "web", Paths: []string{"web/**/*.ts", "web/package.json"}},
+	{Name: "e2e", Paths: []string{"tests/e2e/**"}, DependsOn: []string{"api", "web"}},
+}
+
+func TestGetAffectedModules(t *testing.T) {
+	tests := []struct {
+		name         string
+		changedFiles []string
+		expected     []string
+	}{
+		{"no changes", nil, nil},
+		{"unowned file", []string{"README.md"}, nil},
+		{"leaf module", []string{"tests/e2e/login_test.go"}, []string{"e2e"}},
+		{"transitive dependents", []string{"libs/common/strings.go"}, []string{"common", "api", "e2e"}},
+		{"nested glob", []string{"web/src/app/main.ts"}, []string{"web", "e2e"}},
+		{"glob not matching extension", []string{"web/src/app/main.css"}, nil},
+		{"exact path", []string{"web/package.json"}, []string{"web", "e2e"}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			affected, err := GetAffectedModules(testModules, test.changedFiles)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, affected)
+		})
+	}
+}
+
+func TestPathGlobToRegExp(t *testing.T) {
+	tests := []struct {
+		glob     string
+		path     string
+		expected bool
+	}{
+		{"src/**", "src/a/b/c.go", true},
+		{"src/**/*.go", "src/c.go", true},
+		{"src/**/*.go", "src/a/b/c.go", true},
+		{"src/*.go", "src/a/c.go", false},
+		{"src/?.go", "src/c.go", true},
+		{"./docs/", "docs/index.md", true},
+		{"go.mod", "go.mod", true},
+		{"go.mod", "sub/go.mod", false},
+		{"a.b", "axb", false},
+	}
+	for _, test := range tests {
+		t.Run(test.glob+" "+test.path, func(t *testing.T) {
+			globRegExp, err := pathGlobToRegExp(test.glob)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, globRegExp.MatchString(test.path))
+		})
+	}
+}
+
+func TestReadModulesManifest(t *testing.T) {
+	manifestPath := filepath.Join(t.TempDir(), "modules.yaml")
+	content := `modules:
+  - name: common
+    paths: ["libs/common/**"]
+  - name: api
+    paths: ["services/api/**"]
+    dependsOn: ["common"]
+`
+	require.NoError(t, os.WriteFile(manifestPath, []byte(content), 0600))
+	modules, err := ReadModulesManifest(manifestPath)
+	assert.NoError(t, err)
+	assert.Equal(t, []ModuleDefinition{
+		{Name: "common", Paths: []string{"libs/common/**"}},
+		{Name: "api", Paths: []string{"services/api/**"}, DependsOn: []string{"common"}},
+	}, modules)
+}
+
+func TestValidateModules(t *testing.T) {
+	assert.NoError(t, validateModules(testModules))
+	assert.Error(t, validateModules([]ModuleDefinition{{Paths: []string{"a/**"}}}))
+	assert.Error(t, validateModules([]ModuleDefinition{{Name: "a"}, {Name: "a"}}))
+	assert.Error(t, validateModules([]ModuleDefinition{{Name: "a", DependsOn: []string{"b"}}}))
+}
@@ -3,16 +3,17 @@ package buildinfo
 import (
 	"encoding/json"
 	"errors"
-	"fmt"
 	"io"
 	"strconv"
+	"strings"
 	"time"
 
 	buildinfo "github.com/jfrog/build-info-go/entities"
 	"github.com/jfrog/jfrog-client-go/artifactory"
 	servicesutils "github.com/jfrog/jfrog-client-go/artifactory/services/utils"
 
-	"github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clientconfig"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/credentials"
 	"github.com/jfrog/jfrog-cli-core/v2/common/build"
 	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
 	"github.com/jfrog/jfrog-client-go/artifactory/services"
@@ -25,6 +26,50 @@ type BuildAppendCommand struct {
 	serverDetails       *config.ServerDetails
 	buildNameToAppend   string
 	buildNumberToAppend string
+	// Child builds to append to an aggregated build, in addition to the build to append.
+	childBuilds []ChildBuild
+}
+
+// ChildBuild references a published build appended to an aggregated build. If the start time of the build is known,
+// it isn't fetched from Artifactory.
+type ChildBuild struct {
+	Name    string
+	Number  string
+	Started string
+}
+
+func (cb ChildBuild) String() string {
+	return cb.Name + "/" + cb.Number
+}
+
+// ParseChildBuilds parses a list of child builds of the form "<name>/<number>[@<started>];...", for example
+// "app-a/12;app-b/7@2026-01-01T10:00:00.000+0000". The build number follows the last slash, so build names may contain slashes.
+func ParseChildBuilds(childBuilds string) ([]ChildBuild, error) {
+	var children []ChildBuild
+	for _, childStr := range strings.Split(childBuilds, ";") {
+		if childStr = strings.TrimSpace(childStr); childStr == "" {
+			continue
+		}
+		var child ChildBuild
+		if buildStr, started, found := strings.Cut(childStr, "@"); found {
+			childStr, child.Started = buildStr, started
+		}
+		separator := strings.LastIndex(childStr, "/")
+		if separator <= 0 || separator == len(childStr)-1 {
+			return nil, errorutils.CheckErrorf("invalid child build '%s', expected <name>/<number>[@<started>]", childStr)
+		}
+		child.Name, child.Number = childStr[:separator], childStr[separator+1:]
+		if child.Started != "" {
+			if _, err := time.Parse(buildinfo.TimeFormat, child.Started); err != nil {
+				return nil, errorutils.CheckErrorf("invalid start time of child build '%s': %s", child.String(), err.Error())
+			}
+		}
+		children = append(children, child)
+	}
+	if len(children) == 0 {
+		return nil, errorutils.CheckErrorf("the list of child builds '%s' has no builds", childBuilds)
+	}
+	return children, nil
 }
 
 func NewBuildAppendCommand() *BuildAppendCommand {
@@ -36,7 +81,7 @@ func (bac *BuildAppendCommand) CommandName() string {
 }
 
 func (bac *BuildAppendCommand) ServerDetails() (*config.ServerDetails, error) {
-	return config.GetDefaultServerConf()
+	return credentials.GetDefaultServerConf()
 }
 
 func (bac *BuildAppendCommand) Run() error {
@@ -54,27 +99,44 @@ func (bac *BuildAppendCommand) Run() error {
 	}
 
 	// Create services manager to get build-info from Artifactory.
-	servicesManager, err := utils.CreateServiceManager(bac.serverDetails, -1, 0, false)
+	servicesManager, err := clientconfig.CreateServiceManager(bac.serverDetails, -1, 0, false)
 	if err != nil {
 		return err
 	}
 
+	for _, child := range bac.getChildBuilds() {
+		if err = bac.appendBuild(servicesManager, buildName, buildNumber, child); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (bac *BuildAppendCommand) getChildBuilds() []ChildBuild {
+	children := bac.childBuilds
+	if bac.buildNameToAppend != "" {
+		children = append([]ChildBuild{{Name: bac.buildNameToAppend, Number: bac.buildNumberToAppend}}, children...)
+	}
+	return children
+}
+
+func (bac *BuildAppendCommand) appendBuild(servicesManager artifactory.ArtifactoryServicesManager, buildName, buildNumber string, child ChildBuild) error {
 	// Calculate build timestamp
-	timestamp, err := bac.getBuildTimestamp(servicesManager)
+	timestamp, err := bac.getBuildTimestamp(servicesManager, child)
 	if err != nil {
 		return err
 	}
 
 	// Get checksum values from the build info artifact
-	checksumDetails, err := bac.getChecksumDetails(servicesManager, timestamp)
+	checksumDetails, err := bac.getChecksumDetails(servicesManager, child, timestamp)
 	if err != nil {
 		return err
 	}
 
-	log.Debug("Appending build", bac.buildNameToAppend+"/"+bac.buildNumberToAppend, "to build info")
+	log.Debug("Appending build", child.String(), "to build info")
 	populateFunc := func(partial *buildinfo.Partial) {
 		partial.ModuleType = buildinfo.Build
-		partial.ModuleId = bac.buildNameToAppend + "/" + bac.buildNumberToAppend
+		partial.ModuleId = child.String()
 		partial.Checksum = buildinfo.Checksum{
 			Sha1: checksumDetails.Sha1,
 			Md5:  checksumDetails.Md5,
@@ -82,7 +144,7 @@ func (bac *BuildAppendCommand) Run() error {
 	}
 	err = build.SavePartialBuildInfo(buildName, buildNumber, bac.buildConfiguration.GetProject(), populateFunc)
 	if err == nil {
-		log.Info("Build", bac.buildNameToAppend+"/"+bac.buildNumberToAppend, "successfully appended to", buildName+"/"+buildNumber)
+		log.Info("Build", child.String(), "successfully appended to", buildName+"/"+buildNumber)
 	}
 	return err
 }
@@ -107,40 +169,49 @@ func (bac *BuildAppendCommand) SetBuildNumberToAppend(buildNumber string) *Build
 	return bac
 }
 
+func (bac *BuildAppendCommand) SetChildBuilds(childBuilds []ChildBuild) *BuildAppendCommand {
+	bac.childBuilds = childBuilds
+	return bac
+}
+
 // Get build timestamp of the build to append. The build timestamp has to be converted to milliseconds from epoch.
 // For example, start time of: 2020-11-27T14:33:38.538+0200 should be converted to 1606480418538.
-func (bac *BuildAppendCommand) getBuildTimestamp(servicesManager artifactory.ArtifactoryServicesManager) (int64, error) {
-	// Get published build-info from Artifactory.
-	buildInfoParams := services.BuildInfoParams{BuildName: bac.buildNameToAppend, BuildNumber: bac.buildNumberToAppend, ProjectKey: bac.buildConfiguration.GetProject()}
-	buildInfo, found, err := servicesManager.GetBuildInfo(buildInfoParams)
-	if err != nil {
-		return 0, err
-	}
-	buildString := fmt.Sprintf("Build %s/%s", bac.buildNameToAppend, bac.buildNumberToAppend)
+func (bac *BuildAppendCommand) getBuildTimestamp(servicesManager artifactory.ArtifactoryServicesManager, child ChildBuild) (int64, error) {
+	buildString := "Build " + child.String()
 	if bac.buildConfiguration.GetProject() != "" {
 		buildString = buildString + " of project: " + bac.buildConfiguration.GetProject()
 	}
-	if !found {
-		return 0, errorutils.CheckError(errors.New(buildString + " not found in Artifactory."))
+	started := child.Started
+	if started == "" {
+		// Get published build-info from Artifactory.
+		buildInfoParams := services.BuildInfoParams{BuildName: child.Name, BuildNumber: child.Number, ProjectKey: bac.buildConfiguration.GetProject()}
+		buildInfo, found, err := servicesManager.GetBuildInfo(buildInfoParams)
+		if err != nil {
+			return 0, err
+		}
+		if !found {
+			return 0, errorutils.CheckError(errors.New(buildString + " not found in Artifactory."))
+		}
+		started = buildInfo.BuildInfo.Started
 	}
 
-	buildTime, err := time.Parse(buildinfo.TimeFormat, buildInfo.BuildInfo.Started)
+	buildTime, err := time.Parse(buildinfo.TimeFormat, started)
 	if errorutils.CheckError(err) != nil {
 		return 0, err
 	}
 
 	// Convert from nanoseconds to milliseconds
 	timestamp := buildTime.UnixNano() / 1000000
-	log.Debug(buildString + ". Started: " + buildInfo.BuildInfo.Started + ". Calculated timestamp: " + strconv.FormatInt(timestamp, 10))
+	log.Debug(buildString + ". Started: " + started + ". Calculated timestamp: " + strconv.FormatInt(timestamp, 10))
 
 	return timestamp, err
 }
 
 // Download MD5 and SHA1 from the build info artifact.
-func (bac *BuildAppendCommand) getChecksumDetails(servicesManager artifactory.ArtifactoryServicesManager, timestamp int64) (buildinfo.Checksum, error) {
+func (bac *BuildAppendCommand) getChecksumDetails(servicesManager artifactory.ArtifactoryServicesManager, child ChildBuild, timestamp int64) (buildinfo.Checksum, error) {
 	// Run AQL query for build
 	stringTimestamp := strconv.FormatInt(timestamp, 10)
-	aqlQuery := servicesutils.CreateAqlQueryForBuildInfoJson(bac.buildConfiguration.GetProject(), bac.buildNameToAppend, bac.buildNumberToAppend, stringTimestamp)
+	aqlQuery := servicesutils.CreateAqlQueryForBuildInfoJson(bac.buildConfiguration.GetProject(), child.Name, child.Number, stringTimestamp)
 	stream, err := servicesManager.Aql(aqlQuery)
 	if err != nil {
 		return buildinfo.Checksum{}, err
@@ -159,14 +230,14 @@ func (bac *BuildAppendCommand) getChecksumDetails(servicesManager artifactory.Ar
 		return buildinfo.Checksum{}, errorutils.CheckError(err)
 	}
 	if len(parsedResult.Results) == 0 {
-		return buildinfo.Checksum{}, errorutils.CheckErrorf("Build '%s/%s' could not be found", bac.buildNameToAppend, bac.buildNumberToAppend)
+		return buildinfo.Checksum{}, errorutils.CheckErrorf("Build '%s' could not be found", child.String())
 	}
 
 	// Verify checksum exist
 	sha1 := parsedResult.Results[0].Actual_Sha1
 	md5 := parsedResult.Results[0].Actual_Md5
 	if sha1 == "" || md5 == "" {
-		return buildinfo.Checksum{}, errorutils.CheckErrorf("Missing checksums for build-info: '%s/%s', sha1: '%s', md5: '%s'", bac.buildNameToAppend, bac.buildNumberToAppend, sha1, md5)
+		return buildinfo.Checksum{}, errorutils.CheckErrorf("Missing checksums for build-info: '%s', sha1: '%s', md5: '%s'", child.String(), sha1, md5)
 	}
 
 	// Return checksums
@@ -0,0 +1,27 @@
+package buildinfo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseChildBuilds(t *testing.T) {
+	children, err := ParseChildBuilds("app-a/12; team/app-b/7@2026-01-01T10:00:00.000+0000;")
+	require.NoError(t, err)
+	assert.Equal(t, []ChildBuild{{Name: "app-a", Number: "12"}, {Name: "team/app-b", Number: "7", Started: "2026-01-01T10:00:00.000+0000"}}, children)
+
+	for _, invalid := range []string{"app", "/12", "app/", "app/12@yesterday", ";"} {
+		_, err = ParseChildBuilds(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
+
+func TestGetChildBuilds(t *testing.T) {
+	command := NewBuildAppendCommand().SetChildBuilds([]ChildBuild{{Name: "app-b", Number: "7"}})
+	assert.Equal(t, []ChildBuild{{Name: "app-b", Number: "7"}}, command.getChildBuilds())
+
+	command.SetBuildNameToAppend("app-a").SetBuildNumberToAppend("12")
+	assert.Equal(t, []ChildBuild{{Name: "app-a", Number: "12"}, {Name: "app-b", Number: "7"}}, command.getChildBuilds())
+}
@@ -1,13 +1,24 @@
 package buildinfo
 
 import (
-	"github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	buildinfo "github.com/jfrog/build-info-go/entities"
+	artifactoryutils "github.com/jfrog/jfrog-cli-artifactory/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clientconfig"
 	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/artifactory"
 	"github.com/jfrog/jfrog-client-go/artifactory/services"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
 )
 
 type BuildDiscardCommand struct {
 	serverDetails *config.ServerDetails
+	dryRun        bool
 	services.DiscardBuildsParams
 }
 
@@ -25,14 +36,102 @@ func (buildDiscard *BuildDiscardCommand) SetDiscardBuildsParams(params services.
 	return buildDiscard
 }
 
+// SetDryRun sets the command to list the build runs which would be discarded, without discarding them.
+func (buildDiscard *BuildDiscardCommand) SetDryRun(dryRun bool) *BuildDiscardCommand {
+	buildDiscard.dryRun = dryRun
+	return buildDiscard
+}
+
 func (buildDiscard *BuildDiscardCommand) Run() error {
-	servicesManager, err := utils.CreateServiceManager(buildDiscard.serverDetails, -1, 0, false)
+	servicesManager, err := clientconfig.CreateServiceManager(buildDiscard.serverDetails, -1, 0, false)
 	if err != nil {
 		return err
 	}
+	if buildDiscard.dryRun {
+		return buildDiscard.logDiscardedBuilds(servicesManager)
+	}
 	return servicesManager.DiscardBuilds(buildDiscard.DiscardBuildsParams)
 }
 
+func (buildDiscard *BuildDiscardCommand) logDiscardedBuilds(servicesManager artifactory.ArtifactoryServicesManager) error {
+	buildRuns, found, err := servicesManager.GetBuildRuns(services.BuildInfoParams{BuildName: buildDiscard.BuildName, ProjectKey: buildDiscard.ProjectKey})
+	if err != nil {
+		return err
+	}
+	if !found {
+		return errorutils.CheckErrorf("the build '%s' wasn't found", buildDiscard.BuildName)
+	}
+	discarded, err := getDiscardedBuildNumbers(buildRuns.BuildsNumbers, buildDiscard.DiscardBuildsParams, time.Now())
+	if err != nil {
+		return err
+	}
+	operation := "Discarding the build"
+	if buildDiscard.DeleteArtifacts {
+		operation = "Discarding the build and its artifacts"
+	}
+	for _, buildNumber := range discarded {
+		artifactoryutils.LogDryRun(operation, buildDiscard.BuildName+"/"+buildNumber)
+	}
+	if len(discarded) == 0 {
+		artifactoryutils.LogDryRun("No runs of the build", buildDiscard.BuildName, "are discarded")
+	}
+	return nil
+}
+
+// getDiscardedBuildNumbers returns the numbers of the build runs which the retention discards: the runs beyond the
+// maximum number of builds, counting from the latest, and the runs which started more than the maximum days ago.
+// The excluded build numbers are kept. This is an approximation of the retention applied by Artifactory, which
+// orders the runs and counts the days on the server, so the runs it discards may differ from the previewed ones.
+func getDiscardedBuildNumbers(runs []buildinfo.BuildRun, params services.DiscardBuildsParams, now time.Time) ([]string, error) {
+	maxBuilds, maxDays := -1, -1
+	var err error
+	if params.MaxBuilds != "" {
+		if maxBuilds, err = strconv.Atoi(params.MaxBuilds); err != nil {
+			return nil, errorutils.CheckErrorf("the maximum number of builds '%s' isn't a number", params.MaxBuilds)
+		}
+	}
+	if params.MaxDays != "" {
+		if maxDays, err = strconv.Atoi(params.MaxDays); err != nil {
+			return nil, errorutils.CheckErrorf("the maximum days '%s' isn't a number", params.MaxDays)
+		}
+	}
+	var excluded []string
+	if params.ExcludeBuilds != "" {
+		excluded = strings.Split(params.ExcludeBuilds, ",")
+	}
+	type startedRun struct {
+		number  string
+		started time.Time
+	}
+	startedRuns := make([]startedRun, 0, len(runs))
+	for _, run := range runs {
+		started, err := time.Parse(buildinfo.TimeFormat, run.Started)
+		if err != nil {
+			return nil, errorutils.CheckErrorf("failed to parse the start time '%s' of the build run '%s': %s", run.Started, run.Uri, err.Error())
+		}
+		// The number is escaped in the URI of the run, and unescaped in the excluded build numbers.
+		number, err := url.PathUnescape(strings.TrimPrefix(run.Uri, "/"))
+		if err != nil {
+			return nil, errorutils.CheckErrorf("failed to parse the URI '%s' of the build run: %s", run.Uri, err.Error())
+		}
+		startedRuns = append(startedRuns, startedRun{number: number, started: started})
+	}
+	slices.SortStableFunc(startedRuns, func(a, b startedRun) int {
+		return b.started.Compare(a.started)
+	})
+	minimumStarted := now.Add(-24 * time.Hour * time.Duration(maxDays))
+	var discarded []string
+	for i, run := range startedRuns {
+		if slices.Contains(excluded, run.number) {
+			continue
+		}
+		if (maxBuilds >= 0 && i >= maxBuilds) || (maxDays >= 0 && run.started.Before(minimumStarted)) {
+			discarded = append(discarded, run.number)
+		}
+	}
+	return discarded, nil
+}
+
 func (buildDiscard *BuildDiscardCommand) ServerDetails() (*config.ServerDetails, error) {
 	return buildDiscard.serverDetails, nil
 }
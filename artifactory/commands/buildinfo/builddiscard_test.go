@@ -0,0 +1,52 @@
+package buildinfo
+
+import (
+	"testing"
+	"time"
+
+	buildinfo "github.com/jfrog/build-info-go/entities"
+	"github.com/jfrog/jfrog-client-go/artifactory/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetDiscardedBuildNumbers(t *testing.T) {
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	started := func(daysAgo int) string {
+		return now.Add(-24 * time.Hour * time.Duration(daysAgo)).Format(buildinfo.TimeFormat)
+	}
+	runs := []buildinfo.BuildRun{
+		{Uri: "/3", Started: started(3)},
+		{Uri: "/5", Started: started(1)},
+		{Uri: "/1", Started: started(20)},
+		{Uri: "/4", Started: started(2)},
+		{Uri: "/2", Started: started(10)},
+	}
+	testCases := []struct {
+		name     string
+		params   services.DiscardBuildsParams
+		expected []string
+	}{
+		{"max builds", services.DiscardBuildsParams{MaxBuilds: "3"}, []string{"2", "1"}},
+		{"max days", services.DiscardBuildsParams{MaxDays: "5"}, []string{"2", "1"}},
+		{"max builds and days", services.DiscardBuildsParams{MaxBuilds: "4", MaxDays: "15"}, []string{"1"}},
+		{"excluded builds", services.DiscardBuildsParams{MaxBuilds: "1", ExcludeBuilds: "3,1"}, []string{"4", "2"}},
+		{"no retention", services.DiscardBuildsParams{}, nil},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			discarded, err := getDiscardedBuildNumbers(runs, testCase.params, now)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expected, discarded)
+		})
+	}
+
+	// The escaped numbers of the runs are compared to the excluded numbers unescaped.
+	escapedRuns := []buildinfo.BuildRun{{Uri: "/1.0%2Brc1", Started: started(2)}, {Uri: "/1.0%2Brc2", Started: started(1)}}
+	discarded, err := getDiscardedBuildNumbers(escapedRuns, services.DiscardBuildsParams{MaxBuilds: "0", ExcludeBuilds: "1.0+rc1"}, now)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"1.0+rc2"}, discarded)
+
+	_, err = getDiscardedBuildNumbers(runs, services.DiscardBuildsParams{MaxBuilds: "many"}, now)
+	assert.ErrorContains(t, err, "isn't a number")
+}
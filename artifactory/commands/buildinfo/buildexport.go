@@ -0,0 +1,139 @@
+package buildinfo
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+
+	buildinfo "github.com/jfrog/build-info-go/entities"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clientconfig"
+	"github.com/jfrog/jfrog-cli-core/v2/common/build"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/jfrog/jfrog-client-go/artifactory/services"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const (
+	// The name of the build-info file written to the target directory.
+	ExportedBuildInfoFileName = "build-info.json"
+	// The directory under the target directory to which the build artifacts are downloaded, by their paths in their repositories.
+	ExportedArtifactsDirName = "artifacts"
+)
+
+// BuildExportCommand downloads a published build-info, and optionally its artifacts, to a local directory,
+// so that the build can be reproduced without access to Artifactory.
+type BuildExportCommand struct {
+	serverDetails      *config.ServerDetails
+	buildConfiguration *build.BuildConfiguration
+	targetDir          string
+	includeArtifacts   bool
+	threads            int
+}
+
+func NewBuildExportCommand() *BuildExportCommand {
+	return &BuildExportCommand{}
+}
+
+func (bec *BuildExportCommand) SetServerDetails(serverDetails *config.ServerDetails) *BuildExportCommand {
+	bec.serverDetails = serverDetails
+	return bec
+}
+
+func (bec *BuildExportCommand) SetBuildConfiguration(buildConfiguration *build.BuildConfiguration) *BuildExportCommand {
+	bec.buildConfiguration = buildConfiguration
+	return bec
+}
+
+func (bec *BuildExportCommand) SetTargetDir(targetDir string) *BuildExportCommand {
+	bec.targetDir = targetDir
+	return bec
+}
+
+func (bec *BuildExportCommand) SetIncludeArtifacts(includeArtifacts bool) *BuildExportCommand {
+	bec.includeArtifacts = includeArtifacts
+	return bec
+}
+
+func (bec *BuildExportCommand) SetThreads(threads int) *BuildExportCommand {
+	bec.threads = threads
+	return bec
+}
+
+func (bec *BuildExportCommand) Run() error {
+	servicesManager, err := clientconfig.CreateDownloadServiceManager(bec.serverDetails, bec.threads, 0, 0, false, nil)
+	if err != nil {
+		return err
+	}
+	return bec.export(servicesManager)
+}
+
+func (bec *BuildExportCommand) export(servicesManager artifactory.ArtifactoryServicesManager) error {
+	buildName, err := bec.buildConfiguration.GetBuildName()
+	if err != nil {
+		return err
+	}
+	buildNumber, err := bec.buildConfiguration.GetBuildNumber()
+	if err != nil {
+		return err
+	}
+	project := bec.buildConfiguration.GetProject()
+	// The build number may be LATEST or LAST_RELEASE, which are resolved by Artifactory.
+	publishedBuildInfo, found, err := servicesManager.GetBuildInfo(services.BuildInfoParams{BuildName: buildName, BuildNumber: buildNumber, ProjectKey: project})
+	if err != nil {
+		return err
+	}
+	if !found {
+		return errorutils.CheckErrorf("build %s/%s was not found in Artifactory", buildName, buildNumber)
+	}
+	buildInfo := &publishedBuildInfo.BuildInfo
+	if err = os.MkdirAll(bec.targetDir, 0755); err != nil {
+		return errorutils.CheckError(err)
+	}
+	if err = writeBuildInfoFile(buildInfo, filepath.Join(bec.targetDir, ExportedBuildInfoFileName)); err != nil {
+		return err
+	}
+	log.Info("Exported the build-info of", buildInfo.Name+"/"+buildInfo.Number, "to", bec.targetDir+".")
+	if !bec.includeArtifacts {
+		return nil
+	}
+	return bec.downloadArtifacts(servicesManager, buildInfo, project)
+}
+
+func writeBuildInfoFile(buildInfo *buildinfo.BuildInfo, filePath string) error {
+	content, err := json.MarshalIndent(buildInfo, "", "  ")
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	return errorutils.CheckError(os.WriteFile(filePath, content, 0644))
+}
+
+// Downloads the artifacts of the build to the artifacts directory under the target directory, by their paths in their repositories.
+func (bec *BuildExportCommand) downloadArtifacts(servicesManager artifactory.ArtifactoryServicesManager, buildInfo *buildinfo.BuildInfo, project string) error {
+	downloadParams := services.NewDownloadParams()
+	downloadParams.Pattern = "*"
+	// Slashes in the build name are escaped, as the build number follows the last unescaped slash.
+	downloadParams.Build = strings.ReplaceAll(buildInfo.Name, "/", "\\/") + "/" + buildInfo.Number
+	downloadParams.Project = project
+	downloadParams.Recursive = true
+	downloadParams.Target = filepath.Join(bec.targetDir, ExportedArtifactsDirName) + string(filepath.Separator)
+	downloaded, failed, err := servicesManager.DownloadFiles(downloadParams)
+	if err != nil {
+		return err
+	}
+	log.Info("Downloaded", downloaded, "artifacts of the build.")
+	if failed > 0 {
+		return errorutils.CheckErrorf("failed to download %d artifacts of the build", failed)
+	}
+	return nil
+}
+
+func (bec *BuildExportCommand) ServerDetails() (*config.ServerDetails, error) {
+	return bec.serverDetails, nil
+}
+
+func (bec *BuildExportCommand) CommandName() string {
+	return "rt_build_export"
+}
@@ -0,0 +1,68 @@
+package buildinfo
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	buildinfo "github.com/jfrog/build-info-go/entities"
+	"github.com/jfrog/jfrog-cli-core/v2/common/build"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/jfrog/jfrog-client-go/artifactory/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type exportServicesManager struct {
+	artifactory.EmptyArtifactoryServicesManager
+	buildInfoParams services.BuildInfoParams
+	downloadParams  []services.DownloadParams
+	failed          int
+}
+
+func (esm *exportServicesManager) GetBuildInfo(params services.BuildInfoParams) (*buildinfo.PublishedBuildInfo, bool, error) {
+	esm.buildInfoParams = params
+	if params.BuildName != "my/build" {
+		return nil, false, nil
+	}
+	return &buildinfo.PublishedBuildInfo{BuildInfo: buildinfo.BuildInfo{Name: "my/build", Number: "7", Started: "2024-01-02T03:04:05.000+0000"}}, true, nil
+}
+
+func (esm *exportServicesManager) DownloadFiles(params ...services.DownloadParams) (int, int, error) {
+	esm.downloadParams = append(esm.downloadParams, params...)
+	return 3, esm.failed, nil
+}
+
+func TestBuildExport(t *testing.T) {
+	targetDir := filepath.Join(t.TempDir(), "export")
+	servicesManager := &exportServicesManager{}
+	buildConfiguration := build.NewBuildConfiguration("my/build", "LATEST", "", "proj")
+	exportCmd := NewBuildExportCommand().SetBuildConfiguration(buildConfiguration).SetTargetDir(targetDir)
+
+	// Without the artifacts, only the build-info is written.
+	require.NoError(t, exportCmd.export(servicesManager))
+	assert.Equal(t, services.BuildInfoParams{BuildName: "my/build", BuildNumber: "LATEST", ProjectKey: "proj"}, servicesManager.buildInfoParams)
+	assert.Empty(t, servicesManager.downloadParams)
+	content, err := os.ReadFile(filepath.Join(targetDir, ExportedBuildInfoFileName))
+	require.NoError(t, err)
+	var exported buildinfo.BuildInfo
+	require.NoError(t, json.Unmarshal(content, &exported))
+	assert.Equal(t, "7", exported.Number)
+
+	// The artifacts are downloaded from the resolved build number.
+	exportCmd.SetIncludeArtifacts(true)
+	require.NoError(t, exportCmd.export(servicesManager))
+	require.Len(t, servicesManager.downloadParams, 1)
+	downloadParams := servicesManager.downloadParams[0]
+	assert.Equal(t, "my\\/build/7", downloadParams.Build)
+	assert.Equal(t, "proj", downloadParams.Project)
+	assert.Equal(t, filepath.Join(targetDir, ExportedArtifactsDirName)+string(filepath.Separator), downloadParams.Target)
+	assert.False(t, downloadParams.Flat)
+
+	servicesManager.failed = 1
+	assert.ErrorContains(t, exportCmd.export(servicesManager), "failed to download 1 artifacts")
+
+	exportCmd.SetBuildConfiguration(build.NewBuildConfiguration("missing", "1", "", ""))
+	assert.ErrorContains(t, exportCmd.export(servicesManager), "build missing/1 was not found")
+}
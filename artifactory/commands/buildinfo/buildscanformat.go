@@ -0,0 +1,215 @@
+package buildinfo
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	xrayservices "github.com/jfrog/jfrog-client-go/xray/services"
+)
+
+// The output formats of the build scan results.
+const (
+	TableFormat = "table"
+	JsonFormat  = "json"
+	SarifFormat = "sarif"
+)
+
+// Severity is the severity of an Xray issue, ordered from the least severe.
+type Severity int
+
+const (
+	SeverityUnknown Severity = iota
+	SeverityLow
+	SeverityMedium
+	SeverityHigh
+	SeverityCritical
+)
+
+var severityNames = []string{"Unknown", "Low", "Medium", "High", "Critical"}
+
+func (s Severity) String() string {
+	return severityNames[s]
+}
+
+// ParseSeverity returns the severity by its case-insensitive name, or SeverityUnknown if the name isn't known.
+func ParseSeverity(name string) Severity {
+	for i, severityName := range severityNames {
+		if strings.EqualFold(name, severityName) {
+			return Severity(i)
+		}
+	}
+	return SeverityUnknown
+}
+
+// ParseSeverityThreshold returns the severity by its name, which must be one of Low, Medium, High or Critical.
+func ParseSeverityThreshold(name string) (Severity, error) {
+	if severity := ParseSeverity(name); severity != SeverityUnknown {
+		return severity, nil
+	}
+	return SeverityUnknown, errorutils.CheckErrorf("invalid severity '%s'. The valid severities are Low, Medium, High and Critical", name)
+}
+
+// A violation or a vulnerability found by the scan.
+type buildScanIssue struct {
+	kind       string
+	issueId    string
+	severity   string
+	summary    string
+	components []string
+	cves       []string
+}
+
+// Returns the violations and then the vulnerabilities of the results, each ordered by descending severity.
+func getBuildScanIssues(results *xrayservices.BuildScanResponse) []buildScanIssue {
+	var violations, vulnerabilities []buildScanIssue
+	for _, violation := range results.Violations {
+		violations = append(violations, newBuildScanIssue(violation.ViolationType+" violation", violation.IssueId, violation.Severity, violation.Summary, violation.Components, violation.Cves))
+	}
+	for _, vulnerability := range results.Vulnerabilities {
+		vulnerabilities = append(vulnerabilities, newBuildScanIssue("vulnerability", vulnerability.IssueId, vulnerability.Severity, vulnerability.Summary, vulnerability.Components, vulnerability.Cves))
+	}
+	for _, issues := range [][]buildScanIssue{violations, vulnerabilities} {
+		sort.SliceStable(issues, func(i, j int) bool {
+			return ParseSeverity(issues[i].severity) > ParseSeverity(issues[j].severity)
+		})
+	}
+	return append(violations, vulnerabilities...)
+}
+
+func newBuildScanIssue(kind, issueId, severity, summary string, components map[string]xrayservices.Component, cves []xrayservices.Cve) buildScanIssue {
+	issue := buildScanIssue{kind: kind, issueId: issueId, severity: severity, summary: summary}
+	for component := range components {
+		issue.components = append(issue.components, component)
+	}
+	sort.Strings(issue.components)
+	for _, cve := range cves {
+		if cve.Id != "" {
+			issue.cves = append(issue.cves, cve.Id)
+		}
+	}
+	return issue
+}
+
+// FormatBuildScanResults renders the results of a build scan in the format: table, json or sarif.
+func FormatBuildScanResults(results *xrayservices.BuildScanResponse, format string) (string, error) {
+	switch format {
+	case TableFormat, "":
+		return formatBuildScanTable(results), nil
+	case JsonFormat:
+		content, err := json.MarshalIndent(results, "", "  ")
+		return string(content), errorutils.CheckError(err)
+	case SarifFormat:
+		content, err := json.MarshalIndent(toSarif(results), "", "  ")
+		return string(content), errorutils.CheckError(err)
+	}
+	return "", errorutils.CheckErrorf("unsupported format '%s'. The supported formats are %s, %s and %s", format, TableFormat, JsonFormat, SarifFormat)
+}
+
+func formatBuildScanTable(results *xrayservices.BuildScanResponse) string {
+	issues := getBuildScanIssues(results)
+	builder := &strings.Builder{}
+	if len(issues) == 0 {
+		builder.WriteString("No violations or vulnerabilities were found.\n")
+	} else {
+		writer := tabwriter.NewWriter(builder, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(writer, "SEVERITY\tTYPE\tISSUE\tCVES\tCOMPONENTS\tSUMMARY")
+		for _, issue := range issues {
+			fmt.Fprintf(writer, "%s\t%s\t%s\t%s\t%s\t%s\n", issue.severity, issue.kind, issue.issueId, strings.Join(issue.cves, ","), strings.Join(issue.components, ","), issue.summary)
+		}
+		_ = writer.Flush()
+	}
+	if results.MoreDetailsUrl != "" {
+		builder.WriteString("More details: " + results.MoreDetailsUrl + "\n")
+	}
+	return builder.String()
+}
+
+type sarifReport struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string      `json:"name"`
+	InformationUri string      `json:"informationUri,omitempty"`
+	Rules          []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	Id               string       `json:"id"`
+	ShortDescription sarifMessage `json:"shortDescription"`
+	HelpUri          string       `json:"helpUri,omitempty"`
+}
+
+type sarifResult struct {
+	RuleId     string          `json:"ruleId"`
+	Level      string          `json:"level"`
+	Message    sarifMessage    `json:"message"`
+	Locations  []sarifLocation `json:"locations,omitempty"`
+	Properties map[string]any  `json:"properties,omitempty"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifLocation struct {
+	LogicalLocations []sarifLogicalLocation `json:"logicalLocations"`
+}
+
+type sarifLogicalLocation struct {
+	FullyQualifiedName string `json:"fullyQualifiedName"`
+	Kind               string `json:"kind"`
+}
+
+func toSarif(results *xrayservices.BuildScanResponse) sarifReport {
+	driver := sarifDriver{Name: "JFrog Xray", InformationUri: results.MoreDetailsUrl, Rules: []sarifRule{}}
+	run := sarifRun{Results: []sarifResult{}}
+	addedRules := make(map[string]bool)
+	for _, issue := range getBuildScanIssues(results) {
+		ruleId := issue.issueId
+		if len(issue.cves) > 0 {
+			ruleId = issue.cves[0]
+		}
+		if !addedRules[ruleId] {
+			addedRules[ruleId] = true
+			driver.Rules = append(driver.Rules, sarifRule{Id: ruleId, ShortDescription: sarifMessage{Text: issue.summary}, HelpUri: results.MoreDetailsUrl})
+		}
+		result := sarifResult{
+			RuleId:     ruleId,
+			Level:      toSarifLevel(ParseSeverity(issue.severity)),
+			Message:    sarifMessage{Text: fmt.Sprintf("[%s] %s %s: %s", issue.severity, issue.kind, issue.issueId, issue.summary)},
+			Properties: map[string]any{"severity": issue.severity, "type": issue.kind},
+		}
+		for _, component := range issue.components {
+			result.Locations = append(result.Locations, sarifLocation{LogicalLocations: []sarifLogicalLocation{{FullyQualifiedName: component, Kind: "package"}}})
+		}
+		run.Results = append(run.Results, result)
+	}
+	run.Tool = sarifTool{Driver: driver}
+	return sarifReport{Schema: "https://json.schemastore.org/sarif-2.1.0.json", Version: "2.1.0", Runs: []sarifRun{run}}
+}
+
+func toSarifLevel(severity Severity) string {
+	switch severity {
+	case SeverityCritical, SeverityHigh:
+		return "error"
+	case SeverityMedium:
+		return "warning"
+	}
+	return "note"
+}
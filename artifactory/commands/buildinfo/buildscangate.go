@@ -0,0 +1,276 @@
+package buildinfo
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clientconfig"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clierrors"
+	"github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-core/v2/common/build"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/auth"
+	"github.com/jfrog/jfrog-client-go/http/jfroghttpclient"
+	clientutils "github.com/jfrog/jfrog-client-go/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+	xrayservices "github.com/jfrog/jfrog-client-go/xray/services"
+)
+
+const (
+	DefaultBuildScanTimeout = 10 * time.Minute
+	// The interval between the first requests for the scan results, which is doubled after each request up to maxPollInterval.
+	initialPollInterval = 5 * time.Second
+	maxPollInterval     = time.Minute
+	// Substrings of the responses to the scan trigger.
+	buildNotFoundInfo        = "wasn't found in Artifactory"
+	buildNotIndexedInfo      = "is not selected for indexing"
+	buildScanResultsEndpoint = "scanResult"
+)
+
+// buildScanner triggers Xray scans of published builds, and gets their results.
+type buildScanner interface {
+	TriggerScan(params xrayservices.XrayBuildParams) (info string, err error)
+	// Returns the results of the scan, or done=false if the scan is still in progress.
+	GetScanResults(params xrayservices.XrayBuildParams, includeVulnerabilities bool) (results *xrayservices.BuildScanResponse, done bool, err error)
+}
+
+// BuildScanGateCommand scans a published build with Xray, waits for the scan to complete, prints its results, and fails
+// if the build violates the Xray policies or has issues of the configured severity or above.
+type BuildScanGateCommand struct {
+	serverDetails          *config.ServerDetails
+	buildConfiguration     *build.BuildConfiguration
+	timeout                time.Duration
+	format                 string
+	severityThreshold      Severity
+	includeVulnerabilities bool
+	rescan                 bool
+	scanner                buildScanner
+	sleep                  func(time.Duration)
+}
+
+func NewBuildScanGateCommand() *BuildScanGateCommand {
+	return &BuildScanGateCommand{timeout: DefaultBuildScanTimeout, format: TableFormat, sleep: time.Sleep}
+}
+
+func (bsg *BuildScanGateCommand) SetServerDetails(serverDetails *config.ServerDetails) *BuildScanGateCommand {
+	bsg.serverDetails = serverDetails
+	return bsg
+}
+
+func (bsg *BuildScanGateCommand) SetBuildConfiguration(buildConfiguration *build.BuildConfiguration) *BuildScanGateCommand {
+	bsg.buildConfiguration = buildConfiguration
+	return bsg
+}
+
+// Sets the maximal time to wait for the scan, including the time to wait for the build to be available to Xray.
+func (bsg *BuildScanGateCommand) SetTimeout(timeout time.Duration) *BuildScanGateCommand {
+	bsg.timeout = timeout
+	return bsg
+}
+
+func (bsg *BuildScanGateCommand) SetFormat(format string) *BuildScanGateCommand {
+	bsg.format = format
+	return bsg
+}
+
+// Sets the minimal severity of the issues which fail the gate. If not set, the gate fails if the build violates a
+// policy with the "Fail build" rule.
+func (bsg *BuildScanGateCommand) SetSeverityThreshold(severityThreshold Severity) *BuildScanGateCommand {
+	bsg.severityThreshold = severityThreshold
+	return bsg
+}
+
+// Sets whether all the vulnerabilities of the build, regardless of the Xray policies, are included in the results
+// and checked against the severity threshold.
+func (bsg *BuildScanGateCommand) SetIncludeVulnerabilities(includeVulnerabilities bool) *BuildScanGateCommand {
+	bsg.includeVulnerabilities = includeVulnerabilities
+	return bsg
+}
+
+func (bsg *BuildScanGateCommand) SetRescan(rescan bool) *BuildScanGateCommand {
+	bsg.rescan = rescan
+	return bsg
+}
+
+func (bsg *BuildScanGateCommand) CommandName() string {
+	return "rt_build_scan_gate"
+}
+
+func (bsg *BuildScanGateCommand) ServerDetails() (*config.ServerDetails, error) {
+	return bsg.serverDetails, nil
+}
+
+func (bsg *BuildScanGateCommand) Run() error {
+	if bsg.scanner == nil {
+		xrayManager, err := clientconfig.CreateXrayServiceManager(bsg.serverDetails)
+		if err != nil {
+			return err
+		}
+		bsg.scanner = &xrayBuildScanner{client: xrayManager.Client(), xrayDetails: xrayManager.Config().GetServiceDetails()}
+	}
+	buildName, err := bsg.buildConfiguration.GetBuildName()
+	if err != nil {
+		return err
+	}
+	buildNumber, err := bsg.buildConfiguration.GetBuildNumber()
+	if err != nil {
+		return err
+	}
+	params := xrayservices.XrayBuildParams{BuildName: buildName, BuildNumber: buildNumber, Project: bsg.buildConfiguration.GetProject(), Rescan: bsg.rescan}
+	results, err := bsg.scan(params)
+	if err != nil {
+		return err
+	}
+	output, err := FormatBuildScanResults(results, bsg.format)
+	if err != nil {
+		return err
+	}
+	log.Output(output)
+	if bsg.isFailed(results) {
+		// The build scan error indicates that Xray scanned the build, and the failure isn't due to connectivity or other issues.
+		return errorutils.CheckError(utils.GetBuildScanError())
+	}
+	return nil
+}
+
+// Triggers the scan and polls for its results until the timeout, with an increasing interval between the requests.
+func (bsg *BuildScanGateCommand) scan(params xrayservices.XrayBuildParams) (*xrayservices.BuildScanResponse, error) {
+	deadline := time.Now().Add(bsg.timeout)
+	interval := initialPollInterval
+	// Waits before the next request, or returns false if the timeout is reached.
+	wait := func() bool {
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			return false
+		}
+		bsg.sleep(min(interval, remaining))
+		interval = min(interval*2, maxPollInterval)
+		return true
+	}
+
+	log.Info("Triggering Xray scan of build", params.BuildName+"/"+params.BuildNumber+"...")
+	for {
+		info, err := bsg.scanner.TriggerScan(params)
+		if err != nil {
+			return nil, err
+		}
+		// The build may not be available to Xray right after it's published.
+		if !strings.Contains(info, buildNotFoundInfo) {
+			if err = bsg.checkTriggerInfo(info); err != nil {
+				return nil, err
+			}
+			break
+		}
+		log.Debug(info)
+		if !wait() {
+			return nil, errorutils.CheckErrorf("timed out after %s waiting for the build to be available to Xray: %s", bsg.timeout, info)
+		}
+	}
+
+	log.Info("Waiting for the Xray scan to complete...")
+	interval = initialPollInterval
+	for {
+		results, done, err := bsg.scanner.GetScanResults(params, bsg.includeVulnerabilities)
+		if err != nil {
+			return nil, err
+		}
+		if done {
+			if results.Status == "failed" {
+				return nil, errorutils.CheckErrorf("Xray failed to scan the build")
+			}
+			return results, nil
+		}
+		if !wait() {
+			return nil, errorutils.CheckErrorf("timed out after %s waiting for the Xray scan to complete", bsg.timeout)
+		}
+	}
+}
+
+func (bsg *BuildScanGateCommand) checkTriggerInfo(info string) error {
+	if strings.Contains(info, buildNotIndexedInfo) {
+		return errorutils.CheckErrorf("%s", info)
+	}
+	// Without a "Fail build" policy, the build can only be gated on the severity of its vulnerabilities.
+	if strings.Contains(info, xrayservices.XrayScanBuildNoFailBuildPolicy) && (!bsg.includeVulnerabilities || bsg.severityThreshold == SeverityUnknown) {
+		return errorutils.CheckErrorf("%s. Set both the --vuln and --fail-on-severity options to gate the build on its vulnerabilities", info)
+	}
+	log.Info(info)
+	return nil
+}
+
+func (bsg *BuildScanGateCommand) isFailed(results *xrayservices.BuildScanResponse) bool {
+	if bsg.severityThreshold == SeverityUnknown {
+		return results.FailBuild
+	}
+	for _, violation := range results.Violations {
+		if ParseSeverity(violation.Severity) >= bsg.severityThreshold {
+			return true
+		}
+	}
+	for _, vulnerability := range results.Vulnerabilities {
+		if ParseSeverity(vulnerability.Severity) >= bsg.severityThreshold {
+			return true
+		}
+	}
+	return false
+}
+
+// xrayBuildScanner scans builds with the Xray CI build API.
+type xrayBuildScanner struct {
+	client      *jfroghttpclient.JfrogHttpClient
+	xrayDetails auth.ServiceDetails
+}
+
+func (xbs *xrayBuildScanner) TriggerScan(params xrayservices.XrayBuildParams) (string, error) {
+	resp, body, err := xbs.post(xrayservices.BuildScanAPI, params)
+	if err != nil {
+		return "", err
+	}
+	var scanResponse xrayservices.RequestBuildScanResponse
+	// A build which wasn't found is returned with its message, to be triggered again.
+	if resp.StatusCode == http.StatusNotFound && json.Unmarshal(body, &scanResponse) == nil && strings.Contains(scanResponse.Info, buildNotFoundInfo) {
+		return scanResponse.Info, nil
+	}
+	if err = clierrors.CheckResponseStatusWithBody(resp, body, http.StatusOK, http.StatusCreated); err != nil {
+		return "", err
+	}
+	if err = json.Unmarshal(body, &scanResponse); err != nil {
+		return "", errorutils.CheckError(err)
+	}
+	return scanResponse.Info, nil
+}
+
+func (xbs *xrayBuildScanner) GetScanResults(params xrayservices.XrayBuildParams, includeVulnerabilities bool) (*xrayservices.BuildScanResponse, bool, error) {
+	endpoint := xrayservices.BuildScanAPI + "/" + buildScanResultsEndpoint
+	if includeVulnerabilities {
+		endpoint += "?include_vulnerabilities=true"
+	}
+	resp, body, err := xbs.post(endpoint, params)
+	if err != nil {
+		return nil, false, err
+	}
+	if err = clierrors.CheckResponseStatusWithBody(resp, body, http.StatusOK, http.StatusAccepted); err != nil {
+		return nil, false, err
+	}
+	if resp.StatusCode == http.StatusAccepted {
+		return nil, false, nil
+	}
+	results := &xrayservices.BuildScanResponse{}
+	if err = json.Unmarshal(body, results); err != nil {
+		return nil, false, errorutils.CheckError(err)
+	}
+	return results, true, nil
+}
+
+func (xbs *xrayBuildScanner) post(endpoint string, params xrayservices.XrayBuildParams) (*http.Response, []byte, error) {
+	content, err := json.Marshal(params)
+	if err != nil {
+		return nil, nil, errorutils.CheckError(err)
+	}
+	httpClientDetails := xbs.xrayDetails.CreateHttpClientDetails()
+	httpClientDetails.SetContentTypeApplicationJson()
+	return xbs.client.SendPost(clientutils.AddTrailingSlashIfNeeded(xbs.xrayDetails.GetUrl())+endpoint, content, &httpClientDetails)
+}
@@ -0,0 +1,123 @@
+package buildinfo
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	xrayservices "github.com/jfrog/jfrog-client-go/xray/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeBuildScanner struct {
+	triggerInfos []string
+	pendingPolls int
+	results      *xrayservices.BuildScanResponse
+	triggers     int
+	polls        int
+}
+
+func (fbs *fakeBuildScanner) TriggerScan(xrayservices.XrayBuildParams) (string, error) {
+	info := fbs.triggerInfos[min(fbs.triggers, len(fbs.triggerInfos)-1)]
+	fbs.triggers++
+	return info, nil
+}
+
+func (fbs *fakeBuildScanner) GetScanResults(xrayservices.XrayBuildParams, bool) (*xrayservices.BuildScanResponse, bool, error) {
+	fbs.polls++
+	if fbs.polls <= fbs.pendingPolls {
+		return nil, false, nil
+	}
+	return fbs.results, true, nil
+}
+
+func TestBuildScanGateScan(t *testing.T) {
+	scanner := &fakeBuildScanner{
+		triggerInfos: []string{"Build my-build number 1 wasn't found in Artifactory", "Build scan triggered"},
+		pendingPolls: 3,
+		results:      &xrayservices.BuildScanResponse{Status: "completed"},
+	}
+	var sleeps []time.Duration
+	gate := NewBuildScanGateCommand()
+	gate.scanner = scanner
+	gate.sleep = func(duration time.Duration) {
+		sleeps = append(sleeps, duration)
+	}
+	results, err := gate.scan(xrayservices.XrayBuildParams{BuildName: "my-build", BuildNumber: "1"})
+	require.NoError(t, err)
+	assert.Equal(t, scanner.results, results)
+	assert.Equal(t, 2, scanner.triggers)
+	assert.Equal(t, 4, scanner.polls)
+	// The interval is reset when polling for the results.
+	assert.Equal(t, []time.Duration{5 * time.Second, 5 * time.Second, 10 * time.Second, 20 * time.Second}, sleeps)
+
+	// The timeout is reached while the scan is in progress.
+	scanner = &fakeBuildScanner{triggerInfos: []string{"Build scan triggered"}, pendingPolls: 1000}
+	gate.scanner = scanner
+	gate.SetTimeout(0)
+	_, err = gate.scan(xrayservices.XrayBuildParams{BuildName: "my-build", BuildNumber: "1"})
+	assert.ErrorContains(t, err, "waiting for the Xray scan to complete")
+
+	// Without a "Fail build" policy, only the severity of the vulnerabilities can gate the build.
+	gate.scanner = &fakeBuildScanner{triggerInfos: []string{xrayservices.XrayScanBuildNoFailBuildPolicy}}
+	_, err = gate.scan(xrayservices.XrayBuildParams{BuildName: "my-build", BuildNumber: "1"})
+	assert.ErrorContains(t, err, "--fail-on-severity")
+}
+
+func TestBuildScanGateIsFailed(t *testing.T) {
+	results := &xrayservices.BuildScanResponse{
+		FailBuild:       false,
+		Violations:      []xrayservices.Violation{{Severity: "Medium", IssueId: "XRAY-1"}},
+		Vulnerabilities: []xrayservices.Vulnerability{{Severity: "Critical", IssueId: "XRAY-2"}},
+	}
+	gate := NewBuildScanGateCommand()
+	assert.False(t, gate.isFailed(results))
+	results.FailBuild = true
+	assert.True(t, gate.isFailed(results))
+
+	results.Vulnerabilities = nil
+	gate.SetSeverityThreshold(SeverityHigh)
+	assert.False(t, gate.isFailed(results))
+	gate.SetSeverityThreshold(SeverityMedium)
+	assert.True(t, gate.isFailed(results))
+}
+
+func TestFormatBuildScanResults(t *testing.T) {
+	results := &xrayservices.BuildScanResponse{
+		MoreDetailsUrl: "https://xray.example.com/ui/builds/my-build/1",
+		Violations: []xrayservices.Violation{
+			{Severity: "Low", ViolationType: "license", IssueId: "XRAY-LIC", Summary: "GPL", Components: map[string]xrayservices.Component{"gav://a:b:1": {}}},
+			{Severity: "High", ViolationType: "security", IssueId: "XRAY-1", Summary: "RCE", Cves: []xrayservices.Cve{{Id: "CVE-2024-1"}}, Components: map[string]xrayservices.Component{"npm://x:1.0.0": {}}},
+		},
+	}
+
+	table, err := FormatBuildScanResults(results, TableFormat)
+	require.NoError(t, err)
+	assert.Contains(t, table, "SEVERITY")
+	assert.Less(t, strings.Index(table, "XRAY-1"), strings.Index(table, "XRAY-LIC"))
+	assert.Contains(t, table, "More details: "+results.MoreDetailsUrl)
+
+	sarifOutput, err := FormatBuildScanResults(results, SarifFormat)
+	require.NoError(t, err)
+	var report sarifReport
+	require.NoError(t, json.Unmarshal([]byte(sarifOutput), &report))
+	require.Len(t, report.Runs, 1)
+	require.Len(t, report.Runs[0].Results, 2)
+	assert.Equal(t, "CVE-2024-1", report.Runs[0].Results[0].RuleId)
+	assert.Equal(t, "error", report.Runs[0].Results[0].Level)
+	assert.Equal(t, "note", report.Runs[0].Results[1].Level)
+	assert.Equal(t, "npm://x:1.0.0", report.Runs[0].Results[0].Locations[0].LogicalLocations[0].FullyQualifiedName)
+
+	_, err = FormatBuildScanResults(results, "xml")
+	assert.ErrorContains(t, err, "unsupported format 'xml'")
+}
+
+func TestParseSeverityThreshold(t *testing.T) {
+	severity, err := ParseSeverityThreshold("critical")
+	require.NoError(t, err)
+	assert.Equal(t, SeverityCritical, severity)
+	_, err = ParseSeverityThreshold("Unknown")
+	assert.Error(t, err)
+}
@@ -0,0 +1,155 @@
+package buildinfo
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	buildinfo "github.com/jfrog/build-info-go/entities"
+	ioutils "github.com/jfrog/gofrog/io"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clientconfig"
+	"github.com/jfrog/jfrog-cli-core/v2/common/build"
+	utilsconfig "github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/jfrog/jfrog-client-go/artifactory/services"
+	servicesUtils "github.com/jfrog/jfrog-client-go/artifactory/services/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/io/fileutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const (
+	// The fields of a changelog entry are separated by tabs, which can't appear in the author or the subject.
+	changelogPrettyFormat = "format:%h%x09%an%x09%s"
+	defaultChangelogName  = "CHANGELOG.md"
+)
+
+type ChangelogEntry struct {
+	Revision string
+	Author   string
+	Subject  string
+}
+
+// Changelog holds the commits made since the range start, for publishing as a build property and as a markdown artifact.
+type Changelog struct {
+	BuildName   string
+	BuildNumber string
+	Entries     []ChangelogEntry
+}
+
+// Parses the output of git log with changelogPrettyFormat.
+func parseChangelogEntries(gitLog string) []ChangelogEntry {
+	var entries []ChangelogEntry
+	for _, line := range strings.Split(gitLog, "\n") {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		fields := strings.SplitN(line, "\t", 3)
+		for len(fields) < 3 {
+			fields = append(fields, "")
+		}
+		entries = append(entries, ChangelogEntry{Revision: fields[0], Author: fields[1], Subject: strings.TrimSpace(fields[2])})
+	}
+	return entries
+}
+
+// Markdown returns the changelog as a markdown document, with a bullet per commit.
+func (changelog *Changelog) Markdown() string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("# %s %s\n\n", changelog.BuildName, changelog.BuildNumber))
+	if len(changelog.Entries) == 0 {
+		sb.WriteString("No changes.\n")
+		return sb.String()
+	}
+	for _, entry := range changelog.Entries {
+		sb.WriteString(fmt.Sprintf("- %s `%s`", entry.Subject, entry.Revision))
+		if entry.Author != "" {
+			sb.WriteString(" (" + entry.Author + ")")
+		}
+		sb.WriteString("\n")
+	}
+	return sb.String()
+}
+
+// Returns the commits made since the latest build, or since the revision or tag the range is configured to start from.
+func (config *BuildAddGitCommand) collectChangelog(serverDetails *utilsconfig.ServerDetails, buildName, buildNumber string) (*Changelog, error) {
+	log.Info("Collecting the changelog from VCS...")
+	gitDetails := utils.GitLogDetails{DotGitPath: config.dotGitPath, PrettyFormat: changelogPrettyFormat, FromRef: config.fromRef, FromTagPattern: config.fromTagPattern}
+	gitLog, err := utils.GetPlainGitLogFromLastBuild(serverDetails, config.buildConfiguration, gitDetails)
+	if err != nil {
+		return nil, err
+	}
+	return &Changelog{BuildName: buildName, BuildNumber: buildNumber, Entries: parseChangelogEntries(gitLog)}, nil
+}
+
+// Collects the changelog, and uploads it if a changelog target is set. The server is only required for finding the
+// revision of the latest build, or for uploading.
+func (config *BuildAddGitCommand) publishChangelog(buildName, buildNumber string) (changelog *Changelog, artifacts []buildinfo.Artifact, err error) {
+	var serverDetails *utilsconfig.ServerDetails
+	if config.changelogTarget != "" || (config.fromRef == "" && config.fromTagPattern == "") {
+		if serverDetails, err = config.ServerDetails(); err != nil {
+			return
+		}
+	}
+	if changelog, err = config.collectChangelog(serverDetails, buildName, buildNumber); err != nil {
+		return
+	}
+	if config.changelogTarget != "" {
+		artifacts, err = config.uploadChangelog(serverDetails, changelog, buildName, buildNumber)
+	}
+	return
+}
+
+// Returns the path in Artifactory the changelog is uploaded to. A target ending with a slash is a folder.
+func getChangelogTarget(target string) string {
+	if strings.HasSuffix(target, "/") {
+		return target + defaultChangelogName
+	}
+	return target
+}
+
+// Uploads the changelog in markdown to the changelog target, with the properties of the build, and returns it as a
+// build artifact.
+func (config *BuildAddGitCommand) uploadChangelog(serverDetails *utilsconfig.ServerDetails, changelog *Changelog, buildName, buildNumber string) (artifacts []buildinfo.Artifact, err error) {
+	target := getChangelogTarget(config.changelogTarget)
+	tempDir, err := fileutils.CreateTempDir()
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		err = errorutils.CheckError(errors.Join(err, fileutils.RemoveTempDir(tempDir)))
+	}()
+	changelogPath := filepath.Join(tempDir, path.Base(target))
+	if err = errorutils.CheckError(os.WriteFile(changelogPath, []byte(changelog.Markdown()), 0644)); err != nil {
+		return nil, err
+	}
+
+	buildProps, err := build.CreateBuildProperties(buildName, buildNumber, config.buildConfiguration.GetProject())
+	if err != nil {
+		return nil, err
+	}
+	servicesManager, err := clientconfig.CreateServiceManager(serverDetails, -1, 0, false)
+	if err != nil {
+		return nil, err
+	}
+	uploadParams := services.NewUploadParams()
+	uploadParams.Pattern = filepath.ToSlash(changelogPath)
+	uploadParams.Target = target
+	uploadParams.Flat = true
+	uploadParams.BuildProps = buildProps
+	summary, err := servicesManager.UploadFilesWithSummary(artifactory.UploadServiceOptions{}, uploadParams)
+	if err != nil {
+		return nil, err
+	}
+	defer ioutils.Close(summary.TransferDetailsReader, &err)
+	defer ioutils.Close(summary.ArtifactsDetailsReader, &err)
+	if summary.TotalFailed > 0 {
+		return nil, errorutils.CheckErrorf("failed uploading the changelog to %s", target)
+	}
+	log.Info("Uploaded the changelog to", target)
+	return servicesUtils.ConvertArtifactsDetailsToBuildInfoArtifacts(summary.ArtifactsDetailsReader)
+}
@@ -0,0 +1,44 @@
+package buildinfo
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-core/v2/common/build"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/tests"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseChangelogEntries(t *testing.T) {
+	entries := parseChangelogEntries("a1b2c3d\tJane Doe\tJIRA-1 - Fix the build\n\ne4f5a6b\tJohn\tsubject\twith a tab\n")
+	assert.Equal(t, []ChangelogEntry{
+		{Revision: "a1b2c3d", Author: "Jane Doe", Subject: "JIRA-1 - Fix the build"},
+		{Revision: "e4f5a6b", Author: "John", Subject: "subject\twith a tab"},
+	}, entries)
+	assert.Empty(t, parseChangelogEntries("\n"))
+
+	changelog := &Changelog{BuildName: "my-build", BuildNumber: "7", Entries: entries[:1]}
+	assert.Equal(t, "# my-build 7\n\n- JIRA-1 - Fix the build `a1b2c3d` (Jane Doe)\n", changelog.Markdown())
+	changelog.Entries = nil
+	assert.Equal(t, "# my-build 7\n\nNo changes.\n", changelog.Markdown())
+}
+
+func TestGetChangelogTarget(t *testing.T) {
+	assert.Equal(t, "docs-local/app/1.0/CHANGELOG.md", getChangelogTarget("docs-local/app/1.0/"))
+	assert.Equal(t, "docs-local/app/1.0/changes.md", getChangelogTarget("docs-local/app/1.0/changes.md"))
+}
+
+func TestCollectChangelog(t *testing.T) {
+	originalFolder := "git_issues2_.git_suffix"
+	baseDir, dotGitPath := tests.PrepareDotGitDir(t, originalFolder, filepath.Join("..", "testdata"))
+	defer tests.RenamePath(dotGitPath, filepath.Join(baseDir, originalFolder), t)
+
+	// The range starts from the provided revision, so no server is required
+	config := NewBuildAddGitCommand().SetBuildConfiguration(build.NewBuildConfiguration("cli-tests-rt-build1", "1", "", "")).
+		SetDotGitPath(baseDir).SetFromRef("6198a6294722fdc75a570aac505784d2ec0d1818").SetChangelogProperty("vcs.changelog")
+	changelog, artifacts, err := config.publishChangelog("cli-tests-rt-build1", "1")
+	require.NoError(t, err)
+	assert.Empty(t, artifacts)
+	assert.Equal(t, "# cli-tests-rt-build1 1\n\n- TEST-4 - Adding text to file2.txt `b033a0e` (barbelity)\n- TEST-3 - Adding file2.txt `a9eecfb` (barbelity)\n", changelog.Markdown())
+}
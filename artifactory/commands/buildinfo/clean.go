@@ -1,6 +1,7 @@
 package buildinfo
 
 import (
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/credentials"
 	"github.com/jfrog/jfrog-cli-core/v2/common/build"
 	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
 	"github.com/jfrog/jfrog-client-go/utils/log"
@@ -25,7 +26,7 @@ func (bcc *BuildCleanCommand) CommandName() string {
 
 // Returns the default Artifactory server
 func (bcc *BuildCleanCommand) ServerDetails() (*config.ServerDetails, error) {
-	return config.GetDefaultServerConf()
+	return credentials.GetDefaultServerConf()
 }
 
 func (bcc *BuildCleanCommand) Run() error {
@@ -1,6 +1,11 @@
 package buildinfo
 
 import (
+	"os"
+	"strings"
+
+	"github.com/jfrog/build-info-go/entities"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/credentials"
 	"github.com/jfrog/jfrog-cli-core/v2/common/build"
 	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
 	"github.com/jfrog/jfrog-client-go/utils/errorutils"
@@ -9,6 +14,7 @@ import (
 
 type BuildCollectEnvCommand struct {
 	buildConfiguration *build.BuildConfiguration
+	envFilter          *EnvFilter
 }
 
 func NewBuildCollectEnvCommand() *BuildCollectEnvCommand {
@@ -20,6 +26,12 @@ func (bcec *BuildCollectEnvCommand) SetBuildConfiguration(buildConfiguration *bu
 	return bcec
 }
 
+// Sets the filter of the collected environment variables. If not set, all the variables are collected as they are.
+func (bcec *BuildCollectEnvCommand) SetEnvFilter(envFilter *EnvFilter) *BuildCollectEnvCommand {
+	bcec.envFilter = envFilter
+	return bcec
+}
+
 func (bcec *BuildCollectEnvCommand) Run() error {
 	log.Info("Collecting environment variables...")
 	buildInfoService := build.CreateBuildInfoService()
@@ -35,7 +47,19 @@ func (bcec *BuildCollectEnvCommand) Run() error {
 	if err != nil {
 		return errorutils.CheckError(err)
 	}
-	err = build.CollectEnv()
+	env := parseEnv(os.Environ())
+	if bcec.envFilter != nil {
+		var masked []string
+		env, masked = bcec.envFilter.Apply(env)
+		if len(masked) > 0 {
+			log.Info("Masked the values of the environment variables which look like secrets:", strings.Join(masked, ", "))
+		}
+	}
+	envProps := make(map[string]string, len(env))
+	for name, value := range env {
+		envProps["buildInfo.env."+name] = value
+	}
+	err = build.SavePartialBuildInfo(&entities.Partial{Env: envProps})
 	if err != nil {
 		return errorutils.CheckError(err)
 	}
@@ -45,7 +69,7 @@ func (bcec *BuildCollectEnvCommand) Run() error {
 
 // Returns the default configured Artifactory server
 func (bcec *BuildCollectEnvCommand) ServerDetails() (*config.ServerDetails, error) {
-	return config.GetDefaultServerConf()
+	return credentials.GetDefaultServerConf()
 }
 
 func (bcec *BuildCollectEnvCommand) CommandName() string {
@@ -1,7 +1,7 @@
 package buildinfo
 
 import (
-	"github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clientconfig"
 	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
 	"github.com/jfrog/jfrog-client-go/artifactory/services"
 )
@@ -32,7 +32,7 @@ func (bdc *BuildDistributeCommnad) SetBuildDistributionParams(buildDistributePar
 }
 
 func (bdc *BuildDistributeCommnad) Run() error {
-	servicesManager, err := utils.CreateServiceManager(bdc.serverDetails, -1, 0, bdc.dryRun)
+	servicesManager, err := clientconfig.CreateServiceManager(bdc.serverDetails, -1, 0, bdc.dryRun)
 	if err != nil {
 		return err
 	}
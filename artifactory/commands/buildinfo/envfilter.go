@@ -0,0 +1,160 @@
+package buildinfo
+
+import (
+	"math"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+)
+
+// The value recorded instead of the value of a variable which looks like a secret.
+const MaskedEnvValue = "******"
+
+var (
+	// Names of variables which usually hold secrets.
+	secretEnvNamePattern = regexp.MustCompile(`(?i)(password|passwd|psw|secret|token|api_?key|private_?key|access_?key|credential|auth)`)
+	// Values of well-known secret formats.
+	secretEnvValuePatterns = []*regexp.Regexp{
+		// AWS access key ids
+		regexp.MustCompile(`\b(AKIA|ASIA)[0-9A-Z]{16}\b`),
+		// GitHub tokens
+		regexp.MustCompile(`\b(gh[pousr]_[A-Za-z0-9]{36,}|github_pat_[A-Za-z0-9_]{22,})\b`),
+		// GitLab personal access tokens
+		regexp.MustCompile(`\bglpat-[A-Za-z0-9_-]{20,}`),
+		// Slack tokens
+		regexp.MustCompile(`\bxox[abposr]-[A-Za-z0-9-]{10,}`),
+		// Google API keys
+		regexp.MustCompile(`\bAIza[0-9A-Za-z_-]{35}\b`),
+		// JFrog reference tokens and API keys
+		regexp.MustCompile(`\b(cmVmdGtu[A-Za-z0-9]{56,}|AKC[A-Za-z0-9]{69,})\b`),
+		// JSON Web Tokens, such as JFrog access tokens
+		regexp.MustCompile(`\beyJ[A-Za-z0-9_-]{10,}\.eyJ[A-Za-z0-9_-]{10,}\.[A-Za-z0-9_-]+`),
+		// Private keys
+		regexp.MustCompile(`-----BEGIN [A-Z ]*PRIVATE KEY-----`),
+		// URLs with a password
+		regexp.MustCompile(`[A-Za-z][A-Za-z0-9+.-]*://[^/\s:@]+:[^/\s@]+@`),
+	}
+	// Values which may be random tokens: long strings of base64 characters, which aren't only hexadecimal digits,
+	// such as commit hashes and checksums.
+	tokenCharsPattern = regexp.MustCompile(`^[A-Za-z0-9+/=_-]+$`)
+	hexCharsPattern   = regexp.MustCompile(`^[0-9a-fA-F]+$`)
+)
+
+const (
+	minHighEntropyLength = 32
+	// The minimal Shannon entropy, in bits per character, of a value which is considered a random token.
+	// English words and paths are far below it, and random base64 strings of this length are above it.
+	minTokenEntropy = 4.5
+)
+
+// EnvFilter selects the environment variables recorded in the build-info by regular expressions on their names,
+// and masks the values which look like secrets.
+type EnvFilter struct {
+	include     []*regexp.Regexp
+	exclude     []*regexp.Regexp
+	maskSecrets bool
+}
+
+// NewEnvFilter creates a filter which records the variables whose names match any of the include expressions, or all
+// the variables if none are provided, except for those matching any of the exclude expressions.
+func NewEnvFilter(include, exclude []string, maskSecrets bool) (*EnvFilter, error) {
+	filter := &EnvFilter{maskSecrets: maskSecrets}
+	var err error
+	if filter.include, err = compileEnvPatterns(include); err != nil {
+		return nil, err
+	}
+	if filter.exclude, err = compileEnvPatterns(exclude); err != nil {
+		return nil, err
+	}
+	return filter, nil
+}
+
+func compileEnvPatterns(patterns []string) ([]*regexp.Regexp, error) {
+	var compiled []*regexp.Regexp
+	for _, pattern := range patterns {
+		if pattern == "" {
+			continue
+		}
+		expression, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, errorutils.CheckErrorf("invalid environment variables filter '%s': %s", pattern, err.Error())
+		}
+		compiled = append(compiled, expression)
+	}
+	return compiled, nil
+}
+
+// Apply returns the variables selected by the filter, and the sorted names of the variables whose values were masked.
+func (ef *EnvFilter) Apply(env map[string]string) (filtered map[string]string, masked []string) {
+	filtered = make(map[string]string)
+	for name, value := range env {
+		if !ef.isIncluded(name) {
+			continue
+		}
+		if ef.maskSecrets && IsSecretEnv(name, value) {
+			value = MaskedEnvValue
+			masked = append(masked, name)
+		}
+		filtered[name] = value
+	}
+	sort.Strings(masked)
+	return filtered, masked
+}
+
+func (ef *EnvFilter) isIncluded(name string) bool {
+	if len(ef.include) > 0 && !matchesAny(ef.include, name) {
+		return false
+	}
+	return !matchesAny(ef.exclude, name)
+}
+
+func matchesAny(expressions []*regexp.Regexp, value string) bool {
+	for _, expression := range expressions {
+		if expression.MatchString(value) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsSecretEnv returns true if the variable looks like a secret: by its name, by a known format of its value, or by its
+// value being a long random token.
+func IsSecretEnv(name, value string) bool {
+	if value == "" {
+		return false
+	}
+	if secretEnvNamePattern.MatchString(name) || matchesAny(secretEnvValuePatterns, value) {
+		return true
+	}
+	return len(value) >= minHighEntropyLength && tokenCharsPattern.MatchString(value) && !hexCharsPattern.MatchString(value) &&
+		shannonEntropy(value) >= minTokenEntropy
+}
+
+// Returns the Shannon entropy of the value, in bits per character.
+func shannonEntropy(value string) float64 {
+	counts := make(map[rune]int)
+	for _, char := range value {
+		counts[char]++
+	}
+	length := float64(len(value))
+	entropy := 0.0
+	for _, count := range counts {
+		frequency := float64(count) / length
+		entropy -= frequency * math.Log2(frequency)
+	}
+	return entropy
+}
+
+// Returns the variables of the environment entries, in the form of "name=value". Values may contain '='.
+func parseEnv(environ []string) map[string]string {
+	env := make(map[string]string)
+	for _, entry := range environ {
+		name, value, _ := strings.Cut(entry, "=")
+		if name != "" {
+			env[name] = value
+		}
+	}
+	return env
+}
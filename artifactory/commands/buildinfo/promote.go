@@ -1,10 +1,12 @@
 package buildinfo
 
 import (
-	"github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clientconfig"
+	"github.com/jfrog/jfrog-cli-artifactory/evidence/signing"
 	"github.com/jfrog/jfrog-cli-core/v2/common/build"
 	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
 	"github.com/jfrog/jfrog-client-go/artifactory/services"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
 )
 
 type BuildPromotionCommand struct {
@@ -12,6 +14,9 @@ type BuildPromotionCommand struct {
 	buildConfiguration *build.BuildConfiguration
 	serverDetails      *config.ServerDetails
 	dryRun             bool
+	chain              []PromotionStep
+	evidenceKeyPath    string
+	evidenceKeyAlias   string
 }
 
 func NewBuildPromotionCommand() *BuildPromotionCommand {
@@ -33,13 +38,31 @@ func (bpc *BuildPromotionCommand) SetPromotionParams(params services.PromotionPa
 	return bpc
 }
 
+// SetPromotionChain sets steps to promote the build through, instead of the target repository of the promotion params.
+func (bpc *BuildPromotionCommand) SetPromotionChain(chain []PromotionStep) *BuildPromotionCommand {
+	bpc.chain = chain
+	return bpc
+}
+
+// SetEvidenceKeyPath sets the path of the private key which signs the promotion, and the properties it sets on the
+// artifacts of the build, as evidence attached to the build-info. Without a key, no evidence is attached.
+func (bpc *BuildPromotionCommand) SetEvidenceKeyPath(evidenceKeyPath string) *BuildPromotionCommand {
+	bpc.evidenceKeyPath = evidenceKeyPath
+	return bpc
+}
+
+func (bpc *BuildPromotionCommand) SetEvidenceKeyAlias(evidenceKeyAlias string) *BuildPromotionCommand {
+	bpc.evidenceKeyAlias = evidenceKeyAlias
+	return bpc
+}
+
 func (bpc *BuildPromotionCommand) SetBuildConfiguration(buildConfiguration *build.BuildConfiguration) *BuildPromotionCommand {
 	bpc.buildConfiguration = buildConfiguration
 	return bpc
 }
 
 func (bpc *BuildPromotionCommand) Run() error {
-	servicesManager, err := utils.CreateServiceManager(bpc.serverDetails, -1, 0, bpc.dryRun)
+	servicesManager, err := clientconfig.CreateServiceManager(bpc.serverDetails, -1, 0, bpc.dryRun)
 	if err != nil {
 		return err
 	}
@@ -55,7 +78,33 @@ func (bpc *BuildPromotionCommand) Run() error {
 		return err
 	}
 	bpc.BuildName, bpc.BuildNumber, bpc.ProjectKey = buildName, buildNumber, bpc.buildConfiguration.GetProject()
-	return servicesManager.PromoteBuild(bpc.PromotionParams)
+	// The key is loaded before the promotion, so that a promotion isn't left without its evidence because of an invalid key.
+	var signer signing.Signer
+	if bpc.evidenceKeyPath != "" && !bpc.dryRun {
+		if signer, err = signing.LoadSigner(bpc.evidenceKeyPath); err != nil {
+			return err
+		}
+	}
+	// The properties are set on the artifacts of the build by the promotion itself, so they're set only if it succeeds.
+	targetRepos := []string{bpc.TargetRepo}
+	if len(bpc.chain) > 0 {
+		if err = PromoteBuildChain(servicesManager, bpc.PromotionParams, bpc.chain); err != nil {
+			return err
+		}
+		targetRepos = targetRepos[:0]
+		for _, step := range bpc.chain {
+			targetRepos = append(targetRepos, step.TargetRepo)
+		}
+	} else if err = servicesManager.PromoteBuild(bpc.PromotionParams); err != nil {
+		return err
+	}
+	if signer == nil {
+		return nil
+	}
+	if err = bpc.attachPromotionEvidence(servicesManager, signer, targetRepos); err != nil {
+		return errorutils.CheckErrorf("build %s/%s was promoted, but attaching the promotion evidence failed: %s", buildName, buildNumber, err.Error())
+	}
+	return nil
 }
 
 func (bpc *BuildPromotionCommand) ServerDetails() (*config.ServerDetails, error) {
@@ -0,0 +1,125 @@
+package buildinfo
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/jfrog/jfrog-cli-core/v2/common/spec"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/jfrog/jfrog-client-go/artifactory/services"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// PromotionStep is a step of a promotion chain. The status and comment of the step override those of the promotion, if set.
+type PromotionStep struct {
+	TargetRepo string
+	Status     string
+	Comment    string
+}
+
+// ParsePromotionChain parses a chain of the form "<repo>[:<status>[:<comment>]];...", for example "qa:tested;prod:released:approved by QA".
+func ParsePromotionChain(chain string) ([]PromotionStep, error) {
+	var steps []PromotionStep
+	for _, stepStr := range strings.Split(chain, ";") {
+		if strings.TrimSpace(stepStr) == "" {
+			continue
+		}
+		fields := strings.SplitN(stepStr, ":", 3)
+		step := PromotionStep{TargetRepo: strings.TrimSpace(fields[0])}
+		if step.TargetRepo == "" {
+			return nil, errorutils.CheckErrorf("invalid promotion step '%s', expected <repo>[:<status>[:<comment>]]", stepStr)
+		}
+		if len(fields) > 1 {
+			step.Status = fields[1]
+		}
+		if len(fields) > 2 {
+			step.Comment = fields[2]
+		}
+		steps = append(steps, step)
+	}
+	if len(steps) == 0 {
+		return nil, errorutils.CheckErrorf("the promotion chain '%s' has no steps", chain)
+	}
+	return steps, nil
+}
+
+// PromoteBuildChain promotes the build through the steps in order, each step promoting from the target of the previous one.
+// If a step fails, the completed steps are rolled back in reverse order: moved artifacts are moved back to their source,
+// and copied artifacts are deleted from the target. The statuses added by the completed steps remain in the build's history.
+func PromoteBuildChain(servicesManager artifactory.ArtifactoryServicesManager, params services.PromotionParams, steps []PromotionStep) error {
+	var completed []services.PromotionParams
+	for i, step := range steps {
+		stepParams := params
+		stepParams.TargetRepo = step.TargetRepo
+		if step.Status != "" {
+			stepParams.Status = step.Status
+		}
+		if step.Comment != "" {
+			stepParams.Comment = step.Comment
+		}
+		if i > 0 {
+			stepParams.SourceRepo = steps[i-1].TargetRepo
+		}
+		log.Info(fmt.Sprintf("Promotion step %d of %d: promoting build %s/%s to %s...", i+1, len(steps), params.BuildName, params.BuildNumber, step.TargetRepo))
+		if err := servicesManager.PromoteBuild(stepParams); err != nil {
+			if rollbackErr := rollbackPromotions(servicesManager, completed); rollbackErr != nil {
+				return errorutils.CheckErrorf("promotion step %d to '%s' failed: %s. Rolling back the previous steps failed too: %s", i+1, step.TargetRepo, err.Error(), rollbackErr.Error())
+			}
+			return errorutils.CheckErrorf("promotion step %d to '%s' failed, and the %d previous steps were rolled back: %s", i+1, step.TargetRepo, len(completed), err.Error())
+		}
+		completed = append(completed, stepParams)
+	}
+	return nil
+}
+
+func rollbackPromotions(servicesManager artifactory.ArtifactoryServicesManager, completed []services.PromotionParams) error {
+	for i := len(completed) - 1; i >= 0; i-- {
+		promotion := completed[i]
+		log.Info("Rolling back the promotion to", promotion.TargetRepo)
+		if promotion.Copy {
+			if err := deleteBuildArtifacts(servicesManager, promotion); err != nil {
+				return err
+			}
+			continue
+		}
+		if promotion.SourceRepo == "" {
+			return errorutils.CheckErrorf("can't move the artifacts back from '%s', since the source repository of the promotion is unknown", promotion.TargetRepo)
+		}
+		rollback := promotion
+		rollback.SourceRepo, rollback.TargetRepo = promotion.TargetRepo, promotion.SourceRepo
+		rollback.Status = ""
+		rollback.Comment = "Rollback of the promotion to " + promotion.TargetRepo
+		rollback.Properties = ""
+		if err := servicesManager.PromoteBuild(rollback); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Deletes the artifacts (and dependencies if promoted) of the build which were copied to the target of the promotion.
+func deleteBuildArtifacts(servicesManager artifactory.ArtifactoryServicesManager, promotion services.PromotionParams) (err error) {
+	file := spec.File{Pattern: promotion.TargetRepo + "/*", Build: promotion.BuildName + "/" + promotion.BuildNumber, Project: promotion.ProjectKey, Recursive: "true"}
+	if promotion.IncludeDependencies {
+		file.IncludeDeps = "true"
+	}
+	deleteParams := services.NewDeleteParams()
+	commonParams, err := file.ToCommonParams()
+	if err != nil {
+		return err
+	}
+	deleteParams.CommonParams = commonParams
+	deleteParams.Recursive = true
+	deleteParams.IncludeDeps = promotion.IncludeDependencies
+	reader, err := servicesManager.GetPathsToDelete(deleteParams)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = errors.Join(err, errorutils.CheckError(reader.Close()))
+	}()
+	_, err = servicesManager.DeleteFiles(reader)
+	return err
+}
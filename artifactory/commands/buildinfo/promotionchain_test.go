@@ -0,0 +1,74 @@
+package buildinfo
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/jfrog/jfrog-client-go/artifactory/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type promotionServicesManager struct {
+	artifactory.EmptyArtifactoryServicesManager
+	failingTarget string
+	promotions    []services.PromotionParams
+	discarded     []services.DiscardBuildsParams
+}
+
+func (psm *promotionServicesManager) PromoteBuild(params services.PromotionParams) error {
+	if params.TargetRepo == psm.failingTarget {
+		return errors.New("promotion failed")
+	}
+	psm.promotions = append(psm.promotions, params)
+	return nil
+}
+
+func (psm *promotionServicesManager) DiscardBuilds(params services.DiscardBuildsParams) error {
+	psm.discarded = append(psm.discarded, params)
+	return nil
+}
+
+func TestParsePromotionChain(t *testing.T) {
+	steps, err := ParsePromotionChain("qa:tested;prod:released:approved: by QA;")
+	require.NoError(t, err)
+	assert.Equal(t, []PromotionStep{{TargetRepo: "qa", Status: "tested"}, {TargetRepo: "prod", Status: "released", Comment: "approved: by QA"}}, steps)
+
+	_, err = ParsePromotionChain(":tested")
+	assert.Error(t, err)
+	_, err = ParsePromotionChain(";")
+	assert.Error(t, err)
+}
+
+func TestPromoteBuildChain(t *testing.T) {
+	params := services.NewPromotionParams()
+	params.BuildName, params.BuildNumber, params.SourceRepo, params.Status = "app", "7", "dev", "promoted"
+	steps := []PromotionStep{{TargetRepo: "qa", Status: "tested"}, {TargetRepo: "staging"}, {TargetRepo: "prod", Comment: "release"}}
+
+	servicesManager := &promotionServicesManager{}
+	require.NoError(t, PromoteBuildChain(servicesManager, params, steps))
+	require.Len(t, servicesManager.promotions, 3)
+	assert.Equal(t, []string{"dev", "qa", "staging"}, []string{servicesManager.promotions[0].SourceRepo, servicesManager.promotions[1].SourceRepo, servicesManager.promotions[2].SourceRepo})
+	assert.Equal(t, []string{"tested", "promoted", "promoted"}, []string{servicesManager.promotions[0].Status, servicesManager.promotions[1].Status, servicesManager.promotions[2].Status})
+	assert.Equal(t, "release", servicesManager.promotions[2].Comment)
+
+	// The promotion to prod fails, so the promotions to staging and qa are moved back, in reverse order.
+	servicesManager = &promotionServicesManager{failingTarget: "prod"}
+	err := PromoteBuildChain(servicesManager, params, steps)
+	assert.ErrorContains(t, err, "rolled back")
+	require.Len(t, servicesManager.promotions, 4)
+	assert.Equal(t, "staging", servicesManager.promotions[2].SourceRepo)
+	assert.Equal(t, "qa", servicesManager.promotions[2].TargetRepo)
+	assert.Equal(t, "qa", servicesManager.promotions[3].SourceRepo)
+	assert.Equal(t, "dev", servicesManager.promotions[3].TargetRepo)
+}
+
+func TestApplyBuildRetention(t *testing.T) {
+	servicesManager := &promotionServicesManager{}
+	require.NoError(t, ApplyBuildRetention(servicesManager, "app", "proj", services.DiscardBuildsParams{}))
+	assert.Empty(t, servicesManager.discarded)
+
+	require.NoError(t, ApplyBuildRetention(servicesManager, "app", "proj", services.DiscardBuildsParams{MaxBuilds: "10", ExcludeBuilds: "1,2"}))
+	assert.Equal(t, []services.DiscardBuildsParams{{BuildName: "app", ProjectKey: "proj", MaxBuilds: "10", ExcludeBuilds: "1,2"}}, servicesManager.discarded)
+}
@@ -0,0 +1,99 @@
+package buildinfo
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	buildinfo "github.com/jfrog/build-info-go/entities"
+	"github.com/jfrog/jfrog-cli-artifactory/evidence/attestation"
+	"github.com/jfrog/jfrog-cli-artifactory/evidence/signing"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/jfrog/jfrog-client-go/artifactory/services"
+	serviceutils "github.com/jfrog/jfrog-client-go/artifactory/services/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const PromotionPredicateType = "https://jfrog.com/evidence/build-promotion/v1"
+
+// PromotionPredicate records a promotion of a build, and the properties it set on the artifacts of the build.
+type PromotionPredicate struct {
+	BuildName   string `json:"buildName"`
+	BuildNumber string `json:"buildNumber"`
+	Project     string `json:"project,omitempty"`
+	SourceRepo  string `json:"sourceRepo,omitempty"`
+	// The repositories the build was promoted to, in order. Promoting through a chain has a repository per step.
+	TargetRepos []string            `json:"targetRepos"`
+	Status      string              `json:"status,omitempty"`
+	Comment     string              `json:"comment,omitempty"`
+	Copy        bool                `json:"copy"`
+	Properties  map[string][]string `json:"properties,omitempty"`
+	PromotedAt  string              `json:"promotedAt"`
+}
+
+// createPromotionStatement creates an in-toto statement about the build-info of the promoted build, whose predicate
+// records the promotion.
+func createPromotionStatement(servicesManager artifactory.ArtifactoryServicesManager, params services.PromotionParams, targetRepos []string, promotedAt time.Time) (*attestation.Statement, string, error) {
+	predicate := &PromotionPredicate{
+		BuildName:   params.BuildName,
+		BuildNumber: params.BuildNumber,
+		Project:     params.ProjectKey,
+		SourceRepo:  params.SourceRepo,
+		TargetRepos: targetRepos,
+		Status:      params.Status,
+		Comment:     params.Comment,
+		Copy:        params.Copy,
+		PromotedAt:  promotedAt.UTC().Format(time.RFC3339),
+	}
+	if params.Properties != "" {
+		properties, err := serviceutils.ParseProperties(params.Properties)
+		if err != nil {
+			return nil, "", err
+		}
+		predicate.Properties = properties.ToMap()
+	}
+	subjectRepoPath, err := getBuildInfoRepoPath(servicesManager, params)
+	if err != nil {
+		return nil, "", err
+	}
+	fileInfo, err := servicesManager.FileInfo(subjectRepoPath)
+	if err != nil {
+		return nil, "", err
+	}
+	subject := attestation.Subject{Name: params.BuildName + "/" + params.BuildNumber, Digest: map[string]string{"sha256": fileInfo.Checksums.Sha256}}
+	return attestation.NewStatement(PromotionPredicateType, predicate, subject), subjectRepoPath, nil
+}
+
+// Returns the repository path of the build-info in the build-info repository, which is named by the start time of the build.
+func getBuildInfoRepoPath(servicesManager artifactory.ArtifactoryServicesManager, params services.PromotionParams) (string, error) {
+	publishedBuildInfo, found, err := servicesManager.GetBuildInfo(services.BuildInfoParams{BuildName: params.BuildName, BuildNumber: params.BuildNumber, ProjectKey: params.ProjectKey})
+	if err != nil {
+		return "", err
+	}
+	if !found {
+		return "", errorutils.CheckErrorf("build %s/%s was not found", params.BuildName, params.BuildNumber)
+	}
+	started, err := time.Parse(buildinfo.TimeFormat, publishedBuildInfo.BuildInfo.Started)
+	if err != nil {
+		return "", errorutils.CheckErrorf("failed to parse the start time of build %s/%s: %s", params.BuildName, params.BuildNumber, err.Error())
+	}
+	return fmt.Sprintf("%s/%s/%s-%s.json", serviceutils.GetBuildInfoRepositoryByProject(params.ProjectKey), params.BuildName, params.BuildNumber, strconv.FormatInt(started.UnixMilli(), 10)), nil
+}
+
+// attachPromotionEvidence signs the promotion of the build and attaches it as evidence to its build-info.
+func (bpc *BuildPromotionCommand) attachPromotionEvidence(servicesManager artifactory.ArtifactoryServicesManager, signer signing.Signer, targetRepos []string) error {
+	statement, subjectRepoPath, err := createPromotionStatement(servicesManager, bpc.PromotionParams, targetRepos, time.Now())
+	if err != nil {
+		return err
+	}
+	envelope, err := attestation.Sign(statement, signer, bpc.evidenceKeyAlias)
+	if err != nil {
+		return err
+	}
+	if err = attestation.Upload(bpc.serverDetails, subjectRepoPath, envelope); err != nil {
+		return err
+	}
+	log.Info("Attached the promotion as evidence to", subjectRepoPath)
+	return nil
+}
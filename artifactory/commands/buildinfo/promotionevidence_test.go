@@ -0,0 +1,62 @@
+package buildinfo
+
+import (
+	"testing"
+	"time"
+
+	buildinfo "github.com/jfrog/build-info-go/entities"
+	"github.com/jfrog/jfrog-client-go/artifactory/services"
+	serviceutils "github.com/jfrog/jfrog-client-go/artifactory/services/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type buildInfoServicesManager struct {
+	promotionServicesManager
+	started   string
+	fileInfos map[string]*serviceutils.FileInfo
+}
+
+func (bsm *buildInfoServicesManager) GetBuildInfo(params services.BuildInfoParams) (*buildinfo.PublishedBuildInfo, bool, error) {
+	return &buildinfo.PublishedBuildInfo{BuildInfo: buildinfo.BuildInfo{Name: params.BuildName, Number: params.BuildNumber, Started: bsm.started}}, true, nil
+}
+
+func (bsm *buildInfoServicesManager) FileInfo(relativePath string) (*serviceutils.FileInfo, error) {
+	fileInfo, ok := bsm.fileInfos[relativePath]
+	if !ok {
+		return nil, assert.AnError
+	}
+	return fileInfo, nil
+}
+
+func TestCreatePromotionStatement(t *testing.T) {
+	const buildInfoRepoPath = "artifactory-build-info/app/7-1767268800000.json"
+	buildInfoFile := &serviceutils.FileInfo{}
+	buildInfoFile.Checksums.Sha256 = "abc"
+	servicesManager := &buildInfoServicesManager{started: "2026-01-01T12:00:00.000+0000", fileInfos: map[string]*serviceutils.FileInfo{buildInfoRepoPath: buildInfoFile}}
+	params := services.NewPromotionParams()
+	params.BuildName, params.BuildNumber, params.SourceRepo, params.Status = "app", "7", "dev", "released"
+	params.Properties = "release.stage=prod;ticket=REL-1,REL-2"
+	promotedAt := time.Date(2026, 1, 2, 8, 0, 0, 0, time.UTC)
+
+	statement, subjectRepoPath, err := createPromotionStatement(servicesManager, params, []string{"qa", "prod"}, promotedAt)
+	require.NoError(t, err)
+	assert.Equal(t, buildInfoRepoPath, subjectRepoPath)
+	assert.Equal(t, PromotionPredicateType, statement.PredicateType)
+	require.Len(t, statement.Subject, 1)
+	assert.Equal(t, "app/7", statement.Subject[0].Name)
+	assert.Equal(t, map[string]string{"sha256": "abc"}, statement.Subject[0].Digest)
+	assert.Equal(t, &PromotionPredicate{
+		BuildName:   "app",
+		BuildNumber: "7",
+		SourceRepo:  "dev",
+		TargetRepos: []string{"qa", "prod"},
+		Status:      "released",
+		Properties:  map[string][]string{"release.stage": {"prod"}, "ticket": {"REL-1", "REL-2"}},
+		PromotedAt:  "2026-01-02T08:00:00Z",
+	}, statement.Predicate)
+
+	params.ProjectKey = "proj"
+	_, _, err = createPromotionStatement(servicesManager, params, []string{"prod"}, promotedAt)
+	assert.ErrorIs(t, err, assert.AnError, "the build-info is looked up in the build-info repository of the project")
+}
@@ -11,7 +11,10 @@ import (
 	buildinfo "github.com/jfrog/build-info-go/entities"
 	"github.com/jfrog/build-info-go/utils/cienv"
 	"github.com/jfrog/jfrog-cli-artifactory/artifactory/formats"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/callbacks"
+	cidetect "github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/cienv"
 	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/civcs"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clientconfig"
 	"github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
 	"github.com/jfrog/jfrog-cli-core/v2/artifactory/utils/commandsummary"
 	"github.com/jfrog/jfrog-cli-core/v2/common/build"
@@ -34,6 +37,9 @@ type BuildPublishCommand struct {
 	summary            *clientutils.Sha256Summary
 	collectGitInfo     bool
 	collectEnv         bool
+	callbacks          *callbacks.TransferCallbacks
+	retention          *services.DiscardBuildsParams
+	outputFormat       formats.Format
 	BuildAddGitCommand
 }
 
@@ -74,6 +80,26 @@ func (bpc *BuildPublishCommand) IsDetailedSummary() bool {
 	return bpc.detailedSummary
 }
 
+// SetCallbacks sets hooks that report the publishing of the build info to programs embedding the command.
+func (bpc *BuildPublishCommand) SetCallbacks(transferCallbacks *callbacks.TransferCallbacks) *BuildPublishCommand {
+	bpc.callbacks = transferCallbacks
+	return bpc
+}
+
+// SetRetention sets the retention applied to the builds of the published build's name, once it is published.
+// The build name and project of the params are set by the command.
+func (bpc *BuildPublishCommand) SetRetention(retention *services.DiscardBuildsParams) *BuildPublishCommand {
+	bpc.retention = retention
+	return bpc
+}
+
+// SetOutputFormat sets the format of the structured output of the published build-info. If it isn't set, the
+// BuildPublishOutput JSON is logged.
+func (bpc *BuildPublishCommand) SetOutputFormat(outputFormat formats.Format) *BuildPublishCommand {
+	bpc.outputFormat = outputFormat
+	return bpc
+}
+
 func (bpc *BuildPublishCommand) CommandName() string {
 	autoPublishedTriggered, err := clientutils.GetBoolEnvValue(coreutils.UsageAutoPublishedBuild, false)
 	if err != nil {
@@ -108,7 +134,13 @@ func (bpc *BuildPublishCommand) ServerDetails() (*config.ServerDetails, error) {
 }
 
 func (bpc *BuildPublishCommand) Run() error {
-	servicesManager, err := utils.CreateServiceManager(bpc.serverDetails, -1, 0, bpc.config.DryRun)
+	err := bpc.publish()
+	bpc.callbacks.Error(err)
+	return err
+}
+
+func (bpc *BuildPublishCommand) publish() error {
+	servicesManager, err := clientconfig.CreateServiceManager(bpc.serverDetails, -1, 0, bpc.config.DryRun)
 	if err != nil {
 		return err
 	}
@@ -168,6 +200,9 @@ func (bpc *BuildPublishCommand) Run() error {
 	if errorutils.CheckError(err) != nil {
 		return err
 	}
+	if ciEnv := cidetect.Detect(); ciEnv != nil {
+		ciEnv.ApplyToBuildInfo(buildInfo)
+	}
 	if bpc.buildConfiguration.IsLoadedFromConfigFile() {
 		buildInfo.Number, err = bpc.getNextBuildNumber(buildInfo.Name, servicesManager)
 		if errorutils.CheckError(err) != nil {
@@ -191,13 +226,25 @@ func (bpc *BuildPublishCommand) Run() error {
 			}
 		}
 	}
+	resolveVirtualDeploymentRepos(servicesManager, buildInfo)
+	buildInfoPath := buildInfo.Name + "/" + buildInfo.Number
+	bpc.callbacks.FileStarted(buildInfoPath)
 	summary, err := servicesManager.PublishBuildInfo(buildInfo, bpc.buildConfiguration.GetProject())
 	if bpc.IsDetailedSummary() {
 		bpc.SetSummary(summary)
 	}
-	if err != nil || bpc.config.DryRun {
+	if err != nil {
 		return err
 	}
+	if bpc.config.DryRun {
+		return bpc.printOutput(buildInfo, "", summary)
+	}
+
+	if bpc.retention != nil {
+		if err = ApplyBuildRetention(servicesManager, buildInfo.Name, bpc.buildConfiguration.GetProject(), *bpc.retention); err != nil {
+			return err
+		}
+	}
 
 	// Set CI VCS properties on artifacts from build info.
 	// This only runs if we're in a supported CI environment (GitHub Actions, GitLab CI, etc.)
@@ -222,8 +269,13 @@ func (bpc *BuildPublishCommand) Run() error {
 	if err = recordCommandSummary(buildInfo, buildLink); err != nil {
 		return err
 	}
+	bpc.callbacks.FileCompleted(clientutils.FileTransferDetails{SourcePath: buildInfoPath, TargetPath: buildLink, RtUrl: bpc.serverDetails.ArtifactoryUrl})
 
 	logMsg := "Build info successfully deployed."
+	if bpc.outputFormat != "" {
+		log.Info(logMsg)
+		return bpc.printOutput(buildInfo, buildLink, summary)
+	}
 	if bpc.IsDetailedSummary() {
 		log.Info(logMsg + " Browse it in Artifactory under " + buildLink)
 		return nil
@@ -233,6 +285,17 @@ func (bpc *BuildPublishCommand) Run() error {
 	return logJsonOutput(buildLink)
 }
 
+// ApplyBuildRetention discards the builds of the build name which the retention doesn't keep: builds beyond retention.MaxBuilds,
+// and builds older than retention.MaxDays, except for retention.ExcludeBuilds.
+func ApplyBuildRetention(servicesManager artifactory.ArtifactoryServicesManager, buildName, project string, retention services.DiscardBuildsParams) error {
+	if retention.MaxBuilds == "" && retention.MaxDays == "" {
+		return nil
+	}
+	retention.BuildName, retention.ProjectKey = buildName, project
+	log.Info(fmt.Sprintf("Applying the retention of build %s (max builds: '%s', max days: '%s')...", buildName, retention.MaxBuilds, retention.MaxDays))
+	return servicesManager.DiscardBuilds(retention)
+}
+
 // CalculateBuildNumberFrequency since the build number is not unique, we need to calculate the frequency of each build number
 // in order to delete the correct number of builds and then publish the new build.
 func CalculateBuildNumberFrequency(runs *buildinfo.BuildRuns) map[string]int {
@@ -244,6 +307,24 @@ func CalculateBuildNumberFrequency(runs *buildinfo.BuildRuns) map[string]int {
 	return frequency
 }
 
+// printOutput prints the structured output of the published build-info, if an output format is set.
+func (bpc *BuildPublishCommand) printOutput(buildInfo *buildinfo.BuildInfo, buildLink string, summary *clientutils.Sha256Summary) error {
+	if bpc.outputFormat == "" {
+		return nil
+	}
+	published := &formats.BuildPublish{
+		BuildName:      buildInfo.Name,
+		BuildNumber:    buildInfo.Number,
+		Project:        bpc.buildConfiguration.GetProject(),
+		BuildInfoUiUrl: buildLink,
+		DryRun:         bpc.config.DryRun,
+	}
+	if summary != nil {
+		published.Sha256 = summary.GetSha256()
+	}
+	return formats.Print(bpc.outputFormat, formats.BuildPublishKind, published)
+}
+
 func logJsonOutput(buildInfoUiUrl string) error {
 	output := formats.BuildPublishOutput{BuildInfoUiUrl: buildInfoUiUrl}
 	results, err := output.JSON()
@@ -109,6 +109,9 @@ func TestPrintBuildInfoLink(t *testing.T) {
 			nil,
 			false,
 			false,
+			nil,
+			nil,
+			"",
 			BuildAddGitCommand{},
 		}
 		buildPubComService, err := buildPubConf.getBuildInfoUiUrl(linkTypes[i].majorVersion, linkTypes[i].buildTime)
@@ -0,0 +1,67 @@
+package buildinfo
+
+import (
+	"fmt"
+
+	buildinfo "github.com/jfrog/build-info-go/entities"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const virtualRepoClass = "virtual"
+
+type repositoryClassDetails struct {
+	Key    string `json:"key"`
+	Rclass string `json:"rclass"`
+}
+
+// resolveVirtualDeploymentRepos replaces the original deployment repository of artifacts deployed through a virtual repository,
+// with the local repository the artifact was actually stored in.
+// Promotion and release bundle creation read the artifacts from the build-info, and fail on paths in virtual repositories.
+// Artifacts which can't be resolved are kept unchanged.
+func resolveVirtualDeploymentRepos(servicesManager artifactory.ArtifactoryServicesManager, buildInfo *buildinfo.BuildInfo) {
+	virtualRepos := make(map[string]bool)
+	resolvedCount := 0
+	for moduleIndex := range buildInfo.Modules {
+		artifacts := buildInfo.Modules[moduleIndex].Artifacts
+		for artifactIndex := range artifacts {
+			artifact := &artifacts[artifactIndex]
+			repo := artifact.OriginalDeploymentRepo
+			if repo == "" {
+				continue
+			}
+			isVirtual, checked := virtualRepos[repo]
+			if !checked {
+				isVirtual = isVirtualRepo(servicesManager, repo)
+				virtualRepos[repo] = isVirtual
+			}
+			if !isVirtual {
+				continue
+			}
+			artifactPath := constructArtifactPath(*artifact)
+			fileInfo, err := servicesManager.FileInfo(artifactPath)
+			if err != nil {
+				log.Warn(fmt.Sprintf("Failed to resolve the local repository of '%s': %s", artifactPath, err.Error()))
+				continue
+			}
+			if fileInfo.Repo == "" || fileInfo.Repo == repo {
+				continue
+			}
+			log.Debug(fmt.Sprintf("Resolved '%s' to the local repository '%s'", artifactPath, fileInfo.Repo))
+			artifact.OriginalDeploymentRepo = fileInfo.Repo
+			resolvedCount++
+		}
+	}
+	if resolvedCount > 0 {
+		log.Info(fmt.Sprintf("Resolved the local repositories of %d artifacts deployed through virtual repositories.", resolvedCount))
+	}
+}
+
+func isVirtualRepo(servicesManager artifactory.ArtifactoryServicesManager, repo string) bool {
+	repoDetails := &repositoryClassDetails{}
+	if err := servicesManager.GetRepository(repo, repoDetails); err != nil {
+		log.Debug(fmt.Sprintf("Failed to get the details of the repository '%s': %s", repo, err.Error()))
+		return false
+	}
+	return repoDetails.Rclass == virtualRepoClass
+}
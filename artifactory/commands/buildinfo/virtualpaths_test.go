@@ -0,0 +1,64 @@
+package buildinfo
+
+import (
+	"errors"
+	"testing"
+
+	buildinfo "github.com/jfrog/build-info-go/entities"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/jfrog/jfrog-client-go/artifactory/services/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+type repoResolvingServicesManager struct {
+	artifactory.EmptyArtifactoryServicesManager
+	repoClasses   map[string]string
+	localRepos    map[string]string
+	fileInfoCalls []string
+}
+
+func (m *repoResolvingServicesManager) GetRepository(repoKey string, repoDetails interface{}) error {
+	rclass, ok := m.repoClasses[repoKey]
+	if !ok {
+		return errors.New("repository not found")
+	}
+	details := repoDetails.(*repositoryClassDetails)
+	details.Key = repoKey
+	details.Rclass = rclass
+	return nil
+}
+
+func (m *repoResolvingServicesManager) FileInfo(relativePath string) (*utils.FileInfo, error) {
+	m.fileInfoCalls = append(m.fileInfoCalls, relativePath)
+	localRepo, ok := m.localRepos[relativePath]
+	if !ok {
+		return nil, errors.New("file not found")
+	}
+	return &utils.FileInfo{Repo: localRepo}, nil
+}
+
+func TestResolveVirtualDeploymentRepos(t *testing.T) {
+	servicesManager := &repoResolvingServicesManager{
+		repoClasses: map[string]string{"generic-virtual": "virtual", "generic-local": "local"},
+		localRepos:  map[string]string{"generic-virtual/a/app.zip": "generic-local"},
+	}
+	buildInfo := &buildinfo.BuildInfo{Modules: []buildinfo.Module{{
+		Artifacts: []buildinfo.Artifact{
+			{Name: "app.zip", Path: "a/app.zip", OriginalDeploymentRepo: "generic-virtual"},
+			{Name: "missing.zip", Path: "a/missing.zip", OriginalDeploymentRepo: "generic-virtual"},
+			{Name: "lib.jar", Path: "b/lib.jar", OriginalDeploymentRepo: "generic-local"},
+			{Name: "unknown.jar", Path: "c/unknown.jar", OriginalDeploymentRepo: "unknown"},
+			{Name: "no-repo.jar", Path: "no-repo.jar"},
+		},
+	}}}
+
+	resolveVirtualDeploymentRepos(servicesManager, buildInfo)
+
+	var repos []string
+	for _, artifact := range buildInfo.Modules[0].Artifacts {
+		repos = append(repos, artifact.OriginalDeploymentRepo)
+	}
+	assert.Equal(t, []string{"generic-local", "generic-virtual", "generic-local", "unknown", ""}, repos)
+	// Only artifacts in virtual repositories are looked up.
+	assert.Equal(t, []string{"generic-virtual/a/app.zip", "generic-virtual/a/missing.zip"}, servicesManager.fileInfoCalls)
+}
@@ -3,6 +3,7 @@ package buildinfo
 import (
 	"encoding/json"
 
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clientconfig"
 	"github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
 	"github.com/jfrog/jfrog-cli-core/v2/common/build"
 	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
@@ -48,7 +49,7 @@ func (bsc *BuildScanLegacyCommand) ServerDetails() (*config.ServerDetails, error
 
 func (bsc *BuildScanLegacyCommand) Run() error {
 	log.Info("Triggered Xray build scan... The scan may take a few minutes.")
-	servicesManager, err := utils.CreateServiceManager(bsc.serverDetails, -1, 0, false)
+	servicesManager, err := clientconfig.CreateServiceManager(bsc.serverDetails, -1, 0, false)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,129 @@
+package cargo
+
+import (
+	"encoding/json"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/jfrog/build-info-go/build"
+	"github.com/jfrog/build-info-go/entities"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/checksumcache"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/lockfile"
+	buildUtils "github.com/jfrog/jfrog-cli-core/v2/common/build"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const (
+	cargoLockFileName = "Cargo.lock"
+	cargoManifestName = "Cargo.toml"
+	crateExtension    = ".crate"
+
+	cargoModuleType entities.ModuleType = "cargo"
+)
+
+// The output of 'cargo metadata --no-deps', which describes the packages of the workspace.
+type cargoMetadata struct {
+	Packages        []metadataPackage `json:"packages"`
+	TargetDirectory string            `json:"target_directory"`
+	WorkspaceRoot   string            `json:"workspace_root"`
+}
+
+type metadataPackage struct {
+	Name         string `json:"name"`
+	Version      string `json:"version"`
+	ManifestPath string `json:"manifest_path"`
+}
+
+func getCargoMetadata(workingDir string) (*cargoMetadata, error) {
+	cmd := exec.Command("cargo", "metadata", "--no-deps", "--format-version", "1")
+	cmd.Dir = workingDir
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, errorutils.CheckErrorf("cargo metadata failed: %s", err.Error())
+	}
+	metadata := &cargoMetadata{}
+	if err = json.Unmarshal(output, metadata); err != nil {
+		return nil, errorutils.CheckErrorf("failed to parse the output of cargo metadata: %s", err.Error())
+	}
+	return metadata, nil
+}
+
+// getModuleId returns the id of the package in the working directory, <name>:<version>. A virtual workspace has no
+// package, so it is named after its directory.
+func (cm *cargoMetadata) getModuleId(workingDir string) string {
+	manifestPath := filepath.Join(workingDir, cargoManifestName)
+	for _, cargoPackage := range cm.Packages {
+		if filepath.Clean(cargoPackage.ManifestPath) == manifestPath {
+			return cargoPackage.Name + ":" + cargoPackage.Version
+		}
+	}
+	return filepath.Base(workingDir)
+}
+
+// saveLockfileDependencies saves the module of the working directory, with the crates pinned by the Cargo.lock of its
+// workspace. The crates are found in Artifactory by the checksums recorded in Cargo.lock.
+func saveLockfileDependencies(cargoBuildInfo *build.Build, buildConfiguration *buildUtils.BuildConfiguration, serverDetails *config.ServerDetails, metadata *cargoMetadata, workingDir string) error {
+	moduleId := buildConfiguration.GetModule()
+	if moduleId == "" {
+		moduleId = metadata.getModuleId(workingDir)
+	}
+	return lockfile.SaveModule(cargoBuildInfo, serverDetails, filepath.Join(metadata.WorkspaceRoot, cargoLockFileName), moduleId, cargoModuleType)
+}
+
+// collectPublishedCrates adds the crates packaged by 'cargo publish' to the build-info, each as the artifact of the
+// module of its package. A crate is packaged to target/package/<name>-<version>.crate. The checksums of a crate are
+// taken from the repository it was published to, if provided, so that it isn't hashed again.
+func collectPublishedCrates(cargoBuildInfo *build.Build, buildConfiguration *buildUtils.BuildConfiguration, metadata *cargoMetadata, startedOn time.Time,
+	servicesManager artifactory.ArtifactoryServicesManager, repository string) error {
+	cache := checksumcache.Load()
+	defer cache.SaveOrLog()
+	for _, cargoPackage := range metadata.Packages {
+		crateName := cargoPackage.Name + "-" + cargoPackage.Version + crateExtension
+		cratePath := filepath.Join(metadata.TargetDirectory, "package", crateName)
+		fileInfo, err := os.Stat(cratePath)
+		if err != nil || fileInfo.ModTime().Before(startedOn) {
+			// The crate wasn't packaged by this command.
+			continue
+		}
+		artifact := entities.Artifact{
+			Name: crateName,
+			Path: "crates/" + cargoPackage.Name + "/" + crateName,
+			Type: strings.TrimPrefix(crateExtension, "."),
+		}
+		if artifact.Checksum, err = getCrateChecksums(cache, servicesManager, repository, artifact.Path, cratePath); err != nil {
+			return err
+		}
+		moduleId := buildConfiguration.GetModule()
+		if moduleId == "" {
+			moduleId = cargoPackage.Name + ":" + cargoPackage.Version
+		}
+		log.Debug("Adding the published crate", crateName, "to the build-info")
+		if err = cargoBuildInfo.AddArtifacts(moduleId, cargoModuleType, artifact); err != nil {
+			return errorutils.CheckError(err)
+		}
+	}
+	return nil
+}
+
+// getCrateChecksums returns the checksums of the published crate in the repository, or of the local crate if the
+// repository isn't provided or doesn't hold it.
+func getCrateChecksums(cache *checksumcache.Cache, servicesManager artifactory.ArtifactoryServicesManager, repository, path, cratePath string) (entities.Checksum, error) {
+	if servicesManager != nil && repository != "" {
+		checksums, err := checksumcache.GetDeployedChecksums(servicesManager, repository+"/"+path)
+		if err == nil {
+			return *checksums, cache.Put(cratePath, *checksums)
+		}
+		log.Debug("Calculating the checksums of", cratePath, "since they weren't found in Artifactory:", err.Error())
+	}
+	details, err := cache.GetFileDetails(cratePath)
+	if err != nil {
+		return entities.Checksum{}, err
+	}
+	return details.Checksum, nil
+}
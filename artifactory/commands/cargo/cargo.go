@@ -0,0 +1,187 @@
+package cargo
+
+import (
+	"encoding/base64"
+	"errors"
+	"io"
+	"net/url"
+	"os"
+	"os/exec"
+	"slices"
+	"time"
+
+	gofrogcmd "github.com/jfrog/gofrog/io"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clientconfig"
+	buildUtils "github.com/jfrog/jfrog-cli-core/v2/common/build"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const (
+	// The name of the registry of the Artifactory repository in the Cargo configuration.
+	registryName = "artifactory"
+
+	// Cargo reads its configuration from these environment variables, so the registry is configured without changing the
+	// configuration files of the user or of the project. Crates.io is replaced by the registry, so the crates are
+	// resolved from Artifactory, and the registry is the default one, so the crates are published to it.
+	registryIndexEnv              = "CARGO_REGISTRIES_ARTIFACTORY_INDEX"
+	registryTokenEnv              = "CARGO_REGISTRIES_ARTIFACTORY_TOKEN"
+	registryCredentialProviderEnv = "CARGO_REGISTRIES_ARTIFACTORY_CREDENTIAL_PROVIDER"
+	defaultRegistryEnv            = "CARGO_REGISTRY_DEFAULT"
+	cratesIoReplaceWithEnv        = "CARGO_SOURCE_CRATES_IO_REPLACE_WITH"
+
+	publishCommand = "publish"
+)
+
+// The commands which resolve the crates of Cargo.lock, whose dependencies are collected into the build-info.
+var resolveCommands = []string{"build", "check", "test", "bench", "run", "doc", "fetch", "update", "generate-lockfile", publishCommand}
+
+// CargoCommand runs Cargo commands, resolving the crates from, and publishing them to, a Cargo repository in
+// Artifactory, which is configured as a sparse registry.
+type CargoCommand struct {
+	serverDetails *config.ServerDetails
+	commandName   string
+	args          []string
+	repository    string
+	env           map[string]string
+}
+
+func NewCargoCommand() *CargoCommand {
+	return &CargoCommand{}
+}
+
+func (cc *CargoCommand) SetRepo(repo string) *CargoCommand {
+	cc.repository = repo
+	return cc
+}
+
+func (cc *CargoCommand) SetArgs(arguments []string) *CargoCommand {
+	cc.args = arguments
+	return cc
+}
+
+func (cc *CargoCommand) SetCommandName(commandName string) *CargoCommand {
+	cc.commandName = commandName
+	return cc
+}
+
+func (cc *CargoCommand) SetServerDetails(serverDetails *config.ServerDetails) *CargoCommand {
+	cc.serverDetails = serverDetails
+	return cc
+}
+
+func (cc *CargoCommand) ServerDetails() (*config.ServerDetails, error) {
+	return cc.serverDetails, nil
+}
+
+func (cc *CargoCommand) CommandName() string {
+	return "rt_cargo"
+}
+
+func (cc *CargoCommand) Run() (err error) {
+	log.Info("Running Cargo", cc.commandName)
+	var buildConfiguration *buildUtils.BuildConfiguration
+	cc.args, buildConfiguration, err = buildUtils.ExtractBuildDetailsFromArgs(cc.args)
+	if err != nil {
+		return
+	}
+	cargoBuildInfo, err := buildUtils.PrepareBuildPrerequisites(buildConfiguration)
+	if err != nil {
+		return
+	}
+	defer func() {
+		if cargoBuildInfo != nil && err != nil {
+			err = errors.Join(err, cargoBuildInfo.Clean())
+		}
+	}()
+	if cc.env, err = getCargoEnv(cc.serverDetails, cc.repository); err != nil {
+		return
+	}
+	startedOn := time.Now()
+	if err = gofrogcmd.RunCmd(cc); err != nil || cargoBuildInfo == nil || !slices.Contains(resolveCommands, cc.commandName) {
+		return
+	}
+	workingDir, err := os.Getwd()
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	metadata, err := getCargoMetadata(workingDir)
+	if err != nil {
+		return
+	}
+	if err = saveLockfileDependencies(cargoBuildInfo, buildConfiguration, cc.serverDetails, metadata, workingDir); err != nil {
+		return
+	}
+	if cc.commandName == publishCommand {
+		servicesManager, err := clientconfig.CreateServiceManager(cc.serverDetails, -1, 0, false)
+		if err != nil {
+			return err
+		}
+		return collectPublishedCrates(cargoBuildInfo, buildConfiguration, metadata, startedOn, servicesManager, cc.repository)
+	}
+	return
+}
+
+// GetCargoRegistryIndexUrl returns the URL of the sparse index of the Cargo repository.
+// Example: https://myserver.jfrog.io/artifactory/api/cargo/cargo-virtual/index/
+func GetCargoRegistryIndexUrl(serverDetails *config.ServerDetails, repository string) (string, error) {
+	rtUrl, err := url.Parse(serverDetails.GetArtifactoryUrl())
+	if err != nil {
+		return "", errorutils.CheckError(err)
+	}
+	return rtUrl.JoinPath("api/cargo", repository, "index").String() + "/", nil
+}
+
+// getRegistryToken returns the token which Cargo sends to the registry in the Authorization header.
+func getRegistryToken(serverDetails *config.ServerDetails) string {
+	if accessToken := serverDetails.GetAccessToken(); accessToken != "" {
+		return "Bearer " + accessToken
+	}
+	if serverDetails.GetUser() != "" && serverDetails.GetPassword() != "" {
+		return "Basic " + base64.StdEncoding.EncodeToString([]byte(serverDetails.GetUser()+":"+serverDetails.GetPassword()))
+	}
+	return ""
+}
+
+// getCargoEnv returns the environment variables which configure the repository as a sparse registry, which replaces
+// crates.io and is the default registry of cargo publish.
+func getCargoEnv(serverDetails *config.ServerDetails, repository string) (map[string]string, error) {
+	if serverDetails == nil || repository == "" {
+		return nil, errorutils.CheckErrorf("a server and a Cargo repository are required for running Cargo against Artifactory")
+	}
+	indexUrl, err := GetCargoRegistryIndexUrl(serverDetails, repository)
+	if err != nil {
+		return nil, err
+	}
+	env := map[string]string{
+		registryIndexEnv:       "sparse+" + indexUrl,
+		defaultRegistryEnv:     registryName,
+		cratesIoReplaceWithEnv: registryName,
+	}
+	if token := getRegistryToken(serverDetails); token != "" {
+		env[registryTokenEnv] = token
+		env[registryCredentialProviderEnv] = "cargo:token"
+	}
+	return env, nil
+}
+
+func (cc *CargoCommand) GetCmd() *exec.Cmd {
+	var cmd []string
+	cmd = append(cmd, "cargo")
+	cmd = append(cmd, cc.commandName)
+	cmd = append(cmd, cc.args...)
+	return exec.Command(cmd[0], cmd[1:]...)
+}
+
+func (cc *CargoCommand) GetEnv() map[string]string {
+	return cc.env
+}
+
+func (cc *CargoCommand) GetStdWriter() io.WriteCloser {
+	return nil
+}
+
+func (cc *CargoCommand) GetErrWriter() io.WriteCloser {
+	return nil
+}
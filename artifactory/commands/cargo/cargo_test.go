@@ -0,0 +1,78 @@
+package cargo
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/jfrog/build-info-go/build"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/checksumcache"
+	buildUtils "github.com/jfrog/jfrog-cli-core/v2/common/build"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetCargoEnv(t *testing.T) {
+	serverDetails := &config.ServerDetails{ArtifactoryUrl: "https://myserver.jfrog.io/artifactory/", AccessToken: "token"}
+	env, err := getCargoEnv(serverDetails, "cargo-virtual")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		registryIndexEnv:              "sparse+https://myserver.jfrog.io/artifactory/api/cargo/cargo-virtual/index/",
+		registryTokenEnv:              "Bearer token",
+		registryCredentialProviderEnv: "cargo:token",
+		defaultRegistryEnv:            registryName,
+		cratesIoReplaceWithEnv:        registryName,
+	}, env)
+
+	serverDetails = &config.ServerDetails{ArtifactoryUrl: "https://myserver.jfrog.io/artifactory/", User: "user", Password: "pass"}
+	env, err = getCargoEnv(serverDetails, "cargo-virtual")
+	require.NoError(t, err)
+	assert.Equal(t, "Basic dXNlcjpwYXNz", env[registryTokenEnv])
+
+	env, err = getCargoEnv(&config.ServerDetails{ArtifactoryUrl: "https://myserver.jfrog.io/artifactory/"}, "cargo-virtual")
+	require.NoError(t, err)
+	assert.NotContains(t, env, registryTokenEnv)
+
+	_, err = getCargoEnv(serverDetails, "")
+	assert.Error(t, err)
+}
+
+func TestGetModuleId(t *testing.T) {
+	workspaceRoot := t.TempDir()
+	metadata := &cargoMetadata{WorkspaceRoot: workspaceRoot}
+	metadata.Packages = append(metadata.Packages, metadataPackage{Name: "app", Version: "0.1.0", ManifestPath: filepath.Join(workspaceRoot, "app", cargoManifestName)})
+	assert.Equal(t, "app:0.1.0", metadata.getModuleId(filepath.Join(workspaceRoot, "app")))
+	assert.Equal(t, filepath.Base(workspaceRoot), metadata.getModuleId(workspaceRoot))
+}
+
+func TestCollectPublishedCrates(t *testing.T) {
+	t.Setenv(checksumcache.ChecksumCacheEnv, filepath.Join(t.TempDir(), "checksum-cache.json"))
+	targetDir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(targetDir, "package"), 0755))
+	metadata := &cargoMetadata{TargetDirectory: targetDir}
+	for _, name := range []string{"app", "lib"} {
+		metadata.Packages = append(metadata.Packages, metadataPackage{Name: name, Version: "0.1.0"})
+	}
+	startedOn := time.Now().Add(-time.Minute)
+	stalePath := filepath.Join(targetDir, "package", "lib-0.1.0.crate")
+	require.NoError(t, os.WriteFile(stalePath, []byte("lib"), 0600))
+	require.NoError(t, os.Chtimes(stalePath, startedOn.Add(-time.Hour), startedOn.Add(-time.Hour)))
+	require.NoError(t, os.WriteFile(filepath.Join(targetDir, "package", "app-0.1.0.crate"), []byte("app"), 0600))
+
+	buildInfoService := build.NewBuildInfoService()
+	buildInfoService.SetTempDirPath(t.TempDir())
+	cargoBuild, err := buildInfoService.GetOrCreateBuild("cargo-build", "1")
+	require.NoError(t, err)
+	require.NoError(t, collectPublishedCrates(cargoBuild, buildUtils.NewBuildConfiguration("cargo-build", "1", "", ""), metadata, startedOn, nil, ""))
+
+	buildInfo, err := cargoBuild.ToBuildInfo()
+	require.NoError(t, err)
+	require.Len(t, buildInfo.Modules, 1)
+	assert.Equal(t, "app:0.1.0", buildInfo.Modules[0].Id)
+	assert.Equal(t, cargoModuleType, buildInfo.Modules[0].Type)
+	require.Len(t, buildInfo.Modules[0].Artifacts, 1)
+	assert.Equal(t, "crates/app/app-0.1.0.crate", buildInfo.Modules[0].Artifacts[0].Path)
+	assert.NotEmpty(t, buildInfo.Modules[0].Artifacts[0].Sha256)
+}
@@ -0,0 +1,155 @@
+package cocoapods
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/jfrog/build-info-go/build"
+	"github.com/jfrog/build-info-go/entities"
+	ioutils "github.com/jfrog/gofrog/io"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clientconfig"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/lockfile"
+	buildUtils "github.com/jfrog/jfrog-cli-core/v2/common/build"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/jfrog/jfrog-client-go/artifactory/services"
+	servicesUtils "github.com/jfrog/jfrog-client-go/artifactory/services/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/io/fileutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const (
+	podfileLockFileName = "Podfile.lock"
+	podspecExtension    = ".podspec"
+	podArchiveExtension = ".tar.gz"
+
+	podModuleType entities.ModuleType = "cocoapods"
+)
+
+// The name and version of a pod, as described by its podspec.
+type podspec struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+// saveLockfileDependencies saves the module of the working directory, with the pods pinned by its Podfile.lock. Pods
+// installed from git or from a local path aren't included.
+func saveLockfileDependencies(podBuildInfo *build.Build, buildConfiguration *buildUtils.BuildConfiguration, serverDetails *config.ServerDetails, workingDir string) error {
+	return lockfile.SaveModule(podBuildInfo, serverDetails, filepath.Join(workingDir, podfileLockFileName), lockfile.GetModuleId(buildConfiguration, workingDir), podModuleType)
+}
+
+// findPodspec returns the podspec passed as the first argument, or the single podspec in the working directory.
+func findPodspec(workingDir string, args []string) (string, error) {
+	if len(args) > 0 && (strings.HasSuffix(args[0], podspecExtension) || strings.HasSuffix(args[0], podspecExtension+".json")) {
+		return args[0], nil
+	}
+	podspecs, err := filepath.Glob(filepath.Join(workingDir, "*"+podspecExtension))
+	if err != nil {
+		return "", errorutils.CheckError(err)
+	}
+	jsonPodspecs, err := filepath.Glob(filepath.Join(workingDir, "*"+podspecExtension+".json"))
+	if err != nil {
+		return "", errorutils.CheckError(err)
+	}
+	podspecs = append(podspecs, jsonPodspecs...)
+	if len(podspecs) != 1 {
+		return "", errorutils.CheckErrorf("expected a single podspec in %s, but found %d. Provide the podspec of the published pod as the first argument", workingDir, len(podspecs))
+	}
+	return podspecs[0], nil
+}
+
+// readPodspec reads the name and the version of the pod. A Ruby podspec is converted to JSON by 'pod ipc spec'.
+func readPodspec(podspecPath string) (*podspec, error) {
+	var content []byte
+	var err error
+	if strings.HasSuffix(podspecPath, ".json") {
+		content, err = os.ReadFile(podspecPath)
+	} else {
+		content, err = exec.Command("pod", "ipc", "spec", podspecPath).Output()
+	}
+	if err != nil {
+		return nil, errorutils.CheckErrorf("failed to read the podspec %s: %s", podspecPath, err.Error())
+	}
+	spec := &podspec{}
+	if err = json.Unmarshal(content, spec); err != nil {
+		return nil, errorutils.CheckErrorf("failed to parse the podspec %s: %s", podspecPath, err.Error())
+	}
+	if spec.Name == "" || spec.Version == "" {
+		return nil, errorutils.CheckErrorf("the podspec %s has no name or version", podspecPath)
+	}
+	return spec, nil
+}
+
+// getPublishTarget returns the path of the pod's archive in the repository: <pod>/<pod>-<version>.tar.gz.
+func getPublishTarget(repository string, spec *podspec) string {
+	return path.Join(repository, spec.Name, spec.Name+"-"+spec.Version+podArchiveExtension)
+}
+
+// publishPod packages the committed source of the pod in the working directory with 'git archive', and deploys the
+// archive to the repository, adding it to the build-info.
+func publishPod(podBuildInfo *build.Build, buildConfiguration *buildUtils.BuildConfiguration, serverDetails *config.ServerDetails, repository, workingDir string, args []string) (err error) {
+	podspecPath, err := findPodspec(workingDir, args)
+	if err != nil {
+		return
+	}
+	spec, err := readPodspec(podspecPath)
+	if err != nil {
+		return
+	}
+	tempDir, err := fileutils.CreateTempDir()
+	if err != nil {
+		return
+	}
+	defer func() {
+		err = errors.Join(err, fileutils.RemoveTempDir(tempDir))
+	}()
+	archivePath := filepath.Join(tempDir, spec.Name+"-"+spec.Version+podArchiveExtension)
+	archiveCmd := exec.Command("git", "archive", "--format=tar.gz", "--output", archivePath, "HEAD")
+	archiveCmd.Dir = workingDir
+	if output, err := archiveCmd.CombinedOutput(); err != nil {
+		return errorutils.CheckErrorf("failed to package the pod %s: %s", spec.Name, strings.TrimSpace(string(output)))
+	}
+	uploadParams := services.NewUploadParams()
+	uploadParams.Pattern = filepath.ToSlash(archivePath)
+	uploadParams.Target = getPublishTarget(repository, spec)
+	uploadParams.Flat = true
+	if podBuildInfo != nil {
+		if uploadParams.BuildProps, err = buildUtils.CreateBuildPropsFromConfiguration(buildConfiguration); err != nil {
+			return
+		}
+	}
+	servicesManager, err := clientconfig.CreateServiceManager(serverDetails, -1, 0, false)
+	if err != nil {
+		return
+	}
+	summary, err := servicesManager.UploadFilesWithSummary(artifactory.UploadServiceOptions{}, uploadParams)
+	if err != nil {
+		return
+	}
+	if summary.TotalFailed > 0 || summary.TotalSucceeded == 0 {
+		return errorutils.CheckErrorf("failed to deploy the pod %s to %s", spec.Name, uploadParams.Target)
+	}
+	log.Info("Published the pod", spec.Name, spec.Version, "to", uploadParams.Target)
+	if summary.ArtifactsDetailsReader == nil {
+		return
+	}
+	defer ioutils.Close(summary.ArtifactsDetailsReader, &err)
+	if podBuildInfo == nil {
+		return
+	}
+	artifacts, err := servicesUtils.ConvertArtifactsDetailsToBuildInfoArtifacts(summary.ArtifactsDetailsReader)
+	if err != nil {
+		return
+	}
+	moduleId := buildConfiguration.GetModule()
+	if moduleId == "" {
+		moduleId = spec.Name + ":" + spec.Version
+	}
+	return errorutils.CheckError(podBuildInfo.AddArtifacts(moduleId, podModuleType, artifacts...))
+}
@@ -0,0 +1,77 @@
+package cocoapods
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/auth"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+)
+
+const (
+	netrcBlockStart = "# BEGIN JFrog CLI credentials"
+	netrcBlockEnd   = "# END JFrog CLI credentials"
+)
+
+var netrcBlockRegexp = regexp.MustCompile(`(?s)` + regexp.QuoteMeta(netrcBlockStart) + `.*?` + regexp.QuoteMeta(netrcBlockEnd) + `\n?`)
+
+// getNetrcPath returns the path of the .netrc file, which is read by curl on behalf of CocoaPods.
+func getNetrcPath() (string, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", errorutils.CheckError(err)
+	}
+	return filepath.Join(homeDir, ".netrc"), nil
+}
+
+// createNetrcBlock creates the credentials of the host of Artifactory, in which an access token is the password.
+func createNetrcBlock(serverDetails *config.ServerDetails) (string, error) {
+	rtUrl, err := url.Parse(serverDetails.GetArtifactoryUrl())
+	if err != nil {
+		return "", errorutils.CheckError(err)
+	}
+	user, password := serverDetails.GetUser(), serverDetails.GetPassword()
+	if accessToken := serverDetails.GetAccessToken(); accessToken != "" {
+		password = accessToken
+		if user == "" {
+			user = auth.ExtractUsernameFromAccessToken(accessToken)
+		}
+	}
+	if user == "" || password == "" {
+		return "", nil
+	}
+	return fmt.Sprintf("%s\nmachine %s\nlogin %s\npassword %s\n%s\n", netrcBlockStart, rtUrl.Hostname(), user, password, netrcBlockEnd), nil
+}
+
+// setNetrcBlock replaces the credentials previously written by JFrog CLI in the content of the .netrc file, or adds
+// them. They're written at the beginning of the file, since curl uses the first entry of a host.
+func setNetrcBlock(content, netrcBlock string) string {
+	return netrcBlock + netrcBlockRegexp.ReplaceAllString(content, "")
+}
+
+func setNetrcCredentials(serverDetails *config.ServerDetails) error {
+	netrcBlock, err := createNetrcBlock(serverDetails)
+	if err != nil || netrcBlock == "" {
+		return err
+	}
+	netrcPath, err := getNetrcPath()
+	if err != nil {
+		return err
+	}
+	content, err := os.ReadFile(netrcPath)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return errorutils.CheckError(err)
+	}
+	updatedContent := setNetrcBlock(string(content), netrcBlock)
+	if strings.TrimSpace(updatedContent) == strings.TrimSpace(string(content)) {
+		return nil
+	}
+	return errorutils.CheckError(os.WriteFile(netrcPath, []byte(updatedContent), 0600))
+}
@@ -0,0 +1,153 @@
+package cocoapods
+
+import (
+	"errors"
+	"io"
+	"net/url"
+	"os"
+	"os/exec"
+	"slices"
+	"strings"
+
+	gofrogcmd "github.com/jfrog/gofrog/io"
+	buildUtils "github.com/jfrog/jfrog-cli-core/v2/common/build"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const publishCommand = "publish"
+
+// The commands which install the pods of Podfile.lock, whose dependencies are collected into the build-info.
+var installCommands = []string{"install", "update"}
+
+// PodCommand runs CocoaPods commands, installing the pods from a CocoaPods repository in Artifactory, which is added as
+// a spec repo by the cocoapods-art plugin. The publish command packages the pod of the working directory and deploys it
+// to the repository, which CocoaPods has no command for.
+type PodCommand struct {
+	serverDetails *config.ServerDetails
+	commandName   string
+	args          []string
+	repository    string
+}
+
+func NewPodCommand() *PodCommand {
+	return &PodCommand{}
+}
+
+func (pc *PodCommand) SetRepo(repo string) *PodCommand {
+	pc.repository = repo
+	return pc
+}
+
+func (pc *PodCommand) SetArgs(arguments []string) *PodCommand {
+	pc.args = arguments
+	return pc
+}
+
+func (pc *PodCommand) SetCommandName(commandName string) *PodCommand {
+	pc.commandName = commandName
+	return pc
+}
+
+func (pc *PodCommand) SetServerDetails(serverDetails *config.ServerDetails) *PodCommand {
+	pc.serverDetails = serverDetails
+	return pc
+}
+
+func (pc *PodCommand) ServerDetails() (*config.ServerDetails, error) {
+	return pc.serverDetails, nil
+}
+
+func (pc *PodCommand) CommandName() string {
+	return "rt_pod"
+}
+
+func (pc *PodCommand) Run() (err error) {
+	log.Info("Running CocoaPods", pc.commandName)
+	var buildConfiguration *buildUtils.BuildConfiguration
+	pc.args, buildConfiguration, err = buildUtils.ExtractBuildDetailsFromArgs(pc.args)
+	if err != nil {
+		return
+	}
+	podBuildInfo, err := buildUtils.PrepareBuildPrerequisites(buildConfiguration)
+	if err != nil {
+		return
+	}
+	defer func() {
+		if podBuildInfo != nil && err != nil {
+			err = errors.Join(err, podBuildInfo.Clean())
+		}
+	}()
+	if pc.serverDetails == nil || pc.repository == "" {
+		return errorutils.CheckErrorf("a server and a CocoaPods repository are required for running CocoaPods against Artifactory")
+	}
+	workingDir, err := os.Getwd()
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	if pc.commandName == publishCommand {
+		return publishPod(podBuildInfo, buildConfiguration, pc.serverDetails, pc.repository, workingDir, pc.args)
+	}
+	if err = pc.configureSpecRepo(); err != nil {
+		return
+	}
+	if err = gofrogcmd.RunCmd(pc); err != nil || podBuildInfo == nil || !slices.Contains(installCommands, pc.commandName) {
+		return
+	}
+	return saveLockfileDependencies(podBuildInfo, buildConfiguration, pc.serverDetails, workingDir)
+}
+
+// GetPodsSpecRepoUrl returns the URL of the spec repo of the CocoaPods repository.
+// Example: https://myserver.jfrog.io/artifactory/api/pods/pods-virtual
+func GetPodsSpecRepoUrl(serverDetails *config.ServerDetails, repository string) (string, error) {
+	rtUrl, err := url.Parse(serverDetails.GetArtifactoryUrl())
+	if err != nil {
+		return "", errorutils.CheckError(err)
+	}
+	return rtUrl.JoinPath("api/pods", repository).String(), nil
+}
+
+// configureSpecRepo writes the credentials of Artifactory to the .netrc file, which the cocoapods-art plugin sends
+// them from, and adds the repository as a spec repo, named after it, unless it was already added.
+func (pc *PodCommand) configureSpecRepo() error {
+	specRepoUrl, err := GetPodsSpecRepoUrl(pc.serverDetails, pc.repository)
+	if err != nil {
+		return err
+	}
+	if err = setNetrcCredentials(pc.serverDetails); err != nil {
+		return err
+	}
+	output, err := exec.Command("pod", "repo-art", "list").CombinedOutput()
+	if err != nil {
+		return errorutils.CheckErrorf("failed to list the spec repos of the cocoapods-art plugin, which is required for resolving pods from Artifactory: %s", strings.TrimSpace(string(output)))
+	}
+	if strings.Contains(string(output), specRepoUrl) {
+		return nil
+	}
+	log.Info("Adding the spec repo", pc.repository, "for", specRepoUrl)
+	if output, err = exec.Command("pod", "repo-art", "add", pc.repository, specRepoUrl).CombinedOutput(); err != nil {
+		return errorutils.CheckErrorf("failed to add the spec repo %s: %s", pc.repository, strings.TrimSpace(string(output)))
+	}
+	return nil
+}
+
+func (pc *PodCommand) GetCmd() *exec.Cmd {
+	var cmd []string
+	cmd = append(cmd, "pod")
+	cmd = append(cmd, pc.commandName)
+	cmd = append(cmd, pc.args...)
+	return exec.Command(cmd[0], cmd[1:]...)
+}
+
+func (pc *PodCommand) GetEnv() map[string]string {
+	return map[string]string{}
+}
+
+func (pc *PodCommand) GetStdWriter() io.WriteCloser {
+	return nil
+}
+
+func (pc *PodCommand) GetErrWriter() io.WriteCloser {
+	return nil
+}
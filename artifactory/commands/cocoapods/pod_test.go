@@ -0,0 +1,57 @@
+package cocoapods
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateNetrcBlock(t *testing.T) {
+	serverDetails := &config.ServerDetails{ArtifactoryUrl: "https://myserver.jfrog.io/artifactory/", User: "user", Password: "pass"}
+	netrcBlock, err := createNetrcBlock(serverDetails)
+	require.NoError(t, err)
+	assert.Equal(t, netrcBlockStart+"\nmachine myserver.jfrog.io\nlogin user\npassword pass\n"+netrcBlockEnd+"\n", netrcBlock)
+
+	// Anonymous access needs no credentials.
+	netrcBlock, err = createNetrcBlock(&config.ServerDetails{ArtifactoryUrl: "https://myserver.jfrog.io/artifactory/"})
+	require.NoError(t, err)
+	assert.Empty(t, netrcBlock)
+}
+
+func TestSetNetrcBlock(t *testing.T) {
+	netrcBlock := netrcBlockStart + "\nmachine new\n" + netrcBlockEnd + "\n"
+	assert.Equal(t, netrcBlock, setNetrcBlock("", netrcBlock))
+	// The block is written first, and replaces the previous one.
+	content := "machine github.com\nlogin me\n" + netrcBlockStart + "\nmachine old\n" + netrcBlockEnd + "\n"
+	assert.Equal(t, netrcBlock+"machine github.com\nlogin me\n", setNetrcBlock(content, netrcBlock))
+}
+
+func TestFindPodspec(t *testing.T) {
+	workingDir := t.TempDir()
+	_, err := findPodspec(workingDir, nil)
+	assert.Error(t, err)
+
+	podspecPath := filepath.Join(workingDir, "MyPod.podspec.json")
+	require.NoError(t, os.WriteFile(podspecPath, []byte(`{"name": "MyPod", "version": "1.2.0", "source": {}}`), 0644))
+	found, err := findPodspec(workingDir, nil)
+	require.NoError(t, err)
+	assert.Equal(t, podspecPath, found)
+	found, err = findPodspec(workingDir, []string{"Other.podspec"})
+	require.NoError(t, err)
+	assert.Equal(t, "Other.podspec", found)
+
+	spec, err := readPodspec(podspecPath)
+	require.NoError(t, err)
+	assert.Equal(t, &podspec{Name: "MyPod", Version: "1.2.0"}, spec)
+	assert.Equal(t, "pods-local/MyPod/MyPod-1.2.0.tar.gz", getPublishTarget("pods-local", spec))
+}
+
+func TestGetPodsSpecRepoUrl(t *testing.T) {
+	specRepoUrl, err := GetPodsSpecRepoUrl(&config.ServerDetails{ArtifactoryUrl: "https://myserver.jfrog.io/artifactory/"}, "pods-virtual")
+	require.NoError(t, err)
+	assert.Equal(t, "https://myserver.jfrog.io/artifactory/api/pods/pods-virtual", specRepoUrl)
+}
@@ -7,7 +7,7 @@ import (
 	"time"
 
 	"github.com/jfrog/build-info-go/entities"
-	"github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clientconfig"
 	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
 	"github.com/jfrog/jfrog-client-go/artifactory/services"
 	specutils "github.com/jfrog/jfrog-client-go/artifactory/services/utils"
@@ -67,7 +67,7 @@ func (ac *ArtifactCollector) CollectArtifactsForPath(exactPath string) ([]entiti
 
 // searchArtifacts executes an AQL query and returns matching artifacts.
 func (ac *ArtifactCollector) searchArtifacts(aqlQuery string) ([]entities.Artifact, error) {
-	servicesManager, err := utils.CreateServiceManager(ac.serverDetails, -1, 0, false)
+	servicesManager, err := clientconfig.CreateServiceManager(ac.serverDetails, -1, 0, false)
 	if err != nil {
 		return nil, fmt.Errorf("create services manager: %w", err)
 	}
@@ -200,7 +200,7 @@ func (bps *BuildPropertySetter) SetProperties(artifacts []entities.Artifact) err
 		return nil
 	}
 
-	servicesManager, err := utils.CreateServiceManager(bps.serverDetails, -1, 0, false)
+	servicesManager, err := clientconfig.CreateServiceManager(bps.serverDetails, -1, 0, false)
 	if err != nil {
 		return fmt.Errorf("create services manager: %w", err)
 	}
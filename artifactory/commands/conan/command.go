@@ -11,6 +11,7 @@ import (
 	"github.com/jfrog/build-info-go/entities"
 	conanflex "github.com/jfrog/build-info-go/flexpack/conan"
 	gofrogcmd "github.com/jfrog/gofrog/io"
+	"github.com/jfrog/gofrog/version"
 	buildUtils "github.com/jfrog/jfrog-cli-core/v2/common/build"
 	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
 	"github.com/jfrog/jfrog-client-go/utils/log"
@@ -23,6 +24,10 @@ type ConanCommand struct {
 	serverDetails      *config.ServerDetails
 	buildConfiguration *buildUtils.BuildConfiguration
 	workingDir         string
+	// The Conan repository in Artifactory, configured as the remote of the command, if provided.
+	repo string
+	// True if the installed Conan is 1.x, which has no lockfiles of the Conan 2.x format.
+	conanV1 bool
 }
 
 // NewConanCommand creates a new ConanCommand instance.
@@ -54,6 +59,12 @@ func (c *ConanCommand) SetBuildConfiguration(config *buildUtils.BuildConfigurati
 	return c
 }
 
+// SetRepo sets the Conan repository in Artifactory to configure as the remote of the command.
+func (c *ConanCommand) SetRepo(repo string) *ConanCommand {
+	c.repo = repo
+	return c
+}
+
 // Commands that may need remote access for downloading dependencies or packages.
 // These commands might interact with Conan remotes and require authentication.
 var commandsNeedingRemoteAccess = []string{
@@ -84,8 +95,24 @@ func (c *ConanCommand) Run() error {
 	}
 	c.workingDir = workingDir
 
-	// Perform auto-login for commands that need remote access
-	if needsRemoteAccess(c.commandName) {
+	conanVersion, err := getConanVersion()
+	if err != nil {
+		return err
+	}
+	c.conanV1 = !conanVersion.AtLeast(conanV2Version)
+	if c.conanV1 {
+		log.Debug(fmt.Sprintf("Conan %s is installed. The Conan 2.x remote configuration and lockfile dependencies are skipped.", conanVersion.GetVersion()))
+		if c.repo != "" {
+			return fmt.Errorf("configuring the Conan remote of repository '%s' requires Conan %s or above, but Conan %s is installed", c.repo, conanV2Version, conanVersion.GetVersion())
+		}
+	}
+
+	if c.repo != "" {
+		if err := c.configureRepoRemote(); err != nil {
+			return err
+		}
+	} else if needsRemoteAccess(c.commandName) {
+		// Perform auto-login for commands that need remote access
 		if err := c.autoLoginToRemotes(); err != nil {
 			log.Debug(fmt.Sprintf("Auto-login warning: %v", err))
 		}
@@ -100,6 +127,47 @@ func (c *ConanCommand) Run() error {
 	return c.runConanCommand()
 }
 
+// conanV2Version is the first version of Conan 2.x, whose remotes and lockfiles are configured and collected by the
+// command. Conan 1.x commands are run as is.
+const conanV2Version = "2.0.0"
+
+// getConanVersion returns the version of the installed Conan.
+func getConanVersion() (*version.Version, error) {
+	output, err := exec.Command("conan", "--version").Output()
+	if err != nil {
+		return nil, fmt.Errorf("get Conan version: %w", err)
+	}
+	conanVersion := parseConanVersion(string(output))
+	if conanVersion == "" {
+		return nil, fmt.Errorf("unexpected output of 'conan --version': %s", strings.TrimSpace(string(output)))
+	}
+	return version.NewVersion(conanVersion), nil
+}
+
+// parseConanVersion returns the version of the output of 'conan --version', which is "Conan version X.Y.Z".
+func parseConanVersion(output string) string {
+	fields := strings.Fields(output)
+	if len(fields) < 3 || fields[0] != "Conan" || fields[1] != "version" {
+		return ""
+	}
+	return fields[2]
+}
+
+// configureRepoRemote configures the repository as a Conan remote, named after it, and uses it as the remote of the
+// command if no other remote is specified.
+func (c *ConanCommand) configureRepoRemote() error {
+	if c.serverDetails == nil {
+		return fmt.Errorf("server details are required to configure the Conan remote of repository '%s'", c.repo)
+	}
+	if err := ConfigureRemote(c.repo, c.repo, c.serverDetails); err != nil {
+		return fmt.Errorf("configure Conan remote: %w", err)
+	}
+	if needsRemoteAccess(c.commandName) && ExtractRemoteName(c.args) == "" {
+		c.args = append(c.args, "-r", c.repo)
+	}
+	return nil
+}
+
 // autoLoginToRemotes attempts to log into all configured Conan remotes that match JFrog CLI configs.
 func (c *ConanCommand) autoLoginToRemotes() error {
 	// First check if a specific remote is specified in args
@@ -242,6 +310,17 @@ func (c *ConanCommand) collectAndSaveBuildInfo() error {
 	if err != nil {
 		return fmt.Errorf("failed to collect Conan build info: %w", err)
 	}
+	if !c.conanV1 && c.serverDetails != nil && len(buildInfo.Modules) > 0 {
+		lockfilePath, err := getLockfilePath(c.args, c.workingDir)
+		if err != nil {
+			return err
+		}
+		if lockfilePath != "" {
+			if err := c.addLockfileDependencies(&buildInfo.Modules[0], lockfilePath); err != nil {
+				return fmt.Errorf("failed to collect dependencies from %s: %w", lockfilePath, err)
+			}
+		}
+	}
 	if err := saveBuildInfoLocally(buildInfo); err != nil {
 		return fmt.Errorf("failed to save build info: %w", err)
 	}
@@ -120,3 +120,31 @@ func TestConanCommand_ChainedSetters(t *testing.T) {
 	assert.Equal(t, []string{"pkg/1.0", "-r", "remote"}, cmd.args)
 	assert.Equal(t, serverDetails, cmd.serverDetails)
 }
+
+func TestConanCommand_SetRepo(t *testing.T) {
+	cmd := NewConanCommand()
+
+	result := cmd.SetRepo("conan-virtual")
+
+	assert.Equal(t, "conan-virtual", cmd.repo)
+	assert.Same(t, cmd, result, "SetRepo should return same instance for chaining")
+}
+
+func TestParseConanVersion(t *testing.T) {
+	tests := []struct {
+		name     string
+		output   string
+		expected string
+	}{
+		{name: "Conan 2.x", output: "Conan version 2.0.14\n", expected: "2.0.14"},
+		{name: "Conan 1.x", output: "Conan version 1.62.0\n", expected: "1.62.0"},
+		{name: "Unexpected output", output: "command not found", expected: ""},
+		{name: "Empty output", output: "", expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, parseConanVersion(tt.output))
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package conan
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/jfrog/build-info-go/entities"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/lockfile"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const conanLockFileName = "conan.lock"
+
+// getLockfilePath returns the path of the lockfile of the command. This is the lockfile written by the command with
+// --lockfile-out, or the one read by it with --lockfile, or the conan.lock in the working directory, which Conan uses by
+// default. An empty path is returned if the command has no lockfile.
+func getLockfilePath(args []string, workingDir string) (string, error) {
+	lockfilePath := extractFlagValue(args, "--lockfile-out")
+	if lockfilePath == "" {
+		lockfilePath = extractFlagValue(args, "--lockfile")
+	}
+	if lockfilePath == "" {
+		lockfilePath = conanLockFileName
+	}
+	if !filepath.IsAbs(lockfilePath) {
+		lockfilePath = filepath.Join(workingDir, lockfilePath)
+	}
+	if _, err := os.Stat(lockfilePath); err != nil {
+		if os.IsNotExist(err) {
+			return "", nil
+		}
+		return "", fmt.Errorf("stat lockfile: %w", err)
+	}
+	return lockfilePath, nil
+}
+
+// extractFlagValue returns the value of the flag, passed as '--flag value' or as '--flag=value'.
+func extractFlagValue(args []string, flag string) string {
+	for i, arg := range args {
+		if arg == flag && i+1 < len(args) {
+			return args[i+1]
+		}
+		if value, found := strings.CutPrefix(arg, flag+"="); found {
+			return value
+		}
+	}
+	return ""
+}
+
+// addLockfileDependencies adds the recipes pinned by the lockfile to the dependencies of the module, with the
+// checksums of their files in Artifactory. They replace the dependencies of the same ids collected from the local
+// Conan cache, and the pinned recipes which aren't in it are appended.
+func (c *ConanCommand) addLockfileDependencies(module *entities.Module, lockfilePath string) error {
+	dependencies, err := lockfile.CollectDependencies(c.serverDetails, lockfilePath, module.Id)
+	if err != nil {
+		return fmt.Errorf("collect lockfile recipes: %w", err)
+	}
+	log.Debug(fmt.Sprintf("Collected %d dependencies from %s", len(dependencies), lockfilePath))
+	mergeDependencies(module, dependencies)
+	return nil
+}
+
+// mergeDependencies replaces the dependencies of the module with the dependencies of the same ids, and appends the rest.
+func mergeDependencies(module *entities.Module, dependencies []entities.Dependency) {
+	indexes := make(map[string]int, len(module.Dependencies))
+	for i, dependency := range module.Dependencies {
+		indexes[dependency.Id] = i
+	}
+	for _, dependency := range dependencies {
+		if i, found := indexes[dependency.Id]; found {
+			module.Dependencies[i] = dependency
+			continue
+		}
+		indexes[dependency.Id] = len(module.Dependencies)
+		module.Dependencies = append(module.Dependencies, dependency)
+	}
+}
@@ -0,0 +1,78 @@
+package conan
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/jfrog/build-info-go/entities"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtractFlagValue(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     []string
+		expected string
+	}{
+		{name: "Separate value", args: []string{".", "--lockfile", "app.lock"}, expected: "app.lock"},
+		{name: "Inline value", args: []string{".", "--lockfile=app.lock"}, expected: "app.lock"},
+		{name: "Other flag with the same prefix", args: []string{".", "--lockfile-out=new.lock"}, expected: ""},
+		{name: "Flag without value", args: []string{".", "--lockfile"}, expected: ""},
+		{name: "No flag", args: []string{"."}, expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, extractFlagValue(tt.args, "--lockfile"))
+		})
+	}
+}
+
+func TestGetLockfilePath(t *testing.T) {
+	workingDir := t.TempDir()
+
+	// No lockfile in the working directory.
+	lockfilePath, err := getLockfilePath([]string{"."}, workingDir)
+	require.NoError(t, err)
+	assert.Empty(t, lockfilePath)
+
+	require.NoError(t, os.WriteFile(filepath.Join(workingDir, conanLockFileName), []byte("{}"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(workingDir, "new.lock"), []byte("{}"), 0600))
+
+	lockfilePath, err = getLockfilePath([]string{"."}, workingDir)
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(workingDir, conanLockFileName), lockfilePath)
+
+	// The lockfile written by the command is preferred over the one read by it.
+	lockfilePath, err = getLockfilePath([]string{".", "--lockfile", "missing.lock", "--lockfile-out=new.lock"}, workingDir)
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(workingDir, "new.lock"), lockfilePath)
+}
+
+func TestGetRemoteURLForRepo(t *testing.T) {
+	serverDetails := &config.ServerDetails{ArtifactoryUrl: "https://myserver.jfrog.io/artifactory/"}
+
+	assert.Equal(t, "https://myserver.jfrog.io/artifactory/api/conan/conan-local", GetRemoteURLForRepo(serverDetails, "conan-local"))
+	assert.Equal(t, "conan-local", ExtractRepoName(GetRemoteURLForRepo(serverDetails, "conan-local")))
+}
+
+func TestMergeDependencies(t *testing.T) {
+	module := &entities.Module{Id: "app:1.0", Dependencies: []entities.Dependency{
+		{Id: "zlib:1.3"},
+		{Id: "fmt:10.1.1", Checksum: entities.Checksum{Sha1: "cache"}},
+	}}
+
+	mergeDependencies(module, []entities.Dependency{
+		{Id: "fmt:10.1.1", Checksum: entities.Checksum{Sha1: "artifactory"}},
+		{Id: "openssl:3.2.0"},
+	})
+
+	assert.Equal(t, []entities.Dependency{
+		{Id: "zlib:1.3"},
+		{Id: "fmt:10.1.1", Checksum: entities.Checksum{Sha1: "artifactory"}},
+		{Id: "openssl:3.2.0"},
+	}, module.Dependencies)
+}
@@ -80,6 +80,24 @@ func ListConanRemotes() ([]ConanRemote, error) {
 	return remotes, nil
 }
 
+// ConfigureRemote adds the Conan remote of a Conan repository in Artifactory, or updates the remote if it exists, and
+// logs into it with the credentials of the server.
+func ConfigureRemote(remoteName, repoName string, serverDetails *config.ServerDetails) error {
+	remoteURL := GetRemoteURLForRepo(serverDetails, repoName)
+	log.Debug(fmt.Sprintf("Configuring Conan remote '%s' with URL: %s", remoteName, remoteURL))
+	cmd := exec.Command("conan", "remote", "add", remoteName, remoteURL, "--force")
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("conan remote add failed: %s", strings.TrimSpace(string(output)))
+	}
+	return loginToRemote(remoteName, serverDetails)
+}
+
+// GetRemoteURLForRepo returns the URL of the Conan API of a repository in Artifactory.
+// Example: "https://myserver.jfrog.io/artifactory/", "repo-name" -> "https://myserver.jfrog.io/artifactory/api/conan/repo-name"
+func GetRemoteURLForRepo(serverDetails *config.ServerDetails, repoName string) string {
+	return strings.TrimSuffix(serverDetails.ArtifactoryUrl, "/") + "/api/conan/" + repoName
+}
+
 // getRemoteURL retrieves the URL for a Conan remote.
 func getRemoteURL(remoteName string) (string, error) {
 	remotes, err := ListConanRemotes()
@@ -4,7 +4,7 @@ import (
 	"strings"
 
 	container "github.com/jfrog/jfrog-cli-artifactory/artifactory/commands/ocicontainer"
-	"github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clientconfig"
 	"github.com/jfrog/jfrog-cli-core/v2/common/build"
 	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
 	"github.com/jfrog/jfrog-client-go/artifactory"
@@ -15,6 +15,8 @@ import (
 type BuildDockerCreateCommand struct {
 	ContainerCommandBase
 	manifestSha256 string
+	// Base images of the image, which are added to the build-info as dependencies.
+	baseImages []container.DockerImage
 }
 
 func NewBuildDockerCreateCommand() *BuildDockerCreateCommand {
@@ -25,11 +27,22 @@ func NewBuildDockerCreateCommand() *BuildDockerCreateCommand {
 // This file can be generated by Kaniko using the '--image-name-with-digest-file' flag
 // or by buildx CLI using '--metadata-file' flag.
 // Tag and Sha256 will be used later on to search the image in Artifactory.
+// The base images recorded in the provenance of a buildx or BuildKit metadata file are added as well.
 func (bdc *BuildDockerCreateCommand) SetImageNameWithDigest(filePath string) (err error) {
-	bdc.image, bdc.manifestSha256, err = container.GetImageTagWithDigest(filePath)
+	if bdc.image, bdc.manifestSha256, err = container.GetImageTagWithDigest(filePath); err != nil {
+		return
+	}
+	baseImages, err := container.GetBaseImagesFromMetadata(filePath)
+	bdc.baseImages = append(bdc.baseImages, baseImages...)
 	return
 }
 
+// SetBaseImages adds base images of the image, such as those which can't be read from the image file of Kaniko.
+func (bdc *BuildDockerCreateCommand) SetBaseImages(baseImages []container.DockerImage) *BuildDockerCreateCommand {
+	bdc.baseImages = append(bdc.baseImages, baseImages...)
+	return bdc
+}
+
 func (bdc *BuildDockerCreateCommand) Run() error {
 	if err := bdc.init(); err != nil {
 		return err
@@ -47,7 +60,7 @@ func (bdc *BuildDockerCreateCommand) Run() error {
 		return err
 	}
 	project := bdc.BuildConfiguration().GetProject()
-	serviceManager, err := utils.CreateServiceManager(serverDetails, -1, 0, false)
+	serviceManager, err := clientconfig.CreateServiceManager(serverDetails, -1, 0, false)
 	if err != nil {
 		return err
 	}
@@ -61,6 +74,12 @@ func (bdc *BuildDockerCreateCommand) Run() error {
 		return errorutils.CheckErrorf("no valid images found in image file")
 	}
 
+	// The base images are queried in the registry, so no Docker daemon is required.
+	dependencies, err := container.NewDockerDependenciesBuilder(bdc.baseImages, serviceManager).GetDependencies()
+	if err != nil {
+		log.Warn("Failed to collect the dependencies of the base images:", err.Error())
+	}
+
 	// Get the repo argument (if provided) to use as fallback
 	// The repo from each image takes precedence to handle cases where tags might be in different repositories
 	fallbackRepo, _ := bdc.GetRepo()
@@ -87,6 +106,9 @@ func (bdc *BuildDockerCreateCommand) Run() error {
 		if err != nil {
 			return errorutils.CheckErrorf("build info creation failed: %s", err.Error())
 		}
+		if len(buildInfo.Modules) > 0 {
+			buildInfo.Modules[0].Dependencies = append(buildInfo.Modules[0].Dependencies, dependencies...)
+		}
 		if err := build.SaveBuildInfo(buildName, buildNumber, project, buildInfo); err != nil {
 			return errorutils.CheckErrorf("failed to save build info for '%s/%s': %s", buildName, buildNumber, err.Error())
 		}
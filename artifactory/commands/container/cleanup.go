@@ -0,0 +1,425 @@
+package container
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/commands/generic"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clientconfig"
+	"github.com/jfrog/jfrog-cli-core/v2/common/project"
+	"github.com/jfrog/jfrog-cli-core/v2/common/spec"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	clientutils "github.com/jfrog/jfrog-client-go/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+	"golang.org/x/mod/semver"
+)
+
+const (
+	manifestFileName     = "manifest.json"
+	listManifestFileName = "list.manifest.json"
+)
+
+// KeepRules decide which tags are kept by the docker cleanup. A tag is kept if any of the rules matches it.
+type KeepRules struct {
+	// Number of most recently created tags to keep for each image.
+	Last int `mapstructure:"last" json:"last,omitempty"`
+	// Keep tags which are semantic versions, such as 1.2.3 or v1.2.3.
+	Semver bool `mapstructure:"semver" json:"semver,omitempty"`
+	// Keep tags pulled within this period, for example 90d or 48h.
+	PulledWithin string `mapstructure:"pulledWithin" json:"pulledWithin,omitempty"`
+	// Keep tags matching any of these patterns. Patterns are matched against the tag, and against the image followed by '/' and the tag.
+	Tags []string `mapstructure:"tags" json:"tags,omitempty"`
+}
+
+// ReadKeepRules reads the keep rules from a YAML file of the following form:
+//
+//	keep:
+//	  last: 10
+//	  semver: true
+//	  pulledWithin: 90d
+//	  tags: ["release/*", "latest"]
+func ReadKeepRules(rulesPath string) (*KeepRules, error) {
+	vConfig, err := project.ReadConfigFile(rulesPath, project.YAML)
+	if err != nil {
+		return nil, err
+	}
+	if !vConfig.IsSet("keep") {
+		return nil, errorutils.CheckErrorf("the '%s' rules file is missing the 'keep' section", rulesPath)
+	}
+	rules := new(KeepRules)
+	if err = vConfig.UnmarshalKey("keep", rules); err != nil {
+		return nil, errorutils.CheckErrorf("failed parsing the 'keep' section of '%s': %s", rulesPath, err.Error())
+	}
+	return rules, nil
+}
+
+func (kr *KeepRules) validate() error {
+	if kr.Last < 0 {
+		return errorutils.CheckErrorf("the number of tags to keep must not be negative")
+	}
+	if kr.Last == 0 && !kr.Semver && kr.PulledWithin == "" && len(kr.Tags) == 0 {
+		return errorutils.CheckErrorf("at least one keep rule must be provided, to avoid deleting all tags")
+	}
+	for _, pattern := range kr.Tags {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return errorutils.CheckErrorf("invalid tag pattern '%s': %s", pattern, err.Error())
+		}
+	}
+	_, err := ParsePeriod(kr.PulledWithin)
+	return err
+}
+
+// ParsePeriod parses a period such as 90d, in addition to the units supported by time.ParseDuration. Empty means zero.
+func ParsePeriod(period string) (time.Duration, error) {
+	if period == "" {
+		return 0, nil
+	}
+	if days, found := strings.CutSuffix(period, "d"); found {
+		count, err := strconv.Atoi(days)
+		if err != nil || count < 0 {
+			return 0, errorutils.CheckErrorf("invalid period '%s'", period)
+		}
+		return time.Duration(count) * 24 * time.Hour, nil
+	}
+	duration, err := time.ParseDuration(period)
+	if err != nil {
+		return 0, errorutils.CheckErrorf("invalid period '%s'", period)
+	}
+	return duration, nil
+}
+
+// DockerManifest is a manifest stored in a docker repository, under <image>/<tag>.
+// Manifests referenced by a manifest list are stored under <image>/<digest>.
+type DockerManifest struct {
+	Image          string
+	Tag            string
+	IsList         bool
+	Sha256         string
+	Created        time.Time
+	LastDownloaded time.Time
+	// Digests of the manifests referenced by a manifest list.
+	References []string
+}
+
+func (dm *DockerManifest) Digest() string {
+	return "sha256:" + dm.Sha256
+}
+
+// Manifests referenced by manifest lists are stored in folders named by their digest, rather than by a tag.
+func (dm *DockerManifest) isDigestFolder() bool {
+	return strings.HasPrefix(dm.Tag, "sha256:") || strings.HasPrefix(dm.Tag, "sha256__")
+}
+
+func (dm *DockerManifest) folderDigest() string {
+	return "sha256:" + strings.TrimPrefix(strings.TrimPrefix(dm.Tag, "sha256:"), "sha256__")
+}
+
+type CleanupDecision struct {
+	Image  string `json:"image"`
+	Tag    string `json:"tag"`
+	Keep   bool   `json:"keep"`
+	Reason string `json:"reason"`
+}
+
+// PlanDockerCleanup decides which manifests to keep. Tags are kept according to the rules.
+// Manifests stored by digest are deleted only if no kept tag or manifest list references them.
+func PlanDockerCleanup(manifests []DockerManifest, rules *KeepRules, now time.Time) ([]CleanupDecision, error) {
+	if err := rules.validate(); err != nil {
+		return nil, err
+	}
+	pulledWithin, err := ParsePeriod(rules.PulledWithin)
+	if err != nil {
+		return nil, err
+	}
+
+	tagsByImage := make(map[string][]DockerManifest)
+	var images []string
+	var digestFolders []DockerManifest
+	for _, manifest := range manifests {
+		if manifest.isDigestFolder() {
+			digestFolders = append(digestFolders, manifest)
+			continue
+		}
+		if _, exists := tagsByImage[manifest.Image]; !exists {
+			images = append(images, manifest.Image)
+		}
+		tagsByImage[manifest.Image] = append(tagsByImage[manifest.Image], manifest)
+	}
+	sort.Strings(images)
+
+	var decisions []CleanupDecision
+	referenced := make(map[string]bool)
+	for _, image := range images {
+		tags := tagsByImage[image]
+		// The most recently created tags first.
+		sort.SliceStable(tags, func(i, j int) bool {
+			return tags[i].Created.After(tags[j].Created)
+		})
+		for i, tag := range tags {
+			reason := keepReason(&tag, i, rules, pulledWithin, now)
+			decisions = append(decisions, CleanupDecision{Image: image, Tag: tag.Tag, Keep: reason != "", Reason: reason})
+			if reason == "" {
+				decisions[len(decisions)-1].Reason = "no keep rule matched"
+				continue
+			}
+			referenced[tag.Digest()] = true
+			for _, reference := range tag.References {
+				referenced[reference] = true
+			}
+		}
+	}
+
+	for _, manifest := range digestFolders {
+		decision := CleanupDecision{Image: manifest.Image, Tag: manifest.Tag, Keep: referenced[manifest.folderDigest()]}
+		if decision.Keep {
+			decision.Reason = "referenced by a kept tag"
+		} else {
+			decision.Reason = "unreferenced manifest"
+		}
+		decisions = append(decisions, decision)
+	}
+	return decisions, nil
+}
+
+// Returns the reason for keeping the tag, or an empty string if it should be deleted.
+// index is the position of the tag among the tags of its image, ordered from newest to oldest.
+func keepReason(tag *DockerManifest, index int, rules *KeepRules, pulledWithin time.Duration, now time.Time) string {
+	if index < rules.Last {
+		return fmt.Sprintf("one of the last %d tags", rules.Last)
+	}
+	for _, pattern := range rules.Tags {
+		if matched, _ := path.Match(pattern, tag.Tag); matched {
+			return fmt.Sprintf("matches '%s'", pattern)
+		}
+		if matched, _ := path.Match(pattern, tag.Image+"/"+tag.Tag); matched {
+			return fmt.Sprintf("matches '%s'", pattern)
+		}
+	}
+	if rules.Semver && semver.IsValid("v"+strings.TrimPrefix(tag.Tag, "v")) {
+		return "semantic version"
+	}
+	if pulledWithin > 0 && !tag.LastDownloaded.IsZero() && now.Sub(tag.LastDownloaded) <= pulledWithin {
+		return "pulled within " + rules.PulledWithin
+	}
+	return ""
+}
+
+// DockerCleanupCommand deletes the docker tags of a repository which none of the keep rules match,
+// and the manifests which are no longer referenced by any kept tag.
+type DockerCleanupCommand struct {
+	serverDetails  *config.ServerDetails
+	repo           string
+	rules          *KeepRules
+	dryRun         bool
+	evidenceRecord string
+	decisions      []CleanupDecision
+}
+
+func NewDockerCleanupCommand() *DockerCleanupCommand {
+	return &DockerCleanupCommand{}
+}
+
+func (dcc *DockerCleanupCommand) SetServerDetails(serverDetails *config.ServerDetails) *DockerCleanupCommand {
+	dcc.serverDetails = serverDetails
+	return dcc
+}
+
+func (dcc *DockerCleanupCommand) SetRepo(repo string) *DockerCleanupCommand {
+	dcc.repo = repo
+	return dcc
+}
+
+func (dcc *DockerCleanupCommand) SetRules(rules *KeepRules) *DockerCleanupCommand {
+	dcc.rules = rules
+	return dcc
+}
+
+func (dcc *DockerCleanupCommand) SetDryRun(dryRun bool) *DockerCleanupCommand {
+	dcc.dryRun = dryRun
+	return dcc
+}
+
+// SetEvidenceRecord sets the path of a local JSON file, to which a record of each cleanup run is appended.
+func (dcc *DockerCleanupCommand) SetEvidenceRecord(evidenceRecord string) *DockerCleanupCommand {
+	dcc.evidenceRecord = evidenceRecord
+	return dcc
+}
+
+func (dcc *DockerCleanupCommand) Decisions() []CleanupDecision {
+	return dcc.decisions
+}
+
+func (dcc *DockerCleanupCommand) CommandName() string {
+	return "rt_docker_cleanup"
+}
+
+func (dcc *DockerCleanupCommand) ServerDetails() (*config.ServerDetails, error) {
+	return dcc.serverDetails, nil
+}
+
+func (dcc *DockerCleanupCommand) Run() (err error) {
+	servicesManager, err := clientconfig.CreateServiceManager(dcc.serverDetails, -1, 0, false)
+	if err != nil {
+		return err
+	}
+	manifests, err := dcc.getManifests(servicesManager)
+	if err != nil {
+		return err
+	}
+	if dcc.decisions, err = PlanDockerCleanup(manifests, dcc.rules, time.Now()); err != nil {
+		return err
+	}
+
+	var deleted []string
+	for _, decision := range dcc.decisions {
+		action := "Keep"
+		if !decision.Keep {
+			action = "Delete"
+			deleted = append(deleted, path.Join(dcc.repo, decision.Image, decision.Tag))
+		}
+		log.Output(fmt.Sprintf("%s %s:%s (%s)", action, decision.Image, decision.Tag, decision.Reason))
+	}
+	log.Info(fmt.Sprintf("%d of %d manifests are to be deleted from '%s'.", len(deleted), len(dcc.decisions), dcc.repo))
+
+	if !dcc.dryRun && len(deleted) > 0 {
+		if err = dcc.delete(deleted); err != nil {
+			return err
+		}
+	}
+	if dcc.evidenceRecord != "" {
+		return dcc.appendEvidenceRecord(deleted)
+	}
+	return nil
+}
+
+type aqlManifestItem struct {
+	Path    string `json:"path"`
+	Name    string `json:"name"`
+	Sha256  string `json:"sha256"`
+	Created string `json:"created"`
+	Stats   []struct {
+		Downloaded string `json:"downloaded"`
+	} `json:"stats"`
+}
+
+func (dcc *DockerCleanupCommand) getManifests(servicesManager artifactory.ArtifactoryServicesManager) (manifests []DockerManifest, err error) {
+	query := fmt.Sprintf(`items.find({"repo":"%s","$or":[{"name":"%s"},{"name":"%s"}]}).include("path","name","sha256","created","stat.downloaded")`,
+		dcc.repo, manifestFileName, listManifestFileName)
+	reader, err := servicesManager.Aql(query)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		err = errors.Join(err, errorutils.CheckError(reader.Close()))
+	}()
+	var result struct {
+		Results []aqlManifestItem `json:"results"`
+	}
+	if err = json.NewDecoder(reader).Decode(&result); err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+
+	for _, item := range result.Results {
+		image, tag := path.Split(item.Path)
+		manifest := DockerManifest{Image: strings.TrimSuffix(image, "/"), Tag: tag, IsList: item.Name == listManifestFileName, Sha256: item.Sha256}
+		manifest.Created, _ = time.Parse(time.RFC3339, item.Created)
+		if len(item.Stats) > 0 {
+			manifest.LastDownloaded, _ = time.Parse(time.RFC3339, item.Stats[0].Downloaded)
+		}
+		if manifest.IsList {
+			if manifest.References, err = dcc.getListReferences(servicesManager, item.Path); err != nil {
+				return nil, err
+			}
+		}
+		manifests = append(manifests, manifest)
+	}
+	return manifests, nil
+}
+
+// Returns the digests of the manifests referenced by a manifest list.
+func (dcc *DockerCleanupCommand) getListReferences(servicesManager artifactory.ArtifactoryServicesManager, listPath string) ([]string, error) {
+	serviceDetails := servicesManager.GetConfig().GetServiceDetails()
+	httpClientDetails := serviceDetails.CreateHttpClientDetails()
+	listUrl := clientutils.AddTrailingSlashIfNeeded(serviceDetails.GetUrl()) + path.Join(dcc.repo, listPath, listManifestFileName)
+	resp, body, _, err := servicesManager.Client().SendGet(listUrl, true, &httpClientDetails)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, errorutils.CheckErrorf("failed reading the manifest list '%s': %s", listPath, resp.Status)
+	}
+	var list struct {
+		Manifests []struct {
+			Digest string `json:"digest"`
+		} `json:"manifests"`
+	}
+	if err = json.Unmarshal(body, &list); err != nil {
+		return nil, errorutils.CheckErrorf("failed parsing the manifest list '%s': %s", listPath, err.Error())
+	}
+	var references []string
+	for _, manifest := range list.Manifests {
+		references = append(references, manifest.Digest)
+	}
+	return references, nil
+}
+
+func (dcc *DockerCleanupCommand) delete(folders []string) (err error) {
+	deleteSpec := new(spec.SpecFiles)
+	for _, folder := range folders {
+		deleteSpec.Files = append(deleteSpec.Files, spec.File{Pattern: folder + "/", Recursive: "true"})
+	}
+	deleteCommand := generic.NewDeleteCommand()
+	deleteCommand.SetServerDetails(dcc.serverDetails).SetSpec(deleteSpec).SetQuiet(true)
+	if err = deleteCommand.Run(); err != nil {
+		return err
+	}
+	if failed := deleteCommand.Result().FailCount(); failed > 0 {
+		return errorutils.CheckErrorf("failed deleting %d files", failed)
+	}
+	return nil
+}
+
+type cleanupRecord struct {
+	Timestamp string     `json:"timestamp"`
+	Repo      string     `json:"repo"`
+	DryRun    bool       `json:"dryRun"`
+	Rules     *KeepRules `json:"rules"`
+	Kept      int        `json:"kept"`
+	Deleted   []string   `json:"deleted"`
+}
+
+// Appends a record of the run to the evidence record file.
+func (dcc *DockerCleanupCommand) appendEvidenceRecord(deleted []string) error {
+	var records []cleanupRecord
+	content, err := os.ReadFile(dcc.evidenceRecord)
+	if err == nil {
+		if err = json.Unmarshal(content, &records); err != nil {
+			return errorutils.CheckErrorf("failed parsing the evidence record '%s': %s", dcc.evidenceRecord, err.Error())
+		}
+	} else if !os.IsNotExist(err) {
+		return errorutils.CheckError(err)
+	}
+	records = append(records, cleanupRecord{
+		Timestamp: time.Now().UTC().Format(time.RFC3339),
+		Repo:      dcc.repo,
+		DryRun:    dcc.dryRun,
+		Rules:     dcc.rules,
+		Kept:      len(dcc.decisions) - len(deleted),
+		Deleted:   deleted,
+	})
+	content, err = json.MarshalIndent(records, "", "  ")
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	return errorutils.CheckError(os.WriteFile(dcc.evidenceRecord, content, 0644))
+}
@@ -0,0 +1,64 @@
+package container
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlanDockerCleanup(t *testing.T) {
+	now := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	day := 24 * time.Hour
+	manifests := []DockerManifest{
+		{Image: "app", Tag: "build-1", Sha256: "a1", Created: now.Add(-50 * day)},
+		{Image: "app", Tag: "build-2", Sha256: "a2", Created: now.Add(-40 * day), LastDownloaded: now.Add(-10 * day)},
+		{Image: "app", Tag: "1.0.0", Sha256: "a3", Created: now.Add(-30 * day)},
+		{Image: "app", Tag: "build-4", Sha256: "a4", Created: now.Add(-20 * day)},
+		{Image: "app", Tag: "build-5", Sha256: "a5", Created: now.Add(-10 * day)},
+		{Image: "release", Tag: "old", Sha256: "r1", Created: now.Add(-100 * day)},
+		{Image: "multi", Tag: "old", IsList: true, Sha256: "m1", Created: now.Add(-60 * day), References: []string{"sha256:x1"}},
+		{Image: "multi", Tag: "new", IsList: true, Sha256: "m2", Created: now.Add(-1 * day), References: []string{"sha256:x2"}},
+		{Image: "multi", Tag: "sha256:x1", Sha256: "x1", Created: now.Add(-60 * day)},
+		{Image: "multi", Tag: "sha256__x2", Sha256: "x2", Created: now.Add(-1 * day)},
+	}
+	rules := &KeepRules{Last: 1, Semver: true, PulledWithin: "30d", Tags: []string{"release/*"}}
+	decisions, err := PlanDockerCleanup(manifests, rules, now)
+	require.NoError(t, err)
+
+	kept := make(map[string]bool)
+	for _, decision := range decisions {
+		kept[decision.Image+":"+decision.Tag] = decision.Keep
+	}
+	assert.Equal(t, map[string]bool{
+		"app:build-1":      false,
+		"app:build-2":      true,
+		"app:1.0.0":        true,
+		"app:build-4":      false,
+		"app:build-5":      true,
+		"release:old":      true,
+		"multi:old":        false,
+		"multi:new":        true,
+		"multi:sha256:x1":  false,
+		"multi:sha256__x2": true,
+	}, kept)
+}
+
+func TestPlanDockerCleanupRequiresRules(t *testing.T) {
+	_, err := PlanDockerCleanup(nil, &KeepRules{}, time.Now())
+	assert.Error(t, err)
+	_, err = PlanDockerCleanup(nil, &KeepRules{PulledWithin: "soon"}, time.Now())
+	assert.Error(t, err)
+}
+
+func TestParsePeriod(t *testing.T) {
+	period, err := ParsePeriod("90d")
+	assert.NoError(t, err)
+	assert.Equal(t, 90*24*time.Hour, period)
+	period, err = ParsePeriod("36h")
+	assert.NoError(t, err)
+	assert.Equal(t, 36*time.Hour, period)
+	_, err = ParsePeriod("d")
+	assert.Error(t, err)
+}
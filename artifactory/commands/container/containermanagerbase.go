@@ -2,7 +2,7 @@ package container
 
 import (
 	container "github.com/jfrog/jfrog-cli-artifactory/artifactory/commands/ocicontainer"
-	"github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clientconfig"
 	"github.com/jfrog/jfrog-cli-core/v2/common/build"
 	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
 	clientutils "github.com/jfrog/jfrog-client-go/utils"
@@ -48,7 +48,7 @@ func (ccb *ContainerCommandBase) GetRepo() (string, error) {
 		return ccb.repo, nil
 	}
 
-	serviceManager, err := utils.CreateServiceManager(ccb.serverDetails, -1, 0, false)
+	serviceManager, err := clientconfig.CreateServiceManager(ccb.serverDetails, -1, 0, false)
 	if err != nil {
 		return "", err
 	}
@@ -63,7 +63,7 @@ func (ccb *ContainerCommandBase) SetRepo(repo string) *ContainerCommandBase {
 
 // Since 'RtMinVersion' version of Artifactory we can fetch the docker repository without the user input (which is deprecated).
 func (ccb *ContainerCommandBase) IsGetRepoSupported() (bool, error) {
-	serviceManager, err := utils.CreateServiceManager(ccb.serverDetails, -1, 0, false)
+	serviceManager, err := clientconfig.CreateServiceManager(ccb.serverDetails, -1, 0, false)
 	if err != nil {
 		return false, err
 	}
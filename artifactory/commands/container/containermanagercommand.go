@@ -2,6 +2,7 @@ package container
 
 import (
 	container "github.com/jfrog/jfrog-cli-artifactory/artifactory/commands/ocicontainer"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/credentials"
 	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
 )
 
@@ -41,7 +42,7 @@ func (cm *ContainerCommand) PerformLogin(serverDetails *config.ServerDetails, co
 		// Otherwise refresh Token may be expireted and docker login will fail.
 		if serverDetails.ServerId != "" {
 			var err error
-			serverDetails, err = config.GetSpecificConfig(serverDetails.ServerId, true, true)
+			serverDetails, err = credentials.GetSpecificConfig(serverDetails.ServerId, true, true)
 			if err != nil {
 				return err
 			}
@@ -1,14 +1,33 @@
 package container
 
 import (
-	"github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"strings"
+
+	artifactoryutils "github.com/jfrog/jfrog-cli-artifactory/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clientconfig"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clierrors"
 	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/artifactory"
 	"github.com/jfrog/jfrog-client-go/artifactory/services"
+	clientutils "github.com/jfrog/jfrog-client-go/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
 )
 
+// DockerPromoteCommand promotes a Docker image, or one of its tags, from one repository to another.
+// When the promoted tag is a manifest list, the manifests it references are promoted first, and the layers of each of
+// them are verified to exist in the target repository before the tag is promoted. If any of them fails, the manifests
+// already promoted are removed from the target repository.
 type DockerPromoteCommand struct {
 	serverDetails *config.ServerDetails
 	params        services.DockerPromoteParams
+	dryRun        bool
 }
 
 func NewDockerPromoteCommand() *DockerPromoteCommand {
@@ -17,12 +36,18 @@ func NewDockerPromoteCommand() *DockerPromoteCommand {
 
 func (dp *DockerPromoteCommand) Run() error {
 	// Create Service Manager
-	servicesManager, err := utils.CreateServiceManager(dp.serverDetails, -1, 0, false)
+	servicesManager, err := clientconfig.CreateServiceManager(dp.serverDetails, -1, 0, false)
 	if err != nil {
 		return err
 	}
-	// Promote docker
-	return servicesManager.PromoteDocker(dp.params)
+	if dp.dryRun {
+		return newImagePromoter(servicesManager, dp.params).logPromotion()
+	}
+	// Without a tag, the whole image is promoted by Artifactory.
+	if dp.params.SourceTag == "" {
+		return servicesManager.PromoteDocker(dp.params)
+	}
+	return newImagePromoter(servicesManager, dp.params).promote()
 }
 
 func (dp *DockerPromoteCommand) CommandName() string {
@@ -42,3 +67,319 @@ func (dp *DockerPromoteCommand) SetParams(params services.DockerPromoteParams) *
 	dp.params = params
 	return dp
 }
+
+// SetDryRun sets the command to resolve the promoted image, without promoting it.
+func (dp *DockerPromoteCommand) SetDryRun(dryRun bool) *DockerPromoteCommand {
+	dp.dryRun = dryRun
+	return dp
+}
+
+// imageManifest is either an image manifest, with a config and layers, or a manifest list, referencing image manifests.
+type imageManifest struct {
+	Config struct {
+		Digest string `json:"digest"`
+	} `json:"config"`
+	Layers []struct {
+		Digest    string `json:"digest"`
+		MediaType string `json:"mediaType"`
+	} `json:"layers"`
+	Manifests []struct {
+		Digest string `json:"digest"`
+	} `json:"manifests"`
+}
+
+// Returns the names of the files holding the config and the layers of the manifest in its folder.
+// Foreign layers aren't stored in Artifactory.
+func (im *imageManifest) blobFileNames() []string {
+	var blobs []string
+	if im.Config.Digest != "" {
+		blobs = append(blobs, digestToFileName(im.Config.Digest))
+	}
+	for _, layer := range im.Layers {
+		if !strings.Contains(layer.MediaType, "foreign") {
+			blobs = append(blobs, digestToFileName(layer.Digest))
+		}
+	}
+	return blobs
+}
+
+func digestToFileName(digest string) string {
+	return strings.Replace(digest, ":", "__", 1)
+}
+
+type imagePromoter struct {
+	servicesManager artifactory.ArtifactoryServicesManager
+	params          services.DockerPromoteParams
+	targetImage     string
+	targetTag       string
+}
+
+func newImagePromoter(servicesManager artifactory.ArtifactoryServicesManager, params services.DockerPromoteParams) *imagePromoter {
+	promoter := &imagePromoter{servicesManager: servicesManager, params: params, targetImage: params.TargetDockerImage, targetTag: params.TargetTag}
+	if promoter.targetImage == "" {
+		promoter.targetImage = params.SourceDockerImage
+	}
+	if promoter.targetTag == "" {
+		promoter.targetTag = params.SourceTag
+	}
+	return promoter
+}
+
+func (ip *imagePromoter) promote() error {
+	sourceTagPath := path.Join(ip.params.SourceDockerImage, ip.params.SourceTag)
+	manifest, isList, err := ip.readTagManifest(ip.params.SourceRepo, sourceTagPath)
+	if err != nil {
+		return err
+	}
+	if !isList {
+		// The promotion API promotes the tag along with its layers.
+		if err = ip.servicesManager.PromoteDocker(ip.params); err != nil {
+			return err
+		}
+		return ip.verifyLayers(path.Join(ip.targetImage, ip.targetTag), manifest)
+	}
+	sourceFolders, err := ip.promoteListReferences(manifest)
+	if err != nil {
+		return err
+	}
+	if err = ip.servicesManager.PromoteDocker(ip.params); err != nil {
+		return err
+	}
+	if ip.params.Copy {
+		return nil
+	}
+	return ip.deleteMovedReferences(sourceFolders)
+}
+
+// logPromotion logs the promotion of the image, and of the manifests referenced by the tag, if it's a manifest list.
+func (ip *imagePromoter) logPromotion() error {
+	action := "Moving"
+	if ip.params.Copy {
+		action = "Copying"
+	}
+	source, target := ip.params.SourceDockerImage, ip.targetImage
+	if ip.params.SourceTag != "" {
+		manifest, isList, err := ip.readTagManifest(ip.params.SourceRepo, path.Join(ip.params.SourceDockerImage, ip.params.SourceTag))
+		if err != nil {
+			return err
+		}
+		if isList {
+			for _, reference := range manifest.Manifests {
+				artifactoryutils.LogDryRun("Copying the manifest", reference.Digest, "referenced by", ip.params.SourceDockerImage+":"+ip.params.SourceTag, "to", ip.params.TargetRepo)
+			}
+		}
+		source += ":" + ip.params.SourceTag
+		target += ":" + ip.targetTag
+	}
+	artifactoryutils.LogDryRun(action, "the Docker image", source, "from", ip.params.SourceRepo, "to", target, "in", ip.params.TargetRepo)
+	return nil
+}
+
+// promoteListReferences copies the manifests referenced by a manifest list to the target repository, and verifies their
+// layers. If any of them fails, the manifests copied so far are deleted. Returns the source folders of the manifests.
+func (ip *imagePromoter) promoteListReferences(list *imageManifest) (sourceFolders []string, err error) {
+	sourceDigestFolders, err := ip.getDigestFolders(ip.params.SourceRepo, ip.params.SourceDockerImage)
+	if err != nil {
+		return nil, err
+	}
+	targetDigestFolders, err := ip.getDigestFolders(ip.params.TargetRepo, ip.targetImage)
+	if err != nil {
+		return nil, err
+	}
+	var copied []string
+	defer func() {
+		if err != nil {
+			err = errors.Join(err, ip.rollback(copied))
+		}
+	}()
+	for _, reference := range list.Manifests {
+		sourceFolder, exists := sourceDigestFolders[reference.Digest]
+		if !exists {
+			return nil, errorutils.CheckErrorf("the manifest %s referenced by %s:%s wasn't found in '%s'", reference.Digest, ip.params.SourceDockerImage, ip.params.SourceTag, ip.params.SourceRepo)
+		}
+		sourceFolders = append(sourceFolders, path.Join(ip.params.SourceDockerImage, sourceFolder))
+		manifest, err := ip.readManifest(ip.params.SourceRepo, path.Join(ip.params.SourceDockerImage, sourceFolder, manifestFileName))
+		if err != nil {
+			return nil, err
+		}
+		targetFolder, exists := targetDigestFolders[reference.Digest]
+		if !exists {
+			targetFolder = sourceFolder
+			// The manifest is copied even if the image is moved, so that it can be deleted if the promotion fails.
+			referenceParams := ip.params
+			referenceParams.TargetDockerImage = ip.targetImage
+			referenceParams.SourceTag = sourceFolder
+			referenceParams.TargetTag = sourceFolder
+			referenceParams.Copy = true
+			if err = ip.servicesManager.PromoteDocker(referenceParams); err != nil {
+				return nil, err
+			}
+			copied = append(copied, path.Join(ip.targetImage, targetFolder))
+		}
+		if err = ip.verifyLayers(path.Join(ip.targetImage, targetFolder), manifest); err != nil {
+			return nil, err
+		}
+	}
+	return sourceFolders, nil
+}
+
+func (ip *imagePromoter) rollback(copied []string) error {
+	var errs []error
+	for _, folder := range copied {
+		log.Info("Deleting", folder, "from", ip.params.TargetRepo, "following the failed promotion...")
+		errs = append(errs, ip.deleteFolder(ip.params.TargetRepo, folder))
+	}
+	return errors.Join(errs...)
+}
+
+// Deletes the source folders of the manifests referenced by a moved manifest list, unless other lists of the image reference them.
+func (ip *imagePromoter) deleteMovedReferences(sourceFolders []string) error {
+	inUse, err := ip.getListedDigests(ip.params.SourceRepo, ip.params.SourceDockerImage)
+	if err != nil {
+		return err
+	}
+	for _, folder := range sourceFolders {
+		if inUse[digestFolderToDigest(path.Base(folder))] {
+			log.Info("Keeping", folder, "in", ip.params.SourceRepo, "since other tags reference it.")
+			continue
+		}
+		if err = ip.deleteFolder(ip.params.SourceRepo, folder); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// verifyLayers verifies that the config and the layers of the manifest exist in its folder in the target repository.
+func (ip *imagePromoter) verifyLayers(folder string, manifest *imageManifest) error {
+	query := fmt.Sprintf(`items.find({"repo":"%s","path":"%s","name":{"$match":"sha256__*"}}).include("name")`, ip.params.TargetRepo, folder)
+	var result struct {
+		Results []struct {
+			Name string `json:"name"`
+		} `json:"results"`
+	}
+	if err := ip.aql(query, &result); err != nil {
+		return err
+	}
+	existing := make(map[string]bool)
+	for _, item := range result.Results {
+		existing[item.Name] = true
+	}
+	var missing []string
+	for _, blob := range manifest.blobFileNames() {
+		if !existing[blob] {
+			missing = append(missing, blob)
+		}
+	}
+	if len(missing) > 0 {
+		return errorutils.CheckErrorf("the layers %s of '%s' are missing in '%s'", strings.Join(missing, ", "), folder, ip.params.TargetRepo)
+	}
+	return nil
+}
+
+// Returns the folders of the manifests stored by their digests in the image, mapped by their digests.
+// Depending on the Artifactory version, the folders are named sha256:<hash> or sha256__<hash>.
+func (ip *imagePromoter) getDigestFolders(repo, image string) (map[string]string, error) {
+	query := fmt.Sprintf(`items.find({"repo":"%s","path":{"$match":"%s/sha256*"},"name":"%s"}).include("path")`, repo, image, manifestFileName)
+	var result struct {
+		Results []struct {
+			Path string `json:"path"`
+		} `json:"results"`
+	}
+	if err := ip.aql(query, &result); err != nil {
+		return nil, err
+	}
+	folders := make(map[string]string)
+	for _, item := range result.Results {
+		folder := path.Base(item.Path)
+		folders[digestFolderToDigest(folder)] = folder
+	}
+	return folders, nil
+}
+
+// Returns the digests referenced by the manifest lists of the image.
+func (ip *imagePromoter) getListedDigests(repo, image string) (map[string]bool, error) {
+	query := fmt.Sprintf(`items.find({"repo":"%s","path":{"$match":"%s/*"},"name":"%s"}).include("path")`, repo, image, listManifestFileName)
+	var result struct {
+		Results []struct {
+			Path string `json:"path"`
+		} `json:"results"`
+	}
+	if err := ip.aql(query, &result); err != nil {
+		return nil, err
+	}
+	digests := make(map[string]bool)
+	for _, item := range result.Results {
+		list, err := ip.readManifest(repo, path.Join(item.Path, listManifestFileName))
+		if err != nil {
+			return nil, err
+		}
+		for _, reference := range list.Manifests {
+			digests[reference.Digest] = true
+		}
+	}
+	return digests, nil
+}
+
+func digestFolderToDigest(folder string) string {
+	return strings.Replace(folder, "__", ":", 1)
+}
+
+// Returns the manifest of a tag, and whether it is a manifest list.
+func (ip *imagePromoter) readTagManifest(repo, tagPath string) (manifest *imageManifest, isList bool, err error) {
+	for _, fileName := range []string{listManifestFileName, manifestFileName} {
+		manifest, err = ip.readManifest(repo, path.Join(tagPath, fileName))
+		if err != nil || manifest != nil {
+			return manifest, fileName == listManifestFileName, err
+		}
+	}
+	return nil, false, errorutils.CheckErrorf("the tag '%s' wasn't found in '%s'", tagPath, repo)
+}
+
+// Returns the manifest stored in the path, or nil if it doesn't exist.
+func (ip *imagePromoter) readManifest(repo, manifestPath string) (*imageManifest, error) {
+	serviceDetails := ip.servicesManager.GetConfig().GetServiceDetails()
+	httpClientDetails := serviceDetails.CreateHttpClientDetails()
+	manifestUrl := clientutils.AddTrailingSlashIfNeeded(serviceDetails.GetUrl()) + path.Join(repo, manifestPath)
+	resp, body, _, err := ip.servicesManager.Client().SendGet(manifestUrl, true, &httpClientDetails)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if err = clierrors.CheckResponseStatusWithBody(resp, body, http.StatusOK); err != nil {
+		return nil, err
+	}
+	manifest := new(imageManifest)
+	if err = json.Unmarshal(body, manifest); err != nil {
+		return nil, errorutils.CheckErrorf("failed parsing the manifest '%s': %s", manifestPath, err.Error())
+	}
+	return manifest, nil
+}
+
+func (ip *imagePromoter) deleteFolder(repo, folder string) error {
+	serviceDetails := ip.servicesManager.GetConfig().GetServiceDetails()
+	httpClientDetails := serviceDetails.CreateHttpClientDetails()
+	folderUrl := clientutils.AddTrailingSlashIfNeeded(serviceDetails.GetUrl()) + path.Join(repo, folder)
+	resp, body, err := ip.servicesManager.Client().SendDelete(folderUrl, nil, &httpClientDetails)
+	if err != nil {
+		return err
+	}
+	return clierrors.CheckResponseStatusWithBody(resp, body, http.StatusOK, http.StatusNoContent, http.StatusNotFound)
+}
+
+func (ip *imagePromoter) aql(query string, result any) (err error) {
+	reader, err := ip.servicesManager.Aql(query)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = errors.Join(err, errorutils.CheckError(reader.Close()))
+	}()
+	content, err := io.ReadAll(reader)
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	return errorutils.CheckError(json.Unmarshal(content, result))
+}
@@ -0,0 +1,163 @@
+package container
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/artifactory/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var aqlCriteriaPattern = regexp.MustCompile(`"(repo|path|name)":(?:"([^"]*)"|\{"\$match":"([^"]*)"\})`)
+
+// Serves the files of the Docker repositories from memory, for the APIs used by the promotion.
+// Layers named by missingLayer aren't copied by the promotion API.
+func newTestDockerRegistry(t *testing.T, files map[string]string, missingLayer string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		filePath := strings.TrimPrefix(r.URL.Path, "/")
+		switch {
+		case r.Method == http.MethodPost && filePath == "api/search/aql":
+			query, err := io.ReadAll(r.Body)
+			assert.NoError(t, err)
+			criteria := make(map[string]string)
+			for _, match := range aqlCriteriaPattern.FindAllStringSubmatch(string(query), -1) {
+				criteria[match[1]] = match[2] + match[3]
+			}
+			type item struct {
+				Path string `json:"path"`
+				Name string `json:"name"`
+			}
+			results := []item{}
+			for file := range files {
+				repo, rest, _ := strings.Cut(file, "/")
+				dir, name := path.Split(rest)
+				dir = strings.TrimSuffix(dir, "/")
+				pathMatched, _ := path.Match(criteria["path"], dir)
+				nameMatched, _ := path.Match(criteria["name"], name)
+				if repo == criteria["repo"] && pathMatched && nameMatched {
+					results = append(results, item{Path: dir, Name: name})
+				}
+			}
+			assert.NoError(t, json.NewEncoder(w).Encode(map[string]any{"results": results}))
+		case r.Method == http.MethodPost && strings.HasPrefix(filePath, "api/docker/"):
+			var body services.DockerPromoteBody
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			sourceRepo := strings.Split(filePath, "/")[2]
+			if body.TargetDockerRepository == "" {
+				body.TargetDockerRepository = body.DockerRepository
+			}
+			if body.TargetTag == "" {
+				body.TargetTag = body.Tag
+			}
+			sourcePrefix := path.Join(sourceRepo, body.DockerRepository, body.Tag) + "/"
+			targetPrefix := path.Join(body.TargetRepo, body.TargetDockerRepository, body.TargetTag) + "/"
+			for file, content := range files {
+				if strings.HasPrefix(file, sourcePrefix) {
+					if path.Base(file) != missingLayer {
+						files[targetPrefix+strings.TrimPrefix(file, sourcePrefix)] = content
+					}
+					if !body.Copy {
+						delete(files, file)
+					}
+				}
+			}
+		case r.Method == http.MethodDelete:
+			for file := range files {
+				if strings.HasPrefix(file, filePath+"/") {
+					delete(files, file)
+				}
+			}
+		default:
+			content, exists := files[filePath]
+			if !exists {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			_, err := w.Write([]byte(content))
+			assert.NoError(t, err)
+		}
+	}))
+}
+
+func newTestDockerFiles() map[string]string {
+	amd64 := `{"config":{"digest":"sha256:c1"},"layers":[{"digest":"sha256:l1"},{"digest":"sha256:f1","mediaType":"application/vnd.docker.image.rootfs.foreign.diff.tar.gzip"}]}`
+	arm64 := `{"config":{"digest":"sha256:c2"},"layers":[{"digest":"sha256:l2"}]}`
+	return map[string]string{
+		"dev/app/1.0/list.manifest.json":      `{"manifests":[{"digest":"sha256:m1"},{"digest":"sha256:m2"}]}`,
+		"dev/app/latest/list.manifest.json":   `{"manifests":[{"digest":"sha256:m2"}]}`,
+		"dev/app/sha256__m1/manifest.json":    amd64,
+		"dev/app/sha256__m1/sha256__c1":       "",
+		"dev/app/sha256__m1/sha256__l1":       "",
+		"dev/app/sha256__m2/manifest.json":    arm64,
+		"dev/app/sha256__m2/sha256__c2":       "",
+		"dev/app/sha256__m2/sha256__l2":       "",
+		"dev/single/1.0/manifest.json":        arm64,
+		"dev/single/1.0/sha256__c2":           "",
+		"dev/single/1.0/sha256__l2":           "",
+		"prod/other/sha256__m9/manifest.json": amd64,
+	}
+}
+
+func runTestDockerPromote(t *testing.T, serverUrl string, params services.DockerPromoteParams) error {
+	servicesManager, err := utils.CreateServiceManager(&config.ServerDetails{ArtifactoryUrl: serverUrl + "/"}, 0, 0, false)
+	require.NoError(t, err)
+	return newImagePromoter(servicesManager, params).promote()
+}
+
+func TestDockerPromoteManifestList(t *testing.T) {
+	files := newTestDockerFiles()
+	testServer := newTestDockerRegistry(t, files, "")
+	defer testServer.Close()
+	params := services.NewDockerPromoteParams("app", "dev", "prod")
+	params.SourceTag = "1.0"
+	params.TargetTag = "stable"
+	require.NoError(t, runTestDockerPromote(t, testServer.URL, params))
+
+	assert.Contains(t, files, "prod/app/stable/list.manifest.json")
+	assert.Contains(t, files, "prod/app/sha256__m1/sha256__l1")
+	assert.Contains(t, files, "prod/app/sha256__m2/sha256__l2")
+	assert.NotContains(t, files, "dev/app/1.0/list.manifest.json")
+	assert.NotContains(t, files, "dev/app/sha256__m1/manifest.json")
+	// The manifest referenced by the remaining 'latest' tag is kept in the source repository.
+	assert.Contains(t, files, "dev/app/sha256__m2/manifest.json")
+}
+
+func TestDockerPromoteManifestListRollback(t *testing.T) {
+	files := newTestDockerFiles()
+	testServer := newTestDockerRegistry(t, files, "sha256__l2")
+	defer testServer.Close()
+	params := services.NewDockerPromoteParams("app", "dev", "prod")
+	params.SourceTag = "1.0"
+	assert.ErrorContains(t, runTestDockerPromote(t, testServer.URL, params), "the layers sha256__l2 of 'app/sha256__m2' are missing in 'prod'")
+
+	for file := range files {
+		assert.False(t, strings.HasPrefix(file, "prod/app/"), file)
+	}
+	assert.Contains(t, files, "dev/app/1.0/list.manifest.json")
+	assert.Contains(t, files, "dev/app/sha256__m1/manifest.json")
+}
+
+func TestDockerPromoteSingleManifest(t *testing.T) {
+	files := newTestDockerFiles()
+	testServer := newTestDockerRegistry(t, files, "")
+	defer testServer.Close()
+	params := services.NewDockerPromoteParams("single", "dev", "prod")
+	params.SourceTag = "1.0"
+	params.TargetDockerImage = "released"
+	params.Copy = true
+	require.NoError(t, runTestDockerPromote(t, testServer.URL, params))
+	assert.Contains(t, files, "prod/released/1.0/manifest.json")
+	assert.Contains(t, files, "dev/single/1.0/manifest.json")
+
+	params.SourceTag = "2.0"
+	assert.ErrorContains(t, runTestDockerPromote(t, testServer.URL, params), "the tag 'single/2.0' wasn't found in 'dev'")
+}
@@ -2,7 +2,7 @@ package container
 
 import (
 	container "github.com/jfrog/jfrog-cli-artifactory/artifactory/commands/ocicontainer"
-	"github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clientconfig"
 	"github.com/jfrog/jfrog-cli-core/v2/common/build"
 	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
 	"github.com/jfrog/jfrog-client-go/utils/errorutils"
@@ -58,7 +58,7 @@ func (pc *PullCommand) Run() error {
 		return err
 	}
 	project := pc.BuildConfiguration().GetProject()
-	serviceManager, err := utils.CreateServiceManager(serverDetails, -1, 0, false)
+	serviceManager, err := clientconfig.CreateServiceManager(serverDetails, -1, 0, false)
 	if err != nil {
 		return err
 	}
@@ -6,8 +6,8 @@ import (
 	"strings"
 
 	containerutils "github.com/jfrog/jfrog-cli-artifactory/artifactory/commands/ocicontainer"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clientconfig"
 	commandsutils "github.com/jfrog/jfrog-cli-core/v2/artifactory/commands/utils"
-	"github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
 	"github.com/jfrog/jfrog-cli-core/v2/common/build"
 	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
 	servicesutils "github.com/jfrog/jfrog-client-go/artifactory/services/utils"
@@ -108,7 +108,7 @@ func (pc *PushCommand) Run() error {
 	if err != nil {
 		return err
 	}
-	serviceManager, err := utils.CreateServiceManagerWithThreads(serverDetails, false, pc.threads, -1, 0)
+	serviceManager, err := clientconfig.CreateServiceManagerWithThreads(serverDetails, false, pc.threads, -1, 0)
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,7 @@ import (
 	"fmt"
 	"github.com/jfrog/jfrog-cli-artifactory/artifactory/commands/container/dockerfileutils"
 	container "github.com/jfrog/jfrog-cli-artifactory/artifactory/commands/ocicontainer"
-	"github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clientconfig"
 	"github.com/jfrog/jfrog-cli-core/v2/common/build"
 	"github.com/jfrog/jfrog-client-go/utils/errorutils"
 	"github.com/jfrog/jfrog-client-go/utils/log"
@@ -79,7 +79,7 @@ func (s *RunNativeStrategy) collectBuildInfo(cmdParams []string, buildConfig *bu
 	}
 
 	// Create Artifactory service manager
-	serviceManager, err := utils.CreateServiceManager(serverDetails, -1, 0, false)
+	serviceManager, err := clientconfig.CreateServiceManager(serverDetails, -1, 0, false)
 	if err != nil {
 		return errorutils.CheckErrorf("Failed to create Artifactory service manager: %s", err.Error())
 	}
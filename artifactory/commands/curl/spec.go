@@ -0,0 +1,306 @@
+package curl
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clientconfig"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clierrors"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	clientutils "github.com/jfrog/jfrog-client-go/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+	"gopkg.in/yaml.v3"
+)
+
+// The fields in which Artifactory returns the continuation token of the next page, which is sent back in the query
+// parameter of the same name.
+var defaultTokenFields = []string{"continuation_token", "continuationToken"}
+
+// CurlSpec describes a request to the REST API of Artifactory, and how its paginated responses are merged. The spec is
+// a YAML file, in which ${key} variables are replaced by the spec vars.
+type CurlSpec struct {
+	Method string `yaml:"method"`
+	// The path of the API, relative to the URL of Artifactory, such as api/repositories.
+	Path    string            `yaml:"path"`
+	Query   map[string]string `yaml:"query"`
+	Headers map[string]string `yaml:"headers"`
+	Body    string            `yaml:"body"`
+	// The path of a file whose content is the body, relative to the directory of the spec. Variables are replaced in it
+	// as in the spec.
+	BodyFile   string          `yaml:"bodyFile"`
+	Pagination *PaginationSpec `yaml:"pagination"`
+}
+
+// PaginationSpec describes the responses of an API which uses continuation tokens. Each page is a JSON object, whose
+// items are merged into the items of the first page.
+type PaginationSpec struct {
+	// The field of the items of a page. If not provided, it is the only field of the page whose value is a list.
+	Items string `yaml:"items"`
+	// The field of the continuation token of the next page. If not provided, it is continuation_token or continuationToken.
+	Token string `yaml:"token"`
+	// The query parameter in which the token is sent. If not provided, it is the field of the token.
+	Param string `yaml:"param"`
+	// The maximal number of requested pages. If zero, all pages are requested.
+	MaxPages int `yaml:"maxPages"`
+}
+
+// ReadCurlSpec reads the spec file, replacing the vars in it and in its body file.
+func ReadCurlSpec(specPath string, vars map[string]string) (*CurlSpec, error) {
+	content, err := os.ReadFile(specPath)
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	spec := &CurlSpec{}
+	if err = yaml.Unmarshal(coreutils.ReplaceVars(content, vars), spec); err != nil {
+		return nil, errorutils.CheckErrorf("failed to parse the curl spec %s: %s", specPath, err.Error())
+	}
+	if spec.Path == "" {
+		return nil, errorutils.CheckErrorf("the curl spec %s has no path", specPath)
+	}
+	if strings.HasPrefix(spec.Path, "http://") || strings.HasPrefix(spec.Path, "https://") {
+		return nil, errorutils.CheckErrorf("the path of the curl spec must not be a full URL, but only the REST API URI (e.g 'api/repositories')")
+	}
+	if spec.Body != "" && spec.BodyFile != "" {
+		return nil, errorutils.CheckErrorf("the curl spec %s must not have both body and bodyFile", specPath)
+	}
+	if spec.BodyFile != "" {
+		bodyFile := spec.BodyFile
+		if !filepath.IsAbs(bodyFile) {
+			bodyFile = filepath.Join(filepath.Dir(specPath), bodyFile)
+		}
+		body, err := os.ReadFile(bodyFile)
+		if err != nil {
+			return nil, errorutils.CheckError(err)
+		}
+		spec.Body = string(coreutils.ReplaceVars(body, vars))
+	}
+	if spec.Method == "" {
+		spec.Method = http.MethodGet
+		if spec.Body != "" {
+			spec.Method = http.MethodPost
+		}
+	}
+	spec.Method = strings.ToUpper(spec.Method)
+	return spec, nil
+}
+
+// apiClient sends requests to the REST API of Artifactory.
+type apiClient interface {
+	// send sends the request to the path, relative to the URL of Artifactory, and returns the body of the response.
+	send(method, apiPath string, body []byte, headers map[string]string) ([]byte, error)
+}
+
+type artifactoryApiClient struct {
+	servicesManager artifactory.ArtifactoryServicesManager
+}
+
+func (aac *artifactoryApiClient) send(method, apiPath string, body []byte, headers map[string]string) ([]byte, error) {
+	serviceDetails := aac.servicesManager.GetConfig().GetServiceDetails()
+	httpClientDetails := serviceDetails.CreateHttpClientDetails()
+	if httpClientDetails.Headers == nil {
+		httpClientDetails.Headers = map[string]string{}
+	}
+	for key, value := range headers {
+		httpClientDetails.Headers[key] = value
+	}
+	resp, respBody, _, err := aac.servicesManager.Client().Send(method, clientutils.AddTrailingSlashIfNeeded(serviceDetails.GetUrl())+apiPath, body, true, true, &httpClientDetails, "")
+	if err != nil {
+		return nil, err
+	}
+	if err = clierrors.CheckResponseStatusWithBody(resp, respBody, http.StatusOK, http.StatusCreated, http.StatusAccepted, http.StatusNoContent); err != nil {
+		return nil, err
+	}
+	return respBody, nil
+}
+
+// RtCurlSpecCommand sends the request described by a curl spec to Artifactory, requests all pages of its paginated
+// responses, and outputs the merged response.
+type RtCurlSpecCommand struct {
+	serverDetails *config.ServerDetails
+	spec          *CurlSpec
+	client        apiClient
+	result        []byte
+}
+
+func NewRtCurlSpecCommand() *RtCurlSpecCommand {
+	return &RtCurlSpecCommand{}
+}
+
+func (rcs *RtCurlSpecCommand) SetServerDetails(serverDetails *config.ServerDetails) *RtCurlSpecCommand {
+	rcs.serverDetails = serverDetails
+	return rcs
+}
+
+func (rcs *RtCurlSpecCommand) SetSpec(spec *CurlSpec) *RtCurlSpecCommand {
+	rcs.spec = spec
+	return rcs
+}
+
+func (rcs *RtCurlSpecCommand) ServerDetails() (*config.ServerDetails, error) {
+	return rcs.serverDetails, nil
+}
+
+func (rcs *RtCurlSpecCommand) CommandName() string {
+	return "rt_curl_spec"
+}
+
+// Result returns the body of the response, or the merged pages of a paginated response.
+func (rcs *RtCurlSpecCommand) Result() []byte {
+	return rcs.result
+}
+
+func (rcs *RtCurlSpecCommand) Run() (err error) {
+	if rcs.client == nil {
+		servicesManager, err := clientconfig.CreateServiceManager(rcs.serverDetails, -1, 0, false)
+		if err != nil {
+			return err
+		}
+		rcs.client = &artifactoryApiClient{servicesManager: servicesManager}
+	}
+	if rcs.result, err = rcs.sendRequest(); err != nil {
+		return err
+	}
+	log.Output(string(rcs.result))
+	return nil
+}
+
+func (rcs *RtCurlSpecCommand) sendRequest() ([]byte, error) {
+	query := url.Values{}
+	for key, value := range rcs.spec.Query {
+		query.Set(key, value)
+	}
+	firstPage, err := rcs.client.send(rcs.spec.Method, createApiPath(rcs.spec.Path, query), []byte(rcs.spec.Body), rcs.spec.Headers)
+	if err != nil || rcs.spec.Pagination == nil {
+		return firstPage, err
+	}
+	return rcs.paginate(firstPage, query)
+}
+
+// paginate requests the pages following the first page, until a page has no continuation token, and returns the first
+// page, with the items of all pages and without a continuation token.
+func (rcs *RtCurlSpecCommand) paginate(firstPage []byte, query url.Values) ([]byte, error) {
+	pagination := rcs.spec.Pagination
+	merged := map[string]json.RawMessage{}
+	if err := json.Unmarshal(firstPage, &merged); err != nil {
+		return nil, errorutils.CheckErrorf("the paginated response is not a JSON object: %s", err.Error())
+	}
+	itemsField, err := getItemsField(merged, pagination.Items)
+	if err != nil {
+		return nil, err
+	}
+	var items []json.RawMessage
+	page := merged
+	for pageNumber := 1; ; pageNumber++ {
+		var pageItems []json.RawMessage
+		if raw, found := page[itemsField]; found {
+			if err = json.Unmarshal(raw, &pageItems); err != nil {
+				return nil, errorutils.CheckErrorf("the '%s' field of page %d is not a list: %s", itemsField, pageNumber, err.Error())
+			}
+		}
+		items = append(items, pageItems...)
+		tokenField, token := getContinuationToken(page, pagination.Token)
+		if token == "" || len(pageItems) == 0 {
+			break
+		}
+		if pagination.MaxPages > 0 && pageNumber >= pagination.MaxPages {
+			log.Warn("Stopped after", strconv.Itoa(pageNumber), "pages, although more pages are available.")
+			break
+		}
+		param := pagination.Param
+		if param == "" {
+			param = tokenField
+		}
+		query.Set(param, token)
+		log.Debug("Requesting page", pageNumber+1, "of", rcs.spec.Path)
+		body, err := rcs.client.send(rcs.spec.Method, createApiPath(rcs.spec.Path, query), []byte(rcs.spec.Body), rcs.spec.Headers)
+		if err != nil {
+			return nil, err
+		}
+		page = map[string]json.RawMessage{}
+		if err = json.Unmarshal(body, &page); err != nil {
+			return nil, errorutils.CheckErrorf("page %d of the response is not a JSON object: %s", pageNumber+1, err.Error())
+		}
+	}
+	if items == nil {
+		items = []json.RawMessage{}
+	}
+	if merged[itemsField], err = json.Marshal(items); err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	for _, tokenField := range append(defaultTokenFields, pagination.Token) {
+		delete(merged, tokenField)
+	}
+	var result bytes.Buffer
+	encoder := json.NewEncoder(&result)
+	encoder.SetIndent("", "  ")
+	encoder.SetEscapeHTML(false)
+	if err = encoder.Encode(merged); err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	return bytes.TrimSpace(result.Bytes()), nil
+}
+
+func createApiPath(apiPath string, query url.Values) string {
+	apiPath = strings.TrimPrefix(apiPath, "/")
+	if len(query) == 0 {
+		return apiPath
+	}
+	separator := "?"
+	if strings.Contains(apiPath, "?") {
+		separator = "&"
+	}
+	return apiPath + separator + query.Encode()
+}
+
+// getItemsField returns the field of the items of the page, which is the only list in the page if it isn't specified.
+func getItemsField(page map[string]json.RawMessage, itemsField string) (string, error) {
+	if itemsField != "" {
+		return itemsField, nil
+	}
+	var listFields []string
+	for field, value := range page {
+		if trimmed := bytes.TrimSpace(value); len(trimmed) > 0 && trimmed[0] == '[' {
+			listFields = append(listFields, field)
+		}
+	}
+	if len(listFields) != 1 {
+		return "", errorutils.CheckErrorf("the field of the items of the paginated response could not be detected. Set it by the 'items' field of the pagination in the curl spec")
+	}
+	return listFields[0], nil
+}
+
+// getContinuationToken returns the field and the value of the continuation token of the page, which is empty on the
+// last page.
+func getContinuationToken(page map[string]json.RawMessage, tokenField string) (string, string) {
+	tokenFields := defaultTokenFields
+	if tokenField != "" {
+		tokenFields = []string{tokenField}
+	}
+	for _, field := range tokenFields {
+		raw, found := page[field]
+		if !found {
+			continue
+		}
+		var token any
+		if err := json.Unmarshal(raw, &token); err != nil || token == nil {
+			return field, ""
+		}
+		switch value := token.(type) {
+		case string:
+			return field, value
+		case float64:
+			return field, strconv.FormatFloat(value, 'f', -1, 64)
+		}
+		return field, ""
+	}
+	return "", ""
+}
@@ -0,0 +1,95 @@
+package curl
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type sentRequest struct {
+	method  string
+	apiPath string
+	body    string
+}
+
+// mockApiClient returns the responses by the paths of the requests.
+type mockApiClient struct {
+	responses map[string]string
+	requests  []sentRequest
+}
+
+func (mac *mockApiClient) send(method, apiPath string, body []byte, _ map[string]string) ([]byte, error) {
+	mac.requests = append(mac.requests, sentRequest{method, apiPath, string(body)})
+	return []byte(mac.responses[apiPath]), nil
+}
+
+func TestReadCurlSpec(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "query.aql"), []byte(`items.find({"repo":"${repo}"})`), 0644))
+	specPath := filepath.Join(dir, "spec.yaml")
+	require.NoError(t, os.WriteFile(specPath, []byte(`
+path: api/search/aql
+query:
+  limit: "${limit}"
+headers:
+  Content-Type: text/plain
+bodyFile: query.aql
+`), 0644))
+	spec, err := ReadCurlSpec(specPath, map[string]string{"repo": "libs-release", "limit": "100"})
+	require.NoError(t, err)
+	assert.Equal(t, "POST", spec.Method)
+	assert.Equal(t, map[string]string{"limit": "100"}, spec.Query)
+	assert.Equal(t, `items.find({"repo":"libs-release"})`, spec.Body)
+	assert.Nil(t, spec.Pagination)
+}
+
+func TestReadCurlSpecFullUrl(t *testing.T) {
+	specPath := filepath.Join(t.TempDir(), "spec.yaml")
+	require.NoError(t, os.WriteFile(specPath, []byte("path: https://acme.jfrog.io/artifactory/api/repositories\n"), 0644))
+	_, err := ReadCurlSpec(specPath, nil)
+	assert.ErrorContains(t, err, "must not be a full URL")
+}
+
+func TestRunSpecWithoutPagination(t *testing.T) {
+	client := &mockApiClient{responses: map[string]string{"api/repositories?type=local": `[{"key":"a"}]`}}
+	command := &RtCurlSpecCommand{client: client, spec: &CurlSpec{Method: "GET", Path: "/api/repositories", Query: map[string]string{"type": "local"}}}
+	require.NoError(t, command.Run())
+	assert.Equal(t, `[{"key":"a"}]`, string(command.Result()))
+}
+
+func TestRunSpecWithPagination(t *testing.T) {
+	client := &mockApiClient{responses: map[string]string{
+		"api/v1/records?limit=2":                       `{"total":5,"records":[1,2],"continuation_token":"t1"}`,
+		"api/v1/records?continuation_token=t1&limit=2": `{"total":5,"records":[3,4],"continuation_token":"t2"}`,
+		"api/v1/records?continuation_token=t2&limit=2": `{"total":5,"records":[5]}`,
+	}}
+	spec := &CurlSpec{Method: "GET", Path: "api/v1/records", Query: map[string]string{"limit": "2"}, Pagination: &PaginationSpec{}}
+	command := &RtCurlSpecCommand{client: client, spec: spec}
+	require.NoError(t, command.Run())
+	assert.Len(t, client.requests, 3)
+	merged := map[string]any{}
+	require.NoError(t, json.Unmarshal(command.Result(), &merged))
+	assert.Equal(t, map[string]any{"total": float64(5), "records": []any{float64(1), float64(2), float64(3), float64(4), float64(5)}}, merged)
+}
+
+func TestRunSpecWithMaxPages(t *testing.T) {
+	client := &mockApiClient{responses: map[string]string{
+		"api/builds":          `{"builds":[{"a":1}],"items":"x","next":"2"}`,
+		"api/builds?cursor=2": `{"builds":[{"a":2}],"next":"3"}`,
+		"api/builds?cursor=3": `{"builds":[{"a":3}]}`,
+	}}
+	spec := &CurlSpec{Method: "GET", Path: "api/builds", Pagination: &PaginationSpec{Items: "builds", Token: "next", Param: "cursor", MaxPages: 2}}
+	command := &RtCurlSpecCommand{client: client, spec: spec}
+	require.NoError(t, command.Run())
+	assert.Len(t, client.requests, 2)
+	assert.JSONEq(t, `{"builds":[{"a":1},{"a":2}],"items":"x"}`, string(command.Result()))
+}
+
+func TestGetItemsFieldNotDetected(t *testing.T) {
+	_, err := getItemsField(map[string]json.RawMessage{"a": json.RawMessage(`[]`), "b": json.RawMessage(` [1]`)}, "")
+	assert.ErrorContains(t, err, "could not be detected")
+}
@@ -0,0 +1,215 @@
+package dotnet
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+
+	buildinfo "github.com/jfrog/build-info-go/entities"
+	"github.com/jfrog/gofrog/crypto"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/io/fileutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const (
+	assetsFileName     = "project.assets.json"
+	assetsDirName      = "obj"
+	projectLibraryType = "project"
+)
+
+// projectAssets is the project.assets.json written by the restore of a project which references its packages with
+// PackageReference. It records the packages resolved for each target framework, and the dependencies between them.
+type projectAssets struct {
+	Targets   map[string]map[string]assetsTarget `json:"targets"`
+	Libraries map[string]assetsLibrary           `json:"libraries"`
+	Project   struct {
+		Restore struct {
+			PackagesPath string `json:"packagesPath"`
+		} `json:"restore"`
+		Frameworks map[string]struct {
+			Dependencies map[string]json.RawMessage `json:"dependencies"`
+		} `json:"frameworks"`
+	} `json:"project"`
+}
+
+type assetsTarget struct {
+	Type         string            `json:"type"`
+	Dependencies map[string]string `json:"dependencies"`
+}
+
+type assetsLibrary struct {
+	Type   string   `json:"type"`
+	Path   string   `json:"path"`
+	Sha512 string   `json:"sha512"`
+	Files  []string `json:"files"`
+}
+
+func readProjectAssets(projectRootPath string) (*projectAssets, error) {
+	assetsPath := filepath.Join(projectRootPath, assetsDirName, assetsFileName)
+	exists, err := fileutils.IsFileExists(assetsPath, false)
+	if err != nil || !exists {
+		return nil, err
+	}
+	content, err := os.ReadFile(assetsPath)
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	assets := &projectAssets{}
+	if err = json.Unmarshal(content, assets); err != nil {
+		return nil, errorutils.CheckErrorf("failed to parse %s: %s", assetsPath, err.Error())
+	}
+	return assets, nil
+}
+
+// getDependencies returns the packages restored for all the target frameworks of the project, with the paths through
+// which they're requested by the module. The packages of the projects referenced by the project are included, through
+// the referenced projects. The checksums of the packages are calculated from their nupkg files in the global packages
+// folder, which are verified against the SHA-512 recorded in the assets file.
+func (assets *projectAssets) getDependencies(moduleId string) ([]buildinfo.Dependency, error) {
+	dependencies := map[string]*buildinfo.Dependency{}
+	for libraryId, library := range assets.Libraries {
+		if library.Type == projectLibraryType {
+			continue
+		}
+		checksum, err := assets.getPackageChecksum(libraryId, library)
+		if err != nil {
+			return nil, err
+		}
+		if checksum != nil {
+			dependencies[libraryId] = &buildinfo.Dependency{Id: getDependencyId(libraryId), Checksum: *checksum}
+		}
+	}
+	roots, children := assets.getGraph()
+	for _, rootId := range roots {
+		addRequestedBy(rootId, []string{moduleId}, dependencies, children, map[string]bool{})
+	}
+
+	var result []buildinfo.Dependency
+	for _, dependency := range dependencies {
+		if len(dependency.RequestedBy) > 0 {
+			result = append(result, *dependency)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Id < result[j].Id })
+	return result, nil
+}
+
+// getGraph returns the ids of the libraries directly referenced by the project, and the ids of the dependencies of each
+// library. The libraries are identified by their '<name>/<version>' keys in the targets, and their dependencies are
+// resolved in the target in which they're declared, since each target framework may resolve different versions.
+func (assets *projectAssets) getGraph() (roots []string, children map[string][]string) {
+	children = map[string][]string{}
+	rootsSet := map[string]bool{}
+	for targetName, target := range assets.Targets {
+		idsByName := make(map[string]string, len(target))
+		for libraryId := range target {
+			idsByName[getLibraryName(libraryId)] = libraryId
+		}
+		referenced := map[string]bool{}
+		for libraryId, library := range target {
+			for dependencyName := range library.Dependencies {
+				dependencyId, found := idsByName[strings.ToLower(dependencyName)]
+				if !found {
+					continue
+				}
+				if !slices.Contains(children[libraryId], dependencyId) {
+					children[libraryId] = append(children[libraryId], dependencyId)
+				}
+				referenced[dependencyId] = true
+			}
+		}
+		// The framework of 'net8.0/win-x64' is 'net8.0'.
+		framework, _, _ := strings.Cut(targetName, "/")
+		for dependencyName := range assets.Project.Frameworks[framework].Dependencies {
+			if dependencyId, found := idsByName[strings.ToLower(dependencyName)]; found {
+				rootsSet[dependencyId] = true
+			}
+		}
+		// The referenced projects aren't listed among the dependencies of the framework.
+		for libraryId, library := range target {
+			if library.Type == projectLibraryType && !referenced[libraryId] {
+				rootsSet[libraryId] = true
+			}
+		}
+	}
+	for rootId := range rootsSet {
+		roots = append(roots, rootId)
+	}
+	sort.Strings(roots)
+	for libraryId := range children {
+		sort.Strings(children[libraryId])
+	}
+	return
+}
+
+// addRequestedBy adds the path through which the library is requested to it, and to its dependencies. Referenced
+// projects aren't dependencies, but are part of the paths of their packages.
+func addRequestedBy(libraryId string, path []string, dependencies map[string]*buildinfo.Dependency, children map[string][]string, visited map[string]bool) {
+	if visited[libraryId] || len(path) > buildinfo.RequestedByMaxLength {
+		return
+	}
+	if dependency, found := dependencies[libraryId]; found {
+		if len(dependency.RequestedBy) >= buildinfo.RequestedByMaxLength {
+			return
+		}
+		dependency.RequestedBy = append(dependency.RequestedBy, path)
+	}
+	visited[libraryId] = true
+	defer delete(visited, libraryId)
+	childPath := append([]string{getDependencyId(libraryId)}, path...)
+	for _, childId := range children[libraryId] {
+		addRequestedBy(childId, childPath, dependencies, children, visited)
+	}
+}
+
+// getPackageChecksum returns the checksums of the package's nupkg in the global packages folder, or nil if it isn't
+// there, which is the case of packages restored from the fallback folders of the SDK.
+func (assets *projectAssets) getPackageChecksum(libraryId string, library assetsLibrary) (*buildinfo.Checksum, error) {
+	var sha512FileName string
+	for _, file := range library.Files {
+		if strings.HasSuffix(file, ".nupkg.sha512") {
+			sha512FileName = file
+			break
+		}
+	}
+	packagePath := filepath.Join(assets.Project.Restore.PackagesPath, filepath.FromSlash(library.Path))
+	nupkgPath := filepath.Join(packagePath, strings.TrimSuffix(sha512FileName, ".sha512"))
+	exists, err := fileutils.IsFileExists(nupkgPath, false)
+	if err != nil {
+		return nil, err
+	}
+	if sha512FileName == "" || !exists {
+		log.Warn("The nupkg of", libraryId, "wasn't found in the global packages folder, and will not be included in the build-info.")
+		return nil, nil
+	}
+	// NuGet records the SHA-512 of the package when extracting it to the global packages folder.
+	if library.Sha512 != "" {
+		recordedSha512, err := os.ReadFile(filepath.Join(packagePath, sha512FileName))
+		if err != nil {
+			return nil, errorutils.CheckError(err)
+		}
+		if strings.TrimSpace(string(recordedSha512)) != library.Sha512 {
+			return nil, errorutils.CheckErrorf("the SHA-512 of %s in the global packages folder doesn't match the SHA-512 recorded in %s. Clear the package from the folder, and restore the project again", libraryId, assetsFileName)
+		}
+	}
+	checksums, err := crypto.GetFileChecksums(nupkgPath)
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	return &buildinfo.Checksum{Sha1: checksums[crypto.SHA1], Md5: checksums[crypto.MD5], Sha256: checksums[crypto.SHA256]}, nil
+}
+
+// Libraries are keyed by '<name>/<version>' in the assets file, and their names are case-insensitive.
+func getLibraryName(libraryId string) string {
+	name, _, _ := strings.Cut(libraryId, "/")
+	return strings.ToLower(name)
+}
+
+// The id of a dependency in the build-info is '<name>:<version>'.
+func getDependencyId(libraryId string) string {
+	return strings.Replace(libraryId, "/", ":", 1)
+}
@@ -0,0 +1,111 @@
+package dotnet
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testSha512 = "c2hhNTEy"
+
+// createTestPackage writes a package to the global packages folder, the way NuGet extracts it, and returns the SHA-256
+// of its nupkg.
+func createTestPackage(t *testing.T, packagesPath, name, version, sha512 string) string {
+	packagePath := filepath.Join(packagesPath, name, version)
+	require.NoError(t, os.MkdirAll(packagePath, 0755))
+	content := []byte(name + version)
+	nupkgName := name + "." + version + ".nupkg"
+	require.NoError(t, os.WriteFile(filepath.Join(packagePath, nupkgName), content, 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(packagePath, nupkgName+".sha512"), []byte(sha512), 0600))
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
+
+func createTestAssets(t *testing.T, packagesPath string) *projectAssets {
+	library := func(name, version string) assetsLibrary {
+		return assetsLibrary{
+			Type:   "package",
+			Path:   name + "/" + version,
+			Sha512: testSha512,
+			Files:  []string{name + "." + version + ".nupkg.sha512", name + ".nuspec"},
+		}
+	}
+	assets := &projectAssets{
+		Targets: map[string]map[string]assetsTarget{
+			"net8.0": {
+				"Serilog/3.1.1":                 {Type: "package"},
+				"Serilog.Sinks.Console/5.0.1":   {Type: "package", Dependencies: map[string]string{"Serilog": "3.1.1"}},
+				"Newtonsoft.Json/13.0.3":        {Type: "package"},
+				"Common/1.0.0":                  {Type: "project", Dependencies: map[string]string{"Newtonsoft.Json": "13.0.3"}},
+				"Microsoft.NETCore.Targets/1.0": {Type: "package"},
+			},
+		},
+		Libraries: map[string]assetsLibrary{
+			"Serilog/3.1.1":               library("serilog", "3.1.1"),
+			"Serilog.Sinks.Console/5.0.1": library("serilog.sinks.console", "5.0.1"),
+			"Newtonsoft.Json/13.0.3":      library("newtonsoft.json", "13.0.3"),
+			"Common/1.0.0":                {Type: "project", Path: "../Common/Common.csproj"},
+			// Restored from the fallback folder of the SDK.
+			"Microsoft.NETCore.Targets/1.0": library("microsoft.netcore.targets", "1.0"),
+		},
+	}
+	assets.Project.Restore.PackagesPath = packagesPath
+	assets.Project.Frameworks = map[string]struct {
+		Dependencies map[string]json.RawMessage `json:"dependencies"`
+	}{"net8.0": {Dependencies: map[string]json.RawMessage{"Serilog.Sinks.Console": nil}}}
+	return assets
+}
+
+func TestGetAssetsDependencies(t *testing.T) {
+	packagesPath := t.TempDir()
+	serilogSha256 := createTestPackage(t, packagesPath, "serilog", "3.1.1", testSha512)
+	createTestPackage(t, packagesPath, "serilog.sinks.console", "5.0.1", testSha512)
+	newtonsoftSha256 := createTestPackage(t, packagesPath, "newtonsoft.json", "13.0.3", testSha512)
+
+	dependencies, err := createTestAssets(t, packagesPath).getDependencies("my-app")
+	require.NoError(t, err)
+	require.Len(t, dependencies, 3)
+
+	assert.Equal(t, "Newtonsoft.Json:13.0.3", dependencies[0].Id)
+	assert.Equal(t, newtonsoftSha256, dependencies[0].Sha256)
+	assert.NotEmpty(t, dependencies[0].Sha1)
+	assert.Equal(t, [][]string{{"Common:1.0.0", "my-app"}}, dependencies[0].RequestedBy)
+
+	assert.Equal(t, "Serilog.Sinks.Console:5.0.1", dependencies[1].Id)
+	assert.Equal(t, [][]string{{"my-app"}}, dependencies[1].RequestedBy)
+
+	assert.Equal(t, "Serilog:3.1.1", dependencies[2].Id)
+	assert.Equal(t, serilogSha256, dependencies[2].Sha256)
+	assert.Equal(t, [][]string{{"Serilog.Sinks.Console:5.0.1", "my-app"}}, dependencies[2].RequestedBy)
+}
+
+func TestGetAssetsDependenciesSha512Mismatch(t *testing.T) {
+	packagesPath := t.TempDir()
+	createTestPackage(t, packagesPath, "serilog", "3.1.1", "b3RoZXI=")
+	createTestPackage(t, packagesPath, "serilog.sinks.console", "5.0.1", testSha512)
+	createTestPackage(t, packagesPath, "newtonsoft.json", "13.0.3", testSha512)
+
+	_, err := createTestAssets(t, packagesPath).getDependencies("my-app")
+	assert.ErrorContains(t, err, "Serilog/3.1.1")
+}
+
+func TestReadProjectAssets(t *testing.T) {
+	projectRoot := t.TempDir()
+	assets, err := readProjectAssets(projectRoot)
+	require.NoError(t, err)
+	assert.Nil(t, assets)
+
+	require.NoError(t, os.MkdirAll(filepath.Join(projectRoot, assetsDirName), 0755))
+	content := `{"libraries": {"Serilog/3.1.1": {"type": "package", "path": "serilog/3.1.1", "sha512": "c2hhNTEy"}}, "project": {"restore": {"packagesPath": "/packages"}}}`
+	require.NoError(t, os.WriteFile(filepath.Join(projectRoot, assetsDirName, assetsFileName), []byte(content), 0600))
+	assets, err = readProjectAssets(projectRoot)
+	require.NoError(t, err)
+	assert.Equal(t, "/packages", assets.Project.Restore.PackagesPath)
+	assert.Equal(t, "serilog/3.1.1", assets.Libraries["Serilog/3.1.1"].Path)
+}
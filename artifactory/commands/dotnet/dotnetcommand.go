@@ -137,6 +137,11 @@ func (dc *DotnetCommand) Exec() (err error) {
 		}
 		return err
 	}
+	if buildName != "" && buildNumber != "" {
+		if err = dc.collectBuildInfo(dotnetBuild, buildInfoModule.GetSolutionPath()); err != nil {
+			return err
+		}
+	}
 	log.Info(fmt.Sprintf("%s finished successfully.", dc.toolchainType))
 	return nil
 }
@@ -253,6 +258,13 @@ func (dc *DotnetCommand) prepareConfigFileIfNeeded() (cleanup func() error, err
 		return
 	}
 
+	// Repositories which don't provide the NuGet V3 API accept packages through the V2 API only.
+	if dc.isPushCommand() && !dc.useNugetV2 {
+		if dc.useNugetV2, err = shouldPushWithNugetV2(dc.serverDetails, dc.repoName); err != nil {
+			return
+		}
+	}
+
 	// Use temp dir to save config file, so that config will be removed at the end.
 	tempDirPath, err := fileutils.CreateTempDir()
 	if err != nil {
@@ -273,6 +285,13 @@ func (dc *DotnetCommand) isDotnetTestCommand() bool {
 	return dc.GetToolchain() == dotnet.DotnetCore && dc.subCommand == "test"
 }
 
+func (dc *DotnetCommand) isPushCommand() bool {
+	if dc.GetToolchain() == dotnet.DotnetCore {
+		return dc.subCommand == "nuget push"
+	}
+	return dc.subCommand == "push"
+}
+
 // Returns the value of the flag if exists
 func getFlagValueIfExists(cmdFlag string, argAndFlags []string) (string, error) {
 	for i := 0; i < len(argAndFlags); i++ {
@@ -0,0 +1,158 @@
+package dotnet
+
+import (
+	"errors"
+	"io/fs"
+	"path/filepath"
+	"strings"
+
+	"github.com/jfrog/build-info-go/build"
+	"github.com/jfrog/build-info-go/build/utils/dotnet"
+	"github.com/jfrog/build-info-go/build/utils/dotnet/solution"
+	buildinfo "github.com/jfrog/build-info-go/entities"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/checksumcache"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const (
+	publishSubCommand = "publish"
+	publishDirName    = "publish"
+	binDirName        = "bin"
+)
+
+// collectBuildInfo completes the build-info collected by build-info-go for the solution. The dependencies of the
+// projects restored with PackageReference are replaced by the ones read from their assets files, which include the
+// SHA-256 of the packages, and the files written by 'dotnet publish' are added as the artifacts of the projects.
+func (dc *DotnetCommand) collectBuildInfo(dotnetBuild *build.Build, solutionPath string) error {
+	sol, err := solution.Load(solutionPath, getSolutionFileName(dc.argAndFlags), "", log.Logger)
+	if err != nil {
+		return err
+	}
+	projects := sol.GetProjects()
+	for _, project := range projects {
+		assets, err := readProjectAssets(project.RootPath())
+		if err != nil {
+			return err
+		}
+		if assets == nil {
+			continue
+		}
+		moduleId := dc.getModuleId(project.Name())
+		dependencies, err := assets.getDependencies(moduleId)
+		if err != nil {
+			return err
+		}
+		module := buildinfo.Module{Id: moduleId, Type: buildinfo.Nuget, Dependencies: dependencies}
+		if err = dotnetBuild.SaveBuildInfo(&buildinfo.BuildInfo{Modules: []buildinfo.Module{module}}); err != nil {
+			return errorutils.CheckError(err)
+		}
+	}
+	if !dc.isDotnetPublishCommand() {
+		return nil
+	}
+	outputDir, err := getFlagValueIfExists("-o", dc.argAndFlags)
+	if err != nil {
+		return err
+	}
+	if outputDir == "" {
+		if outputDir, err = getFlagValueIfExists("--output", dc.argAndFlags); err != nil {
+			return err
+		}
+	}
+	if outputDir != "" {
+		// All the projects are published to the same directory, so its files can't be attributed to them.
+		moduleId := filepath.Base(solutionPath)
+		if len(projects) == 1 {
+			moduleId = projects[0].Name()
+		}
+		return addPublishedArtifacts(dotnetBuild, dc.getModuleId(moduleId), outputDir)
+	}
+	for _, project := range projects {
+		publishDirs, err := findPublishDirs(project.RootPath())
+		if err != nil {
+			return err
+		}
+		for _, publishDir := range publishDirs {
+			if err = addPublishedArtifacts(dotnetBuild, dc.getModuleId(project.Name()), publishDir); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (dc *DotnetCommand) getModuleId(projectName string) string {
+	if customModule := dc.buildConfiguration.GetModule(); customModule != "" {
+		return customModule
+	}
+	return projectName
+}
+
+func (dc *DotnetCommand) isDotnetPublishCommand() bool {
+	return dc.GetToolchain() == dotnet.DotnetCore && dc.subCommand == publishSubCommand
+}
+
+// getSolutionFileName returns the name of the sln file, if one is passed as the first argument of the command.
+func getSolutionFileName(argAndFlags []string) string {
+	if len(argAndFlags) == 0 || !strings.HasSuffix(argAndFlags[0], ".sln") {
+		return ""
+	}
+	return filepath.Base(argAndFlags[0])
+}
+
+// findPublishDirs returns the default output directories of 'dotnet publish' in the project, which are
+// 'bin/<configuration>/<framework>[/<runtime>]/publish'.
+func findPublishDirs(projectRootPath string) (publishDirs []string, err error) {
+	binDir := filepath.Join(projectRootPath, binDirName)
+	err = filepath.WalkDir(binDir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			if path == binDir && errors.Is(err, fs.ErrNotExist) {
+				return filepath.SkipDir
+			}
+			return err
+		}
+		if entry.IsDir() && entry.Name() == publishDirName {
+			publishDirs = append(publishDirs, path)
+			return filepath.SkipDir
+		}
+		return nil
+	})
+	return publishDirs, errorutils.CheckError(err)
+}
+
+// addPublishedArtifacts adds the files in the output directory of 'dotnet publish' to the module's artifacts.
+func addPublishedArtifacts(dotnetBuild *build.Build, moduleId, publishDir string) error {
+	var artifacts []buildinfo.Artifact
+	// Most of the published files are usually unchanged since the previous publish.
+	cache := checksumcache.Load()
+	defer cache.SaveOrLog()
+	err := filepath.WalkDir(publishDir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil || entry.IsDir() {
+			return err
+		}
+		relativePath, err := filepath.Rel(publishDir, path)
+		if err != nil {
+			return err
+		}
+		details, err := cache.GetFileDetails(path)
+		if err != nil {
+			return err
+		}
+		artifacts = append(artifacts, buildinfo.Artifact{
+			Name:     entry.Name(),
+			Path:     filepath.ToSlash(relativePath),
+			Type:     strings.TrimPrefix(filepath.Ext(entry.Name()), "."),
+			Checksum: details.Checksum,
+		})
+		return nil
+	})
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	if len(artifacts) == 0 {
+		return nil
+	}
+	log.Debug("Adding", len(artifacts), "published files of", moduleId, "to the build-info.")
+	return errorutils.CheckError(dotnetBuild.AddArtifacts(moduleId, buildinfo.Nuget, artifacts...))
+}
@@ -0,0 +1,99 @@
+package dotnet
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clientconfig"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clierrors"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/http/httpclient"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// The types of the resources of a NuGet V3 service index, without their versions.
+const (
+	PackagePublishResource       = "PackagePublish"
+	PackageBaseAddressResource   = "PackageBaseAddress"
+	RegistrationsBaseUrlResource = "RegistrationsBaseUrl"
+	SearchQueryServiceResource   = "SearchQueryService"
+)
+
+// ServiceIndex is the NuGet V3 service index of a repository, the entry point of the V3 API, which lists the URLs of
+// the resources the repository provides.
+type ServiceIndex struct {
+	Version   string                 `json:"version"`
+	Resources []ServiceIndexResource `json:"resources"`
+}
+
+type ServiceIndexResource struct {
+	Id   string `json:"@id"`
+	Type string `json:"@type"`
+}
+
+// GetResourceUrl returns the URL of the first resource of the type, in any of its versions. For example, the type
+// 'PackagePublish' matches the resources of type 'PackagePublish/2.0.0'.
+func (si *ServiceIndex) GetResourceUrl(resourceType string) (string, bool) {
+	for _, resource := range si.Resources {
+		if resource.Type == resourceType || strings.HasPrefix(resource.Type, resourceType+"/") {
+			return resource.Id, true
+		}
+	}
+	return "", false
+}
+
+// GetServiceIndex returns the service index of the repository. It returns nil, without an error, if the repository
+// doesn't provide the NuGet V3 API.
+func GetServiceIndex(serverDetails *config.ServerDetails, repoName string) (*ServiceIndex, error) {
+	serviceIndexUrl, _, _, err := GetSourceDetails(serverDetails, repoName, false)
+	if err != nil {
+		return nil, err
+	}
+	authDetails, err := serverDetails.CreateArtAuthConfig()
+	if err != nil {
+		return nil, err
+	}
+	client, err := httpclient.ClientBuilder().Build()
+	if err != nil {
+		return nil, err
+	}
+	if err = clientconfig.ConfigureHttpClient(client.GetClient(), serverDetails); err != nil {
+		return nil, err
+	}
+	httpDetails := authDetails.CreateHttpClientDetails()
+	resp, body, _, err := client.SendGet(serviceIndexUrl, true, httpDetails, "")
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if err = clierrors.CheckResponseStatusWithBody(resp, body, http.StatusOK); err != nil {
+		return nil, err
+	}
+	serviceIndex := &ServiceIndex{}
+	if err = json.Unmarshal(body, serviceIndex); err != nil {
+		return nil, errorutils.CheckErrorf("failed to parse the NuGet service index of '%s': %s", repoName, err.Error())
+	}
+	return serviceIndex, nil
+}
+
+// shouldPushWithNugetV2 returns true if packages should be pushed to the repository with the NuGet V2 API, because it
+// doesn't provide a V3 service index, or its service index doesn't provide the PackagePublish resource.
+func shouldPushWithNugetV2(serverDetails *config.ServerDetails, repoName string) (bool, error) {
+	serviceIndex, err := GetServiceIndex(serverDetails, repoName)
+	if err != nil {
+		return false, err
+	}
+	if serviceIndex == nil {
+		log.Warn("The repository", repoName, "doesn't provide the NuGet V3 API. Pushing with the NuGet V2 API.")
+		return true, nil
+	}
+	if _, found := serviceIndex.GetResourceUrl(PackagePublishResource); !found {
+		log.Warn("The NuGet service index of", repoName, "doesn't provide the", PackagePublishResource, "resource. Pushing with the NuGet V2 API.")
+		return true, nil
+	}
+	return false, nil
+}
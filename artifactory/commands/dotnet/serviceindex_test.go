@@ -0,0 +1,45 @@
+package dotnet
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetServiceIndex(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/nuget/v3/nuget-local/index.json" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, err := w.Write([]byte(`{"version": "3.0.0", "resources": [
+			{"@id": "` + "http://" + r.Host + `/api/nuget/v3/nuget-local/query", "@type": "SearchQueryService/3.0.0-beta"},
+			{"@id": "` + "http://" + r.Host + `/api/nuget/v3/nuget-local", "@type": "PackagePublish/2.0.0"}]}`))
+		assert.NoError(t, err)
+	}))
+	defer server.Close()
+	serverDetails := &config.ServerDetails{ArtifactoryUrl: server.URL + "/"}
+
+	serviceIndex, err := GetServiceIndex(serverDetails, "nuget-local")
+	require.NoError(t, err)
+	require.NotNil(t, serviceIndex)
+	publishUrl, found := serviceIndex.GetResourceUrl(PackagePublishResource)
+	assert.True(t, found)
+	assert.Equal(t, server.URL+"/api/nuget/v3/nuget-local", publishUrl)
+	_, found = serviceIndex.GetResourceUrl(PackageBaseAddressResource)
+	assert.False(t, found)
+	pushWithV2, err := shouldPushWithNugetV2(serverDetails, "nuget-local")
+	require.NoError(t, err)
+	assert.False(t, pushWithV2)
+
+	serviceIndex, err = GetServiceIndex(serverDetails, "nuget-v2-only")
+	require.NoError(t, err)
+	assert.Nil(t, serviceIndex)
+	pushWithV2, err = shouldPushWithNugetV2(serverDetails, "nuget-v2-only")
+	require.NoError(t, err)
+	assert.True(t, pushWithV2)
+}
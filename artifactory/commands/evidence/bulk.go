@@ -0,0 +1,321 @@
+package evidence
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/jfrog/gofrog/parallel"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/formats"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clientconfig"
+	"github.com/jfrog/jfrog-cli-artifactory/evidence/attestation"
+	"github.com/jfrog/jfrog-cli-artifactory/evidence/signing"
+	"github.com/jfrog/jfrog-cli-artifactory/evidence/subject"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	BulkItemSucceeded = "success"
+	BulkItemFailed    = "failure"
+)
+
+// BulkManifest lists the evidence to create, as pairs of a subject and a predicate. The key and key alias apply to
+// the items which don't set their own.
+type BulkManifest struct {
+	Key      string     `yaml:"key,omitempty" json:"key,omitempty"`
+	KeyAlias string     `yaml:"keyAlias,omitempty" json:"keyAlias,omitempty"`
+	Items    []BulkItem `yaml:"items" json:"items"`
+}
+
+// BulkItem is a predicate about a subject, which is either a repository path or the coordinates of a package.
+type BulkItem struct {
+	SubjectRepoPath string           `yaml:"subjectRepoPath,omitempty" json:"subjectRepoPath,omitempty"`
+	Package         *subject.Package `yaml:"package,omitempty" json:"package,omitempty"`
+	// The path of the JSON predicate file, relative to the manifest.
+	Predicate     string `yaml:"predicate" json:"predicate"`
+	PredicateType string `yaml:"predicateType" json:"predicateType"`
+	Key           string `yaml:"key,omitempty" json:"key,omitempty"`
+	KeyAlias      string `yaml:"keyAlias,omitempty" json:"keyAlias,omitempty"`
+}
+
+func (bi *BulkItem) subjectName() string {
+	if bi.Package != nil {
+		return bi.Package.String()
+	}
+	return bi.SubjectRepoPath
+}
+
+// BulkSummary is the outcome of creating the evidence of a manifest. The items are in the order of the manifest.
+type BulkSummary struct {
+	TotalSucceeded int              `json:"totalSucceeded"`
+	TotalFailed    int              `json:"totalFailed"`
+	Items          []BulkItemResult `json:"items"`
+}
+
+type BulkItemResult struct {
+	Subject         string `json:"subject"`
+	SubjectRepoPath string `json:"subjectRepoPath,omitempty"`
+	SubjectSha256   string `json:"subjectSha256,omitempty"`
+	PredicateType   string `json:"predicateType"`
+	Status          string `json:"status"`
+	Error           string `json:"error,omitempty"`
+}
+
+type bulkItemRow struct {
+	Subject       string `col-name:"Subject"`
+	PredicateType string `col-name:"Predicate Type"`
+	Status        string `col-name:"Status"`
+	Error         string `col-name:"Error"`
+}
+
+func (bs *BulkSummary) Tables() []formats.Table {
+	var rows []bulkItemRow
+	for _, item := range bs.Items {
+		rows = append(rows, bulkItemRow{item.Subject, item.PredicateType, item.Status, item.Error})
+	}
+	return []formats.Table{{Title: fmt.Sprintf("Evidence (%d succeeded, %d failed)", bs.TotalSucceeded, bs.TotalFailed), Rows: rows, EmptyMessage: "The manifest lists no evidence"}}
+}
+
+// CreateBulkCommand creates the evidence listed in a manifest file, in parallel. An item which fails doesn't stop the
+// others, and the outcome of each item is reported.
+type CreateBulkCommand struct {
+	serverDetails *config.ServerDetails
+	manifestPath  string
+	keyPath       string
+	keyAlias      string
+	threads       int
+	format        string
+	summary       *BulkSummary
+}
+
+func NewCreateBulkCommand() *CreateBulkCommand {
+	return &CreateBulkCommand{}
+}
+
+func (cbc *CreateBulkCommand) SetManifestPath(manifestPath string) *CreateBulkCommand {
+	cbc.manifestPath = manifestPath
+	return cbc
+}
+
+// SetKeyPath sets the key which signs the items for which neither the item nor the manifest sets a key.
+func (cbc *CreateBulkCommand) SetKeyPath(keyPath string) *CreateBulkCommand {
+	cbc.keyPath = keyPath
+	return cbc
+}
+
+func (cbc *CreateBulkCommand) SetKeyAlias(keyAlias string) *CreateBulkCommand {
+	cbc.keyAlias = keyAlias
+	return cbc
+}
+
+func (cbc *CreateBulkCommand) SetThreads(threads int) *CreateBulkCommand {
+	cbc.threads = threads
+	return cbc
+}
+
+func (cbc *CreateBulkCommand) SetFormat(format string) *CreateBulkCommand {
+	cbc.format = format
+	return cbc
+}
+
+func (cbc *CreateBulkCommand) SetServerDetails(serverDetails *config.ServerDetails) *CreateBulkCommand {
+	cbc.serverDetails = serverDetails
+	return cbc
+}
+
+func (cbc *CreateBulkCommand) ServerDetails() (*config.ServerDetails, error) {
+	return cbc.serverDetails, nil
+}
+
+func (cbc *CreateBulkCommand) CommandName() string {
+	return "rt_evidence_create_bulk"
+}
+
+// Summary returns the outcome of the last run.
+func (cbc *CreateBulkCommand) Summary() *BulkSummary {
+	return cbc.summary
+}
+
+func (cbc *CreateBulkCommand) Run() error {
+	outputFormat, err := formats.ParseFormat(cbc.format)
+	if err != nil {
+		return err
+	}
+	manifest, err := LoadBulkManifest(cbc.manifestPath)
+	if err != nil {
+		return err
+	}
+	servicesManager, err := clientconfig.CreateServiceManager(cbc.serverDetails, -1, 0, false)
+	if err != nil {
+		return err
+	}
+	cbc.summary, err = cbc.createBulk(servicesManager, manifest)
+	if err != nil {
+		return err
+	}
+	if outputFormat == "" {
+		outputFormat = formats.TableFormat
+	}
+	if err = formats.Print(outputFormat, formats.EvidenceBulkKind, cbc.summary); err != nil {
+		return err
+	}
+	if cbc.summary.TotalFailed > 0 {
+		return errorutils.CheckErrorf("failed to create %d of the %d evidence items of %s", cbc.summary.TotalFailed, len(cbc.summary.Items), cbc.manifestPath)
+	}
+	return nil
+}
+
+// LoadBulkManifest reads a YAML or JSON manifest, and resolves the paths of its predicates and keys relative to it.
+func LoadBulkManifest(manifestPath string) (*BulkManifest, error) {
+	content, err := os.ReadFile(manifestPath)
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	manifest := new(BulkManifest)
+	// JSON is parsed as YAML.
+	if err = yaml.Unmarshal(content, manifest); err != nil {
+		return nil, errorutils.CheckErrorf("failed to parse the evidence manifest '%s': %s", manifestPath, err.Error())
+	}
+	baseDir := filepath.Dir(manifestPath)
+	resolvePath := func(path string) string {
+		if path == "" || filepath.IsAbs(path) {
+			return path
+		}
+		return filepath.Join(baseDir, path)
+	}
+	manifest.Key = resolvePath(manifest.Key)
+	for i := range manifest.Items {
+		item := &manifest.Items[i]
+		if (item.SubjectRepoPath == "") == (item.Package == nil) {
+			return nil, errorutils.CheckErrorf("item %d of the evidence manifest should have either a subjectRepoPath or a package", i+1)
+		}
+		if item.Predicate == "" || item.PredicateType == "" {
+			return nil, errorutils.CheckErrorf("item %d of the evidence manifest should have a predicate and a predicateType", i+1)
+		}
+		item.Predicate, item.Key = resolvePath(item.Predicate), resolvePath(item.Key)
+	}
+	return manifest, nil
+}
+
+func (cbc *CreateBulkCommand) createBulk(servicesManager artifactory.ArtifactoryServicesManager, manifest *BulkManifest) (*BulkSummary, error) {
+	// The keys are loaded once, before any evidence is created, so that a missing key fails fast.
+	signers := make(map[string]signing.Signer)
+	for i := range manifest.Items {
+		keyPath := cbc.itemKeyPath(manifest, &manifest.Items[i])
+		if keyPath == "" {
+			return nil, errorutils.CheckErrorf("no key signs item %d of the evidence manifest. Set a key in the manifest or with the --key option", i+1)
+		}
+		if signers[keyPath] != nil {
+			continue
+		}
+		signer, err := signing.LoadSigner(keyPath)
+		if err != nil {
+			return nil, err
+		}
+		signers[keyPath] = signer
+	}
+
+	summary := &BulkSummary{Items: make([]BulkItemResult, len(manifest.Items))}
+	threads := cbc.threads
+	if threads <= 0 {
+		threads = 1
+	}
+	runner := parallel.NewBounedRunner(threads, false)
+	go func() {
+		defer runner.Done()
+		for i := range manifest.Items {
+			index, item := i, &manifest.Items[i]
+			keyAlias := item.KeyAlias
+			if keyAlias == "" {
+				keyAlias = manifest.KeyAlias
+			}
+			if keyAlias == "" {
+				keyAlias = cbc.keyAlias
+			}
+			signer := signers[cbc.itemKeyPath(manifest, item)]
+			_, _ = runner.AddTask(func(int) error {
+				// Each task writes only its own result.
+				summary.Items[index] = cbc.createItem(servicesManager, item, signer, keyAlias)
+				return nil
+			})
+		}
+	}()
+	runner.Run()
+
+	for _, result := range summary.Items {
+		if result.Status == BulkItemSucceeded {
+			summary.TotalSucceeded++
+		} else {
+			summary.TotalFailed++
+		}
+	}
+	return summary, nil
+}
+
+func (cbc *CreateBulkCommand) itemKeyPath(manifest *BulkManifest, item *BulkItem) string {
+	switch {
+	case item.Key != "":
+		return item.Key
+	case manifest.Key != "":
+		return manifest.Key
+	}
+	return cbc.keyPath
+}
+
+func (cbc *CreateBulkCommand) createItem(servicesManager artifactory.ArtifactoryServicesManager, item *BulkItem, signer signing.Signer, keyAlias string) BulkItemResult {
+	result := BulkItemResult{Subject: item.subjectName(), PredicateType: item.PredicateType, Status: BulkItemSucceeded}
+	var err error
+	result.SubjectRepoPath, result.SubjectSha256, err = createEvidence(cbc.serverDetails, servicesManager, item, signer, keyAlias)
+	if err != nil {
+		log.Error(fmt.Sprintf("Failed to create the %s evidence of %s: %s", item.PredicateType, result.Subject, err.Error()))
+		result.Status, result.Error = BulkItemFailed, err.Error()
+	}
+	return result
+}
+
+// createEvidence signs the predicate of the item about its subject and attaches it to the subject. It returns the
+// repository path and the SHA-256 checksum of the subject, which are resolved from its package coordinates if set.
+func createEvidence(serverDetails *config.ServerDetails, servicesManager artifactory.ArtifactoryServicesManager, item *BulkItem, signer signing.Signer, keyAlias string) (subjectRepoPath, subjectSha256 string, err error) {
+	predicate, err := os.ReadFile(item.Predicate)
+	if err != nil {
+		return "", "", errorutils.CheckError(err)
+	}
+	if !json.Valid(predicate) {
+		return "", "", errorutils.CheckErrorf("the predicate '%s' isn't a JSON file", item.Predicate)
+	}
+	if item.Package != nil {
+		subjectRepoPath, subjectSha256, err = subject.ResolvePackage(servicesManager, *item.Package)
+	} else {
+		subjectRepoPath = strings.TrimPrefix(item.SubjectRepoPath, "/")
+		subjectSha256, err = getSha256(servicesManager, subjectRepoPath)
+	}
+	if err != nil {
+		return "", "", err
+	}
+	statement := attestation.NewStatement(item.PredicateType, json.RawMessage(predicate),
+		attestation.Subject{Name: path.Base(subjectRepoPath), Digest: map[string]string{"sha256": subjectSha256}})
+	envelope, err := attestation.Sign(statement, signer, keyAlias)
+	if err != nil {
+		return "", "", err
+	}
+	if err = attestation.Upload(serverDetails, subjectRepoPath, envelope); err != nil {
+		return "", "", err
+	}
+	log.Info("Attached the", item.PredicateType, "evidence to", subjectRepoPath)
+	return subjectRepoPath, subjectSha256, nil
+}
+
+func getSha256(servicesManager artifactory.ArtifactoryServicesManager, repoPath string) (string, error) {
+	fileInfo, err := servicesManager.FileInfo(repoPath)
+	if err != nil {
+		return "", err
+	}
+	return fileInfo.Checksums.Sha256, nil
+}
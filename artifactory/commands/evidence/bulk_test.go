@@ -0,0 +1,103 @@
+package evidence
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	rtUtils "github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestKey(t *testing.T, dir string) string {
+	_, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	keyBytes, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	require.NoError(t, err)
+	keyPath := filepath.Join(dir, "private.pem")
+	require.NoError(t, os.WriteFile(keyPath, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyBytes}), 0600))
+	return keyPath
+}
+
+func TestCreateBulk(t *testing.T) {
+	var mutex sync.Mutex
+	var uploaded []string
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && strings.HasPrefix(r.URL.Path, "/evidence/api/v1/subject/"):
+			mutex.Lock()
+			uploaded = append(uploaded, strings.TrimPrefix(r.URL.Path, "/evidence/api/v1/subject/"))
+			mutex.Unlock()
+			w.WriteHeader(http.StatusCreated)
+		case r.URL.Path == "/artifactory/api/storage/generic-local/app/1.0/app.zip" || r.URL.Path == "/artifactory/api/storage/generic-local/app/1.0/app.tgz":
+			_, err := w.Write([]byte(`{"checksums":{"sha256":"abc"}}`))
+			assert.NoError(t, err)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer testServer.Close()
+	serverDetails := &config.ServerDetails{Url: testServer.URL + "/", ArtifactoryUrl: testServer.URL + "/artifactory/"}
+	servicesManager, err := rtUtils.CreateServiceManager(serverDetails, 0, 0, false)
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	keyPath := createTestKey(t, dir)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "test-results.json"), []byte(`{"passed":true}`), 0600))
+	manifestPath := filepath.Join(dir, "evidence.yaml")
+	require.NoError(t, os.WriteFile(manifestPath, []byte(`
+keyAlias: ci-key
+items:
+  - subjectRepoPath: generic-local/app/1.0/app.zip
+    predicate: test-results.json
+    predicateType: https://jfrog.com/evidence/test-results/v1
+  - subjectRepoPath: generic-local/app/1.0/missing.zip
+    predicate: test-results.json
+    predicateType: https://jfrog.com/evidence/test-results/v1
+  - package: {type: generic, repo: generic-local, name: app, version: "1.0"}
+    predicate: missing.json
+    predicateType: https://jfrog.com/evidence/test-results/v1
+  - subjectRepoPath: /generic-local/app/1.0/app.tgz
+    predicate: test-results.json
+    predicateType: https://jfrog.com/evidence/test-results/v1
+`), 0600))
+	manifest, err := LoadBulkManifest(manifestPath)
+	require.NoError(t, err)
+	assert.Equal(t, "generic-local", manifest.Items[2].Package.Repo)
+
+	createBulkCmd := NewCreateBulkCommand().SetServerDetails(serverDetails).SetKeyPath(keyPath).SetThreads(3)
+	summary, err := createBulkCmd.createBulk(servicesManager, manifest)
+	require.NoError(t, err)
+	assert.Equal(t, 2, summary.TotalSucceeded)
+	assert.Equal(t, 2, summary.TotalFailed)
+	// The results are in the order of the manifest.
+	require.Len(t, summary.Items, 4)
+	assert.Equal(t, BulkItemResult{Subject: "generic-local/app/1.0/app.zip", SubjectRepoPath: "generic-local/app/1.0/app.zip", SubjectSha256: "abc",
+		PredicateType: "https://jfrog.com/evidence/test-results/v1", Status: BulkItemSucceeded}, summary.Items[0])
+	assert.Equal(t, BulkItemFailed, summary.Items[1].Status)
+	assert.Equal(t, "app@1.0 in generic-local", summary.Items[2].Subject)
+	assert.Contains(t, summary.Items[2].Error, "missing.json")
+	assert.Equal(t, "generic-local/app/1.0/app.tgz", summary.Items[3].SubjectRepoPath)
+	assert.ElementsMatch(t, []string{"generic-local/app/1.0/app.zip", "generic-local/app/1.0/app.tgz"}, uploaded)
+
+	// A key is required for every item before any evidence is created
+	_, err = NewCreateBulkCommand().createBulk(servicesManager, manifest)
+	assert.ErrorContains(t, err, "no key signs item 1")
+}
+
+func TestLoadBulkManifestValidation(t *testing.T) {
+	manifestPath := filepath.Join(t.TempDir(), "evidence.json")
+	require.NoError(t, os.WriteFile(manifestPath, []byte(`{"items":[{"predicate":"p.json","predicateType":"t"}]}`), 0600))
+	_, err := LoadBulkManifest(manifestPath)
+	assert.ErrorContains(t, err, "either a subjectRepoPath or a package")
+}
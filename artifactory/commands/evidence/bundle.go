@@ -0,0 +1,427 @@
+package evidence
+
+import (
+	"crypto"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/formats"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clientconfig"
+	"github.com/jfrog/jfrog-cli-artifactory/evidence/attestation"
+	"github.com/jfrog/jfrog-cli-artifactory/evidence/signing"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/jfrog/jfrog-client-go/onemodel"
+	clientutils "github.com/jfrog/jfrog-client-go/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const BundleMediaType = "application/vnd.jfrog.evidence.bundle.v1+json"
+
+// Bundle holds all the evidence of a subject, together with the public keys which verify it, so that it can be
+// verified without access to the platform.
+type Bundle struct {
+	MediaType  string           `json:"mediaType"`
+	ExportedAt string           `json:"exportedAt"`
+	Subject    BundleSubject    `json:"subject"`
+	Evidence   []BundleEvidence `json:"evidence"`
+	// The trusted public keys of the platform in PEM format, by their alias.
+	PublicKeys map[string]string `json:"publicKeys"`
+}
+
+type BundleSubject struct {
+	RepoPath string `json:"repoPath"`
+	Sha256   string `json:"sha256"`
+}
+
+type BundleEvidence struct {
+	// The repository path of the evidence file in the platform.
+	DownloadPath  string                `json:"downloadPath"`
+	PredicateType string                `json:"predicateType"`
+	CreatedBy     string                `json:"createdBy,omitempty"`
+	CreatedAt     string                `json:"createdAt,omitempty"`
+	KeyAlias      string                `json:"keyAlias,omitempty"`
+	Envelope      *attestation.Envelope `json:"envelope"`
+}
+
+// ExportCommand exports the evidence of a subject into a bundle file.
+type ExportCommand struct {
+	serverDetails   *config.ServerDetails
+	subjectRepoPath string
+	bundlePath      string
+	evidenceQuerier onemodel.Manager
+}
+
+func NewExportCommand() *ExportCommand {
+	return &ExportCommand{}
+}
+
+func (ec *ExportCommand) SetSubjectRepoPath(subjectRepoPath string) *ExportCommand {
+	ec.subjectRepoPath = strings.Trim(subjectRepoPath, "/")
+	return ec
+}
+
+// SetBundlePath sets the path of the exported bundle. By default, it is <subject name>.evidence.json in the current
+// directory.
+func (ec *ExportCommand) SetBundlePath(bundlePath string) *ExportCommand {
+	ec.bundlePath = bundlePath
+	return ec
+}
+
+func (ec *ExportCommand) SetServerDetails(serverDetails *config.ServerDetails) *ExportCommand {
+	ec.serverDetails = serverDetails
+	return ec
+}
+
+func (ec *ExportCommand) ServerDetails() (*config.ServerDetails, error) {
+	return ec.serverDetails, nil
+}
+
+func (ec *ExportCommand) CommandName() string {
+	return "rt_evidence_export"
+}
+
+func (ec *ExportCommand) Run() error {
+	servicesManager, err := clientconfig.CreateServiceManager(ec.serverDetails, -1, 0, false)
+	if err != nil {
+		return err
+	}
+	if ec.evidenceQuerier == nil {
+		onemodelDetails := *ec.serverDetails
+		if onemodelDetails.OnemodelUrl == "" {
+			onemodelDetails.OnemodelUrl = clientutils.AddTrailingSlashIfNeeded(ec.serverDetails.Url) + "onemodel/"
+		}
+		if ec.evidenceQuerier, err = clientconfig.CreateOnemodelServiceManager(&onemodelDetails, false); err != nil {
+			return err
+		}
+	}
+	bundle, err := exportBundle(servicesManager, ec.evidenceQuerier, ec.subjectRepoPath, time.Now())
+	if err != nil {
+		return err
+	}
+	content, err := json.MarshalIndent(bundle, "", "  ")
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	bundlePath := ec.bundlePath
+	if bundlePath == "" {
+		bundlePath = path.Base(ec.subjectRepoPath) + ".evidence.json"
+	}
+	if err = os.WriteFile(bundlePath, content, 0644); err != nil {
+		return errorutils.CheckError(err)
+	}
+	log.Info(fmt.Sprintf("Exported %d evidence of %s to %s", len(bundle.Evidence), ec.subjectRepoPath, bundlePath))
+	return nil
+}
+
+type bundleEvidenceResponse struct {
+	Data struct {
+		Evidence struct {
+			SearchEvidence struct {
+				Edges []struct {
+					Node struct {
+						DownloadPath  string `json:"downloadPath"`
+						PredicateType string `json:"predicateType"`
+						CreatedBy     string `json:"createdBy"`
+						CreatedAt     string `json:"createdAt"`
+						SigningKey    struct {
+							Alias string `json:"alias"`
+						} `json:"signingKey"`
+					} `json:"node"`
+				} `json:"edges"`
+			} `json:"searchEvidence"`
+		} `json:"evidence"`
+	} `json:"data"`
+	Errors []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+func exportBundle(servicesManager artifactory.ArtifactoryServicesManager, evidenceQuerier onemodel.Manager, subjectRepoPath string, now time.Time) (*Bundle, error) {
+	repo, itemPath, found := strings.Cut(subjectRepoPath, "/")
+	if !found || itemPath == "" {
+		return nil, errorutils.CheckErrorf("'%s' isn't the repository path of a file", subjectRepoPath)
+	}
+	fileInfo, err := servicesManager.FileInfo(subjectRepoPath)
+	if err != nil {
+		return nil, err
+	}
+	bundle := &Bundle{
+		MediaType:  BundleMediaType,
+		ExportedAt: now.UTC().Format(time.RFC3339),
+		Subject:    BundleSubject{RepoPath: subjectRepoPath, Sha256: fileInfo.Checksums.Sha256},
+		Evidence:   []BundleEvidence{},
+		PublicKeys: map[string]string{},
+	}
+
+	query := fmt.Sprintf("{ evidence { searchEvidence(where: { hasSubjectWith: { repositoryKey: %q, path: %q, name: %q } }) "+
+		"{ edges { node { downloadPath predicateType createdBy createdAt signingKey { alias } } } } } }", repo, path.Dir(itemPath), path.Base(itemPath))
+	content, err := json.Marshal(map[string]string{"query": query})
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	body, err := evidenceQuerier.GraphqlQuery(content)
+	if err != nil {
+		return nil, err
+	}
+	var response bundleEvidenceResponse
+	if err = json.Unmarshal(body, &response); err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	if len(response.Errors) > 0 {
+		return nil, errorutils.CheckErrorf("failed to search the evidence of %s: %s", subjectRepoPath, response.Errors[0].Message)
+	}
+	aliases := map[string]bool{}
+	for _, edge := range response.Data.Evidence.SearchEvidence.Edges {
+		node := edge.Node
+		envelope, err := downloadEnvelope(servicesManager, node.DownloadPath)
+		if err != nil {
+			return nil, err
+		}
+		bundle.Evidence = append(bundle.Evidence, BundleEvidence{
+			DownloadPath:  node.DownloadPath,
+			PredicateType: node.PredicateType,
+			CreatedBy:     node.CreatedBy,
+			CreatedAt:     node.CreatedAt,
+			KeyAlias:      node.SigningKey.Alias,
+			Envelope:      envelope,
+		})
+		if node.SigningKey.Alias != "" {
+			aliases[node.SigningKey.Alias] = true
+		}
+	}
+	if len(aliases) == 0 {
+		return bundle, nil
+	}
+	trustedKeys, err := listTrustedKeys(servicesManager)
+	if err != nil {
+		return nil, err
+	}
+	for _, key := range trustedKeys {
+		if aliases[key.Alias] {
+			bundle.PublicKeys[key.Alias] = key.Key
+		}
+	}
+	for alias := range aliases {
+		if _, found := bundle.PublicKeys[alias]; !found {
+			log.Warn(fmt.Sprintf("The key '%s' isn't trusted by the platform, so the evidence signed by it can't be verified by the bundle alone.", alias))
+		}
+	}
+	return bundle, nil
+}
+
+func downloadEnvelope(servicesManager artifactory.ArtifactoryServicesManager, downloadPath string) (envelope *attestation.Envelope, err error) {
+	reader, err := servicesManager.ReadRemoteFile(downloadPath)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		err = errors.Join(err, errorutils.CheckError(reader.Close()))
+	}()
+	content, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	envelope = new(attestation.Envelope)
+	if err = json.Unmarshal(content, envelope); err != nil {
+		return nil, errorutils.CheckErrorf("the evidence file '%s' isn't a DSSE envelope: %s", downloadPath, err.Error())
+	}
+	return envelope, nil
+}
+
+// The results of verifying the evidence of a bundle.
+const (
+	VerificationPassed = "verified"
+	VerificationFailed = "failed"
+)
+
+// BundleVerification is the outcome of verifying the evidence of a bundle offline.
+type BundleVerification struct {
+	Subject  BundleSubject          `json:"subject"`
+	Verified bool                   `json:"verified"`
+	Evidence []EvidenceVerification `json:"evidence"`
+}
+
+type EvidenceVerification struct {
+	PredicateType string `json:"predicateType"`
+	KeyAlias      string `json:"keyAlias,omitempty"`
+	Status        string `json:"status"`
+	Error         string `json:"error,omitempty"`
+}
+
+type evidenceVerificationRow struct {
+	PredicateType string `col-name:"Predicate Type"`
+	KeyAlias      string `col-name:"Key"`
+	Status        string `col-name:"Status"`
+	Error         string `col-name:"Error"`
+}
+
+func (bv *BundleVerification) Tables() []formats.Table {
+	var rows []evidenceVerificationRow
+	for _, evidence := range bv.Evidence {
+		rows = append(rows, evidenceVerificationRow(evidence))
+	}
+	return []formats.Table{{Title: "Evidence of " + bv.Subject.RepoPath, Rows: rows, EmptyMessage: "The bundle holds no evidence"}}
+}
+
+// VerifyBundleCommand verifies the evidence of an exported bundle without access to the platform. Each envelope should
+// be signed by a trusted key, and its statement should be about the subject of the bundle.
+type VerifyBundleCommand struct {
+	bundlePath     string
+	subjectPath    string
+	publicKeyPaths []string
+	format         string
+}
+
+func NewVerifyBundleCommand() *VerifyBundleCommand {
+	return &VerifyBundleCommand{}
+}
+
+func (vbc *VerifyBundleCommand) SetBundlePath(bundlePath string) *VerifyBundleCommand {
+	vbc.bundlePath = bundlePath
+	return vbc
+}
+
+// SetSubjectPath sets the path of a local copy of the subject, whose checksum should match the subject of the bundle.
+func (vbc *VerifyBundleCommand) SetSubjectPath(subjectPath string) *VerifyBundleCommand {
+	vbc.subjectPath = subjectPath
+	return vbc
+}
+
+// SetPublicKeyPaths sets the public keys to trust. If set, the keys of the bundle are ignored, so that the trust
+// doesn't depend on the bundle itself.
+func (vbc *VerifyBundleCommand) SetPublicKeyPaths(publicKeyPaths []string) *VerifyBundleCommand {
+	vbc.publicKeyPaths = publicKeyPaths
+	return vbc
+}
+
+func (vbc *VerifyBundleCommand) SetFormat(format string) *VerifyBundleCommand {
+	vbc.format = format
+	return vbc
+}
+
+func (vbc *VerifyBundleCommand) ServerDetails() (*config.ServerDetails, error) {
+	return nil, nil
+}
+
+func (vbc *VerifyBundleCommand) CommandName() string {
+	return "rt_evidence_verify_bundle"
+}
+
+func (vbc *VerifyBundleCommand) Run() error {
+	outputFormat, err := formats.ParseFormat(vbc.format)
+	if err != nil {
+		return err
+	}
+	content, err := os.ReadFile(vbc.bundlePath)
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	bundle := new(Bundle)
+	if err = json.Unmarshal(content, bundle); err != nil || bundle.MediaType != BundleMediaType {
+		return errorutils.CheckErrorf("'%s' isn't an evidence bundle", vbc.bundlePath)
+	}
+	if vbc.subjectPath != "" {
+		if err = checkSubjectFile(vbc.subjectPath, bundle.Subject); err != nil {
+			return err
+		}
+	}
+	var trustedKeys []crypto.PublicKey
+	for _, keyPath := range vbc.publicKeyPaths {
+		publicKey, err := signing.LoadPublicKey(keyPath)
+		if err != nil {
+			return err
+		}
+		trustedKeys = append(trustedKeys, publicKey)
+	}
+	verification := verifyBundle(bundle, trustedKeys)
+	if outputFormat == "" {
+		outputFormat = formats.TableFormat
+	}
+	if err = formats.Print(outputFormat, formats.EvidenceVerificationKind, verification); err != nil {
+		return err
+	}
+	if !verification.Verified {
+		return errorutils.CheckErrorf("the evidence of %s in '%s' failed the verification", bundle.Subject.RepoPath, vbc.bundlePath)
+	}
+	return nil
+}
+
+func checkSubjectFile(subjectPath string, subject BundleSubject) (err error) {
+	file, err := os.Open(subjectPath)
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	defer func() {
+		err = errors.Join(err, errorutils.CheckError(file.Close()))
+	}()
+	hash := sha256.New()
+	if _, err = io.Copy(hash, file); err != nil {
+		return errorutils.CheckError(err)
+	}
+	if checksum := hex.EncodeToString(hash.Sum(nil)); checksum != subject.Sha256 {
+		return errorutils.CheckErrorf("the sha256 of '%s' is %s, but the evidence is about %s with sha256 %s", subjectPath, checksum, subject.RepoPath, subject.Sha256)
+	}
+	return nil
+}
+
+// verifyBundle verifies each envelope of the bundle by the trusted keys, or by the key of the bundle with its alias if
+// no keys are trusted explicitly. The bundle is verified if it has evidence and all of it is verified.
+func verifyBundle(bundle *Bundle, trustedKeys []crypto.PublicKey) *BundleVerification {
+	verification := &BundleVerification{Subject: bundle.Subject, Verified: len(bundle.Evidence) > 0, Evidence: []EvidenceVerification{}}
+	for _, evidence := range bundle.Evidence {
+		result := EvidenceVerification{PredicateType: evidence.PredicateType, KeyAlias: evidence.KeyAlias, Status: VerificationPassed}
+		if err := verifyEvidence(bundle, evidence, trustedKeys); err != nil {
+			result.Status, result.Error = VerificationFailed, err.Error()
+			verification.Verified = false
+		}
+		verification.Evidence = append(verification.Evidence, result)
+	}
+	return verification
+}
+
+func verifyEvidence(bundle *Bundle, evidence BundleEvidence, trustedKeys []crypto.PublicKey) error {
+	if evidence.Envelope == nil {
+		return errorutils.CheckErrorf("the evidence has no envelope")
+	}
+	keys := trustedKeys
+	if len(keys) == 0 {
+		keyPem, found := bundle.PublicKeys[evidence.KeyAlias]
+		if !found {
+			return errorutils.CheckErrorf("the bundle has no public key with the alias '%s'", evidence.KeyAlias)
+		}
+		publicKey, err := signing.ParsePublicKey([]byte(keyPem))
+		if err != nil {
+			return err
+		}
+		keys = []crypto.PublicKey{publicKey}
+	}
+	var statement *attestation.Statement
+	var err error
+	for _, key := range keys {
+		if statement, err = attestation.VerifyEnvelope(evidence.Envelope, key); err == nil {
+			break
+		}
+	}
+	if err != nil {
+		return err
+	}
+	if statement.PredicateType != evidence.PredicateType {
+		return errorutils.CheckErrorf("the signed predicate type is '%s'", statement.PredicateType)
+	}
+	for _, statementSubject := range statement.Subject {
+		if statementSubject.Digest["sha256"] == bundle.Subject.Sha256 {
+			return nil
+		}
+	}
+	return errorutils.CheckErrorf("the signed statement isn't about the subject with sha256 %s", bundle.Subject.Sha256)
+}
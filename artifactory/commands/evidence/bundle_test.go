@@ -0,0 +1,100 @@
+package evidence
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/jfrog/jfrog-cli-artifactory/evidence/attestation"
+	"github.com/jfrog/jfrog-cli-artifactory/evidence/signing"
+	rtUtils "github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testPredicateType = "https://jfrog.com/evidence/test-results/v1"
+
+type fakeEvidenceQuerier struct{}
+
+func (f *fakeEvidenceQuerier) GraphqlQuery([]byte) ([]byte, error) {
+	return []byte(`{"data":{"evidence":{"searchEvidence":{"edges":[{"node":{"downloadPath":"generic-local/app/.evidence/test-results.json",
+		"predicateType":"` + testPredicateType + `","createdBy":"ci","signingKey":{"alias":"ci-key"}}}]}}}}`), nil
+}
+
+func TestExportAndVerifyBundle(t *testing.T) {
+	signer, err := signing.LoadSigner(createTestKey(t, t.TempDir()))
+	require.NoError(t, err)
+	publicKeyBytes, err := x509.MarshalPKIXPublicKey(signer.Public())
+	require.NoError(t, err)
+	publicKeyPem := string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicKeyBytes}))
+	statement := attestation.NewStatement(testPredicateType, map[string]bool{"passed": true},
+		attestation.Subject{Name: "app.zip", Digest: map[string]string{"sha256": "abc"}})
+	envelope, err := attestation.Sign(statement, signer, "ci-key")
+	require.NoError(t, err)
+	envelopeContent, err := json.Marshal(envelope)
+	require.NoError(t, err)
+
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var response []byte
+		switch r.URL.Path {
+		case "/api/storage/generic-local/app/app.zip":
+			response = []byte(`{"checksums":{"sha256":"abc"}}`)
+		case "/generic-local/app/.evidence/test-results.json":
+			response = envelopeContent
+		case "/" + trustedKeysApi:
+			keys, err := json.Marshal(map[string]any{"keys": []map[string]string{{"alias": "ci-key", "key": publicKeyPem}, {"alias": "other", "key": "x"}}})
+			assert.NoError(t, err)
+			response = keys
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, err := w.Write(response)
+		assert.NoError(t, err)
+	}))
+	defer testServer.Close()
+	servicesManager, err := rtUtils.CreateServiceManager(&config.ServerDetails{ArtifactoryUrl: testServer.URL + "/"}, 0, 0, false)
+	require.NoError(t, err)
+
+	bundle, err := exportBundle(servicesManager, &fakeEvidenceQuerier{}, "generic-local/app/app.zip", time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, BundleSubject{RepoPath: "generic-local/app/app.zip", Sha256: "abc"}, bundle.Subject)
+	require.Len(t, bundle.Evidence, 1)
+	assert.Equal(t, envelope, bundle.Evidence[0].Envelope)
+	// Only the keys which signed the evidence are exported
+	assert.Equal(t, map[string]string{"ci-key": publicKeyPem}, bundle.PublicKeys)
+
+	// The bundle is verified by its own keys, once it has gone through a file
+	content, err := json.Marshal(bundle)
+	require.NoError(t, err)
+	exported := new(Bundle)
+	require.NoError(t, json.Unmarshal(content, exported))
+	verification := verifyBundle(exported, nil)
+	assert.True(t, verification.Verified)
+	assert.Equal(t, []EvidenceVerification{{PredicateType: testPredicateType, KeyAlias: "ci-key", Status: VerificationPassed}}, verification.Evidence)
+
+	// Explicitly trusted keys replace the keys of the bundle
+	_, otherKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	verification = verifyBundle(exported, []crypto.PublicKey{otherKey.Public()})
+	assert.False(t, verification.Verified)
+	assert.Equal(t, "invalid signature", verification.Evidence[0].Error)
+	assert.True(t, verifyBundle(exported, []crypto.PublicKey{otherKey.Public(), signer.Public()}).Verified)
+
+	// Evidence about another subject fails the verification
+	exported.Subject.Sha256 = "def"
+	verification = verifyBundle(exported, nil)
+	assert.False(t, verification.Verified)
+	assert.Contains(t, verification.Evidence[0].Error, "isn't about the subject")
+
+	// A bundle without evidence isn't verified
+	assert.False(t, verifyBundle(&Bundle{}, nil).Verified)
+}
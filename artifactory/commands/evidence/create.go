@@ -0,0 +1,86 @@
+package evidence
+
+import (
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clientconfig"
+	"github.com/jfrog/jfrog-cli-artifactory/evidence/signing"
+	"github.com/jfrog/jfrog-cli-artifactory/evidence/subject"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+)
+
+// CreateCommand signs a JSON predicate about a subject and attaches it as evidence. The subject is either a repository
+// path or the coordinates of a package, whose artifact is resolved through the native API of its package type.
+type CreateCommand struct {
+	serverDetails   *config.ServerDetails
+	predicatePath   string
+	predicateType   string
+	subjectRepoPath string
+	subjectPackage  *subject.Package
+	keyPath         string
+	keyAlias        string
+}
+
+func NewCreateCommand() *CreateCommand {
+	return &CreateCommand{}
+}
+
+func (cc *CreateCommand) SetPredicatePath(predicatePath string) *CreateCommand {
+	cc.predicatePath = predicatePath
+	return cc
+}
+
+func (cc *CreateCommand) SetPredicateType(predicateType string) *CreateCommand {
+	cc.predicateType = predicateType
+	return cc
+}
+
+func (cc *CreateCommand) SetSubjectRepoPath(subjectRepoPath string) *CreateCommand {
+	cc.subjectRepoPath = subjectRepoPath
+	return cc
+}
+
+// SetSubjectPackage sets the package version which the evidence is attached to, instead of a repository path.
+func (cc *CreateCommand) SetSubjectPackage(subjectPackage *subject.Package) *CreateCommand {
+	cc.subjectPackage = subjectPackage
+	return cc
+}
+
+func (cc *CreateCommand) SetKeyPath(keyPath string) *CreateCommand {
+	cc.keyPath = keyPath
+	return cc
+}
+
+func (cc *CreateCommand) SetKeyAlias(keyAlias string) *CreateCommand {
+	cc.keyAlias = keyAlias
+	return cc
+}
+
+func (cc *CreateCommand) SetServerDetails(serverDetails *config.ServerDetails) *CreateCommand {
+	cc.serverDetails = serverDetails
+	return cc
+}
+
+func (cc *CreateCommand) ServerDetails() (*config.ServerDetails, error) {
+	return cc.serverDetails, nil
+}
+
+func (cc *CreateCommand) CommandName() string {
+	return "rt_evidence_create"
+}
+
+func (cc *CreateCommand) Run() error {
+	if (cc.subjectRepoPath == "") == (cc.subjectPackage == nil) {
+		return errorutils.CheckErrorf("either the --subject-repo-path option or the package options should be provided")
+	}
+	signer, err := signing.LoadSigner(cc.keyPath)
+	if err != nil {
+		return err
+	}
+	servicesManager, err := clientconfig.CreateServiceManager(cc.serverDetails, -1, 0, false)
+	if err != nil {
+		return err
+	}
+	item := &BulkItem{SubjectRepoPath: cc.subjectRepoPath, Package: cc.subjectPackage, Predicate: cc.predicatePath, PredicateType: cc.predicateType}
+	_, _, err = createEvidence(cc.serverDetails, servicesManager, item, signer, cc.keyAlias)
+	return err
+}
@@ -0,0 +1,58 @@
+package evidence
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-artifactory/evidence/subject"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreate(t *testing.T) {
+	var testServerUrl string
+	var uploaded []string
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body string
+		switch {
+		case r.Method == http.MethodPost && strings.HasPrefix(r.URL.Path, "/evidence/api/v1/subject/"):
+			uploaded = append(uploaded, strings.TrimPrefix(r.URL.Path, "/evidence/api/v1/subject/"))
+			w.WriteHeader(http.StatusCreated)
+			return
+		case r.URL.Path == "/artifactory/api/npm/npm-local/app/1.2.3":
+			body = `{"dist":{"tarball":"` + testServerUrl + `/artifactory/api/npm/npm-local/app/-/app-1.2.3.tgz"}}`
+		case strings.HasPrefix(r.URL.Path, "/artifactory/api/storage/"):
+			body = `{"checksums":{"sha256":"abc"}}`
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, err := w.Write([]byte(body))
+		assert.NoError(t, err)
+	}))
+	defer testServer.Close()
+	testServerUrl = testServer.URL
+	serverDetails := &config.ServerDetails{Url: testServer.URL + "/", ArtifactoryUrl: testServer.URL + "/artifactory/"}
+
+	dir := t.TempDir()
+	keyPath := createTestKey(t, dir)
+	predicatePath := filepath.Join(dir, "test-results.json")
+	require.NoError(t, os.WriteFile(predicatePath, []byte(`{"passed":true}`), 0600))
+	newCreateCmd := func() *CreateCommand {
+		return NewCreateCommand().SetServerDetails(serverDetails).SetPredicatePath(predicatePath).
+			SetPredicateType("https://jfrog.com/evidence/test-results/v1").SetKeyPath(keyPath)
+	}
+
+	// The subject is resolved from the package coordinates
+	require.NoError(t, newCreateCmd().SetSubjectPackage(&subject.Package{Type: subject.Npm, Repo: "npm-local", Name: "app", Version: "1.2.3"}).Run())
+	require.NoError(t, newCreateCmd().SetSubjectRepoPath("/generic-local/app/1.0/app.zip").Run())
+	assert.Equal(t, []string{"npm-local/app/-/app-1.2.3.tgz", "generic-local/app/1.0/app.zip"}, uploaded)
+
+	assert.ErrorContains(t, newCreateCmd().Run(), "either the --subject-repo-path option or the package options")
+	assert.ErrorContains(t, newCreateCmd().SetSubjectPackage(&subject.Package{Type: subject.Npm, Repo: "npm-local", Name: "app", Version: "2.0.0"}).Run(), "404")
+}
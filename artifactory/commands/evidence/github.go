@@ -0,0 +1,370 @@
+package evidence
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/formats"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clientconfig"
+	"github.com/jfrog/jfrog-cli-artifactory/evidence/attestation"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/jfrog/jfrog-client-go/onemodel"
+	clientutils "github.com/jfrog/jfrog-client-go/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// The media type of the Sigstore bundles of GitHub artifact attestations, which the exported bundles have.
+const SigstoreBundleMediaType = "application/vnd.dev.sigstore.bundle.v0.3+json"
+
+// SigstoreBundle is a DSSE envelope with the material which verifies it, as created by GitHub artifact attestations
+// and downloaded by 'gh attestation download'.
+type SigstoreBundle struct {
+	MediaType string `json:"mediaType"`
+	// The certificate and transparency log entries of GitHub attestations, or the hint of the public key of JFrog
+	// evidence.
+	VerificationMaterial json.RawMessage       `json:"verificationMaterial,omitempty"`
+	DsseEnvelope         *attestation.Envelope `json:"dsseEnvelope"`
+}
+
+// Parses the bundles of a file downloaded by 'gh attestation download', which has a bundle per line, or the response of
+// the attestations API of GitHub, or a single bundle.
+func parseSigstoreBundles(content []byte) ([]SigstoreBundle, error) {
+	var apiResponse struct {
+		Attestations []struct {
+			Bundle SigstoreBundle `json:"bundle"`
+		} `json:"attestations"`
+	}
+	if err := json.Unmarshal(content, &apiResponse); err == nil && len(apiResponse.Attestations) > 0 {
+		var bundles []SigstoreBundle
+		for _, item := range apiResponse.Attestations {
+			bundles = append(bundles, item.Bundle)
+		}
+		return bundles, nil
+	}
+	var bundles []SigstoreBundle
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	for line := 1; scanner.Scan(); line++ {
+		if len(bytes.TrimSpace(scanner.Bytes())) == 0 {
+			continue
+		}
+		var bundle SigstoreBundle
+		if err := json.Unmarshal(scanner.Bytes(), &bundle); err != nil {
+			// A single bundle may be indented over many lines.
+			if err = json.Unmarshal(content, &bundle); err != nil {
+				return nil, errorutils.CheckErrorf("line %d isn't a Sigstore bundle: %s", line, err.Error())
+			}
+			return []SigstoreBundle{bundle}, nil
+		}
+		bundles = append(bundles, bundle)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	return bundles, nil
+}
+
+// ImportGithubCommand uploads GitHub artifact attestations as evidence. Each attestation is attached to the artifact in
+// Artifactory with the SHA-256 digest of its subject.
+type ImportGithubCommand struct {
+	serverDetails   *config.ServerDetails
+	bundlePath      string
+	subjectRepoPath string
+	repo            string
+	format          string
+}
+
+func NewImportGithubCommand() *ImportGithubCommand {
+	return &ImportGithubCommand{}
+}
+
+func (igc *ImportGithubCommand) SetBundlePath(bundlePath string) *ImportGithubCommand {
+	igc.bundlePath = bundlePath
+	return igc
+}
+
+// SetSubjectRepoPath sets the artifact which the attestations are attached to, instead of finding the artifacts by the
+// digests of their subjects. The artifact should have the digest of a subject of each attestation.
+func (igc *ImportGithubCommand) SetSubjectRepoPath(subjectRepoPath string) *ImportGithubCommand {
+	igc.subjectRepoPath = strings.Trim(subjectRepoPath, "/")
+	return igc
+}
+
+// SetRepo limits the artifacts found by the digests of the subjects to a repository.
+func (igc *ImportGithubCommand) SetRepo(repo string) *ImportGithubCommand {
+	igc.repo = repo
+	return igc
+}
+
+func (igc *ImportGithubCommand) SetFormat(format string) *ImportGithubCommand {
+	igc.format = format
+	return igc
+}
+
+func (igc *ImportGithubCommand) SetServerDetails(serverDetails *config.ServerDetails) *ImportGithubCommand {
+	igc.serverDetails = serverDetails
+	return igc
+}
+
+func (igc *ImportGithubCommand) ServerDetails() (*config.ServerDetails, error) {
+	return igc.serverDetails, nil
+}
+
+func (igc *ImportGithubCommand) CommandName() string {
+	return "rt_evidence_import_github"
+}
+
+func (igc *ImportGithubCommand) Run() error {
+	outputFormat, err := formats.ParseFormat(igc.format)
+	if err != nil {
+		return err
+	}
+	content, err := os.ReadFile(igc.bundlePath)
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	bundles, err := parseSigstoreBundles(content)
+	if err != nil {
+		return err
+	}
+	servicesManager, err := clientconfig.CreateServiceManager(igc.serverDetails, -1, 0, false)
+	if err != nil {
+		return err
+	}
+	summary := &BulkSummary{Items: []BulkItemResult{}}
+	for _, bundle := range bundles {
+		result := igc.importBundle(servicesManager, bundle)
+		if result.Status == BulkItemSucceeded {
+			summary.TotalSucceeded++
+		} else {
+			summary.TotalFailed++
+		}
+		summary.Items = append(summary.Items, result)
+	}
+	if outputFormat == "" {
+		outputFormat = formats.TableFormat
+	}
+	if err = formats.Print(outputFormat, formats.EvidenceBulkKind, summary); err != nil {
+		return err
+	}
+	if summary.TotalFailed > 0 {
+		return errorutils.CheckErrorf("failed to import %d of the %d attestations of %s", summary.TotalFailed, len(bundles), igc.bundlePath)
+	}
+	return nil
+}
+
+func (igc *ImportGithubCommand) importBundle(servicesManager artifactory.ArtifactoryServicesManager, bundle SigstoreBundle) BulkItemResult {
+	result := BulkItemResult{Status: BulkItemSucceeded}
+	err := func() error {
+		statement, err := decodeStatement(bundle.DsseEnvelope)
+		if err != nil {
+			return err
+		}
+		result.PredicateType = statement.PredicateType
+		var digests []string
+		for _, subject := range statement.Subject {
+			if digest := subject.Digest["sha256"]; digest != "" {
+				digests = append(digests, digest)
+				result.Subject = subject.Name
+			}
+		}
+		if len(digests) == 0 {
+			return errorutils.CheckErrorf("the attestation has no subject with a sha256 digest")
+		}
+		if result.SubjectRepoPath, result.SubjectSha256, err = igc.resolveSubject(servicesManager, digests); err != nil {
+			return err
+		}
+		return attestation.Upload(igc.serverDetails, result.SubjectRepoPath, bundle.DsseEnvelope)
+	}()
+	if err != nil {
+		log.Error(fmt.Sprintf("Failed to import the attestation of %s: %s", result.Subject, err.Error()))
+		result.Status, result.Error = BulkItemFailed, err.Error()
+		return result
+	}
+	log.Info("Attached the", result.PredicateType, "attestation as evidence to", result.SubjectRepoPath)
+	return result
+}
+
+func decodeStatement(envelope *attestation.Envelope) (*attestation.Statement, error) {
+	if envelope == nil {
+		return nil, errorutils.CheckErrorf("the bundle has no DSSE envelope")
+	}
+	if envelope.PayloadType != attestation.PayloadType {
+		return nil, errorutils.CheckErrorf("the payload type of the envelope is '%s' rather than '%s'", envelope.PayloadType, attestation.PayloadType)
+	}
+	payload, err := base64.StdEncoding.DecodeString(envelope.Payload)
+	if err != nil {
+		return nil, errorutils.CheckErrorf("failed to decode the payload of the envelope: %s", err.Error())
+	}
+	statement := new(attestation.Statement)
+	if err = json.Unmarshal(payload, statement); err != nil {
+		return nil, errorutils.CheckErrorf("failed to parse the statement of the envelope: %s", err.Error())
+	}
+	return statement, nil
+}
+
+// Maps the digests of the subjects of an attestation to the artifact in Artifactory which the attestation is attached
+// to. The artifact should be the only one with any of the digests.
+func (igc *ImportGithubCommand) resolveSubject(servicesManager artifactory.ArtifactoryServicesManager, digests []string) (string, string, error) {
+	if igc.subjectRepoPath != "" {
+		sha256, err := getSha256(servicesManager, igc.subjectRepoPath)
+		if err != nil {
+			return "", "", err
+		}
+		for _, digest := range digests {
+			if digest == sha256 {
+				return igc.subjectRepoPath, sha256, nil
+			}
+		}
+		return "", "", errorutils.CheckErrorf("the sha256 of %s is %s, which isn't the digest of any subject of the attestation", igc.subjectRepoPath, sha256)
+	}
+	var repoPaths []string
+	var sha256 string
+	for _, digest := range digests {
+		found, err := findBySha256(servicesManager, digest, igc.repo)
+		if err != nil {
+			return "", "", err
+		}
+		if len(found) > 0 {
+			sha256 = digest
+		}
+		repoPaths = append(repoPaths, found...)
+	}
+	switch len(repoPaths) {
+	case 0:
+		return "", "", errorutils.CheckErrorf("no artifact has the sha256 digest %s", strings.Join(digests, " or "))
+	case 1:
+		return repoPaths[0], sha256, nil
+	}
+	return "", "", errorutils.CheckErrorf("%d artifacts have the digest of the subject: %s. Narrow them down with --repo or --subject-repo-path", len(repoPaths), strings.Join(repoPaths, ", "))
+}
+
+func findBySha256(servicesManager artifactory.ArtifactoryServicesManager, sha256, repo string) (repoPaths []string, err error) {
+	criteria := fmt.Sprintf(`{"sha256":%q}`, sha256)
+	if repo != "" {
+		criteria = fmt.Sprintf(`{"sha256":%q,"repo":%q}`, sha256, repo)
+	}
+	reader, err := servicesManager.Aql(fmt.Sprintf(`items.find(%s).include("repo","path","name")`, criteria))
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		err = errors.Join(err, errorutils.CheckError(reader.Close()))
+	}()
+	var result struct {
+		Results []struct {
+			Repo string `json:"repo"`
+			Path string `json:"path"`
+			Name string `json:"name"`
+		} `json:"results"`
+	}
+	if err = json.NewDecoder(reader).Decode(&result); err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	for _, item := range result.Results {
+		repoPaths = append(repoPaths, path.Join(item.Repo, item.Path, item.Name))
+	}
+	return repoPaths, nil
+}
+
+// ExportGithubCommand exports the evidence of an artifact as Sigstore bundles, in the format of the files downloaded by
+// 'gh attestation download'. The bundles identify the public key which verifies them by its alias in the platform.
+type ExportGithubCommand struct {
+	serverDetails   *config.ServerDetails
+	subjectRepoPath string
+	outputPath      string
+	evidenceQuerier onemodel.Manager
+}
+
+func NewExportGithubCommand() *ExportGithubCommand {
+	return &ExportGithubCommand{}
+}
+
+func (egc *ExportGithubCommand) SetSubjectRepoPath(subjectRepoPath string) *ExportGithubCommand {
+	egc.subjectRepoPath = strings.Trim(subjectRepoPath, "/")
+	return egc
+}
+
+// SetOutputPath sets the path of the exported file. By default, it is sha256:<digest>.jsonl in the current directory,
+// like the files downloaded by 'gh attestation download'.
+func (egc *ExportGithubCommand) SetOutputPath(outputPath string) *ExportGithubCommand {
+	egc.outputPath = outputPath
+	return egc
+}
+
+func (egc *ExportGithubCommand) SetServerDetails(serverDetails *config.ServerDetails) *ExportGithubCommand {
+	egc.serverDetails = serverDetails
+	return egc
+}
+
+func (egc *ExportGithubCommand) ServerDetails() (*config.ServerDetails, error) {
+	return egc.serverDetails, nil
+}
+
+func (egc *ExportGithubCommand) CommandName() string {
+	return "rt_evidence_export_github"
+}
+
+func (egc *ExportGithubCommand) Run() error {
+	servicesManager, err := clientconfig.CreateServiceManager(egc.serverDetails, -1, 0, false)
+	if err != nil {
+		return err
+	}
+	if egc.evidenceQuerier == nil {
+		onemodelDetails := *egc.serverDetails
+		if onemodelDetails.OnemodelUrl == "" {
+			onemodelDetails.OnemodelUrl = clientutils.AddTrailingSlashIfNeeded(egc.serverDetails.Url) + "onemodel/"
+		}
+		if egc.evidenceQuerier, err = clientconfig.CreateOnemodelServiceManager(&onemodelDetails, false); err != nil {
+			return err
+		}
+	}
+	bundle, err := exportBundle(servicesManager, egc.evidenceQuerier, egc.subjectRepoPath, time.Now())
+	if err != nil {
+		return err
+	}
+	content, err := toSigstoreBundles(bundle)
+	if err != nil {
+		return err
+	}
+	outputPath := egc.outputPath
+	if outputPath == "" {
+		outputPath = "sha256:" + bundle.Subject.Sha256 + ".jsonl"
+	}
+	if err = os.WriteFile(outputPath, content, 0644); err != nil {
+		return errorutils.CheckError(err)
+	}
+	log.Info(fmt.Sprintf("Exported %d evidence of %s to %s", len(bundle.Evidence), egc.subjectRepoPath, outputPath))
+	return nil
+}
+
+// Converts the evidence of the bundle to Sigstore bundles, a bundle per line.
+func toSigstoreBundles(bundle *Bundle) ([]byte, error) {
+	var content bytes.Buffer
+	for _, evidence := range bundle.Evidence {
+		var verificationMaterial json.RawMessage
+		if evidence.KeyAlias != "" {
+			material, err := json.Marshal(map[string]any{"publicKey": map[string]string{"hint": evidence.KeyAlias}})
+			if err != nil {
+				return nil, errorutils.CheckError(err)
+			}
+			verificationMaterial = material
+		}
+		line, err := json.Marshal(SigstoreBundle{MediaType: SigstoreBundleMediaType, VerificationMaterial: verificationMaterial, DsseEnvelope: evidence.Envelope})
+		if err != nil {
+			return nil, errorutils.CheckError(err)
+		}
+		content.Write(append(line, '\n'))
+	}
+	return content.Bytes(), nil
+}
@@ -0,0 +1,114 @@
+package evidence
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-artifactory/evidence/attestation"
+	rtUtils "github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestEnvelope(t *testing.T, digest string) *attestation.Envelope {
+	statement := attestation.NewStatement("https://slsa.dev/provenance/v1", map[string]string{"buildType": "https://actions.github.io/buildtypes/workflow/v1"},
+		attestation.Subject{Name: "app.tgz", Digest: map[string]string{"sha256": digest}})
+	payload, err := json.Marshal(statement)
+	require.NoError(t, err)
+	return &attestation.Envelope{Payload: base64.StdEncoding.EncodeToString(payload), PayloadType: attestation.PayloadType, Signatures: []attestation.Signature{{Sig: "c2ln"}}}
+}
+
+func TestParseSigstoreBundles(t *testing.T) {
+	envelope := createTestEnvelope(t, "abc")
+	bundle, err := json.Marshal(SigstoreBundle{MediaType: SigstoreBundleMediaType, VerificationMaterial: json.RawMessage(`{"certificate":{"rawBytes":"Y2VydA=="}}`), DsseEnvelope: envelope})
+	require.NoError(t, err)
+
+	// The JSON Lines file of 'gh attestation download'
+	bundles, err := parseSigstoreBundles([]byte(string(bundle) + "\n\n" + string(bundle) + "\n"))
+	require.NoError(t, err)
+	require.Len(t, bundles, 2)
+	assert.Equal(t, envelope, bundles[1].DsseEnvelope)
+	assert.JSONEq(t, `{"certificate":{"rawBytes":"Y2VydA=="}}`, string(bundles[0].VerificationMaterial))
+
+	// The response of the attestations API
+	bundles, err = parseSigstoreBundles([]byte(`{"attestations":[{"repository_id":1,"bundle":` + string(bundle) + `}]}`))
+	require.NoError(t, err)
+	require.Len(t, bundles, 1)
+	assert.Equal(t, envelope, bundles[0].DsseEnvelope)
+
+	// An indented bundle
+	indented, err := json.MarshalIndent(SigstoreBundle{MediaType: SigstoreBundleMediaType, DsseEnvelope: envelope}, "", "  ")
+	require.NoError(t, err)
+	bundles, err = parseSigstoreBundles(indented)
+	require.NoError(t, err)
+	require.Len(t, bundles, 1)
+
+	// Evidence exported as bundles is parsed back
+	exported, err := toSigstoreBundles(&Bundle{Evidence: []BundleEvidence{{KeyAlias: "ci-key", Envelope: envelope}}})
+	require.NoError(t, err)
+	bundles, err = parseSigstoreBundles(exported)
+	require.NoError(t, err)
+	require.Len(t, bundles, 1)
+	assert.Equal(t, SigstoreBundleMediaType, bundles[0].MediaType)
+	assert.JSONEq(t, `{"publicKey":{"hint":"ci-key"}}`, string(bundles[0].VerificationMaterial))
+}
+
+func TestImportGithubBundle(t *testing.T) {
+	var uploadedPath string
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var response string
+		switch {
+		case r.URL.Path == "/artifactory/api/search/aql":
+			query, err := io.ReadAll(r.Body)
+			assert.NoError(t, err)
+			switch {
+			case strings.Contains(string(query), `"sha256":"abc"`):
+				response = `{"results":[{"repo":"npm-local","path":"app/-","name":"app-1.0.0.tgz"}]}`
+			case strings.Contains(string(query), `"sha256":"dup"`):
+				response = `{"results":[{"repo":"npm-local","path":"app/-","name":"app-1.0.0.tgz"},{"repo":"npm-remote-cache","path":"app/-","name":"app-1.0.0.tgz"}]}`
+			default:
+				response = `{"results":[]}`
+			}
+		case r.URL.Path == "/artifactory/api/storage/npm-local/app/-/app-1.0.0.tgz":
+			response = `{"checksums":{"sha256":"abc"}}`
+		case r.Method == http.MethodPost && strings.HasPrefix(r.URL.Path, "/evidence/api/v1/subject/"):
+			uploadedPath = strings.TrimPrefix(r.URL.Path, "/evidence/api/v1/subject/")
+			w.WriteHeader(http.StatusCreated)
+			return
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, err := w.Write([]byte(response))
+		assert.NoError(t, err)
+	}))
+	defer testServer.Close()
+	serverDetails := &config.ServerDetails{Url: testServer.URL + "/", ArtifactoryUrl: testServer.URL + "/artifactory/"}
+	servicesManager, err := rtUtils.CreateServiceManager(serverDetails, 0, 0, false)
+	require.NoError(t, err)
+	importCmd := NewImportGithubCommand().SetServerDetails(serverDetails)
+
+	// The subject digest is remapped to the artifact with the same checksum
+	result := importCmd.importBundle(servicesManager, SigstoreBundle{DsseEnvelope: createTestEnvelope(t, "abc")})
+	assert.Equal(t, BulkItemResult{Subject: "app.tgz", SubjectRepoPath: "npm-local/app/-/app-1.0.0.tgz", SubjectSha256: "abc",
+		PredicateType: "https://slsa.dev/provenance/v1", Status: BulkItemSucceeded}, result)
+	assert.Equal(t, "npm-local/app/-/app-1.0.0.tgz", uploadedPath)
+
+	result = importCmd.importBundle(servicesManager, SigstoreBundle{DsseEnvelope: createTestEnvelope(t, "dup")})
+	assert.Contains(t, result.Error, "2 artifacts have the digest")
+	result = importCmd.importBundle(servicesManager, SigstoreBundle{DsseEnvelope: createTestEnvelope(t, "none")})
+	assert.Contains(t, result.Error, "no artifact has the sha256 digest none")
+
+	// An explicit subject should have the digest of the attestation
+	importCmd.SetSubjectRepoPath("npm-local/app/-/app-1.0.0.tgz")
+	result = importCmd.importBundle(servicesManager, SigstoreBundle{DsseEnvelope: createTestEnvelope(t, "dup")})
+	assert.Contains(t, result.Error, "isn't the digest of any subject")
+	result = importCmd.importBundle(servicesManager, SigstoreBundle{DsseEnvelope: createTestEnvelope(t, "abc")})
+	assert.Equal(t, BulkItemSucceeded, result.Status)
+}
@@ -0,0 +1,354 @@
+package evidence
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/formats"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clientconfig"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clierrors"
+	"github.com/jfrog/jfrog-cli-artifactory/evidence/signing"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/io/fileutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const trustedKeysApi = "api/security/keys/trusted"
+
+// TrustedKey is a public key which the platform trusts to verify evidence.
+type TrustedKey struct {
+	Kid         string `json:"kid"`
+	Alias       string `json:"alias"`
+	Fingerprint string `json:"fingerprint,omitempty"`
+	// The public key in PEM format.
+	Key        string `json:"key"`
+	IssuedOn   string `json:"issued_on,omitempty"`
+	IssuedBy   string `json:"issued_by,omitempty"`
+	ValidUntil string `json:"valid_until,omitempty"`
+}
+
+type TrustedKeys []TrustedKey
+
+type trustedKeyRow struct {
+	Alias       string `col-name:"Alias"`
+	Kid         string `col-name:"Key ID"`
+	Fingerprint string `col-name:"Fingerprint"`
+	IssuedOn    string `col-name:"Issued On"`
+	ValidUntil  string `col-name:"Valid Until"`
+}
+
+func (tk TrustedKeys) Tables() []formats.Table {
+	var rows []trustedKeyRow
+	for _, key := range tk {
+		rows = append(rows, trustedKeyRow{key.Alias, key.Kid, key.Fingerprint, key.IssuedOn, key.ValidUntil})
+	}
+	return []formats.Table{{Title: "Trusted Keys", Rows: rows, EmptyMessage: "No trusted keys found"}}
+}
+
+func listTrustedKeys(servicesManager artifactory.ArtifactoryServicesManager) (TrustedKeys, error) {
+	serviceDetails := servicesManager.GetConfig().GetServiceDetails()
+	httpClientDetails := serviceDetails.CreateHttpClientDetails()
+	resp, body, _, err := servicesManager.Client().SendGet(serviceDetails.GetUrl()+trustedKeysApi, true, &httpClientDetails)
+	if err != nil {
+		return nil, err
+	}
+	if err = clierrors.CheckResponseStatusWithBody(resp, body, http.StatusOK); err != nil {
+		return nil, err
+	}
+	var response struct {
+		Keys TrustedKeys `json:"keys"`
+	}
+	if err = json.Unmarshal(body, &response); err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	if response.Keys == nil {
+		return TrustedKeys{}, nil
+	}
+	return response.Keys, nil
+}
+
+// uploadTrustedKey adds the public key in PEM format to the trusted keys of the platform, under the alias. The alias
+// is the key ID of the evidence signed by the private key.
+func uploadTrustedKey(servicesManager artifactory.ArtifactoryServicesManager, alias string, publicPem []byte) (*TrustedKey, error) {
+	if alias == "" {
+		return nil, errorutils.CheckErrorf("an alias is required to upload a trusted key")
+	}
+	content, err := json.Marshal(map[string]string{"alias": alias, "public_key": string(publicPem)})
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	serviceDetails := servicesManager.GetConfig().GetServiceDetails()
+	httpClientDetails := serviceDetails.CreateHttpClientDetails()
+	httpClientDetails.SetContentTypeApplicationJson()
+	resp, body, err := servicesManager.Client().SendPost(serviceDetails.GetUrl()+trustedKeysApi, content, &httpClientDetails)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusConflict {
+		return nil, errorutils.CheckErrorf("a trusted key with the alias '%s' already exists", alias)
+	}
+	if err = clierrors.CheckResponseStatusWithBody(resp, body, http.StatusOK, http.StatusCreated); err != nil {
+		return nil, err
+	}
+	trustedKey := &TrustedKey{Alias: alias, Key: string(publicPem)}
+	if len(body) > 0 {
+		if err = json.Unmarshal(body, trustedKey); err != nil {
+			return nil, errorutils.CheckError(err)
+		}
+	}
+	return trustedKey, nil
+}
+
+// writeKeyPair generates a key pair, and writes the private key to the path and the public key next to it, with a
+// '.pub' suffix. Existing files aren't overwritten.
+func writeKeyPair(keyType, privateKeyPath string) (publicPem []byte, publicKeyPath string, err error) {
+	publicKeyPath = privateKeyPath + ".pub"
+	for _, keyPath := range []string{privateKeyPath, publicKeyPath} {
+		exists, err := fileutils.IsFileExists(keyPath, false)
+		if err != nil {
+			return nil, "", err
+		}
+		if exists {
+			return nil, "", errorutils.CheckErrorf("the file '%s' already exists", keyPath)
+		}
+	}
+	privatePem, publicPem, err := signing.GenerateKey(keyType)
+	if err != nil {
+		return nil, "", err
+	}
+	if err = os.WriteFile(privateKeyPath, privatePem, 0600); err != nil {
+		return nil, "", errorutils.CheckError(err)
+	}
+	if err = os.WriteFile(publicKeyPath, publicPem, 0644); err != nil {
+		return nil, "", errorutils.CheckError(err)
+	}
+	return publicPem, publicKeyPath, nil
+}
+
+// KeyGenerateCommand creates a key pair which signs evidence.
+type KeyGenerateCommand struct {
+	keyType        string
+	privateKeyPath string
+}
+
+func NewKeyGenerateCommand() *KeyGenerateCommand {
+	return &KeyGenerateCommand{keyType: signing.Ed25519Key}
+}
+
+func (kgc *KeyGenerateCommand) SetKeyType(keyType string) *KeyGenerateCommand {
+	kgc.keyType = keyType
+	return kgc
+}
+
+func (kgc *KeyGenerateCommand) SetPrivateKeyPath(privateKeyPath string) *KeyGenerateCommand {
+	kgc.privateKeyPath = privateKeyPath
+	return kgc
+}
+
+func (kgc *KeyGenerateCommand) ServerDetails() (*config.ServerDetails, error) {
+	return nil, nil
+}
+
+func (kgc *KeyGenerateCommand) CommandName() string {
+	return "rt_evidence_key_generate"
+}
+
+func (kgc *KeyGenerateCommand) Run() error {
+	_, publicKeyPath, err := writeKeyPair(kgc.keyType, kgc.privateKeyPath)
+	if err != nil {
+		return err
+	}
+	log.Info(fmt.Sprintf("Generated a %s key pair. The private key is in %s and the public key is in %s.", kgc.keyType, kgc.privateKeyPath, publicKeyPath))
+	return nil
+}
+
+// KeyUploadCommand adds a public key to the trusted keys of the platform, which verify evidence.
+type KeyUploadCommand struct {
+	serverDetails *config.ServerDetails
+	alias         string
+	publicKeyPath string
+}
+
+func NewKeyUploadCommand() *KeyUploadCommand {
+	return &KeyUploadCommand{}
+}
+
+func (kuc *KeyUploadCommand) SetAlias(alias string) *KeyUploadCommand {
+	kuc.alias = alias
+	return kuc
+}
+
+// SetPublicKeyPath sets the path of the public key in PEM format. The public key of a private key file is uploaded as
+// well.
+func (kuc *KeyUploadCommand) SetPublicKeyPath(publicKeyPath string) *KeyUploadCommand {
+	kuc.publicKeyPath = publicKeyPath
+	return kuc
+}
+
+func (kuc *KeyUploadCommand) SetServerDetails(serverDetails *config.ServerDetails) *KeyUploadCommand {
+	kuc.serverDetails = serverDetails
+	return kuc
+}
+
+func (kuc *KeyUploadCommand) ServerDetails() (*config.ServerDetails, error) {
+	return kuc.serverDetails, nil
+}
+
+func (kuc *KeyUploadCommand) CommandName() string {
+	return "rt_evidence_key_upload"
+}
+
+func (kuc *KeyUploadCommand) Run() error {
+	publicKey, err := signing.LoadPublicKey(kuc.publicKeyPath)
+	if err != nil {
+		return err
+	}
+	// The key is re-encoded, so that the private key is never sent.
+	publicPem, err := signing.EncodePublicKey(publicKey)
+	if err != nil {
+		return err
+	}
+	servicesManager, err := clientconfig.CreateServiceManager(kuc.serverDetails, -1, 0, false)
+	if err != nil {
+		return err
+	}
+	trustedKey, err := uploadTrustedKey(servicesManager, kuc.alias, publicPem)
+	if err != nil {
+		return err
+	}
+	log.Info(fmt.Sprintf("Uploaded the trusted key '%s' (key ID %s).", trustedKey.Alias, trustedKey.Kid))
+	return nil
+}
+
+// KeyListCommand lists the trusted keys of the platform, which verify evidence.
+type KeyListCommand struct {
+	serverDetails *config.ServerDetails
+	format        string
+}
+
+func NewKeyListCommand() *KeyListCommand {
+	return &KeyListCommand{}
+}
+
+func (klc *KeyListCommand) SetFormat(format string) *KeyListCommand {
+	klc.format = format
+	return klc
+}
+
+func (klc *KeyListCommand) SetServerDetails(serverDetails *config.ServerDetails) *KeyListCommand {
+	klc.serverDetails = serverDetails
+	return klc
+}
+
+func (klc *KeyListCommand) ServerDetails() (*config.ServerDetails, error) {
+	return klc.serverDetails, nil
+}
+
+func (klc *KeyListCommand) CommandName() string {
+	return "rt_evidence_key_list"
+}
+
+func (klc *KeyListCommand) Run() error {
+	outputFormat, err := formats.ParseFormat(klc.format)
+	if err != nil {
+		return err
+	}
+	servicesManager, err := clientconfig.CreateServiceManager(klc.serverDetails, -1, 0, false)
+	if err != nil {
+		return err
+	}
+	keys, err := listTrustedKeys(servicesManager)
+	if err != nil {
+		return err
+	}
+	if outputFormat == "" {
+		outputFormat = formats.TableFormat
+	}
+	return formats.Print(outputFormat, formats.TrustedKeysKind, keys)
+}
+
+// KeyRotateCommand generates a new key pair and trusts its public key under a new alias. The previous keys stay
+// trusted, so the evidence they signed is still verified while new evidence is signed by the new key. Once the
+// rotation window ends, the previous keys can be removed from the trusted keys of the platform.
+type KeyRotateCommand struct {
+	serverDetails  *config.ServerDetails
+	keyType        string
+	alias          string
+	privateKeyPath string
+}
+
+func NewKeyRotateCommand() *KeyRotateCommand {
+	return &KeyRotateCommand{keyType: signing.Ed25519Key}
+}
+
+func (krc *KeyRotateCommand) SetKeyType(keyType string) *KeyRotateCommand {
+	krc.keyType = keyType
+	return krc
+}
+
+// SetAlias sets the alias of the new key, which should differ from the aliases of the previous keys.
+func (krc *KeyRotateCommand) SetAlias(alias string) *KeyRotateCommand {
+	krc.alias = alias
+	return krc
+}
+
+func (krc *KeyRotateCommand) SetPrivateKeyPath(privateKeyPath string) *KeyRotateCommand {
+	krc.privateKeyPath = privateKeyPath
+	return krc
+}
+
+func (krc *KeyRotateCommand) SetServerDetails(serverDetails *config.ServerDetails) *KeyRotateCommand {
+	krc.serverDetails = serverDetails
+	return krc
+}
+
+func (krc *KeyRotateCommand) ServerDetails() (*config.ServerDetails, error) {
+	return krc.serverDetails, nil
+}
+
+func (krc *KeyRotateCommand) CommandName() string {
+	return "rt_evidence_key_rotate"
+}
+
+func (krc *KeyRotateCommand) Run() error {
+	servicesManager, err := clientconfig.CreateServiceManager(krc.serverDetails, -1, 0, false)
+	if err != nil {
+		return err
+	}
+	previousKeys, err := rotateKey(servicesManager, krc.keyType, krc.alias, krc.privateKeyPath)
+	if err != nil {
+		return err
+	}
+	log.Info(fmt.Sprintf("Sign new evidence with %s and the key alias '%s'.", krc.privateKeyPath, krc.alias))
+	if len(previousKeys) > 0 {
+		log.Info(fmt.Sprintf("The %d previous trusted keys still verify the evidence they signed. Remove them once the rotation window ends.", len(previousKeys)))
+	}
+	return nil
+}
+
+// rotateKey generates the new key pair and uploads its public key, and returns the previously trusted keys. The key
+// pair is generated only if the alias is free, so that a failed rotation doesn't leave an untrusted key behind.
+func rotateKey(servicesManager artifactory.ArtifactoryServicesManager, keyType, alias, privateKeyPath string) (TrustedKeys, error) {
+	previousKeys, err := listTrustedKeys(servicesManager)
+	if err != nil {
+		return nil, err
+	}
+	for _, key := range previousKeys {
+		if key.Alias == alias {
+			return nil, errorutils.CheckErrorf("a trusted key with the alias '%s' already exists. The new key should have a new alias", alias)
+		}
+	}
+	publicPem, publicKeyPath, err := writeKeyPair(keyType, privateKeyPath)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = uploadTrustedKey(servicesManager, alias, publicPem); err != nil {
+		return nil, err
+	}
+	log.Info(fmt.Sprintf("Generated a %s key pair in %s and %s, and trusted its public key as '%s'.", keyType, privateKeyPath, publicKeyPath, alias))
+	return previousKeys, nil
+}
@@ -0,0 +1,68 @@
+package evidence
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-artifactory/evidence/signing"
+	rtUtils "github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRotateKey(t *testing.T) {
+	var uploaded map[string]string
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/"+trustedKeysApi {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if r.Method == http.MethodPost {
+			content, err := io.ReadAll(r.Body)
+			assert.NoError(t, err)
+			assert.NoError(t, json.Unmarshal(content, &uploaded))
+			w.WriteHeader(http.StatusCreated)
+			_, err = w.Write([]byte(`{"kid":"new-kid","alias":"` + uploaded["alias"] + `"}`))
+			assert.NoError(t, err)
+			return
+		}
+		_, err := w.Write([]byte(`{"keys":[{"kid":"old-kid","alias":"ci-2025","key":"old"}]}`))
+		assert.NoError(t, err)
+	}))
+	defer testServer.Close()
+	servicesManager, err := rtUtils.CreateServiceManager(&config.ServerDetails{ArtifactoryUrl: testServer.URL + "/"}, 0, 0, false)
+	require.NoError(t, err)
+	privateKeyPath := filepath.Join(t.TempDir(), "evidence.key")
+
+	// The alias of a previous key can't be reused, and no key pair is generated
+	_, err = rotateKey(servicesManager, signing.Ed25519Key, "ci-2025", privateKeyPath)
+	assert.ErrorContains(t, err, "already exists")
+	assert.NoFileExists(t, privateKeyPath)
+
+	previousKeys, err := rotateKey(servicesManager, signing.EcdsaKey, "ci-2026", privateKeyPath)
+	require.NoError(t, err)
+	assert.Equal(t, TrustedKeys{{Kid: "old-kid", Alias: "ci-2025", Key: "old"}}, previousKeys)
+	assert.Equal(t, "ci-2026", uploaded["alias"])
+	publicPem, err := os.ReadFile(privateKeyPath + ".pub")
+	require.NoError(t, err)
+	assert.Equal(t, string(publicPem), uploaded["public_key"])
+
+	// The new private key signs, and the uploaded public key verifies
+	signer, err := signing.LoadSigner(privateKeyPath)
+	require.NoError(t, err)
+	signature, err := signer.Sign([]byte("payload"))
+	require.NoError(t, err)
+	publicKey, err := signing.ParsePublicKey(publicPem)
+	require.NoError(t, err)
+	assert.NoError(t, signing.Verify(publicKey, []byte("payload"), signature))
+
+	// Existing key files aren't overwritten
+	_, err = rotateKey(servicesManager, signing.Ed25519Key, "ci-2027", privateKeyPath)
+	assert.ErrorContains(t, err, "already exists")
+}
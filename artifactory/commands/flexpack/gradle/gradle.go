@@ -14,7 +14,7 @@ import (
 	"github.com/jfrog/build-info-go/entities"
 	"github.com/jfrog/build-info-go/flexpack"
 	gradle "github.com/jfrog/build-info-go/flexpack/gradle"
-	"github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clientconfig"
 	buildUtils "github.com/jfrog/jfrog-cli-core/v2/common/build"
 	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
 	"github.com/jfrog/jfrog-client-go/artifactory"
@@ -122,7 +122,7 @@ func setGradleBuildPropertiesOnArtifacts(workingDir, buildName, buildNumber, pro
 		return nil
 	}
 
-	servicesManager, err := utils.CreateServiceManager(serverDetails, -1, 0, false)
+	servicesManager, err := clientconfig.CreateServiceManager(serverDetails, -1, 0, false)
 	if err != nil {
 		return fmt.Errorf("failed to create services manager: %w", err)
 	}
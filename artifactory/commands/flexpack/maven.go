@@ -14,9 +14,9 @@ import (
 	"github.com/jfrog/build-info-go/entities"
 	"github.com/jfrog/build-info-go/flexpack"
 	"github.com/jfrog/gofrog/crypto"
-	"github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clientconfig"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/credentials"
 	buildUtils "github.com/jfrog/jfrog-cli-core/v2/common/build"
-	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
 	"github.com/jfrog/jfrog-client-go/artifactory/services"
 	specutils "github.com/jfrog/jfrog-client-go/artifactory/services/utils"
 	"github.com/jfrog/jfrog-client-go/utils/log"
@@ -145,7 +145,7 @@ func wasDeployCommand() bool {
 // Following the pattern from twine.go
 func setMavenBuildPropertiesOnArtifacts(workingDir, buildName, buildNumber string, buildArgs *buildUtils.BuildConfiguration) error {
 	// Get server details from configuration
-	serverDetails, err := config.GetDefaultServerConf()
+	serverDetails, err := credentials.GetDefaultServerConf()
 	if err != nil {
 		return fmt.Errorf("failed to get server details: %w", err)
 	}
@@ -156,7 +156,7 @@ func setMavenBuildPropertiesOnArtifacts(workingDir, buildName, buildNumber strin
 	}
 
 	// Create services manager
-	servicesManager, err := utils.CreateServiceManager(serverDetails, -1, 0, false)
+	servicesManager, err := clientconfig.CreateServiceManager(serverDetails, -1, 0, false)
 	if err != nil {
 		return fmt.Errorf("failed to create services manager: %w", err)
 	}
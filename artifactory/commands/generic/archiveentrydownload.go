@@ -0,0 +1,200 @@
+package generic
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/jfrog/gofrog/stringutils"
+	"github.com/jfrog/jfrog-cli-core/v2/common/spec"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	serviceutils "github.com/jfrog/jfrog-client-go/artifactory/services/utils"
+	clientutils "github.com/jfrog/jfrog-client-go/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// Artifactory serves a single entry of an archive by appending this separator and the entry path to the archive's path.
+const archiveEntrySeparator = "!/"
+
+// Downloads the entries matching the patterns from each archive matching the spec files, rather than the whole archives.
+// The entries are extracted by Artifactory, so only their content is transferred.
+// Entry patterns with wildcards are matched against the manifest embedded in archives uploaded by the CLI.
+func (dc *DownloadCommand) downloadArchiveEntries(servicesManager artifactory.ArtifactoryServicesManager) error {
+	var totalDownloaded, totalFailed int
+	var errorOccurred bool
+	for i := range dc.Spec().Files {
+		file := dc.Spec().Get(i)
+		archives, err := searchArchives(servicesManager, file)
+		if err != nil {
+			errorOccurred = true
+			log.Error(err)
+			continue
+		}
+		flat, err := file.IsFlat(false)
+		if err != nil {
+			return err
+		}
+		for _, archive := range archives {
+			entries, err := resolveArchiveEntries(servicesManager, archive, dc.entryPatterns)
+			if err != nil {
+				errorOccurred = true
+				log.Error(err)
+				continue
+			}
+			for _, entry := range entries {
+				if !filepath.IsLocal(filepath.FromSlash(entry)) {
+					totalFailed++
+					log.Error(fmt.Sprintf("the entry '%s' of '%s' can't be extracted outside of the target directory", entry, archive))
+					continue
+				}
+				localPath := getArchiveEntryLocalPath(file.Target, entry, flat)
+				log.Info(fmt.Sprintf("Downloading %s%s%s to %s", archive, archiveEntrySeparator, entry, localPath))
+				if dc.DryRun() {
+					totalDownloaded++
+					continue
+				}
+				if err = downloadArchiveEntry(servicesManager, archive, entry, localPath); err != nil {
+					totalFailed++
+					log.Error(err)
+					continue
+				}
+				totalDownloaded++
+			}
+		}
+	}
+	dc.result.SetSuccessCount(totalDownloaded)
+	dc.result.SetFailCount(totalFailed)
+	if errorOccurred || totalFailed > 0 {
+		return errors.New("download finished with errors, please review the logs")
+	}
+	return nil
+}
+
+// Returns the paths (repo/path/name) of the archives matching the spec file.
+func searchArchives(servicesManager artifactory.ArtifactoryServicesManager, file *spec.File) (archives []string, err error) {
+	reader, err := searchItems(&spec.SpecFiles{Files: []spec.File{*file}}, servicesManager)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		err = errors.Join(err, reader.Close())
+	}()
+	for item := new(serviceutils.ResultItem); reader.NextRecord(item) == nil; item = new(serviceutils.ResultItem) {
+		if item.Type != "folder" {
+			archives = append(archives, path.Join(item.Repo, item.Path, item.Name))
+		}
+	}
+	err = reader.GetError()
+	return
+}
+
+// Returns the entries of the archive matching the patterns.
+// Patterns without wildcards are taken as they are, so any archive supported by Artifactory can be used.
+func resolveArchiveEntries(servicesManager artifactory.ArtifactoryServicesManager, archive string, patterns []string) ([]string, error) {
+	var entries, wildcardPatterns []string
+	for _, pattern := range patterns {
+		pattern = strings.TrimPrefix(pattern, "/")
+		if strings.Contains(pattern, "*") {
+			wildcardPatterns = append(wildcardPatterns, pattern)
+		} else {
+			entries = append(entries, pattern)
+		}
+	}
+	if len(wildcardPatterns) == 0 {
+		return entries, nil
+	}
+	manifest, err := readArchiveManifest(servicesManager, archive)
+	if err != nil {
+		return nil, err
+	}
+	matched, err := matchArchiveEntries(manifest, wildcardPatterns)
+	if err != nil {
+		return nil, err
+	}
+	return append(entries, matched...), nil
+}
+
+func matchArchiveEntries(manifest *ArchiveManifest, patterns []string) (matched []string, err error) {
+	for _, entry := range manifest.Entries {
+		for _, pattern := range patterns {
+			var isMatch bool
+			if isMatch, err = stringutils.MatchWildcardPattern(pattern, entry.Path); err != nil {
+				return nil, errorutils.CheckError(err)
+			}
+			if isMatch {
+				matched = append(matched, entry.Path)
+				break
+			}
+		}
+	}
+	return
+}
+
+func readArchiveManifest(servicesManager artifactory.ArtifactoryServicesManager, archive string) (manifest *ArchiveManifest, err error) {
+	reader, err := readArchiveEntry(servicesManager, archive, ArchiveManifestEntryName)
+	if err != nil {
+		return nil, fmt.Errorf("wildcard entry patterns require an archive uploaded by the CLI with an entries manifest. Specify the full paths of the entries of '%s' instead: %w", archive, err)
+	}
+	defer func() {
+		err = errors.Join(err, errorutils.CheckError(reader.Close()))
+	}()
+	manifest = new(ArchiveManifest)
+	if err = json.NewDecoder(reader).Decode(manifest); err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	return manifest, nil
+}
+
+func readArchiveEntry(servicesManager artifactory.ArtifactoryServicesManager, archive, entry string) (io.ReadCloser, error) {
+	entryUrl, err := clientutils.BuildUrl(servicesManager.GetConfig().GetServiceDetails().GetUrl(), archive+archiveEntrySeparator+entry, make(map[string]string))
+	if err != nil {
+		return nil, err
+	}
+	httpClientDetails := servicesManager.GetConfig().GetServiceDetails().CreateHttpClientDetails()
+	reader, resp, err := servicesManager.Client().ReadRemoteFile(entryUrl, &httpClientDetails)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		err = errorutils.CheckResponseStatus(resp, http.StatusOK)
+		return nil, errors.Join(err, errorutils.CheckError(resp.Body.Close()))
+	}
+	return reader, nil
+}
+
+func downloadArchiveEntry(servicesManager artifactory.ArtifactoryServicesManager, archive, entry, localPath string) (err error) {
+	reader, err := readArchiveEntry(servicesManager, archive, entry)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = errors.Join(err, errorutils.CheckError(reader.Close()))
+	}()
+	if err = os.MkdirAll(filepath.Dir(localPath), 0755); err != nil {
+		return errorutils.CheckError(err)
+	}
+	localFile, err := os.Create(localPath)
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	defer func() {
+		err = errors.Join(err, errorutils.CheckError(localFile.Close()))
+	}()
+	_, err = io.Copy(localFile, reader)
+	return errorutils.CheckError(err)
+}
+
+// Entries are downloaded under the target directory, keeping their path inside the archive unless flat is set.
+func getArchiveEntryLocalPath(target, entry string, flat bool) string {
+	if flat {
+		entry = path.Base(entry)
+	}
+	return filepath.Join(filepath.FromSlash(target), filepath.FromSlash(entry))
+}
@@ -0,0 +1,31 @@
+package generic
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveArchiveEntriesWithoutWildcards(t *testing.T) {
+	// Entries without wildcards don't require the manifest of the archive.
+	entries, err := resolveArchiveEntries(nil, "generic-local/app.zip", []string{"/META-INF/MANIFEST.MF", "config/app.yaml"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"META-INF/MANIFEST.MF", "config/app.yaml"}, entries)
+}
+
+func TestMatchArchiveEntries(t *testing.T) {
+	manifest := &ArchiveManifest{Entries: []ArchiveManifestEntry{
+		{Path: "bin/app"}, {Path: "config/app.yaml"}, {Path: "config/db/db.yaml"}, {Path: "README.md"},
+	}}
+	matched, err := matchArchiveEntries(manifest, []string{"config/*.yaml", "*.md"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"config/app.yaml", "config/db/db.yaml", "README.md"}, matched)
+}
+
+func TestGetArchiveEntryLocalPath(t *testing.T) {
+	assert.Equal(t, filepath.Join("out", "config", "app.yaml"), getArchiveEntryLocalPath("out/", "config/app.yaml", false))
+	assert.Equal(t, filepath.Join("out", "app.yaml"), getArchiveEntryLocalPath("out/", "config/app.yaml", true))
+	assert.Equal(t, filepath.Join("config", "app.yaml"), getArchiveEntryLocalPath("", "config/app.yaml", false))
+}
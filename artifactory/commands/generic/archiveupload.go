@@ -0,0 +1,290 @@
+package generic
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	buildInfo "github.com/jfrog/build-info-go/entities"
+	"github.com/jfrog/jfrog-cli-core/v2/common/spec"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	serviceutils "github.com/jfrog/jfrog-client-go/artifactory/services/utils"
+	clientutils "github.com/jfrog/jfrog-client-go/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const (
+	ArchiveFormatZip   = "zip"
+	ArchiveFormatTarGz = "tar.gz"
+
+	// The manifest is the last entry of archives streamed by the CLI.
+	ArchiveManifestEntryName = ".jfrog/archive-manifest.json"
+
+	ArchiveFormatProp         = "archive.format"
+	ArchiveManifestProp       = "archive.manifest"
+	ArchiveManifestSha256Prop = "archive.manifest.sha256"
+	ArchiveEntriesCountProp   = "archive.entries.count"
+	// A multi-value property holding '<entry-path>:<sha256>' for each entry.
+	ArchiveEntriesProp = "archive.entries"
+	// Archives with more entries only get the manifest, since properties are sent in the request URL.
+	maxArchiveEntriesProps = 100
+)
+
+// ArchiveManifest lists the entries of an archive streamed on upload, allowing the extraction of selected entries later on.
+type ArchiveManifest struct {
+	Format  string                 `json:"format"`
+	Entries []ArchiveManifestEntry `json:"entries"`
+}
+
+type ArchiveManifestEntry struct {
+	Path   string `json:"path"`
+	Size   int64  `json:"size"`
+	Sha256 string `json:"sha256"`
+}
+
+type archiveEntry struct {
+	localPath string
+	name      string
+}
+
+// IsStreamedArchive returns true if the files of the spec file are streamed into an archive by the CLI, rather than by the upload service.
+// The upload service only supports zip archives, and is still used for zip archives with placeholders in the target path inside the archive, or with ANT patterns.
+func IsStreamedArchive(file *spec.File) bool {
+	switch file.Archive {
+	case ArchiveFormatTarGz:
+		return true
+	case ArchiveFormatZip:
+		return file.TargetPathInArchive == "" && file.Ant != "true"
+	default:
+		return false
+	}
+}
+
+// Streams the local files matching the spec file into a single archive deployed to the target path, without staging it on disk.
+// The manifest of the entries is embedded in the archive and recorded as properties of the artifact.
+func uploadStreamedArchive(servicesManager artifactory.ArtifactoryServicesManager, file *spec.File, buildProps string, dryRun bool) (artifact *buildInfo.Artifact, err error) {
+	if file.Target == "" || strings.HasSuffix(file.Target, "/") {
+		return nil, errorutils.CheckErrorf("an archive upload target must be a file, not a directory: '%s'", file.Target)
+	}
+	entries, err := getArchiveEntries(file)
+	if err != nil {
+		return nil, err
+	}
+	log.Info(fmt.Sprintf("Archiving %d files to %s", len(entries), file.Target))
+	if dryRun {
+		return nil, nil
+	}
+	targetUrl, err := buildArchiveTargetUrl(servicesManager.GetConfig().GetServiceDetails().GetUrl(), file.Target, file.TargetProps, buildProps)
+	if err != nil {
+		return nil, err
+	}
+
+	pipeReader, pipeWriter := io.Pipe()
+	manifestChan := make(chan *ArchiveManifest, 1)
+	go func() {
+		manifest, writeErr := writeArchive(pipeWriter, file.Archive, entries)
+		manifestChan <- manifest
+		pipeWriter.CloseWithError(writeErr)
+	}()
+	// The archive is hashed while it is streamed, to record its checksum in the build-info.
+	archiveHash := sha256.New()
+	httpClientDetails := servicesManager.GetConfig().GetServiceDetails().CreateHttpClientDetails()
+	_, _, err = servicesManager.Client().UploadFileFromReader(io.TeeReader(pipeReader, archiveHash), targetUrl, &httpClientDetails, -1)
+	// Drain the pipe, so that the archive writer isn't blocked if the upload failed.
+	_, _ = io.Copy(io.Discard, pipeReader)
+	manifest := <-manifestChan
+	if err != nil {
+		return nil, err
+	}
+	if manifest == nil {
+		return nil, errorutils.CheckErrorf("failed to create the archive '%s'", file.Target)
+	}
+	if err = setArchiveManifestProps(servicesManager, file.Target, manifest); err != nil {
+		return nil, err
+	}
+	name := path.Base(file.Target)
+	repo, artifactPath, _ := strings.Cut(file.Target, "/")
+	return &buildInfo.Artifact{
+		Name:                   name,
+		Type:                   file.Archive,
+		Path:                   artifactPath,
+		OriginalDeploymentRepo: repo,
+		Checksum:               buildInfo.Checksum{Sha256: hex.EncodeToString(archiveHash.Sum(nil))},
+	}, nil
+}
+
+func getArchiveEntries(file *spec.File) ([]archiveEntry, error) {
+	paths, err := matchLocalFiles(file)
+	if err != nil {
+		return nil, err
+	}
+	flat, err := file.IsFlat(true)
+	if err != nil {
+		return nil, err
+	}
+	entries := make([]archiveEntry, 0, len(paths))
+	for _, localPath := range paths {
+		name := clientutils.TrimPath(localPath)
+		if flat {
+			name = filepath.Base(localPath)
+		}
+		if name == ArchiveManifestEntryName {
+			return nil, errorutils.CheckErrorf("'%s' is reserved for the archive manifest", localPath)
+		}
+		entries = append(entries, archiveEntry{localPath: localPath, name: name})
+	}
+	return entries, nil
+}
+
+func buildArchiveTargetUrl(artifactoryUrl, target, targetProps, buildProps string) (string, error) {
+	targetUrl, err := clientutils.BuildUrl(artifactoryUrl, target, make(map[string]string))
+	if err != nil {
+		return "", err
+	}
+	props, err := serviceutils.ParseProperties(clientutils.AddProps(targetProps, buildProps))
+	if err != nil {
+		return "", err
+	}
+	if encodedProps := props.ToEncodedString(false); encodedProps != "" {
+		targetUrl += ";" + encodedProps
+	}
+	return targetUrl, nil
+}
+
+// Writes the entries and then the manifest to the writer, in the requested archive format.
+func writeArchive(writer io.Writer, format string, entries []archiveEntry) (manifest *ArchiveManifest, err error) {
+	var archiveWriter entryWriter
+	switch format {
+	case ArchiveFormatZip:
+		archiveWriter = &zipEntryWriter{zip.NewWriter(writer)}
+	case ArchiveFormatTarGz:
+		gzipWriter := gzip.NewWriter(writer)
+		archiveWriter = &tarEntryWriter{tar.NewWriter(gzipWriter), gzipWriter}
+	default:
+		return nil, errorutils.CheckErrorf("unsupported archive format '%s'. Supported formats are %s and %s", format, ArchiveFormatZip, ArchiveFormatTarGz)
+	}
+	defer func() {
+		err = errors.Join(err, archiveWriter.Close())
+	}()
+
+	manifest = &ArchiveManifest{Format: format}
+	for _, entry := range entries {
+		var manifestEntry *ArchiveManifestEntry
+		if manifestEntry, err = writeArchiveEntry(archiveWriter, entry); err != nil {
+			return nil, err
+		}
+		manifest.Entries = append(manifest.Entries, *manifestEntry)
+	}
+	content, err := json.Marshal(manifest)
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	entryContent, err := archiveWriter.Create(ArchiveManifestEntryName, int64(len(content)), 0644)
+	if err != nil {
+		return nil, err
+	}
+	_, err = entryContent.Write(content)
+	return manifest, errorutils.CheckError(err)
+}
+
+func writeArchiveEntry(archiveWriter entryWriter, entry archiveEntry) (manifestEntry *ArchiveManifestEntry, err error) {
+	file, err := os.Open(entry.localPath)
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	defer func() {
+		err = errors.Join(err, errorutils.CheckError(file.Close()))
+	}()
+	info, err := file.Stat()
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	entryContent, err := archiveWriter.Create(entry.name, info.Size(), info.Mode().Perm())
+	if err != nil {
+		return nil, err
+	}
+	hash := sha256.New()
+	size, err := io.Copy(io.MultiWriter(entryContent, hash), file)
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	return &ArchiveManifestEntry{Path: entry.name, Size: size, Sha256: hex.EncodeToString(hash.Sum(nil))}, nil
+}
+
+func setArchiveManifestProps(servicesManager artifactory.ArtifactoryServicesManager, target string, manifest *ArchiveManifest) error {
+	repo, artifactPath, _ := strings.Cut(target, "/")
+	dir, name := path.Split(artifactPath)
+	dir = strings.TrimSuffix(dir, "/")
+	if dir == "" {
+		dir = "."
+	}
+	return setPropsOnItems(servicesManager, []serviceutils.ResultItem{{Repo: repo, Path: dir, Name: name}}, GetArchiveManifestProps(manifest))
+}
+
+// GetArchiveManifestProps returns the properties describing the entries of an archive streamed on upload.
+func GetArchiveManifestProps(manifest *ArchiveManifest) string {
+	content, _ := json.Marshal(manifest)
+	manifestSha256 := sha256.Sum256(content)
+	props := []string{
+		ArchiveFormatProp + "=" + manifest.Format,
+		ArchiveManifestProp + "=" + ArchiveManifestEntryName,
+		ArchiveManifestSha256Prop + "=" + hex.EncodeToString(manifestSha256[:]),
+		ArchiveEntriesCountProp + "=" + strconv.Itoa(len(manifest.Entries)),
+	}
+	if len(manifest.Entries) > 0 && len(manifest.Entries) <= maxArchiveEntriesProps {
+		var values []string
+		escaper := strings.NewReplacer(",", `\,`, ";", `\;`)
+		for _, entry := range manifest.Entries {
+			values = append(values, escaper.Replace(entry.Path)+":"+entry.Sha256)
+		}
+		props = append(props, ArchiveEntriesProp+"="+strings.Join(values, ","))
+	}
+	return strings.Join(props, ";")
+}
+
+// entryWriter abstracts the zip and tar writers.
+type entryWriter interface {
+	Create(name string, size int64, mode os.FileMode) (io.Writer, error)
+	Close() error
+}
+
+type zipEntryWriter struct {
+	writer *zip.Writer
+}
+
+func (zew *zipEntryWriter) Create(name string, _ int64, mode os.FileMode) (io.Writer, error) {
+	header := &zip.FileHeader{Name: name, Method: zip.Deflate}
+	header.SetMode(mode)
+	writer, err := zew.writer.CreateHeader(header)
+	return writer, errorutils.CheckError(err)
+}
+
+func (zew *zipEntryWriter) Close() error {
+	return errorutils.CheckError(zew.writer.Close())
+}
+
+type tarEntryWriter struct {
+	writer     *tar.Writer
+	gzipWriter *gzip.Writer
+}
+
+func (tew *tarEntryWriter) Create(name string, size int64, mode os.FileMode) (io.Writer, error) {
+	err := tew.writer.WriteHeader(&tar.Header{Name: name, Size: size, Mode: int64(mode), Typeflag: tar.TypeReg, Format: tar.FormatPAX})
+	return tew.writer, errorutils.CheckError(err)
+}
+
+func (tew *tarEntryWriter) Close() error {
+	return errorutils.CheckError(errors.Join(tew.writer.Close(), tew.gzipWriter.Close()))
+}
@@ -0,0 +1,115 @@
+package generic
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-core/v2/common/spec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createArchiveEntries(t *testing.T) []archiveEntry {
+	root := t.TempDir()
+	var entries []archiveEntry
+	for name, content := range map[string]string{"a.txt": "a", "dir/b.txt": "bb"} {
+		localPath := filepath.Join(root, filepath.FromSlash(name))
+		require.NoError(t, os.MkdirAll(filepath.Dir(localPath), 0755))
+		require.NoError(t, os.WriteFile(localPath, []byte(content), 0644))
+		entries = append(entries, archiveEntry{localPath: localPath, name: name})
+	}
+	return entries
+}
+
+func TestWriteArchiveZip(t *testing.T) {
+	entries := createArchiveEntries(t)
+	var buffer bytes.Buffer
+	manifest, err := writeArchive(&buffer, ArchiveFormatZip, entries)
+	require.NoError(t, err)
+
+	zipReader, err := zip.NewReader(bytes.NewReader(buffer.Bytes()), int64(buffer.Len()))
+	require.NoError(t, err)
+	contents := make(map[string][]byte)
+	var names []string
+	for _, file := range zipReader.File {
+		reader, err := file.Open()
+		require.NoError(t, err)
+		contents[file.Name], err = io.ReadAll(reader)
+		require.NoError(t, err)
+		require.NoError(t, reader.Close())
+		names = append(names, file.Name)
+	}
+	assert.Equal(t, ArchiveManifestEntryName, names[len(names)-1])
+	assertArchiveManifest(t, manifest, contents)
+}
+
+func TestWriteArchiveTarGz(t *testing.T) {
+	entries := createArchiveEntries(t)
+	var buffer bytes.Buffer
+	manifest, err := writeArchive(&buffer, ArchiveFormatTarGz, entries)
+	require.NoError(t, err)
+
+	gzipReader, err := gzip.NewReader(&buffer)
+	require.NoError(t, err)
+	tarReader := tar.NewReader(gzipReader)
+	contents := make(map[string][]byte)
+	var names []string
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		contents[header.Name], err = io.ReadAll(tarReader)
+		require.NoError(t, err)
+		names = append(names, header.Name)
+	}
+	assert.Equal(t, ArchiveManifestEntryName, names[len(names)-1])
+	assertArchiveManifest(t, manifest, contents)
+}
+
+func assertArchiveManifest(t *testing.T, manifest *ArchiveManifest, contents map[string][]byte) {
+	var embedded ArchiveManifest
+	require.NoError(t, json.Unmarshal(contents[ArchiveManifestEntryName], &embedded))
+	assert.Equal(t, *manifest, embedded)
+	assert.Len(t, manifest.Entries, 2)
+	for _, entry := range manifest.Entries {
+		checksum := sha256.Sum256(contents[entry.Path])
+		assert.Equal(t, hex.EncodeToString(checksum[:]), entry.Sha256)
+		assert.Equal(t, int64(len(contents[entry.Path])), entry.Size)
+	}
+}
+
+func TestWriteArchiveUnsupportedFormat(t *testing.T) {
+	_, err := writeArchive(io.Discard, "rar", nil)
+	assert.ErrorContains(t, err, "unsupported archive format")
+}
+
+func TestGetArchiveManifestProps(t *testing.T) {
+	manifest := &ArchiveManifest{Format: ArchiveFormatTarGz, Entries: []ArchiveManifestEntry{
+		{Path: "a.txt", Sha256: "s1"},
+		{Path: "b,c;d.txt", Sha256: "s2"},
+	}}
+	content, err := json.Marshal(manifest)
+	require.NoError(t, err)
+	manifestSha256 := sha256.Sum256(content)
+	assert.Equal(t, "archive.format=tar.gz;archive.manifest=.jfrog/archive-manifest.json;archive.manifest.sha256="+hex.EncodeToString(manifestSha256[:])+
+		`;archive.entries.count=2;archive.entries=a.txt:s1,b\,c\;d.txt:s2`, GetArchiveManifestProps(manifest))
+}
+
+func TestIsStreamedArchive(t *testing.T) {
+	assert.True(t, IsStreamedArchive(&spec.File{Archive: ArchiveFormatTarGz}))
+	assert.True(t, IsStreamedArchive(&spec.File{Archive: ArchiveFormatZip}))
+	assert.False(t, IsStreamedArchive(&spec.File{Archive: ArchiveFormatZip, TargetPathInArchive: "{1}"}))
+	assert.False(t, IsStreamedArchive(&spec.File{Archive: ArchiveFormatZip, Ant: "true"}))
+	assert.False(t, IsStreamedArchive(&spec.File{}))
+}
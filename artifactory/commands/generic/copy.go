@@ -3,7 +3,7 @@ package generic
 import (
 	"errors"
 
-	"github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clientconfig"
 	"github.com/jfrog/jfrog-cli-core/v2/common/spec"
 	"github.com/jfrog/jfrog-client-go/artifactory/services"
 	"github.com/jfrog/jfrog-client-go/utils/log"
@@ -12,6 +12,7 @@ import (
 type CopyCommand struct {
 	GenericCommand
 	threads int
+	options CopyMoveOptions
 }
 
 func NewCopyCommand() *CopyCommand {
@@ -27,6 +28,13 @@ func (cc *CopyCommand) SetThreads(threads int) *CopyCommand {
 	return cc
 }
 
+// SetOptions sets the conflict strategy and the preservation of properties and statistics. When any is set, the files are
+// planned one by one, and the completed transfers are rolled back if any fails.
+func (cc *CopyCommand) SetOptions(options CopyMoveOptions) *CopyCommand {
+	cc.options = options
+	return cc
+}
+
 func (cc *CopyCommand) CommandName() string {
 	return "rt_copy"
 }
@@ -34,7 +42,7 @@ func (cc *CopyCommand) CommandName() string {
 // Copies the artifacts using the specified move pattern.
 func (cc *CopyCommand) Run() error {
 	// Create Service Manager:
-	servicesManager, err := utils.CreateServiceManagerWithThreads(cc.serverDetails, cc.dryRun, cc.threads, cc.retries, cc.retryWaitTimeMilliSecs)
+	servicesManager, err := clientconfig.CreateServiceManagerWithThreads(cc.serverDetails, cc.dryRun, cc.threads, cc.retries, cc.retryWaitTimeMilliSecs)
 	if err != nil {
 		return err
 	}
@@ -52,8 +60,20 @@ func (cc *CopyCommand) Run() error {
 		copyParamsArray = append(copyParamsArray, copyParams)
 	}
 
+	// The planned copies are verified once their conflicts are resolved.
+	if !cc.options.isPlanned() {
+		if err = verifyCopyMoveNotProtected(servicesManager, copyAction, copyParamsArray...); err != nil {
+			return err
+		}
+	}
+
 	// Perform copy.
-	totalCopied, totalFailed, err := servicesManager.Copy(copyParamsArray...)
+	var totalCopied, totalFailed int
+	if cc.options.isPlanned() {
+		totalCopied, totalFailed, err = runPlannedCopyMove(servicesManager, copyAction, cc.options, cc.dryRun, cc.threads, copyParamsArray...)
+	} else {
+		totalCopied, totalFailed, err = servicesManager.Copy(copyParamsArray...)
+	}
 	if err != nil {
 		errorOccurred = true
 		log.Error(err)
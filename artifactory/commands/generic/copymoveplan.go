@@ -0,0 +1,433 @@
+package generic
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/jfrog/gofrog/parallel"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clierrors"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/protection"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/jfrog/jfrog-client-go/artifactory/services"
+	serviceutils "github.com/jfrog/jfrog-client-go/artifactory/services/utils"
+	clientutils "github.com/jfrog/jfrog-client-go/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/io/fileutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const (
+	// The existing target is replaced, as Artifactory does by default.
+	OnConflictOverwrite = "overwrite"
+	// The source isn't copied or moved.
+	OnConflictSkip = "skip"
+	// Nothing is copied or moved if any target exists.
+	OnConflictFail = "fail"
+	// The source is copied or moved next to the existing target, under a free name such as 'name-1.ext'.
+	OnConflictRename = "rename"
+
+	// Artifactory doesn't copy download statistics, so they are kept as properties of the target when preserved.
+	StatsDownloadCountProp  = "stats.downloadCount"
+	StatsLastDownloadedProp = "stats.lastDownloaded"
+
+	copyAction = "copy"
+	moveAction = "move"
+)
+
+// CopyMoveOptions control how copy and move handle existing targets, and what is kept of the source and target items.
+type CopyMoveOptions struct {
+	// One of the OnConflict strategies. If not set, existing targets are overwritten.
+	OnConflict string
+	// Keeps the properties of an overwritten target which the source doesn't have.
+	MergeProps bool
+	// Sets the download statistics of the source as properties of the target.
+	PreserveStats bool
+}
+
+// Returns true if the options require planning the items one by one, rather than delegating the whole operation to Artifactory.
+func (options CopyMoveOptions) isPlanned() bool {
+	return options.OnConflict != "" || options.MergeProps || options.PreserveStats
+}
+
+func (options CopyMoveOptions) validate() error {
+	switch options.OnConflict {
+	case "", OnConflictOverwrite, OnConflictSkip, OnConflictFail, OnConflictRename:
+		return nil
+	}
+	return errorutils.CheckErrorf("unsupported conflict strategy '%s'. Supported strategies are %s, %s, %s and %s",
+		options.OnConflict, OnConflictSkip, OnConflictOverwrite, OnConflictFail, OnConflictRename)
+}
+
+// CopyMovePlanItem is a single file to copy or move.
+type CopyMovePlanItem struct {
+	Source string `json:"source"`
+	Target string `json:"target"`
+	// The strategy applied to the existing target, if there's one.
+	Conflict string `json:"conflict,omitempty"`
+	// The properties of the overwritten target, when they are merged.
+	targetProps map[string][]string
+}
+
+// Copies or moves the files matching the params according to a plan, which resolves the conflicts with existing targets
+// before anything is transferred. If any transfer fails, the completed ones are rolled back: moved files are moved back,
+// and copies which didn't overwrite an existing target are deleted.
+func runPlannedCopyMove(servicesManager artifactory.ArtifactoryServicesManager, action string, options CopyMoveOptions, dryRun bool, threads int, paramsArray ...services.MoveCopyParams) (succeeded, failed int, err error) {
+	if err = options.validate(); err != nil {
+		return
+	}
+	plan, err := planCopyMove(servicesManager, options, paramsArray...)
+	if err != nil {
+		return
+	}
+	if err = verifyPlanNotProtected(servicesManager, action, plan, action == moveAction); err != nil {
+		return
+	}
+	if dryRun {
+		for _, item := range plan {
+			log.Info(fmt.Sprintf("[Dry run] %s %s to %s%s", capitalizedAction(action), item.Source, item.Target, conflictSuffix(item)))
+		}
+		return len(plan), 0, nil
+	}
+	return executeCopyMovePlan(servicesManager, action, options, threads, plan)
+}
+
+// Copies or moves the items of the plan in parallel. If any transfer fails, the completed ones are rolled back.
+func executeCopyMovePlan(servicesManager artifactory.ArtifactoryServicesManager, action string, options CopyMoveOptions, threads int, plan []CopyMovePlanItem) (succeeded, failed int, err error) {
+	var mutex sync.Mutex
+	var completed []CopyMovePlanItem
+	if threads <= 0 {
+		threads = 1
+	}
+	runner := parallel.NewBounedRunner(threads, false)
+	go func() {
+		defer runner.Done()
+		for _, item := range plan {
+			_, _ = runner.AddTask(func(int) error {
+				if transferErr := transferPlanItem(servicesManager, action, options, item); transferErr != nil {
+					log.Error(transferErr)
+					mutex.Lock()
+					failed++
+					mutex.Unlock()
+					return nil
+				}
+				mutex.Lock()
+				completed = append(completed, item)
+				mutex.Unlock()
+				return nil
+			})
+		}
+	}()
+	runner.Run()
+	if failed == 0 {
+		return len(completed), 0, nil
+	}
+	rollbackCopyMove(servicesManager, action, completed)
+	return 0, len(plan), errorutils.CheckErrorf("%s of %d files failed, and the %d completed ones were rolled back", action, failed, len(completed))
+}
+
+// Lists the files to copy or move with their targets, and applies the conflict strategy to the targets which exist.
+func planCopyMove(servicesManager artifactory.ArtifactoryServicesManager, options CopyMoveOptions, paramsArray ...services.MoveCopyParams) ([]CopyMovePlanItem, error) {
+	items, err := listCopyMoveItems(servicesManager, paramsArray...)
+	if err != nil {
+		return nil, err
+	}
+	var plan []CopyMovePlanItem
+	plannedTargets := make(map[string]bool)
+	exists := func(target string) (bool, error) {
+		if plannedTargets[target] {
+			return true, nil
+		}
+		return itemExists(servicesManager, target)
+	}
+	for _, planItem := range items {
+		target := planItem.Target
+		targetExists, err := exists(target)
+		if err != nil {
+			return nil, err
+		}
+		if targetExists {
+			switch options.OnConflict {
+			case OnConflictFail:
+				return nil, errorutils.CheckErrorf("'%s' already exists, nothing was copied or moved", target)
+			case OnConflictSkip:
+				log.Info(fmt.Sprintf("Skipping %s since %s already exists", planItem.Source, target))
+				continue
+			case OnConflictRename:
+				if planItem.Target, err = findFreeTarget(target, exists); err != nil {
+					return nil, err
+				}
+				planItem.Conflict = OnConflictRename
+			default:
+				planItem.Conflict = OnConflictOverwrite
+				if options.MergeProps {
+					itemProps, err := servicesManager.GetItemProps(target)
+					if err != nil {
+						return nil, err
+					}
+					if itemProps != nil {
+						planItem.targetProps = itemProps.Properties
+					}
+				}
+			}
+		}
+		plannedTargets[planItem.Target] = true
+		plan = append(plan, planItem)
+	}
+	return plan, nil
+}
+
+// Lists the files to copy or move with their targets, regardless of whether the targets exist.
+func listCopyMoveItems(servicesManager artifactory.ArtifactoryServicesManager, paramsArray ...services.MoveCopyParams) ([]CopyMovePlanItem, error) {
+	var planItems []CopyMovePlanItem
+	for _, params := range paramsArray {
+		items, err := searchFilesToCopyMove(servicesManager, params)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range items {
+			target, err := copyMoveTargetPath(params.Target, params.Pattern, item.Path, item.GetItemRelativePath(), params.Flat)
+			if err != nil {
+				return nil, err
+			}
+			if strings.HasSuffix(target, "/") {
+				target += item.Name
+			}
+			planItems = append(planItems, CopyMovePlanItem{Source: item.GetItemRelativePath(), Target: target})
+		}
+	}
+	return planItems, nil
+}
+
+// Denies the copy or move if any of the files it would replace or add, or for a move any of the files it would remove,
+// is or is contained in an item which is immutable or under legal hold.
+func verifyCopyMoveNotProtected(servicesManager artifactory.ArtifactoryServicesManager, action string, paramsArray ...services.MoveCopyParams) error {
+	items, err := listCopyMoveItems(servicesManager, paramsArray...)
+	if err != nil {
+		return err
+	}
+	return verifyPlanNotProtected(servicesManager, action, items, action == moveAction)
+}
+
+// Denies the operation if any of the targets of the plan, or of its sources if checkSources is set, is or is contained
+// in an item which is immutable or under legal hold.
+func verifyPlanNotProtected(servicesManager artifactory.ArtifactoryServicesManager, operation string, plan []CopyMovePlanItem, checkSources bool) error {
+	paths := make([]string, 0, 2*len(plan))
+	for _, item := range plan {
+		if checkSources {
+			paths = append(paths, item.Source)
+		}
+		paths = append(paths, item.Target)
+	}
+	return protection.VerifyNotProtected(servicesManager, operation, paths)
+}
+
+func searchFilesToCopyMove(servicesManager artifactory.ArtifactoryServicesManager, params services.MoveCopyParams) (items []serviceutils.ResultItem, err error) {
+	reader, err := servicesManager.SearchFiles(services.SearchParams{CommonParams: params.CommonParams})
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		err = errors.Join(err, reader.Close())
+	}()
+	for item := new(serviceutils.ResultItem); reader.NextRecord(item) == nil; item = new(serviceutils.ResultItem) {
+		if item.Type != "folder" {
+			items = append(items, *item)
+		}
+	}
+	err = reader.GetError()
+	return
+}
+
+// Returns the target of a file, as Artifactory's copy and move do: placeholders in the target are replaced, and unless
+// flat or placeholders are used, the path of the source is kept under the target.
+func copyMoveTargetPath(specTarget, specPattern, sourceItemPath, sourceItemRelativePath string, isFlat bool) (string, error) {
+	target, placeholdersUsed, err := clientutils.BuildTargetPath(specPattern, sourceItemRelativePath, specTarget, true)
+	if err != nil {
+		return "", err
+	}
+	if isFlat || placeholdersUsed {
+		return target, nil
+	}
+	if strings.Contains(specTarget, "/") {
+		file, dir := fileutils.GetFileAndDirFromPath(specTarget)
+		return clientutils.TrimPath(dir + "/" + sourceItemPath + "/" + file), nil
+	}
+	return clientutils.TrimPath(specTarget + "/" + sourceItemPath + "/"), nil
+}
+
+// Returns the first name of the form 'name-N.ext' next to the target which doesn't exist.
+func findFreeTarget(target string, exists func(string) (bool, error)) (string, error) {
+	dir, name := path.Split(target)
+	extension := path.Ext(name)
+	base := strings.TrimSuffix(name, extension)
+	for i := 1; ; i++ {
+		candidate := fmt.Sprintf("%s%s-%d%s", dir, base, i, extension)
+		candidateExists, err := exists(candidate)
+		if err != nil || !candidateExists {
+			return candidate, err
+		}
+	}
+}
+
+func itemExists(servicesManager artifactory.ArtifactoryServicesManager, itemPath string) (bool, error) {
+	resp, body, err := getItemStorageInfo(servicesManager, itemPath, "")
+	if err != nil {
+		return false, err
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		return false, nil
+	}
+	return true, clierrors.CheckResponseStatusWithBody(resp, body, http.StatusOK)
+}
+
+func transferPlanItem(servicesManager artifactory.ArtifactoryServicesManager, action string, options CopyMoveOptions, item CopyMovePlanItem) error {
+	props := serviceutils.NewProperties()
+	if options.PreserveStats {
+		if err := addDownloadStatsProps(servicesManager, item.Source, props); err != nil {
+			return err
+		}
+	}
+	log.Info(fmt.Sprintf("%s %s to %s%s", capitalizedAction(action), item.Source, item.Target, conflictSuffix(item)))
+	if err := copyOrMoveItem(servicesManager, action, item.Source, item.Target); err != nil {
+		return err
+	}
+	if len(item.targetProps) > 0 {
+		// The copied or moved item holds the properties of the source, to which the missing properties of the overwritten target are added.
+		sourceProps, err := servicesManager.GetItemProps(item.Target)
+		if err != nil {
+			return err
+		}
+		for key, values := range item.targetProps {
+			if sourceProps != nil && len(sourceProps.Properties[key]) > 0 {
+				continue
+			}
+			for _, value := range values {
+				props.AddProperty(key, value)
+			}
+		}
+	}
+	if props.KeysLen() == 0 {
+		return nil
+	}
+	return changeItemProps(servicesManager, item.Target, props.ToEncodedString(true), false)
+}
+
+func addDownloadStatsProps(servicesManager artifactory.ArtifactoryServicesManager, itemPath string, props *serviceutils.Properties) error {
+	resp, body, err := getItemStorageInfo(servicesManager, itemPath, "stats")
+	if err != nil {
+		return err
+	}
+	if err = clierrors.CheckResponseStatusWithBody(resp, body, http.StatusOK); err != nil {
+		return err
+	}
+	var stats struct {
+		DownloadCount  int64 `json:"downloadCount"`
+		LastDownloaded int64 `json:"lastDownloaded"`
+	}
+	if err = json.Unmarshal(body, &stats); err != nil {
+		return errorutils.CheckError(err)
+	}
+	props.AddProperty(StatsDownloadCountProp, strconv.FormatInt(stats.DownloadCount, 10))
+	if stats.LastDownloaded > 0 {
+		props.AddProperty(StatsLastDownloadedProp, strconv.FormatInt(stats.LastDownloaded, 10))
+	}
+	return nil
+}
+
+func copyOrMoveItem(servicesManager artifactory.ArtifactoryServicesManager, action, source, target string) error {
+	requestUrl, err := clientutils.BuildUrl(servicesManager.GetConfig().GetServiceDetails().GetUrl(), path.Join("api", action, source), map[string]string{"to": target})
+	if err != nil {
+		return err
+	}
+	httpClientDetails := servicesManager.GetConfig().GetServiceDetails().CreateHttpClientDetails()
+	resp, body, err := servicesManager.Client().SendPost(requestUrl, nil, &httpClientDetails)
+	if err != nil {
+		return err
+	}
+	return clierrors.CheckResponseStatusWithBody(resp, body, http.StatusOK)
+}
+
+// Reverts the completed transfers, in reverse order. Overwritten targets can't be restored, and targets which became
+// immutable or were put under legal hold, such as copies of protected sources, can't be moved or deleted, so they are
+// only reported.
+func rollbackCopyMove(servicesManager artifactory.ArtifactoryServicesManager, action string, completed []CopyMovePlanItem) {
+	for i := len(completed) - 1; i >= 0; i-- {
+		item := completed[i]
+		if action == moveAction || item.Conflict != OnConflictOverwrite {
+			if err := protection.VerifyNotProtected(servicesManager, "rollback", []string{item.Target}); err != nil {
+				log.Error("Can't roll back the", action, "of", item.Source+":", err.Error())
+				continue
+			}
+		}
+		var err error
+		switch {
+		case action == moveAction:
+			log.Info("Rolling back: moving", item.Target, "back to", item.Source)
+			err = copyOrMoveItem(servicesManager, moveAction, item.Target, item.Source)
+		case item.Conflict == OnConflictOverwrite:
+			log.Warn("Can't roll back the copy of", item.Source, "since it overwrote", item.Target)
+		default:
+			log.Info("Rolling back: deleting", item.Target)
+			err = deleteItem(servicesManager, item.Target)
+		}
+		if err != nil {
+			log.Error("Failed to roll back", item.Target+":", err.Error())
+		}
+	}
+}
+
+// Returns the response of the storage API for an item, with the query, such as 'stats', if set.
+func getItemStorageInfo(servicesManager artifactory.ArtifactoryServicesManager, itemPath, query string) (*http.Response, []byte, error) {
+	requestUrl, err := clientutils.BuildUrl(servicesManager.GetConfig().GetServiceDetails().GetUrl(), path.Join("api", "storage", itemPath), make(map[string]string))
+	if err != nil {
+		return nil, nil, err
+	}
+	if query != "" {
+		requestUrl += "?" + query
+	}
+	httpClientDetails := servicesManager.GetConfig().GetServiceDetails().CreateHttpClientDetails()
+	resp, body, _, err := servicesManager.Client().SendGet(requestUrl, true, &httpClientDetails)
+	return resp, body, err
+}
+
+func deleteItem(servicesManager artifactory.ArtifactoryServicesManager, itemPath string) error {
+	return sendDeleteItem(servicesManager, itemPath, http.StatusNoContent, http.StatusOK)
+}
+
+// Deletes the item like deleteItem, and doesn't fail if it doesn't exist.
+func deleteItemIfExists(servicesManager artifactory.ArtifactoryServicesManager, itemPath string) error {
+	return sendDeleteItem(servicesManager, itemPath, http.StatusNoContent, http.StatusOK, http.StatusNotFound)
+}
+
+func sendDeleteItem(servicesManager artifactory.ArtifactoryServicesManager, itemPath string, expectedStatusCodes ...int) error {
+	requestUrl, err := clientutils.BuildUrl(servicesManager.GetConfig().GetServiceDetails().GetUrl(), itemPath, make(map[string]string))
+	if err != nil {
+		return err
+	}
+	httpClientDetails := servicesManager.GetConfig().GetServiceDetails().CreateHttpClientDetails()
+	resp, body, err := servicesManager.Client().SendDelete(requestUrl, nil, &httpClientDetails)
+	if err != nil {
+		return err
+	}
+	return clierrors.CheckResponseStatusWithBody(resp, body, expectedStatusCodes...)
+}
+
+func capitalizedAction(action string) string {
+	if action == moveAction {
+		return "Moving"
+	}
+	return "Copying"
+}
+
+func conflictSuffix(item CopyMovePlanItem) string {
+	if item.Conflict == "" {
+		return ""
+	}
+	return fmt.Sprintf(" (%s)", item.Conflict)
+}
@@ -0,0 +1,143 @@
+package generic
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/protection"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/artifactory/services"
+	serviceutils "github.com/jfrog/jfrog-client-go/artifactory/services/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCopyMoveTargetPath(t *testing.T) {
+	tests := []struct {
+		specTarget     string
+		specPattern    string
+		sourcePath     string
+		sourceRelative string
+		flat           bool
+		expected       string
+	}{
+		{"target-repo/dir/", "libs-local/a/*", "a", "libs-local/a/b.jar", false, "target-repo/dir/a/"},
+		{"target-repo/dir/", "libs-local/a/*", "a", "libs-local/a/b.jar", true, "target-repo/dir/"},
+		{"target-repo/dir/c.jar", "libs-local/a/*", "a", "libs-local/a/b.jar", false, "target-repo/dir/a/c.jar"},
+		{"target-repo", "libs-local/a/*", "a", "libs-local/a/b.jar", false, "target-repo/a/"},
+		{"target-repo/{1}", "libs-local/a/(*)", "a", "libs-local/a/b.jar", false, "target-repo/b.jar"},
+	}
+	for _, test := range tests {
+		t.Run(test.specTarget, func(t *testing.T) {
+			target, err := copyMoveTargetPath(test.specTarget, test.specPattern, test.sourcePath, test.sourceRelative, test.flat)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, target)
+		})
+	}
+}
+
+func TestFindFreeTarget(t *testing.T) {
+	existing := map[string]bool{"repo/a/b.jar": true, "repo/a/b-1.jar": true}
+	exists := func(target string) (bool, error) {
+		return existing[target], nil
+	}
+	target, err := findFreeTarget("repo/a/b.jar", exists)
+	require.NoError(t, err)
+	assert.Equal(t, "repo/a/b-2.jar", target)
+
+	target, err = findFreeTarget("repo/a/README", exists)
+	require.NoError(t, err)
+	assert.Equal(t, "repo/a/README-1", target)
+}
+
+func TestCopyMoveOptionsValidate(t *testing.T) {
+	assert.NoError(t, CopyMoveOptions{}.validate())
+	assert.NoError(t, CopyMoveOptions{OnConflict: OnConflictRename}.validate())
+	assert.Error(t, CopyMoveOptions{OnConflict: "merge"}.validate())
+	assert.False(t, CopyMoveOptions{}.isPlanned())
+	assert.True(t, CopyMoveOptions{PreserveStats: true}.isPlanned())
+}
+
+func TestRunPlannedMove(t *testing.T) {
+	var mutex sync.Mutex
+	var requests []string
+	// The item which is immutable, if any.
+	var immutable string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/api/system/version":
+			_, _ = w.Write([]byte(`{"version": "7.90.0"}`))
+		case r.URL.Path == "/api/search/aql":
+			body, err := io.ReadAll(r.Body)
+			assert.NoError(t, err)
+			switch {
+			case immutable != "" && strings.Contains(string(body), protection.ImmutableProperty):
+				repo, name := path.Split(immutable)
+				_, _ = w.Write([]byte(`{"results": [{"repo": "` + strings.TrimSuffix(repo, "/") + `", "path": ".", "name": "` + name + `", "type": "file"}]}`))
+			case strings.Contains(string(body), "lifecycle."):
+				_, _ = w.Write([]byte(`{"results": []}`))
+			default:
+				_, _ = w.Write([]byte(`{"results": [{"repo": "libs-local", "path": "a", "name": "b.jar", "type": "file"}, {"repo": "libs-local", "path": "a", "name": "locked.jar", "type": "file"}]}`))
+			}
+		case r.Method == http.MethodGet && r.URL.Path == "/api/storage/target/b.jar":
+			_, _ = w.Write([]byte(`{}`))
+		case r.Method == http.MethodGet:
+			w.WriteHeader(http.StatusNotFound)
+		case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "locked.jar"):
+			w.WriteHeader(http.StatusForbidden)
+		default:
+			mutex.Lock()
+			requests = append(requests, r.Method+" "+r.URL.Path+"?"+r.URL.RawQuery)
+			mutex.Unlock()
+		}
+	}))
+	defer server.Close()
+	servicesManager, err := createPropsServiceManager(1, 0, 0, &config.ServerDetails{ArtifactoryUrl: server.URL + "/"})
+	require.NoError(t, err)
+	params := services.MoveCopyParams{CommonParams: &serviceutils.CommonParams{Pattern: "libs-local/a/*", Target: "target/", Recursive: true}, Flat: true}
+
+	options := CopyMoveOptions{OnConflict: OnConflictRename}
+	plan, err := planCopyMove(servicesManager, options, params)
+	require.NoError(t, err)
+	assert.Equal(t, []CopyMovePlanItem{
+		{Source: "libs-local/a/b.jar", Target: "target/b-1.jar", Conflict: OnConflictRename},
+		{Source: "libs-local/a/locked.jar", Target: "target/locked.jar"},
+	}, plan)
+
+	_, err = planCopyMove(servicesManager, CopyMoveOptions{OnConflict: OnConflictFail}, params)
+	assert.ErrorContains(t, err, "target/b.jar")
+
+	succeeded, _, err := runPlannedCopyMove(servicesManager, moveAction, options, true, 1, params)
+	require.NoError(t, err)
+	assert.Equal(t, 2, succeeded)
+	assert.Empty(t, requests)
+
+	// The move of locked.jar fails, so b.jar is moved back.
+	succeeded, failed, err := runPlannedCopyMove(servicesManager, moveAction, options, false, 1, params)
+	assert.Error(t, err)
+	assert.Equal(t, 0, succeeded)
+	assert.Equal(t, 2, failed)
+	assert.Equal(t, []string{
+		"POST /api/move/libs-local/a/b.jar?to=target%2Fb-1.jar",
+		"POST /api/move/target/b-1.jar?to=libs-local%2Fa%2Fb.jar",
+	}, requests)
+
+	// Overwriting an immutable target is denied, for copies as well as moves.
+	requests, immutable = nil, "target/b.jar"
+	for _, action := range []string{copyAction, moveAction} {
+		_, _, err = runPlannedCopyMove(servicesManager, action, CopyMoveOptions{OnConflict: OnConflictOverwrite}, false, 1, params)
+		assert.ErrorContains(t, err, "target/b.jar (immutable)")
+		assert.ErrorContains(t, verifyCopyMoveNotProtected(servicesManager, action, params), "target/b.jar (immutable)")
+	}
+	assert.Empty(t, requests)
+
+	// Renaming doesn't replace the immutable target.
+	succeeded, _, err = runPlannedCopyMove(servicesManager, copyAction, options, true, 1, params)
+	require.NoError(t, err)
+	assert.Equal(t, 2, succeeded)
+}
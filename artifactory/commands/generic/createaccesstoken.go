@@ -5,7 +5,7 @@ import (
 	"github.com/jfrog/jfrog-client-go/auth"
 	"strings"
 
-	rtUtils "github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clientconfig"
 	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
 	"github.com/jfrog/jfrog-client-go/artifactory/services"
 	"github.com/jfrog/jfrog-client-go/utils/errorutils"
@@ -81,7 +81,7 @@ func (atcc *AccessTokenCreateCommand) CommandName() string {
 }
 
 func (atcc *AccessTokenCreateCommand) Run() error {
-	servicesManager, err := rtUtils.CreateServiceManager(atcc.serverDetails, -1, 0, false)
+	servicesManager, err := clientconfig.CreateServiceManager(atcc.serverDetails, -1, 0, false)
 	if err != nil {
 		return err
 	}
@@ -124,7 +124,7 @@ func (atcc *AccessTokenCreateCommand) getTokenParams() (tokenParams services.Cre
 }
 
 func getInstanceId(serverDetails *config.ServerDetails) (string, error) {
-	servicesManager, err := rtUtils.CreateServiceManager(serverDetails, -1, 0, false)
+	servicesManager, err := clientconfig.CreateServiceManager(serverDetails, -1, 0, false)
 	if err != nil {
 		return "", err
 	}
@@ -0,0 +1,140 @@
+package generic
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/checksumcache"
+	"github.com/jfrog/jfrog-cli-core/v2/common/spec"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// The maximum number of checksums searched in a single AQL query.
+const dedupAqlBatchSize = 200
+
+// DedupStats summarizes the files which were found in Artifactory before the upload, and were deployed by checksum.
+type DedupStats struct {
+	Files int   `json:"files"`
+	Bytes int64 `json:"bytes"`
+}
+
+type localFileDetails struct {
+	path string
+	sha1 string
+	size int64
+}
+
+// Searches Artifactory for the content of the local files matching each spec file, and returns the minimal size of
+// a duplicated file per spec file index. Lowering the minimal checksum deploy size of the spec file to this size makes
+// the upload deploy all the duplicated files by checksum, without transferring their content.
+// Spec files which aren't uploaded file by file, and files which can't be matched locally, are skipped.
+func planDedup(servicesManager artifactory.ArtifactoryServicesManager, files []spec.File, repos []string) (minSizes map[int]int64, stats DedupStats, err error) {
+	minSizes = make(map[int]int64)
+	for i := range files {
+		if files[i].Archive != "" || files[i].Explode == "true" {
+			continue
+		}
+		var paths []string
+		if paths, err = matchLocalFiles(&files[i]); err != nil {
+			log.Debug(fmt.Sprintf("Skipping the deduplication of the pattern '%s': %s", files[i].Pattern, err.Error()))
+			err = nil
+			continue
+		}
+		var localFiles []localFileDetails
+		if localFiles, err = getLocalFilesDetails(paths); err != nil {
+			return
+		}
+		var existing map[string]bool
+		if existing, err = searchChecksums(servicesManager, localFiles, repos); err != nil {
+			return
+		}
+		for _, localFile := range localFiles {
+			if !existing[localFile.sha1] {
+				continue
+			}
+			log.Debug(fmt.Sprintf("The content of '%s' already exists in Artifactory", localFile.path))
+			stats.Files++
+			stats.Bytes += localFile.size
+			if minSize, ok := minSizes[i]; !ok || localFile.size < minSize {
+				minSizes[i] = localFile.size
+			}
+		}
+	}
+	return
+}
+
+func getLocalFilesDetails(paths []string) ([]localFileDetails, error) {
+	cache := checksumcache.Load()
+	defer cache.SaveOrLog()
+	localFiles := make([]localFileDetails, 0, len(paths))
+	for _, path := range paths {
+		details, err := cache.GetFileDetails(path)
+		if err != nil {
+			return nil, err
+		}
+		localFiles = append(localFiles, localFileDetails{path: path, sha1: details.Checksum.Sha1, size: details.Size})
+	}
+	return localFiles, nil
+}
+
+// Returns the sha1 checksums of the local files which exist in the given repositories, or in any repository if none are given.
+func searchChecksums(servicesManager artifactory.ArtifactoryServicesManager, localFiles []localFileDetails, repos []string) (map[string]bool, error) {
+	existing := make(map[string]bool)
+	for start := 0; start < len(localFiles); start += dedupAqlBatchSize {
+		end := min(start+dedupAqlBatchSize, len(localFiles))
+		var checksums []string
+		for _, localFile := range localFiles[start:end] {
+			checksums = append(checksums, localFile.sha1)
+		}
+		found, err := runChecksumsQuery(servicesManager, createDedupAqlQuery(checksums, repos))
+		if err != nil {
+			return nil, err
+		}
+		for _, checksum := range found {
+			existing[checksum] = true
+		}
+	}
+	return existing, nil
+}
+
+func createDedupAqlQuery(checksums, repos []string) string {
+	var checksumConditions []string
+	for _, checksum := range checksums {
+		checksumConditions = append(checksumConditions, fmt.Sprintf(`{"actual_sha1":"%s"}`, checksum))
+	}
+	criteria := fmt.Sprintf(`{"$or":[%s]}`, strings.Join(checksumConditions, ","))
+	if len(repos) > 0 {
+		var repoConditions []string
+		for _, repo := range repos {
+			repoConditions = append(repoConditions, fmt.Sprintf(`{"repo":"%s"}`, repo))
+		}
+		criteria = fmt.Sprintf(`{"$and":[{"$or":[%s]},%s]}`, strings.Join(repoConditions, ","), criteria)
+	}
+	return fmt.Sprintf(`items.find(%s).include("actual_sha1")`, criteria)
+}
+
+func runChecksumsQuery(servicesManager artifactory.ArtifactoryServicesManager, query string) (checksums []string, err error) {
+	reader, err := servicesManager.Aql(query)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		err = errors.Join(err, errorutils.CheckError(reader.Close()))
+	}()
+	var result struct {
+		Results []struct {
+			ActualSha1 string `json:"actual_sha1"`
+		} `json:"results"`
+	}
+	if err = json.NewDecoder(reader).Decode(&result); err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	for _, item := range result.Results {
+		checksums = append(checksums, item.ActualSha1)
+	}
+	return
+}
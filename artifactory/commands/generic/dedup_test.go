@@ -0,0 +1,14 @@
+package generic
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateDedupAqlQuery(t *testing.T) {
+	assert.Equal(t, `items.find({"$or":[{"actual_sha1":"a1"},{"actual_sha1":"b2"}]}).include("actual_sha1")`,
+		createDedupAqlQuery([]string{"a1", "b2"}, nil))
+	assert.Equal(t, `items.find({"$and":[{"$or":[{"repo":"libs-local"},{"repo":"generic-local"}]},{"$or":[{"actual_sha1":"a1"}]}]}).include("actual_sha1")`,
+		createDedupAqlQuery([]string{"a1"}, []string{"libs-local", "generic-local"}))
+}
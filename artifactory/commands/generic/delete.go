@@ -2,8 +2,11 @@ package generic
 
 import (
 	"errors"
+	"path"
 
 	ioutils "github.com/jfrog/gofrog/io"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clientconfig"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/protection"
 	"github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
 	"github.com/jfrog/jfrog-cli-core/v2/common/spec"
 	"github.com/jfrog/jfrog-client-go/artifactory/services"
@@ -40,6 +43,9 @@ func (dc *DeleteCommand) Run() (err error) {
 		return
 	}
 	defer ioutils.Close(reader, &err)
+	if err = dc.verifyNotProtected(reader); err != nil {
+		return
+	}
 	allowDelete := true
 	if !dc.quiet {
 		allowDelete, err = utils.ConfirmDelete(reader)
@@ -64,10 +70,13 @@ func (dc *DeleteCommand) GetPathsToDelete() (contentReader *content.ContentReade
 	if errorutils.CheckError(err) != nil {
 		return
 	}
-	servicesManager, err := utils.CreateServiceManager(serverDetails, dc.retries, dc.retryWaitTimeMilliSecs, dc.DryRun())
+	servicesManager, err := clientconfig.CreateServiceManager(serverDetails, dc.retries, dc.retryWaitTimeMilliSecs, dc.DryRun())
 	if err != nil {
 		return
 	}
+	if dc.rawAql != "" {
+		return dc.searchRawAql(servicesManager)
+	}
 	var temp []*content.ContentReader
 	defer func() {
 		for _, reader := range temp {
@@ -102,12 +111,33 @@ func (dc *DeleteCommand) GetPathsToDelete() (contentReader *content.ContentReade
 	return
 }
 
+// Denies the deletion if any of the paths to delete is, contains or is contained in an item which is immutable or under
+// legal hold.
+func (dc *DeleteCommand) verifyNotProtected(reader *content.ContentReader) error {
+	var paths []string
+	for item := new(clientutils.ResultItem); reader.NextRecord(item) == nil; item = new(clientutils.ResultItem) {
+		paths = append(paths, path.Join(item.Repo, item.Path, item.Name))
+	}
+	if err := reader.GetError(); err != nil {
+		return err
+	}
+	reader.Reset()
+	if len(paths) == 0 {
+		return nil
+	}
+	servicesManager, err := clientconfig.CreateServiceManager(dc.serverDetails, dc.retries, dc.retryWaitTimeMilliSecs, false)
+	if err != nil {
+		return err
+	}
+	return protection.VerifyNotProtected(servicesManager, "delete", paths)
+}
+
 func (dc *DeleteCommand) DeleteFiles(reader *content.ContentReader) (successCount, failedCount int, err error) {
 	serverDetails, err := dc.ServerDetails()
 	if errorutils.CheckError(err) != nil {
 		return 0, 0, err
 	}
-	servicesManager, err := utils.CreateDeleteServiceManager(serverDetails, dc.Threads(), dc.retries, dc.retryWaitTimeMilliSecs, dc.DryRun())
+	servicesManager, err := clientconfig.CreateDeleteServiceManager(serverDetails, dc.Threads(), dc.retries, dc.retryWaitTimeMilliSecs, dc.DryRun())
 	if err != nil {
 		return 0, 0, err
 	}
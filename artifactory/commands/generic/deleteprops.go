@@ -38,6 +38,9 @@ func (dp *DeletePropsCommand) Run() error {
 	if err != nil {
 		return err
 	}
+	if !dp.repoOnly {
+		return dp.runBatch(servicesManager, true)
+	}
 	reader, err := searchItems(dp.Spec(), servicesManager)
 	if err != nil {
 		return err
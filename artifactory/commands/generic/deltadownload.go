@@ -0,0 +1,342 @@
+package generic
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	ioutils "github.com/jfrog/gofrog/io"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clierrors"
+	"github.com/jfrog/jfrog-cli-core/v2/common/spec"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/jfrog/jfrog-client-go/artifactory/services"
+	clientutils "github.com/jfrog/jfrog-client-go/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/io/content"
+	"github.com/jfrog/jfrog-client-go/utils/io/fileutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const (
+	// Suffix of the block manifest deployed alongside an artifact, used for delta downloads.
+	BlockManifestSuffix = ".blockmap"
+	// Default size of a block in a block manifest.
+	DefaultDeltaBlockSize int64 = 4 * 1024 * 1024
+)
+
+// BlockManifest describes an artifact as a list of fixed-size blocks and their SHA-256 checksums.
+// It is deployed next to the artifact, and allows downloading only the blocks that differ from an older local copy.
+type BlockManifest struct {
+	BlockSize int64    `json:"blockSize"`
+	Size      int64    `json:"size"`
+	Sha256    string   `json:"sha256"`
+	Blocks    []string `json:"blocks"`
+}
+
+// CreateBlockManifest splits the file into blocks of blockSize bytes and calculates the checksum of each block.
+func CreateBlockManifest(filePath string, blockSize int64) (manifest *BlockManifest, err error) {
+	if blockSize <= 0 {
+		blockSize = DefaultDeltaBlockSize
+	}
+	file, err := os.Open(filePath)
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	defer ioutils.Close(file, &err)
+
+	manifest = &BlockManifest{BlockSize: blockSize}
+	fileHash := sha256.New()
+	buf := make([]byte, blockSize)
+	for {
+		n, readErr := io.ReadFull(file, buf)
+		if n > 0 {
+			blockHash := sha256.Sum256(buf[:n])
+			manifest.Blocks = append(manifest.Blocks, hex.EncodeToString(blockHash[:]))
+			fileHash.Write(buf[:n])
+			manifest.Size += int64(n)
+		}
+		if readErr == io.EOF || errors.Is(readErr, io.ErrUnexpectedEOF) {
+			break
+		}
+		if readErr != nil {
+			return nil, errorutils.CheckError(readErr)
+		}
+	}
+	manifest.Sha256 = hex.EncodeToString(fileHash.Sum(nil))
+	return manifest, nil
+}
+
+// ChangedBlocks returns the indexes of the blocks in the manifest which do not match the provided local manifest.
+func (bm *BlockManifest) ChangedBlocks(local *BlockManifest) (changed []int) {
+	for i, blockHash := range bm.Blocks {
+		if local == nil || local.BlockSize != bm.BlockSize || i >= len(local.Blocks) || local.Blocks[i] != blockHash {
+			changed = append(changed, i)
+		}
+	}
+	return
+}
+
+// Returns the range of bytes covered by the block in the provided index.
+func (bm *BlockManifest) blockRange(index int) (start, end int64) {
+	start = int64(index) * bm.BlockSize
+	end = start + bm.BlockSize - 1
+	if end >= bm.Size {
+		end = bm.Size - 1
+	}
+	return
+}
+
+// DeltaDownloader updates existing local files by downloading only the blocks which changed in Artifactory.
+type DeltaDownloader struct {
+	servicesManager artifactory.ArtifactoryServicesManager
+	// Total number of bytes downloaded and reused, for reporting.
+	downloadedBytes int64
+	reusedBytes     int64
+}
+
+func NewDeltaDownloader(servicesManager artifactory.ArtifactoryServicesManager) *DeltaDownloader {
+	return &DeltaDownloader{servicesManager: servicesManager}
+}
+
+func (dd *DeltaDownloader) DownloadedBytes() int64 {
+	return dd.downloadedBytes
+}
+
+func (dd *DeltaDownloader) ReusedBytes() int64 {
+	return dd.reusedBytes
+}
+
+// Run attempts a delta download for each File-Spec group that points to a single artifact with an existing local copy.
+// Files which cannot be delta-downloaded are skipped and left for the regular download.
+// Reconstructed files match their remote checksum, so the regular download that follows skips them.
+func (dd *DeltaDownloader) Run(files []spec.File) {
+	for i := range files {
+		localPath, ok := resolveDeltaLocalPath(&files[i])
+		if !ok || !fileutils.IsPathExists(localPath, false) {
+			continue
+		}
+		artifactPath := strings.TrimPrefix(files[i].Pattern, "/")
+		if err := dd.downloadDelta(artifactPath, localPath); err != nil {
+			log.Info(fmt.Sprintf("Delta download of '%s' is not possible, falling back to a full download: %s", artifactPath, err.Error()))
+		}
+	}
+	if dd.downloadedBytes > 0 || dd.reusedBytes > 0 {
+		log.Info(fmt.Sprintf("Delta download transferred %d bytes and reused %d bytes from existing local files.", dd.downloadedBytes, dd.reusedBytes))
+	}
+}
+
+func (dd *DeltaDownloader) downloadDelta(artifactPath, localPath string) (err error) {
+	remote, err := dd.getBlockManifest(artifactPath)
+	if err != nil {
+		return err
+	}
+	local, err := CreateBlockManifest(localPath, remote.BlockSize)
+	if err != nil {
+		return err
+	}
+	if local.Sha256 == remote.Sha256 {
+		log.Debug("Local file", localPath, "is identical to", artifactPath)
+		return nil
+	}
+	changed := remote.ChangedBlocks(local)
+	log.Info(fmt.Sprintf("Delta downloading '%s': %d of %d blocks changed.", artifactPath, len(changed), len(remote.Blocks)))
+
+	tmpFile, err := os.CreateTemp(filepath.Dir(localPath), ".jfrog-delta-*")
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	tmpPath := tmpFile.Name()
+	defer func() {
+		if err != nil {
+			err = errors.Join(err, errorutils.CheckError(os.Remove(tmpPath)))
+		}
+	}()
+	if err = dd.writeBlocks(tmpFile, artifactPath, localPath, remote, changed); err != nil {
+		err = errors.Join(err, errorutils.CheckError(tmpFile.Close()))
+		return
+	}
+	if err = errorutils.CheckError(tmpFile.Close()); err != nil {
+		return
+	}
+	reconstructed, err := CreateBlockManifest(tmpPath, remote.BlockSize)
+	if err != nil {
+		return
+	}
+	if reconstructed.Sha256 != remote.Sha256 {
+		return errorutils.CheckErrorf("checksum mismatch after delta download of '%s': expected %s, got %s", artifactPath, remote.Sha256, reconstructed.Sha256)
+	}
+	return errorutils.CheckError(os.Rename(tmpPath, localPath))
+}
+
+// Writes the remote file into target, reusing unchanged blocks from the local file and fetching changed blocks by HTTP range requests.
+func (dd *DeltaDownloader) writeBlocks(target *os.File, artifactPath, localPath string, remote *BlockManifest, changed []int) (err error) {
+	localFile, err := os.Open(localPath)
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	defer ioutils.Close(localFile, &err)
+
+	changedSet := make(map[int]bool, len(changed))
+	for _, index := range changed {
+		changedSet[index] = true
+	}
+	for i := range remote.Blocks {
+		start, end := remote.blockRange(i)
+		var block []byte
+		if changedSet[i] {
+			block, err = dd.getRange(artifactPath, start, end)
+			if err != nil {
+				return err
+			}
+			dd.downloadedBytes += int64(len(block))
+		} else {
+			block = make([]byte, end-start+1)
+			if _, err = localFile.ReadAt(block, start); err != nil {
+				return errorutils.CheckError(err)
+			}
+			dd.reusedBytes += int64(len(block))
+		}
+		if _, err = target.Write(block); err != nil {
+			return errorutils.CheckError(err)
+		}
+	}
+	return nil
+}
+
+func (dd *DeltaDownloader) getBlockManifest(artifactPath string) (*BlockManifest, error) {
+	serviceDetails := dd.servicesManager.GetConfig().GetServiceDetails()
+	httpClientDetails := serviceDetails.CreateHttpClientDetails()
+	resp, body, _, err := dd.servicesManager.Client().SendGet(serviceDetails.GetUrl()+artifactPath+BlockManifestSuffix, true, &httpClientDetails)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, errorutils.CheckErrorf("no block manifest was found for the artifact")
+	}
+	if err = clierrors.CheckResponseStatusWithBody(resp, body, http.StatusOK); err != nil {
+		return nil, err
+	}
+	manifest := new(BlockManifest)
+	if err = json.Unmarshal(body, manifest); err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	if manifest.BlockSize <= 0 {
+		return nil, errorutils.CheckErrorf("invalid block size in the block manifest: %d", manifest.BlockSize)
+	}
+	return manifest, nil
+}
+
+func (dd *DeltaDownloader) getRange(artifactPath string, start, end int64) ([]byte, error) {
+	serviceDetails := dd.servicesManager.GetConfig().GetServiceDetails()
+	httpClientDetails := serviceDetails.CreateHttpClientDetails()
+	if httpClientDetails.Headers == nil {
+		httpClientDetails.Headers = make(map[string]string)
+	}
+	httpClientDetails.Headers["Range"] = fmt.Sprintf("bytes=%d-%d", start, end)
+	resp, body, _, err := dd.servicesManager.Client().SendGet(serviceDetails.GetUrl()+artifactPath, true, &httpClientDetails)
+	if err != nil {
+		return nil, err
+	}
+	if err = clierrors.CheckResponseStatusWithBody(resp, body, http.StatusPartialContent); err != nil {
+		return nil, err
+	}
+	if int64(len(body)) != end-start+1 {
+		return nil, errorutils.CheckErrorf("unexpected range size for bytes %d-%d: %d", start, end, len(body))
+	}
+	return body, nil
+}
+
+// Resolves the local path of a File-Spec group which points to a single artifact.
+// Returns false if the group may match more than one artifact, or if its target uses placeholders.
+func resolveDeltaLocalPath(f *spec.File) (string, bool) {
+	pattern := strings.TrimPrefix(f.Pattern, "/")
+	if strings.ContainsAny(pattern, "*?") || strings.Contains(f.Target, "{") || strings.HasSuffix(pattern, "/") {
+		return "", false
+	}
+	repoAndPath := strings.SplitN(pattern, "/", 2)
+	if len(repoAndPath) != 2 || repoAndPath[1] == "" {
+		return "", false
+	}
+	relativePath := repoAndPath[1]
+	flat, err := f.IsFlat(false)
+	if err != nil {
+		return "", false
+	}
+	if flat {
+		relativePath = path.Base(relativePath)
+	}
+	switch {
+	case f.Target == "":
+		return filepath.FromSlash(relativePath), true
+	case strings.HasSuffix(f.Target, "/"):
+		return filepath.FromSlash(f.Target + relativePath), true
+	default:
+		return filepath.FromSlash(f.Target), true
+	}
+}
+
+// Creates a block manifest for each uploaded file and deploys it next to the uploaded artifact.
+func uploadBlockManifests(servicesManager artifactory.ArtifactoryServicesManager, transferDetailsReader *content.ContentReader) (err error) {
+	tmpDir, err := fileutils.CreateTempDir()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = errors.Join(err, fileutils.RemoveTempDir(tmpDir))
+	}()
+
+	var uploadParamsArray []services.UploadParams
+	index := 0
+	for details := new(clientutils.FileTransferDetails); transferDetailsReader.NextRecord(details) == nil; details = new(clientutils.FileTransferDetails) {
+		if strings.HasSuffix(details.TargetPath, BlockManifestSuffix) {
+			continue
+		}
+		var manifest *BlockManifest
+		manifest, err = CreateBlockManifest(details.SourcePath, DefaultDeltaBlockSize)
+		if err != nil {
+			return err
+		}
+		var manifestContent []byte
+		manifestContent, err = json.Marshal(manifest)
+		if err != nil {
+			return errorutils.CheckError(err)
+		}
+		manifestPath := filepath.Join(tmpDir, fmt.Sprintf("%d%s", index, BlockManifestSuffix))
+		index++
+		if err = os.WriteFile(manifestPath, manifestContent, 0600); err != nil {
+			return errorutils.CheckError(err)
+		}
+		targetPath := strings.TrimPrefix(details.TargetPath, details.RtUrl)
+		manifestSpec := spec.NewBuilder().Pattern(manifestPath).Target(targetPath + BlockManifestSuffix).Flat(true).BuildSpec()
+		uploadParams := services.NewUploadParams()
+		if uploadParams.CommonParams, err = manifestSpec.Get(0).ToCommonParams(); err != nil {
+			return err
+		}
+		uploadParams.Flat = true
+		uploadParamsArray = append(uploadParamsArray, uploadParams)
+	}
+	transferDetailsReader.Reset()
+	if err = transferDetailsReader.GetError(); err != nil {
+		return err
+	}
+	if len(uploadParamsArray) == 0 {
+		return nil
+	}
+	_, failed, err := servicesManager.UploadFiles(artifactory.UploadServiceOptions{}, uploadParamsArray...)
+	if err != nil {
+		return err
+	}
+	if failed > 0 {
+		return errorutils.CheckErrorf("failed uploading %d block manifests", failed)
+	}
+	log.Info(fmt.Sprintf("Uploaded %d block manifests for delta downloads.", len(uploadParamsArray)))
+	return nil
+}
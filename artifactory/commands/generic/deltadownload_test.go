@@ -0,0 +1,75 @@
+package generic
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-core/v2/common/spec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateBlockManifest(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "file.bin")
+	require.NoError(t, os.WriteFile(filePath, []byte("aaaabbbbcc"), 0600))
+
+	manifest, err := CreateBlockManifest(filePath, 4)
+	require.NoError(t, err)
+	assert.Equal(t, int64(4), manifest.BlockSize)
+	assert.Equal(t, int64(10), manifest.Size)
+	assert.Len(t, manifest.Blocks, 3)
+	assert.Equal(t, "e5b23841c6af4520d056e6428103d28178e586ff65111e80167d43736ade2f72", manifest.Sha256)
+
+	start, end := manifest.blockRange(1)
+	assert.Equal(t, int64(4), start)
+	assert.Equal(t, int64(7), end)
+	start, end = manifest.blockRange(2)
+	assert.Equal(t, int64(8), start)
+	assert.Equal(t, int64(9), end)
+}
+
+func TestChangedBlocks(t *testing.T) {
+	remote := &BlockManifest{BlockSize: 4, Blocks: []string{"a", "b", "c"}}
+	tests := []struct {
+		name     string
+		local    *BlockManifest
+		expected []int
+	}{
+		{"identical", &BlockManifest{BlockSize: 4, Blocks: []string{"a", "b", "c"}}, nil},
+		{"middle block changed", &BlockManifest{BlockSize: 4, Blocks: []string{"a", "x", "c"}}, []int{1}},
+		{"local file shorter", &BlockManifest{BlockSize: 4, Blocks: []string{"a"}}, []int{1, 2}},
+		{"different block size", &BlockManifest{BlockSize: 8, Blocks: []string{"a", "b", "c"}}, []int{0, 1, 2}},
+		{"no local manifest", nil, []int{0, 1, 2}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, remote.ChangedBlocks(test.local))
+		})
+	}
+}
+
+func TestResolveDeltaLocalPath(t *testing.T) {
+	tests := []struct {
+		name       string
+		file       spec.File
+		expected   string
+		expectedOk bool
+	}{
+		{"no target", spec.File{Pattern: "repo/a/b/file.bin"}, "a/b/file.bin", true},
+		{"target directory", spec.File{Pattern: "repo/a/file.bin", Target: "out/"}, "out/a/file.bin", true},
+		{"flat target directory", spec.File{Pattern: "/repo/a/file.bin", Target: "out/", Flat: "true"}, "out/file.bin", true},
+		{"target file", spec.File{Pattern: "repo/a/file.bin", Target: "out/renamed.bin"}, "out/renamed.bin", true},
+		{"wildcard", spec.File{Pattern: "repo/a/*.bin"}, "", false},
+		{"placeholder", spec.File{Pattern: "repo/a/file.bin", Target: "out/{1}"}, "", false},
+		{"repository only", spec.File{Pattern: "repo"}, "", false},
+		{"directory", spec.File{Pattern: "repo/a/"}, "", false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			localPath, ok := resolveDeltaLocalPath(&test.file)
+			assert.Equal(t, test.expectedOk, ok)
+			assert.Equal(t, filepath.FromSlash(test.expected), localPath)
+		})
+	}
+}
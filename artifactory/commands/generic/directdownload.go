@@ -10,6 +10,7 @@ import (
 	gofrog "github.com/jfrog/gofrog/io"
 	"github.com/jfrog/jfrog-cli-core/v2/common/spec"
 
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clientconfig"
 	"github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
 	"github.com/jfrog/jfrog-cli-core/v2/common/build"
 	"github.com/jfrog/jfrog-client-go/artifactory/services"
@@ -42,7 +43,7 @@ func (ddc *DirectDownloadCommand) directDownload() error {
 		ddc.progress.InitProgressReaders()
 	}
 
-	servicesManager, err := utils.CreateDownloadServiceManager(ddc.serverDetails, ddc.configuration.Threads, ddc.retries, ddc.retryWaitTimeMilliSecs, ddc.DryRun(), ddc.progress)
+	servicesManager, err := clientconfig.CreateDownloadServiceManager(ddc.serverDetails, ddc.configuration.Threads, ddc.retries, ddc.retryWaitTimeMilliSecs, ddc.DryRun(), ddc.wrapProgress(ddc.progress))
 	if err != nil {
 		return err
 	}
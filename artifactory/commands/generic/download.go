@@ -2,6 +2,7 @@ package generic
 
 import (
 	"errors"
+	"io"
 	"os"
 	"path/filepath"
 	"strconv"
@@ -9,6 +10,10 @@ import (
 
 	buildinfo "github.com/jfrog/build-info-go/entities"
 	gofrog "github.com/jfrog/gofrog/io"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/formats"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clientconfig"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/downloadcache"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/encryption"
 	"github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
 	"github.com/jfrog/jfrog-cli-core/v2/common/build"
 	"github.com/jfrog/jfrog-cli-core/v2/common/spec"
@@ -27,6 +32,24 @@ type DownloadCommand struct {
 	GenericCommand
 	configuration *utils.DownloadConfiguration
 	progress      ioUtils.ProgressMgr
+	delta         bool
+	keyProvider   encryption.KeyProvider
+	// Whether downloaded artifacts which were uploaded as symbolic links should be recreated as links.
+	preserveSymlinks bool
+	// Paths or wildcard patterns of entries to extract from the matched archives, instead of downloading the archives.
+	entryPatterns []string
+	// If set, the single matched artifact is streamed to this writer rather than downloaded to the file system.
+	output io.Writer
+	// The algorithm by which the downloaded files are verified against Artifactory, and the policy for mismatches.
+	verifyAlgorithm     VerifyAlgorithm
+	verifyFailurePolicy VerifyFailurePolicy
+	verifyRetries       int
+	verification        *formats.DownloadVerification
+	// Whether only the artifacts of the newest version matching each spec file are downloaded, within the version range if set.
+	latest       bool
+	versionRange string
+	// The local store from which artifacts downloaded before are copied, rather than downloaded again.
+	cache *downloadcache.Cache
 }
 
 func NewDownloadCommand() *DownloadCommand {
@@ -47,6 +70,83 @@ func (dc *DownloadCommand) SetConfiguration(configuration *utils.DownloadConfigu
 	return dc
 }
 
+func (dc *DownloadCommand) Delta() bool {
+	return dc.delta
+}
+
+func (dc *DownloadCommand) SetDelta(delta bool) *DownloadCommand {
+	dc.delta = delta
+	return dc
+}
+
+// SetEncryptionKeyProvider enables the decryption of downloaded files which were encrypted on upload.
+func (dc *DownloadCommand) SetEncryptionKeyProvider(keyProvider encryption.KeyProvider) *DownloadCommand {
+	dc.keyProvider = keyProvider
+	return dc
+}
+
+// SetPreserveSymlinks sets whether artifacts uploaded with preserved symbolic links should be recreated as links.
+// Relative targets are kept relative, and links which were dangling on upload are recreated as dangling links.
+func (dc *DownloadCommand) SetPreserveSymlinks(preserveSymlinks bool) *DownloadCommand {
+	dc.preserveSymlinks = preserveSymlinks
+	return dc
+}
+
+// SetArchiveEntryPatterns sets the entries to download from the matched archives, which are extracted by Artifactory.
+func (dc *DownloadCommand) SetArchiveEntryPatterns(entryPatterns []string) *DownloadCommand {
+	dc.entryPatterns = entryPatterns
+	return dc
+}
+
+// SetOutputWriter streams the single artifact matching the spec to the writer, such as the standard output.
+func (dc *DownloadCommand) SetOutputWriter(output io.Writer) *DownloadCommand {
+	dc.output = output
+	return dc
+}
+
+// SetVerification verifies the downloaded files by their checksums in Artifactory with the algorithm, and applies the
+// failure policy to the files which mismatch. Retries is the number of times a file is downloaded again by the retry
+// policy. The files aren't verified if the algorithm is empty or none.
+func (dc *DownloadCommand) SetVerification(algorithm VerifyAlgorithm, policy VerifyFailurePolicy, retries int) *DownloadCommand {
+	dc.verifyAlgorithm = algorithm
+	dc.verifyFailurePolicy = policy
+	dc.verifyRetries = retries
+	return dc
+}
+
+// Verification returns the result of the verification of the downloaded files, or nil if they weren't verified.
+func (dc *DownloadCommand) Verification() *formats.DownloadVerification {
+	return dc.verification
+}
+
+func (dc *DownloadCommand) shouldVerify() bool {
+	return dc.verifyAlgorithm != "" && dc.verifyAlgorithm != VerifyNone && !dc.DryRun()
+}
+
+// SetLatest sets whether only the artifacts of the newest version matching each spec file should be downloaded. The
+// version of an artifact is taken from its version property, or otherwise from its path.
+func (dc *DownloadCommand) SetLatest(latest bool) *DownloadCommand {
+	dc.latest = latest
+	return dc
+}
+
+// SetVersionRange sets the semver range, such as '^1.2' or '>=1.2, <2', within which the newest version is selected.
+// Setting a range implies downloading the latest version.
+func (dc *DownloadCommand) SetVersionRange(versionRange string) *DownloadCommand {
+	dc.versionRange = versionRange
+	return dc
+}
+
+// SetCache sets the local store of downloaded files. Files of encrypted artifacts aren't cached.
+func (dc *DownloadCommand) SetCache(cache *downloadcache.Cache) *DownloadCommand {
+	dc.cache = cache
+	return dc
+}
+
+func (dc *DownloadCommand) useCache() bool {
+	return dc.cache != nil && dc.keyProvider == nil && !dc.DryRun()
+}
+
 func (dc *DownloadCommand) SetProgress(progress ioUtils.ProgressMgr) {
 	dc.progress = progress
 }
@@ -60,7 +160,9 @@ func (dc *DownloadCommand) CommandName() string {
 }
 
 func (dc *DownloadCommand) Run() error {
-	return dc.download()
+	err := dc.download()
+	dc.callbacks.Error(err)
+	return err
 }
 
 func (dc *DownloadCommand) download() (err error) {
@@ -70,10 +172,33 @@ func (dc *DownloadCommand) download() (err error) {
 		dc.progress.InitProgressReaders()
 	}
 	// Create Service Manager:
-	servicesManager, err := utils.CreateDownloadServiceManager(dc.serverDetails, dc.configuration.Threads, dc.retries, dc.retryWaitTimeMilliSecs, dc.DryRun(), dc.progress)
+	servicesManager, err := clientconfig.CreateDownloadServiceManager(dc.serverDetails, dc.configuration.Threads, dc.retries, dc.retryWaitTimeMilliSecs, dc.DryRun(), dc.wrapProgress(dc.progress))
 	if err != nil {
 		return err
 	}
+	dc.attachConcurrencyLimiter(servicesManager, dc.configuration.Threads)
+
+	var rawAqlItems []serviceutils.ResultItem
+	if dc.rawAql != "" {
+		if rawAqlItems, err = dc.createRawAqlSpec(servicesManager); err != nil {
+			return err
+		}
+	} else {
+		if dc.latest || dc.versionRange != "" {
+			if err = dc.resolveLatestVersions(servicesManager); err != nil {
+				return err
+			}
+		}
+		if err = dc.expandTargetTemplates(servicesManager); err != nil {
+			return err
+		}
+	}
+	if dc.output != nil {
+		return dc.downloadToWriter(servicesManager)
+	}
+	if len(dc.entryPatterns) > 0 {
+		return dc.downloadArchiveEntries(servicesManager)
+	}
 
 	// Build Info Collection:
 	toCollect, err := dc.buildConfiguration.IsCollectBuildInfo()
@@ -95,7 +220,16 @@ func (dc *DownloadCommand) download() (err error) {
 		}
 	}
 
-	var errorOccurred = false
+	// Update existing local files by downloading only their changed blocks.
+	if dc.delta && !dc.DryRun() {
+		NewDeltaDownloader(servicesManager).Run(dc.Spec().Files)
+	}
+	var cachedChecksums map[string]string
+	if dc.useCache() {
+		cachedChecksums = restoreFromDownloadCache(servicesManager, dc.cache, dc.Spec().Files)
+	}
+
+	var errorOccurred, validateSymlinks = false, false
 	var downloadParamsArray []services.DownloadParams
 	// Create DownloadParams for all File-Spec groups.
 	var downParams services.DownloadParams
@@ -106,6 +240,14 @@ func (dc *DownloadCommand) download() (err error) {
 			log.Error(err)
 			continue
 		}
+		if dc.preserveSymlinks && downParams.ValidateSymlink {
+			// Relative targets are validated against the directory of the link once it is recreated.
+			validateSymlinks = true
+			downParams.ValidateSymlink = false
+		}
+		if rawAqlItems != nil && rawAqlItems[i].Sha256 != "" {
+			downParams.Sha256, downParams.Size = rawAqlItems[i].Sha256, &rawAqlItems[i].Size
+		}
 		downloadParamsArray = append(downloadParamsArray, downParams)
 	}
 	// Perform download.
@@ -113,7 +255,7 @@ func (dc *DownloadCommand) download() (err error) {
 	// otherwise we use the download service which provides only general counters.
 	var totalDownloaded, totalFailed int
 	var summary *serviceutils.OperationSummary
-	if toCollect || dc.SyncDeletesPath() != "" || dc.DetailedSummary() {
+	if toCollect || dc.SyncDeletesPath() != "" || dc.DetailedSummary() || dc.callbacks.ReportsCompletedFiles() || dc.keyProvider != nil || dc.preserveSymlinks || dc.shouldVerify() || dc.useCache() {
 		summary, err = servicesManager.DownloadFilesWithSummary(downloadParamsArray...)
 		if err != nil {
 			errorOccurred = true
@@ -121,6 +263,35 @@ func (dc *DownloadCommand) download() (err error) {
 		}
 		if summary != nil {
 			defer gofrog.Close(summary.ArtifactsDetailsReader, &err)
+			// The files are verified before they're decrypted, since the checksums in Artifactory are of the encrypted files.
+			if dc.shouldVerify() {
+				verifier := newDownloadVerifier(servicesManager, dc.verifyAlgorithm, dc.verifyFailurePolicy, dc.verifyRetries)
+				err = verifier.verify(summary.TransferDetailsReader)
+				dc.verification = verifier.result
+				if err != nil {
+					errorOccurred = true
+					log.Error(err)
+				}
+			}
+			if dc.keyProvider != nil && !dc.DryRun() {
+				if err = decryptDownloadedFiles(summary.TransferDetailsReader, dc.keyProvider); err != nil {
+					errorOccurred = true
+					log.Error(err)
+				}
+			}
+			if dc.preserveSymlinks && !dc.DryRun() {
+				if err = recreateDownloadedSymlinks(servicesManager, dc.Spec(), summary.TransferDetailsReader, validateSymlinks); err != nil {
+					errorOccurred = true
+					log.Error(err)
+				}
+			}
+			if dc.useCache() {
+				addToDownloadCache(dc.cache, cachedChecksums, summary.TransferDetailsReader)
+			}
+			if err = dc.callbacks.ReportCompletedFiles(summary.TransferDetailsReader); err != nil {
+				errorOccurred = true
+				log.Error(err)
+			}
 			// If 'detailed summary' was requested, then the reader should not be closed here.
 			// It will be closed after it will be used to generate the summary.
 			if dc.DetailedSummary() {
@@ -0,0 +1,114 @@
+package generic
+
+import (
+	"fmt"
+	"net/http"
+	"path/filepath"
+
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clierrors"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/downloadcache"
+	"github.com/jfrog/jfrog-cli-core/v2/common/spec"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	serviceutils "github.com/jfrog/jfrog-client-go/artifactory/services/utils"
+	clientutils "github.com/jfrog/jfrog-client-go/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/io/content"
+	"github.com/jfrog/jfrog-client-go/utils/io/fileutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// Copies the artifacts matching the spec files which the cache holds to their local paths, once a HEAD request
+// validates that each of them can still be downloaded with the same checksum. The download that follows finds these
+// local files identical to the artifacts, and skips them. Local files which already exist are left to the download.
+// Returns the sha256 of each matching artifact by its path, since the download results don't include it.
+func restoreFromDownloadCache(servicesManager artifactory.ArtifactoryServicesManager, cache *downloadcache.Cache, files []spec.File) map[string]string {
+	checksums := make(map[string]string)
+	var restored int
+	for _, file := range files {
+		items, err := searchFileItems(servicesManager, file)
+		if err != nil {
+			log.Info("Skipping the download cache for", file.Pattern+":", err.Error())
+			continue
+		}
+		for _, item := range items {
+			checksums[item.GetItemRelativePath()] = item.Sha256
+			localPath, err := getDownloadLocalPath(&file, &item)
+			if err != nil || fileutils.IsPathExists(localPath, false) || !cache.Contains(item.Sha256) {
+				continue
+			}
+			if err = validateCachedArtifact(servicesManager, &item); err != nil {
+				log.Debug("Not restoring", item.GetItemRelativePath(), "from the download cache:", err.Error())
+				continue
+			}
+			found, err := cache.Get(item.Sha256, localPath)
+			if err != nil {
+				log.Warn("Failed to restore", localPath, "from the download cache:", err.Error())
+			}
+			if found {
+				log.Debug("Restored", localPath, "from the download cache")
+				restored++
+			}
+		}
+	}
+	if restored > 0 {
+		log.Info(fmt.Sprintf("Restored %d files from the download cache.", restored))
+	}
+	return checksums
+}
+
+// Returns the local path to which the artifact is downloaded by the spec file, the same way as the download builds it.
+func getDownloadLocalPath(file *spec.File, item *serviceutils.ResultItem) (string, error) {
+	flat, err := file.IsFlat(false)
+	if err != nil {
+		return "", err
+	}
+	target, placeholdersUsed, err := clientutils.BuildTargetPath(file.Pattern, item.GetItemRelativePath(), file.Target, true)
+	if err != nil {
+		return "", err
+	}
+	localPath, localFileName := fileutils.GetLocalPathAndFile(item.Name, item.Path, target, flat, placeholdersUsed)
+	return filepath.Join(localPath, localFileName), nil
+}
+
+// Sends a HEAD request for the artifact, which fails if it was removed or can no longer be read, and verifies that its
+// checksum didn't change since it was searched.
+func validateCachedArtifact(servicesManager artifactory.ArtifactoryServicesManager, item *serviceutils.ResultItem) error {
+	serviceDetails := servicesManager.GetConfig().GetServiceDetails()
+	artifactUrl, err := clientutils.BuildUrl(serviceDetails.GetUrl(), item.GetItemRelativePath(), make(map[string]string))
+	if err != nil {
+		return err
+	}
+	httpClientDetails := serviceDetails.CreateHttpClientDetails()
+	resp, body, err := servicesManager.Client().SendHead(artifactUrl, &httpClientDetails)
+	if err != nil {
+		return err
+	}
+	if err = clierrors.CheckResponseStatusWithBody(resp, body, http.StatusOK); err != nil {
+		return err
+	}
+	if sha256 := resp.Header.Get("X-Checksum-Sha256"); sha256 != "" && sha256 != item.Sha256 {
+		return errorutils.CheckErrorf("the sha256 of the artifact changed to %s", sha256)
+	}
+	return nil
+}
+
+// Adds the downloaded files to the cache, and evicts the least recently used files beyond its size. Failures are
+// logged, since the cache only speeds up later downloads.
+func addToDownloadCache(cache *downloadcache.Cache, checksums map[string]string, transferDetailsReader *content.ContentReader) {
+	for details := new(clientutils.FileTransferDetails); transferDetailsReader.NextRecord(details) == nil; details = new(clientutils.FileTransferDetails) {
+		sha256 := checksums[details.SourcePath]
+		if sha256 == "" || !fileutils.IsPathExists(details.TargetPath, false) {
+			continue
+		}
+		if err := cache.Add(sha256, details.TargetPath); err != nil {
+			log.Warn("Failed to add", details.TargetPath, "to the download cache:", err.Error())
+		}
+	}
+	transferDetailsReader.Reset()
+	if err := transferDetailsReader.GetError(); err != nil {
+		log.Warn("Failed to read the downloaded files:", err.Error())
+	}
+	if err := cache.Evict(); err != nil {
+		log.Warn("Failed to evict files from the download cache:", err.Error())
+	}
+}
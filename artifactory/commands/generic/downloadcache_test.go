@@ -0,0 +1,95 @@
+package generic
+
+import (
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/downloadcache"
+	"github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-core/v2/common/spec"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	serviceutils "github.com/jfrog/jfrog-client-go/artifactory/services/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDownloadWithCache(t *testing.T) {
+	const artifactContent = "artifact content"
+	md5Sum, sha1Sum, sha256Sum := md5.Sum([]byte(artifactContent)), sha1.Sum([]byte(artifactContent)), sha256.Sum256([]byte(artifactContent))
+	var mutex sync.Mutex
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		mutex.Unlock()
+		switch r.URL.Path {
+		case "/api/system/version":
+			_, _ = w.Write([]byte(`{"version": "7.90.0"}`))
+		case "/api/search/aql":
+			_, _ = fmt.Fprintf(w, `{"results": [{"repo": "libs-local", "path": "org/app", "name": "app.jar", "type": "file", "size": %d, "actual_md5": "%s", "actual_sha1": "%s", "sha256": "%s"}]}`,
+				len(artifactContent), hex.EncodeToString(md5Sum[:]), hex.EncodeToString(sha1Sum[:]), hex.EncodeToString(sha256Sum[:]))
+		case "/libs-local/org/app/app.jar":
+			w.Header().Set("X-Checksum-Sha256", hex.EncodeToString(sha256Sum[:]))
+			if r.Method == http.MethodGet {
+				_, _ = w.Write([]byte(artifactContent))
+			}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	cache := downloadcache.New(t.TempDir(), 1024)
+	download := func() string {
+		mutex.Lock()
+		requests = nil
+		mutex.Unlock()
+		target := t.TempDir()
+		downloadCmd := NewDownloadCommand().SetCache(cache)
+		downloadCmd.SetConfiguration(&utils.DownloadConfiguration{Threads: 1}).
+			SetSpec(&spec.SpecFiles{Files: []spec.File{{Pattern: "libs-local/org/*", Target: target + "/", Flat: "true"}}}).
+			SetServerDetails(&config.ServerDetails{ArtifactoryUrl: server.URL + "/"})
+		require.NoError(t, downloadCmd.Run())
+		assert.Equal(t, 1, downloadCmd.Result().SuccessCount())
+		downloaded, err := os.ReadFile(filepath.Join(target, "app.jar"))
+		require.NoError(t, err)
+		assert.Equal(t, artifactContent, string(downloaded))
+		return target
+	}
+
+	download()
+	assert.Contains(t, requests, "GET /libs-local/org/app/app.jar")
+	assert.True(t, cache.Contains(hex.EncodeToString(sha256Sum[:])))
+
+	download()
+	assert.Contains(t, requests, "HEAD /libs-local/org/app/app.jar")
+	assert.NotContains(t, requests, "GET /libs-local/org/app/app.jar", "the artifact is copied from the cache")
+}
+
+func TestGetDownloadLocalPath(t *testing.T) {
+	item := &serviceutils.ResultItem{Repo: "libs-local", Path: "org/app", Name: "app.jar", Type: "file"}
+	tests := []struct {
+		name     string
+		file     spec.File
+		expected string
+	}{
+		{"flat", spec.File{Pattern: "libs-local/org/*", Target: "out/", Flat: "true"}, filepath.Join("out", "app.jar")},
+		{"hierarchy", spec.File{Pattern: "libs-local/org/*", Target: "out/"}, filepath.Join("out", "org", "app", "app.jar")},
+		{"placeholder", spec.File{Pattern: "libs-local/org/(*)/app.jar", Target: "out/{1}.jar"}, filepath.Join("out", "app.jar")},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			localPath, err := getDownloadLocalPath(&test.file, item)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, localPath)
+		})
+	}
+}
@@ -0,0 +1,173 @@
+package generic
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/jfrog/jfrog-cli-core/v2/common/spec"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/jfrog/jfrog-client-go/artifactory/services"
+	serviceutils "github.com/jfrog/jfrog-client-go/artifactory/services/utils"
+	clientutils "github.com/jfrog/jfrog-client-go/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// The properties holding the version of the artifact, which package repositories set from the package metadata, in the
+// order in which they're looked up.
+var versionProperties = []string{"version", "npm.version", "pypi.version", "nuget.version", "helm.chart.version", "conan.package.version", "composer.version", "gem.version"}
+
+// A version in a file name, such as '1.2.3' in 'app-1.2.3.jar', or '1.2.3-rc1' in 'app-1.2.3-rc1.tgz'.
+var fileNameVersionRegexp = regexp.MustCompile(`\d+\.\d+(?:\.\d+)?(?:-[0-9A-Za-z]+)?`)
+
+// versionResolver selects, from the artifacts matching a spec file, the artifacts of the newest version, optionally
+// within a semver range.
+type versionResolver struct {
+	constraints *semver.Constraints
+}
+
+// The versions are selected within the version range, or among all the versions which aren't pre-releases if it isn't set.
+func newVersionResolver(versionRange string) (*versionResolver, error) {
+	if versionRange == "" {
+		versionRange = "*"
+	}
+	constraints, err := semver.NewConstraint(versionRange)
+	if err != nil {
+		return nil, errorutils.CheckErrorf("invalid version range '%s': %s", versionRange, err.Error())
+	}
+	return &versionResolver{constraints: constraints}, nil
+}
+
+// getArtifactVersion returns the version of the artifact, from its version property set by the package repository, or
+// from its repository layout: the deepest directory of its path which is a version, and otherwise its file name.
+// Returns nil if the artifact has no version.
+func getArtifactVersion(item *serviceutils.ResultItem) *semver.Version {
+	for _, key := range versionProperties {
+		for _, property := range item.Properties {
+			if property.Key != key {
+				continue
+			}
+			if version, err := semver.NewVersion(property.Value); err == nil {
+				return version
+			}
+		}
+	}
+	if item.Path != "." {
+		dirs := strings.Split(item.Path, "/")
+		for i := len(dirs) - 1; i >= 0; i-- {
+			if version, err := semver.NewVersion(dirs[i]); err == nil {
+				return version
+			}
+		}
+	}
+	if version, err := semver.NewVersion(fileNameVersionRegexp.FindString(item.Name)); err == nil {
+		return version
+	}
+	return nil
+}
+
+// selectVersion returns the artifacts of the newest version which is within the range, and the version.
+func (vr *versionResolver) selectVersion(items []serviceutils.ResultItem) ([]serviceutils.ResultItem, *semver.Version) {
+	var newest *semver.Version
+	versions := make([]*semver.Version, len(items))
+	for i := range items {
+		version := getArtifactVersion(&items[i])
+		if version == nil {
+			log.Debug("Skipping", items[i].GetItemRelativePath()+", whose version couldn't be determined")
+			continue
+		}
+		if !vr.constraints.Check(version) {
+			continue
+		}
+		versions[i] = version
+		if newest == nil || version.GreaterThan(newest) {
+			newest = version
+		}
+	}
+	var selected []serviceutils.ResultItem
+	for i, version := range versions {
+		if version != nil && version.Equal(newest) {
+			selected = append(selected, items[i])
+		}
+	}
+	return selected, newest
+}
+
+// Replaces each spec file by a file per artifact of the newest version it matches, within the version range if set.
+func (dc *DownloadCommand) resolveLatestVersions(servicesManager artifactory.ArtifactoryServicesManager) error {
+	resolver, err := newVersionResolver(dc.versionRange)
+	if err != nil {
+		return err
+	}
+	var files []spec.File
+	for _, file := range dc.Spec().Files {
+		latestFiles, err := resolver.expandLatestVersion(servicesManager, file)
+		if err != nil {
+			return err
+		}
+		files = append(files, latestFiles...)
+	}
+	dc.SetSpec(&spec.SpecFiles{Files: files})
+	return nil
+}
+
+func (vr *versionResolver) expandLatestVersion(servicesManager artifactory.ArtifactoryServicesManager, file spec.File) ([]spec.File, error) {
+	items, err := searchFileItems(servicesManager, file)
+	if err != nil {
+		return nil, err
+	}
+	selected, version := vr.selectVersion(items)
+	if version == nil {
+		return nil, errorutils.CheckErrorf("no version within the range '%s' was found among the artifacts matching '%s'", vr.constraints.String(), file.Pattern)
+	}
+	log.Info(fmt.Sprintf("Downloading version %s of the artifacts matching '%s'", version.Original(), file.Pattern))
+	files := make([]spec.File, 0, len(selected))
+	for _, item := range selected {
+		target, placeholdersUsed, err := clientutils.BuildTargetPath(file.Pattern, item.GetItemRelativePath(), file.Target, true)
+		if err != nil {
+			return nil, err
+		}
+		latestFile := templatedSpecFile(file, path.Join(item.Repo, item.Path, item.Name), target)
+		// Without placeholders, the path of the artifact is kept under the target as the original spec file keeps it.
+		if !placeholdersUsed {
+			latestFile.Flat = file.Flat
+		}
+		files = append(files, latestFile)
+	}
+	return files, nil
+}
+
+// Returns the artifacts matching the spec file, without its folders.
+func searchFileItems(servicesManager artifactory.ArtifactoryServicesManager, file spec.File) (items []serviceutils.ResultItem, err error) {
+	searchParams := services.NewSearchParams()
+	if searchParams.CommonParams, err = file.ToCommonParams(); err != nil {
+		return
+	}
+	if searchParams.Recursive, err = file.IsRecursive(true); err != nil {
+		return
+	}
+	if searchParams.ExcludeArtifacts, err = file.IsExcludeArtifacts(false); err != nil {
+		return
+	}
+	if searchParams.IncludeDeps, err = file.IsIncludeDeps(false); err != nil {
+		return
+	}
+	reader, err := servicesManager.SearchFiles(searchParams)
+	if err != nil {
+		return
+	}
+	defer func() {
+		err = errors.Join(err, reader.Close())
+	}()
+	for item := new(serviceutils.ResultItem); reader.NextRecord(item) == nil; item = new(serviceutils.ResultItem) {
+		if item.Type != "folder" {
+			items = append(items, *item)
+		}
+	}
+	err = reader.GetError()
+	return
+}
@@ -0,0 +1,84 @@
+package generic
+
+import (
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-core/v2/common/spec"
+	serviceutils "github.com/jfrog/jfrog-client-go/artifactory/services/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetArtifactVersion(t *testing.T) {
+	tests := []struct {
+		name     string
+		item     serviceutils.ResultItem
+		expected string
+	}{
+		{"property", serviceutils.ResultItem{Path: "app/latest", Name: "app.tgz", Properties: []serviceutils.Property{{Key: "npm.version", Value: "2.1.0"}}}, "2.1.0"},
+		{"layout directory", serviceutils.ResultItem{Path: "org/app/1.4.2/sub", Name: "app.jar"}, "1.4.2"},
+		{"file name", serviceutils.ResultItem{Path: "app/-", Name: "app-3.0.1-rc1.tgz"}, "3.0.1-rc1"},
+		{"root file name", serviceutils.ResultItem{Path: ".", Name: "tool-1.2.zip"}, "1.2.0"},
+		{"no version", serviceutils.ResultItem{Path: "docs", Name: "readme.md"}, ""},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			version := getArtifactVersion(&test.item)
+			if test.expected == "" {
+				assert.Nil(t, version)
+				return
+			}
+			require.NotNil(t, version)
+			assert.Equal(t, test.expected, version.String())
+		})
+	}
+}
+
+func TestResolveLatestVersions(t *testing.T) {
+	item := func(version, name string) serviceutils.ResultItem {
+		return serviceutils.ResultItem{Repo: "libs-local", Path: "org/app/" + version, Name: name, Type: "file"}
+	}
+	servicesManager := &searchServicesManager{items: []serviceutils.ResultItem{
+		item("1.2.0", "app-1.2.0.jar"), item("1.10.0", "app-1.10.0.jar"), item("1.10.0", "app-1.10.0.pom"),
+		item("2.0.0", "app-2.0.0.jar"), item("2.1.0-rc1", "app-2.1.0-rc1.jar"),
+		{Repo: "libs-local", Path: "org/app", Name: "maven-metadata.xml", Type: "file"},
+	}}
+	tests := []struct {
+		versionRange string
+		expected     []string
+	}{
+		{"", []string{"libs-local/org/app/2.0.0/app-2.0.0.jar"}},
+		{"^1.2", []string{"libs-local/org/app/1.10.0/app-1.10.0.jar", "libs-local/org/app/1.10.0/app-1.10.0.pom"}},
+		{">=1.0, <1.10", []string{"libs-local/org/app/1.2.0/app-1.2.0.jar"}},
+		{">=2.1.0-0", []string{"libs-local/org/app/2.1.0-rc1/app-2.1.0-rc1.jar"}},
+	}
+	for _, test := range tests {
+		t.Run(test.versionRange, func(t *testing.T) {
+			downloadCmd := NewDownloadCommand().SetLatest(true).SetVersionRange(test.versionRange)
+			downloadCmd.SetSpec(&spec.SpecFiles{Files: []spec.File{{Pattern: "libs-local/org/app/*", Target: "out/"}}})
+			require.NoError(t, downloadCmd.resolveLatestVersions(servicesManager))
+			var patterns []string
+			for _, file := range downloadCmd.Spec().Files {
+				patterns = append(patterns, file.Pattern)
+				assert.Equal(t, "out/", file.Target)
+				assert.Empty(t, file.Flat, "the artifact path should be kept under the target")
+			}
+			assert.Equal(t, test.expected, patterns)
+		})
+	}
+
+	downloadCmd := NewDownloadCommand().SetVersionRange("^3")
+	downloadCmd.SetSpec(&spec.SpecFiles{Files: []spec.File{{Pattern: "libs-local/org/app/*"}}})
+	assert.ErrorContains(t, downloadCmd.resolveLatestVersions(servicesManager), "no version within the range '^3'")
+
+	downloadCmd.SetVersionRange("not a range")
+	assert.ErrorContains(t, downloadCmd.resolveLatestVersions(servicesManager), "invalid version range")
+
+	// Placeholders are resolved by the version's artifact, whose path isn't kept under the target.
+	downloadCmd = NewDownloadCommand().SetLatest(true)
+	downloadCmd.SetSpec(&spec.SpecFiles{Files: []spec.File{{Pattern: "libs-local/org/app/(*)/*.jar", Target: "out/{1}/"}}})
+	require.NoError(t, downloadCmd.resolveLatestVersions(servicesManager))
+	require.Len(t, downloadCmd.Spec().Files, 1)
+	assert.Equal(t, "out/2.0.0/", downloadCmd.Spec().Files[0].Target)
+	assert.Equal(t, "true", downloadCmd.Spec().Files[0].Flat)
+}
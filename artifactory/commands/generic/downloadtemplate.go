@@ -0,0 +1,159 @@
+package generic
+
+import (
+	"errors"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/jfrog/jfrog-cli-core/v2/common/spec"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	serviceutils "github.com/jfrog/jfrog-client-go/artifactory/services/utils"
+	clientutils "github.com/jfrog/jfrog-client-go/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+)
+
+// TargetPropertyPrefix is the prefix of the download target placeholders which are replaced by the values of a property
+// of the artifact, such as {property.version}.
+const TargetPropertyPrefix = "property."
+
+// The download target placeholders which are replaced by the AQL fields of the artifact, or by its properties.
+var targetTemplateRegexp = regexp.MustCompile(`\{(property\.[^{}]+|repo|path|name|created|modified|updated|created_by|modified_by|sha256|actual_sha1|actual_md5|size|type)}`)
+
+// hasTargetTemplate returns whether the target has placeholders of the artifact fields or properties.
+func hasTargetTemplate(target string) bool {
+	return targetTemplateRegexp.MatchString(target)
+}
+
+// resolveTargetTemplate replaces the placeholders of the artifact fields and properties in the target by their values for
+// the artifact. A property with several values is replaced by its values, sorted and separated by commas.
+func resolveTargetTemplate(target string, item *serviceutils.ResultItem) (string, error) {
+	var errs []error
+	resolved := targetTemplateRegexp.ReplaceAllStringFunc(target, func(placeholder string) string {
+		name := strings.TrimSuffix(strings.TrimPrefix(placeholder, "{"), "}")
+		value, err := getTemplateValue(name, item)
+		if err == nil && isPathTraversal(value) {
+			err = errorutils.CheckErrorf("the value '%s' of %s of '%s' cannot be used in the target path", value, placeholder, item.GetItemRelativePath())
+		}
+		if err != nil {
+			errs = append(errs, err)
+		}
+		return value
+	})
+	return resolved, errors.Join(errs...)
+}
+
+func getTemplateValue(name string, item *serviceutils.ResultItem) (string, error) {
+	if key, isProperty := strings.CutPrefix(name, TargetPropertyPrefix); isProperty {
+		var values []string
+		for _, property := range item.Properties {
+			if property.Key == key {
+				values = append(values, property.Value)
+			}
+		}
+		if len(values) == 0 {
+			return "", errorutils.CheckErrorf("'%s' has no '%s' property, which the target path requires", item.GetItemRelativePath(), key)
+		}
+		sort.Strings(values)
+		return strings.Join(values, ","), nil
+	}
+	switch name {
+	case "repo":
+		return item.Repo, nil
+	case "path":
+		if item.Path == "." {
+			return "", nil
+		}
+		return item.Path, nil
+	case "name":
+		return item.Name, nil
+	case "created":
+		return item.Created, nil
+	case "modified":
+		return item.Modified, nil
+	case "updated":
+		return item.Updated, nil
+	case "created_by":
+		return item.CreatedBy, nil
+	case "modified_by":
+		return item.ModifiedBy, nil
+	case "sha256":
+		return item.Sha256, nil
+	case "actual_sha1":
+		return item.Actual_Sha1, nil
+	case "actual_md5":
+		return item.Actual_Md5, nil
+	case "size":
+		return strconv.FormatInt(item.Size, 10), nil
+	}
+	return item.Type, nil
+}
+
+// Values such as '..' would place the downloaded file outside the target directory.
+func isPathTraversal(value string) bool {
+	for _, element := range strings.FieldsFunc(value, func(r rune) bool { return r == '/' || r == '\\' }) {
+		if element == ".." {
+			return true
+		}
+	}
+	return false
+}
+
+// Replaces each spec file whose target has placeholders of the artifact fields or properties by a file per artifact it
+// matches, downloaded to the target resolved for the artifact. The placeholders of the pattern, such as {1}, are
+// replaced as the download replaces them.
+func (dc *DownloadCommand) expandTargetTemplates(servicesManager artifactory.ArtifactoryServicesManager) error {
+	expanded := false
+	files := make([]spec.File, 0, len(dc.Spec().Files))
+	for _, file := range dc.Spec().Files {
+		if !hasTargetTemplate(file.Target) {
+			files = append(files, file)
+			continue
+		}
+		expanded = true
+		templatedFiles, err := expandTargetTemplate(servicesManager, file)
+		if err != nil {
+			return err
+		}
+		files = append(files, templatedFiles...)
+	}
+	if expanded {
+		dc.SetSpec(&spec.SpecFiles{Files: files})
+	}
+	return nil
+}
+
+func expandTargetTemplate(servicesManager artifactory.ArtifactoryServicesManager, file spec.File) ([]spec.File, error) {
+	items, err := searchFileItems(servicesManager, file)
+	if err != nil {
+		return nil, err
+	}
+	files := make([]spec.File, 0, len(items))
+	for i := range items {
+		target, err := resolveTargetTemplate(file.Target, &items[i])
+		if err != nil {
+			return nil, err
+		}
+		if target, _, err = clientutils.BuildTargetPath(file.Pattern, items[i].GetItemRelativePath(), target, true); err != nil {
+			return nil, err
+		}
+		files = append(files, templatedSpecFile(file, path.Join(items[i].Repo, items[i].Path, items[i].Name), target))
+	}
+	return files, nil
+}
+
+// Returns a spec file which downloads the artifact to the resolved target, according to the download options of the
+// original spec file. As with the placeholders of the pattern, the path of the artifact isn't kept under the target.
+func templatedSpecFile(file spec.File, artifactPath, target string) spec.File {
+	return spec.File{
+		Pattern:                 artifactPath,
+		Target:                  target,
+		Flat:                    "true",
+		Recursive:               "false",
+		Explode:                 file.Explode,
+		BypassArchiveInspection: file.BypassArchiveInspection,
+		ValidateSymlinks:        file.ValidateSymlinks,
+	}
+}
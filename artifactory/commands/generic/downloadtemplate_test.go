@@ -0,0 +1,86 @@
+package generic
+
+import (
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-core/v2/common/spec"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/jfrog/jfrog-client-go/artifactory/services"
+	serviceutils "github.com/jfrog/jfrog-client-go/artifactory/services/utils"
+	"github.com/jfrog/jfrog-client-go/utils/io/content"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// searchServicesManager returns the items as the result of any search.
+type searchServicesManager struct {
+	artifactory.EmptyArtifactoryServicesManager
+	items []serviceutils.ResultItem
+}
+
+func (ssm *searchServicesManager) SearchFiles(services.SearchParams) (*content.ContentReader, error) {
+	writer, err := content.NewContentWriter(content.DefaultKey, true, false)
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range ssm.items {
+		writer.Write(item)
+	}
+	if err = writer.Close(); err != nil {
+		return nil, err
+	}
+	return content.NewContentReader(writer.GetFilePath(), content.DefaultKey), nil
+}
+
+func TestResolveTargetTemplate(t *testing.T) {
+	item := &serviceutils.ResultItem{Repo: "libs-local", Path: "org/app", Name: "app.jar", Size: 42, Sha256: "abc",
+		Properties: []serviceutils.Property{{Key: "version", Value: "1.2.0"}, {Key: "os", Value: "linux"}, {Key: "os", Value: "darwin"}}}
+	tests := []struct {
+		target   string
+		expected string
+	}{
+		{"out/{property.version}/{name}", "out/1.2.0/app.jar"},
+		{"{repo}/{path}/{size}-{sha256}", "libs-local/org/app/42-abc"},
+		{"{property.os}/", "darwin,linux/"},
+		{"out/{1}/{unknown}", "out/{1}/{unknown}"},
+	}
+	for _, test := range tests {
+		t.Run(test.target, func(t *testing.T) {
+			target, err := resolveTargetTemplate(test.target, item)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, target)
+		})
+	}
+
+	_, err := resolveTargetTemplate("{property.missing}/{property.other}/", item)
+	assert.ErrorContains(t, err, "no 'missing' property")
+	assert.ErrorContains(t, err, "no 'other' property")
+
+	item.Properties = []serviceutils.Property{{Key: "version", Value: "../../etc"}}
+	_, err = resolveTargetTemplate("{property.version}/{name}", item)
+	assert.ErrorContains(t, err, "cannot be used in the target path")
+
+	assert.True(t, hasTargetTemplate("{property.version}/"))
+	assert.False(t, hasTargetTemplate("out/{1}/"))
+}
+
+func TestExpandTargetTemplates(t *testing.T) {
+	servicesManager := &searchServicesManager{items: []serviceutils.ResultItem{
+		{Repo: "libs-local", Path: "org/a", Name: "a.jar", Type: "file", Properties: []serviceutils.Property{{Key: "version", Value: "1.0"}}},
+		{Repo: "libs-local", Path: "org", Name: "a", Type: "folder"},
+		{Repo: "libs-local", Path: "org/b", Name: "b.jar", Type: "file", Properties: []serviceutils.Property{{Key: "version", Value: "2.0"}}},
+	}}
+	downloadCmd := NewDownloadCommand()
+	downloadCmd.SetSpec(&spec.SpecFiles{Files: []spec.File{
+		{Pattern: "libs-local/org/(*)/*.jar", Target: "out/{1}/{property.version}/{name}", Explode: "true"},
+		{Pattern: "libs-local/other/*", Target: "other/"},
+	}})
+	require.NoError(t, downloadCmd.expandTargetTemplates(servicesManager))
+
+	files := downloadCmd.Spec().Files
+	require.Len(t, files, 3)
+	assert.Equal(t, spec.File{Pattern: "libs-local/org/a/a.jar", Target: "out/a/1.0/a.jar", Flat: "true", Recursive: "false", Explode: "true"}, files[0])
+	assert.Equal(t, "libs-local/org/b/b.jar", files[1].Pattern)
+	assert.Equal(t, "out/b/2.0/b.jar", files[1].Target)
+	assert.Equal(t, spec.File{Pattern: "libs-local/other/*", Target: "other/"}, files[2])
+}
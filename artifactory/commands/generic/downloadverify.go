@@ -0,0 +1,216 @@
+package generic
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/jfrog/gofrog/crypto"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/formats"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	clientutils "github.com/jfrog/jfrog-client-go/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/io/content"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// VerifyAlgorithm is the checksum algorithm by which the downloaded files are verified against their checksums in
+// Artifactory.
+type VerifyAlgorithm string
+
+const (
+	VerifySha256 VerifyAlgorithm = "sha256"
+	VerifySha1   VerifyAlgorithm = "sha1"
+	VerifyMd5    VerifyAlgorithm = "md5"
+	VerifyNone   VerifyAlgorithm = "none"
+)
+
+// VerifyFailurePolicy determines what is done with a downloaded file whose checksum doesn't match its checksum in
+// Artifactory.
+type VerifyFailurePolicy string
+
+const (
+	// The download fails.
+	FailOnMismatch VerifyFailurePolicy = "fail"
+	// The file is downloaded again, up to the number of verify retries, and the download fails if it still mismatches.
+	RetryOnMismatch VerifyFailurePolicy = "retry"
+	// The file is renamed with the quarantine suffix, so that it isn't consumed, and the download fails.
+	QuarantineOnMismatch VerifyFailurePolicy = "quarantine"
+
+	QuarantineSuffix = ".quarantine"
+	// The default number of times a mismatching file is downloaded again with the retry policy.
+	DefaultVerifyRetries = 3
+)
+
+func ParseVerifyAlgorithm(algorithm string) (VerifyAlgorithm, error) {
+	switch VerifyAlgorithm(strings.ToLower(algorithm)) {
+	case VerifySha256, VerifySha1, VerifyMd5, VerifyNone:
+		return VerifyAlgorithm(strings.ToLower(algorithm)), nil
+	}
+	return "", errorutils.CheckErrorf("invalid verification algorithm '%s'. The algorithm should be one of: %s, %s, %s, %s", algorithm, VerifySha256, VerifySha1, VerifyMd5, VerifyNone)
+}
+
+func ParseVerifyFailurePolicy(policy string) (VerifyFailurePolicy, error) {
+	switch VerifyFailurePolicy(strings.ToLower(policy)) {
+	case FailOnMismatch, RetryOnMismatch, QuarantineOnMismatch:
+		return VerifyFailurePolicy(strings.ToLower(policy)), nil
+	}
+	return "", errorutils.CheckErrorf("invalid verification failure policy '%s'. The policy should be one of: %s, %s, %s", policy, FailOnMismatch, RetryOnMismatch, QuarantineOnMismatch)
+}
+
+func (va VerifyAlgorithm) cryptoAlgorithm() crypto.Algorithm {
+	switch va {
+	case VerifySha1:
+		return crypto.SHA1
+	case VerifyMd5:
+		return crypto.MD5
+	}
+	return crypto.SHA256
+}
+
+// downloadVerifier verifies the downloaded files by the checksums of the artifacts in Artifactory, which are read by
+// the storage API, and applies the failure policy to the files which mismatch.
+type downloadVerifier struct {
+	servicesManager artifactory.ArtifactoryServicesManager
+	algorithm       VerifyAlgorithm
+	policy          VerifyFailurePolicy
+	retries         int
+	result          *formats.DownloadVerification
+}
+
+func newDownloadVerifier(servicesManager artifactory.ArtifactoryServicesManager, algorithm VerifyAlgorithm, policy VerifyFailurePolicy, retries int) *downloadVerifier {
+	return &downloadVerifier{
+		servicesManager: servicesManager,
+		algorithm:       algorithm,
+		policy:          policy,
+		retries:         retries,
+		result:          &formats.DownloadVerification{Algorithm: string(algorithm)},
+	}
+}
+
+// verify verifies each downloaded file, and resets the reader for further use. Files which were downloaded as
+// symbolic links, or extracted and removed, are skipped. An error is returned if any file mismatches after the policy
+// was applied.
+func (dv *downloadVerifier) verify(transferDetailsReader *content.ContentReader) error {
+	if transferDetailsReader == nil {
+		return nil
+	}
+	for details := new(clientutils.FileTransferDetails); transferDetailsReader.NextRecord(details) == nil; details = new(clientutils.FileTransferDetails) {
+		if err := dv.verifyFile(details.SourcePath, details.TargetPath); err != nil {
+			return err
+		}
+	}
+	transferDetailsReader.Reset()
+	if err := transferDetailsReader.GetError(); err != nil {
+		return err
+	}
+	if len(dv.result.Mismatched) > 0 {
+		return errorutils.CheckErrorf("the %s checksums of %d downloaded files don't match their checksums in Artifactory: %s",
+			dv.algorithm, len(dv.result.Mismatched), strings.Join(dv.result.Mismatched, ", "))
+	}
+	return nil
+}
+
+func (dv *downloadVerifier) verifyFile(repoPath, localPath string) error {
+	if fileInfo, err := os.Lstat(localPath); err != nil || !fileInfo.Mode().IsRegular() {
+		log.Debug("Skipping the verification of", localPath+", which isn't a downloaded file")
+		dv.result.Skipped++
+		return nil
+	}
+	expected, err := dv.getExpectedChecksum(repoPath)
+	if err != nil {
+		return err
+	}
+	if expected == "" {
+		log.Warn(fmt.Sprintf("Artifactory has no %s checksum of '%s', so '%s' wasn't verified", dv.algorithm, repoPath, localPath))
+		dv.result.Skipped++
+		return nil
+	}
+	matches, err := dv.matches(localPath, expected)
+	if err != nil || matches {
+		if matches {
+			dv.result.Verified++
+		}
+		return err
+	}
+	log.Warn(fmt.Sprintf("The %s checksum of '%s' doesn't match the checksum of '%s' in Artifactory", dv.algorithm, localPath, repoPath))
+	switch dv.policy {
+	case RetryOnMismatch:
+		return dv.redownload(repoPath, localPath, expected)
+	case QuarantineOnMismatch:
+		log.Warn("Quarantining", localPath, "as", localPath+QuarantineSuffix)
+		if err = os.Rename(localPath, localPath+QuarantineSuffix); err != nil {
+			return errorutils.CheckError(err)
+		}
+		dv.result.Quarantined = append(dv.result.Quarantined, localPath)
+	}
+	dv.result.Mismatched = append(dv.result.Mismatched, localPath)
+	return nil
+}
+
+func (dv *downloadVerifier) getExpectedChecksum(repoPath string) (string, error) {
+	fileInfo, err := dv.servicesManager.FileInfo(repoPath)
+	if err != nil {
+		return "", err
+	}
+	switch dv.algorithm {
+	case VerifySha1:
+		return fileInfo.Checksums.Sha1, nil
+	case VerifyMd5:
+		return fileInfo.Checksums.Md5, nil
+	}
+	return fileInfo.Checksums.Sha256, nil
+}
+
+func (dv *downloadVerifier) matches(localPath, expected string) (bool, error) {
+	checksums, err := crypto.GetFileChecksums(localPath, dv.algorithm.cryptoAlgorithm())
+	if err != nil {
+		return false, errorutils.CheckError(err)
+	}
+	return strings.EqualFold(checksums[dv.algorithm.cryptoAlgorithm()], expected), nil
+}
+
+// redownload downloads the artifact again into a temp file next to the local file, which replaces the local file
+// once its checksum matches.
+func (dv *downloadVerifier) redownload(repoPath, localPath, expected string) error {
+	for attempt := 1; attempt <= dv.retries; attempt++ {
+		log.Info(fmt.Sprintf("Downloading '%s' again (attempt %d/%d)", repoPath, attempt, dv.retries))
+		tempPath, err := dv.downloadToTempFile(repoPath, filepath.Dir(localPath))
+		if err != nil {
+			return err
+		}
+		matches, err := dv.matches(tempPath, expected)
+		if err == nil && matches {
+			dv.result.Redownloaded++
+			dv.result.Verified++
+			return errorutils.CheckError(os.Rename(tempPath, localPath))
+		}
+		if err = errors.Join(err, os.Remove(tempPath)); err != nil {
+			return errorutils.CheckError(err)
+		}
+	}
+	dv.result.Mismatched = append(dv.result.Mismatched, localPath)
+	return nil
+}
+
+func (dv *downloadVerifier) downloadToTempFile(repoPath, dir string) (tempPath string, err error) {
+	remoteFile, err := dv.servicesManager.ReadRemoteFile(repoPath)
+	if err != nil {
+		return "", err
+	}
+	defer func() {
+		err = errors.Join(err, errorutils.CheckError(remoteFile.Close()))
+	}()
+	tempFile, err := os.CreateTemp(dir, ".jfrog-verify-*")
+	if err != nil {
+		return "", errorutils.CheckError(err)
+	}
+	_, err = io.Copy(tempFile, remoteFile)
+	if err = errors.Join(err, tempFile.Close()); err != nil {
+		return "", errorutils.CheckError(errors.Join(err, os.Remove(tempFile.Name())))
+	}
+	return tempFile.Name(), nil
+}
@@ -0,0 +1,139 @@
+package generic
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	serviceutils "github.com/jfrog/jfrog-client-go/artifactory/services/utils"
+	clientutils "github.com/jfrog/jfrog-client-go/utils"
+	"github.com/jfrog/jfrog-client-go/utils/io/content"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// verifyServicesManager serves the content of the artifacts, and their checksums computed from it.
+type verifyServicesManager struct {
+	artifactory.EmptyArtifactoryServicesManager
+	artifacts map[string]string
+	reads     int
+}
+
+func (vsm *verifyServicesManager) FileInfo(relativePath string) (*serviceutils.FileInfo, error) {
+	fileInfo := &serviceutils.FileInfo{}
+	if artifact, found := vsm.artifacts[relativePath]; found && artifact != "" {
+		checksum := sha256.Sum256([]byte(artifact))
+		fileInfo.Checksums.Sha256 = hex.EncodeToString(checksum[:])
+	}
+	return fileInfo, nil
+}
+
+func (vsm *verifyServicesManager) ReadRemoteFile(readPath string) (io.ReadCloser, error) {
+	vsm.reads++
+	return io.NopCloser(strings.NewReader(vsm.artifacts[readPath])), nil
+}
+
+func createTransferDetailsReader(t *testing.T, transfers ...clientutils.FileTransferDetails) *content.ContentReader {
+	writer, err := content.NewContentWriter(content.DefaultKey, true, false)
+	require.NoError(t, err)
+	for _, transfer := range transfers {
+		writer.Write(transfer)
+	}
+	require.NoError(t, writer.Close())
+	reader := content.NewContentReader(writer.GetFilePath(), content.DefaultKey)
+	t.Cleanup(func() {
+		assert.NoError(t, reader.Close())
+	})
+	return reader
+}
+
+func TestDownloadVerifier(t *testing.T) {
+	servicesManager := &verifyServicesManager{artifacts: map[string]string{
+		"repo/good.txt": "good", "repo/bad.txt": "expected", "repo/unknown.txt": "",
+	}}
+	tests := []struct {
+		policy       VerifyFailurePolicy
+		expectedErr  bool
+		expectedBad  string
+		quarantined  bool
+		redownloaded int
+	}{
+		{policy: FailOnMismatch, expectedErr: true, expectedBad: "corrupted"},
+		{policy: RetryOnMismatch, expectedBad: "expected", redownloaded: 1},
+		{policy: QuarantineOnMismatch, expectedErr: true, quarantined: true},
+	}
+	for _, test := range tests {
+		t.Run(string(test.policy), func(t *testing.T) {
+			dir := t.TempDir()
+			require.NoError(t, os.WriteFile(filepath.Join(dir, "good.txt"), []byte("good"), 0600))
+			require.NoError(t, os.WriteFile(filepath.Join(dir, "bad.txt"), []byte("corrupted"), 0600))
+			require.NoError(t, os.WriteFile(filepath.Join(dir, "unknown.txt"), []byte("unknown"), 0600))
+			reader := createTransferDetailsReader(t,
+				clientutils.FileTransferDetails{SourcePath: "repo/good.txt", TargetPath: filepath.Join(dir, "good.txt")},
+				clientutils.FileTransferDetails{SourcePath: "repo/bad.txt", TargetPath: filepath.Join(dir, "bad.txt")},
+				clientutils.FileTransferDetails{SourcePath: "repo/unknown.txt", TargetPath: filepath.Join(dir, "unknown.txt")},
+				clientutils.FileTransferDetails{SourcePath: "repo/exploded.zip", TargetPath: filepath.Join(dir, "exploded.zip")})
+
+			verifier := newDownloadVerifier(servicesManager, VerifySha256, test.policy, 2)
+			err := verifier.verify(reader)
+			if test.expectedErr {
+				assert.ErrorContains(t, err, "don't match their checksums in Artifactory")
+				assert.Equal(t, []string{filepath.Join(dir, "bad.txt")}, verifier.result.Mismatched)
+			} else {
+				assert.NoError(t, err)
+				assert.Empty(t, verifier.result.Mismatched)
+			}
+			assert.Equal(t, 1+test.redownloaded, verifier.result.Verified)
+			assert.Equal(t, test.redownloaded, verifier.result.Redownloaded)
+			assert.Equal(t, 2, verifier.result.Skipped)
+
+			if test.quarantined {
+				assert.NoFileExists(t, filepath.Join(dir, "bad.txt"))
+				assert.FileExists(t, filepath.Join(dir, "bad.txt"+QuarantineSuffix))
+				assert.Equal(t, []string{filepath.Join(dir, "bad.txt")}, verifier.result.Quarantined)
+				return
+			}
+			content, err := os.ReadFile(filepath.Join(dir, "bad.txt"))
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedBad, string(content))
+		})
+	}
+}
+
+func TestDownloadVerifierRetriesExhausted(t *testing.T) {
+	servicesManager := &verifyServicesManager{artifacts: map[string]string{"repo/bad.txt": "expected"}}
+	// The checksum of the artifact is of other content than it serves.
+	fileInfo, err := servicesManager.FileInfo("repo/bad.txt")
+	require.NoError(t, err)
+	servicesManager.artifacts["repo/bad.txt"] = "still corrupted"
+	dir := t.TempDir()
+	localPath := filepath.Join(dir, "bad.txt")
+	require.NoError(t, os.WriteFile(localPath, []byte("corrupted"), 0600))
+
+	verifier := newDownloadVerifier(servicesManager, VerifySha256, RetryOnMismatch, 2)
+	assert.NoError(t, verifier.redownload("repo/bad.txt", localPath, fileInfo.Checksums.Sha256))
+	assert.Equal(t, 2, servicesManager.reads)
+	assert.Equal(t, []string{localPath}, verifier.result.Mismatched)
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Len(t, entries, 1, "the temp files of the downloads should be removed")
+}
+
+func TestParseVerifyOptions(t *testing.T) {
+	algorithm, err := ParseVerifyAlgorithm("SHA1")
+	assert.NoError(t, err)
+	assert.Equal(t, VerifySha1, algorithm)
+	_, err = ParseVerifyAlgorithm("crc32")
+	assert.ErrorContains(t, err, "invalid verification algorithm")
+
+	policy, err := ParseVerifyFailurePolicy("quarantine")
+	assert.NoError(t, err)
+	assert.Equal(t, QuarantineOnMismatch, policy)
+	_, err = ParseVerifyFailurePolicy("ignore")
+	assert.ErrorContains(t, err, "invalid verification failure policy")
+}
@@ -0,0 +1,165 @@
+package generic
+
+import (
+	"path/filepath"
+	"strconv"
+
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/encryption"
+	"github.com/jfrog/jfrog-cli-core/v2/common/spec"
+	"github.com/jfrog/jfrog-client-go/artifactory/services/fspatterns"
+	clientutils "github.com/jfrog/jfrog-client-go/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/io/content"
+	"github.com/jfrog/jfrog-client-go/utils/io/fileutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// Encrypts the files uploaded by each spec file into a temp directory, and returns a spec which uploads the encrypted
+// files to the target paths of the original files. The target paths are resolved by a dry run of the upload, so that
+// they are resolved the same way as the upload resolves them.
+func stageEncryptedFiles(uploadCmd *UploadCommand, envelope *encryption.Envelope) (stagedSpec *spec.SpecFiles, tmpRoot string, err error) {
+	tmpRoot, err = fileutils.CreateTempDir()
+	if err != nil {
+		return
+	}
+	stagedSpec = new(spec.SpecFiles)
+	for i, file := range uploadCmd.Spec().Files {
+		if file.Archive != "" {
+			return nil, tmpRoot, errorutils.CheckErrorf("archives cannot be uploaded encrypted")
+		}
+		var transfers []clientutils.FileTransferDetails
+		if transfers, err = dryRunUpload(uploadCmd, &spec.SpecFiles{Files: []spec.File{file}}); err != nil {
+			return
+		}
+		for j, transfer := range transfers {
+			// Each file is staged in its own directory, so that files with the same name don't collide.
+			stagedPath := filepath.Join(tmpRoot, strconv.Itoa(i), strconv.Itoa(j), filepath.Base(transfer.SourcePath))
+			log.Debug("Encrypting", transfer.SourcePath)
+			if err = envelope.EncryptFile(transfer.SourcePath, stagedPath); err != nil {
+				return
+			}
+			stagedSpec.Files = append(stagedSpec.Files, stagedSpecFile(file, stagedPath, transfer.TargetPath))
+		}
+	}
+	return
+}
+
+// Returns a spec file which uploads the staged file to the target path, with the properties of the original spec file.
+func stagedSpecFile(file spec.File, stagedPath, targetPath string) spec.File {
+	file.Pattern = stagedPath
+	file.Target = targetPath
+	file.Flat = "true"
+	file.Recursive = "false"
+	file.Regexp = "false"
+	file.Ant = "false"
+	file.Exclusions = nil
+	file.TargetPathInArchive = ""
+	return file
+}
+
+// Returns the paths of the local files matching the pattern of the spec file, the same way as the upload matches them.
+func matchLocalFiles(file *spec.File) ([]string, error) {
+	matches, err := findLocalFiles(file, listLocalFiles)
+	if err != nil {
+		return nil, err
+	}
+	var paths []string
+	for _, match := range matches {
+		paths = append(paths, match.path)
+	}
+	return paths, nil
+}
+
+// localFileMatch is a local file matching the pattern of a spec file, with the values of the pattern's placeholders.
+type localFileMatch struct {
+	path   string
+	groups []string
+}
+
+// localFilesLister lists the files under the root path, except for the files matching the exclude path pattern.
+type localFilesLister func(rootPath string, isRecursive bool, excludePathPattern string) ([]string, error)
+
+func listLocalFiles(rootPath string, isRecursive bool, excludePathPattern string) ([]string, error) {
+	return fspatterns.ListFiles(rootPath, isRecursive, false, false, false, excludePathPattern)
+}
+
+// Returns the local files matching the pattern of the spec file, from the files listed by listFiles.
+func findLocalFiles(file *spec.File, listFiles localFilesLister) ([]localFileMatch, error) {
+	isAnt, err := file.IsAnt(false)
+	if err != nil {
+		return nil, err
+	}
+	isRegexp, err := file.IsRegexp(false)
+	if err != nil {
+		return nil, err
+	}
+	isRecursive, err := file.IsRecursive(true)
+	if err != nil {
+		return nil, err
+	}
+	patternType := clientutils.GetPatternType(clientutils.PatternTypes{RegExp: isRegexp, Ant: isAnt})
+	pattern := clientutils.ReplaceTildeWithUserHome(file.Pattern)
+	rootPath, err := fspatterns.GetRootPath(pattern, file.Target, file.TargetPathInArchive, patternType, false)
+	if err != nil {
+		return nil, err
+	}
+	isDir, err := fileutils.IsDirExists(rootPath, false)
+	if err != nil {
+		return nil, err
+	}
+	if !isDir {
+		return []localFileMatch{{path: rootPath}}, nil
+	}
+
+	// Parentheses which aren't placeholders are escaped, as the upload escapes them.
+	if isAnt {
+		pattern = clientutils.ConvertLocalPatternToRegexp(clientutils.AddEscapingParentheses(pattern, file.Target, file.TargetPathInArchive), patternType)
+	} else {
+		pattern = clientutils.ConvertLocalPatternToRegexp(pattern, patternType)
+		if !isRegexp {
+			pattern = clientutils.AddEscapingParentheses(pattern, file.Target, file.TargetPathInArchive)
+		}
+	}
+	patternRegExp, err := clientutils.GetRegExp(pattern)
+	if err != nil {
+		return nil, err
+	}
+	excludePathPattern := fspatterns.PrepareExcludePathPattern(file.Exclusions, patternType, isRecursive)
+	paths, err := listFiles(rootPath, isRecursive, excludePathPattern)
+	if err != nil {
+		return nil, err
+	}
+	var localFiles []localFileMatch
+	for _, path := range paths {
+		matches, isDir, err := fspatterns.SearchPatterns(path, false, false, patternRegExp)
+		if err != nil {
+			return nil, err
+		}
+		if len(matches) > 0 && !isDir {
+			localFiles = append(localFiles, localFileMatch{path: path, groups: matches})
+		}
+	}
+	return localFiles, nil
+}
+
+// Decrypts the downloaded files which were encrypted on upload, and resets the reader for further use.
+func decryptDownloadedFiles(transferDetailsReader *content.ContentReader, keyProvider encryption.KeyProvider) error {
+	if transferDetailsReader == nil {
+		return nil
+	}
+	for details := new(clientutils.FileTransferDetails); transferDetailsReader.NextRecord(details) == nil; details = new(clientutils.FileTransferDetails) {
+		isEncrypted, err := encryption.IsEncryptedFile(details.TargetPath)
+		if err != nil {
+			return err
+		}
+		if !isEncrypted {
+			continue
+		}
+		log.Debug("Decrypting", details.TargetPath)
+		if err = encryption.DecryptFile(details.TargetPath, keyProvider); err != nil {
+			return err
+		}
+	}
+	transferDetailsReader.Reset()
+	return transferDetailsReader.GetError()
+}
@@ -0,0 +1,95 @@
+package generic
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/encryption"
+	"github.com/jfrog/jfrog-cli-core/v2/common/spec"
+	"github.com/jfrog/jfrog-client-go/utils/io/fileutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createLocalFiles(t *testing.T, root string, paths ...string) {
+	for _, path := range paths {
+		localPath := filepath.Join(root, filepath.FromSlash(path))
+		require.NoError(t, os.MkdirAll(filepath.Dir(localPath), 0700))
+		require.NoError(t, os.WriteFile(localPath, []byte(path), 0600))
+	}
+}
+
+func TestMatchLocalFiles(t *testing.T) {
+	root := t.TempDir()
+	createLocalFiles(t, root, "build/a.zip", "build/libs/b.zip", "build/libs/c.jar", "other/d.zip")
+	pattern := filepath.ToSlash(root) + "/"
+	inRoot := func(paths ...string) (localPaths []string) {
+		for _, path := range paths {
+			localPaths = append(localPaths, filepath.Join(root, filepath.FromSlash(path)))
+		}
+		return
+	}
+
+	testCases := []struct {
+		name     string
+		file     spec.File
+		expected []string
+	}{
+		{"wildcard", spec.File{Pattern: pattern + "build/*.zip"}, inRoot("build/a.zip", "build/libs/b.zip")},
+		{"not recursive", spec.File{Pattern: pattern + "build/*.zip", Recursive: "false"}, inRoot("build/a.zip")},
+		{"exclusions", spec.File{Pattern: pattern + "*.zip", Exclusions: []string{"*other*"}}, inRoot("build/a.zip", "build/libs/b.zip")},
+		{"ant", spec.File{Pattern: pattern + "**/libs/*", Ant: "true"}, inRoot("build/libs/b.zip", "build/libs/c.jar")},
+		{"regexp", spec.File{Pattern: pattern + `(.*)/[a-c]\.zip`, Regexp: "true"}, inRoot("build/a.zip", "build/libs/b.zip")},
+		{"single file", spec.File{Pattern: pattern + "other/d.zip"}, inRoot("other/d.zip")},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			paths, err := matchLocalFiles(&testCase.file)
+			require.NoError(t, err)
+			assert.ElementsMatch(t, testCase.expected, paths)
+		})
+	}
+}
+
+func TestStageEncryptedFiles(t *testing.T) {
+	artifactory := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Fail(t, "no request should be sent while staging", r.Method+" "+r.URL.Path)
+	}))
+	defer artifactory.Close()
+	root := t.TempDir()
+	createLocalFiles(t, root, "a/app.bin", "b/app.bin")
+	keyPath := filepath.Join(t.TempDir(), "key")
+	require.NoError(t, os.WriteFile(keyPath, bytes.Repeat([]byte{1}, encryption.DataKeySize), 0600))
+	keyProvider, err := encryption.NewLocalKeyProvider(keyPath)
+	require.NoError(t, err)
+	envelope, err := encryption.NewEnvelope(keyProvider)
+	require.NoError(t, err)
+
+	uploadCmd := newTestUploadCommand(t, artifactory.URL)
+	uploadCmd.SetSpec(spec.NewBuilder().Pattern(filepath.ToSlash(root) + "/(*)/app.bin").Target("libs-local/{1}/app.bin").Recursive(true).Props("a=b").BuildSpec())
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+	stagedSpec, tmpRoot, err := stageEncryptedFiles(uploadCmd, envelope)
+	defer func() {
+		assert.NoError(t, fileutils.RemoveTempDir(tmpRoot))
+	}()
+	require.NoError(t, err)
+
+	// The working directory is kept, and the staged files are uploaded to the targets of the original files.
+	currentWd, err := os.Getwd()
+	require.NoError(t, err)
+	assert.Equal(t, wd, currentWd)
+	var targets []string
+	for _, file := range stagedSpec.Files {
+		targets = append(targets, file.Target)
+		assert.Equal(t, "a=b", file.Props)
+		isEncrypted, err := encryption.IsEncryptedFile(file.Pattern)
+		require.NoError(t, err)
+		assert.True(t, isEncrypted)
+	}
+	assert.ElementsMatch(t, []string{"libs-local/a/app.bin", "libs-local/b/app.bin"}, targets)
+}
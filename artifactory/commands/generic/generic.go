@@ -1,9 +1,15 @@
 package generic
 
 import (
+	artifactoryutils "github.com/jfrog/jfrog-cli-artifactory/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/callbacks"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/concurrency"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/ratelimit"
 	commandsutils "github.com/jfrog/jfrog-cli-core/v2/artifactory/commands/utils"
 	"github.com/jfrog/jfrog-cli-core/v2/common/spec"
 	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	ioUtils "github.com/jfrog/jfrog-client-go/utils/io"
 )
 
 type GenericCommand struct {
@@ -17,6 +23,13 @@ type GenericCommand struct {
 	retries                int
 	retryWaitTimeMilliSecs int
 	aqlInclude             []string
+	callbacks              *callbacks.TransferCallbacks
+	rateLimit              int64
+	globalRateLimit        int64
+	adaptiveThreads        bool
+	minThreads             int
+	projectKey             string
+	rawAql                 string
 }
 
 func NewGenericCommand() *GenericCommand {
@@ -103,3 +116,122 @@ func (gc *GenericCommand) SetAqlInclude(include []string) *GenericCommand {
 	gc.aqlInclude = include
 	return gc
 }
+
+func (gc *GenericCommand) Callbacks() *callbacks.TransferCallbacks {
+	return gc.callbacks
+}
+
+// SetCallbacks sets hooks that report the progress of the command to programs embedding it.
+func (gc *GenericCommand) SetCallbacks(transferCallbacks *callbacks.TransferCallbacks) *GenericCommand {
+	gc.callbacks = transferCallbacks
+	return gc
+}
+
+// SetProgressListener reports the transfer events of the command to the listener. Replaces any callbacks which were set.
+func (gc *GenericCommand) SetProgressListener(listener callbacks.TransferProgressListener) *GenericCommand {
+	gc.callbacks = callbacks.NewListenerCallbacks(listener)
+	return gc
+}
+
+func (gc *GenericCommand) RateLimit() int64 {
+	return gc.rateLimit
+}
+
+// SetRateLimit limits the transfer rate of each file to rateLimit bytes per second. Zero means no limit.
+func (gc *GenericCommand) SetRateLimit(rateLimit int64) *GenericCommand {
+	gc.rateLimit = rateLimit
+	return gc
+}
+
+func (gc *GenericCommand) GlobalRateLimit() int64 {
+	return gc.globalRateLimit
+}
+
+// SetGlobalRateLimit limits the total transfer rate of all concurrent transfers to globalRateLimit bytes per second. Zero means no limit.
+func (gc *GenericCommand) SetGlobalRateLimit(globalRateLimit int64) *GenericCommand {
+	gc.globalRateLimit = globalRateLimit
+	return gc
+}
+
+func (gc *GenericCommand) AdaptiveThreads() bool {
+	return gc.adaptiveThreads
+}
+
+// SetAdaptiveThreads makes the number of concurrent requests adapt to the load of the server, between the minimum
+// threads and the threads of the command, instead of being fixed.
+func (gc *GenericCommand) SetAdaptiveThreads(adaptiveThreads bool) *GenericCommand {
+	gc.adaptiveThreads = adaptiveThreads
+	return gc
+}
+
+func (gc *GenericCommand) MinThreads() int {
+	return gc.minThreads
+}
+
+// SetMinThreads sets the number of concurrent requests which adaptive threads start from, and never drop below.
+func (gc *GenericCommand) SetMinThreads(minThreads int) *GenericCommand {
+	gc.minThreads = minThreads
+	return gc
+}
+
+func (gc *GenericCommand) ProjectKey() string {
+	return gc.projectKey
+}
+
+// SetProjectKey sets the project whose repositories are used. Logical repository names in the spec are translated to
+// the physical names of the project's repositories, which are prefixed by the project key.
+func (gc *GenericCommand) SetProjectKey(projectKey string) *GenericCommand {
+	gc.projectKey = projectKey
+	return gc
+}
+
+func (gc *GenericCommand) RawAql() string {
+	return gc.rawAql
+}
+
+// SetRawAql sets an AQL query of the items domain, whose results are used instead of the spec's patterns.
+func (gc *GenericCommand) SetRawAql(rawAql string) *GenericCommand {
+	gc.rawAql = rawAql
+	return gc
+}
+
+// Translates the repositories of the spec's patterns, or of its targets, to the physical names of the project's repositories.
+func (gc *GenericCommand) resolveProjectRepos(servicesManager artifactory.ArtifactoryServicesManager, targets bool) error {
+	if gc.projectKey == "" || gc.spec == nil {
+		return nil
+	}
+	resolver := artifactoryutils.NewProjectRepoResolver(servicesManager, gc.projectKey)
+	for i := range gc.spec.Files {
+		file := &gc.spec.Files[i]
+		var err error
+		if targets {
+			file.Target, err = resolver.ResolvePath(file.Target)
+		} else if file.Pattern != "" {
+			file.Pattern, err = resolver.ResolvePath(file.Pattern)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	if targets && gc.syncDeletesPath != "" {
+		// The sync-deletes path of uploads is an Artifactory path, under the targets.
+		var err error
+		gc.syncDeletesPath, err = resolver.ResolvePath(gc.syncDeletesPath)
+		return err
+	}
+	return nil
+}
+
+// Limits the concurrent requests of the services manager to an adaptive number, between the minimum threads and
+// threads, if adaptive threads are set.
+func (gc *GenericCommand) attachConcurrencyLimiter(servicesManager artifactory.ArtifactoryServicesManager, threads int) {
+	if !gc.adaptiveThreads {
+		return
+	}
+	concurrency.Attach(servicesManager.Client(), concurrency.NewLimiter(gc.minThreads, threads))
+}
+
+// Wraps the progress manager passed to the services manager with the rate limits and callbacks of the command.
+func (gc *GenericCommand) wrapProgress(progress ioUtils.ProgressMgr) ioUtils.ProgressMgr {
+	return callbacks.WrapProgress(ratelimit.WrapProgress(progress, gc.rateLimit, gc.globalRateLimit), gc.callbacks)
+}
@@ -3,7 +3,7 @@ package generic
 import (
 	"fmt"
 
-	"github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clientconfig"
 	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
 	"github.com/jfrog/jfrog-client-go/artifactory/services"
 	clientutils "github.com/jfrog/jfrog-client-go/artifactory/services/utils"
@@ -35,7 +35,7 @@ func (glc *GitLfsCommand) Run() error {
 	if errorutils.CheckError(err) != nil {
 		return err
 	}
-	servicesManager, err := utils.CreateServiceManager(serverDetails, glc.retries, glc.retryWaitTimeMilliSecs, glc.DryRun())
+	servicesManager, err := clientconfig.CreateServiceManager(serverDetails, glc.retries, glc.retryWaitTimeMilliSecs, glc.DryRun())
 	if err != nil {
 		return err
 	}
@@ -72,7 +72,7 @@ func (glc *GitLfsCommand) deleteLfsFilesFromArtifactory(deleteItems *content.Con
 		return errorutils.CheckError(err)
 	}
 	log.Info("Deleting", length, "files from", glc.configuration.Repo, "...")
-	servicesManager, err := utils.CreateServiceManager(glc.serverDetails, glc.retries, glc.retryWaitTimeMilliSecs, glc.DryRun())
+	servicesManager, err := clientconfig.CreateServiceManager(glc.serverDetails, glc.retries, glc.retryWaitTimeMilliSecs, glc.DryRun())
 	if err != nil {
 		return err
 	}
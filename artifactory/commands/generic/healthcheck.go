@@ -0,0 +1,318 @@
+package generic
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/formats"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clientconfig"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clierrors"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	clientutils "github.com/jfrog/jfrog-client-go/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const (
+	// DefaultMaxClockSkew is the difference between the local clock and the clock of the server beyond which the clock
+	// skew check fails. Larger skews break the validation of short-lived tokens and signed URLs.
+	DefaultMaxClockSkew = time.Minute
+	// The time before the license expires from which the license check warns.
+	licenseExpiryWarning = 30 * 24 * time.Hour
+	// The directory of the repository to which the canary file is uploaded.
+	healthCheckCanaryDir = ".jfrog-health-check"
+)
+
+// The statuses of a health check.
+const (
+	HealthCheckPassed  = "passed"
+	HealthCheckWarning = "warning"
+	HealthCheckFailed  = "failed"
+	HealthCheckSkipped = "skipped"
+)
+
+// HealthReport is the result of each check of a server, in the order in which they ran.
+type HealthReport struct {
+	ServerUrl string              `json:"serverUrl"`
+	Healthy   bool                `json:"healthy"`
+	Checks    []HealthCheckResult `json:"checks"`
+}
+
+type HealthCheckResult struct {
+	Name    string `json:"name"`
+	Status  string `json:"status"`
+	Message string `json:"message,omitempty"`
+	// The time the check took, in milliseconds.
+	DurationMs int64 `json:"durationMs"`
+}
+
+type healthCheckRow struct {
+	Name     string `col-name:"Check"`
+	Status   string `col-name:"Status"`
+	Message  string `col-name:"Details"`
+	Duration string `col-name:"Duration"`
+}
+
+func (hr *HealthReport) Tables() []formats.Table {
+	var rows []healthCheckRow
+	for _, check := range hr.Checks {
+		rows = append(rows, healthCheckRow{check.Name, check.Status, check.Message, strconv.FormatInt(check.DurationMs, 10) + "ms"})
+	}
+	return []formats.Table{{Title: "Health check of " + hr.ServerUrl, Rows: rows}}
+}
+
+func (hr *HealthReport) add(name, status, message string, start time.Time) {
+	hr.Checks = append(hr.Checks, HealthCheckResult{Name: name, Status: status, Message: message, DurationMs: time.Since(start).Milliseconds()})
+	if status == HealthCheckFailed {
+		hr.Healthy = false
+	}
+}
+
+// HealthCheckCommand validates that a server can be used, as a pre-flight step of a CI pipeline: that it's reachable,
+// its version and license, that its clock agrees with the local clock, and, when a repository is set, that files can be
+// uploaded to it, downloaded and deleted, by uploading a canary file. The command fails if any of the checks fails,
+// after the report is printed.
+type HealthCheckCommand struct {
+	serverDetails *config.ServerDetails
+	repo          string
+	maxClockSkew  time.Duration
+	format        string
+}
+
+func NewHealthCheckCommand() *HealthCheckCommand {
+	return &HealthCheckCommand{maxClockSkew: DefaultMaxClockSkew}
+}
+
+func (hcc *HealthCheckCommand) SetServerDetails(serverDetails *config.ServerDetails) *HealthCheckCommand {
+	hcc.serverDetails = serverDetails
+	return hcc
+}
+
+// SetRepo sets the repository to which the canary file is uploaded. The read and write checks are skipped if it's empty.
+func (hcc *HealthCheckCommand) SetRepo(repo string) *HealthCheckCommand {
+	hcc.repo = repo
+	return hcc
+}
+
+func (hcc *HealthCheckCommand) SetMaxClockSkew(maxClockSkew time.Duration) *HealthCheckCommand {
+	hcc.maxClockSkew = maxClockSkew
+	return hcc
+}
+
+func (hcc *HealthCheckCommand) SetFormat(format string) *HealthCheckCommand {
+	hcc.format = format
+	return hcc
+}
+
+func (hcc *HealthCheckCommand) ServerDetails() (*config.ServerDetails, error) {
+	return hcc.serverDetails, nil
+}
+
+func (hcc *HealthCheckCommand) CommandName() string {
+	return "rt_health_check"
+}
+
+func (hcc *HealthCheckCommand) Run() error {
+	outputFormat, err := formats.ParseFormat(hcc.format)
+	if err != nil {
+		return err
+	}
+	servicesManager, err := clientconfig.CreateServiceManager(hcc.serverDetails, 0, 0, false)
+	if err != nil {
+		return err
+	}
+	report := hcc.check(servicesManager)
+	if outputFormat == "" {
+		outputFormat = formats.TableFormat
+	}
+	if err = formats.Print(outputFormat, formats.HealthReportKind, report); err != nil {
+		return err
+	}
+	if !report.Healthy {
+		var failed []string
+		for _, check := range report.Checks {
+			if check.Status == HealthCheckFailed {
+				failed = append(failed, check.Name)
+			}
+		}
+		return errorutils.CheckErrorf("the health check of %s failed: %s", report.ServerUrl, strings.Join(failed, ", "))
+	}
+	return nil
+}
+
+func (hcc *HealthCheckCommand) check(servicesManager artifactory.ArtifactoryServicesManager) *HealthReport {
+	report := &HealthReport{ServerUrl: hcc.serverDetails.ArtifactoryUrl, Healthy: true}
+	start := time.Now()
+	serverTime, err := ping(servicesManager)
+	if err != nil {
+		report.add("ping", HealthCheckFailed, err.Error(), start)
+		// The other checks would fail the same way.
+		return report
+	}
+	report.add("ping", HealthCheckPassed, "", start)
+
+	start = time.Now()
+	if version, err := servicesManager.GetVersion(); err != nil {
+		report.add("version", HealthCheckFailed, err.Error(), start)
+	} else {
+		report.add("version", HealthCheckPassed, version, start)
+	}
+
+	start = time.Now()
+	status, message := checkLicense(servicesManager, time.Now())
+	report.add("license", status, message, start)
+
+	start = time.Now()
+	status, message = checkClockSkew(serverTime, time.Now(), hcc.maxClockSkew)
+	report.add("clock skew", status, message, start)
+
+	hcc.checkReadWrite(servicesManager, report)
+	return report
+}
+
+// Pings the server, and returns its time, by the Date header of the response.
+func ping(servicesManager artifactory.ArtifactoryServicesManager) (time.Time, error) {
+	serviceDetails := servicesManager.GetConfig().GetServiceDetails()
+	httpClientDetails := serviceDetails.CreateHttpClientDetails()
+	resp, body, _, err := servicesManager.Client().SendGet(serviceDetails.GetUrl()+"api/system/ping", true, &httpClientDetails)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if err = clierrors.CheckResponseStatusWithBody(resp, body, http.StatusOK); err != nil {
+		return time.Time{}, err
+	}
+	serverTime, err := http.ParseTime(resp.Header.Get("Date"))
+	if err != nil {
+		log.Debug("The ping response has no valid Date header:", err.Error())
+		return time.Time{}, nil
+	}
+	return serverTime, nil
+}
+
+type licenseDetails struct {
+	Type         string `json:"type"`
+	ValidThrough string `json:"validThrough"`
+	LicensedTo   string `json:"licensedTo"`
+}
+
+// Checks that the license of the server is valid, and warns when it's about to expire. Reading the license requires
+// admin permissions, so the check is skipped when they're missing.
+func checkLicense(servicesManager artifactory.ArtifactoryServicesManager, now time.Time) (string, string) {
+	serviceDetails := servicesManager.GetConfig().GetServiceDetails()
+	httpClientDetails := serviceDetails.CreateHttpClientDetails()
+	resp, body, _, err := servicesManager.Client().SendGet(serviceDetails.GetUrl()+"api/system/license", true, &httpClientDetails)
+	if err != nil {
+		return HealthCheckFailed, err.Error()
+	}
+	if resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden {
+		return HealthCheckSkipped, "reading the license requires admin permissions"
+	}
+	if err = clierrors.CheckResponseStatusWithBody(resp, body, http.StatusOK); err != nil {
+		return HealthCheckFailed, err.Error()
+	}
+	license := new(licenseDetails)
+	if err = json.Unmarshal(body, license); err != nil {
+		return HealthCheckFailed, "failed to parse the license: " + err.Error()
+	}
+	message := license.Type
+	if license.LicensedTo != "" {
+		message += ", licensed to " + license.LicensedTo
+	}
+	if license.ValidThrough == "" {
+		return HealthCheckPassed, message
+	}
+	message += ", valid through " + license.ValidThrough
+	validThrough, err := time.Parse("Jan 2, 2006", license.ValidThrough)
+	if err != nil {
+		log.Debug("Failed to parse the license expiry '" + license.ValidThrough + "': " + err.Error())
+		return HealthCheckPassed, message
+	}
+	// The license is valid through the end of its last day.
+	expiry := validThrough.Add(24 * time.Hour)
+	switch {
+	case now.After(expiry):
+		return HealthCheckFailed, message + " (expired)"
+	case expiry.Sub(now) < licenseExpiryWarning:
+		return HealthCheckWarning, message + " (expires soon)"
+	}
+	return HealthCheckPassed, message
+}
+
+func checkClockSkew(serverTime, now time.Time, maxClockSkew time.Duration) (string, string) {
+	if serverTime.IsZero() {
+		return HealthCheckSkipped, "the server didn't report its time"
+	}
+	// The Date header has a resolution of seconds.
+	skew := serverTime.Sub(now.Truncate(time.Second))
+	if skew < 0 {
+		skew = -skew
+	}
+	message := "the clocks differ by " + skew.String()
+	if skew > maxClockSkew {
+		return HealthCheckFailed, message + ", more than " + maxClockSkew.String()
+	}
+	return HealthCheckPassed, message
+}
+
+// Uploads a canary file to the repository, downloads it and deletes it.
+func (hcc *HealthCheckCommand) checkReadWrite(servicesManager artifactory.ArtifactoryServicesManager, report *HealthReport) {
+	if hcc.repo == "" {
+		for _, name := range []string{"write", "read", "delete"} {
+			report.add(name, HealthCheckSkipped, "no repository is set", time.Now())
+		}
+		return
+	}
+	serviceDetails := servicesManager.GetConfig().GetServiceDetails()
+	httpClientDetails := serviceDetails.CreateHttpClientDetails()
+	canaryPath := hcc.repo + "/" + healthCheckCanaryDir + "/" + strconv.FormatInt(time.Now().UnixNano(), 10) + ".txt"
+	canaryUrl, err := clientutils.BuildUrl(serviceDetails.GetUrl(), canaryPath, make(map[string]string))
+	if err != nil {
+		report.add("write", HealthCheckFailed, err.Error(), time.Now())
+		return
+	}
+	canary := []byte("JFrog CLI health check " + time.Now().UTC().Format(time.RFC3339) + "\n")
+
+	start := time.Now()
+	resp, body, err := servicesManager.Client().SendPut(canaryUrl, canary, &httpClientDetails)
+	if err == nil {
+		err = clierrors.CheckResponseStatusWithBody(resp, body, http.StatusOK, http.StatusCreated)
+	}
+	if err != nil {
+		report.add("write", HealthCheckFailed, err.Error(), start)
+		report.add("read", HealthCheckSkipped, "the canary file wasn't uploaded", time.Now())
+		report.add("delete", HealthCheckSkipped, "the canary file wasn't uploaded", time.Now())
+		return
+	}
+	report.add("write", HealthCheckPassed, "uploaded "+canaryPath, start)
+
+	start = time.Now()
+	resp, body, _, err = servicesManager.Client().SendGet(canaryUrl, true, &httpClientDetails)
+	if err == nil {
+		err = clierrors.CheckResponseStatusWithBody(resp, body, http.StatusOK)
+	}
+	if err == nil && !bytes.Equal(body, canary) {
+		err = fmt.Errorf("the downloaded canary file differs from the uploaded one")
+	}
+	if err != nil {
+		report.add("read", HealthCheckFailed, err.Error(), start)
+	} else {
+		report.add("read", HealthCheckPassed, "", start)
+	}
+
+	start = time.Now()
+	resp, body, err = servicesManager.Client().SendDelete(canaryUrl, nil, &httpClientDetails)
+	if err == nil {
+		err = clierrors.CheckResponseStatusWithBody(resp, body, http.StatusOK, http.StatusNoContent)
+	}
+	if err != nil {
+		report.add("delete", HealthCheckFailed, err.Error()+". The canary file "+canaryPath+" should be deleted manually", start)
+		return
+	}
+	report.add("delete", HealthCheckPassed, "", start)
+}
@@ -0,0 +1,86 @@
+package generic
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clientconfig"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHealthCheck(t *testing.T) {
+	var mutex sync.Mutex
+	canaries := make(map[string][]byte)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		switch {
+		case r.URL.Path == "/api/system/ping":
+			_, _ = w.Write([]byte("OK"))
+		case r.URL.Path == "/api/system/version":
+			_, _ = w.Write([]byte(`{"version": "7.90.0"}`))
+		case r.URL.Path == "/api/system/license":
+			w.WriteHeader(http.StatusForbidden)
+		case strings.HasPrefix(r.URL.Path, "/generic-local/"+healthCheckCanaryDir+"/"):
+			switch r.Method {
+			case http.MethodPut:
+				content, err := io.ReadAll(r.Body)
+				assert.NoError(t, err)
+				canaries[r.URL.Path] = content
+				w.WriteHeader(http.StatusCreated)
+			case http.MethodGet:
+				_, _ = w.Write(canaries[r.URL.Path])
+			case http.MethodDelete:
+				delete(canaries, r.URL.Path)
+				w.WriteHeader(http.StatusNoContent)
+			}
+		default:
+			w.WriteHeader(http.StatusForbidden)
+		}
+	}))
+	defer server.Close()
+	serverDetails := &config.ServerDetails{ArtifactoryUrl: server.URL + "/"}
+
+	healthCheckCmd := NewHealthCheckCommand().SetServerDetails(serverDetails).SetRepo("generic-local").SetFormat("json")
+	require.NoError(t, healthCheckCmd.Run())
+	assert.Empty(t, canaries, "the canary file is deleted")
+
+	servicesManager, err := clientconfig.CreateServiceManager(serverDetails, 0, 0, false)
+	require.NoError(t, err)
+	statuses := func(report *HealthReport) map[string]string {
+		result := make(map[string]string)
+		for _, check := range report.Checks {
+			result[check.Name] = check.Status
+		}
+		return result
+	}
+	report := healthCheckCmd.check(servicesManager)
+	assert.True(t, report.Healthy)
+	assert.Equal(t, map[string]string{"ping": HealthCheckPassed, "version": HealthCheckPassed, "license": HealthCheckSkipped, "clock skew": HealthCheckPassed,
+		"write": HealthCheckPassed, "read": HealthCheckPassed, "delete": HealthCheckPassed}, statuses(report))
+
+	// No permissions to write to the repository
+	report = healthCheckCmd.SetRepo("other-local").check(servicesManager)
+	assert.False(t, report.Healthy)
+	assert.Equal(t, HealthCheckFailed, statuses(report)["write"])
+	assert.Equal(t, HealthCheckSkipped, statuses(report)["read"])
+	assert.ErrorContains(t, healthCheckCmd.Run(), "the health check of "+server.URL+"/ failed: write")
+}
+
+func TestCheckClockSkew(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	status, _ := checkClockSkew(now.Add(-30*time.Second), now, time.Minute)
+	assert.Equal(t, HealthCheckPassed, status)
+	status, message := checkClockSkew(now.Add(2*time.Minute), now, time.Minute)
+	assert.Equal(t, HealthCheckFailed, status)
+	assert.Equal(t, "the clocks differ by 2m0s, more than 1m0s", message)
+	status, _ = checkClockSkew(time.Time{}, now, time.Minute)
+	assert.Equal(t, HealthCheckSkipped, status)
+}
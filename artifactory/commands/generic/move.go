@@ -3,7 +3,7 @@ package generic
 import (
 	"errors"
 
-	"github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clientconfig"
 	"github.com/jfrog/jfrog-cli-core/v2/common/spec"
 	"github.com/jfrog/jfrog-client-go/artifactory/services"
 	"github.com/jfrog/jfrog-client-go/utils/log"
@@ -12,6 +12,7 @@ import (
 type MoveCommand struct {
 	GenericCommand
 	threads int
+	options CopyMoveOptions
 }
 
 func NewMoveCommand() *MoveCommand {
@@ -27,10 +28,17 @@ func (mc *MoveCommand) SetThreads(threads int) *MoveCommand {
 	return mc
 }
 
+// SetOptions sets the conflict strategy and the preservation of properties and statistics. When any is set, the files are
+// planned one by one, and the completed transfers are rolled back if any fails.
+func (mc *MoveCommand) SetOptions(options CopyMoveOptions) *MoveCommand {
+	mc.options = options
+	return mc
+}
+
 // Moves the artifacts using the specified move pattern.
 func (mc *MoveCommand) Run() error {
 	// Create Service Manager:
-	servicesManager, err := utils.CreateServiceManagerWithThreads(mc.serverDetails, mc.DryRun(), mc.threads, mc.retries, mc.retryWaitTimeMilliSecs)
+	servicesManager, err := clientconfig.CreateServiceManagerWithThreads(mc.serverDetails, mc.DryRun(), mc.threads, mc.retries, mc.retryWaitTimeMilliSecs)
 	if err != nil {
 		return err
 	}
@@ -48,8 +56,20 @@ func (mc *MoveCommand) Run() error {
 		moveParamsArray = append(moveParamsArray, moveParams)
 	}
 
+	// The planned moves are verified once their conflicts are resolved.
+	if !mc.options.isPlanned() {
+		if err = verifyCopyMoveNotProtected(servicesManager, moveAction, moveParamsArray...); err != nil {
+			return err
+		}
+	}
+
 	// Perform move.
-	totalMoved, totalFailed, err := servicesManager.Move(moveParamsArray...)
+	var totalMoved, totalFailed int
+	if mc.options.isPlanned() {
+		totalMoved, totalFailed, err = runPlannedCopyMove(servicesManager, moveAction, mc.options, mc.DryRun(), mc.threads, moveParamsArray...)
+	} else {
+		totalMoved, totalFailed, err = servicesManager.Move(moveParamsArray...)
+	}
 	if err != nil {
 		errorOccurred = true
 		log.Error(err)
@@ -0,0 +1,208 @@
+package generic
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clientconfig"
+	"github.com/jfrog/jfrog-cli-core/v2/common/spec"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	clientutils "github.com/jfrog/jfrog-client-go/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// ServerUpload is the upload of the spec to a single server.
+type ServerUpload struct {
+	ServerId string
+	Command  *UploadCommand
+	Err      error
+	// True if the uploaded files were deleted, since the upload to another server failed.
+	RolledBack bool
+	// The target paths which existed before the upload. They are not deleted on rollback.
+	existingTargets map[string]bool
+}
+
+func (su *ServerUpload) failed() bool {
+	return su.Err != nil || su.Command.Result().FailCount() > 0
+}
+
+// MultiServerUploadCommand uploads the same spec to multiple servers in parallel. If all-or-nothing is set and the
+// upload to any server fails, the uploaded files which didn't exist before the upload are deleted from all the servers.
+type MultiServerUploadCommand struct {
+	uploads      []*ServerUpload
+	allOrNothing bool
+}
+
+func NewMultiServerUploadCommand() *MultiServerUploadCommand {
+	return &MultiServerUploadCommand{}
+}
+
+// AddServer adds a server, to which the files are uploaded by the upload command. The upload commands of the servers
+// should be configured alike, except for their server details.
+func (msuc *MultiServerUploadCommand) AddServer(serverId string, uploadCommand *UploadCommand) *MultiServerUploadCommand {
+	msuc.uploads = append(msuc.uploads, &ServerUpload{ServerId: serverId, Command: uploadCommand})
+	return msuc
+}
+
+func (msuc *MultiServerUploadCommand) SetAllOrNothing(allOrNothing bool) *MultiServerUploadCommand {
+	msuc.allOrNothing = allOrNothing
+	return msuc
+}
+
+// Uploads returns the uploads to the servers, in the order in which the servers were added.
+func (msuc *MultiServerUploadCommand) Uploads() []*ServerUpload {
+	return msuc.uploads
+}
+
+func (msuc *MultiServerUploadCommand) CommandName() string {
+	return "rt_multi_server_upload"
+}
+
+func (msuc *MultiServerUploadCommand) ServerDetails() (*config.ServerDetails, error) {
+	if len(msuc.uploads) == 0 {
+		return nil, nil
+	}
+	return msuc.uploads[0].Command.ServerDetails()
+}
+
+func (msuc *MultiServerUploadCommand) Run() error {
+	if len(msuc.uploads) == 0 {
+		return errorutils.CheckErrorf("no servers to upload to")
+	}
+	for _, upload := range msuc.uploads {
+		// The upload updates its spec and configuration, so each server gets its own copies.
+		uploadConfiguration := *upload.Command.UploadConfiguration()
+		upload.Command.SetUploadConfiguration(&uploadConfiguration).SetSpec(cloneSpec(upload.Command.Spec()))
+		// The uploaded files are read from the detailed summary when they are deleted.
+		if msuc.allOrNothing {
+			upload.Command.SetDetailedSummary(true)
+		}
+	}
+	if msuc.allOrNothing {
+		if err := msuc.findExistingTargets(); err != nil {
+			return err
+		}
+	}
+	msuc.runInParallel(func(upload *ServerUpload) {
+		log.Info("Uploading to", upload.ServerId+"...")
+		upload.Err = upload.Command.Run()
+	})
+
+	var failedServers []string
+	for _, upload := range msuc.uploads {
+		if upload.failed() {
+			failedServers = append(failedServers, upload.ServerId)
+		}
+	}
+	if len(failedServers) == 0 {
+		return nil
+	}
+	err := errorutils.CheckErrorf("the upload to %s failed", strings.Join(failedServers, ", "))
+	if msuc.allOrNothing {
+		log.Warn("The upload to", strings.Join(failedServers, ", "), "failed. Deleting the uploaded files from all the servers...")
+		for _, upload := range msuc.uploads {
+			err = errors.Join(err, rollbackUpload(upload))
+		}
+	}
+	return err
+}
+
+func (msuc *MultiServerUploadCommand) runInParallel(run func(upload *ServerUpload)) {
+	var wg sync.WaitGroup
+	for _, upload := range msuc.uploads {
+		wg.Add(1)
+		go func(upload *ServerUpload) {
+			defer wg.Done()
+			run(upload)
+		}(upload)
+	}
+	wg.Wait()
+}
+
+// findExistingTargets finds the target paths which already exist on each server, before anything is uploaded, so that
+// the rollback deletes only the files which were added by the upload.
+func (msuc *MultiServerUploadCommand) findExistingTargets() error {
+	errs := make([]error, len(msuc.uploads))
+	msuc.runInParallel(func(upload *ServerUpload) {
+		upload.existingTargets, upload.Err = findExistingTargets(upload.Command)
+	})
+	for i, upload := range msuc.uploads {
+		if upload.Err != nil {
+			errs[i] = fmt.Errorf("failed to check the existing files on %s: %w", upload.ServerId, upload.Err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// findExistingTargets returns the target paths of the upload which already exist in Artifactory. The target paths
+// are resolved by a dry run of the upload, the same way as the upload resolves them.
+func findExistingTargets(uploadCmd *UploadCommand) (map[string]bool, error) {
+	transfers, err := dryRunUpload(uploadCmd, cloneSpec(uploadCmd.Spec()))
+	if err != nil {
+		return nil, err
+	}
+	serverDetails, err := uploadCmd.ServerDetails()
+	if err != nil {
+		return nil, err
+	}
+	servicesManager, err := clientconfig.CreateServiceManager(serverDetails, uploadCmd.retries, uploadCmd.retryWaitTimeMilliSecs, false)
+	if err != nil {
+		return nil, err
+	}
+	existingTargets := make(map[string]bool)
+	for _, transfer := range transfers {
+		exists, err := itemExists(servicesManager, transfer.TargetPath)
+		if err != nil {
+			return nil, err
+		}
+		if exists {
+			existingTargets[transfer.TargetPath] = true
+		}
+	}
+	return existingTargets, nil
+}
+
+func cloneSpec(uploadSpec *spec.SpecFiles) *spec.SpecFiles {
+	return &spec.SpecFiles{Files: append([]spec.File(nil), uploadSpec.Files...)}
+}
+
+// rollbackUpload deletes the files which were uploaded to the server.
+func rollbackUpload(upload *ServerUpload) error {
+	result := upload.Command.Result()
+	if upload.Command.DryRun() || result.SuccessCount() == 0 || result.Reader() == nil {
+		return nil
+	}
+	serverDetails, err := upload.Command.ServerDetails()
+	if err != nil {
+		return err
+	}
+	servicesManager, err := clientconfig.CreateServiceManager(serverDetails, upload.Command.retries, upload.Command.retryWaitTimeMilliSecs, false)
+	if err != nil {
+		return err
+	}
+	reader := result.Reader()
+	defer reader.Reset()
+	var failed int
+	for details := new(clientutils.FileTransferDetails); reader.NextRecord(details) == nil; details = new(clientutils.FileTransferDetails) {
+		if upload.existingTargets[details.TargetPath] {
+			log.Warn("Rolling back: keeping", details.TargetPath, "on", upload.ServerId+", since it existed before the upload and was overwritten")
+			continue
+		}
+		log.Info("Rolling back: deleting", details.TargetPath, "from", upload.ServerId)
+		if err = deleteItem(servicesManager, details.TargetPath); err != nil {
+			log.Error("Failed to roll back", details.TargetPath, "on", upload.ServerId+":", err.Error())
+			failed++
+		}
+	}
+	if err = reader.GetError(); err != nil {
+		return errorutils.CheckError(err)
+	}
+	if failed > 0 {
+		return errorutils.CheckErrorf("failed to delete %d uploaded files from %s", failed, upload.ServerId)
+	}
+	upload.RolledBack = true
+	return nil
+}
@@ -0,0 +1,104 @@
+package generic
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-core/v2/common/spec"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeUploadServer struct {
+	*httptest.Server
+	mutex    sync.Mutex
+	requests []string
+}
+
+// newFakeUploadServer returns a server which answers the uploads with uploadStatus, and on which only the existing
+// paths are found.
+func newFakeUploadServer(t *testing.T, uploadStatus int, existing ...string) *fakeUploadServer {
+	server := &fakeUploadServer{}
+	server.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/system/version" {
+			_, _ = w.Write([]byte(`{"version": "7.90.0"}`))
+			return
+		}
+		if itemPath, found := strings.CutPrefix(r.URL.Path, "/api/storage/"); found {
+			if !slices.Contains(existing, itemPath) {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			_, _ = w.Write([]byte(`{"path": "/` + itemPath + `"}`))
+			return
+		}
+		server.mutex.Lock()
+		server.requests = append(server.requests, r.Method+" "+r.URL.Path)
+		server.mutex.Unlock()
+		if r.Method == http.MethodPut {
+			w.WriteHeader(uploadStatus)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func newTestUploadCommand(t *testing.T, serverUrl string, fileNames ...string) *UploadCommand {
+	localDir := t.TempDir()
+	for _, fileName := range fileNames {
+		require.NoError(t, os.WriteFile(filepath.Join(localDir, fileName), []byte("content"), 0644))
+	}
+	uploadSpec := spec.NewBuilder().Pattern(filepath.ToSlash(localDir) + "/*.txt").Target("libs-local/").Flat(true).BuildSpec()
+	uploadCmd := NewUploadCommand()
+	uploadCmd.SetUploadConfiguration(&utils.UploadConfiguration{Threads: 1, MinChecksumDeploySize: 10240}).SetSpec(uploadSpec).
+		SetServerDetails(&config.ServerDetails{ArtifactoryUrl: serverUrl + "/"}).SetRetries(0)
+	return uploadCmd
+}
+
+func TestMultiServerUploadAllOrNothing(t *testing.T) {
+	healthy := newFakeUploadServer(t, http.StatusCreated, "libs-local/b.txt")
+	failing := newFakeUploadServer(t, http.StatusInternalServerError)
+
+	multiServerUploadCmd := NewMultiServerUploadCommand().SetAllOrNothing(true).
+		AddServer("healthy", newTestUploadCommand(t, healthy.URL, "a.txt", "b.txt")).
+		AddServer("failing", newTestUploadCommand(t, failing.URL, "a.txt", "b.txt"))
+	err := multiServerUploadCmd.Run()
+	assert.ErrorContains(t, err, "the upload to failing failed")
+
+	uploads := multiServerUploadCmd.Uploads()
+	require.Len(t, uploads, 2)
+	for _, upload := range uploads {
+		defer func() {
+			assert.NoError(t, upload.Command.Result().Reader().Close())
+		}()
+	}
+	assert.Equal(t, 2, uploads[0].Command.Result().SuccessCount())
+	assert.True(t, uploads[0].RolledBack)
+	assert.Equal(t, 2, uploads[1].Command.Result().FailCount())
+	assert.False(t, uploads[1].RolledBack)
+	// The overwritten b.txt existed before the upload, so only a.txt is deleted.
+	assert.ElementsMatch(t, []string{"PUT /libs-local/a.txt", "PUT /libs-local/b.txt", "DELETE /libs-local/a.txt"}, healthy.requests)
+}
+
+func TestMultiServerUploadPartialFailure(t *testing.T) {
+	healthy := newFakeUploadServer(t, http.StatusCreated)
+	failing := newFakeUploadServer(t, http.StatusInternalServerError)
+
+	multiServerUploadCmd := NewMultiServerUploadCommand().
+		AddServer("healthy", newTestUploadCommand(t, healthy.URL, "a.txt")).
+		AddServer("failing", newTestUploadCommand(t, failing.URL, "a.txt"))
+	assert.ErrorContains(t, multiServerUploadCmd.Run(), "the upload to failing failed")
+	// Without all-or-nothing, the files uploaded to the healthy server are kept.
+	assert.Equal(t, []string{"PUT /libs-local/a.txt"}, healthy.requests)
+	assert.False(t, multiServerUploadCmd.Uploads()[0].RolledBack)
+}
@@ -2,6 +2,7 @@ package generic
 
 import (
 	"errors"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clientconfig"
 	"github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
 	"github.com/jfrog/jfrog-cli-core/v2/common/spec"
 	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
@@ -18,6 +19,7 @@ type PropsCommand struct {
 	props    string
 	threads  int
 	repoOnly bool
+	summary  *PropsBatchSummary
 	GenericCommand
 }
 
@@ -68,7 +70,11 @@ func createPropsServiceManager(threads, httpRetries, retryWaitMilliSecs int, ser
 	if err != nil {
 		return nil, err
 	}
-	return artifactory.New(serviceConfig)
+	servicesManager, err := artifactory.New(serviceConfig)
+	if err != nil {
+		return nil, err
+	}
+	return servicesManager, clientconfig.ConfigureClient(servicesManager.Client(), serverDetails)
 }
 
 func searchItems(spec *spec.SpecFiles, servicesManager artifactory.ArtifactoryServicesManager) (resultReader *content.ContentReader, err error) {
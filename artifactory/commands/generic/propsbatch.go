@@ -0,0 +1,166 @@
+package generic
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/jfrog/gofrog/parallel"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clierrors"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	serviceutils "github.com/jfrog/jfrog-client-go/artifactory/services/utils"
+	clientutils "github.com/jfrog/jfrog-client-go/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/io/content"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const (
+	PropsItemSucceeded = "success"
+	PropsItemFailed    = "failure"
+	PropsItemDryRun    = "dry-run"
+)
+
+// PropsBatchSummary is the machine-readable outcome of setting or deleting properties on a batch of items.
+type PropsBatchSummary struct {
+	Action         string            `json:"action"`
+	Props          string            `json:"props"`
+	DryRun         bool              `json:"dryRun,omitempty"`
+	TotalSucceeded int               `json:"totalSucceeded"`
+	TotalFailed    int               `json:"totalFailed"`
+	Items          []PropsItemResult `json:"items"`
+}
+
+type PropsItemResult struct {
+	Path   string `json:"path"`
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+// PropsSummary returns the summary of the last run, or nil if the properties were set on a repository.
+func (pc *PropsCommand) PropsSummary() *PropsBatchSummary {
+	return pc.summary
+}
+
+// Sets or deletes the properties on each item matching the raw AQL query or the spec, in parallel with up to
+// pc.threads requests at a time. On a dry run, the matching items are only listed.
+func (pc *PropsCommand) runBatch(servicesManager artifactory.ArtifactoryServicesManager, isDelete bool) (err error) {
+	encodedProps, err := encodeProps(pc.props, isDelete)
+	if err != nil {
+		return err
+	}
+	pc.summary = &PropsBatchSummary{Action: "set", Props: pc.props, DryRun: pc.DryRun(), Items: []PropsItemResult{}}
+	if isDelete {
+		pc.summary.Action = "delete"
+	}
+	reader, err := pc.searchPropsTargets(servicesManager)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = errors.Join(err, reader.Close())
+	}()
+
+	var mutex sync.Mutex
+	addResult := func(itemPath string, itemErr error) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		result := PropsItemResult{Path: itemPath, Status: PropsItemSucceeded}
+		switch {
+		case pc.DryRun():
+			result.Status = PropsItemDryRun
+			pc.summary.TotalSucceeded++
+		case itemErr != nil:
+			log.Error(itemErr)
+			result.Status, result.Error = PropsItemFailed, itemErr.Error()
+			pc.summary.TotalFailed++
+		default:
+			pc.summary.TotalSucceeded++
+		}
+		pc.summary.Items = append(pc.summary.Items, result)
+	}
+
+	threads := pc.threads
+	if threads <= 0 {
+		threads = 1
+	}
+	runner := parallel.NewBounedRunner(threads, false)
+	go func() {
+		defer runner.Done()
+		for item := new(serviceutils.ResultItem); reader.NextRecord(item) == nil; item = new(serviceutils.ResultItem) {
+			itemPath := item.GetItemRelativePath()
+			if pc.DryRun() {
+				log.Info("[Dry run] Changing properties on:", itemPath)
+				addResult(itemPath, nil)
+				continue
+			}
+			_, _ = runner.AddTask(func(int) error {
+				addResult(itemPath, changeItemProps(servicesManager, itemPath, encodedProps, isDelete))
+				return nil
+			})
+		}
+	}()
+	runner.Run()
+	if err = reader.GetError(); err != nil {
+		return err
+	}
+
+	sort.Slice(pc.summary.Items, func(i, j int) bool {
+		return pc.summary.Items[i].Path < pc.summary.Items[j].Path
+	})
+	pc.Result().SetSuccessCount(pc.summary.TotalSucceeded)
+	pc.Result().SetFailCount(pc.summary.TotalFailed)
+	if pc.summary.TotalFailed > 0 {
+		return errorutils.CheckErrorf("failed to change the properties of %d items, please review the logs", pc.summary.TotalFailed)
+	}
+	return nil
+}
+
+func (pc *PropsCommand) searchPropsTargets(servicesManager artifactory.ArtifactoryServicesManager) (*content.ContentReader, error) {
+	if pc.rawAql != "" {
+		return pc.searchRawAql(servicesManager)
+	}
+	return searchItems(pc.Spec(), servicesManager)
+}
+
+// Returns the value of the 'properties' query parameter of the storage API.
+func encodeProps(props string, isDelete bool) (string, error) {
+	if !isDelete {
+		properties, err := serviceutils.ParseProperties(props)
+		if err != nil {
+			return "", err
+		}
+		return properties.ToEncodedString(true), nil
+	}
+	var keys []string
+	for _, key := range strings.Split(props, ",") {
+		keys = append(keys, url.QueryEscape(key))
+	}
+	return strings.Join(keys, ","), nil
+}
+
+func changeItemProps(servicesManager artifactory.ArtifactoryServicesManager, itemPath, encodedProps string, isDelete bool) error {
+	propsUrl, err := clientutils.BuildUrl(servicesManager.GetConfig().GetServiceDetails().GetUrl(), path.Join("api", "storage", itemPath), make(map[string]string))
+	if err != nil {
+		return err
+	}
+	propsUrl += "?properties=" + encodedProps + "&recursive=0"
+	httpClientDetails := servicesManager.GetConfig().GetServiceDetails().CreateHttpClientDetails()
+	var resp *http.Response
+	var body []byte
+	if isDelete {
+		log.Info("Deleting properties on:", itemPath)
+		resp, body, err = servicesManager.Client().SendDelete(propsUrl, nil, &httpClientDetails)
+	} else {
+		log.Info("Setting properties on:", itemPath)
+		resp, body, err = servicesManager.Client().SendPut(propsUrl, nil, &httpClientDetails)
+	}
+	if err != nil {
+		return err
+	}
+	return clierrors.CheckResponseStatusWithBody(resp, body, http.StatusNoContent)
+}
@@ -0,0 +1,66 @@
+package generic
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodeProps(t *testing.T) {
+	encoded, err := encodeProps("a=1,2;b=x y", false)
+	require.NoError(t, err)
+	assert.Equal(t, "a=1%2C2;b=x+y", encoded)
+
+	encoded, err = encodeProps("a,b c", true)
+	require.NoError(t, err)
+	assert.Equal(t, "a,b+c", encoded)
+}
+
+func TestRunPropsBatch(t *testing.T) {
+	var mutex sync.Mutex
+	var changed []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/api/search/aql":
+			_, _ = w.Write([]byte(`{"results": [{"repo": "libs-local", "path": "a", "name": "ok.jar", "type": "file"}, {"repo": "libs-local", "path": "a", "name": "locked.jar", "type": "file"}]}`))
+		case strings.HasPrefix(r.URL.Path, "/api/storage/") && r.Method == http.MethodPut:
+			if strings.HasSuffix(r.URL.Path, "locked.jar") {
+				w.WriteHeader(http.StatusForbidden)
+				return
+			}
+			mutex.Lock()
+			changed = append(changed, r.URL.Path)
+			mutex.Unlock()
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	servicesManager, err := createPropsServiceManager(2, 0, 0, &config.ServerDetails{ArtifactoryUrl: server.URL + "/"})
+	require.NoError(t, err)
+
+	command := NewPropsCommand().SetProps("a=b").SetThreads(2)
+	command.SetRawAql(`items.find({"repo": "libs-local"}).limit(10)`)
+
+	command.SetDryRun(true)
+	assert.NoError(t, command.runBatch(servicesManager, false))
+	assert.Empty(t, changed)
+	assert.Equal(t, []PropsItemResult{{Path: "libs-local/a/locked.jar", Status: PropsItemDryRun}, {Path: "libs-local/a/ok.jar", Status: PropsItemDryRun}}, command.PropsSummary().Items)
+
+	command.SetDryRun(false)
+	assert.Error(t, command.runBatch(servicesManager, false))
+	assert.Equal(t, []string{"/api/storage/libs-local/a/ok.jar"}, changed)
+	summary := command.PropsSummary()
+	assert.Equal(t, 1, summary.TotalSucceeded)
+	assert.Equal(t, 1, summary.TotalFailed)
+	assert.Equal(t, PropsItemFailed, summary.Items[0].Status)
+	assert.Contains(t, summary.Items[0].Error, "403")
+	assert.Equal(t, 1, command.Result().FailCount())
+}
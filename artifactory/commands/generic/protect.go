@@ -0,0 +1,69 @@
+package generic
+
+import (
+	"strings"
+
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/protection"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+)
+
+// ProtectCommand marks artifacts as immutable or under legal hold, or releases them, by setting or deleting their
+// lifecycle property. Protected artifacts can't be deleted or moved by this CLI, and retention policies skip them.
+type ProtectCommand struct {
+	PropsCommand
+	property string
+	value    string
+	release  bool
+}
+
+// NewImmutableCommand returns a command marking artifacts as immutable.
+func NewImmutableCommand() *ProtectCommand {
+	return &ProtectCommand{property: protection.ImmutableProperty, value: "true"}
+}
+
+// NewLegalHoldCommand returns a command placing artifacts under the legal hold, such as a case number. If it's empty,
+// the artifacts are placed under an unnamed hold.
+func NewLegalHoldCommand(hold string) *ProtectCommand {
+	if hold == "" {
+		hold = "true"
+	}
+	return &ProtectCommand{property: protection.LegalHoldProperty, value: hold}
+}
+
+func (pc *ProtectCommand) SetPropsCommand(command PropsCommand) *ProtectCommand {
+	pc.PropsCommand = command
+	return pc
+}
+
+// SetRelease sets whether the protection is removed from the artifacts, rather than set.
+func (pc *ProtectCommand) SetRelease(release bool) *ProtectCommand {
+	pc.release = release
+	return pc
+}
+
+func (pc *ProtectCommand) CommandName() string {
+	if pc.property == protection.LegalHoldProperty {
+		return "rt_legal_hold"
+	}
+	return "rt_immutable"
+}
+
+func (pc *ProtectCommand) Run() error {
+	if strings.ContainsAny(pc.value, ",;=") {
+		return errorutils.CheckErrorf("the legal hold '%s' cannot contain commas, semicolons or equal signs", pc.value)
+	}
+	serverDetails, err := pc.ServerDetails()
+	if errorutils.CheckError(err) != nil {
+		return err
+	}
+	servicesManager, err := createPropsServiceManager(pc.threads, pc.retries, pc.retryWaitTimeMilliSecs, serverDetails)
+	if err != nil {
+		return err
+	}
+	if pc.release {
+		pc.props = pc.property
+		return pc.runBatch(servicesManager, true)
+	}
+	pc.props = pc.property + "=" + pc.value
+	return pc.runBatch(servicesManager, false)
+}
@@ -0,0 +1,42 @@
+package generic
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProtectCommand(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/search/aql" {
+			_, _ = w.Write([]byte(`{"results": [{"repo": "libs-local", "path": "a", "name": "app.jar", "type": "file"}]}`))
+			return
+		}
+		requests = append(requests, r.Method+" "+r.URL.Path+"?"+r.URL.RawQuery)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+	propsCommand := func() PropsCommand {
+		command := NewPropsCommand().SetThreads(1)
+		command.SetServerDetails(&config.ServerDetails{ArtifactoryUrl: server.URL + "/"}).SetRawAql(`items.find({"repo": "libs-local"})`)
+		return *command
+	}
+
+	legalHold := NewLegalHoldCommand("CASE-7").SetPropsCommand(propsCommand())
+	assert.Equal(t, "rt_legal_hold", legalHold.CommandName())
+	require.NoError(t, legalHold.Run())
+	release := NewImmutableCommand().SetPropsCommand(propsCommand()).SetRelease(true)
+	assert.Equal(t, "rt_immutable", release.CommandName())
+	require.NoError(t, release.Run())
+	assert.Equal(t, []string{
+		"PUT /api/storage/libs-local/a/app.jar?properties=lifecycle.legal-hold=CASE-7&recursive=0",
+		"DELETE /api/storage/libs-local/a/app.jar?properties=lifecycle.immutable&recursive=0",
+	}, requests)
+
+	assert.ErrorContains(t, NewLegalHoldCommand("a,b").SetPropsCommand(propsCommand()).Run(), "cannot contain commas")
+}
@@ -0,0 +1,72 @@
+package generic
+
+import (
+	"errors"
+	"path"
+
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/aql"
+	"github.com/jfrog/jfrog-cli-core/v2/common/spec"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	serviceutils "github.com/jfrog/jfrog-client-go/artifactory/services/utils"
+	"github.com/jfrog/jfrog-client-go/utils/io/content"
+)
+
+// Runs the raw AQL query of the command, page by page, and returns a reader of all its results.
+func (gc *GenericCommand) searchRawAql(servicesManager artifactory.ArtifactoryServicesManager) (*content.ContentReader, error) {
+	writer, err := content.NewContentWriter(content.DefaultKey, true, false)
+	if err != nil {
+		return nil, err
+	}
+	err = aql.SearchItems(servicesManager, gc.rawAql, aql.DefaultPageSize, func(item *serviceutils.ResultItem) error {
+		writer.Write(*item)
+		return nil
+	})
+	if err = errors.Join(err, writer.Close()); err != nil {
+		return nil, err
+	}
+	return content.NewContentReader(writer.GetFilePath(), content.DefaultKey), nil
+}
+
+// Replaces the spec with a file per artifact returned by the raw AQL query, downloaded according to the target and download
+// options of the original spec.
+// The checksum and size of each artifact are passed on, so the artifacts aren't searched again.
+func (dc *DownloadCommand) createRawAqlSpec(servicesManager artifactory.ArtifactoryServicesManager) ([]serviceutils.ResultItem, error) {
+	reader, err := dc.searchRawAql(servicesManager)
+	if err != nil {
+		return nil, err
+	}
+	var items []serviceutils.ResultItem
+	for item := new(serviceutils.ResultItem); reader.NextRecord(item) == nil; item = new(serviceutils.ResultItem) {
+		if item.Type != "folder" {
+			items = append(items, *item)
+		}
+	}
+	if err = errors.Join(reader.GetError(), reader.Close()); err != nil {
+		return nil, err
+	}
+	var template spec.File
+	if dc.Spec() != nil && len(dc.Spec().Files) > 0 {
+		template = *dc.Spec().Get(0)
+	}
+	files := make([]spec.File, 0, len(items))
+	for _, item := range items {
+		if hasTargetTemplate(template.Target) {
+			target, err := resolveTargetTemplate(template.Target, &item)
+			if err != nil {
+				return nil, err
+			}
+			files = append(files, templatedSpecFile(template, path.Join(item.Repo, item.Path, item.Name), target))
+			continue
+		}
+		files = append(files, spec.File{
+			Pattern:                 path.Join(item.Repo, item.Path, item.Name),
+			Target:                  template.Target,
+			Flat:                    template.Flat,
+			Explode:                 template.Explode,
+			BypassArchiveInspection: template.BypassArchiveInspection,
+			ValidateSymlinks:        template.ValidateSymlinks,
+		})
+	}
+	dc.SetSpec(&spec.SpecFiles{Files: files})
+	return items, nil
+}
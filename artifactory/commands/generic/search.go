@@ -2,6 +2,11 @@ package generic
 
 import (
 	"errors"
+	"io"
+	"os"
+
+	ioutils "github.com/jfrog/gofrog/io"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clientconfig"
 	"github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
 	clientartutils "github.com/jfrog/jfrog-client-go/artifactory/services/utils"
 	"github.com/jfrog/jfrog-client-go/utils/errorutils"
@@ -11,17 +16,67 @@ import (
 
 type SearchCommand struct {
 	GenericCommand
+	format    string
+	countOnly bool
+	output    io.Writer
+	count     int
 }
 
 func NewSearchCommand() *SearchCommand {
-	return &SearchCommand{GenericCommand: *NewGenericCommand()}
+	return &SearchCommand{GenericCommand: *NewGenericCommand(), format: SearchFormatJson, output: os.Stdout}
 }
 
 func (sc *SearchCommand) CommandName() string {
 	return "rt_search"
 }
 
+// SetFormat sets the format of the results. With SearchFormatJsonl, the results are streamed to the output writer
+// rather than returned by the result's reader.
+func (sc *SearchCommand) SetFormat(format string) *SearchCommand {
+	sc.format = format
+	return sc
+}
+
+// SetCountOnly sets whether the results are only counted as they are fetched, rather than kept.
+func (sc *SearchCommand) SetCountOnly(countOnly bool) *SearchCommand {
+	sc.countOnly = countOnly
+	return sc
+}
+
+func (sc *SearchCommand) SetOutputWriter(output io.Writer) *SearchCommand {
+	sc.output = output
+	return sc
+}
+
+// Count returns the number of results which were streamed or counted.
+func (sc *SearchCommand) Count() int {
+	return sc.count
+}
+
+func (sc *SearchCommand) IsStreaming() bool {
+	return sc.format == SearchFormatJsonl || sc.countOnly
+}
+
 func (sc *SearchCommand) Run() error {
+	if sc.format != SearchFormatJson && sc.format != SearchFormatJsonl {
+		return errorutils.CheckErrorf("unsupported search format '%s'. Supported formats are %s and %s", sc.format, SearchFormatJson, SearchFormatJsonl)
+	}
+	if sc.IsStreaming() {
+		serverDetails, err := sc.ServerDetails()
+		if err != nil {
+			return err
+		}
+		servicesManager, err := clientconfig.CreateServiceManager(serverDetails, sc.retries, sc.retryWaitTimeMilliSecs, false)
+		if err != nil {
+			return err
+		}
+		if err = sc.resolveProjectRepos(servicesManager, false); err != nil {
+			return err
+		}
+		err = sc.stream(servicesManager)
+		sc.Result().SetSuccessCount(sc.count)
+		return err
+	}
 	reader, err := sc.Search()
 	sc.Result().SetReader(reader)
 	return err
@@ -33,19 +88,33 @@ func (sc *SearchCommand) Search() (*content.ContentReader, error) {
 	if errorutils.CheckError(err) != nil {
 		return nil, err
 	}
-	servicesManager, err := utils.CreateServiceManager(serverDetails, sc.retries, sc.retryWaitTimeMilliSecs, false)
+	servicesManager, err := clientconfig.CreateServiceManager(serverDetails, sc.retries, sc.retryWaitTimeMilliSecs, false)
 	if err != nil {
 		return nil, err
 	}
+	if err = sc.resolveProjectRepos(servicesManager, false); err != nil {
+		return nil, err
+	}
 	// Search Loop
 	log.Info("Searching artifacts...")
 
-	searchResults, callbackFunc, err := utils.SearchFiles(servicesManager, sc.Spec())
-	defer func() {
-		err = errors.Join(err, callbackFunc())
-	}()
-	if err != nil {
-		return nil, err
+	var searchResults []*content.ContentReader
+	if sc.rawAql != "" {
+		rawAqlResults, err := sc.searchRawAql(servicesManager)
+		if err != nil {
+			return nil, err
+		}
+		defer ioutils.Close(rawAqlResults, &err)
+		searchResults = append(searchResults, rawAqlResults)
+	} else {
+		var callbackFunc func() error
+		searchResults, callbackFunc, err = utils.SearchFiles(servicesManager, sc.Spec())
+		defer func() {
+			err = errors.Join(err, callbackFunc())
+		}()
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	reader, err := utils.AqlResultToSearchResult(searchResults)
@@ -0,0 +1,127 @@
+package generic
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/aql"
+	"github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/jfrog/jfrog-client-go/artifactory/services"
+	serviceutils "github.com/jfrog/jfrog-client-go/artifactory/services/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const (
+	// The results are printed as a single JSON array, once the search is done.
+	SearchFormatJson = "json"
+	// Each result is printed as a JSON object on its own line, as soon as it is fetched.
+	SearchFormatJsonl = "jsonl"
+)
+
+// Streams the results of the search to the output as JSON Lines, or only counts them, without keeping them.
+// Pattern searches are run in pages, sorted by their path unless the spec sorts or limits them.
+// Since Artifactory doesn't return properties for sorted queries, the streamed results don't include properties.
+func (sc *SearchCommand) stream(servicesManager artifactory.ArtifactoryServicesManager) error {
+	log.Info("Searching artifacts...")
+	handler := func(item *serviceutils.ResultItem) error {
+		sc.count++
+		if sc.countOnly {
+			return nil
+		}
+		line, err := json.Marshal(toSearchResult(item))
+		if err != nil {
+			return errorutils.CheckError(err)
+		}
+		_, err = fmt.Fprintln(sc.output, string(line))
+		return errorutils.CheckError(err)
+	}
+	if sc.rawAql != "" {
+		return aql.SearchItems(servicesManager, sc.rawAql, aql.DefaultPageSize, handler)
+	}
+	for i := range sc.Spec().Files {
+		searchParams, err := utils.GetSearchParams(sc.Spec().Get(i))
+		if err != nil {
+			return err
+		}
+		if searchParams.GetSpecType() != serviceutils.WILDCARD {
+			// Build and bundle searches filter their results after the query, so they can't be paginated.
+			if err = streamSearchResults(servicesManager, searchParams, handler); err != nil {
+				return err
+			}
+			continue
+		}
+		query, err := createPaginatedSearchQuery(searchParams.CommonParams)
+		if err != nil {
+			return err
+		}
+		if err = aql.SearchItems(servicesManager, query, aql.DefaultPageSize, handler); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Returns the AQL query of a pattern search. Unless the search is sorted or limited, it is sorted by the path of the
+// results, so that its pages are consistent.
+func createPaginatedSearchQuery(params *serviceutils.CommonParams) (string, error) {
+	body, err := serviceutils.CreateAqlBodyForSpecWithPattern(params)
+	if err != nil {
+		return "", err
+	}
+	params.Aql = serviceutils.Aql{ItemsFind: body}
+	if len(params.SortBy) == 0 && params.Limit <= 0 && params.Offset <= 0 {
+		params.SortBy = []string{"repo", "path", "name"}
+	}
+	return serviceutils.BuildQueryFromSpecFile(params, serviceutils.NONE), nil
+}
+
+func streamSearchResults(servicesManager artifactory.ArtifactoryServicesManager, searchParams services.SearchParams, handler func(item *serviceutils.ResultItem) error) (err error) {
+	reader, err := servicesManager.SearchFiles(searchParams)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = errors.Join(err, reader.Close())
+	}()
+	for item := new(serviceutils.ResultItem); reader.NextRecord(item) == nil; item = new(serviceutils.ResultItem) {
+		if err = handler(item); err != nil {
+			return err
+		}
+	}
+	return reader.GetError()
+}
+
+// Converts an AQL result to the format printed by the search command.
+func toSearchResult(item *serviceutils.ResultItem) utils.SearchResult {
+	result := utils.SearchResult{
+		Path:        item.Repo + "/",
+		Type:        item.Type,
+		Size:        item.Size,
+		Created:     item.Created,
+		Modified:    item.Modified,
+		Sha1:        item.Actual_Sha1,
+		Sha256:      item.Sha256,
+		Md5:         item.Actual_Md5,
+		ModifiedBy:  item.ModifiedBy,
+		Updated:     item.Updated,
+		CreatedBy:   item.CreatedBy,
+		OriginalMd5: item.OriginalMd5,
+		Depth:       item.Depth,
+	}
+	if item.Path != "." {
+		result.Path += item.Path + "/"
+	}
+	if item.Name != "." {
+		result.Path += item.Name
+	}
+	if len(item.Properties) > 0 {
+		result.Props = make(map[string][]string, len(item.Properties))
+		for _, prop := range item.Properties {
+			result.Props[prop.Key] = append(result.Props[prop.Key], prop.Value)
+		}
+	}
+	return result
+}
@@ -0,0 +1,34 @@
+package generic
+
+import (
+	"testing"
+
+	serviceutils "github.com/jfrog/jfrog-client-go/artifactory/services/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestToSearchResult(t *testing.T) {
+	result := toSearchResult(&serviceutils.ResultItem{Repo: "libs-local", Path: "a/b", Name: "c.jar", Type: "file", Size: 10, Actual_Sha1: "sha1",
+		Properties: []serviceutils.Property{{Key: "k", Value: "v1"}, {Key: "k", Value: "v2"}}})
+	assert.Equal(t, "libs-local/a/b/c.jar", result.Path)
+	assert.Equal(t, "sha1", result.Sha1)
+	assert.Equal(t, map[string][]string{"k": {"v1", "v2"}}, result.Props)
+
+	assert.Equal(t, "libs-local/c.jar", toSearchResult(&serviceutils.ResultItem{Repo: "libs-local", Path: ".", Name: "c.jar"}).Path)
+	assert.Equal(t, "libs-local/", toSearchResult(&serviceutils.ResultItem{Repo: "libs-local", Path: ".", Name: "."}).Path)
+}
+
+func TestCreatePaginatedSearchQuery(t *testing.T) {
+	params := &serviceutils.CommonParams{Pattern: "libs-local/a/*", Recursive: true}
+	query, err := createPaginatedSearchQuery(params)
+	require.NoError(t, err)
+	assert.Contains(t, query, `.sort({"$asc":["repo","path","name"]})`)
+	assert.NotContains(t, query, ".limit(")
+
+	params = &serviceutils.CommonParams{Pattern: "libs-local/a/*", Recursive: true, Limit: 5}
+	query, err = createPaginatedSearchQuery(params)
+	require.NoError(t, err)
+	assert.NotContains(t, query, ".sort(")
+	assert.Contains(t, query, ".limit(5)")
+}
@@ -37,6 +37,9 @@ func (setProps *SetPropsCommand) Run() (err error) {
 	if err != nil {
 		return err
 	}
+	if !setProps.repoOnly {
+		return setProps.runBatch(servicesManager, false)
+	}
 
 	reader, err := searchItems(setProps.Spec(), servicesManager)
 	if err != nil {
@@ -0,0 +1,113 @@
+package generic
+
+import (
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"net/http"
+	"path"
+
+	"github.com/jfrog/jfrog-cli-core/v2/common/spec"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	serviceutils "github.com/jfrog/jfrog-client-go/artifactory/services/utils"
+	clientutils "github.com/jfrog/jfrog-client-go/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// StdoutOutput is the value of the output option which streams the downloaded artifact to the standard output.
+const StdoutOutput = "-"
+
+// Streams the single artifact matching the spec to the output writer, verifying its checksum on the stream.
+// Since the content is written before it is verified, a checksum mismatch is reported by the returned error only.
+func (dc *DownloadCommand) downloadToWriter(servicesManager artifactory.ArtifactoryServicesManager) (err error) {
+	if len(dc.Spec().Files) != 1 || dc.SyncDeletesPath() != "" || dc.keyProvider != nil || dc.preserveSymlinks || len(dc.entryPatterns) > 0 {
+		return errorutils.CheckErrorf("streaming the download to the standard output supports a single pattern, and can't be combined with sync-deletes, decryption, preserved symbolic links or extracted archive entries")
+	}
+	item, err := searchSingleArtifact(servicesManager, dc.Spec().Get(0))
+	if err != nil {
+		return err
+	}
+	artifactPath := path.Join(item.Repo, item.Path, item.Name)
+	if dc.DryRun() {
+		log.Info("[Dry run] Streaming", artifactPath)
+		dc.result.SetSuccessCount(1)
+		return nil
+	}
+	log.Info("Streaming", artifactPath)
+	if err = streamArtifact(servicesManager, item, dc.output, dc.configuration.SkipChecksum); err != nil {
+		dc.result.SetFailCount(1)
+		return err
+	}
+	dc.result.SetSuccessCount(1)
+	return nil
+}
+
+func searchSingleArtifact(servicesManager artifactory.ArtifactoryServicesManager, file *spec.File) (item *serviceutils.ResultItem, err error) {
+	reader, err := searchItems(&spec.SpecFiles{Files: []spec.File{*file}}, servicesManager)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		err = errors.Join(err, reader.Close())
+	}()
+	var items []*serviceutils.ResultItem
+	for current := new(serviceutils.ResultItem); reader.NextRecord(current) == nil; current = new(serviceutils.ResultItem) {
+		if current.Type != "folder" {
+			items = append(items, current)
+		}
+	}
+	if err = reader.GetError(); err != nil {
+		return nil, err
+	}
+	if len(items) != 1 {
+		return nil, errorutils.CheckErrorf("streaming the download to the standard output requires a single artifact, but %d artifacts match '%s'", len(items), file.Pattern)
+	}
+	return items[0], nil
+}
+
+// Copies the content of the artifact to the writer, and compares its checksum with the checksum held by Artifactory.
+func streamArtifact(servicesManager artifactory.ArtifactoryServicesManager, item *serviceutils.ResultItem, writer io.Writer, skipChecksum bool) (err error) {
+	artifactPath := path.Join(item.Repo, item.Path, item.Name)
+	downloadUrl, err := clientutils.BuildUrl(servicesManager.GetConfig().GetServiceDetails().GetUrl(), artifactPath, make(map[string]string))
+	if err != nil {
+		return err
+	}
+	httpClientDetails := servicesManager.GetConfig().GetServiceDetails().CreateHttpClientDetails()
+	reader, resp, err := servicesManager.Client().ReadRemoteFile(downloadUrl, &httpClientDetails)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		err = errorutils.CheckResponseStatus(resp, http.StatusOK)
+		return errors.Join(err, errorutils.CheckError(resp.Body.Close()))
+	}
+	defer func() {
+		err = errors.Join(err, errorutils.CheckError(reader.Close()))
+	}()
+	if skipChecksum {
+		_, err = io.Copy(writer, reader)
+		return errorutils.CheckError(err)
+	}
+	return copyAndVerifyChecksum(writer, reader, artifactPath, item.Sha256, item.Actual_Sha1)
+}
+
+// Copies the content to the writer, and compares its sha256 checksum with the expected one if given, and its sha1 checksum otherwise.
+func copyAndVerifyChecksum(writer io.Writer, reader io.Reader, artifactPath, expectedSha256, expectedSha1 string) error {
+	var checksumHash hash.Hash = sha256.New()
+	expected := expectedSha256
+	if expected == "" {
+		expected, checksumHash = expectedSha1, sha1.New()
+	}
+	if _, err := io.Copy(io.MultiWriter(writer, checksumHash), reader); err != nil {
+		return errorutils.CheckError(err)
+	}
+	if actual := hex.EncodeToString(checksumHash.Sum(nil)); expected != "" && actual != expected {
+		return errorutils.CheckError(fmt.Errorf("checksum mismatch for '%s': expected %s but the streamed content has %s", artifactPath, expected, actual))
+	}
+	return nil
+}
@@ -0,0 +1,22 @@
+package generic
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCopyAndVerifyChecksum(t *testing.T) {
+	const content = "content"
+	const contentSha256 = "ed7002b439e9ac845f22357d822bac1444730fbdb6016d3ec9432297b9ec9f73"
+	const contentSha1 = "040f06fd774092478d450774f5ba30c5da78acc8"
+	var output bytes.Buffer
+	assert.NoError(t, copyAndVerifyChecksum(&output, strings.NewReader(content), "a/b", contentSha256, ""))
+	assert.Equal(t, content, output.String())
+	assert.NoError(t, copyAndVerifyChecksum(&output, strings.NewReader(content), "a/b", "", contentSha1))
+
+	err := copyAndVerifyChecksum(&output, strings.NewReader(content), "a/b", "", "0000")
+	assert.ErrorContains(t, err, "checksum mismatch for 'a/b'")
+}
@@ -0,0 +1,282 @@
+package generic
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/jfrog/jfrog-cli-core/v2/common/spec"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/jfrog/jfrog-client-go/artifactory/services"
+	serviceutils "github.com/jfrog/jfrog-client-go/artifactory/services/utils"
+	clientutils "github.com/jfrog/jfrog-client-go/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/io/content"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// Properties describing a symbolic link which was uploaded in preserve-symlinks mode.
+// The 'symlink.dest' property is also set by the upload service when symlinks are preserved.
+const (
+	SymlinkDestProp       = "symlink.dest"
+	SymlinkRelativeProp   = "symlink.relative"
+	SymlinkDanglingProp   = "symlink.dangling"
+	SymlinkDestSha256Prop = "symlink.destsha256"
+)
+
+// SymlinkDetails describes a symbolic link, as recorded in the properties of its artifact.
+type SymlinkDetails struct {
+	// The target of the link, exactly as returned by readlink. Relative targets are kept relative.
+	Dest     string
+	Relative bool
+	// True if the target of the link did not exist when the link was uploaded.
+	Dangling bool
+	// The sha256 of the target, if the target is a regular file.
+	DestSha256 string
+}
+
+// ReadSymlinkDetails returns the details of the symbolic link at linkPath, or nil if linkPath isn't a symbolic link.
+func ReadSymlinkDetails(linkPath string) (*SymlinkDetails, error) {
+	fileInfo, err := os.Lstat(linkPath)
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	if fileInfo.Mode()&os.ModeSymlink == 0 {
+		return nil, nil
+	}
+	dest, err := os.Readlink(linkPath)
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	details := &SymlinkDetails{Dest: dest, Relative: !filepath.IsAbs(dest)}
+	targetInfo, err := os.Stat(linkPath)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			return nil, errorutils.CheckError(err)
+		}
+		details.Dangling = true
+		return details, nil
+	}
+	if targetInfo.Mode().IsRegular() {
+		if details.DestSha256, err = fileSha256(linkPath); err != nil {
+			return nil, err
+		}
+	}
+	return details, nil
+}
+
+// SymlinkDetailsFromProperties returns the symbolic link described by the properties of an artifact,
+// or nil if the artifact doesn't represent a symbolic link.
+func SymlinkDetailsFromProperties(properties []serviceutils.Property) *SymlinkDetails {
+	var details *SymlinkDetails
+	values := make(map[string]string, len(properties))
+	for _, property := range properties {
+		values[property.Key] = property.Value
+	}
+	dest, ok := values[SymlinkDestProp]
+	if !ok || dest == "" {
+		return details
+	}
+	details = &SymlinkDetails{Dest: dest, DestSha256: values[SymlinkDestSha256Prop]}
+	details.Relative, _ = strconv.ParseBool(values[SymlinkRelativeProp])
+	if _, ok = values[SymlinkRelativeProp]; !ok {
+		details.Relative = !filepath.IsAbs(dest)
+	}
+	details.Dangling, _ = strconv.ParseBool(values[SymlinkDanglingProp])
+	return details
+}
+
+// Props returns the properties string recording the symbolic link.
+func (sd *SymlinkDetails) Props() string {
+	props := fmt.Sprintf("%s=%s;%s=%t;%s=%t", SymlinkDestProp, sd.Dest, SymlinkRelativeProp, sd.Relative, SymlinkDanglingProp, sd.Dangling)
+	if sd.DestSha256 != "" {
+		props += fmt.Sprintf(";%s=%s", SymlinkDestSha256Prop, sd.DestSha256)
+	}
+	return props
+}
+
+// ResolveDest returns the path the link at linkPath points to. Relative targets are resolved against the link's directory.
+func (sd *SymlinkDetails) ResolveDest(linkPath string) string {
+	if sd.Relative && !filepath.IsAbs(sd.Dest) {
+		return filepath.Join(filepath.Dir(linkPath), sd.Dest)
+	}
+	return sd.Dest
+}
+
+// RecreateSymlink creates the symbolic link at linkPath, replacing any file the download left in its place.
+func RecreateSymlink(linkPath string, details *SymlinkDetails) error {
+	if current, err := os.Readlink(linkPath); err == nil && current == details.Dest {
+		return nil
+	}
+	if err := os.Remove(linkPath); err != nil && !os.IsNotExist(err) {
+		return errorutils.CheckError(err)
+	}
+	if err := os.MkdirAll(filepath.Dir(linkPath), 0755); err != nil {
+		return errorutils.CheckError(err)
+	}
+	return errorutils.CheckError(os.Symlink(details.Dest, linkPath))
+}
+
+// ValidateSymlink verifies that the target of the recreated link matches the target it had on upload.
+// Links which were dangling on upload, and links to directories, aren't validated.
+// A missing target is only reported, since it may be outside the downloaded tree.
+func ValidateSymlink(linkPath string, details *SymlinkDetails) error {
+	if details.Dangling || details.DestSha256 == "" {
+		return nil
+	}
+	dest := details.ResolveDest(linkPath)
+	if _, err := os.Stat(dest); err != nil {
+		if os.IsNotExist(err) {
+			log.Warn(fmt.Sprintf("The target '%s' of the symbolic link '%s' does not exist.", dest, linkPath))
+			return nil
+		}
+		return errorutils.CheckError(err)
+	}
+	actual, err := fileSha256(dest)
+	if err != nil {
+		return err
+	}
+	if actual != details.DestSha256 {
+		return errorutils.CheckErrorf("symlink validation failed for '%s': the sha256 of the target '%s' is %s, expected %s", linkPath, dest, actual, details.DestSha256)
+	}
+	return nil
+}
+
+func fileSha256(filePath string) (checksum string, err error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return "", errorutils.CheckError(err)
+	}
+	defer func() {
+		err = errors.Join(err, errorutils.CheckError(file.Close()))
+	}()
+	hash := sha256.New()
+	if _, err = io.Copy(hash, file); err != nil {
+		return "", errorutils.CheckError(err)
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// Sets the symlink properties on the uploaded artifacts whose source is a symbolic link, and resets the reader for further use.
+func recordSymlinkProps(servicesManager artifactory.ArtifactoryServicesManager, transferDetailsReader *content.ContentReader) error {
+	// Artifacts with identical properties are grouped, to set their properties in a single request.
+	artifactsByProps := make(map[string][]serviceutils.ResultItem)
+	for details := new(clientutils.FileTransferDetails); transferDetailsReader.NextRecord(details) == nil; details = new(clientutils.FileTransferDetails) {
+		symlinkDetails, err := ReadSymlinkDetails(details.SourcePath)
+		if err != nil {
+			return err
+		}
+		if symlinkDetails == nil {
+			continue
+		}
+		artifactPath := strings.TrimPrefix(strings.TrimPrefix(details.TargetPath, details.RtUrl), "/")
+		props := symlinkDetails.Props()
+		artifactsByProps[props] = append(artifactsByProps[props], toResultItem(artifactPath))
+	}
+	transferDetailsReader.Reset()
+	if err := transferDetailsReader.GetError(); err != nil {
+		return err
+	}
+
+	allProps := make([]string, 0, len(artifactsByProps))
+	for props := range artifactsByProps {
+		allProps = append(allProps, props)
+	}
+	sort.Strings(allProps)
+	for _, props := range allProps {
+		if err := setPropsOnItems(servicesManager, artifactsByProps[props], props); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func toResultItem(artifactPath string) serviceutils.ResultItem {
+	repo, relativePath, _ := strings.Cut(artifactPath, "/")
+	dir, name := path.Split(relativePath)
+	dir = strings.TrimSuffix(dir, "/")
+	if dir == "" {
+		dir = "."
+	}
+	return serviceutils.ResultItem{Repo: repo, Path: dir, Name: name}
+}
+
+func setPropsOnItems(servicesManager artifactory.ArtifactoryServicesManager, items []serviceutils.ResultItem, props string) (err error) {
+	reader, err := writeResultItems(items)
+	if err != nil {
+		return
+	}
+	defer func() {
+		err = errors.Join(err, reader.Close())
+	}()
+	_, err = servicesManager.SetProps(services.PropsParams{Reader: reader, Props: props})
+	return
+}
+
+// Writes the items to a temporary file, to be read by the services which operate on search results.
+func writeResultItems(items []serviceutils.ResultItem) (*content.ContentReader, error) {
+	writer, err := content.NewContentWriter(content.DefaultKey, true, false)
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range items {
+		writer.Write(item)
+	}
+	if err = writer.Close(); err != nil {
+		return nil, err
+	}
+	return content.NewContentReader(writer.GetFilePath(), content.DefaultKey), nil
+}
+
+// Recreates the downloaded artifacts which represent symbolic links, and resets the reader for further use.
+func recreateDownloadedSymlinks(servicesManager artifactory.ArtifactoryServicesManager, downloadSpec *spec.SpecFiles, transferDetailsReader *content.ContentReader, validate bool) (err error) {
+	symlinks, err := searchSymlinks(servicesManager, downloadSpec)
+	if err != nil || len(symlinks) == 0 {
+		return
+	}
+	for details := new(clientutils.FileTransferDetails); transferDetailsReader.NextRecord(details) == nil; details = new(clientutils.FileTransferDetails) {
+		artifactPath := strings.TrimPrefix(strings.TrimPrefix(details.SourcePath, details.RtUrl), "/")
+		symlinkDetails, ok := symlinks[artifactPath]
+		if !ok {
+			continue
+		}
+		log.Debug(fmt.Sprintf("Creating the symbolic link '%s' -> '%s'", details.TargetPath, symlinkDetails.Dest))
+		if err = RecreateSymlink(details.TargetPath, symlinkDetails); err != nil {
+			return
+		}
+		if validate {
+			if err = ValidateSymlink(details.TargetPath, symlinkDetails); err != nil {
+				return
+			}
+		}
+	}
+	transferDetailsReader.Reset()
+	return transferDetailsReader.GetError()
+}
+
+// Returns a map of: artifact-path -> symlink-details, for the artifacts matched by the spec which represent symbolic links.
+func searchSymlinks(servicesManager artifactory.ArtifactoryServicesManager, downloadSpec *spec.SpecFiles) (symlinks map[string]*SymlinkDetails, err error) {
+	reader, err := searchItems(downloadSpec, servicesManager)
+	if err != nil {
+		return
+	}
+	defer func() {
+		err = errors.Join(err, reader.Close())
+	}()
+	symlinks = make(map[string]*SymlinkDetails)
+	for item := new(serviceutils.ResultItem); reader.NextRecord(item) == nil; item = new(serviceutils.ResultItem) {
+		if symlinkDetails := SymlinkDetailsFromProperties(item.Properties); symlinkDetails != nil {
+			symlinks[path.Join(item.Repo, item.Path, item.Name)] = symlinkDetails
+		}
+	}
+	err = reader.GetError()
+	return
+}
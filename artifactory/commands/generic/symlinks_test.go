@@ -0,0 +1,104 @@
+package generic
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	serviceutils "github.com/jfrog/jfrog-client-go/artifactory/services/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadSymlinkDetails(t *testing.T) {
+	root := t.TempDir()
+	target := filepath.Join(root, "lib", "a.txt")
+	require.NoError(t, os.MkdirAll(filepath.Dir(target), 0755))
+	require.NoError(t, os.WriteFile(target, []byte("content"), 0644))
+	expectedSha256, err := fileSha256(target)
+	require.NoError(t, err)
+
+	relativeLink := filepath.Join(root, "relative")
+	require.NoError(t, os.Symlink(filepath.Join("lib", "a.txt"), relativeLink))
+	details, err := ReadSymlinkDetails(relativeLink)
+	require.NoError(t, err)
+	assert.Equal(t, &SymlinkDetails{Dest: filepath.Join("lib", "a.txt"), Relative: true, DestSha256: expectedSha256}, details)
+
+	absoluteLink := filepath.Join(root, "absolute")
+	require.NoError(t, os.Symlink(target, absoluteLink))
+	details, err = ReadSymlinkDetails(absoluteLink)
+	require.NoError(t, err)
+	assert.Equal(t, &SymlinkDetails{Dest: target, DestSha256: expectedSha256}, details)
+
+	danglingLink := filepath.Join(root, "dangling")
+	require.NoError(t, os.Symlink("missing", danglingLink))
+	details, err = ReadSymlinkDetails(danglingLink)
+	require.NoError(t, err)
+	assert.Equal(t, &SymlinkDetails{Dest: "missing", Relative: true, Dangling: true}, details)
+
+	dirLink := filepath.Join(root, "dir")
+	require.NoError(t, os.Symlink("lib", dirLink))
+	details, err = ReadSymlinkDetails(dirLink)
+	require.NoError(t, err)
+	assert.Equal(t, &SymlinkDetails{Dest: "lib", Relative: true}, details)
+
+	details, err = ReadSymlinkDetails(target)
+	require.NoError(t, err)
+	assert.Nil(t, details)
+}
+
+func TestSymlinkDetailsFromProperties(t *testing.T) {
+	assert.Nil(t, SymlinkDetailsFromProperties([]serviceutils.Property{{Key: "a", Value: "b"}}))
+
+	details := SymlinkDetailsFromProperties([]serviceutils.Property{
+		{Key: SymlinkDestProp, Value: "../a.txt"},
+		{Key: SymlinkRelativeProp, Value: "true"},
+		{Key: SymlinkDanglingProp, Value: "false"},
+		{Key: SymlinkDestSha256Prop, Value: "abc"},
+	})
+	assert.Equal(t, &SymlinkDetails{Dest: "../a.txt", Relative: true, DestSha256: "abc"}, details)
+	assert.Equal(t, "symlink.dest=../a.txt;symlink.relative=true;symlink.dangling=false;symlink.destsha256=abc", details.Props())
+
+	// Links uploaded with --symlinks only have the 'symlink.dest' property.
+	details = SymlinkDetailsFromProperties([]serviceutils.Property{{Key: SymlinkDestProp, Value: "a.txt"}})
+	assert.Equal(t, &SymlinkDetails{Dest: "a.txt", Relative: true}, details)
+}
+
+func TestRecreateAndValidateSymlink(t *testing.T) {
+	root := t.TempDir()
+	target := filepath.Join(root, "a.txt")
+	require.NoError(t, os.WriteFile(target, []byte("content"), 0644))
+	checksum, err := fileSha256(target)
+	require.NoError(t, err)
+
+	// The download leaves an empty file in place of the link.
+	linkPath := filepath.Join(root, "sub", "link")
+	require.NoError(t, os.MkdirAll(filepath.Dir(linkPath), 0755))
+	require.NoError(t, os.WriteFile(linkPath, nil, 0644))
+	details := &SymlinkDetails{Dest: filepath.Join("..", "a.txt"), Relative: true, DestSha256: checksum}
+	require.NoError(t, RecreateSymlink(linkPath, details))
+	dest, err := os.Readlink(linkPath)
+	require.NoError(t, err)
+	assert.Equal(t, details.Dest, dest)
+	assert.Equal(t, target, details.ResolveDest(linkPath))
+	assert.NoError(t, ValidateSymlink(linkPath, details))
+
+	// Recreating an existing link is a no-op.
+	assert.NoError(t, RecreateSymlink(linkPath, details))
+
+	details.DestSha256 = "mismatch"
+	assert.ErrorContains(t, ValidateSymlink(linkPath, details), "symlink validation failed")
+
+	danglingPath := filepath.Join(root, "dangling")
+	dangling := &SymlinkDetails{Dest: "missing", Relative: true, Dangling: true}
+	require.NoError(t, RecreateSymlink(danglingPath, dangling))
+	dest, err = os.Readlink(danglingPath)
+	require.NoError(t, err)
+	assert.Equal(t, "missing", dest)
+	assert.NoError(t, ValidateSymlink(danglingPath, dangling))
+}
+
+func TestToResultItem(t *testing.T) {
+	assert.Equal(t, serviceutils.ResultItem{Repo: "repo", Path: "a/b", Name: "c"}, toResultItem("repo/a/b/c"))
+	assert.Equal(t, serviceutils.ResultItem{Repo: "repo", Path: ".", Name: "c"}, toResultItem("repo/c"))
+}
@@ -0,0 +1,268 @@
+package generic
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clientconfig"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/protection"
+	commonCliUtils "github.com/jfrog/jfrog-cli-core/v2/common/cliutils"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/jfrog/jfrog-client-go/artifactory/services"
+	serviceutils "github.com/jfrog/jfrog-client-go/artifactory/services/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/io/fileutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const (
+	// Local files which are new or changed are uploaded.
+	SyncPush = "push"
+	// Remote files which are new or changed are downloaded.
+	SyncPull = "pull"
+	// New files are transferred in both directions. Changed files are transferred from the side on which they were modified last.
+	SyncBoth = "both"
+)
+
+// SyncCommand synchronizes a local directory with an Artifactory path, comparing their files by checksum.
+type SyncCommand struct {
+	serverDetails *config.ServerDetails
+	localPath     string
+	repoPath      string
+	direction     string
+	// Whether files which exist only on the target side are deleted. Not supported when syncing in both directions.
+	deleteMissing bool
+	dryRun        bool
+	threads       int
+	plan          *SyncPlan
+}
+
+// SyncPlan lists the paths, relative to the local directory and to the Artifactory path, to transfer and delete.
+type SyncPlan struct {
+	Upload       []string `json:"upload,omitempty"`
+	Download     []string `json:"download,omitempty"`
+	DeleteRemote []string `json:"deleteRemote,omitempty"`
+	DeleteLocal  []string `json:"deleteLocal,omitempty"`
+}
+
+func NewSyncCommand() *SyncCommand {
+	return &SyncCommand{direction: SyncPush, threads: commonCliUtils.Threads}
+}
+
+func (sc *SyncCommand) SetServerDetails(serverDetails *config.ServerDetails) *SyncCommand {
+	sc.serverDetails = serverDetails
+	return sc
+}
+
+func (sc *SyncCommand) SetLocalPath(localPath string) *SyncCommand {
+	sc.localPath = localPath
+	return sc
+}
+
+func (sc *SyncCommand) SetRepoPath(repoPath string) *SyncCommand {
+	sc.repoPath = strings.Trim(repoPath, "/")
+	return sc
+}
+
+func (sc *SyncCommand) SetDirection(direction string) *SyncCommand {
+	sc.direction = direction
+	return sc
+}
+
+func (sc *SyncCommand) SetDeleteMissing(deleteMissing bool) *SyncCommand {
+	sc.deleteMissing = deleteMissing
+	return sc
+}
+
+func (sc *SyncCommand) SetDryRun(dryRun bool) *SyncCommand {
+	sc.dryRun = dryRun
+	return sc
+}
+
+func (sc *SyncCommand) SetThreads(threads int) *SyncCommand {
+	sc.threads = threads
+	return sc
+}
+
+func (sc *SyncCommand) Plan() *SyncPlan {
+	return sc.plan
+}
+
+func (sc *SyncCommand) ServerDetails() (*config.ServerDetails, error) {
+	return sc.serverDetails, nil
+}
+
+func (sc *SyncCommand) CommandName() string {
+	return "rt_sync"
+}
+
+func (sc *SyncCommand) Run() error {
+	if err := sc.validate(); err != nil {
+		return err
+	}
+	servicesManager, err := clientconfig.CreateServiceManagerWithThreads(sc.serverDetails, sc.dryRun, sc.threads, -1, 0)
+	if err != nil {
+		return err
+	}
+	remoteFiles, err := listRemoteTree(servicesManager, sc.repoPath)
+	if err != nil {
+		return err
+	}
+	report, err := compareLocalTree(sc.localPath, remoteFiles)
+	if err != nil {
+		return err
+	}
+	sc.plan, err = planSync(report, sc.direction, sc.deleteMissing, func(relativePath string) (bool, error) {
+		return isLocalFileNewer(filepath.Join(sc.localPath, filepath.FromSlash(relativePath)), remoteFiles[relativePath].modified)
+	})
+	if err != nil {
+		return err
+	}
+	log.Info(fmt.Sprintf("Syncing '%s' and '%s': %d files to upload, %d to download, %d to delete from Artifactory and %d to delete locally.",
+		sc.localPath, sc.repoPath, len(sc.plan.Upload), len(sc.plan.Download), len(sc.plan.DeleteRemote), len(sc.plan.DeleteLocal)))
+	return sc.execute(servicesManager)
+}
+
+func (sc *SyncCommand) validate() error {
+	switch sc.direction {
+	case SyncPush, SyncPull:
+	case SyncBoth:
+		if sc.deleteMissing {
+			return errorutils.CheckErrorf("deleting missing files isn't supported when syncing in both directions, since files which exist on a single side are transferred")
+		}
+	default:
+		return errorutils.CheckErrorf("unsupported sync direction '%s'. Supported directions are %s, %s and %s", sc.direction, SyncPush, SyncPull, SyncBoth)
+	}
+	if sc.repoPath == "" || strings.ContainsAny(sc.repoPath, "*?") {
+		return errorutils.CheckErrorf("the Artifactory path '%s' must be a repository or a folder, without wildcards", sc.repoPath)
+	}
+	return fileutils.CreateDirIfNotExist(sc.localPath)
+}
+
+// Divides the differences between the local directory and the Artifactory path into transfers and deletions according to the direction.
+// isLocalNewer decides the direction of modified files when syncing in both directions.
+func planSync(report *DriftReport, direction string, deleteMissing bool, isLocalNewer func(relativePath string) (bool, error)) (*SyncPlan, error) {
+	plan := &SyncPlan{}
+	switch direction {
+	case SyncPush:
+		plan.Upload = append(append(plan.Upload, report.Extra...), report.Modified...)
+		if deleteMissing {
+			plan.DeleteRemote = report.Missing
+		}
+	case SyncPull:
+		plan.Download = append(append(plan.Download, report.Missing...), report.Modified...)
+		if deleteMissing {
+			plan.DeleteLocal = report.Extra
+		}
+	case SyncBoth:
+		plan.Upload = append(plan.Upload, report.Extra...)
+		plan.Download = append(plan.Download, report.Missing...)
+		for _, relativePath := range report.Modified {
+			localNewer, err := isLocalNewer(relativePath)
+			if err != nil {
+				return nil, err
+			}
+			if localNewer {
+				plan.Upload = append(plan.Upload, relativePath)
+			} else {
+				plan.Download = append(plan.Download, relativePath)
+			}
+		}
+	}
+	return plan, nil
+}
+
+func isLocalFileNewer(localPath, remoteModified string) (bool, error) {
+	info, err := os.Stat(localPath)
+	if err != nil {
+		return false, errorutils.CheckError(err)
+	}
+	modified, err := time.Parse(time.RFC3339, remoteModified)
+	if err != nil {
+		return false, errorutils.CheckErrorf("failed to parse the modification time '%s' of a file in Artifactory: %s", remoteModified, err.Error())
+	}
+	return info.ModTime().After(modified), nil
+}
+
+func (sc *SyncCommand) execute(servicesManager artifactory.ArtifactoryServicesManager) error {
+	var failed int
+	if len(sc.plan.Upload) > 0 {
+		var uploadParams []services.UploadParams
+		for _, relativePath := range sc.plan.Upload {
+			params := services.NewUploadParams()
+			params.Pattern = filepath.Join(sc.localPath, filepath.FromSlash(relativePath))
+			params.Target = path.Join(sc.repoPath, relativePath)
+			params.Flat = true
+			uploadParams = append(uploadParams, params)
+		}
+		_, uploadFailed, err := servicesManager.UploadFiles(artifactory.UploadServiceOptions{}, uploadParams...)
+		if err != nil {
+			return err
+		}
+		failed += uploadFailed
+	}
+	if len(sc.plan.Download) > 0 {
+		var downloadParams []services.DownloadParams
+		for _, relativePath := range sc.plan.Download {
+			params := services.NewDownloadParams()
+			params.Pattern = path.Join(sc.repoPath, relativePath)
+			params.Target = filepath.Join(sc.localPath, filepath.FromSlash(path.Dir(relativePath))) + string(filepath.Separator)
+			params.Flat = true
+			downloadParams = append(downloadParams, params)
+		}
+		_, downloadFailed, err := servicesManager.DownloadFiles(downloadParams...)
+		if err != nil {
+			return err
+		}
+		failed += downloadFailed
+	}
+	if len(sc.plan.DeleteRemote) > 0 {
+		if err := deleteRemoteFiles(servicesManager, sc.repoPath, sc.plan.DeleteRemote); err != nil {
+			return err
+		}
+	}
+	for _, relativePath := range sc.plan.DeleteLocal {
+		localPath := filepath.Join(sc.localPath, filepath.FromSlash(relativePath))
+		if sc.dryRun {
+			log.Info("[Dry run] Deleting", localPath)
+			continue
+		}
+		log.Info("Deleting", localPath)
+		if err := os.Remove(localPath); err != nil {
+			return errorutils.CheckError(err)
+		}
+	}
+	if failed > 0 {
+		return errorutils.CheckErrorf("failed to transfer %d files, please review the logs", failed)
+	}
+	return nil
+}
+
+// Deletes the remote files, unless any of them is, or is contained in, an item which is immutable or under legal hold.
+func deleteRemoteFiles(servicesManager artifactory.ArtifactoryServicesManager, repoPath string, relativePaths []string) (err error) {
+	var items []serviceutils.ResultItem
+	var paths []string
+	for _, relativePath := range relativePaths {
+		remotePath := path.Join(repoPath, relativePath)
+		items = append(items, toResultItem(remotePath))
+		paths = append(paths, remotePath)
+	}
+	if err = protection.VerifyNotProtected(servicesManager, "delete", paths); err != nil {
+		return err
+	}
+	reader, err := writeResultItems(items)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = errors.Join(err, reader.Close())
+	}()
+	_, err = servicesManager.DeleteFiles(reader)
+	return
+}
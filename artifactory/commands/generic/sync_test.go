@@ -0,0 +1,74 @@
+package generic
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/protection"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlanSync(t *testing.T) {
+	report := &DriftReport{Missing: []string{"remote.txt"}, Extra: []string{"local.txt"}, Modified: []string{"new-locally.txt", "new-remotely.txt"}}
+	isLocalNewer := func(relativePath string) (bool, error) {
+		return relativePath == "new-locally.txt", nil
+	}
+	tests := []struct {
+		direction     string
+		deleteMissing bool
+		expected      *SyncPlan
+	}{
+		{SyncPush, false, &SyncPlan{Upload: []string{"local.txt", "new-locally.txt", "new-remotely.txt"}}},
+		{SyncPush, true, &SyncPlan{Upload: []string{"local.txt", "new-locally.txt", "new-remotely.txt"}, DeleteRemote: []string{"remote.txt"}}},
+		{SyncPull, false, &SyncPlan{Download: []string{"remote.txt", "new-locally.txt", "new-remotely.txt"}}},
+		{SyncPull, true, &SyncPlan{Download: []string{"remote.txt", "new-locally.txt", "new-remotely.txt"}, DeleteLocal: []string{"local.txt"}}},
+		{SyncBoth, false, &SyncPlan{Upload: []string{"local.txt", "new-locally.txt"}, Download: []string{"remote.txt", "new-remotely.txt"}}},
+	}
+	for _, test := range tests {
+		t.Run(test.direction, func(t *testing.T) {
+			plan, err := planSync(report, test.direction, test.deleteMissing, isLocalNewer)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, plan)
+		})
+	}
+}
+
+func TestSyncValidate(t *testing.T) {
+	localPath := t.TempDir()
+	assert.ErrorContains(t, NewSyncCommand().SetLocalPath(localPath).SetRepoPath("generic-local").SetDirection("sideways").validate(), "unsupported sync direction")
+	assert.ErrorContains(t, NewSyncCommand().SetLocalPath(localPath).SetRepoPath("generic-local").SetDirection(SyncBoth).SetDeleteMissing(true).validate(), "isn't supported")
+	assert.ErrorContains(t, NewSyncCommand().SetLocalPath(localPath).SetRepoPath("generic-local/*").validate(), "without wildcards")
+	assert.NoError(t, NewSyncCommand().SetLocalPath(localPath).SetRepoPath("generic-local/dir/").validate())
+}
+
+func TestDeleteRemoteFilesProtected(t *testing.T) {
+	var deletes []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/search/aql" {
+			body, err := io.ReadAll(r.Body)
+			assert.NoError(t, err)
+			if strings.Contains(string(body), protection.LegalHoldProperty) {
+				_, _ = w.Write([]byte(`{"results": [{"repo": "libs-local", "path": "dir", "name": "held.txt", "type": "file"}]}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"results": []}`))
+			return
+		}
+		if r.Method == http.MethodDelete {
+			deletes = append(deletes, r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+	servicesManager, err := createPropsServiceManager(1, 0, 0, &config.ServerDetails{ArtifactoryUrl: server.URL + "/"})
+	require.NoError(t, err)
+
+	err = deleteRemoteFiles(servicesManager, "libs-local/dir", []string{"removed.txt", "held.txt"})
+	assert.ErrorContains(t, err, "libs-local/dir/held.txt (under legal hold)")
+	assert.Empty(t, deletes)
+}
@@ -2,6 +2,7 @@ package generic
 
 import (
 	"errors"
+	"fmt"
 	"os"
 	"strconv"
 	"time"
@@ -9,6 +10,9 @@ import (
 	buildInfo "github.com/jfrog/build-info-go/entities"
 	ioutils "github.com/jfrog/gofrog/io"
 	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/civcs"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clientconfig"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/encryption"
+	commandsutils "github.com/jfrog/jfrog-cli-core/v2/artifactory/commands/utils"
 	"github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
 	"github.com/jfrog/jfrog-cli-core/v2/artifactory/utils/commandsummary"
 	"github.com/jfrog/jfrog-cli-core/v2/common/build"
@@ -20,6 +24,7 @@ import (
 	"github.com/jfrog/jfrog-client-go/utils/errorutils"
 	ioUtils "github.com/jfrog/jfrog-client-go/utils/io"
 	"github.com/jfrog/jfrog-client-go/utils/io/content"
+	"github.com/jfrog/jfrog-client-go/utils/io/fileutils"
 	"github.com/jfrog/jfrog-client-go/utils/log"
 )
 
@@ -28,6 +33,16 @@ type UploadCommand struct {
 	uploadConfiguration *utils.UploadConfiguration
 	buildConfiguration  *build.BuildConfiguration
 	progress            ioUtils.ProgressMgr
+	deltaManifest       bool
+	keyProvider         encryption.KeyProvider
+	preserveSymlinks    bool
+	dedup               bool
+	dedupRepos          []string
+	dedupStats          DedupStats
+	atomic              bool
+	stagingRepo         string
+	// Set on the internal dry runs, whose files shouldn't be added to the command summary.
+	skipCommandSummary bool
 }
 
 func NewUploadCommand() *UploadCommand {
@@ -48,6 +63,62 @@ func (uc *UploadCommand) SetUploadConfiguration(uploadConfiguration *utils.Uploa
 	return uc
 }
 
+func (uc *UploadCommand) DeltaManifest() bool {
+	return uc.deltaManifest
+}
+
+// SetDeltaManifest sets whether a block manifest should be deployed next to each uploaded file, to allow delta downloads.
+func (uc *UploadCommand) SetDeltaManifest(deltaManifest bool) *UploadCommand {
+	uc.deltaManifest = deltaManifest
+	return uc
+}
+
+// SetEncryptionKeyProvider enables client-side encryption. The files are encrypted before the upload,
+// and the data key wrapped by the key provider is stored as a property of the artifacts.
+func (uc *UploadCommand) SetEncryptionKeyProvider(keyProvider encryption.KeyProvider) *UploadCommand {
+	uc.keyProvider = keyProvider
+	return uc
+}
+
+// SetPreserveSymlinks sets whether symbolic links should be uploaded as links rather than followed.
+// The target of each link, whether it is relative or dangling and the sha256 of its target are recorded as properties.
+func (uc *UploadCommand) SetPreserveSymlinks(preserveSymlinks bool) *UploadCommand {
+	uc.preserveSymlinks = preserveSymlinks
+	return uc
+}
+
+// SetDedup sets whether the content of the local files should be searched in Artifactory before the upload.
+// Files whose content already exists in one of the dedup repositories, or in any repository if none are set,
+// are deployed by checksum without transferring their content.
+func (uc *UploadCommand) SetDedup(dedup bool) *UploadCommand {
+	uc.dedup = dedup
+	return uc
+}
+
+func (uc *UploadCommand) SetDedupRepos(dedupRepos []string) *UploadCommand {
+	uc.dedupRepos = dedupRepos
+	return uc
+}
+
+// DedupStats returns the number of files and bytes which were deployed by checksum, following the dedup phase.
+func (uc *UploadCommand) DedupStats() DedupStats {
+	return uc.dedupStats
+}
+
+// SetAtomic sets whether the files should be uploaded to a staging directory, and moved to their targets only after all
+// of them were uploaded. If any file fails to upload, none of the files is moved, and the staging directory is deleted.
+func (uc *UploadCommand) SetAtomic(atomic bool) *UploadCommand {
+	uc.atomic = atomic
+	return uc
+}
+
+// SetStagingRepo sets the repository in which the files of an atomic upload are staged. If not set, the files are
+// staged in their target repositories.
+func (uc *UploadCommand) SetStagingRepo(stagingRepo string) *UploadCommand {
+	uc.stagingRepo = stagingRepo
+	return uc
+}
+
 func (uc *UploadCommand) SetProgress(progress ioUtils.ProgressMgr) {
 	uc.progress = progress
 }
@@ -65,7 +136,9 @@ func (uc *UploadCommand) CommandName() string {
 }
 
 func (uc *UploadCommand) Run() error {
-	return uc.upload()
+	err := uc.upload()
+	uc.callbacks.Error(err)
+	return err
 }
 
 // Uploads the artifacts in the specified local path pattern to the specified target path.
@@ -92,10 +165,14 @@ func (uc *UploadCommand) upload() (err error) {
 	if errorutils.CheckError(err) != nil {
 		return
 	}
-	servicesManager, err := utils.CreateUploadServiceManager(serverDetails, uc.uploadConfiguration.Threads, uc.retries, uc.retryWaitTimeMilliSecs, uc.DryRun(), uc.progress)
+	servicesManager, err := clientconfig.CreateUploadServiceManager(serverDetails, uc.uploadConfiguration.Threads, uc.retries, uc.retryWaitTimeMilliSecs, uc.DryRun(), uc.wrapProgress(uc.progress))
 	if err != nil {
 		return
 	}
+	uc.attachConcurrencyLimiter(servicesManager, uc.uploadConfiguration.Threads)
+	if err = uc.resolveProjectRepos(servicesManager, true); err != nil {
+		return
+	}
 
 	addVcsProps := false
 	buildProps := ""
@@ -112,22 +189,73 @@ func (uc *UploadCommand) upload() (err error) {
 		}
 	}
 
+	restoreSpec, err := uc.applyJfrogIgnore()
+	if err != nil {
+		return
+	}
+	defer restoreSpec()
+
+	encryptionProps := ""
+	if uc.keyProvider != nil {
+		var cleanup func() error
+		if encryptionProps, cleanup, err = uc.stageEncryptedUpload(); err != nil {
+			return
+		}
+		defer func() {
+			err = errors.Join(err, cleanup())
+		}()
+	}
+
+	var dedupMinSizes map[int]int64
+	if uc.dedup {
+		if dedupMinSizes, uc.dedupStats, err = planDedup(servicesManager, uc.Spec().Files, uc.dedupRepos); err != nil {
+			return
+		}
+		log.Info(fmt.Sprintf("Found the content of %d files (%d bytes) in Artifactory. These files will be deployed by checksum.", uc.dedupStats.Files, uc.dedupStats.Bytes))
+	}
+
+	var staging *uploadStaging
+	if uc.atomic && !uc.DryRun() {
+		if uc.deltaManifest {
+			return errorutils.CheckErrorf("block manifests cannot be created for atomic uploads")
+		}
+		staging = newUploadStaging(uc.stagingRepo)
+		defer func() {
+			err = errors.Join(err, staging.cleanup(servicesManager))
+		}()
+	}
+
 	var errorOccurred = false
 	var uploadParamsArray []services.UploadParams
+	var streamedArchives []*spec.File
 	// Create UploadParams for all File-Spec groups.
 	for i := 0; i < len(uc.Spec().Files); i++ {
 		file := uc.Spec().Get(i)
 		file.TargetProps = clientUtils.AddProps(file.TargetProps, file.Props)
 		file.TargetProps = clientUtils.AddProps(file.TargetProps, syncDeletesProp)
+		file.TargetProps = clientUtils.AddProps(file.TargetProps, encryptionProps)
 		file.Props += syncDeletesProp
+		if uc.preserveSymlinks {
+			file.Symlinks = "true"
+		}
 		// Add CI VCS properties if in CI environment (respects user precedence)
 		file.TargetProps = civcs.MergeWithUserProps(file.TargetProps)
+		if IsStreamedArchive(file) {
+			streamedArchives = append(streamedArchives, file)
+			continue
+		}
 		uploadParams, err := getUploadParams(file, uc.uploadConfiguration, buildProps, addVcsProps, uc.DryRun())
 		if err != nil {
 			errorOccurred = true
 			log.Error(err)
 			continue
 		}
+		if staging != nil {
+			uploadParams.SetTarget(staging.stagedTarget(uploadParams.GetTarget()))
+		}
+		if minSize, ok := dedupMinSizes[i]; ok && minSize < uploadParams.MinChecksumDeploy {
+			uploadParams.MinChecksumDeploy = minSize
+		}
 		uploadParamsArray = append(uploadParamsArray, uploadParams)
 	}
 
@@ -136,7 +264,7 @@ func (uc *UploadCommand) upload() (err error) {
 	// otherwise we use the upload service which provides only general counters.
 	var successCount, failCount int
 	var artifactsDetailsReader *content.ContentReader = nil
-	if uc.DetailedSummary() || toCollect {
+	if uc.DetailedSummary() || toCollect || uc.deltaManifest || uc.preserveSymlinks || uc.callbacks.ReportsCompletedFiles() || staging != nil {
 		var summary *rtServicesUtils.OperationSummary
 		summary, err = servicesManager.UploadFilesWithSummary(artifactory.UploadServiceOptions{}, uploadParamsArray...)
 		if err != nil {
@@ -146,6 +274,28 @@ func (uc *UploadCommand) upload() (err error) {
 		if summary != nil {
 			artifactsDetailsReader = summary.ArtifactsDetailsReader
 			defer ioutils.Close(artifactsDetailsReader, &err)
+			if err = uc.callbacks.ReportCompletedFiles(summary.TransferDetailsReader); err != nil {
+				errorOccurred = true
+				log.Error(err)
+			}
+			if staging != nil {
+				if err = staging.addUploaded(summary.TransferDetailsReader); err != nil {
+					errorOccurred = true
+					log.Error(err)
+				}
+			}
+			if uc.deltaManifest && !uc.DryRun() {
+				if err = uploadBlockManifests(servicesManager, summary.TransferDetailsReader); err != nil {
+					errorOccurred = true
+					log.Error(err)
+				}
+			}
+			if uc.preserveSymlinks && !uc.DryRun() {
+				if err = recordSymlinkProps(servicesManager, summary.TransferDetailsReader); err != nil {
+					errorOccurred = true
+					log.Error(err)
+				}
+			}
 			// If 'detailed summary' was requested, then the reader should not be closed here.
 			// It will be closed after it will be used to generate the summary.
 			if uc.DetailedSummary() {
@@ -160,8 +310,10 @@ func (uc *UploadCommand) upload() (err error) {
 			successCount = summary.TotalSucceeded
 			failCount = summary.TotalFailed
 
-			if err = recordCommandSummary(summary); err != nil {
-				return
+			if !uc.skipCommandSummary {
+				if err = recordCommandSummary(summary); err != nil {
+					return
+				}
 			}
 		}
 	} else {
@@ -171,6 +323,28 @@ func (uc *UploadCommand) upload() (err error) {
 			log.Error(err)
 		}
 	}
+	var archiveArtifacts []buildInfo.Artifact
+	for _, file := range streamedArchives {
+		if staging != nil {
+			stagedFile := *file
+			stagedFile.Target = staging.stagedTarget(file.Target)
+			file = &stagedFile
+		}
+		artifact, archiveErr := uploadStreamedArchive(servicesManager, file, buildProps, uc.DryRun())
+		if archiveErr != nil {
+			errorOccurred = true
+			failCount++
+			log.Error(archiveErr)
+			continue
+		}
+		successCount++
+		if staging != nil {
+			staging.addStaged(file.Target)
+		}
+		if artifact != nil {
+			archiveArtifacts = append(archiveArtifacts, *artifact)
+		}
+	}
 	uc.result.SetSuccessCount(successCount)
 	uc.result.SetFailCount(failCount)
 	if errorOccurred {
@@ -181,6 +355,12 @@ func (uc *UploadCommand) upload() (err error) {
 		return
 	}
 
+	if staging != nil {
+		if err = staging.publish(servicesManager, uc.uploadConfiguration.Threads); err != nil {
+			return
+		}
+	}
+
 	// Handle sync-deletes
 	if uc.syncDelete() {
 		err = uc.handleSyncDeletes(syncDeletesProp)
@@ -196,12 +376,66 @@ func (uc *UploadCommand) upload() (err error) {
 		if err != nil {
 			return
 		}
+		buildArtifacts = append(buildArtifacts, archiveArtifacts...)
+		if staging != nil {
+			buildArtifacts = staging.finalArtifacts(buildArtifacts)
+		}
 		return build.PopulateBuildArtifactsAsPartials(buildArtifacts, uc.buildConfiguration, buildInfo.Generic)
 	}
 
 	return
 }
 
+// Encrypts the files to upload into a temp directory, and replaces the spec by a spec which uploads the encrypted files
+// until cleanup is called. Returns the properties describing the encryption envelope.
+func (uc *UploadCommand) stageEncryptedUpload() (props string, cleanup func() error, err error) {
+	if uc.deltaManifest {
+		return "", nil, errorutils.CheckErrorf("block manifests cannot be created for encrypted files")
+	}
+	envelope, err := encryption.NewEnvelope(uc.keyProvider)
+	if err != nil {
+		return
+	}
+	stagedSpec, tmpRoot, err := stageEncryptedFiles(uc, envelope)
+	removeTmpRoot := func() error {
+		return fileutils.RemoveTempDir(tmpRoot)
+	}
+	if err != nil {
+		return "", nil, errors.Join(err, removeTmpRoot())
+	}
+	originalSpec := uc.Spec()
+	uc.SetSpec(stagedSpec)
+	cleanup = func() error {
+		uc.SetSpec(originalSpec)
+		return removeTmpRoot()
+	}
+	return envelope.Properties(), cleanup, nil
+}
+
+// dryRunUpload runs the upload of the spec by a dry run copy of the command, and returns the files it would transfer.
+func dryRunUpload(uploadCmd *UploadCommand, uploadSpec *spec.SpecFiles) (transfers []clientUtils.FileTransferDetails, err error) {
+	dryRunCmd := *uploadCmd
+	dryRunCmd.GenericCommand.result = new(commandsutils.Result)
+	dryRunCmd.SetSpec(uploadSpec).SetDryRun(true).SetDetailedSummary(true).SetSyncDeletesPath("").SetCallbacks(nil)
+	dryRunCmd.progress = nil
+	dryRunCmd.keyProvider = nil
+	dryRunCmd.dedup = false
+	dryRunCmd.deltaManifest = false
+	dryRunCmd.skipCommandSummary = true
+	if err = dryRunCmd.upload(); err != nil {
+		return
+	}
+	reader := dryRunCmd.Result().Reader()
+	if reader == nil {
+		return
+	}
+	defer ioutils.Close(reader, &err)
+	for details := new(clientUtils.FileTransferDetails); reader.NextRecord(details) == nil; details = new(clientUtils.FileTransferDetails) {
+		transfers = append(transfers, *details)
+	}
+	return transfers, errorutils.CheckError(reader.GetError())
+}
+
 func getUploadParams(f *spec.File, configuration *utils.UploadConfiguration, buildProps string, addVcsProps bool, dryRun bool) (uploadParams services.UploadParams, err error) {
 	uploadParams = services.NewUploadParams()
 	uploadParams.CommonParams, err = f.ToCommonParams()
@@ -261,7 +495,7 @@ func getUploadParams(f *spec.File, configuration *utils.UploadConfiguration, bui
 }
 
 func (uc *UploadCommand) handleSyncDeletes(syncDeletesProp string) (err error) {
-	servicesManager, err := utils.CreateServiceManager(uc.serverDetails, uc.retries, uc.retryWaitTimeMilliSecs, false)
+	servicesManager, err := clientconfig.CreateServiceManager(uc.serverDetails, uc.retries, uc.retryWaitTimeMilliSecs, false)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,149 @@
+package generic
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/jfrogignore"
+	"github.com/jfrog/jfrog-cli-core/v2/common/spec"
+	"github.com/jfrog/jfrog-client-go/artifactory/services/fspatterns"
+	clientutils "github.com/jfrog/jfrog-client-go/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/io/fileutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// Replaces each spec file whose files are under a directory with a .jfrogignore file, in the working directory or in
+// the root directory of its pattern, by spec files which upload each of its files which aren't ignored, until cleanup
+// is called. The directories are walked by the upload threads, and the ignored directories aren't walked at all.
+func (uc *UploadCommand) applyJfrogIgnore() (cleanup func(), err error) {
+	workingDir, err := os.Getwd()
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	expandedSpec := new(spec.SpecFiles)
+	expanded := false
+	for _, file := range uc.Spec().Files {
+		var files []spec.File
+		if files, err = expandIgnoredFiles(file, workingDir, uc.uploadConfiguration.Threads); err != nil {
+			return nil, err
+		}
+		if files == nil {
+			expandedSpec.Files = append(expandedSpec.Files, file)
+			continue
+		}
+		expanded = true
+		expandedSpec.Files = append(expandedSpec.Files, files...)
+	}
+	originalSpec := uc.Spec()
+	if expanded {
+		uc.SetSpec(expandedSpec)
+	}
+	return func() {
+		uc.SetSpec(originalSpec)
+	}, nil
+}
+
+// Returns a spec file for each file of the spec file which isn't ignored, or nil if no .jfrogignore file applies to the
+// spec file. Archives, directories and symbolic links are uploaded by the spec file itself.
+func expandIgnoredFiles(file spec.File, workingDir string, threads int) ([]spec.File, error) {
+	if file.Archive != "" || file.IncludeDirs == "true" || file.Symlinks == "true" {
+		return nil, nil
+	}
+	isAnt, err := file.IsAnt(false)
+	if err != nil {
+		return nil, err
+	}
+	isRegexp, err := file.IsRegexp(false)
+	if err != nil {
+		return nil, err
+	}
+	patternType := clientutils.GetPatternType(clientutils.PatternTypes{RegExp: isRegexp, Ant: isAnt})
+	rootPath, err := fspatterns.GetRootPath(clientutils.ReplaceTildeWithUserHome(file.Pattern), file.Target, file.TargetPathInArchive, patternType, false)
+	if err != nil {
+		return nil, err
+	}
+	if isDir, err := fileutils.IsDirExists(rootPath, false); err != nil || !isDir {
+		return nil, err
+	}
+	ignoreDirs := []string{workingDir}
+	if absRootPath, err := filepath.Abs(rootPath); err == nil && absRootPath != workingDir {
+		ignoreDirs = append(ignoreDirs, absRootPath)
+	}
+	rules, err := jfrogignore.Load(ignoreDirs...)
+	if err != nil || rules == nil {
+		return nil, err
+	}
+	log.Debug(fmt.Sprintf("Walking '%s' by the rules of the %s files", rootPath, jfrogignore.FileName))
+
+	matches, err := findLocalFiles(&file, func(rootPath string, isRecursive bool, excludePathPattern string) ([]string, error) {
+		return listNotIgnoredFiles(rootPath, isRecursive, excludePathPattern, threads, rules)
+	})
+	if err != nil {
+		return nil, err
+	}
+	isFlat, err := file.IsFlat(true)
+	if err != nil {
+		return nil, err
+	}
+	// The target is normalized the same way as the upload normalizes it.
+	target := strings.TrimPrefix(file.Target, "/")
+	if !strings.Contains(target, "/") {
+		target += "/"
+	}
+	files := []spec.File{}
+	for _, match := range matches {
+		var fileTarget string
+		if fileTarget, err = getLocalFileUploadTarget(match, target, isFlat, isRegexp); err != nil {
+			return nil, err
+		}
+		files = append(files, stagedSpecFile(file, match.path, fileTarget))
+	}
+	return files, nil
+}
+
+func listNotIgnoredFiles(rootPath string, isRecursive bool, excludePathPattern string, threads int, rules *jfrogignore.Rules) ([]string, error) {
+	paths, err := jfrogignore.ListFiles(rootPath, isRecursive, threads, rules)
+	if err != nil || excludePathPattern == "" {
+		return paths, err
+	}
+	excludeRegExp, err := regexp.Compile(excludePathPattern)
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	var notExcluded []string
+	for _, path := range paths {
+		if excludeRegExp.MatchString(path) {
+			log.Verbose(fmt.Sprintf("The path '%s' is excluded", path))
+			continue
+		}
+		notExcluded = append(notExcluded, path)
+	}
+	return notExcluded, nil
+}
+
+// Returns the target path of the local file, the same way as the upload resolves it from the target of the spec file.
+func getLocalFileUploadTarget(match localFileMatch, target string, isFlat, isRegexp bool) (string, error) {
+	target, placeholdersUsed, err := clientutils.ReplacePlaceHolders(match.groups, target, isRegexp)
+	if err != nil {
+		return "", err
+	}
+	if !strings.HasSuffix(target, "/") {
+		return target, nil
+	}
+	// A symbolic link is uploaded by the path it points to.
+	localPath := match.path
+	if symlinkPath, err := fspatterns.GetFileSymlinkPath(match.path); err != nil {
+		return "", err
+	} else if symlinkPath != "" {
+		localPath = symlinkPath
+	}
+	if isFlat || placeholdersUsed {
+		fileName, _ := fileutils.GetFileAndDirFromPath(localPath)
+		return target + fileName, nil
+	}
+	return target + clientutils.TrimPath(localPath), nil
+}
@@ -0,0 +1,56 @@
+package generic
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/jfrogignore"
+	"github.com/jfrog/jfrog-cli-core/v2/common/spec"
+	clientutils "github.com/jfrog/jfrog-client-go/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpandIgnoredFiles(t *testing.T) {
+	root := t.TempDir()
+	createLocalFiles(t, root, "a.zip", "app.log", "libs/b.zip", "node_modules/c.zip", "other/d.zip")
+	pattern := filepath.ToSlash(root) + "/"
+	workingDir := t.TempDir()
+
+	files, err := expandIgnoredFiles(spec.File{Pattern: pattern + "*.zip", Target: "repo/"}, workingDir, 2)
+	require.NoError(t, err)
+	assert.Nil(t, files, "no spec files should be expanded without a .jfrogignore file")
+
+	require.NoError(t, os.WriteFile(filepath.Join(root, jfrogignore.FileName), []byte("node_modules/\n*.log\n"), 0600))
+	testCases := []struct {
+		name     string
+		file     spec.File
+		expected map[string]string
+	}{
+		{"flat", spec.File{Pattern: pattern + "*", Target: "repo/dir/", Flat: "true", Exclusions: []string{"*other*"}},
+			map[string]string{"a.zip": "repo/dir/a.zip", "libs/b.zip": "repo/dir/b.zip", jfrogignore.FileName: "repo/dir/" + jfrogignore.FileName}},
+		{"not flat", spec.File{Pattern: pattern + "*.zip", Target: "/repo", Flat: "false", Recursive: "false"},
+			map[string]string{"a.zip": "repo/" + clientutils.TrimPath(filepath.Join(root, "a.zip"))}},
+		{"placeholders", spec.File{Pattern: pattern + "(*)/(*).zip", Target: "repo/{1}-{2}.zip"},
+			map[string]string{"libs/b.zip": "repo/libs-b.zip", "other/d.zip": "repo/other-d.zip"}},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			files, err := expandIgnoredFiles(testCase.file, workingDir, 2)
+			require.NoError(t, err)
+			targets := map[string]string{}
+			for _, file := range files {
+				relPath, err := filepath.Rel(root, file.Pattern)
+				require.NoError(t, err)
+				targets[filepath.ToSlash(relPath)] = file.Target
+				assert.Equal(t, "true", file.Flat)
+			}
+			assert.Equal(t, testCase.expected, targets)
+		})
+	}
+
+	files, err = expandIgnoredFiles(spec.File{Pattern: pattern + "*.zip", Target: "repo/", Archive: "zip"}, workingDir, 2)
+	require.NoError(t, err)
+	assert.Nil(t, files, "archives should be uploaded by the spec file itself")
+}
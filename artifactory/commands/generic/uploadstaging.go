@@ -0,0 +1,124 @@
+package generic
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	buildInfo "github.com/jfrog/build-info-go/entities"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	clientutils "github.com/jfrog/jfrog-client-go/utils"
+	"github.com/jfrog/jfrog-client-go/utils/io/content"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// StagingDir is the directory of the staging repository under which the atomic uploads are staged.
+const StagingDir = ".jfrog-staging"
+
+// uploadStaging stages the files of an atomic upload under a temporary path, from which they are moved to their
+// targets only once all of them were uploaded, so that consumers never observe a partially uploaded set of files.
+type uploadStaging struct {
+	// The repository in which the files are staged. If empty, the files are staged in their target repositories.
+	repo string
+	// The directory of the upload under the staging directory, which is unique per upload.
+	id string
+	// The staging directories of the upload, which are deleted once the upload ends.
+	roots map[string]bool
+	// The uploaded files, from their staged paths to their targets.
+	items []CopyMovePlanItem
+}
+
+func newUploadStaging(repo string) *uploadStaging {
+	return &uploadStaging{repo: repo, id: strconv.FormatInt(time.Now().UnixNano(), 36), roots: make(map[string]bool)}
+}
+
+// Returns the staging directory of the files whose target is in the target repository.
+func (us *uploadStaging) root(targetRepo string) string {
+	if us.repo == "" {
+		return path.Join(targetRepo, StagingDir, us.id) + "/"
+	}
+	return path.Join(us.repo, StagingDir, us.id, targetRepo) + "/"
+}
+
+// stagedTarget returns the target in the staging directory which replaces the upload target.
+func (us *uploadStaging) stagedTarget(target string) string {
+	targetRepo, targetPath, _ := strings.Cut(strings.TrimPrefix(target, "/"), "/")
+	root := us.root(targetRepo)
+	us.roots[root] = true
+	return root + targetPath
+}
+
+// finalPath returns the target of a staged path, or false if the path isn't staged by the upload.
+func (us *uploadStaging) finalPath(stagedPath string) (string, bool) {
+	stagedPath = strings.TrimPrefix(stagedPath, "/")
+	stagingRepo, rest, found := strings.Cut(stagedPath, "/"+path.Join(StagingDir, us.id)+"/")
+	if !found || strings.Contains(stagingRepo, "/") {
+		return "", false
+	}
+	if us.repo == "" {
+		return stagingRepo + "/" + rest, true
+	}
+	return rest, stagingRepo == us.repo
+}
+
+// addUploaded adds the files of the transfer details, which were uploaded to the staging directory, and resets the
+// reader for further use.
+func (us *uploadStaging) addUploaded(transferDetailsReader *content.ContentReader) error {
+	for details := new(clientutils.FileTransferDetails); transferDetailsReader.NextRecord(details) == nil; details = new(clientutils.FileTransferDetails) {
+		us.addStaged(details.TargetPath)
+	}
+	transferDetailsReader.Reset()
+	return transferDetailsReader.GetError()
+}
+
+func (us *uploadStaging) addStaged(stagedPath string) {
+	if target, staged := us.finalPath(stagedPath); staged {
+		us.items = append(us.items, CopyMovePlanItem{Source: strings.TrimPrefix(stagedPath, "/"), Target: target})
+	}
+}
+
+// publish moves the staged files to their targets by server-side moves. If any move fails, the files which were
+// already moved are moved back to the staging directory. Nothing is moved if any of the targets, which the moves
+// replace and their rollback removes, is immutable or under legal hold.
+func (us *uploadStaging) publish(servicesManager artifactory.ArtifactoryServicesManager, threads int) error {
+	if err := verifyPlanNotProtected(servicesManager, "upload", us.items, false); err != nil {
+		return err
+	}
+	log.Info(fmt.Sprintf("Moving %d staged files to their targets", len(us.items)))
+	_, _, err := executeCopyMovePlan(servicesManager, moveAction, CopyMoveOptions{}, threads, us.items)
+	return err
+}
+
+// finalArtifacts replaces the staged paths of the build-info artifacts by their targets.
+func (us *uploadStaging) finalArtifacts(artifacts []buildInfo.Artifact) []buildInfo.Artifact {
+	for i, artifact := range artifacts {
+		target, staged := us.finalPath(path.Join(artifact.OriginalDeploymentRepo, artifact.Path))
+		if !staged {
+			continue
+		}
+		artifacts[i].OriginalDeploymentRepo, artifacts[i].Path, _ = strings.Cut(target, "/")
+	}
+	return artifacts
+}
+
+// cleanup deletes the staging directories of the upload, with the files which were left in them if the upload failed.
+func (us *uploadStaging) cleanup(servicesManager artifactory.ArtifactoryServicesManager) error {
+	roots := make([]string, 0, len(us.roots))
+	for root := range us.roots {
+		roots = append(roots, root)
+	}
+	sort.Strings(roots)
+	var errs []error
+	for _, root := range roots {
+		log.Debug("Deleting the staging directory", root)
+		// The directory doesn't exist if none of its files was uploaded.
+		if err := deleteItemIfExists(servicesManager, root); err != nil {
+			errs = append(errs, fmt.Errorf("failed to delete the staging directory %s: %w", root, err))
+		}
+	}
+	return errors.Join(errs...)
+}
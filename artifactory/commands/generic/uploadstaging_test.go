@@ -0,0 +1,125 @@
+package generic
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	buildInfo "github.com/jfrog/build-info-go/entities"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/protection"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUploadStagingPaths(t *testing.T) {
+	staging := &uploadStaging{id: "abc", roots: make(map[string]bool)}
+	assert.Equal(t, "libs-local/.jfrog-staging/abc/a/b/", staging.stagedTarget("/libs-local/a/b/"))
+	assert.Equal(t, "generic-local/.jfrog-staging/abc/", staging.stagedTarget("generic-local"))
+	target, staged := staging.finalPath("libs-local/.jfrog-staging/abc/a/b/c.jar")
+	assert.True(t, staged)
+	assert.Equal(t, "libs-local/a/b/c.jar", target)
+	_, staged = staging.finalPath("libs-local/.jfrog-staging/other/c.jar")
+	assert.False(t, staged)
+
+	staging = &uploadStaging{repo: "staging-local", id: "abc", roots: make(map[string]bool)}
+	assert.Equal(t, "staging-local/.jfrog-staging/abc/libs-local/a/", staging.stagedTarget("libs-local/a/"))
+	target, staged = staging.finalPath("staging-local/.jfrog-staging/abc/libs-local/a/c.jar")
+	assert.True(t, staged)
+	assert.Equal(t, "libs-local/a/c.jar", target)
+	_, staged = staging.finalPath("libs-local/.jfrog-staging/abc/libs-local/a/c.jar")
+	assert.False(t, staged)
+
+	artifacts := staging.finalArtifacts([]buildInfo.Artifact{
+		{Name: "c.jar", Path: ".jfrog-staging/abc/libs-local/a/c.jar", OriginalDeploymentRepo: "staging-local"},
+		{Name: "d.jar", Path: "a/d.jar", OriginalDeploymentRepo: "libs-local"},
+	})
+	assert.Equal(t, "libs-local", artifacts[0].OriginalDeploymentRepo)
+	assert.Equal(t, "a/c.jar", artifacts[0].Path)
+	assert.Equal(t, "a/d.jar", artifacts[1].Path)
+}
+
+func TestAtomicUpload(t *testing.T) {
+	tests := []struct {
+		uploadStatus int
+		// Whether the target of a.txt is immutable.
+		protected bool
+	}{
+		{http.StatusCreated, false},
+		{http.StatusInternalServerError, false},
+		{http.StatusCreated, true},
+	}
+	for _, test := range tests {
+		var mutex sync.Mutex
+		var requests []string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path {
+			case "/api/system/version":
+				_, _ = w.Write([]byte(`{"version": "7.90.0"}`))
+				return
+			case "/api/search/aql":
+				body, err := io.ReadAll(r.Body)
+				assert.NoError(t, err)
+				if test.protected && strings.Contains(string(body), protection.ImmutableProperty) {
+					_, _ = w.Write([]byte(`{"results": [{"repo": "libs-local", "path": ".", "name": "a.txt", "type": "file"}]}`))
+					return
+				}
+				_, _ = w.Write([]byte(`{"results": []}`))
+				return
+			}
+			mutex.Lock()
+			requests = append(requests, r.Method+" "+r.URL.Path)
+			mutex.Unlock()
+			switch {
+			case r.Method == http.MethodPut && strings.HasSuffix(r.URL.Path, "b.txt"):
+				w.WriteHeader(test.uploadStatus)
+			case r.Method == http.MethodPut:
+				w.WriteHeader(http.StatusCreated)
+			case r.Method == http.MethodPost:
+				w.WriteHeader(http.StatusOK)
+			default:
+				w.WriteHeader(http.StatusNoContent)
+			}
+		}))
+		uploadCmd := newTestUploadCommand(t, server.URL, "a.txt", "b.txt").SetAtomic(true)
+		err := uploadCmd.Run()
+		server.Close()
+
+		var puts, moves, deletes []string
+		for _, request := range requests {
+			method, requestPath, _ := strings.Cut(request, " ")
+			switch method {
+			case http.MethodPut:
+				puts = append(puts, requestPath)
+			case http.MethodPost:
+				moves = append(moves, requestPath)
+			case http.MethodDelete:
+				deletes = append(deletes, requestPath)
+			}
+		}
+		require.Len(t, puts, 2)
+		for _, put := range puts {
+			assert.Contains(t, put, "/libs-local/.jfrog-staging/")
+		}
+		require.Len(t, deletes, 1, "the staging directory should be deleted")
+		assert.True(t, strings.HasPrefix(deletes[0], "/libs-local/.jfrog-staging/"))
+		if test.uploadStatus != http.StatusCreated {
+			assert.Error(t, err)
+			assert.Empty(t, moves, "no file should be moved if any file failed to upload")
+			continue
+		}
+		if test.protected {
+			assert.ErrorContains(t, err, "libs-local/a.txt (immutable)")
+			assert.Empty(t, moves, "no file should be moved if any target is protected")
+			continue
+		}
+		assert.NoError(t, err)
+		assert.Len(t, moves, 2)
+		for _, move := range moves {
+			assert.True(t, strings.HasPrefix(move, "/api/move/libs-local/.jfrog-staging/"))
+		}
+		assert.Equal(t, 2, uploadCmd.Result().SuccessCount())
+	}
+}
@@ -0,0 +1,274 @@
+package generic
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"sort"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	commandsutils "github.com/jfrog/jfrog-cli-core/v2/artifactory/commands/utils"
+	"github.com/jfrog/jfrog-cli-core/v2/common/spec"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/artifactory/services/fspatterns"
+	clientutils "github.com/jfrog/jfrog-client-go/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/io/fileutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// DefaultWatchDebounce is the time with no further changes after which the changed files are uploaded.
+const DefaultWatchDebounce = 500 * time.Millisecond
+
+// UploadWatchCommand uploads the files of the spec, and then watches the directories of the spec patterns and uploads
+// each file which is created or changed, until interrupted. The changes are collected until no file changes for the
+// debounce time, so that a build writing many files triggers a single upload. Deleted files aren't deleted from
+// Artifactory.
+type UploadWatchCommand struct {
+	uploadCmd *UploadCommand
+	debounce  time.Duration
+}
+
+func NewUploadWatchCommand(uploadCmd *UploadCommand) *UploadWatchCommand {
+	return &UploadWatchCommand{uploadCmd: uploadCmd, debounce: DefaultWatchDebounce}
+}
+
+func (uwc *UploadWatchCommand) SetDebounce(debounce time.Duration) *UploadWatchCommand {
+	uwc.debounce = debounce
+	return uwc
+}
+
+func (uwc *UploadWatchCommand) ServerDetails() (*config.ServerDetails, error) {
+	return uwc.uploadCmd.ServerDetails()
+}
+
+func (uwc *UploadWatchCommand) CommandName() string {
+	return "rt_upload_watch"
+}
+
+func (uwc *UploadWatchCommand) Run() error {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	return uwc.watch(ctx)
+}
+
+func (uwc *UploadWatchCommand) watch(ctx context.Context) (err error) {
+	if err = uwc.validate(); err != nil {
+		return err
+	}
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	defer func() {
+		err = errors.Join(err, errorutils.CheckError(watcher.Close()))
+	}()
+	// The directories are watched before the initial upload, so that files changed during the upload aren't missed.
+	dirs := &watchedDirs{watcher: watcher}
+	for i := range uwc.uploadCmd.Spec().Files {
+		if err = dirs.addSpecFile(&uwc.uploadCmd.Spec().Files[i]); err != nil {
+			return err
+		}
+	}
+	if err = uwc.uploadSpec(uwc.uploadCmd.Spec()); err != nil {
+		return err
+	}
+
+	log.Info(fmt.Sprintf("Watching %d directories for changes. Press Ctrl+C to stop.", len(watcher.WatchList())))
+	changed := make(map[string]bool)
+	timer := time.NewTimer(uwc.debounce)
+	timer.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info("Stopping the watch...")
+			return nil
+		case watchErr, ok := <-watcher.Errors:
+			if !ok {
+				return nil
+			}
+			log.Warn("Watching the files failed:", watchErr.Error())
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return nil
+			}
+			changedPaths := dirs.handleEvent(event)
+			for _, path := range changedPaths {
+				changed[path] = true
+			}
+			if len(changedPaths) > 0 {
+				timer.Reset(uwc.debounce)
+			}
+		case <-timer.C:
+			uwc.uploadChanged(changed)
+			changed = make(map[string]bool)
+		}
+	}
+}
+
+func (uwc *UploadWatchCommand) validate() error {
+	switch {
+	case uwc.uploadCmd.DryRun():
+		return errorutils.CheckErrorf("the watch mode can't be used with --dry-run")
+	case uwc.uploadCmd.SyncDeletesPath() != "":
+		return errorutils.CheckErrorf("the watch mode can't be used with --sync-deletes, since deleted files aren't deleted from Artifactory")
+	case uwc.uploadCmd.atomic:
+		return errorutils.CheckErrorf("the watch mode can't be used with --atomic")
+	}
+	for _, file := range uwc.uploadCmd.Spec().Files {
+		if file.Archive != "" {
+			return errorutils.CheckErrorf("the watch mode can't be used with archives, since only the changed files are uploaded")
+		}
+	}
+	return nil
+}
+
+// Uploads the changed files which match the spec. A failed upload is logged, and the changes after it are still
+// uploaded.
+func (uwc *UploadWatchCommand) uploadChanged(changed map[string]bool) {
+	changedSpec, err := changedFilesSpec(uwc.uploadCmd, changed)
+	if err == nil {
+		if len(changedSpec.Files) == 0 {
+			log.Debug("None of the changed files matches the spec.")
+			return
+		}
+		err = uwc.uploadSpec(changedSpec)
+	}
+	if err != nil {
+		log.Error("Failed to upload the changed files:", err.Error())
+	}
+}
+
+func (uwc *UploadWatchCommand) uploadSpec(uploadSpec *spec.SpecFiles) (err error) {
+	cycleCmd := *uwc.uploadCmd
+	cycleCmd.GenericCommand.result = new(commandsutils.Result)
+	cycleCmd.SetSpec(uploadSpec)
+	err = cycleCmd.Run()
+	result := cycleCmd.Result()
+	if reader := result.Reader(); reader != nil {
+		err = errors.Join(err, errorutils.CheckError(reader.Close()))
+	}
+	log.Info(fmt.Sprintf("Uploaded %d files. %d files failed.", result.SuccessCount(), result.FailCount()))
+	return err
+}
+
+// Returns a spec which uploads the changed files to the targets of the spec files they match, with the properties of
+// these spec files.
+func changedFilesSpec(uploadCmd *UploadCommand, changed map[string]bool) (*spec.SpecFiles, error) {
+	changedSpec := new(spec.SpecFiles)
+	for _, file := range uploadCmd.Spec().Files {
+		transfers, err := dryRunUpload(uploadCmd, &spec.SpecFiles{Files: []spec.File{file}})
+		if err != nil {
+			return nil, err
+		}
+		for _, transfer := range transfers {
+			if absolutePath, err := filepath.Abs(transfer.SourcePath); err == nil && changed[absolutePath] {
+				changedSpec.Files = append(changedSpec.Files, stagedSpecFile(file, transfer.SourcePath, transfer.TargetPath))
+			}
+		}
+	}
+	sort.Slice(changedSpec.Files, func(i, j int) bool {
+		return changedSpec.Files[i].Pattern < changedSpec.Files[j].Pattern
+	})
+	return changedSpec, nil
+}
+
+// watchedDirs adds the directories of the spec patterns to the watcher, and the directories created under the
+// recursively watched directories.
+type watchedDirs struct {
+	watcher        *fsnotify.Watcher
+	recursiveRoots []string
+}
+
+func (wd *watchedDirs) addSpecFile(file *spec.File) error {
+	isAnt, err := file.IsAnt(false)
+	if err != nil {
+		return err
+	}
+	isRegexp, err := file.IsRegexp(false)
+	if err != nil {
+		return err
+	}
+	isRecursive, err := file.IsRecursive(true)
+	if err != nil {
+		return err
+	}
+	patternType := clientutils.GetPatternType(clientutils.PatternTypes{RegExp: isRegexp, Ant: isAnt})
+	rootPath, err := fspatterns.GetRootPath(clientutils.ReplaceTildeWithUserHome(file.Pattern), file.Target, file.TargetPathInArchive, patternType, false)
+	if err != nil {
+		return err
+	}
+	if rootPath, err = filepath.Abs(rootPath); err != nil {
+		return errorutils.CheckError(err)
+	}
+	isDir, err := fileutils.IsDirExists(rootPath, false)
+	if err != nil {
+		return err
+	}
+	if !isDir {
+		// A single file is watched through its directory, since editors often replace files rather than write them.
+		return errorutils.CheckError(wd.watcher.Add(filepath.Dir(rootPath)))
+	}
+	if !isRecursive {
+		return errorutils.CheckError(wd.watcher.Add(rootPath))
+	}
+	wd.recursiveRoots = append(wd.recursiveRoots, rootPath)
+	_, err = wd.addTree(rootPath)
+	return err
+}
+
+// Watches the directory and its subdirectories. Returns the files under the directory.
+func (wd *watchedDirs) addTree(dir string) (files []string, err error) {
+	err = filepath.WalkDir(dir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() {
+			return wd.watcher.Add(path)
+		}
+		files = append(files, path)
+		return nil
+	})
+	return files, errorutils.CheckError(err)
+}
+
+func (wd *watchedDirs) isUnderRecursiveRoot(path string) bool {
+	for _, root := range wd.recursiveRoots {
+		if strings.HasPrefix(path, root+string(filepath.Separator)) {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns the paths of the files changed by the event. A directory created under a recursively watched directory is
+// watched, and its files are returned, since they may have been created before it was watched.
+func (wd *watchedDirs) handleEvent(event fsnotify.Event) []string {
+	// Removed and renamed files are ignored. A file renamed within the watched directories is created under its new name.
+	if !event.Has(fsnotify.Create) && !event.Has(fsnotify.Write) {
+		return nil
+	}
+	info, err := os.Stat(event.Name)
+	if err != nil {
+		// The file was removed after the event.
+		return nil
+	}
+	if !info.IsDir() {
+		return []string{event.Name}
+	}
+	if !event.Has(fsnotify.Create) || !wd.isUnderRecursiveRoot(event.Name) {
+		return nil
+	}
+	files, err := wd.addTree(event.Name)
+	if err != nil {
+		log.Warn("Failed to watch", event.Name+":", err.Error())
+	}
+	return files
+}
@@ -0,0 +1,63 @@
+package generic
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/jfrog/jfrog-cli-core/v2/common/spec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUploadWatch(t *testing.T) {
+	server := newFakeUploadServer(t, http.StatusCreated)
+	uploadCmd := newTestUploadCommand(t, server.URL, "a.txt")
+	localDir := filepath.Dir(uploadCmd.Spec().Files[0].Pattern)
+	uploadCmd.SetSpec(spec.NewBuilder().Pattern(uploadCmd.Spec().Files[0].Pattern).Target("libs-local/").Flat(true).Recursive(true).BuildSpec())
+	uploaded := func(path string) func() bool {
+		return func() bool {
+			server.mutex.Lock()
+			defer server.mutex.Unlock()
+			return slices.Contains(server.requests, "PUT /libs-local/"+path)
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	watchErr := make(chan error, 1)
+	go func() {
+		watchErr <- NewUploadWatchCommand(uploadCmd).SetDebounce(50 * time.Millisecond).watch(ctx)
+	}()
+	assert.Eventually(t, uploaded("a.txt"), 10*time.Second, 20*time.Millisecond, "the files of the spec are uploaded first")
+
+	require.NoError(t, os.WriteFile(filepath.Join(localDir, "b.txt"), []byte("content"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(localDir, "ignored.bin"), []byte("content"), 0644))
+	assert.Eventually(t, uploaded("b.txt"), 10*time.Second, 20*time.Millisecond)
+	// The new directory is watched, and its files are uploaded.
+	require.NoError(t, os.MkdirAll(filepath.Join(localDir, "sub"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(localDir, "sub", "c.txt"), []byte("content"), 0644))
+	assert.Eventually(t, uploaded("c.txt"), 10*time.Second, 20*time.Millisecond)
+
+	cancel()
+	require.NoError(t, <-watchErr)
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	assert.NotContains(t, server.requests, "PUT /libs-local/ignored.bin")
+	assert.Len(t, slices.DeleteFunc(slices.Clone(server.requests), func(request string) bool {
+		return request != "PUT /libs-local/a.txt"
+	}), 1, "unchanged files aren't uploaded again")
+}
+
+func TestUploadWatchValidation(t *testing.T) {
+	uploadCmd := newTestUploadCommand(t, "http://localhost")
+	uploadCmd.SetSyncDeletesPath("libs-local/")
+	assert.ErrorContains(t, NewUploadWatchCommand(uploadCmd).watch(context.Background()), "--sync-deletes")
+
+	uploadCmd = newTestUploadCommand(t, "http://localhost")
+	uploadCmd.SetSpec(spec.NewBuilder().Pattern(uploadCmd.Spec().Files[0].Pattern).Target("libs-local/a.zip").Archive("zip").BuildSpec())
+	assert.ErrorContains(t, NewUploadWatchCommand(uploadCmd).watch(context.Background()), "archives")
+}
@@ -0,0 +1,190 @@
+package generic
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clientconfig"
+	"github.com/jfrog/jfrog-cli-core/v2/common/spec"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	serviceutils "github.com/jfrog/jfrog-client-go/artifactory/services/utils"
+	clientutils "github.com/jfrog/jfrog-client-go/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/io/fileutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// VerifyDownloadCommand compares a local directory with the Artifactory path it was downloaded from.
+type VerifyDownloadCommand struct {
+	serverDetails *config.ServerDetails
+	localPath     string
+	repoPath      string
+	report        *DriftReport
+}
+
+// DriftReport lists the differences between a local directory and an Artifactory path.
+// Paths are relative to the directory and to the Artifactory path.
+type DriftReport struct {
+	Verified int `json:"verified"`
+	// Files in Artifactory which are missing from the local directory.
+	Missing []string `json:"missing,omitempty"`
+	// Local files which don't exist in Artifactory.
+	Extra []string `json:"extra,omitempty"`
+	// Files whose local checksum differs from the checksum in Artifactory.
+	Modified []string `json:"modified,omitempty"`
+}
+
+func (dr *DriftReport) HasDrift() bool {
+	return len(dr.Missing) > 0 || len(dr.Extra) > 0 || len(dr.Modified) > 0
+}
+
+type remoteFile struct {
+	sha1     string
+	sha256   string
+	modified string
+}
+
+func NewVerifyDownloadCommand() *VerifyDownloadCommand {
+	return &VerifyDownloadCommand{}
+}
+
+func (vdc *VerifyDownloadCommand) SetServerDetails(serverDetails *config.ServerDetails) *VerifyDownloadCommand {
+	vdc.serverDetails = serverDetails
+	return vdc
+}
+
+func (vdc *VerifyDownloadCommand) SetLocalPath(localPath string) *VerifyDownloadCommand {
+	vdc.localPath = localPath
+	return vdc
+}
+
+func (vdc *VerifyDownloadCommand) SetRepoPath(repoPath string) *VerifyDownloadCommand {
+	vdc.repoPath = strings.Trim(repoPath, "/")
+	return vdc
+}
+
+func (vdc *VerifyDownloadCommand) Report() *DriftReport {
+	return vdc.report
+}
+
+func (vdc *VerifyDownloadCommand) ServerDetails() (*config.ServerDetails, error) {
+	return vdc.serverDetails, nil
+}
+
+func (vdc *VerifyDownloadCommand) CommandName() string {
+	return "rt_verify_download"
+}
+
+func (vdc *VerifyDownloadCommand) Run() error {
+	if strings.ContainsAny(vdc.repoPath, "*?") {
+		return errorutils.CheckErrorf("the Artifactory path '%s' must not include wildcards", vdc.repoPath)
+	}
+	isDir, err := fileutils.IsDirExists(vdc.localPath, false)
+	if err != nil {
+		return err
+	}
+	if !isDir {
+		return errorutils.CheckErrorf("the local directory '%s' doesn't exist", vdc.localPath)
+	}
+	servicesManager, err := clientconfig.CreateServiceManager(vdc.serverDetails, -1, 0, false)
+	if err != nil {
+		return err
+	}
+	remoteFiles, err := listRemoteTree(servicesManager, vdc.repoPath)
+	if err != nil {
+		return err
+	}
+	if vdc.report, err = compareLocalTree(vdc.localPath, remoteFiles); err != nil {
+		return err
+	}
+	content, err := json.Marshal(vdc.report)
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	log.Output(clientutils.IndentJson(content))
+	if vdc.report.HasDrift() {
+		return errorutils.CheckErrorf("'%s' drifted from '%s': %d files are missing, %d are extra and %d are modified",
+			vdc.localPath, vdc.repoPath, len(vdc.report.Missing), len(vdc.report.Extra), len(vdc.report.Modified))
+	}
+	log.Info(fmt.Sprintf("Verified %d files.", vdc.report.Verified))
+	return nil
+}
+
+// Returns the files under the Artifactory path, by their path relative to it.
+func listRemoteTree(servicesManager artifactory.ArtifactoryServicesManager, repoPath string) (remoteFiles map[string]remoteFile, err error) {
+	searchSpec := spec.NewBuilder().Pattern(repoPath + "/").Recursive(true).BuildSpec()
+	reader, err := searchItems(searchSpec, servicesManager)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		err = errors.Join(err, reader.Close())
+	}()
+	_, dirPath, _ := strings.Cut(repoPath, "/")
+	remoteFiles = make(map[string]remoteFile)
+	for item := new(serviceutils.ResultItem); reader.NextRecord(item) == nil; item = new(serviceutils.ResultItem) {
+		relativePath := path.Join(item.Path, item.Name)
+		if dirPath != "" {
+			relativePath = strings.TrimPrefix(relativePath, dirPath+"/")
+		}
+		remoteFiles[relativePath] = remoteFile{sha1: item.Actual_Sha1, sha256: item.Sha256, modified: item.Modified}
+	}
+	err = reader.GetError()
+	return
+}
+
+// Compares the files of the local directory with the remote files in both directions.
+// The checksums are compared by sha256 if Artifactory holds it, and by sha1 otherwise.
+func compareLocalTree(localPath string, remoteFiles map[string]remoteFile) (*DriftReport, error) {
+	report := &DriftReport{}
+	found := make(map[string]bool)
+	err := filepath.WalkDir(localPath, func(filePath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !entry.Type().IsRegular() {
+			return nil
+		}
+		relativePath, err := filepath.Rel(localPath, filePath)
+		if err != nil {
+			return err
+		}
+		relativePath = filepath.ToSlash(relativePath)
+		remote, ok := remoteFiles[relativePath]
+		if !ok {
+			report.Extra = append(report.Extra, relativePath)
+			return nil
+		}
+		found[relativePath] = true
+		details, err := fileutils.GetFileDetails(filePath, true)
+		if err != nil {
+			return err
+		}
+		if (remote.sha256 != "" && remote.sha256 != details.Checksum.Sha256) ||
+			(remote.sha256 == "" && remote.sha1 != details.Checksum.Sha1) {
+			report.Modified = append(report.Modified, relativePath)
+			return nil
+		}
+		report.Verified++
+		return nil
+	})
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	for relativePath := range remoteFiles {
+		if !found[relativePath] {
+			report.Missing = append(report.Missing, relativePath)
+		}
+	}
+	sort.Strings(report.Missing)
+	sort.Strings(report.Extra)
+	sort.Strings(report.Modified)
+	return report, nil
+}
@@ -0,0 +1,40 @@
+package generic
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompareLocalTree(t *testing.T) {
+	root := t.TempDir()
+	for name, content := range map[string]string{"a.txt": "a", "dir/b.txt": "b", "dir/c.txt": "local", "extra.txt": "e"} {
+		localPath := filepath.Join(root, filepath.FromSlash(name))
+		require.NoError(t, os.MkdirAll(filepath.Dir(localPath), 0755))
+		require.NoError(t, os.WriteFile(localPath, []byte(content), 0644))
+	}
+	checksum := func(content string) string {
+		sum := sha256.Sum256([]byte(content))
+		return hex.EncodeToString(sum[:])
+	}
+	report, err := compareLocalTree(root, map[string]remoteFile{
+		"a.txt": {sha256: checksum("a")},
+		// Artifactory may hold only the sha1 checksum of older artifacts.
+		"dir/b.txt":   {sha1: "e9d71f5ee7c92d6dc9e92ffdad17b8bd49418f98"},
+		"dir/c.txt":   {sha256: checksum("remote")},
+		"missing.txt": {sha256: checksum("m")},
+	})
+	require.NoError(t, err)
+	assert.True(t, report.HasDrift())
+	assert.Equal(t, &DriftReport{
+		Verified: 2,
+		Missing:  []string{"missing.txt"},
+		Extra:    []string{"extra.txt"},
+		Modified: []string{"dir/c.txt"},
+	}, report)
+}
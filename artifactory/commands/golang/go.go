@@ -5,7 +5,10 @@ import (
 	"errors"
 	"fmt"
 	"github.com/jfrog/build-info-go/build"
+	"github.com/jfrog/build-info-go/entities"
 	biutils "github.com/jfrog/build-info-go/utils"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clientconfig"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clierrors"
 	buildUtils "github.com/jfrog/jfrog-cli-core/v2/common/build"
 	"github.com/jfrog/jfrog-cli-core/v2/common/project"
 	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
@@ -31,6 +34,7 @@ type GoCommand struct {
 	resolverParams     *project.RepositoryConfig
 	configFilePath     string
 	noFallback         bool
+	privateModules     string
 }
 
 func NewGoCommand() *GoCommand {
@@ -62,6 +66,12 @@ func (gc *GoCommand) SetGoArg(goArg []string) *GoCommand {
 	return gc
 }
 
+// SetPrivateModules sets the comma-separated module path patterns of the private modules hosted in Artifactory.
+func (gc *GoCommand) SetPrivateModules(privateModules string) *GoCommand {
+	gc.privateModules = privateModules
+	return gc
+}
+
 func (gc *GoCommand) CommandName() string {
 	return "rt_go"
 }
@@ -117,6 +127,12 @@ func (gc *GoCommand) Run() error {
 	if err != nil {
 		return err
 	}
+
+	// Extract private-modules flag from the args.
+	gc.goArg, err = gc.extractPrivateModulesFromArgs()
+	if err != nil {
+		return err
+	}
 	return gc.run()
 }
 
@@ -134,6 +150,34 @@ func (gc *GoCommand) extractNoFallbackFromArgs() (cleanArgs []string, err error)
 	return
 }
 
+func (gc *GoCommand) extractPrivateModulesFromArgs() (cleanArgs []string, err error) {
+	cleanArgs = append([]string(nil), gc.goArg...)
+	flagIndex, valueIndex, privateModules, err := coreutils.FindFlag("--private-modules", cleanArgs)
+	if err != nil || flagIndex == -1 {
+		return
+	}
+	gc.privateModules = privateModules
+	coreutils.RemoveFlagFromCommand(&cleanArgs, flagIndex, valueIndex)
+	return
+}
+
+// getGoEnv returns the environment variables for resolving the private modules through Artifactory, and for building
+// vendored modules. Unless the private modules are provided, they're derived from the path of the main module.
+func (gc *GoCommand) getGoEnv() (env map[string]string, projectPath string) {
+	projectPath, err := getProjectRoot()
+	if err != nil {
+		log.Debug("No go.mod was found:", err.Error())
+		return getPrivateModulesEnv("", gc.privateModules, gc.goArg), ""
+	}
+	privateModules := gc.privateModules
+	if privateModules == "" {
+		if modulePath, err := readModulePath(projectPath); err == nil {
+			privateModules = getDefaultPrivateModules(modulePath)
+		}
+	}
+	return getPrivateModulesEnv(projectPath, privateModules, gc.goArg), projectPath
+}
+
 func (gc *GoCommand) run() (err error) {
 	err = logGoVersion()
 	if err != nil {
@@ -159,6 +203,15 @@ func (gc *GoCommand) run() (err error) {
 		return
 	}
 
+	goEnv, projectPath := gc.getGoEnv()
+	restoreEnv, err := setGoEnv(goEnv)
+	if err != nil {
+		return
+	}
+	defer func() {
+		err = errors.Join(err, restoreEnv())
+	}()
+
 	err = biutils.RunGo(gc.goArg, repoUrl)
 	if errorutils.CheckError(err) != nil {
 		err = coreutils.ConvertExitCodeError(err)
@@ -182,12 +235,7 @@ func (gc *GoCommand) run() (err error) {
 			defer func() {
 				err = errors.Join(err, fileutils.RemoveTempDir(tempDirPath))
 			}()
-			var serverDetails auth.ServiceDetails
-			serverDetails, err = resolverDetails.CreateArtAuthConfig()
-			if err != nil {
-				return
-			}
-			err = copyGoPackageFiles(tempDirPath, gc.goArg[1], gc.resolverParams.TargetRepo(), serverDetails)
+			err = copyGoPackageFiles(tempDirPath, gc.goArg[1], gc.resolverParams.TargetRepo(), resolverDetails)
 			if err != nil {
 				return
 			}
@@ -200,16 +248,42 @@ func (gc *GoCommand) run() (err error) {
 		if gc.buildConfiguration.GetModule() != "" {
 			goModule.SetName(gc.buildConfiguration.GetModule())
 		}
+		if tempDirPath == "" && isVendored(projectPath) {
+			// The dependencies of vendored modules aren't downloaded to the module cache, so they're collected
+			// from vendor/modules.txt.
+			err = gc.saveVendoredDependencies(goBuildInfo, resolverDetails, projectPath)
+			return
+		}
 		err = errorutils.CheckError(goModule.CalcDependencies())
 	}
 
 	return
 }
 
+func (gc *GoCommand) saveVendoredDependencies(goBuildInfo *build.Build, resolverDetails *config.ServerDetails, projectPath string) error {
+	moduleId := gc.buildConfiguration.GetModule()
+	if moduleId == "" {
+		var err error
+		if moduleId, err = readModulePath(projectPath); err != nil {
+			return err
+		}
+	}
+	servicesManager, err := clientconfig.CreateServiceManager(resolverDetails, -1, 0, false)
+	if err != nil {
+		return err
+	}
+	dependencies, err := newGoSumDependenciesCollector(projectPath, servicesManager, gc.resolverParams.TargetRepo()).collect()
+	if err != nil {
+		return err
+	}
+	buildInfo := &entities.BuildInfo{Modules: []entities.Module{{Id: moduleId, Type: entities.Go, Dependencies: dependencies}}}
+	return errorutils.CheckError(goBuildInfo.SaveBuildInfo(buildInfo))
+}
+
 // copyGoPackageFiles copies the package files from the go mod cache directory to the given destPath.
 // The path to those cache files is retrieved using the supplied package name and Artifactory details.
-func copyGoPackageFiles(destPath, packageName, rtTargetRepo string, authArtDetails auth.ServiceDetails) error {
-	packageFilesPath, err := getPackageFilePathFromArtifactory(packageName, rtTargetRepo, authArtDetails)
+func copyGoPackageFiles(destPath, packageName, rtTargetRepo string, serverDetails *config.ServerDetails) error {
+	packageFilesPath, err := getPackageFilePathFromArtifactory(packageName, rtTargetRepo, serverDetails)
 	if err != nil {
 		return err
 	}
@@ -225,7 +299,7 @@ func copyGoPackageFiles(destPath, packageName, rtTargetRepo string, authArtDetai
 // getPackageFilePathFromArtifactory returns a string that represents the package files cache path.
 // In most cases the path to those cache files is retrieved using the supplied package name and Artifactory details.
 // However, if the user asked for a specific version (package@vX.Y.Z) the unnecessary call to Artifactory is avoided.
-func getPackageFilePathFromArtifactory(packageName, rtTargetRepo string, authArtDetails auth.ServiceDetails) (packageFilesPath string, err error) {
+func getPackageFilePathFromArtifactory(packageName, rtTargetRepo string, serverDetails *config.ServerDetails) (packageFilesPath string, err error) {
 	var version string
 	packageCachePath, err := biutils.GetGoModCachePath()
 	if errorutils.CheckError(err) != nil {
@@ -244,7 +318,7 @@ func getPackageFilePathFromArtifactory(packageName, rtTargetRepo string, authArt
 		}
 		packageVersionRequest := buildPackageVersionRequest(name, branchName)
 		// Retrieve the package version using Artifactory
-		version, err = getPackageVersion(rtTargetRepo, packageVersionRequest, authArtDetails)
+		version, err = getPackageVersion(rtTargetRepo, packageVersionRequest, serverDetails)
 		if err != nil {
 			return
 		}
@@ -257,7 +331,11 @@ func getPackageFilePathFromArtifactory(packageName, rtTargetRepo string, authArt
 // getPackageVersion returns the matching version for the packageName string using the Artifactory details that are provided.
 // PackageName string should be in the following format: <Package Path>/@V/<Requested Branch Name>.info OR latest.info
 // For example the jfrog/jfrog-cli/@v/master.info packageName will return the corresponding canonical version (vX.Y.Z) string for the jfrog-cli master branch.
-func getPackageVersion(repoName, packageName string, details auth.ServiceDetails) (string, error) {
+func getPackageVersion(repoName, packageName string, serverDetails *config.ServerDetails) (string, error) {
+	details, err := serverDetails.CreateArtAuthConfig()
+	if err != nil {
+		return "", err
+	}
 	artifactoryApiUrl, err := rtutils.BuildUrl(details.GetUrl(), "api/go/"+repoName, make(map[string]string))
 	if err != nil {
 		return "", err
@@ -267,12 +345,15 @@ func getPackageVersion(repoName, packageName string, details auth.ServiceDetails
 	if err != nil {
 		return "", err
 	}
+	if err = clientconfig.ConfigureHttpClient(client.GetClient(), serverDetails); err != nil {
+		return "", err
+	}
 	artifactoryApiUrl = artifactoryApiUrl + "/" + packageName
 	resp, body, _, err := client.SendGet(artifactoryApiUrl, true, artHttpDetails, "")
 	if err != nil {
 		return "", err
 	}
-	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK); err != nil {
+	if err = clierrors.CheckResponseStatusWithBody(resp, body, http.StatusOK); err != nil {
 		return "", err
 	}
 	// Extract version from response
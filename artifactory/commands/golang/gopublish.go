@@ -4,12 +4,15 @@ import (
 	"os/exec"
 
 	"github.com/jfrog/build-info-go/build"
+	buildinfo "github.com/jfrog/build-info-go/entities"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clientconfig"
 	commandutils "github.com/jfrog/jfrog-cli-core/v2/artifactory/commands/utils"
-	"github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
 	buildUtils "github.com/jfrog/jfrog-cli-core/v2/common/build"
 	"github.com/jfrog/jfrog-cli-core/v2/common/project"
+	"github.com/jfrog/jfrog-client-go/artifactory"
 	clientutils "github.com/jfrog/jfrog-client-go/utils"
 	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/spf13/viper"
 )
 
 const minSupportedArtifactoryVersion = "6.2.0"
@@ -75,7 +78,7 @@ func (gpc *GoPublishCommand) Run() error {
 	if errorutils.CheckError(err) != nil {
 		return err
 	}
-	serviceManager, err := utils.CreateServiceManager(serverDetails, -1, 0, false)
+	serviceManager, err := clientconfig.CreateServiceManager(serverDetails, -1, 0, false)
 	if err != nil {
 		return err
 	}
@@ -123,20 +126,41 @@ func (gpc *GoPublishCommand) Run() error {
 	}
 	// Publish the build-info to Artifactory
 	if collectBuildInfo {
-		goModule, err := goBuild.AddGoModule("")
-		if err != nil {
-			return errorutils.CheckError(err)
-		}
-		if gpc.buildConfiguration.GetModule() != "" {
-			goModule.SetName(gpc.buildConfiguration.GetModule())
+		return gpc.saveBuildInfo(goBuild, artifacts, serviceManager, vConfig)
+	}
+
+	return err
+}
+
+// saveBuildInfo saves the published artifacts, and the dependencies recorded in go.sum or in vendor/modules.txt.
+// The checksums of the dependencies which aren't in the module cache are taken from the resolution repository, if
+// it's configured.
+func (gpc *GoPublishCommand) saveBuildInfo(goBuild *build.Build, artifacts []buildinfo.Artifact, serviceManager artifactory.ArtifactoryServicesManager, vConfig *viper.Viper) error {
+	projectPath, err := getProjectRoot()
+	if err != nil {
+		return err
+	}
+	moduleId := gpc.buildConfiguration.GetModule()
+	if moduleId == "" {
+		if moduleId, err = readModulePath(projectPath); err != nil {
+			return err
 		}
-		err = goModule.AddArtifacts(artifacts...)
+	}
+	var resolverServicesManager artifactory.ArtifactoryServicesManager
+	var resolverRepo string
+	if vConfig.IsSet(project.ProjectConfigResolverPrefix) {
+		resolverParams, err := project.GetRepoConfigByPrefix(gpc.configFilePath, project.ProjectConfigResolverPrefix, vConfig)
 		if err != nil {
-			return errorutils.CheckError(err)
+			return err
 		}
+		resolverServicesManager, resolverRepo = serviceManager, resolverParams.TargetRepo()
 	}
-
-	return err
+	dependencies, err := newGoSumDependenciesCollector(projectPath, resolverServicesManager, resolverRepo).collect()
+	if err != nil {
+		return err
+	}
+	module := buildinfo.Module{Id: moduleId, Type: buildinfo.Go, Artifacts: artifacts, Dependencies: dependencies}
+	return errorutils.CheckError(goBuild.SaveBuildInfo(&buildinfo.BuildInfo{Modules: []buildinfo.Module{module}}))
 }
 
 func (gpc *GoPublishCommandArgs) Result() *commandutils.Result {
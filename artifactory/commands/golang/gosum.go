@@ -0,0 +1,179 @@
+package golang
+
+import (
+	"bufio"
+	"bytes"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	buildinfo "github.com/jfrog/build-info-go/entities"
+	biutils "github.com/jfrog/build-info-go/utils"
+	"github.com/jfrog/gofrog/crypto"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/io/fileutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+	"golang.org/x/mod/modfile"
+	"golang.org/x/mod/module"
+)
+
+const (
+	goSumFileName     = "go.sum"
+	vendorModulesFile = "vendor/modules.txt"
+)
+
+// goSumDependenciesCollector collects the dependencies of a Go module from its go.sum, or from vendor/modules.txt when
+// the module is built with vendoring. Unlike 'go list', it doesn't require the dependencies to be downloaded.
+// The checksums of each dependency are taken from its zip in the module cache or, if it isn't cached, from the
+// resolution repository in Artifactory.
+type goSumDependenciesCollector struct {
+	projectPath string
+	// The download directory of the module cache, $GOMODCACHE/cache/download.
+	cachePath       string
+	servicesManager artifactory.ArtifactoryServicesManager
+	repo            string
+}
+
+func newGoSumDependenciesCollector(projectPath string, servicesManager artifactory.ArtifactoryServicesManager, repo string) *goSumDependenciesCollector {
+	cachePath, err := biutils.GetCachePath()
+	if err != nil {
+		log.Debug("Couldn't find the module cache:", err.Error())
+	}
+	return &goSumDependenciesCollector{projectPath: projectPath, cachePath: cachePath, servicesManager: servicesManager, repo: repo}
+}
+
+// isVendored returns true if the module is built with vendoring, with its dependencies copied into the vendor directory.
+func isVendored(projectPath string) bool {
+	exists, err := fileutils.IsFileExists(filepath.Join(projectPath, filepath.FromSlash(vendorModulesFile)), false)
+	return err == nil && exists
+}
+
+// readModulePath reads the module path from go.mod without running Go, which would download the dependencies of a
+// vendored module.
+func readModulePath(projectPath string) (string, error) {
+	content, err := os.ReadFile(filepath.Join(projectPath, "go.mod"))
+	if err != nil {
+		return "", errorutils.CheckError(err)
+	}
+	modulePath := modfile.ModulePath(content)
+	if modulePath == "" {
+		return "", errorutils.CheckErrorf("the go.mod in '%s' doesn't declare the module path", projectPath)
+	}
+	return modulePath, nil
+}
+
+// readGoSumModules returns the modules whose content is listed in go.sum. Modules listed only by their go.mod hash are
+// part of the module graph, but aren't built into the module, and are skipped.
+func readGoSumModules(projectPath string) ([]module.Version, error) {
+	content, err := os.ReadFile(filepath.Join(projectPath, goSumFileName))
+	if err != nil {
+		if os.IsNotExist(err) {
+			log.Debug("No go.sum was found in", projectPath+". The module has no dependencies.")
+			return nil, nil
+		}
+		return nil, errorutils.CheckError(err)
+	}
+	var modules []module.Version
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) != 3 || strings.HasSuffix(fields[1], "/go.mod") {
+			continue
+		}
+		mod := module.Version{Path: fields[0], Version: fields[1]}
+		if !slices.Contains(modules, mod) {
+			modules = append(modules, mod)
+		}
+	}
+	return modules, errorutils.CheckError(scanner.Err())
+}
+
+// readVendoredModules returns the modules in vendor/modules.txt, as replaced by the replace directives of go.mod.
+// Modules replaced by local directories aren't resolved from a repository, and are skipped.
+func readVendoredModules(projectPath string) ([]module.Version, error) {
+	content, err := os.ReadFile(filepath.Join(projectPath, filepath.FromSlash(vendorModulesFile)))
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	var modules []module.Version
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		// Module lines look like '# golang.org/x/text v0.3.0' or '# example.com/a v1.0.0 => example.com/b v1.1.0'.
+		line := scanner.Text()
+		if !strings.HasPrefix(line, "# ") {
+			continue
+		}
+		fields := strings.Fields(strings.TrimPrefix(line, "# "))
+		if index := slices.Index(fields, "=>"); index != -1 {
+			fields = fields[index+1:]
+		}
+		if len(fields) != 2 {
+			continue
+		}
+		modules = append(modules, module.Version{Path: fields[0], Version: fields[1]})
+	}
+	return modules, errorutils.CheckError(scanner.Err())
+}
+
+// collect returns the dependencies of the module, by their IDs in the form of <escaped path>:<version>.
+func (gdc *goSumDependenciesCollector) collect() ([]buildinfo.Dependency, error) {
+	var modules []module.Version
+	var err error
+	if isVendored(gdc.projectPath) {
+		log.Debug("Collecting the vendored dependencies from", vendorModulesFile)
+		modules, err = readVendoredModules(gdc.projectPath)
+	} else {
+		modules, err = readGoSumModules(gdc.projectPath)
+	}
+	if err != nil {
+		return nil, err
+	}
+	var dependencies []buildinfo.Dependency
+	for _, mod := range modules {
+		escapedPath, err := module.EscapePath(mod.Path)
+		if err != nil {
+			return nil, errorutils.CheckError(err)
+		}
+		escapedVersion, err := module.EscapeVersion(mod.Version)
+		if err != nil {
+			return nil, errorutils.CheckError(err)
+		}
+		zipPath := path.Join(escapedPath, "@v", escapedVersion+".zip")
+		checksum, err := gdc.getChecksum(zipPath)
+		if err != nil {
+			return nil, err
+		}
+		if checksum == nil {
+			log.Debug("The checksums of", mod.String(), "weren't found in the module cache or in Artifactory. The dependency is skipped.")
+			continue
+		}
+		dependencies = append(dependencies, buildinfo.Dependency{Id: escapedPath + ":" + mod.Version, Type: "zip", Checksum: *checksum})
+	}
+	return dependencies, nil
+}
+
+// getChecksum returns the checksums of a module zip, by its path relative to the module cache and to the repository.
+func (gdc *goSumDependenciesCollector) getChecksum(zipPath string) (*buildinfo.Checksum, error) {
+	if gdc.cachePath != "" {
+		cachedZipPath := filepath.Join(gdc.cachePath, filepath.FromSlash(zipPath))
+		if exists, err := fileutils.IsFileExists(cachedZipPath, false); err == nil && exists {
+			checksums, err := crypto.GetFileChecksums(cachedZipPath)
+			if err != nil {
+				return nil, errorutils.CheckError(err)
+			}
+			return &buildinfo.Checksum{Sha1: checksums[crypto.SHA1], Md5: checksums[crypto.MD5], Sha256: checksums[crypto.SHA256]}, nil
+		}
+	}
+	if gdc.servicesManager == nil {
+		return nil, nil
+	}
+	fileInfo, err := gdc.servicesManager.FileInfo(gdc.repo + "/" + zipPath)
+	if err != nil {
+		log.Debug("Failed to get the checksums of", zipPath, "from", gdc.repo+":", err.Error())
+		return nil, nil
+	}
+	return &buildinfo.Checksum{Sha1: fileInfo.Checksums.Sha1, Md5: fileInfo.Checksums.Md5, Sha256: fileInfo.Checksums.Sha256}, nil
+}
@@ -0,0 +1,132 @@
+package golang
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/jfrog/gofrog/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/mod/module"
+)
+
+const testGoSum = `github.com/BurntSushi/toml v1.3.2 h1:o7IhLm0Msx3BaB+n3Ag7L8EVlByGKoTzrlHklVbyxUY=
+github.com/BurntSushi/toml v1.3.2/go.mod h1:CxXYINrC8qIiEnFrOxCa7Jy5BFHlXnUU2pbicEuybxQ=
+golang.org/x/text v0.14.0 h1:ScX5w1eTa3QqT8oi6+ziP7dTV1S2+ALU0bI+0zXKWiQ=
+golang.org/x/text v0.14.0/go.mod h1:18ZOQIKpY8NJVqYksKHtTdi31H5itFRjB5/qKTNYzSU=
+golang.org/x/tools v0.1.0/go.mod h1:xkSsbof2nBLbhDlRMhhhyNLN/zl3eTqcnHD5viDpcZ0=
+`
+
+const testVendorModules = `# github.com/BurntSushi/toml v1.3.2
+## explicit; go 1.16
+github.com/BurntSushi/toml
+# golang.org/x/text v0.13.0 => golang.org/x/text v0.14.0
+## explicit; go 1.18
+golang.org/x/text/language
+# example.com/local v1.0.0 => ../local
+example.com/local
+`
+
+func writeGoProject(t *testing.T, files map[string]string) string {
+	projectPath := t.TempDir()
+	for name, content := range files {
+		filePath := filepath.Join(projectPath, filepath.FromSlash(name))
+		require.NoError(t, os.MkdirAll(filepath.Dir(filePath), 0755))
+		require.NoError(t, os.WriteFile(filePath, []byte(content), 0600))
+	}
+	return projectPath
+}
+
+func TestReadGoSumModules(t *testing.T) {
+	modules, err := readGoSumModules(writeGoProject(t, map[string]string{goSumFileName: testGoSum}))
+	require.NoError(t, err)
+	assert.Equal(t, []module.Version{{Path: "github.com/BurntSushi/toml", Version: "v1.3.2"}, {Path: "golang.org/x/text", Version: "v0.14.0"}}, modules)
+
+	modules, err = readGoSumModules(t.TempDir())
+	assert.NoError(t, err)
+	assert.Empty(t, modules)
+}
+
+func TestReadVendoredModules(t *testing.T) {
+	projectPath := writeGoProject(t, map[string]string{vendorModulesFile: testVendorModules})
+	assert.True(t, isVendored(projectPath))
+	modules, err := readVendoredModules(projectPath)
+	require.NoError(t, err)
+	assert.Equal(t, []module.Version{{Path: "github.com/BurntSushi/toml", Version: "v1.3.2"}, {Path: "golang.org/x/text", Version: "v0.14.0"}}, modules)
+}
+
+func TestReadModulePath(t *testing.T) {
+	modulePath, err := readModulePath(writeGoProject(t, map[string]string{"go.mod": "module github.com/acme/app\n\ngo 1.21\n"}))
+	require.NoError(t, err)
+	assert.Equal(t, "github.com/acme/app", modulePath)
+
+	_, err = readModulePath(writeGoProject(t, map[string]string{"go.mod": "go 1.21\n"}))
+	assert.Error(t, err)
+}
+
+func TestCollectGoSumDependencies(t *testing.T) {
+	projectPath := writeGoProject(t, map[string]string{goSumFileName: testGoSum})
+	// Only the escaped zip of github.com/BurntSushi/toml is cached.
+	cachePath := writeGoProject(t, map[string]string{"github.com/!burnt!sushi/toml/@v/v1.3.2.zip": "zip"})
+	checksums, err := crypto.GetFileChecksums(filepath.Join(cachePath, "github.com", "!burnt!sushi", "toml", "@v", "v1.3.2.zip"))
+	require.NoError(t, err)
+
+	collector := &goSumDependenciesCollector{projectPath: projectPath, cachePath: cachePath}
+	dependencies, err := collector.collect()
+	require.NoError(t, err)
+	require.Len(t, dependencies, 1)
+	assert.Equal(t, "github.com/!burnt!sushi/toml:v1.3.2", dependencies[0].Id)
+	assert.Equal(t, "zip", dependencies[0].Type)
+	assert.Equal(t, checksums[crypto.SHA1], dependencies[0].Sha1)
+	assert.Equal(t, checksums[crypto.SHA256], dependencies[0].Sha256)
+}
+
+func TestGetDefaultPrivateModules(t *testing.T) {
+	assert.Equal(t, "github.com/acme", getDefaultPrivateModules("github.com/acme/app/v2"))
+	assert.Equal(t, "go.acme.com", getDefaultPrivateModules("go.acme.com/platform/app"))
+	assert.Equal(t, "", getDefaultPrivateModules("app"))
+}
+
+func TestAppendGoEnvList(t *testing.T) {
+	assert.Equal(t, "github.com/acme", appendGoEnvList("", "github.com/acme"))
+	assert.Equal(t, "*.corp.com,github.com/acme", appendGoEnvList("*.corp.com, github.com/acme", "github.com/acme"))
+	assert.Equal(t, "*.corp.com,github.com/acme,go.acme.com", appendGoEnvList("*.corp.com", "github.com/acme,go.acme.com"))
+}
+
+func TestGetPrivateModulesEnv(t *testing.T) {
+	t.Setenv(goNoSumDbEnv, "*.corp.com")
+	t.Setenv(goPrivateEnv, "")
+	t.Setenv(goNoProxyEnv, "")
+	t.Setenv(goFlagsEnv, "-trimpath")
+
+	projectPath := writeGoProject(t, map[string]string{vendorModulesFile: testVendorModules})
+	env := getPrivateModulesEnv(projectPath, "github.com/acme", []string{"build"})
+	assert.Equal(t, map[string]string{
+		goNoSumDbEnv: "*.corp.com,github.com/acme",
+		goPrivateEnv: "github.com/acme",
+		goNoProxyEnv: "none",
+		goFlagsEnv:   "-trimpath -mod=vendor",
+	}, env)
+
+	// The -mod flag of the command isn't overridden.
+	env = getPrivateModulesEnv(projectPath, "", []string{"build", "-mod=mod"})
+	assert.Empty(t, env)
+}
+
+func TestSetGoEnv(t *testing.T) {
+	t.Setenv(goPrivateEnv, "*.corp.com")
+	// Register the restoration of GONOSUMDB before unsetting it.
+	t.Setenv(goNoSumDbEnv, "")
+	require.NoError(t, os.Unsetenv(goNoSumDbEnv))
+
+	restore, err := setGoEnv(map[string]string{goPrivateEnv: "github.com/acme", goNoSumDbEnv: "github.com/acme"})
+	require.NoError(t, err)
+	assert.Equal(t, "github.com/acme", os.Getenv(goPrivateEnv))
+	assert.Equal(t, "github.com/acme", os.Getenv(goNoSumDbEnv))
+
+	require.NoError(t, restore())
+	assert.Equal(t, "*.corp.com", os.Getenv(goPrivateEnv))
+	_, exists := os.LookupEnv(goNoSumDbEnv)
+	assert.False(t, exists)
+}
@@ -0,0 +1,117 @@
+package golang
+
+import (
+	"errors"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const (
+	goNoSumDbEnv  = "GONOSUMDB"
+	goPrivateEnv  = "GOPRIVATE"
+	goNoProxyEnv  = "GONOPROXY"
+	goFlagsEnv    = "GOFLAGS"
+	vendorModFlag = "-mod=vendor"
+)
+
+// Hosts whose module paths are in the form of <host>/<owner>/<repository>, so that the private modules of an owner
+// share the first two elements of their paths.
+var ownerScopedHosts = []string{"github.com", "gitlab.com", "bitbucket.org"}
+
+// getDefaultPrivateModules returns the pattern matching the private modules which share the owner of the main module.
+// Modules hosted in Artifactory are unknown to the public checksum database, and fail the checksum verification
+// unless they're excluded from it.
+func getDefaultPrivateModules(modulePath string) string {
+	elements := strings.Split(modulePath, "/")
+	// Paths without a dot in their first element, such as 'example/app', aren't fetched from a host.
+	if !strings.Contains(elements[0], ".") {
+		return ""
+	}
+	if slices.Contains(ownerScopedHosts, elements[0]) && len(elements) > 1 {
+		return elements[0] + "/" + elements[1]
+	}
+	return elements[0]
+}
+
+// appendGoEnvList appends patterns to a comma-separated Go environment list, such as GOPRIVATE, skipping the
+// patterns already in the list.
+func appendGoEnvList(current, patterns string) string {
+	list := splitGoEnvList(current)
+	for _, pattern := range splitGoEnvList(patterns) {
+		if !slices.Contains(list, pattern) {
+			list = append(list, pattern)
+		}
+	}
+	return strings.Join(list, ",")
+}
+
+func splitGoEnvList(value string) (list []string) {
+	for _, element := range strings.Split(value, ",") {
+		if element = strings.TrimSpace(element); element != "" {
+			list = append(list, element)
+		}
+	}
+	return
+}
+
+// hasModFlag returns true if the Go arguments, or GOFLAGS, already set the -mod flag.
+func hasModFlag(goArgs []string, goFlags string) bool {
+	for _, arg := range append(slices.Clone(goArgs), strings.Fields(goFlags)...) {
+		if arg == "-mod" || strings.HasPrefix(arg, "-mod=") {
+			return true
+		}
+	}
+	return false
+}
+
+// getPrivateModulesEnv returns the Go environment variables which should be set to build the module at projectPath,
+// with the private modules matching privateModules resolved through Artifactory:
+//   - The private modules are excluded from the checksum database by GONOSUMDB and GOPRIVATE.
+//   - Since GOPRIVATE also excludes the modules from the proxy by default, GONOPROXY is set to 'none', unless it's
+//     already set, so that the private modules are still resolved from Artifactory.
+//   - Vendored modules are built with -mod=vendor, unless the -mod flag is already set.
+func getPrivateModulesEnv(projectPath, privateModules string, goArgs []string) map[string]string {
+	env := make(map[string]string)
+	if privateModules != "" {
+		env[goNoSumDbEnv] = appendGoEnvList(os.Getenv(goNoSumDbEnv), privateModules)
+		env[goPrivateEnv] = appendGoEnvList(os.Getenv(goPrivateEnv), privateModules)
+		if os.Getenv(goNoProxyEnv) == "" {
+			env[goNoProxyEnv] = "none"
+		}
+	}
+	if goFlags := os.Getenv(goFlagsEnv); isVendored(projectPath) && !hasModFlag(goArgs, goFlags) {
+		env[goFlagsEnv] = strings.TrimSpace(goFlags + " " + vendorModFlag)
+	}
+	return env
+}
+
+// setGoEnv sets the environment variables, and returns a function restoring their previous values.
+func setGoEnv(env map[string]string) (restore func() error, err error) {
+	previous := make(map[string]*string)
+	restore = func() (err error) {
+		for key, value := range previous {
+			if value == nil {
+				err = errors.Join(err, errorutils.CheckError(os.Unsetenv(key)))
+			} else {
+				err = errors.Join(err, errorutils.CheckError(os.Setenv(key, *value)))
+			}
+		}
+		return
+	}
+	for key, value := range env {
+		if current, exists := os.LookupEnv(key); exists {
+			previous[key] = &current
+		} else {
+			previous[key] = nil
+		}
+		log.Debug("Setting", key+"="+value)
+		if err = os.Setenv(key, value); err != nil {
+			return nil, errors.Join(errorutils.CheckError(err), restore())
+		}
+	}
+	return
+}
@@ -34,8 +34,8 @@ func publishPackage(packageVersion, targetRepo, buildName, buildNumber, projectK
 		return nil, nil, errorutils.CheckError(err)
 	}
 
-	// Read module name
-	moduleName, err := GetModuleName(projectPath)
+	// Read module name from go.mod, without running Go, which would download the dependencies of vendored modules
+	moduleName, err := readModulePath(projectPath)
 	if err != nil {
 		return nil, nil, err
 	}
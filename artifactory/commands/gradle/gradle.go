@@ -23,6 +23,7 @@ import (
 	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
 	"github.com/jfrog/jfrog-cli-core/v2/utils/dependencies"
 	"github.com/jfrog/jfrog-cli-core/v2/utils/ioutils"
+	"github.com/jfrog/jfrog-client-go/auth"
 	clientutils "github.com/jfrog/jfrog-client-go/utils"
 	"github.com/jfrog/jfrog-client-go/utils/errorutils"
 	"github.com/jfrog/jfrog-client-go/utils/io/fileutils"
@@ -55,6 +56,8 @@ type GradleCommand struct {
 	deploymentDisabled bool
 	// File path for Gradle extractor in which all build's artifacts details will be listed at the end of the build.
 	buildArtifactsDetailsFile string
+	// Print the init script which resolves and publishes through the configured repository, without running the build.
+	printInitScript bool
 }
 
 func NewGradleCommand() *GradleCommand {
@@ -119,6 +122,9 @@ func (gc *GradleCommand) shouldCreateBuildArtifactsFile() bool {
 }
 
 func (gc *GradleCommand) Run() error {
+	if gc.printInitScript {
+		return gc.outputInitScript()
+	}
 	if artifactoryutils.ShouldRunNative(gc.configPath) {
 		return gc.runWithGradleNative()
 	}
@@ -143,6 +149,29 @@ func (gc *GradleCommand) Run() error {
 	return nil
 }
 
+// outputInitScript prints the init script of the resolution repository in the config file, for inspection or for
+// running Gradle with '--init-script'.
+func (gc *GradleCommand) outputInitScript() error {
+	vConfig, err := project.ReadConfigFile(gc.configPath, project.YAML)
+	if err != nil {
+		return err
+	}
+	resolverParams, err := project.GetRepoConfigByPrefix(gc.configPath, project.ProjectConfigResolverPrefix, vConfig)
+	if err != nil {
+		return err
+	}
+	serverDetails, err := resolverParams.ServerDetails()
+	if err != nil {
+		return err
+	}
+	initScript, err := GenerateInitScript(NewInitScriptAuthConfig(serverDetails, resolverParams.TargetRepo()))
+	if err != nil {
+		return err
+	}
+	log.Output(initScript)
+	return nil
+}
+
 func (gc *GradleCommand) unmarshalDeployableArtifacts(filesPath string) error {
 	result, err := commandsutils.UnmarshalDeployableArtifacts(filesPath, gc.configPath, gc.IsXrayScan())
 	if err != nil {
@@ -305,6 +334,11 @@ func (gc *GradleCommand) SetTasks(tasks []string) *GradleCommand {
 	return gc
 }
 
+func (gc *GradleCommand) SetPrintInitScript(printInitScript bool) *GradleCommand {
+	gc.printInitScript = printInitScript
+	return gc
+}
+
 func (gc *GradleCommand) SetThreads(threads int) *GradleCommand {
 	gc.threads = threads
 	return gc
@@ -359,9 +393,26 @@ type InitScriptAuthConfig struct {
 	ArtifactoryAccessToken string
 }
 
+// NewInitScriptAuthConfig creates the init script configuration of a repository. When an access token is configured,
+// it's used as the password of the username it was issued to.
+func NewInitScriptAuthConfig(serverDetails *config.ServerDetails, repoName string) InitScriptAuthConfig {
+	username := serverDetails.GetUser()
+	password := serverDetails.GetPassword()
+	if serverDetails.GetAccessToken() != "" {
+		password = serverDetails.GetAccessToken()
+		username = auth.ExtractUsernameFromAccessToken(password)
+	}
+	return InitScriptAuthConfig{
+		ArtifactoryURL:         serverDetails.GetArtifactoryUrl(),
+		GradleRepoName:         repoName,
+		ArtifactoryUsername:    username,
+		ArtifactoryAccessToken: password,
+	}
+}
+
 // GenerateInitScript generates a Gradle init script with the provided authentication configuration.
 func GenerateInitScript(config InitScriptAuthConfig) (string, error) {
-	tmpl, err := template.New("gradleTemplate").Parse(gradleInitScript)
+	tmpl, err := template.New("gradleTemplate").Funcs(template.FuncMap{"groovy": escapeGroovyString}).Parse(gradleInitScript)
 	if err != nil {
 		return "", fmt.Errorf("failed to parse Gradle init script template: %s", err)
 	}
@@ -378,6 +429,12 @@ func GenerateInitScript(config InitScriptAuthConfig) (string, error) {
 	return result.String(), nil
 }
 
+// escapeGroovyString escapes a value for a single-quoted Groovy string, so that credentials with quotes or backslashes
+// don't break the init script.
+func escapeGroovyString(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `'`, `\'`, "\n", `\n`, "\r", `\r`).Replace(value)
+}
+
 // WriteInitScript writes the Gradle init script to the Gradle user home `init.d` directory,
 // which stores initialization scripts. The final path should be `$GRADLE_USER_HOME/init.d/jfrog.init.gradle`.
 // More info on how Gradle invokes these init scripts can be found here:
@@ -24,7 +24,7 @@ func TestGenerateInitScript(t *testing.T) {
 	assert.Contains(t, script, "token")
 	// Verify publishing configuration is included
 	assert.Contains(t, script, "maven-publish")
-	assert.Contains(t, script, "publishing {")
+	assert.Contains(t, script, "project.extensions.configure('publishing')")
 
 	// Verify Maven repository configuration
 	assert.Contains(t, script, "repositories {")
@@ -35,7 +35,13 @@ func TestGenerateInitScript(t *testing.T) {
 
 	// Verify modern uri() function usage
 	assert.Contains(t, script, "url = uri(")
-	assert.Contains(t, script, "url uri(")
+	assert.NotContains(t, script, "url uri(")
+
+	// Verify the settings are configured before the settings script runs, and the repositories mode is respected
+	assert.Contains(t, script, "gradle.beforeSettings(configureSettings)")
+	assert.Contains(t, script, "settings.dependencyResolutionManagement.repositories")
+	assert.Contains(t, script, "repositoriesMode")
+	assert.NotContains(t, script, "allprojects")
 
 	// Verify exclusive publishing with clear()
 	assert.Contains(t, script, "clear()")
@@ -52,6 +58,19 @@ func TestGenerateInitScript(t *testing.T) {
 	assert.Contains(t, script, "gradleVersion >= GradleVersion.version")
 }
 
+func TestGenerateInitScriptEscapesValues(t *testing.T) {
+	config := InitScriptAuthConfig{
+		ArtifactoryURL:         "https://example.com/artifactory/",
+		GradleRepoName:         "example-repo",
+		ArtifactoryUsername:    "user",
+		ArtifactoryAccessToken: `it's\secret`,
+	}
+	script, err := GenerateInitScript(config)
+	assert.NoError(t, err)
+	assert.Contains(t, script, "def artifactoryUrl = 'https://example.com/artifactory'")
+	assert.Contains(t, script, `def artifactoryAccessToken = 'it\'s\\secret'`)
+}
+
 func TestWriteInitScript(t *testing.T) {
 	// Set up a temporary directory for testing
 	tempDir := t.TempDir()
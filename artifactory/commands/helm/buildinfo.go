@@ -4,7 +4,7 @@ import (
 	"fmt"
 	"github.com/jfrog/build-info-go/entities"
 	"github.com/jfrog/build-info-go/flexpack"
-	"github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clientconfig"
 	buildtool "github.com/jfrog/jfrog-cli-core/v2/common/build"
 	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
 	"github.com/jfrog/jfrog-client-go/utils/log"
@@ -12,7 +12,7 @@ import (
 
 // CollectHelmBuildInfoWithFlexPack collects Helm build info using FlexPack
 func CollectHelmBuildInfoWithFlexPack(workingDir, buildName, buildNumber, project, commandName string, helmArgs []string, serverDetails *config.ServerDetails) error {
-	serviceManager, err := utils.CreateServiceManager(serverDetails, -1, 0, false)
+	serviceManager, err := clientconfig.CreateServiceManager(serverDetails, -1, 0, false)
 	if err != nil {
 		return fmt.Errorf("failed to create services manager: %w", err)
 	}
@@ -28,6 +28,8 @@ func CollectHelmBuildInfoWithFlexPack(workingDir, buildName, buildNumber, projec
 	switch commandName {
 	case "push":
 		return handlePushCommand(buildInfo, helmArgs, serviceManager, buildName, buildNumber, project)
+	case "pull":
+		return handlePullCommand(buildInfo, helmArgs, serviceManager, workingDir, buildName, buildNumber, project)
 	case "package":
 		return handlePackageCommand(buildInfo, helmArgs, serviceManager, buildName, buildNumber, project)
 	case "dependency":
@@ -0,0 +1,136 @@
+package helm
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/jfrog/build-info-go/entities"
+	ioutils "github.com/jfrog/gofrog/io"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clientconfig"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/jfrog/jfrog-client-go/artifactory/services"
+	servicesUtils "github.com/jfrog/jfrog-client-go/artifactory/services/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const (
+	provenanceFileSuffix = ".prov"
+	helmApiPath          = "/api/helm/"
+)
+
+// isHelmRepositoryTarget checks if the target of helm push is a Helm repository in Artifactory rather than an OCI
+// registry. The target is either the name of the repository or its URL.
+func isHelmRepositoryTarget(target string) bool {
+	if target == "" || isOCIRepository(target) {
+		return false
+	}
+	return !strings.Contains(target, "://") || strings.HasPrefix(target, schemeHttp+"://") || strings.HasPrefix(target, schemeSecure+"://")
+}
+
+// getHelmRepositoryName returns the name of the Helm repository in Artifactory from a push target.
+// Example: "https://myserver.jfrog.io/artifactory/api/helm/helm-local" -> "helm-local"
+func getHelmRepositoryName(target string) string {
+	if idx := strings.Index(target, helmApiPath); idx != -1 {
+		target = target[idx+len(helmApiPath):]
+	} else if strings.Contains(target, "://") {
+		target = removeProtocolPrefix(target)
+		target = strings.TrimSuffix(target, "/")
+		return path.Base(target)
+	}
+	return strings.Split(strings.Trim(target, "/"), "/")[0]
+}
+
+// getProvenanceFilePath returns the path of the provenance file of a chart archive, which helm package --sign writes next
+// to it, or an empty string if the chart isn't signed.
+func getProvenanceFilePath(chartPath string) string {
+	provenancePath := chartPath + provenanceFileSuffix
+	if _, err := os.Stat(provenancePath); err != nil {
+		return ""
+	}
+	return provenancePath
+}
+
+// pushToHelmRepository uploads the chart archive and its provenance file to the root of a Helm repository in
+// Artifactory, which indexes them. Helm itself can push only to OCI registries.
+func (hc *HelmCommand) pushToHelmRepository(chartPath, target string) error {
+	if hc.serverDetails == nil {
+		return errorutils.CheckErrorf("no server details are configured for pushing to the Helm repository %s", target)
+	}
+	chartPath = hc.getLocalChartPath(chartPath)
+	repoName := getHelmRepositoryName(target)
+	filePaths := []string{chartPath}
+	if provenancePath := getProvenanceFilePath(chartPath); provenancePath != "" {
+		filePaths = append(filePaths, provenancePath)
+	}
+	var uploadParams []services.UploadParams
+	for _, filePath := range filePaths {
+		up := services.NewUploadParams()
+		up.Pattern = filepath.ToSlash(filePath)
+		up.Target = repoName + "/"
+		up.Flat = true
+		uploadParams = append(uploadParams, up)
+	}
+	serviceManager, err := clientconfig.CreateServiceManager(hc.serverDetails, -1, 0, false)
+	if err != nil {
+		return err
+	}
+	log.Info("Pushing", filepath.Base(chartPath), "to the Helm repository", repoName)
+	_, totalFailed, err := serviceManager.UploadFiles(artifactory.UploadServiceOptions{FailFast: true}, uploadParams...)
+	if err != nil {
+		return err
+	}
+	if totalFailed > 0 {
+		return errorutils.CheckErrorf("failed to push %s to the Helm repository %s", filepath.Base(chartPath), repoName)
+	}
+	return nil
+}
+
+// getPushedClassicArtifacts returns the chart archive and provenance file pushed to a Helm repository as build-info
+// artifacts, and sets the build properties on them.
+func getPushedClassicArtifacts(chartPath, target string, serviceManager artifactory.ArtifactoryServicesManager, buildProps string) ([]entities.Artifact, error) {
+	repoName := getHelmRepositoryName(target)
+	chartFileName := filepath.Base(chartPath)
+	searchParams := services.NewSearchParams()
+	searchParams.Pattern = fmt.Sprintf("%s/%s*", repoName, chartFileName)
+	searchParams.Recursive = false
+	reader, err := serviceManager.SearchFiles(searchParams)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search for the pushed chart: %w", err)
+	}
+	var closeErr error
+	defer func() {
+		ioutils.Close(reader, &closeErr)
+		if closeErr != nil {
+			log.Debug("Failed to close search reader: ", closeErr)
+		}
+	}()
+	var artifacts []entities.Artifact
+	for item := new(servicesUtils.ResultItem); reader.NextRecord(item) == nil; item = new(servicesUtils.ResultItem) {
+		if item.Type != "folder" && (item.Name == chartFileName || item.Name == chartFileName+provenanceFileSuffix) {
+			artifacts = append(artifacts, item.ToArtifact())
+		}
+	}
+	if len(artifacts) == 0 {
+		return nil, fmt.Errorf("%s was not found in the Helm repository %s", chartFileName, repoName)
+	}
+	reader.Reset()
+	addBuildPropertiesOnArtifacts(serviceManager, reader, buildProps)
+	return artifacts, nil
+}
+
+// getLocalChartPath returns the path of a chart archive relative to the working directory of the command.
+func (hc *HelmCommand) getLocalChartPath(chartPath string) string {
+	if filepath.IsAbs(chartPath) || hc.workingDirectory == "" {
+		return chartPath
+	}
+	return filepath.Join(hc.workingDirectory, chartPath)
+}
+
+// getClassicChartRepoPath returns the path of a chart archive pushed to a Helm repository.
+func getClassicChartRepoPath(chartPath, target string) string {
+	return getHelmRepositoryName(target) + "/" + filepath.Base(chartPath)
+}
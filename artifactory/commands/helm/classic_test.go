@@ -0,0 +1,50 @@
+package helm
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsHelmRepositoryTarget(t *testing.T) {
+	assert.True(t, isHelmRepositoryTarget("helm-local"))
+	assert.True(t, isHelmRepositoryTarget("https://myserver.jfrog.io/artifactory/api/helm/helm-local"))
+	assert.True(t, isHelmRepositoryTarget("http://localhost:8082/artifactory/helm-local"))
+	assert.False(t, isHelmRepositoryTarget("oci://myserver.jfrog.io/helm-oci"))
+	assert.False(t, isHelmRepositoryTarget("s3://bucket/charts"))
+	assert.False(t, isHelmRepositoryTarget(""))
+}
+
+func TestGetHelmRepositoryName(t *testing.T) {
+	tests := map[string]string{
+		"helm-local":  "helm-local",
+		"helm-local/": "helm-local",
+		"https://myserver.jfrog.io/artifactory/api/helm/helm-local":  "helm-local",
+		"https://myserver.jfrog.io/artifactory/api/helm/helm-local/": "helm-local",
+		"http://localhost:8082/artifactory/helm-local":               "helm-local",
+	}
+	for target, expected := range tests {
+		assert.Equal(t, expected, getHelmRepositoryName(target), target)
+	}
+}
+
+func TestGetProvenanceFilePath(t *testing.T) {
+	chartPath := filepath.Join(t.TempDir(), "mychart-1.0.0.tgz")
+	require.NoError(t, os.WriteFile(chartPath, []byte("chart"), 0600))
+	assert.Empty(t, getProvenanceFilePath(chartPath))
+
+	require.NoError(t, os.WriteFile(chartPath+".prov", []byte("provenance"), 0600))
+	assert.Equal(t, chartPath+".prov", getProvenanceFilePath(chartPath))
+	assert.Equal(t, "helm-local/mychart-1.0.0.tgz", getClassicChartRepoPath(chartPath, "https://myserver.jfrog.io/artifactory/api/helm/helm-local"))
+}
+
+func TestGetLocalChartPath(t *testing.T) {
+	hc := NewHelmCommand()
+	assert.Equal(t, "mychart-1.0.0.tgz", hc.getLocalChartPath("mychart-1.0.0.tgz"))
+	workingDir := t.TempDir()
+	hc.SetWorkingDirectory(workingDir)
+	assert.Equal(t, filepath.Join(workingDir, "mychart-1.0.0.tgz"), hc.getLocalChartPath("mychart-1.0.0.tgz"))
+}
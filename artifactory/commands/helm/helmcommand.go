@@ -4,6 +4,7 @@ import (
 	"fmt"
 	buildUtils "github.com/jfrog/jfrog-cli-core/v2/common/build"
 	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
 	"github.com/jfrog/jfrog-client-go/auth"
 	"github.com/jfrog/jfrog-client-go/utils/errorutils"
 	"github.com/jfrog/jfrog-client-go/utils/log"
@@ -12,6 +13,7 @@ import (
 	"os"
 	"os/exec"
 	"strings"
+	"time"
 )
 
 const PASSWORD_STDIN = "password-stdin"
@@ -26,6 +28,8 @@ type HelmCommand struct {
 	username           string
 	password           string
 	buildConfiguration *buildUtils.BuildConfiguration
+	provenanceKeyPath  string
+	provenanceKeyAlias string
 }
 
 // NewHelmCommand creates a new HelmCommand instance
@@ -86,6 +90,14 @@ func (hc *HelmCommand) SetServerId(serverId string) *HelmCommand {
 	return hc
 }
 
+// SetProvenanceKey sets the private key which signs the provenance of a pushed chart. If set, the provenance is
+// attached as evidence to the chart version. The key alias is the name of the public key in the platform.
+func (hc *HelmCommand) SetProvenanceKey(keyPath, keyAlias string) *HelmCommand {
+	hc.provenanceKeyPath = keyPath
+	hc.provenanceKeyAlias = keyAlias
+	return hc
+}
+
 // ServerDetails returns the server details
 func (hc *HelmCommand) ServerDetails() (*config.ServerDetails, error) {
 	return hc.serverDetails, nil
@@ -93,6 +105,32 @@ func (hc *HelmCommand) ServerDetails() (*config.ServerDetails, error) {
 
 // Run executes the Helm command
 func (hc *HelmCommand) Run() error {
+	if hc.cmdName != "push" {
+		return hc.runHelmCommand()
+	}
+	if err := hc.extractProvenanceKeyFromArgs(); err != nil {
+		return err
+	}
+	startedOn := time.Now()
+	chartPath, target := getPushChartPathAndRegistryURL(hc.helmArgs)
+	if isHelmRepositoryTarget(target) {
+		if err := hc.pushToHelmRepository(chartPath, target); err != nil {
+			return errorutils.CheckErrorf("helm %s failed: %w", hc.cmdName, err)
+		}
+		if err := hc.collectBuildInfoIfNeeded(); err != nil {
+			return errorutils.CheckError(err)
+		}
+	} else if err := hc.runHelmCommand(); err != nil {
+		return err
+	}
+	if hc.provenanceKeyPath == "" {
+		return nil
+	}
+	return hc.attachProvenance(chartPath, target, startedOn)
+}
+
+// runHelmCommand runs the native Helm command, and collects its build info
+func (hc *HelmCommand) runHelmCommand() error {
 	hc.appendCredentialsInArguments()
 	if err := hc.executeHelmCommand(); err != nil {
 		return errorutils.CheckErrorf("helm %s failed: %w", hc.cmdName, err)
@@ -103,6 +141,24 @@ func (hc *HelmCommand) Run() error {
 	return nil
 }
 
+// extractProvenanceKeyFromArgs extracts the provenance key options from the arguments of helm push, which doesn't
+// recognize them
+func (hc *HelmCommand) extractProvenanceKeyFromArgs() error {
+	helmArgs, provenanceKeyPath, err := coreutils.ExtractStringOptionFromArgs(hc.helmArgs, "provenance-key")
+	if err != nil {
+		return err
+	}
+	helmArgs, provenanceKeyAlias, err := coreutils.ExtractStringOptionFromArgs(helmArgs, "provenance-key-alias")
+	if err != nil {
+		return err
+	}
+	hc.helmArgs = helmArgs
+	if provenanceKeyPath != "" {
+		hc.SetProvenanceKey(provenanceKeyPath, provenanceKeyAlias)
+	}
+	return nil
+}
+
 // appendCredentialsInArguments appends the username and password to arguments
 func (hc *HelmCommand) appendCredentialsInArguments() {
 	if hc.username != "" && hc.password != "" {
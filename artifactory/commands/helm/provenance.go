@@ -0,0 +1,160 @@
+package helm
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/jfrog/build-info-go/utils/cienv"
+	"github.com/jfrog/gofrog/crypto"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clientconfig"
+	"github.com/jfrog/jfrog-cli-artifactory/evidence/attestation"
+	"github.com/jfrog/jfrog-cli-artifactory/evidence/signing"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const (
+	slsaProvenancePredicateType = "https://slsa.dev/provenance/v1"
+	helmPushBuildType           = "https://jfrog.com/cli/helm-push/v1"
+	jfrogCliBuilderId           = "https://jfrog.com/cli"
+)
+
+// pushedChart is a chart version which was pushed by the helm push command, addressed by its digest.
+type pushedChart struct {
+	name    string
+	version string
+	// The name of the chart in the provenance statement. This is its OCI reference by digest, or its archive name.
+	subjectName string
+	// The path of the manifest of the chart in an OCI repository, or of its archive in a Helm repository.
+	repoPath string
+	// The SHA-256 digest of the manifest or archive.
+	sha256 string
+}
+
+// The SLSA v1 provenance predicate of a pushed chart.
+type slsaProvenance struct {
+	BuildDefinition slsaBuildDefinition `json:"buildDefinition"`
+	RunDetails      slsaRunDetails      `json:"runDetails"`
+}
+
+type slsaBuildDefinition struct {
+	BuildType          string            `json:"buildType"`
+	ExternalParameters map[string]string `json:"externalParameters"`
+	InternalParameters map[string]string `json:"internalParameters,omitempty"`
+}
+
+type slsaRunDetails struct {
+	Builder struct {
+		Id string `json:"id"`
+	} `json:"builder"`
+	Metadata struct {
+		StartedOn  string `json:"startedOn"`
+		FinishedOn string `json:"finishedOn"`
+	} `json:"metadata"`
+}
+
+// getPushedChart finds the chart version pushed to the target. The digest of a chart in an OCI repository is the
+// digest of its manifest, and the digest of a chart in a Helm repository is the digest of its archive.
+func (hc *HelmCommand) getPushedChart(chartPath, target string) (*pushedChart, error) {
+	chartPath = hc.getLocalChartPath(chartPath)
+	chartName, chartVersion, err := getChartDetails(chartPath)
+	if err != nil {
+		return nil, fmt.Errorf("could not extract chart name/version from artifact %s: %w", chartPath, err)
+	}
+	chart := &pushedChart{name: chartName, version: chartVersion}
+	if isHelmRepositoryTarget(target) {
+		checksums, err := crypto.GetFileChecksums(chartPath, crypto.SHA256)
+		if err != nil {
+			return nil, errorutils.CheckError(err)
+		}
+		chart.subjectName = filepath.Base(chartPath)
+		chart.repoPath = getClassicChartRepoPath(chartPath, target)
+		chart.sha256 = checksums[crypto.SHA256]
+		return chart, nil
+	}
+	serviceManager, err := clientconfig.CreateServiceManager(hc.serverDetails, -1, 0, false)
+	if err != nil {
+		return nil, err
+	}
+	repoName := extractRepositoryNameFromURL(target)
+	if chart.sha256, err = getChartManifestSha256(serviceManager, repoName, chartName, chartVersion); err != nil {
+		return nil, err
+	}
+	chart.subjectName = fmt.Sprintf("%s/%s@sha256:%s", strings.TrimSuffix(removeProtocolPrefix(target), "/"), chartName, chart.sha256)
+	chart.repoPath = fmt.Sprintf("%s/%s/sha256:%s/manifest.json", repoName, chartName, chart.sha256)
+	return chart, nil
+}
+
+// createProvenanceStatement creates the SLSA provenance of a pushed chart, as an in-toto statement about its digest.
+func (hc *HelmCommand) createProvenanceStatement(chart *pushedChart, target string, startedOn, finishedOn time.Time) (*attestation.Statement, error) {
+	predicate := slsaProvenance{
+		BuildDefinition: slsaBuildDefinition{
+			BuildType: helmPushBuildType,
+			ExternalParameters: map[string]string{
+				"chart":      chart.name,
+				"version":    chart.version,
+				"repository": target,
+			},
+		},
+	}
+	if vcsInfo := cienv.GetCIVcsInfo(); !vcsInfo.IsEmpty() {
+		predicate.BuildDefinition.ExternalParameters["vcs.provider"] = vcsInfo.Provider
+		predicate.BuildDefinition.ExternalParameters["vcs.org"] = vcsInfo.Org
+		predicate.BuildDefinition.ExternalParameters["vcs.repo"] = vcsInfo.Repo
+	}
+	if hc.buildConfiguration != nil {
+		isCollectBuildInfo, err := hc.buildConfiguration.IsCollectBuildInfo()
+		if err != nil {
+			return nil, err
+		}
+		if isCollectBuildInfo {
+			buildName, err := hc.buildConfiguration.GetBuildName()
+			if err != nil {
+				return nil, err
+			}
+			buildNumber, err := hc.buildConfiguration.GetBuildNumber()
+			if err != nil {
+				return nil, err
+			}
+			predicate.BuildDefinition.InternalParameters = map[string]string{"build.name": buildName, "build.number": buildNumber}
+			if project := hc.buildConfiguration.GetProject(); project != "" {
+				predicate.BuildDefinition.InternalParameters["build.project"] = project
+			}
+		}
+	}
+	predicate.RunDetails.Builder.Id = jfrogCliBuilderId
+	predicate.RunDetails.Metadata.StartedOn = startedOn.UTC().Format(time.RFC3339)
+	predicate.RunDetails.Metadata.FinishedOn = finishedOn.UTC().Format(time.RFC3339)
+	subject := attestation.Subject{Name: chart.subjectName, Digest: map[string]string{"sha256": chart.sha256}}
+	return attestation.NewStatement(slsaProvenancePredicateType, predicate, subject), nil
+}
+
+// attachProvenance signs the provenance of the pushed chart, and attaches it as evidence to the chart version.
+func (hc *HelmCommand) attachProvenance(chartPath, target string, startedOn time.Time) error {
+	if hc.serverDetails == nil {
+		return errorutils.CheckErrorf("no server details are configured for attaching the provenance of %s", chartPath)
+	}
+	signer, err := signing.LoadSigner(hc.provenanceKeyPath)
+	if err != nil {
+		return err
+	}
+	chart, err := hc.getPushedChart(chartPath, target)
+	if err != nil {
+		return err
+	}
+	statement, err := hc.createProvenanceStatement(chart, target, startedOn, time.Now())
+	if err != nil {
+		return err
+	}
+	envelope, err := attestation.Sign(statement, signer, hc.provenanceKeyAlias)
+	if err != nil {
+		return err
+	}
+	if err = attestation.Upload(hc.serverDetails, chart.repoPath, envelope); err != nil {
+		return errorutils.CheckErrorf("failed to attach the provenance of '%s' as evidence: %s", chart.repoPath, err.Error())
+	}
+	log.Info("Attached the provenance of", chart.subjectName, "as evidence to", chart.repoPath)
+	return nil
+}
@@ -0,0 +1,44 @@
+package helm
+
+import (
+	"testing"
+	"time"
+
+	buildUtils "github.com/jfrog/jfrog-cli-core/v2/common/build"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateProvenanceStatement(t *testing.T) {
+	chart := &pushedChart{
+		name:        "mychart",
+		version:     "1.0.0",
+		subjectName: "myserver.jfrog.io/helm-oci/mychart@sha256:abc",
+		repoPath:    "helm-oci/mychart/sha256:abc/manifest.json",
+		sha256:      "abc",
+	}
+	hc := NewHelmCommand().SetBuildConfiguration(buildUtils.NewBuildConfiguration("my-build", "7", "", "my-project"))
+	startedOn := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	statement, err := hc.createProvenanceStatement(chart, "oci://myserver.jfrog.io/helm-oci", startedOn, startedOn.Add(time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, slsaProvenancePredicateType, statement.PredicateType)
+	require.Len(t, statement.Subject, 1)
+	assert.Equal(t, chart.subjectName, statement.Subject[0].Name)
+	assert.Equal(t, map[string]string{"sha256": "abc"}, statement.Subject[0].Digest)
+
+	predicate, ok := statement.Predicate.(slsaProvenance)
+	require.True(t, ok)
+	assert.Equal(t, helmPushBuildType, predicate.BuildDefinition.BuildType)
+	assert.Equal(t, "mychart", predicate.BuildDefinition.ExternalParameters["chart"])
+	assert.Equal(t, "oci://myserver.jfrog.io/helm-oci", predicate.BuildDefinition.ExternalParameters["repository"])
+	assert.Equal(t, map[string]string{"build.name": "my-build", "build.number": "7", "build.project": "my-project"}, predicate.BuildDefinition.InternalParameters)
+	assert.Equal(t, "2024-05-01T10:01:00Z", predicate.RunDetails.Metadata.FinishedOn)
+}
+
+func TestExtractProvenanceKeyFromArgs(t *testing.T) {
+	hc := NewHelmCommand().SetHelmCmdName("push").SetHelmArgs([]string{"mychart-1.0.0.tgz", "helm-local", "--provenance-key=key.pem", "--provenance-key-alias", "my-key"})
+	require.NoError(t, hc.extractProvenanceKeyFromArgs())
+	assert.Equal(t, []string{"mychart-1.0.0.tgz", "helm-local"}, hc.helmArgs)
+	assert.Equal(t, "key.pem", hc.provenanceKeyPath)
+	assert.Equal(t, "my-key", hc.provenanceKeyAlias)
+}
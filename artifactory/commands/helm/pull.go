@@ -0,0 +1,191 @@
+package helm
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/jfrog/build-info-go/entities"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+	"gopkg.in/yaml.v3"
+	"helm.sh/helm/v3/pkg/helmpath"
+)
+
+// The flags of helm pull which take a value.
+var pullValueFlags = map[string]bool{
+	"--version": true, "--repo": true, "-d": true, "--destination": true, "--untardir": true, "--username": true,
+	"--password": true, "--ca-file": true, "--cert-file": true, "--key-file": true, "--keyring": true,
+}
+
+// The repositories file of Helm, which maps the aliases added by helm repo add to the URLs of the repositories.
+type helmRepositoriesFile struct {
+	Repositories []struct {
+		Name string `yaml:"name"`
+		URL  string `yaml:"url"`
+	} `yaml:"repositories"`
+}
+
+// pullArgs holds the arguments of helm pull which locate the pulled chart.
+type pullArgs struct {
+	chartRef    string
+	version     string
+	repoURL     string
+	destination string
+	untar       bool
+	untarDir    string
+}
+
+func parsePullArgs(helmArgs []string) *pullArgs {
+	args := &pullArgs{destination: ".", untarDir: "."}
+	for i := 0; i < len(helmArgs); i++ {
+		arg := helmArgs[i]
+		if !strings.HasPrefix(arg, "-") {
+			if args.chartRef == "" {
+				args.chartRef = arg
+			}
+			continue
+		}
+		if arg == "--untar" || arg == "--untar=true" {
+			args.untar = true
+			continue
+		}
+		flag, value, hasValue := strings.Cut(arg, "=")
+		if !pullValueFlags[flag] {
+			continue
+		}
+		if !hasValue {
+			if i+1 >= len(helmArgs) {
+				break
+			}
+			i++
+			value = helmArgs[i]
+		}
+		switch flag {
+		case "--version":
+			args.version = value
+		case "--repo":
+			args.repoURL = value
+		case "-d", "--destination":
+			args.destination = value
+		case "--untardir":
+			args.untarDir = value
+		}
+	}
+	return args
+}
+
+// getChartName returns the name of the pulled chart, which is the last part of its reference.
+func (pa *pullArgs) getChartName() string {
+	return path.Base(strings.TrimSuffix(pa.chartRef, "/"))
+}
+
+// getPulledChartPath returns the path of the pulled chart archive, or of the directory it was extracted to.
+func (pa *pullArgs) getPulledChartPath(workingDir string) (string, error) {
+	destination := pa.destination
+	if !filepath.IsAbs(destination) {
+		destination = filepath.Join(workingDir, destination)
+	}
+	chartName := pa.getChartName()
+	if pa.untar {
+		untarDir := pa.untarDir
+		if !filepath.IsAbs(untarDir) {
+			untarDir = filepath.Join(destination, untarDir)
+		}
+		return filepath.Join(untarDir, chartName), nil
+	}
+	if pa.version != "" {
+		archive := filepath.Join(destination, fmt.Sprintf("%s-%s.tgz", chartName, pa.version))
+		if _, err := os.Stat(archive); err == nil {
+			return archive, nil
+		}
+	}
+	// The version is either absent or a constraint, so the newest archive of the chart is the pulled one.
+	archives, err := filepath.Glob(filepath.Join(destination, chartName+"-*.tgz"))
+	if err != nil {
+		return "", err
+	}
+	var pulledChartPath string
+	var pulledChartModTime int64
+	for _, archive := range archives {
+		info, err := os.Stat(archive)
+		if err != nil {
+			return "", err
+		}
+		if modTime := info.ModTime().UnixNano(); pulledChartPath == "" || modTime > pulledChartModTime {
+			pulledChartPath, pulledChartModTime = archive, modTime
+		}
+	}
+	if pulledChartPath == "" {
+		return "", fmt.Errorf("the pulled chart %s was not found in %s", chartName, destination)
+	}
+	return pulledChartPath, nil
+}
+
+// getRepository returns the repository of the pulled chart, in the form expected by processDependency. Charts
+// referenced as <repo alias>/<chart> are pulled from the Helm repository added under the alias.
+func (pa *pullArgs) getRepository() string {
+	if isOCIRepository(pa.chartRef) {
+		return pa.chartRef
+	}
+	if pa.repoURL != "" {
+		return getHelmRepositoryName(pa.repoURL)
+	}
+	alias, _, found := strings.Cut(pa.chartRef, "/")
+	if !found {
+		return ""
+	}
+	repositoryConfig := os.Getenv("HELM_REPOSITORY_CONFIG")
+	if repositoryConfig == "" {
+		repositoryConfig = helmpath.ConfigPath("repositories.yaml")
+	}
+	content, err := os.ReadFile(repositoryConfig)
+	if err != nil {
+		log.Debug("Failed to read the Helm repositories file: ", err)
+		return ""
+	}
+	var repositories helmRepositoriesFile
+	if err = yaml.Unmarshal(content, &repositories); err != nil {
+		log.Debug("Failed to parse the Helm repositories file: ", err)
+		return ""
+	}
+	for _, repository := range repositories.Repositories {
+		if repository.Name == alias {
+			return getHelmRepositoryName(repository.URL)
+		}
+	}
+	return ""
+}
+
+// handlePullCommand adds the pulled chart to the build-info as a dependency of the module of the working directory.
+func handlePullCommand(buildInfo *entities.BuildInfo, helmArgs []string, serviceManager artifactory.ArtifactoryServicesManager, workingDir, buildName, buildNumber, project string) error {
+	args := parsePullArgs(helmArgs)
+	if args.chartRef == "" {
+		return fmt.Errorf("invalid helm chart reference")
+	}
+	pulledChartPath, err := args.getPulledChartPath(workingDir)
+	if err != nil {
+		return err
+	}
+	chartName, chartVersion, err := getChartDetails(pulledChartPath)
+	if err != nil {
+		return fmt.Errorf("could not extract chart name/version from %s: %w", pulledChartPath, err)
+	}
+	log.Debug("Processing pull command for chart: ", args.chartRef, " version: ", chartVersion)
+	var dependencies []entities.Dependency
+	dependency := entities.Dependency{Id: fmt.Sprintf("%s:%s", chartName, chartVersion), Repository: args.getRepository()}
+	processDependency(dependency, serviceManager, &dependencies)
+	if len(dependencies) == 0 {
+		log.Warn("The pulled chart", dependency.Id, "was not found in Artifactory, so it is not added to the build-info")
+		return nil
+	}
+	moduleId := filepath.Base(workingDir)
+	if name, version, err := getChartDetails(workingDir); err == nil {
+		moduleId = fmt.Sprintf("%s:%s", name, version)
+	}
+	appendModuleInExistingBuildInfo(buildInfo, &entities.Module{Id: moduleId, Type: "helm", Dependencies: dependencies})
+	removeDuplicateDependencies(buildInfo)
+	return saveBuildInfo(buildInfo, buildName, buildNumber, project)
+}
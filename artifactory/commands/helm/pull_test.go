@@ -0,0 +1,59 @@
+package helm
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePullArgs(t *testing.T) {
+	args := parsePullArgs([]string{"oci://myserver.jfrog.io/helm-oci/mychart", "--version", "1.2.0", "-d=charts", "--untar", "--untardir", "extracted"})
+	assert.Equal(t, "oci://myserver.jfrog.io/helm-oci/mychart", args.chartRef)
+	assert.Equal(t, "1.2.0", args.version)
+	assert.Equal(t, "charts", args.destination)
+	assert.True(t, args.untar)
+	assert.Equal(t, "extracted", args.untarDir)
+	assert.Equal(t, "mychart", args.getChartName())
+
+	args = parsePullArgs([]string{"--repo=https://myserver.jfrog.io/artifactory/api/helm/helm-remote", "mychart"})
+	assert.Equal(t, "mychart", args.chartRef)
+	assert.Equal(t, ".", args.destination)
+	assert.False(t, args.untar)
+	assert.Equal(t, "helm-remote", args.getRepository())
+}
+
+func TestGetPulledChartPath(t *testing.T) {
+	workingDir := t.TempDir()
+	args := parsePullArgs([]string{"stable/mychart", "--untar"})
+	chartPath, err := args.getPulledChartPath(workingDir)
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(workingDir, "mychart"), chartPath)
+
+	args = parsePullArgs([]string{"stable/mychart", "--version", "^1.0.0"})
+	_, err = args.getPulledChartPath(workingDir)
+	assert.Error(t, err)
+
+	archive := filepath.Join(workingDir, "mychart-1.2.0.tgz")
+	require.NoError(t, os.WriteFile(archive, []byte("chart"), 0600))
+	chartPath, err = args.getPulledChartPath(workingDir)
+	require.NoError(t, err)
+	assert.Equal(t, archive, chartPath)
+}
+
+func TestGetPullRepositoryFromAlias(t *testing.T) {
+	repositoryConfig := filepath.Join(t.TempDir(), "repositories.yaml")
+	content := `apiVersion: ""
+repositories:
+- name: stable
+  url: https://myserver.jfrog.io/artifactory/api/helm/helm-virtual
+`
+	require.NoError(t, os.WriteFile(repositoryConfig, []byte(content), 0600))
+	t.Setenv("HELM_REPOSITORY_CONFIG", repositoryConfig)
+
+	assert.Equal(t, "helm-virtual", parsePullArgs([]string{"stable/mychart"}).getRepository())
+	assert.Empty(t, parsePullArgs([]string{"other/mychart"}).getRepository())
+	assert.Equal(t, "oci://myserver.jfrog.io/helm-oci/mychart", parsePullArgs([]string{"oci://myserver.jfrog.io/helm-oci/mychart"}).getRepository())
+}
@@ -29,44 +29,51 @@ func handlePushCommand(buildInfo *entities.BuildInfo, helmArgs []string, service
 	}
 	appendModuleAndBuildAgentIfAbsent(buildInfo, chartName, chartVersion)
 	log.Debug("Processing push command for chart: ", filePath, " to registry: ", registryURL)
-	repoName := extractRepositoryNameFromURL(registryURL)
 	timestamp := strconv.FormatInt(time.Now().UnixNano()/int64(time.Millisecond), 10)
 	buildProps := fmt.Sprintf("build.name=%s;build.number=%s;build.timestamp=%s", buildName, buildNumber, timestamp)
 	if project != "" {
 		buildProps += fmt.Sprintf(";build.project=%s", project)
 	}
-	aqlQuery := fmt.Sprintf(`{
-	  "repo": "%s",
-	  "path": "%s/%s",
-	  "name": "manifest.json"
-	}`, repoName, chartName, chartVersion)
-	resultMap, err := searchOCIArtifactsByAQL(serviceManager, aqlQuery)
-	if err != nil {
-		return fmt.Errorf("failed to search manifest for %s : %s: %w", chartName, chartVersion, err)
+	var artifacts []entities.Artifact
+	if isHelmRepositoryTarget(registryURL) {
+		artifacts, err = getPushedClassicArtifacts(filePath, registryURL, serviceManager, buildProps)
+	} else {
+		artifacts, err = getPushedOCIArtifacts(serviceManager, extractRepositoryNameFromURL(registryURL), chartName, chartVersion, buildProps)
 	}
-	if len(resultMap) == 0 {
-		return fmt.Errorf("no manifest found for chart: %s : %s", chartName, chartVersion)
+	if err != nil {
+		return err
 	}
-	manifestSha256, err := getManifestSha256(resultMap)
+	addArtifactsInBuildInfo(buildInfo, artifacts, chartName, chartVersion)
+	removeDuplicateArtifacts(buildInfo)
+	dependencies, err := getChartDependencies(filePath, serviceManager)
 	if err != nil {
 		return err
 	}
-	if manifestSha256 == "" {
-		return fmt.Errorf("no manifest found for chart: %s : %s", chartName, chartVersion)
+	addDependenciesInBuildInfo(buildInfo, dependencies, chartName, chartVersion)
+	removeDuplicateDependencies(buildInfo)
+	return saveBuildInfo(buildInfo, buildName, buildNumber, project)
+}
+
+// getPushedOCIArtifacts returns the layers of a chart pushed to an OCI registry as build-info artifacts, and sets the
+// build properties on them. The provenance file of a signed chart is pushed by Helm as one of its layers.
+func getPushedOCIArtifacts(serviceManager artifactory.ArtifactoryServicesManager, repoName, chartName, chartVersion, buildProps string) ([]entities.Artifact, error) {
+	manifestSha256, err := getChartManifestSha256(serviceManager, repoName, chartName, chartVersion)
+	if err != nil {
+		return nil, err
 	}
-	resultMap, err = searchPushedArtifacts(serviceManager, repoName, chartName, manifestSha256, buildProps)
+	resultMap, err := searchPushedArtifacts(serviceManager, repoName, chartName, manifestSha256, buildProps)
 	if err != nil {
-		return fmt.Errorf("failed to search oci layers for %s : %s: %w", chartName, chartVersion, err)
+		return nil, fmt.Errorf("failed to search oci layers for %s : %s: %w", chartName, chartVersion, err)
 	}
 	if len(resultMap) == 0 {
-		return fmt.Errorf("no oci layers found for chart: %s : %s", chartName, chartVersion)
+		return nil, fmt.Errorf("no oci layers found for chart: %s : %s", chartName, chartVersion)
 	}
 	artifactManifest, err := getManifest(resultMap, serviceManager, repoName)
 	if err != nil {
-		return fmt.Errorf("failed to get manifest")
+		return nil, fmt.Errorf("failed to get manifest")
 	}
 	if artifactManifest == nil {
-		return fmt.Errorf("could not find image manifest in Artifactory")
+		return nil, fmt.Errorf("could not find image manifest in Artifactory")
 	}
 	layerDigests := make([]struct{ Digest, MediaType string }, len(artifactManifest.Layers))
 	for i, layerItem := range artifactManifest.Layers {
@@ -77,15 +84,37 @@ func handlePushCommand(buildInfo *entities.BuildInfo, helmArgs []string, service
 	}
 	artifactsLayers, err := ocicontainer.ExtractLayersFromManifestData(resultMap, artifactManifest.Config.Digest, layerDigests)
 	if err != nil {
-		return fmt.Errorf("failed to extract OCI artifacts for %s : %s: %w", chartName, chartVersion, err)
+		return nil, fmt.Errorf("failed to extract OCI artifacts for %s : %s: %w", chartName, chartVersion, err)
 	}
 	var artifacts []entities.Artifact
 	for _, artLayer := range artifactsLayers {
 		artifacts = append(artifacts, artLayer.ToArtifact())
 	}
-	addArtifactsInBuildInfo(buildInfo, artifacts, chartName, chartVersion)
-	removeDuplicateArtifacts(buildInfo)
-	return saveBuildInfo(buildInfo, buildName, buildNumber, project)
+	return artifacts, nil
+}
+
+// getChartManifestSha256 returns the digest of the manifest of a chart version in an OCI repository.
+func getChartManifestSha256(serviceManager artifactory.ArtifactoryServicesManager, repoName, chartName, chartVersion string) (string, error) {
+	aqlQuery := fmt.Sprintf(`{
+	  "repo": "%s",
+	  "path": "%s/%s",
+	  "name": "manifest.json"
+	}`, repoName, chartName, chartVersion)
+	resultMap, err := searchOCIArtifactsByAQL(serviceManager, aqlQuery)
+	if err != nil {
+		return "", fmt.Errorf("failed to search manifest for %s : %s: %w", chartName, chartVersion, err)
+	}
+	if len(resultMap) == 0 {
+		return "", fmt.Errorf("no manifest found for chart: %s : %s", chartName, chartVersion)
+	}
+	manifestSha256, err := getManifestSha256(resultMap)
+	if err != nil {
+		return "", err
+	}
+	if manifestSha256 == "" {
+		return "", fmt.Errorf("no manifest found for chart: %s : %s", chartName, chartVersion)
+	}
+	return manifestSha256, nil
 }
 
 // searchPushedArtifacts searches for pushed OCI artifacts using a search pattern
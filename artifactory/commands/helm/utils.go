@@ -3,6 +3,7 @@ package helm
 import (
 	"fmt"
 	"github.com/jfrog/build-info-go/entities"
+	"github.com/jfrog/jfrog-client-go/artifactory"
 	"github.com/jfrog/jfrog-client-go/utils/log"
 	"helm.sh/helm/v3/pkg/chart/loader"
 	"helm.sh/helm/v3/pkg/registry"
@@ -15,6 +16,7 @@ func needBuildInfo(cmdName string) bool {
 	buildInfoNeededCommands := map[string]bool{
 		"dependency": true,
 		"package":    true,
+		"pull":       true,
 		"push":       true,
 	}
 	return buildInfoNeededCommands[cmdName]
@@ -67,6 +69,28 @@ func getChartDetails(filePath string) (string, string, error) {
 	return name, version, nil
 }
 
+// getChartDependencies returns the subcharts packaged in a chart as build-info dependencies, with the checksums of
+// their charts in Artifactory. Subcharts which aren't found in Artifactory are skipped.
+func getChartDependencies(filePath string, serviceManager artifactory.ArtifactoryServicesManager) ([]entities.Dependency, error) {
+	loadedChart, err := loader.Load(filePath)
+	if err != nil {
+		return nil, err
+	}
+	repositories := make(map[string]string)
+	for _, dependency := range loadedChart.Metadata.Dependencies {
+		repositories[dependency.Name] = dependency.Repository
+	}
+	var dependencies []entities.Dependency
+	for _, subchart := range loadedChart.Dependencies() {
+		dependency := entities.Dependency{
+			Id:         fmt.Sprintf("%s:%s", subchart.Metadata.Name, subchart.Metadata.Version),
+			Repository: repositories[subchart.Metadata.Name],
+		}
+		processDependency(dependency, serviceManager, &dependencies)
+	}
+	return dependencies, nil
+}
+
 // getUploadedFileDeploymentPath extracts the deployment path from the OCI registry URL argument
 func getUploadedFileDeploymentPath(registryURL string) string {
 	if registryURL == "" {
@@ -164,6 +188,19 @@ func addArtifactsInBuildInfo(buildInfo *entities.BuildInfo, artifacts []entities
 	}
 }
 
+func addDependenciesInBuildInfo(buildInfo *entities.BuildInfo, dependencies []entities.Dependency, chartName, chartVersion string) {
+	if buildInfo == nil {
+		return
+	}
+	moduleId := fmt.Sprintf("%s:%s", chartName, chartVersion)
+	for moduleIdx, module := range buildInfo.Modules {
+		if module.Id == moduleId {
+			module.Dependencies = append(module.Dependencies, dependencies...)
+			buildInfo.Modules[moduleIdx] = module
+		}
+	}
+}
+
 func removeDuplicateArtifacts(buildInfo *entities.BuildInfo) {
 	if buildInfo == nil {
 		return
@@ -0,0 +1,237 @@
+// Package linuxpackage deploys Debian and RPM packages to Artifactory, triggers the recalculation of the metadata of their
+// repositories, and waits for the metadata to index them, so that apt and yum can install them right away.
+package linuxpackage
+
+import (
+	"fmt"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clientconfig"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/jfrog/jfrog-client-go/artifactory/services"
+	servicesUtils "github.com/jfrog/jfrog-client-go/artifactory/services/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const (
+	Debian = "deb"
+	Rpm    = "rpm"
+
+	DefaultComponent   = "main"
+	DefaultWaitTimeout = 10 * time.Minute
+	indexPollInterval  = 5 * time.Second
+
+	debDistributionProp = "deb.distribution"
+	debComponentProp    = "deb.component"
+	debArchitectureProp = "deb.architecture"
+)
+
+// deployedPackage is a package file and its coordinates in the repository.
+type deployedPackage struct {
+	localPath string
+	// The path of the package in the repository, without the repository.
+	repoPath string
+	// The directory of the metadata which indexes the package, relative to the root of the repository. It's the
+	// distribution of a Debian package, and the root of the YUM metadata of an RPM package.
+	indexRoot    string
+	architecture string
+	props        *servicesUtils.Properties
+}
+
+// PackageDeployCommand deploys the Debian or RPM packages matching a local pattern to a repository. Debian packages are
+// deployed with the properties of their distributions, component and architecture to their pool, and RPM packages are
+// deployed to the provided path, which is the root of the YUM metadata.
+type PackageDeployCommand struct {
+	serverDetails *config.ServerDetails
+	packageType   string
+	pattern       string
+	repo          string
+	targetPath    string
+	distributions []string
+	component     string
+	architecture  string
+	wait          bool
+	waitTimeout   time.Duration
+	client        indexClient
+	sleep         func(time.Duration)
+}
+
+func NewPackageDeployCommand(packageType string) *PackageDeployCommand {
+	return &PackageDeployCommand{packageType: packageType, component: DefaultComponent, wait: true, waitTimeout: DefaultWaitTimeout, sleep: time.Sleep}
+}
+
+// SetPattern sets the local path of the packages, in which '*' is a wildcard.
+func (pdc *PackageDeployCommand) SetPattern(pattern string) *PackageDeployCommand {
+	pdc.pattern = pattern
+	return pdc
+}
+
+// SetTarget sets the repository, optionally followed by the path of the packages in it: <repo>[/<path>].
+func (pdc *PackageDeployCommand) SetTarget(target string) *PackageDeployCommand {
+	pdc.repo, pdc.targetPath, _ = strings.Cut(strings.Trim(target, "/"), "/")
+	return pdc
+}
+
+func (pdc *PackageDeployCommand) SetDistributions(distributions []string) *PackageDeployCommand {
+	pdc.distributions = distributions
+	return pdc
+}
+
+func (pdc *PackageDeployCommand) SetComponent(component string) *PackageDeployCommand {
+	pdc.component = component
+	return pdc
+}
+
+// SetArchitecture sets the architecture of the Debian packages. By default, it's read from their file names.
+func (pdc *PackageDeployCommand) SetArchitecture(architecture string) *PackageDeployCommand {
+	pdc.architecture = architecture
+	return pdc
+}
+
+// SetWait sets whether to wait for the metadata of the repository to index the deployed packages.
+func (pdc *PackageDeployCommand) SetWait(wait bool) *PackageDeployCommand {
+	pdc.wait = wait
+	return pdc
+}
+
+func (pdc *PackageDeployCommand) SetWaitTimeout(waitTimeout time.Duration) *PackageDeployCommand {
+	pdc.waitTimeout = waitTimeout
+	return pdc
+}
+
+func (pdc *PackageDeployCommand) SetServerDetails(serverDetails *config.ServerDetails) *PackageDeployCommand {
+	pdc.serverDetails = serverDetails
+	return pdc
+}
+
+func (pdc *PackageDeployCommand) ServerDetails() (*config.ServerDetails, error) {
+	return pdc.serverDetails, nil
+}
+
+func (pdc *PackageDeployCommand) CommandName() string {
+	return "rt_" + pdc.packageType + "_deploy"
+}
+
+func (pdc *PackageDeployCommand) Run() error {
+	packages, err := pdc.getPackages()
+	if err != nil {
+		return err
+	}
+	servicesManager, err := clientconfig.CreateServiceManager(pdc.serverDetails, -1, 0, false)
+	if err != nil {
+		return err
+	}
+	if pdc.client == nil {
+		pdc.client = &artifactoryIndexClient{servicesManager: servicesManager}
+	}
+	if err = deployPackages(servicesManager, pdc.repo, packages); err != nil {
+		return err
+	}
+	if err = pdc.reindex(packages); err != nil {
+		return err
+	}
+	if !pdc.wait {
+		return nil
+	}
+	return pdc.waitForIndex(packages)
+}
+
+// getPackages returns the packages matching the pattern, with their coordinates in the repository.
+func (pdc *PackageDeployCommand) getPackages() ([]deployedPackage, error) {
+	if pdc.repo == "" {
+		return nil, errorutils.CheckErrorf("a target repository is required")
+	}
+	if pdc.packageType == Debian && len(pdc.distributions) == 0 {
+		return nil, errorutils.CheckErrorf("the distribution of the Debian packages is required")
+	}
+	localPaths, err := filepath.Glob(pdc.pattern)
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	var packages []deployedPackage
+	for _, localPath := range localPaths {
+		if !strings.HasSuffix(localPath, "."+pdc.packageType) {
+			log.Debug("Skipping", localPath, "which isn't a", pdc.packageType, "package")
+			continue
+		}
+		var deployed deployedPackage
+		if pdc.packageType == Debian {
+			deployed, err = pdc.getDebianPackage(localPath)
+		} else {
+			deployed = pdc.getRpmPackage(localPath)
+		}
+		if err != nil {
+			return nil, err
+		}
+		packages = append(packages, deployed)
+	}
+	if len(packages) == 0 {
+		return nil, errorutils.CheckErrorf("no %s packages match '%s'", pdc.packageType, pdc.pattern)
+	}
+	return packages, nil
+}
+
+// getDebianPackage returns the coordinates of a Debian package, which is named <name>_<version>_<architecture>.deb. It's
+// deployed to pool/<component>/<prefix>/<name>/ unless a path is provided, in which the prefix is the first letter of
+// the name, or its first four letters if it starts with 'lib', as in the Debian archive.
+func (pdc *PackageDeployCommand) getDebianPackage(localPath string) (deployedPackage, error) {
+	fileName := filepath.Base(localPath)
+	nameParts := strings.Split(strings.TrimSuffix(fileName, ".deb"), "_")
+	architecture := pdc.architecture
+	if architecture == "" {
+		if len(nameParts) != 3 {
+			return deployedPackage{}, errorutils.CheckErrorf("the architecture of %s can't be read from its name, which isn't <name>_<version>_<architecture>.deb. Provide it with --architecture", fileName)
+		}
+		architecture = nameParts[2]
+	}
+	repoPath := path.Join(pdc.targetPath, fileName)
+	if pdc.targetPath == "" {
+		name := nameParts[0]
+		if name == "" {
+			return deployedPackage{}, errorutils.CheckErrorf("the name of the package can't be read from %s", fileName)
+		}
+		prefix := name[:1]
+		if strings.HasPrefix(name, "lib") && len(name) > 3 {
+			prefix = name[:4]
+		}
+		repoPath = path.Join("pool", pdc.component, prefix, name, fileName)
+	}
+	props := servicesUtils.NewProperties()
+	for _, distribution := range pdc.distributions {
+		props.AddProperty(debDistributionProp, distribution)
+	}
+	props.AddProperty(debComponentProp, pdc.component)
+	props.AddProperty(debArchitectureProp, architecture)
+	return deployedPackage{localPath: localPath, repoPath: repoPath, architecture: architecture, props: props}, nil
+}
+
+// getRpmPackage returns the coordinates of an RPM package, which is deployed to the provided path.
+func (pdc *PackageDeployCommand) getRpmPackage(localPath string) deployedPackage {
+	return deployedPackage{localPath: localPath, repoPath: path.Join(pdc.targetPath, filepath.Base(localPath)), indexRoot: pdc.targetPath}
+}
+
+func deployPackages(servicesManager artifactory.ArtifactoryServicesManager, repo string, packages []deployedPackage) error {
+	uploadParamsArray := make([]services.UploadParams, 0, len(packages))
+	for _, deployed := range packages {
+		uploadParams := services.NewUploadParams()
+		uploadParams.Pattern = filepath.ToSlash(deployed.localPath)
+		uploadParams.Target = path.Join(repo, deployed.repoPath)
+		uploadParams.Flat = true
+		uploadParams.TargetProps = deployed.props
+		uploadParamsArray = append(uploadParamsArray, uploadParams)
+	}
+	succeeded, failed, err := servicesManager.UploadFiles(artifactory.UploadServiceOptions{}, uploadParamsArray...)
+	if err != nil {
+		return err
+	}
+	if failed > 0 {
+		return errorutils.CheckErrorf("failed to deploy %d of the %d packages to %s", failed, succeeded+failed, repo)
+	}
+	log.Info(fmt.Sprintf("Deployed %d packages to %s", succeeded, repo))
+	return nil
+}
@@ -0,0 +1,128 @@
+package linuxpackage
+
+import (
+	"bytes"
+	"compress/gzip"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeIndexClient serves the metadata files of a repository, which are indexed after the configured number of reads.
+type fakeIndexClient struct {
+	recalculated []string
+	files        map[string][]byte
+	readsToIndex int
+	reads        int
+}
+
+func (fic *fakeIndexClient) recalculate(apiPath string) error {
+	fic.recalculated = append(fic.recalculated, apiPath)
+	return nil
+}
+
+func (fic *fakeIndexClient) getFile(repoPath string) ([]byte, bool, error) {
+	fic.reads++
+	if fic.reads <= fic.readsToIndex {
+		return nil, false, nil
+	}
+	content, found := fic.files[repoPath]
+	return content, found, nil
+}
+
+func createPackageFiles(t *testing.T, fileNames ...string) string {
+	dir := t.TempDir()
+	for _, fileName := range fileNames {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, fileName), []byte(fileName), 0644))
+	}
+	return dir
+}
+
+func TestGetDebianPackages(t *testing.T) {
+	dir := createPackageFiles(t, "libssl3_3.0.2_amd64.deb", "curl_7.81.0_arm64.deb", "notes.txt")
+	command := NewPackageDeployCommand(Debian).SetPattern(filepath.Join(dir, "*")).SetTarget("debian-local").SetDistributions([]string{"focal", "jammy"})
+	packages, err := command.getPackages()
+	require.NoError(t, err)
+	require.Len(t, packages, 2)
+	assert.Equal(t, "pool/main/c/curl/curl_7.81.0_arm64.deb", packages[0].repoPath)
+	assert.Equal(t, "pool/main/libs/libssl3/libssl3_3.0.2_amd64.deb", packages[1].repoPath)
+	assert.Equal(t, map[string][]string{
+		debDistributionProp: {"focal", "jammy"},
+		debComponentProp:    {DefaultComponent},
+		debArchitectureProp: {"amd64"},
+	}, packages[1].props.ToMap())
+
+	// A provided path and architecture replace the defaults.
+	command.SetTarget("debian-local/custom/").SetArchitecture("all")
+	packages, err = command.getPackages()
+	require.NoError(t, err)
+	assert.Equal(t, "custom/curl_7.81.0_arm64.deb", packages[0].repoPath)
+	assert.Equal(t, "all", packages[0].architecture)
+
+	_, err = NewPackageDeployCommand(Debian).SetPattern(filepath.Join(dir, "*")).SetTarget("debian-local").getPackages()
+	assert.ErrorContains(t, err, "distribution")
+	_, err = command.SetPattern(filepath.Join(dir, "*.rpm")).getPackages()
+	assert.ErrorContains(t, err, "no deb packages")
+}
+
+func TestGetRpmPackages(t *testing.T) {
+	dir := createPackageFiles(t, "app-1.0-1.x86_64.rpm")
+	packages, err := NewPackageDeployCommand(Rpm).SetPattern(filepath.Join(dir, "*.rpm")).SetTarget("/rpm-local/el9/").getPackages()
+	require.NoError(t, err)
+	require.Len(t, packages, 1)
+	assert.Equal(t, "el9/app-1.0-1.x86_64.rpm", packages[0].repoPath)
+	assert.Equal(t, "el9", packages[0].indexRoot)
+}
+
+func TestReindex(t *testing.T) {
+	client := &fakeIndexClient{}
+	command := NewPackageDeployCommand(Debian).SetTarget("debian-local")
+	command.client = client
+	require.NoError(t, command.reindex(nil))
+	assert.Equal(t, []string{"api/deb/reindex/debian-local?async=1"}, client.recalculated)
+
+	client = &fakeIndexClient{}
+	command = NewPackageDeployCommand(Rpm).SetTarget("rpm-local")
+	command.client = client
+	require.NoError(t, command.reindex([]deployedPackage{{indexRoot: "el9"}, {indexRoot: "el9"}, {indexRoot: ""}}))
+	assert.Equal(t, []string{"api/yum/rpm-local?async=1&path=el9", "api/yum/rpm-local?async=1"}, client.recalculated)
+}
+
+func TestWaitForDebianIndex(t *testing.T) {
+	client := &fakeIndexClient{readsToIndex: 2, files: map[string][]byte{
+		"debian-local/dists/jammy/main/binary-amd64/Packages": []byte("Package: curl\nFilename: pool/main/c/curl/curl_7.81.0_amd64.deb\n"),
+	}}
+	var slept []time.Duration
+	command := NewPackageDeployCommand(Debian).SetTarget("debian-local").SetDistributions([]string{"jammy"})
+	command.client = client
+	command.sleep = func(duration time.Duration) { slept = append(slept, duration) }
+	packages := []deployedPackage{{repoPath: "pool/main/c/curl/curl_7.81.0_amd64.deb", architecture: "amd64"}}
+	require.NoError(t, command.waitForIndex(packages))
+	assert.Len(t, slept, 2)
+
+	// The wait fails once the timeout expires.
+	client.reads = 0
+	command.SetWaitTimeout(0)
+	err := command.waitForIndex([]deployedPackage{{repoPath: "pool/main/w/wget/wget_1.21_amd64.deb", architecture: "amd64"}})
+	assert.ErrorContains(t, err, "pool/main/w/wget/wget_1.21_amd64.deb")
+}
+
+func TestWaitForRpmIndex(t *testing.T) {
+	var primary bytes.Buffer
+	gzipWriter := gzip.NewWriter(&primary)
+	_, err := gzipWriter.Write([]byte(`<metadata><package type="rpm"><name>app</name><location href="app-1.0-1.x86_64.rpm"/></package></metadata>`))
+	require.NoError(t, err)
+	require.NoError(t, gzipWriter.Close())
+	client := &fakeIndexClient{files: map[string][]byte{
+		"rpm-local/el9/repodata/repomd.xml":         []byte(`<repomd><data type="filelists"><location href="repodata/filelists.xml.gz"/></data><data type="primary"><location href="repodata/abc-primary.xml.gz"/></data></repomd>`),
+		"rpm-local/el9/repodata/abc-primary.xml.gz": primary.Bytes(),
+	}}
+	command := NewPackageDeployCommand(Rpm).SetTarget("rpm-local/el9").SetWaitTimeout(0)
+	command.client = client
+	assert.NoError(t, command.waitForIndex([]deployedPackage{{repoPath: "el9/app-1.0-1.x86_64.rpm", indexRoot: "el9"}}))
+	assert.Error(t, command.waitForIndex([]deployedPackage{{repoPath: "el9/other-2.0-1.x86_64.rpm", indexRoot: "el9"}}))
+}
@@ -0,0 +1,239 @@
+package linuxpackage
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/xml"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clierrors"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	clientutils "github.com/jfrog/jfrog-client-go/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const (
+	debReindexApi = "api/deb/reindex/"
+	yumReindexApi = "api/yum/"
+	// Packages of this architecture are indexed with the packages of every architecture of the distribution.
+	debArchitectureAll = "all"
+)
+
+// indexClient triggers the recalculation of the metadata of repositories, and reads the metadata.
+type indexClient interface {
+	// recalculate sends the request of the API, relative to the URL of Artifactory, which recalculates the metadata.
+	recalculate(apiPath string) error
+	// getFile returns the content of the file in the repository, or false if it doesn't exist.
+	getFile(repoPath string) ([]byte, bool, error)
+}
+
+type artifactoryIndexClient struct {
+	servicesManager artifactory.ArtifactoryServicesManager
+}
+
+func (aic *artifactoryIndexClient) recalculate(apiPath string) error {
+	serviceDetails := aic.servicesManager.GetConfig().GetServiceDetails()
+	httpClientDetails := serviceDetails.CreateHttpClientDetails()
+	resp, body, err := aic.servicesManager.Client().SendPost(clientutils.AddTrailingSlashIfNeeded(serviceDetails.GetUrl())+apiPath, nil, &httpClientDetails)
+	if err != nil {
+		return err
+	}
+	return clierrors.CheckResponseStatusWithBody(resp, body, http.StatusOK, http.StatusAccepted)
+}
+
+func (aic *artifactoryIndexClient) getFile(repoPath string) ([]byte, bool, error) {
+	serviceDetails := aic.servicesManager.GetConfig().GetServiceDetails()
+	httpClientDetails := serviceDetails.CreateHttpClientDetails()
+	resp, body, _, err := aic.servicesManager.Client().SendGet(clientutils.AddTrailingSlashIfNeeded(serviceDetails.GetUrl())+repoPath, true, &httpClientDetails)
+	if err != nil {
+		return nil, false, err
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, false, nil
+	}
+	if err = clierrors.CheckResponseStatusWithBody(resp, body, http.StatusOK); err != nil {
+		return nil, false, err
+	}
+	return body, true, nil
+}
+
+// reindex triggers the asynchronous recalculation of the metadata of the repository, or of the YUM roots of the RPM
+// packages in it.
+func (pdc *PackageDeployCommand) reindex(packages []deployedPackage) error {
+	if pdc.packageType == Debian {
+		log.Info("Triggering the recalculation of the Debian metadata of", pdc.repo)
+		return pdc.client.recalculate(debReindexApi + url.PathEscape(pdc.repo) + "?async=1")
+	}
+	var indexRoots []string
+	for _, deployed := range packages {
+		if !slices.Contains(indexRoots, deployed.indexRoot) {
+			indexRoots = append(indexRoots, deployed.indexRoot)
+		}
+	}
+	for _, indexRoot := range indexRoots {
+		log.Info("Triggering the recalculation of the YUM metadata of", path.Join(pdc.repo, indexRoot))
+		query := url.Values{"async": {"1"}}
+		if indexRoot != "" {
+			query.Set("path", indexRoot)
+		}
+		if err := pdc.client.recalculate(yumReindexApi + url.PathEscape(pdc.repo) + "?" + query.Encode()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// waitForIndex polls the metadata of the repository until it indexes all the packages, or until the timeout expires.
+func (pdc *PackageDeployCommand) waitForIndex(packages []deployedPackage) error {
+	log.Info("Waiting for the metadata of", pdc.repo, "to index the deployed packages...")
+	deadline := time.Now().Add(pdc.waitTimeout)
+	pending := slices.Clone(packages)
+	for {
+		var err error
+		if pending, err = pdc.getUnindexedPackages(pending); err != nil {
+			return err
+		}
+		if len(pending) == 0 {
+			log.Info("The metadata of", pdc.repo, "indexes the deployed packages")
+			return nil
+		}
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			var pendingPaths []string
+			for _, deployed := range pending {
+				pendingPaths = append(pendingPaths, deployed.repoPath)
+			}
+			return errorutils.CheckErrorf("the metadata of %s didn't index the following packages within %s:\n%s", pdc.repo, pdc.waitTimeout.String(), strings.Join(pendingPaths, "\n"))
+		}
+		pdc.sleep(min(indexPollInterval, remaining))
+	}
+}
+
+func (pdc *PackageDeployCommand) getUnindexedPackages(packages []deployedPackage) (unindexed []deployedPackage, err error) {
+	for _, deployed := range packages {
+		var indexed bool
+		if pdc.packageType == Debian {
+			indexed, err = pdc.isDebianPackageIndexed(deployed)
+		} else {
+			indexed, err = pdc.isRpmPackageIndexed(deployed)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if !indexed {
+			unindexed = append(unindexed, deployed)
+		}
+	}
+	return
+}
+
+// isDebianPackageIndexed returns true if the Packages index of the component and the architecture of the package, in
+// each of its distributions, lists it by its path.
+func (pdc *PackageDeployCommand) isDebianPackageIndexed(deployed deployedPackage) (bool, error) {
+	if deployed.architecture == debArchitectureAll {
+		// These packages are indexed under the architectures of the distribution, which aren't known.
+		log.Debug("Not waiting for the indexing of", deployed.repoPath, "whose architecture is", debArchitectureAll)
+		return true, nil
+	}
+	for _, distribution := range pdc.distributions {
+		indexPath := path.Join(pdc.repo, "dists", distribution, pdc.component, "binary-"+deployed.architecture, "Packages")
+		content, found, err := pdc.client.getFile(indexPath)
+		if err != nil || !found || !debianIndexContains(content, deployed.repoPath) {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// debianIndexContains returns true if the Packages index has a package of the path, relative to the repository.
+func debianIndexContains(content []byte, repoPath string) bool {
+	for _, line := range strings.Split(string(content), "\n") {
+		if filename, found := strings.CutPrefix(strings.TrimSpace(line), "Filename:"); found && strings.TrimSpace(filename) == repoPath {
+			return true
+		}
+	}
+	return false
+}
+
+// The locations of the metadata files of a YUM root, in repodata/repomd.xml.
+type repomd struct {
+	Data []struct {
+		Type     string `xml:"type,attr"`
+		Location struct {
+			Href string `xml:"href,attr"`
+		} `xml:"location"`
+	} `xml:"data"`
+}
+
+// The locations of the packages of a YUM root, in its primary metadata.
+type yumPrimary struct {
+	Packages []struct {
+		Location struct {
+			Href string `xml:"href,attr"`
+		} `xml:"location"`
+	} `xml:"package"`
+}
+
+// isRpmPackageIndexed returns true if the primary metadata of the YUM root of the package lists it by its location,
+// relative to the root.
+func (pdc *PackageDeployCommand) isRpmPackageIndexed(deployed deployedPackage) (bool, error) {
+	indexRoot := path.Join(pdc.repo, deployed.indexRoot)
+	content, found, err := pdc.client.getFile(path.Join(indexRoot, "repodata", "repomd.xml"))
+	if err != nil || !found {
+		return false, err
+	}
+	primaryHref, err := getPrimaryHref(content)
+	if err != nil || primaryHref == "" {
+		return false, err
+	}
+	if content, found, err = pdc.client.getFile(path.Join(indexRoot, primaryHref)); err != nil || !found {
+		return false, err
+	}
+	location := strings.TrimPrefix(strings.TrimPrefix(deployed.repoPath, deployed.indexRoot), "/")
+	return yumPrimaryContains(content, strings.HasSuffix(primaryHref, ".gz"), location)
+}
+
+func getPrimaryHref(content []byte) (string, error) {
+	var metadata repomd
+	if err := xml.Unmarshal(content, &metadata); err != nil {
+		return "", errorutils.CheckErrorf("failed to parse repomd.xml: %s", err.Error())
+	}
+	for _, data := range metadata.Data {
+		if data.Type == "primary" {
+			return data.Location.Href, nil
+		}
+	}
+	return "", nil
+}
+
+// yumPrimaryContains returns true if the primary metadata, which may be compressed, has a package of the location.
+func yumPrimaryContains(content []byte, compressed bool, location string) (bool, error) {
+	reader := io.Reader(bytes.NewReader(content))
+	if compressed {
+		gzipReader, err := gzip.NewReader(reader)
+		if err != nil {
+			return false, errorutils.CheckError(err)
+		}
+		defer func() {
+			_ = gzipReader.Close()
+		}()
+		reader = gzipReader
+	}
+	var primary yumPrimary
+	if err := xml.NewDecoder(reader).Decode(&primary); err != nil {
+		return false, errorutils.CheckErrorf("failed to parse the primary YUM metadata: %s", err.Error())
+	}
+	for _, yumPackage := range primary.Packages {
+		if yumPackage.Location.Href == location {
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,353 @@
+package mvn
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	buildInfo "github.com/jfrog/build-info-go/entities"
+	ioutils "github.com/jfrog/gofrog/io"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clientconfig"
+	"github.com/jfrog/jfrog-cli-core/v2/common/build"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/jfrog/jfrog-client-go/artifactory/services"
+	rtServicesUtils "github.com/jfrog/jfrog-client-go/artifactory/services/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/io/fileutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// DefaultDeployFileLayout is the file path pattern of the 'maven-2-default' repository layout.
+const DefaultDeployFileLayout = "[orgPath]/[module]/[baseRev]/[module]-[baseRev](-[classifier]).[ext]"
+
+// Matches the tokens of a layout, such as [orgPath], and the optional parts of a layout, such as (-[classifier]).
+var (
+	layoutTokenRegexp    = regexp.MustCompile(`\[(\w+)]`)
+	layoutOptionalRegexp = regexp.MustCompile(`\(([^()]*)\)`)
+)
+
+// MvnDeployFileCommand deploys a file, such as a vendored jar, to a Maven repository by its GAV coordinates, without
+// running a Maven build. A minimal POM is generated and deployed with the file, so that Maven can resolve it.
+type MvnDeployFileCommand struct {
+	serverDetails      *config.ServerDetails
+	buildConfiguration *build.BuildConfiguration
+	file               string
+	targetRepo         string
+	coordinates        MavenCoordinates
+	// Attached files, such as sources and javadoc jars, deployed with the same coordinates and their own classifiers.
+	attachments []MavenAttachment
+	layout      string
+	generatePom bool
+	dryRun      bool
+}
+
+// MavenCoordinates are the GAV coordinates of a deployed file.
+type MavenCoordinates struct {
+	GroupId    string
+	ArtifactId string
+	Version    string
+	Classifier string
+	// The packaging of the artifact in the generated POM. Defaults to the extension of the file.
+	Packaging string
+}
+
+type MavenAttachment struct {
+	Classifier string
+	Path       string
+}
+
+// mavenDeployment is a file to deploy, and its path in the target repository.
+type mavenDeployment struct {
+	localPath  string
+	targetPath string
+}
+
+func NewMvnDeployFileCommand() *MvnDeployFileCommand {
+	return &MvnDeployFileCommand{layout: DefaultDeployFileLayout, generatePom: true}
+}
+
+func (mdc *MvnDeployFileCommand) SetServerDetails(serverDetails *config.ServerDetails) *MvnDeployFileCommand {
+	mdc.serverDetails = serverDetails
+	return mdc
+}
+
+func (mdc *MvnDeployFileCommand) SetBuildConfiguration(buildConfiguration *build.BuildConfiguration) *MvnDeployFileCommand {
+	mdc.buildConfiguration = buildConfiguration
+	return mdc
+}
+
+func (mdc *MvnDeployFileCommand) SetFile(file string) *MvnDeployFileCommand {
+	mdc.file = file
+	return mdc
+}
+
+func (mdc *MvnDeployFileCommand) SetTargetRepo(targetRepo string) *MvnDeployFileCommand {
+	mdc.targetRepo = targetRepo
+	return mdc
+}
+
+func (mdc *MvnDeployFileCommand) SetCoordinates(coordinates MavenCoordinates) *MvnDeployFileCommand {
+	mdc.coordinates = coordinates
+	return mdc
+}
+
+func (mdc *MvnDeployFileCommand) SetAttachments(attachments []MavenAttachment) *MvnDeployFileCommand {
+	mdc.attachments = attachments
+	return mdc
+}
+
+func (mdc *MvnDeployFileCommand) SetLayout(layout string) *MvnDeployFileCommand {
+	mdc.layout = layout
+	return mdc
+}
+
+func (mdc *MvnDeployFileCommand) SetGeneratePom(generatePom bool) *MvnDeployFileCommand {
+	mdc.generatePom = generatePom
+	return mdc
+}
+
+func (mdc *MvnDeployFileCommand) SetDryRun(dryRun bool) *MvnDeployFileCommand {
+	mdc.dryRun = dryRun
+	return mdc
+}
+
+func (mdc *MvnDeployFileCommand) ServerDetails() (*config.ServerDetails, error) {
+	return mdc.serverDetails, nil
+}
+
+func (mdc *MvnDeployFileCommand) CommandName() string {
+	return "rt_maven_deploy_file"
+}
+
+func (mdc *MvnDeployFileCommand) Run() (err error) {
+	if err = mdc.coordinates.validate(); err != nil {
+		return
+	}
+	tempDir, err := fileutils.CreateTempDir()
+	if err != nil {
+		return
+	}
+	defer func() {
+		err = errors.Join(err, fileutils.RemoveTempDir(tempDir))
+	}()
+	deployments, err := mdc.getDeployments(tempDir)
+	if err != nil {
+		return
+	}
+
+	toCollect, err := mdc.buildConfiguration.IsCollectBuildInfo()
+	if err != nil {
+		return
+	}
+	buildProps := ""
+	if toCollect && !mdc.dryRun {
+		if buildProps, err = build.CreateBuildPropsFromConfiguration(mdc.buildConfiguration); err != nil {
+			return
+		}
+	}
+	servicesManager, err := clientconfig.CreateServiceManager(mdc.serverDetails, -1, 0, mdc.dryRun)
+	if err != nil {
+		return
+	}
+	var uploadParamsArray []services.UploadParams
+	for _, deployment := range deployments {
+		uploadParams := services.NewUploadParams()
+		uploadParams.Pattern = deployment.localPath
+		uploadParams.Target = mdc.targetRepo + "/" + deployment.targetPath
+		uploadParams.Flat = true
+		uploadParams.BuildProps = buildProps
+		uploadParams.AddVcsProps = toCollect
+		uploadParamsArray = append(uploadParamsArray, uploadParams)
+		log.Info(fmt.Sprintf("Deploying '%s' to '%s'", deployment.localPath, uploadParams.Target))
+	}
+	summary, err := servicesManager.UploadFilesWithSummary(artifactory.UploadServiceOptions{}, uploadParamsArray...)
+	if err != nil {
+		return
+	}
+	defer ioutils.Close(summary.ArtifactsDetailsReader, &err)
+	defer ioutils.Close(summary.TransferDetailsReader, &err)
+	if summary.TotalFailed > 0 || summary.TotalSucceeded != len(deployments) {
+		return errorutils.CheckErrorf("deployed %d of %d files. Review the logs for more information", summary.TotalSucceeded, len(deployments))
+	}
+	log.Info(fmt.Sprintf("Deployed %s with %d files.", mdc.coordinates.gav(), len(deployments)))
+	if !toCollect || mdc.dryRun {
+		return
+	}
+	buildArtifacts, err := rtServicesUtils.ConvertArtifactsDetailsToBuildInfoArtifacts(summary.ArtifactsDetailsReader)
+	if err != nil {
+		return
+	}
+	return mdc.saveBuildArtifacts(buildArtifacts)
+}
+
+// getDeployments returns the file, its attachments and the generated POM, with their paths by the layout.
+func (mdc *MvnDeployFileCommand) getDeployments(tempDir string) ([]mavenDeployment, error) {
+	ext := getExtension(mdc.file)
+	if mdc.coordinates.Packaging == "" {
+		mdc.coordinates.Packaging = ext
+	}
+	targetPath, err := mdc.coordinates.layoutPath(mdc.layout, mdc.coordinates.Classifier, ext)
+	if err != nil {
+		return nil, err
+	}
+	deployments := []mavenDeployment{{localPath: mdc.file, targetPath: targetPath}}
+	for _, attachment := range mdc.attachments {
+		if attachment.Classifier == mdc.coordinates.Classifier && getExtension(attachment.Path) == ext {
+			return nil, errorutils.CheckErrorf("the attachment '%s' has the classifier and the extension of the deployed file", attachment.Path)
+		}
+		if targetPath, err = mdc.coordinates.layoutPath(mdc.layout, attachment.Classifier, getExtension(attachment.Path)); err != nil {
+			return nil, err
+		}
+		deployments = append(deployments, mavenDeployment{localPath: attachment.Path, targetPath: targetPath})
+	}
+	for _, deployment := range deployments {
+		if exists, err := fileutils.IsFileExists(deployment.localPath, false); err != nil || !exists {
+			return nil, errorutils.CheckErrorf("the file '%s' to deploy doesn't exist", deployment.localPath)
+		}
+	}
+	// A POM file is deployed as the POM of the coordinates itself.
+	if !mdc.generatePom || ext == "pom" {
+		return deployments, nil
+	}
+	pomPath := filepath.Join(tempDir, mdc.coordinates.ArtifactId+"-"+mdc.coordinates.Version+".pom")
+	if err = os.WriteFile(pomPath, mdc.coordinates.createPom(), 0600); err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	if targetPath, err = mdc.coordinates.layoutPath(mdc.layout, "", "pom"); err != nil {
+		return nil, err
+	}
+	return append(deployments, mavenDeployment{localPath: pomPath, targetPath: targetPath}), nil
+}
+
+// saveBuildArtifacts records the deployed files as the artifacts of a Maven module, named by the GAV coordinates
+// unless a module is set in the build configuration.
+func (mdc *MvnDeployFileCommand) saveBuildArtifacts(buildArtifacts []buildInfo.Artifact) error {
+	buildName, err := mdc.buildConfiguration.GetBuildName()
+	if err != nil {
+		return err
+	}
+	buildNumber, err := mdc.buildConfiguration.GetBuildNumber()
+	if err != nil {
+		return err
+	}
+	moduleId := mdc.buildConfiguration.GetModule()
+	if moduleId == "" {
+		moduleId = mdc.coordinates.gav()
+	}
+	populateFunc := func(partial *buildInfo.Partial) {
+		partial.Artifacts = buildArtifacts
+		partial.ModuleId = moduleId
+		partial.ModuleType = buildInfo.Maven
+	}
+	return build.SavePartialBuildInfo(buildName, buildNumber, mdc.buildConfiguration.GetProject(), populateFunc)
+}
+
+func (mc *MavenCoordinates) validate() error {
+	var missing []string
+	for _, coordinate := range [][2]string{{"group ID", mc.GroupId}, {"artifact ID", mc.ArtifactId}, {"version", mc.Version}} {
+		if coordinate[1] == "" {
+			missing = append(missing, coordinate[0])
+		}
+	}
+	if len(missing) > 0 {
+		return errorutils.CheckErrorf("the %s of the deployed file must be provided", strings.Join(missing, " and "))
+	}
+	return nil
+}
+
+func (mc *MavenCoordinates) gav() string {
+	return mc.GroupId + ":" + mc.ArtifactId + ":" + mc.Version
+}
+
+// layoutPath returns the path of a file of the coordinates by a repository layout pattern, such as
+// '[orgPath]/[module]/[baseRev]/[module]-[baseRev](-[classifier]).[ext]'. An optional part, in parentheses, is
+// omitted if one of its tokens is empty.
+func (mc *MavenCoordinates) layoutPath(layout, classifier, ext string) (string, error) {
+	tokens := map[string]string{
+		"org":        mc.GroupId,
+		"orgPath":    strings.ReplaceAll(mc.GroupId, ".", "/"),
+		"module":     mc.ArtifactId,
+		"baseRev":    mc.Version,
+		"classifier": classifier,
+		"ext":        ext,
+		"type":       ext,
+	}
+	var unknownToken string
+	replaceTokens := func(text string) (replaced string, hasEmptyToken bool) {
+		replaced = layoutTokenRegexp.ReplaceAllStringFunc(text, func(token string) string {
+			value, ok := tokens[strings.Trim(token, "[]")]
+			if !ok {
+				unknownToken = token
+			}
+			hasEmptyToken = hasEmptyToken || value == ""
+			return value
+		})
+		return
+	}
+	path := layoutOptionalRegexp.ReplaceAllStringFunc(layout, func(optional string) string {
+		replaced, hasEmptyToken := replaceTokens(optional[1 : len(optional)-1])
+		if hasEmptyToken {
+			return ""
+		}
+		return replaced
+	})
+	path, hasEmptyToken := replaceTokens(path)
+	if unknownToken != "" {
+		return "", errorutils.CheckErrorf("the layout '%s' has the unsupported token %s", layout, unknownToken)
+	}
+	if hasEmptyToken {
+		return "", errorutils.CheckErrorf("the layout '%s' has a token without a value outside of an optional part", layout)
+	}
+	return path, nil
+}
+
+// createPom creates a minimal POM, which declares the coordinates and the packaging of the artifact, without dependencies.
+func (mc *MavenCoordinates) createPom() []byte {
+	escape := func(value string) string {
+		var escaped strings.Builder
+		_ = xml.EscapeText(&escaped, []byte(value))
+		return escaped.String()
+	}
+	return []byte(`<?xml version="1.0" encoding="UTF-8"?>
+<project xmlns="http://maven.apache.org/POM/4.0.0" xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:schemaLocation="http://maven.apache.org/POM/4.0.0 http://maven.apache.org/xsd/maven-4.0.0.xsd">
+  <modelVersion>4.0.0</modelVersion>
+  <groupId>` + escape(mc.GroupId) + `</groupId>
+  <artifactId>` + escape(mc.ArtifactId) + `</artifactId>
+  <version>` + escape(mc.Version) + `</version>
+  <packaging>` + escape(mc.Packaging) + `</packaging>
+  <description>POM was created by JFrog CLI</description>
+</project>
+`)
+}
+
+// ParseAttachments parses attached files in the form of "classifier1=path1;classifier2=path2".
+func ParseAttachments(attachments string) ([]MavenAttachment, error) {
+	var parsed []MavenAttachment
+	for _, attachment := range strings.Split(attachments, ";") {
+		if strings.TrimSpace(attachment) == "" {
+			continue
+		}
+		classifier, path, found := strings.Cut(attachment, "=")
+		if !found || strings.TrimSpace(classifier) == "" || strings.TrimSpace(path) == "" {
+			return nil, errorutils.CheckErrorf("invalid attachment '%s'. The attachments should be in the form of \"classifier1=path1;classifier2=path2\"", attachment)
+		}
+		parsed = append(parsed, MavenAttachment{Classifier: strings.TrimSpace(classifier), Path: strings.TrimSpace(path)})
+	}
+	return parsed, nil
+}
+
+// getExtension returns the extension of a file, keeping compound extensions such as 'tar.gz'.
+func getExtension(path string) string {
+	base := filepath.Base(path)
+	for _, compound := range []string{".tar.gz", ".tar.bz2", ".tar.xz"} {
+		if strings.HasSuffix(base, compound) {
+			return compound[1:]
+		}
+	}
+	return strings.TrimPrefix(filepath.Ext(base), ".")
+}
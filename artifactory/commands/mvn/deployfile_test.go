@@ -0,0 +1,91 @@
+package mvn
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testCoordinates = MavenCoordinates{GroupId: "com.acme.vendor", ArtifactId: "lib", Version: "1.2.3"}
+
+func TestLayoutPath(t *testing.T) {
+	testCases := []struct {
+		name       string
+		layout     string
+		classifier string
+		ext        string
+		expected   string
+	}{
+		{"default", DefaultDeployFileLayout, "", "jar", "com/acme/vendor/lib/1.2.3/lib-1.2.3.jar"},
+		{"default with classifier", DefaultDeployFileLayout, "sources", "jar", "com/acme/vendor/lib/1.2.3/lib-1.2.3-sources.jar"},
+		{"custom", "[org]/[module]/[baseRev]/[module]-[baseRev](_[classifier]).[ext]", "linux", "so", "com.acme.vendor/lib/1.2.3/lib-1.2.3_linux.so"},
+		{"custom without classifier", "[org]/[module]/[baseRev]/[module]-[baseRev](_[classifier]).[ext]", "", "so", "com.acme.vendor/lib/1.2.3/lib-1.2.3.so"},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			path, err := testCoordinates.layoutPath(testCase.layout, testCase.classifier, testCase.ext)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expected, path)
+		})
+	}
+
+	_, err := testCoordinates.layoutPath("[orgPath]/[module]/[folderItegRev]/[module].[ext]", "", "jar")
+	assert.ErrorContains(t, err, "[folderItegRev]")
+	_, err = testCoordinates.layoutPath("[orgPath]/[module]-[classifier].[ext]", "", "jar")
+	assert.Error(t, err)
+}
+
+func TestGetDeployments(t *testing.T) {
+	sourceDir := t.TempDir()
+	for _, file := range []string{"lib.jar", "lib-sources.jar"} {
+		require.NoError(t, os.WriteFile(filepath.Join(sourceDir, file), []byte(file), 0600))
+	}
+	tempDir := t.TempDir()
+	mdc := NewMvnDeployFileCommand().SetFile(filepath.Join(sourceDir, "lib.jar")).SetCoordinates(testCoordinates).
+		SetAttachments([]MavenAttachment{{Classifier: "sources", Path: filepath.Join(sourceDir, "lib-sources.jar")}})
+
+	deployments, err := mdc.getDeployments(tempDir)
+	require.NoError(t, err)
+	require.Len(t, deployments, 3)
+	assert.Equal(t, "com/acme/vendor/lib/1.2.3/lib-1.2.3.jar", deployments[0].targetPath)
+	assert.Equal(t, "com/acme/vendor/lib/1.2.3/lib-1.2.3-sources.jar", deployments[1].targetPath)
+	assert.Equal(t, "com/acme/vendor/lib/1.2.3/lib-1.2.3.pom", deployments[2].targetPath)
+
+	pom, err := os.ReadFile(deployments[2].localPath)
+	require.NoError(t, err)
+	assert.Contains(t, string(pom), "<groupId>com.acme.vendor</groupId>")
+	assert.Contains(t, string(pom), "<artifactId>lib</artifactId>")
+	assert.Contains(t, string(pom), "<version>1.2.3</version>")
+	assert.Contains(t, string(pom), "<packaging>jar</packaging>")
+
+	deployments, err = mdc.SetGeneratePom(false).getDeployments(tempDir)
+	require.NoError(t, err)
+	assert.Len(t, deployments, 2)
+
+	_, err = mdc.SetFile(filepath.Join(sourceDir, "missing.jar")).getDeployments(tempDir)
+	assert.ErrorContains(t, err, "missing.jar")
+}
+
+func TestParseAttachments(t *testing.T) {
+	attachments, err := ParseAttachments("sources=lib-sources.jar; javadoc=docs/lib-javadoc.jar;")
+	require.NoError(t, err)
+	assert.Equal(t, []MavenAttachment{{Classifier: "sources", Path: "lib-sources.jar"}, {Classifier: "javadoc", Path: "docs/lib-javadoc.jar"}}, attachments)
+
+	_, err = ParseAttachments("lib-sources.jar")
+	assert.Error(t, err)
+}
+
+func TestValidateCoordinates(t *testing.T) {
+	assert.NoError(t, testCoordinates.validate())
+	coordinates := MavenCoordinates{GroupId: "com.acme"}
+	assert.EqualError(t, coordinates.validate(), "the artifact ID and version of the deployed file must be provided")
+}
+
+func TestGetExtension(t *testing.T) {
+	assert.Equal(t, "jar", getExtension("libs/lib-1.2.3.jar"))
+	assert.Equal(t, "tar.gz", getExtension("dist.tar.gz"))
+	assert.Equal(t, "", getExtension("LICENSE"))
+}
@@ -6,7 +6,7 @@ import (
 
 	buildinfo "github.com/jfrog/build-info-go/entities"
 	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/civcs"
-	"github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clientconfig"
 	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
 	"github.com/jfrog/jfrog-client-go/artifactory"
 	"github.com/jfrog/jfrog-client-go/artifactory/services"
@@ -32,7 +32,11 @@ func (nru *npmRtUpload) upload() (err error) {
 				return
 			}
 		}
-		err = errors.Join(err, nru.doDeploy(target, nru.serverDetails, packedFilePath))
+		deployErr := nru.doDeploy(target, nru.serverDetails, packedFilePath)
+		if deployErr == nil {
+			deployErr = nru.addPublishedPackage(packedFilePath, nru.repo, nru.serverDetails)
+		}
+		err = errors.Join(err, deployErr)
 	}
 	return
 }
@@ -42,7 +46,7 @@ func (nru *npmRtUpload) getBuildArtifacts() []buildinfo.Artifact {
 }
 
 func (nru *npmRtUpload) doDeploy(target string, artDetails *config.ServerDetails, packedFilePath string) error {
-	servicesManager, err := utils.CreateServiceManager(artDetails, -1, 0, false)
+	servicesManager, err := clientconfig.CreateServiceManager(artDetails, -1, 0, false)
 	if err != nil {
 		return err
 	}
@@ -55,6 +55,7 @@ type NpmCommand struct {
 	configFilePath      string
 	collectBuildInfo    bool
 	buildInfoModule     *build.NpmModule
+	npmBuild            *build.Build
 	installHandler      *NpmInstallStrategy
 }
 
@@ -338,11 +339,11 @@ func (nc *NpmCommand) prepareBuildInfoModule() error {
 		return err
 	}
 	buildInfoService := buildUtils.CreateBuildInfoService()
-	npmBuild, err := buildInfoService.GetOrCreateBuildWithProject(buildName, buildNumber, nc.buildConfiguration.GetProject())
+	nc.npmBuild, err = buildInfoService.GetOrCreateBuildWithProject(buildName, buildNumber, nc.buildConfiguration.GetProject())
 	if err != nil {
 		return errorutils.CheckError(err)
 	}
-	nc.buildInfoModule, err = npmBuild.AddNpmModule(nc.workingDirectory)
+	nc.buildInfoModule, err = nc.npmBuild.AddNpmModule(nc.workingDirectory)
 	if err != nil {
 		return errorutils.CheckError(err)
 	}
@@ -355,7 +356,38 @@ func (nc *NpmCommand) prepareBuildInfoModule() error {
 
 func (nc *NpmCommand) collectDependencies() error {
 	nc.buildInfoModule.SetNpmArgs(append([]string{nc.cmdName}, nc.npmArgs...))
-	return errorutils.CheckError(nc.buildInfoModule.Build())
+	if err := nc.buildInfoModule.Build(); err != nil {
+		return errorutils.CheckError(err)
+	}
+	return nc.collectWorkspacesDependencies()
+}
+
+// collectWorkspacesDependencies adds a build-info module with the dependencies of each workspace package, when the
+// workspaces are installed with the --workspaces or --workspace options.
+func (nc *NpmCommand) collectWorkspacesDependencies() error {
+	if !nc.collectBuildInfo {
+		return nil
+	}
+	_, allWorkspaces, workspaces, err := extractWorkspacesFromArgs(nc.npmArgs)
+	if err != nil || !allWorkspaces && len(workspaces) == 0 {
+		return err
+	}
+	workspacePackages, err := getWorkspacePackages(nc.workingDirectory, workspaces, nc.npmVersion)
+	if err != nil {
+		return err
+	}
+	for _, pkg := range workspacePackages {
+		log.Debug("Collecting the dependencies of the workspace package:", pkg.path)
+		workspaceModule, err := nc.npmBuild.AddNpmModule(pkg.path)
+		if err != nil {
+			return errorutils.CheckError(err)
+		}
+		workspaceModule.SetCollectBuildInfo(true)
+		if err = workspaceModule.CalcDependencies(); err != nil {
+			return errorutils.CheckError(err)
+		}
+	}
+	return nil
 }
 
 // Gets a config with value which is an array
@@ -399,7 +431,13 @@ func isValidKey(key string) bool {
 
 func filterFlags(splitArgs []string) []string {
 	var filteredArgs []string
-	for _, arg := range splitArgs {
+	for i := 0; i < len(splitArgs); i++ {
+		arg := splitArgs[i]
+		if arg == workspaceFlag || arg == "-w" {
+			// Skip the workspace name, which isn't a package to install.
+			i++
+			continue
+		}
 		if !strings.HasPrefix(arg, "-") {
 			filteredArgs = append(filteredArgs, arg)
 		}
@@ -7,6 +7,7 @@ import (
 
 	buildinfo "github.com/jfrog/build-info-go/entities"
 	gofrogcmd "github.com/jfrog/gofrog/io"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clientconfig"
 	"github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
 	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
 	"github.com/jfrog/jfrog-client-go/artifactory/services"
@@ -46,7 +47,11 @@ func (npu *npmPublish) upload() (err error) {
 				return
 			}
 		}
-		err = errors.Join(err, npu.publishPackage(npu.executablePath, packedFilePath, targetServer, target))
+		publishErr := npu.publishPackage(npu.executablePath, packedFilePath, targetServer, target)
+		if publishErr == nil {
+			publishErr = npu.addPublishedPackage(packedFilePath, targetRepo, targetServer)
+		}
+		err = errors.Join(err, publishErr)
 	}
 	return
 }
@@ -64,7 +69,7 @@ func (npu *npmPublish) publishPackage(executablePath, filePath string, serverDet
 		return err
 	}
 	npu.result.SetSuccessCount(npu.result.SuccessCount() + 1)
-	servicesManager, err := utils.CreateServiceManager(serverDetails, -1, 0, false)
+	servicesManager, err := clientconfig.CreateServiceManager(serverDetails, -1, 0, false)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,142 @@
+package npm
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	biutils "github.com/jfrog/build-info-go/build/utils"
+	"github.com/jfrog/build-info-go/utils/cienv"
+	"github.com/jfrog/jfrog-cli-artifactory/evidence/attestation"
+	"github.com/jfrog/jfrog-cli-artifactory/evidence/signing"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const (
+	slsaProvenancePredicateType = "https://slsa.dev/provenance/v1"
+	npmPublishBuildType         = "https://jfrog.com/cli/npm-publish/v1"
+	jfrogCliBuilderId           = "https://jfrog.com/cli"
+)
+
+// publishedPackage is a package version which was published by the npm publish command.
+type publishedPackage struct {
+	packageInfo *biutils.PackageInfo
+	// The SHA-256 checksum of the published tarball.
+	sha256        string
+	repo          string
+	serverDetails *config.ServerDetails
+}
+
+// The SLSA v1 provenance predicate of a published npm package.
+type slsaProvenance struct {
+	BuildDefinition slsaBuildDefinition `json:"buildDefinition"`
+	RunDetails      slsaRunDetails      `json:"runDetails"`
+}
+
+type slsaBuildDefinition struct {
+	BuildType          string            `json:"buildType"`
+	ExternalParameters map[string]string `json:"externalParameters"`
+	InternalParameters map[string]string `json:"internalParameters,omitempty"`
+}
+
+type slsaRunDetails struct {
+	Builder struct {
+		Id string `json:"id"`
+	} `json:"builder"`
+	Metadata struct {
+		StartedOn  string `json:"startedOn"`
+		FinishedOn string `json:"finishedOn"`
+	} `json:"metadata"`
+}
+
+func newPublishedPackage(packageInfo *biutils.PackageInfo, tarballPath, repo string, serverDetails *config.ServerDetails) (*publishedPackage, error) {
+	tarball, err := os.Open(tarballPath)
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	defer func() {
+		_ = tarball.Close()
+	}()
+	hash := sha256.New()
+	if _, err = io.Copy(hash, tarball); err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	return &publishedPackage{packageInfo: packageInfo, sha256: hex.EncodeToString(hash.Sum(nil)), repo: repo, serverDetails: serverDetails}, nil
+}
+
+// getPurl returns the package URL of the package version, which names the subject of its provenance.
+func (pp *publishedPackage) getPurl() string {
+	name := pp.packageInfo.Name
+	if pp.packageInfo.Scope != "" {
+		name = "%40" + strings.TrimPrefix(pp.packageInfo.Scope, "@") + "/" + name
+	}
+	return "pkg:npm/" + name + "@" + pp.packageInfo.Version
+}
+
+// createProvenanceStatement creates the SLSA provenance of a published package, as an in-toto statement about its tarball.
+func (npc *NpmPublishCommand) createProvenanceStatement(pkg *publishedPackage, startedOn, finishedOn time.Time) (*attestation.Statement, error) {
+	predicate := slsaProvenance{
+		BuildDefinition: slsaBuildDefinition{
+			BuildType: npmPublishBuildType,
+			ExternalParameters: map[string]string{
+				"package":    pkg.packageInfo.FullName(),
+				"version":    pkg.packageInfo.Version,
+				"repository": pkg.repo,
+			},
+		},
+	}
+	if vcsInfo := cienv.GetCIVcsInfo(); !vcsInfo.IsEmpty() {
+		predicate.BuildDefinition.ExternalParameters["vcs.provider"] = vcsInfo.Provider
+		predicate.BuildDefinition.ExternalParameters["vcs.org"] = vcsInfo.Org
+		predicate.BuildDefinition.ExternalParameters["vcs.repo"] = vcsInfo.Repo
+	}
+	if npc.collectBuildInfo {
+		buildName, err := npc.buildConfiguration.GetBuildName()
+		if err != nil {
+			return nil, err
+		}
+		buildNumber, err := npc.buildConfiguration.GetBuildNumber()
+		if err != nil {
+			return nil, err
+		}
+		predicate.BuildDefinition.InternalParameters = map[string]string{"build.name": buildName, "build.number": buildNumber}
+		if project := npc.buildConfiguration.GetProject(); project != "" {
+			predicate.BuildDefinition.InternalParameters["build.project"] = project
+		}
+	}
+	predicate.RunDetails.Builder.Id = jfrogCliBuilderId
+	predicate.RunDetails.Metadata.StartedOn = startedOn.UTC().Format(time.RFC3339)
+	predicate.RunDetails.Metadata.FinishedOn = finishedOn.UTC().Format(time.RFC3339)
+	subject := attestation.Subject{Name: pkg.getPurl(), Digest: map[string]string{"sha256": pkg.sha256}}
+	return attestation.NewStatement(slsaProvenancePredicateType, predicate, subject), nil
+}
+
+// attachProvenance signs the provenance of each published package, and attaches it as evidence to the package version.
+func (npc *NpmPublishCommand) attachProvenance(startedOn time.Time) error {
+	signer, err := signing.LoadSigner(npc.provenanceKeyPath)
+	if err != nil {
+		return err
+	}
+	finishedOn := time.Now()
+	for _, pkg := range npc.publishedPackages {
+		statement, err := npc.createProvenanceStatement(pkg, startedOn, finishedOn)
+		if err != nil {
+			return err
+		}
+		envelope, err := attestation.Sign(statement, signer, npc.provenanceKeyAlias)
+		if err != nil {
+			return err
+		}
+		subjectRepoPath := pkg.repo + "/" + pkg.packageInfo.GetDeployPath()
+		if err = attestation.Upload(pkg.serverDetails, subjectRepoPath, envelope); err != nil {
+			return errorutils.CheckErrorf("failed to attach the provenance of '%s' as evidence: %s", subjectRepoPath, err.Error())
+		}
+		log.Info("Attached the provenance of", pkg.getPurl(), "as evidence to", subjectRepoPath)
+	}
+	return nil
+}
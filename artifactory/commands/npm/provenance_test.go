@@ -0,0 +1,51 @@
+package npm
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	biutils "github.com/jfrog/build-info-go/build/utils"
+	buildinfo "github.com/jfrog/build-info-go/entities"
+	"github.com/jfrog/jfrog-cli-core/v2/common/build"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateProvenanceStatement(t *testing.T) {
+	packageInfo := &biutils.PackageInfo{Name: "npm-example", Version: "0.0.3"}
+	pkg, err := newPublishedPackage(packageInfo, filepath.Join("..", "testdata", "npm", "npm-example-0.0.3.tgz"), "npm-local", nil)
+	require.NoError(t, err)
+	assert.Len(t, pkg.sha256, 64)
+	assert.Equal(t, "pkg:npm/npm-example@0.0.3", pkg.getPurl())
+
+	npmPublish := NewNpmPublishCommand()
+	npmPublish.collectBuildInfo = true
+	npmPublish.SetBuildConfiguration(build.NewBuildConfiguration("my-build", "7", "", "my-project"))
+	startedOn := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	statement, err := npmPublish.createProvenanceStatement(pkg, startedOn, startedOn.Add(time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, slsaProvenancePredicateType, statement.PredicateType)
+	require.Len(t, statement.Subject, 1)
+	assert.Equal(t, map[string]string{"sha256": pkg.sha256}, statement.Subject[0].Digest)
+
+	predicate, ok := statement.Predicate.(slsaProvenance)
+	require.True(t, ok)
+	assert.Equal(t, "npm-local", predicate.BuildDefinition.ExternalParameters["repository"])
+	assert.Equal(t, map[string]string{"build.name": "my-build", "build.number": "7", "build.project": "my-project"}, predicate.BuildDefinition.InternalParameters)
+	assert.Equal(t, "2024-05-01T10:00:00Z", predicate.RunDetails.Metadata.StartedOn)
+	assert.Equal(t, "2024-05-01T10:01:00Z", predicate.RunDetails.Metadata.FinishedOn)
+
+	scoped := &publishedPackage{packageInfo: &biutils.PackageInfo{Name: "core", Version: "1.0.0", Scope: "@acme"}}
+	assert.Equal(t, "pkg:npm/%40acme/core@1.0.0", scoped.getPurl())
+}
+
+func TestGetPackageArtifacts(t *testing.T) {
+	buildArtifacts := []buildinfo.Artifact{
+		{Name: "core-1.0.0.tgz", Path: "@acme/core/-/@acme/core-1.0.0.tgz"},
+		{Name: "utils-2.1.0.tgz", Path: "utils/-/utils-2.1.0.tgz"},
+	}
+	artifacts := getPackageArtifacts(&biutils.PackageInfo{Name: "core", Version: "1.0.0", Scope: "@acme"}, buildArtifacts)
+	assert.Equal(t, buildArtifacts[:1], artifacts)
+	assert.Empty(t, getPackageArtifacts(&biutils.PackageInfo{Name: "core", Version: "2.0.0"}, buildArtifacts))
+}
@@ -10,9 +10,11 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/jfrog/build-info-go/build"
 	biutils "github.com/jfrog/build-info-go/build/utils"
+	buildinfo "github.com/jfrog/build-info-go/entities"
 	gofrogcmd "github.com/jfrog/gofrog/io"
 	"github.com/jfrog/gofrog/version"
 	commandsutils "github.com/jfrog/jfrog-cli-core/v2/artifactory/commands/utils"
@@ -48,6 +50,13 @@ type NpmPublishCommandArgs struct {
 	xrayScan               bool
 	scanOutputFormat       format.OutputFormat
 	distTag                string
+	// The npm workspace packages to publish. Empty if the command doesn't publish workspaces.
+	workspacePackages  []*workspacePackage
+	allWorkspaces      bool
+	workspaces         []string
+	publishedPackages  []*publishedPackage
+	provenanceKeyPath  string
+	provenanceKeyAlias string
 }
 
 type NpmPublishCommand struct {
@@ -111,6 +120,22 @@ func (npc *NpmPublishCommand) SetDistTag(tag string) *NpmPublishCommand {
 	return npc
 }
 
+// SetWorkspaces sets the npm workspace packages to publish, by their names or paths. If allWorkspaces is true, all the
+// packages declared in the 'workspaces' field of the package.json are published.
+func (npc *NpmPublishCommand) SetWorkspaces(allWorkspaces bool, workspaces []string) *NpmPublishCommand {
+	npc.allWorkspaces = allWorkspaces
+	npc.workspaces = workspaces
+	return npc
+}
+
+// SetProvenanceKey sets the private key which signs the provenance of the published packages. If set, the provenance
+// is attached as evidence to each published package version. The key alias is the name of the public key in the platform.
+func (npc *NpmPublishCommand) SetProvenanceKey(keyPath, keyAlias string) *NpmPublishCommand {
+	npc.provenanceKeyPath = keyPath
+	npc.provenanceKeyAlias = keyAlias
+	return npc
+}
+
 func (npc *NpmPublishCommand) Result() *commandsutils.Result {
 	return npc.result
 }
@@ -137,6 +162,18 @@ func (npc *NpmPublishCommand) Init() error {
 	if err != nil {
 		return err
 	}
+	filteredNpmArgs, allWorkspaces, workspaces, err := extractWorkspacesFromArgs(filteredNpmArgs)
+	if err != nil {
+		return err
+	}
+	filteredNpmArgs, provenanceKeyPath, err := coreutils.ExtractStringOptionFromArgs(filteredNpmArgs, "provenance-key")
+	if err != nil {
+		return err
+	}
+	filteredNpmArgs, provenanceKeyAlias, err := coreutils.ExtractStringOptionFromArgs(filteredNpmArgs, "provenance-key-alias")
+	if err != nil {
+		return err
+	}
 	if npc.configFilePath != "" {
 		// Read config file.
 		log.Debug("Preparing to read the config file", npc.configFilePath)
@@ -155,11 +192,13 @@ func (npc *NpmPublishCommand) Init() error {
 		npc.SetBuildConfiguration(buildConfiguration).SetRepo(deployerParams.TargetRepo()).SetNpmArgs(filteredNpmArgs).SetServerDetails(rtDetails)
 	}
 	npc.SetDetailedSummary(detailedSummary).SetXrayScan(xrayScan).SetScanOutputFormat(scanOutputFormat).SetDistTag(tag).SetUseNative(useNative)
+	npc.SetWorkspaces(allWorkspaces, workspaces).SetProvenanceKey(provenanceKeyPath, provenanceKeyAlias)
 	return nil
 }
 
 func (npc *NpmPublishCommand) Run() (err error) {
 	log.Info("Running npm Publish")
+	startedOn := time.Now()
 	err = npc.preparePrerequisites()
 	if err != nil {
 		return err
@@ -207,11 +246,35 @@ func (npc *NpmPublishCommand) Run() (err error) {
 		}
 	}
 
+	if npc.provenanceKeyPath != "" {
+		if err = npc.attachProvenance(startedOn); err != nil {
+			return err
+		}
+	}
+
 	if !npc.collectBuildInfo {
 		log.Info("npm publish finished successfully.")
 		return nil
 	}
 
+	buildArtifacts := publishStrategy.GetBuildArtifacts()
+	for _, artifactReader := range npc.artifactsDetailsReader {
+		gofrogcmd.Close(artifactReader, &err)
+	}
+	if len(npc.workspacePackages) > 0 {
+		err = npc.addWorkspacesModules(npmBuild, buildArtifacts)
+	} else {
+		err = npc.addModule(npmBuild, buildArtifacts)
+	}
+	if err != nil {
+		return err
+	}
+
+	log.Info("npm publish finished successfully.")
+	return nil
+}
+
+func (npc *NpmPublishCommand) addModule(npmBuild *build.Build, buildArtifacts []buildinfo.Artifact) error {
 	npmModule, err := npmBuild.AddNpmModule("")
 	if err != nil {
 		return errorutils.CheckError(err)
@@ -219,20 +282,38 @@ func (npc *NpmPublishCommand) Run() (err error) {
 	if npc.buildConfiguration.GetModule() != "" {
 		npmModule.SetName(npc.buildConfiguration.GetModule())
 	}
+	return errorutils.CheckError(npmModule.AddArtifacts(buildArtifacts...))
+}
 
-	buildArtifacts := publishStrategy.GetBuildArtifacts()
-	for _, artifactReader := range npc.artifactsDetailsReader {
-		gofrogcmd.Close(artifactReader, &err)
+// addWorkspacesModules adds a build-info module for each published workspace package, with the artifacts of the package.
+func (npc *NpmPublishCommand) addWorkspacesModules(npmBuild *build.Build, buildArtifacts []buildinfo.Artifact) error {
+	if npc.buildConfiguration.GetModule() != "" {
+		log.Warn("The module name is ignored when publishing npm workspaces. Each workspace package is added as a module of its own.")
 	}
-	err = npmModule.AddArtifacts(buildArtifacts...)
-	if err != nil {
-		return errorutils.CheckError(err)
+	for _, pkg := range npc.publishedPackages {
+		npmModule, err := npmBuild.AddNpmModule("")
+		if err != nil {
+			return errorutils.CheckError(err)
+		}
+		npmModule.SetName(pkg.packageInfo.BuildInfoModuleId())
+		if err = npmModule.AddArtifacts(getPackageArtifacts(pkg.packageInfo, buildArtifacts)...); err != nil {
+			return errorutils.CheckError(err)
+		}
 	}
-
-	log.Info("npm publish finished successfully.")
 	return nil
 }
 
+// getPackageArtifacts returns the artifacts deployed to the path of the package version.
+func getPackageArtifacts(packageInfo *biutils.PackageInfo, buildArtifacts []buildinfo.Artifact) []buildinfo.Artifact {
+	var packageArtifacts []buildinfo.Artifact
+	for _, artifact := range buildArtifacts {
+		if artifact.Path == packageInfo.GetDeployPath() {
+			packageArtifacts = append(packageArtifacts, artifact)
+		}
+	}
+	return packageArtifacts
+}
+
 func (npc *NpmPublishCommand) CommandName() string {
 	return npc.commandName
 }
@@ -268,25 +349,72 @@ func (npc *NpmPublishCommand) preparePrerequisites() error {
 		return err
 	}
 
-	return npc.setPackageInfo()
+	if err = npc.setPackageInfo(); err != nil {
+		return err
+	}
+	return npc.setWorkspacePackages()
 }
 
-func (npc *NpmPublishCommand) pack() error {
-	log.Debug("Creating npm package.")
-	packedFileNames, err := npm.Pack(npc.npmArgs, npc.executablePath)
+func (npc *NpmPublishCommand) setWorkspacePackages() error {
+	if !npc.allWorkspaces && len(npc.workspaces) == 0 {
+		return nil
+	}
+	if npc.tarballProvided {
+		return errorutils.CheckErrorf("npm workspaces can't be published from a compressed npm package: %s", npc.publishPath)
+	}
+	workspacePackages, err := getWorkspacePackages(npc.publishPath, npc.workspaces, npc.npmVersion)
 	if err != nil {
 		return err
 	}
+	npc.workspacePackages = getPublishedWorkspacePackages(workspacePackages)
+	if len(npc.workspacePackages) == 0 {
+		return errorutils.CheckErrorf("no public workspace packages were found to publish in '%s'", npc.publishPath)
+	}
+	return nil
+}
 
+func (npc *NpmPublishCommand) pack() error {
 	tarballDir, err := npc.getTarballDir()
 	if err != nil {
 		return err
 	}
+	if len(npc.workspacePackages) == 0 {
+		log.Debug("Creating npm package.")
+		return npc.packArgs(npc.npmArgs, tarballDir)
+	}
 
+	// The publish path argument is replaced by the path of each workspace package.
+	packFlags := npc.npmArgs
+	if len(packFlags) > 0 && !strings.HasPrefix(strings.TrimSpace(packFlags[0]), "-") {
+		packFlags = packFlags[1:]
+	}
+	for _, pkg := range npc.workspacePackages {
+		log.Debug("Creating npm package of the workspace package:", pkg.path)
+		if err = npc.packArgs(append([]string{pkg.path}, packFlags...), tarballDir); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (npc *NpmPublishCommand) packArgs(args []string, tarballDir string) error {
+	packedFileNames, err := npm.Pack(args, npc.executablePath)
+	if err != nil {
+		return err
+	}
 	for _, packageFileName := range packedFileNames {
 		npc.packedFilePaths = append(npc.packedFilePaths, filepath.Join(tarballDir, packageFileName))
 	}
+	return nil
+}
 
+// addPublishedPackage records the package of the tarball, which was published to the repository.
+func (npc *NpmPublishCommand) addPublishedPackage(packedFilePath, repo string, serverDetails *config.ServerDetails) error {
+	pkg, err := newPublishedPackage(npc.packageInfo, packedFilePath, repo, serverDetails)
+	if err != nil {
+		return err
+	}
+	npc.publishedPackages = append(npc.publishedPackages, pkg)
 	return nil
 }
 
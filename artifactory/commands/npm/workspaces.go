@@ -0,0 +1,167 @@
+package npm
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	biutils "github.com/jfrog/build-info-go/build/utils"
+	"github.com/jfrog/gofrog/version"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const (
+	workspacesFlag = "--workspaces"
+	workspaceFlag  = "--workspace"
+)
+
+// workspacePackage is a package of an npm workspace, declared in the 'workspaces' field of the root package.json.
+type workspacePackage struct {
+	// The absolute path of the package directory.
+	path        string
+	packageInfo *biutils.PackageInfo
+	private     bool
+}
+
+type workspacesPackageJson struct {
+	Private bool `json:"private"`
+	// Either a list of glob patterns, or an object with a 'packages' list of glob patterns.
+	Workspaces json.RawMessage `json:"workspaces"`
+}
+
+// extractWorkspacesFromArgs removes the --workspaces and --workspace options from the npm args.
+// Returns true if all the workspace packages are selected, and the names or paths of the selected packages otherwise.
+func extractWorkspacesFromArgs(args []string) (cleanArgs []string, allWorkspaces bool, workspaces []string, err error) {
+	cleanArgs, allWorkspaces, err = coreutils.ExtractBoolFlagFromArgs(args, strings.TrimPrefix(workspacesFlag, "--"))
+	if err != nil {
+		return
+	}
+	for {
+		var workspace string
+		cleanArgs, workspace, err = coreutils.ExtractStringOptionFromArgs(cleanArgs, strings.TrimPrefix(workspaceFlag, "--"))
+		if err != nil || workspace == "" {
+			return
+		}
+		workspaces = append(workspaces, workspace)
+	}
+}
+
+// getWorkspacePackages returns the packages of the npm workspace in the root directory, sorted by their paths.
+// If names are provided, only the packages matching them by name or by path are returned.
+func getWorkspacePackages(rootDir string, names []string, npmVersion *version.Version) ([]*workspacePackage, error) {
+	patterns, err := readWorkspacesPatterns(rootDir)
+	if err != nil {
+		return nil, err
+	}
+	var packages []*workspacePackage
+	for _, pattern := range patterns {
+		matches, err := filepath.Glob(filepath.Join(rootDir, filepath.FromSlash(pattern)))
+		if err != nil {
+			return nil, errorutils.CheckErrorf("invalid workspaces pattern '%s': %s", pattern, err.Error())
+		}
+		for _, match := range matches {
+			if slices.ContainsFunc(packages, func(pkg *workspacePackage) bool { return pkg.path == match }) {
+				continue
+			}
+			pkg, err := readWorkspacePackage(match, npmVersion)
+			if err != nil {
+				return nil, err
+			}
+			if pkg != nil {
+				packages = append(packages, pkg)
+			}
+		}
+	}
+	slices.SortFunc(packages, func(a, b *workspacePackage) int { return strings.Compare(a.path, b.path) })
+	if len(names) == 0 {
+		return packages, nil
+	}
+	var selected []*workspacePackage
+	for _, name := range names {
+		index := slices.IndexFunc(packages, func(pkg *workspacePackage) bool { return pkg.matches(rootDir, name) })
+		if index == -1 {
+			return nil, errorutils.CheckErrorf("no workspace package named '%s' was found in '%s'", name, rootDir)
+		}
+		if !slices.Contains(selected, packages[index]) {
+			selected = append(selected, packages[index])
+		}
+	}
+	return selected, nil
+}
+
+func readWorkspacesPatterns(rootDir string) ([]string, error) {
+	content, err := os.ReadFile(filepath.Join(rootDir, "package.json"))
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	var packageJson workspacesPackageJson
+	if err = json.Unmarshal(content, &packageJson); err != nil {
+		return nil, errorutils.CheckErrorf("failed to parse the package.json in '%s': %s", rootDir, err.Error())
+	}
+	var patterns []string
+	if len(packageJson.Workspaces) > 0 && json.Unmarshal(packageJson.Workspaces, &patterns) != nil {
+		var workspaces struct {
+			Packages []string `json:"packages"`
+		}
+		if err = json.Unmarshal(packageJson.Workspaces, &workspaces); err != nil {
+			return nil, errorutils.CheckErrorf("the 'workspaces' field of the package.json in '%s' is invalid: %s", rootDir, err.Error())
+		}
+		patterns = workspaces.Packages
+	}
+	if len(patterns) == 0 {
+		return nil, errorutils.CheckErrorf("the package.json in '%s' doesn't declare workspaces", rootDir)
+	}
+	return patterns, nil
+}
+
+// readWorkspacePackage reads the package in the directory. Returns nil if the path isn't a directory with a package.json.
+func readWorkspacePackage(path string, npmVersion *version.Version) (*workspacePackage, error) {
+	if info, err := os.Stat(path); err != nil || !info.IsDir() {
+		return nil, errorutils.CheckError(err)
+	}
+	content, err := os.ReadFile(filepath.Join(path, "package.json"))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, errorutils.CheckError(err)
+	}
+	packageInfo, err := biutils.ReadPackageInfo(content, npmVersion)
+	if err != nil {
+		return nil, errorutils.CheckErrorf("failed to parse the package.json in '%s': %s", path, err.Error())
+	}
+	var packageJson workspacesPackageJson
+	if err = json.Unmarshal(content, &packageJson); err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	return &workspacePackage{path: path, packageInfo: packageInfo, private: packageJson.Private}, nil
+}
+
+// matches returns true if the name is the full name of the package, or its path relative to the root directory.
+func (wp *workspacePackage) matches(rootDir, name string) bool {
+	if wp.packageInfo.FullName() == name {
+		return true
+	}
+	relPath, err := filepath.Rel(rootDir, wp.path)
+	if err != nil {
+		return false
+	}
+	return filepath.ToSlash(relPath) == strings.TrimSuffix(filepath.ToSlash(filepath.Clean(name)), "/")
+}
+
+// getPublishedWorkspacePackages returns the packages to publish, skipping the private packages as 'npm publish --workspaces' does.
+func getPublishedWorkspacePackages(packages []*workspacePackage) []*workspacePackage {
+	var published []*workspacePackage
+	for _, pkg := range packages {
+		if pkg.private {
+			log.Info("Skipping the private workspace package:", pkg.packageInfo.FullName())
+			continue
+		}
+		published = append(published, pkg)
+	}
+	return published
+}
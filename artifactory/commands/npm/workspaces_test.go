@@ -0,0 +1,86 @@
+package npm
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeWorkspace(t *testing.T, rootPackageJson string, packages map[string]string) string {
+	rootDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(rootDir, "package.json"), []byte(rootPackageJson), 0644))
+	for path, packageJson := range packages {
+		packageDir := filepath.Join(rootDir, filepath.FromSlash(path))
+		require.NoError(t, os.MkdirAll(packageDir, 0755))
+		require.NoError(t, os.WriteFile(filepath.Join(packageDir, "package.json"), []byte(packageJson), 0644))
+	}
+	// A directory without a package.json, which isn't a workspace package.
+	require.NoError(t, os.MkdirAll(filepath.Join(rootDir, "packages", "docs"), 0755))
+	return rootDir
+}
+
+func TestGetWorkspacePackages(t *testing.T) {
+	packages := map[string]string{
+		"packages/core":  `{"name":"@acme/core","version":"1.0.0"}`,
+		"packages/utils": `{"name":"utils","version":"2.1.0"}`,
+		"tools/scripts":  `{"name":"scripts","version":"0.0.1","private":true}`,
+	}
+	for name, rootPackageJson := range map[string]string{
+		"list":   `{"name":"root","private":true,"workspaces":["packages/*","tools/scripts","packages/core"]}`,
+		"object": `{"name":"root","private":true,"workspaces":{"packages":["packages/*","tools/scripts"]}}`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			rootDir := writeWorkspace(t, rootPackageJson, packages)
+			workspacePackages, err := getWorkspacePackages(rootDir, nil, nil)
+			require.NoError(t, err)
+			require.Len(t, workspacePackages, 3)
+			assert.Equal(t, filepath.Join(rootDir, "packages", "core"), workspacePackages[0].path)
+			assert.Equal(t, "@acme", workspacePackages[0].packageInfo.Scope)
+			assert.Equal(t, "utils", workspacePackages[1].packageInfo.Name)
+			assert.True(t, workspacePackages[2].private)
+
+			published := getPublishedWorkspacePackages(workspacePackages)
+			assert.Equal(t, workspacePackages[:2], published)
+		})
+	}
+}
+
+func TestGetWorkspacePackagesSelection(t *testing.T) {
+	rootDir := writeWorkspace(t, `{"name":"root","workspaces":["packages/*"]}`, map[string]string{
+		"packages/core":  `{"name":"@acme/core","version":"1.0.0"}`,
+		"packages/utils": `{"name":"utils","version":"2.1.0"}`,
+	})
+	workspacePackages, err := getWorkspacePackages(rootDir, []string{"packages/utils/", "@acme/core", "utils"}, nil)
+	require.NoError(t, err)
+	require.Len(t, workspacePackages, 2)
+	assert.Equal(t, "utils", workspacePackages[0].packageInfo.Name)
+	assert.Equal(t, "core", workspacePackages[1].packageInfo.Name)
+
+	_, err = getWorkspacePackages(rootDir, []string{"missing"}, nil)
+	assert.ErrorContains(t, err, "no workspace package named 'missing'")
+
+	_, err = getWorkspacePackages(writeWorkspace(t, `{"name":"root"}`, nil), nil, nil)
+	assert.ErrorContains(t, err, "doesn't declare workspaces")
+}
+
+func TestExtractWorkspacesFromArgs(t *testing.T) {
+	cleanArgs, allWorkspaces, workspaces, err := extractWorkspacesFromArgs([]string{"--workspace", "core", "--access=public", "--workspace=utils"})
+	require.NoError(t, err)
+	assert.False(t, allWorkspaces)
+	assert.Equal(t, []string{"core", "utils"}, workspaces)
+	assert.Equal(t, []string{"--access=public"}, cleanArgs)
+
+	cleanArgs, allWorkspaces, workspaces, err = extractWorkspacesFromArgs([]string{"./root", "--workspaces"})
+	require.NoError(t, err)
+	assert.True(t, allWorkspaces)
+	assert.Empty(t, workspaces)
+	assert.Equal(t, []string{"./root"}, cleanArgs)
+}
+
+func TestFilterFlagsWithWorkspace(t *testing.T) {
+	assert.Empty(t, filterFlags([]string{"--workspace", "core", "-w", "utils", "--save"}))
+	assert.Equal(t, []string{"lodash"}, filterFlags([]string{"lodash", "--workspace=core"}))
+}
@@ -12,7 +12,8 @@ import (
 
 	container "github.com/jfrog/jfrog-cli-artifactory/artifactory/commands/ocicontainer"
 
-	"github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clientconfig"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/credentials"
 	"github.com/jfrog/jfrog-cli-core/v2/common/build"
 	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
 	"github.com/jfrog/jfrog-client-go/utils/errorutils"
@@ -41,7 +42,7 @@ func (osb *OcStartBuildCommand) Run() error {
 		return err
 	}
 
-	osb.serverDetails, err = config.GetSpecificConfig(osb.serverId, true, true)
+	osb.serverDetails, err = credentials.GetSpecificConfig(osb.serverId, true, true)
 	if err != nil {
 		return err
 	}
@@ -82,7 +83,7 @@ func (osb *OcStartBuildCommand) Run() error {
 	if err := build.SaveBuildGeneralDetails(buildName, buildNumber, project); err != nil {
 		return err
 	}
-	serviceManager, err := utils.CreateServiceManager(osb.serverDetails, -1, 0, false)
+	serviceManager, err := clientconfig.CreateServiceManager(osb.serverDetails, -1, 0, false)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,294 @@
+package ociartifact
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+
+	"github.com/jfrog/jfrog-cli-artifactory/evidence/signing"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+	"github.com/opencontainers/go-digest"
+	"github.com/opencontainers/image-spec/specs-go"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"oras.land/oras-go/v2"
+	"oras.land/oras-go/v2/content"
+	"oras.land/oras-go/v2/errdef"
+)
+
+// Cosign stores the signatures of an image as the layers of an image manifest, tagged sha256-<digest>.sig in the
+// repository of the image. Each layer holds a simple signing payload, and its signature in an annotation.
+const (
+	cosignSignatureMediaType  = "application/vnd.dev.cosign.simplesigning.v1+json"
+	cosignSignatureAnnotation = "dev.cosignproject.cosign/signature"
+	cosignSignatureType       = "cosign container image signature"
+)
+
+type simpleSigningPayload struct {
+	Critical struct {
+		Identity struct {
+			DockerReference string `json:"docker-reference"`
+		} `json:"identity"`
+		Image struct {
+			DockerManifestDigest string `json:"docker-manifest-digest"`
+		} `json:"image"`
+		Type string `json:"type"`
+	} `json:"critical"`
+	Optional map[string]string `json:"optional"`
+}
+
+func signatureTag(imageDigest digest.Digest) string {
+	return strings.Replace(imageDigest.String(), ":", "-", 1) + ".sig"
+}
+
+// OciSignCommand signs an image or an OCI artifact in the cosign format, with the key which signs evidence, so that
+// 'cosign verify' verifies it with the public key.
+type OciSignCommand struct {
+	serverDetails *config.ServerDetails
+	reference     string
+	keyPath       string
+	annotations   map[string]string
+}
+
+func NewOciSignCommand() *OciSignCommand {
+	return &OciSignCommand{}
+}
+
+func (osc *OciSignCommand) SetReference(reference string) *OciSignCommand {
+	osc.reference = reference
+	return osc
+}
+
+// SetKeyPath sets the path of an unencrypted ECDSA, RSA or Ed25519 private key in PEM format.
+func (osc *OciSignCommand) SetKeyPath(keyPath string) *OciSignCommand {
+	osc.keyPath = keyPath
+	return osc
+}
+
+// SetAnnotations sets claims which are signed along with the image, in the optional section of the payload.
+func (osc *OciSignCommand) SetAnnotations(annotations map[string]string) *OciSignCommand {
+	osc.annotations = annotations
+	return osc
+}
+
+func (osc *OciSignCommand) SetServerDetails(serverDetails *config.ServerDetails) *OciSignCommand {
+	osc.serverDetails = serverDetails
+	return osc
+}
+
+func (osc *OciSignCommand) ServerDetails() (*config.ServerDetails, error) {
+	return osc.serverDetails, nil
+}
+
+func (osc *OciSignCommand) CommandName() string {
+	return "rt_oci_sign"
+}
+
+func (osc *OciSignCommand) Run() error {
+	signer, err := signing.LoadSigner(osc.keyPath)
+	if err != nil {
+		return err
+	}
+	repo, reference, err := newRepository(osc.serverDetails, osc.reference)
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+	image, err := repo.Resolve(ctx, reference)
+	if err != nil {
+		return errorutils.CheckErrorf("failed to resolve '%s': %s", osc.reference, err.Error())
+	}
+	dockerReference := repo.Reference.Registry + "/" + repo.Reference.Repository
+	signatures, err := signImage(ctx, repo, dockerReference, image, signer, osc.annotations)
+	if err != nil {
+		return err
+	}
+	log.Info("Signed", osc.reference, "with the digest", image.Digest.String())
+	return printJson(newArtifactDetails(repo.Reference.Repository+":"+signatureTag(image.Digest), signatures))
+}
+
+// signImage adds a signature of the image to its signature manifest, and returns the descriptor of the manifest.
+func signImage(ctx context.Context, target oras.Target, dockerReference string, image ocispec.Descriptor, signer signing.Signer, annotations map[string]string) (ocispec.Descriptor, error) {
+	var payload simpleSigningPayload
+	payload.Critical.Identity.DockerReference = dockerReference
+	payload.Critical.Image.DockerManifestDigest = image.Digest.String()
+	payload.Critical.Type = cosignSignatureType
+	payload.Optional = annotations
+	payloadContent, err := json.Marshal(payload)
+	if err != nil {
+		return ocispec.Descriptor{}, errorutils.CheckError(err)
+	}
+	signature, err := signer.Sign(payloadContent)
+	if err != nil {
+		return ocispec.Descriptor{}, err
+	}
+	layer := content.NewDescriptorFromBytes(cosignSignatureMediaType, payloadContent)
+	if err = pushBytesIfNotExist(ctx, target, layer, payloadContent); err != nil {
+		return ocispec.Descriptor{}, err
+	}
+	layer.Annotations = map[string]string{cosignSignatureAnnotation: base64.StdEncoding.EncodeToString(signature)}
+
+	signatures, err := fetchSignatureManifest(ctx, target, image.Digest)
+	if err != nil {
+		return ocispec.Descriptor{}, err
+	}
+	if signatures == nil {
+		signatures = &ocispec.Manifest{Versioned: specs.Versioned{SchemaVersion: 2}, MediaType: ocispec.MediaTypeImageManifest}
+	}
+	signatures.Layers = append(signatures.Layers, layer)
+	if signatures.Config, err = pushSignaturesConfig(ctx, target, signatures.Layers); err != nil {
+		return ocispec.Descriptor{}, err
+	}
+	manifestContent, err := json.Marshal(signatures)
+	if err != nil {
+		return ocispec.Descriptor{}, errorutils.CheckError(err)
+	}
+	desc, err := oras.TagBytes(ctx, target, ocispec.MediaTypeImageManifest, manifestContent, signatureTag(image.Digest))
+	return desc, errorutils.CheckError(err)
+}
+
+// Cosign records the signature layers in the config of the signature manifest, as the layers of an image.
+func pushSignaturesConfig(ctx context.Context, target oras.Target, layers []ocispec.Descriptor) (ocispec.Descriptor, error) {
+	imageConfig := ocispec.Image{RootFS: ocispec.RootFS{Type: "layers"}}
+	for _, layer := range layers {
+		imageConfig.RootFS.DiffIDs = append(imageConfig.RootFS.DiffIDs, layer.Digest)
+	}
+	configContent, err := json.Marshal(imageConfig)
+	if err != nil {
+		return ocispec.Descriptor{}, errorutils.CheckError(err)
+	}
+	configDesc := content.NewDescriptorFromBytes(ocispec.MediaTypeImageConfig, configContent)
+	return configDesc, pushBytesIfNotExist(ctx, target, configDesc, configContent)
+}
+
+// Returns the signature manifest of the image, or nil if the image isn't signed.
+func fetchSignatureManifest(ctx context.Context, target oras.ReadOnlyTarget, imageDigest digest.Digest) (*ocispec.Manifest, error) {
+	desc, err := target.Resolve(ctx, signatureTag(imageDigest))
+	if errors.Is(err, errdef.ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	manifestContent, err := content.FetchAll(ctx, target, desc)
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	manifest := new(ocispec.Manifest)
+	return manifest, errorutils.CheckError(json.Unmarshal(manifestContent, manifest))
+}
+
+func pushBytesIfNotExist(ctx context.Context, target oras.Target, desc ocispec.Descriptor, data []byte) error {
+	exists, err := target.Exists(ctx, desc)
+	if err != nil || exists {
+		return errorutils.CheckError(err)
+	}
+	return errorutils.CheckError(target.Push(ctx, desc, bytes.NewReader(data)))
+}
+
+// OciVerifyCommand verifies the cosign signatures of an image or an OCI artifact with a public key, and fails unless
+// at least one of them is valid.
+type OciVerifyCommand struct {
+	serverDetails *config.ServerDetails
+	reference     string
+	keyPath       string
+}
+
+func NewOciVerifyCommand() *OciVerifyCommand {
+	return &OciVerifyCommand{}
+}
+
+func (ovc *OciVerifyCommand) SetReference(reference string) *OciVerifyCommand {
+	ovc.reference = reference
+	return ovc
+}
+
+// SetKeyPath sets the path of a public key in PEM format, or of the private key which signed the image.
+func (ovc *OciVerifyCommand) SetKeyPath(keyPath string) *OciVerifyCommand {
+	ovc.keyPath = keyPath
+	return ovc
+}
+
+func (ovc *OciVerifyCommand) SetServerDetails(serverDetails *config.ServerDetails) *OciVerifyCommand {
+	ovc.serverDetails = serverDetails
+	return ovc
+}
+
+func (ovc *OciVerifyCommand) ServerDetails() (*config.ServerDetails, error) {
+	return ovc.serverDetails, nil
+}
+
+func (ovc *OciVerifyCommand) CommandName() string {
+	return "rt_oci_verify"
+}
+
+func (ovc *OciVerifyCommand) Run() error {
+	publicKey, err := signing.LoadPublicKey(ovc.keyPath)
+	if err != nil {
+		return err
+	}
+	repo, reference, err := newRepository(ovc.serverDetails, ovc.reference)
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+	image, err := repo.Resolve(ctx, reference)
+	if err != nil {
+		return errorutils.CheckErrorf("failed to resolve '%s': %s", ovc.reference, err.Error())
+	}
+	payloads, err := verifyImage(ctx, repo, image, publicKey)
+	if err != nil {
+		return err
+	}
+	log.Info("Verified", len(payloads), "signatures of", ovc.reference)
+	return printJson(payloads)
+}
+
+// verifyImage returns the payloads of the signatures of the image which are valid for the public key.
+func verifyImage(ctx context.Context, target oras.ReadOnlyTarget, image ocispec.Descriptor, publicKey crypto.PublicKey) ([]simpleSigningPayload, error) {
+	signatures, err := fetchSignatureManifest(ctx, target, image.Digest)
+	if err != nil {
+		return nil, err
+	}
+	if signatures == nil {
+		return nil, errorutils.CheckErrorf("no signatures were found for %s", image.Digest)
+	}
+	var verified []simpleSigningPayload
+	for _, layer := range signatures.Layers {
+		if layer.MediaType != cosignSignatureMediaType {
+			continue
+		}
+		signature, err := base64.StdEncoding.DecodeString(layer.Annotations[cosignSignatureAnnotation])
+		if err != nil {
+			log.Debug("Skipping the signature", layer.Digest.String(), "with an invalid encoding:", err.Error())
+			continue
+		}
+		payloadContent, err := content.FetchAll(ctx, target, layer)
+		if err != nil {
+			return nil, errorutils.CheckError(err)
+		}
+		if err = signing.Verify(publicKey, payloadContent, signature); err != nil {
+			log.Debug("Skipping the signature", layer.Digest.String()+":", err.Error())
+			continue
+		}
+		var payload simpleSigningPayload
+		if err = json.Unmarshal(payloadContent, &payload); err != nil {
+			return nil, errorutils.CheckErrorf("failed to parse the signature payload %s: %s", layer.Digest, err.Error())
+		}
+		// A valid signature of another image mustn't verify this image.
+		if payload.Critical.Image.DockerManifestDigest != image.Digest.String() {
+			log.Debug("Skipping the signature", layer.Digest.String(), "of", payload.Critical.Image.DockerManifestDigest)
+			continue
+		}
+		verified = append(verified, payload)
+	}
+	if len(verified) == 0 {
+		return nil, errorutils.CheckErrorf("none of the %d signatures of %s is valid for the key", len(signatures.Layers), image.Digest)
+	}
+	return verified, nil
+}
@@ -0,0 +1,69 @@
+package ociartifact
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-artifactory/evidence/signing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"oras.land/oras-go/v2/content/memory"
+)
+
+func newTestSigner(t *testing.T) signing.Signer {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	keyBytes, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+	keyPath := filepath.Join(t.TempDir(), "signing.pem")
+	require.NoError(t, os.WriteFile(keyPath, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyBytes}), 0600))
+	signer, err := signing.LoadSigner(keyPath)
+	require.NoError(t, err)
+	return signer
+}
+
+func TestSignVerifyImage(t *testing.T) {
+	ctx := context.Background()
+	store := memory.New()
+	image, err := NewOciPushCommand().push(ctx, store, "1.0.0")
+	require.NoError(t, err)
+	otherImage, err := NewOciPushCommand().SetArtifactType("application/vnd.other").push(ctx, store, "2.0.0")
+	require.NoError(t, err)
+	signer, otherSigner := newTestSigner(t), newTestSigner(t)
+
+	_, err = verifyImage(ctx, store, image, signer.Public())
+	assert.ErrorContains(t, err, "no signatures were found for "+image.Digest.String())
+
+	_, err = signImage(ctx, store, "acme.jfrog.io/oci-local/app", image, otherSigner, nil)
+	require.NoError(t, err)
+	_, err = verifyImage(ctx, store, image, signer.Public())
+	assert.ErrorContains(t, err, "none of the 1 signatures")
+
+	// Signatures are added to the signature manifest of the image.
+	_, err = signImage(ctx, store, "acme.jfrog.io/oci-local/app", image, signer, map[string]string{"build": "42"})
+	require.NoError(t, err)
+	signatures, err := fetchSignatureManifest(ctx, store, image.Digest)
+	require.NoError(t, err)
+	assert.Len(t, signatures.Layers, 2)
+	payloads, err := verifyImage(ctx, store, image, signer.Public())
+	require.NoError(t, err)
+	require.Len(t, payloads, 1)
+	assert.Equal(t, "acme.jfrog.io/oci-local/app", payloads[0].Critical.Identity.DockerReference)
+	assert.Equal(t, image.Digest.String(), payloads[0].Critical.Image.DockerManifestDigest)
+	assert.Equal(t, cosignSignatureType, payloads[0].Critical.Type)
+	assert.Equal(t, "42", payloads[0].Optional["build"])
+
+	_, err = verifyImage(ctx, store, otherImage, signer.Public())
+	assert.ErrorContains(t, err, "no signatures were found")
+}
+
+func TestSignatureTag(t *testing.T) {
+	assert.Equal(t, "sha256-2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae.sig", signatureTag("sha256:2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae"))
+}
@@ -0,0 +1,90 @@
+// Package ociartifact pushes and pulls generic OCI artifacts, such as SBOMs, signatures and WASM modules, to and from
+// the Docker and OCI repositories of Artifactory.
+package ociartifact
+
+import (
+	"encoding/json"
+	"net/url"
+	"strings"
+
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	clientauth "github.com/jfrog/jfrog-client-go/auth"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"oras.land/oras-go/v2/registry/remote"
+	"oras.land/oras-go/v2/registry/remote/auth"
+	"oras.land/oras-go/v2/registry/remote/retry"
+)
+
+// ArtifactDetails describes a pushed artifact or a referrer, so that evidence can be attached to it by its digest.
+type ArtifactDetails struct {
+	Reference    string            `json:"reference,omitempty"`
+	Digest       string            `json:"digest"`
+	MediaType    string            `json:"mediaType"`
+	ArtifactType string            `json:"artifactType,omitempty"`
+	Size         int64             `json:"size"`
+	Annotations  map[string]string `json:"annotations,omitempty"`
+}
+
+func newArtifactDetails(reference string, desc ocispec.Descriptor) ArtifactDetails {
+	return ArtifactDetails{Reference: reference, Digest: desc.Digest.String(), MediaType: desc.MediaType, ArtifactType: desc.ArtifactType, Size: desc.Size, Annotations: desc.Annotations}
+}
+
+// newRepository returns a client of the OCI repository of a reference, such as <repo>/<name>:<tag> or <repo>/<name>@<digest>.
+// The references are relative to the registry of the Artifactory server, which is authenticated with the credentials of the server.
+// Returns the client, and the tag or digest of the reference.
+func newRepository(serverDetails *config.ServerDetails, reference string) (*remote.Repository, string, error) {
+	artifactoryUrl, err := url.Parse(serverDetails.ArtifactoryUrl)
+	if err != nil || artifactoryUrl.Host == "" {
+		return nil, "", errorutils.CheckErrorf("failed to get the registry of the Artifactory URL '%s'", serverDetails.ArtifactoryUrl)
+	}
+	repo, err := remote.NewRepository(artifactoryUrl.Host + "/" + strings.TrimPrefix(reference, "/"))
+	if err != nil {
+		return nil, "", errorutils.CheckErrorf("invalid reference '%s': %s", reference, err.Error())
+	}
+	repo.PlainHTTP = artifactoryUrl.Scheme == "http"
+	user, password := getCredentials(serverDetails)
+	repo.Client = &auth.Client{
+		Client:     retry.DefaultClient,
+		Cache:      auth.NewCache(),
+		Credential: auth.StaticCredential(repo.Reference.Registry, auth.Credential{Username: user, Password: password}),
+	}
+	return repo, repo.Reference.Reference, nil
+}
+
+func getCredentials(serverDetails *config.ServerDetails) (user, password string) {
+	if serverDetails.AccessToken != "" {
+		user = serverDetails.User
+		if user == "" {
+			user = clientauth.ExtractUsernameFromAccessToken(serverDetails.AccessToken)
+		}
+		return user, serverDetails.AccessToken
+	}
+	return serverDetails.User, serverDetails.Password
+}
+
+func printJson(value any) error {
+	content, err := json.MarshalIndent(value, "", "  ")
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	log.Output(string(content))
+	return nil
+}
+
+// ParseAnnotations parses annotations in the form of "key1=value1;key2=value2".
+func ParseAnnotations(annotations string) (map[string]string, error) {
+	parsed := make(map[string]string)
+	for _, annotation := range strings.Split(annotations, ";") {
+		if strings.TrimSpace(annotation) == "" {
+			continue
+		}
+		key, value, found := strings.Cut(annotation, "=")
+		if !found || strings.TrimSpace(key) == "" {
+			return nil, errorutils.CheckErrorf("invalid annotation '%s'. Annotations should be in the form of key=value", annotation)
+		}
+		parsed[strings.TrimSpace(key)] = value
+	}
+	return parsed, nil
+}
@@ -0,0 +1,104 @@
+package ociartifact
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"oras.land/oras-go/v2"
+	"oras.land/oras-go/v2/content"
+	"oras.land/oras-go/v2/content/memory"
+)
+
+func writeTestFile(t *testing.T, dir, name, content string) string {
+	filePath := filepath.Join(dir, name)
+	require.NoError(t, os.WriteFile(filePath, []byte(content), 0644))
+	return filePath
+}
+
+func TestPushPullArtifact(t *testing.T) {
+	ctx := context.Background()
+	store := memory.New()
+	sourceDir := t.TempDir()
+
+	moduleCmd := NewOciPushCommand().SetArtifactType("application/vnd.wasm.config.v0+json").
+		SetFiles([]string{writeTestFile(t, sourceDir, "module.wasm", "wasm") + ":application/vnd.wasm.content.layer.v1+wasm"}).
+		SetAnnotations(map[string]string{"org.opencontainers.image.version": "1.0.0"})
+	moduleDesc, err := moduleCmd.push(ctx, store, "1.0.0")
+	require.NoError(t, err)
+
+	sbomCmd := NewOciPushCommand().SetArtifactType("application/spdx+json").SetSubject("1.0.0").
+		SetFiles([]string{writeTestFile(t, sourceDir, "sbom.spdx.json", "{}")})
+	sbomDesc, err := sbomCmd.push(ctx, store, "")
+	require.NoError(t, err)
+
+	targetDir := filepath.Join(t.TempDir(), "pulled")
+	pulled, err := pullArtifact(ctx, store, "1.0.0", targetDir)
+	require.NoError(t, err)
+	assert.Equal(t, moduleDesc.Digest, pulled.Digest)
+	assert.Equal(t, "application/vnd.wasm.config.v0+json", pulled.ArtifactType)
+	assert.Equal(t, "1.0.0", pulled.Annotations["org.opencontainers.image.version"])
+	content, err := os.ReadFile(filepath.Join(targetDir, "module.wasm"))
+	require.NoError(t, err)
+	assert.Equal(t, "wasm", string(content))
+
+	referrers, err := getReferrers(ctx, store, "1.0.0", "application/spdx+json")
+	require.NoError(t, err)
+	require.Len(t, referrers, 1)
+	assert.Equal(t, sbomDesc.Digest.String(), referrers[0].Digest)
+	referrers, err = getReferrers(ctx, store, "1.0.0", "application/vnd.dev.cosign.artifact.sig.v1+json")
+	require.NoError(t, err)
+	assert.Empty(t, referrers)
+
+	_, err = NewOciPushCommand().SetSubject("missing").push(ctx, store, "")
+	assert.ErrorContains(t, err, "failed to resolve the subject 'missing'")
+}
+
+func TestSplitMediaType(t *testing.T) {
+	filePath, mediaType := splitMediaType("sbom.json:application/spdx+json")
+	assert.Equal(t, "sbom.json", filePath)
+	assert.Equal(t, "application/spdx+json", mediaType)
+	filePath, mediaType = splitMediaType(`C:\artifacts\module.wasm`)
+	assert.Equal(t, `C:\artifacts\module.wasm`, filePath)
+	assert.Equal(t, DefaultLayerMediaType, mediaType)
+}
+
+func TestNewRepository(t *testing.T) {
+	repo, reference, err := newRepository(&config.ServerDetails{ArtifactoryUrl: "http://localhost:8082/artifactory/", User: "admin", Password: "password"}, "oci-local/sbom:1.0")
+	require.NoError(t, err)
+	assert.Equal(t, "localhost:8082", repo.Reference.Registry)
+	assert.Equal(t, "oci-local/sbom", repo.Reference.Repository)
+	assert.Equal(t, "1.0", reference)
+	assert.True(t, repo.PlainHTTP)
+
+	_, _, err = newRepository(&config.ServerDetails{ArtifactoryUrl: "https://acme.jfrog.io/artifactory/"}, "Invalid Reference")
+	assert.ErrorContains(t, err, "invalid reference")
+}
+
+func TestPullArtifactInvalidTitle(t *testing.T) {
+	ctx := context.Background()
+	store := memory.New()
+	layerContent := []byte("escape")
+	layer := content.NewDescriptorFromBytes(DefaultLayerMediaType, layerContent)
+	layer.Annotations = map[string]string{ocispec.AnnotationTitle: "../escape"}
+	require.NoError(t, store.Push(ctx, layer, bytes.NewReader(layerContent)))
+	desc, err := oras.PackManifest(ctx, store, oras.PackManifestVersion1_1, oras.MediaTypeUnknownArtifact, oras.PackManifestOptions{Layers: []ocispec.Descriptor{layer}})
+	require.NoError(t, err)
+	require.NoError(t, store.Tag(ctx, desc, "escape"))
+	_, err = pullArtifact(ctx, store, "escape", t.TempDir())
+	assert.ErrorContains(t, err, "has an invalid file name '../escape'")
+}
+
+func TestParseAnnotations(t *testing.T) {
+	annotations, err := ParseAnnotations("org.opencontainers.image.source=https://github.com/jfrog/app;org.opencontainers.image.revision=abc=1;")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"org.opencontainers.image.source": "https://github.com/jfrog/app", "org.opencontainers.image.revision": "abc=1"}, annotations)
+	_, err = ParseAnnotations("no-value")
+	assert.ErrorContains(t, err, "invalid annotation 'no-value'")
+}
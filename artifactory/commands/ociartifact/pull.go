@@ -0,0 +1,108 @@
+package ociartifact
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"oras.land/oras-go/v2"
+	"oras.land/oras-go/v2/content"
+)
+
+// OciPullCommand downloads the layers of an OCI artifact to a directory, as files named by their title annotations.
+type OciPullCommand struct {
+	serverDetails *config.ServerDetails
+	reference     string
+	targetDir     string
+}
+
+func NewOciPullCommand() *OciPullCommand {
+	return &OciPullCommand{targetDir: "."}
+}
+
+func (opc *OciPullCommand) SetReference(reference string) *OciPullCommand {
+	opc.reference = reference
+	return opc
+}
+
+func (opc *OciPullCommand) SetTargetDir(targetDir string) *OciPullCommand {
+	opc.targetDir = targetDir
+	return opc
+}
+
+func (opc *OciPullCommand) SetServerDetails(serverDetails *config.ServerDetails) *OciPullCommand {
+	opc.serverDetails = serverDetails
+	return opc
+}
+
+func (opc *OciPullCommand) ServerDetails() (*config.ServerDetails, error) {
+	return opc.serverDetails, nil
+}
+
+func (opc *OciPullCommand) CommandName() string {
+	return "rt_oci_pull"
+}
+
+func (opc *OciPullCommand) Run() error {
+	repo, reference, err := newRepository(opc.serverDetails, opc.reference)
+	if err != nil {
+		return err
+	}
+	if reference == "" {
+		return errorutils.CheckErrorf("the reference '%s' must have a tag or a digest", opc.reference)
+	}
+	desc, err := pullArtifact(context.Background(), repo, reference, opc.targetDir)
+	if err != nil {
+		return err
+	}
+	return printJson(newArtifactDetails(opc.reference, desc))
+}
+
+// pullArtifact writes the layers of the artifact to the target directory, and returns the descriptor of its manifest.
+// Layers without a title are named by their digests.
+func pullArtifact(ctx context.Context, target oras.ReadOnlyTarget, reference, targetDir string) (ocispec.Descriptor, error) {
+	desc, err := target.Resolve(ctx, reference)
+	if err != nil {
+		return ocispec.Descriptor{}, errorutils.CheckErrorf("failed to resolve '%s': %s", reference, err.Error())
+	}
+	manifestContent, err := content.FetchAll(ctx, target, desc)
+	if err != nil {
+		return ocispec.Descriptor{}, errorutils.CheckError(err)
+	}
+	var manifest ocispec.Manifest
+	if err = json.Unmarshal(manifestContent, &manifest); err != nil {
+		return ocispec.Descriptor{}, errorutils.CheckErrorf("failed to parse the manifest of '%s': %s", reference, err.Error())
+	}
+	if manifest.MediaType != ocispec.MediaTypeImageManifest {
+		return ocispec.Descriptor{}, errorutils.CheckErrorf("'%s' is a %s rather than an OCI artifact manifest", reference, desc.MediaType)
+	}
+	desc.ArtifactType = manifest.ArtifactType
+	desc.Annotations = manifest.Annotations
+	if err = os.MkdirAll(targetDir, 0755); err != nil {
+		return ocispec.Descriptor{}, errorutils.CheckError(err)
+	}
+	for _, layer := range manifest.Layers {
+		fileName := layer.Annotations[ocispec.AnnotationTitle]
+		if fileName == "" {
+			fileName = layer.Digest.Encoded()
+		}
+		// The titles are set by the pusher, so they mustn't reach outside the target directory.
+		if fileName != filepath.Base(fileName) || fileName == ".." {
+			return ocispec.Descriptor{}, errorutils.CheckErrorf("the layer %s has an invalid file name '%s'", layer.Digest, fileName)
+		}
+		layerContent, err := content.FetchAll(ctx, target, layer)
+		if err != nil {
+			return ocispec.Descriptor{}, errorutils.CheckError(err)
+		}
+		log.Info("Downloaded", fileName)
+		if err = os.WriteFile(filepath.Join(targetDir, fileName), layerContent, 0644); err != nil {
+			return ocispec.Descriptor{}, errorutils.CheckError(err)
+		}
+	}
+	return desc, nil
+}
@@ -0,0 +1,152 @@
+package ociartifact
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+	"github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"oras.land/oras-go/v2"
+)
+
+const DefaultLayerMediaType = "application/octet-stream"
+
+// OciPushCommand pushes files as the layers of an OCI artifact. The artifact may refer to a subject artifact, such as
+// the image which an SBOM or a signature describes, so that it is listed among the referrers of the subject.
+type OciPushCommand struct {
+	serverDetails *config.ServerDetails
+	reference     string
+	// Each file may be followed by its media type, as <path>:<media type>.
+	files        []string
+	artifactType string
+	annotations  map[string]string
+	subject      string
+}
+
+func NewOciPushCommand() *OciPushCommand {
+	return &OciPushCommand{artifactType: oras.MediaTypeUnknownArtifact}
+}
+
+func (opc *OciPushCommand) SetReference(reference string) *OciPushCommand {
+	opc.reference = reference
+	return opc
+}
+
+func (opc *OciPushCommand) SetFiles(files []string) *OciPushCommand {
+	opc.files = files
+	return opc
+}
+
+func (opc *OciPushCommand) SetArtifactType(artifactType string) *OciPushCommand {
+	opc.artifactType = artifactType
+	return opc
+}
+
+func (opc *OciPushCommand) SetAnnotations(annotations map[string]string) *OciPushCommand {
+	opc.annotations = annotations
+	return opc
+}
+
+// SetSubject sets the tag or digest, in the repository of the artifact, of the artifact which the pushed artifact refers to.
+func (opc *OciPushCommand) SetSubject(subject string) *OciPushCommand {
+	opc.subject = subject
+	return opc
+}
+
+func (opc *OciPushCommand) SetServerDetails(serverDetails *config.ServerDetails) *OciPushCommand {
+	opc.serverDetails = serverDetails
+	return opc
+}
+
+func (opc *OciPushCommand) ServerDetails() (*config.ServerDetails, error) {
+	return opc.serverDetails, nil
+}
+
+func (opc *OciPushCommand) CommandName() string {
+	return "rt_oci_push"
+}
+
+func (opc *OciPushCommand) Run() error {
+	repo, tag, err := newRepository(opc.serverDetails, opc.reference)
+	if err != nil {
+		return err
+	}
+	desc, err := opc.push(context.Background(), repo, tag)
+	if err != nil {
+		return err
+	}
+	log.Info("Pushed", opc.reference, "with the digest", desc.Digest.String())
+	return printJson(newArtifactDetails(opc.reference, desc))
+}
+
+// push pushes the files and the manifest of the artifact to the target, and tags the manifest unless the tag is a digest.
+func (opc *OciPushCommand) push(ctx context.Context, target oras.Target, tag string) (ocispec.Descriptor, error) {
+	var layers []ocispec.Descriptor
+	for _, file := range opc.files {
+		layer, err := pushFile(ctx, target, file)
+		if err != nil {
+			return ocispec.Descriptor{}, err
+		}
+		layers = append(layers, layer)
+	}
+	packOptions := oras.PackManifestOptions{Layers: layers, ManifestAnnotations: opc.annotations}
+	if opc.subject != "" {
+		subject, err := target.Resolve(ctx, opc.subject)
+		if err != nil {
+			return ocispec.Descriptor{}, errorutils.CheckErrorf("failed to resolve the subject '%s': %s", opc.subject, err.Error())
+		}
+		packOptions.Subject = &subject
+	}
+	desc, err := oras.PackManifest(ctx, target, oras.PackManifestVersion1_1, opc.artifactType, packOptions)
+	if err != nil {
+		return ocispec.Descriptor{}, errorutils.CheckError(err)
+	}
+	// References by digest aren't tagged.
+	if _, digestErr := digest.Parse(tag); tag != "" && digestErr != nil {
+		if err = target.Tag(ctx, desc, tag); err != nil {
+			return ocispec.Descriptor{}, errorutils.CheckError(err)
+		}
+	}
+	return desc, nil
+}
+
+// pushFile pushes a file as a layer, named by its base name. The file may be followed by its media type, as <path>:<media type>.
+func pushFile(ctx context.Context, target oras.Target, file string) (ocispec.Descriptor, error) {
+	filePath, mediaType := splitMediaType(file)
+	content, err := os.ReadFile(filePath)
+	if err != nil {
+		return ocispec.Descriptor{}, errorutils.CheckError(err)
+	}
+	desc := ocispec.Descriptor{
+		MediaType:   mediaType,
+		Digest:      digest.FromBytes(content),
+		Size:        int64(len(content)),
+		Annotations: map[string]string{ocispec.AnnotationTitle: filepath.Base(filePath)},
+	}
+	exists, err := target.Exists(ctx, desc)
+	if err != nil {
+		return ocispec.Descriptor{}, errorutils.CheckError(err)
+	}
+	if !exists {
+		log.Info("Uploading", filePath+"...")
+		if err = target.Push(ctx, desc, bytes.NewReader(content)); err != nil {
+			return ocispec.Descriptor{}, errorutils.CheckError(err)
+		}
+	}
+	return desc, nil
+}
+
+// Media types always hold a slash, which tells them apart from the drive letters of Windows paths.
+func splitMediaType(file string) (filePath, mediaType string) {
+	separator := strings.LastIndex(file, ":")
+	if separator > 0 && strings.Contains(file[separator+1:], "/") {
+		return file[:separator], file[separator+1:]
+	}
+	return file, DefaultLayerMediaType
+}
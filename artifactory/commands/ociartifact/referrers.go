@@ -0,0 +1,82 @@
+package ociartifact
+
+import (
+	"context"
+
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"oras.land/oras-go/v2/content"
+	"oras.land/oras-go/v2/registry"
+)
+
+// OciReferrersCommand lists the artifacts which refer to an artifact, such as its SBOMs and signatures.
+type OciReferrersCommand struct {
+	serverDetails *config.ServerDetails
+	reference     string
+	artifactType  string
+}
+
+func NewOciReferrersCommand() *OciReferrersCommand {
+	return &OciReferrersCommand{}
+}
+
+func (orc *OciReferrersCommand) SetReference(reference string) *OciReferrersCommand {
+	orc.reference = reference
+	return orc
+}
+
+// SetArtifactType sets the artifact type of the listed referrers. If not set, all the referrers are listed.
+func (orc *OciReferrersCommand) SetArtifactType(artifactType string) *OciReferrersCommand {
+	orc.artifactType = artifactType
+	return orc
+}
+
+func (orc *OciReferrersCommand) SetServerDetails(serverDetails *config.ServerDetails) *OciReferrersCommand {
+	orc.serverDetails = serverDetails
+	return orc
+}
+
+func (orc *OciReferrersCommand) ServerDetails() (*config.ServerDetails, error) {
+	return orc.serverDetails, nil
+}
+
+func (orc *OciReferrersCommand) CommandName() string {
+	return "rt_oci_referrers"
+}
+
+func (orc *OciReferrersCommand) Run() error {
+	repo, reference, err := newRepository(orc.serverDetails, orc.reference)
+	if err != nil {
+		return err
+	}
+	if reference == "" {
+		return errorutils.CheckErrorf("the reference '%s' must have a tag or a digest", orc.reference)
+	}
+	referrers, err := getReferrers(context.Background(), repo, reference, orc.artifactType)
+	if err != nil {
+		return err
+	}
+	return printJson(referrers)
+}
+
+// referrersStorage resolves references, and lists the referrers of artifacts.
+type referrersStorage interface {
+	content.ReadOnlyGraphStorage
+	content.Resolver
+}
+
+func getReferrers(ctx context.Context, store referrersStorage, reference, artifactType string) ([]ArtifactDetails, error) {
+	desc, err := store.Resolve(ctx, reference)
+	if err != nil {
+		return nil, errorutils.CheckErrorf("failed to resolve '%s': %s", reference, err.Error())
+	}
+	referrers, err := registry.Referrers(ctx, store, desc, artifactType)
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	details := []ArtifactDetails{}
+	for _, referrer := range referrers {
+		details = append(details, newArtifactDetails("", referrer))
+	}
+	return details, nil
+}
@@ -161,3 +161,17 @@ func createManifestConfigWithForeignLayer() (map[string]*utils.ResultItem, *mani
 	searchResults["sha__1"] = dummySearchResults
 	return searchResults, manifest
 }
+
+func TestGetBaseImagesFromMetadata(t *testing.T) {
+	baseImages, err := GetBaseImagesFromMetadata(filepath.Join("..", "testdata", "container", "buildxMetadataWithProvenance.json"))
+	assert.NoError(t, err)
+	assert.Equal(t, []DockerImage{
+		{Image: "acme.jfrog.io/docker-remote/alpine:3.19", OS: "linux", Architecture: "arm64"},
+		{Image: "acme.jfrog.io/docker-remote/golang@sha256:4a3c2bcd243d3dbb7b15237eecb0792db3614900037998c2cd6a579c46888c1e", OS: "linux", Architecture: "amd64"},
+	}, baseImages)
+
+	// Kaniko's image digest file has no provenance.
+	baseImages, err = GetBaseImagesFromMetadata(filepath.Join("..", "testdata", "container", "imageTagWithDigest"))
+	assert.NoError(t, err)
+	assert.Empty(t, baseImages)
+}
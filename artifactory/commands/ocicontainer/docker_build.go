@@ -76,7 +76,7 @@ func (dbib *DockerBuildInfoBuilder) Build() error {
 		return err
 	}
 
-	dependencies, err := NewDockerDependenciesBuilder(dbib.baseImages, dbib.serviceManager).getDependencies()
+	dependencies, err := NewDockerDependenciesBuilder(dbib.baseImages, dbib.serviceManager).GetDependencies()
 	if err != nil {
 		log.Warn(fmt.Sprintf("Failed to get dependencies for '%s'. Error: %v", dbib.buildName, err))
 	}
@@ -26,8 +26,8 @@ func NewDockerDependenciesBuilder(dockerImages []DockerImage, serviceManager art
 	}
 }
 
-// getDependencies collects dependencies for all base images in parallel
-func (ddp *DockerDependenciesBuilder) getDependencies() ([]buildinfo.Dependency, error) {
+// GetDependencies collects the layers of the base images as dependencies, in parallel
+func (ddp *DockerDependenciesBuilder) GetDependencies() ([]buildinfo.Dependency, error) {
 	var wg sync.WaitGroup
 	errChan := make(chan error, len(ddp.dockerImages))
 	dependencyResultChan := make(chan []utils.ResultItem, len(ddp.dockerImages))
@@ -8,6 +8,7 @@ import (
 	"strings"
 	"sync"
 
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clierrors"
 	"github.com/jfrog/jfrog-client-go/artifactory"
 	"github.com/jfrog/jfrog-client-go/artifactory/services/utils"
 	"github.com/jfrog/jfrog-client-go/utils/errorutils"
@@ -271,7 +272,7 @@ func downloadSingleMarkerLayer(layerSha, remoteRepo, imageName, baseUrl string,
 		log.Warn(fmt.Sprintf("Skipping adding layer %s to build info. Failed to download layer in cache. Error: %s", layerSha, err.Error()))
 		return nil
 	}
-	if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK); err != nil {
+	if err = clierrors.CheckResponseStatusWithBody(resp, body, http.StatusOK); err != nil {
 		log.Warn(fmt.Sprintf("Skipping adding layer %s to build info. Failed to download layer in cache. Error: %s, httpStatus: %d", layerSha, err.Error(), resp.StatusCode))
 		return nil
 	}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	buildinfo "github.com/jfrog/build-info-go/entities"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clierrors"
 	"github.com/jfrog/jfrog-client-go/artifactory"
 	"github.com/jfrog/jfrog-client-go/artifactory/services/utils"
 	"github.com/jfrog/jfrog-client-go/utils/errorutils"
@@ -76,7 +77,7 @@ func (labib *localAgentBuildInfoBuilder) handleImageByDigest() (*buildinfo.Build
 	dockerImage := DockerImage{
 		Image: labib.buildInfoBuilder.image.Name(),
 	}
-	dependencies, err := NewDockerDependenciesBuilder([]DockerImage{dockerImage}, labib.buildInfoBuilder.serviceManager).getDependencies()
+	dependencies, err := NewDockerDependenciesBuilder([]DockerImage{dockerImage}, labib.buildInfoBuilder.serviceManager).GetDependencies()
 	if err != nil {
 		return nil, err
 	}
@@ -214,7 +215,7 @@ func downloadMarkerLayersToRemoteCache(resultMap map[string]*utils.ResultItem, b
 				log.Debug("Failed to download marker layer. Error:", err.Error())
 				return totalDownloaded, err
 			}
-			if err = errorutils.CheckResponseStatusWithBody(resp, body, http.StatusOK); err != nil {
+			if err = clierrors.CheckResponseStatusWithBody(resp, body, http.StatusOK); err != nil {
 				log.Debug("Failed to download marker layer. HTTP stats code:", resp.StatusCode)
 				return totalDownloaded, err
 			}
@@ -0,0 +1,84 @@
+package ocicontainer
+
+import (
+	"encoding/json"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const (
+	dockerPurlPrefix = "pkg:docker/"
+	// The images of the Dockerfile frontend are materials of the build, rather than base images.
+	dockerfileFrontendImage = "docker/dockerfile"
+)
+
+// The materials of the SLSA provenance which BuildKit adds to its metadata file, in both SLSA v0.2 and v1 formats.
+type buildxProvenance struct {
+	Materials       []provenanceMaterial `json:"materials"`
+	BuildDefinition struct {
+		ResolvedDependencies []provenanceMaterial `json:"resolvedDependencies"`
+	} `json:"buildDefinition"`
+}
+
+type provenanceMaterial struct {
+	Uri    string            `json:"uri"`
+	Digest map[string]string `json:"digest"`
+}
+
+// GetBaseImagesFromMetadata returns the base images recorded in the provenance of a BuildKit or buildx metadata file,
+// created with '--metadata-file'. Returns no images for other files, such as the image digest file of Kaniko.
+func GetBaseImagesFromMetadata(filePath string) ([]DockerImage, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	var metadata struct {
+		Provenance *buildxProvenance `json:"buildx.build.provenance"`
+	}
+	if json.Unmarshal(data, &metadata) != nil || metadata.Provenance == nil {
+		log.Debug("No build provenance was found in", filePath)
+		return nil, nil
+	}
+	var baseImages []DockerImage
+	for _, material := range append(metadata.Provenance.Materials, metadata.Provenance.BuildDefinition.ResolvedDependencies...) {
+		baseImage, ok := parseDockerPurl(material.Uri)
+		if !ok || strings.HasPrefix(baseImage.Image, dockerfileFrontendImage) || strings.Contains(baseImage.Image, "/"+dockerfileFrontendImage) {
+			continue
+		}
+		baseImages = append(baseImages, baseImage)
+	}
+	return baseImages, nil
+}
+
+// parseDockerPurl parses a Docker package URL, such as pkg:docker/alpine@3.19?platform=linux%2Famd64, into an image
+// referenced by its tag or digest, and its platform.
+func parseDockerPurl(purl string) (DockerImage, bool) {
+	if !strings.HasPrefix(purl, dockerPurlPrefix) {
+		return DockerImage{}, false
+	}
+	nameAndVersion, qualifiers, _ := strings.Cut(strings.TrimPrefix(purl, dockerPurlPrefix), "?")
+	name, version, found := strings.Cut(nameAndVersion, "@")
+	if !found {
+		return DockerImage{}, false
+	}
+	name, nameErr := url.PathUnescape(name)
+	version, versionErr := url.PathUnescape(version)
+	if nameErr != nil || versionErr != nil || name == "" || version == "" {
+		return DockerImage{}, false
+	}
+	image := DockerImage{Image: name + ":" + version}
+	if strings.HasPrefix(version, "sha256:") {
+		image.Image = name + "@" + version
+	}
+	if values, err := url.ParseQuery(qualifiers); err == nil {
+		platform := strings.Split(values.Get("platform"), "/")
+		if len(platform) >= 2 {
+			image.OS, image.Architecture = platform[0], platform[1]
+		}
+	}
+	return image, true
+}
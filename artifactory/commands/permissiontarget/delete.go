@@ -1,7 +1,7 @@
 package permissiontarget
 
 import (
-	rtUtils "github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clientconfig"
 	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
 	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
 )
@@ -43,7 +43,7 @@ func (ptdc *PermissionTargetDeleteCommand) Run() (err error) {
 	if !ptdc.quiet && !coreutils.AskYesNo("Are you sure you want to permanently delete the permission target "+ptdc.permissionTargetName+"?", false) {
 		return nil
 	}
-	servicesManager, err := rtUtils.CreateServiceManager(ptdc.rtDetails, -1, 0, false)
+	servicesManager, err := clientconfig.CreateServiceManager(ptdc.rtDetails, -1, 0, false)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,157 @@
+package permissiontarget
+
+import (
+	"path/filepath"
+
+	artifactoryutils "github.com/jfrog/jfrog-cli-artifactory/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clientconfig"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/jfrog/jfrog-client-go/artifactory/services"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// PermissionTargetExportCommand writes the permission targets matching a name pattern to a YAML or JSON file, which can
+// be imported with the PermissionTargetImportCommand.
+type PermissionTargetExportCommand struct {
+	serverDetails *config.ServerDetails
+	namePattern   string
+	configPath    string
+}
+
+func NewPermissionTargetExportCommand() *PermissionTargetExportCommand {
+	return &PermissionTargetExportCommand{}
+}
+
+func (ptec *PermissionTargetExportCommand) SetNamePattern(namePattern string) *PermissionTargetExportCommand {
+	ptec.namePattern = namePattern
+	return ptec
+}
+
+// SetConfigPath sets the path of the exported file. The file is written as JSON if it has a .json extension, and as YAML otherwise.
+func (ptec *PermissionTargetExportCommand) SetConfigPath(configPath string) *PermissionTargetExportCommand {
+	ptec.configPath = configPath
+	return ptec
+}
+
+func (ptec *PermissionTargetExportCommand) SetServerDetails(serverDetails *config.ServerDetails) *PermissionTargetExportCommand {
+	ptec.serverDetails = serverDetails
+	return ptec
+}
+
+func (ptec *PermissionTargetExportCommand) ServerDetails() (*config.ServerDetails, error) {
+	return ptec.serverDetails, nil
+}
+
+func (ptec *PermissionTargetExportCommand) CommandName() string {
+	return "rt_permission_target_export"
+}
+
+func (ptec *PermissionTargetExportCommand) Run() error {
+	servicesManager, err := clientconfig.CreateServiceManager(ptec.serverDetails, -1, 0, false)
+	if err != nil {
+		return err
+	}
+	permissionTargets, err := getPermissionTargets(servicesManager, ptec.namePattern)
+	if err != nil {
+		return err
+	}
+	if err = artifactoryutils.WriteConfigFile(ptec.configPath, permissionTargets); err != nil {
+		return err
+	}
+	log.Info("Exported", len(permissionTargets), "permission targets to", ptec.configPath)
+	return nil
+}
+
+// Returns the full configurations of the permission targets matching the name pattern.
+func getPermissionTargets(servicesManager artifactory.ArtifactoryServicesManager, namePattern string) ([]services.PermissionTargetParams, error) {
+	allPermissionTargets, err := servicesManager.GetAllPermissionTargets()
+	if err != nil {
+		return nil, err
+	}
+	permissionTargets := []services.PermissionTargetParams{}
+	for _, permissionTarget := range *allPermissionTargets {
+		matched, err := filepath.Match(namePattern, permissionTarget.Name)
+		if err != nil {
+			return nil, errorutils.CheckError(err)
+		}
+		if !matched {
+			continue
+		}
+		// The list of the permission targets holds their names only.
+		details, err := servicesManager.GetPermissionTarget(permissionTarget.Name)
+		if err != nil {
+			return nil, err
+		}
+		if details != nil {
+			details.Uri = ""
+			permissionTargets = append(permissionTargets, *details)
+		}
+	}
+	return permissionTargets, nil
+}
+
+// PermissionTargetImportCommand creates or updates the permission targets of a YAML or JSON file.
+type PermissionTargetImportCommand struct {
+	serverDetails *config.ServerDetails
+	configPath    string
+}
+
+func NewPermissionTargetImportCommand() *PermissionTargetImportCommand {
+	return &PermissionTargetImportCommand{}
+}
+
+// SetConfigPath sets the path of a file holding a list of permission targets, in the format of the permissions REST API.
+func (ptic *PermissionTargetImportCommand) SetConfigPath(configPath string) *PermissionTargetImportCommand {
+	ptic.configPath = configPath
+	return ptic
+}
+
+func (ptic *PermissionTargetImportCommand) SetServerDetails(serverDetails *config.ServerDetails) *PermissionTargetImportCommand {
+	ptic.serverDetails = serverDetails
+	return ptic
+}
+
+func (ptic *PermissionTargetImportCommand) ServerDetails() (*config.ServerDetails, error) {
+	return ptic.serverDetails, nil
+}
+
+func (ptic *PermissionTargetImportCommand) CommandName() string {
+	return "rt_permission_target_import"
+}
+
+func (ptic *PermissionTargetImportCommand) Run() error {
+	var permissionTargets []services.PermissionTargetParams
+	if err := artifactoryutils.ReadConfigFile(ptic.configPath, &permissionTargets); err != nil {
+		return err
+	}
+	servicesManager, err := clientconfig.CreateServiceManager(ptic.serverDetails, -1, 0, false)
+	if err != nil {
+		return err
+	}
+	return importPermissionTargets(servicesManager, permissionTargets)
+}
+
+func importPermissionTargets(servicesManager artifactory.ArtifactoryServicesManager, permissionTargets []services.PermissionTargetParams) error {
+	for _, permissionTarget := range permissionTargets {
+		if permissionTarget.Name == "" {
+			return errorutils.CheckErrorf("each permission target must have a name")
+		}
+	}
+	for _, permissionTarget := range permissionTargets {
+		existing, err := servicesManager.GetPermissionTarget(permissionTarget.Name)
+		if err != nil {
+			return err
+		}
+		if existing == nil {
+			err = servicesManager.CreatePermissionTarget(permissionTarget)
+		} else {
+			err = servicesManager.UpdatePermissionTarget(permissionTarget)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
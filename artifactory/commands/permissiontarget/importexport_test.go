@@ -0,0 +1,83 @@
+package permissiontarget
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	rtUtils "github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/jfrog/jfrog-client-go/artifactory/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const permissionsApi = "/api/v2/security/permissions"
+
+func createPermissionsServer(t *testing.T, requests map[string]string) (*httptest.Server, artifactory.ArtifactoryServicesManager) {
+	permissionTargets := map[string]string{
+		"dev-readers":  `{"name":"dev-readers","repo":{"repositories":["dev-local"],"actions":{"groups":{"devs":["read"]}}},"uri":"http://localhost/dev-readers"}`,
+		"dev-writers":  `{"name":"dev-writers","repo":{"repositories":["dev-local"],"actions":{"groups":{"devs":["write"]}}},"uri":"http://localhost/dev-writers"}`,
+		"prod-readers": `{"name":"prod-readers","repo":{"repositories":["prod-local"],"actions":{"groups":{"ops":["read"]}}},"uri":"http://localhost/prod-readers"}`,
+	}
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		name := strings.TrimPrefix(strings.TrimPrefix(r.URL.Path, permissionsApi), "/")
+		switch {
+		case r.Method == http.MethodGet && name == "":
+			w.WriteHeader(http.StatusOK)
+			_, err := w.Write([]byte(`[{"name":"dev-readers"},{"name":"dev-writers"},{"name":"prod-readers"}]`))
+			assert.NoError(t, err)
+		case r.Method == http.MethodGet:
+			permissionTarget, exists := permissionTargets[name]
+			if !exists {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+			_, err := w.Write([]byte(permissionTarget))
+			assert.NoError(t, err)
+		default:
+			content, err := io.ReadAll(r.Body)
+			assert.NoError(t, err)
+			requests[r.Method+" "+name] = string(content)
+			w.WriteHeader(http.StatusOK)
+		}
+	}))
+	servicesManager, err := rtUtils.CreateServiceManager(&config.ServerDetails{ArtifactoryUrl: testServer.URL + "/"}, 0, 0, false)
+	require.NoError(t, err)
+	return testServer, servicesManager
+}
+
+func TestGetPermissionTargets(t *testing.T) {
+	testServer, servicesManager := createPermissionsServer(t, nil)
+	defer testServer.Close()
+
+	permissionTargets, err := getPermissionTargets(servicesManager, "dev-*")
+	require.NoError(t, err)
+	require.Len(t, permissionTargets, 2)
+	assert.Equal(t, "dev-readers", permissionTargets[0].Name)
+	assert.Equal(t, []string{"dev-local"}, permissionTargets[0].Repo.Repositories)
+	assert.Equal(t, map[string][]string{"devs": {"write"}}, permissionTargets[1].Repo.Actions.Groups)
+	// The URI is set by Artifactory, and isn't part of the configuration.
+	assert.Empty(t, permissionTargets[0].Uri)
+}
+
+func TestImportPermissionTargets(t *testing.T) {
+	requests := make(map[string]string)
+	testServer, servicesManager := createPermissionsServer(t, requests)
+	defer testServer.Close()
+
+	assert.ErrorContains(t, importPermissionTargets(servicesManager, []services.PermissionTargetParams{{}}), "must have a name")
+	assert.Empty(t, requests)
+
+	require.NoError(t, importPermissionTargets(servicesManager, []services.PermissionTargetParams{
+		{Name: "dev-readers", Repo: &services.PermissionTargetSection{Repositories: []string{"dev-local", "dev-remote"}}},
+		{Name: "qa-readers", Repo: &services.PermissionTargetSection{Repositories: []string{"qa-local"}}},
+	}))
+	assert.Len(t, requests, 2)
+	assert.JSONEq(t, `{"name":"dev-readers","repo":{"repositories":["dev-local","dev-remote"]}}`, requests[http.MethodPut+" dev-readers"])
+	assert.JSONEq(t, `{"name":"qa-readers","repo":{"repositories":["qa-local"]}}`, requests[http.MethodPost+" qa-readers"])
+}
@@ -3,8 +3,8 @@ package permissiontarget
 import (
 	"encoding/json"
 	"errors"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clientconfig"
 	"github.com/jfrog/jfrog-cli-core/v2/artifactory/commands/utils"
-	rtUtils "github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
 	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
 	"github.com/jfrog/jfrog-client-go/artifactory/services"
 	"github.com/jfrog/jfrog-client-go/utils/errorutils"
@@ -65,7 +65,7 @@ func (ptc *PermissionTargetCommand) PerformPermissionTargetCmd(isUpdate bool) (e
 	if errorutils.CheckError(err) != nil {
 		return err
 	}
-	servicesManager, err := rtUtils.CreateServiceManager(ptc.serverDetails, -1, 0, false)
+	servicesManager, err := clientconfig.CreateServiceManager(ptc.serverDetails, -1, 0, false)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,206 @@
+package pnpm
+
+import (
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/jfrog/build-info-go/entities"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	PnpmLockFileName = "pnpm-lock.yaml"
+	// The directory of the pnpm virtual store, into which the packages are installed.
+	defaultVirtualStoreDir = "node_modules/.pnpm"
+	rootImporter           = "."
+)
+
+// pnpmLockfile holds the fields of pnpm-lock.yaml which describe the dependency graph, in the lockfile versions 5 to 9.
+// Up to version 8, a single project lists its direct dependencies at the root of the lockfile, and the dependencies of
+// each package are listed under 'packages'. From version 9, the dependencies of each package are listed under 'snapshots'.
+type pnpmLockfile struct {
+	LockfileVersion      string                         `yaml:"lockfileVersion"`
+	Importers            map[string]*pnpmImporter       `yaml:"importers"`
+	Dependencies         map[string]pnpmImporterVersion `yaml:"dependencies"`
+	DevDependencies      map[string]pnpmImporterVersion `yaml:"devDependencies"`
+	OptionalDependencies map[string]pnpmImporterVersion `yaml:"optionalDependencies"`
+	Packages             map[string]*pnpmPackage        `yaml:"packages"`
+	Snapshots            map[string]*pnpmPackage        `yaml:"snapshots"`
+}
+
+type pnpmImporter struct {
+	Dependencies         map[string]pnpmImporterVersion `yaml:"dependencies"`
+	DevDependencies      map[string]pnpmImporterVersion `yaml:"devDependencies"`
+	OptionalDependencies map[string]pnpmImporterVersion `yaml:"optionalDependencies"`
+}
+
+// pnpmImporterVersion is the resolved version of a direct dependency. Up to lockfile version 5, the version is a plain
+// string. From version 6, it's an object with the specifier and the version.
+type pnpmImporterVersion string
+
+func (piv *pnpmImporterVersion) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind == yaml.ScalarNode {
+		*piv = pnpmImporterVersion(node.Value)
+		return nil
+	}
+	var dependency struct {
+		Version string `yaml:"version"`
+	}
+	if err := node.Decode(&dependency); err != nil {
+		return err
+	}
+	*piv = pnpmImporterVersion(dependency.Version)
+	return nil
+}
+
+type pnpmPackage struct {
+	Dependencies         map[string]string `yaml:"dependencies"`
+	OptionalDependencies map[string]string `yaml:"optionalDependencies"`
+}
+
+func readPnpmLockfile(projectDir string) (*pnpmLockfile, error) {
+	lockfilePath := filepath.Join(projectDir, PnpmLockFileName)
+	content, err := os.ReadFile(lockfilePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, errorutils.CheckErrorf("'%s' was not found. Run 'pnpm install' to create it", lockfilePath)
+		}
+		return nil, errorutils.CheckError(err)
+	}
+	lockfile := new(pnpmLockfile)
+	if err = yaml.Unmarshal(content, lockfile); err != nil {
+		return nil, errorutils.CheckErrorf("failed to parse '%s': %s", lockfilePath, err.Error())
+	}
+	return lockfile, nil
+}
+
+// getImporter returns the direct dependencies of the project in the lockfile. The importer is the path of the project
+// relative to the workspace root, which is '.' for the root project.
+func (pl *pnpmLockfile) getImporter(importer string) *pnpmImporter {
+	if project, ok := pl.Importers[importer]; ok {
+		return project
+	}
+	if importer != rootImporter {
+		return nil
+	}
+	return &pnpmImporter{Dependencies: pl.Dependencies, DevDependencies: pl.DevDependencies, OptionalDependencies: pl.OptionalDependencies}
+}
+
+// getPackageDependencies returns the dependencies of the package version. The version may have a suffix of the
+// resolved peer dependencies, such as 18.2.0(react@18.2.0).
+func (pl *pnpmLockfile) getPackageDependencies(name, fullVersion string) map[string]string {
+	key := name + "@" + fullVersion
+	// From lockfile version 9, the dependencies are listed under 'snapshots'. Until version 6, the keys start with '/',
+	// and in version 5 the version is separated by '/' rather than by '@'.
+	for _, pkg := range []*pnpmPackage{pl.Snapshots[key], pl.Packages[key], pl.Packages["/"+key], pl.Packages["/"+name+"/"+fullVersion]} {
+		if pkg == nil {
+			continue
+		}
+		dependencies := make(map[string]string, len(pkg.Dependencies)+len(pkg.OptionalDependencies))
+		for depName, depVersion := range pkg.Dependencies {
+			dependencies[depName] = depVersion
+		}
+		for depName, depVersion := range pkg.OptionalDependencies {
+			dependencies[depName] = depVersion
+		}
+		return dependencies
+	}
+	return nil
+}
+
+// parseDependencyVersion returns the name and version of a dependency from its name and version in the lockfile.
+// Local dependencies, such as workspace packages linked with 'link:', aren't resolved from a registry and return false.
+func parseDependencyVersion(name, lockfileVersion string) (depName, depVersion, fullVersion string, ok bool) {
+	if strings.HasPrefix(lockfileVersion, "link:") || strings.HasPrefix(lockfileVersion, "file:") {
+		return "", "", "", false
+	}
+	depName, fullVersion = name, lockfileVersion
+	// An aliased dependency is resolved to another package, such as 'string-width-cjs: string-width@4.2.3'.
+	// The version is followed by the resolved peer dependencies, in parentheses or, in lockfile version 5, after '_'.
+	// In lockfile version 5, the alias is the path of the package, such as '/@scope/lib/1.0.0'.
+	versionWithoutPeers := strings.Split(strings.Split(fullVersion, "(")[0], "_")[0]
+	if strings.HasPrefix(versionWithoutPeers, "/") {
+		index := strings.LastIndex(versionWithoutPeers, "/")
+		depName, fullVersion = fullVersion[1:index], fullVersion[index+1:]
+	} else if index := strings.LastIndex(versionWithoutPeers, "@"); index > 0 {
+		depName, fullVersion = fullVersion[:index], fullVersion[index+1:]
+	}
+	depVersion = strings.Split(strings.Split(fullVersion, "(")[0], "_")[0]
+	return depName, depVersion, fullVersion, true
+}
+
+// getDependencies returns the dependencies of the importer by their build-info IDs, with their scopes and the paths of
+// the dependencies which requested them. The module ID is the first element of each path.
+// installed returns true if a package version is installed, so that optional dependencies for other platforms, which
+// are listed in the lockfile but aren't installed, are skipped.
+func (pl *pnpmLockfile) getDependencies(importer, moduleId string, installed func(name, version string) bool) (map[string]*entities.Dependency, error) {
+	project := pl.getImporter(importer)
+	if project == nil {
+		return nil, errorutils.CheckErrorf("the project '%s' was not found in %s", importer, PnpmLockFileName)
+	}
+	dependencies := make(map[string]*entities.Dependency)
+	type queuedDependency struct {
+		name, fullVersion string
+		scope             string
+		requestedBy       []string
+	}
+	var queue []queuedDependency
+	for _, scoped := range []struct {
+		scope        string
+		dependencies map[string]pnpmImporterVersion
+	}{{"prod", project.Dependencies}, {"optional", project.OptionalDependencies}, {"dev", project.DevDependencies}} {
+		for _, name := range slices.Sorted(maps.Keys(scoped.dependencies)) {
+			queue = append(queue, queuedDependency{name: name, fullVersion: string(scoped.dependencies[name]), scope: scoped.scope, requestedBy: []string{moduleId}})
+		}
+	}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		name, version, fullVersion, ok := parseDependencyVersion(current.name, current.fullVersion)
+		if !ok || !installed(name, version) {
+			continue
+		}
+		id := name + ":" + version
+		if dependency, exists := dependencies[id]; exists {
+			if !slices.Contains(dependency.Scopes, current.scope) {
+				dependency.Scopes = append(dependency.Scopes, current.scope)
+			}
+			continue
+		}
+		dependencies[id] = &entities.Dependency{Id: id, Scopes: []string{current.scope}, RequestedBy: [][]string{current.requestedBy}}
+		requestedBy := append([]string{id}, current.requestedBy...)
+		packageDependencies := pl.getPackageDependencies(name, fullVersion)
+		for _, depName := range slices.Sorted(maps.Keys(packageDependencies)) {
+			queue = append(queue, queuedDependency{name: depName, fullVersion: packageDependencies[depName], scope: current.scope, requestedBy: requestedBy})
+		}
+	}
+	return dependencies, nil
+}
+
+// newVirtualStoreChecker returns a function which checks if a package version is installed in the pnpm virtual store.
+// If the virtual store doesn't exist, for example when only the lockfile is updated, all the packages are considered installed.
+func newVirtualStoreChecker(projectDir string) func(name, version string) bool {
+	virtualStoreDir := filepath.Join(projectDir, filepath.FromSlash(defaultVirtualStoreDir))
+	if _, err := os.Stat(virtualStoreDir); err != nil {
+		return func(string, string) bool { return true }
+	}
+	return func(name, version string) bool {
+		// The packages are installed in directories such as '@babel+core@7.24.0' or 'react-dom@18.2.0_react@18.2.0'.
+		prefix := strings.ReplaceAll(name, "/", "+") + "@" + version
+		matches, err := filepath.Glob(filepath.Join(virtualStoreDir, prefix+"*"))
+		if err != nil {
+			return true
+		}
+		for _, match := range matches {
+			suffix := strings.TrimPrefix(filepath.Base(match), prefix)
+			if suffix == "" || suffix[0] == '_' || suffix[0] == '(' {
+				return true
+			}
+		}
+		return false
+	}
+}
@@ -0,0 +1,212 @@
+package pnpm
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/jfrog/build-info-go/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const lockfileV6 = `lockfileVersion: '6.0'
+
+dependencies:
+  react-dom:
+    specifier: ^18.2.0
+    version: 18.2.0(react@18.2.0)
+  local-lib:
+    specifier: link:../local-lib
+    version: link:../local-lib
+
+devDependencies:
+  typescript:
+    specifier: ^5.0.0
+    version: 5.3.3
+
+packages:
+
+  /loose-envify@1.4.0:
+    resolution: {integrity: sha512-abc}
+    dependencies:
+      js-tokens: 4.0.0
+
+  /js-tokens@4.0.0:
+    resolution: {integrity: sha512-def}
+
+  /react-dom@18.2.0(react@18.2.0):
+    resolution: {integrity: sha512-ghi}
+    dependencies:
+      loose-envify: 1.4.0
+      react: 18.2.0
+
+  /react@18.2.0:
+    resolution: {integrity: sha512-jkl}
+    dependencies:
+      loose-envify: 1.4.0
+
+  /typescript@5.3.3:
+    resolution: {integrity: sha512-mno}
+`
+
+const lockfileV9 = `lockfileVersion: '9.0'
+
+importers:
+
+  .:
+    dependencies:
+      string-width-cjs:
+        specifier: npm:string-width@^4.2.0
+        version: string-width@4.2.3
+
+  packages/app:
+    dependencies:
+      '@scope/lib':
+        specifier: ^1.0.0
+        version: 1.0.0
+
+packages:
+
+  '@scope/lib@1.0.0':
+    resolution: {integrity: sha512-abc}
+
+  string-width@4.2.3:
+    resolution: {integrity: sha512-def}
+
+snapshots:
+
+  '@scope/lib@1.0.0':
+    dependencies:
+      string-width: 4.2.3
+
+  string-width@4.2.3: {}
+`
+
+func writeLockfile(t *testing.T, content string) string {
+	projectDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(projectDir, PnpmLockFileName), []byte(content), 0600))
+	return projectDir
+}
+
+func allInstalled(string, string) bool {
+	return true
+}
+
+func TestGetDependenciesLockfileV6(t *testing.T) {
+	lockfile, err := readPnpmLockfile(writeLockfile(t, lockfileV6))
+	require.NoError(t, err)
+	dependencies, err := lockfile.getDependencies(rootImporter, "my-app:1.0.0", allInstalled)
+	require.NoError(t, err)
+
+	assert.ElementsMatch(t, []string{"react-dom:18.2.0", "react:18.2.0", "loose-envify:1.4.0", "js-tokens:4.0.0", "typescript:5.3.3"}, getIds(dependencies))
+	assert.Equal(t, []string{"prod"}, dependencies["js-tokens:4.0.0"].Scopes)
+	assert.Equal(t, []string{"dev"}, dependencies["typescript:5.3.3"].Scopes)
+	assert.Equal(t, [][]string{{"loose-envify:1.4.0", "react-dom:18.2.0", "my-app:1.0.0"}}, dependencies["js-tokens:4.0.0"].RequestedBy)
+}
+
+func TestGetDependenciesLockfileV9(t *testing.T) {
+	lockfile, err := readPnpmLockfile(writeLockfile(t, lockfileV9))
+	require.NoError(t, err)
+
+	dependencies, err := lockfile.getDependencies(rootImporter, "root:1.0.0", allInstalled)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"string-width:4.2.3"}, getIds(dependencies))
+
+	dependencies, err = lockfile.getDependencies("packages/app", "app:1.0.0", allInstalled)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"@scope/lib:1.0.0", "string-width:4.2.3"}, getIds(dependencies))
+	assert.Equal(t, [][]string{{"@scope/lib:1.0.0", "app:1.0.0"}}, dependencies["string-width:4.2.3"].RequestedBy)
+
+	_, err = lockfile.getDependencies("packages/missing", "missing:1.0.0", allInstalled)
+	assert.Error(t, err)
+}
+
+func TestGetDependenciesSkipsNotInstalled(t *testing.T) {
+	lockfile, err := readPnpmLockfile(writeLockfile(t, lockfileV6))
+	require.NoError(t, err)
+	dependencies, err := lockfile.getDependencies(rootImporter, "my-app:1.0.0", func(name, _ string) bool { return name != "typescript" })
+	require.NoError(t, err)
+	assert.NotContains(t, dependencies, "typescript:5.3.3")
+	assert.Contains(t, dependencies, "react-dom:18.2.0")
+}
+
+func TestParseDependencyVersion(t *testing.T) {
+	testCases := []struct {
+		name, lockfileVersion                       string
+		expectedName, expectedVersion, expectedFull string
+		expectedOk                                  bool
+	}{
+		{"react", "18.2.0", "react", "18.2.0", "18.2.0", true},
+		{"react-dom", "18.2.0(react@18.2.0)", "react-dom", "18.2.0", "18.2.0(react@18.2.0)", true},
+		{"react-dom", "18.2.0_react@18.2.0", "react-dom", "18.2.0", "18.2.0_react@18.2.0", true},
+		{"string-width-cjs", "string-width@4.2.3", "string-width", "4.2.3", "4.2.3", true},
+		{"alias", "/@scope/lib/1.0.0", "@scope/lib", "1.0.0", "1.0.0", true},
+		{"lib", "link:../lib", "", "", "", false},
+		{"lib", "file:../lib.tgz", "", "", "", false},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name+"@"+testCase.lockfileVersion, func(t *testing.T) {
+			name, version, fullVersion, ok := parseDependencyVersion(testCase.name, testCase.lockfileVersion)
+			assert.Equal(t, testCase.expectedOk, ok)
+			assert.Equal(t, testCase.expectedName, name)
+			assert.Equal(t, testCase.expectedVersion, version)
+			assert.Equal(t, testCase.expectedFull, fullVersion)
+		})
+	}
+}
+
+func TestVirtualStoreChecker(t *testing.T) {
+	projectDir := t.TempDir()
+	// Without a virtual store, all the packages are considered installed.
+	assert.True(t, newVirtualStoreChecker(projectDir)("react", "18.2.0"))
+
+	virtualStoreDir := filepath.Join(projectDir, filepath.FromSlash(defaultVirtualStoreDir))
+	for _, dir := range []string{"react@18.2.0", "@babel+core@7.24.0", "react-dom@18.2.0_react@18.2.0"} {
+		require.NoError(t, os.MkdirAll(filepath.Join(virtualStoreDir, dir), 0755))
+	}
+	installed := newVirtualStoreChecker(projectDir)
+	assert.True(t, installed("react", "18.2.0"))
+	assert.True(t, installed("@babel/core", "7.24.0"))
+	assert.True(t, installed("react-dom", "18.2.0"))
+	assert.False(t, installed("react", "18.2.1"))
+	assert.False(t, installed("react", "18.2.0-rc.1"))
+	assert.False(t, installed("fsevents", "2.3.3"))
+}
+
+func TestInjectNpmrcRegistry(t *testing.T) {
+	projectDir := t.TempDir()
+	npmrc := "registry=https://registry.npmjs.org/\n@acme:registry=https://npm.acme.com/\nauto-install-peers=true\n"
+	require.NoError(t, os.WriteFile(filepath.Join(projectDir, npmrcFileName), []byte(npmrc), 0600))
+
+	registry := "https://acme.jfrog.io/artifactory/api/npm/npm-virtual"
+	require.NoError(t, injectNpmrcRegistry(projectDir, registry, "", "token"))
+	content, err := os.ReadFile(filepath.Join(projectDir, npmrcFileName))
+	require.NoError(t, err)
+	assert.Equal(t, "@acme:registry="+registry+"\nauto-install-peers=true\nregistry="+registry+"\n"+
+		"//acme.jfrog.io/artifactory/api/npm/npm-virtual/:_authToken=token\n", string(content))
+}
+
+func TestFindLockfile(t *testing.T) {
+	rootDir := writeLockfile(t, lockfileV9)
+	packageDir := filepath.Join(rootDir, "packages", "app")
+	require.NoError(t, os.MkdirAll(packageDir, 0755))
+
+	lockfileDir, importer, err := findLockfile(packageDir)
+	require.NoError(t, err)
+	assert.Equal(t, rootDir, lockfileDir)
+	assert.Equal(t, "packages/app", importer)
+
+	lockfileDir, importer, err = findLockfile(rootDir)
+	require.NoError(t, err)
+	assert.Equal(t, rootDir, lockfileDir)
+	assert.Equal(t, rootImporter, importer)
+}
+
+func getIds(dependencies map[string]*entities.Dependency) []string {
+	var ids []string
+	for id := range dependencies {
+		ids = append(ids, id)
+	}
+	return ids
+}
@@ -0,0 +1,260 @@
+package pnpm
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	biutils "github.com/jfrog/build-info-go/build/utils"
+	"github.com/jfrog/build-info-go/entities"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/commands/yarn"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clientconfig"
+	commandUtils "github.com/jfrog/jfrog-cli-core/v2/artifactory/commands/utils"
+	buildUtils "github.com/jfrog/jfrog-cli-core/v2/common/build"
+	"github.com/jfrog/jfrog-cli-core/v2/common/project"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/ioutils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const (
+	npmrcFileName       = ".npmrc"
+	npmrcBackupFileName = "jfrog.npmrc.backup"
+	defaultThreads      = 3
+)
+
+// PnpmCommand runs pnpm commands, such as 'pnpm install', with the dependencies resolved from an Artifactory npm
+// repository, and collects the dependencies of the project into the build-info from pnpm-lock.yaml.
+type PnpmCommand struct {
+	executablePath     string
+	workingDirectory   string
+	repo               string
+	configFilePath     string
+	pnpmArgs           []string
+	threads            int
+	serverDetails      *config.ServerDetails
+	buildConfiguration *buildUtils.BuildConfiguration
+}
+
+func NewPnpmCommand() *PnpmCommand {
+	return &PnpmCommand{}
+}
+
+func (pc *PnpmCommand) SetConfigFilePath(configFilePath string) *PnpmCommand {
+	pc.configFilePath = configFilePath
+	return pc
+}
+
+func (pc *PnpmCommand) SetArgs(args []string) *PnpmCommand {
+	pc.pnpmArgs = args
+	return pc
+}
+
+func (pc *PnpmCommand) ServerDetails() (*config.ServerDetails, error) {
+	return pc.serverDetails, nil
+}
+
+func (pc *PnpmCommand) CommandName() string {
+	return "rt_pnpm"
+}
+
+func (pc *PnpmCommand) Run() (err error) {
+	log.Info("Running pnpm...")
+	if err = pc.readConfigFile(); err != nil {
+		return
+	}
+	filteredPnpmArgs, threads, err := coreutils.ExtractThreadsFromArgs(pc.pnpmArgs, defaultThreads)
+	if err != nil {
+		return
+	}
+	pc.threads = threads
+	_, _, _, filteredPnpmArgs, pc.buildConfiguration, err = commandUtils.ExtractNpmOptionsFromArgs(filteredPnpmArgs)
+	if err != nil {
+		return
+	}
+	if err = pc.preparePrerequisites(); err != nil {
+		return
+	}
+
+	registry, npmAuthIdent, npmAuthToken, err := yarn.GetYarnAuthDetails(pc.serverDetails, pc.repo)
+	if err != nil {
+		return
+	}
+	restoreNpmrcFunc, err := ioutils.BackupFile(filepath.Join(pc.workingDirectory, npmrcFileName), npmrcBackupFileName)
+	if err != nil {
+		return
+	}
+	defer func() {
+		err = errors.Join(err, restoreNpmrcFunc())
+	}()
+	if err = injectNpmrcRegistry(pc.workingDirectory, registry, npmAuthIdent, npmAuthToken); err != nil {
+		return
+	}
+
+	pnpmCmd := exec.Command(pc.executablePath, filteredPnpmArgs...) // #nosec G204 -- the args are provided by the user.
+	pnpmCmd.Dir = pc.workingDirectory
+	pnpmCmd.Stdout, pnpmCmd.Stderr = os.Stdout, os.Stderr
+	if err = errorutils.CheckError(pnpmCmd.Run()); err != nil {
+		return
+	}
+
+	collectBuildInfo, err := pc.buildConfiguration.IsCollectBuildInfo()
+	if err != nil || !collectBuildInfo {
+		return
+	}
+	if err = pc.collectDependencies(); err != nil {
+		return
+	}
+	log.Info("pnpm finished successfully.")
+	return
+}
+
+func (pc *PnpmCommand) readConfigFile() error {
+	log.Debug("Preparing to read the config file", pc.configFilePath)
+	vConfig, err := project.ReadConfigFile(pc.configFilePath, project.YAML)
+	if err != nil {
+		return err
+	}
+	resolverParams, err := project.GetRepoConfigByPrefix(pc.configFilePath, project.ProjectConfigResolverPrefix, vConfig)
+	if err != nil {
+		return err
+	}
+	pc.repo = resolverParams.TargetRepo()
+	pc.serverDetails, err = resolverParams.ServerDetails()
+	return err
+}
+
+func (pc *PnpmCommand) preparePrerequisites() (err error) {
+	if pc.executablePath, err = exec.LookPath("pnpm"); err != nil {
+		return errorutils.CheckError(err)
+	}
+	log.Debug("Found pnpm executable at:", pc.executablePath)
+	pc.workingDirectory, err = coreutils.GetWorkingDirectory()
+	if err != nil {
+		return
+	}
+	log.Debug("Working directory set to:", pc.workingDirectory)
+	return
+}
+
+// collectDependencies adds the dependencies of the project, as resolved in pnpm-lock.yaml, to the build-info.
+// The lockfile is found in the working directory or, for a package of a pnpm workspace, in the workspace root.
+func (pc *PnpmCommand) collectDependencies() (err error) {
+	packageInfo, err := biutils.ReadPackageInfoFromPackageJsonIfExists(pc.workingDirectory, nil)
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	moduleId := pc.buildConfiguration.GetModule()
+	if moduleId == "" {
+		moduleId = packageInfo.BuildInfoModuleId()
+	}
+	lockfileDir, importer, err := findLockfile(pc.workingDirectory)
+	if err != nil {
+		return
+	}
+	lockfile, err := readPnpmLockfile(lockfileDir)
+	if err != nil {
+		return
+	}
+	dependenciesMap, err := lockfile.getDependencies(importer, moduleId, newVirtualStoreChecker(lockfileDir))
+	if err != nil {
+		return
+	}
+
+	servicesManager, err := clientconfig.CreateServiceManager(pc.serverDetails, -1, 0, false)
+	if err != nil {
+		return
+	}
+	buildName, err := pc.buildConfiguration.GetBuildName()
+	if err != nil {
+		return
+	}
+	buildNumber, err := pc.buildConfiguration.GetBuildNumber()
+	if err != nil {
+		return
+	}
+	previousBuildDependencies, err := yarn.GetDependenciesFromLatestBuild(servicesManager, buildName)
+	if err != nil {
+		return
+	}
+	var missingDependencies []string
+	missingDepsChan := make(chan string)
+	missingDepsDone := make(chan struct{})
+	go func() {
+		for depId := range missingDepsChan {
+			missingDependencies = append(missingDependencies, depId)
+		}
+		close(missingDepsDone)
+	}()
+	collectChecksumsFunc := yarn.CreateCollectChecksumsFunc(previousBuildDependencies, servicesManager, missingDepsChan)
+	buildInfoDependencies, err := biutils.TraverseDependencies(dependenciesMap, collectChecksumsFunc, pc.threads)
+	close(missingDepsChan)
+	<-missingDepsDone
+	if err != nil {
+		return
+	}
+	yarn.PrintMissingDependencies(missingDependencies)
+
+	pnpmBuild, err := buildUtils.CreateBuildInfoService().GetOrCreateBuildWithProject(buildName, buildNumber, pc.buildConfiguration.GetProject())
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	buildInfo := &entities.BuildInfo{Modules: []entities.Module{{Id: moduleId, Type: entities.Npm, Dependencies: buildInfoDependencies}}}
+	return errorutils.CheckError(pnpmBuild.SaveBuildInfo(buildInfo))
+}
+
+// findLockfile returns the directory of pnpm-lock.yaml, which is the working directory or one of its parents, and the
+// importer of the working directory in the lockfile.
+func findLockfile(workingDirectory string) (lockfileDir, importer string, err error) {
+	for lockfileDir = workingDirectory; ; lockfileDir = filepath.Dir(lockfileDir) {
+		if _, statErr := os.Stat(filepath.Join(lockfileDir, PnpmLockFileName)); statErr == nil {
+			break
+		}
+		if filepath.Dir(lockfileDir) == lockfileDir {
+			return "", "", errorutils.CheckErrorf("'%s' was not found in '%s' or in its parent directories. Run 'pnpm install' to create it", PnpmLockFileName, workingDirectory)
+		}
+	}
+	relPath, err := filepath.Rel(lockfileDir, workingDirectory)
+	if err != nil {
+		return "", "", errorutils.CheckError(err)
+	}
+	return lockfileDir, filepath.ToSlash(relPath), nil
+}
+
+// injectNpmrcRegistry sets Artifactory as the registry, and as the registry of each npm scope, in the .npmrc of the
+// working directory, which pnpm reads before the user and global configurations.
+func injectNpmrcRegistry(workingDirectory, registry, npmAuthIdent, npmAuthToken string) error {
+	npmrcPath := filepath.Join(workingDirectory, npmrcFileName)
+	content, err := os.ReadFile(npmrcPath)
+	if err != nil && !os.IsNotExist(err) {
+		return errorutils.CheckError(err)
+	}
+	var lines []string
+	for _, line := range strings.Split(string(content), "\n") {
+		key, _, _ := strings.Cut(strings.TrimSpace(line), "=")
+		key = strings.TrimSpace(key)
+		switch {
+		case key == "registry":
+			continue
+		case strings.HasPrefix(key, "@") && strings.HasSuffix(key, ":registry"):
+			lines = append(lines, key+"="+registry)
+		case line != "":
+			lines = append(lines, line)
+		}
+	}
+	lines = append(lines, "registry="+registry)
+	// The authentication is scoped to the registry URL without the scheme, such as //acme.jfrog.io/artifactory/api/npm/npm/:_authToken.
+	registryKey := strings.TrimSuffix(strings.TrimPrefix(strings.TrimPrefix(registry, "https:"), "http:"), "/") + "/"
+	if npmAuthToken != "" {
+		lines = append(lines, fmt.Sprintf("%s:%s=%s", registryKey, commandUtils.NpmConfigAuthTokenKey, npmAuthToken))
+	} else {
+		lines = append(lines, fmt.Sprintf("%s:%s=%s", registryKey, commandUtils.NpmConfigAuthKey, npmAuthIdent))
+	}
+	log.Debug("Setting the Artifactory registry in", npmrcPath)
+	return errorutils.CheckError(os.WriteFile(npmrcPath, []byte(strings.Join(lines, "\n")+"\n"), 0600))
+}
@@ -0,0 +1,130 @@
+package project
+
+import (
+	"net/http"
+	"net/url"
+	"reflect"
+
+	artifactoryutils "github.com/jfrog/jfrog-cli-artifactory/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	accessServices "github.com/jfrog/jfrog-client-go/access/services"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// ProjectApplyCommand creates or updates a project to match its configuration file: assigns the repositories to it,
+// creates or updates its custom roles, and grants the roles to its members. Members and repositories which aren't in
+// the file are kept.
+type ProjectApplyCommand struct {
+	serverDetails *config.ServerDetails
+	configPath    string
+}
+
+func NewProjectApplyCommand() *ProjectApplyCommand {
+	return &ProjectApplyCommand{}
+}
+
+func (pac *ProjectApplyCommand) SetConfigPath(configPath string) *ProjectApplyCommand {
+	pac.configPath = configPath
+	return pac
+}
+
+func (pac *ProjectApplyCommand) SetServerDetails(serverDetails *config.ServerDetails) *ProjectApplyCommand {
+	pac.serverDetails = serverDetails
+	return pac
+}
+
+func (pac *ProjectApplyCommand) ServerDetails() (*config.ServerDetails, error) {
+	return pac.serverDetails, nil
+}
+
+func (pac *ProjectApplyCommand) CommandName() string {
+	return "rt_project_apply"
+}
+
+func (pac *ProjectApplyCommand) Run() error {
+	projectConfig := &ProjectConfig{}
+	if err := artifactoryutils.ReadConfigFile(pac.configPath, projectConfig); err != nil {
+		return err
+	}
+	if err := projectConfig.validate(); err != nil {
+		return err
+	}
+	ac, err := newAccessClient(pac.serverDetails)
+	if err != nil {
+		return err
+	}
+	return applyProjectConfig(ac, projectConfig)
+}
+
+func applyProjectConfig(ac *accessClient, projectConfig *ProjectConfig) error {
+	projectKey := projectConfig.ProjectKey
+	existing, err := ac.GetProject(projectKey)
+	if err != nil {
+		return err
+	}
+	projectParams := accessServices.ProjectParams{ProjectDetails: projectConfig.toProject()}
+	if existing == nil {
+		log.Info("Creating project", projectKey)
+		err = ac.CreateProject(projectParams)
+	} else {
+		log.Info("Updating project", projectKey)
+		err = ac.UpdateProject(projectParams)
+	}
+	if err != nil {
+		return err
+	}
+	for _, repo := range projectConfig.Repositories {
+		if err = ac.AssignRepoToProject(repo, projectKey, false); err != nil {
+			return err
+		}
+	}
+	if err = applyProjectRoles(ac, projectKey, projectConfig.Roles); err != nil {
+		return err
+	}
+	for _, user := range projectConfig.Users {
+		log.Info("Granting the roles", user.Roles, "to the user", user.Name)
+		if err = ac.send(http.MethodPut, url.PathEscape(projectKey)+"/users/"+url.PathEscape(user.Name), user, nil); err != nil {
+			return err
+		}
+	}
+	for _, group := range projectConfig.Groups {
+		log.Info("Granting the roles", group.Roles, "to the group", group.Name)
+		if err = ac.UpdateGroupInProject(projectKey, group.Name, accessServices.ProjectGroup(group)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Creates the missing roles and updates the roles which differ from their configuration.
+func applyProjectRoles(ac *accessClient, projectKey string, roles []ProjectRole) error {
+	if len(roles) == 0 {
+		return nil
+	}
+	existingRoles, err := ac.getProjectRoles(projectKey)
+	if err != nil {
+		return err
+	}
+	existing := make(map[string]ProjectRole)
+	for _, role := range existingRoles {
+		existing[role.Name] = role
+	}
+	for _, role := range roles {
+		if role.Type == "" {
+			role.Type = CustomRoleType
+		}
+		existingRole, exists := existing[role.Name]
+		switch {
+		case !exists:
+			log.Info("Creating the role", role.Name)
+			err = ac.send(http.MethodPost, url.PathEscape(projectKey)+"/roles", role, nil)
+		case !reflect.DeepEqual(existingRole, role):
+			log.Info("Updating the role", role.Name)
+			err = ac.send(http.MethodPut, url.PathEscape(projectKey)+"/roles/"+url.PathEscape(role.Name), role, nil)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
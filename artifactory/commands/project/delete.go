@@ -0,0 +1,70 @@
+package project
+
+import (
+	artifactoryutils "github.com/jfrog/jfrog-cli-artifactory/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+)
+
+type ProjectDeleteCommand struct {
+	serverDetails *config.ServerDetails
+	projectKey    string
+	quiet         bool
+	dryRun        bool
+}
+
+func NewProjectDeleteCommand() *ProjectDeleteCommand {
+	return &ProjectDeleteCommand{}
+}
+
+func (pdc *ProjectDeleteCommand) SetProjectKey(projectKey string) *ProjectDeleteCommand {
+	pdc.projectKey = projectKey
+	return pdc
+}
+
+func (pdc *ProjectDeleteCommand) SetQuiet(quiet bool) *ProjectDeleteCommand {
+	pdc.quiet = quiet
+	return pdc
+}
+
+// SetDryRun sets the command to verify that the project exists, without deleting it.
+func (pdc *ProjectDeleteCommand) SetDryRun(dryRun bool) *ProjectDeleteCommand {
+	pdc.dryRun = dryRun
+	return pdc
+}
+
+func (pdc *ProjectDeleteCommand) SetServerDetails(serverDetails *config.ServerDetails) *ProjectDeleteCommand {
+	pdc.serverDetails = serverDetails
+	return pdc
+}
+
+func (pdc *ProjectDeleteCommand) ServerDetails() (*config.ServerDetails, error) {
+	return pdc.serverDetails, nil
+}
+
+func (pdc *ProjectDeleteCommand) CommandName() string {
+	return "rt_project_delete"
+}
+
+func (pdc *ProjectDeleteCommand) Run() error {
+	if !pdc.dryRun && !pdc.quiet && !coreutils.AskYesNo("Are you sure you want to permanently delete the project "+pdc.projectKey+"?", false) {
+		return nil
+	}
+	ac, err := newAccessClient(pdc.serverDetails)
+	if err != nil {
+		return err
+	}
+	if !pdc.dryRun {
+		return ac.DeleteProject(pdc.projectKey)
+	}
+	project, err := ac.GetProject(pdc.projectKey)
+	if err != nil {
+		return err
+	}
+	if project == nil {
+		return errorutils.CheckErrorf("the project '%s' doesn't exist", pdc.projectKey)
+	}
+	artifactoryutils.LogDryRun("Deleting the project", pdc.projectKey)
+	return nil
+}
@@ -0,0 +1,115 @@
+package project
+
+import (
+	"sort"
+
+	artifactoryutils "github.com/jfrog/jfrog-cli-artifactory/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clientconfig"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/jfrog/jfrog-client-go/artifactory/services"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// ProjectExportCommand writes the configuration of a project to a file, which can be applied with the ProjectApplyCommand.
+type ProjectExportCommand struct {
+	serverDetails *config.ServerDetails
+	projectKey    string
+	configPath    string
+}
+
+func NewProjectExportCommand() *ProjectExportCommand {
+	return &ProjectExportCommand{}
+}
+
+func (pec *ProjectExportCommand) SetProjectKey(projectKey string) *ProjectExportCommand {
+	pec.projectKey = projectKey
+	return pec
+}
+
+// SetConfigPath sets the path of the exported file. The file is written as JSON if it has a .json extension, and as YAML otherwise.
+func (pec *ProjectExportCommand) SetConfigPath(configPath string) *ProjectExportCommand {
+	pec.configPath = configPath
+	return pec
+}
+
+func (pec *ProjectExportCommand) SetServerDetails(serverDetails *config.ServerDetails) *ProjectExportCommand {
+	pec.serverDetails = serverDetails
+	return pec
+}
+
+func (pec *ProjectExportCommand) ServerDetails() (*config.ServerDetails, error) {
+	return pec.serverDetails, nil
+}
+
+func (pec *ProjectExportCommand) CommandName() string {
+	return "rt_project_export"
+}
+
+func (pec *ProjectExportCommand) Run() error {
+	ac, err := newAccessClient(pec.serverDetails)
+	if err != nil {
+		return err
+	}
+	servicesManager, err := clientconfig.CreateServiceManager(pec.serverDetails, -1, 0, false)
+	if err != nil {
+		return err
+	}
+	projectConfig, err := exportProjectConfig(ac, servicesManager, pec.projectKey)
+	if err != nil {
+		return err
+	}
+	if err = artifactoryutils.WriteConfigFile(pec.configPath, projectConfig); err != nil {
+		return err
+	}
+	log.Info("Exported the configuration of project", pec.projectKey, "to", pec.configPath)
+	return nil
+}
+
+func exportProjectConfig(ac *accessClient, servicesManager artifactory.ArtifactoryServicesManager, projectKey string) (*ProjectConfig, error) {
+	project, err := ac.GetProject(projectKey)
+	if err != nil {
+		return nil, err
+	}
+	if project == nil {
+		return nil, errorutils.CheckErrorf("the project '%s' doesn't exist", projectKey)
+	}
+	projectConfig := &ProjectConfig{
+		ProjectKey:        projectKey,
+		DisplayName:       project.DisplayName,
+		Description:       project.Description,
+		AdminPrivileges:   project.AdminPrivileges,
+		StorageQuotaBytes: project.StorageQuotaBytes,
+	}
+	repos, err := servicesManager.GetAllRepositoriesFiltered(services.RepositoriesFilterParams{ProjectKey: projectKey})
+	if err != nil {
+		return nil, err
+	}
+	for _, repo := range *repos {
+		projectConfig.Repositories = append(projectConfig.Repositories, repo.Key)
+	}
+	sort.Strings(projectConfig.Repositories)
+	roles, err := ac.getProjectRoles(projectKey)
+	if err != nil {
+		return nil, err
+	}
+	for _, role := range roles {
+		if role.Type == CustomRoleType {
+			projectConfig.Roles = append(projectConfig.Roles, role)
+		}
+	}
+	if projectConfig.Users, err = ac.getProjectUsers(projectKey); err != nil {
+		return nil, err
+	}
+	groups, err := ac.GetProjectsGroups(projectKey)
+	if err != nil {
+		return nil, err
+	}
+	if groups != nil {
+		for _, group := range *groups {
+			projectConfig.Groups = append(projectConfig.Groups, ProjectMember(group))
+		}
+	}
+	return projectConfig, nil
+}
@@ -0,0 +1,141 @@
+package project
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+
+	artifactoryutils "github.com/jfrog/jfrog-cli-artifactory/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clierrors"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/access"
+	accessServices "github.com/jfrog/jfrog-client-go/access/services"
+	"github.com/jfrog/jfrog-client-go/auth"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+)
+
+const (
+	projectsApi = "api/v1/projects/"
+	// The type of the roles defined by the project, rather than by the platform.
+	CustomRoleType = "CUSTOM"
+)
+
+// ProjectConfig is the configuration of a JFrog Project, its repositories, roles and members, as kept in a YAML or JSON file.
+type ProjectConfig struct {
+	ProjectKey        string                          `json:"projectKey"`
+	DisplayName       string                          `json:"displayName"`
+	Description       string                          `json:"description,omitempty"`
+	AdminPrivileges   *accessServices.AdminPrivileges `json:"adminPrivileges,omitempty"`
+	StorageQuotaBytes float64                         `json:"storageQuotaBytes,omitempty"`
+	Repositories      []string                        `json:"repositories,omitempty"`
+	// The custom roles of the project. The platform roles, such as Developer, can be granted without being listed.
+	Roles  []ProjectRole   `json:"roles,omitempty"`
+	Users  []ProjectMember `json:"users,omitempty"`
+	Groups []ProjectMember `json:"groups,omitempty"`
+}
+
+type ProjectRole struct {
+	Name         string   `json:"name"`
+	Description  string   `json:"description,omitempty"`
+	Type         string   `json:"type,omitempty"`
+	Environments []string `json:"environments,omitempty"`
+	Actions      []string `json:"actions"`
+}
+
+type ProjectMember struct {
+	Name  string   `json:"name"`
+	Roles []string `json:"roles"`
+}
+
+func (pc *ProjectConfig) validate() error {
+	if pc.ProjectKey == "" || pc.DisplayName == "" {
+		return errorutils.CheckErrorf("the project configuration must have a projectKey and a displayName")
+	}
+	for _, role := range pc.Roles {
+		if role.Name == "" || len(role.Actions) == 0 {
+			return errorutils.CheckErrorf("each role of the project '%s' must have a name and actions", pc.ProjectKey)
+		}
+	}
+	for _, member := range append(append([]ProjectMember{}, pc.Users...), pc.Groups...) {
+		if member.Name == "" || len(member.Roles) == 0 {
+			return errorutils.CheckErrorf("each member of the project '%s' must have a name and roles", pc.ProjectKey)
+		}
+	}
+	return nil
+}
+
+func (pc *ProjectConfig) toProject() accessServices.Project {
+	return accessServices.Project{
+		ProjectKey:        pc.ProjectKey,
+		DisplayName:       pc.DisplayName,
+		Description:       pc.Description,
+		AdminPrivileges:   pc.AdminPrivileges,
+		StorageQuotaBytes: pc.StorageQuotaBytes,
+	}
+}
+
+// accessClient manages projects with the Access services manager, and with the projects API for the endpoints which
+// aren't covered by the manager.
+type accessClient struct {
+	*access.AccessServicesManager
+	serviceDetails auth.ServiceDetails
+}
+
+func newAccessClient(serverDetails *config.ServerDetails) (*accessClient, error) {
+	accessManager, serviceDetails, err := artifactoryutils.CreateAccessServiceManager(serverDetails)
+	if err != nil {
+		return nil, err
+	}
+	return &accessClient{AccessServicesManager: accessManager, serviceDetails: serviceDetails}, nil
+}
+
+// send sends a request to the projects API, and unmarshals the response into the result, if not nil.
+func (ac *accessClient) send(method, restApi string, body, result any) error {
+	httpClientDetails := ac.serviceDetails.CreateHttpClientDetails()
+	httpClientDetails.SetContentTypeApplicationJson()
+	requestUrl := ac.serviceDetails.GetUrl() + projectsApi + restApi
+	var (
+		content, responseBody []byte
+		resp                  *http.Response
+		err                   error
+	)
+	if body != nil {
+		if content, err = json.Marshal(body); err != nil {
+			return errorutils.CheckError(err)
+		}
+	}
+	switch method {
+	case http.MethodGet:
+		resp, responseBody, _, err = ac.Client().SendGet(requestUrl, true, &httpClientDetails)
+	case http.MethodPost:
+		resp, responseBody, err = ac.Client().SendPost(requestUrl, content, &httpClientDetails)
+	case http.MethodPut:
+		resp, responseBody, err = ac.Client().SendPut(requestUrl, content, &httpClientDetails)
+	default:
+		resp, responseBody, err = ac.Client().SendDelete(requestUrl, content, &httpClientDetails)
+	}
+	if err != nil {
+		return err
+	}
+	if err = clierrors.CheckResponseStatusWithBody(resp, responseBody, http.StatusOK, http.StatusCreated, http.StatusNoContent); err != nil {
+		return err
+	}
+	if result == nil {
+		return nil
+	}
+	return errorutils.CheckError(json.Unmarshal(responseBody, result))
+}
+
+func (ac *accessClient) getProjectRoles(projectKey string) ([]ProjectRole, error) {
+	var roles []ProjectRole
+	err := ac.send(http.MethodGet, url.PathEscape(projectKey)+"/roles", nil, &roles)
+	return roles, err
+}
+
+func (ac *accessClient) getProjectUsers(projectKey string) ([]ProjectMember, error) {
+	var users struct {
+		Members []ProjectMember `json:"members"`
+	}
+	err := ac.send(http.MethodGet, url.PathEscape(projectKey)+"/users", nil, &users)
+	return users.Members, err
+}
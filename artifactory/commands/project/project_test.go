@@ -0,0 +1,65 @@
+package project
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateProjectConfig(t *testing.T) {
+	assert.ErrorContains(t, (&ProjectConfig{ProjectKey: "acme"}).validate(), "must have a projectKey and a displayName")
+	assert.ErrorContains(t, (&ProjectConfig{ProjectKey: "acme", DisplayName: "Acme", Roles: []ProjectRole{{Name: "reader"}}}).validate(), "must have a name and actions")
+	assert.ErrorContains(t, (&ProjectConfig{ProjectKey: "acme", DisplayName: "Acme", Groups: []ProjectMember{{Name: "devs"}}}).validate(), "must have a name and roles")
+	assert.NoError(t, (&ProjectConfig{ProjectKey: "acme", DisplayName: "Acme", Users: []ProjectMember{{Name: "admin", Roles: []string{"Project Admin"}}}}).validate())
+}
+
+func TestApplyProjectConfig(t *testing.T) {
+	requests := make(map[string]string)
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/access/api/v1/projects/acme":
+			w.WriteHeader(http.StatusNotFound)
+		case r.Method == http.MethodGet && r.URL.Path == "/access/api/v1/projects/acme/roles":
+			w.WriteHeader(http.StatusOK)
+			_, err := w.Write([]byte(`[{"name":"Developer","type":"PREDEFINED","actions":["READ_REPOSITORY"]},` +
+				`{"name":"reader","type":"CUSTOM","actions":["READ_REPOSITORY"]},` +
+				`{"name":"deployer","type":"CUSTOM","actions":["READ_REPOSITORY"]}]`))
+			assert.NoError(t, err)
+		default:
+			content, err := io.ReadAll(r.Body)
+			assert.NoError(t, err)
+			requests[r.Method+" "+r.URL.Path] = string(content)
+			w.WriteHeader(http.StatusOK)
+		}
+	}))
+	defer testServer.Close()
+	ac, err := newAccessClient(&config.ServerDetails{ArtifactoryUrl: testServer.URL + "/artifactory/"})
+	require.NoError(t, err)
+
+	projectConfig := &ProjectConfig{
+		ProjectKey:   "acme",
+		DisplayName:  "Acme",
+		Repositories: []string{"acme-generic-local"},
+		Roles: []ProjectRole{
+			{Name: "reader", Actions: []string{"READ_REPOSITORY"}},
+			{Name: "deployer", Actions: []string{"READ_REPOSITORY", "DEPLOY_CACHE_REPOSITORY"}},
+			{Name: "auditor", Environments: []string{"PROD"}, Actions: []string{"READ_BUILD"}},
+		},
+		Users:  []ProjectMember{{Name: "alice", Roles: []string{"reader"}}},
+		Groups: []ProjectMember{{Name: "devs", Roles: []string{"Developer", "deployer"}}},
+	}
+	require.NoError(t, applyProjectConfig(ac, projectConfig))
+	assert.Len(t, requests, 6)
+	assert.JSONEq(t, `{"project_key":"acme","display_name":"Acme"}`, requests["POST /access/api/v1/projects"])
+	assert.Contains(t, requests, "PUT /access/api/v1/projects/_/attach/repositories/acme-generic-local/acme")
+	// The reader role is unchanged, the deployer role is updated and the auditor role is created.
+	assert.JSONEq(t, `{"name":"deployer","type":"CUSTOM","actions":["READ_REPOSITORY","DEPLOY_CACHE_REPOSITORY"]}`, requests["PUT /access/api/v1/projects/acme/roles/deployer"])
+	assert.JSONEq(t, `{"name":"auditor","type":"CUSTOM","environments":["PROD"],"actions":["READ_BUILD"]}`, requests["POST /access/api/v1/projects/acme/roles"])
+	assert.JSONEq(t, `{"name":"alice","roles":["reader"]}`, requests["PUT /access/api/v1/projects/acme/users/alice"])
+	assert.JSONEq(t, `{"name":"devs","roles":["Developer","deployer"]}`, requests["PUT /access/api/v1/projects/acme/groups/devs"])
+}
@@ -0,0 +1,318 @@
+// Package provenance aggregates where an artifact came from and where it went: the builds which produced it and depend
+// on it, the release bundles which hold it, its evidence and its promotions.
+package provenance
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/formats"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clientconfig"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clierrors"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/jfrog/jfrog-client-go/onemodel"
+	clientutils "github.com/jfrog/jfrog-client-go/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+var (
+	sha1Regexp   = regexp.MustCompile(`^[0-9a-fA-F]{40}$`)
+	sha256Regexp = regexp.MustCompile(`^[0-9a-fA-F]{64}$`)
+)
+
+// The suffix of the repositories which hold the artifacts of release bundles v2.
+const releaseBundlesRepoSuffix = "release-bundles-v2"
+
+// Provenance is the aggregated provenance of the artifacts with the same content. Failures to query the optional
+// sources, such as the evidence, are reported in the errors rather than failing the query.
+type Provenance struct {
+	Query          string             `json:"query"`
+	Sha1           string             `json:"sha1"`
+	Sha256         string             `json:"sha256,omitempty"`
+	Artifacts      []ArtifactLocation `json:"artifacts"`
+	ProducedBy     []BuildReference   `json:"producedBy"`
+	UsedBy         []BuildReference   `json:"usedBy"`
+	ReleaseBundles []ReleaseBundleRef `json:"releaseBundles"`
+	Errors         []string           `json:"errors,omitempty"`
+}
+
+// ArtifactLocation is a repository path which holds the artifact, and the evidence attached to it.
+type ArtifactLocation struct {
+	RepoPath string              `json:"repoPath"`
+	Evidence []EvidenceReference `json:"evidence"`
+}
+
+type EvidenceReference struct {
+	Path          string `json:"path"`
+	PredicateType string `json:"predicateType"`
+	Verified      bool   `json:"verified"`
+	CreatedBy     string `json:"createdBy,omitempty"`
+	CreatedAt     string `json:"createdAt,omitempty"`
+}
+
+type BuildReference struct {
+	Name    string `json:"name"`
+	Number  string `json:"number"`
+	Started string `json:"started,omitempty"`
+	// The promotion history of the build, which the artifact was promoted with. Only the builds which produced the
+	// artifact have it.
+	Promotions []PromotionRecord `json:"promotions,omitempty"`
+}
+
+type PromotionRecord struct {
+	Status     string `json:"status"`
+	Repository string `json:"repository,omitempty"`
+	Comment    string `json:"comment,omitempty"`
+	User       string `json:"user,omitempty"`
+	Timestamp  string `json:"timestamp"`
+}
+
+type ReleaseBundleRef struct {
+	Name     string `json:"name"`
+	Version  string `json:"version"`
+	RepoPath string `json:"repoPath"`
+}
+
+// WhenceCommand reports the provenance of an artifact, given by its repository path or by its SHA-1 or SHA-256
+// checksum. Since the builds reference their artifacts and dependencies by checksum, all the artifacts with the same
+// content share the provenance, so all their locations are reported.
+type WhenceCommand struct {
+	serverDetails   *config.ServerDetails
+	query           string
+	format          string
+	evidenceQuerier onemodel.Manager
+}
+
+func NewWhenceCommand() *WhenceCommand {
+	return &WhenceCommand{}
+}
+
+func (wc *WhenceCommand) SetServerDetails(serverDetails *config.ServerDetails) *WhenceCommand {
+	wc.serverDetails = serverDetails
+	return wc
+}
+
+// SetQuery sets the repository path or the checksum of the artifact.
+func (wc *WhenceCommand) SetQuery(query string) *WhenceCommand {
+	wc.query = query
+	return wc
+}
+
+func (wc *WhenceCommand) SetFormat(format string) *WhenceCommand {
+	wc.format = format
+	return wc
+}
+
+func (wc *WhenceCommand) ServerDetails() (*config.ServerDetails, error) {
+	return wc.serverDetails, nil
+}
+
+func (wc *WhenceCommand) CommandName() string {
+	return "rt_whence"
+}
+
+func (wc *WhenceCommand) Run() error {
+	outputFormat, err := formats.ParseFormat(wc.format)
+	if err != nil {
+		return err
+	}
+	servicesManager, err := clientconfig.CreateServiceManager(wc.serverDetails, -1, 0, false)
+	if err != nil {
+		return err
+	}
+	if wc.evidenceQuerier == nil {
+		onemodelDetails := *wc.serverDetails
+		if onemodelDetails.OnemodelUrl == "" {
+			onemodelDetails.OnemodelUrl = clientutils.AddTrailingSlashIfNeeded(wc.serverDetails.Url) + "onemodel/"
+		}
+		if wc.evidenceQuerier, err = clientconfig.CreateOnemodelServiceManager(&onemodelDetails, false); err != nil {
+			return err
+		}
+	}
+	provenance, err := whence(servicesManager, wc.evidenceQuerier, wc.query)
+	if err != nil {
+		return err
+	}
+	if outputFormat == "" {
+		outputFormat = formats.JsonFormat
+	}
+	return formats.Print(outputFormat, formats.ProvenanceKind, provenance)
+}
+
+func whence(servicesManager artifactory.ArtifactoryServicesManager, evidenceQuerier onemodel.Manager, query string) (*Provenance, error) {
+	provenance := &Provenance{Query: query, Artifacts: []ArtifactLocation{}, ProducedBy: []BuildReference{}, UsedBy: []BuildReference{}, ReleaseBundles: []ReleaseBundleRef{}}
+	checksumField, checksum := "", strings.ToLower(query)
+	switch {
+	case sha1Regexp.MatchString(query):
+		checksumField = "actual_sha1"
+	case sha256Regexp.MatchString(query):
+		checksumField = "sha256"
+	default:
+		fileInfo, err := servicesManager.FileInfo(strings.TrimPrefix(query, "/"))
+		if err != nil {
+			return nil, err
+		}
+		checksumField, checksum = "actual_sha1", fileInfo.Checksums.Sha1
+	}
+	items, err := findItems(servicesManager, checksumField, checksum)
+	if err != nil {
+		return nil, err
+	}
+	if len(items) == 0 {
+		return nil, errorutils.CheckErrorf("no artifact was found with the %s checksum %s", checksumField, checksum)
+	}
+	provenance.Sha1, provenance.Sha256 = items[0].ActualSha1, items[0].Sha256
+	for _, item := range items {
+		repoPath := path.Join(item.Repo, item.Path, item.Name)
+		if strings.HasSuffix(item.Repo, releaseBundlesRepoSuffix) {
+			// The artifacts of a release bundle are held under <name>/<version>/.
+			if parts := strings.SplitN(item.Path, "/", 3); len(parts) >= 2 {
+				provenance.ReleaseBundles = append(provenance.ReleaseBundles, ReleaseBundleRef{Name: parts[0], Version: parts[1], RepoPath: repoPath})
+			}
+			continue
+		}
+		evidence, err := getArtifactEvidence(evidenceQuerier, item)
+		if err != nil {
+			provenance.Errors = append(provenance.Errors, fmt.Sprintf("failed to get the evidence of %s: %s", repoPath, err.Error()))
+		}
+		provenance.Artifacts = append(provenance.Artifacts, ArtifactLocation{RepoPath: repoPath, Evidence: evidence})
+	}
+	if provenance.ProducedBy, err = findBuilds(servicesManager, "artifact", provenance.Sha1); err != nil {
+		return nil, err
+	}
+	if provenance.UsedBy, err = findBuilds(servicesManager, "dependency", provenance.Sha1); err != nil {
+		return nil, err
+	}
+	for i := range provenance.ProducedBy {
+		build := &provenance.ProducedBy[i]
+		if build.Promotions, err = getPromotions(servicesManager, build.Name, build.Number); err != nil {
+			provenance.Errors = append(provenance.Errors, fmt.Sprintf("failed to get the promotions of build %s/%s: %s", build.Name, build.Number, err.Error()))
+		}
+	}
+	log.Debug(fmt.Sprintf("Found %d locations, %d producing builds and %d dependent builds of %s", len(items), len(provenance.ProducedBy), len(provenance.UsedBy), query))
+	return provenance, nil
+}
+
+type provenanceItem struct {
+	Repo       string `json:"repo"`
+	Path       string `json:"path"`
+	Name       string `json:"name"`
+	ActualSha1 string `json:"actual_sha1"`
+	Sha256     string `json:"sha256"`
+}
+
+func findItems(servicesManager artifactory.ArtifactoryServicesManager, checksumField, checksum string) ([]provenanceItem, error) {
+	query := fmt.Sprintf(`items.find({%q:%q}).include("repo","path","name","actual_sha1","sha256")`, checksumField, checksum)
+	var result struct {
+		Results []provenanceItem `json:"results"`
+	}
+	if err := runAql(servicesManager, query, &result); err != nil {
+		return nil, err
+	}
+	return result.Results, nil
+}
+
+// Returns the builds with an artifact or a dependency, by the module field, with the checksum.
+func findBuilds(servicesManager artifactory.ArtifactoryServicesManager, moduleField, sha1 string) ([]BuildReference, error) {
+	query := fmt.Sprintf(`builds.find({"module.%s.sha1":%q}).include("name","number","started")`, moduleField, sha1)
+	var result struct {
+		Results []struct {
+			Name    string `json:"build.name"`
+			Number  string `json:"build.number"`
+			Started string `json:"build.started"`
+		} `json:"results"`
+	}
+	if err := runAql(servicesManager, query, &result); err != nil {
+		return nil, err
+	}
+	builds := []BuildReference{}
+	for _, build := range result.Results {
+		builds = append(builds, BuildReference{Name: build.Name, Number: build.Number, Started: build.Started})
+	}
+	return builds, nil
+}
+
+func runAql(servicesManager artifactory.ArtifactoryServicesManager, query string, result any) (err error) {
+	reader, err := servicesManager.Aql(query)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = errors.Join(err, errorutils.CheckError(reader.Close()))
+	}()
+	if err = json.NewDecoder(reader).Decode(result); err != nil {
+		return errorutils.CheckErrorf("failed to parse the results of the AQL query: %s", err.Error())
+	}
+	return nil
+}
+
+// Returns the promotion history of the build, which the build-info holds as its statuses.
+func getPromotions(servicesManager artifactory.ArtifactoryServicesManager, buildName, buildNumber string) ([]PromotionRecord, error) {
+	serviceDetails := servicesManager.GetConfig().GetServiceDetails()
+	httpClientDetails := serviceDetails.CreateHttpClientDetails()
+	buildUrl := serviceDetails.GetUrl() + "api/build/" + url.PathEscape(buildName) + "/" + url.PathEscape(buildNumber)
+	resp, body, _, err := servicesManager.Client().SendGet(buildUrl, true, &httpClientDetails)
+	if err != nil {
+		return nil, err
+	}
+	if err = clierrors.CheckResponseStatusWithBody(resp, body, http.StatusOK); err != nil {
+		return nil, err
+	}
+	var buildInfo struct {
+		BuildInfo struct {
+			Statuses []PromotionRecord `json:"statuses"`
+		} `json:"buildInfo"`
+	}
+	if err = json.Unmarshal(body, &buildInfo); err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	return buildInfo.BuildInfo.Statuses, nil
+}
+
+type evidenceResponse struct {
+	Data struct {
+		Evidence struct {
+			SearchEvidence struct {
+				Edges []struct {
+					Node EvidenceReference `json:"node"`
+				} `json:"edges"`
+			} `json:"searchEvidence"`
+		} `json:"evidence"`
+	} `json:"data"`
+	Errors []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+func getArtifactEvidence(querier onemodel.Manager, item provenanceItem) ([]EvidenceReference, error) {
+	evidence := []EvidenceReference{}
+	query := fmt.Sprintf("{ evidence { searchEvidence(where: { hasSubjectWith: { repositoryKey: %q, path: %q, name: %q } }) "+
+		"{ edges { node { path predicateType verified createdBy createdAt } } } } }", item.Repo, item.Path, item.Name)
+	content, err := json.Marshal(map[string]string{"query": query})
+	if err != nil {
+		return evidence, errorutils.CheckError(err)
+	}
+	body, err := querier.GraphqlQuery(content)
+	if err != nil {
+		return evidence, err
+	}
+	var response evidenceResponse
+	if err = json.Unmarshal(body, &response); err != nil {
+		return evidence, errorutils.CheckError(err)
+	}
+	if len(response.Errors) > 0 {
+		return evidence, errorutils.CheckErrorf("%s", response.Errors[0].Message)
+	}
+	for _, edge := range response.Data.Evidence.SearchEvidence.Edges {
+		evidence = append(evidence, edge.Node)
+	}
+	return evidence, nil
+}
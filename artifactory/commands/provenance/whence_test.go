@@ -0,0 +1,88 @@
+package provenance
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clientconfig"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testSha1   = "0123456789abcdef0123456789abcdef01234567"
+	testSha256 = "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+)
+
+type fakeEvidenceQuerier struct {
+	queries []string
+}
+
+func (f *fakeEvidenceQuerier) GraphqlQuery(query []byte) ([]byte, error) {
+	f.queries = append(f.queries, string(query))
+	return []byte(`{"data":{"evidence":{"searchEvidence":{"edges":[{"node":{"path":"libs-release/org/app/1.0/.evidence/sbom.json","predicateType":"https://cyclonedx.org/bom","verified":true}}]}}}}`), nil
+}
+
+func TestWhence(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var response string
+		switch r.URL.Path {
+		case "/api/storage/libs-release/org/app/1.0/app-1.0.jar":
+			response = `{"repo":"libs-release","checksums":{"sha1":"` + testSha1 + `","sha256":"` + testSha256 + `"}}`
+		case "/api/search/aql":
+			content, err := io.ReadAll(r.Body)
+			assert.NoError(t, err)
+			query := string(content)
+			switch {
+			case strings.HasPrefix(query, `items.find({"actual_sha1":"`+testSha1+`"})`):
+				response = `{"results":[
+					{"repo":"libs-release","path":"org/app/1.0","name":"app-1.0.jar","actual_sha1":"` + testSha1 + `","sha256":"` + testSha256 + `"},
+					{"repo":"release-bundles-v2","path":"app-bundle/1.0/artifacts/libs-release/org/app/1.0","name":"app-1.0.jar","actual_sha1":"` + testSha1 + `","sha256":"` + testSha256 + `"}]}`
+			case strings.HasPrefix(query, `builds.find({"module.artifact.sha1":"`+testSha1+`"})`):
+				response = `{"results":[{"build.name":"app","build.number":"7","build.started":"2026-01-01T12:00:00.000+0000"}]}`
+			case strings.HasPrefix(query, `builds.find({"module.dependency.sha1":"`+testSha1+`"})`):
+				response = `{"results":[{"build.name":"service","build.number":"12"}]}`
+			default:
+				t.Errorf("unexpected query %s", query)
+			}
+		case "/api/build/app/7":
+			response = `{"buildInfo":{"name":"app","number":"7","statuses":[{"status":"released","repository":"libs-release","user":"admin","timestamp":"2026-01-02T08:00:00.000+0000"}]}}`
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, err := w.Write([]byte(response))
+		assert.NoError(t, err)
+	}))
+	defer testServer.Close()
+	servicesManager, err := clientconfig.CreateServiceManager(&config.ServerDetails{ArtifactoryUrl: testServer.URL + "/"}, 0, 0, false)
+	require.NoError(t, err)
+	querier := &fakeEvidenceQuerier{}
+
+	provenance, err := whence(servicesManager, querier, "libs-release/org/app/1.0/app-1.0.jar")
+	require.NoError(t, err)
+	assert.Equal(t, testSha1, provenance.Sha1)
+	assert.Equal(t, testSha256, provenance.Sha256)
+	require.Len(t, provenance.Artifacts, 1)
+	assert.Equal(t, "libs-release/org/app/1.0/app-1.0.jar", provenance.Artifacts[0].RepoPath)
+	assert.Equal(t, []EvidenceReference{{Path: "libs-release/org/app/1.0/.evidence/sbom.json", PredicateType: "https://cyclonedx.org/bom", Verified: true}}, provenance.Artifacts[0].Evidence)
+	require.Len(t, querier.queries, 1, "the evidence of release bundles isn't queried by artifact")
+	assert.Contains(t, querier.queries[0], `repositoryKey: \"libs-release\", path: \"org/app/1.0\", name: \"app-1.0.jar\"`)
+	assert.Equal(t, []ReleaseBundleRef{{Name: "app-bundle", Version: "1.0", RepoPath: "release-bundles-v2/app-bundle/1.0/artifacts/libs-release/org/app/1.0/app-1.0.jar"}}, provenance.ReleaseBundles)
+	assert.Equal(t, []BuildReference{{Name: "app", Number: "7", Started: "2026-01-01T12:00:00.000+0000",
+		Promotions: []PromotionRecord{{Status: "released", Repository: "libs-release", User: "admin", Timestamp: "2026-01-02T08:00:00.000+0000"}}}}, provenance.ProducedBy)
+	assert.Equal(t, []BuildReference{{Name: "service", Number: "12"}}, provenance.UsedBy)
+	assert.Empty(t, provenance.Errors)
+
+	// The same provenance is found by the checksum
+	provenance, err = whence(servicesManager, querier, strings.ToUpper(testSha1))
+	require.NoError(t, err)
+	assert.Len(t, provenance.ProducedBy, 1)
+
+	_, err = whence(servicesManager, querier, "libs-release/org/app/2.0/app-2.0.jar")
+	assert.Error(t, err)
+}
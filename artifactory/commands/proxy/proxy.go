@@ -0,0 +1,291 @@
+package proxy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"os"
+	"os/signal"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+
+	buildinfo "github.com/jfrog/build-info-go/entities"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clientconfig"
+	"github.com/jfrog/jfrog-cli-core/v2/common/build"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	clientutils "github.com/jfrog/jfrog-client-go/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/io/httputils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// ProxyCommand runs a reverse proxy on localhost, which forwards requests to Artifactory with the credentials of the configured server.
+// Tools that cannot be configured with credentials can then fetch artifacts from the local address.
+// Files downloaded through the proxy may be recorded as dependencies of a build.
+// The proxy is read-only unless writes are allowed, and serves only requests addressed to its own address, so that
+// web pages can't use the credentials through DNS rebinding.
+type ProxyCommand struct {
+	serverDetails      *config.ServerDetails
+	buildConfiguration *build.BuildConfiguration
+	port               int
+	repo               string
+	allowWrites        bool
+	dependencies       []buildinfo.Dependency
+	mutex              sync.Mutex
+}
+
+func NewProxyCommand() *ProxyCommand {
+	return &ProxyCommand{}
+}
+
+func (pc *ProxyCommand) SetServerDetails(serverDetails *config.ServerDetails) *ProxyCommand {
+	pc.serverDetails = serverDetails
+	return pc
+}
+
+func (pc *ProxyCommand) SetBuildConfiguration(buildConfiguration *build.BuildConfiguration) *ProxyCommand {
+	pc.buildConfiguration = buildConfiguration
+	return pc
+}
+
+func (pc *ProxyCommand) SetPort(port int) *ProxyCommand {
+	pc.port = port
+	return pc
+}
+
+// SetRepo sets the path in Artifactory which the root of the proxy is mapped to.
+// For example 'npm-virtual', or 'api/npm/npm-virtual' to serve the npm API of the repository.
+func (pc *ProxyCommand) SetRepo(repo string) *ProxyCommand {
+	pc.repo = strings.Trim(repo, "/")
+	return pc
+}
+
+// SetAllowWrites allows requests which modify Artifactory, like PUT and DELETE, to be forwarded.
+func (pc *ProxyCommand) SetAllowWrites(allowWrites bool) *ProxyCommand {
+	pc.allowWrites = allowWrites
+	return pc
+}
+
+func (pc *ProxyCommand) Dependencies() []buildinfo.Dependency {
+	pc.mutex.Lock()
+	defer pc.mutex.Unlock()
+	return pc.dependencies
+}
+
+func (pc *ProxyCommand) CommandName() string {
+	return "rt_proxy"
+}
+
+func (pc *ProxyCommand) ServerDetails() (*config.ServerDetails, error) {
+	return pc.serverDetails, nil
+}
+
+func (pc *ProxyCommand) Run() (err error) {
+	toCollect, err := pc.buildConfiguration.IsCollectBuildInfo()
+	if err != nil {
+		return err
+	}
+	listener, err := net.Listen("tcp", net.JoinHostPort("127.0.0.1", strconv.Itoa(pc.port)))
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	handler, err := pc.NewHandler(listener.Addr().String())
+	if err != nil {
+		return errors.Join(err, errorutils.CheckError(listener.Close()))
+	}
+	server := &http.Server{Handler: handler}
+
+	// Stop the proxy gracefully when interrupted.
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	serveErr := make(chan error, 1)
+	go func() {
+		serveErr <- server.Serve(listener)
+	}()
+	log.Info(fmt.Sprintf("Proxying http://%s to %s. Press Ctrl+C to stop.", listener.Addr().String(), pc.targetUrl()))
+	select {
+	case err = <-serveErr:
+		if !errors.Is(err, http.ErrServerClosed) {
+			return errorutils.CheckError(err)
+		}
+	case <-ctx.Done():
+		log.Info("Stopping the proxy...")
+		if err = server.Shutdown(context.Background()); err != nil {
+			return errorutils.CheckError(err)
+		}
+	}
+
+	if !toCollect {
+		return nil
+	}
+	return pc.saveBuildInfo()
+}
+
+// NewHandler returns the handler which forwards the requests to Artifactory. listenAddr is the address the proxy
+// listens on, which is the only host the requests may be addressed to.
+func (pc *ProxyCommand) NewHandler(listenAddr string) (http.Handler, error) {
+	target, err := url.Parse(pc.targetUrl())
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	artAuth, err := pc.serverDetails.CreateArtAuthConfig()
+	if err != nil {
+		return nil, err
+	}
+	httpClientDetails := artAuth.CreateHttpClientDetails()
+	transport, err := pc.createTransport()
+	if err != nil {
+		return nil, err
+	}
+	reverseProxy := &httputil.ReverseProxy{
+		Rewrite: func(proxyRequest *httputil.ProxyRequest) {
+			proxyRequest.SetURL(target)
+			setAuthHeaders(proxyRequest.Out, httpClientDetails)
+		},
+		ModifyResponse: pc.recordDownload,
+		Transport:      transport,
+		ErrorHandler: func(w http.ResponseWriter, r *http.Request, err error) {
+			log.Error(fmt.Sprintf("Failed forwarding %s %s: %s", r.Method, r.URL.Path, err.Error()))
+			w.WriteHeader(http.StatusBadGateway)
+		},
+	}
+	allowedHosts, err := getAllowedHosts(listenAddr)
+	if err != nil {
+		return nil, err
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !allowedHosts[strings.ToLower(r.Host)] {
+			log.Warn(fmt.Sprintf("Rejected a request to %s %s, which isn't addressed to the proxy", r.Host, r.URL.Path))
+			w.WriteHeader(http.StatusMisdirectedRequest)
+			return
+		}
+		if !pc.allowWrites && r.Method != http.MethodGet && r.Method != http.MethodHead {
+			w.Header().Set("Allow", "GET, HEAD")
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		cleanPath, ok := cleanRequestPath(r.URL.Path)
+		if !ok {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		r.URL.Path, r.URL.RawPath = cleanPath, ""
+		reverseProxy.ServeHTTP(w, r)
+	}), nil
+}
+
+// getAllowedHosts returns the values of the Host header of the requests addressed to the proxy.
+func getAllowedHosts(listenAddr string) (map[string]bool, error) {
+	_, port, err := net.SplitHostPort(listenAddr)
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	return map[string]bool{
+		net.JoinHostPort("127.0.0.1", port): true,
+		net.JoinHostPort("localhost", port): true,
+	}, nil
+}
+
+// cleanRequestPath returns the cleaned path of a request, which is forwarded under the path the proxy is mapped to.
+// Paths which include '..' segments are rejected rather than cleaned, since they attempt to leave that path.
+func cleanRequestPath(requestPath string) (string, bool) {
+	for _, segment := range strings.Split(requestPath, "/") {
+		if segment == ".." {
+			return "", false
+		}
+	}
+	cleanPath := path.Clean("/" + requestPath)
+	if strings.HasSuffix(requestPath, "/") && cleanPath != "/" {
+		cleanPath += "/"
+	}
+	return cleanPath, true
+}
+
+func (pc *ProxyCommand) targetUrl() string {
+	targetUrl := clientutils.AddTrailingSlashIfNeeded(pc.serverDetails.GetArtifactoryUrl())
+	if pc.repo != "" {
+		targetUrl += pc.repo + "/"
+	}
+	return targetUrl
+}
+
+// createTransport returns the transport of the forwarded requests, which is configured like the transports of the
+// other clients of the server.
+func (pc *ProxyCommand) createTransport() (http.RoundTripper, error) {
+	httpClient := &http.Client{Transport: http.DefaultTransport.(*http.Transport).Clone()}
+	if err := clientconfig.ConfigureHttpClient(httpClient, pc.serverDetails); err != nil {
+		return nil, err
+	}
+	return httpClient.Transport, nil
+}
+
+// Replaces any credentials sent by the local client with the credentials of the server.
+func setAuthHeaders(request *http.Request, httpClientDetails httputils.HttpClientDetails) {
+	request.Header.Del("Authorization")
+	for name, value := range httpClientDetails.Headers {
+		request.Header.Set(name, value)
+	}
+	if httpClientDetails.AccessToken != "" {
+		request.Header.Set("Authorization", "Bearer "+httpClientDetails.AccessToken)
+	} else if httpClientDetails.User != "" || httpClientDetails.Password != "" {
+		request.SetBasicAuth(httpClientDetails.User, httpClientDetails.Password)
+	}
+}
+
+// Records each file successfully downloaded through the proxy as a build dependency.
+// Responses without checksum headers, such as folder listings and package metadata, are not recorded.
+func (pc *ProxyCommand) recordDownload(response *http.Response) error {
+	if response.Request.Method != http.MethodGet || response.StatusCode != http.StatusOK {
+		return nil
+	}
+	dependency := buildinfo.Dependency{
+		Id: path.Base(response.Request.URL.Path),
+		Checksum: buildinfo.Checksum{
+			Sha1:   response.Header.Get("X-Checksum-Sha1"),
+			Md5:    response.Header.Get("X-Checksum-Md5"),
+			Sha256: response.Header.Get("X-Checksum-Sha256"),
+		},
+	}
+	if dependency.Sha1 == "" {
+		return nil
+	}
+	log.Debug("Downloaded through the proxy:", response.Request.URL.Path)
+	pc.mutex.Lock()
+	defer pc.mutex.Unlock()
+	for _, existing := range pc.dependencies {
+		if existing.Sha1 == dependency.Sha1 && existing.Id == dependency.Id {
+			return nil
+		}
+	}
+	pc.dependencies = append(pc.dependencies, dependency)
+	return nil
+}
+
+func (pc *ProxyCommand) saveBuildInfo() error {
+	buildName, err := pc.buildConfiguration.GetBuildName()
+	if err != nil {
+		return err
+	}
+	buildNumber, err := pc.buildConfiguration.GetBuildNumber()
+	if err != nil {
+		return err
+	}
+	if err = build.SaveBuildGeneralDetails(buildName, buildNumber, pc.buildConfiguration.GetProject()); err != nil {
+		return err
+	}
+	dependencies := pc.Dependencies()
+	log.Info(fmt.Sprintf("Recording %d dependencies downloaded through the proxy in the build-info.", len(dependencies)))
+	populateFunc := func(partial *buildinfo.Partial) {
+		partial.Dependencies = dependencies
+		partial.ModuleId = pc.buildConfiguration.GetModule()
+		partial.ModuleType = buildinfo.Generic
+	}
+	return build.SavePartialBuildInfo(buildName, buildNumber, pc.buildConfiguration.GetProject(), populateFunc)
+}
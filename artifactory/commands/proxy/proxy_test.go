@@ -0,0 +1,133 @@
+package proxy
+
+import (
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProxyHandler(t *testing.T) {
+	artifactory := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user, password, ok := r.BasicAuth()
+		if !ok || user != "admin" || password != "password" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch r.URL.Path {
+		case "/artifactory/npm-virtual/lodash/-/lodash-4.17.21.tgz":
+			w.Header().Set("X-Checksum-Sha1", "sha1")
+			w.Header().Set("X-Checksum-Sha256", "sha256")
+			_, _ = w.Write([]byte("content"))
+		case "/artifactory/npm-virtual/lodash":
+			_, _ = w.Write([]byte("{}"))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer artifactory.Close()
+
+	serverDetails := &config.ServerDetails{ArtifactoryUrl: artifactory.URL + "/artifactory/", User: "admin", Password: "password"}
+	proxyCommand := NewProxyCommand().SetServerDetails(serverDetails).SetRepo("/npm-virtual/")
+	proxy := newProxyServer(t, proxyCommand)
+	defer proxy.Close()
+
+	// The credentials of the local client are replaced by the credentials of the server.
+	request, err := http.NewRequest(http.MethodGet, proxy.URL+"/lodash/-/lodash-4.17.21.tgz", nil)
+	require.NoError(t, err)
+	request.SetBasicAuth("local", "local")
+	response, err := http.DefaultClient.Do(request)
+	require.NoError(t, err)
+	body, err := io.ReadAll(response.Body)
+	assert.NoError(t, response.Body.Close())
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	assert.Equal(t, "content", string(body))
+
+	// Package metadata and missing files are not recorded.
+	for _, requestPath := range []string{"/lodash", "/missing.tgz", "/lodash/-/lodash-4.17.21.tgz"} {
+		response, err = http.Get(proxy.URL + requestPath)
+		require.NoError(t, err)
+		assert.NoError(t, response.Body.Close())
+	}
+
+	dependencies := proxyCommand.Dependencies()
+	require.Len(t, dependencies, 1)
+	assert.Equal(t, "lodash-4.17.21.tgz", dependencies[0].Id)
+	assert.Equal(t, "sha1", dependencies[0].Sha1)
+	assert.Equal(t, "sha256", dependencies[0].Sha256)
+}
+
+func TestProxyHandlerRejectedRequests(t *testing.T) {
+	var forwarded []string
+	artifactory := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		forwarded = append(forwarded, r.Method+" "+r.URL.Path)
+	}))
+	defer artifactory.Close()
+
+	serverDetails := &config.ServerDetails{ArtifactoryUrl: artifactory.URL + "/artifactory/"}
+	proxyCommand := NewProxyCommand().SetServerDetails(serverDetails).SetRepo("generic-local")
+	proxy := newProxyServer(t, proxyCommand)
+	defer proxy.Close()
+
+	sendRequest := func(method, requestPath, host string) int {
+		request, err := http.NewRequest(method, proxy.URL+requestPath, nil)
+		require.NoError(t, err)
+		if host != "" {
+			request.Host = host
+		}
+		response, err := http.DefaultClient.Do(request)
+		require.NoError(t, err)
+		assert.NoError(t, response.Body.Close())
+		return response.StatusCode
+	}
+	_, port, err := net.SplitHostPort(proxy.Listener.Addr().String())
+	require.NoError(t, err)
+
+	// Writes aren't forwarded unless they are allowed.
+	assert.Equal(t, http.StatusMethodNotAllowed, sendRequest(http.MethodPut, "/a.txt", ""))
+	// Requests addressed to other hosts, as sent by a page through DNS rebinding, aren't forwarded.
+	assert.Equal(t, http.StatusMisdirectedRequest, sendRequest(http.MethodGet, "/a.txt", "attacker.example:"+port))
+	// Paths which leave the repository aren't forwarded.
+	assert.Equal(t, http.StatusBadRequest, sendRequest(http.MethodGet, "/%2e%2e/other-local/a.txt", ""))
+	assert.Equal(t, http.StatusOK, sendRequest(http.MethodGet, "/dir//./a.txt", "localhost:"+port))
+
+	proxyCommand.SetAllowWrites(true)
+	assert.Equal(t, http.StatusOK, sendRequest(http.MethodPut, "/a.txt", ""))
+	assert.Equal(t, []string{"GET /artifactory/generic-local/dir/a.txt", "PUT /artifactory/generic-local/a.txt"}, forwarded)
+}
+
+func TestCleanRequestPath(t *testing.T) {
+	testCases := []struct {
+		requestPath string
+		expected    string
+		ok          bool
+	}{
+		{"", "/", true},
+		{"/", "/", true},
+		{"/a/./b//c.tgz", "/a/b/c.tgz", true},
+		{"/a/b/", "/a/b/", true},
+		{"/a/../b", "", false},
+		{"/..", "", false},
+	}
+	for _, testCase := range testCases {
+		cleanPath, ok := cleanRequestPath(testCase.requestPath)
+		assert.Equal(t, testCase.ok, ok, testCase.requestPath)
+		assert.Equal(t, testCase.expected, cleanPath, testCase.requestPath)
+	}
+}
+
+// newProxyServer starts a server which serves the handler of the proxy command on its own address.
+func newProxyServer(t *testing.T, proxyCommand *ProxyCommand) *httptest.Server {
+	proxy := httptest.NewUnstartedServer(nil)
+	handler, err := proxyCommand.NewHandler(proxy.Listener.Addr().String())
+	require.NoError(t, err)
+	proxy.Config.Handler = handler
+	proxy.Start()
+	return proxy
+}
@@ -0,0 +1,74 @@
+package python
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/jfrog/build-info-go/build"
+	"github.com/jfrog/build-info-go/entities"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/lockfile"
+	buildUtils "github.com/jfrog/jfrog-cli-core/v2/common/build"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+	"github.com/pelletier/go-toml/v2"
+)
+
+const (
+	poetryLockFileName = "poetry.lock"
+	pdmLockFileName    = "pdm.lock"
+)
+
+type pyprojectFile struct {
+	Project struct {
+		Name    string `toml:"name"`
+		Version string `toml:"version"`
+	} `toml:"project"`
+	Tool struct {
+		Poetry struct {
+			Name    string `toml:"name"`
+			Version string `toml:"version"`
+		} `toml:"poetry"`
+	} `toml:"tool"`
+}
+
+// getPyprojectModuleId returns the id of the project's module, <name>:<version>, from the [project] table of its
+// pyproject.toml, or from the [tool.poetry] table of projects of Poetry 1.x.
+func getPyprojectModuleId(workingDir string) (string, error) {
+	content, err := os.ReadFile(filepath.Join(workingDir, pyproject))
+	if err != nil {
+		return "", errorutils.CheckError(err)
+	}
+	var projectFile pyprojectFile
+	if err = toml.Unmarshal(content, &projectFile); err != nil {
+		return "", errorutils.CheckErrorf("failed to parse pyproject.toml: %s", err.Error())
+	}
+	name, version := projectFile.Project.Name, projectFile.Project.Version
+	if name == "" {
+		name, version = projectFile.Tool.Poetry.Name, projectFile.Tool.Poetry.Version
+	}
+	if name == "" {
+		return "", errorutils.CheckErrorf("no project name found in pyproject.toml")
+	}
+	if version == "" {
+		return name, nil
+	}
+	return name + ":" + version, nil
+}
+
+// saveLockfileDependencies saves the module of the project, with the dependencies pinned by its lockfile.
+// The module is named by the build configuration, or by the project's pyproject.toml.
+func saveLockfileDependencies(pythonBuildInfo *build.Build, buildConfiguration *buildUtils.BuildConfiguration, serverDetails *config.ServerDetails, workingDir, lockfileName string) error {
+	moduleId := buildConfiguration.GetModule()
+	if moduleId == "" {
+		var err error
+		if moduleId, err = getPyprojectModuleId(workingDir); err != nil {
+			log.Debug("Couldn't read the module name from pyproject.toml:", err.Error())
+			moduleId, err = buildConfiguration.GetBuildName()
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return lockfile.SaveModule(pythonBuildInfo, serverDetails, filepath.Join(workingDir, lockfileName), moduleId, entities.Python)
+}
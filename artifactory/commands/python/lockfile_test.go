@@ -0,0 +1,49 @@
+package python
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetPyprojectModuleId(t *testing.T) {
+	testCases := []struct {
+		name       string
+		pyproject  string
+		expectedId string
+	}{
+		{"project table", "[project]\nname = \"my-app\"\nversion = \"1.0.0\"\n", "my-app:1.0.0"},
+		{"poetry table", "[tool.poetry]\nname = \"my-app\"\nversion = \"2.0.0\"\n", "my-app:2.0.0"},
+		{"dynamic version", "[project]\nname = \"my-app\"\ndynamic = [\"version\"]\n", "my-app"},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			workingDir := t.TempDir()
+			require.NoError(t, os.WriteFile(filepath.Join(workingDir, pyproject), []byte(testCase.pyproject), 0600))
+			moduleId, err := getPyprojectModuleId(workingDir)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedId, moduleId)
+		})
+	}
+
+	_, err := getPyprojectModuleId(t.TempDir())
+	assert.Error(t, err)
+}
+
+func TestGetPdmEnv(t *testing.T) {
+	serverDetails := &config.ServerDetails{ArtifactoryUrl: "https://acme.jfrog.io/artifactory/", User: "user", Password: "password"}
+	env, err := getPdmEnv(serverDetails, "pypi-virtual")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		pdmPypiUrlEnv:         "https://acme.jfrog.io/artifactory/api/pypi/pypi-virtual/simple",
+		pdmPypiUsernameEnv:    "user",
+		pdmPypiPasswordEnv:    "password",
+		pdmPublishRepoEnv:     "https://acme.jfrog.io/artifactory/api/pypi/pypi-virtual",
+		pdmPublishUsernameEnv: "user",
+		pdmPublishPasswordEnv: "password",
+	}, env)
+}
@@ -0,0 +1,164 @@
+package python
+
+import (
+	"errors"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/jfrog/build-info-go/build"
+	"github.com/jfrog/build-info-go/utils/pythonutils"
+	gofrogcmd "github.com/jfrog/gofrog/io"
+	buildUtils "github.com/jfrog/jfrog-cli-core/v2/common/build"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const (
+	pdmTool pythonutils.PythonTool = "pdm"
+
+	// PDM reads the credentials of the default package index, and of the repository it publishes to, from these
+	// environment variables, so they aren't written to the configuration of the user or of the project.
+	pdmPypiUrlEnv         = "PDM_PYPI_URL"
+	pdmPypiUsernameEnv    = "PDM_PYPI_USERNAME"
+	pdmPypiPasswordEnv    = "PDM_PYPI_PASSWORD"
+	pdmPublishRepoEnv     = "PDM_PUBLISH_REPO"
+	pdmPublishUsernameEnv = "PDM_PUBLISH_USERNAME"
+	pdmPublishPasswordEnv = "PDM_PUBLISH_PASSWORD"
+)
+
+// PdmCommand runs PDM commands, resolving the packages from, and publishing them to, a PyPI repository in Artifactory.
+type PdmCommand struct {
+	PythonCommand
+	env map[string]string
+}
+
+func NewPdmCommand() *PdmCommand {
+	return &PdmCommand{PythonCommand: *NewPythonCommand(pdmTool)}
+}
+
+func (pc *PdmCommand) Run() (err error) {
+	log.Info("Running PDM", pc.commandName)
+	var buildConfiguration *buildUtils.BuildConfiguration
+	pc.args, buildConfiguration, err = buildUtils.ExtractBuildDetailsFromArgs(pc.args)
+	if err != nil {
+		return
+	}
+	pythonBuildInfo, err := buildUtils.PrepareBuildPrerequisites(buildConfiguration)
+	if err != nil {
+		return
+	}
+	defer func() {
+		if pythonBuildInfo != nil && err != nil {
+			err = errors.Join(err, pythonBuildInfo.Clean())
+		}
+	}()
+	if pc.env, err = getPdmEnv(pc.serverDetails, pc.repository); err != nil {
+		return
+	}
+	if err = gofrogcmd.RunCmd(pc); err != nil || pythonBuildInfo == nil {
+		return
+	}
+	workingDir, err := os.Getwd()
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	switch pc.commandName {
+	case "install", "sync":
+		return saveLockfileDependencies(pythonBuildInfo, buildConfiguration, pc.serverDetails, workingDir, pdmLockFileName)
+	case "publish":
+		return collectPdmPublishedArtifacts(pythonBuildInfo, buildConfiguration, workingDir)
+	}
+	return
+}
+
+// getPdmEnv returns the environment variables which configure the repository as PDM's default package index, and as
+// the repository it publishes to.
+func getPdmEnv(serverDetails *config.ServerDetails, repository string) (map[string]string, error) {
+	rtUrl, username, password, err := GetPypiRepoUrlWithCredentials(serverDetails, repository, false)
+	if err != nil {
+		return nil, err
+	}
+	env := map[string]string{
+		pdmPypiUrlEnv:     rtUrl.String(),
+		pdmPublishRepoEnv: strings.TrimSuffix(rtUrl.String(), "/simple"),
+	}
+	if password != "" {
+		env[pdmPypiUsernameEnv], env[pdmPypiPasswordEnv] = username, password
+		env[pdmPublishUsernameEnv], env[pdmPublishPasswordEnv] = username, password
+	}
+	return env, nil
+}
+
+// collectPdmPublishedArtifacts adds the distributions built by 'pdm publish' to the build-info.
+func collectPdmPublishedArtifacts(pythonBuildInfo *build.Build, buildConfiguration *buildUtils.BuildConfiguration, workingDir string) error {
+	distDir := filepath.Join(workingDir, "dist")
+	if _, err := os.Stat(distDir); os.IsNotExist(err) {
+		log.Debug("No build directory found at", distDir+", skipping artifact collection")
+		return nil
+	}
+	moduleId := buildConfiguration.GetModule()
+	if moduleId == "" {
+		var err error
+		if moduleId, err = getPyprojectModuleId(workingDir); err != nil {
+			return err
+		}
+	}
+	artifacts, err := findDistArtifacts(distDir)
+	if err != nil || len(artifacts) == 0 {
+		return err
+	}
+	log.Debug("Found", len(artifacts), "artifacts to add to build info")
+	return errorutils.CheckError(pythonBuildInfo.AddArtifacts(moduleId, "pypi", artifacts...))
+}
+
+func (pc *PdmCommand) SetRepo(repo string) *PdmCommand {
+	pc.PythonCommand.SetRepo(repo)
+	return pc
+}
+
+func (pc *PdmCommand) SetArgs(arguments []string) *PdmCommand {
+	pc.PythonCommand.SetArgs(arguments)
+	return pc
+}
+
+func (pc *PdmCommand) SetCommandName(commandName string) *PdmCommand {
+	pc.PythonCommand.SetCommandName(commandName)
+	return pc
+}
+
+func (pc *PdmCommand) CommandName() string {
+	return "rt_python_pdm"
+}
+
+func (pc *PdmCommand) SetServerDetails(serverDetails *config.ServerDetails) *PdmCommand {
+	pc.PythonCommand.SetServerDetails(serverDetails)
+	return pc
+}
+
+func (pc *PdmCommand) ServerDetails() (*config.ServerDetails, error) {
+	return pc.serverDetails, nil
+}
+
+func (pc *PdmCommand) GetCmd() *exec.Cmd {
+	var cmd []string
+	cmd = append(cmd, string(pc.pythonTool))
+	cmd = append(cmd, pc.commandName)
+	cmd = append(cmd, pc.args...)
+	return exec.Command(cmd[0], cmd[1:]...)
+}
+
+func (pc *PdmCommand) GetEnv() map[string]string {
+	return pc.env
+}
+
+func (pc *PdmCommand) GetStdWriter() io.WriteCloser {
+	return nil
+}
+
+func (pc *PdmCommand) GetErrWriter() io.WriteCloser {
+	return nil
+}
@@ -10,7 +10,7 @@ import (
 	"github.com/jfrog/build-info-go/entities"
 	"github.com/jfrog/build-info-go/utils/pythonutils"
 	"github.com/jfrog/jfrog-cli-artifactory/artifactory/commands/python/dependencies"
-	"github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clientconfig"
 	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
 )
 
@@ -27,7 +27,7 @@ func (pc *PipCommand) Run() (err error) {
 }
 
 func (pc *PipCommand) UpdateDepsChecksumInfoFunc(dependenciesMap map[string]entities.Dependency, srcPath string) error {
-	servicesManager, err := utils.CreateServiceManager(pc.serverDetails, -1, 0, false)
+	servicesManager, err := clientconfig.CreateServiceManager(pc.serverDetails, -1, 0, false)
 	if err != nil {
 		return err
 	}
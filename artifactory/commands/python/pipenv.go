@@ -7,7 +7,7 @@ import (
 	"github.com/jfrog/build-info-go/entities"
 	"github.com/jfrog/build-info-go/utils/pythonutils"
 	"github.com/jfrog/jfrog-cli-artifactory/artifactory/commands/python/dependencies"
-	"github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clientconfig"
 	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
 )
 
@@ -24,7 +24,7 @@ func (pc *PipenvCommand) Run() (err error) {
 }
 
 func (pc *PipenvCommand) UpdateDepsChecksumInfoFunc(dependenciesMap map[string]entities.Dependency, srcPath string) error {
-	servicesManager, err := utils.CreateServiceManager(pc.serverDetails, -1, 0, false)
+	servicesManager, err := clientconfig.CreateServiceManager(pc.serverDetails, -1, 0, false)
 	if err != nil {
 		return err
 	}
@@ -12,9 +12,10 @@ import (
 	"github.com/jfrog/build-info-go/build"
 	"github.com/jfrog/build-info-go/entities"
 	"github.com/jfrog/build-info-go/utils/pythonutils"
+	"github.com/jfrog/gofrog/crypto"
 	gofrogcmd "github.com/jfrog/gofrog/io"
 	"github.com/jfrog/jfrog-cli-artifactory/artifactory/commands/python/dependencies"
-	"github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clientconfig"
 	buildUtils "github.com/jfrog/jfrog-cli-core/v2/common/build"
 	"github.com/jfrog/jfrog-cli-core/v2/common/project"
 	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
@@ -75,28 +76,20 @@ func (pc *PoetryCommand) Run() (err error) {
 	return gofrogcmd.RunCmd(pc)
 }
 
+// install runs the install command, and collects the packages pinned by poetry.lock, which are the installed packages.
 func (pc *PoetryCommand) install(buildConfiguration *buildUtils.BuildConfiguration, pythonBuildInfo *build.Build) (err error) {
-	var pythonModule *build.PythonModule
-	pythonModule, err = pythonBuildInfo.AddPythonModule("", pc.pythonTool)
-	if err != nil {
+	if err = gofrogcmd.RunCmd(pc); err != nil {
 		return
 	}
-	if buildConfiguration.GetModule() != "" {
-		pythonModule.SetName(buildConfiguration.GetModule())
-	}
-	var localDependenciesPath string
-	localDependenciesPath, err = config.GetJfrogDependenciesPath()
+	workingDir, err := os.Getwd()
 	if err != nil {
-		return
+		return errorutils.CheckError(err)
 	}
-	pythonModule.SetLocalDependenciesPath(localDependenciesPath)
-	pythonModule.SetUpdateDepsChecksumInfoFunc(pc.UpdateDepsChecksumInfoFunc)
-
-	return errorutils.CheckError(pythonModule.RunInstallAndCollectDependencies(pc.args))
+	return saveLockfileDependencies(pythonBuildInfo, buildConfiguration, pc.serverDetails, workingDir, poetryLockFileName)
 }
 
 func (pc *PoetryCommand) publish(buildConfiguration *buildUtils.BuildConfiguration, pythonBuildInfo *build.Build) error {
-	publishCmdArgs := append(slices.Clone(pc.args), "-r "+pc.repository)
+	publishCmdArgs := append(slices.Clone(pc.args), "-r", pc.repository)
 
 	// Get build name and number (already extracted from CLI arguments)
 	// Since buildConfiguration is created from CLI args, these should be available directly
@@ -155,7 +148,7 @@ func (pc *PoetryCommand) collectPublishedArtifacts(buildConfiguration *buildUtil
 	}
 
 	// Find artifacts in build directory
-	artifacts, err := pc.findDistArtifacts(buildDir)
+	artifacts, err := findDistArtifacts(buildDir)
 	if err != nil {
 		return err
 	}
@@ -210,7 +203,7 @@ func (pc *PoetryCommand) getProjectNameFromPyproject(workingDir string) (string,
 }
 
 // findDistArtifacts finds and creates artifact entries for files in dist directory
-func (pc *PoetryCommand) findDistArtifacts(distDir string) ([]entities.Artifact, error) {
+func findDistArtifacts(distDir string) ([]entities.Artifact, error) {
 	var artifacts []entities.Artifact
 
 	entries, err := os.ReadDir(distDir)
@@ -231,12 +224,17 @@ func (pc *PoetryCommand) findDistArtifacts(distDir string) ([]entities.Artifact,
 		}
 
 		filePath := filepath.Join(distDir, filename)
+		checksums, err := crypto.GetFileChecksums(filePath)
+		if err != nil {
+			return nil, errorutils.CheckError(err)
+		}
 
 		// Create artifact entry
 		artifact := entities.Artifact{
-			Name: filename,
-			Path: filePath,
-			Type: artifactType,
+			Name:     filename,
+			Path:     filePath,
+			Type:     artifactType,
+			Checksum: entities.Checksum{Sha1: checksums[crypto.SHA1], Md5: checksums[crypto.MD5], Sha256: checksums[crypto.SHA256]},
 		}
 
 		artifacts = append(artifacts, artifact)
@@ -256,7 +254,7 @@ func getArtifactType(filename string) string {
 }
 
 func (pc *PoetryCommand) UpdateDepsChecksumInfoFunc(dependenciesMap map[string]entities.Dependency, srcPath string) error {
-	servicesManager, err := utils.CreateServiceManager(pc.serverDetails, -1, 0, false)
+	servicesManager, err := clientconfig.CreateServiceManager(pc.serverDetails, -1, 0, false)
 	if err != nil {
 		return err
 	}
@@ -14,7 +14,7 @@ import (
 	"github.com/jfrog/build-info-go/utils/pythonutils"
 	gofrogcmd "github.com/jfrog/gofrog/io"
 	"github.com/jfrog/jfrog-cli-artifactory/artifactory/commands/python/dependencies"
-	"github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clientconfig"
 	buildUtils "github.com/jfrog/jfrog-cli-core/v2/common/build"
 	"github.com/jfrog/jfrog-cli-core/v2/common/project"
 	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
@@ -105,7 +105,7 @@ func (pc *PythonCommand) Run() (err error) {
 }
 
 func (pc *PythonCommand) UpdateDepsChecksumInfoFunc(dependenciesMap map[string]entities.Dependency, srcPath string) error {
-	servicesManager, err := utils.CreateServiceManager(pc.serverDetails, -1, 0, false)
+	servicesManager, err := clientconfig.CreateServiceManager(pc.serverDetails, -1, 0, false)
 	if err != nil {
 		return err
 	}
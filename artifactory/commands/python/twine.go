@@ -11,7 +11,7 @@ import (
 
 	"github.com/jfrog/build-info-go/build"
 	"github.com/jfrog/build-info-go/utils/pythonutils"
-	rtUtils "github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clientconfig"
 	buildUtils "github.com/jfrog/jfrog-cli-core/v2/common/build"
 	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
 	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
@@ -202,7 +202,7 @@ func (tc *TwineCommand) uploadAndCollectBuildInfo() error {
 			},
 		},
 	}
-	servicesManager, err := rtUtils.CreateServiceManager(tc.serverDetails, -1, 0, false)
+	servicesManager, err := clientconfig.CreateServiceManager(tc.serverDetails, -1, 0, false)
 	if err != nil {
 		return err
 	}
@@ -66,7 +66,7 @@ var (
 func TestCreateReplicationPathPrefix(t *testing.T) {
 	// Create replication command
 	replicationCmd := NewReplicationCreateCommand()
-	testServer := createMockServer(t, replicationCmd)
+	testServer := createMockServer(t, &replicationCmd.ReplicationCommand, http.MethodPut)
 	defer testServer.Close()
 
 	// Test create replication with template containing "pathPrefix"
@@ -77,7 +77,7 @@ func TestCreateReplicationPathPrefix(t *testing.T) {
 func TestReplicationIncludePathPrefix(t *testing.T) {
 	// Create replication command
 	replicationCmd := NewReplicationCreateCommand()
-	testServer := createMockServer(t, replicationCmd)
+	testServer := createMockServer(t, &replicationCmd.ReplicationCommand, http.MethodPut)
 	defer testServer.Close()
 
 	// Test create replication with template containing "includePathPrefixPattern"
@@ -85,11 +85,23 @@ func TestReplicationIncludePathPrefix(t *testing.T) {
 	assert.NoError(t, replicationCmd.Run())
 }
 
+func TestUpdateReplication(t *testing.T) {
+	// Update replication command
+	replicationCmd := NewReplicationUpdateCommand()
+	testServer := createMockServer(t, &replicationCmd.ReplicationCommand, http.MethodPost)
+	defer testServer.Close()
+
+	replicationCmd.SetTemplatePath(filepath.Join(templatesPath, "template-includePathPrefixPattern.json"))
+	assert.NoError(t, replicationCmd.Run())
+}
+
 // Create mock server to test replication body
 // t              - The testing object
-// replicationCmd - The replication-create command to populate with the server URL
-func createMockServer(t *testing.T, replicationCmd *ReplicationCreateCommand) *httptest.Server {
+// replicationCmd - The replication command to populate with the server URL
+// method         - The expected HTTP method of the request
+func createMockServer(t *testing.T, replicationCmd *ReplicationCommand, method string) *httptest.Server {
 	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, method, r.Method)
 		w.WriteHeader(http.StatusOK)
 
 		// Read body
@@ -102,7 +114,6 @@ func createMockServer(t *testing.T, replicationCmd *ReplicationCreateCommand) *h
 		// Make sure the sent replication body equals to the expected
 		assert.Equal(t, *expected, actual)
 	}))
-	serverDetails := &config.ServerDetails{ArtifactoryUrl: testServer.URL + "/"}
-	replicationCmd.SetServerDetails(serverDetails)
+	replicationCmd.serverDetails = &config.ServerDetails{ArtifactoryUrl: testServer.URL + "/"}
 	return testServer
 }
@@ -1,15 +1,18 @@
 package replication
 
 import (
-	rtUtils "github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
+	artifactoryutils "github.com/jfrog/jfrog-cli-artifactory/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clientconfig"
 	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
 	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
 )
 
 type ReplicationDeleteCommand struct {
 	serverDetails *config.ServerDetails
 	repoKey       string
 	quiet         bool
+	dryRun        bool
 }
 
 func NewReplicationDeleteCommand() *ReplicationDeleteCommand {
@@ -26,6 +29,12 @@ func (rdc *ReplicationDeleteCommand) SetQuiet(quiet bool) *ReplicationDeleteComm
 	return rdc
 }
 
+// SetDryRun sets the command to list the replications of the repository, without deleting them.
+func (rdc *ReplicationDeleteCommand) SetDryRun(dryRun bool) *ReplicationDeleteCommand {
+	rdc.dryRun = dryRun
+	return rdc
+}
+
 func (rdc *ReplicationDeleteCommand) SetServerDetails(serverDetails *config.ServerDetails) *ReplicationDeleteCommand {
 	rdc.serverDetails = serverDetails
 	return rdc
@@ -40,12 +49,33 @@ func (rdc *ReplicationDeleteCommand) CommandName() string {
 }
 
 func (rdc *ReplicationDeleteCommand) Run() (err error) {
+	if rdc.dryRun {
+		return rdc.logReplications()
+	}
 	if !rdc.quiet && !coreutils.AskYesNo("Are you sure you want to delete the replication for  "+rdc.repoKey+" ?", false) {
 		return nil
 	}
-	servicesManager, err := rtUtils.CreateServiceManager(rdc.serverDetails, -1, 0, false)
+	servicesManager, err := clientconfig.CreateServiceManager(rdc.serverDetails, -1, 0, false)
 	if err != nil {
 		return err
 	}
 	return servicesManager.DeleteReplication(rdc.repoKey)
 }
+
+func (rdc *ReplicationDeleteCommand) logReplications() error {
+	servicesManager, err := clientconfig.CreateServiceManager(rdc.serverDetails, -1, 0, false)
+	if err != nil {
+		return err
+	}
+	replications, err := servicesManager.GetReplication(rdc.repoKey)
+	if err != nil {
+		return err
+	}
+	if len(replications) == 0 {
+		return errorutils.CheckErrorf("the repository '%s' has no replication", rdc.repoKey)
+	}
+	for _, replication := range replications {
+		artifactoryutils.LogDryRun("Deleting the replication of", rdc.repoKey, "to", replication.Url)
+	}
+	return nil
+}
@@ -0,0 +1,146 @@
+package replication
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clientconfig"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/credentials"
+	"github.com/jfrog/jfrog-cli-core/v2/artifactory/commands/utils"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/ioutils"
+	"github.com/jfrog/jfrog-client-go/artifactory/services"
+	clientUtils "github.com/jfrog/jfrog-client-go/artifactory/services/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/io/fileutils"
+)
+
+// ReplicationCommand creates or updates the replication of a repository from a template.
+type ReplicationCommand struct {
+	serverDetails *config.ServerDetails
+	templatePath  string
+	vars          string
+}
+
+func (rc *ReplicationCommand) PerformReplicationCmd(isUpdate bool) (err error) {
+	content, err := fileutils.ReadFile(rc.templatePath)
+	if errorutils.CheckError(err) != nil {
+		return
+	}
+	// Replace vars string-by-string if needed
+	if len(rc.vars) > 0 {
+		templateVars := coreutils.SpecVarsStringToMap(rc.vars)
+		content = coreutils.ReplaceVars(content, templateVars)
+	}
+	// Unmarshal template to a map
+	var replicationConfigMap map[string]interface{}
+	err = json.Unmarshal(content, &replicationConfigMap)
+	if errorutils.CheckError(err) != nil {
+		return
+	}
+	// All the values in the template are strings
+	// Go over the confMap and write the values with the correct type using the writersMap
+	serverId := ""
+	for key, value := range replicationConfigMap {
+		if err = utils.ValidateMapEntry(key, value, writersMap); err != nil {
+			return
+		}
+		if key == "serverId" {
+			serverId = fmt.Sprint(value)
+		} else {
+			err := writersMap[key](&replicationConfigMap, key, fmt.Sprint(value))
+			if err != nil {
+				return err
+			}
+		}
+	}
+	err = fillMissingDefaultValue(replicationConfigMap)
+	if err != nil {
+		return err
+	}
+	// Write a JSON with the correct values
+	content, err = json.Marshal(replicationConfigMap)
+	if errorutils.CheckError(err) != nil {
+		return
+	}
+	var params clientUtils.ReplicationParams
+	err = json.Unmarshal(content, &params)
+	if errorutils.CheckError(err) != nil {
+		return
+	}
+
+	setPathPrefixBackwardCompatibility(&params)
+	servicesManager, err := clientconfig.CreateServiceManager(rc.serverDetails, -1, 0, false)
+	if err != nil {
+		return err
+	}
+	// In case 'serverId' is not found, pull replication will be assumed.
+	if serverId != "" {
+		if targetRepo, ok := replicationConfigMap["targetRepoKey"]; ok {
+			if err = updateArtifactoryInfo(&params, serverId, fmt.Sprint(targetRepo)); err != nil {
+				return err
+			}
+		} else {
+			return errorutils.CheckErrorf("expected 'targetRepoKey' field in the json template file.")
+		}
+	}
+	if isUpdate {
+		return servicesManager.UpdateReplication(services.UpdateReplicationParams{ReplicationParams: params})
+	}
+	return servicesManager.CreateReplication(services.CreateReplicationParams{ReplicationParams: params})
+}
+
+func fillMissingDefaultValue(replicationConfigMap map[string]interface{}) error {
+	if _, ok := replicationConfigMap["socketTimeoutMillis"]; !ok {
+		err := writersMap["socketTimeoutMillis"](&replicationConfigMap, "socketTimeoutMillis", "15000")
+		if err != nil {
+			return err
+		}
+	}
+	if _, ok := replicationConfigMap["syncProperties"]; !ok {
+		err := writersMap["syncProperties"](&replicationConfigMap, "syncProperties", "true")
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Make the pathPrefix parameter equals to the includePathPrefixPattern to support Artifactory < 7.27.4
+func setPathPrefixBackwardCompatibility(params *clientUtils.ReplicationParams) {
+	if params.IncludePathPrefixPattern == "" {
+		params.IncludePathPrefixPattern = params.PathPrefix
+		return
+	}
+	if params.PathPrefix == "" {
+		params.PathPrefix = params.IncludePathPrefixPattern
+	}
+}
+
+func updateArtifactoryInfo(param *clientUtils.ReplicationParams, serverId, targetRepo string) error {
+	singleConfig, err := credentials.GetSpecificConfig(serverId, true, false)
+	if err != nil {
+		return err
+	}
+	param.Url, param.Password, param.Username = strings.TrimSuffix(singleConfig.GetArtifactoryUrl(), "/")+"/"+targetRepo, singleConfig.GetPassword(), singleConfig.GetUser()
+	return nil
+}
+
+var writersMap = map[string]ioutils.AnswerWriter{
+	ServerId:                 ioutils.WriteStringAnswer,
+	RepoKey:                  ioutils.WriteStringAnswer,
+	TargetRepoKey:            ioutils.WriteStringAnswer,
+	CronExp:                  ioutils.WriteStringAnswer,
+	EnableEventReplication:   ioutils.WriteBoolAnswer,
+	Enabled:                  ioutils.WriteBoolAnswer,
+	SyncDeletes:              ioutils.WriteBoolAnswer,
+	SyncProperties:           ioutils.WriteBoolAnswer,
+	SyncStatistics:           ioutils.WriteBoolAnswer,
+	PathPrefix:               ioutils.WriteStringAnswer,
+	IncludePathPrefixPattern: ioutils.WriteStringAnswer,
+	SocketTimeoutMillis:      ioutils.WriteIntAnswer,
+	DisableProxy:             ioutils.WriteBoolAnswer,
+	Proxy:                    ioutils.WriteStringAnswer,
+}
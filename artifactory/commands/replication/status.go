@@ -0,0 +1,104 @@
+package replication
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clientconfig"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clierrors"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	clientutils "github.com/jfrog/jfrog-client-go/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const replicationStatusApi = "api/replication/"
+
+// ReplicationStatus is the status of the last replication run of a repository, as reported by Artifactory.
+type ReplicationStatus struct {
+	RepoKey string `json:"repoKey"`
+	// One of never_run, incomplete, error, warn, ok or inconsistent.
+	Status        string `json:"status"`
+	LastCompleted string `json:"lastCompleted,omitempty"`
+	// The status of each push replication target.
+	Targets []ReplicationTargetStatus `json:"targets,omitempty"`
+	// The status of each replicated repository, by its key.
+	Repositories map[string]ReplicationRunStatus `json:"repositories,omitempty"`
+}
+
+type ReplicationTargetStatus struct {
+	Url           string `json:"url"`
+	RepoKey       string `json:"repoKey,omitempty"`
+	Status        string `json:"status"`
+	LastCompleted string `json:"lastCompleted,omitempty"`
+}
+
+type ReplicationRunStatus struct {
+	Status        string `json:"status"`
+	LastCompleted string `json:"lastCompleted,omitempty"`
+}
+
+// ReplicationStatusCommand prints the status of the last replication run of a repository.
+type ReplicationStatusCommand struct {
+	serverDetails *config.ServerDetails
+	repoKey       string
+}
+
+func NewReplicationStatusCommand() *ReplicationStatusCommand {
+	return &ReplicationStatusCommand{}
+}
+
+func (rsc *ReplicationStatusCommand) SetRepoKey(repoKey string) *ReplicationStatusCommand {
+	rsc.repoKey = repoKey
+	return rsc
+}
+
+func (rsc *ReplicationStatusCommand) SetServerDetails(serverDetails *config.ServerDetails) *ReplicationStatusCommand {
+	rsc.serverDetails = serverDetails
+	return rsc
+}
+
+func (rsc *ReplicationStatusCommand) ServerDetails() (*config.ServerDetails, error) {
+	return rsc.serverDetails, nil
+}
+
+func (rsc *ReplicationStatusCommand) CommandName() string {
+	return "rt_replication_status"
+}
+
+func (rsc *ReplicationStatusCommand) Run() error {
+	servicesManager, err := clientconfig.CreateServiceManager(rsc.serverDetails, -1, 0, false)
+	if err != nil {
+		return err
+	}
+	status, err := getReplicationStatus(servicesManager, rsc.repoKey)
+	if err != nil {
+		return err
+	}
+	content, err := json.Marshal(status)
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	log.Output(clientutils.IndentJson(content))
+	return nil
+}
+
+func getReplicationStatus(servicesManager artifactory.ArtifactoryServicesManager, repoKey string) (*ReplicationStatus, error) {
+	serviceDetails := servicesManager.GetConfig().GetServiceDetails()
+	httpClientDetails := serviceDetails.CreateHttpClientDetails()
+	resp, body, _, err := servicesManager.Client().SendGet(serviceDetails.GetUrl()+replicationStatusApi+url.PathEscape(repoKey), true, &httpClientDetails)
+	if err != nil {
+		return nil, err
+	}
+	if err = clierrors.CheckResponseStatusWithBody(resp, body, http.StatusOK); err != nil {
+		return nil, err
+	}
+	status := &ReplicationStatus{}
+	if err = json.Unmarshal(body, status); err != nil {
+		return nil, errorutils.CheckErrorf("failed to parse the replication status of %s: %s", repoKey, err.Error())
+	}
+	status.RepoKey = repoKey
+	return status, nil
+}
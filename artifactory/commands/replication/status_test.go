@@ -0,0 +1,49 @@
+package replication
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	rtUtils "github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetReplicationStatus(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/replication/generic-local", r.URL.Path)
+		w.WriteHeader(http.StatusOK)
+		_, err := w.Write([]byte(`{"status":"ok","lastCompleted":"2024-05-01T10:00:00.000Z",
+			"targets":[{"url":"https://edge.example.com/artifactory/generic-remote","repoKey":"generic-local","status":"ok","lastCompleted":"2024-05-01T10:00:00.000Z"}],
+			"repositories":{"generic-local":{"status":"ok","lastCompleted":"2024-05-01T10:00:00.000Z"}}}`))
+		assert.NoError(t, err)
+	}))
+	defer testServer.Close()
+	servicesManager, err := rtUtils.CreateServiceManager(&config.ServerDetails{ArtifactoryUrl: testServer.URL + "/"}, 0, 0, false)
+	require.NoError(t, err)
+
+	status, err := getReplicationStatus(servicesManager, "generic-local")
+	require.NoError(t, err)
+	assert.Equal(t, &ReplicationStatus{
+		RepoKey:       "generic-local",
+		Status:        "ok",
+		LastCompleted: "2024-05-01T10:00:00.000Z",
+		Targets: []ReplicationTargetStatus{{Url: "https://edge.example.com/artifactory/generic-remote", RepoKey: "generic-local",
+			Status: "ok", LastCompleted: "2024-05-01T10:00:00.000Z"}},
+		Repositories: map[string]ReplicationRunStatus{"generic-local": {Status: "ok", LastCompleted: "2024-05-01T10:00:00.000Z"}},
+	}, status)
+}
+
+func TestGetReplicationStatusNotFound(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer testServer.Close()
+	servicesManager, err := rtUtils.CreateServiceManager(&config.ServerDetails{ArtifactoryUrl: testServer.URL + "/"}, 0, 0, false)
+	require.NoError(t, err)
+
+	_, err = getReplicationStatus(servicesManager, "generic-local")
+	assert.Error(t, err)
+}
@@ -38,6 +38,7 @@ const (
 	IncludePathPrefixPattern = "includePathPrefixPattern"
 	SocketTimeoutMillis      = "socketTimeoutMillis"
 	DisableProxy             = "disableProxy"
+	Proxy                    = "proxy"
 )
 
 type ReplicationTemplateCommand struct {
@@ -178,6 +179,14 @@ var questionMap = map[string]ioutils.QuestionInfo{
 		MapKey:       SocketTimeoutMillis,
 		Callback:     nil,
 	},
+	Proxy: {
+		Msg:          "",
+		PromptPrefix: "Enter the key of the proxy to replicate through >",
+		AllowVars:    true,
+		Writer:       ioutils.WriteStringAnswer,
+		MapKey:       Proxy,
+		Callback:     nil,
+	},
 }
 
 func jobTypeCallback(iq *ioutils.InteractiveQuestionnaire, jobType string) (string, error) {
@@ -191,7 +200,7 @@ func jobTypeCallback(iq *ioutils.InteractiveQuestionnaire, jobType string) (stri
 }
 
 func getAllPossibleOptionalRepoConfKeys(values ...string) []prompt.Suggest {
-	optionalKeys := []string{ioutils.SaveAndExit, Enabled, SyncDeletes, SyncProperties, SyncStatistics, PathPrefix, IncludePathPrefixPattern, EnableEventReplication, SocketTimeoutMillis}
+	optionalKeys := []string{ioutils.SaveAndExit, Enabled, SyncDeletes, SyncProperties, SyncStatistics, PathPrefix, IncludePathPrefixPattern, EnableEventReplication, SocketTimeoutMillis, Proxy}
 	if len(values) > 0 {
 		optionalKeys = append(optionalKeys, values...)
 	}
@@ -220,4 +229,5 @@ var suggestionMap = map[string]prompt.Suggest{
 	IncludePathPrefixPattern: {Text: IncludePathPrefixPattern},
 	SocketTimeoutMillis:      {Text: SocketTimeoutMillis},
 	DisableProxy:             {Text: DisableProxy},
+	Proxy:                    {Text: Proxy},
 }
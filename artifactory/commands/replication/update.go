@@ -0,0 +1,40 @@
+package replication
+
+import (
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+)
+
+type ReplicationUpdateCommand struct {
+	ReplicationCommand
+}
+
+func NewReplicationUpdateCommand() *ReplicationUpdateCommand {
+	return &ReplicationUpdateCommand{}
+}
+
+func (ruc *ReplicationUpdateCommand) SetTemplatePath(path string) *ReplicationUpdateCommand {
+	ruc.templatePath = path
+	return ruc
+}
+
+func (ruc *ReplicationUpdateCommand) SetVars(vars string) *ReplicationUpdateCommand {
+	ruc.vars = vars
+	return ruc
+}
+
+func (ruc *ReplicationUpdateCommand) SetServerDetails(serverDetails *config.ServerDetails) *ReplicationUpdateCommand {
+	ruc.serverDetails = serverDetails
+	return ruc
+}
+
+func (ruc *ReplicationUpdateCommand) ServerDetails() (*config.ServerDetails, error) {
+	return ruc.serverDetails, nil
+}
+
+func (ruc *ReplicationUpdateCommand) CommandName() string {
+	return "rt_replication_update"
+}
+
+func (ruc *ReplicationUpdateCommand) Run() (err error) {
+	return ruc.PerformReplicationCmd(true)
+}
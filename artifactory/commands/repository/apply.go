@@ -0,0 +1,267 @@
+package repository
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clientconfig"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	RepoApplyCreate    = "create"
+	RepoApplyUpdate    = "update"
+	RepoApplyUnchanged = "unchanged"
+)
+
+// RepoApplyPlan is the change of a single repository needed to match its configuration file.
+type RepoApplyPlan struct {
+	Key string `json:"key"`
+	// One of create, update or unchanged.
+	Action string `json:"action"`
+	// The configuration file of the repository.
+	Source  string             `json:"source"`
+	Changes []RepoConfigChange `json:"changes,omitempty"`
+	config  map[string]interface{}
+}
+
+// RepoConfigChange is a field of the repository configuration whose live value differs from the configured one.
+type RepoConfigChange struct {
+	Field string      `json:"field"`
+	Live  interface{} `json:"live"`
+	Want  interface{} `json:"want"`
+}
+
+// RepoApplyCommand creates and updates repositories to match a directory of configuration files, updating only the
+// repositories whose live configuration drifted.
+type RepoApplyCommand struct {
+	serverDetails *config.ServerDetails
+	configDir     string
+	vars          string
+	dryRun        bool
+}
+
+func NewRepoApplyCommand() *RepoApplyCommand {
+	return &RepoApplyCommand{}
+}
+
+// SetConfigDir sets the directory of the repositories configuration files. Each JSON or YAML file holds the configuration
+// of a single repository, or a list of configurations, in the format of the Artifactory repositories REST API.
+func (rac *RepoApplyCommand) SetConfigDir(configDir string) *RepoApplyCommand {
+	rac.configDir = configDir
+	return rac
+}
+
+func (rac *RepoApplyCommand) SetVars(vars string) *RepoApplyCommand {
+	rac.vars = vars
+	return rac
+}
+
+func (rac *RepoApplyCommand) SetDryRun(dryRun bool) *RepoApplyCommand {
+	rac.dryRun = dryRun
+	return rac
+}
+
+func (rac *RepoApplyCommand) SetServerDetails(serverDetails *config.ServerDetails) *RepoApplyCommand {
+	rac.serverDetails = serverDetails
+	return rac
+}
+
+func (rac *RepoApplyCommand) ServerDetails() (*config.ServerDetails, error) {
+	return rac.serverDetails, nil
+}
+
+func (rac *RepoApplyCommand) CommandName() string {
+	return "rt_repo_apply"
+}
+
+func (rac *RepoApplyCommand) Run() error {
+	configs, sources, err := readRepoConfigs(rac.configDir, rac.vars)
+	if err != nil {
+		return err
+	}
+	servicesManager, err := clientconfig.CreateServiceManager(rac.serverDetails, -1, 0, false)
+	if err != nil {
+		return err
+	}
+	plans, err := planRepoApply(servicesManager, configs, sources)
+	if err != nil {
+		return err
+	}
+	log.Output(renderRepoApplyPlans(plans))
+	if rac.dryRun {
+		return nil
+	}
+	return applyRepoPlans(servicesManager, plans)
+}
+
+// readRepoConfigs reads the repositories configurations from the JSON and YAML files of the directory, ordered by the
+// file names. Returns the configurations and the file of each configuration.
+func readRepoConfigs(configDir, vars string) (configs []map[string]interface{}, sources []string, err error) {
+	entries, err := os.ReadDir(configDir)
+	if err != nil {
+		return nil, nil, errorutils.CheckError(err)
+	}
+	keys := make(map[string]string)
+	for _, entry := range entries {
+		extension := strings.ToLower(filepath.Ext(entry.Name()))
+		if entry.IsDir() || (extension != ".json" && extension != ".yaml" && extension != ".yml") {
+			continue
+		}
+		configPath := filepath.Join(configDir, entry.Name())
+		fileConfigs, err := readRepoConfigFile(configPath, extension, vars)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, repoConfig := range fileConfigs {
+			key, ok := repoConfig[Key].(string)
+			if !ok || key == "" {
+				return nil, nil, errorutils.CheckErrorf("'%s' has a repository configuration without a key", configPath)
+			}
+			if _, ok = repoConfig[Rclass].(string); !ok {
+				return nil, nil, errorutils.CheckErrorf("the configuration of the repository '%s' in '%s' has no rclass", key, configPath)
+			}
+			if otherPath, exists := keys[key]; exists {
+				return nil, nil, errorutils.CheckErrorf("the repository '%s' is configured in both '%s' and '%s'", key, otherPath, configPath)
+			}
+			keys[key] = configPath
+			configs = append(configs, repoConfig)
+			sources = append(sources, configPath)
+		}
+	}
+	if len(configs) == 0 {
+		return nil, nil, errorutils.CheckErrorf("no repository configuration files were found in '%s'", configDir)
+	}
+	return configs, sources, nil
+}
+
+func readRepoConfigFile(configPath, extension, vars string) ([]map[string]interface{}, error) {
+	content, err := os.ReadFile(configPath)
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	if len(vars) > 0 {
+		content = coreutils.ReplaceVars(content, coreutils.SpecVarsStringToMap(vars))
+	}
+	var parsed interface{}
+	if extension == ".json" {
+		err = json.Unmarshal(content, &parsed)
+	} else {
+		err = yaml.Unmarshal(content, &parsed)
+	}
+	if err != nil {
+		return nil, errorutils.CheckErrorf("failed to parse '%s': %s", configPath, err.Error())
+	}
+	// Normalize the parsed values to the types of a JSON configuration, so that they can be compared to the live ones.
+	if content, err = json.Marshal(parsed); err != nil {
+		return nil, errorutils.CheckErrorf("failed to parse '%s': %s", configPath, err.Error())
+	}
+	var configs []map[string]interface{}
+	if err = json.Unmarshal(content, &configs); err == nil {
+		return configs, nil
+	}
+	var repoConfig map[string]interface{}
+	if err = json.Unmarshal(content, &repoConfig); err != nil {
+		return nil, errorutils.CheckErrorf("'%s' should hold a repository configuration or a list of them", configPath)
+	}
+	return []map[string]interface{}{repoConfig}, nil
+}
+
+// planRepoApply compares the configurations to the live ones. Only the configured fields are compared, so fields left to
+// their defaults in the files don't count as changes.
+func planRepoApply(servicesManager artifactory.ArtifactoryServicesManager, configs []map[string]interface{}, sources []string) ([]RepoApplyPlan, error) {
+	var plans []RepoApplyPlan
+	for i, repoConfig := range configs {
+		key := repoConfig[Key].(string)
+		plan := RepoApplyPlan{Key: key, Source: sources[i], config: repoConfig}
+		exists, err := servicesManager.IsRepoExists(key)
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			plan.Action = RepoApplyCreate
+			plans = append(plans, plan)
+			continue
+		}
+		liveConfig := make(map[string]interface{})
+		if err = servicesManager.GetRepository(key, &liveConfig); err != nil {
+			return nil, err
+		}
+		plan.Changes = diffRepoConfig(liveConfig, repoConfig)
+		plan.Action = RepoApplyUnchanged
+		if len(plan.Changes) > 0 {
+			plan.Action = RepoApplyUpdate
+		}
+		plans = append(plans, plan)
+	}
+	return plans, nil
+}
+
+func diffRepoConfig(liveConfig, repoConfig map[string]interface{}) []RepoConfigChange {
+	var changes []RepoConfigChange
+	for field, want := range repoConfig {
+		if live := liveConfig[field]; !reflect.DeepEqual(live, want) {
+			changes = append(changes, RepoConfigChange{Field: field, Live: live, Want: want})
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Field < changes[j].Field
+	})
+	return changes
+}
+
+func renderRepoApplyPlans(plans []RepoApplyPlan) string {
+	builder := &strings.Builder{}
+	counts := make(map[string]int)
+	for _, plan := range plans {
+		counts[plan.Action]++
+		switch plan.Action {
+		case RepoApplyCreate:
+			fmt.Fprintf(builder, "+ %s (create from %s)\n", plan.Key, plan.Source)
+		case RepoApplyUpdate:
+			fmt.Fprintf(builder, "~ %s (update from %s)\n", plan.Key, plan.Source)
+			for _, change := range plan.Changes {
+				fmt.Fprintf(builder, "    %s: %s -> %s\n", change.Field, renderConfigValue(change.Live), renderConfigValue(change.Want))
+			}
+		}
+	}
+	fmt.Fprintf(builder, "%d to create, %d to update, %d unchanged.", counts[RepoApplyCreate], counts[RepoApplyUpdate], counts[RepoApplyUnchanged])
+	return builder.String()
+}
+
+func renderConfigValue(value interface{}) string {
+	if value == nil {
+		return "<unset>"
+	}
+	content, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(content)
+}
+
+func applyRepoPlans(servicesManager artifactory.ArtifactoryServicesManager, plans []RepoApplyPlan) error {
+	for _, plan := range plans {
+		var err error
+		switch plan.Action {
+		case RepoApplyCreate:
+			err = servicesManager.CreateRepositoryWithParams(plan.config, plan.Key)
+		case RepoApplyUpdate:
+			err = servicesManager.UpdateRepositoryWithParams(plan.config, plan.Key)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
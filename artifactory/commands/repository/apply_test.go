@@ -0,0 +1,120 @@
+package repository
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	rtUtils "github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeRepoConfigFiles(t *testing.T, files map[string]string) string {
+	configDir := t.TempDir()
+	for name, content := range files {
+		require.NoError(t, os.WriteFile(filepath.Join(configDir, name), []byte(content), 0644))
+	}
+	return configDir
+}
+
+func TestReadRepoConfigs(t *testing.T) {
+	configDir := writeRepoConfigFiles(t, map[string]string{
+		"a-generic.json": `{"key":"generic-local","rclass":"local","packageType":"generic","description":"${team} files"}`,
+		"b-maven.yaml": `
+- key: maven-local
+  rclass: local
+  packageType: maven
+  maxUniqueSnapshots: 5
+  handleSnapshots: true
+- key: maven-remote
+  rclass: remote
+  packageType: maven
+  url: https://repo.maven.apache.org/maven2
+`,
+		"README.md": "Not a repository configuration",
+	})
+	configs, sources, err := readRepoConfigs(configDir, "team=platform")
+	require.NoError(t, err)
+	require.Len(t, configs, 3)
+	assert.Equal(t, "platform files", configs[0]["description"])
+	// The YAML values are normalized to the types of JSON values.
+	assert.Equal(t, float64(5), configs[1]["maxUniqueSnapshots"])
+	assert.Equal(t, true, configs[1]["handleSnapshots"])
+	assert.Equal(t, "maven-remote", configs[2]["key"])
+	assert.Equal(t, []string{filepath.Join(configDir, "a-generic.json"), filepath.Join(configDir, "b-maven.yaml"), filepath.Join(configDir, "b-maven.yaml")}, sources)
+}
+
+func TestReadRepoConfigsErrors(t *testing.T) {
+	_, _, err := readRepoConfigs(writeRepoConfigFiles(t, map[string]string{"README.md": ""}), "")
+	assert.ErrorContains(t, err, "no repository configuration files were found")
+
+	_, _, err = readRepoConfigs(writeRepoConfigFiles(t, map[string]string{"repo.yml": "rclass: local"}), "")
+	assert.ErrorContains(t, err, "has a repository configuration without a key")
+
+	_, _, err = readRepoConfigs(writeRepoConfigFiles(t, map[string]string{
+		"a.json": `{"key":"generic-local","rclass":"local"}`,
+		"b.json": `{"key":"generic-local","rclass":"local"}`,
+	}), "")
+	assert.ErrorContains(t, err, "the repository 'generic-local' is configured in both")
+}
+
+func TestRepoApply(t *testing.T) {
+	liveConfigs := map[string]string{
+		"generic-local": `{"key":"generic-local","rclass":"local","packageType":"generic","description":"old","xrayIndex":false}`,
+		"maven-local":   `{"key":"maven-local","rclass":"local","packageType":"maven","maxUniqueSnapshots":5}`,
+	}
+	requests := make(map[string]string)
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := strings.TrimPrefix(r.URL.Path, "/api/repositories/")
+		if r.Method == http.MethodGet {
+			liveConfig, exists := liveConfigs[key]
+			if !exists {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+			_, err := w.Write([]byte(liveConfig))
+			assert.NoError(t, err)
+			return
+		}
+		content, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		requests[r.Method+" "+key] = string(content)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer testServer.Close()
+	servicesManager, err := rtUtils.CreateServiceManager(&config.ServerDetails{ArtifactoryUrl: testServer.URL + "/"}, 0, 0, false)
+	require.NoError(t, err)
+
+	configs := []map[string]interface{}{
+		{"key": "generic-local", "rclass": "local", "packageType": "generic", "description": "new", "xrayIndex": true},
+		{"key": "maven-local", "rclass": "local", "packageType": "maven", "maxUniqueSnapshots": float64(5)},
+		{"key": "npm-local", "rclass": "local", "packageType": "npm"},
+	}
+	plans, err := planRepoApply(servicesManager, configs, []string{"generic.json", "maven.json", "npm.json"})
+	require.NoError(t, err)
+	require.Len(t, plans, 3)
+	assert.Equal(t, RepoApplyUpdate, plans[0].Action)
+	assert.Equal(t, []RepoConfigChange{{Field: "description", Live: "old", Want: "new"}, {Field: "xrayIndex", Live: false, Want: true}}, plans[0].Changes)
+	assert.Equal(t, RepoApplyUnchanged, plans[1].Action)
+	assert.Equal(t, RepoApplyCreate, plans[2].Action)
+	assert.Equal(t, "+ npm-local (create from npm.json)\n"+
+		"~ generic-local (update from generic.json)\n"+
+		"    description: \"old\" -> \"new\"\n"+
+		"    xrayIndex: false -> true\n"+
+		"1 to create, 1 to update, 1 unchanged.", renderRepoApplyPlans([]RepoApplyPlan{plans[2], plans[0], plans[1]}))
+
+	require.NoError(t, applyRepoPlans(servicesManager, plans))
+	assert.Len(t, requests, 2)
+	var created map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(requests[http.MethodPut+" npm-local"]), &created))
+	assert.Equal(t, configs[2], created)
+	assert.Contains(t, requests, http.MethodPost+" generic-local")
+}
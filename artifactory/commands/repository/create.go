@@ -22,6 +22,11 @@ func (rcc *RepoCreateCommand) SetVars(vars string) *RepoCreateCommand {
 	return rcc
 }
 
+func (rcc *RepoCreateCommand) SetProjectKey(projectKey string) *RepoCreateCommand {
+	rcc.projectKey = projectKey
+	return rcc
+}
+
 func (rcc *RepoCreateCommand) SetServerDetails(serverDetails *config.ServerDetails) *RepoCreateCommand {
 	rcc.serverDetails = serverDetails
 	return rcc
@@ -4,16 +4,20 @@ import (
 	"path/filepath"
 	"strings"
 
-	rtUtils "github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
+	artifactoryutils "github.com/jfrog/jfrog-cli-artifactory/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clientconfig"
 	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
 	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
 	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/jfrog/jfrog-client-go/artifactory/services"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
 )
 
 type RepoDeleteCommand struct {
 	serverDetails *config.ServerDetails
 	repoPattern   string
 	quiet         bool
+	dryRun        bool
 }
 
 func NewRepoDeleteCommand() *RepoDeleteCommand {
@@ -30,6 +34,12 @@ func (rdc *RepoDeleteCommand) SetQuiet(quiet bool) *RepoDeleteCommand {
 	return rdc
 }
 
+// SetDryRun sets the command to list the repositories which match the pattern, without deleting them.
+func (rdc *RepoDeleteCommand) SetDryRun(dryRun bool) *RepoDeleteCommand {
+	rdc.dryRun = dryRun
+	return rdc
+}
+
 func (rdc *RepoDeleteCommand) SetServerDetails(serverDetails *config.ServerDetails) *RepoDeleteCommand {
 	rdc.serverDetails = serverDetails
 	return rdc
@@ -44,33 +54,60 @@ func (rdc *RepoDeleteCommand) CommandName() string {
 }
 
 func (rdc *RepoDeleteCommand) Run() (err error) {
-	servicesManager, err := rtUtils.CreateServiceManager(rdc.serverDetails, -1, 0, false)
+	servicesManager, err := clientconfig.CreateServiceManager(rdc.serverDetails, -1, 0, false)
+	if err != nil {
+		return err
+	}
+	repoKeys, err := rdc.getRepoKeys(servicesManager)
 	if err != nil {
 		return err
 	}
+	for _, repoKey := range repoKeys {
+		if rdc.dryRun {
+			artifactoryutils.LogDryRun("Deleting the repository", repoKey, "including all of its content")
+			continue
+		}
+		if err = rdc.deleteRepo(&servicesManager, repoKey); err != nil {
+			return err
+		}
+	}
+	return nil
+}
 
-	// A single repo to be deleted
+// getRepoKeys returns the keys of the repositories to delete. A single repository is deleted if no pattern is received.
+func (rdc *RepoDeleteCommand) getRepoKeys(servicesManager artifactory.ArtifactoryServicesManager) ([]string, error) {
 	if !strings.Contains(rdc.repoPattern, "*") {
-		return rdc.deleteRepo(&servicesManager, rdc.repoPattern)
+		if rdc.dryRun {
+			// The repository is only resolved on a dry run, so the deletion reports its own error if it doesn't exist.
+			exists, err := servicesManager.IsRepoExists(rdc.repoPattern)
+			if err != nil {
+				return nil, err
+			}
+			if !exists {
+				return nil, errorutils.CheckErrorf("the repository '%s' doesn't exist", rdc.repoPattern)
+			}
+		}
+		return []string{rdc.repoPattern}, nil
 	}
-
-	// A pattern for the repo name was received
 	repos, err := servicesManager.GetAllRepositories()
 	if err != nil {
-		return err
+		return nil, err
 	}
-	for _, repo := range *repos {
-		matched, err := filepath.Match(rdc.repoPattern, repo.Key)
+	return matchRepoKeys(rdc.repoPattern, *repos)
+}
+
+func matchRepoKeys(repoPattern string, repos []services.RepositoryDetails) ([]string, error) {
+	var repoKeys []string
+	for _, repo := range repos {
+		matched, err := filepath.Match(repoPattern, repo.Key)
 		if err != nil {
-			return err
+			return nil, errorutils.CheckError(err)
 		}
 		if matched {
-			if err := rdc.deleteRepo(&servicesManager, repo.Key); err != nil {
-				return err
-			}
+			repoKeys = append(repoKeys, repo.Key)
 		}
 	}
-	return nil
+	return repoKeys, nil
 }
 
 func (rdc *RepoDeleteCommand) deleteRepo(servicesManager *artifactory.ArtifactoryServicesManager, repoKey string) error {
@@ -0,0 +1,23 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/jfrog/jfrog-client-go/artifactory/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatchRepoKeys(t *testing.T) {
+	repos := []services.RepositoryDetails{{Key: "npm-local"}, {Key: "npm-remote"}, {Key: "maven-local"}}
+	repoKeys, err := matchRepoKeys("npm-*", repos)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"npm-local", "npm-remote"}, repoKeys)
+
+	repoKeys, err = matchRepoKeys("go-*", repos)
+	require.NoError(t, err)
+	assert.Empty(t, repoKeys)
+
+	_, err = matchRepoKeys("[", repos)
+	assert.Error(t, err)
+}
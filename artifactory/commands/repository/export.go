@@ -0,0 +1,91 @@
+package repository
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clientconfig"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// RepoExportCommand writes the live configurations of repositories to a directory, as files which can be applied with
+// the RepoApplyCommand.
+type RepoExportCommand struct {
+	serverDetails *config.ServerDetails
+	repoPattern   string
+	targetDir     string
+}
+
+func NewRepoExportCommand() *RepoExportCommand {
+	return &RepoExportCommand{}
+}
+
+func (rec *RepoExportCommand) SetRepoPattern(repoPattern string) *RepoExportCommand {
+	rec.repoPattern = repoPattern
+	return rec
+}
+
+func (rec *RepoExportCommand) SetTargetDir(targetDir string) *RepoExportCommand {
+	rec.targetDir = targetDir
+	return rec
+}
+
+func (rec *RepoExportCommand) SetServerDetails(serverDetails *config.ServerDetails) *RepoExportCommand {
+	rec.serverDetails = serverDetails
+	return rec
+}
+
+func (rec *RepoExportCommand) ServerDetails() (*config.ServerDetails, error) {
+	return rec.serverDetails, nil
+}
+
+func (rec *RepoExportCommand) CommandName() string {
+	return "rt_repo_export"
+}
+
+func (rec *RepoExportCommand) Run() error {
+	servicesManager, err := clientconfig.CreateServiceManager(rec.serverDetails, -1, 0, false)
+	if err != nil {
+		return err
+	}
+	return exportRepoConfigs(servicesManager, rec.repoPattern, rec.targetDir)
+}
+
+// exportRepoConfigs writes the configuration of each repository matching the pattern to <key>.json in the target directory.
+func exportRepoConfigs(servicesManager artifactory.ArtifactoryServicesManager, repoPattern, targetDir string) error {
+	repos, err := servicesManager.GetAllRepositories()
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(targetDir, 0755); err != nil {
+		return errorutils.CheckError(err)
+	}
+	exported := 0
+	for _, repo := range *repos {
+		matched, err := filepath.Match(repoPattern, repo.Key)
+		if err != nil {
+			return errorutils.CheckError(err)
+		}
+		if !matched {
+			continue
+		}
+		repoConfig := make(map[string]interface{})
+		if err = servicesManager.GetRepository(repo.Key, &repoConfig); err != nil {
+			return err
+		}
+		content, err := json.MarshalIndent(repoConfig, "", "  ")
+		if err != nil {
+			return errorutils.CheckError(err)
+		}
+		if err = os.WriteFile(filepath.Join(targetDir, repo.Key+".json"), content, 0644); err != nil {
+			return errorutils.CheckError(err)
+		}
+		exported++
+	}
+	log.Info("Exported the configurations of", exported, "repositories to", targetDir)
+	return nil
+}
@@ -0,0 +1,211 @@
+package repository
+
+import (
+	"strings"
+
+	artifactoryutils "github.com/jfrog/jfrog-cli-artifactory/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clientconfig"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/jfrog/jfrog-client-go/artifactory/services"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+type federationCmd struct {
+	serverDetails *config.ServerDetails
+	repoKey       string
+}
+
+func (fc *federationCmd) ServerDetails() (*config.ServerDetails, error) {
+	return fc.serverDetails, nil
+}
+
+// FederationConvertCommand converts a local repository to a federated repository.
+type FederationConvertCommand struct {
+	federationCmd
+}
+
+func NewFederationConvertCommand() *FederationConvertCommand {
+	return &FederationConvertCommand{}
+}
+
+func (fcc *FederationConvertCommand) SetRepoKey(repoKey string) *FederationConvertCommand {
+	fcc.repoKey = repoKey
+	return fcc
+}
+
+func (fcc *FederationConvertCommand) SetServerDetails(serverDetails *config.ServerDetails) *FederationConvertCommand {
+	fcc.serverDetails = serverDetails
+	return fcc
+}
+
+func (fcc *FederationConvertCommand) CommandName() string {
+	return "rt_federation_convert"
+}
+
+func (fcc *FederationConvertCommand) Run() error {
+	servicesManager, err := clientconfig.CreateServiceManager(fcc.serverDetails, -1, 0, false)
+	if err != nil {
+		return err
+	}
+	return servicesManager.ConvertLocalToFederatedRepository(fcc.repoKey)
+}
+
+// FederationSyncCommand triggers a full synchronization of a federated repository with all of its members, or with a
+// single member.
+type FederationSyncCommand struct {
+	federationCmd
+	mirrorUrl string
+}
+
+func NewFederationSyncCommand() *FederationSyncCommand {
+	return &FederationSyncCommand{}
+}
+
+func (fsc *FederationSyncCommand) SetRepoKey(repoKey string) *FederationSyncCommand {
+	fsc.repoKey = repoKey
+	return fsc
+}
+
+// SetMirrorUrl sets the URL of the member repository to synchronize with. If not set, all the members are synchronized.
+func (fsc *FederationSyncCommand) SetMirrorUrl(mirrorUrl string) *FederationSyncCommand {
+	fsc.mirrorUrl = mirrorUrl
+	return fsc
+}
+
+func (fsc *FederationSyncCommand) SetServerDetails(serverDetails *config.ServerDetails) *FederationSyncCommand {
+	fsc.serverDetails = serverDetails
+	return fsc
+}
+
+func (fsc *FederationSyncCommand) CommandName() string {
+	return "rt_federation_sync"
+}
+
+func (fsc *FederationSyncCommand) Run() error {
+	servicesManager, err := clientconfig.CreateServiceManager(fsc.serverDetails, -1, 0, false)
+	if err != nil {
+		return err
+	}
+	if fsc.mirrorUrl != "" {
+		return servicesManager.TriggerFederatedRepositoryFullSyncMirror(fsc.repoKey, fsc.mirrorUrl)
+	}
+	return servicesManager.TriggerFederatedRepositoryFullSyncAll(fsc.repoKey)
+}
+
+// FederationMemberCommand adds a member to a federated repository, or removes a member from it.
+type FederationMemberCommand struct {
+	federationCmd
+	memberUrl string
+	remove    bool
+	quiet     bool
+	dryRun    bool
+}
+
+func NewFederationMemberCommand() *FederationMemberCommand {
+	return &FederationMemberCommand{}
+}
+
+func (fmc *FederationMemberCommand) SetRepoKey(repoKey string) *FederationMemberCommand {
+	fmc.repoKey = repoKey
+	return fmc
+}
+
+// SetMemberUrl sets the URL of the member repository, for example https://acme.jfrog.io/artifactory/generic-federated.
+func (fmc *FederationMemberCommand) SetMemberUrl(memberUrl string) *FederationMemberCommand {
+	fmc.memberUrl = memberUrl
+	return fmc
+}
+
+func (fmc *FederationMemberCommand) SetRemove(remove bool) *FederationMemberCommand {
+	fmc.remove = remove
+	return fmc
+}
+
+func (fmc *FederationMemberCommand) SetQuiet(quiet bool) *FederationMemberCommand {
+	fmc.quiet = quiet
+	return fmc
+}
+
+// SetDryRun sets the command to verify the membership of the member, without updating the repository.
+func (fmc *FederationMemberCommand) SetDryRun(dryRun bool) *FederationMemberCommand {
+	fmc.dryRun = dryRun
+	return fmc
+}
+
+func (fmc *FederationMemberCommand) SetServerDetails(serverDetails *config.ServerDetails) *FederationMemberCommand {
+	fmc.serverDetails = serverDetails
+	return fmc
+}
+
+func (fmc *FederationMemberCommand) CommandName() string {
+	if fmc.remove {
+		return "rt_federation_member_remove"
+	}
+	return "rt_federation_member_add"
+}
+
+func (fmc *FederationMemberCommand) Run() error {
+	if fmc.remove && !fmc.dryRun && !fmc.quiet && !coreutils.AskYesNo("Are you sure you want to remove the member "+fmc.memberUrl+" from the federated repository "+fmc.repoKey+"?", false) {
+		return nil
+	}
+	servicesManager, err := clientconfig.CreateServiceManager(fmc.serverDetails, -1, 0, false)
+	if err != nil {
+		return err
+	}
+	return updateFederationMembers(servicesManager, fmc.repoKey, fmc.memberUrl, fmc.remove, fmc.dryRun)
+}
+
+// The body of a federated repository update, which replaces the members of the repository and keeps its other settings.
+// Unlike in the repository params, the members are sent even if empty, so that the last member can be removed.
+type federationMembersUpdate struct {
+	Key     string                               `json:"key"`
+	Rclass  string                               `json:"rclass"`
+	Members []services.FederatedRepositoryMember `json:"members"`
+}
+
+func updateFederationMembers(servicesManager artifactory.ArtifactoryServicesManager, repoKey, memberUrl string, remove, dryRun bool) error {
+	repoDetails := services.FederatedRepositoryBaseParams{}
+	if err := servicesManager.GetRepository(repoKey, &repoDetails); err != nil {
+		return err
+	}
+	if repoDetails.Rclass != services.FederatedRepositoryRepoType {
+		return errorutils.CheckErrorf("the repository %s is a %s repository rather than a federated repository", repoKey, repoDetails.Rclass)
+	}
+	members := []services.FederatedRepositoryMember{}
+	found := false
+	for _, member := range repoDetails.Members {
+		if isSameMemberUrl(member.Url, memberUrl) {
+			found = true
+			if remove {
+				continue
+			}
+		}
+		members = append(members, member)
+	}
+	switch {
+	case remove && !found:
+		return errorutils.CheckErrorf("%s is not a member of the federated repository %s", memberUrl, repoKey)
+	case !remove && found:
+		log.Info(memberUrl, "is already a member of the federated repository", repoKey)
+		return nil
+	case !remove:
+		enabled := true
+		members = append(members, services.FederatedRepositoryMember{Url: memberUrl, Enabled: &enabled})
+	}
+	if dryRun {
+		if remove {
+			artifactoryutils.LogDryRun("Removing the member", memberUrl, "from the federated repository", repoKey)
+		} else {
+			artifactoryutils.LogDryRun("Adding the member", memberUrl, "to the federated repository", repoKey)
+		}
+		return nil
+	}
+	return servicesManager.UpdateRepositoryWithParams(federationMembersUpdate{Key: repoKey, Rclass: services.FederatedRepositoryRepoType, Members: members}, repoKey)
+}
+
+func isSameMemberUrl(url, otherUrl string) bool {
+	return strings.TrimSuffix(url, "/") == strings.TrimSuffix(otherUrl, "/")
+}
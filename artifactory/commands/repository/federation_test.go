@@ -0,0 +1,110 @@
+package repository
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	rtUtils "github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testFederatedRepoKey = "generic-federated"
+	testMemberUrl        = "https://acme.jfrog.io/artifactory/generic-federated"
+	testOtherMemberUrl   = "https://edge.jfrog.io/artifactory/generic-federated"
+)
+
+// Creates a services manager of a mock server, which returns the repository configuration and records the updates to it.
+func createFederationMockServer(t *testing.T, repoConfig string, updates *[]federationMembersUpdate) (*httptest.Server, artifactory.ArtifactoryServicesManager) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/repositories/"+testFederatedRepoKey, r.URL.Path)
+		if r.Method == http.MethodGet {
+			w.WriteHeader(http.StatusOK)
+			_, err := w.Write([]byte(repoConfig))
+			assert.NoError(t, err)
+			return
+		}
+		assert.Equal(t, http.MethodPost, r.Method)
+		content, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		var update federationMembersUpdate
+		safeJSONDecode(t, content, &update)
+		*updates = append(*updates, update)
+		w.WriteHeader(http.StatusOK)
+	}))
+	servicesManager, err := rtUtils.CreateServiceManager(&config.ServerDetails{ArtifactoryUrl: testServer.URL + "/"}, 0, 0, false)
+	require.NoError(t, err)
+	return testServer, servicesManager
+}
+
+func TestUpdateFederationMembers(t *testing.T) {
+	repoConfig := `{"key":"generic-federated","rclass":"federated","packageType":"generic","members":[{"url":"` + testMemberUrl + `","enabled":true}]}`
+	var updates []federationMembersUpdate
+	testServer, servicesManager := createFederationMockServer(t, repoConfig, &updates)
+	defer testServer.Close()
+
+	// Add a new member
+	require.NoError(t, updateFederationMembers(servicesManager, testFederatedRepoKey, testOtherMemberUrl, false, false))
+	require.Len(t, updates, 1)
+	assert.Equal(t, "federated", updates[0].Rclass)
+	if assert.Len(t, updates[0].Members, 2) {
+		assert.Equal(t, testMemberUrl, updates[0].Members[0].Url)
+		assert.Equal(t, testOtherMemberUrl, updates[0].Members[1].Url)
+	}
+
+	// Adding an existing member doesn't update the repository
+	require.NoError(t, updateFederationMembers(servicesManager, testFederatedRepoKey, testMemberUrl+"/", false, false))
+	assert.Len(t, updates, 1)
+
+	// Remove the last member
+	require.NoError(t, updateFederationMembers(servicesManager, testFederatedRepoKey, testMemberUrl, true, false))
+	require.Len(t, updates, 2)
+	assert.NotNil(t, updates[1].Members)
+	assert.Empty(t, updates[1].Members)
+
+	// A dry run doesn't update the repository
+	require.NoError(t, updateFederationMembers(servicesManager, testFederatedRepoKey, testOtherMemberUrl, false, true))
+	require.NoError(t, updateFederationMembers(servicesManager, testFederatedRepoKey, testMemberUrl, true, true))
+	assert.Len(t, updates, 2)
+
+	// Remove a missing member
+	assert.EqualError(t, updateFederationMembers(servicesManager, testFederatedRepoKey, testOtherMemberUrl, true, false),
+		testOtherMemberUrl+" is not a member of the federated repository generic-federated")
+}
+
+func TestUpdateFederationMembersNotFederated(t *testing.T) {
+	var updates []federationMembersUpdate
+	testServer, servicesManager := createFederationMockServer(t, `{"key":"generic-federated","rclass":"local","packageType":"generic"}`, &updates)
+	defer testServer.Close()
+
+	assert.EqualError(t, updateFederationMembers(servicesManager, testFederatedRepoKey, testMemberUrl, false, false),
+		"the repository generic-federated is a local repository rather than a federated repository")
+	assert.Empty(t, updates)
+}
+
+func TestGetFederationStatus(t *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/federation/status/repo/"+testFederatedRepoKey, r.URL.Path)
+		w.WriteHeader(http.StatusOK)
+		_, err := w.Write([]byte(`{"localKey":"generic-federated","binariesTasksInfo":{"inProgressTasks":2,"failingTasks":0},
+			"mirrors":[{"remoteUrl":"` + testMemberUrl + `","remoteRepoKey":"generic-federated","status":"SYNCHRONIZING","lastEventTime":1714557600000,"lagInMS":1500}]}`))
+		assert.NoError(t, err)
+	}))
+	defer testServer.Close()
+	servicesManager, err := rtUtils.CreateServiceManager(&config.ServerDetails{ArtifactoryUrl: testServer.URL + "/"}, 0, 0, false)
+	require.NoError(t, err)
+
+	status, err := getFederationStatus(servicesManager, testFederatedRepoKey)
+	require.NoError(t, err)
+	assert.Equal(t, &FederationStatus{
+		LocalKey:          testFederatedRepoKey,
+		BinariesTasksInfo: &FederationBinariesTasks{InProgressTasks: 2},
+		Mirrors: []FederationMirrorStatus{{RemoteUrl: testMemberUrl, RemoteRepoKey: testFederatedRepoKey, Status: "SYNCHRONIZING",
+			LastEventTime: 1714557600000, LagInMS: 1500}},
+	}, status)
+}
@@ -0,0 +1,106 @@
+package repository
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clientconfig"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clierrors"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	clientutils "github.com/jfrog/jfrog-client-go/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const federationStatusApi = "api/federation/status/repo/"
+
+// FederationStatus is the synchronization status of a federated repository with each of its members, as reported by Artifactory.
+type FederationStatus struct {
+	LocalKey          string                     `json:"localKey"`
+	BinariesTasksInfo *FederationBinariesTasks   `json:"binariesTasksInfo,omitempty"`
+	Mirrors           []FederationMirrorStatus   `json:"mirrors"`
+	Failures          []FederationFailureDetails `json:"failures,omitempty"`
+}
+
+type FederationBinariesTasks struct {
+	InProgressTasks int `json:"inProgressTasks"`
+	FailingTasks    int `json:"failingTasks"`
+}
+
+type FederationMirrorStatus struct {
+	RemoteUrl     string `json:"remoteUrl"`
+	RemoteRepoKey string `json:"remoteRepoKey"`
+	// For example, SYNCHRONIZED, SYNCHRONIZING, OUT_OF_SYNC or DISABLED.
+	Status        string `json:"status"`
+	LastEventTime int64  `json:"lastEventTime,omitempty"`
+	LagInMS       int64  `json:"lagInMS,omitempty"`
+}
+
+type FederationFailureDetails struct {
+	RemoteUrl     string `json:"remoteUrl,omitempty"`
+	RemoteRepoKey string `json:"remoteRepoKey,omitempty"`
+	Message       string `json:"message,omitempty"`
+	Timestamp     int64  `json:"timestamp,omitempty"`
+}
+
+// FederationStatusCommand prints the synchronization status of a federated repository with each of its members.
+type FederationStatusCommand struct {
+	federationCmd
+}
+
+func NewFederationStatusCommand() *FederationStatusCommand {
+	return &FederationStatusCommand{}
+}
+
+func (fsc *FederationStatusCommand) SetRepoKey(repoKey string) *FederationStatusCommand {
+	fsc.repoKey = repoKey
+	return fsc
+}
+
+func (fsc *FederationStatusCommand) SetServerDetails(serverDetails *config.ServerDetails) *FederationStatusCommand {
+	fsc.serverDetails = serverDetails
+	return fsc
+}
+
+func (fsc *FederationStatusCommand) CommandName() string {
+	return "rt_federation_status"
+}
+
+func (fsc *FederationStatusCommand) Run() error {
+	servicesManager, err := clientconfig.CreateServiceManager(fsc.serverDetails, -1, 0, false)
+	if err != nil {
+		return err
+	}
+	status, err := getFederationStatus(servicesManager, fsc.repoKey)
+	if err != nil {
+		return err
+	}
+	content, err := json.Marshal(status)
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	log.Output(clientutils.IndentJson(content))
+	return nil
+}
+
+func getFederationStatus(servicesManager artifactory.ArtifactoryServicesManager, repoKey string) (*FederationStatus, error) {
+	serviceDetails := servicesManager.GetConfig().GetServiceDetails()
+	httpClientDetails := serviceDetails.CreateHttpClientDetails()
+	resp, body, _, err := servicesManager.Client().SendGet(serviceDetails.GetUrl()+federationStatusApi+url.PathEscape(repoKey), true, &httpClientDetails)
+	if err != nil {
+		return nil, err
+	}
+	if err = clierrors.CheckResponseStatusWithBody(resp, body, http.StatusOK); err != nil {
+		return nil, err
+	}
+	status := &FederationStatus{}
+	if err = json.Unmarshal(body, status); err != nil {
+		return nil, errorutils.CheckErrorf("failed to parse the federation status of %s: %s", repoKey, err.Error())
+	}
+	if status.Mirrors == nil {
+		status.Mirrors = []FederationMirrorStatus{}
+	}
+	return status, nil
+}
@@ -9,8 +9,9 @@ import (
 	"strconv"
 	"strings"
 
+	artifactoryutils "github.com/jfrog/jfrog-cli-artifactory/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clientconfig"
 	"github.com/jfrog/jfrog-cli-core/v2/artifactory/commands/utils"
-	rtUtils "github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
 	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
 	"github.com/jfrog/jfrog-cli-core/v2/utils/ioutils"
 	"github.com/jfrog/jfrog-client-go/artifactory"
@@ -28,6 +29,7 @@ type RepoCommand struct {
 	serverDetails *config.ServerDetails
 	templatePath  string
 	vars          string
+	projectKey    string
 }
 
 func (rc *RepoCommand) Vars() string {
@@ -80,14 +82,61 @@ func (rc *RepoCommand) PerformRepoCmd(isUpdate bool) (err error) {
 		return fmt.Errorf("'key' is missing in the following configs\n: %v", missingKeys)
 	}
 
-	servicesManager, err := rtUtils.CreateServiceManager(rc.serverDetails, -1, 0, false)
+	servicesManager, err := clientconfig.CreateServiceManager(rc.serverDetails, -1, 0, false)
 	if err != nil {
 		return err
 	}
+	if rc.projectKey != "" {
+		if err = applyProjectKey(repoConfigMaps, artifactoryutils.NewProjectRepoResolver(servicesManager, rc.projectKey), rc.projectKey); err != nil {
+			return err
+		}
+	}
 
 	return strategy.Execute(repoConfigMaps, servicesManager, isUpdate)
 }
 
+// Assigns the repositories to the project, prefixing their keys by the project key.
+// The repositories aggregated by virtual repositories are translated to the project's repositories, if they exist.
+func applyProjectKey(repoConfigMaps []map[string]interface{}, resolver *artifactoryutils.ProjectRepoResolver, projectKey string) error {
+	for _, repoConfigMap := range repoConfigMaps {
+		if configProjectKey, ok := repoConfigMap[ProjectKey]; ok && configProjectKey != "" && configProjectKey != projectKey {
+			return errorutils.CheckErrorf("the repository '%v' is configured with the project key '%v', which doesn't match the project '%s'", repoConfigMap["key"], configProjectKey, projectKey)
+		}
+		repoConfigMap[ProjectKey] = projectKey
+		repoConfigMap["key"] = artifactoryutils.ProjectRepoName(projectKey, fmt.Sprint(repoConfigMap["key"]))
+
+		if defaultDeploymentRepo, ok := repoConfigMap[DefaultDeploymentRepo].(string); ok && defaultDeploymentRepo != "" {
+			resolved, err := resolver.Resolve(defaultDeploymentRepo)
+			if err != nil {
+				return err
+			}
+			repoConfigMap[DefaultDeploymentRepo] = resolved
+		}
+		switch repositories := repoConfigMap[Repositories].(type) {
+		case []interface{}:
+			for i, repo := range repositories {
+				resolved, err := resolver.Resolve(fmt.Sprint(repo))
+				if err != nil {
+					return err
+				}
+				repositories[i] = resolved
+			}
+		case string:
+			// Templates of a single repository hold the aggregated repositories as a comma-separated list.
+			var resolvedRepos []string
+			for _, repo := range strings.Split(repositories, ",") {
+				resolved, err := resolver.Resolve(strings.TrimSpace(repo))
+				if err != nil {
+					return err
+				}
+				resolvedRepos = append(resolvedRepos, resolved)
+			}
+			repoConfigMap[Repositories] = strings.Join(resolvedRepos, ",")
+		}
+	}
+	return nil
+}
+
 func (m *MultipleRepositoryHandler) Execute(repoConfigMaps []map[string]interface{}, servicesManager artifactory.ArtifactoryServicesManager, isUpdate bool) error {
 	content, err := json.Marshal(repoConfigMaps)
 	if err != nil {
@@ -0,0 +1,343 @@
+package repository
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/formats"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clientconfig"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clierrors"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	serviceutils "github.com/jfrog/jfrog-client-go/artifactory/services/utils"
+	clientutils "github.com/jfrog/jfrog-client-go/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// The sources from which a virtual repository serves a path.
+const (
+	SourceLocal  = "local"
+	SourceCache  = "cache"
+	SourceRemote = "remote"
+)
+
+// The results of looking a path up in an aggregated repository.
+const (
+	stepFound    = "found"
+	stepNotFound = "not found"
+	stepExcluded = "excluded by the repository patterns"
+	stepOffline  = "offline"
+	stepSkipped  = "not checked"
+)
+
+// ResolveTrace describes how a virtual repository resolves a path: the aggregated repositories it looked the path up in,
+// in the order of the resolution, and the one which served it.
+type ResolveTrace struct {
+	VirtualRepo string `json:"virtualRepo"`
+	Path        string `json:"path"`
+	// The aggregated repository which serves the path. Empty if none of them holds it.
+	ResolvedRepo string `json:"resolvedRepo,omitempty"`
+	// Whether the path is served by a local repository, by the cache of a remote repository, or fetched from the remote.
+	Source string `json:"source,omitempty"`
+	// The URL of the origin of a path served by a remote repository.
+	RemoteUrl   string             `json:"remoteUrl,omitempty"`
+	LastUpdated string             `json:"lastUpdated,omitempty"`
+	Sha256      string             `json:"sha256,omitempty"`
+	Cache       *CacheFreshness    `json:"cache,omitempty"`
+	Steps       []ResolveTraceStep `json:"steps"`
+}
+
+// CacheFreshness is the age of a cached file, compared to the retrieval cache period of its remote repository. Once the
+// period passes, Artifactory checks the remote for updates of the metadata and snapshot files.
+type CacheFreshness struct {
+	AgeSecs    int64 `json:"ageSecs"`
+	PeriodSecs int64 `json:"periodSecs"`
+	Expired    bool  `json:"expired"`
+}
+
+type ResolveTraceStep struct {
+	Repo   string `json:"repo"`
+	Source string `json:"source"`
+	Result string `json:"result"`
+}
+
+type resolveTraceRow struct {
+	Repo   string `col-name:"Repository"`
+	Source string `col-name:"Source"`
+	Result string `col-name:"Result"`
+}
+
+type resolvedRow struct {
+	Repo        string `col-name:"Resolved Repository"`
+	Source      string `col-name:"Source"`
+	RemoteUrl   string `col-name:"Remote URL"`
+	LastUpdated string `col-name:"Last Updated"`
+	Freshness   string `col-name:"Cache Freshness"`
+}
+
+func (rt *ResolveTrace) Tables() []formats.Table {
+	var steps []resolveTraceRow
+	for _, step := range rt.Steps {
+		steps = append(steps, resolveTraceRow(step))
+	}
+	tables := []formats.Table{{Title: "Resolution of " + rt.VirtualRepo + "/" + rt.Path, Rows: steps, EmptyMessage: "The virtual repository aggregates no repositories"}}
+	var resolved []resolvedRow
+	if rt.ResolvedRepo != "" {
+		freshness := ""
+		if rt.Cache != nil {
+			freshness = fmt.Sprintf("cached %ds ago, period %ds", rt.Cache.AgeSecs, rt.Cache.PeriodSecs)
+			if rt.Cache.Expired {
+				freshness += " (expired)"
+			}
+		}
+		resolved = append(resolved, resolvedRow{rt.ResolvedRepo, rt.Source, rt.RemoteUrl, rt.LastUpdated, freshness})
+	}
+	return append(tables, formats.Table{Title: "Resolved", Rows: resolved, EmptyMessage: "None of the aggregated repositories holds the path"})
+}
+
+// The configuration of a repository, which determines how a virtual repository resolves paths through it.
+type traceRepoConfig struct {
+	Key             string   `json:"key"`
+	Rclass          string   `json:"rclass"`
+	Url             string   `json:"url,omitempty"`
+	Offline         bool     `json:"offline,omitempty"`
+	IncludesPattern string   `json:"includesPattern,omitempty"`
+	ExcludesPattern string   `json:"excludesPattern,omitempty"`
+	Repositories    []string `json:"repositories,omitempty"`
+	// The number of seconds for which the metadata of a remote repository is cached.
+	RetrievalCachePeriodSecs int64 `json:"retrievalCachePeriodSecs,omitempty"`
+}
+
+// ResolveTraceCommand reports which of the repositories aggregated by a virtual repository serves a path. Like the
+// virtual repository, it looks the path up in the local repositories first, then in the caches of the remote
+// repositories, and finally in the remote repositories themselves, in the order in which they're aggregated.
+type ResolveTraceCommand struct {
+	serverDetails *config.ServerDetails
+	repoKey       string
+	path          string
+	format        string
+}
+
+func NewResolveTraceCommand() *ResolveTraceCommand {
+	return &ResolveTraceCommand{}
+}
+
+func (rtc *ResolveTraceCommand) SetRepoKey(repoKey string) *ResolveTraceCommand {
+	rtc.repoKey = repoKey
+	return rtc
+}
+
+func (rtc *ResolveTraceCommand) SetPath(path string) *ResolveTraceCommand {
+	rtc.path = strings.Trim(path, "/")
+	return rtc
+}
+
+func (rtc *ResolveTraceCommand) SetFormat(format string) *ResolveTraceCommand {
+	rtc.format = format
+	return rtc
+}
+
+func (rtc *ResolveTraceCommand) SetServerDetails(serverDetails *config.ServerDetails) *ResolveTraceCommand {
+	rtc.serverDetails = serverDetails
+	return rtc
+}
+
+func (rtc *ResolveTraceCommand) ServerDetails() (*config.ServerDetails, error) {
+	return rtc.serverDetails, nil
+}
+
+func (rtc *ResolveTraceCommand) CommandName() string {
+	return "rt_resolve_trace"
+}
+
+func (rtc *ResolveTraceCommand) Run() error {
+	outputFormat, err := formats.ParseFormat(rtc.format)
+	if err != nil {
+		return err
+	}
+	servicesManager, err := clientconfig.CreateServiceManager(rtc.serverDetails, -1, 0, false)
+	if err != nil {
+		return err
+	}
+	trace, err := traceResolution(servicesManager, rtc.repoKey, rtc.path, time.Now())
+	if err != nil {
+		return err
+	}
+	if outputFormat == "" {
+		outputFormat = formats.TableFormat
+	}
+	return formats.Print(outputFormat, formats.ResolveTraceKind, trace)
+}
+
+func traceResolution(servicesManager artifactory.ArtifactoryServicesManager, virtualRepo, path string, now time.Time) (*ResolveTrace, error) {
+	if path == "" {
+		return nil, errorutils.CheckErrorf("a path in the virtual repository is required")
+	}
+	virtual := new(traceRepoConfig)
+	if err := servicesManager.GetRepository(virtualRepo, virtual); err != nil {
+		return nil, err
+	}
+	if virtual.Rclass != "virtual" {
+		return nil, errorutils.CheckErrorf("'%s' is a %s repository. Only the resolution of virtual repositories can be traced", virtualRepo, virtual.Rclass)
+	}
+	aggregated, err := getAggregatedRepos(servicesManager, virtual, map[string]bool{virtualRepo: true})
+	if err != nil {
+		return nil, err
+	}
+	trace := &ResolveTrace{VirtualRepo: virtualRepo, Path: path, Steps: []ResolveTraceStep{}}
+	resolve := func(repo *traceRepoConfig, source string, storageRepo string) error {
+		if trace.ResolvedRepo != "" {
+			trace.Steps = append(trace.Steps, ResolveTraceStep{repo.Key, source, stepSkipped})
+			return nil
+		}
+		matched, err := matchRepoPatterns(repo, path)
+		if err != nil {
+			return err
+		}
+		if !matched {
+			trace.Steps = append(trace.Steps, ResolveTraceStep{repo.Key, source, stepExcluded})
+			return nil
+		}
+		if source == SourceRemote && repo.Offline {
+			trace.Steps = append(trace.Steps, ResolveTraceStep{repo.Key, source, stepOffline})
+			return nil
+		}
+		info, err := getStorageInfo(servicesManager, storageRepo+"/"+path)
+		if err != nil {
+			return err
+		}
+		if info == nil {
+			trace.Steps = append(trace.Steps, ResolveTraceStep{repo.Key, source, stepNotFound})
+			return nil
+		}
+		trace.Steps = append(trace.Steps, ResolveTraceStep{repo.Key, source, stepFound})
+		trace.ResolvedRepo, trace.Source, trace.LastUpdated, trace.Sha256 = repo.Key, source, info.LastUpdated, info.Checksums.Sha256
+		if source != SourceLocal {
+			trace.RemoteUrl = info.RemoteUrl
+			if trace.RemoteUrl == "" {
+				trace.RemoteUrl = strings.TrimSuffix(repo.Url, "/") + "/" + path
+			}
+		}
+		if source == SourceCache {
+			trace.Cache = getCacheFreshness(info, repo, now)
+		}
+		return nil
+	}
+	for _, phase := range []string{SourceLocal, SourceCache, SourceRemote} {
+		for _, repo := range aggregated {
+			isRemote := repo.Rclass == "remote"
+			switch {
+			case phase == SourceLocal && !isRemote:
+				err = resolve(repo, phase, repo.Key)
+			case phase == SourceCache && isRemote:
+				err = resolve(repo, phase, repo.Key+"-cache")
+			case phase == SourceRemote && isRemote:
+				err = resolve(repo, phase, repo.Key)
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	log.Debug(fmt.Sprintf("Traced the resolution of %s through %d repositories", path, len(aggregated)))
+	return trace, nil
+}
+
+// Returns the non-virtual repositories aggregated by the virtual repository, in the order of the resolution. The
+// repositories of nested virtual repositories replace them in the order.
+func getAggregatedRepos(servicesManager artifactory.ArtifactoryServicesManager, virtual *traceRepoConfig, visited map[string]bool) ([]*traceRepoConfig, error) {
+	var aggregated []*traceRepoConfig
+	for _, key := range virtual.Repositories {
+		if visited[key] {
+			continue
+		}
+		visited[key] = true
+		repo := new(traceRepoConfig)
+		if err := servicesManager.GetRepository(key, repo); err != nil {
+			return nil, err
+		}
+		if repo.Rclass != "virtual" {
+			aggregated = append(aggregated, repo)
+			continue
+		}
+		nested, err := getAggregatedRepos(servicesManager, repo, visited)
+		if err != nil {
+			return nil, err
+		}
+		aggregated = append(aggregated, nested...)
+	}
+	return aggregated, nil
+}
+
+// Returns true if the path matches the comma-separated include patterns of the repository, and none of its exclude
+// patterns.
+func matchRepoPatterns(repo *traceRepoConfig, path string) (bool, error) {
+	includes := repo.IncludesPattern
+	if includes == "" {
+		includes = "**/*"
+	}
+	included, err := matchAntPatterns(includes, path)
+	if err != nil || !included || repo.ExcludesPattern == "" {
+		return included, err
+	}
+	excluded, err := matchAntPatterns(repo.ExcludesPattern, path)
+	return !excluded, err
+}
+
+func matchAntPatterns(patterns, path string) (bool, error) {
+	for _, pattern := range strings.Split(patterns, ",") {
+		if pattern = strings.TrimSpace(pattern); pattern == "" {
+			continue
+		}
+		// The patterns are converted by the separator of the local file system.
+		patternRegexp, err := regexp.Compile(clientutils.AntToRegex(filepath.FromSlash(pattern)))
+		if err != nil {
+			return false, errorutils.CheckError(err)
+		}
+		if patternRegexp.MatchString(filepath.FromSlash(path)) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// Returns the storage info of the repository path, or nil if it doesn't exist.
+func getStorageInfo(servicesManager artifactory.ArtifactoryServicesManager, repoPath string) (*serviceutils.FileInfo, error) {
+	serviceDetails := servicesManager.GetConfig().GetServiceDetails()
+	storageUrl, err := clientutils.BuildUrl(serviceDetails.GetUrl(), "api/storage/"+repoPath, make(map[string]string))
+	if err != nil {
+		return nil, err
+	}
+	httpClientDetails := serviceDetails.CreateHttpClientDetails()
+	resp, body, _, err := servicesManager.Client().SendGet(storageUrl, true, &httpClientDetails)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if err = clierrors.CheckResponseStatusWithBody(resp, body, http.StatusOK); err != nil {
+		return nil, err
+	}
+	info := new(serviceutils.FileInfo)
+	if err = json.Unmarshal(body, info); err != nil {
+		return nil, errorutils.CheckErrorf("failed to parse the storage info of %s: %s", repoPath, err.Error())
+	}
+	return info, nil
+}
+
+func getCacheFreshness(info *serviceutils.FileInfo, repo *traceRepoConfig, now time.Time) *CacheFreshness {
+	lastUpdated, err := time.Parse("2006-01-02T15:04:05.000Z07:00", info.LastUpdated)
+	if err != nil {
+		log.Debug("Failed to parse the last update time '" + info.LastUpdated + "': " + err.Error())
+		return nil
+	}
+	age := int64(now.Sub(lastUpdated).Seconds())
+	return &CacheFreshness{AgeSecs: age, PeriodSecs: repo.RetrievalCachePeriodSecs, Expired: age > repo.RetrievalCachePeriodSecs}
+}
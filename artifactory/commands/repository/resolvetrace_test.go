@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	rtUtils "github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTraceResolution(t *testing.T) {
+	responses := map[string]string{
+		"/api/repositories/libs":            `{"key":"libs","rclass":"virtual","repositories":["libs-local","libs-nested"]}`,
+		"/api/repositories/libs-nested":     `{"key":"libs-nested","rclass":"virtual","repositories":["snapshots-local","central","offline-remote","libs"]}`,
+		"/api/repositories/libs-local":      `{"key":"libs-local","rclass":"local"}`,
+		"/api/repositories/snapshots-local": `{"key":"snapshots-local","rclass":"local","includesPattern":"**/*-SNAPSHOT/**"}`,
+		"/api/repositories/central":         `{"key":"central","rclass":"remote","url":"https://repo.maven.apache.org/maven2/","retrievalCachePeriodSecs":3600}`,
+		"/api/repositories/offline-remote":  `{"key":"offline-remote","rclass":"remote","offline":true}`,
+	}
+	var storage map[string]string
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, ok := responses[r.URL.Path]
+		if !ok {
+			body, ok = storage[r.URL.Path]
+		}
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, err := w.Write([]byte(body))
+		assert.NoError(t, err)
+	}))
+	defer testServer.Close()
+	servicesManager, err := rtUtils.CreateServiceManager(&config.ServerDetails{ArtifactoryUrl: testServer.URL + "/"}, 0, 0, false)
+	require.NoError(t, err)
+	const path = "org/app/1.0/app-1.0.jar"
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	// Served by the cache of the remote repository, once the local repositories don't hold it
+	storage = map[string]string{"/api/storage/central-cache/" + path: `{"repo":"central-cache","lastUpdated":"2026-01-01T10:00:00.000Z","remoteUrl":"https://repo.maven.apache.org/maven2/` + path + `","checksums":{"sha256":"abc"}}`}
+	trace, err := traceResolution(servicesManager, "libs", path, now)
+	require.NoError(t, err)
+	assert.Equal(t, "central", trace.ResolvedRepo)
+	assert.Equal(t, SourceCache, trace.Source)
+	assert.Equal(t, "https://repo.maven.apache.org/maven2/"+path, trace.RemoteUrl)
+	assert.Equal(t, "abc", trace.Sha256)
+	assert.Equal(t, &CacheFreshness{AgeSecs: 7200, PeriodSecs: 3600, Expired: true}, trace.Cache)
+	assert.Equal(t, []ResolveTraceStep{
+		{"libs-local", SourceLocal, stepNotFound},
+		{"snapshots-local", SourceLocal, stepExcluded},
+		{"central", SourceCache, stepFound},
+		{"offline-remote", SourceCache, stepSkipped},
+		{"central", SourceRemote, stepSkipped},
+		{"offline-remote", SourceRemote, stepSkipped},
+	}, trace.Steps)
+
+	// Served by a local repository before the cache
+	storage["/api/storage/libs-local/"+path] = `{"repo":"libs-local","lastUpdated":"2026-01-01T10:00:00.000Z"}`
+	trace, err = traceResolution(servicesManager, "libs", path, now)
+	require.NoError(t, err)
+	assert.Equal(t, "libs-local", trace.ResolvedRepo)
+	assert.Equal(t, SourceLocal, trace.Source)
+	assert.Empty(t, trace.RemoteUrl)
+	assert.Nil(t, trace.Cache)
+
+	// Not found anywhere, and the offline remote repository isn't queried
+	storage = map[string]string{}
+	trace, err = traceResolution(servicesManager, "libs", path, now)
+	require.NoError(t, err)
+	assert.Empty(t, trace.ResolvedRepo)
+	assert.Equal(t, ResolveTraceStep{"offline-remote", SourceRemote, stepOffline}, trace.Steps[len(trace.Steps)-1])
+
+	// Only virtual repositories can be traced
+	_, err = traceResolution(servicesManager, "libs-local", path, now)
+	assert.ErrorContains(t, err, "is a local repository")
+}
@@ -22,6 +22,11 @@ func (ruc *RepoUpdateCommand) SetVars(vars string) *RepoUpdateCommand {
 	return ruc
 }
 
+func (ruc *RepoUpdateCommand) SetProjectKey(projectKey string) *RepoUpdateCommand {
+	ruc.projectKey = projectKey
+	return ruc
+}
+
 func (ruc *RepoUpdateCommand) SetServerDetails(serverDetails *config.ServerDetails) *RepoUpdateCommand {
 	ruc.serverDetails = serverDetails
 	return ruc
@@ -0,0 +1,290 @@
+package retention
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jfrog/gofrog/parallel"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/aql"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clientconfig"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clierrors"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/protection"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/ratelimit"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	serviceutils "github.com/jfrog/jfrog-client-go/artifactory/services/utils"
+	clientutils "github.com/jfrog/jfrog-client-go/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// CleanupCommand deletes the files which the retention policies select, after printing the plan of the deletion.
+type CleanupCommand struct {
+	serverDetails *config.ServerDetails
+	policies      []Policy
+	dryRun        bool
+	threads       int
+	// The maximal number of files deleted per second. Zero means no limit.
+	deletesPerSecond int
+	auditTarget      string
+	plan             []PlannedDeletion
+	record           *AuditRecord
+}
+
+func NewCleanupCommand() *CleanupCommand {
+	return &CleanupCommand{threads: 1}
+}
+
+func (cc *CleanupCommand) SetServerDetails(serverDetails *config.ServerDetails) *CleanupCommand {
+	cc.serverDetails = serverDetails
+	return cc
+}
+
+func (cc *CleanupCommand) SetPolicies(policies []Policy) *CleanupCommand {
+	cc.policies = policies
+	return cc
+}
+
+func (cc *CleanupCommand) SetDryRun(dryRun bool) *CleanupCommand {
+	cc.dryRun = dryRun
+	return cc
+}
+
+func (cc *CleanupCommand) SetThreads(threads int) *CleanupCommand {
+	cc.threads = threads
+	return cc
+}
+
+func (cc *CleanupCommand) SetDeletesPerSecond(deletesPerSecond int) *CleanupCommand {
+	cc.deletesPerSecond = deletesPerSecond
+	return cc
+}
+
+// SetAuditTarget sets the Artifactory folder, such as 'audit-local/cleanup/', to which the audit log of each cleanup is uploaded.
+func (cc *CleanupCommand) SetAuditTarget(auditTarget string) *CleanupCommand {
+	cc.auditTarget = auditTarget
+	return cc
+}
+
+func (cc *CleanupCommand) Plan() []PlannedDeletion {
+	return cc.plan
+}
+
+// AuditRecord returns the record of the last run, or nil if it didn't reach the deletion.
+func (cc *CleanupCommand) AuditRecord() *AuditRecord {
+	return cc.record
+}
+
+func (cc *CleanupCommand) CommandName() string {
+	return "rt_cleanup"
+}
+
+func (cc *CleanupCommand) ServerDetails() (*config.ServerDetails, error) {
+	return cc.serverDetails, nil
+}
+
+func (cc *CleanupCommand) Run() error {
+	servicesManager, err := clientconfig.CreateServiceManager(cc.serverDetails, -1, 0, false)
+	if err != nil {
+		return err
+	}
+	if cc.plan, err = cc.createPlan(servicesManager, time.Now()); err != nil {
+		return err
+	}
+	var totalSize int64
+	for _, deletion := range cc.plan {
+		totalSize += deletion.Size
+		log.Output(fmt.Sprintf("Delete %s [%s] (%s)", deletion.Path, deletion.Policy, deletion.Reason))
+	}
+	log.Info(fmt.Sprintf("%d files of %d bytes are to be deleted by %d policies.", len(cc.plan), totalSize, len(cc.policies)))
+	if cc.dryRun {
+		return nil
+	}
+
+	cc.record = cc.deletePlan(servicesManager)
+	if cc.auditTarget != "" {
+		if err = cc.uploadAuditRecord(servicesManager); err != nil {
+			return err
+		}
+	}
+	if len(cc.record.Failed) > 0 {
+		return errorutils.CheckErrorf("failed to delete %d files, please review the logs", len(cc.record.Failed))
+	}
+	return nil
+}
+
+// Returns the files which the policies select, sorted by their path. A file selected by several policies is attributed to the first.
+// The files which are immutable or under legal hold, or are in such a folder, are never selected.
+func (cc *CleanupCommand) createPlan(servicesManager artifactory.ArtifactoryServicesManager, now time.Time) ([]PlannedDeletion, error) {
+	planned := make(map[string]bool)
+	var plan []PlannedDeletion
+	for i := range cc.policies {
+		policy := &cc.policies[i]
+		if err := policy.validate(); err != nil {
+			return nil, err
+		}
+		for _, repo := range policy.Repos {
+			query, err := policy.query(repo)
+			if err != nil {
+				return nil, err
+			}
+			var items []serviceutils.ResultItem
+			err = aql.SearchItems(servicesManager, query, aql.DefaultPageSize, func(item *serviceutils.ResultItem) error {
+				items = append(items, *item)
+				return nil
+			})
+			if err != nil {
+				return nil, err
+			}
+			deletions, err := policy.Plan(items, now)
+			if err != nil {
+				return nil, err
+			}
+			for _, deletion := range deletions {
+				if !planned[deletion.Path] {
+					planned[deletion.Path] = true
+					plan = append(plan, deletion)
+				}
+			}
+		}
+	}
+	paths := make([]string, len(plan))
+	for i, deletion := range plan {
+		paths[i] = deletion.Path
+	}
+	protected, err := protection.FindProtected(servicesManager, paths)
+	if err != nil {
+		return nil, err
+	}
+	plan = excludeProtected(plan, protected)
+	sort.SliceStable(plan, func(i, j int) bool {
+		return plan[i].Path < plan[j].Path
+	})
+	return plan, nil
+}
+
+func excludeProtected(plan []PlannedDeletion, protected []protection.Item) []PlannedDeletion {
+	if len(protected) == 0 {
+		return plan
+	}
+	kept := plan[:0]
+	for _, deletion := range plan {
+		if item := findProtection(deletion.Path, protected); item != nil {
+			log.Info(fmt.Sprintf("Keeping %s, selected by the policy '%s', since %s is %s.", deletion.Path, deletion.Policy, item.Path, item.Reason))
+			continue
+		}
+		kept = append(kept, deletion)
+	}
+	return kept
+}
+
+// Returns the protected item which is the file or one of its folders, or nil if the file isn't protected.
+func findProtection(filePath string, protected []protection.Item) *protection.Item {
+	for i := range protected {
+		if filePath == protected[i].Path || strings.HasPrefix(filePath, protected[i].Path+"/") {
+			return &protected[i]
+		}
+	}
+	return nil
+}
+
+// AuditRecord is the log of a cleanup, uploaded to Artifactory as a JSON file.
+type AuditRecord struct {
+	Timestamp string            `json:"timestamp"`
+	User      string            `json:"user,omitempty"`
+	Policies  []Policy          `json:"policies"`
+	Deleted   []PlannedDeletion `json:"deleted"`
+	Failed    []PlannedDeletion `json:"failed,omitempty"`
+	// The total size of the deleted files, in bytes.
+	FreedSize int64 `json:"freedSize"`
+}
+
+// Deletes the planned files in parallel, throttled to cc.deletesPerSecond.
+func (cc *CleanupCommand) deletePlan(servicesManager artifactory.ArtifactoryServicesManager) *AuditRecord {
+	record := &AuditRecord{Timestamp: time.Now().UTC().Format(time.RFC3339), User: cc.serverDetails.User, Policies: cc.policies, Deleted: []PlannedDeletion{}}
+	var limiter *ratelimit.Limiter
+	if cc.deletesPerSecond > 0 {
+		limiter = ratelimit.NewLimiter(int64(cc.deletesPerSecond))
+	}
+	threads := cc.threads
+	if threads <= 0 {
+		threads = 1
+	}
+	var mutex sync.Mutex
+	runner := parallel.NewBounedRunner(threads, false)
+	go func() {
+		defer runner.Done()
+		for _, deletion := range cc.plan {
+			_, _ = runner.AddTask(func(int) error {
+				limiter.WaitN(1)
+				err := deleteFile(servicesManager, deletion.Path)
+				mutex.Lock()
+				defer mutex.Unlock()
+				if err != nil {
+					log.Error(err)
+					record.Failed = append(record.Failed, deletion)
+					return nil
+				}
+				record.Deleted = append(record.Deleted, deletion)
+				record.FreedSize += deletion.Size
+				return nil
+			})
+		}
+	}()
+	runner.Run()
+	sort.Slice(record.Deleted, func(i, j int) bool {
+		return record.Deleted[i].Path < record.Deleted[j].Path
+	})
+	sort.Slice(record.Failed, func(i, j int) bool {
+		return record.Failed[i].Path < record.Failed[j].Path
+	})
+	log.Info(fmt.Sprintf("Deleted %d files, freeing %d bytes.", len(record.Deleted), record.FreedSize))
+	return record
+}
+
+func deleteFile(servicesManager artifactory.ArtifactoryServicesManager, filePath string) error {
+	log.Info("Deleting", filePath)
+	deleteUrl, err := clientutils.BuildUrl(servicesManager.GetConfig().GetServiceDetails().GetUrl(), filePath, make(map[string]string))
+	if err != nil {
+		return err
+	}
+	httpClientDetails := servicesManager.GetConfig().GetServiceDetails().CreateHttpClientDetails()
+	resp, body, err := servicesManager.Client().SendDelete(deleteUrl, nil, &httpClientDetails)
+	if err != nil {
+		return err
+	}
+	return clierrors.CheckResponseStatusWithBody(resp, body, http.StatusNoContent, http.StatusOK)
+}
+
+// Uploads the audit record under the audit target, as cleanup-<timestamp>.json.
+func (cc *CleanupCommand) uploadAuditRecord(servicesManager artifactory.ArtifactoryServicesManager) error {
+	content, err := json.MarshalIndent(cc.record, "", "  ")
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	recordTime, err := time.Parse(time.RFC3339, cc.record.Timestamp)
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	recordPath := clientutils.AddTrailingSlashIfNeeded(cc.auditTarget) + "cleanup-" + recordTime.Format("20060102T150405Z") + ".json"
+	recordUrl, err := clientutils.BuildUrl(servicesManager.GetConfig().GetServiceDetails().GetUrl(), recordPath, make(map[string]string))
+	if err != nil {
+		return err
+	}
+	httpClientDetails := servicesManager.GetConfig().GetServiceDetails().CreateHttpClientDetails()
+	serviceutils.SetContentType("application/json", &httpClientDetails.Headers)
+	resp, body, err := servicesManager.Client().SendPut(recordUrl, content, &httpClientDetails)
+	if err != nil {
+		return err
+	}
+	if err = clierrors.CheckResponseStatusWithBody(resp, body, http.StatusCreated, http.StatusOK); err != nil {
+		return err
+	}
+	log.Info("Uploaded the audit log of the cleanup to", recordPath)
+	return nil
+}
@@ -0,0 +1,23 @@
+package retention
+
+import (
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/protection"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExcludeProtected(t *testing.T) {
+	plan := []PlannedDeletion{
+		{Policy: "old", Path: "libs-local/app/1.0/app.jar"},
+		{Policy: "old", Path: "libs-local/app/1.1/app.jar"},
+		{Policy: "old", Path: "libs-local/frozen/a.zip"},
+		{Policy: "old", Path: "libs-local/frozen-copy/a.zip"},
+	}
+	protected := []protection.Item{
+		{Path: "libs-local/app/1.0/app.jar", Reason: "immutable"},
+		{Path: "libs-local/frozen", Reason: "under legal hold"},
+	}
+	kept := excludeProtected(plan, protected)
+	assert.Equal(t, []PlannedDeletion{{Policy: "old", Path: "libs-local/app/1.1/app.jar"}, {Policy: "old", Path: "libs-local/frozen-copy/a.zip"}}, kept)
+}
@@ -0,0 +1,216 @@
+package retention
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/commands/container"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/aql"
+	"github.com/jfrog/jfrog-cli-core/v2/common/project"
+	serviceutils "github.com/jfrog/jfrog-client-go/artifactory/services/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+)
+
+// Policy selects the files of its repositories to delete. A file is deleted only if it matches all the criteria set in the policy.
+type Policy struct {
+	Name  string   `mapstructure:"name" json:"name"`
+	Repos []string `mapstructure:"repos" json:"repos"`
+	// Pattern of the path of the files within the repository, in which '*' is a wildcard, for example 'org/acme/*'.
+	Path string `mapstructure:"path" json:"path,omitempty"`
+	// Pattern of the name of the files, for example '*.jar'.
+	FileName string `mapstructure:"fileName" json:"fileName,omitempty"`
+	// Files created earlier than this period, for example 180d.
+	OlderThan string `mapstructure:"olderThan" json:"olderThan,omitempty"`
+	// Files which weren't downloaded within this period, for example 90d. Files which were never downloaded match.
+	NotDownloadedWithin string `mapstructure:"notDownloadedWithin" json:"notDownloadedWithin,omitempty"`
+	// Files downloaded at most this number of times.
+	MaxDownloads *int `mapstructure:"maxDownloads" json:"maxDownloads,omitempty"`
+	// Files having all these properties, each of the form key=value.
+	Properties []string `mapstructure:"properties" json:"properties,omitempty"`
+	// Number of most recent versions of each package to keep. The folder holding a file is its version,
+	// and the parent of that folder is its package, as in the layout of Maven and npm repositories.
+	KeepVersions int `mapstructure:"keepVersions" json:"keepVersions,omitempty"`
+}
+
+// ReadPolicies reads the policies from a YAML file of the following form:
+//
+//	policies:
+//	  - name: stale-snapshots
+//	    repos: ["libs-snapshot-local"]
+//	    olderThan: 180d
+//	    notDownloadedWithin: 90d
+//	    keepVersions: 5
+//	    properties: ["release.status=snapshot"]
+func ReadPolicies(policiesPath string) ([]Policy, error) {
+	vConfig, err := project.ReadConfigFile(policiesPath, project.YAML)
+	if err != nil {
+		return nil, err
+	}
+	if !vConfig.IsSet("policies") {
+		return nil, errorutils.CheckErrorf("the '%s' policies file is missing the 'policies' section", policiesPath)
+	}
+	var policies []Policy
+	if err = vConfig.UnmarshalKey("policies", &policies); err != nil {
+		return nil, errorutils.CheckErrorf("failed parsing the 'policies' section of '%s': %s", policiesPath, err.Error())
+	}
+	if len(policies) == 0 {
+		return nil, errorutils.CheckErrorf("the '%s' policies file has no policies", policiesPath)
+	}
+	for i := range policies {
+		if err = policies[i].validate(); err != nil {
+			return nil, err
+		}
+	}
+	return policies, nil
+}
+
+func (p *Policy) validate() error {
+	if p.Name == "" {
+		return errorutils.CheckErrorf("each cleanup policy must have a name")
+	}
+	if len(p.Repos) == 0 {
+		return errorutils.CheckErrorf("the '%s' cleanup policy has no repositories", p.Name)
+	}
+	if p.KeepVersions < 0 || (p.MaxDownloads != nil && *p.MaxDownloads < 0) {
+		return errorutils.CheckErrorf("the numbers of the '%s' cleanup policy must not be negative", p.Name)
+	}
+	if p.OlderThan == "" && p.NotDownloadedWithin == "" && p.MaxDownloads == nil && len(p.Properties) == 0 && p.KeepVersions == 0 {
+		return errorutils.CheckErrorf("the '%s' cleanup policy has no criteria, which would delete all its files", p.Name)
+	}
+	for _, property := range p.Properties {
+		if key, _, found := strings.Cut(property, "="); !found || key == "" {
+			return errorutils.CheckErrorf("invalid property '%s' in the '%s' cleanup policy, expected key=value", property, p.Name)
+		}
+	}
+	if _, err := container.ParsePeriod(p.OlderThan); err != nil {
+		return err
+	}
+	_, err := container.ParsePeriod(p.NotDownloadedWithin)
+	return err
+}
+
+// Returns the AQL query of the files of the repository which the policy may delete. The age, download and version
+// criteria are evaluated on the results, since the versions to keep are ranked among all the files.
+func (p *Policy) query(repo string) (string, error) {
+	criteria := []aql.Criteria{aql.Field("repo", repo), aql.Field("type", "file")}
+	if p.Path != "" {
+		criteria = append(criteria, aql.Field("path", aql.Match(p.Path)))
+	}
+	if p.FileName != "" {
+		criteria = append(criteria, aql.Field("name", aql.Match(p.FileName)))
+	}
+	for _, property := range p.Properties {
+		key, value, _ := strings.Cut(property, "=")
+		criteria = append(criteria, aql.Field("@"+key, value))
+	}
+	return aql.Find("items", aql.And(criteria...)).
+		Include("repo", "path", "name", "size", "created", "stat.downloads", "stat.downloaded").
+		SortAsc("repo", "path", "name").
+		String()
+}
+
+// PlannedDeletion is a file which a policy deletes.
+type PlannedDeletion struct {
+	Policy string `json:"policy"`
+	Path   string `json:"path"`
+	Size   int64  `json:"size"`
+	Reason string `json:"reason"`
+}
+
+// Plan returns the files to delete out of the files matching the query of the policy.
+func (p *Policy) Plan(items []serviceutils.ResultItem, now time.Time) ([]PlannedDeletion, error) {
+	olderThan, err := container.ParsePeriod(p.OlderThan)
+	if err != nil {
+		return nil, err
+	}
+	notDownloadedWithin, err := container.ParsePeriod(p.NotDownloadedWithin)
+	if err != nil {
+		return nil, err
+	}
+	keptVersions := p.keptVersions(items)
+
+	var plan []PlannedDeletion
+	for _, item := range items {
+		var reasons []string
+		created, _ := time.Parse(time.RFC3339, item.Created)
+		if olderThan > 0 {
+			if created.IsZero() || now.Sub(created) < olderThan {
+				continue
+			}
+			reasons = append(reasons, "older than "+p.OlderThan)
+		}
+		downloads, lastDownloaded := itemStats(&item)
+		if notDownloadedWithin > 0 {
+			if !lastDownloaded.IsZero() && now.Sub(lastDownloaded) < notDownloadedWithin {
+				continue
+			}
+			reasons = append(reasons, "not downloaded within "+p.NotDownloadedWithin)
+		}
+		if p.MaxDownloads != nil {
+			if downloads > int64(*p.MaxDownloads) {
+				continue
+			}
+			reasons = append(reasons, fmt.Sprintf("downloaded %d times", downloads))
+		}
+		if p.KeepVersions > 0 {
+			if keptVersions[item.Repo+"/"+item.Path] {
+				continue
+			}
+			reasons = append(reasons, fmt.Sprintf("not one of the last %d versions", p.KeepVersions))
+		}
+		if len(p.Properties) > 0 {
+			reasons = append(reasons, "has "+strings.Join(p.Properties, ", "))
+		}
+		plan = append(plan, PlannedDeletion{Policy: p.Name, Path: item.GetItemRelativePath(), Size: item.Size, Reason: strings.Join(reasons, ", ")})
+	}
+	return plan, nil
+}
+
+// Returns the version folders to keep, as <repo>/<path>. The versions of each package are ranked by their most recently created file.
+func (p *Policy) keptVersions(items []serviceutils.ResultItem) map[string]bool {
+	kept := make(map[string]bool)
+	if p.KeepVersions == 0 {
+		return kept
+	}
+	versionsByPackage := make(map[string]map[string]time.Time)
+	for _, item := range items {
+		pkg := item.Repo + "/" + path.Dir(item.Path)
+		if versionsByPackage[pkg] == nil {
+			versionsByPackage[pkg] = make(map[string]time.Time)
+		}
+		created, _ := time.Parse(time.RFC3339, item.Created)
+		version := item.Repo + "/" + item.Path
+		if current, exists := versionsByPackage[pkg][version]; !exists || created.After(current) {
+			versionsByPackage[pkg][version] = created
+		}
+	}
+	for _, versions := range versionsByPackage {
+		var ordered []string
+		for version := range versions {
+			ordered = append(ordered, version)
+		}
+		// The most recently created versions first.
+		sort.Slice(ordered, func(i, j int) bool {
+			if !versions[ordered[i]].Equal(versions[ordered[j]]) {
+				return versions[ordered[i]].After(versions[ordered[j]])
+			}
+			return ordered[i] > ordered[j]
+		})
+		for i := 0; i < len(ordered) && i < p.KeepVersions; i++ {
+			kept[ordered[i]] = true
+		}
+	}
+	return kept
+}
+
+func itemStats(item *serviceutils.ResultItem) (downloads int64, lastDownloaded time.Time) {
+	if len(item.Stats) == 0 {
+		return 0, time.Time{}
+	}
+	downloads, _ = item.Stats[0].Downloads.Int64()
+	lastDownloaded, _ = time.Parse(time.RFC3339, item.Stats[0].Downloaded)
+	return downloads, lastDownloaded
+}
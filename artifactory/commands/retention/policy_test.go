@@ -0,0 +1,83 @@
+package retention
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	serviceutils "github.com/jfrog/jfrog-client-go/artifactory/services/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadPolicies(t *testing.T) {
+	policiesPath := filepath.Join(t.TempDir(), "policies.yaml")
+	require.NoError(t, os.WriteFile(policiesPath, []byte(`policies:
+  - name: stale-snapshots
+    repos: ["libs-snapshot-local"]
+    olderThan: 180d
+    maxDownloads: 0
+    properties: ["release.status=snapshot"]
+`), 0644))
+	policies, err := ReadPolicies(policiesPath)
+	require.NoError(t, err)
+	require.Len(t, policies, 1)
+	assert.Equal(t, "stale-snapshots", policies[0].Name)
+	assert.Equal(t, []string{"libs-snapshot-local"}, policies[0].Repos)
+	assert.Equal(t, "180d", policies[0].OlderThan)
+	require.NotNil(t, policies[0].MaxDownloads)
+	assert.Equal(t, 0, *policies[0].MaxDownloads)
+	assert.Equal(t, []string{"release.status=snapshot"}, policies[0].Properties)
+
+	require.NoError(t, os.WriteFile(policiesPath, []byte("policies:\n  - name: all\n    repos: [\"libs-local\"]\n"), 0644))
+	_, err = ReadPolicies(policiesPath)
+	assert.ErrorContains(t, err, "no criteria")
+}
+
+func TestPolicyQuery(t *testing.T) {
+	policy := Policy{Name: "jars", Repos: []string{"libs-local"}, FileName: "*.jar", Properties: []string{"status=old"}}
+	query, err := policy.query("libs-local")
+	require.NoError(t, err)
+	assert.Equal(t, `items.find({"$and":[{"repo":"libs-local"},{"type":"file"},{"name":{"$match":"*.jar"}},{"@status":"old"}]})`+
+		`.include("repo","path","name","size","created","stat.downloads","stat.downloaded").sort({"$asc":["repo","path","name"]})`, query)
+}
+
+func TestPlan(t *testing.T) {
+	now := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	day := 24 * time.Hour
+	item := func(path, name string, age time.Duration, downloads int, lastDownloaded time.Duration) serviceutils.ResultItem {
+		result := serviceutils.ResultItem{Repo: "libs-local", Path: path, Name: name, Size: 10, Created: now.Add(-age).Format(time.RFC3339)}
+		if downloads > 0 {
+			result.Stats = []serviceutils.Stat{{Downloads: json.Number(strconv.Itoa(downloads)), Downloaded: now.Add(-lastDownloaded).Format(time.RFC3339)}}
+		}
+		return result
+	}
+	items := []serviceutils.ResultItem{
+		item("org/app/1.0", "app-1.0.jar", 400*day, 0, 0),
+		item("org/app/1.1", "app-1.1.jar", 300*day, 1, 10*day),
+		item("org/app/1.2", "app-1.2.jar", 200*day, 0, 0),
+		item("org/app/1.3", "app-1.3.jar", 100*day, 0, 0),
+		item("org/lib/2.0", "lib-2.0.jar", 400*day, 0, 0),
+	}
+
+	policy := Policy{Name: "old", Repos: []string{"libs-local"}, OlderThan: "180d", NotDownloadedWithin: "30d", KeepVersions: 1}
+	plan, err := policy.Plan(items, now)
+	require.NoError(t, err)
+	assert.Equal(t, []PlannedDeletion{
+		{Policy: "old", Path: "libs-local/org/app/1.0/app-1.0.jar", Size: 10, Reason: "older than 180d, not downloaded within 30d, not one of the last 1 versions"},
+		{Policy: "old", Path: "libs-local/org/app/1.2/app-1.2.jar", Size: 10, Reason: "older than 180d, not downloaded within 30d, not one of the last 1 versions"},
+	}, plan)
+
+	maxDownloads := 0
+	policy = Policy{Name: "unused", Repos: []string{"libs-local"}, MaxDownloads: &maxDownloads, KeepVersions: 2}
+	plan, err = policy.Plan(items, now)
+	require.NoError(t, err)
+	var paths []string
+	for _, deletion := range plan {
+		paths = append(paths, deletion.Path)
+	}
+	assert.Equal(t, []string{"libs-local/org/app/1.0/app-1.0.jar"}, paths)
+}
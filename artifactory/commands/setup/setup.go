@@ -471,19 +471,7 @@ func (sc *SetupCommand) configureMaven() error {
 
 // configureGradle configures Gradle to use the specified Artifactory repository for both dependency resolution and publishing.
 func (sc *SetupCommand) configureGradle() error {
-	password := sc.serverDetails.GetPassword()
-	username := sc.serverDetails.GetUser()
-	if sc.serverDetails.GetAccessToken() != "" {
-		password = sc.serverDetails.GetAccessToken()
-		username = auth.ExtractUsernameFromAccessToken(password)
-	}
-	initScriptAuthConfig := gradle.InitScriptAuthConfig{
-		ArtifactoryURL:         sc.serverDetails.GetArtifactoryUrl(),
-		GradleRepoName:         sc.repoName,
-		ArtifactoryAccessToken: password,
-		ArtifactoryUsername:    username,
-	}
-	initScript, err := gradle.GenerateInitScript(initScriptAuthConfig)
+	initScript, err := gradle.GenerateInitScript(gradle.NewInitScriptAuthConfig(sc.serverDetails, sc.repoName))
 	if err != nil {
 		return fmt.Errorf("failed to generate Gradle init script: %w", err)
 	}
@@ -0,0 +1,65 @@
+package storage
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/jfrog/jfrog-client-go/artifactory/services/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+)
+
+const (
+	TableFormat = "table"
+	JsonFormat  = "json"
+	CsvFormat   = "csv"
+)
+
+// FormatStorageUsage formats the usages as a table, JSON or CSV.
+func FormatStorageUsage(usages []StorageUsage, format string) (string, error) {
+	switch format {
+	case TableFormat, "":
+		return formatStorageUsageTable(usages), nil
+	case JsonFormat:
+		if usages == nil {
+			usages = []StorageUsage{}
+		}
+		content, err := json.MarshalIndent(usages, "", "  ")
+		return string(content), errorutils.CheckError(err)
+	case CsvFormat:
+		return formatStorageUsageCsv(usages)
+	}
+	return "", errorutils.CheckErrorf("unsupported format '%s'. The supported formats are %s, %s and %s", format, TableFormat, JsonFormat, CsvFormat)
+}
+
+func formatStorageUsageTable(usages []StorageUsage) string {
+	if len(usages) == 0 {
+		return "No storage usage to report."
+	}
+	builder := &strings.Builder{}
+	writer := tabwriter.NewWriter(builder, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(writer, "NAME\tREPO TYPE\tPACKAGE TYPE\tPROJECT\tREPOSITORIES\tFILES\tUSED SPACE\tPERCENTAGE")
+	for _, usage := range usages {
+		fmt.Fprintf(writer, "%s\t%s\t%s\t%s\t%d\t%d\t%s\t%.2f%%\n", usage.Name, usage.RepoType, usage.PackageType, usage.ProjectKey,
+			usage.Repositories, usage.Files, utils.ConvertIntToStorageSizeString(usage.UsedSpaceInBytes), usage.Percentage)
+	}
+	_ = writer.Flush()
+	return strings.TrimSuffix(builder.String(), "\n")
+}
+
+func formatStorageUsageCsv(usages []StorageUsage) (string, error) {
+	builder := &strings.Builder{}
+	writer := csv.NewWriter(builder)
+	records := [][]string{{"name", "repoType", "packageType", "projectKey", "repositories", "files", "usedSpaceInBytes", "percentage"}}
+	for _, usage := range usages {
+		records = append(records, []string{usage.Name, usage.RepoType, usage.PackageType, usage.ProjectKey, strconv.Itoa(usage.Repositories),
+			strconv.FormatInt(usage.Files, 10), strconv.FormatInt(usage.UsedSpaceInBytes, 10), strconv.FormatFloat(usage.Percentage, 'f', 2, 64)})
+	}
+	if err := writer.WriteAll(records); err != nil {
+		return "", errorutils.CheckError(err)
+	}
+	return strings.TrimSuffix(builder.String(), "\n"), nil
+}
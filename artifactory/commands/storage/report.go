@@ -0,0 +1,292 @@
+// Package storage reports the storage usage of Artifactory, from its storage summary.
+package storage
+
+import (
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clientconfig"
+	rtUtils "github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/artifactory/services/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const (
+	GroupByRepo        = "repo"
+	GroupByPackageType = "package-type"
+	GroupByProject     = "project"
+
+	SortBySize  = "size"
+	SortByFiles = "files"
+	SortByName  = "name"
+
+	DefaultRecalculateTimeout = 10 * time.Minute
+	recalculatePollInterval   = 10 * time.Second
+	// The key of the summary row of all the repositories in the storage summary.
+	totalRepoKey = "TOTAL"
+)
+
+// storageInfoProvider gets the storage summary of Artifactory, and triggers its recalculation.
+type storageInfoProvider interface {
+	GetStorageInfo() (*utils.StorageInfo, error)
+	CalculateStorageInfo() error
+}
+
+// StorageUsage is the storage used by a repository, or by the repositories of a package type or a project.
+type StorageUsage struct {
+	Name             string  `json:"name"`
+	RepoType         string  `json:"repoType,omitempty"`
+	PackageType      string  `json:"packageType,omitempty"`
+	ProjectKey       string  `json:"projectKey,omitempty"`
+	Repositories     int     `json:"repositories"`
+	Files            int64   `json:"files"`
+	UsedSpaceInBytes int64   `json:"usedSpaceInBytes"`
+	Percentage       float64 `json:"percentage"`
+}
+
+// StorageReportCommand aggregates the storage summary of Artifactory by repository, package type or project, and prints
+// the usage sorted by size, files or name. The report may be limited to the usages above a minimal size, and the
+// command fails if any usage exceeds a maximal size.
+type StorageReportCommand struct {
+	serverDetails      *config.ServerDetails
+	groupBy            string
+	sortBy             string
+	format             string
+	minSize            int64
+	failAbove          int64
+	recalculate        bool
+	recalculateTimeout time.Duration
+	provider           storageInfoProvider
+	sleep              func(time.Duration)
+}
+
+func NewStorageReportCommand() *StorageReportCommand {
+	return &StorageReportCommand{groupBy: GroupByRepo, sortBy: SortBySize, format: TableFormat, recalculateTimeout: DefaultRecalculateTimeout, sleep: time.Sleep}
+}
+
+func (src *StorageReportCommand) SetGroupBy(groupBy string) *StorageReportCommand {
+	src.groupBy = groupBy
+	return src
+}
+
+func (src *StorageReportCommand) SetSortBy(sortBy string) *StorageReportCommand {
+	src.sortBy = sortBy
+	return src
+}
+
+func (src *StorageReportCommand) SetFormat(format string) *StorageReportCommand {
+	src.format = format
+	return src
+}
+
+// SetMinSize sets the size in bytes below which usages are left out of the report.
+func (src *StorageReportCommand) SetMinSize(minSize int64) *StorageReportCommand {
+	src.minSize = minSize
+	return src
+}
+
+// SetFailAbove sets the size in bytes above which a usage fails the command. Zero means no limit.
+func (src *StorageReportCommand) SetFailAbove(failAbove int64) *StorageReportCommand {
+	src.failAbove = failAbove
+	return src
+}
+
+// SetRecalculate sets whether to recalculate the storage summary and wait for the recalculated summary before reporting.
+func (src *StorageReportCommand) SetRecalculate(recalculate bool) *StorageReportCommand {
+	src.recalculate = recalculate
+	return src
+}
+
+func (src *StorageReportCommand) SetRecalculateTimeout(recalculateTimeout time.Duration) *StorageReportCommand {
+	src.recalculateTimeout = recalculateTimeout
+	return src
+}
+
+func (src *StorageReportCommand) SetServerDetails(serverDetails *config.ServerDetails) *StorageReportCommand {
+	src.serverDetails = serverDetails
+	return src
+}
+
+func (src *StorageReportCommand) ServerDetails() (*config.ServerDetails, error) {
+	return src.serverDetails, nil
+}
+
+func (src *StorageReportCommand) CommandName() string {
+	return "rt_storage_report"
+}
+
+func (src *StorageReportCommand) Run() error {
+	if src.provider == nil {
+		servicesManager, err := clientconfig.CreateServiceManager(src.serverDetails, -1, 0, false)
+		if err != nil {
+			return err
+		}
+		src.provider = servicesManager
+	}
+	storageInfo, err := src.getStorageInfo()
+	if err != nil {
+		return err
+	}
+	usages, err := aggregateStorageUsage(storageInfo, src.groupBy)
+	if err != nil {
+		return err
+	}
+	if err = sortStorageUsage(usages, src.sortBy); err != nil {
+		return err
+	}
+	var reported []StorageUsage
+	for _, usage := range usages {
+		if usage.UsedSpaceInBytes >= src.minSize {
+			reported = append(reported, usage)
+		}
+	}
+	output, err := FormatStorageUsage(reported, src.format)
+	if err != nil {
+		return err
+	}
+	log.Output(output)
+	return src.checkFailAbove(reported)
+}
+
+// Returns the storage summary, after recalculating it if required.
+func (src *StorageReportCommand) getStorageInfo() (*utils.StorageInfo, error) {
+	if !src.recalculate {
+		return src.provider.GetStorageInfo()
+	}
+	previous, err := src.provider.GetStorageInfo()
+	if err != nil {
+		return nil, err
+	}
+	if err = src.provider.CalculateStorageInfo(); err != nil {
+		return nil, err
+	}
+	log.Info("Waiting for the storage summary to be recalculated...")
+	// The API doesn't report the progress of the calculation, so the summary is recalculated once it changes.
+	deadline := time.Now().Add(src.recalculateTimeout)
+	for {
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			log.Warn("The storage summary didn't change within", src.recalculateTimeout.String()+". Reporting the current summary.")
+			return previous, nil
+		}
+		src.sleep(min(recalculatePollInterval, remaining))
+		current, err := src.provider.GetStorageInfo()
+		if err != nil {
+			return nil, err
+		}
+		if !reflect.DeepEqual(previous, current) {
+			return current, nil
+		}
+	}
+}
+
+func (src *StorageReportCommand) checkFailAbove(usages []StorageUsage) error {
+	if src.failAbove <= 0 {
+		return nil
+	}
+	var exceeding []string
+	for _, usage := range usages {
+		if usage.UsedSpaceInBytes > src.failAbove {
+			exceeding = append(exceeding, usage.Name)
+		}
+	}
+	if len(exceeding) > 0 {
+		return errorutils.CheckErrorf("the storage usage of %s exceeds %s", strings.Join(exceeding, ", "), utils.ConvertIntToStorageSizeString(src.failAbove))
+	}
+	return nil
+}
+
+// aggregateStorageUsage sums the usage of the repositories of the storage summary by the groupBy key.
+func aggregateStorageUsage(storageInfo *utils.StorageInfo, groupBy string) ([]StorageUsage, error) {
+	usages := make(map[string]*StorageUsage)
+	var names []string
+	var totalSize int64
+	for i := range storageInfo.RepositoriesSummaryList {
+		repoSummary := &storageInfo.RepositoriesSummaryList[i]
+		if repoSummary.RepoKey == totalRepoKey {
+			continue
+		}
+		size, err := rtUtils.GetUsedSpaceInBytes(repoSummary)
+		if err != nil {
+			return nil, err
+		}
+		var files int64
+		if repoSummary.FilesCount.String() != "" {
+			if files, err = rtUtils.GetFilesCountFromRepositorySummary(repoSummary); err != nil {
+				return nil, err
+			}
+		}
+		usage := StorageUsage{Name: repoSummary.RepoKey, RepoType: repoSummary.RepoType, PackageType: repoSummary.PackageType, ProjectKey: repoSummary.ProjectKey}
+		switch groupBy {
+		case GroupByRepo, "":
+		case GroupByPackageType:
+			usage = StorageUsage{Name: repoSummary.PackageType, PackageType: repoSummary.PackageType}
+		case GroupByProject:
+			usage = StorageUsage{Name: repoSummary.ProjectKey, ProjectKey: repoSummary.ProjectKey}
+			if usage.Name == "" {
+				// Repositories which aren't assigned to a project.
+				usage.Name = "-"
+			}
+		default:
+			return nil, errorutils.CheckErrorf("unsupported grouping '%s'. The supported groupings are %s, %s and %s", groupBy, GroupByRepo, GroupByPackageType, GroupByProject)
+		}
+		aggregated, exists := usages[usage.Name]
+		if !exists {
+			aggregated = &usage
+			usages[usage.Name] = aggregated
+			names = append(names, usage.Name)
+		}
+		aggregated.Repositories++
+		aggregated.Files += files
+		aggregated.UsedSpaceInBytes += size
+		totalSize += size
+	}
+	result := make([]StorageUsage, 0, len(names))
+	for _, name := range names {
+		usage := usages[name]
+		if totalSize > 0 {
+			usage.Percentage = float64(usage.UsedSpaceInBytes) * 100 / float64(totalSize)
+		}
+		result = append(result, *usage)
+	}
+	return result, nil
+}
+
+// sortStorageUsage sorts the usages by size or files in descending order, or by name in ascending order.
+func sortStorageUsage(usages []StorageUsage, sortBy string) error {
+	var less func(i, j int) bool
+	switch sortBy {
+	case SortBySize, "":
+		less = func(i, j int) bool { return usages[i].UsedSpaceInBytes > usages[j].UsedSpaceInBytes }
+	case SortByFiles:
+		less = func(i, j int) bool { return usages[i].Files > usages[j].Files }
+	case SortByName:
+		less = func(i, j int) bool { return usages[i].Name < usages[j].Name }
+	default:
+		return errorutils.CheckErrorf("unsupported sort '%s'. The supported sorts are %s, %s and %s", sortBy, SortBySize, SortByFiles, SortByName)
+	}
+	sort.SliceStable(usages, less)
+	return nil
+}
+
+var sizeUnits = map[string]int64{"": 1, "B": 1, "KB": utils.SizeKib, "MB": utils.SizeMiB, "GB": utils.SizeGiB, "TB": utils.SizeTiB}
+
+// ParseSize parses a size such as "500MB" or "2GB" into bytes. A number without a unit is a number of bytes.
+func ParseSize(size string) (int64, error) {
+	size = strings.ToUpper(strings.TrimSpace(size))
+	unitIndex := strings.IndexFunc(size, func(r rune) bool { return (r < '0' || r > '9') && r != '.' })
+	if unitIndex == -1 {
+		unitIndex = len(size)
+	}
+	multiplier, ok := sizeUnits[strings.TrimSpace(size[unitIndex:])]
+	value, err := strconv.ParseFloat(size[:unitIndex], 64)
+	if !ok || err != nil || value < 0 {
+		return 0, errorutils.CheckErrorf("invalid size '%s'. The size should be a number, optionally followed by a unit (KB, MB, GB or TB)", size)
+	}
+	return int64(value * float64(multiplier)), nil
+}
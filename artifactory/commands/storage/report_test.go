@@ -0,0 +1,123 @@
+package storage
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/jfrog/jfrog-client-go/artifactory/services/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestStorageInfo(usedSpace string) *utils.StorageInfo {
+	return &utils.StorageInfo{RepositoriesSummaryList: []utils.RepositorySummary{
+		{RepoKey: "maven-local", RepoType: "LOCAL", PackageType: "Maven", ProjectKey: "app", FilesCount: json.Number("10"), UsedSpaceInBytes: json.Number(usedSpace)},
+		{RepoKey: "npm-local", RepoType: "LOCAL", PackageType: "Npm", FilesCount: json.Number("30"), UsedSpaceInBytes: json.Number("1024")},
+		{RepoKey: "maven-remote", RepoType: "CACHE", PackageType: "Maven", ProjectKey: "app", FilesCount: json.Number("5"), UsedSpaceInBytes: json.Number("1024")},
+		{RepoKey: "TOTAL", RepoType: "NA", FilesCount: json.Number("45"), UsedSpaceInBytes: json.Number("5120")},
+	}}
+}
+
+func TestAggregateStorageUsage(t *testing.T) {
+	usages, err := aggregateStorageUsage(newTestStorageInfo("2048"), GroupByRepo)
+	require.NoError(t, err)
+	require.NoError(t, sortStorageUsage(usages, SortBySize))
+	require.Len(t, usages, 3)
+	assert.Equal(t, StorageUsage{Name: "maven-local", RepoType: "LOCAL", PackageType: "Maven", ProjectKey: "app", Repositories: 1, Files: 10, UsedSpaceInBytes: 2048, Percentage: 50}, usages[0])
+
+	usages, err = aggregateStorageUsage(newTestStorageInfo("2048"), GroupByPackageType)
+	require.NoError(t, err)
+	require.NoError(t, sortStorageUsage(usages, SortByFiles))
+	assert.Equal(t, []StorageUsage{
+		{Name: "Npm", PackageType: "Npm", Repositories: 1, Files: 30, UsedSpaceInBytes: 1024, Percentage: 25},
+		{Name: "Maven", PackageType: "Maven", Repositories: 2, Files: 15, UsedSpaceInBytes: 3072, Percentage: 75},
+	}, usages)
+
+	usages, err = aggregateStorageUsage(newTestStorageInfo("2048"), GroupByProject)
+	require.NoError(t, err)
+	require.NoError(t, sortStorageUsage(usages, SortByName))
+	assert.Equal(t, []string{"-", "app"}, []string{usages[0].Name, usages[1].Name})
+
+	_, err = aggregateStorageUsage(newTestStorageInfo("2048"), "owner")
+	assert.ErrorContains(t, err, "unsupported grouping 'owner'")
+	assert.ErrorContains(t, sortStorageUsage(usages, "age"), "unsupported sort 'age'")
+}
+
+func TestFormatStorageUsage(t *testing.T) {
+	usages := []StorageUsage{{Name: "maven-local", RepoType: "LOCAL", PackageType: "Maven", Repositories: 1, Files: 10, UsedSpaceInBytes: 2048, Percentage: 50}}
+	csvOutput, err := FormatStorageUsage(usages, CsvFormat)
+	require.NoError(t, err)
+	assert.Equal(t, "name,repoType,packageType,projectKey,repositories,files,usedSpaceInBytes,percentage\nmaven-local,LOCAL,Maven,,1,10,2048,50.00", csvOutput)
+
+	jsonOutput, err := FormatStorageUsage(nil, JsonFormat)
+	require.NoError(t, err)
+	assert.Equal(t, "[]", jsonOutput)
+
+	tableOutput, err := FormatStorageUsage(usages, TableFormat)
+	require.NoError(t, err)
+	assert.Contains(t, tableOutput, "2.0KB")
+
+	_, err = FormatStorageUsage(usages, "xml")
+	assert.ErrorContains(t, err, "unsupported format 'xml'")
+}
+
+func TestParseSize(t *testing.T) {
+	for size, expected := range map[string]int64{"100": 100, "2KB": 2048, "1.5 GB": 1536 * utils.SizeMiB, "1tb": utils.SizeTiB} {
+		parsed, err := ParseSize(size)
+		assert.NoError(t, err, size)
+		assert.Equal(t, expected, parsed, size)
+	}
+	for _, size := range []string{"", "GB", "10PB", "-1KB"} {
+		_, err := ParseSize(size)
+		assert.Error(t, err, size)
+	}
+}
+
+type testStorageInfoProvider struct {
+	storageInfos []*utils.StorageInfo
+	calls        int
+	calculated   bool
+}
+
+func (p *testStorageInfoProvider) GetStorageInfo() (*utils.StorageInfo, error) {
+	storageInfo := p.storageInfos[min(p.calls, len(p.storageInfos)-1)]
+	p.calls++
+	return storageInfo, nil
+}
+
+func (p *testStorageInfoProvider) CalculateStorageInfo() error {
+	p.calculated = true
+	return nil
+}
+
+func TestStorageReportRecalculate(t *testing.T) {
+	provider := &testStorageInfoProvider{storageInfos: []*utils.StorageInfo{newTestStorageInfo("2048"), newTestStorageInfo("2048"), newTestStorageInfo("4096")}}
+	src := NewStorageReportCommand().SetRecalculate(true)
+	src.provider = provider
+	src.sleep = func(time.Duration) {}
+	storageInfo, err := src.getStorageInfo()
+	require.NoError(t, err)
+	assert.True(t, provider.calculated)
+	assert.Equal(t, 3, provider.calls)
+	assert.Equal(t, json.Number("4096"), storageInfo.RepositoriesSummaryList[0].UsedSpaceInBytes)
+
+	// The current summary is reported if it doesn't change before the timeout.
+	provider = &testStorageInfoProvider{storageInfos: []*utils.StorageInfo{newTestStorageInfo("2048")}}
+	src = NewStorageReportCommand().SetRecalculate(true).SetRecalculateTimeout(time.Millisecond)
+	src.provider = provider
+	src.sleep = time.Sleep
+	storageInfo, err = src.getStorageInfo()
+	require.NoError(t, err)
+	assert.Equal(t, json.Number("2048"), storageInfo.RepositoriesSummaryList[0].UsedSpaceInBytes)
+}
+
+func TestStorageReportFailAbove(t *testing.T) {
+	src := NewStorageReportCommand().SetFormat(JsonFormat).SetMinSize(2048).SetFailAbove(1024)
+	src.provider = &testStorageInfoProvider{storageInfos: []*utils.StorageInfo{newTestStorageInfo("2048")}}
+	assert.ErrorContains(t, src.Run(), "the storage usage of maven-local exceeds 1.0KB")
+
+	src = NewStorageReportCommand().SetFailAbove(4096)
+	src.provider = &testStorageInfoProvider{storageInfos: []*utils.StorageInfo{newTestStorageInfo("2048")}}
+	assert.NoError(t, src.Run())
+}
@@ -0,0 +1,93 @@
+package swift
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/jfrog/build-info-go/build"
+	"github.com/jfrog/build-info-go/entities"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clientconfig"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/lockfile"
+	buildUtils "github.com/jfrog/jfrog-cli-core/v2/common/build"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const (
+	packageResolvedFileName = "Package.resolved"
+
+	swiftModuleType entities.ModuleType = "swift"
+)
+
+// saveLockfileDependencies saves the module of the working directory, with the packages pinned by its
+// Package.resolved. Packages resolved from source control, rather than from the registry, aren't included.
+func saveLockfileDependencies(swiftBuildInfo *build.Build, buildConfiguration *buildUtils.BuildConfiguration, serverDetails *config.ServerDetails, workingDir string) error {
+	return lockfile.SaveModule(swiftBuildInfo, serverDetails, filepath.Join(workingDir, packageResolvedFileName), lockfile.GetModuleId(buildConfiguration, workingDir), swiftModuleType)
+}
+
+// getPublishedPackage returns the package published by 'swift package-registry publish <scope>.<name> <version>',
+// whose source archive is <name>-<version>.zip.
+func getPublishedPackage(args []string) (lockfile.Package, error) {
+	var positionalArgs []string
+	for i := 0; i < len(args); i++ {
+		if strings.HasPrefix(args[i], "-") {
+			// Skip the value of the option, unless it's a flag or its value is attached.
+			if !strings.Contains(args[i], "=") && i+1 < len(args) && !strings.HasPrefix(args[i+1], "-") && !isPublishFlag(args[i]) {
+				i++
+			}
+			continue
+		}
+		positionalArgs = append(positionalArgs, args[i])
+	}
+	if len(positionalArgs) < 2 {
+		return lockfile.Package{}, errorutils.CheckErrorf("the id and the version of the published package are required: swift package-registry publish <scope>.<name> <version>")
+	}
+	packageId, version := positionalArgs[0], positionalArgs[1]
+	_, name, found := strings.Cut(packageId, ".")
+	if !found {
+		return lockfile.Package{}, errorutils.CheckErrorf("invalid package id '%s', which should be <scope>.<name>", packageId)
+	}
+	return lockfile.Package{Type: lockfile.Swift, Name: packageId, Version: version, FileNames: []string{name + "-" + version + ".zip"}}, nil
+}
+
+// The options of 'swift package-registry publish' which take no value.
+func isPublishFlag(option string) bool {
+	switch option {
+	case "--dry-run", "--allow-insecure-http", "-v", "--verbose", "--vv", "--very-verbose":
+		return true
+	}
+	return false
+}
+
+// collectPublishedPackage adds the source archive of the published package, as found in the repository, to the
+// build-info.
+func collectPublishedPackage(swiftBuildInfo *build.Build, buildConfiguration *buildUtils.BuildConfiguration, serverDetails *config.ServerDetails, repository string, args []string) error {
+	publishedPackage, err := getPublishedPackage(args)
+	if err != nil {
+		return err
+	}
+	servicesManager, err := clientconfig.CreateServiceManager(serverDetails, -1, 0, false)
+	if err != nil {
+		return err
+	}
+	found, _, err := lockfile.Resolve(servicesManager, []lockfile.Package{publishedPackage}, []string{repository})
+	if err != nil {
+		return err
+	}
+	if len(found) == 0 {
+		log.Warn("The published package", publishedPackage.Id(), "wasn't found in", repository+", and will not be included in the build-info")
+		return nil
+	}
+	moduleId := buildConfiguration.GetModule()
+	if moduleId == "" {
+		moduleId = publishedPackage.Id()
+	}
+	artifact := entities.Artifact{
+		Name:     publishedPackage.FileNames[0],
+		Type:     "zip",
+		Checksum: found[0].Checksum,
+	}
+	log.Debug("Adding the published package", publishedPackage.Id(), "to the build-info")
+	return errorutils.CheckError(swiftBuildInfo.AddArtifacts(moduleId, swiftModuleType, artifact))
+}
@@ -0,0 +1,174 @@
+package swift
+
+import (
+	"errors"
+	"io"
+	"net/url"
+	"os"
+	"os/exec"
+	"slices"
+	"strings"
+
+	gofrogcmd "github.com/jfrog/gofrog/io"
+	buildUtils "github.com/jfrog/jfrog-cli-core/v2/common/build"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const (
+	publishCommand = "publish"
+	packageCommand = "package"
+)
+
+// The commands which resolve the packages of Package.resolved, whose dependencies are collected into the build-info.
+var resolveCommands = []string{"build", "test", "run", packageCommand}
+
+// SwiftCommand runs Swift Package Manager commands, resolving the packages from, and publishing them to, a Swift
+// repository in Artifactory, which is configured as the package registry.
+type SwiftCommand struct {
+	serverDetails *config.ServerDetails
+	commandName   string
+	args          []string
+	repository    string
+	registryUrl   string
+}
+
+func NewSwiftCommand() *SwiftCommand {
+	return &SwiftCommand{}
+}
+
+func (sc *SwiftCommand) SetRepo(repo string) *SwiftCommand {
+	sc.repository = repo
+	return sc
+}
+
+func (sc *SwiftCommand) SetArgs(arguments []string) *SwiftCommand {
+	sc.args = arguments
+	return sc
+}
+
+func (sc *SwiftCommand) SetCommandName(commandName string) *SwiftCommand {
+	sc.commandName = commandName
+	return sc
+}
+
+func (sc *SwiftCommand) SetServerDetails(serverDetails *config.ServerDetails) *SwiftCommand {
+	sc.serverDetails = serverDetails
+	return sc
+}
+
+func (sc *SwiftCommand) ServerDetails() (*config.ServerDetails, error) {
+	return sc.serverDetails, nil
+}
+
+func (sc *SwiftCommand) CommandName() string {
+	return "rt_swift"
+}
+
+func (sc *SwiftCommand) Run() (err error) {
+	log.Info("Running Swift", sc.commandName)
+	var buildConfiguration *buildUtils.BuildConfiguration
+	sc.args, buildConfiguration, err = buildUtils.ExtractBuildDetailsFromArgs(sc.args)
+	if err != nil {
+		return
+	}
+	swiftBuildInfo, err := buildUtils.PrepareBuildPrerequisites(buildConfiguration)
+	if err != nil {
+		return
+	}
+	defer func() {
+		if swiftBuildInfo != nil && err != nil {
+			err = errors.Join(err, swiftBuildInfo.Clean())
+		}
+	}()
+	if err = sc.configureRegistry(); err != nil {
+		return
+	}
+	if err = gofrogcmd.RunCmd(sc); err != nil || swiftBuildInfo == nil {
+		return
+	}
+	workingDir, err := os.Getwd()
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	switch {
+	case sc.commandName == publishCommand:
+		return collectPublishedPackage(swiftBuildInfo, buildConfiguration, sc.serverDetails, sc.repository, sc.args)
+	case slices.Contains(resolveCommands, sc.commandName):
+		return saveLockfileDependencies(swiftBuildInfo, buildConfiguration, sc.serverDetails, workingDir)
+	}
+	return
+}
+
+// GetSwiftRegistryUrl returns the URL of the package registry of the Swift repository.
+// Example: https://myserver.jfrog.io/artifactory/api/swift/swift-virtual
+func GetSwiftRegistryUrl(serverDetails *config.ServerDetails, repository string) (string, error) {
+	rtUrl, err := url.Parse(serverDetails.GetArtifactoryUrl())
+	if err != nil {
+		return "", errorutils.CheckError(err)
+	}
+	return rtUrl.JoinPath("api/swift", repository).String(), nil
+}
+
+// getLoginArgs returns the arguments of 'swift package-registry login', which stores the credentials of the registry
+// in the keychain on macOS, or in the .netrc file on other platforms.
+func getLoginArgs(serverDetails *config.ServerDetails, registryUrl string) []string {
+	args := []string{"package-registry", "login", registryUrl, "--no-confirm"}
+	if accessToken := serverDetails.GetAccessToken(); accessToken != "" {
+		return append(args, "--token", accessToken)
+	}
+	if serverDetails.GetUser() != "" && serverDetails.GetPassword() != "" {
+		return append(args, "--username", serverDetails.GetUser(), "--password", serverDetails.GetPassword())
+	}
+	return nil
+}
+
+// configureRegistry sets the repository as the package registry of the Swift package in the working directory, and
+// logs in to it.
+func (sc *SwiftCommand) configureRegistry() (err error) {
+	if sc.serverDetails == nil || sc.repository == "" {
+		return errorutils.CheckErrorf("a server and a Swift repository are required for running Swift against Artifactory")
+	}
+	if sc.registryUrl, err = GetSwiftRegistryUrl(sc.serverDetails, sc.repository); err != nil {
+		return
+	}
+	if output, err := exec.Command("swift", "package-registry", "set", sc.registryUrl).CombinedOutput(); err != nil {
+		return errorutils.CheckErrorf("failed to set the package registry: %s", strings.TrimSpace(string(output)))
+	}
+	loginArgs := getLoginArgs(sc.serverDetails, sc.registryUrl)
+	if loginArgs == nil {
+		log.Debug("No credentials are configured for the Swift repository", sc.repository)
+		return
+	}
+	if output, err := exec.Command("swift", loginArgs...).CombinedOutput(); err != nil {
+		return errorutils.CheckErrorf("failed to log in to the package registry: %s", strings.TrimSpace(string(output)))
+	}
+	return
+}
+
+func (sc *SwiftCommand) GetCmd() *exec.Cmd {
+	var cmd []string
+	cmd = append(cmd, "swift")
+	if sc.commandName == publishCommand {
+		cmd = append(cmd, "package-registry", publishCommand)
+		cmd = append(cmd, sc.args...)
+		cmd = append(cmd, "--url", sc.registryUrl)
+	} else {
+		cmd = append(cmd, sc.commandName)
+		cmd = append(cmd, sc.args...)
+	}
+	return exec.Command(cmd[0], cmd[1:]...)
+}
+
+func (sc *SwiftCommand) GetEnv() map[string]string {
+	return map[string]string{}
+}
+
+func (sc *SwiftCommand) GetStdWriter() io.WriteCloser {
+	return nil
+}
+
+func (sc *SwiftCommand) GetErrWriter() io.WriteCloser {
+	return nil
+}
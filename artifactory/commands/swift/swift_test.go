@@ -0,0 +1,43 @@
+package swift
+
+import (
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetLoginArgs(t *testing.T) {
+	serverDetails := &config.ServerDetails{ArtifactoryUrl: "https://myserver.jfrog.io/artifactory/", AccessToken: "token"}
+	registryUrl, err := GetSwiftRegistryUrl(serverDetails, "swift-virtual")
+	require.NoError(t, err)
+	assert.Equal(t, "https://myserver.jfrog.io/artifactory/api/swift/swift-virtual", registryUrl)
+	assert.Equal(t, []string{"package-registry", "login", registryUrl, "--no-confirm", "--token", "token"}, getLoginArgs(serverDetails, registryUrl))
+
+	serverDetails = &config.ServerDetails{ArtifactoryUrl: "https://myserver.jfrog.io/artifactory/", User: "user", Password: "pass"}
+	assert.Equal(t, []string{"package-registry", "login", registryUrl, "--no-confirm", "--username", "user", "--password", "pass"}, getLoginArgs(serverDetails, registryUrl))
+
+	assert.Nil(t, getLoginArgs(&config.ServerDetails{ArtifactoryUrl: "https://myserver.jfrog.io/artifactory/"}, registryUrl))
+}
+
+func TestGetCmd(t *testing.T) {
+	swiftCommand := NewSwiftCommand().SetCommandName(publishCommand).SetArgs([]string{"mona.LinkedList", "1.1.0"})
+	swiftCommand.registryUrl = "https://myserver.jfrog.io/artifactory/api/swift/swift-local"
+	assert.Equal(t, []string{"swift", "package-registry", "publish", "mona.LinkedList", "1.1.0", "--url", swiftCommand.registryUrl}, swiftCommand.GetCmd().Args)
+
+	swiftCommand = NewSwiftCommand().SetCommandName(packageCommand).SetArgs([]string{"resolve"})
+	assert.Equal(t, []string{"swift", "package", "resolve"}, swiftCommand.GetCmd().Args)
+}
+
+func TestGetPublishedPackage(t *testing.T) {
+	publishedPackage, err := getPublishedPackage([]string{"--dry-run", "--metadata-path", "package-metadata.json", "mona.LinkedList", "1.1.0", "--scratch-directory=/tmp/publish"})
+	require.NoError(t, err)
+	assert.Equal(t, "mona.LinkedList:1.1.0", publishedPackage.Id())
+	assert.Equal(t, []string{"LinkedList-1.1.0.zip"}, publishedPackage.FileNames)
+
+	_, err = getPublishedPackage([]string{"mona.LinkedList"})
+	assert.Error(t, err)
+	_, err = getPublishedPackage([]string{"LinkedList", "1.1.0"})
+	assert.Error(t, err)
+}
@@ -0,0 +1,193 @@
+package terraform
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"strings"
+
+	"github.com/jfrog/jfrog-cli-core/v2/common/project"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const (
+	cliConfigFileEnv = "TF_CLI_CONFIG_FILE"
+
+	mirrorBlockStart = "# BEGIN JFrog CLI network mirror"
+	mirrorBlockEnd   = "# END JFrog CLI network mirror"
+)
+
+var (
+	mirrorBlockRegexp            = regexp.MustCompile(`(?s)` + regexp.QuoteMeta(mirrorBlockStart) + `.*?` + regexp.QuoteMeta(mirrorBlockEnd) + `\n?`)
+	providerInstallationRegexp   = regexp.MustCompile(`(?m)^\s*provider_installation\s*\{`)
+	errProviderInstallationExist = errors.New("the Terraform CLI configuration already has a provider_installation block")
+)
+
+// TerraformNetworkMirrorCommand configures a Terraform repository in Artifactory as the network mirror from which
+// Terraform installs the providers, in the Terraform CLI configuration file.
+type TerraformNetworkMirrorCommand struct {
+	repo           string
+	configFilePath string
+	cliConfigPath  string
+	serverDetails  *config.ServerDetails
+}
+
+func NewTerraformNetworkMirrorCommand() *TerraformNetworkMirrorCommand {
+	return &TerraformNetworkMirrorCommand{}
+}
+
+// SetConfigFilePath sets the path of the project's terraform.yaml, whose resolver is the network mirror.
+func (tnc *TerraformNetworkMirrorCommand) SetConfigFilePath(configFilePath string) *TerraformNetworkMirrorCommand {
+	tnc.configFilePath = configFilePath
+	return tnc
+}
+
+// SetCliConfigPath sets the path of the Terraform CLI configuration file. By default, it is the file Terraform reads.
+func (tnc *TerraformNetworkMirrorCommand) SetCliConfigPath(cliConfigPath string) *TerraformNetworkMirrorCommand {
+	tnc.cliConfigPath = cliConfigPath
+	return tnc
+}
+
+func (tnc *TerraformNetworkMirrorCommand) SetRepo(repo string) *TerraformNetworkMirrorCommand {
+	tnc.repo = repo
+	return tnc
+}
+
+func (tnc *TerraformNetworkMirrorCommand) SetServerDetails(serverDetails *config.ServerDetails) *TerraformNetworkMirrorCommand {
+	tnc.serverDetails = serverDetails
+	return tnc
+}
+
+func (tnc *TerraformNetworkMirrorCommand) ServerDetails() (*config.ServerDetails, error) {
+	return tnc.serverDetails, nil
+}
+
+func (tnc *TerraformNetworkMirrorCommand) CommandName() string {
+	return "rt_terraform_network_mirror"
+}
+
+func (tnc *TerraformNetworkMirrorCommand) Run() error {
+	if tnc.repo == "" {
+		if err := tnc.setRepoFromConfiguration(); err != nil {
+			return err
+		}
+	}
+	if tnc.serverDetails == nil || tnc.repo == "" {
+		return errorutils.CheckErrorf("a server and a Terraform repository are required for configuring the network mirror")
+	}
+	cliConfigPath := tnc.cliConfigPath
+	if cliConfigPath == "" {
+		var err error
+		if cliConfigPath, err = getCliConfigPath(); err != nil {
+			return err
+		}
+	}
+	mirrorBlock, err := createNetworkMirrorBlock(tnc.serverDetails, tnc.repo)
+	if err != nil {
+		return err
+	}
+	content, err := os.ReadFile(cliConfigPath)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return errorutils.CheckError(err)
+	}
+	updatedContent, err := setNetworkMirrorBlock(string(content), mirrorBlock)
+	if err != nil {
+		return errorutils.CheckErrorf("%s: %s. Remove it to configure the network mirror.", cliConfigPath, err.Error())
+	}
+	if err = os.WriteFile(cliConfigPath, []byte(updatedContent), 0600); err != nil {
+		return errorutils.CheckError(err)
+	}
+	log.Info("Configured the Terraform repository", tnc.repo, "as the network mirror of the providers in", cliConfigPath)
+	return nil
+}
+
+func (tnc *TerraformNetworkMirrorCommand) setRepoFromConfiguration() error {
+	log.Debug("Preparing to read the config file", tnc.configFilePath)
+	vConfig, err := project.ReadConfigFile(tnc.configFilePath, project.YAML)
+	if err != nil {
+		return err
+	}
+	resolverParams, err := project.GetRepoConfigByPrefix(tnc.configFilePath, project.ProjectConfigResolverPrefix, vConfig)
+	if err != nil {
+		return err
+	}
+	if tnc.serverDetails, err = resolverParams.ServerDetails(); err != nil {
+		return err
+	}
+	tnc.repo = resolverParams.TargetRepo()
+	return nil
+}
+
+// getCliConfigPath returns the path of the Terraform CLI configuration file, which is set by TF_CLI_CONFIG_FILE, or is
+// terraform.rc in the APPDATA directory on Windows and .terraformrc in the home directory on other platforms.
+func getCliConfigPath() (string, error) {
+	if cliConfigPath := os.Getenv(cliConfigFileEnv); cliConfigPath != "" {
+		return cliConfigPath, nil
+	}
+	if runtime.GOOS == "windows" {
+		return filepath.Join(os.Getenv("APPDATA"), "terraform.rc"), nil
+	}
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", errorutils.CheckError(err)
+	}
+	return filepath.Join(homeDir, ".terraformrc"), nil
+}
+
+// GetProvidersMirrorUrl returns the URL of the provider network mirror protocol of the Terraform repository.
+// Example: https://myserver.jfrog.io/artifactory/api/terraform/terraform-virtual/providers/
+func GetProvidersMirrorUrl(serverDetails *config.ServerDetails, repo string) (string, error) {
+	rtUrl, err := url.Parse(serverDetails.GetArtifactoryUrl())
+	if err != nil {
+		return "", errorutils.CheckError(err)
+	}
+	return rtUrl.JoinPath("api/terraform", repo, "providers").String() + "/", nil
+}
+
+// createNetworkMirrorBlock creates the configuration of the network mirror, and of the credentials which Terraform
+// sends to the host of the mirror.
+func createNetworkMirrorBlock(serverDetails *config.ServerDetails, repo string) (string, error) {
+	mirrorUrl, err := GetProvidersMirrorUrl(serverDetails, repo)
+	if err != nil {
+		return "", err
+	}
+	var block strings.Builder
+	block.WriteString(mirrorBlockStart + "\n")
+	block.WriteString("provider_installation {\n  network_mirror {\n")
+	block.WriteString(fmt.Sprintf("    url = %q\n", mirrorUrl))
+	block.WriteString("  }\n}\n")
+	token := serverDetails.GetAccessToken()
+	if token == "" {
+		token = serverDetails.GetPassword()
+	}
+	if token != "" {
+		parsedUrl, err := url.Parse(mirrorUrl)
+		if err != nil {
+			return "", errorutils.CheckError(err)
+		}
+		block.WriteString(fmt.Sprintf("credentials %q {\n  token = %q\n}\n", parsedUrl.Host, token))
+	}
+	block.WriteString(mirrorBlockEnd + "\n")
+	return block.String(), nil
+}
+
+// setNetworkMirrorBlock replaces the network mirror block previously written by JFrog CLI in the content of the
+// configuration file, or appends it. Terraform allows a single provider_installation block, so an error is returned if
+// the configuration has another one.
+func setNetworkMirrorBlock(content, mirrorBlock string) (string, error) {
+	content = mirrorBlockRegexp.ReplaceAllString(content, "")
+	if providerInstallationRegexp.MatchString(content) {
+		return "", errProviderInstallationExist
+	}
+	if content != "" && !strings.HasSuffix(content, "\n") {
+		content += "\n"
+	}
+	return content + mirrorBlock, nil
+}
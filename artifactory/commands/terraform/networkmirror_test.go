@@ -0,0 +1,68 @@
+package terraform
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateNetworkMirrorBlock(t *testing.T) {
+	serverDetails := &config.ServerDetails{ArtifactoryUrl: "https://myserver.jfrog.io/artifactory/", AccessToken: "token"}
+	block, err := createNetworkMirrorBlock(serverDetails, "terraform-virtual")
+	require.NoError(t, err)
+	assert.Equal(t, mirrorBlockStart+`
+provider_installation {
+  network_mirror {
+    url = "https://myserver.jfrog.io/artifactory/api/terraform/terraform-virtual/providers/"
+  }
+}
+credentials "myserver.jfrog.io" {
+  token = "token"
+}
+`+mirrorBlockEnd+"\n", block)
+
+	// Terraform sends no credentials to an anonymous server.
+	block, err = createNetworkMirrorBlock(&config.ServerDetails{ArtifactoryUrl: "https://myserver.jfrog.io/artifactory/"}, "terraform-virtual")
+	require.NoError(t, err)
+	assert.NotContains(t, block, "credentials")
+}
+
+func TestSetNetworkMirrorBlock(t *testing.T) {
+	mirrorBlock := mirrorBlockStart + "\nnew\n" + mirrorBlockEnd + "\n"
+	testCases := []struct {
+		name     string
+		content  string
+		expected string
+	}{
+		{"empty", "", mirrorBlock},
+		{"append", "plugin_cache_dir = \"/tmp\"", "plugin_cache_dir = \"/tmp\"\n" + mirrorBlock},
+		{"replace", "a = 1\n" + mirrorBlockStart + "\nold\n" + mirrorBlockEnd + "\nb = 2\n", "a = 1\nb = 2\n" + mirrorBlock},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			content, err := setNetworkMirrorBlock(testCase.content, mirrorBlock)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expected, content)
+		})
+	}
+	_, err := setNetworkMirrorBlock("provider_installation {\n  direct {}\n}\n", mirrorBlock)
+	assert.ErrorIs(t, err, errProviderInstallationExist)
+}
+
+func TestTerraformNetworkMirrorCommand(t *testing.T) {
+	cliConfigPath := filepath.Join(t.TempDir(), ".terraformrc")
+	serverDetails := &config.ServerDetails{ArtifactoryUrl: "https://myserver.jfrog.io/artifactory/", AccessToken: "token"}
+	command := NewTerraformNetworkMirrorCommand().SetServerDetails(serverDetails).SetRepo("terraform-virtual").SetCliConfigPath(cliConfigPath)
+	// Running the command twice keeps a single network mirror block.
+	require.NoError(t, command.Run())
+	require.NoError(t, command.Run())
+	content, err := os.ReadFile(cliConfigPath)
+	require.NoError(t, err)
+	expected, err := createNetworkMirrorBlock(serverDetails, "terraform-virtual")
+	require.NoError(t, err)
+	assert.Equal(t, expected, string(content))
+}
@@ -0,0 +1,147 @@
+package terraform
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	buildInfo "github.com/jfrog/build-info-go/entities"
+	"github.com/jfrog/gofrog/crypto"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clientconfig"
+	"github.com/jfrog/jfrog-cli-core/v2/common/build"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/jfrog/jfrog-client-go/artifactory/services"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const (
+	moduleType     = "module"
+	providerType   = "provider"
+	defaultDistDir = "dist"
+
+	providerArchivePrefix = "terraform-provider-"
+	sha256SumsSuffix      = "_SHA256SUMS"
+	signatureSuffix       = ".sig"
+)
+
+// getProviderVersion returns the version of the provider, which is its tag without the 'v' prefix.
+func (tpa *TerraformPublishCommandArgs) getProviderVersion() string {
+	return strings.TrimPrefix(tpa.tag, "v")
+}
+
+// getProviderFilePrefix returns the prefix of the provider's files: terraform-provider-<type>_<version>.
+func (tpa *TerraformPublishCommandArgs) getProviderFilePrefix() string {
+	return providerArchivePrefix + tpa.provider + "_" + tpa.getProviderVersion()
+}
+
+// findProviderArchives returns the archives of the provider for all platforms in the dist directory, which are named
+// terraform-provider-<type>_<version>_<os>_<arch>.zip, like the archives built by GoReleaser.
+func (tpa *TerraformPublishCommandArgs) findProviderArchives() ([]string, error) {
+	archives, err := filepath.Glob(filepath.Join(tpa.distDir, tpa.getProviderFilePrefix()+"_*_*.zip"))
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	if len(archives) == 0 {
+		return nil, errorutils.CheckErrorf("no archives of the provider %s were found in %s", tpa.getProviderFilePrefix(), tpa.distDir)
+	}
+	slices.Sort(archives)
+	return archives, nil
+}
+
+// writeSha256Sums writes the SHA256SUMS file of the provider's archives, which Terraform verifies the archives by,
+// unless it was written when the archives were built.
+func (tpa *TerraformPublishCommandArgs) writeSha256Sums(archives []string) (string, error) {
+	sumsPath := filepath.Join(tpa.distDir, tpa.getProviderFilePrefix()+sha256SumsSuffix)
+	if _, err := os.Stat(sumsPath); err == nil {
+		log.Debug("Using the existing", filepath.Base(sumsPath), "file")
+		return sumsPath, nil
+	}
+	var sums strings.Builder
+	for _, archive := range archives {
+		checksums, err := crypto.GetFileChecksums(archive, crypto.SHA256)
+		if err != nil {
+			return "", errorutils.CheckError(err)
+		}
+		sums.WriteString(fmt.Sprintf("%s  %s\n", checksums[crypto.SHA256], filepath.Base(archive)))
+	}
+	return sumsPath, errorutils.CheckError(os.WriteFile(sumsPath, []byte(sums.String()), 0644))
+}
+
+// signSha256Sums writes a detached GPG signature of the SHA256SUMS file, which Terraform verifies it by.
+func (tpa *TerraformPublishCommandArgs) signSha256Sums(sumsPath string) (string, error) {
+	signaturePath := sumsPath + signatureSuffix
+	log.Debug("Signing", filepath.Base(sumsPath), "with the GPG key", tpa.gpgKey)
+	cmd := exec.Command("gpg", "--batch", "--yes", "--local-user", tpa.gpgKey, "--output", signaturePath, "--detach-sign", sumsPath)
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return "", errorutils.CheckErrorf("failed to sign %s with the GPG key %s: %s", filepath.Base(sumsPath), tpa.gpgKey, strings.TrimSpace(string(output)))
+	}
+	return signaturePath, nil
+}
+
+// getProviderFiles returns the files of the provider to publish: its archives, their SHA256SUMS file, and its
+// signature. The signature is created if a GPG key is provided, or published if it was created when the archives were
+// built.
+func (tpa *TerraformPublishCommandArgs) getProviderFiles() ([]string, error) {
+	archives, err := tpa.findProviderArchives()
+	if err != nil {
+		return nil, err
+	}
+	sumsPath, err := tpa.writeSha256Sums(archives)
+	if err != nil {
+		return nil, err
+	}
+	files := append(archives, sumsPath)
+	if tpa.gpgKey != "" {
+		signaturePath, err := tpa.signSha256Sums(sumsPath)
+		if err != nil {
+			return nil, err
+		}
+		return append(files, signaturePath), nil
+	}
+	if _, err = os.Stat(sumsPath + signatureSuffix); err == nil {
+		return append(files, sumsPath+signatureSuffix), nil
+	}
+	log.Warn("The SHA256SUMS file of the provider is not signed. Provide --gpg-key to sign it.")
+	return files, nil
+}
+
+// Provider's path in terraform repository : namespace/type/version/
+func (tpc *TerraformPublishCommand) getProviderPublishTarget() string {
+	return path.Join(tpc.repo, tpc.namespace, tpc.provider, tpc.getProviderVersion()) + "/"
+}
+
+func (tpc *TerraformPublishCommand) terraformPublishProvider() (totalUploaded, totalFailed int, err error) {
+	files, err := tpc.getProviderFiles()
+	if err != nil {
+		return
+	}
+	var uploadParamsArray []services.UploadParams
+	for _, file := range files {
+		uploadParams := services.NewUploadParams()
+		uploadParams.Pattern = filepath.ToSlash(file)
+		uploadParams.Target = tpc.getProviderPublishTarget()
+		uploadParams.Flat = true
+		uploadParams.BuildProps = tpc.buildProps
+		uploadParamsArray = append(uploadParamsArray, uploadParams)
+	}
+	serviceManager, err := clientconfig.CreateServiceManager(tpc.serverDetails, -1, 0, false)
+	if err != nil {
+		return
+	}
+	summary, err := serviceManager.UploadFilesWithSummary(artifactory.UploadServiceOptions{}, uploadParamsArray...)
+	if err != nil {
+		return
+	}
+	totalUploaded, totalFailed = summary.TotalSucceeded, summary.TotalFailed
+	var artifacts []buildInfo.Artifact
+	if artifacts, err = readArtifactsFromSummary(summary); err != nil || !tpc.collectBuildInfo {
+		return
+	}
+	err = build.PopulateBuildArtifactsAsPartials(artifacts, tpc.buildConfiguration, buildInfo.Terraform)
+	return
+}
@@ -0,0 +1,81 @@
+package terraform
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/jfrog/gofrog/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtractProviderOptionsFromArgs(t *testing.T) {
+	terraformPublishArgs := NewTerraformPublishCommandArgs()
+	terraformArgs := []string{"--namespace=name", "--provider=aws", "--tag=v0.1.2"}
+	assert.NoError(t, terraformPublishArgs.extractTerraformPublishOptionsFromArgs(terraformArgs))
+	assert.Equal(t, moduleType, terraformPublishArgs.publishType)
+	assert.Equal(t, defaultDistDir, terraformPublishArgs.distDir)
+	assert.Empty(t, terraformPublishArgs.gpgKey)
+
+	terraformPublishArgs = NewTerraformPublishCommandArgs()
+	terraformArgs = []string{"--namespace=name", "--provider=aws", "--tag=v0.1.2", "--type=provider", "--dist=out", "--gpg-key=ABCDEF"}
+	assert.NoError(t, terraformPublishArgs.extractTerraformPublishOptionsFromArgs(terraformArgs))
+	assert.Equal(t, providerType, terraformPublishArgs.publishType)
+	assert.Equal(t, "out", terraformPublishArgs.distDir)
+	assert.Equal(t, "ABCDEF", terraformPublishArgs.gpgKey)
+	assert.Equal(t, "0.1.2", terraformPublishArgs.getProviderVersion())
+	assert.Equal(t, "terraform-provider-aws_0.1.2", terraformPublishArgs.getProviderFilePrefix())
+}
+
+func TestProviderFiles(t *testing.T) {
+	distDir := t.TempDir()
+	archives := []string{
+		filepath.Join(distDir, "terraform-provider-aws_0.1.2_linux_amd64.zip"),
+		filepath.Join(distDir, "terraform-provider-aws_0.1.2_darwin_arm64.zip"),
+	}
+	for _, archive := range archives {
+		require.NoError(t, os.WriteFile(archive, []byte(filepath.Base(archive)), 0644))
+	}
+	// Archives of other versions aren't published.
+	require.NoError(t, os.WriteFile(filepath.Join(distDir, "terraform-provider-aws_0.1.1_linux_amd64.zip"), []byte{}, 0644))
+
+	terraformPublishArgs := NewTerraformPublishCommandArgs()
+	terraformPublishArgs.provider = "aws"
+	terraformPublishArgs.tag = "v0.1.2"
+	terraformPublishArgs.distDir = distDir
+	found, err := terraformPublishArgs.findProviderArchives()
+	require.NoError(t, err)
+	assert.Equal(t, []string{archives[1], archives[0]}, found)
+
+	sumsPath, err := terraformPublishArgs.writeSha256Sums(found)
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(distDir, "terraform-provider-aws_0.1.2_SHA256SUMS"), sumsPath)
+	sums, err := os.ReadFile(sumsPath)
+	require.NoError(t, err)
+	darwinChecksums, err := crypto.GetFileChecksums(archives[1], crypto.SHA256)
+	require.NoError(t, err)
+	linuxChecksums, err := crypto.GetFileChecksums(archives[0], crypto.SHA256)
+	require.NoError(t, err)
+	assert.Equal(t, darwinChecksums[crypto.SHA256]+"  terraform-provider-aws_0.1.2_darwin_arm64.zip\n"+
+		linuxChecksums[crypto.SHA256]+"  terraform-provider-aws_0.1.2_linux_amd64.zip\n", string(sums))
+
+	// An existing signature is published with the archives.
+	require.NoError(t, os.WriteFile(sumsPath+signatureSuffix, []byte("signature"), 0644))
+	files, err := terraformPublishArgs.getProviderFiles()
+	require.NoError(t, err)
+	assert.Equal(t, append(found, sumsPath, sumsPath+signatureSuffix), files)
+
+	terraformPublishArgs.tag = "v0.2.0"
+	_, err = terraformPublishArgs.findProviderArchives()
+	assert.Error(t, err)
+}
+
+func TestGetProviderPublishTarget(t *testing.T) {
+	terraformPublishCommand := NewTerraformPublishCommand()
+	terraformPublishCommand.setRepo("terraform-local")
+	terraformPublishCommand.namespace = "name"
+	terraformPublishCommand.provider = "aws"
+	terraformPublishCommand.tag = "v0.1.2"
+	assert.Equal(t, "terraform-local/name/aws/0.1.2/", terraformPublishCommand.getProviderPublishTarget())
+}
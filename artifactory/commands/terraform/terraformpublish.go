@@ -12,6 +12,7 @@ import (
 	buildInfo "github.com/jfrog/build-info-go/entities"
 	ioutils "github.com/jfrog/gofrog/io"
 	"github.com/jfrog/gofrog/parallel"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clientconfig"
 	commandsUtils "github.com/jfrog/jfrog-cli-core/v2/artifactory/commands/utils"
 	"github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
 	"github.com/jfrog/jfrog-cli-core/v2/common/build"
@@ -30,9 +31,15 @@ import (
 const threads = 3
 
 type TerraformPublishCommandArgs struct {
-	namespace          string
-	provider           string
-	tag                string
+	namespace string
+	provider  string
+	tag       string
+	// The type of the published item, a module or a provider.
+	publishType string
+	// The directory of the provider's archives.
+	distDir string
+	// The GPG key which signs the SHA256SUMS file of the provider.
+	gpgKey             string
 	exclusions         []string
 	buildConfiguration *build.BuildConfiguration
 	collectBuildInfo   bool
@@ -111,6 +118,9 @@ func (tpc *TerraformPublishCommand) Init() error {
 	if tpc.namespace == "" || tpc.provider == "" || tpc.tag == "" {
 		return errorutils.CheckErrorf("the --namespace, --provider and --tag options are mandatory")
 	}
+	if tpc.publishType != moduleType && tpc.publishType != providerType {
+		return errorutils.CheckErrorf("the --type option must be either '%s' or '%s'", moduleType, providerType)
+	}
 	if err = tpc.setRepoFromConfiguration(); err != nil {
 		return err
 	}
@@ -132,8 +142,15 @@ func (tpc *TerraformPublishCommand) Init() error {
 }
 
 func (tpc *TerraformPublishCommand) publish() error {
-	log.Debug("Deploying terraform module...")
-	success, failed, err := tpc.terraformPublish()
+	var success, failed int
+	var err error
+	if tpc.publishType == providerType {
+		log.Debug("Deploying terraform provider...")
+		success, failed, err = tpc.terraformPublishProvider()
+	} else {
+		log.Debug("Deploying terraform module...")
+		success, failed, err = tpc.terraformPublish()
+	}
 	if err != nil {
 		return err
 	}
@@ -169,12 +186,35 @@ func (tpa *TerraformPublishCommandArgs) extractTerraformPublishOptionsFromArgs(a
 	}
 	tpa.exclusions = append(tpa.exclusions, strings.Split(exclusionsString, ";")...)
 	coreutils.RemoveFlagFromCommand(&args, flagIndex, valueIndex)
+	// Extract the type of the published item from the args.
+	flagIndex, valueIndex, tpa.publishType, err = coreutils.FindFlag("--type", args)
+	if err != nil {
+		return
+	}
+	coreutils.RemoveFlagFromCommand(&args, flagIndex, valueIndex)
+	if tpa.publishType == "" {
+		tpa.publishType = moduleType
+	}
+	// Extract the provider's options from the args.
+	flagIndex, valueIndex, tpa.distDir, err = coreutils.FindFlag("--dist", args)
+	if err != nil {
+		return
+	}
+	coreutils.RemoveFlagFromCommand(&args, flagIndex, valueIndex)
+	if tpa.distDir == "" {
+		tpa.distDir = defaultDistDir
+	}
+	flagIndex, valueIndex, tpa.gpgKey, err = coreutils.FindFlag("--gpg-key", args)
+	if err != nil {
+		return
+	}
+	coreutils.RemoveFlagFromCommand(&args, flagIndex, valueIndex)
 	args, tpa.buildConfiguration, err = build.ExtractBuildDetailsFromArgs(args)
 	if err != nil {
 		return err
 	}
 	if len(args) != 0 {
-		errMsg := "Unknown flag:" + strings.Split(args[0], "=")[0] + ". for a terraform publish command please provide --namespace, --provider, --tag and optionally --exclusions."
+		errMsg := "Unknown flag:" + strings.Split(args[0], "=")[0] + ". for a terraform publish command please provide --namespace, --provider, --tag and optionally --exclusions, --type, --dist and --gpg-key."
 		err = errorutils.CheckError(errors.New(errMsg))
 	}
 	return
@@ -231,7 +271,7 @@ func uploadModuleTask(serverDetails *config.ServerDetails, uploadSummary *[][]*s
 }
 
 func createServiceManagerAndUpload(serverDetails *config.ServerDetails, uploadParams *services.UploadParams, dryRun bool) (operationSummary *servicesUtils.OperationSummary, err error) {
-	serviceManager, err := utils.CreateServiceManagerWithThreads(serverDetails, dryRun, 1, -1, 0)
+	serviceManager, err := clientconfig.CreateServiceManagerWithThreads(serverDetails, dryRun, 1, -1, 0)
 	if err != nil {
 		return nil, err
 	}
@@ -22,7 +22,7 @@ func TestPreparePrerequisites(t *testing.T) {
 	assert.Equal(t, []string{"*test*", "*ignore*"}, terraformPublishArgs.exclusions)
 	// Add unknown flag
 	terraformArgs = []string{"--namespace=name", "--provider=aws", "--tag=v0.1.2", "--exclusions=*test*;*ignore*", "--unknown-flag=value"}
-	assert.EqualError(t, terraformPublishArgs.extractTerraformPublishOptionsFromArgs(terraformArgs), "Unknown flag:--unknown-flag. for a terraform publish command please provide --namespace, --provider, --tag and optionally --exclusions.")
+	assert.EqualError(t, terraformPublishArgs.extractTerraformPublishOptionsFromArgs(terraformArgs), "Unknown flag:--unknown-flag. for a terraform publish command please provide --namespace, --provider, --tag and optionally --exclusions, --type, --dist and --gpg-key.")
 }
 
 func TestCheckIfTerraformModule(t *testing.T) {
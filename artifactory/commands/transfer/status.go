@@ -0,0 +1,258 @@
+package transfer
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/formats"
+	"github.com/jfrog/jfrog-cli-core/v2/artifactory/commands/transferfiles"
+	"github.com/jfrog/jfrog-cli-core/v2/artifactory/commands/transferfiles/state"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// The transfer status of a repository.
+const (
+	RepoPending     = "pending"
+	RepoInProgress  = "in progress"
+	RepoTransferred = "transferred"
+)
+
+// Status is the progress of the transfer of each repository, as persisted by the transfer-files command.
+type Status struct {
+	Repositories []RepoStatus `json:"repositories"`
+}
+
+// RepoStatus is the progress of the transfer of a repository and the breakdown of its failures.
+type RepoStatus struct {
+	Repo                 string `json:"repo"`
+	Status               string `json:"status"`
+	TransferredFiles     int64  `json:"transferredFiles"`
+	TotalFiles           int64  `json:"totalFiles"`
+	TransferredSizeBytes int64  `json:"transferredSizeBytes"`
+	TotalSizeBytes       int64  `json:"totalSizeBytes"`
+	FullTransferStarted  string `json:"fullTransferStarted,omitempty"`
+	FullTransferEnded    string `json:"fullTransferEnded,omitempty"`
+	// The number of completed runs which transferred only the files created or modified since the previous run.
+	DeltaRuns    int    `json:"deltaRuns"`
+	LastDeltaRun string `json:"lastDeltaRun,omitempty"`
+	// The failures which the next run retries.
+	RetryableFailures int `json:"retryableFailures"`
+	// The failures which aren't retried, such as files which exceed the limits of the target.
+	SkippedFailures int           `json:"skippedFailures"`
+	Errors          []ErrorsCount `json:"errors,omitempty"`
+}
+
+// ErrorsCount is the number of failures of a repository with the same status code and reason.
+type ErrorsCount struct {
+	StatusCode int    `json:"statusCode,omitempty"`
+	Reason     string `json:"reason"`
+	Retryable  bool   `json:"retryable"`
+	Count      int    `json:"count"`
+}
+
+type repoStatusRow struct {
+	Repo      string `col-name:"Repository"`
+	Status    string `col-name:"Status"`
+	Files     string `col-name:"Files"`
+	Size      string `col-name:"Size"`
+	DeltaRuns int    `col-name:"Delta Runs"`
+	Retryable int    `col-name:"Retryable Failures"`
+	Skipped   int    `col-name:"Skipped Failures"`
+}
+
+type errorsCountRow struct {
+	Repo       string `col-name:"Repository"`
+	StatusCode string `col-name:"Status Code"`
+	Reason     string `col-name:"Reason"`
+	Retryable  bool   `col-name:"Retryable"`
+	Count      int    `col-name:"Count"`
+}
+
+func (s *Status) Tables() []formats.Table {
+	var repoRows []repoStatusRow
+	var errorRows []errorsCountRow
+	for _, repo := range s.Repositories {
+		repoRows = append(repoRows, repoStatusRow{repo.Repo, repo.Status, fmt.Sprintf("%d / %d", repo.TransferredFiles, repo.TotalFiles),
+			fmt.Sprintf("%d / %d", repo.TransferredSizeBytes, repo.TotalSizeBytes), repo.DeltaRuns, repo.RetryableFailures, repo.SkippedFailures})
+		for _, errorsCount := range repo.Errors {
+			statusCode := ""
+			if errorsCount.StatusCode != 0 {
+				statusCode = strconv.Itoa(errorsCount.StatusCode)
+			}
+			errorRows = append(errorRows, errorsCountRow{repo.Repo, statusCode, errorsCount.Reason, errorsCount.Retryable, errorsCount.Count})
+		}
+	}
+	return []formats.Table{
+		{Title: "Transfer Status", Rows: repoRows, EmptyMessage: "No repository transfer was found"},
+		{Title: "Transfer Failures", Rows: errorRows, EmptyMessage: "No transfer failures"},
+	}
+}
+
+// StatusCommand reports the progress of the transfer of each repository and the breakdown of its failures, from the
+// state which the transfer-files command persists in the transfer directory of the JFrog CLI home. Unlike
+// 'transfer-files --status', which shows the repository being transferred, it covers all the repositories, including
+// those of interrupted and finished runs.
+type StatusCommand struct {
+	format string
+	status *Status
+}
+
+func NewStatusCommand() *StatusCommand {
+	return &StatusCommand{}
+}
+
+func (sc *StatusCommand) SetFormat(format string) *StatusCommand {
+	sc.format = format
+	return sc
+}
+
+func (sc *StatusCommand) ServerDetails() (*config.ServerDetails, error) {
+	return nil, nil
+}
+
+func (sc *StatusCommand) CommandName() string {
+	return "rt_transfer_files_status"
+}
+
+// Status returns the status read by the last run.
+func (sc *StatusCommand) Status() *Status {
+	return sc.status
+}
+
+func (sc *StatusCommand) Run() error {
+	outputFormat, err := formats.ParseFormat(sc.format)
+	if err != nil {
+		return err
+	}
+	reposDir, err := coreutils.GetJfrogTransferRepositoriesDir()
+	if err != nil {
+		return err
+	}
+	if sc.status, err = ReadStatus(reposDir); err != nil {
+		return err
+	}
+	if outputFormat == "" {
+		outputFormat = formats.TableFormat
+	}
+	return formats.Print(outputFormat, formats.TransferStatusKind, sc.status)
+}
+
+// ReadStatus reads the state and the failures of each repository under the repositories directory of a transfer. The
+// repositories are sorted by name.
+func ReadStatus(reposDir string) (*Status, error) {
+	status := &Status{Repositories: []RepoStatus{}}
+	entries, err := os.ReadDir(reposDir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return status, nil
+		}
+		return nil, errorutils.CheckError(err)
+	}
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		repoStatus, err := readRepoStatus(filepath.Join(reposDir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		if repoStatus != nil {
+			status.Repositories = append(status.Repositories, *repoStatus)
+		}
+	}
+	sort.Slice(status.Repositories, func(i, j int) bool {
+		return status.Repositories[i].Repo < status.Repositories[j].Repo
+	})
+	return status, nil
+}
+
+// Returns nil if the directory has no repository state.
+func readRepoStatus(repoDir string) (*RepoStatus, error) {
+	stateFilePath := filepath.Join(repoDir, coreutils.JfrogTransferRepoStateFileName)
+	content, err := os.ReadFile(stateFilePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			log.Debug("Skipping", repoDir, "which has no repository state")
+			return nil, nil
+		}
+		return nil, errorutils.CheckError(err)
+	}
+	var transferState state.TransferState
+	if err = json.Unmarshal(content, &transferState); err != nil {
+		return nil, errorutils.CheckErrorf("failed to parse the repository state %s: %s", stateFilePath, err.Error())
+	}
+	repo := transferState.CurrentRepo
+	repoStatus := &RepoStatus{
+		Repo:                 repo.Name,
+		Status:               RepoPending,
+		TransferredFiles:     repo.Phase1Info.TransferredUnits,
+		TotalFiles:           repo.Phase1Info.TotalUnits,
+		TransferredSizeBytes: repo.Phase1Info.TransferredSizeBytes,
+		TotalSizeBytes:       repo.Phase1Info.TotalSizeBytes,
+		FullTransferStarted:  repo.FullTransfer.Started,
+		FullTransferEnded:    repo.FullTransfer.Ended,
+	}
+	switch {
+	case repo.FullTransfer.Ended != "":
+		repoStatus.Status = RepoTransferred
+	case repo.FullTransfer.Started != "":
+		repoStatus.Status = RepoInProgress
+	}
+	for _, diff := range repo.Diffs {
+		if diff.Completed {
+			repoStatus.DeltaRuns++
+			repoStatus.LastDeltaRun = diff.FilesDiffRunTime.Ended
+		}
+	}
+
+	errorsDir := filepath.Join(repoDir, coreutils.JfrogTransferErrorsDirName)
+	counts := make(map[ErrorsCount]int)
+	if repoStatus.RetryableFailures, err = countErrors(filepath.Join(errorsDir, coreutils.JfrogTransferRetryableErrorsDirName), true, counts); err != nil {
+		return nil, err
+	}
+	if repoStatus.SkippedFailures, err = countErrors(filepath.Join(errorsDir, coreutils.JfrogTransferSkippedErrorsDirName), false, counts); err != nil {
+		return nil, err
+	}
+	for key, count := range counts {
+		key.Count = count
+		repoStatus.Errors = append(repoStatus.Errors, key)
+	}
+	// The most frequent failures first.
+	sort.Slice(repoStatus.Errors, func(i, j int) bool {
+		if repoStatus.Errors[i].Count != repoStatus.Errors[j].Count {
+			return repoStatus.Errors[i].Count > repoStatus.Errors[j].Count
+		}
+		return repoStatus.Errors[i].Reason < repoStatus.Errors[j].Reason
+	})
+	return repoStatus, nil
+}
+
+// Counts the failures in the error files of the directory by their status code and reason, and returns their total.
+func countErrors(errorsDir string, retryable bool, counts map[ErrorsCount]int) (total int, err error) {
+	errorFiles, err := filepath.Glob(filepath.Join(errorsDir, "*.json"))
+	if err != nil {
+		return 0, errorutils.CheckError(err)
+	}
+	for _, errorFile := range errorFiles {
+		content, err := os.ReadFile(errorFile)
+		if err != nil {
+			return 0, errorutils.CheckError(err)
+		}
+		var filesErrors transferfiles.FilesErrors
+		if err = json.Unmarshal(content, &filesErrors); err != nil {
+			return 0, errorutils.CheckErrorf("failed to parse the transfer errors %s: %s", errorFile, err.Error())
+		}
+		for _, fileError := range filesErrors.Errors {
+			counts[ErrorsCount{StatusCode: fileError.StatusCode, Reason: fileError.Reason, Retryable: retryable}]++
+			total++
+		}
+	}
+	return total, nil
+}
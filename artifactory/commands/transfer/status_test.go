@@ -0,0 +1,60 @@
+package transfer
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeTestFile(t *testing.T, path, content string) {
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0700))
+	require.NoError(t, os.WriteFile(path, []byte(content), 0600))
+}
+
+func TestReadStatus(t *testing.T) {
+	reposDir := t.TempDir()
+	writeTestFile(t, filepath.Join(reposDir, "b1", "repo-state.json"), `{"repository":{"name":"maven-local",
+		"phase1_info":{"total_size_bytes":300,"transferred_size_bytes":300,"total_units":3,"transferred_units":3},
+		"full_transfer":{"started":"2026-10-01T10:00:00Z","ended":"2026-10-01T11:00:00Z"},
+		"diffs":[{"files_diff":{"started":"2026-10-02T10:00:00Z","ended":"2026-10-02T10:05:00Z"},"completed":true},
+			{"files_diff":{"started":"2026-10-03T10:00:00Z"}}]}}`)
+	writeTestFile(t, filepath.Join(reposDir, "a1", "repo-state.json"), `{"repository":{"name":"generic-local",
+		"phase1_info":{"total_size_bytes":500,"transferred_size_bytes":200,"total_units":5,"transferred_units":2},
+		"full_transfer":{"started":"2026-10-01T10:00:00Z"}}}`)
+	writeTestFile(t, filepath.Join(reposDir, "a1", "errors", "retryable", "generic-local-1-0.json"), `{"errors":[
+		{"repo":"generic-local","path":"app","name":"a.zip","status_code":502,"reason":"Bad Gateway"},
+		{"repo":"generic-local","path":"app","name":"b.zip","status_code":502,"reason":"Bad Gateway"},
+		{"repo":"generic-local","path":"app","name":"c.zip","status_code":500,"reason":"Internal Server Error"}]}`)
+	writeTestFile(t, filepath.Join(reposDir, "a1", "errors", "skipped", "generic-local-1-0.json"), `{"errors":[
+		{"repo":"generic-local","path":"app","name":"d.zip","reason":"The file size exceeds the limit"}]}`)
+	// A directory without a repository state is skipped
+	require.NoError(t, os.MkdirAll(filepath.Join(reposDir, "c1", "snapshot"), 0700))
+
+	status, err := ReadStatus(reposDir)
+	require.NoError(t, err)
+	require.Len(t, status.Repositories, 2)
+	assert.Equal(t, RepoStatus{
+		Repo: "generic-local", Status: RepoInProgress, TransferredFiles: 2, TotalFiles: 5, TransferredSizeBytes: 200, TotalSizeBytes: 500,
+		FullTransferStarted: "2026-10-01T10:00:00Z", RetryableFailures: 3, SkippedFailures: 1,
+		Errors: []ErrorsCount{
+			{StatusCode: 502, Reason: "Bad Gateway", Retryable: true, Count: 2},
+			{StatusCode: 500, Reason: "Internal Server Error", Retryable: true, Count: 1},
+			{Reason: "The file size exceeds the limit", Count: 1},
+		},
+	}, status.Repositories[0])
+	maven := status.Repositories[1]
+	assert.Equal(t, "maven-local", maven.Repo)
+	assert.Equal(t, RepoTransferred, maven.Status)
+	// Only the completed delta runs are counted
+	assert.Equal(t, 1, maven.DeltaRuns)
+	assert.Equal(t, "2026-10-02T10:05:00Z", maven.LastDeltaRun)
+	assert.Empty(t, maven.Errors)
+
+	// No transfer has run yet
+	status, err = ReadStatus(filepath.Join(reposDir, "missing"))
+	require.NoError(t, err)
+	assert.Empty(t, status.Repositories)
+}
@@ -0,0 +1,313 @@
+package usersmanagement
+
+import (
+	"encoding/csv"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	artifactoryutils "github.com/jfrog/jfrog-cli-artifactory/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clientconfig"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/jfrog/jfrog-client-go/artifactory/services"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// The columns of the users CSV files. The groups of a user are separated by commas.
+var csvColumns = []string{"username", "email", "password", "admin", "realm", "groups"}
+
+// Identities is the content of the files exported by the UsersExportCommand and imported by the UsersImportCommand, in
+// the format of the security REST API.
+type Identities struct {
+	Groups []services.Group `json:"groups,omitempty"`
+	Users  []services.User  `json:"users,omitempty"`
+}
+
+// UsersExportCommand writes the users matching a filter, and their groups, to a YAML, JSON or CSV file. A CSV file holds
+// the users only. The passwords of the users aren't exported.
+type UsersExportCommand struct {
+	serverDetails *config.ServerDetails
+	filter        UserFilter
+	filePath      string
+}
+
+func NewUsersExportCommand() *UsersExportCommand {
+	return &UsersExportCommand{}
+}
+
+func (uec *UsersExportCommand) SetFilter(filter UserFilter) *UsersExportCommand {
+	uec.filter = filter
+	return uec
+}
+
+// SetFilePath sets the path of the exported file. The file is written as CSV or JSON if it has a .csv or .json extension,
+// and as YAML otherwise.
+func (uec *UsersExportCommand) SetFilePath(filePath string) *UsersExportCommand {
+	uec.filePath = filePath
+	return uec
+}
+
+func (uec *UsersExportCommand) SetServerDetails(serverDetails *config.ServerDetails) *UsersExportCommand {
+	uec.serverDetails = serverDetails
+	return uec
+}
+
+func (uec *UsersExportCommand) ServerDetails() (*config.ServerDetails, error) {
+	return uec.serverDetails, nil
+}
+
+func (uec *UsersExportCommand) CommandName() string {
+	return "rt_users_export"
+}
+
+func (uec *UsersExportCommand) Run() error {
+	servicesManager, err := clientconfig.CreateServiceManager(uec.serverDetails, -1, 0, false)
+	if err != nil {
+		return err
+	}
+	identities, err := exportIdentities(servicesManager, uec.filter, !isCsv(uec.filePath))
+	if err != nil {
+		return err
+	}
+	if isCsv(uec.filePath) {
+		err = writeUsersCsv(uec.filePath, identities.Users)
+	} else {
+		err = artifactoryutils.WriteConfigFile(uec.filePath, identities)
+	}
+	if err != nil {
+		return err
+	}
+	log.Info(fmt.Sprintf("Exported %d users and %d groups to %s", len(identities.Users), len(identities.Groups), uec.filePath))
+	return nil
+}
+
+// Returns the users matching the filter, and optionally their groups. If no filter is set, all the groups are returned.
+func exportIdentities(servicesManager artifactory.ArtifactoryServicesManager, filter UserFilter, includeGroups bool) (*Identities, error) {
+	users, err := getUsers(servicesManager, filter)
+	if err != nil {
+		return nil, err
+	}
+	identities := &Identities{Users: make([]services.User, 0, len(users))}
+	memberOf := make(map[string]bool)
+	for _, user := range users {
+		for _, group := range getUserGroups(&user) {
+			memberOf[group] = true
+		}
+		// The details which can't be imported aren't exported.
+		user.Password, user.LastLoggedIn = "", ""
+		identities.Users = append(identities.Users, user)
+	}
+	if !includeGroups {
+		return identities, nil
+	}
+	groups, err := getGroups(servicesManager, "", false)
+	if err != nil {
+		return nil, err
+	}
+	for _, group := range groups {
+		if filter == (UserFilter{}) || memberOf[group.Name] {
+			// The members are exported as the groups of the users.
+			group.UsersNames = nil
+			identities.Groups = append(identities.Groups, group)
+		}
+	}
+	return identities, nil
+}
+
+// UsersImportCommand creates or updates the users and groups of a YAML, JSON or CSV file. The groups are imported first,
+// so that the users can join them.
+type UsersImportCommand struct {
+	serverDetails *config.ServerDetails
+	filePath      string
+}
+
+func NewUsersImportCommand() *UsersImportCommand {
+	return &UsersImportCommand{}
+}
+
+// SetFilePath sets the path of the imported file. A file with a .csv extension holds users, with the 'username' column
+// and any of the 'email', 'password', 'admin', 'realm' and 'groups' columns. Other files hold users and groups, in the
+// format written by the UsersExportCommand.
+func (uic *UsersImportCommand) SetFilePath(filePath string) *UsersImportCommand {
+	uic.filePath = filePath
+	return uic
+}
+
+func (uic *UsersImportCommand) SetServerDetails(serverDetails *config.ServerDetails) *UsersImportCommand {
+	uic.serverDetails = serverDetails
+	return uic
+}
+
+func (uic *UsersImportCommand) ServerDetails() (*config.ServerDetails, error) {
+	return uic.serverDetails, nil
+}
+
+func (uic *UsersImportCommand) CommandName() string {
+	return "rt_users_import"
+}
+
+func (uic *UsersImportCommand) Run() error {
+	identities := new(Identities)
+	var err error
+	if isCsv(uic.filePath) {
+		identities.Users, err = ReadUsersCsv(uic.filePath)
+	} else {
+		err = artifactoryutils.ReadConfigFile(uic.filePath, identities)
+	}
+	if err != nil {
+		return err
+	}
+	servicesManager, err := clientconfig.CreateServiceManager(uic.serverDetails, -1, 0, false)
+	if err != nil {
+		return err
+	}
+	return importIdentities(servicesManager, identities)
+}
+
+func importIdentities(servicesManager artifactory.ArtifactoryServicesManager, identities *Identities) error {
+	for _, group := range identities.Groups {
+		if group.Name == "" {
+			return errorutils.CheckErrorf("each group must have a name")
+		}
+	}
+	for _, user := range identities.Users {
+		if user.Name == "" {
+			return errorutils.CheckErrorf("each user must have a username")
+		}
+	}
+	var created, updated int
+	for _, group := range identities.Groups {
+		existing, err := servicesManager.GetGroup(services.GroupParams{GroupDetails: services.Group{Name: group.Name}})
+		if err != nil {
+			return err
+		}
+		if existing == nil {
+			log.Info(fmt.Sprintf("Creating group %s...", group.Name))
+			err = servicesManager.CreateGroup(services.GroupParams{GroupDetails: group, ReplaceIfExists: true})
+			created++
+		} else {
+			log.Info(fmt.Sprintf("Updating group %s...", group.Name))
+			err = servicesManager.UpdateGroup(services.GroupParams{GroupDetails: group})
+			updated++
+		}
+		if err != nil {
+			return err
+		}
+	}
+	for _, user := range identities.Users {
+		existing, err := servicesManager.GetUser(services.UserParams{UserDetails: services.User{Name: user.Name}})
+		if err != nil {
+			return err
+		}
+		if existing == nil {
+			if user.Password == "" && !isTrue(user.InternalPasswordDisabled) {
+				return errorutils.CheckErrorf("the new user '%s' must have a password", user.Name)
+			}
+			log.Info(fmt.Sprintf("Creating user %s...", user.Name))
+			err = servicesManager.CreateUser(services.UserParams{UserDetails: user, ReplaceIfExists: true})
+			created++
+		} else {
+			log.Info(fmt.Sprintf("Updating user %s...", user.Name))
+			err = servicesManager.UpdateUser(services.UserParams{UserDetails: user})
+			updated++
+		}
+		if err != nil {
+			return err
+		}
+	}
+	log.Info(fmt.Sprintf("Created %d and updated %d users and groups.", created, updated))
+	return nil
+}
+
+func isCsv(filePath string) bool {
+	return strings.EqualFold(filepath.Ext(filePath), ".csv")
+}
+
+func writeUsersCsv(filePath string, users []services.User) error {
+	records := [][]string{csvColumns}
+	for _, user := range users {
+		admin := ""
+		if user.Admin != nil {
+			admin = strconv.FormatBool(*user.Admin)
+		}
+		records = append(records, []string{user.Name, user.Email, "", admin, user.Realm, strings.Join(getUserGroups(&user), ",")})
+	}
+	if err := os.MkdirAll(filepath.Dir(filePath), 0755); err != nil {
+		return errorutils.CheckError(err)
+	}
+	file, err := os.Create(filePath)
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	writer := csv.NewWriter(file)
+	err = writer.WriteAll(records)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	return errorutils.CheckError(err)
+}
+
+// ReadUsersCsv reads the users of a CSV file, whose first row holds the column headers. The 'username' column is
+// required, and the 'email', 'password', 'admin', 'realm' and 'groups' columns are optional.
+func ReadUsersCsv(filePath string) ([]services.User, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+	records, err := csv.NewReader(file).ReadAll()
+	if err != nil {
+		return nil, errorutils.CheckErrorf("failed to parse '%s': %s", filePath, err.Error())
+	}
+	if len(records) == 0 {
+		return nil, nil
+	}
+	columns := make(map[string]int)
+	for i, column := range records[0] {
+		column = strings.TrimSpace(column)
+		if !isCsvColumn(column) {
+			return nil, errorutils.CheckErrorf("unknown column '%s' in '%s'. The supported columns are %s", column, filePath, strings.Join(csvColumns, ", "))
+		}
+		columns[column] = i
+	}
+	if _, exists := columns["username"]; !exists {
+		return nil, errorutils.CheckErrorf("the first row of '%s' must include the 'username' column", filePath)
+	}
+	users := make([]services.User, 0, len(records)-1)
+	for _, record := range records[1:] {
+		value := func(column string) string {
+			if i, exists := columns[column]; exists {
+				return strings.TrimSpace(record[i])
+			}
+			return ""
+		}
+		user := services.User{Name: value("username"), Email: value("email"), Password: value("password"), Realm: value("realm")}
+		if user.Admin, err = ParseOptionalBool("admin", value("admin")); err != nil {
+			return nil, err
+		}
+		if groups := value("groups"); groups != "" {
+			groupsList := strings.Split(groups, ",")
+			for i := range groupsList {
+				groupsList[i] = strings.TrimSpace(groupsList[i])
+			}
+			user.Groups = &groupsList
+		}
+		users = append(users, user)
+	}
+	return users, nil
+}
+
+func isCsvColumn(column string) bool {
+	for _, supported := range csvColumns {
+		if column == supported {
+			return true
+		}
+	}
+	return false
+}
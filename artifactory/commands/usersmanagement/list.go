@@ -0,0 +1,285 @@
+package usersmanagement
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clientconfig"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/jfrog/jfrog-client-go/artifactory/services"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const (
+	TableFormat = "table"
+	JsonFormat  = "json"
+)
+
+// UserFilter selects the users listed or exported. Empty fields match all the users.
+type UserFilter struct {
+	// A pattern of the usernames, in which '*' and '?' are wildcards.
+	NamePattern string
+	// The group the users belong to.
+	Group string
+	// The realm of the users, such as 'internal' or 'ldap'.
+	Realm string
+	// Set to true to select the admins only.
+	AdminsOnly bool
+}
+
+// UsersListCommand prints the users matching a filter, with their email, groups and admin permission.
+type UsersListCommand struct {
+	serverDetails *config.ServerDetails
+	filter        UserFilter
+	format        string
+}
+
+func NewUsersListCommand() *UsersListCommand {
+	return &UsersListCommand{format: TableFormat}
+}
+
+func (ulc *UsersListCommand) SetFilter(filter UserFilter) *UsersListCommand {
+	ulc.filter = filter
+	return ulc
+}
+
+// SetFormat sets the output format, table or json.
+func (ulc *UsersListCommand) SetFormat(format string) *UsersListCommand {
+	ulc.format = format
+	return ulc
+}
+
+func (ulc *UsersListCommand) SetServerDetails(serverDetails *config.ServerDetails) *UsersListCommand {
+	ulc.serverDetails = serverDetails
+	return ulc
+}
+
+func (ulc *UsersListCommand) ServerDetails() (*config.ServerDetails, error) {
+	return ulc.serverDetails, nil
+}
+
+func (ulc *UsersListCommand) CommandName() string {
+	return "rt_users_list"
+}
+
+func (ulc *UsersListCommand) Run() error {
+	if ulc.format != TableFormat && ulc.format != JsonFormat {
+		return errorutils.CheckErrorf("unsupported format '%s'. The supported formats are %s and %s", ulc.format, TableFormat, JsonFormat)
+	}
+	servicesManager, err := clientconfig.CreateServiceManager(ulc.serverDetails, -1, 0, false)
+	if err != nil {
+		return err
+	}
+	users, err := getUsers(servicesManager, ulc.filter)
+	if err != nil {
+		return err
+	}
+	if ulc.format == JsonFormat {
+		return printJson(users)
+	}
+	if len(users) == 0 {
+		log.Output("No users match the filter.")
+		return nil
+	}
+	builder := &strings.Builder{}
+	writer := tabwriter.NewWriter(builder, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(writer, "NAME\tEMAIL\tADMIN\tREALM\tGROUPS")
+	for _, user := range users {
+		fmt.Fprintf(writer, "%s\t%s\t%t\t%s\t%s\n", user.Name, user.Email, isTrue(user.Admin), user.Realm, strings.Join(getUserGroups(&user), ","))
+	}
+	_ = writer.Flush()
+	log.Output(strings.TrimSuffix(builder.String(), "\n"))
+	return nil
+}
+
+// Returns the details of the users matching the filter, sorted by their name.
+func getUsers(servicesManager artifactory.ArtifactoryServicesManager, filter UserFilter) ([]services.User, error) {
+	allUsers, err := servicesManager.GetAllUsers()
+	if err != nil {
+		return nil, err
+	}
+	var members map[string]bool
+	if filter.Group != "" {
+		group, err := servicesManager.GetGroup(services.GroupParams{GroupDetails: services.Group{Name: filter.Group}, IncludeUsers: true})
+		if err != nil {
+			return nil, err
+		}
+		if group == nil {
+			return nil, errorutils.CheckErrorf("the group '%s' doesn't exist", filter.Group)
+		}
+		members = make(map[string]bool)
+		for _, name := range group.UsersNames {
+			members[name] = true
+		}
+	}
+	users := []services.User{}
+	for _, listed := range allUsers {
+		matched, err := matchName(filter.NamePattern, listed.Name)
+		if err != nil {
+			return nil, err
+		}
+		if !matched || (members != nil && !members[listed.Name]) || (filter.Realm != "" && listed.Realm != filter.Realm) {
+			continue
+		}
+		// The list of the users holds their names and realms only.
+		user, err := servicesManager.GetUser(services.UserParams{UserDetails: services.User{Name: listed.Name}})
+		if err != nil {
+			return nil, err
+		}
+		if user == nil || (filter.AdminsOnly && !isTrue(user.Admin)) {
+			continue
+		}
+		users = append(users, *user)
+	}
+	sort.Slice(users, func(i, j int) bool {
+		return users[i].Name < users[j].Name
+	})
+	return users, nil
+}
+
+// GroupsListCommand prints the groups matching a name pattern, with their members.
+type GroupsListCommand struct {
+	serverDetails *config.ServerDetails
+	namePattern   string
+	format        string
+}
+
+func NewGroupsListCommand() *GroupsListCommand {
+	return &GroupsListCommand{format: TableFormat}
+}
+
+// SetNamePattern sets a pattern of the group names, in which '*' and '?' are wildcards.
+func (glc *GroupsListCommand) SetNamePattern(namePattern string) *GroupsListCommand {
+	glc.namePattern = namePattern
+	return glc
+}
+
+// SetFormat sets the output format, table or json.
+func (glc *GroupsListCommand) SetFormat(format string) *GroupsListCommand {
+	glc.format = format
+	return glc
+}
+
+func (glc *GroupsListCommand) SetServerDetails(serverDetails *config.ServerDetails) *GroupsListCommand {
+	glc.serverDetails = serverDetails
+	return glc
+}
+
+func (glc *GroupsListCommand) ServerDetails() (*config.ServerDetails, error) {
+	return glc.serverDetails, nil
+}
+
+func (glc *GroupsListCommand) CommandName() string {
+	return "rt_groups_list"
+}
+
+func (glc *GroupsListCommand) Run() error {
+	if glc.format != TableFormat && glc.format != JsonFormat {
+		return errorutils.CheckErrorf("unsupported format '%s'. The supported formats are %s and %s", glc.format, TableFormat, JsonFormat)
+	}
+	servicesManager, err := clientconfig.CreateServiceManager(glc.serverDetails, -1, 0, false)
+	if err != nil {
+		return err
+	}
+	groups, err := getGroups(servicesManager, glc.namePattern, true)
+	if err != nil {
+		return err
+	}
+	if glc.format == JsonFormat {
+		return printJson(groups)
+	}
+	if len(groups) == 0 {
+		log.Output("No groups match the filter.")
+		return nil
+	}
+	builder := &strings.Builder{}
+	writer := tabwriter.NewWriter(builder, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(writer, "NAME\tDESCRIPTION\tAUTO JOIN\tADMIN\tREALM\tUSERS")
+	for _, group := range groups {
+		fmt.Fprintf(writer, "%s\t%s\t%t\t%t\t%s\t%s\n", group.Name, group.Description, isTrue(group.AutoJoin), isTrue(group.AdminPrivileges),
+			group.Realm, strings.Join(group.UsersNames, ","))
+	}
+	_ = writer.Flush()
+	log.Output(strings.TrimSuffix(builder.String(), "\n"))
+	return nil
+}
+
+// Returns the details of the groups matching the name pattern, sorted by their name.
+func getGroups(servicesManager artifactory.ArtifactoryServicesManager, namePattern string, includeUsers bool) ([]services.Group, error) {
+	names, err := servicesManager.GetAllGroups()
+	if err != nil {
+		return nil, err
+	}
+	groups := []services.Group{}
+	if names == nil {
+		return groups, nil
+	}
+	for _, name := range *names {
+		matched, err := matchName(namePattern, name)
+		if err != nil {
+			return nil, err
+		}
+		if !matched {
+			continue
+		}
+		group, err := servicesManager.GetGroup(services.GroupParams{GroupDetails: services.Group{Name: name}, IncludeUsers: includeUsers})
+		if err != nil {
+			return nil, err
+		}
+		if group != nil {
+			groups = append(groups, *group)
+		}
+	}
+	sort.Slice(groups, func(i, j int) bool {
+		return groups[i].Name < groups[j].Name
+	})
+	return groups, nil
+}
+
+func matchName(pattern, name string) (bool, error) {
+	if pattern == "" {
+		return true, nil
+	}
+	matched, err := filepath.Match(pattern, name)
+	return matched, errorutils.CheckError(err)
+}
+
+func getUserGroups(user *services.User) []string {
+	if user.Groups == nil {
+		return nil
+	}
+	return *user.Groups
+}
+
+func isTrue(value *bool) bool {
+	return value != nil && *value
+}
+
+// ParseOptionalBool parses the value of an option which may be left unset, such as the admin permission of an updated
+// user. Returns nil if the value is empty.
+func ParseOptionalBool(name, value string) (*bool, error) {
+	if value == "" {
+		return nil, nil
+	}
+	parsed, err := strconv.ParseBool(value)
+	if err != nil {
+		return nil, errorutils.CheckErrorf("the '%s' option should be either true or false, but got '%s'", name, value)
+	}
+	return &parsed, nil
+}
+
+func printJson(value any) error {
+	content, err := json.MarshalIndent(value, "", "  ")
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	log.Output(string(content))
+	return nil
+}
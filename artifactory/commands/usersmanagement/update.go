@@ -0,0 +1,210 @@
+package usersmanagement
+
+import (
+	"fmt"
+
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clientconfig"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/artifactory/services"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// UserUpdateCommand updates the details of an existing user. The details which aren't set are kept.
+type UserUpdateCommand struct {
+	serverDetails *config.ServerDetails
+	user          services.User
+}
+
+func NewUserUpdateCommand() *UserUpdateCommand {
+	return &UserUpdateCommand{}
+}
+
+func (uuc *UserUpdateCommand) SetName(name string) *UserUpdateCommand {
+	uuc.user.Name = name
+	return uuc
+}
+
+func (uuc *UserUpdateCommand) SetEmail(email string) *UserUpdateCommand {
+	uuc.user.Email = email
+	return uuc
+}
+
+func (uuc *UserUpdateCommand) SetPassword(password string) *UserUpdateCommand {
+	uuc.user.Password = password
+	return uuc
+}
+
+// SetAdmin sets whether the user is an admin. Nil keeps the current permission.
+func (uuc *UserUpdateCommand) SetAdmin(admin *bool) *UserUpdateCommand {
+	uuc.user.Admin = admin
+	return uuc
+}
+
+// SetGroups replaces the groups of the user. Nil keeps the current groups.
+func (uuc *UserUpdateCommand) SetGroups(groups *[]string) *UserUpdateCommand {
+	uuc.user.Groups = groups
+	return uuc
+}
+
+func (uuc *UserUpdateCommand) SetServerDetails(serverDetails *config.ServerDetails) *UserUpdateCommand {
+	uuc.serverDetails = serverDetails
+	return uuc
+}
+
+func (uuc *UserUpdateCommand) ServerDetails() (*config.ServerDetails, error) {
+	return uuc.serverDetails, nil
+}
+
+func (uuc *UserUpdateCommand) CommandName() string {
+	return "rt_user_update"
+}
+
+func (uuc *UserUpdateCommand) Run() error {
+	servicesManager, err := clientconfig.CreateServiceManager(uuc.serverDetails, -1, 0, false)
+	if err != nil {
+		return err
+	}
+	existing, err := servicesManager.GetUser(services.UserParams{UserDetails: services.User{Name: uuc.user.Name}})
+	if err != nil {
+		return err
+	}
+	if existing == nil {
+		return errorutils.CheckErrorf("the user '%s' doesn't exist", uuc.user.Name)
+	}
+	log.Info(fmt.Sprintf("Updating user %s...", uuc.user.Name))
+	// Artifactory keeps the details which the request doesn't include.
+	return servicesManager.UpdateUser(services.UserParams{UserDetails: uuc.user, ClearGroups: uuc.user.Groups != nil && len(*uuc.user.Groups) == 0})
+}
+
+// GroupUpdateCommand updates the details of an existing group. The details which aren't set are kept.
+type GroupUpdateCommand struct {
+	serverDetails *config.ServerDetails
+	group         services.Group
+}
+
+func NewGroupUpdateCommand() *GroupUpdateCommand {
+	return &GroupUpdateCommand{}
+}
+
+func (guc *GroupUpdateCommand) SetName(name string) *GroupUpdateCommand {
+	guc.group.Name = name
+	return guc
+}
+
+func (guc *GroupUpdateCommand) SetDescription(description string) *GroupUpdateCommand {
+	guc.group.Description = description
+	return guc
+}
+
+// SetAutoJoin sets whether new users join the group automatically. Nil keeps the current setting.
+func (guc *GroupUpdateCommand) SetAutoJoin(autoJoin *bool) *GroupUpdateCommand {
+	guc.group.AutoJoin = autoJoin
+	return guc
+}
+
+// SetAdminPrivileges sets whether the members of the group are admins. Nil keeps the current setting.
+func (guc *GroupUpdateCommand) SetAdminPrivileges(adminPrivileges *bool) *GroupUpdateCommand {
+	guc.group.AdminPrivileges = adminPrivileges
+	return guc
+}
+
+func (guc *GroupUpdateCommand) SetServerDetails(serverDetails *config.ServerDetails) *GroupUpdateCommand {
+	guc.serverDetails = serverDetails
+	return guc
+}
+
+func (guc *GroupUpdateCommand) ServerDetails() (*config.ServerDetails, error) {
+	return guc.serverDetails, nil
+}
+
+func (guc *GroupUpdateCommand) CommandName() string {
+	return "rt_group_update"
+}
+
+func (guc *GroupUpdateCommand) Run() error {
+	servicesManager, err := clientconfig.CreateServiceManager(guc.serverDetails, -1, 0, false)
+	if err != nil {
+		return err
+	}
+	existing, err := servicesManager.GetGroup(services.GroupParams{GroupDetails: services.Group{Name: guc.group.Name}})
+	if err != nil {
+		return err
+	}
+	if existing == nil {
+		return errorutils.CheckErrorf("the group '%s' doesn't exist", guc.group.Name)
+	}
+	log.Info(fmt.Sprintf("Updating group %s...", guc.group.Name))
+	return servicesManager.UpdateGroup(services.GroupParams{GroupDetails: guc.group})
+}
+
+// GroupAddUsersCommand adds users to a group, keeping its current members.
+type GroupAddUsersCommand struct {
+	serverDetails *config.ServerDetails
+	name          string
+	users         []string
+}
+
+func NewGroupAddUsersCommand() *GroupAddUsersCommand {
+	return &GroupAddUsersCommand{}
+}
+
+func (gauc *GroupAddUsersCommand) SetName(name string) *GroupAddUsersCommand {
+	gauc.name = name
+	return gauc
+}
+
+func (gauc *GroupAddUsersCommand) SetUsers(users []string) *GroupAddUsersCommand {
+	gauc.users = users
+	return gauc
+}
+
+func (gauc *GroupAddUsersCommand) SetServerDetails(serverDetails *config.ServerDetails) *GroupAddUsersCommand {
+	gauc.serverDetails = serverDetails
+	return gauc
+}
+
+func (gauc *GroupAddUsersCommand) ServerDetails() (*config.ServerDetails, error) {
+	return gauc.serverDetails, nil
+}
+
+func (gauc *GroupAddUsersCommand) CommandName() string {
+	return "rt_group_add_users"
+}
+
+func (gauc *GroupAddUsersCommand) Run() error {
+	servicesManager, err := clientconfig.CreateServiceManager(gauc.serverDetails, -1, 0, false)
+	if err != nil {
+		return err
+	}
+	group, err := servicesManager.GetGroup(services.GroupParams{GroupDetails: services.Group{Name: gauc.name}, IncludeUsers: true})
+	if err != nil {
+		return err
+	}
+	if group == nil {
+		return errorutils.CheckErrorf("the group '%s' doesn't exist", gauc.name)
+	}
+	members := mergeNames(group.UsersNames, gauc.users)
+	if len(members) == len(group.UsersNames) {
+		log.Info(fmt.Sprintf("The users are already members of group %s.", gauc.name))
+		return nil
+	}
+	log.Info(fmt.Sprintf("Adding %d users to group %s...", len(members)-len(group.UsersNames), gauc.name))
+	return servicesManager.UpdateGroup(services.GroupParams{GroupDetails: services.Group{Name: gauc.name, UsersNames: members}})
+}
+
+// Returns the names followed by the added names which aren't among them.
+func mergeNames(names, added []string) []string {
+	merged := append([]string{}, names...)
+	exists := make(map[string]bool, len(names))
+	for _, name := range names {
+		exists[name] = true
+	}
+	for _, name := range added {
+		if name != "" && !exists[name] {
+			exists[name] = true
+			merged = append(merged, name)
+		}
+	}
+	return merged
+}
@@ -0,0 +1,149 @@
+package usersmanagement
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	rtUtils "github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/jfrog/jfrog-client-go/artifactory/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const securityApi = "/api/security/"
+
+func createSecurityServer(t *testing.T, requests *[]string) (*httptest.Server, artifactory.ArtifactoryServicesManager) {
+	users := map[string]string{
+		"alice": `{"name":"alice","email":"alice@acme.com","admin":true,"realm":"internal","groups":["devs"],"lastLoggedIn":"2026-01-01T00:00:00.000Z"}`,
+		"bob":   `{"name":"bob","email":"bob@acme.com","admin":false,"realm":"internal","groups":["devs","ops"]}`,
+		"carol": `{"name":"carol","email":"carol@acme.com","realm":"ldap"}`,
+	}
+	groups := map[string]string{
+		"devs": `{"name":"devs","description":"Developers","userNames":["alice","bob"]}`,
+		"ops":  `{"name":"ops","autoJoin":true,"userNames":["bob"]}`,
+	}
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		kind, name, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, securityApi), "/")
+		if r.Method != http.MethodGet {
+			content, err := io.ReadAll(r.Body)
+			assert.NoError(t, err)
+			*requests = append(*requests, r.Method+" "+kind+"/"+name+" "+string(content))
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		var response string
+		var exists bool
+		switch {
+		case kind == "users" && name == "":
+			response, exists = `[{"name":"alice","realm":"internal"},{"name":"bob","realm":"internal"},{"name":"carol","realm":"ldap"}]`, true
+		case kind == "users":
+			response, exists = users[name]
+		case kind == "groups" && name == "":
+			response, exists = `[{"name":"devs"},{"name":"ops"}]`, true
+		case kind == "groups":
+			response, exists = groups[name]
+		}
+		if !exists {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, err := w.Write([]byte(response))
+		assert.NoError(t, err)
+	}))
+	servicesManager, err := rtUtils.CreateServiceManager(&config.ServerDetails{ArtifactoryUrl: testServer.URL + "/"}, 0, 0, false)
+	require.NoError(t, err)
+	return testServer, servicesManager
+}
+
+func TestGetUsers(t *testing.T) {
+	testServer, servicesManager := createSecurityServer(t, nil)
+	defer testServer.Close()
+
+	tests := []struct {
+		name     string
+		filter   UserFilter
+		expected []string
+	}{
+		{"all", UserFilter{}, []string{"alice", "bob", "carol"}},
+		{"name pattern", UserFilter{NamePattern: "?o*"}, []string{"bob"}},
+		{"group", UserFilter{Group: "devs"}, []string{"alice", "bob"}},
+		{"realm", UserFilter{Realm: "ldap"}, []string{"carol"}},
+		{"admins", UserFilter{AdminsOnly: true}, []string{"alice"}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			users, err := getUsers(servicesManager, test.filter)
+			require.NoError(t, err)
+			names := []string{}
+			for _, user := range users {
+				names = append(names, user.Name)
+			}
+			assert.Equal(t, test.expected, names)
+		})
+	}
+	_, err := getUsers(servicesManager, UserFilter{Group: "missing"})
+	assert.ErrorContains(t, err, "the group 'missing' doesn't exist")
+}
+
+func TestExportIdentities(t *testing.T) {
+	testServer, servicesManager := createSecurityServer(t, nil)
+	defer testServer.Close()
+
+	identities, err := exportIdentities(servicesManager, UserFilter{NamePattern: "b*"}, true)
+	require.NoError(t, err)
+	require.Len(t, identities.Users, 1)
+	assert.Equal(t, "bob", identities.Users[0].Name)
+	require.Len(t, identities.Groups, 2)
+	assert.Equal(t, "Developers", identities.Groups[0].Description)
+	assert.Empty(t, identities.Groups[0].UsersNames, "the members are exported as the groups of the users")
+
+	identities, err = exportIdentities(servicesManager, UserFilter{NamePattern: "alice"}, true)
+	require.NoError(t, err)
+	assert.Empty(t, identities.Users[0].LastLoggedIn)
+	require.Len(t, identities.Groups, 1)
+	assert.Equal(t, "devs", identities.Groups[0].Name)
+
+	csvPath := filepath.Join(t.TempDir(), "users.csv")
+	require.NoError(t, writeUsersCsv(csvPath, identities.Users))
+	users, err := ReadUsersCsv(csvPath)
+	require.NoError(t, err)
+	require.Len(t, users, 1)
+	assert.Equal(t, "alice@acme.com", users[0].Email)
+	assert.True(t, isTrue(users[0].Admin))
+	assert.Equal(t, []string{"devs"}, getUserGroups(&users[0]))
+}
+
+func TestImportIdentities(t *testing.T) {
+	var requests []string
+	testServer, servicesManager := createSecurityServer(t, &requests)
+	defer testServer.Close()
+
+	admin := false
+	identities := &Identities{
+		Groups: []services.Group{{Name: "devs", Description: "Developers"}, {Name: "qa"}},
+		Users:  []services.User{{Name: "bob", Admin: &admin}, {Name: "dave", Password: "secret", Groups: &[]string{"qa"}}},
+	}
+	require.NoError(t, importIdentities(servicesManager, identities))
+	assert.Equal(t, []string{
+		`POST groups/devs {"name":"devs","description":"Developers"}`,
+		`PUT groups/qa {"name":"qa"}`,
+		`POST users/bob {"name":"bob","admin":false}`,
+		`PUT users/dave {"name":"dave","password":"secret","groups":["qa"]}`,
+	}, requests)
+
+	err := importIdentities(servicesManager, &Identities{Users: []services.User{{Name: "erin"}}})
+	assert.ErrorContains(t, err, "the new user 'erin' must have a password")
+	err = importIdentities(servicesManager, &Identities{Groups: []services.Group{{Description: "unnamed"}}})
+	assert.ErrorContains(t, err, "each group must have a name")
+}
+
+func TestMergeNames(t *testing.T) {
+	assert.Equal(t, []string{"alice", "bob", "carol"}, mergeNames([]string{"alice", "bob"}, []string{"bob", "carol", ""}))
+	assert.Equal(t, []string{"alice"}, mergeNames(nil, []string{"alice"}))
+}
@@ -0,0 +1,50 @@
+package webhook
+
+import (
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// WebhookCreateCommand creates a webhook, which posts the events of a domain to a URL.
+type WebhookCreateCommand struct {
+	serverDetails *config.ServerDetails
+	params        WebhookParams
+}
+
+func NewWebhookCreateCommand() *WebhookCreateCommand {
+	return &WebhookCreateCommand{}
+}
+
+func (wcc *WebhookCreateCommand) SetParams(params WebhookParams) *WebhookCreateCommand {
+	wcc.params = params
+	return wcc
+}
+
+func (wcc *WebhookCreateCommand) SetServerDetails(serverDetails *config.ServerDetails) *WebhookCreateCommand {
+	wcc.serverDetails = serverDetails
+	return wcc
+}
+
+func (wcc *WebhookCreateCommand) ServerDetails() (*config.ServerDetails, error) {
+	return wcc.serverDetails, nil
+}
+
+func (wcc *WebhookCreateCommand) CommandName() string {
+	return "rt_webhook_create"
+}
+
+func (wcc *WebhookCreateCommand) Run() error {
+	webhook, err := wcc.params.toWebhook()
+	if err != nil {
+		return err
+	}
+	ec, err := newEventClient(wcc.serverDetails)
+	if err != nil {
+		return err
+	}
+	if err = ec.createWebhook(webhook); err != nil {
+		return err
+	}
+	log.Info("Created the webhook", webhook.Key, "of the", webhook.EventFilter.Domain, "events", webhook.EventFilter.EventTypes)
+	return nil
+}
@@ -0,0 +1,82 @@
+package webhook
+
+import (
+	"slices"
+
+	artifactoryutils "github.com/jfrog/jfrog-cli-artifactory/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// WebhookDeleteCommand permanently deletes a webhook by its key.
+type WebhookDeleteCommand struct {
+	serverDetails *config.ServerDetails
+	key           string
+	quiet         bool
+	dryRun        bool
+}
+
+func NewWebhookDeleteCommand() *WebhookDeleteCommand {
+	return &WebhookDeleteCommand{}
+}
+
+func (wdc *WebhookDeleteCommand) SetKey(key string) *WebhookDeleteCommand {
+	wdc.key = key
+	return wdc
+}
+
+func (wdc *WebhookDeleteCommand) SetQuiet(quiet bool) *WebhookDeleteCommand {
+	wdc.quiet = quiet
+	return wdc
+}
+
+// SetDryRun sets the command to verify that the webhook exists, without deleting it.
+func (wdc *WebhookDeleteCommand) SetDryRun(dryRun bool) *WebhookDeleteCommand {
+	wdc.dryRun = dryRun
+	return wdc
+}
+
+func (wdc *WebhookDeleteCommand) SetServerDetails(serverDetails *config.ServerDetails) *WebhookDeleteCommand {
+	wdc.serverDetails = serverDetails
+	return wdc
+}
+
+func (wdc *WebhookDeleteCommand) ServerDetails() (*config.ServerDetails, error) {
+	return wdc.serverDetails, nil
+}
+
+func (wdc *WebhookDeleteCommand) CommandName() string {
+	return "rt_webhook_delete"
+}
+
+func (wdc *WebhookDeleteCommand) Run() error {
+	if !wdc.dryRun && !wdc.quiet && !coreutils.AskYesNo("Are you sure you want to permanently delete the webhook "+wdc.key+"?", false) {
+		return nil
+	}
+	ec, err := newEventClient(wdc.serverDetails)
+	if err != nil {
+		return err
+	}
+	if wdc.dryRun {
+		return wdc.logDeletion(ec)
+	}
+	if err = ec.deleteWebhook(wdc.key); err != nil {
+		return err
+	}
+	log.Info("Deleted the webhook", wdc.key)
+	return nil
+}
+
+func (wdc *WebhookDeleteCommand) logDeletion(ec *eventClient) error {
+	webhooks, err := ec.getWebhooks()
+	if err != nil {
+		return err
+	}
+	if !slices.ContainsFunc(webhooks, func(webhook Webhook) bool { return webhook.Key == wdc.key }) {
+		return errorutils.CheckErrorf("the webhook '%s' doesn't exist", wdc.key)
+	}
+	artifactoryutils.LogDryRun("Deleting the webhook", wdc.key)
+	return nil
+}
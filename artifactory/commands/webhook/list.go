@@ -0,0 +1,54 @@
+package webhook
+
+import (
+	"encoding/json"
+
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// WebhookListCommand prints the webhooks of the platform as JSON.
+type WebhookListCommand struct {
+	serverDetails *config.ServerDetails
+}
+
+func NewWebhookListCommand() *WebhookListCommand {
+	return &WebhookListCommand{}
+}
+
+func (wlc *WebhookListCommand) SetServerDetails(serverDetails *config.ServerDetails) *WebhookListCommand {
+	wlc.serverDetails = serverDetails
+	return wlc
+}
+
+func (wlc *WebhookListCommand) ServerDetails() (*config.ServerDetails, error) {
+	return wlc.serverDetails, nil
+}
+
+func (wlc *WebhookListCommand) CommandName() string {
+	return "rt_webhook_list"
+}
+
+func (wlc *WebhookListCommand) Run() error {
+	ec, err := newEventClient(wlc.serverDetails)
+	if err != nil {
+		return err
+	}
+	webhooks, err := ec.getWebhooks()
+	if err != nil {
+		return err
+	}
+	// Keep the secrets out of the output.
+	for i := range webhooks {
+		for j := range webhooks[i].Handlers {
+			webhooks[i].Handlers[j].Secret = ""
+		}
+	}
+	content, err := json.MarshalIndent(webhooks, "", "  ")
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	log.Output(string(content))
+	return nil
+}
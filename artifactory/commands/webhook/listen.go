@@ -0,0 +1,181 @@
+package webhook
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const (
+	DefaultListenPort = 8090
+	// The header by which the platform sends the secret of the webhook.
+	eventAuthHeader = "X-JFrog-Event-Auth"
+)
+
+// WebhookListenCommand is a developer mode for webhooks. It runs an HTTP listener on localhost, registers a temporary
+// webhook which posts to the listener through a tunnel URL, and prints the incoming events as JSON until interrupted.
+// The tunnel, such as ngrok, must forward the tunnel URL to the local port.
+type WebhookListenCommand struct {
+	serverDetails *config.ServerDetails
+	tunnelUrl     string
+	port          int
+	domain        string
+	eventTypes    []string
+	names         []string
+}
+
+func NewWebhookListenCommand() *WebhookListenCommand {
+	return &WebhookListenCommand{port: DefaultListenPort, domain: ArtifactDomain}
+}
+
+// SetTunnelUrl sets the public URL which is forwarded to the local listener.
+func (wlc *WebhookListenCommand) SetTunnelUrl(tunnelUrl string) *WebhookListenCommand {
+	wlc.tunnelUrl = tunnelUrl
+	return wlc
+}
+
+func (wlc *WebhookListenCommand) SetPort(port int) *WebhookListenCommand {
+	wlc.port = port
+	return wlc
+}
+
+func (wlc *WebhookListenCommand) SetDomain(domain string) *WebhookListenCommand {
+	wlc.domain = domain
+	return wlc
+}
+
+func (wlc *WebhookListenCommand) SetEventTypes(eventTypes []string) *WebhookListenCommand {
+	wlc.eventTypes = eventTypes
+	return wlc
+}
+
+func (wlc *WebhookListenCommand) SetNames(names []string) *WebhookListenCommand {
+	wlc.names = names
+	return wlc
+}
+
+func (wlc *WebhookListenCommand) SetServerDetails(serverDetails *config.ServerDetails) *WebhookListenCommand {
+	wlc.serverDetails = serverDetails
+	return wlc
+}
+
+func (wlc *WebhookListenCommand) ServerDetails() (*config.ServerDetails, error) {
+	return wlc.serverDetails, nil
+}
+
+func (wlc *WebhookListenCommand) CommandName() string {
+	return "rt_webhook_listen"
+}
+
+func (wlc *WebhookListenCommand) Run() (err error) {
+	if wlc.tunnelUrl == "" {
+		return errorutils.CheckErrorf("a tunnel URL, which forwards to the local port %d, is required", wlc.port)
+	}
+	secret, err := generateSecret()
+	if err != nil {
+		return err
+	}
+	webhook, err := (&WebhookParams{
+		Key:         "jfrog-cli-listen-" + strconv.FormatInt(time.Now().Unix(), 10),
+		Description: "A temporary webhook of the JFrog CLI webhook listener",
+		Url:         wlc.tunnelUrl,
+		Secret:      secret,
+		Domain:      wlc.domain,
+		EventTypes:  wlc.eventTypes,
+		Names:       wlc.names,
+	}).toWebhook()
+	if err != nil {
+		return err
+	}
+	ec, err := newEventClient(wlc.serverDetails)
+	if err != nil {
+		return err
+	}
+	listener, err := net.Listen("tcp", net.JoinHostPort("127.0.0.1", strconv.Itoa(wlc.port)))
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	server := &http.Server{Handler: newEventHandler(secret, os.Stdout), ReadHeaderTimeout: time.Minute}
+	serveErr := make(chan error, 1)
+	go func() {
+		serveErr <- server.Serve(listener)
+	}()
+	defer func() {
+		err = errors.Join(err, errorutils.CheckError(server.Shutdown(context.Background())))
+	}()
+
+	if err = ec.createWebhook(webhook); err != nil {
+		return err
+	}
+	// The webhook is temporary, and is deleted when the listener stops.
+	defer func() {
+		log.Info("Deleting the webhook", webhook.Key+"...")
+		err = errors.Join(err, ec.deleteWebhook(webhook.Key))
+	}()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	log.Info(fmt.Sprintf("Listening on http://%s to the %s events %v of the webhook %s. Press Ctrl+C to stop.",
+		listener.Addr().String(), webhook.EventFilter.Domain, webhook.EventFilter.EventTypes, webhook.Key))
+	select {
+	case err = <-serveErr:
+		return errorutils.CheckError(err)
+	case <-ctx.Done():
+		log.Info("Stopping the listener...")
+		return nil
+	}
+}
+
+// newEventHandler returns a handler which writes the posted events to the output as indented JSON, one per event.
+// Requests without the secret of the webhook are rejected.
+func newEventHandler(secret string, output io.Writer) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		if subtle.ConstantTimeCompare([]byte(r.Header.Get(eventAuthHeader)), []byte(secret)) != 1 {
+			log.Warn("Rejected an event without the secret of the webhook from", r.RemoteAddr)
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		var event any
+		if err := json.NewDecoder(r.Body).Decode(&event); err != nil {
+			log.Warn("Rejected an event which isn't JSON:", err.Error())
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		content, err := json.MarshalIndent(event, "", "  ")
+		if err == nil {
+			_, err = fmt.Fprintln(output, string(content))
+		}
+		if err != nil {
+			log.Warn("Failed to print an event:", err.Error())
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+}
+
+func generateSecret() (string, error) {
+	secret := make([]byte, 16)
+	if _, err := rand.Read(secret); err != nil {
+		return "", errorutils.CheckError(err)
+	}
+	return hex.EncodeToString(secret), nil
+}
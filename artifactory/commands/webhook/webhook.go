@@ -0,0 +1,176 @@
+// Package webhook manages the webhooks of the JFrog Platform, which are subscriptions of the Event service.
+package webhook
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+
+	artifactoryutils "github.com/jfrog/jfrog-cli-artifactory/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clientconfig"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clierrors"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	clientutils "github.com/jfrog/jfrog-client-go/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+)
+
+const (
+	subscriptionsApi = "event/api/v1/subscriptions"
+
+	ArtifactDomain      = "artifact"
+	BuildDomain         = "build"
+	ReleaseBundleDomain = "release_bundle"
+	DistributionDomain  = "distribution"
+)
+
+// The event types of each supported domain. A webhook which doesn't specify its event types subscribes to all of them.
+var domainEventTypes = map[string][]string{
+	ArtifactDomain:      {"deployed", "deleted", "moved", "copied", "cached"},
+	BuildDomain:         {"uploaded", "deleted", "promoted"},
+	ReleaseBundleDomain: {"created", "signed", "deleted"},
+	DistributionDomain: {"distribute_started", "distribute_completed", "distribute_aborted", "distribute_failed",
+		"delete_started", "delete_completed", "delete_failed"},
+}
+
+// Webhook is a webhook subscription, as kept by the Event service.
+type Webhook struct {
+	Key         string      `json:"key"`
+	Description string      `json:"description,omitempty"`
+	Enabled     bool        `json:"enabled"`
+	EventFilter EventFilter `json:"event_filter"`
+	Handlers    []Handler   `json:"handlers"`
+}
+
+type EventFilter struct {
+	Domain     string         `json:"domain"`
+	EventTypes []string       `json:"event_types"`
+	Criteria   map[string]any `json:"criteria"`
+}
+
+type Handler struct {
+	HandlerType string `json:"handler_type"`
+	Url         string `json:"url"`
+	Secret      string `json:"secret,omitempty"`
+}
+
+// WebhookParams are the details of a webhook to create.
+type WebhookParams struct {
+	Key         string
+	Description string
+	Url         string
+	Secret      string
+	Domain      string
+	// The event types to subscribe to. If empty, all the event types of the domain are subscribed to.
+	EventTypes []string
+	// The repositories, builds or release bundles which trigger the webhook, by the domain. If empty, any of them does.
+	Names []string
+}
+
+func (wp *WebhookParams) toWebhook() (*Webhook, error) {
+	if wp.Key == "" || wp.Url == "" {
+		return nil, errorutils.CheckErrorf("a webhook must have a key and a URL")
+	}
+	supportedEventTypes, ok := domainEventTypes[wp.Domain]
+	if !ok {
+		return nil, errorutils.CheckErrorf("unsupported webhook domain '%s'. The supported domains are %s", wp.Domain,
+			strings.Join([]string{ArtifactDomain, BuildDomain, ReleaseBundleDomain, DistributionDomain}, ", "))
+	}
+	eventTypes := wp.EventTypes
+	if len(eventTypes) == 0 {
+		eventTypes = supportedEventTypes
+	}
+	for _, eventType := range eventTypes {
+		if !slices.Contains(supportedEventTypes, eventType) {
+			return nil, errorutils.CheckErrorf("unsupported event type '%s' of the '%s' domain. The supported event types are %s",
+				eventType, wp.Domain, strings.Join(supportedEventTypes, ", "))
+		}
+	}
+	return &Webhook{
+		Key:         wp.Key,
+		Description: wp.Description,
+		Enabled:     true,
+		EventFilter: EventFilter{Domain: wp.Domain, EventTypes: eventTypes, Criteria: getCriteria(wp.Domain, wp.Names)},
+		Handlers:    []Handler{{HandlerType: "webhook", Url: wp.Url, Secret: wp.Secret}},
+	}, nil
+}
+
+// Returns the criteria by which the events of the domain trigger the webhook.
+func getCriteria(domain string, names []string) map[string]any {
+	criteria := map[string]any{"includePatterns": []string{}, "excludePatterns": []string{}}
+	switch domain {
+	case ArtifactDomain:
+		criteria["anyLocal"] = len(names) == 0
+		criteria["anyRemote"] = len(names) == 0
+		criteria["anyFederated"] = len(names) == 0
+		criteria["repoKeys"] = nonNil(names)
+	case BuildDomain:
+		criteria["anyBuild"] = len(names) == 0
+		criteria["selectedBuilds"] = nonNil(names)
+	default:
+		criteria["anyReleaseBundle"] = len(names) == 0
+		criteria["registeredReleaseBundlesNames"] = nonNil(names)
+	}
+	return criteria
+}
+
+// The Event service expects empty lists rather than nulls.
+func nonNil(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
+
+// eventClient sends requests to the subscriptions API of the Event service.
+type eventClient struct {
+	servicesManager  artifactory.ArtifactoryServicesManager
+	subscriptionsUrl string
+}
+
+func newEventClient(serverDetails *config.ServerDetails) (*eventClient, error) {
+	servicesManager, err := clientconfig.CreateServiceManager(serverDetails, -1, 0, false)
+	if err != nil {
+		return nil, err
+	}
+	platformUrl := clientutils.AddTrailingSlashIfNeeded(artifactoryutils.WithPlatformUrl(serverDetails).Url)
+	return &eventClient{servicesManager: servicesManager, subscriptionsUrl: platformUrl + subscriptionsApi}, nil
+}
+
+func (ec *eventClient) createWebhook(webhook *Webhook) error {
+	content, err := json.Marshal(webhook)
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	httpClientDetails := ec.servicesManager.GetConfig().GetServiceDetails().CreateHttpClientDetails()
+	httpClientDetails.SetContentTypeApplicationJson()
+	resp, body, err := ec.servicesManager.Client().SendPost(ec.subscriptionsUrl, content, &httpClientDetails)
+	if err != nil {
+		return err
+	}
+	return clierrors.CheckResponseStatusWithBody(resp, body, http.StatusOK, http.StatusCreated)
+}
+
+func (ec *eventClient) getWebhooks() ([]Webhook, error) {
+	httpClientDetails := ec.servicesManager.GetConfig().GetServiceDetails().CreateHttpClientDetails()
+	resp, body, _, err := ec.servicesManager.Client().SendGet(ec.subscriptionsUrl, true, &httpClientDetails)
+	if err != nil {
+		return nil, err
+	}
+	if err = clierrors.CheckResponseStatusWithBody(resp, body, http.StatusOK); err != nil {
+		return nil, err
+	}
+	var webhooks []Webhook
+	return webhooks, errorutils.CheckError(json.Unmarshal(body, &webhooks))
+}
+
+func (ec *eventClient) deleteWebhook(key string) error {
+	httpClientDetails := ec.servicesManager.GetConfig().GetServiceDetails().CreateHttpClientDetails()
+	resp, body, err := ec.servicesManager.Client().SendDelete(ec.subscriptionsUrl+"/"+url.PathEscape(key), nil, &httpClientDetails)
+	if err != nil {
+		return err
+	}
+	return clierrors.CheckResponseStatusWithBody(resp, body, http.StatusOK, http.StatusNoContent)
+}
@@ -0,0 +1,88 @@
+package webhook
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestToWebhook(t *testing.T) {
+	webhook, err := (&WebhookParams{Key: "deployments", Url: "https://ci.example.com/hook", Domain: ArtifactDomain, EventTypes: []string{"deployed"}, Names: []string{"libs-release-local"}}).toWebhook()
+	require.NoError(t, err)
+	content, err := json.Marshal(webhook)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"key":"deployments","enabled":true,
+		"event_filter":{"domain":"artifact","event_types":["deployed"],"criteria":{"anyLocal":false,"anyRemote":false,"anyFederated":false,
+			"repoKeys":["libs-release-local"],"includePatterns":[],"excludePatterns":[]}},
+		"handlers":[{"handler_type":"webhook","url":"https://ci.example.com/hook"}]}`, string(content))
+
+	// All the event types of the domain are subscribed to by default.
+	webhook, err = (&WebhookParams{Key: "builds", Url: "https://ci.example.com/hook", Domain: BuildDomain}).toWebhook()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"uploaded", "deleted", "promoted"}, webhook.EventFilter.EventTypes)
+	assert.Equal(t, true, webhook.EventFilter.Criteria["anyBuild"])
+
+	_, err = (&WebhookParams{Key: "k", Url: "https://ci.example.com/hook", Domain: "docker"}).toWebhook()
+	assert.ErrorContains(t, err, "unsupported webhook domain 'docker'")
+	_, err = (&WebhookParams{Key: "k", Url: "https://ci.example.com/hook", Domain: BuildDomain, EventTypes: []string{"deployed"}}).toWebhook()
+	assert.ErrorContains(t, err, "unsupported event type 'deployed' of the 'build' domain")
+	_, err = (&WebhookParams{Key: "k", Domain: BuildDomain}).toWebhook()
+	assert.ErrorContains(t, err, "must have a key and a URL")
+}
+
+func TestEventClient(t *testing.T) {
+	requests := make(map[string]string)
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		content, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		requests[r.Method+" "+r.URL.Path] = string(content)
+		if r.Method == http.MethodGet {
+			_, err = w.Write([]byte(`[{"key":"deployments","enabled":true,"event_filter":{"domain":"artifact","event_types":["deployed"]},"handlers":[{"handler_type":"webhook","url":"https://ci.example.com/hook","secret":"s3cr3t"}]}]`))
+			assert.NoError(t, err)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer testServer.Close()
+	ec, err := newEventClient(&config.ServerDetails{ArtifactoryUrl: testServer.URL + "/artifactory/"})
+	require.NoError(t, err)
+
+	webhook, err := (&WebhookParams{Key: "deployments", Url: "https://ci.example.com/hook", Domain: ArtifactDomain}).toWebhook()
+	require.NoError(t, err)
+	require.NoError(t, ec.createWebhook(webhook))
+	assert.Contains(t, requests["POST /event/api/v1/subscriptions"], `"key":"deployments"`)
+
+	webhooks, err := ec.getWebhooks()
+	require.NoError(t, err)
+	require.Len(t, webhooks, 1)
+	assert.Equal(t, "deployments", webhooks[0].Key)
+	assert.Equal(t, []string{"deployed"}, webhooks[0].EventFilter.EventTypes)
+
+	require.NoError(t, ec.deleteWebhook("deployments"))
+	assert.Contains(t, requests, "DELETE /event/api/v1/subscriptions/deployments")
+}
+
+func TestEventHandler(t *testing.T) {
+	var output bytes.Buffer
+	handler := newEventHandler("s3cr3t", &output)
+
+	post := func(secret, body string) int {
+		request := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+		request.Header.Set(eventAuthHeader, secret)
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, request)
+		return recorder.Code
+	}
+	assert.Equal(t, http.StatusOK, post("s3cr3t", `{"domain":"artifact","event_type":"deployed","data":{"repo_key":"libs-release-local"}}`))
+	assert.Equal(t, http.StatusUnauthorized, post("wrong", `{"domain":"artifact"}`))
+	assert.Equal(t, http.StatusBadRequest, post("s3cr3t", `not json`))
+	assert.Equal(t, "{\n  \"data\": {\n    \"repo_key\": \"libs-release-local\"\n  },\n  \"domain\": \"artifact\",\n  \"event_type\": \"deployed\"\n}\n", output.String())
+}
@@ -0,0 +1,14 @@
+package yarn
+
+import (
+	"os"
+	"path/filepath"
+)
+
+const yarnPnpFileName = ".pnp.cjs"
+
+// isPnpProject returns true if the dependencies of the project are installed with Yarn Plug'n'Play.
+func isPnpProject(workingDirectory string) bool {
+	_, err := os.Stat(filepath.Join(workingDirectory, yarnPnpFileName))
+	return err == nil
+}
@@ -0,0 +1,17 @@
+package yarn
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsPnpProject(t *testing.T) {
+	workingDirectory := t.TempDir()
+	assert.False(t, isPnpProject(workingDirectory))
+	require.NoError(t, os.WriteFile(filepath.Join(workingDirectory, yarnPnpFileName), []byte{}, 0644))
+	assert.True(t, isPnpProject(workingDirectory))
+}
@@ -20,8 +20,8 @@ import (
 	"github.com/jfrog/build-info-go/build"
 	buildUtils "github.com/jfrog/jfrog-cli-core/v2/common/build"
 
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clientconfig"
 	commandUtils "github.com/jfrog/jfrog-cli-core/v2/artifactory/commands/utils"
-	"github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
 	"github.com/jfrog/jfrog-cli-core/v2/artifactory/utils/yarn"
 	"github.com/jfrog/jfrog-cli-core/v2/common/project"
 	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
@@ -101,6 +101,9 @@ func (yc *YarnCommand) Run() (err error) {
 	if err != nil {
 		return err
 	}
+	if isPnpProject(yc.workingDirectory) {
+		log.Debug("The Yarn project uses Plug'n'Play. The dependencies are resolved into the Yarn cache rather than into node_modules.")
+	}
 
 	var missingDepsChan chan string
 	var missingDependencies []string
@@ -132,7 +135,7 @@ func (yc *YarnCommand) Run() (err error) {
 
 	if yc.collectBuildInfo {
 		close(missingDepsChan)
-		printMissingDependencies(missingDependencies)
+		PrintMissingDependencies(missingDependencies)
 	}
 
 	if err = RestoreConfigurationsFromBackup(backupEnvMap, restoreYarnrcFunc); err != nil {
@@ -176,7 +179,7 @@ func (yc *YarnCommand) validateSupportedCommand() error {
 // validateSupportedVersion checks if the version to be set is supported.
 // currently version 4 is not supported.
 func validateSupportedVersion(arg string, yarnArgs []string, index int) error {
-	if arg == "set" && len(yarnArgs) > index {
+	if arg == "set" && len(yarnArgs) > index+1 {
 		setCommand := yarnArgs[index+1]
 		if setCommand == "version" && len(yarnArgs) > index+2 {
 			versionCommand := yarnArgs[index+2]
@@ -252,7 +255,7 @@ func (yc *YarnCommand) preparePrerequisites() error {
 
 func (yc *YarnCommand) prepareBuildInfo() (missingDepsChan chan string, err error) {
 	log.Info("Preparing for dependencies information collection... For the first run of the build, the dependencies collection may take a few minutes. Subsequent runs should be faster.")
-	servicesManager, err := utils.CreateServiceManager(yc.serverDetails, -1, 0, false)
+	servicesManager, err := clientconfig.CreateServiceManager(yc.serverDetails, -1, 0, false)
 	if err != nil {
 		return
 	}
@@ -262,12 +265,12 @@ func (yc *YarnCommand) prepareBuildInfo() (missingDepsChan chan string, err erro
 	if err != nil {
 		return
 	}
-	previousBuildDependencies, err := getDependenciesFromLatestBuild(servicesManager, buildName)
+	previousBuildDependencies, err := GetDependenciesFromLatestBuild(servicesManager, buildName)
 	if err != nil {
 		return
 	}
 	missingDepsChan = make(chan string)
-	collectChecksumsFunc := createCollectChecksumsFunc(previousBuildDependencies, servicesManager, missingDepsChan)
+	collectChecksumsFunc := CreateCollectChecksumsFunc(previousBuildDependencies, servicesManager, missingDepsChan)
 	yc.buildInfoModule.SetTraverseDependenciesFunc(collectChecksumsFunc)
 	yc.buildInfoModule.SetThreads(yc.threads)
 	return
@@ -449,7 +452,9 @@ type aqlResult struct {
 	Results []*servicesUtils.ResultItem `json:"results,omitempty"`
 }
 
-func getDependenciesFromLatestBuild(servicesManager artifactory.ArtifactoryServicesManager, buildName string) (map[string]*entities.Dependency, error) {
+// GetDependenciesFromLatestBuild returns the dependencies of the latest build of the build name, by their IDs, to avoid
+// querying Artifactory for the checksums of dependencies which were already collected.
+func GetDependenciesFromLatestBuild(servicesManager artifactory.ArtifactoryServicesManager, buildName string) (map[string]*entities.Dependency, error) {
 	buildDependencies := make(map[string]*entities.Dependency)
 	previousBuild, found, err := servicesManager.GetBuildInfo(services.BuildInfoParams{BuildName: buildName, BuildNumber: servicesUtils.LatestBuildNumberKey})
 	if err != nil || !found {
@@ -526,7 +531,8 @@ func extractYarnOptionsFromArgs(args []string) (threads int, detailedSummary, xr
 	return
 }
 
-func printMissingDependencies(missingDependencies []string) {
+// PrintMissingDependencies warns about the npm dependencies which couldn't be found in Artifactory.
+func PrintMissingDependencies(missingDependencies []string) {
 	if len(missingDependencies) == 0 {
 		return
 	}
@@ -535,7 +541,9 @@ func printMissingDependencies(missingDependencies []string) {
 		"Deleting the local cache will force populating Artifactory with these dependencies.")
 }
 
-func createCollectChecksumsFunc(previousBuildDependencies map[string]*entities.Dependency, servicesManager artifactory.ArtifactoryServicesManager, missingDepsChan chan string) func(dependency *entities.Dependency) (bool, error) {
+// CreateCollectChecksumsFunc returns a function which sets the checksums and type of an npm dependency from Artifactory.
+// The IDs of the dependencies which can't be found are sent to the missing dependencies channel.
+func CreateCollectChecksumsFunc(previousBuildDependencies map[string]*entities.Dependency, servicesManager artifactory.ArtifactoryServicesManager, missingDepsChan chan string) func(dependency *entities.Dependency) (bool, error) {
 	return func(dependency *entities.Dependency) (bool, error) {
 		splitDepId := strings.SplitN(dependency.Id, ":", 2)
 		name := splitDepId[0]
@@ -5,6 +5,7 @@ import "github.com/jfrog/jfrog-cli-core/v2/plugins/components"
 var Usage = []string{
 	"rt bad [command options] <build name> <build number> <pattern>",
 	"rt bad --spec=<File Spec path> [command options] <build name> <build number>",
+	"rt bad --lockfile=<lockfile path> [command options] <build name> <build number>",
 }
 
 func GetDescription() string {
@@ -29,7 +30,8 @@ You can specify multiple dependencies by using wildcards or a regular expression
 as designated by the --regexp command option.
 When the --from-rt option is added, this argument specifies a path in Artifactory
 in the following format: <repository name>/<repository path>, from which the dependencies
-should be collected and added to the build. You can use wildcards to specify multiple files.`,
+should be collected and added to the build. You can use wildcards to specify multiple files.
+Not used with the --spec or --lockfile options.`,
 		},
 	}
 }
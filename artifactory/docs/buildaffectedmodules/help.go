@@ -0,0 +1,24 @@
+package buildaffectedmodules
+
+import "github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+
+var Usage = []string{
+	"rt bam [command options] <build name> [Path To .git]",
+}
+
+func GetDescription() string {
+	return "Lists the modules affected by the git changes since the latest published build, according to a modules manifest."
+}
+
+func GetArguments() []components.Argument {
+	return []components.Argument{
+		{
+			Name:        "build name",
+			Description: "Build name. The VCS revision of the latest published build with this name is compared to the local git history.",
+		},
+		{
+			Name:        "path to .git",
+			Description: "Path to a directory containing the .git directory. If not specified, the .git directory is assumed to be in the current directory or in one of the parent directories.",
+		},
+	}
+}
@@ -4,6 +4,7 @@ import "github.com/jfrog/jfrog-cli-core/v2/plugins/components"
 
 var Usage = []string{
 	"rt ba <build name> <build number> <build name to append> <build number to append>",
+	"rt ba --children=<builds> [command options] <build name> <build number>",
 }
 
 func GetDescription() string {
@@ -26,7 +27,7 @@ func GetArguments() []components.Argument {
 		},
 		{
 			Name:        "build number to append",
-			Description: "The published build number to append to the current build.",
+			Description: "The published build number to append to the current build. The build to append may be omitted when the --children option is set.",
 		},
 	}
 }
@@ -7,7 +7,7 @@ var Usage = []string{
 }
 
 func GetDescription() string {
-	return "Collect environment variables. Environment variables can be excluded using the build-publish command."
+	return "Collect environment variables. The collected variables can be filtered by regular expressions on their names, and the values of variables which look like secrets are masked. Environment variables can also be excluded using the build-publish command."
 }
 
 func GetArguments() []components.Argument {
@@ -5,7 +5,7 @@ import "github.com/jfrog/jfrog-cli-core/v2/plugins/components"
 var Usage = []string{"rt build-docker-create <target repo> --image-file=<Image file path>"}
 
 func GetDescription() string {
-	return "Add a published docker image to the build-info."
+	return "Add a published docker image to the build-info, from the image file of Kaniko, OpenShift or buildx/BuildKit. The image layers are added as artifacts, and the layers of its base images as dependencies. No Docker daemon is required."
 }
 
 func GetArguments() []components.Argument {
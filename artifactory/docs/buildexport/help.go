@@ -0,0 +1,28 @@
+package buildexport
+
+import "github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+
+var Usage = []string{
+	"rt be [command options] <build name> <build number> [target path]",
+}
+
+func GetDescription() string {
+	return "Download a published build-info, and optionally the artifacts of the build, to a local directory."
+}
+
+func GetArguments() []components.Argument {
+	return []components.Argument{
+		{
+			Name:        "build name",
+			Description: "Build name.",
+		},
+		{
+			Name:        "build number",
+			Description: "Build number. Set to LATEST to export the latest published build.",
+		},
+		{
+			Name:        "target path",
+			Description: "[Default: ./] The local directory to which the build-info is written, as build-info.json.",
+		},
+	}
+}
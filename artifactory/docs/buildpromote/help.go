@@ -2,7 +2,10 @@ package buildpromote
 
 import "github.com/jfrog/jfrog-cli-core/v2/plugins/components"
 
-var Usage = []string{"rt bpr [command options] <build name> <build number> <target repository>"}
+var Usage = []string{
+	"rt bpr [command options] <build name> <build number> <target repository>",
+	"rt bpr --chain=<steps> [command options] <build name> <build number>",
+}
 
 func GetDescription() string {
 	return "This command is used to promote build in Artifactory."
@@ -20,7 +23,7 @@ func GetArguments() []components.Argument {
 		},
 		{
 			Name:        "target repository",
-			Description: "Build promotion target repository.",
+			Description: "Build promotion target repository. Not used with the --chain option.",
 		},
 	}
 }
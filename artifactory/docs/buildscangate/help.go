@@ -0,0 +1,24 @@
+package buildscangate
+
+import "github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+
+var Usage = []string{
+	"rt bsg [command options] <build name> <build number>",
+}
+
+func GetDescription() string {
+	return "Scan a published build with Xray, wait for the scan to complete and print its results. Exits with code 3 if the build violates the Xray policies, or has issues of the severity set by --fail-on-severity or above."
+}
+
+func GetArguments() []components.Argument {
+	return []components.Argument{
+		{
+			Name:        "build name",
+			Description: "Build name.",
+		},
+		{
+			Name:        "build number",
+			Description: "Build number.",
+		},
+	}
+}
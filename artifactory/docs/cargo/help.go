@@ -0,0 +1,7 @@
+package cargo
+
+var Usage = []string{"rt cargo [cargo command] [command options]"}
+
+func GetDescription() string {
+	return "Run Cargo commands, resolving the crates from a Cargo repository in Artifactory and publishing them to it. The crates pinned by Cargo.lock are collected into the build-info with the checksums of their files in Artifactory."
+}
@@ -0,0 +1,20 @@
+package cleanup
+
+import "github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+
+var Usage = []string{"rt cleanup [command options] <policies file>"}
+
+func GetDescription() string {
+	return "Delete the files which the retention policies of a YAML file select, by age, downloads, properties and the number of versions to keep."
+}
+
+func GetArguments() []components.Argument {
+	return []components.Argument{
+		{
+			Name: "policies file",
+			Description: "Path to a YAML file with a 'policies' list. Each policy has a 'name' and 'repos', and selects the files matching all its criteria: " +
+				"'path' and 'fileName' patterns, 'olderThan' and 'notDownloadedWithin' periods such as 90d, 'maxDownloads', 'properties' of the form key=value, " +
+				"and 'keepVersions', the number of most recent versions of each package to keep.",
+		},
+	}
+}
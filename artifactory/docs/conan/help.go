@@ -0,0 +1,7 @@
+package conan
+
+var Usage = []string{"rt conan [conan command] [command options]"}
+
+func GetDescription() string {
+	return "Run Conan commands, resolving the packages from Artifactory and uploading them to it. With Conan 2.x, the recipes pinned by conan.lock are collected into the build-info with the checksums of their files in Artifactory."
+}
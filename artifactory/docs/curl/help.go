@@ -2,17 +2,18 @@ package curl
 
 import "github.com/jfrog/jfrog-cli-core/v2/plugins/components"
 
-var Usage = []string{"rt curl [command options] <curl command>"}
+var Usage = []string{"rt curl [command options] <curl command>",
+	"rt curl --spec=<curl spec path> [--spec-vars=<vars>]"}
 
 func GetDescription() string {
-	return "Execute a cUrl command, using the configured Artifactory details."
+	return "Execute a cUrl command, using the configured Artifactory details, or send the request described by a curl spec and merge its paginated responses."
 }
 
 func GetArguments() []components.Argument {
 	return []components.Argument{
 		{
 			Name:        "curl command",
-			Description: "cUrl command to run.",
+			Description: "cUrl command to run. Not provided when --spec is used.",
 		},
 	}
 }
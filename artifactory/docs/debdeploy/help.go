@@ -0,0 +1,22 @@
+package debdeploy
+
+import "github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+
+var Usage = []string{"rt debd [command options] <files pattern> <target repository>[/<path>]"}
+
+func GetDescription() string {
+	return "Deploy Debian packages to a Debian repository with their distribution, component and architecture, trigger the recalculation of its metadata, and wait for the metadata to index them."
+}
+
+func GetArguments() []components.Argument {
+	return []components.Argument{
+		{
+			Name:        "files pattern",
+			Description: "Local path of the .deb files. The * wildcard can be used to deploy several packages.",
+		},
+		{
+			Name:        "target repository",
+			Description: "The Debian repository, optionally followed by the path of the packages in it. By default, the packages are deployed to its pool, as in the Debian archive.",
+		},
+	}
+}
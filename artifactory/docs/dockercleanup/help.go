@@ -0,0 +1,18 @@
+package dockercleanup
+
+import "github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+
+var Usage = []string{"rt docker-cleanup [command options] <repository>"}
+
+func GetDescription() string {
+	return "Delete the Docker tags of a repository which none of the keep rules match, and the manifests no longer referenced by a kept tag."
+}
+
+func GetArguments() []components.Argument {
+	return []components.Argument{
+		{
+			Name:        "repository",
+			Description: "The Docker repository to clean up.",
+		},
+	}
+}
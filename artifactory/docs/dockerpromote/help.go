@@ -5,7 +5,8 @@ import "github.com/jfrog/jfrog-cli-core/v2/plugins/components"
 var Usage = []string{"rt docker-promote <source docker image> <source repo> <target repo>"}
 
 func GetDescription() string {
-	return "Promotes a Docker image from one repository to another. Supported by local repositories only."
+	return "Promotes a Docker image from one repository to another. Supported by local repositories only. " +
+		"When the promoted tag is a multi-arch manifest list, the manifests it references are promoted along with it, and their layers are verified to exist in the target repository before the tag is promoted."
 }
 
 func GetArguments() []components.Argument {
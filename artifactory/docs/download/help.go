@@ -25,7 +25,10 @@ func GetArguments() []components.Argument {
 			Description: `Optional argument specifying the local file system target path.
 If the target path ends with a slash, it is assumed to be a directory.
 If there is no terminal slash, the target path is assumed to be a file.
-Placeholders in the form of {1}, {2} can be used, replaced by corresponding tokens in the source path enclosed in parentheses.`,
+Placeholders in the form of {1}, {2} can be used, replaced by corresponding tokens in the source path enclosed in parentheses.
+Placeholders of the artifact properties, such as {property.version}, and of its AQL fields ({repo}, {path}, {name}, {created},
+{modified}, {updated}, {created_by}, {modified_by}, {sha256}, {actual_sha1}, {actual_md5}, {size} and {type}) can also be used,
+for example "{property.version}/{name}". When these placeholders are used, the repository path of the artifact is not added to the target.`,
 		},
 	}
 }
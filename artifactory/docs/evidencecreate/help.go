@@ -0,0 +1,18 @@
+package evidencecreate
+
+import "github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+
+var Usage = []string{"rt evidence-create [command options] <predicate path>"}
+
+func GetDescription() string {
+	return "Create signed evidence, which attaches a JSON predicate to a subject. The subject is given by its repository path, or by its package coordinates, which are resolved to the docker manifest, maven artifact, npm tarball, pypi distribution or generic file of the package version."
+}
+
+func GetArguments() []components.Argument {
+	return []components.Argument{
+		{
+			Name:        "predicate path",
+			Description: "Path to the JSON predicate of the evidence.",
+		},
+	}
+}
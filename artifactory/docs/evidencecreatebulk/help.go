@@ -0,0 +1,19 @@
+package evidencecreatebulk
+
+import "github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+
+var Usage = []string{"rt evidence-create-bulk [command options] <manifest path>"}
+
+func GetDescription() string {
+	return "Create the signed evidence listed in a YAML or JSON manifest, in parallel. Each item of the manifest attaches a JSON predicate to a subject, given by its repository path or by its package coordinates."
+}
+
+func GetArguments() []components.Argument {
+	return []components.Argument{
+		{
+			Name: "manifest path",
+			Description: "Path to the manifest, which has the optional 'key' and 'keyAlias' defaults and a list of 'items'. Each item has a 'subjectRepoPath' or a 'package' with its 'type', 'repo', 'name' and 'version', " +
+				"a 'predicate' file, a 'predicateType', and an optional 'key' and 'keyAlias'. The paths of the predicates and the keys are relative to the manifest.",
+		},
+	}
+}
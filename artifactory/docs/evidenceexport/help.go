@@ -0,0 +1,18 @@
+package evidenceexport
+
+import "github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+
+var Usage = []string{"rt evidence-export [command options] <subject repository path>"}
+
+func GetDescription() string {
+	return "Export all the evidence of an artifact, with the trusted public keys which verify it, into a bundle which can be verified offline by the evidence-verify command."
+}
+
+func GetArguments() []components.Argument {
+	return []components.Argument{
+		{
+			Name:        "subject repository path",
+			Description: "The repository path of the artifact whose evidence is exported.",
+		},
+	}
+}
@@ -0,0 +1,18 @@
+package evidenceexportgithub
+
+import "github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+
+var Usage = []string{"rt evidence-export-github [command options] <subject repository path>"}
+
+func GetDescription() string {
+	return "Export the evidence of an artifact as Sigstore bundles, in the JSON Lines format of the files downloaded by 'gh attestation download'. The bundles identify the key which verifies them by its alias in the platform."
+}
+
+func GetArguments() []components.Argument {
+	return []components.Argument{
+		{
+			Name:        "subject repository path",
+			Description: "The repository path of the artifact whose evidence is exported.",
+		},
+	}
+}
@@ -0,0 +1,18 @@
+package evidenceimportgithub
+
+import "github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+
+var Usage = []string{"rt evidence-import-github [command options] <bundle path>"}
+
+func GetDescription() string {
+	return "Upload GitHub artifact attestations as evidence. Each attestation is attached to the artifact in Artifactory with the sha256 digest of its subject."
+}
+
+func GetArguments() []components.Argument {
+	return []components.Argument{
+		{
+			Name:        "bundle path",
+			Description: "Path to the Sigstore bundles of the attestations, such as the JSON Lines file downloaded by 'gh attestation download', or the response of the attestations API of GitHub.",
+		},
+	}
+}
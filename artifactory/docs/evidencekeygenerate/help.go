@@ -0,0 +1,18 @@
+package evidencekeygenerate
+
+import "github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+
+var Usage = []string{"rt evidence-key-generate [command options] <private key path>"}
+
+func GetDescription() string {
+	return "Generate an Ed25519, ECDSA or RSA key pair which signs evidence. The public key is written next to the private key, with a '.pub' suffix."
+}
+
+func GetArguments() []components.Argument {
+	return []components.Argument{
+		{
+			Name:        "private key path",
+			Description: "Path of the unencrypted private key in PEM format. Existing files aren't overwritten.",
+		},
+	}
+}
@@ -0,0 +1,7 @@
+package evidencekeylist
+
+var Usage = []string{"rt evidence-key-list [command options]"}
+
+func GetDescription() string {
+	return "List the trusted keys of the platform, which verify evidence."
+}
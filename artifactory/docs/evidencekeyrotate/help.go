@@ -0,0 +1,22 @@
+package evidencekeyrotate
+
+import "github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+
+var Usage = []string{"rt evidence-key-rotate [command options] <new alias> <new private key path>"}
+
+func GetDescription() string {
+	return "Generate a new key pair which signs evidence, and trust its public key under a new alias. The previous keys stay trusted, so the evidence they signed is still verified during the rotation window."
+}
+
+func GetArguments() []components.Argument {
+	return []components.Argument{
+		{
+			Name:        "new alias",
+			Description: "The alias of the new trusted key, which should differ from the aliases of the previous keys.",
+		},
+		{
+			Name:        "new private key path",
+			Description: "Path of the new unencrypted private key in PEM format. The public key is written next to it, with a '.pub' suffix.",
+		},
+	}
+}
@@ -0,0 +1,22 @@
+package evidencekeyupload
+
+import "github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+
+var Usage = []string{"rt evidence-key-upload [command options] <alias> <public key path>"}
+
+func GetDescription() string {
+	return "Add a public key to the trusted keys of the platform, which verify the evidence signed by its private key."
+}
+
+func GetArguments() []components.Argument {
+	return []components.Argument{
+		{
+			Name:        "alias",
+			Description: "The alias of the trusted key. Evidence signed by the private key should be created with this key alias.",
+		},
+		{
+			Name:        "public key path",
+			Description: "Path to the public key in PEM format. If a private key is provided, only its public key is uploaded.",
+		},
+	}
+}
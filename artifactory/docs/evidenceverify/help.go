@@ -0,0 +1,7 @@
+package evidenceverify
+
+var Usage = []string{"rt evidence-verify [command options] --bundle=<bundle path>"}
+
+func GetDescription() string {
+	return "Verify the signatures of the evidence in an exported bundle, and that it is about the subject of the bundle, without access to the platform."
+}
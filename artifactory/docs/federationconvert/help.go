@@ -0,0 +1,18 @@
+package federationconvert
+
+import "github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+
+var Usage = []string{"rt fedc <repository key>"}
+
+func GetDescription() string {
+	return "Convert a local repository to a federated repository."
+}
+
+func GetArguments() []components.Argument {
+	return []components.Argument{
+		{
+			Name:        "repository key",
+			Description: "The key of the local repository to convert.",
+		},
+	}
+}
@@ -0,0 +1,22 @@
+package federationmemberadd
+
+import "github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+
+var Usage = []string{"rt fedma <repository key> <member URL>"}
+
+func GetDescription() string {
+	return "Add a member to a federated repository."
+}
+
+func GetArguments() []components.Argument {
+	return []components.Argument{
+		{
+			Name:        "repository key",
+			Description: "The key of the federated repository.",
+		},
+		{
+			Name:        "member URL",
+			Description: "The URL of the member repository, for example https://acme.jfrog.io/artifactory/generic-federated.",
+		},
+	}
+}
@@ -0,0 +1,22 @@
+package federationmemberremove
+
+import "github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+
+var Usage = []string{"rt fedmr <repository key> <member URL>"}
+
+func GetDescription() string {
+	return "Remove a member from a federated repository."
+}
+
+func GetArguments() []components.Argument {
+	return []components.Argument{
+		{
+			Name:        "repository key",
+			Description: "The key of the federated repository.",
+		},
+		{
+			Name:        "member URL",
+			Description: "The URL of the member repository to remove.",
+		},
+	}
+}
@@ -0,0 +1,18 @@
+package federationstatus
+
+import "github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+
+var Usage = []string{"rt fedst <repository key>"}
+
+func GetDescription() string {
+	return "Show the synchronization status of a federated repository with each of its members."
+}
+
+func GetArguments() []components.Argument {
+	return []components.Argument{
+		{
+			Name:        "repository key",
+			Description: "The key of the federated repository.",
+		},
+	}
+}
@@ -0,0 +1,18 @@
+package federationsync
+
+import "github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+
+var Usage = []string{"rt feds <repository key>"}
+
+func GetDescription() string {
+	return "Trigger a full synchronization of a federated repository with its members."
+}
+
+func GetArguments() []components.Argument {
+	return []components.Argument{
+		{
+			Name:        "repository key",
+			Description: "The key of the federated repository.",
+		},
+	}
+}
@@ -0,0 +1,18 @@
+package groupslist
+
+import "github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+
+var Usage = []string{"rt gl [command options] [group name pattern]"}
+
+func GetDescription() string {
+	return "List the groups, with their settings and members."
+}
+
+func GetArguments() []components.Argument {
+	return []components.Argument{
+		{
+			Name:        "group name pattern",
+			Description: "[Optional] Specifies the groups to list. You can use wildcards to specify multiple groups. If not set, all the groups are listed.",
+		},
+	}
+}
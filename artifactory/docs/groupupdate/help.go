@@ -0,0 +1,18 @@
+package groupupdate
+
+import "github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+
+var Usage = []string{"rt gu [command options] <group name>"}
+
+func GetDescription() string {
+	return "Update the description, auto-join or admin privileges of a group. The details which aren't set are kept."
+}
+
+func GetArguments() []components.Argument {
+	return []components.Argument{
+		{
+			Name:        "group name",
+			Description: "The name of the group to update.",
+		},
+	}
+}
@@ -0,0 +1,13 @@
+package healthcheck
+
+import "github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+
+var Usage = []string{"rt hc [command options]"}
+
+func GetDescription() string {
+	return "Check that Artifactory is reachable, its version and license, the skew between the clocks, and optionally that a repository can be written to and read from, for use as a pre-flight step of a CI pipeline."
+}
+
+func GetArguments() []components.Argument {
+	return nil
+}
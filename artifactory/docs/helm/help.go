@@ -0,0 +1,7 @@
+package helm
+
+var Usage = []string{"rt helm [helm command] [command options]"}
+
+func GetDescription() string {
+	return "Run Helm commands against Artifactory. Charts are pushed to OCI repositories with helm push, or uploaded with their provenance files to Helm repositories, and the pushed and pulled charts are collected into the build-info."
+}
@@ -0,0 +1,21 @@
+package immutable
+
+import "github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+
+var Usage = []string{
+	"rt immutable [command options] <files pattern>",
+	"rt immutable --spec=<File Spec path> [command options]",
+}
+
+func GetDescription() string {
+	return "Mark files in Artifactory as immutable, by the 'lifecycle.immutable' property. The delete, move, copy, sync and atomic upload commands deny operations which would remove or replace immutable files, and the cleanup command keeps them. Use --release to remove the mark."
+}
+
+func GetArguments() []components.Argument {
+	return []components.Argument{
+		{
+			Name:        "files pattern",
+			Description: "Specifies the artifacts in Artifactory to mark. Use <repository>/<path> format and wildcards (*, ?) to match multiple artifacts.",
+		},
+	}
+}
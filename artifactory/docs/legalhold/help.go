@@ -0,0 +1,21 @@
+package legalhold
+
+import "github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+
+var Usage = []string{
+	"rt legal-hold [command options] <files pattern>",
+	"rt legal-hold --spec=<File Spec path> [command options]",
+}
+
+func GetDescription() string {
+	return "Place files in Artifactory under legal hold, by the 'lifecycle.legal-hold' property. The delete, move, copy, sync and atomic upload commands deny operations which would remove or replace files under legal hold, and the cleanup command keeps them. Use --release to lift the hold."
+}
+
+func GetArguments() []components.Argument {
+	return []components.Argument{
+		{
+			Name:        "files pattern",
+			Description: "Specifies the artifacts in Artifactory to place under legal hold. Use <repository>/<path> format and wildcards (*, ?) to match multiple artifacts.",
+		},
+	}
+}
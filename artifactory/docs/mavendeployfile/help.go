@@ -0,0 +1,22 @@
+package mavendeployfile
+
+import "github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+
+var Usage = []string{"rt mvndf --group-id=<group ID> --artifact-id=<artifact ID> --version=<version> [command options] <file> <target repository>"}
+
+func GetDescription() string {
+	return "Deploys a file, such as a vendored jar, to a Maven repository by its GAV coordinates with a generated minimal POM, without running a Maven build."
+}
+
+func GetArguments() []components.Argument {
+	return []components.Argument{
+		{
+			Name:        "file",
+			Description: "Path to the file to deploy.",
+		},
+		{
+			Name:        "target repository",
+			Description: "The Maven repository to deploy the file to.",
+		},
+	}
+}
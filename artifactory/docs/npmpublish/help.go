@@ -3,5 +3,7 @@ package npmpublish
 var Usage = []string{"rt npmp [command options]"}
 
 func GetDescription() string {
-	return "Packs and deploys the npm package to the designated npm repository."
+	return "Packs and deploys the npm package to the designated npm repository. " +
+		"With --workspaces or --workspace, the packages of the npm workspace are published, each as a build-info module of its own. " +
+		"With --provenance-key, a signed SLSA provenance of each published package version is attached to it as evidence."
 }
@@ -0,0 +1,22 @@
+package ocipull
+
+import "github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+
+var Usage = []string{"rt ocipl [command options] <reference> [target directory]"}
+
+func GetDescription() string {
+	return "Downloads the files of an OCI artifact from a Docker or OCI repository."
+}
+
+func GetArguments() []components.Argument {
+	return []components.Argument{
+		{
+			Name:        "reference",
+			Description: "The reference of the artifact in the form of <repository>/<name>:<tag> or <repository>/<name>@<digest>.",
+		},
+		{
+			Name:        "target directory",
+			Description: "[Default: .] The directory to which the files are downloaded.",
+		},
+	}
+}
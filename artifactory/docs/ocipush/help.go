@@ -0,0 +1,22 @@
+package ocipush
+
+import "github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+
+var Usage = []string{"rt ocip [command options] <reference> <file>..."}
+
+func GetDescription() string {
+	return "Pushes files as an OCI artifact to a Docker or OCI repository, and prints the digest of the artifact."
+}
+
+func GetArguments() []components.Argument {
+	return []components.Argument{
+		{
+			Name:        "reference",
+			Description: "The reference of the artifact in the form of <repository>/<name>:<tag>, for example 'oci-local/app-sbom:1.0.0'.",
+		},
+		{
+			Name:        "file",
+			Description: "Files to push as the layers of the artifact. A file may be followed by its media type in the form of <path>:<media type>. The default media type is 'application/octet-stream'.",
+		},
+	}
+}
@@ -0,0 +1,18 @@
+package ocireferrers
+
+import "github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+
+var Usage = []string{"rt ocir [command options] <reference>"}
+
+func GetDescription() string {
+	return "Lists the artifacts which refer to an OCI artifact or image, such as its SBOMs and signatures."
+}
+
+func GetArguments() []components.Argument {
+	return []components.Argument{
+		{
+			Name:        "reference",
+			Description: "The reference of the artifact in the form of <repository>/<name>:<tag> or <repository>/<name>@<digest>.",
+		},
+	}
+}
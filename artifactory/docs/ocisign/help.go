@@ -0,0 +1,18 @@
+package ocisign
+
+import "github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+
+var Usage = []string{"rt ocis [command options] --key=<private key path> <reference>"}
+
+func GetDescription() string {
+	return "Signs an image or an OCI artifact in the cosign format, and stores the signature alongside it in the repository. The signature can be verified with 'cosign verify --key'."
+}
+
+func GetArguments() []components.Argument {
+	return []components.Argument{
+		{
+			Name:        "reference",
+			Description: "The reference of the image in the form of <repository>/<name>:<tag> or <repository>/<name>@<digest>.",
+		},
+	}
+}
@@ -0,0 +1,18 @@
+package ociverify
+
+import "github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+
+var Usage = []string{"rt ociv [command options] --key=<public key path> <reference>"}
+
+func GetDescription() string {
+	return "Verifies the cosign signatures of an image or an OCI artifact, and prints the verified signature payloads. Fails unless at least one signature is valid."
+}
+
+func GetArguments() []components.Argument {
+	return []components.Argument{
+		{
+			Name:        "reference",
+			Description: "The reference of the image in the form of <repository>/<name>:<tag> or <repository>/<name>@<digest>.",
+		},
+	}
+}
@@ -0,0 +1,14 @@
+package oidctokenexchange
+
+import "github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+
+var Usage = []string{"rt ote [command options]"}
+
+func GetDescription() string {
+	return "Exchange the OIDC identity token of the running CI job for an access token, so that pipelines access the JFrog Platform without stored secrets. " +
+		"The identity token is obtained from GitHub Actions, GitLab CI or CircleCI. The access token is printed, or saved as the credentials of a server in the JFrog CLI configuration with the --save-as option."
+}
+
+func GetArguments() []components.Argument {
+	return nil
+}
@@ -0,0 +1,7 @@
+package pdm
+
+var Usage = []string{"rt pdm [pdm command] [command options]"}
+
+func GetDescription() string {
+	return "Run PDM commands, resolving the packages from Artifactory and publishing them to it. The packages installed by 'pdm install' and 'pdm sync' are collected into the build-info from pdm.lock."
+}
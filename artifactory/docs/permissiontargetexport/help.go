@@ -0,0 +1,22 @@
+package permissiontargetexport
+
+import "github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+
+var Usage = []string{"rt ptex <permission target pattern> <config path>"}
+
+func GetDescription() string {
+	return "Export permission targets to a configuration file."
+}
+
+func GetArguments() []components.Argument {
+	return []components.Argument{
+		{
+			Name:        "permission target pattern",
+			Description: "Specifies the permission targets to export. You can use wildcards to specify multiple permission targets.",
+		},
+		{
+			Name:        "config path",
+			Description: "The path of the exported file. The file is written as JSON if it has a .json extension, and as YAML otherwise.",
+		},
+	}
+}
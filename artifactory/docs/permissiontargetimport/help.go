@@ -0,0 +1,22 @@
+package permissiontargetimport
+
+import (
+	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
+)
+
+var Usage = []string{"rt ptim <config path>"}
+
+func GetDescription() string {
+	return "Create or update the permission targets of a configuration file."
+}
+
+func GetArguments() []components.Argument {
+	return []components.Argument{
+		{
+			Name: "config path",
+			Description: "Path to a YAML or JSON file holding a list of permission targets, in the format of the Artifactory permissions REST API. " +
+				"The file can be created using the `" + coreutils.GetCliExecutableName() + " rt ptex` command.",
+		},
+	}
+}
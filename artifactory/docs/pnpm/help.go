@@ -0,0 +1,7 @@
+package pnpm
+
+var Usage = []string{"rt pnpm [pnpm command] [command options]"}
+
+func GetDescription() string {
+	return "Run pnpm commands, resolving the dependencies from Artifactory and collecting them into the build-info from pnpm-lock.yaml."
+}
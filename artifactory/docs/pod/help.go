@@ -0,0 +1,7 @@
+package pod
+
+var Usage = []string{"rt pod [pod command] [command options]"}
+
+func GetDescription() string {
+	return "Run CocoaPods commands, installing the pods from a CocoaPods repository in Artifactory, which is added as a spec repo by the cocoapods-art plugin. The publish command deploys the pod of the working directory to the repository. The pods pinned by Podfile.lock are collected into the build-info with the checksums of their files in Artifactory."
+}
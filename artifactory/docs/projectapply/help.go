@@ -0,0 +1,22 @@
+package projectapply
+
+import (
+	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
+)
+
+var Usage = []string{"rt pja <config path>"}
+
+func GetDescription() string {
+	return "Create or update a JFrog Project to match a configuration file: assign its repositories, create or update its custom roles and grant roles to its users and groups. Repositories and members which aren't in the file are kept."
+}
+
+func GetArguments() []components.Argument {
+	return []components.Argument{
+		{
+			Name: "config path",
+			Description: "Path to a YAML or JSON file holding the projectKey, displayName, description, adminPrivileges, storageQuotaBytes, repositories, roles, users and groups of the project. " +
+				"The file can be created using the `" + coreutils.GetCliExecutableName() + " rt pjex` command.",
+		},
+	}
+}
@@ -0,0 +1,18 @@
+package projectdelete
+
+import "github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+
+var Usage = []string{"rt pjdel <project key>"}
+
+func GetDescription() string {
+	return "Permanently delete a JFrog Project."
+}
+
+func GetArguments() []components.Argument {
+	return []components.Argument{
+		{
+			Name:        "project key",
+			Description: "The key of the project to delete.",
+		},
+	}
+}
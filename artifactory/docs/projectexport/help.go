@@ -0,0 +1,22 @@
+package projectexport
+
+import "github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+
+var Usage = []string{"rt pjex <project key> <config path>"}
+
+func GetDescription() string {
+	return "Export the configuration of a JFrog Project, its repositories, custom roles and members to a file."
+}
+
+func GetArguments() []components.Argument {
+	return []components.Argument{
+		{
+			Name:        "project key",
+			Description: "The key of the project to export.",
+		},
+		{
+			Name:        "config path",
+			Description: "The path of the exported file. The file is written as JSON if it has a .json extension, and as YAML otherwise.",
+		},
+	}
+}
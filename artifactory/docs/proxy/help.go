@@ -0,0 +1,13 @@
+package proxy
+
+import "github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+
+var Usage = []string{"rt proxy [command options]"}
+
+func GetDescription() string {
+	return "Run a local reverse proxy to Artifactory, which adds the configured credentials to each request and optionally records the downloaded files in the build-info."
+}
+
+func GetArguments() []components.Argument {
+	return nil
+}
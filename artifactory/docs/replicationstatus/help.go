@@ -0,0 +1,18 @@
+package replicationstatus
+
+import "github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+
+var Usage = []string{"rt rpls <repository key>"}
+
+func GetDescription() string {
+	return "Show the status and the completion time of the last replication run of a repository, for each of its replication targets."
+}
+
+func GetArguments() []components.Argument {
+	return []components.Argument{
+		{
+			Name:        "repository key",
+			Description: "The repository whose replication status will be shown.",
+		},
+	}
+}
@@ -0,0 +1,18 @@
+package replicationupdate
+
+import "github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+
+var Usage = []string{"rt rplu <template path>"}
+
+func GetDescription() string {
+	return "Update an existing replication in Artifactory."
+}
+
+func GetArguments() []components.Argument {
+	return []components.Argument{
+		{
+			Name:        "template path",
+			Description: "Specifies the local file system path for the template file to be used to update the replication. The template can be created using the “jfrog rt rplt” command.",
+		},
+	}
+}
@@ -0,0 +1,22 @@
+package repoapply
+
+import (
+	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
+)
+
+var Usage = []string{"rt rap <config dir>"}
+
+func GetDescription() string {
+	return "Create and update repositories to match a directory of repository configuration files. Only repositories whose configuration differs from the live one are updated."
+}
+
+func GetArguments() []components.Argument {
+	return []components.Argument{
+		{
+			Name: "config dir",
+			Description: "Path to a directory of JSON or YAML files, each holding a repository configuration, or a list of them, in the format of the Artifactory repositories REST API. " +
+				"The files can be created using the `" + coreutils.GetCliExecutableName() + " rt rex` command.",
+		},
+	}
+}
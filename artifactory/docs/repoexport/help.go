@@ -0,0 +1,22 @@
+package repoexport
+
+import "github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+
+var Usage = []string{"rt rex <repository pattern> <target dir>"}
+
+func GetDescription() string {
+	return "Export the configurations of repositories to a directory, one JSON file per repository."
+}
+
+func GetArguments() []components.Argument {
+	return []components.Argument{
+		{
+			Name:        "repository pattern",
+			Description: "Specifies the repositories to export. You can use wildcards to specify multiple repositories.",
+		},
+		{
+			Name:        "target dir",
+			Description: "The directory to which the configuration files are written.",
+		},
+	}
+}
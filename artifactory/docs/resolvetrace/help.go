@@ -0,0 +1,22 @@
+package resolvetrace
+
+import "github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+
+var Usage = []string{"rt rtr <repository key> <path>"}
+
+func GetDescription() string {
+	return "Show which of the repositories aggregated by a virtual repository serves a path, the remote URL it was fetched from, and the freshness of its cache."
+}
+
+func GetArguments() []components.Argument {
+	return []components.Argument{
+		{
+			Name:        "repository key",
+			Description: "The key of the virtual repository.",
+		},
+		{
+			Name:        "path",
+			Description: "The path of the artifact in the virtual repository.",
+		},
+	}
+}
@@ -0,0 +1,22 @@
+package rpmdeploy
+
+import "github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+
+var Usage = []string{"rt rpmd [command options] <files pattern> <target repository>[/<path>]"}
+
+func GetDescription() string {
+	return "Deploy RPM packages to an RPM repository, trigger the recalculation of its YUM metadata, and wait for the metadata to index them."
+}
+
+func GetArguments() []components.Argument {
+	return []components.Argument{
+		{
+			Name:        "files pattern",
+			Description: "Local path of the .rpm files. The * wildcard can be used to deploy several packages.",
+		},
+		{
+			Name:        "target repository",
+			Description: "The RPM repository, optionally followed by the path of the packages in it, which is the root of the YUM metadata that indexes them.",
+		},
+	}
+}
@@ -0,0 +1,13 @@
+package storagereport
+
+import "github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+
+var Usage = []string{"rt stor [command options]"}
+
+func GetDescription() string {
+	return "Reports the storage usage of Artifactory from its storage summary, per repository, package type or project."
+}
+
+func GetArguments() []components.Argument {
+	return nil
+}
@@ -0,0 +1,7 @@
+package swift
+
+var Usage = []string{"rt swift [swift command] [command options]"}
+
+func GetDescription() string {
+	return "Run Swift Package Manager commands, resolving the packages from a Swift repository in Artifactory, which is set as the package registry, and publishing them to it. The packages pinned by Package.resolved are collected into the build-info with the checksums of their files in Artifactory."
+}
@@ -0,0 +1,22 @@
+package sync
+
+import "github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+
+var Usage = []string{"rt sync [command options] <local directory> <repository path>"}
+
+func GetDescription() string {
+	return "Synchronize a local directory with an Artifactory path, transferring only the files which are new or whose checksum changed."
+}
+
+func GetArguments() []components.Argument {
+	return []components.Argument{
+		{
+			Name:        "local directory",
+			Description: "The local directory to synchronize.",
+		},
+		{
+			Name:        "repository path",
+			Description: "The Artifactory path to synchronize, in the following format: <repository name>/<repository path>.",
+		},
+	}
+}
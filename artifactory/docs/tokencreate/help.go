@@ -0,0 +1,19 @@
+package tokencreate
+
+import "github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+
+var Usage = []string{"rt tkc [command options] [username]"}
+
+func GetDescription() string {
+	return "Create a scoped access token. The token is printed, or saved as the credentials of a server in the JFrog CLI configuration with the --save-as option."
+}
+
+func GetArguments() []components.Argument {
+	return []components.Argument{
+		{
+			Name:        "username",
+			Description: "The user for which the token is created. If not provided, the token is created for the user of the command.",
+			Optional:    true,
+		},
+	}
+}
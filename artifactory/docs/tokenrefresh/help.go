@@ -0,0 +1,13 @@
+package tokenrefresh
+
+import "github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+
+var Usage = []string{"rt tkr [command options]"}
+
+func GetDescription() string {
+	return "Replace the access token of a server with a new token, using its refresh token. The new token is saved to the server in the JFrog CLI configuration."
+}
+
+func GetArguments() []components.Argument {
+	return nil
+}
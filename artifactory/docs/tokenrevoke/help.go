@@ -0,0 +1,19 @@
+package tokenrevoke
+
+import "github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+
+var Usage = []string{"rt tkrv [command options] [token id]"}
+
+func GetDescription() string {
+	return "Revoke an access token."
+}
+
+func GetArguments() []components.Argument {
+	return []components.Argument{
+		{
+			Name:        "token id",
+			Description: "The ID of the token to revoke. If not provided, the access token of the server is revoked and removed from the JFrog CLI configuration.",
+			Optional:    true,
+		},
+	}
+}
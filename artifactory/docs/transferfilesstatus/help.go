@@ -0,0 +1,13 @@
+package transferfilesstatus
+
+import "github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+
+var Usage = []string{"rt transfer-files-status [command options]"}
+
+func GetDescription() string {
+	return "Show the progress of the transfer of each repository by the transfer-files command, and the breakdown of its failures by status code and reason. The status is read from the state which transfer-files persists in the JFrog CLI home, and covers the interrupted and finished runs too."
+}
+
+func GetArguments() []components.Argument {
+	return []components.Argument{}
+}
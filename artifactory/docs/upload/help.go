@@ -20,7 +20,8 @@ func GetArguments() []components.Argument {
 			Name: "source pattern",
 			Description: `Specifies the local file system path to artifacts which should be uploaded to Artifactory.
 You can specify multiple artifacts by using wildcards or a regular expression as designated by the --regexp command option.
-If you have specified that you are using regular expressions, then the first one used in the argument must be enclosed in parenthesis.`,
+If you have specified that you are using regular expressions, then the first one used in the argument must be enclosed in parenthesis.
+Files matching the rules of a .jfrogignore file (gitignore syntax) in the current directory, or in the root directory of the pattern, are not uploaded.`,
 		},
 		{
 			Name: "target pattern",
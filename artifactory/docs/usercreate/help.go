@@ -1,7 +1,26 @@
 package usercreate
 
+import "github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+
 var Usage = []string{"rt user-create <username> <password> <email>"}
 
 func GetDescription() string {
 	return "Create new user."
 }
+
+func GetArguments() []components.Argument {
+	return []components.Argument{
+		{
+			Name:        "username",
+			Description: "The username of the new user.",
+		},
+		{
+			Name:        "password",
+			Description: "The password of the new user.",
+		},
+		{
+			Name:        "email",
+			Description: "The email address of the new user.",
+		},
+	}
+}
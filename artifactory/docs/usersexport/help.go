@@ -0,0 +1,23 @@
+package usersexport
+
+import "github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+
+var Usage = []string{"rt uex [command options] <username pattern> <file path>"}
+
+func GetDescription() string {
+	return "Export users and their groups to a YAML, JSON or CSV file. The passwords of the users aren't exported."
+}
+
+func GetArguments() []components.Argument {
+	return []components.Argument{
+		{
+			Name:        "username pattern",
+			Description: "Specifies the users to export. You can use wildcards to specify multiple users, or '*' to export all the users and groups.",
+		},
+		{
+			Name: "file path",
+			Description: "The path of the exported file. The file is written as CSV if it has a .csv extension, as JSON if it has a .json extension, and as YAML otherwise. " +
+				"A CSV file holds the users only, with the 'username', 'email', 'password', 'admin', 'realm' and 'groups' columns.",
+		},
+	}
+}
@@ -0,0 +1,20 @@
+package usersimport
+
+import "github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+
+var Usage = []string{"rt uim [command options] <file path>"}
+
+func GetDescription() string {
+	return "Create or update the users and groups of a YAML, JSON or CSV file, such as a file exported by the users-export command."
+}
+
+func GetArguments() []components.Argument {
+	return []components.Argument{
+		{
+			Name: "file path",
+			Description: "The path of a YAML or JSON file with 'users' and 'groups' lists, in the format of the security REST API, or of a CSV file of users. " +
+				"The first row of a CSV file holds the column headers, which must include 'username' and may include 'email', 'password', 'admin', 'realm' and 'groups'. " +
+				"New users must have a password.",
+		},
+	}
+}
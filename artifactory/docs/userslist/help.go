@@ -0,0 +1,18 @@
+package userslist
+
+import "github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+
+var Usage = []string{"rt ul [command options] [username pattern]"}
+
+func GetDescription() string {
+	return "List the users, with their email, admin permission, realm and groups."
+}
+
+func GetArguments() []components.Argument {
+	return []components.Argument{
+		{
+			Name:        "username pattern",
+			Description: "[Optional] Specifies the users to list. You can use wildcards to specify multiple users. If not set, all the users are listed.",
+		},
+	}
+}
@@ -0,0 +1,18 @@
+package userupdate
+
+import "github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+
+var Usage = []string{"rt uu [command options] <username>"}
+
+func GetDescription() string {
+	return "Update the email, password, admin permission or groups of a user. The details which aren't set are kept."
+}
+
+func GetArguments() []components.Argument {
+	return []components.Argument{
+		{
+			Name:        "username",
+			Description: "The username of the user to update.",
+		},
+	}
+}
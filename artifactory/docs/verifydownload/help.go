@@ -0,0 +1,22 @@
+package verifydownload
+
+import "github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+
+var Usage = []string{"rt verify-download [command options] <local directory> <repository path>"}
+
+func GetDescription() string {
+	return "Compare a local directory with the Artifactory path it was downloaded from, and report the files which are missing, extra or modified on either side."
+}
+
+func GetArguments() []components.Argument {
+	return []components.Argument{
+		{
+			Name:        "local directory",
+			Description: "The local directory holding the downloaded files.",
+		},
+		{
+			Name:        "repository path",
+			Description: "The Artifactory path the files were downloaded from, in the following format: <repository name>/<repository path>.",
+		},
+	}
+}
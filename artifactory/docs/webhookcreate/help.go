@@ -0,0 +1,22 @@
+package webhookcreate
+
+import "github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+
+var Usage = []string{"rt whc [command options] <webhook key> <webhook url>"}
+
+func GetDescription() string {
+	return "Create a webhook of the JFrog Platform, which posts artifact, build, release bundle or distribution events to a URL."
+}
+
+func GetArguments() []components.Argument {
+	return []components.Argument{
+		{
+			Name:        "webhook key",
+			Description: "A unique key of the webhook.",
+		},
+		{
+			Name:        "webhook url",
+			Description: "The URL to which the events are posted.",
+		},
+	}
+}
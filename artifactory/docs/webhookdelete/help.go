@@ -0,0 +1,18 @@
+package webhookdelete
+
+import "github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+
+var Usage = []string{"rt whd [command options] <webhook key>"}
+
+func GetDescription() string {
+	return "Permanently delete a webhook of the JFrog Platform."
+}
+
+func GetArguments() []components.Argument {
+	return []components.Argument{
+		{
+			Name:        "webhook key",
+			Description: "The key of the webhook to delete.",
+		},
+	}
+}
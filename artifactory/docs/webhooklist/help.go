@@ -0,0 +1,13 @@
+package webhooklist
+
+import "github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+
+var Usage = []string{"rt whl [command options]"}
+
+func GetDescription() string {
+	return "List the webhooks of the JFrog Platform as JSON."
+}
+
+func GetArguments() []components.Argument {
+	return nil
+}
@@ -0,0 +1,14 @@
+package webhooklisten
+
+import "github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+
+var Usage = []string{"rt whlsn [command options] --tunnel-url=<url>"}
+
+func GetDescription() string {
+	return "Developer mode for webhooks. Runs a local HTTP listener, registers a temporary webhook which posts to the listener through a tunnel URL, and prints the incoming events as JSON. " +
+		"The webhook is deleted when the listener is stopped with Ctrl+C."
+}
+
+func GetArguments() []components.Argument {
+	return nil
+}
@@ -0,0 +1,18 @@
+package whence
+
+import "github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+
+var Usage = []string{"rt whence <artifact path or checksum>"}
+
+func GetDescription() string {
+	return "Show the provenance of an artifact: the builds which produced it and depend on it, the release bundles which hold it, its evidence and its promotion history, as a single JSON document."
+}
+
+func GetArguments() []components.Argument {
+	return []components.Argument{
+		{
+			Name:        "artifact path or checksum",
+			Description: "The repository path of the artifact, or its SHA-1 or SHA-256 checksum. All the artifacts with the same content are reported.",
+		},
+	}
+}
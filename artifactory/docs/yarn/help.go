@@ -3,5 +3,5 @@ package yarn
 var Usage = []string{"rt yarn [yarn command] [command options]"}
 
 func GetDescription() string {
-	return "Run Yarn commands."
+	return "Run Yarn commands. Yarn Plug'n'Play projects are supported. Yarn 4 isn't supported."
 }
@@ -0,0 +1,225 @@
+package formats
+
+import (
+	"strconv"
+
+	commandUtils "github.com/jfrog/jfrog-cli-core/v2/artifactory/commands/utils"
+	"github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
+	clientUtils "github.com/jfrog/jfrog-client-go/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+)
+
+// The structs in this file are the schemas of the structured output of the commands. Their fields and 'json' annotations
+// should NOT be changed or removed without increasing SchemaVersion, since they are an API.
+
+// Kind is the kind of the result of a command, whose data has a schema of its own.
+type Kind string
+
+const (
+	SearchResultsKind          Kind = "SearchResults"
+	TransferSummaryKind        Kind = "TransferSummary"
+	MultiServerUploadKind      Kind = "MultiServerUpload"
+	BuildPublishKind           Kind = "BuildPublish"
+	ReleaseBundleOperationKind Kind = "ReleaseBundleOperation"
+	ReleaseBundleContentsKind  Kind = "ReleaseBundleContents"
+	ReleaseBundleDiffKind      Kind = "ReleaseBundleDiff"
+	SigningKeysKind            Kind = "SigningKeys"
+	EvidenceKind               Kind = "Evidence"
+	CommandSummaryKind         Kind = "CommandSummary"
+	ResolveTraceKind           Kind = "ResolveTrace"
+	HealthReportKind           Kind = "HealthReport"
+	ProvenanceKind             Kind = "Provenance"
+	EvidenceBulkKind           Kind = "EvidenceBulk"
+	EvidenceVerificationKind   Kind = "EvidenceVerification"
+	TrustedKeysKind            Kind = "TrustedKeys"
+	TransferStatusKind         Kind = "TransferStatus"
+)
+
+const (
+	StatusSuccess = "success"
+	StatusFailure = "failure"
+)
+
+// SearchResults are the artifacts found by the search command.
+type SearchResults []utils.SearchResult
+
+type searchResultRow struct {
+	Path     string `col-name:"Path"`
+	Type     string `col-name:"Type"`
+	Size     string `col-name:"Size"`
+	Modified string `col-name:"Modified"`
+}
+
+func (sr SearchResults) Tables() []Table {
+	var rows []searchResultRow
+	for _, result := range sr {
+		rows = append(rows, searchResultRow{Path: result.Path, Type: result.Type, Size: strconv.FormatInt(result.Size, 10), Modified: result.Modified})
+	}
+	return []Table{{Title: "Search Results", Rows: rows, EmptyMessage: "No artifacts found"}}
+}
+
+// ReadSearchResults reads the search results of the search command.
+func ReadSearchResults(result *commandUtils.Result) (SearchResults, error) {
+	results := SearchResults{}
+	reader := result.Reader()
+	for searchResult := new(utils.SearchResult); reader.NextRecord(searchResult) == nil; searchResult = new(utils.SearchResult) {
+		results = append(results, *searchResult)
+	}
+	reader.Reset()
+	return results, errorutils.CheckError(reader.GetError())
+}
+
+// TransferSummary is the summary of the files transferred by the upload and download commands. The transferred files
+// are listed if the detailed summary was requested.
+type TransferSummary struct {
+	Status string            `json:"status"`
+	Totals TransferTotals    `json:"totals"`
+	Files  []TransferredFile `json:"files,omitempty"`
+}
+
+type TransferTotals struct {
+	Success int `json:"success"`
+	Failure int `json:"failure"`
+}
+
+type TransferredFile struct {
+	Source string `json:"source"`
+	Target string `json:"target"`
+	Sha256 string `json:"sha256,omitempty"`
+}
+
+type transferTotalsRow struct {
+	Status  string `col-name:"Status"`
+	Success string `col-name:"Success"`
+	Failure string `col-name:"Failure"`
+}
+
+type transferredFileRow struct {
+	Source string `col-name:"Source"`
+	Target string `col-name:"Target"`
+	Sha256 string `col-name:"SHA-256"`
+}
+
+func (ts *TransferSummary) Tables() []Table {
+	tables := []Table{{Title: "Summary", Rows: []transferTotalsRow{{ts.Status, strconv.Itoa(ts.Totals.Success), strconv.Itoa(ts.Totals.Failure)}}}}
+	if ts.Files != nil {
+		var rows []transferredFileRow
+		for _, file := range ts.Files {
+			rows = append(rows, transferredFileRow(file))
+		}
+		tables = append(tables, Table{Title: "Files", Rows: rows, EmptyMessage: "No files were transferred"})
+	}
+	return tables
+}
+
+// NewTransferSummary creates the summary of the result of the transfer, which failed if an error is returned or if a
+// file failed to transfer.
+func NewTransferSummary(result *commandUtils.Result, detailed bool, transferErr error) (*TransferSummary, error) {
+	summary := &TransferSummary{Status: StatusSuccess}
+	if result != nil {
+		summary.Totals = TransferTotals{Success: result.SuccessCount(), Failure: result.FailCount()}
+	}
+	if transferErr != nil || summary.Totals.Failure > 0 {
+		summary.Status = StatusFailure
+	}
+	if !detailed || result == nil || result.Reader() == nil {
+		return summary, nil
+	}
+	summary.Files = []TransferredFile{}
+	reader := result.Reader()
+	for details := new(clientUtils.FileTransferDetails); reader.NextRecord(details) == nil; details = new(clientUtils.FileTransferDetails) {
+		summary.Files = append(summary.Files, TransferredFile{Source: details.SourcePath, Target: details.TargetPath, Sha256: details.Sha256})
+	}
+	reader.Reset()
+	return summary, errorutils.CheckError(reader.GetError())
+}
+
+// MultiServerUpload is the summary of an upload to multiple servers, with the transfer summary of each server.
+type MultiServerUpload []ServerTransferSummary
+
+type ServerTransferSummary struct {
+	ServerId string `json:"serverId"`
+	TransferSummary
+	// True if the uploaded files were deleted, since the upload to another server failed.
+	RolledBack bool   `json:"rolledBack,omitempty"`
+	Error      string `json:"error,omitempty"`
+}
+
+type serverTransferRow struct {
+	ServerId   string `col-name:"Server ID"`
+	Status     string `col-name:"Status"`
+	Success    string `col-name:"Success"`
+	Failure    string `col-name:"Failure"`
+	RolledBack string `col-name:"Rolled Back"`
+}
+
+func (msu MultiServerUpload) Tables() []Table {
+	var rows []serverTransferRow
+	for _, server := range msu {
+		rows = append(rows, serverTransferRow{server.ServerId, server.Status, strconv.Itoa(server.Totals.Success), strconv.Itoa(server.Totals.Failure), strconv.FormatBool(server.RolledBack)})
+	}
+	return []Table{{Title: "Upload Summary", Rows: rows, EmptyMessage: "No servers"}}
+}
+
+// BuildPublish is the build-info published by the build-publish command.
+type BuildPublish struct {
+	BuildName      string `json:"buildName"`
+	BuildNumber    string `json:"buildNumber"`
+	Project        string `json:"project,omitempty"`
+	BuildInfoUiUrl string `json:"buildInfoUiUrl,omitempty"`
+	Sha256         string `json:"sha256,omitempty"`
+	DryRun         bool   `json:"dryRun,omitempty"`
+}
+
+type buildPublishRow struct {
+	BuildName   string `col-name:"Build Name"`
+	BuildNumber string `col-name:"Build Number"`
+	Url         string `col-name:"Build-Info URL"`
+}
+
+func (bp *BuildPublish) Tables() []Table {
+	return []Table{{Title: "Published Build-Info", Rows: []buildPublishRow{{bp.BuildName, bp.BuildNumber, bp.BuildInfoUiUrl}}}}
+}
+
+// ReleaseBundleOperation is a release bundle version which was created, updated, promoted or distributed.
+type ReleaseBundleOperation struct {
+	Operation string `json:"operation"`
+	Name      string `json:"name"`
+	Version   string `json:"version"`
+	Project   string `json:"project,omitempty"`
+	// The environment to which the release bundle was promoted.
+	Environment string `json:"environment,omitempty"`
+	Sync        bool   `json:"sync"`
+	DryRun      bool   `json:"dryRun,omitempty"`
+}
+
+type releaseBundleOperationRow struct {
+	Operation   string `col-name:"Operation"`
+	Name        string `col-name:"Release Bundle"`
+	Version     string `col-name:"Version"`
+	Environment string `col-name:"Environment"`
+}
+
+func (rbo *ReleaseBundleOperation) Tables() []Table {
+	return []Table{{Title: "Release Bundle", Rows: []releaseBundleOperationRow{{rbo.Operation, rbo.Name, rbo.Version, rbo.Environment}}}}
+}
+
+// Evidence is evidence which was attached to an artifact.
+type Evidence struct {
+	SubjectRepoPath string `json:"subjectRepoPath"`
+	SubjectSha256   string `json:"subjectSha256"`
+	PredicateType   string `json:"predicateType"`
+	// The repository path of the artifact which is the predicate, such as an uploaded SBOM.
+	PredicateRepoPath string `json:"predicateRepoPath,omitempty"`
+	Signed            bool   `json:"signed"`
+}
+
+type evidenceRow struct {
+	Subject       string `col-name:"Subject"`
+	PredicateType string `col-name:"Predicate Type"`
+	Signed        string `col-name:"Signed"`
+}
+
+func (e *Evidence) Tables() []Table {
+	return []Table{{Title: "Evidence", Rows: []evidenceRow{{e.SubjectRepoPath, e.PredicateType, strconv.FormatBool(e.Signed)}}}}
+}
@@ -0,0 +1,124 @@
+package formats
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+
+	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+	"gopkg.in/yaml.v3"
+)
+
+// Format is the format of the output of a command.
+type Format string
+
+const (
+	JsonFormat  Format = "json"
+	YamlFormat  Format = "yaml"
+	TableFormat Format = "table"
+)
+
+// SchemaVersion is the version of the schemas of the structured (JSON and YAML) output. It is increased when a field
+// of a schema is removed or changes its meaning. Fields may be added without increasing it.
+const SchemaVersion = "v1"
+
+// Document is the structured output of a command: its result, described by the kind of the result and the version of
+// its schema.
+type Document struct {
+	SchemaVersion string `json:"schemaVersion"`
+	Kind          Kind   `json:"kind"`
+	Data          any    `json:"data"`
+}
+
+// Table is a table of the output, rendered by coreutils.PrintTable from a slice of structs with col-name tags.
+type Table struct {
+	Title        string
+	Rows         any
+	EmptyMessage string
+}
+
+// Tabular is implemented by results which can be rendered as tables.
+type Tabular interface {
+	Tables() []Table
+}
+
+// ParseFormat returns the format by its name. An empty name is returned as is, in which case the command keeps its
+// default output.
+func ParseFormat(format string) (Format, error) {
+	switch parsed := Format(strings.ToLower(format)); parsed {
+	case "", JsonFormat, YamlFormat, TableFormat:
+		return parsed, nil
+	}
+	return "", errorutils.CheckErrorf("unsupported output format '%s'. The supported formats are %s, %s and %s", format, JsonFormat, YamlFormat, TableFormat)
+}
+
+// Print outputs the result of the kind in the format.
+func Print(format Format, kind Kind, data any) error {
+	if format == TableFormat {
+		tabular, ok := data.(Tabular)
+		if !ok {
+			return errorutils.CheckErrorf("the %s format is not supported for %s", TableFormat, kind)
+		}
+		for _, table := range tabular.Tables() {
+			if err := coreutils.PrintTable(table.Rows, table.Title, table.EmptyMessage, false); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	content, err := Render(format, kind, data)
+	if err != nil {
+		return err
+	}
+	log.Output(content)
+	return nil
+}
+
+// Render returns the structured output of the result of the kind, in the JSON or YAML format.
+func Render(format Format, kind Kind, data any) (string, error) {
+	document := Document{SchemaVersion: SchemaVersion, Kind: kind, Data: data}
+	var content bytes.Buffer
+	encoder := json.NewEncoder(&content)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(document); err != nil {
+		return "", errorutils.CheckError(err)
+	}
+	switch format {
+	case JsonFormat:
+		return strings.TrimSuffix(content.String(), "\n"), nil
+	case YamlFormat:
+		return jsonToYaml(content.Bytes())
+	}
+	return "", errorutils.CheckErrorf("the result can't be rendered in the %s format", format)
+}
+
+// jsonToYaml converts the JSON document to YAML, keeping the order and the names of its fields, so both formats have
+// the same schema.
+func jsonToYaml(content []byte) (string, error) {
+	var node yaml.Node
+	if err := yaml.Unmarshal(content, &node); err != nil {
+		return "", errorutils.CheckError(err)
+	}
+	resetStyle(&node)
+	var converted bytes.Buffer
+	encoder := yaml.NewEncoder(&converted)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(&node); err != nil {
+		return "", errorutils.CheckError(err)
+	}
+	if err := encoder.Close(); err != nil {
+		return "", errorutils.CheckError(err)
+	}
+	return strings.TrimSuffix(converted.String(), "\n"), nil
+}
+
+// resetStyle sets the block style to the nodes, which are parsed from JSON in the flow style.
+func resetStyle(node *yaml.Node) {
+	node.Style = 0
+	for _, child := range node.Content {
+		resetStyle(child)
+	}
+}
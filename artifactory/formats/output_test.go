@@ -0,0 +1,70 @@
+package formats
+
+import (
+	"errors"
+	"testing"
+
+	commandUtils "github.com/jfrog/jfrog-cli-core/v2/artifactory/commands/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFormat(t *testing.T) {
+	for _, format := range []string{"", "json", "YAML", "table"} {
+		_, err := ParseFormat(format)
+		assert.NoError(t, err, format)
+	}
+	_, err := ParseFormat("csv")
+	assert.ErrorContains(t, err, "unsupported output format 'csv'")
+}
+
+func TestRenderJson(t *testing.T) {
+	content, err := Render(JsonFormat, BuildPublishKind, &BuildPublish{BuildName: "my-build", BuildNumber: "1", BuildInfoUiUrl: "https://acme.jfrog.io/ui/builds/my-build/1?a=b&c=d"})
+	require.NoError(t, err)
+	assert.Equal(t, `{
+  "schemaVersion": "v1",
+  "kind": "BuildPublish",
+  "data": {
+    "buildName": "my-build",
+    "buildNumber": "1",
+    "buildInfoUiUrl": "https://acme.jfrog.io/ui/builds/my-build/1?a=b&c=d"
+  }
+}`, content)
+}
+
+func TestRenderYaml(t *testing.T) {
+	summary := &TransferSummary{Status: StatusSuccess, Totals: TransferTotals{Success: 2}, Files: []TransferredFile{{Source: "a.txt", Target: "repo/a.txt", Sha256: "123"}}}
+	content, err := Render(YamlFormat, TransferSummaryKind, summary)
+	require.NoError(t, err)
+	assert.Equal(t, `schemaVersion: v1
+kind: TransferSummary
+data:
+  status: success
+  totals:
+    success: 2
+    failure: 0
+  files:
+    - source: a.txt
+      target: repo/a.txt
+      sha256: "123"`, content)
+}
+
+func TestRenderTable(t *testing.T) {
+	_, err := Render(TableFormat, EvidenceKind, &Evidence{})
+	assert.Error(t, err)
+	assert.ErrorContains(t, Print(TableFormat, SearchResultsKind, []string{}), "not supported")
+}
+
+func TestNewTransferSummary(t *testing.T) {
+	result := new(commandUtils.Result)
+	result.SetSuccessCount(3)
+	result.SetFailCount(1)
+	summary, err := NewTransferSummary(result, false, nil)
+	require.NoError(t, err)
+	assert.Equal(t, &TransferSummary{Status: StatusFailure, Totals: TransferTotals{Success: 3, Failure: 1}}, summary)
+
+	result.SetFailCount(0)
+	summary, err = NewTransferSummary(result, false, errors.New("failed"))
+	require.NoError(t, err)
+	assert.Equal(t, StatusFailure, summary.Status)
+}
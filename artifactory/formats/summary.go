@@ -0,0 +1,95 @@
+package formats
+
+import (
+	"os"
+	"time"
+
+	commandUtils "github.com/jfrog/jfrog-cli-core/v2/artifactory/commands/utils"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	clientUtils "github.com/jfrog/jfrog-client-go/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+)
+
+// CommandSummary is a normalized summary of a command, which CI systems collect the metrics of the command from,
+// rather than from its log. Like the other kinds, its schema is versioned by SchemaVersion.
+type CommandSummary struct {
+	Operation      string `json:"operation"`
+	Status         string `json:"status"`
+	Server         string `json:"server,omitempty"`
+	Succeeded      int    `json:"succeeded"`
+	Failed         int    `json:"failed"`
+	TotalBytes     int64  `json:"totalBytes"`
+	StartedAt      string `json:"startedAt"`
+	DurationMillis int64  `json:"durationMillis"`
+	// The SHA-256 checksums of the transferred files, or of the published build-info.
+	Sha256s []string `json:"sha256s,omitempty"`
+	// The verification of the downloaded files by their checksums in Artifactory, if they were verified.
+	Verification *DownloadVerification `json:"verification,omitempty"`
+}
+
+// DownloadVerification is the result of the verification of the downloaded files by their checksums in Artifactory.
+type DownloadVerification struct {
+	Algorithm string `json:"algorithm"`
+	Verified  int    `json:"verified"`
+	// Files which have no checksum in Artifactory, or aren't regular files, like recreated symbolic links.
+	Skipped int `json:"skipped,omitempty"`
+	// Files which matched after they were downloaded again.
+	Redownloaded int `json:"redownloaded,omitempty"`
+	// The local paths of the files which still mismatch.
+	Mismatched []string `json:"mismatched,omitempty"`
+	// The local paths of the mismatching files, which were renamed with the quarantine suffix.
+	Quarantined []string `json:"quarantined,omitempty"`
+}
+
+// NewCommandSummary creates the summary of the operation which started at startedOn and has just ended. The operation
+// failed if an error is returned or if an item failed.
+func NewCommandSummary(operation string, serverDetails *config.ServerDetails, startedOn time.Time, succeeded, failed int, commandErr error) *CommandSummary {
+	summary := &CommandSummary{
+		Operation:      operation,
+		Status:         StatusSuccess,
+		Succeeded:      succeeded,
+		Failed:         failed,
+		StartedAt:      startedOn.UTC().Format(time.RFC3339),
+		DurationMillis: time.Since(startedOn).Milliseconds(),
+	}
+	if serverDetails != nil {
+		summary.Server = serverDetails.GetArtifactoryUrl()
+	}
+	if commandErr != nil || failed > 0 {
+		summary.Status = StatusFailure
+	}
+	return summary
+}
+
+// AddTransferredFiles adds the checksums and the sizes of the files transferred by an upload or a download, which
+// are listed in the result if the command collected its detailed summary. The size of a file is read from its local
+// copy, which is the source of an upload and the target of a download.
+func (cs *CommandSummary) AddTransferredFiles(result *commandUtils.Result, downloaded bool) error {
+	if result == nil || result.Reader() == nil {
+		return nil
+	}
+	reader := result.Reader()
+	for details := new(clientUtils.FileTransferDetails); reader.NextRecord(details) == nil; details = new(clientUtils.FileTransferDetails) {
+		if details.Sha256 != "" {
+			cs.Sha256s = append(cs.Sha256s, details.Sha256)
+		}
+		localPath := details.SourcePath
+		if downloaded {
+			localPath = details.TargetPath
+		}
+		if fileInfo, err := os.Stat(localPath); err == nil && !fileInfo.IsDir() {
+			cs.TotalBytes += fileInfo.Size()
+		}
+	}
+	reader.Reset()
+	return errorutils.CheckError(reader.GetError())
+}
+
+// WriteCommandSummary writes the summary to the file, as a json document.
+func WriteCommandSummary(summaryPath string, summary *CommandSummary) error {
+	content, err := Render(JsonFormat, CommandSummaryKind, summary)
+	if err != nil {
+		return err
+	}
+	return errorutils.CheckError(os.WriteFile(summaryPath, []byte(content+"\n"), 0644))
+}
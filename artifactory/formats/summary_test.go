@@ -0,0 +1,44 @@
+package formats
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCommandSummary(t *testing.T) {
+	startedOn := time.Now().Add(-time.Second)
+	summary := NewCommandSummary("upload", &config.ServerDetails{ArtifactoryUrl: "https://acme.jfrog.io/artifactory/"}, startedOn, 3, 0, nil)
+	assert.Equal(t, StatusSuccess, summary.Status)
+	assert.Equal(t, "https://acme.jfrog.io/artifactory/", summary.Server)
+	assert.Equal(t, startedOn.UTC().Format(time.RFC3339), summary.StartedAt)
+	assert.GreaterOrEqual(t, summary.DurationMillis, int64(1000))
+
+	assert.Equal(t, StatusFailure, NewCommandSummary("copy", nil, startedOn, 3, 1, nil).Status)
+	assert.Equal(t, StatusFailure, NewCommandSummary("delete", nil, startedOn, 0, 0, errors.New("failed")).Status)
+}
+
+func TestWriteCommandSummary(t *testing.T) {
+	summaryPath := filepath.Join(t.TempDir(), "summary.json")
+	summary := &CommandSummary{Operation: "build-publish", Status: StatusSuccess, Succeeded: 1, Sha256s: []string{"123"}}
+	require.NoError(t, WriteCommandSummary(summaryPath, summary))
+
+	content, err := os.ReadFile(summaryPath)
+	require.NoError(t, err)
+	var document struct {
+		SchemaVersion string         `json:"schemaVersion"`
+		Kind          Kind           `json:"kind"`
+		Data          CommandSummary `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(content, &document))
+	assert.Equal(t, SchemaVersion, document.SchemaVersion)
+	assert.Equal(t, CommandSummaryKind, document.Kind)
+	assert.Equal(t, *summary, document.Data)
+}
@@ -0,0 +1,37 @@
+package utils
+
+import (
+	"strings"
+
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clientconfig"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/access"
+	"github.com/jfrog/jfrog-client-go/auth"
+	clientutils "github.com/jfrog/jfrog-client-go/utils"
+)
+
+// CreateAccessServiceManager creates an Access services manager, and returns the details of the Access service as well,
+// for sending the requests which the manager doesn't cover.
+func CreateAccessServiceManager(serverDetails *config.ServerDetails) (*access.AccessServicesManager, auth.ServiceDetails, error) {
+	serverDetails = WithPlatformUrl(serverDetails)
+	accessManager, err := clientconfig.CreateAccessServiceManager(serverDetails, false)
+	if err != nil {
+		return nil, nil, err
+	}
+	serviceDetails, err := serverDetails.CreateAccessAuthConfig()
+	if err != nil {
+		return nil, nil, err
+	}
+	return accessManager, serviceDetails, nil
+}
+
+// WithPlatformUrl returns the server details with the platform URL, from which the Access URL is derived. The platform URL
+// is derived from the Artifactory URL if the server was configured with the Artifactory URL only.
+func WithPlatformUrl(serverDetails *config.ServerDetails) *config.ServerDetails {
+	if serverDetails.Url != "" {
+		return serverDetails
+	}
+	withPlatform := *serverDetails
+	withPlatform.Url = strings.TrimSuffix(clientutils.AddTrailingSlashIfNeeded(serverDetails.ArtifactoryUrl), "artifactory/")
+	return &withPlatform
+}
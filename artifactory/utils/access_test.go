@@ -0,0 +1,14 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithPlatformUrl(t *testing.T) {
+	assert.Equal(t, "https://acme.jfrog.io/", WithPlatformUrl(&config.ServerDetails{ArtifactoryUrl: "https://acme.jfrog.io/artifactory"}).Url)
+	serverDetails := &config.ServerDetails{Url: "https://acme.jfrog.io/", ArtifactoryUrl: "https://acme.jfrog.io/artifactory/"}
+	assert.Same(t, serverDetails, WithPlatformUrl(serverDetails))
+}
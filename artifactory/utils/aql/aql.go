@@ -0,0 +1,223 @@
+package aql
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	serviceutils "github.com/jfrog/jfrog-client-go/artifactory/services/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+)
+
+// DefaultPageSize is the number of results fetched by each query when paginating.
+// It matches the default limit Artifactory applies to the results of non-admin users.
+const DefaultPageSize = 1000
+
+var (
+	findPattern       = regexp.MustCompile(`^\s*(\w+)\.find\s*\(`)
+	paginationPattern = regexp.MustCompile(`\.(offset|limit)\s*\(`)
+)
+
+// Criteria is the object passed to the find function of a query, e.g. {"repo": "libs-local", "size": {"$gt": 0}}.
+type Criteria map[string]any
+
+// And matches the items matching all the criteria.
+func And(criteria ...Criteria) Criteria {
+	return Criteria{"$and": criteria}
+}
+
+// Or matches the items matching any of the criteria.
+func Or(criteria ...Criteria) Criteria {
+	return Criteria{"$or": criteria}
+}
+
+// Field returns criteria comparing a field with a value, e.g. Field("size", Gt(0)).
+// A value which isn't a comparison matches the field's value exactly.
+func Field(name string, value any) Criteria {
+	return Criteria{name: value}
+}
+
+func Eq(value any) Criteria {
+	return Criteria{"$eq": value}
+}
+
+func Ne(value any) Criteria {
+	return Criteria{"$ne": value}
+}
+
+func Gt(value any) Criteria {
+	return Criteria{"$gt": value}
+}
+
+func Gte(value any) Criteria {
+	return Criteria{"$gte": value}
+}
+
+func Lt(value any) Criteria {
+	return Criteria{"$lt": value}
+}
+
+func Lte(value any) Criteria {
+	return Criteria{"$lte": value}
+}
+
+// Match compares the field with a pattern, in which '*' and '?' are wildcards.
+func Match(pattern string) Criteria {
+	return Criteria{"$match": pattern}
+}
+
+func Nmatch(pattern string) Criteria {
+	return Criteria{"$nmatch": pattern}
+}
+
+// Query builds an AQL query, such as items.find({...}).include("name").sort({"$asc": ["name"]}).limit(10).
+type Query struct {
+	domain     string
+	criteria   Criteria
+	include    []string
+	sortOrder  string
+	sortFields []string
+	offset     int
+	limit      int
+}
+
+// Find creates a query for the entities of the domain (items, builds, entries...) which match the criteria.
+func Find(domain string, criteria Criteria) *Query {
+	return &Query{domain: domain, criteria: criteria}
+}
+
+// Include sets the fields returned for each result.
+func (q *Query) Include(fields ...string) *Query {
+	q.include = fields
+	return q
+}
+
+func (q *Query) SortAsc(fields ...string) *Query {
+	q.sortOrder, q.sortFields = "$asc", fields
+	return q
+}
+
+func (q *Query) SortDesc(fields ...string) *Query {
+	q.sortOrder, q.sortFields = "$desc", fields
+	return q
+}
+
+func (q *Query) Offset(offset int) *Query {
+	q.offset = offset
+	return q
+}
+
+// Limit sets the maximal number of results. Zero returns all the results, up to the limit of the server.
+func (q *Query) Limit(limit int) *Query {
+	q.limit = limit
+	return q
+}
+
+func (q *Query) String() (string, error) {
+	criteria := q.criteria
+	if criteria == nil {
+		criteria = Criteria{}
+	}
+	criteriaJson, err := json.Marshal(criteria)
+	if err != nil {
+		return "", errorutils.CheckError(err)
+	}
+	query := fmt.Sprintf("%s.find(%s)", q.domain, criteriaJson)
+	if len(q.include) > 0 {
+		query += ".include(" + quoteFields(q.include) + ")"
+	}
+	if len(q.sortFields) > 0 {
+		query += fmt.Sprintf(`.sort({"%s":[%s]})`, q.sortOrder, quoteFields(q.sortFields))
+	}
+	if q.offset > 0 {
+		query += ".offset(" + strconv.Itoa(q.offset) + ")"
+	}
+	if q.limit > 0 {
+		query += ".limit(" + strconv.Itoa(q.limit) + ")"
+	}
+	return query, nil
+}
+
+func quoteFields(fields []string) string {
+	quoted := make([]string, len(fields))
+	for i, field := range fields {
+		quoted[i] = strconv.Quote(field)
+	}
+	return strings.Join(quoted, ",")
+}
+
+// GetDomain returns the domain of a raw query, e.g. 'items' for items.find({...}).
+func GetDomain(query string) (string, error) {
+	match := findPattern.FindStringSubmatch(query)
+	if match == nil {
+		return "", errorutils.CheckErrorf("invalid AQL query, expected <domain>.find(<criteria>): %s", query)
+	}
+	return match[1], nil
+}
+
+// IsPaginated returns true if the raw query sets its own offset or limit, and therefore can't be paginated.
+func IsPaginated(query string) bool {
+	return paginationPattern.MatchString(query)
+}
+
+// PageQuery returns the raw query restricted to the page starting at the offset.
+func PageQuery(query string, offset, pageSize int) string {
+	query = strings.TrimRight(strings.TrimSpace(query), ";")
+	return fmt.Sprintf("%s.offset(%d).limit(%d)", query, offset, pageSize)
+}
+
+// SearchItems runs a raw items query and passes each result to the handler.
+// The results are fetched in pages of pageSize until a partial page is returned, so result sets beyond the limit
+// of the server are fully read. The query should sort its results, so that the pages are consistent.
+// Queries which set their own offset or limit are run once, as they are.
+func SearchItems(servicesManager artifactory.ArtifactoryServicesManager, query string, pageSize int, handler func(item *serviceutils.ResultItem) error) error {
+	domain, err := GetDomain(query)
+	if err != nil {
+		return err
+	}
+	if domain != "items" {
+		return errorutils.CheckErrorf("only AQL queries of the items domain are supported, but the query searches '%s'", domain)
+	}
+	if pageSize <= 0 {
+		pageSize = DefaultPageSize
+	}
+	if IsPaginated(query) {
+		_, err = searchPage(servicesManager, query, handler)
+		return err
+	}
+	for offset := 0; ; offset += pageSize {
+		count, err := searchPage(servicesManager, PageQuery(query, offset, pageSize), handler)
+		if err != nil {
+			return err
+		}
+		if count < pageSize {
+			return nil
+		}
+	}
+}
+
+func searchPage(servicesManager artifactory.ArtifactoryServicesManager, query string, handler func(item *serviceutils.ResultItem) error) (int, error) {
+	reader, err := servicesManager.Aql(query)
+	if err != nil {
+		return 0, err
+	}
+	body, err := io.ReadAll(reader)
+	if err = errorutils.CheckError(errors.Join(err, reader.Close())); err != nil {
+		return 0, err
+	}
+	result := new(serviceutils.AqlSearchResult)
+	if err = json.Unmarshal(body, result); err != nil {
+		return 0, errorutils.CheckErrorf("failed to parse the results of the AQL query: %s", err.Error())
+	}
+	for i := range result.Results {
+		if err = handler(&result.Results[i]); err != nil {
+			return 0, err
+		}
+	}
+	return len(result.Results), nil
+}
@@ -0,0 +1,36 @@
+package aql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueryString(t *testing.T) {
+	query, err := Find("items", And(Field("repo", "libs-local"), Field("size", Gt(0)), Or(Field("name", Match("*.jar")), Field("name", Match("*.pom"))))).
+		Include("repo", "path", "name").SortDesc("created").Offset(20).Limit(10).String()
+	require.NoError(t, err)
+	assert.Equal(t, `items.find({"$and":[{"repo":"libs-local"},{"size":{"$gt":0}},{"$or":[{"name":{"$match":"*.jar"}},{"name":{"$match":"*.pom"}}]}]})`+
+		`.include("repo","path","name").sort({"$desc":["created"]}).offset(20).limit(10)`, query)
+
+	query, err = Find("builds", nil).String()
+	require.NoError(t, err)
+	assert.Equal(t, "builds.find({})", query)
+}
+
+func TestGetDomain(t *testing.T) {
+	domain, err := GetDomain(` items.find({"repo": "libs-local"})`)
+	require.NoError(t, err)
+	assert.Equal(t, "items", domain)
+
+	_, err = GetDomain(`{"repo": "libs-local"}`)
+	assert.ErrorContains(t, err, "invalid AQL query")
+}
+
+func TestPagination(t *testing.T) {
+	assert.False(t, IsPaginated(`items.find({"name": {"$match": "limit(*"}}).sort({"$asc": ["name"]})`))
+	assert.True(t, IsPaginated(`items.find({}).limit(5)`))
+	assert.True(t, IsPaginated(`items.find({}).offset (5)`))
+	assert.Equal(t, `items.find({}).sort({"$asc":["name"]}).offset(2000).limit(1000)`, PageQuery("items.find({}).sort({\"$asc\":[\"name\"]});\n", 2000, 1000))
+}
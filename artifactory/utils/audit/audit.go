@@ -0,0 +1,153 @@
+package audit
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/auth"
+	"github.com/jfrog/jfrog-client-go/http/jfroghttpclient"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const (
+	// AuditLogEnv enables the audit log. Its value is the path of the file to which the mutations are appended.
+	AuditLogEnv = "JFROG_CLI_AUDIT_LOG"
+
+	requestIdHeader   = "X-Request-Id"
+	uberTraceIdHeader = "uber-trace-id"
+	// The path of the search APIs, under which AQL is sent as well.
+	searchApiPath = "/api/search/"
+)
+
+// The entries are appended by the transports of all clients, which may send requests concurrently.
+var fileMutex sync.Mutex
+
+// Entry is a line of the audit log, which records a single REST mutation.
+type Entry struct {
+	Timestamp string `json:"timestamp"`
+	User      string `json:"user,omitempty"`
+	Method    string `json:"method"`
+	Url       string `json:"url"`
+	Status    int    `json:"status,omitempty"`
+	RequestId string `json:"requestId,omitempty"`
+	Error     string `json:"error,omitempty"`
+}
+
+// GetAuditLogPath returns the path of the audit log, or an empty string if the audit log is disabled.
+func GetAuditLogPath() string {
+	return os.Getenv(AuditLogEnv)
+}
+
+// Attach wraps the transport of the client, so that the mutations it sends are appended to the audit log. It does
+// nothing if the audit log is disabled, or if the client is already audited.
+func Attach(client *jfroghttpclient.JfrogHttpClient, serverDetails *config.ServerDetails) {
+	if client == nil || client.GetHttpClient() == nil {
+		return
+	}
+	AttachToHttpClient(client.GetHttpClient().GetClient(), serverDetails)
+}
+
+// AttachToHttpClient wraps the transport of the http client like Attach.
+func AttachToHttpClient(httpClient *http.Client, serverDetails *config.ServerDetails) {
+	logPath := GetAuditLogPath()
+	if logPath == "" || httpClient == nil {
+		return
+	}
+	if _, audited := httpClient.Transport.(*transport); audited {
+		return
+	}
+	next := httpClient.Transport
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	httpClient.Transport = &transport{next: next, user: getUser(serverDetails), logPath: logPath}
+}
+
+// getUser returns the user the requests are sent on behalf of, which is the subject of the access token if no
+// username is configured.
+func getUser(serverDetails *config.ServerDetails) string {
+	if serverDetails == nil {
+		return ""
+	}
+	if serverDetails.User != "" {
+		return serverDetails.User
+	}
+	if serverDetails.AccessToken == "" {
+		return ""
+	}
+	subject, err := auth.ExtractSubjectFromAccessToken(serverDetails.AccessToken)
+	if err != nil {
+		return ""
+	}
+	return subject
+}
+
+type transport struct {
+	next    http.RoundTripper
+	user    string
+	logPath string
+}
+
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.next.RoundTrip(req)
+	if isMutation(req) {
+		if auditErr := appendEntry(t.logPath, newEntry(t.user, req, resp, err, time.Now())); auditErr != nil {
+			log.Warn("Failed to append to the audit log:", auditErr.Error())
+		}
+	}
+	return resp, err
+}
+
+// isMutation returns true for the requests which may change the state of the server. Searches, including AQL queries,
+// are sent as POST requests, but they only read the state of the server.
+func isMutation(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodPut, http.MethodPatch, http.MethodDelete:
+		return true
+	case http.MethodPost:
+		return !strings.Contains(req.URL.Path, searchApiPath)
+	}
+	return false
+}
+
+func newEntry(user string, req *http.Request, resp *http.Response, err error, now time.Time) Entry {
+	entry := Entry{
+		Timestamp: now.UTC().Format(time.RFC3339Nano),
+		User:      user,
+		Method:    req.Method,
+		Url:       req.URL.Redacted(),
+		RequestId: req.Header.Get(uberTraceIdHeader),
+	}
+	if resp != nil {
+		entry.Status = resp.StatusCode
+		if requestId := resp.Header.Get(requestIdHeader); requestId != "" {
+			entry.RequestId = requestId
+		}
+	}
+	if err != nil {
+		entry.Error = err.Error()
+	}
+	return entry
+}
+
+func appendEntry(logPath string, entry Entry) error {
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	fileMutex.Lock()
+	defer fileMutex.Unlock()
+	file, err := os.OpenFile(logPath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	_, err = file.Write(append(line, '\n'))
+	return errorutils.CheckError(errors.Join(err, file.Close()))
+}
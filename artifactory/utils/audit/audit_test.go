@@ -0,0 +1,69 @@
+package audit
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/http/jfroghttpclient"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAttach(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(requestIdHeader, "request-"+r.Method)
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+	logPath := filepath.Join(t.TempDir(), "audit.log")
+	t.Setenv(AuditLogEnv, logPath)
+
+	client, err := jfroghttpclient.JfrogClientBuilder().Build()
+	require.NoError(t, err)
+	Attach(client, &config.ServerDetails{User: "admin"})
+	// Attaching again doesn't audit the mutations twice.
+	Attach(client, &config.ServerDetails{User: "admin"})
+
+	httpClient := client.GetHttpClient().GetClient()
+	for _, method := range []string{http.MethodGet, http.MethodPut, http.MethodDelete} {
+		req, err := http.NewRequest(method, server.URL+"/api/repositories/generic-local", nil)
+		require.NoError(t, err)
+		resp, err := httpClient.Do(req)
+		require.NoError(t, err)
+		assert.NoError(t, resp.Body.Close())
+	}
+	// AQL queries are sent as POST requests, but they aren't mutations.
+	resp, err := httpClient.Post(server.URL+"/api/search/aql", "text/plain", strings.NewReader("items.find()"))
+	require.NoError(t, err)
+	assert.NoError(t, resp.Body.Close())
+
+	content, err := os.ReadFile(logPath)
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(content)), "\n")
+	require.Len(t, lines, 2)
+	for i, method := range []string{http.MethodPut, http.MethodDelete} {
+		var entry Entry
+		require.NoError(t, json.Unmarshal([]byte(lines[i]), &entry))
+		assert.Equal(t, "admin", entry.User)
+		assert.Equal(t, method, entry.Method)
+		assert.Equal(t, server.URL+"/api/repositories/generic-local", entry.Url)
+		assert.Equal(t, http.StatusCreated, entry.Status)
+		assert.Equal(t, "request-"+method, entry.RequestId)
+		assert.NotEmpty(t, entry.Timestamp)
+	}
+}
+
+func TestAttachDisabled(t *testing.T) {
+	t.Setenv(AuditLogEnv, "")
+	client, err := jfroghttpclient.JfrogClientBuilder().Build()
+	require.NoError(t, err)
+	Attach(client, nil)
+	_, audited := client.GetHttpClient().GetClient().Transport.(*transport)
+	assert.False(t, audited)
+}
@@ -0,0 +1,116 @@
+package buildstate
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clientconfig"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clierrors"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	clientutils "github.com/jfrog/jfrog-client-go/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+)
+
+// Stores the state of each build as files in a folder of a generic repository.
+type artifactoryBackend struct {
+	servicesManager artifactory.ArtifactoryServicesManager
+	// The path of the folder holding the states of the builds, starting with the repository.
+	root string
+}
+
+func newArtifactoryBackend(serverDetails *config.ServerDetails, root string) (*artifactoryBackend, error) {
+	if root == "" {
+		return nil, errorutils.CheckErrorf("the build state URL must specify the repository, as in artifactory://<repository>[/<path>]")
+	}
+	servicesManager, err := clientconfig.CreateServiceManager(serverDetails, 3, 0, false)
+	if err != nil {
+		return nil, err
+	}
+	return &artifactoryBackend{servicesManager: servicesManager, root: root}, nil
+}
+
+type folderListing struct {
+	Files []struct {
+		Uri    string `json:"uri"`
+		Folder bool   `json:"folder"`
+	} `json:"files"`
+}
+
+func (ab *artifactoryBackend) List(buildKey string) ([]string, error) {
+	listUrl, err := ab.buildUrl("api/storage/"+path.Join(ab.root, buildKey), map[string]string{"list": "", "deep": "1"})
+	if err != nil {
+		return nil, err
+	}
+	httpClientDetails := ab.servicesManager.GetConfig().GetServiceDetails().CreateHttpClientDetails()
+	resp, body, _, err := ab.servicesManager.Client().SendGet(listUrl, true, &httpClientDetails)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if err = clierrors.CheckResponseStatusWithBody(resp, body, http.StatusOK); err != nil {
+		return nil, err
+	}
+	var listing folderListing
+	if err = json.Unmarshal(body, &listing); err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	var files []string
+	for _, file := range listing.Files {
+		if !file.Folder {
+			files = append(files, strings.TrimPrefix(file.Uri, "/"))
+		}
+	}
+	return files, nil
+}
+
+func (ab *artifactoryBackend) Get(buildKey, filePath string) (content []byte, err error) {
+	reader, err := ab.servicesManager.ReadRemoteFile(path.Join(ab.root, buildKey, filePath))
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		err = errors.Join(err, errorutils.CheckError(reader.Close()))
+	}()
+	content, err = io.ReadAll(reader)
+	return content, errorutils.CheckError(err)
+}
+
+func (ab *artifactoryBackend) Put(buildKey, filePath string, content []byte) error {
+	putUrl, err := ab.buildUrl(path.Join(ab.root, buildKey, filePath), nil)
+	if err != nil {
+		return err
+	}
+	httpClientDetails := ab.servicesManager.GetConfig().GetServiceDetails().CreateHttpClientDetails()
+	resp, body, err := ab.servicesManager.Client().SendPut(putUrl, content, &httpClientDetails)
+	if err != nil {
+		return err
+	}
+	return clierrors.CheckResponseStatusWithBody(resp, body, http.StatusCreated, http.StatusOK)
+}
+
+func (ab *artifactoryBackend) Remove(buildKey string) error {
+	deleteUrl, err := ab.buildUrl(path.Join(ab.root, buildKey), nil)
+	if err != nil {
+		return err
+	}
+	httpClientDetails := ab.servicesManager.GetConfig().GetServiceDetails().CreateHttpClientDetails()
+	resp, body, err := ab.servicesManager.Client().SendDelete(deleteUrl, nil, &httpClientDetails)
+	if err != nil {
+		return err
+	}
+	return clierrors.CheckResponseStatusWithBody(resp, body, http.StatusNoContent, http.StatusOK, http.StatusNotFound)
+}
+
+func (ab *artifactoryBackend) buildUrl(urlPath string, params map[string]string) (string, error) {
+	if params == nil {
+		params = make(map[string]string)
+	}
+	return clientutils.BuildUrl(ab.servicesManager.GetConfig().GetServiceDetails().GetUrl(), urlPath, params)
+}
@@ -0,0 +1,210 @@
+// Package buildstate shares the build-info collected by the build commands between machines. The build commands save
+// the build-info in a local directory of the build, which is synchronized with a state backend: the state of the build
+// is pulled before a command runs, and the files it adds are pushed after it. This lets the steps of a build, such as
+// build-collect-env, build-add-dependencies and build-publish, run on different agents.
+package buildstate
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io/fs"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/jfrog/gofrog/datastructures"
+	"github.com/jfrog/jfrog-cli-core/v2/common/build"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/io/fileutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// The environment variable holding the URL of the state backend, if not provided by the --build-state option.
+const BuildStateEnv = "JFROG_CLI_BUILD_STATE"
+
+// Backend stores the state files of builds. The state of a build is identified by a key, and each of its files by
+// a slash-separated path relative to the build directory.
+type Backend interface {
+	// List returns the paths of the state files of the build, or none if the backend has no state for it.
+	List(buildKey string) ([]string, error)
+	Get(buildKey, filePath string) ([]byte, error)
+	Put(buildKey, filePath string, content []byte) error
+	// Remove removes the state of the build.
+	Remove(buildKey string) error
+}
+
+// NewBackend creates the state backend of the URL. The supported URLs are:
+//
+//	artifactory://<repository>[/<path>] - a generic repository of the Artifactory server, on which the state is stored as files.
+//	s3://<bucket>[/<prefix>] - an S3 bucket, accessed with the AWS credentials of the environment.
+//	gs://<bucket>[/<prefix>] - a GCS bucket, accessed with the Google Cloud credentials of the environment.
+//	redis[s]://[[<user>]:<password>@]<host>[:<port>][/<db>] - a Redis server, on which the state of each build is a hash.
+func NewBackend(stateUrl string, serverDetails *config.ServerDetails) (Backend, error) {
+	parsedUrl, err := url.Parse(stateUrl)
+	if err != nil {
+		return nil, errorutils.CheckErrorf("invalid build state URL '%s': %s", stateUrl, err.Error())
+	}
+	prefix := strings.Trim(parsedUrl.Path, "/")
+	switch parsedUrl.Scheme {
+	case "artifactory":
+		if serverDetails == nil {
+			return nil, errorutils.CheckErrorf("storing the build state in Artifactory requires a server configuration")
+		}
+		return newArtifactoryBackend(serverDetails, path.Join(parsedUrl.Host, prefix))
+	case "s3":
+		return newS3Backend(parsedUrl.Host, prefix)
+	case "gs":
+		return newGcsBackend(parsedUrl.Host, prefix)
+	case "redis", "rediss":
+		return newRedisBackend(stateUrl)
+	}
+	return nil, errorutils.CheckErrorf("unsupported build state URL '%s', expected a URL with one of the schemes: artifactory, s3, gs, redis, rediss", stateUrl)
+}
+
+// Pull downloads the state files of the build which are missing from its local directory. The files are matched by
+// their paths on the backend, so that the files which were pushed from the local directory aren't downloaded again.
+func Pull(backend Backend, buildName, buildNumber, projectKey string) error {
+	buildDir, err := build.GetBuildDir(buildName, buildNumber, projectKey)
+	if err != nil {
+		return err
+	}
+	buildKey := filepath.Base(buildDir)
+	remoteFiles, err := backend.List(buildKey)
+	if err != nil {
+		return err
+	}
+	localFiles, err := listLocalStateFiles(buildDir)
+	if err != nil {
+		return err
+	}
+	pulled := 0
+	for _, remoteFile := range remoteFiles {
+		if _, exists := localFiles[remoteFile]; exists {
+			continue
+		}
+		localPath, err := getLocalPath(buildDir, remoteFile)
+		if err != nil {
+			return err
+		}
+		exists, err := fileutils.IsFileExists(localPath, false)
+		if err != nil {
+			return err
+		}
+		if exists {
+			continue
+		}
+		content, err := backend.Get(buildKey, remoteFile)
+		if err != nil {
+			return err
+		}
+		if err = os.MkdirAll(filepath.Dir(localPath), 0777); err != nil {
+			return errorutils.CheckError(err)
+		}
+		if err = os.WriteFile(localPath, content, 0600); err != nil {
+			return errorutils.CheckError(err)
+		}
+		pulled++
+	}
+	log.Debug("Pulled", pulled, "build state files of", buildName+"/"+buildNumber)
+	return nil
+}
+
+// Push uploads the local state files of the build which are missing from the backend. Except for the general details
+// of the build, the files are named by their content, so that files added on different machines never collide.
+func Push(backend Backend, buildName, buildNumber, projectKey string) error {
+	buildDir, err := build.GetBuildDir(buildName, buildNumber, projectKey)
+	if err != nil {
+		return err
+	}
+	buildKey := filepath.Base(buildDir)
+	remoteFiles, err := backend.List(buildKey)
+	if err != nil {
+		return err
+	}
+	existing := datastructures.MakeSetFromElements(remoteFiles...)
+	localFiles, err := listLocalStateFiles(buildDir)
+	if err != nil {
+		return err
+	}
+	pushed := 0
+	for remoteFile, localFile := range localFiles {
+		if existing.Exists(remoteFile) {
+			continue
+		}
+		content, err := os.ReadFile(localFile)
+		if err != nil {
+			return errorutils.CheckError(err)
+		}
+		if err = backend.Put(buildKey, remoteFile, content); err != nil {
+			return err
+		}
+		existing.Add(remoteFile)
+		pushed++
+	}
+	log.Debug("Pushed", pushed, "build state files of", buildName+"/"+buildNumber)
+	return nil
+}
+
+// Remove removes the state of the build from the backend, once it is published.
+func Remove(backend Backend, buildName, buildNumber, projectKey string) error {
+	buildDir, err := build.GetBuildDir(buildName, buildNumber, projectKey)
+	if err != nil {
+		return err
+	}
+	return backend.Remove(filepath.Base(buildDir))
+}
+
+// Returns the paths of the files in the build directory and its subdirectories, by their paths on the backend.
+func listLocalStateFiles(buildDir string) (map[string]string, error) {
+	localFiles := make(map[string]string)
+	err := filepath.WalkDir(buildDir, func(localPath string, entry fs.DirEntry, err error) error {
+		if err != nil || entry.IsDir() {
+			return err
+		}
+		content, err := os.ReadFile(localPath)
+		if err != nil {
+			return err
+		}
+		relPath, err := filepath.Rel(buildDir, localPath)
+		if err != nil {
+			return err
+		}
+		localFiles[remoteFilePath(filepath.ToSlash(relPath), content)] = localPath
+		return nil
+	})
+	if errors.Is(err, fs.ErrNotExist) {
+		return localFiles, nil
+	}
+	return localFiles, errorutils.CheckError(err)
+}
+
+// Returns the local path of a state file on the backend. The paths listed by the backend aren't trusted, so paths
+// which would be written outside the build directory are rejected.
+func getLocalPath(buildDir, remoteFile string) (string, error) {
+	cleanPath := path.Clean(remoteFile)
+	if cleanPath == "." || cleanPath == ".." || strings.HasPrefix(cleanPath, "../") || path.IsAbs(cleanPath) ||
+		filepath.IsAbs(filepath.FromSlash(cleanPath)) || filepath.VolumeName(filepath.FromSlash(cleanPath)) != "" {
+		return "", errorutils.CheckErrorf("invalid build state file '%s'", remoteFile)
+	}
+	localPath := filepath.Join(buildDir, filepath.FromSlash(cleanPath))
+	relPath, err := filepath.Rel(buildDir, localPath)
+	if err != nil || relPath == ".." || strings.HasPrefix(relPath, ".."+string(filepath.Separator)) {
+		return "", errorutils.CheckErrorf("invalid build state file '%s'", remoteFile)
+	}
+	return localPath, nil
+}
+
+// Returns the path of a local state file on the backend: the general details of the build keep their name, so that
+// its start time is shared by all the machines, and the other files are named by the checksum of their content.
+func remoteFilePath(relPath string, content []byte) string {
+	dir, name := path.Split(relPath)
+	if name == build.BuildInfoDetails {
+		return relPath
+	}
+	checksum := sha256.Sum256(content)
+	return dir + hex.EncodeToString(checksum[:16])
+}
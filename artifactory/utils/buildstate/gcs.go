@@ -0,0 +1,136 @@
+package buildstate
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clierrors"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/google"
+)
+
+const (
+	gcsDefaultUrl = "https://storage.googleapis.com"
+	gcsScope      = "https://www.googleapis.com/auth/devstorage.read_write"
+)
+
+// A GCS bucket, accessed through the JSON API.
+type gcsStore struct {
+	client  *http.Client
+	baseUrl string
+	bucket  string
+}
+
+// Creates a backend on the GCS bucket. The OAuth access token in the GOOGLE_OAUTH_ACCESS_TOKEN environment variable,
+// as printed by 'gcloud auth print-access-token', is used if set. Otherwise, the credentials are resolved like the Google
+// Cloud SDKs resolve the Application Default Credentials: from GOOGLE_APPLICATION_CREDENTIALS, the credentials of
+// 'gcloud auth application-default login', or the service account of the instance. STORAGE_EMULATOR_HOST sets the
+// endpoint of a GCS emulator.
+func newGcsBackend(bucket, prefix string) (*bucketBackend, error) {
+	if bucket == "" {
+		return nil, errorutils.CheckErrorf("the build state URL must specify the bucket, as in gs://<bucket>[/<prefix>]")
+	}
+	var tokenSource oauth2.TokenSource
+	if accessToken := os.Getenv("GOOGLE_OAUTH_ACCESS_TOKEN"); accessToken != "" {
+		tokenSource = oauth2.StaticTokenSource(&oauth2.Token{AccessToken: accessToken})
+	} else {
+		var err error
+		if tokenSource, err = google.DefaultTokenSource(context.Background(), gcsScope); err != nil {
+			return nil, errorutils.CheckErrorf("failed finding the Google Cloud credentials: %s", err.Error())
+		}
+	}
+	baseUrl := gcsDefaultUrl
+	if emulatorHost := os.Getenv("STORAGE_EMULATOR_HOST"); emulatorHost != "" {
+		baseUrl = emulatorHost
+		if !strings.Contains(baseUrl, "://") {
+			baseUrl = "http://" + baseUrl
+		}
+	}
+	store := &gcsStore{client: oauth2.NewClient(context.Background(), tokenSource), baseUrl: strings.TrimSuffix(baseUrl, "/"), bucket: bucket}
+	return &bucketBackend{store: store, prefix: prefix}, nil
+}
+
+type gcsListResult struct {
+	Items []struct {
+		Name string `json:"name"`
+	} `json:"items"`
+	NextPageToken string `json:"nextPageToken"`
+}
+
+func (gs *gcsStore) listObjects(prefix string) ([]string, error) {
+	var names []string
+	query := url.Values{"prefix": {prefix}, "fields": {"items(name),nextPageToken"}}
+	for {
+		body, err := gs.send(http.MethodGet, gs.bucketUrl()+"/o?"+query.Encode(), nil, http.StatusOK)
+		if err != nil {
+			return nil, err
+		}
+		var result gcsListResult
+		if err = json.Unmarshal(body, &result); err != nil {
+			return nil, errorutils.CheckError(err)
+		}
+		for _, item := range result.Items {
+			names = append(names, item.Name)
+		}
+		if result.NextPageToken == "" {
+			return names, nil
+		}
+		query.Set("pageToken", result.NextPageToken)
+	}
+}
+
+func (gs *gcsStore) getObject(name string) ([]byte, error) {
+	return gs.send(http.MethodGet, gs.objectUrl(name)+"?alt=media", nil, http.StatusOK)
+}
+
+func (gs *gcsStore) putObject(name string, content []byte) error {
+	query := url.Values{"uploadType": {"media"}, "name": {name}}
+	_, err := gs.send(http.MethodPost, gs.baseUrl+"/upload/storage/v1/b/"+url.PathEscape(gs.bucket)+"/o?"+query.Encode(), content, http.StatusOK)
+	return err
+}
+
+func (gs *gcsStore) deleteObject(name string) error {
+	_, err := gs.send(http.MethodDelete, gs.objectUrl(name), nil, http.StatusNoContent, http.StatusOK)
+	return err
+}
+
+func (gs *gcsStore) bucketUrl() string {
+	return gs.baseUrl + "/storage/v1/b/" + url.PathEscape(gs.bucket)
+}
+
+func (gs *gcsStore) objectUrl(name string) string {
+	return gs.bucketUrl() + "/o/" + url.PathEscape(name)
+}
+
+// Sends the request, and returns the body of the response if its status is one of the expected statuses.
+func (gs *gcsStore) send(method, requestUrl string, content []byte, expectedStatusCodes ...int) ([]byte, error) {
+	req, err := http.NewRequest(method, requestUrl, bytes.NewReader(content))
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	if content != nil {
+		req.Header.Set("Content-Type", "application/octet-stream")
+	}
+	resp, err := gs.client.Do(req)
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	if err = clierrors.CheckResponseStatusWithBody(resp, body, expectedStatusCodes...); err != nil {
+		return nil, err
+	}
+	return body, nil
+}
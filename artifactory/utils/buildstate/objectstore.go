@@ -0,0 +1,64 @@
+package buildstate
+
+import (
+	"path"
+	"strings"
+)
+
+// An object storage bucket, such as S3 or GCS.
+type objectStore interface {
+	// Returns the names of the objects starting with the prefix.
+	listObjects(prefix string) ([]string, error)
+	getObject(name string) ([]byte, error)
+	putObject(name string, content []byte) error
+	deleteObject(name string) error
+}
+
+// Stores the state of each build as objects named '<prefix>/<build key>/<file path>' in a bucket.
+type bucketBackend struct {
+	store  objectStore
+	prefix string
+}
+
+func (bb *bucketBackend) List(buildKey string) ([]string, error) {
+	buildPrefix := bb.objectName(buildKey, "")
+	names, err := bb.store.listObjects(buildPrefix)
+	if err != nil {
+		return nil, err
+	}
+	files := make([]string, 0, len(names))
+	for _, name := range names {
+		files = append(files, strings.TrimPrefix(name, buildPrefix))
+	}
+	return files, nil
+}
+
+func (bb *bucketBackend) Get(buildKey, filePath string) ([]byte, error) {
+	return bb.store.getObject(bb.objectName(buildKey, filePath))
+}
+
+func (bb *bucketBackend) Put(buildKey, filePath string, content []byte) error {
+	return bb.store.putObject(bb.objectName(buildKey, filePath), content)
+}
+
+func (bb *bucketBackend) Remove(buildKey string) error {
+	names, err := bb.store.listObjects(bb.objectName(buildKey, ""))
+	if err != nil {
+		return err
+	}
+	for _, name := range names {
+		if err = bb.store.deleteObject(name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Returns the name of a state file of the build, or the prefix of the names of its files if filePath is empty.
+func (bb *bucketBackend) objectName(buildKey, filePath string) string {
+	name := strings.TrimPrefix(path.Join(bb.prefix, buildKey, filePath), "/")
+	if filePath == "" {
+		name += "/"
+	}
+	return name
+}
@@ -0,0 +1,56 @@
+package buildstate
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	redisKeyPrefix = "jfrog-cli:build-state:"
+	// The state of a build expires if it isn't published within this time.
+	redisStateTtl = 7 * 24 * time.Hour
+)
+
+// Stores the state of each build as a hash on a Redis server, which maps the paths of its files to their content.
+type redisBackend struct {
+	client *redis.Client
+}
+
+func newRedisBackend(redisUrl string) (*redisBackend, error) {
+	options, err := redis.ParseURL(redisUrl)
+	if err != nil {
+		return nil, errorutils.CheckErrorf("invalid Redis build state URL: %s", err.Error())
+	}
+	return &redisBackend{client: redis.NewClient(options)}, nil
+}
+
+func (rb *redisBackend) List(buildKey string) ([]string, error) {
+	files, err := rb.client.HKeys(context.Background(), redisKeyPrefix+buildKey).Result()
+	return files, errorutils.CheckError(err)
+}
+
+func (rb *redisBackend) Get(buildKey, filePath string) ([]byte, error) {
+	content, err := rb.client.HGet(context.Background(), redisKeyPrefix+buildKey, filePath).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, errorutils.CheckErrorf("the build state file '%s' was not found on Redis", filePath)
+	}
+	return content, errorutils.CheckError(err)
+}
+
+func (rb *redisBackend) Put(buildKey, filePath string, content []byte) error {
+	key := redisKeyPrefix + buildKey
+	_, err := rb.client.Pipelined(context.Background(), func(pipe redis.Pipeliner) error {
+		pipe.HSet(context.Background(), key, filePath, content)
+		pipe.Expire(context.Background(), key, redisStateTtl)
+		return nil
+	})
+	return errorutils.CheckError(err)
+}
+
+func (rb *redisBackend) Remove(buildKey string) error {
+	return errorutils.CheckError(rb.client.Del(context.Background(), redisKeyPrefix+buildKey).Err())
+}
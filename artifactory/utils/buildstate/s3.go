@@ -0,0 +1,79 @@
+package buildstate
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+)
+
+const s3DefaultRegion = "us-east-1"
+
+// An S3 bucket, accessed with the AWS SDK.
+type s3Store struct {
+	client *s3.Client
+	bucket string
+}
+
+// Creates a backend on the S3 bucket. The credentials and the region are resolved like the AWS CLI resolves them: from
+// the AWS_* environment variables, the shared configuration and credentials files (including profiles and SSO), or
+// the role of the instance or the container. AWS_ENDPOINT_URL sets the endpoint of an S3 compatible storage, which is
+// accessed with path-style URLs.
+func newS3Backend(bucket, prefix string) (*bucketBackend, error) {
+	if bucket == "" {
+		return nil, errorutils.CheckErrorf("the build state URL must specify the bucket, as in s3://<bucket>[/<prefix>]")
+	}
+	awsConfig, err := awsconfig.LoadDefaultConfig(context.Background())
+	if err != nil {
+		return nil, errorutils.CheckErrorf("failed loading the AWS configuration: %s", err.Error())
+	}
+	if awsConfig.Region == "" {
+		awsConfig.Region = s3DefaultRegion
+	}
+	client := s3.NewFromConfig(awsConfig, func(options *s3.Options) {
+		options.UsePathStyle = os.Getenv("AWS_ENDPOINT_URL") != "" || os.Getenv("AWS_ENDPOINT_URL_S3") != ""
+	})
+	return &bucketBackend{store: &s3Store{client: client, bucket: bucket}, prefix: prefix}, nil
+}
+
+func (ss *s3Store) listObjects(prefix string) ([]string, error) {
+	var names []string
+	paginator := s3.NewListObjectsV2Paginator(ss.client, &s3.ListObjectsV2Input{Bucket: aws.String(ss.bucket), Prefix: aws.String(prefix)})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(context.Background())
+		if err != nil {
+			return nil, errorutils.CheckError(err)
+		}
+		for _, object := range page.Contents {
+			names = append(names, aws.ToString(object.Key))
+		}
+	}
+	return names, nil
+}
+
+func (ss *s3Store) getObject(name string) ([]byte, error) {
+	output, err := ss.client.GetObject(context.Background(), &s3.GetObjectInput{Bucket: aws.String(ss.bucket), Key: aws.String(name)})
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	defer func() {
+		_ = output.Body.Close()
+	}()
+	content, err := io.ReadAll(output.Body)
+	return content, errorutils.CheckError(err)
+}
+
+func (ss *s3Store) putObject(name string, content []byte) error {
+	_, err := ss.client.PutObject(context.Background(), &s3.PutObjectInput{Bucket: aws.String(ss.bucket), Key: aws.String(name), Body: bytes.NewReader(content)})
+	return errorutils.CheckError(err)
+}
+
+func (ss *s3Store) deleteObject(name string) error {
+	_, err := ss.client.DeleteObject(context.Background(), &s3.DeleteObjectInput{Bucket: aws.String(ss.bucket), Key: aws.String(name)})
+	return errorutils.CheckError(err)
+}
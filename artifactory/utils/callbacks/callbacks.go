@@ -0,0 +1,100 @@
+package callbacks
+
+import (
+	"sync"
+
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/progresshooks"
+	clientutils "github.com/jfrog/jfrog-client-go/utils"
+	ioUtils "github.com/jfrog/jfrog-client-go/utils/io"
+	"github.com/jfrog/jfrog-client-go/utils/io/content"
+)
+
+// TransferCallbacks allows programs embedding the transfer and build commands to follow their progress.
+// All hooks are optional and may be called concurrently from the transfer worker goroutines.
+type TransferCallbacks struct {
+	// Called when the transfer of a file starts.
+	OnFileStart func(path string)
+	// Called as the content of a file is transferred, with the number of bytes transferred so far and the size of the file.
+	OnFileProgress func(path string, transferred, total int64)
+	// Called for each file which was transferred successfully, once the command completes.
+	OnFileComplete func(details clientutils.FileTransferDetails)
+	// Called when the transfer of a file is started again after a failed attempt. The first retry is attempt 1.
+	OnRetry func(path string, attempt int)
+	// Called for each error reported by the command.
+	OnError func(err error)
+}
+
+func (tc *TransferCallbacks) FileStarted(path string) {
+	if tc != nil && tc.OnFileStart != nil {
+		tc.OnFileStart(path)
+	}
+}
+
+func (tc *TransferCallbacks) FileProgressed(path string, transferred, total int64) {
+	if tc != nil && tc.OnFileProgress != nil {
+		tc.OnFileProgress(path, transferred, total)
+	}
+}
+
+func (tc *TransferCallbacks) FileCompleted(details clientutils.FileTransferDetails) {
+	if tc != nil && tc.OnFileComplete != nil {
+		tc.OnFileComplete(details)
+	}
+}
+
+func (tc *TransferCallbacks) Retried(path string, attempt int) {
+	if tc != nil && tc.OnRetry != nil {
+		tc.OnRetry(path, attempt)
+	}
+}
+
+func (tc *TransferCallbacks) Error(err error) {
+	if tc != nil && tc.OnError != nil && err != nil {
+		tc.OnError(err)
+	}
+}
+
+// ReportsCompletedFiles returns true if the command should collect the details of the transferred files for the OnFileComplete hook.
+func (tc *TransferCallbacks) ReportsCompletedFiles() bool {
+	return tc != nil && tc.OnFileComplete != nil
+}
+
+// ReportCompletedFiles calls the OnFileComplete hook for each record in the transfer details reader, and resets the reader for further use.
+func (tc *TransferCallbacks) ReportCompletedFiles(transferDetailsReader *content.ContentReader) error {
+	if !tc.ReportsCompletedFiles() || transferDetailsReader == nil {
+		return nil
+	}
+	for details := new(clientutils.FileTransferDetails); transferDetailsReader.NextRecord(details) == nil; details = new(clientutils.FileTransferDetails) {
+		tc.FileCompleted(*details)
+	}
+	transferDetailsReader.Reset()
+	return transferDetailsReader.GetError()
+}
+
+// WrapProgress returns a progress manager which reports file transfers to the callbacks before delegating to the provided progress manager.
+// The provided progress manager may be nil. Returns it as is if no callbacks were set.
+// A transfer that starts again for the same path is reported as a retry.
+func WrapProgress(progress ioUtils.ProgressMgr, tc *TransferCallbacks) ioUtils.ProgressMgr {
+	if tc == nil || (tc.OnFileStart == nil && tc.OnRetry == nil && tc.OnFileProgress == nil) {
+		return progress
+	}
+	var mutex sync.Mutex
+	attempts := make(map[string]int)
+	hooks := progresshooks.Hooks{
+		OnNewReader: func(_ int64, _, path string) {
+			mutex.Lock()
+			attempt := attempts[path]
+			attempts[path] = attempt + 1
+			mutex.Unlock()
+			if attempt == 0 {
+				tc.FileStarted(path)
+			} else {
+				tc.Retried(path, attempt)
+			}
+		},
+	}
+	if tc.OnFileProgress != nil {
+		hooks.OnRead = tc.FileProgressed
+	}
+	return progresshooks.Wrap(progress, hooks)
+}
@@ -0,0 +1,45 @@
+package callbacks
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNilCallbacks(t *testing.T) {
+	var tc *TransferCallbacks
+	assert.NotPanics(t, func() {
+		tc.FileStarted("path")
+		tc.Retried("path", 1)
+		tc.Error(errors.New("error"))
+	})
+	assert.False(t, tc.ReportsCompletedFiles())
+	assert.Nil(t, WrapProgress(nil, tc))
+	assert.Nil(t, WrapProgress(nil, &TransferCallbacks{OnError: func(error) {}}))
+}
+
+func TestWrapProgress(t *testing.T) {
+	var started []string
+	var retried []int
+	tc := &TransferCallbacks{
+		OnFileStart: func(path string) { started = append(started, path) },
+		OnRetry:     func(path string, attempt int) { retried = append(retried, attempt) },
+	}
+	progress := WrapProgress(nil, tc)
+	assert.NotNil(t, progress)
+
+	first := progress.NewProgressReader(10, "Uploading", "a.zip")
+	second := progress.NewProgressReader(10, "Uploading", "b.zip")
+	progress.NewProgressReader(10, "Uploading", "a.zip")
+	progress.NewProgressReader(10, "Uploading", "a.zip")
+
+	assert.Equal(t, []string{"a.zip", "b.zip"}, started)
+	assert.Equal(t, []int{1, 2}, retried)
+	assert.NotEqual(t, first.GetId(), second.GetId())
+
+	reader := strings.NewReader("content")
+	assert.Equal(t, reader, first.ActionWithProgress(reader))
+	assert.NoError(t, progress.Quit())
+}
@@ -0,0 +1,95 @@
+package callbacks
+
+import (
+	"sync"
+
+	clientutils "github.com/jfrog/jfrog-client-go/utils"
+)
+
+type TransferEventType string
+
+const (
+	FileStartEvent    TransferEventType = "start"
+	FileProgressEvent TransferEventType = "progress"
+	FileCompleteEvent TransferEventType = "complete"
+	FileRetryEvent    TransferEventType = "retry"
+	ErrorEvent        TransferEventType = "error"
+)
+
+// TransferEvent describes a change in the state of a transfer.
+type TransferEvent struct {
+	Type TransferEventType
+	// The transferred file. Empty for errors which are not related to a specific file.
+	Path string
+	// The number of bytes transferred so far and the size of the file, set for progress events.
+	Transferred int64
+	Total       int64
+	// The retry attempt, set for retry events.
+	Attempt int
+	// The details of the transferred file, set for complete events.
+	Details *clientutils.FileTransferDetails
+	// Set for error events.
+	Err error
+}
+
+// TransferProgressListener receives the events of the upload and download commands.
+// OnTransferEvent may be called concurrently from the transfer worker goroutines.
+type TransferProgressListener interface {
+	OnTransferEvent(event TransferEvent)
+}
+
+// NewListenerCallbacks returns callbacks which report all events to the listener.
+func NewListenerCallbacks(listener TransferProgressListener) *TransferCallbacks {
+	if listener == nil {
+		return nil
+	}
+	return &TransferCallbacks{
+		OnFileStart: func(path string) {
+			listener.OnTransferEvent(TransferEvent{Type: FileStartEvent, Path: path})
+		},
+		OnFileProgress: func(path string, transferred, total int64) {
+			listener.OnTransferEvent(TransferEvent{Type: FileProgressEvent, Path: path, Transferred: transferred, Total: total})
+		},
+		OnFileComplete: func(details clientutils.FileTransferDetails) {
+			listener.OnTransferEvent(TransferEvent{Type: FileCompleteEvent, Path: details.SourcePath, Details: &details})
+		},
+		OnRetry: func(path string, attempt int) {
+			listener.OnTransferEvent(TransferEvent{Type: FileRetryEvent, Path: path, Attempt: attempt})
+		},
+		OnError: func(err error) {
+			listener.OnTransferEvent(TransferEvent{Type: ErrorEvent, Err: err})
+		},
+	}
+}
+
+// ChannelListener is a TransferProgressListener which sends the events to a channel.
+// Progress events are dropped while the channel is full, so that a slow consumer does not slow down the transfer.
+// All other events are delivered, so the channel must be drained until the command returns.
+type ChannelListener struct {
+	events chan TransferEvent
+	once   sync.Once
+}
+
+func NewChannelListener(bufferSize int) *ChannelListener {
+	return &ChannelListener{events: make(chan TransferEvent, bufferSize)}
+}
+
+func (cl *ChannelListener) Events() <-chan TransferEvent {
+	return cl.events
+}
+
+func (cl *ChannelListener) OnTransferEvent(event TransferEvent) {
+	if event.Type != FileProgressEvent {
+		cl.events <- event
+		return
+	}
+	select {
+	case cl.events <- event:
+	default:
+	}
+}
+
+// Close closes the events channel. Should be called once the command returns.
+func (cl *ChannelListener) Close() {
+	cl.once.Do(func() { close(cl.events) })
+}
@@ -0,0 +1,56 @@
+package callbacks
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	clientutils "github.com/jfrog/jfrog-client-go/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+type recordingListener struct {
+	events []TransferEvent
+}
+
+func (rl *recordingListener) OnTransferEvent(event TransferEvent) {
+	rl.events = append(rl.events, event)
+}
+
+func TestListenerCallbacks(t *testing.T) {
+	assert.Nil(t, NewListenerCallbacks(nil))
+
+	listener := &recordingListener{}
+	tc := NewListenerCallbacks(listener)
+	progress := WrapProgress(nil, tc)
+	reader := progress.NewProgressReader(7, "Uploading", "a.zip").ActionWithProgress(strings.NewReader("content"))
+	_, err := io.ReadAll(reader)
+	assert.NoError(t, err)
+	progress.NewProgressReader(7, "Uploading", "a.zip")
+	tc.FileCompleted(clientutils.FileTransferDetails{SourcePath: "a.zip", TargetPath: "repo/a.zip"})
+	tc.Error(errors.New("upload failed"))
+
+	var types []TransferEventType
+	for _, event := range listener.events {
+		types = append(types, event.Type)
+	}
+	assert.Equal(t, []TransferEventType{FileStartEvent, FileProgressEvent, FileRetryEvent, FileCompleteEvent, ErrorEvent}, types)
+	assert.Equal(t, int64(7), listener.events[1].Transferred)
+	assert.Equal(t, int64(7), listener.events[1].Total)
+	assert.Equal(t, 1, listener.events[2].Attempt)
+	assert.Equal(t, "repo/a.zip", listener.events[3].Details.TargetPath)
+	assert.EqualError(t, listener.events[4].Err, "upload failed")
+}
+
+func TestChannelListener(t *testing.T) {
+	listener := NewChannelListener(1)
+	listener.OnTransferEvent(TransferEvent{Type: FileStartEvent, Path: "a.zip"})
+	// The channel is full, so the progress event is dropped.
+	listener.OnTransferEvent(TransferEvent{Type: FileProgressEvent, Path: "a.zip"})
+	assert.Equal(t, FileStartEvent, (<-listener.Events()).Type)
+	listener.Close()
+	listener.Close()
+	_, open := <-listener.Events()
+	assert.False(t, open)
+}
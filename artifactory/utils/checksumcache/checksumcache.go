@@ -0,0 +1,208 @@
+package checksumcache
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	buildinfo "github.com/jfrog/build-info-go/entities"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/io/fileutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const (
+	// ChecksumCacheEnv overrides the path of the cache file, which is checksum-cache.json in the JFrog home dir by
+	// default. Set it to 'false' to disable the cache.
+	ChecksumCacheEnv = "JFROG_CLI_CHECKSUM_CACHE"
+
+	cacheFileName = "checksum-cache.json"
+	// The least recently used entries are evicted when the cache is saved with more entries.
+	maxEntries = 100000
+)
+
+type entry struct {
+	Size    int64              `json:"size"`
+	ModTime int64              `json:"modTime"`
+	UsedAt  int64              `json:"usedAt"`
+	Sum     buildinfo.Checksum `json:"checksum"`
+}
+
+// Cache holds the checksums of local files, keyed by their absolute path, and valid as long as the size and the
+// modification time of the file don't change. It's persisted between runs, so that repeated publishes of mostly
+// unchanged trees don't hash all their files again. A nil cache calculates the checksums of all the files.
+type Cache struct {
+	path    string
+	mutex   sync.Mutex
+	entries map[string]*entry
+	dirty   bool
+}
+
+// Load loads the cache of the JFrog home dir, or the cache file set by ChecksumCacheEnv. Returns nil if the cache is
+// disabled or its file can't be located. A cache file which can't be read is ignored, and replaced when the cache is
+// saved.
+func Load() *Cache {
+	cachePath := os.Getenv(ChecksumCacheEnv)
+	if strings.EqualFold(cachePath, "false") {
+		return nil
+	}
+	if cachePath == "" {
+		homeDir, err := coreutils.GetJfrogHomeDir()
+		if err != nil {
+			log.Warn("The checksum cache is disabled:", err.Error())
+			return nil
+		}
+		cachePath = filepath.Join(homeDir, cacheFileName)
+	}
+	return LoadFrom(cachePath)
+}
+
+// LoadFrom loads the cache from the file, which doesn't have to exist.
+func LoadFrom(cachePath string) *Cache {
+	cache := &Cache{path: cachePath, entries: make(map[string]*entry)}
+	content, err := os.ReadFile(cachePath)
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			log.Debug("Failed to read the checksum cache:", err.Error())
+		}
+		return cache
+	}
+	if err = json.Unmarshal(content, &cache.entries); err != nil {
+		log.Debug("Ignoring the invalid checksum cache", cachePath+":", err.Error())
+		cache.entries = make(map[string]*entry)
+	}
+	return cache
+}
+
+// GetFileDetails returns the size and the checksums of the file, which are calculated only if the cache doesn't hold
+// them for its current size and modification time.
+func (c *Cache) GetFileDetails(filePath string) (*fileutils.FileDetails, error) {
+	if c == nil {
+		return fileutils.GetFileDetails(filePath, true)
+	}
+	key, fileInfo, err := stat(filePath)
+	if err != nil {
+		return nil, err
+	}
+	c.mutex.Lock()
+	cached, exists := c.entries[key]
+	if exists && cached.Size == fileInfo.Size() && cached.ModTime == fileInfo.ModTime().UnixNano() {
+		cached.UsedAt = time.Now().Unix()
+		c.dirty = true
+		c.mutex.Unlock()
+		return &fileutils.FileDetails{Checksum: cached.Sum, Size: cached.Size}, nil
+	}
+	c.mutex.Unlock()
+	details, err := fileutils.GetFileDetails(filePath, true)
+	if err != nil {
+		return nil, err
+	}
+	c.put(key, fileInfo, details.Checksum)
+	return details, nil
+}
+
+// Put adds checksums of the file which were received from Artifactory, such as the checksums of a deployed file, so
+// that the file isn't hashed by later runs.
+func (c *Cache) Put(filePath string, checksum buildinfo.Checksum) error {
+	if c == nil || checksum.Sha1 == "" || checksum.Md5 == "" || checksum.Sha256 == "" {
+		return nil
+	}
+	key, fileInfo, err := stat(filePath)
+	if err != nil {
+		return err
+	}
+	c.put(key, fileInfo, checksum)
+	return nil
+}
+
+func (c *Cache) put(key string, fileInfo os.FileInfo, checksum buildinfo.Checksum) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.entries[key] = &entry{Size: fileInfo.Size(), ModTime: fileInfo.ModTime().UnixNano(), UsedAt: time.Now().Unix(), Sum: checksum}
+	c.dirty = true
+}
+
+// Save writes the cache to its file, if it was changed, evicting its least recently used entries beyond the maximal
+// number of entries.
+func (c *Cache) Save() error {
+	if c == nil {
+		return nil
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if !c.dirty {
+		return nil
+	}
+	if len(c.entries) > maxEntries {
+		keys := make([]string, 0, len(c.entries))
+		for key := range c.entries {
+			keys = append(keys, key)
+		}
+		sort.Slice(keys, func(i, j int) bool {
+			return c.entries[keys[i]].UsedAt > c.entries[keys[j]].UsedAt
+		})
+		for _, key := range keys[maxEntries:] {
+			delete(c.entries, key)
+		}
+	}
+	content, err := json.Marshal(c.entries)
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	if err = os.MkdirAll(filepath.Dir(c.path), 0700); err != nil {
+		return errorutils.CheckError(err)
+	}
+	// The cache is replaced atomically, so that concurrent runs don't read a partially written file.
+	tempFile, err := os.CreateTemp(filepath.Dir(c.path), cacheFileName+".*")
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	_, err = tempFile.Write(content)
+	err = errors.Join(err, tempFile.Close())
+	if err == nil {
+		err = os.Rename(tempFile.Name(), c.path)
+	}
+	if err != nil {
+		return errorutils.CheckError(errors.Join(err, os.Remove(tempFile.Name())))
+	}
+	c.dirty = false
+	return nil
+}
+
+// SaveOrLog saves the cache, and logs the error if it fails, since the cache only speeds up later runs.
+func (c *Cache) SaveOrLog() {
+	if err := c.Save(); err != nil {
+		log.Warn("Failed to save the checksum cache:", err.Error())
+	}
+}
+
+func stat(filePath string) (string, os.FileInfo, error) {
+	absolutePath, err := filepath.Abs(filePath)
+	if err != nil {
+		return "", nil, errorutils.CheckError(err)
+	}
+	fileInfo, err := os.Stat(absolutePath)
+	if err != nil {
+		return "", nil, errorutils.CheckError(err)
+	}
+	return absolutePath, fileInfo, nil
+}
+
+// GetDeployedChecksums returns the checksums of a file deployed to Artifactory, from its storage info.
+func GetDeployedChecksums(servicesManager artifactory.ArtifactoryServicesManager, repoPath string) (*buildinfo.Checksum, error) {
+	fileInfo, err := servicesManager.FileInfo(repoPath)
+	if err != nil {
+		return nil, err
+	}
+	if fileInfo.Checksums.Sha1 == "" || fileInfo.Checksums.Md5 == "" || fileInfo.Checksums.Sha256 == "" {
+		return nil, errorutils.CheckErrorf("the storage info of '%s' doesn't include its checksums", repoPath)
+	}
+	return &buildinfo.Checksum{Sha1: fileInfo.Checksums.Sha1, Md5: fileInfo.Checksums.Md5, Sha256: fileInfo.Checksums.Sha256}, nil
+}
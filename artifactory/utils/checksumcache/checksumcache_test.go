@@ -0,0 +1,92 @@
+package checksumcache
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	buildinfo "github.com/jfrog/build-info-go/entities"
+	"github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCache(t *testing.T) {
+	dir := t.TempDir()
+	cachePath := filepath.Join(dir, "cache", cacheFileName)
+	filePath := filepath.Join(dir, "file.txt")
+	require.NoError(t, os.WriteFile(filePath, []byte("content"), 0600))
+
+	cache := LoadFrom(cachePath)
+	details, err := cache.GetFileDetails(filePath)
+	require.NoError(t, err)
+	assert.Equal(t, int64(7), details.Size)
+	assert.Equal(t, "040f06fd774092478d450774f5ba30c5da78acc8", details.Checksum.Sha1)
+	require.NoError(t, cache.Save())
+
+	// The cached checksums are returned as long as the size and the modification time of the file don't change.
+	cache = LoadFrom(cachePath)
+	cache.entries[mustAbs(t, filePath)].Sum.Sha1 = "cached"
+	details, err = cache.GetFileDetails(filePath)
+	require.NoError(t, err)
+	assert.Equal(t, "cached", details.Checksum.Sha1)
+
+	require.NoError(t, os.WriteFile(filePath, []byte("changed"), 0600))
+	require.NoError(t, os.Chtimes(filePath, time.Now().Add(time.Hour), time.Now().Add(time.Hour)))
+	details, err = cache.GetFileDetails(filePath)
+	require.NoError(t, err)
+	assert.NotEqual(t, "cached", details.Checksum.Sha1)
+
+	deployed := buildinfo.Checksum{Sha1: "sha1", Md5: "md5", Sha256: "sha256"}
+	require.NoError(t, cache.Put(filePath, deployed))
+	require.NoError(t, cache.Save())
+	details, err = LoadFrom(cachePath).GetFileDetails(filePath)
+	require.NoError(t, err)
+	assert.Equal(t, deployed, details.Checksum)
+}
+
+func TestInvalidAndDisabledCache(t *testing.T) {
+	dir := t.TempDir()
+	cachePath := filepath.Join(dir, cacheFileName)
+	require.NoError(t, os.WriteFile(cachePath, []byte("{invalid"), 0600))
+	assert.Empty(t, LoadFrom(cachePath).entries)
+
+	t.Setenv(ChecksumCacheEnv, "false")
+	cache := Load()
+	assert.Nil(t, cache)
+	filePath := filepath.Join(dir, "file.txt")
+	require.NoError(t, os.WriteFile(filePath, []byte("content"), 0600))
+	details, err := cache.GetFileDetails(filePath)
+	require.NoError(t, err)
+	assert.Equal(t, "040f06fd774092478d450774f5ba30c5da78acc8", details.Checksum.Sha1)
+	assert.NoError(t, cache.Save())
+}
+
+func TestGetDeployedChecksums(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/storage/cargo-local/crates/app/app-0.1.0.crate" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(`{"repo":"cargo-local","checksums":{"sha1":"sha1","md5":"md5","sha256":"sha256"}}`))
+	}))
+	defer server.Close()
+	servicesManager, err := utils.CreateServiceManager(&config.ServerDetails{ArtifactoryUrl: server.URL + "/"}, 0, 0, false)
+	require.NoError(t, err)
+
+	checksums, err := GetDeployedChecksums(servicesManager, "cargo-local/crates/app/app-0.1.0.crate")
+	require.NoError(t, err)
+	assert.Equal(t, &buildinfo.Checksum{Sha1: "sha1", Md5: "md5", Sha256: "sha256"}, checksums)
+	_, err = GetDeployedChecksums(servicesManager, "cargo-local/crates/lib/lib-0.1.0.crate")
+	assert.Error(t, err)
+}
+
+func mustAbs(t *testing.T, path string) string {
+	absolutePath, err := filepath.Abs(path)
+	require.NoError(t, err)
+	return absolutePath
+}
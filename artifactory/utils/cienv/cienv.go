@@ -0,0 +1,108 @@
+// Package cienv detects the build details provided by the environment variables of well-known CI systems,
+// so that build commands run in those systems without build options.
+package cienv
+
+import (
+	"net/url"
+	"os"
+	"strings"
+
+	buildinfo "github.com/jfrog/build-info-go/entities"
+	"github.com/jfrog/jfrog-cli-core/v2/common/build"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// Environment holds the build details of the running CI job. Details which the CI system doesn't provide are empty.
+type Environment struct {
+	// The identifier of the CI system, such as 'github'.
+	System       string
+	BuildName    string
+	BuildNumber  string
+	BuildUrl     string
+	VcsUrl       string
+	VcsRevision  string
+	VcsBranch    string
+	AgentName    string
+	AgentVersion string
+}
+
+// Detector recognizes a CI system by its environment variables.
+type Detector interface {
+	// Detect returns the environment of the CI system, or nil if not running in it. getenv reads an environment variable.
+	Detect(getenv func(string) string) *Environment
+}
+
+// The detectors are checked in order, and the first which recognizes its CI system is used.
+var detectors = []Detector{jenkinsDetector{}, gitHubActionsDetector{}, gitLabDetector{}, azureDevOpsDetector{}, circleCIDetector{}}
+
+// Detect returns the environment of the CI system in which the CLI runs, or nil if it isn't running in a supported one.
+func Detect() *Environment {
+	return detect(os.Getenv)
+}
+
+func detect(getenv func(string) string) *Environment {
+	for _, detector := range detectors {
+		if env := detector.Detect(getenv); env != nil {
+			env.VcsUrl = removeCredentials(env.VcsUrl)
+			return env
+		}
+	}
+	return nil
+}
+
+// ApplyToBuildConfiguration sets the build name and number of the CI job, if neither is provided by options,
+// environment variables or the project configuration.
+func ApplyToBuildConfiguration(buildConfiguration *build.BuildConfiguration) {
+	applyToBuildConfiguration(buildConfiguration, Detect())
+}
+
+func applyToBuildConfiguration(buildConfiguration *build.BuildConfiguration, env *Environment) {
+	if env == nil || env.BuildName == "" || env.BuildNumber == "" {
+		return
+	}
+	buildName, err := buildConfiguration.GetBuildName()
+	if err != nil || buildName != "" {
+		return
+	}
+	buildNumber, err := buildConfiguration.GetBuildNumber()
+	if err != nil || buildNumber != "" {
+		return
+	}
+	log.Debug("Using the build name and number of the", env.AgentName, "job:", env.BuildName, env.BuildNumber)
+	buildConfiguration.SetBuildName(env.BuildName).SetBuildNumber(env.BuildNumber)
+}
+
+// ApplyToBuildInfo adds the details of the CI job to the build info: its URL and VCS, unless already collected,
+// and the CI agent as the 'ci.agent.name' and 'ci.agent.version' properties.
+func (env *Environment) ApplyToBuildInfo(buildInfo *buildinfo.BuildInfo) {
+	if buildInfo.BuildUrl == "" {
+		buildInfo.BuildUrl = env.BuildUrl
+	}
+	if len(buildInfo.VcsList) == 0 && env.VcsUrl != "" {
+		buildInfo.VcsList = append(buildInfo.VcsList, buildinfo.Vcs{Url: env.VcsUrl, Revision: env.VcsRevision, Branch: env.VcsBranch})
+	}
+	if buildInfo.Properties == nil {
+		buildInfo.Properties = make(buildinfo.Env)
+	}
+	buildInfo.Properties["ci.agent.name"] = env.AgentName
+	if env.AgentVersion != "" {
+		buildInfo.Properties["ci.agent.version"] = env.AgentVersion
+	}
+}
+
+// NormalizeVcsUrl returns a form of the URL of a repository which ignores the differences between the URLs reported by
+// git and by CI systems: credentials, letter case, a trailing slash and the '.git' suffix.
+func NormalizeVcsUrl(vcsUrl string) string {
+	vcsUrl = strings.ToLower(removeCredentials(strings.TrimSpace(vcsUrl)))
+	return strings.TrimSuffix(strings.TrimSuffix(vcsUrl, "/"), ".git")
+}
+
+// CI systems may provide the URL of the repository with the token used to clone it.
+func removeCredentials(vcsUrl string) string {
+	parsedUrl, err := url.Parse(vcsUrl)
+	if err != nil || parsedUrl.User == nil || parsedUrl.Host == "" {
+		return vcsUrl
+	}
+	parsedUrl.User = nil
+	return parsedUrl.String()
+}
@@ -0,0 +1,116 @@
+package cienv
+
+import (
+	"net/url"
+	"strings"
+)
+
+type jenkinsDetector struct{}
+
+func (jenkinsDetector) Detect(getenv func(string) string) *Environment {
+	if getenv("JENKINS_URL") == "" || getenv("BUILD_NUMBER") == "" {
+		return nil
+	}
+	env := &Environment{
+		System:       "jenkins",
+		BuildName:    getenv("JOB_NAME"),
+		BuildNumber:  getenv("BUILD_NUMBER"),
+		BuildUrl:     getenv("BUILD_URL"),
+		VcsUrl:       getenv("GIT_URL"),
+		VcsRevision:  getenv("GIT_COMMIT"),
+		VcsBranch:    getenv("GIT_BRANCH"),
+		AgentName:    "Jenkins",
+		AgentVersion: getenv("JENKINS_VERSION"),
+	}
+	// Multibranch pipelines provide the branch by its name only.
+	if env.VcsBranch == "" {
+		env.VcsBranch = getenv("BRANCH_NAME")
+	}
+	return env
+}
+
+type gitHubActionsDetector struct{}
+
+func (gitHubActionsDetector) Detect(getenv func(string) string) *Environment {
+	if getenv("GITHUB_ACTIONS") != "true" {
+		return nil
+	}
+	env := &Environment{
+		System:       "github",
+		BuildName:    getenv("GITHUB_WORKFLOW"),
+		BuildNumber:  getenv("GITHUB_RUN_NUMBER"),
+		VcsRevision:  getenv("GITHUB_SHA"),
+		VcsBranch:    getenv("GITHUB_REF_NAME"),
+		AgentName:    "GitHub Actions",
+		AgentVersion: getenv("RUNNER_VERSION"),
+	}
+	if serverUrl, repository := getenv("GITHUB_SERVER_URL"), getenv("GITHUB_REPOSITORY"); serverUrl != "" && repository != "" {
+		env.VcsUrl = serverUrl + "/" + repository + ".git"
+		if runId := getenv("GITHUB_RUN_ID"); runId != "" {
+			env.BuildUrl = serverUrl + "/" + repository + "/actions/runs/" + runId
+		}
+	}
+	return env
+}
+
+type gitLabDetector struct{}
+
+func (gitLabDetector) Detect(getenv func(string) string) *Environment {
+	if getenv("GITLAB_CI") != "true" {
+		return nil
+	}
+	env := &Environment{
+		System:       "gitlab",
+		BuildName:    getenv("CI_PROJECT_NAME"),
+		BuildNumber:  getenv("CI_PIPELINE_ID"),
+		BuildUrl:     getenv("CI_PIPELINE_URL"),
+		VcsRevision:  getenv("CI_COMMIT_SHA"),
+		VcsBranch:    getenv("CI_COMMIT_REF_NAME"),
+		AgentName:    "GitLab CI",
+		AgentVersion: getenv("CI_SERVER_VERSION"),
+	}
+	if projectUrl := getenv("CI_PROJECT_URL"); projectUrl != "" {
+		env.VcsUrl = projectUrl + ".git"
+	}
+	return env
+}
+
+type azureDevOpsDetector struct{}
+
+func (azureDevOpsDetector) Detect(getenv func(string) string) *Environment {
+	if !strings.EqualFold(getenv("TF_BUILD"), "true") {
+		return nil
+	}
+	env := &Environment{
+		System:       "azure",
+		BuildName:    getenv("BUILD_DEFINITIONNAME"),
+		BuildNumber:  getenv("BUILD_BUILDNUMBER"),
+		VcsUrl:       getenv("BUILD_REPOSITORY_URI"),
+		VcsRevision:  getenv("BUILD_SOURCEVERSION"),
+		VcsBranch:    getenv("BUILD_SOURCEBRANCHNAME"),
+		AgentName:    "Azure Pipelines",
+		AgentVersion: getenv("AGENT_VERSION"),
+	}
+	if collectionUri, project, buildId := getenv("SYSTEM_COLLECTIONURI"), getenv("SYSTEM_TEAMPROJECT"), getenv("BUILD_BUILDID"); collectionUri != "" && project != "" && buildId != "" {
+		env.BuildUrl = strings.TrimSuffix(collectionUri, "/") + "/" + url.PathEscape(project) + "/_build/results?buildId=" + buildId
+	}
+	return env
+}
+
+type circleCIDetector struct{}
+
+func (circleCIDetector) Detect(getenv func(string) string) *Environment {
+	if getenv("CIRCLECI") != "true" {
+		return nil
+	}
+	return &Environment{
+		System:      "circleci",
+		BuildName:   getenv("CIRCLE_PROJECT_REPONAME"),
+		BuildNumber: getenv("CIRCLE_BUILD_NUM"),
+		BuildUrl:    getenv("CIRCLE_BUILD_URL"),
+		VcsUrl:      getenv("CIRCLE_REPOSITORY_URL"),
+		VcsRevision: getenv("CIRCLE_SHA1"),
+		VcsBranch:   getenv("CIRCLE_BRANCH"),
+		AgentName:   "CircleCI",
+	}
+}
@@ -0,0 +1,135 @@
+package clientconfig
+
+import (
+	"net/http"
+
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/audit"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/offline"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/serverproxy"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/servertls"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/telemetry"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/throttle"
+	"github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	xrayutils "github.com/jfrog/jfrog-cli-core/v2/utils/xray"
+	"github.com/jfrog/jfrog-client-go/access"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/jfrog/jfrog-client-go/distribution"
+	"github.com/jfrog/jfrog-client-go/evidence"
+	"github.com/jfrog/jfrog-client-go/http/jfroghttpclient"
+	"github.com/jfrog/jfrog-client-go/jpd"
+	"github.com/jfrog/jfrog-client-go/lifecycle"
+	"github.com/jfrog/jfrog-client-go/onemodel"
+	"github.com/jfrog/jfrog-client-go/utils/io"
+	"github.com/jfrog/jfrog-client-go/xray"
+)
+
+// The functions below create the services managers like their namesakes in jfrog-cli-core, and configure their clients
+// by ConfigureClient.
+
+type clientManager interface {
+	Client() *jfroghttpclient.JfrogHttpClient
+}
+
+func track[T clientManager](serverDetails *config.ServerDetails, manager T, err error) (T, error) {
+	if err != nil {
+		return manager, err
+	}
+	return manager, ConfigureClient(manager.Client(), serverDetails)
+}
+
+// ConfigureClient applies the TLS configuration and the proxy of the server to the client, attaches the audit log, the
+// telemetry and the retries of throttled requests, and attaches the offline mode last. The retries wrap the audit log
+// and the telemetry, so that every attempt is audited and traced. The offline mode wraps the other transports, so that
+// the replayed requests, which aren't sent to the server, are neither audited nor traced.
+func ConfigureClient(client *jfroghttpclient.JfrogHttpClient, serverDetails *config.ServerDetails) error {
+	if client == nil || client.GetHttpClient() == nil {
+		return nil
+	}
+	return ConfigureHttpClient(client.GetHttpClient().GetClient(), serverDetails)
+}
+
+// ConfigureHttpClient configures an http client, which sends requests to the server without a services manager,
+// like ConfigureClient.
+func ConfigureHttpClient(httpClient *http.Client, serverDetails *config.ServerDetails) error {
+	if err := servertls.ApplyToHttpClient(httpClient, serverDetails); err != nil {
+		return err
+	}
+	if err := serverproxy.ApplyToHttpClient(httpClient, serverDetails); err != nil {
+		return err
+	}
+	audit.AttachToHttpClient(httpClient, serverDetails)
+	telemetry.AttachToHttpClient(httpClient)
+	if err := throttle.AttachToHttpClient(httpClient); err != nil {
+		return err
+	}
+	return offline.AttachToHttpClient(httpClient)
+}
+
+func CreateServiceManager(serverDetails *config.ServerDetails, httpRetries, httpRetryWaitMilliSecs int, isDryRun bool) (artifactory.ArtifactoryServicesManager, error) {
+	manager, err := utils.CreateServiceManager(serverDetails, httpRetries, httpRetryWaitMilliSecs, isDryRun)
+	return track(serverDetails, manager, err)
+}
+
+func CreateServiceManagerWithThreads(serverDetails *config.ServerDetails, isDryRun bool, threads, httpRetries, httpRetryWaitMilliSecs int) (artifactory.ArtifactoryServicesManager, error) {
+	manager, err := utils.CreateServiceManagerWithThreads(serverDetails, isDryRun, threads, httpRetries, httpRetryWaitMilliSecs)
+	return track(serverDetails, manager, err)
+}
+
+func CreateUploadServiceManager(serverDetails *config.ServerDetails, threads, httpRetries, httpRetryWaitMilliSecs int, dryRun bool, progressBar io.ProgressMgr) (artifactory.ArtifactoryServicesManager, error) {
+	manager, err := utils.CreateUploadServiceManager(serverDetails, threads, httpRetries, httpRetryWaitMilliSecs, dryRun, progressBar)
+	return track(serverDetails, manager, err)
+}
+
+func CreateDownloadServiceManager(serverDetails *config.ServerDetails, threads, httpRetries, httpRetryWaitMilliSecs int, dryRun bool, progressBar io.ProgressMgr) (artifactory.ArtifactoryServicesManager, error) {
+	manager, err := utils.CreateDownloadServiceManager(serverDetails, threads, httpRetries, httpRetryWaitMilliSecs, dryRun, progressBar)
+	return track(serverDetails, manager, err)
+}
+
+func CreateDeleteServiceManager(serverDetails *config.ServerDetails, threads, httpRetries, httpRetryWaitMilliSecs int, dryRun bool) (artifactory.ArtifactoryServicesManager, error) {
+	manager, err := utils.CreateDeleteServiceManager(serverDetails, threads, httpRetries, httpRetryWaitMilliSecs, dryRun)
+	return track(serverDetails, manager, err)
+}
+
+func CreateLifecycleServiceManager(serverDetails *config.ServerDetails, isDryRun bool) (*lifecycle.LifecycleServicesManager, error) {
+	manager, err := utils.CreateLifecycleServiceManager(serverDetails, isDryRun)
+	return track(serverDetails, manager, err)
+}
+
+func CreateAccessServiceManager(serverDetails *config.ServerDetails, isDryRun bool) (*access.AccessServicesManager, error) {
+	manager, err := utils.CreateAccessServiceManager(serverDetails, isDryRun)
+	return track(serverDetails, manager, err)
+}
+
+func CreateDistributionServiceManager(serverDetails *config.ServerDetails, isDryRun bool) (*distribution.DistributionServicesManager, error) {
+	manager, err := utils.CreateDistributionServiceManager(serverDetails, isDryRun)
+	return track(serverDetails, manager, err)
+}
+
+func CreateEvidenceServiceManager(serverDetails *config.ServerDetails, isDryRun bool) (*evidence.EvidenceServicesManager, error) {
+	manager, err := utils.CreateEvidenceServiceManager(serverDetails, isDryRun)
+	return track(serverDetails, manager, err)
+}
+
+func CreateJPDServiceManager(serverDetails *config.ServerDetails, isDryRun bool) (*jpd.JPDServicesManager, error) {
+	manager, err := utils.CreateJPDServiceManager(serverDetails, isDryRun)
+	return track(serverDetails, manager, err)
+}
+
+func CreateXrayServiceManager(serverDetails *config.ServerDetails) (*xray.XrayServicesManager, error) {
+	manager, err := xrayutils.CreateXrayServiceManager(serverDetails)
+	return track(serverDetails, manager, err)
+}
+
+// CreateOnemodelServiceManager returns the onemodel.Manager interface, so its client is configured if its
+// implementation exposes it.
+func CreateOnemodelServiceManager(serverDetails *config.ServerDetails, isDryRun bool) (onemodel.Manager, error) {
+	manager, err := utils.CreateOnemodelServiceManager(serverDetails, isDryRun)
+	if err != nil {
+		return nil, err
+	}
+	if withClient, ok := manager.(clientManager); ok {
+		return manager, ConfigureClient(withClient.Client(), serverDetails)
+	}
+	return manager, nil
+}
@@ -0,0 +1,47 @@
+package clientconfig
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/audit"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/offline"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
+	"github.com/jfrog/jfrog-client-go/http/jfroghttpclient"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigureClientDoesNotAuditReplayedRequests(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+	logPath := filepath.Join(t.TempDir(), "audit.log")
+	t.Setenv(audit.AuditLogEnv, logPath)
+	t.Setenv(offline.OfflineCacheEnv, t.TempDir())
+	t.Setenv(coreutils.HomeDir, t.TempDir())
+
+	for _, mode := range []string{offline.RecordMode, offline.ReplayMode} {
+		t.Setenv(offline.OfflineModeEnv, mode)
+		client, err := jfroghttpclient.JfrogClientBuilder().Build()
+		require.NoError(t, err)
+		require.NoError(t, ConfigureClient(client, &config.ServerDetails{Url: server.URL}))
+		req, err := http.NewRequest(http.MethodPut, server.URL+"/generic-local/a.txt", strings.NewReader("content"))
+		require.NoError(t, err)
+		resp, err := client.GetHttpClient().GetClient().Do(req)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusCreated, resp.StatusCode)
+		assert.NoError(t, resp.Body.Close())
+	}
+
+	// Only the recorded request was sent to the server.
+	content, err := os.ReadFile(logPath)
+	require.NoError(t, err)
+	assert.Len(t, strings.Split(strings.TrimSpace(string(content)), "\n"), 1)
+}
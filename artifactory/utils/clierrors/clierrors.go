@@ -0,0 +1,176 @@
+package clierrors
+
+import (
+	"errors"
+	"net"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+)
+
+// Code identifies the category of an error, for scripts and Go consumers which handle the categories differently.
+type Code string
+
+const (
+	AuthErrorCode     Code = "AUTH_ERROR"
+	NotFoundCode      Code = "NOT_FOUND"
+	ConflictCode      Code = "CONFLICT"
+	QuotaExceededCode Code = "QUOTA_EXCEEDED"
+	NetworkErrorCode  Code = "NETWORK_ERROR"
+)
+
+// CategorizedError is an error of a known category, which carries a hint for resolving it.
+// Use errors.As to find it in the chain of an error returned by a command.
+type CategorizedError interface {
+	error
+	Code() Code
+	Hint() string
+}
+
+// AuthError is returned when the server rejects the credentials (401) or the permissions (403) of the user.
+type AuthError struct {
+	StatusCode int
+	Err        error
+}
+
+func (err AuthError) Error() string { return err.Err.Error() }
+func (err AuthError) Unwrap() error { return err.Err }
+func (err AuthError) Code() Code    { return AuthErrorCode }
+
+func (err AuthError) Hint() string {
+	if err.StatusCode == http.StatusForbidden {
+		return "The user isn't permitted to perform the operation. Check the permissions of the user, or the scope of the access token."
+	}
+	return "Check the credentials of the server configuration by 'jf config show', and that the access token hasn't expired."
+}
+
+// NotFoundError is returned when a repository, a path or another resource doesn't exist (404).
+type NotFoundError struct {
+	Err error
+}
+
+func (err NotFoundError) Error() string { return err.Err.Error() }
+func (err NotFoundError) Unwrap() error { return err.Err }
+func (err NotFoundError) Code() Code    { return NotFoundCode }
+
+func (err NotFoundError) Hint() string {
+	return "Check that the repository, the path or the resource exists and is spelled correctly. Resources which the user isn't permitted to read are also not found."
+}
+
+// ConflictError is returned when the resource already exists, or was changed concurrently (409).
+type ConflictError struct {
+	Err error
+}
+
+func (err ConflictError) Error() string { return err.Err.Error() }
+func (err ConflictError) Unwrap() error { return err.Err }
+func (err ConflictError) Code() Code    { return ConflictCode }
+
+func (err ConflictError) Hint() string {
+	return "The resource already exists, or was changed by another request. Use a different name, or run the command again."
+}
+
+// QuotaExceededError is returned when the storage quota, or the upload size limit of the server, was exceeded
+// (413 or 507).
+type QuotaExceededError struct {
+	StatusCode int
+	Err        error
+}
+
+func (err QuotaExceededError) Error() string { return err.Err.Error() }
+func (err QuotaExceededError) Unwrap() error { return err.Err }
+func (err QuotaExceededError) Code() Code    { return QuotaExceededCode }
+
+func (err QuotaExceededError) Hint() string {
+	return "Free storage, or ask the administrator of Artifactory to raise the storage quota or the maximal upload size."
+}
+
+// NetworkError is returned when the server couldn't be reached.
+type NetworkError struct {
+	Err error
+}
+
+func (err NetworkError) Error() string { return err.Err.Error() }
+func (err NetworkError) Unwrap() error { return err.Err }
+func (err NetworkError) Code() Code    { return NetworkErrorCode }
+
+func (err NetworkError) Hint() string {
+	return "Check the URL of the server, the network connection, the proxy (HTTP_PROXY and HTTPS_PROXY) and the TLS configuration."
+}
+
+// The status of the errors generated by errorutils.GenerateResponseError, like "server response: 404 Not Found".
+var responseStatusRegexp = regexp.MustCompile(`server response: (\d{3})`)
+
+var networkErrorMessages = []string{"connection refused", "no such host", "i/o timeout", "connection reset by peer", "tls: ", "x509: ", "network is unreachable"}
+
+// CheckResponseStatusWithBody returns nil if the status of the response is one of the expected status codes, like
+// errorutils.CheckResponseStatusWithBody. Otherwise, it returns the same error, categorized by the status.
+func CheckResponseStatusWithBody(resp *http.Response, body []byte, expectedStatusCodes ...int) error {
+	if err := errorutils.CheckResponseStatusWithBody(resp, body, expectedStatusCodes...); err != nil {
+		return fromStatus(resp.StatusCode, err)
+	}
+	return nil
+}
+
+// Classify returns the error categorized by the status of the server response or the network failure it describes.
+// Errors which are already categorized, or which aren't of a known category, are returned as is.
+func Classify(err error) error {
+	var categorized CategorizedError
+	if err == nil || errors.As(err, &categorized) {
+		return err
+	}
+	if match := responseStatusRegexp.FindStringSubmatch(err.Error()); match != nil {
+		statusCode, _ := strconv.Atoi(match[1])
+		return fromStatus(statusCode, err)
+	}
+	if isNetworkError(err) {
+		return NetworkError{Err: err}
+	}
+	return err
+}
+
+func fromStatus(statusCode int, err error) error {
+	switch statusCode {
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return AuthError{StatusCode: statusCode, Err: err}
+	case http.StatusNotFound:
+		return NotFoundError{Err: err}
+	case http.StatusConflict:
+		return ConflictError{Err: err}
+	case http.StatusRequestEntityTooLarge, http.StatusInsufficientStorage:
+		return QuotaExceededError{StatusCode: statusCode, Err: err}
+	}
+	if strings.Contains(strings.ToLower(err.Error()), "quota") {
+		return QuotaExceededError{StatusCode: statusCode, Err: err}
+	}
+	return err
+}
+
+func isNetworkError(err error) bool {
+	var netErr net.Error
+	var urlErr *url.Error
+	if errors.As(err, &netErr) || errors.As(err, &urlErr) {
+		return true
+	}
+	// The errors of the services are often flattened to their messages.
+	message := err.Error()
+	for _, networkMessage := range networkErrorMessages {
+		if strings.Contains(message, networkMessage) {
+			return true
+		}
+	}
+	return false
+}
+
+// Render returns the message of the error, followed by the code and the hint of its category, if it has one.
+func Render(err error) string {
+	var categorized CategorizedError
+	if !errors.As(err, &categorized) {
+		return err.Error()
+	}
+	return err.Error() + "\nHint [" + string(categorized.Code()) + "]: " + categorized.Hint()
+}
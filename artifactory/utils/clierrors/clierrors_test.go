@@ -0,0 +1,69 @@
+package clierrors
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"testing"
+
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckResponseStatusWithBody(t *testing.T) {
+	assert.NoError(t, CheckResponseStatusWithBody(&http.Response{StatusCode: http.StatusCreated}, nil, http.StatusOK, http.StatusCreated))
+
+	err := CheckResponseStatusWithBody(&http.Response{StatusCode: http.StatusForbidden, Status: "403 Forbidden"}, []byte(`{"errors":[{"message":"Not permitted"}]}`), http.StatusOK)
+	var authError AuthError
+	assert.ErrorAs(t, err, &authError)
+	assert.Equal(t, http.StatusForbidden, authError.StatusCode)
+	assert.Contains(t, authError.Hint(), "isn't permitted")
+	assert.Contains(t, err.Error(), "server response: 403 Forbidden")
+
+	// Unknown statuses aren't categorized
+	err = CheckResponseStatusWithBody(&http.Response{StatusCode: http.StatusBadRequest, Status: "400 Bad Request"}, nil, http.StatusOK)
+	var categorized CategorizedError
+	assert.False(t, errors.As(err, &categorized))
+}
+
+func TestClassify(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected Code
+	}{
+		{"unauthorized", errorutils.GenerateResponseError("401 Unauthorized", ""), AuthErrorCode},
+		{"not found", fmt.Errorf("failed deleting: %w", errorutils.GenerateResponseError("404 Not Found", "")), NotFoundCode},
+		{"conflict", errorutils.GenerateResponseError("409 Conflict", "exists"), ConflictCode},
+		{"too large", errorutils.GenerateResponseError("413 Request Entity Too Large", ""), QuotaExceededCode},
+		{"quota", errorutils.GenerateResponseError("400 Bad Request", "Storage quota exceeded"), QuotaExceededCode},
+		{"dial", &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")}, NetworkErrorCode},
+		{"flattened", errors.New("Get \"https://acme.jfrog.io\": dial tcp: lookup acme.jfrog.io: no such host"), NetworkErrorCode},
+		{"categorized", NotFoundError{Err: errors.New("no such build")}, NotFoundCode},
+		{"other", errors.New("invalid spec"), ""},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := Classify(test.err)
+			assert.ErrorIs(t, err, test.err)
+			var categorized CategorizedError
+			if test.expected == "" {
+				assert.False(t, errors.As(err, &categorized))
+				return
+			}
+			if assert.ErrorAs(t, err, &categorized) {
+				assert.Equal(t, test.expected, categorized.Code())
+				assert.NotEmpty(t, categorized.Hint())
+			}
+		})
+	}
+	assert.NoError(t, Classify(nil))
+}
+
+func TestRender(t *testing.T) {
+	assert.Equal(t, "invalid spec", Render(errors.New("invalid spec")))
+	err := ConflictError{Err: errors.New("server response: 409 Conflict")}
+	assert.Equal(t, "server response: 409 Conflict\nHint [CONFLICT]: "+err.Hint(), Render(renderedError{err: err}.err))
+	assert.Equal(t, Render(err), renderedError{err: err}.Error())
+}
@@ -0,0 +1,35 @@
+package clierrors
+
+import (
+	"errors"
+
+	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+)
+
+// RenderCommands wraps the actions of the commands, so that the errors they return are categorized, rendered with the
+// code and the hint of their category, and exit with the exit code of their category.
+func RenderCommands(commands []components.Command) []components.Command {
+	for i := range commands {
+		action := commands[i].Action
+		if action == nil {
+			continue
+		}
+		commands[i].Action = func(c *components.Context) error {
+			err := WithCategoryExitCode(Classify(action(c)))
+			var categorized CategorizedError
+			if errors.As(err, &categorized) {
+				return renderedError{err: err}
+			}
+			return err
+		}
+	}
+	return commands
+}
+
+// Renders the hint of a categorized error in its message, and keeps it in the chain for errors.As.
+type renderedError struct {
+	err error
+}
+
+func (err renderedError) Error() string { return Render(err.err) }
+func (err renderedError) Unwrap() error { return err.err }
@@ -0,0 +1,107 @@
+package clierrors
+
+import (
+	"errors"
+	"os"
+	"strconv"
+
+	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
+)
+
+// The exit codes of the commands:
+//
+//	0 - success.
+//	1 - an error which isn't of a more specific category below.
+//	2 - nothing was matched or affected, with --fail-no-op.
+//	3 - the build was found vulnerable by the build scan.
+//	4 - a partial failure, where some files succeeded and others failed.
+//	5 - the server rejected the credentials or the permissions of the user.
+//	6 - the repository, the path or the resource wasn't found.
+//	7 - the resource already exists, or was changed concurrently.
+//	8 - the storage quota or the upload size limit was exceeded.
+//	9 - the server couldn't be reached.
+var (
+	ExitCodePartialFailure = coreutils.ExitCode{Code: 4}
+	ExitCodeAuthError      = coreutils.ExitCode{Code: 5}
+	ExitCodeNotFound       = coreutils.ExitCode{Code: 6}
+	ExitCodeConflict       = coreutils.ExitCode{Code: 7}
+	ExitCodeQuotaExceeded  = coreutils.ExitCode{Code: 8}
+	ExitCodeNetworkError   = coreutils.ExitCode{Code: 9}
+)
+
+// FailNoOpEnv enables --fail-no-op for all the commands which support it.
+const FailNoOpEnv = "JFROG_CLI_FAIL_NO_OP"
+
+var categoryExitCodes = map[Code]coreutils.ExitCode{
+	AuthErrorCode:     ExitCodeAuthError,
+	NotFoundCode:      ExitCodeNotFound,
+	ConflictCode:      ExitCodeConflict,
+	QuotaExceededCode: ExitCodeQuotaExceeded,
+	NetworkErrorCode:  ExitCodeNetworkError,
+}
+
+// IsFailNoOp returns true if the command should fail with exit code 2 when nothing is affected, by the --fail-no-op
+// option, or by the JFROG_CLI_FAIL_NO_OP environment variable when the option isn't set.
+func IsFailNoOp(c *components.Context) bool {
+	if c.IsFlagSet("fail-no-op") {
+		return c.GetBoolFlagValue("fail-no-op")
+	}
+	failNoOp, err := strconv.ParseBool(os.Getenv(FailNoOpEnv))
+	return err == nil && failNoOp
+}
+
+// GetCliError returns the error with the exit code of the outcome of a command which affected succeeded items and
+// failed to affect failed items, like common.GetCliError. Unlike it, a partial failure exits with
+// ExitCodePartialFailure, and the original error is kept in the chain of the returned error for errors.As.
+// Errors which already have an exit code other than ExitCodeError are returned as is.
+func GetCliError(err error, succeeded, failed int, failNoOp bool) error {
+	var cliError coreutils.CliError
+	if errors.As(err, &cliError) && cliError.ExitCode != coreutils.ExitCodeError {
+		return err
+	}
+	switch coreutils.GetExitCode(err, succeeded, failed, failNoOp) {
+	case coreutils.ExitCodeError:
+		exitCode := coreutils.ExitCodeError
+		if succeeded > 0 && failed > 0 {
+			exitCode = ExitCodePartialFailure
+		}
+		if err == nil {
+			err = errors.New("")
+		}
+		return withExitCode(err, exitCode)
+	case coreutils.ExitCodeFailNoOp:
+		return coreutils.CliError{ExitCode: coreutils.ExitCodeFailNoOp, ErrorMsg: "No errors, but also no files affected (fail-no-op flag)."}
+	default:
+		return nil
+	}
+}
+
+// WithCategoryExitCode returns the error with the exit code of its category, unless it already has an exit code
+// other than ExitCodeError.
+func WithCategoryExitCode(err error) error {
+	var categorized CategorizedError
+	if !errors.As(err, &categorized) {
+		return err
+	}
+	exitCode, ok := categoryExitCodes[categorized.Code()]
+	var cliError coreutils.CliError
+	if !ok || (errors.As(err, &cliError) && cliError.ExitCode != coreutils.ExitCodeError) {
+		return err
+	}
+	return withExitCode(err, exitCode)
+}
+
+func withExitCode(err error, exitCode coreutils.ExitCode) error {
+	return exitCodeError{cliError: coreutils.CliError{ExitCode: exitCode, ErrorMsg: err.Error()}, err: err}
+}
+
+// An error with an exit code, which is found by errors.As as a coreutils.CliError, and which keeps the original error in
+// its chain.
+type exitCodeError struct {
+	cliError coreutils.CliError
+	err      error
+}
+
+func (err exitCodeError) Error() string   { return err.err.Error() }
+func (err exitCodeError) Unwrap() []error { return []error{err.cliError, err.err} }
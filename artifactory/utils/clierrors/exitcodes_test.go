@@ -0,0 +1,74 @@
+package clierrors
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func getExitCode(err error) coreutils.ExitCode {
+	var cliError coreutils.CliError
+	if errors.As(err, &cliError) {
+		return cliError.ExitCode
+	}
+	return coreutils.ExitCodeNoError
+}
+
+func TestGetCliError(t *testing.T) {
+	notFoundErr := NotFoundError{Err: errors.New("server response: 404 Not Found")}
+	tests := []struct {
+		name      string
+		err       error
+		succeeded int
+		failed    int
+		failNoOp  bool
+		expected  coreutils.ExitCode
+	}{
+		{"success", nil, 3, 0, true, coreutils.ExitCodeNoError},
+		{"nothing affected", nil, 0, 0, false, coreutils.ExitCodeNoError},
+		{"fail no-op", nil, 0, 0, true, coreutils.ExitCodeFailNoOp},
+		{"failure", nil, 0, 2, false, coreutils.ExitCodeError},
+		{"partial failure", nil, 3, 2, false, ExitCodePartialFailure},
+		{"error", notFoundErr, 0, 0, true, coreutils.ExitCodeError},
+		{"specific exit code", coreutils.CliError{ExitCode: coreutils.ExitCodeFailNoOp}, 0, 0, false, coreutils.ExitCodeFailNoOp},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, getExitCode(GetCliError(test.err, test.succeeded, test.failed, test.failNoOp)))
+		})
+	}
+
+	// The original error is kept in the chain
+	err := GetCliError(notFoundErr, 0, 1, false)
+	assert.ErrorIs(t, err, notFoundErr)
+	assert.Equal(t, notFoundErr.Error(), err.Error())
+}
+
+func TestWithCategoryExitCode(t *testing.T) {
+	assert.Equal(t, ExitCodeAuthError, getExitCode(WithCategoryExitCode(Classify(errorutils.GenerateResponseError("401 Unauthorized", "")))))
+	assert.Equal(t, ExitCodeNetworkError, getExitCode(WithCategoryExitCode(NetworkError{Err: errors.New("connection refused")})))
+
+	// The category overrides the generic exit code, but not a specific one
+	failure := GetCliError(errorutils.GenerateResponseError("409 Conflict", ""), 0, 1, false)
+	assert.Equal(t, ExitCodeConflict, getExitCode(WithCategoryExitCode(Classify(failure))))
+	partialFailure := GetCliError(errorutils.GenerateResponseError("507 Insufficient Storage", ""), 2, 1, false)
+	assert.Equal(t, ExitCodePartialFailure, getExitCode(WithCategoryExitCode(Classify(partialFailure))))
+
+	uncategorized := errors.New("invalid spec")
+	assert.Equal(t, uncategorized, WithCategoryExitCode(uncategorized))
+}
+
+func TestIsFailNoOp(t *testing.T) {
+	c := &components.Context{}
+	assert.False(t, IsFailNoOp(c))
+	t.Setenv(FailNoOpEnv, "true")
+	assert.True(t, IsFailNoOp(c))
+
+	// The option overrides the environment
+	c.AddBoolFlag("fail-no-op", false)
+	assert.False(t, IsFailNoOp(c))
+}
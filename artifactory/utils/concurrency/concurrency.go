@@ -0,0 +1,202 @@
+package concurrency
+
+import (
+	"context"
+	"io"
+	"math"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/jfrog/jfrog-client-go/http/jfroghttpclient"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const (
+	// The limit is halved when the server throttles, and reduced by a tenth when the latency rises.
+	throttledBackoff = 0.5
+	latencyBackoff   = 0.9
+	// A latency of more than this many times the lowest latency seen indicates that the server is overloaded.
+	latencyTolerance = 3
+	// The limit is reduced at most once in this period, so that a burst of throttled responses is counted once.
+	decreaseCooldown = time.Second
+)
+
+// Limiter limits the number of in-flight requests to a limit between min and max, which adapts to the load of the
+// server. The limit starts at min, and is increased by one on every successful response until the server shows the
+// first sign of load. Then, it's increased by one per limit successful responses, which is about one per round trip.
+// Throttled responses (429 and 503) and failed requests halve the limit, and latencies which rise above
+// latencyTolerance times the lowest latency seen reduce it by a tenth.
+// A Limiter may be shared between goroutines.
+type Limiter struct {
+	min          int
+	max          int
+	limit        float64
+	inFlight     int
+	slowStart    bool
+	minLatency   time.Duration
+	lastDecrease time.Time
+	// Closed and replaced whenever a slot may have become available.
+	changed chan struct{}
+	mutex   sync.Mutex
+	// Used by tests to avoid waiting for real time to pass.
+	now func() time.Time
+}
+
+// NewLimiter returns a limiter of at least min and at most max in-flight requests. Values below 1 are treated as 1.
+func NewLimiter(min, max int) *Limiter {
+	min = int(math.Max(float64(min), 1))
+	max = int(math.Max(float64(max), float64(min)))
+	return &Limiter{min: min, max: max, limit: float64(min), slowStart: true, changed: make(chan struct{}), now: time.Now}
+}
+
+// Limit returns the current number of requests which may be in flight.
+func (l *Limiter) Limit() int {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	return int(l.limit)
+}
+
+// Acquire blocks until a request may be sent, or until the context is done.
+func (l *Limiter) Acquire(ctx context.Context) error {
+	for {
+		l.mutex.Lock()
+		if l.inFlight < int(l.limit) {
+			l.inFlight++
+			l.mutex.Unlock()
+			return nil
+		}
+		changed := l.changed
+		l.mutex.Unlock()
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// Release frees the slot of a request acquired by Acquire.
+func (l *Limiter) Release() {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	l.inFlight--
+	l.notify()
+}
+
+// Observe adjusts the limit by the outcome of a request. statusCode is 0 if the request failed without a response.
+// The latency is only compared for requests without a body, since the latency of uploads depends on their size.
+func (l *Limiter) Observe(statusCode int, latency time.Duration, hasBody bool) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	previous := int(l.limit)
+	switch {
+	case statusCode == 0 || statusCode == http.StatusTooManyRequests || statusCode == http.StatusServiceUnavailable:
+		l.decrease(throttledBackoff)
+	case hasBody || statusCode >= http.StatusInternalServerError:
+		// Neither a sign of load nor of capacity.
+	case l.minLatency == 0 || latency < l.minLatency:
+		l.minLatency = latency
+		l.increase()
+	case latency > l.minLatency*latencyTolerance:
+		l.decrease(latencyBackoff)
+	default:
+		l.increase()
+	}
+	if current := int(l.limit); current != previous {
+		log.Debug("Adjusted the number of concurrent requests from", previous, "to", current)
+		l.notify()
+	}
+}
+
+func (l *Limiter) increase() {
+	if l.slowStart {
+		l.limit++
+	} else {
+		l.limit += 1 / l.limit
+	}
+	l.limit = math.Min(l.limit, float64(l.max))
+}
+
+func (l *Limiter) decrease(factor float64) {
+	now := l.now()
+	if now.Sub(l.lastDecrease) < decreaseCooldown {
+		return
+	}
+	l.lastDecrease = now
+	l.slowStart = false
+	l.limit = math.Max(math.Floor(l.limit*factor), float64(l.min))
+}
+
+// Wakes up the requests waiting for a slot. Must be called while holding the lock.
+func (l *Limiter) notify() {
+	close(l.changed)
+	l.changed = make(chan struct{})
+}
+
+// Attach wraps the transport of the client, so that the number of its in-flight requests is limited by the limiter.
+func Attach(client *jfroghttpclient.JfrogHttpClient, limiter *Limiter) {
+	if client == nil || client.GetHttpClient() == nil {
+		return
+	}
+	AttachToHttpClient(client.GetHttpClient().GetClient(), limiter)
+}
+
+// AttachToHttpClient wraps the transport of the http client like Attach. It does nothing if the limiter is nil.
+func AttachToHttpClient(httpClient *http.Client, limiter *Limiter) {
+	if limiter == nil || httpClient == nil {
+		return
+	}
+	if _, attached := httpClient.Transport.(*transport); attached {
+		return
+	}
+	next := httpClient.Transport
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	httpClient.Transport = &transport{next: next, limiter: limiter}
+}
+
+type transport struct {
+	next    http.RoundTripper
+	limiter *Limiter
+}
+
+// RoundTrip holds a slot of the limiter until the body of the response is closed, so that downloads occupy their slot
+// while they're streamed.
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := t.limiter.Acquire(req.Context()); err != nil {
+		return nil, err
+	}
+	start := t.limiter.now()
+	resp, err := t.next.RoundTrip(req)
+	latency := t.limiter.now().Sub(start)
+	hasBody := req.ContentLength > 0 || (req.Body != nil && req.Body != http.NoBody)
+	if err != nil || resp == nil {
+		if req.Context().Err() == nil {
+			t.limiter.Observe(0, latency, hasBody)
+		}
+		t.limiter.Release()
+		return resp, err
+	}
+	t.limiter.Observe(resp.StatusCode, latency, hasBody)
+	if resp.Body == nil {
+		t.limiter.Release()
+		return resp, nil
+	}
+	resp.Body = &releasingBody{ReadCloser: resp.Body, release: t.limiter.Release}
+	return resp, nil
+}
+
+// Releases the slot of the request once its body is closed.
+type releasingBody struct {
+	io.ReadCloser
+	release func()
+	once    sync.Once
+}
+
+func (body *releasingBody) Close() error {
+	err := body.ReadCloser.Close()
+	body.once.Do(body.release)
+	return err
+}
@@ -0,0 +1,128 @@
+package concurrency
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestLimiter(min, max int) (*Limiter, *time.Time) {
+	now := time.Now()
+	limiter := NewLimiter(min, max)
+	limiter.now = func() time.Time { return now }
+	return limiter, &now
+}
+
+func TestLimiterObserve(t *testing.T) {
+	limiter, now := newTestLimiter(2, 10)
+	assert.Equal(t, 2, limiter.Limit())
+
+	// The limit grows by one per response until the first sign of load, up to the maximum.
+	for i := 0; i < 20; i++ {
+		limiter.Observe(http.StatusOK, 10*time.Millisecond, false)
+	}
+	assert.Equal(t, 10, limiter.Limit())
+
+	// A throttled response halves the limit, and the ones which follow it shortly are counted once.
+	limiter.Observe(http.StatusTooManyRequests, time.Millisecond, false)
+	limiter.Observe(http.StatusServiceUnavailable, time.Millisecond, false)
+	assert.Equal(t, 5, limiter.Limit())
+
+	// After the slow start, the limit grows by about one per limit responses.
+	for i := 0; i < 6; i++ {
+		limiter.Observe(http.StatusOK, 10*time.Millisecond, false)
+	}
+	assert.Equal(t, 6, limiter.Limit())
+
+	// High latencies reduce the limit by a tenth, unless they're of requests with a body.
+	*now = now.Add(decreaseCooldown)
+	limiter.Observe(http.StatusOK, time.Second, true)
+	assert.Equal(t, 6, limiter.Limit())
+	limiter.Observe(http.StatusOK, time.Second, false)
+	assert.Equal(t, 5, limiter.Limit())
+
+	// The limit doesn't drop below the minimum.
+	for i := 0; i < 5; i++ {
+		*now = now.Add(decreaseCooldown)
+		limiter.Observe(0, 0, false)
+	}
+	assert.Equal(t, 2, limiter.Limit())
+}
+
+func TestLimiterAcquire(t *testing.T) {
+	limiter, _ := newTestLimiter(1, 2)
+	require.NoError(t, limiter.Acquire(context.Background()))
+
+	// The second request waits until the first is released.
+	acquired := make(chan error)
+	go func() {
+		acquired <- limiter.Acquire(context.Background())
+	}()
+	select {
+	case <-acquired:
+		t.Fatal("a request was sent above the limit")
+	case <-time.After(50 * time.Millisecond):
+	}
+	limiter.Release()
+	assert.NoError(t, <-acquired)
+
+	// A canceled request stops waiting.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.ErrorIs(t, limiter.Acquire(ctx), context.Canceled)
+}
+
+func TestTransport(t *testing.T) {
+	var inFlight, maxInFlight, requests int32
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		current := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			observed := atomic.LoadInt32(&maxInFlight)
+			if current <= observed || atomic.CompareAndSwapInt32(&maxInFlight, observed, current) {
+				break
+			}
+		}
+		if atomic.AddInt32(&requests, 1) == 1 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		time.Sleep(5 * time.Millisecond)
+		_, err := w.Write([]byte("content"))
+		assert.NoError(t, err)
+	}))
+	defer testServer.Close()
+
+	limiter := NewLimiter(1, 3)
+	httpClient := &http.Client{}
+	AttachToHttpClient(httpClient, limiter)
+	AttachToHttpClient(httpClient, limiter)
+	_, doubleWrapped := httpClient.Transport.(*transport).next.(*transport)
+	assert.False(t, doubleWrapped)
+
+	done := make(chan struct{})
+	for i := 0; i < 10; i++ {
+		go func() {
+			defer func() { done <- struct{}{} }()
+			resp, err := httpClient.Get(testServer.URL)
+			if !assert.NoError(t, err) {
+				return
+			}
+			_, err = io.ReadAll(resp.Body)
+			assert.NoError(t, err)
+			assert.NoError(t, resp.Body.Close())
+		}()
+	}
+	for i := 0; i < 10; i++ {
+		<-done
+	}
+	assert.LessOrEqual(t, atomic.LoadInt32(&maxInFlight), int32(3))
+	assert.Equal(t, 0, limiter.inFlight)
+}
@@ -0,0 +1,63 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"gopkg.in/yaml.v3"
+)
+
+// ReadConfigFile reads a YAML or JSON file into the target. The fields of the file are matched to the JSON tags of the
+// target, so that the files keep the field names of the REST APIs. Unknown fields are rejected.
+func ReadConfigFile(configPath string, target any) error {
+	content, err := os.ReadFile(configPath)
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	// YAML is a superset of JSON, so JSON files are parsed as well.
+	var parsed any
+	if err = yaml.Unmarshal(content, &parsed); err != nil {
+		return errorutils.CheckErrorf("failed to parse '%s': %s", configPath, err.Error())
+	}
+	if content, err = json.Marshal(parsed); err != nil {
+		return errorutils.CheckErrorf("failed to parse '%s': %s", configPath, err.Error())
+	}
+	decoder := json.NewDecoder(bytes.NewReader(content))
+	decoder.DisallowUnknownFields()
+	if err = decoder.Decode(target); err != nil {
+		return errorutils.CheckErrorf("failed to parse '%s': %s", configPath, err.Error())
+	}
+	return nil
+}
+
+// WriteConfigFile writes the value to a YAML file, or to a JSON file if the path has a .json extension. The fields are
+// named by the JSON tags of the value.
+func WriteConfigFile(configPath string, value any) error {
+	content, err := json.Marshal(value)
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	if strings.EqualFold(filepath.Ext(configPath), ".json") {
+		var indented bytes.Buffer
+		if err = json.Indent(&indented, content, "", "  "); err != nil {
+			return errorutils.CheckError(err)
+		}
+		content = indented.Bytes()
+	} else {
+		var generic any
+		if err = json.Unmarshal(content, &generic); err != nil {
+			return errorutils.CheckError(err)
+		}
+		if content, err = yaml.Marshal(generic); err != nil {
+			return errorutils.CheckError(err)
+		}
+	}
+	if err = os.MkdirAll(filepath.Dir(configPath), 0755); err != nil {
+		return errorutils.CheckError(err)
+	}
+	return errorutils.CheckError(os.WriteFile(configPath, content, 0644))
+}
@@ -0,0 +1,50 @@
+package utils
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testConfig struct {
+	Name  string   `json:"name"`
+	Repos []string `json:"repos,omitempty"`
+	Quota float64  `json:"quota,omitempty"`
+}
+
+func TestWriteAndReadConfigFile(t *testing.T) {
+	value := []testConfig{{Name: "acme", Repos: []string{"acme-local"}, Quota: 1024}, {Name: "other"}}
+	for _, fileName := range []string{"config.yaml", "config.json"} {
+		t.Run(fileName, func(t *testing.T) {
+			configPath := filepath.Join(t.TempDir(), "nested", fileName)
+			require.NoError(t, WriteConfigFile(configPath, value))
+			var read []testConfig
+			require.NoError(t, ReadConfigFile(configPath, &read))
+			assert.Equal(t, value, read)
+		})
+	}
+}
+
+func TestWriteConfigFileFormat(t *testing.T) {
+	configDir := t.TempDir()
+	value := testConfig{Name: "acme"}
+	require.NoError(t, WriteConfigFile(filepath.Join(configDir, "config.yaml"), value))
+	content, err := os.ReadFile(filepath.Join(configDir, "config.yaml"))
+	require.NoError(t, err)
+	assert.Equal(t, "name: acme\n", string(content))
+
+	require.NoError(t, WriteConfigFile(filepath.Join(configDir, "config.JSON"), value))
+	content, err = os.ReadFile(filepath.Join(configDir, "config.JSON"))
+	require.NoError(t, err)
+	assert.Equal(t, "{\n  \"name\": \"acme\"\n}", string(content))
+}
+
+func TestReadConfigFileUnknownField(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "config.yml")
+	require.NoError(t, os.WriteFile(configPath, []byte("name: acme\nrepo: acme-local\n"), 0644))
+	var read testConfig
+	assert.ErrorContains(t, ReadConfigFile(configPath, &read), `unknown field "repo"`)
+}
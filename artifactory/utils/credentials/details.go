@@ -0,0 +1,43 @@
+package credentials
+
+import (
+	"github.com/jfrog/jfrog-cli-core/v2/common/cliutils"
+	"github.com/jfrog/jfrog-cli-core/v2/plugins/common"
+	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+)
+
+// The functions below resolve the server details like their namesakes in jfrog-cli-core, and set the secrets of the
+// server from the selected credential provider.
+
+func CreateArtifactoryDetailsByFlags(c *components.Context) (*config.ServerDetails, error) {
+	serverDetails, err := common.CreateArtifactoryDetailsByFlags(c)
+	if err != nil {
+		return nil, err
+	}
+	return serverDetails, Resolve(serverDetails)
+}
+
+func CreateServerDetailsWithConfigOffer(c *components.Context, excludeRefreshableTokens bool, domain cliutils.CommandDomain) (*config.ServerDetails, error) {
+	serverDetails, err := common.CreateServerDetailsWithConfigOffer(c, excludeRefreshableTokens, domain)
+	if err != nil {
+		return nil, err
+	}
+	return serverDetails, Resolve(serverDetails)
+}
+
+func GetSpecificConfig(serverId string, defaultOrEmpty, excludeRefreshableTokens bool) (*config.ServerDetails, error) {
+	serverDetails, err := config.GetSpecificConfig(serverId, defaultOrEmpty, excludeRefreshableTokens)
+	if err != nil {
+		return nil, err
+	}
+	return serverDetails, Resolve(serverDetails)
+}
+
+func GetDefaultServerConf() (*config.ServerDetails, error) {
+	serverDetails, err := config.GetDefaultServerConf()
+	if err != nil {
+		return nil, err
+	}
+	return serverDetails, Resolve(serverDetails)
+}
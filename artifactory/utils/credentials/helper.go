@@ -0,0 +1,59 @@
+package credentials
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+)
+
+const (
+	// The Docker credential helpers return this username with an identity token, which is used as an access token.
+	identityTokenUsername = "<token>"
+	// The Docker credential helpers fail with this message if they have no credentials of the server.
+	credentialsNotFoundMessage = "credentials not found"
+)
+
+// helperResponse is the output of the get command of a credential helper.
+type helperResponse struct {
+	ServerURL string `json:"ServerURL"`
+	Username  string `json:"Username"`
+	Secret    string `json:"Secret"`
+}
+
+// helperProvider gets the credentials from an executable which implements the protocol of the Docker credential
+// helpers: the executable is run with the 'get' command, reads the URL of the server from its standard input, and
+// prints the username and the secret as json.
+type helperProvider struct {
+	executable string
+}
+
+func (hp *helperProvider) Name() string {
+	return HelperProvider
+}
+
+func (hp *helperProvider) GetCredentials(serverDetails *config.ServerDetails) (*Credentials, error) {
+	serverUrl := serverDetails.Url
+	if serverUrl == "" {
+		serverUrl = serverDetails.ArtifactoryUrl
+	}
+	output, err := runCommand(serverUrl, hp.executable, "get")
+	if err != nil {
+		if strings.Contains(strings.ToLower(err.Error()), credentialsNotFoundMessage) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	response := helperResponse{}
+	if err = json.Unmarshal(output, &response); err != nil {
+		return nil, errorutils.CheckErrorf("failed to parse the output of the credential helper %s: %s", hp.executable, err.Error())
+	}
+	if response.Secret == "" {
+		return nil, nil
+	}
+	if response.Username == "" || response.Username == identityTokenUsername {
+		return &Credentials{AccessToken: response.Secret}, nil
+	}
+	return &Credentials{User: response.Username, Password: response.Secret}, nil
+}
@@ -0,0 +1,80 @@
+package credentials
+
+import (
+	"errors"
+	"fmt"
+	"runtime"
+
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const (
+	// The service under which the credentials are stored in the keychain. The account is the ID of the server.
+	keychainService = "jfrog-cli"
+	// The exit code of security if the keychain has no such item.
+	securityItemNotFoundExitCode = 44
+)
+
+// keychainProvider reads the credentials from the keychain of the OS: the macOS Keychain, the Windows Credential
+// Manager or the Secret Service of libsecret.
+//
+// The credentials are stored, for example, by:
+// macOS: security add-generic-password -s jfrog-cli -a <server ID> -w <secret>
+// Windows: cmdkey /generic:jfrog-cli:<server ID> /user:<user> /pass:<secret>
+// Linux: secret-tool store --label=jfrog-cli service jfrog-cli account <server ID>
+type keychainProvider struct{}
+
+func (kp *keychainProvider) Name() string {
+	return KeychainProvider
+}
+
+func (kp *keychainProvider) GetCredentials(serverDetails *config.ServerDetails) (*Credentials, error) {
+	account := getAccount(serverDetails)
+	var secret string
+	var err error
+	switch runtime.GOOS {
+	case "darwin":
+		secret, err = readCommandSecret(runCommand("", "security", "find-generic-password", "-s", keychainService, "-a", account, "-w"))
+	case "windows":
+		secret, err = readWindowsCredential(keychainService + ":" + account)
+	case "linux":
+		secret, err = readCommandSecret(runCommand("", "secret-tool", "lookup", "service", keychainService, "account", account))
+	default:
+		return nil, errorutils.CheckErrorf("the %s credential provider isn't supported on %s", KeychainProvider, runtime.GOOS)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return parseSecret(secret, serverDetails)
+}
+
+// readCommandSecret returns the secret which a keychain command printed, or an empty string if the keychain has no such
+// secret. Other failures, like a locked keychain or a missing Secret Service, are returned.
+func readCommandSecret(output []byte, err error) (string, error) {
+	if err == nil {
+		return string(output), nil
+	}
+	if isItemNotFound(err) {
+		log.Debug("The keychain has no credentials:", err.Error())
+		return "", nil
+	}
+	return "", fmt.Errorf("failed to read the credentials from the keychain: %w", err)
+}
+
+// isItemNotFound returns true if the keychain command failed since the keychain has no such secret. security exits
+// with errSecItemNotFound (44), and secret-tool exits with 1 without an error message.
+func isItemNotFound(err error) bool {
+	var cmdErr *commandError
+	if !errors.As(err, &cmdErr) {
+		return false
+	}
+	switch cmdErr.name {
+	case "security":
+		return cmdErr.exitCode == securityItemNotFoundExitCode
+	case "secret-tool":
+		return cmdErr.exitCode == 1 && cmdErr.stderr == ""
+	}
+	return false
+}
@@ -0,0 +1,9 @@
+//go:build !windows
+
+package credentials
+
+import "github.com/jfrog/jfrog-client-go/utils/errorutils"
+
+func readWindowsCredential(string) (string, error) {
+	return "", errorutils.CheckErrorf("the Windows Credential Manager is available on Windows only")
+}
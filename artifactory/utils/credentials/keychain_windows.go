@@ -0,0 +1,72 @@
+package credentials
+
+import (
+	"errors"
+	"syscall"
+	"unicode/utf16"
+	"unsafe"
+
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+)
+
+const (
+	credTypeGeneric = 1
+	errorNotFound   = syscall.Errno(1168)
+)
+
+var (
+	advapi32      = syscall.NewLazyDLL("advapi32.dll")
+	procCredReadW = advapi32.NewProc("CredReadW")
+	procCredFree  = advapi32.NewProc("CredFree")
+)
+
+// credential is the CREDENTIALW struct of the Windows Credential Manager.
+type credential struct {
+	Flags              uint32
+	Type               uint32
+	TargetName         *uint16
+	Comment            *uint16
+	LastWritten        syscall.Filetime
+	CredentialBlobSize uint32
+	CredentialBlob     *byte
+	Persist            uint32
+	AttributeCount     uint32
+	Attributes         uintptr
+	TargetAlias        *uint16
+	UserName           *uint16
+}
+
+// readWindowsCredential returns the secret of the generic credential with the target name, or an empty string if the
+// Credential Manager has no such credential.
+func readWindowsCredential(targetName string) (string, error) {
+	target, err := syscall.UTF16PtrFromString(targetName)
+	if err != nil {
+		return "", errorutils.CheckError(err)
+	}
+	var cred *credential
+	result, _, err := procCredReadW.Call(uintptr(unsafe.Pointer(target)), credTypeGeneric, 0, uintptr(unsafe.Pointer(&cred)))
+	if result == 0 {
+		if errors.Is(err, errorNotFound) {
+			return "", nil
+		}
+		return "", errorutils.CheckErrorf("failed to read the credential %s from the Windows Credential Manager: %s", targetName, err.Error())
+	}
+	defer func() {
+		_, _, _ = procCredFree.Call(uintptr(unsafe.Pointer(cred)))
+	}()
+	blob := unsafe.Slice(cred.CredentialBlob, cred.CredentialBlobSize)
+	return decodeCredentialBlob(blob), nil
+}
+
+// decodeCredentialBlob decodes the secret, which is stored as UTF-16 by cmdkey and the Credential Manager, or as bytes
+// by other tools.
+func decodeCredentialBlob(blob []byte) string {
+	if len(blob)%2 != 0 || len(blob) == 0 || blob[1] != 0 {
+		return string(blob)
+	}
+	encoded := make([]uint16, len(blob)/2)
+	for i := range encoded {
+		encoded[i] = uint16(blob[2*i]) | uint16(blob[2*i+1])<<8
+	}
+	return string(utf16.Decode(encoded))
+}
@@ -0,0 +1,163 @@
+package credentials
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const (
+	// CredentialProviderEnv selects the provider of the secrets of the servers, which aren't configured in plaintext.
+	// Its value is the name of the provider, optionally followed by a colon and an argument: 'keychain',
+	// 'helper:<executable>', 'aws:<secret id>' or 'gcp:<secret name>'. {serverId} in the argument is replaced by the ID
+	// of the server.
+	CredentialProviderEnv = "JFROG_CLI_CREDENTIAL_PROVIDER"
+
+	KeychainProvider = "keychain"
+	HelperProvider   = "helper"
+	AwsProvider      = "aws"
+	GcpProvider      = "gcp"
+
+	serverIdPlaceholder = "{serverId}"
+)
+
+// Credentials are the secrets of a server, which a provider returns.
+type Credentials struct {
+	User        string `json:"user,omitempty"`
+	Password    string `json:"password,omitempty"`
+	AccessToken string `json:"accessToken,omitempty"`
+}
+
+// Provider returns the credentials of a server from a store outside the configuration of JFrog CLI. It returns nil if
+// the store has no credentials of the server.
+type Provider interface {
+	Name() string
+	GetCredentials(serverDetails *config.ServerDetails) (*Credentials, error)
+}
+
+// runCommand runs an executable which reads the credentials from a store, and returns its output. Tests replace it.
+var runCommand = func(stdin string, name string, args ...string) ([]byte, error) {
+	cmd := exec.Command(name, args...)
+	if stdin != "" {
+		cmd.Stdin = strings.NewReader(stdin)
+	}
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	output, err := cmd.Output()
+	if err != nil {
+		cmdErr := &commandError{name: name, err: err, stderr: strings.TrimSpace(stderr.String()), exitCode: -1}
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			cmdErr.exitCode = exitErr.ExitCode()
+		}
+		return nil, errorutils.CheckError(cmdErr)
+	}
+	return output, nil
+}
+
+// commandError is the error of a command run by a provider. Its exit code is -1 if the command couldn't be run.
+type commandError struct {
+	name     string
+	err      error
+	stderr   string
+	exitCode int
+}
+
+func (ce *commandError) Error() string {
+	return fmt.Sprintf("%s failed: %s %s", ce.name, ce.err.Error(), ce.stderr)
+}
+
+func (ce *commandError) Unwrap() error {
+	return ce.err
+}
+
+// GetProvider returns the provider selected by JFROG_CLI_CREDENTIAL_PROVIDER, or nil if none is selected.
+func GetProvider() (Provider, error) {
+	providerSpec := strings.TrimSpace(os.Getenv(CredentialProviderEnv))
+	if providerSpec == "" {
+		return nil, nil
+	}
+	name, argument, _ := strings.Cut(providerSpec, ":")
+	switch name {
+	case KeychainProvider:
+		return &keychainProvider{}, nil
+	case HelperProvider:
+		if argument == "" {
+			return nil, errorutils.CheckErrorf("the %s credential provider requires the executable of the helper, as in '%s:<executable>'", HelperProvider, HelperProvider)
+		}
+		return &helperProvider{executable: argument}, nil
+	case AwsProvider, GcpProvider:
+		if argument == "" {
+			return nil, errorutils.CheckErrorf("the %s credential provider requires the ID of the secret, as in '%s:<secret id>'", name, name)
+		}
+		return &secretManagerProvider{cloud: name, secretId: argument}, nil
+	}
+	return nil, errorutils.CheckErrorf("unknown credential provider '%s'. The supported providers are %s, %s, %s and %s", name, KeychainProvider, HelperProvider, AwsProvider, GcpProvider)
+}
+
+// Resolve sets the secrets of the server from the selected provider, if the server has neither a password nor an
+// access token. The secrets which are configured or passed as flags take precedence over the provider.
+func Resolve(serverDetails *config.ServerDetails) error {
+	if serverDetails == nil || serverDetails.Password != "" || serverDetails.AccessToken != "" || serverDetails.SshKeyPath != "" {
+		return nil
+	}
+	provider, err := GetProvider()
+	if err != nil || provider == nil {
+		return err
+	}
+	credentials, err := provider.GetCredentials(serverDetails)
+	if err != nil {
+		return err
+	}
+	if credentials == nil {
+		log.Debug("The", provider.Name(), "credential provider has no credentials of the server", getAccount(serverDetails))
+		return nil
+	}
+	log.Debug("Using the credentials of the server", getAccount(serverDetails), "from the", provider.Name(), "credential provider")
+	if serverDetails.User == "" {
+		serverDetails.User = credentials.User
+	}
+	serverDetails.Password = credentials.Password
+	serverDetails.AccessToken = credentials.AccessToken
+	return nil
+}
+
+// getAccount returns the name under which the credentials of the server are stored: its ID, or its URL if it isn't
+// configured.
+func getAccount(serverDetails *config.ServerDetails) string {
+	if serverDetails.ServerId != "" {
+		return serverDetails.ServerId
+	}
+	if serverDetails.Url != "" {
+		return serverDetails.Url
+	}
+	return serverDetails.ArtifactoryUrl
+}
+
+// parseSecret parses a stored secret, which is either a json object with the user, the password and the access token,
+// or a single secret. A single secret is the password of the configured user, or an access token if there's no user.
+func parseSecret(secret string, serverDetails *config.ServerDetails) (*Credentials, error) {
+	secret = strings.TrimSpace(secret)
+	if secret == "" {
+		return nil, nil
+	}
+	if strings.HasPrefix(secret, "{") {
+		credentials := &Credentials{}
+		if err := json.Unmarshal([]byte(secret), credentials); err != nil {
+			return nil, errorutils.CheckErrorf("failed to parse the stored credentials: %s", err.Error())
+		}
+		return credentials, nil
+	}
+	if serverDetails.User != "" {
+		return &Credentials{Password: secret}, nil
+	}
+	return &Credentials{AccessToken: secret}, nil
+}
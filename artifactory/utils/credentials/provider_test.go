@@ -0,0 +1,145 @@
+package credentials
+
+import (
+	"errors"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type executedCommand struct {
+	stdin string
+	args  []string
+}
+
+func mockRunCommand(t *testing.T, output string, err error) *[]executedCommand {
+	var executed []executedCommand
+	originalRunCommand := runCommand
+	runCommand = func(stdin string, name string, args ...string) ([]byte, error) {
+		executed = append(executed, executedCommand{stdin: stdin, args: append([]string{name}, args...)})
+		return []byte(output), err
+	}
+	t.Cleanup(func() {
+		runCommand = originalRunCommand
+	})
+	return &executed
+}
+
+func TestGetProvider(t *testing.T) {
+	tests := []struct {
+		providerSpec string
+		expected     Provider
+		expectError  bool
+	}{
+		{"", nil, false},
+		{"keychain", &keychainProvider{}, false},
+		{"helper:docker-credential-jfrog", &helperProvider{executable: "docker-credential-jfrog"}, false},
+		{"aws:jfrog/{serverId}", &secretManagerProvider{cloud: AwsProvider, secretId: "jfrog/{serverId}"}, false},
+		{"gcp:jfrog-token", &secretManagerProvider{cloud: GcpProvider, secretId: "jfrog-token"}, false},
+		{"helper", nil, true},
+		{"aws", nil, true},
+		{"vault:secret", nil, true},
+	}
+	for _, test := range tests {
+		t.Run(test.providerSpec, func(t *testing.T) {
+			t.Setenv(CredentialProviderEnv, test.providerSpec)
+			provider, err := GetProvider()
+			if test.expectError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, provider)
+		})
+	}
+}
+
+func TestResolveWithHelper(t *testing.T) {
+	t.Setenv(CredentialProviderEnv, "helper:docker-credential-jfrog")
+	executed := mockRunCommand(t, `{"ServerURL":"https://acme.jfrog.io/","Username":"admin","Secret":"password"}`, nil)
+
+	serverDetails := &config.ServerDetails{ServerId: "acme", Url: "https://acme.jfrog.io/"}
+	require.NoError(t, Resolve(serverDetails))
+	assert.Equal(t, "admin", serverDetails.User)
+	assert.Equal(t, "password", serverDetails.Password)
+	assert.Equal(t, []executedCommand{{stdin: "https://acme.jfrog.io/", args: []string{"docker-credential-jfrog", "get"}}}, *executed)
+
+	// Configured secrets take precedence over the provider.
+	serverDetails = &config.ServerDetails{ServerId: "acme", Url: "https://acme.jfrog.io/", AccessToken: "token"}
+	require.NoError(t, Resolve(serverDetails))
+	assert.Empty(t, serverDetails.Password)
+	assert.Len(t, *executed, 1)
+}
+
+func TestHelperIdentityToken(t *testing.T) {
+	mockRunCommand(t, `{"ServerURL":"https://acme.jfrog.io/","Username":"<token>","Secret":"token"}`, nil)
+	credentials, err := (&helperProvider{executable: "helper"}).GetCredentials(&config.ServerDetails{Url: "https://acme.jfrog.io/"})
+	require.NoError(t, err)
+	assert.Equal(t, &Credentials{AccessToken: "token"}, credentials)
+}
+
+func TestHelperCredentialsNotFound(t *testing.T) {
+	mockRunCommand(t, "", errors.New("helper failed: exit status 1 credentials not found in native keychain"))
+	credentials, err := (&helperProvider{executable: "helper"}).GetCredentials(&config.ServerDetails{Url: "https://acme.jfrog.io/"})
+	assert.NoError(t, err)
+	assert.Nil(t, credentials)
+}
+
+func TestSecretManagerProvider(t *testing.T) {
+	executed := mockRunCommand(t, `{"user":"deployer","accessToken":"token"}`+"\n", nil)
+	credentials, err := (&secretManagerProvider{cloud: AwsProvider, secretId: "jfrog/{serverId}"}).GetCredentials(&config.ServerDetails{ServerId: "acme"})
+	require.NoError(t, err)
+	assert.Equal(t, &Credentials{User: "deployer", AccessToken: "token"}, credentials)
+	assert.Equal(t, "aws secretsmanager get-secret-value --secret-id jfrog/acme --query SecretString --output text", strings.Join((*executed)[0].args, " "))
+
+	executed = mockRunCommand(t, "password\n", nil)
+	credentials, err = (&secretManagerProvider{cloud: GcpProvider, secretId: "jfrog-password"}).GetCredentials(&config.ServerDetails{ServerId: "acme", User: "admin"})
+	require.NoError(t, err)
+	assert.Equal(t, &Credentials{Password: "password"}, credentials)
+	assert.Equal(t, "gcloud secrets versions access latest --secret jfrog-password", strings.Join((*executed)[0].args, " "))
+}
+
+func TestKeychainProvider(t *testing.T) {
+	if runtime.GOOS != "linux" {
+		t.Skip("The keychain commands are mocked on Linux only.")
+	}
+	executed := mockRunCommand(t, "token", nil)
+	credentials, err := (&keychainProvider{}).GetCredentials(&config.ServerDetails{ServerId: "acme"})
+	require.NoError(t, err)
+	assert.Equal(t, &Credentials{AccessToken: "token"}, credentials)
+	assert.Equal(t, "secret-tool lookup service jfrog-cli account acme", strings.Join((*executed)[0].args, " "))
+
+	// The lookup fails if the keychain has no credentials of the server.
+	mockRunCommand(t, "", &commandError{name: "secret-tool", err: errors.New("exit status 1"), exitCode: 1})
+	credentials, err = (&keychainProvider{}).GetCredentials(&config.ServerDetails{ServerId: "acme"})
+	assert.NoError(t, err)
+	assert.Nil(t, credentials)
+
+	// Other failures of the keychain are returned.
+	mockRunCommand(t, "", &commandError{name: "secret-tool", err: errors.New("exit status 1"), stderr: "Cannot autolaunch D-Bus without X11 $DISPLAY", exitCode: 1})
+	_, err = (&keychainProvider{}).GetCredentials(&config.ServerDetails{ServerId: "acme"})
+	assert.ErrorContains(t, err, "Cannot autolaunch D-Bus")
+}
+
+func TestReadCommandSecret(t *testing.T) {
+	secret, err := readCommandSecret(nil, &commandError{name: "security", err: errors.New("exit status 44"), exitCode: securityItemNotFoundExitCode})
+	assert.NoError(t, err)
+	assert.Empty(t, secret)
+	for _, failure := range []error{
+		&commandError{name: "security", err: errors.New("exit status 36"), stderr: "User interaction is not allowed.", exitCode: 36},
+		&commandError{name: "secret-tool", err: errors.New("executable file not found in $PATH"), exitCode: -1},
+		errors.New("signal: killed"),
+	} {
+		_, err = readCommandSecret(nil, failure)
+		assert.ErrorIs(t, err, failure)
+	}
+}
+
+func TestParseSecretInvalidJson(t *testing.T) {
+	_, err := parseSecret("{invalid", &config.ServerDetails{})
+	assert.Error(t, err)
+}
@@ -0,0 +1,34 @@
+package credentials
+
+import (
+	"strings"
+
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+)
+
+// secretManagerProvider reads the credentials from a secret of the AWS Secrets Manager or the GCP Secret Manager, by
+// the CLI of the cloud, which authenticates by its own configuration. The secret is either a json object with the
+// user, the password and the access token, or a single secret.
+type secretManagerProvider struct {
+	cloud    string
+	secretId string
+}
+
+func (smp *secretManagerProvider) Name() string {
+	return smp.cloud
+}
+
+func (smp *secretManagerProvider) GetCredentials(serverDetails *config.ServerDetails) (*Credentials, error) {
+	secretId := strings.ReplaceAll(smp.secretId, serverIdPlaceholder, serverDetails.ServerId)
+	var output []byte
+	var err error
+	if smp.cloud == AwsProvider {
+		output, err = runCommand("", "aws", "secretsmanager", "get-secret-value", "--secret-id", secretId, "--query", "SecretString", "--output", "text")
+	} else {
+		output, err = runCommand("", "gcloud", "secrets", "versions", "access", "latest", "--secret", secretId)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return parseSecret(string(output), serverDetails)
+}
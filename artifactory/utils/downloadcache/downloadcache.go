@@ -0,0 +1,175 @@
+package downloadcache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"time"
+
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const (
+	// DownloadCacheEnv sets the directory of the download cache, when the --cache-dir option isn't set.
+	DownloadCacheEnv = "JFROG_CLI_DOWNLOAD_CACHE"
+	// DefaultMaxSizeMB is the size of the cache, in megabytes, beyond which the least recently used files are evicted.
+	DefaultMaxSizeMB = 10240
+)
+
+var sha256Regexp = regexp.MustCompile(`^[0-9a-f]{64}$`)
+
+// Cache is a content-addressable store of downloaded files, keyed by their sha256, so that repeated downloads of the
+// same artifacts on the same agent are copied from the disk. The content of each file is verified against its sha256
+// when it's added to the cache and when it's copied out of it. The time a file was last used is kept as its
+// modification time, by which the least recently used files are evicted.
+type Cache struct {
+	dir     string
+	maxSize int64
+}
+
+// New returns a cache in the directory, which is created when the first file is added, holding up to maxSize bytes.
+func New(dir string, maxSize int64) *Cache {
+	return &Cache{dir: dir, maxSize: maxSize}
+}
+
+// Returns the path of the file with the checksum, under a directory named by the first two characters of the checksum,
+// so that no directory holds too many files.
+func (c *Cache) path(sha256 string) string {
+	return filepath.Join(c.dir, sha256[:2], sha256)
+}
+
+// Contains returns true if the cache holds the file with the checksum.
+func (c *Cache) Contains(sha256 string) bool {
+	if !sha256Regexp.MatchString(sha256) {
+		return false
+	}
+	_, err := os.Stat(c.path(sha256))
+	return err == nil
+}
+
+// Get copies the file with the checksum to the target path. Returns false if the cache doesn't hold the file. A cached
+// file whose content doesn't match its checksum is removed.
+func (c *Cache) Get(sha256, targetPath string) (bool, error) {
+	if !c.Contains(sha256) {
+		return false, nil
+	}
+	cachedPath := c.path(sha256)
+	if err := copyVerified(cachedPath, targetPath, sha256); err != nil {
+		log.Warn("Removing the corrupted cached file", cachedPath+":", err.Error())
+		return false, errorutils.CheckError(os.Remove(cachedPath))
+	}
+	c.touch(cachedPath)
+	return true, nil
+}
+
+// Add copies the file to the cache, if its content matches the checksum.
+func (c *Cache) Add(sha256, filePath string) error {
+	if !sha256Regexp.MatchString(sha256) {
+		return errorutils.CheckErrorf("invalid sha256 '%s'", sha256)
+	}
+	cachedPath := c.path(sha256)
+	if c.Contains(sha256) {
+		c.touch(cachedPath)
+		return nil
+	}
+	return copyVerified(filePath, cachedPath, sha256)
+}
+
+func (c *Cache) touch(cachedPath string) {
+	now := time.Now()
+	if err := os.Chtimes(cachedPath, now, now); err != nil {
+		log.Debug("Failed to update the last use time of", cachedPath+":", err.Error())
+	}
+}
+
+type cachedFile struct {
+	path   string
+	size   int64
+	usedAt time.Time
+}
+
+// Evict removes the least recently used files, until the cache holds no more than its maximal size.
+func (c *Cache) Evict() error {
+	var files []cachedFile
+	var totalSize int64
+	err := filepath.WalkDir(c.dir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+		if entry.IsDir() {
+			return nil
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+		files = append(files, cachedFile{path: path, size: info.Size(), usedAt: info.ModTime()})
+		totalSize += info.Size()
+		return nil
+	})
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].usedAt.Before(files[j].usedAt)
+	})
+	for _, file := range files {
+		if totalSize <= c.maxSize {
+			break
+		}
+		log.Debug("Evicting", file.path, "from the download cache")
+		if err = os.Remove(file.path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return errorutils.CheckError(err)
+		}
+		totalSize -= file.size
+	}
+	return nil
+}
+
+// Copies the file to the target path through a temporary file, which replaces the target only if its content matches
+// the checksum.
+func copyVerified(sourcePath, targetPath, expectedSha256 string) (err error) {
+	source, err := os.Open(sourcePath)
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	defer func() {
+		err = errors.Join(err, errorutils.CheckError(source.Close()))
+	}()
+	if err = os.MkdirAll(filepath.Dir(targetPath), 0755); err != nil {
+		return errorutils.CheckError(err)
+	}
+	tempFile, err := os.CreateTemp(filepath.Dir(targetPath), ".jfrog-cache-*")
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	hash := sha256.New()
+	_, err = io.Copy(io.MultiWriter(tempFile, hash), source)
+	err = errors.Join(err, tempFile.Close())
+	if err == nil {
+		if actual := hex.EncodeToString(hash.Sum(nil)); actual != expectedSha256 {
+			err = errors.New("expected sha256 " + expectedSha256 + ", but got " + actual)
+		}
+	}
+	if err == nil {
+		// The temporary file is readable by its owner only, unlike the downloaded files.
+		err = os.Chmod(tempFile.Name(), 0644)
+	}
+	if err == nil {
+		err = os.Rename(tempFile.Name(), targetPath)
+	}
+	if err != nil {
+		return errorutils.CheckError(errors.Join(err, os.Remove(tempFile.Name())))
+	}
+	return nil
+}
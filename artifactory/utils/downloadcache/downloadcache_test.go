@@ -0,0 +1,81 @@
+package downloadcache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeFile(t *testing.T, dir, name, content string) (string, string) {
+	filePath := filepath.Join(dir, name)
+	require.NoError(t, os.WriteFile(filePath, []byte(content), 0600))
+	checksum := sha256.Sum256([]byte(content))
+	return filePath, hex.EncodeToString(checksum[:])
+}
+
+func TestAddAndGet(t *testing.T) {
+	cache := New(filepath.Join(t.TempDir(), "cache"), 1024)
+	localDir := t.TempDir()
+	filePath, checksum := writeFile(t, localDir, "a.bin", "content")
+
+	found, err := cache.Get(checksum, filepath.Join(localDir, "restored", "a.bin"))
+	require.NoError(t, err)
+	assert.False(t, found)
+
+	require.NoError(t, cache.Add(checksum, filePath))
+	assert.True(t, cache.Contains(checksum))
+	found, err = cache.Get(checksum, filepath.Join(localDir, "restored", "a.bin"))
+	require.NoError(t, err)
+	assert.True(t, found)
+	restored, err := os.ReadFile(filepath.Join(localDir, "restored", "a.bin"))
+	require.NoError(t, err)
+	assert.Equal(t, "content", string(restored))
+
+	_, otherChecksum := writeFile(t, localDir, "b.bin", "other")
+	assert.ErrorContains(t, cache.Add(otherChecksum, filePath), "expected sha256 "+otherChecksum)
+	assert.False(t, cache.Contains(otherChecksum))
+	assert.ErrorContains(t, cache.Add("../../escape", filePath), "invalid sha256")
+}
+
+func TestGetCorruptedFile(t *testing.T) {
+	cache := New(t.TempDir(), 1024)
+	filePath, checksum := writeFile(t, t.TempDir(), "a.bin", "content")
+	require.NoError(t, cache.Add(checksum, filePath))
+	require.NoError(t, os.WriteFile(cache.path(checksum), []byte("corrupted"), 0600))
+
+	targetPath := filepath.Join(t.TempDir(), "a.bin")
+	found, err := cache.Get(checksum, targetPath)
+	require.NoError(t, err)
+	assert.False(t, found)
+	assert.False(t, cache.Contains(checksum), "the corrupted file is removed")
+	assert.NoFileExists(t, targetPath)
+}
+
+func TestEvict(t *testing.T) {
+	cache := New(t.TempDir(), 10)
+	localDir := t.TempDir()
+	var checksums []string
+	// Each file holds 5 bytes, so only two of them fit in the cache.
+	for i, name := range []string{"old", "mid", "new"} {
+		filePath, checksum := writeFile(t, localDir, name, name+"..")
+		require.NoError(t, cache.Add(checksum, filePath))
+		usedAt := time.Now().Add(time.Duration(i-3) * time.Hour)
+		require.NoError(t, os.Chtimes(cache.path(checksum), usedAt, usedAt))
+		checksums = append(checksums, checksum)
+	}
+	// Getting the oldest file makes it the most recently used.
+	found, err := cache.Get(checksums[0], filepath.Join(localDir, "restored"))
+	require.NoError(t, err)
+	assert.True(t, found)
+
+	require.NoError(t, cache.Evict())
+	assert.True(t, cache.Contains(checksums[0]))
+	assert.False(t, cache.Contains(checksums[1]))
+	assert.True(t, cache.Contains(checksums[2]))
+}
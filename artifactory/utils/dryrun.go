@@ -0,0 +1,14 @@
+package utils
+
+import (
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// DryRunPrefix prefixes the log of the operations which a command would perform, when it runs in dry-run mode.
+const DryRunPrefix = "[Dry run]"
+
+// LogDryRun logs an operation which the command would perform, rather than performing it. The targets of the
+// operation should be resolved as they would be for performing it, so the log lists the exact operations.
+func LogDryRun(operation ...any) {
+	log.Info(append([]any{DryRunPrefix}, operation...)...)
+}
@@ -0,0 +1,205 @@
+package encryption
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+)
+
+const (
+	Algorithm = "AES-256-GCM"
+	// Properties set on encrypted artifacts.
+	AlgorithmProperty  = "encryption.algorithm"
+	KeyIdProperty      = "encryption.key.id"
+	WrappedKeyProperty = "encryption.key.wrapped"
+
+	DataKeySize      = 32
+	defaultChunkSize = 64 * 1024
+	// The chunk size is read from the header before the content is authenticated, so it's bounded before allocating.
+	maxChunkSize    = 1024 * 1024
+	noncePrefixSize = 7
+)
+
+// Encrypted files start with the magic, followed by the length of the JSON header (uint16) and the header itself.
+// The content follows as a sequence of chunks, each sealed separately so that large files can be streamed.
+var magic = []byte("JFENC1")
+
+// Header describes how the content of an encrypted file was encrypted.
+// The wrapped data key is kept in the header too, so that a downloaded file can be decrypted without its properties.
+type Header struct {
+	Algorithm   string `json:"alg"`
+	KeyId       string `json:"keyId"`
+	WrappedKey  string `json:"wrappedKey"`
+	ChunkSize   int    `json:"chunkSize"`
+	NoncePrefix []byte `json:"noncePrefix"`
+}
+
+// NewDataKey generates a random key for encrypting the content of files.
+func NewDataKey() ([]byte, error) {
+	dataKey := make([]byte, DataKeySize)
+	_, err := rand.Read(dataKey)
+	return dataKey, errorutils.CheckError(err)
+}
+
+// Encrypt encrypts the content read from reader to writer, using the data key.
+// The key id and the wrapped key are stored in the header of the output.
+func Encrypt(reader io.Reader, writer io.Writer, dataKey []byte, keyId, wrappedKey string) error {
+	aead, err := newAead(dataKey)
+	if err != nil {
+		return err
+	}
+	header := Header{Algorithm: Algorithm, KeyId: keyId, WrappedKey: wrappedKey, ChunkSize: defaultChunkSize, NoncePrefix: make([]byte, noncePrefixSize)}
+	if _, err = rand.Read(header.NoncePrefix); err != nil {
+		return errorutils.CheckError(err)
+	}
+	headerBytes, err := writeHeader(writer, &header)
+	if err != nil {
+		return err
+	}
+
+	bufferedReader := bufio.NewReader(reader)
+	plaintext := make([]byte, header.ChunkSize)
+	var ciphertext []byte
+	for counter := uint32(0); ; counter++ {
+		n, err := io.ReadFull(bufferedReader, plaintext)
+		if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+			return errorutils.CheckError(err)
+		}
+		last := isLastChunk(bufferedReader, err)
+		ciphertext = aead.Seal(ciphertext[:0], nonce(header.NoncePrefix, counter, last), plaintext[:n], headerBytes)
+		if _, err = writer.Write(ciphertext); err != nil {
+			return errorutils.CheckError(err)
+		}
+		if last {
+			return nil
+		}
+	}
+}
+
+// Decrypt decrypts the content read from reader to writer. The data key is resolved from the header by unwrapKey.
+func Decrypt(reader io.Reader, writer io.Writer, unwrapKey func(header *Header) ([]byte, error)) error {
+	bufferedReader := bufio.NewReader(reader)
+	header, headerBytes, err := readHeader(bufferedReader)
+	if err != nil {
+		return err
+	}
+	dataKey, err := unwrapKey(header)
+	if err != nil {
+		return err
+	}
+	aead, err := newAead(dataKey)
+	if err != nil {
+		return err
+	}
+	ciphertext := make([]byte, header.ChunkSize+aead.Overhead())
+	var plaintext []byte
+	for counter := uint32(0); ; counter++ {
+		n, err := io.ReadFull(bufferedReader, ciphertext)
+		if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+			return errorutils.CheckError(err)
+		}
+		last := isLastChunk(bufferedReader, err)
+		// A truncated file fails here, since its last chunk was not sealed as the last one.
+		plaintext, err = aead.Open(plaintext[:0], nonce(header.NoncePrefix, counter, last), ciphertext[:n], headerBytes)
+		if err != nil {
+			return errorutils.CheckErrorf("failed decrypting the content: %s", err.Error())
+		}
+		if _, err = writer.Write(plaintext); err != nil {
+			return errorutils.CheckError(err)
+		}
+		if last {
+			return nil
+		}
+	}
+}
+
+// IsEncryptedFile returns true if the file starts with the magic of encrypted files.
+func IsEncryptedFile(path string) (bool, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return false, errorutils.CheckError(err)
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+	prefix := make([]byte, len(magic))
+	n, err := io.ReadFull(file, prefix)
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return false, errorutils.CheckError(err)
+	}
+	return bytes.Equal(prefix[:n], magic), nil
+}
+
+func newAead(dataKey []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(dataKey)
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	aead, err := cipher.NewGCM(block)
+	return aead, errorutils.CheckError(err)
+}
+
+// The nonce of each chunk is made of the random prefix, the chunk counter and a flag marking the last chunk.
+func nonce(prefix []byte, counter uint32, last bool) []byte {
+	chunkNonce := make([]byte, noncePrefixSize+5)
+	copy(chunkNonce, prefix)
+	binary.BigEndian.PutUint32(chunkNonce[noncePrefixSize:], counter)
+	if last {
+		chunkNonce[len(chunkNonce)-1] = 1
+	}
+	return chunkNonce
+}
+
+// A chunk is the last one if it wasn't read whole, or if nothing follows it.
+func isLastChunk(reader *bufio.Reader, readErr error) bool {
+	if readErr != nil {
+		return true
+	}
+	_, err := reader.Peek(1)
+	return err != nil
+}
+
+func writeHeader(writer io.Writer, header *Header) ([]byte, error) {
+	headerBytes, err := json.Marshal(header)
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	prefix := make([]byte, len(magic)+2)
+	copy(prefix, magic)
+	binary.BigEndian.PutUint16(prefix[len(magic):], uint16(len(headerBytes)))
+	if _, err = writer.Write(append(prefix, headerBytes...)); err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	return headerBytes, nil
+}
+
+func readHeader(reader io.Reader) (*Header, []byte, error) {
+	prefix := make([]byte, len(magic)+2)
+	if _, err := io.ReadFull(reader, prefix); err != nil || !bytes.Equal(prefix[:len(magic)], magic) {
+		return nil, nil, errorutils.CheckErrorf("the content is not encrypted")
+	}
+	headerBytes := make([]byte, binary.BigEndian.Uint16(prefix[len(magic):]))
+	if _, err := io.ReadFull(reader, headerBytes); err != nil {
+		return nil, nil, errorutils.CheckErrorf("invalid encryption header: %s", err.Error())
+	}
+	header := new(Header)
+	if err := json.Unmarshal(headerBytes, header); err != nil {
+		return nil, nil, errorutils.CheckErrorf("invalid encryption header: %s", err.Error())
+	}
+	if header.Algorithm != Algorithm {
+		return nil, nil, errorutils.CheckErrorf("unsupported encryption algorithm '%s'", header.Algorithm)
+	}
+	if len(header.NoncePrefix) != noncePrefixSize || header.ChunkSize <= 0 || header.ChunkSize > maxChunkSize {
+		return nil, nil, errorutils.CheckErrorf("invalid encryption header")
+	}
+	return header, headerBytes, nil
+}
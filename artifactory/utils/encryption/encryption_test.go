@@ -0,0 +1,89 @@
+package encryption
+
+import (
+	"bytes"
+	"crypto/rand"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecryptOversizedChunk(t *testing.T) {
+	var encrypted bytes.Buffer
+	_, err := writeHeader(&encrypted, &Header{Algorithm: Algorithm, ChunkSize: 1 << 40, NoncePrefix: make([]byte, noncePrefixSize)})
+	require.NoError(t, err)
+	err = Decrypt(&encrypted, &bytes.Buffer{}, func(*Header) ([]byte, error) {
+		assert.Fail(t, "the key of an invalid header mustn't be unwrapped")
+		return nil, nil
+	})
+	assert.ErrorContains(t, err, "invalid encryption header")
+}
+
+func TestEncryptDecrypt(t *testing.T) {
+	keyProvider := newLocalKeyProvider(bytes.Repeat([]byte{1}, DataKeySize))
+	envelope, err := NewEnvelope(keyProvider)
+	require.NoError(t, err)
+
+	for _, size := range []int{0, 10, defaultChunkSize, 2*defaultChunkSize + 5} {
+		content := make([]byte, size)
+		_, err = rand.Read(content)
+		require.NoError(t, err)
+
+		var encrypted bytes.Buffer
+		require.NoError(t, Encrypt(bytes.NewReader(content), &encrypted, envelope.dataKey, envelope.keyId, envelope.wrappedKey))
+		assert.False(t, size > 0 && bytes.Contains(encrypted.Bytes(), content))
+
+		var decrypted bytes.Buffer
+		require.NoError(t, Decrypt(bytes.NewReader(encrypted.Bytes()), &decrypted, func(header *Header) ([]byte, error) {
+			assert.Equal(t, keyProvider.KeyId(), header.KeyId)
+			return keyProvider.UnwrapKey(header.WrappedKey)
+		}))
+		assert.True(t, bytes.Equal(content, decrypted.Bytes()))
+
+		// Truncating the content at a chunk boundary must fail the decryption.
+		if size > defaultChunkSize {
+			truncated := encrypted.Bytes()[:encrypted.Len()-(size-defaultChunkSize)-2*16]
+			assert.Error(t, Decrypt(bytes.NewReader(truncated), &bytes.Buffer{}, func(*Header) ([]byte, error) { return envelope.dataKey, nil }))
+		}
+	}
+}
+
+func TestDecryptFile(t *testing.T) {
+	tmpDir := t.TempDir()
+	keyFile := filepath.Join(tmpDir, "key")
+	require.NoError(t, os.WriteFile(keyFile, []byte("AQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQE=\n"), 0600))
+	keyProvider, err := NewLocalKeyProvider(keyFile)
+	require.NoError(t, err)
+	envelope, err := NewEnvelope(keyProvider)
+	require.NoError(t, err)
+	assert.Contains(t, envelope.Properties(), KeyIdProperty+"="+keyProvider.KeyId())
+
+	plainPath := filepath.Join(tmpDir, "plain.txt")
+	encryptedPath := filepath.Join(tmpDir, "encrypted", "plain.txt")
+	require.NoError(t, os.WriteFile(plainPath, []byte("regulated data"), 0600))
+	require.NoError(t, envelope.EncryptFile(plainPath, encryptedPath))
+	isEncrypted, err := IsEncryptedFile(encryptedPath)
+	require.NoError(t, err)
+	assert.True(t, isEncrypted)
+	isEncrypted, err = IsEncryptedFile(plainPath)
+	require.NoError(t, err)
+	assert.False(t, isEncrypted)
+
+	// A different key fails without modifying the file.
+	otherProvider := newLocalKeyProvider(bytes.Repeat([]byte{2}, DataKeySize))
+	assert.Error(t, DecryptFile(encryptedPath, otherProvider))
+	isEncrypted, err = IsEncryptedFile(encryptedPath)
+	require.NoError(t, err)
+	assert.True(t, isEncrypted)
+
+	require.NoError(t, DecryptFile(encryptedPath, keyProvider))
+	content, err := os.ReadFile(encryptedPath)
+	require.NoError(t, err)
+	assert.Equal(t, "regulated data", string(content))
+	entries, err := os.ReadDir(filepath.Dir(encryptedPath))
+	require.NoError(t, err)
+	assert.Len(t, entries, 1)
+}
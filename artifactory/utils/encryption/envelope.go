@@ -0,0 +1,94 @@
+package encryption
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+)
+
+// Envelope encrypts files with a single data key, which is wrapped by the key provider.
+type Envelope struct {
+	dataKey    []byte
+	keyId      string
+	wrappedKey string
+}
+
+func NewEnvelope(keyProvider KeyProvider) (*Envelope, error) {
+	dataKey, err := NewDataKey()
+	if err != nil {
+		return nil, err
+	}
+	wrappedKey, err := keyProvider.WrapKey(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	return &Envelope{dataKey: dataKey, keyId: keyProvider.KeyId(), wrappedKey: wrappedKey}, nil
+}
+
+// Properties returns the artifact properties describing the envelope, in the form of "key1=value1;key2=value2".
+func (e *Envelope) Properties() string {
+	return AlgorithmProperty + "=" + Algorithm + ";" + KeyIdProperty + "=" + e.keyId + ";" + WrappedKeyProperty + "=" + e.wrappedKey
+}
+
+// EncryptFile writes the encrypted content of the source file to the target path.
+func (e *Envelope) EncryptFile(sourcePath, targetPath string) (err error) {
+	source, err := os.Open(sourcePath)
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	defer func() {
+		err = errors.Join(err, errorutils.CheckError(source.Close()))
+	}()
+	if err = os.MkdirAll(filepath.Dir(targetPath), 0700); err != nil {
+		return errorutils.CheckError(err)
+	}
+	target, err := os.OpenFile(targetPath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	defer func() {
+		err = errors.Join(err, errorutils.CheckError(target.Close()))
+	}()
+	return Encrypt(source, target, e.dataKey, e.keyId, e.wrappedKey)
+}
+
+// DecryptFile decrypts an encrypted file in place. The file is replaced only if it was decrypted successfully.
+func DecryptFile(path string, keyProvider KeyProvider) (err error) {
+	source, err := os.Open(path)
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	defer func() {
+		if source != nil {
+			err = errors.Join(err, errorutils.CheckError(source.Close()))
+		}
+	}()
+	target, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.decrypting")
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	defer func() {
+		if err != nil {
+			err = errors.Join(err, errorutils.CheckError(os.Remove(target.Name())))
+		}
+	}()
+	err = Decrypt(source, target, func(header *Header) ([]byte, error) {
+		if header.KeyId != keyProvider.KeyId() {
+			return nil, errorutils.CheckErrorf("'%s' was encrypted with the key '%s', but the key '%s' was provided", path, header.KeyId, keyProvider.KeyId())
+		}
+		return keyProvider.UnwrapKey(header.WrappedKey)
+	})
+	err = errors.Join(err, errorutils.CheckError(target.Close()))
+	if err != nil {
+		return err
+	}
+	// Close the source before replacing it, as required on Windows.
+	err = errorutils.CheckError(source.Close())
+	source = nil
+	if err != nil {
+		return err
+	}
+	return errorutils.CheckError(os.Rename(target.Name(), path))
+}
@@ -0,0 +1,128 @@
+package encryption
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+)
+
+// KeyProvider wraps the data keys of encrypted files with a master key, which never leaves the provider.
+type KeyProvider interface {
+	// KeyId identifies the master key. It is stored with the wrapped keys.
+	KeyId() string
+	WrapKey(dataKey []byte) (string, error)
+	UnwrapKey(wrappedKey string) ([]byte, error)
+}
+
+// LocalKeyProvider wraps data keys with a 256-bit master key read from a local file.
+type LocalKeyProvider struct {
+	masterKey []byte
+	keyId     string
+}
+
+// NewLocalKeyProvider reads the master key from a file, containing either the 32 raw bytes of the key or their base64 encoding.
+func NewLocalKeyProvider(keyFilePath string) (*LocalKeyProvider, error) {
+	content, err := os.ReadFile(keyFilePath)
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	masterKey := content
+	if len(content) != DataKeySize {
+		if masterKey, err = base64.StdEncoding.DecodeString(strings.TrimSpace(string(content))); err != nil || len(masterKey) != DataKeySize {
+			return nil, errorutils.CheckErrorf("the encryption key file '%s' should contain a 256-bit key, either raw or base64 encoded", keyFilePath)
+		}
+	}
+	return newLocalKeyProvider(masterKey), nil
+}
+
+func newLocalKeyProvider(masterKey []byte) *LocalKeyProvider {
+	// The key id is derived from the key, so that it can be matched without revealing the key.
+	checksum := sha256.Sum256(masterKey)
+	return &LocalKeyProvider{masterKey: masterKey, keyId: "local:" + hex.EncodeToString(checksum[:8])}
+}
+
+func (lkp *LocalKeyProvider) KeyId() string {
+	return lkp.keyId
+}
+
+func (lkp *LocalKeyProvider) WrapKey(dataKey []byte) (string, error) {
+	aead, err := newAead(lkp.masterKey)
+	if err != nil {
+		return "", err
+	}
+	keyNonce := make([]byte, aead.NonceSize())
+	if _, err = rand.Read(keyNonce); err != nil {
+		return "", errorutils.CheckError(err)
+	}
+	return base64.StdEncoding.EncodeToString(aead.Seal(keyNonce, keyNonce, dataKey, []byte(lkp.keyId))), nil
+}
+
+func (lkp *LocalKeyProvider) UnwrapKey(wrappedKey string) ([]byte, error) {
+	aead, err := newAead(lkp.masterKey)
+	if err != nil {
+		return nil, err
+	}
+	sealed, err := base64.StdEncoding.DecodeString(wrappedKey)
+	if err != nil || len(sealed) < aead.NonceSize() {
+		return nil, errorutils.CheckErrorf("invalid wrapped key")
+	}
+	dataKey, err := aead.Open(nil, sealed[:aead.NonceSize()], sealed[aead.NonceSize():], []byte(lkp.keyId))
+	if err != nil {
+		return nil, errorutils.CheckErrorf("failed unwrapping the data key. Make sure the key used for encryption is provided: %s", err.Error())
+	}
+	return dataKey, nil
+}
+
+// CommandKeyProvider delegates wrapping and unwrapping to an external command, such as a script calling a KMS.
+// The command is run with a 'wrap' or 'unwrap' argument, reads the base64 encoded input from stdin,
+// and writes the base64 encoded output to stdout.
+type CommandKeyProvider struct {
+	command []string
+}
+
+func NewCommandKeyProvider(command string) (*CommandKeyProvider, error) {
+	fields := strings.Fields(command)
+	if len(fields) == 0 {
+		return nil, errorutils.CheckErrorf("the encryption key command is empty")
+	}
+	return &CommandKeyProvider{command: fields}, nil
+}
+
+func (ckp *CommandKeyProvider) KeyId() string {
+	return "command:" + ckp.command[0]
+}
+
+func (ckp *CommandKeyProvider) WrapKey(dataKey []byte) (string, error) {
+	return ckp.run("wrap", base64.StdEncoding.EncodeToString(dataKey))
+}
+
+func (ckp *CommandKeyProvider) UnwrapKey(wrappedKey string) ([]byte, error) {
+	output, err := ckp.run("unwrap", wrappedKey)
+	if err != nil {
+		return nil, err
+	}
+	dataKey, err := base64.StdEncoding.DecodeString(output)
+	if err != nil {
+		return nil, errorutils.CheckErrorf("the encryption key command returned an invalid key: %s", err.Error())
+	}
+	return dataKey, nil
+}
+
+func (ckp *CommandKeyProvider) run(operation, input string) (string, error) {
+	cmd := exec.Command(ckp.command[0], append(ckp.command[1:], operation)...) // #nosec G204 -- the command is provided by the user.
+	cmd.Stdin = strings.NewReader(input)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	output, err := cmd.Output()
+	if err != nil {
+		return "", errorutils.CheckErrorf("the encryption key command failed to %s the data key: %s %s", operation, err.Error(), strings.TrimSpace(stderr.String()))
+	}
+	return strings.TrimSpace(string(output)), nil
+}
@@ -0,0 +1,175 @@
+package jfrogignore
+
+import (
+	"bufio"
+	"bytes"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+)
+
+// FileName is the name of the file listing the local paths which aren't uploaded, in gitignore syntax.
+const FileName = ".jfrogignore"
+
+// Rules are the rules of one or more .jfrogignore files. Like in gitignore, the last rule matching a path decides
+// whether it is ignored, so the rules of a file loaded later override the rules of the files loaded before it.
+type Rules struct {
+	rules []rule
+}
+
+type rule struct {
+	// The absolute path of the directory of the .jfrogignore file, which the pattern is relative to.
+	baseDir string
+	pattern *regexp.Regexp
+	negate  bool
+	dirOnly bool
+}
+
+// Load loads the .jfrogignore files of the directories, in order. Directories without a .jfrogignore file are
+// skipped, and nil is returned if none of them has one.
+func Load(dirs ...string) (*Rules, error) {
+	var rules *Rules
+	for _, dir := range dirs {
+		content, err := os.ReadFile(filepath.Join(dir, FileName))
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return nil, errorutils.CheckError(err)
+		}
+		if rules == nil {
+			rules = new(Rules)
+		}
+		if err = rules.add(dir, content); err != nil {
+			return nil, err
+		}
+	}
+	return rules, nil
+}
+
+// Parse parses the content of a .jfrogignore file in the base directory.
+func Parse(baseDir string, content []byte) (*Rules, error) {
+	rules := new(Rules)
+	return rules, rules.add(baseDir, content)
+}
+
+func (r *Rules) add(baseDir string, content []byte) error {
+	baseDir, err := filepath.Abs(baseDir)
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), " \t\r")
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		parsed := rule{baseDir: baseDir}
+		if strings.HasPrefix(line, "!") {
+			parsed.negate = true
+			line = line[1:]
+		}
+		// A leading backslash escapes a literal '#' or '!'.
+		line = strings.TrimPrefix(line, `\`)
+		if strings.HasSuffix(line, "/") {
+			parsed.dirOnly = true
+			line = strings.TrimRight(line, "/")
+		}
+		if line == "" {
+			continue
+		}
+		if parsed.pattern, err = compilePattern(line); err != nil {
+			return errorutils.CheckErrorf("invalid pattern '%s' in %s: %s", scanner.Text(), filepath.Join(baseDir, FileName), err.Error())
+		}
+		r.rules = append(r.rules, parsed)
+	}
+	return errorutils.CheckError(scanner.Err())
+}
+
+// compilePattern converts a gitignore pattern to a regexp matching the slash separated path relative to the base
+// directory. A pattern without a slash matches a name at any depth, otherwise it's anchored to the base directory.
+func compilePattern(pattern string) (*regexp.Regexp, error) {
+	anchored := strings.Contains(pattern, "/")
+	pattern = strings.TrimPrefix(pattern, "/")
+	var expr strings.Builder
+	expr.WriteString("^")
+	if !anchored {
+		expr.WriteString("(?:.*/)?")
+	}
+	for i := 0; i < len(pattern); i++ {
+		switch char := pattern[i]; {
+		case strings.HasPrefix(pattern[i:], "**/"):
+			expr.WriteString("(?:.*/)?")
+			i += 2
+		case pattern[i:] == "**":
+			expr.WriteString(".*")
+			i++
+		case char == '*':
+			expr.WriteString("[^/]*")
+		case char == '?':
+			expr.WriteString("[^/]")
+		case char == '[':
+			end := strings.IndexByte(pattern[i+1:], ']')
+			if end == -1 {
+				expr.WriteString(regexp.QuoteMeta(string(char)))
+				continue
+			}
+			class := pattern[i+1 : i+1+end]
+			if strings.HasPrefix(class, "!") {
+				class = "^" + class[1:]
+			}
+			expr.WriteString("[" + class + "]")
+			i += end + 1
+		case char == '\\' && i+1 < len(pattern):
+			i++
+			expr.WriteString(regexp.QuoteMeta(string(pattern[i])))
+		default:
+			expr.WriteString(regexp.QuoteMeta(string(char)))
+		}
+	}
+	expr.WriteString("$")
+	return regexp.Compile(expr.String())
+}
+
+// IsIgnored returns whether the path is ignored by the rules. The path may be absolute or relative to the working
+// directory. A path is also ignored if one of its parent directories is ignored, as the content of an ignored
+// directory cannot be included again.
+func (r *Rules) IsIgnored(path string, isDir bool) bool {
+	if r == nil || len(r.rules) == 0 {
+		return false
+	}
+	path, err := filepath.Abs(path)
+	if err != nil {
+		return false
+	}
+	for parent := filepath.Dir(path); parent != path && parent != filepath.Dir(parent); parent = filepath.Dir(parent) {
+		if r.match(parent, true) {
+			return true
+		}
+	}
+	return r.match(path, isDir)
+}
+
+// match returns whether the last rule matching the absolute path ignores it, regardless of its parent directories.
+func (r *Rules) match(path string, isDir bool) bool {
+	if r == nil {
+		return false
+	}
+	ignored := false
+	for _, rule := range r.rules {
+		if rule.dirOnly && !isDir {
+			continue
+		}
+		relPath, err := filepath.Rel(rule.baseDir, path)
+		if err != nil || relPath == "." || relPath == ".." || strings.HasPrefix(relPath, ".."+string(filepath.Separator)) {
+			continue
+		}
+		if rule.pattern.MatchString(filepath.ToSlash(relPath)) {
+			ignored = !rule.negate
+		}
+	}
+	return ignored
+}
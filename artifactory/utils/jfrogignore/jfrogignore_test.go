@@ -0,0 +1,108 @@
+package jfrogignore
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsIgnored(t *testing.T) {
+	base := t.TempDir()
+	rules, err := Parse(base, []byte(`# Dependencies
+node_modules/
+*.log
+!important.log
+/build
+docs/**/*.tmp
+cache?/
+\#literal
+`))
+	require.NoError(t, err)
+
+	tests := []struct {
+		path     string
+		isDir    bool
+		expected bool
+	}{
+		{"node_modules", true, true},
+		{"web/node_modules", true, true},
+		{"web/node_modules/lib/index.js", false, true},
+		{"node_modules", false, false},
+		{"app.log", false, true},
+		{"logs/app.log", false, true},
+		{"important.log", false, false},
+		{"build", true, true},
+		{"build/out.bin", false, true},
+		{"src/build", true, false},
+		{"docs/a/b/c.tmp", false, true},
+		{"docs/c.tmp", false, true},
+		{"c.tmp", false, false},
+		{"cache1", true, true},
+		{"cache12", true, false},
+		{"#literal", false, true},
+		{"src/main.go", false, false},
+	}
+	for _, test := range tests {
+		t.Run(test.path, func(t *testing.T) {
+			assert.Equal(t, test.expected, rules.IsIgnored(filepath.Join(base, filepath.FromSlash(test.path)), test.isDir))
+		})
+	}
+	// Paths outside the base directory aren't matched.
+	assert.False(t, rules.IsIgnored(filepath.Join(filepath.Dir(base), "app.log"), false))
+	assert.False(t, (*Rules)(nil).IsIgnored("app.log", false))
+}
+
+func TestLoad(t *testing.T) {
+	root := t.TempDir()
+	sub := filepath.Join(root, "sub")
+	require.NoError(t, os.MkdirAll(sub, 0700))
+	rules, err := Load(root, sub)
+	require.NoError(t, err)
+	assert.Nil(t, rules)
+
+	require.NoError(t, os.WriteFile(filepath.Join(root, FileName), []byte("*.bin\n"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(sub, FileName), []byte("!keep.bin\n"), 0600))
+	rules, err = Load(root, sub)
+	require.NoError(t, err)
+	assert.True(t, rules.IsIgnored(filepath.Join(sub, "drop.bin"), false))
+	// The rules of the file loaded later override the rules loaded before them.
+	assert.False(t, rules.IsIgnored(filepath.Join(sub, "keep.bin"), false))
+	assert.True(t, rules.IsIgnored(filepath.Join(root, "keep.bin"), false))
+}
+
+func TestListFiles(t *testing.T) {
+	root := t.TempDir()
+	for _, path := range []string{"a.txt", "app.log", "src/b.txt", "src/deep/c.txt", "node_modules/lib/d.js", "src/node_modules/e.js"} {
+		localPath := filepath.Join(root, filepath.FromSlash(path))
+		require.NoError(t, os.MkdirAll(filepath.Dir(localPath), 0700))
+		require.NoError(t, os.WriteFile(localPath, []byte(path), 0600))
+	}
+	rules, err := Parse(root, []byte("node_modules/\n*.log\n"))
+	require.NoError(t, err)
+
+	inRoot := func(paths ...string) (localPaths []string) {
+		for _, path := range paths {
+			localPaths = append(localPaths, filepath.Join(root, filepath.FromSlash(path)))
+		}
+		return
+	}
+	for _, threads := range []int{0, 1, 8} {
+		files, err := ListFiles(root, true, threads, rules)
+		require.NoError(t, err)
+		assert.Equal(t, inRoot("a.txt", "src/b.txt", "src/deep/c.txt"), files)
+	}
+
+	files, err := ListFiles(root, false, 4, rules)
+	require.NoError(t, err)
+	assert.Equal(t, inRoot("a.txt"), files)
+
+	files, err = ListFiles(root, true, 4, nil)
+	require.NoError(t, err)
+	assert.Len(t, files, 6)
+
+	_, err = ListFiles(filepath.Join(root, "missing"), true, 4, rules)
+	assert.Error(t, err)
+}
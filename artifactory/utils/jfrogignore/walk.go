@@ -0,0 +1,77 @@
+package jfrogignore
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+)
+
+// ListFiles lists the files under the root directory, sorted, with the root directory as their prefix. The directories
+// are read by up to the given number of goroutines, so that the traversal of large trees isn't bound to one goroutine.
+// Files and directories ignored by the rules are skipped, and the ignored directories aren't read at all. Symbolic
+// links are listed as files, and the directories they point to aren't walked.
+func ListFiles(root string, recursive bool, threads int, rules *Rules) ([]string, error) {
+	absRoot, err := filepath.Abs(root)
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	if threads < 1 {
+		threads = 1
+	}
+	w := &walker{recursive: recursive, rules: rules, semaphore: make(chan struct{}, threads)}
+	w.pending.Add(1)
+	go w.walkDir(root, absRoot)
+	w.pending.Wait()
+	if err = errors.Join(w.errs...); err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	sort.Strings(w.files)
+	return w.files, nil
+}
+
+type walker struct {
+	recursive bool
+	rules     *Rules
+	// Bounds the number of directories which are read concurrently.
+	semaphore chan struct{}
+	pending   sync.WaitGroup
+	mutex     sync.Mutex
+	files     []string
+	errs      []error
+}
+
+func (w *walker) walkDir(dir, absDir string) {
+	defer w.pending.Done()
+	w.semaphore <- struct{}{}
+	entries, err := os.ReadDir(dir)
+	<-w.semaphore
+	if err != nil {
+		w.mutex.Lock()
+		w.errs = append(w.errs, err)
+		w.mutex.Unlock()
+		return
+	}
+	var files []string
+	for _, entry := range entries {
+		path, absPath := filepath.Join(dir, entry.Name()), filepath.Join(absDir, entry.Name())
+		isDir := entry.IsDir()
+		if w.rules.match(absPath, isDir) {
+			continue
+		}
+		if !isDir {
+			files = append(files, path)
+			continue
+		}
+		if w.recursive {
+			w.pending.Add(1)
+			go w.walkDir(path, absPath)
+		}
+	}
+	w.mutex.Lock()
+	w.files = append(w.files, files...)
+	w.mutex.Unlock()
+}
@@ -0,0 +1,68 @@
+package lockfile
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/jfrog/build-info-go/build"
+	buildinfo "github.com/jfrog/build-info-go/entities"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clientconfig"
+	buildUtils "github.com/jfrog/jfrog-cli-core/v2/common/build"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// GetModuleId returns the module of the build configuration, or the name of the working directory if none is provided.
+func GetModuleId(buildConfiguration *buildUtils.BuildConfiguration, workingDir string) string {
+	if moduleId := buildConfiguration.GetModule(); moduleId != "" {
+		return moduleId
+	}
+	return filepath.Base(workingDir)
+}
+
+// CollectDependencies returns the packages pinned by the lockfile as the dependencies of the module, with the checksums
+// of their files in Artifactory. The files are searched in all the repositories, so the package which was installed
+// is recorded, whichever repository it was resolved from. The packages which aren't found are logged and left out.
+func CollectDependencies(serverDetails *config.ServerDetails, lockfilePath, moduleId string) ([]buildinfo.Dependency, error) {
+	packages, err := Parse(lockfilePath)
+	if err != nil {
+		return nil, err
+	}
+	servicesManager, err := clientconfig.CreateServiceManager(serverDetails, -1, 0, false)
+	if err != nil {
+		return nil, err
+	}
+	dependencies, unresolved, err := Resolve(servicesManager, packages, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(unresolved) > 0 {
+		var unresolvedIds []string
+		for _, unresolvedPackage := range unresolved {
+			unresolvedIds = append(unresolvedIds, unresolvedPackage.Id())
+		}
+		log.Info("The following packages of", filepath.Base(lockfilePath), "weren't found in Artifactory, and will not be included in the build-info:\n"+
+			strings.Join(unresolvedIds, "\n"))
+	}
+	SetRequestedBy(dependencies, packages, moduleId)
+	return dependencies, nil
+}
+
+// SaveModule saves the module to the build-info, with the dependencies collected from the lockfile by
+// CollectDependencies. Nothing is saved if the lockfile doesn't exist.
+func SaveModule(buildInfo *build.Build, serverDetails *config.ServerDetails, lockfilePath, moduleId string, moduleType buildinfo.ModuleType) error {
+	if _, err := os.Stat(lockfilePath); errors.Is(err, fs.ErrNotExist) {
+		log.Debug("No", filepath.Base(lockfilePath), "found at", filepath.Dir(lockfilePath)+", skipping dependency collection")
+		return nil
+	}
+	dependencies, err := CollectDependencies(serverDetails, lockfilePath, moduleId)
+	if err != nil {
+		return err
+	}
+	module := buildinfo.Module{Id: moduleId, Type: moduleType, Dependencies: dependencies}
+	return errorutils.CheckError(buildInfo.SaveBuildInfo(&buildinfo.BuildInfo{Modules: []buildinfo.Module{module}}))
+}
@@ -0,0 +1,108 @@
+// Package lockfile reads the packages pinned by the lockfiles of package managers, and resolves them to their files
+// in Artifactory, so that they can be recorded as build dependencies.
+package lockfile
+
+import (
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+)
+
+// The types of the packages, as recorded in the build-info.
+const (
+	Npm   = "npm"
+	Go    = "go"
+	Pypi  = "pypi"
+	Gem   = "gem"
+	Cargo = "cargo"
+	Conan = "conan"
+	Swift = "swift"
+	Pods  = "cocoapods"
+)
+
+// Package is a package pinned by a lockfile.
+type Package struct {
+	Type    string
+	Name    string
+	Version string
+	// Patterns of the names of the package's files, in which '*' is a wildcard.
+	FileNames []string
+	// The end of the path of the package's files in their repository, if known.
+	Path string
+	// The SHA-256 checksums of the package's files, if recorded by the lockfile. When set, the files are found by them.
+	Sha256 []string
+	// The names of the package's dependencies, if recorded by the lockfile.
+	Dependencies []string
+}
+
+// Id returns the id of the package's dependency in the build-info.
+func (p Package) Id() string {
+	return p.Name + ":" + p.Version
+}
+
+// Matches returns true if the file, in the path of its repository, is a file of the package.
+func (p Package) Matches(filePath, fileName, sha256 string) bool {
+	if len(p.Sha256) > 0 {
+		return slices.Contains(p.Sha256, sha256)
+	}
+	if p.Path != "" && !strings.HasSuffix("/"+filePath, "/"+p.Path) {
+		return false
+	}
+	for _, pattern := range p.FileNames {
+		if matched, err := path.Match(pattern, fileName); err == nil && matched {
+			return true
+		}
+	}
+	return false
+}
+
+type parser func(content []byte) ([]Package, error)
+
+// Parse reads the packages pinned by the lockfile. The format of the lockfile is determined by its name: package-lock.json,
+// go.sum, requirements.txt (or any other *requirements*.txt file), poetry.lock, pdm.lock, Gemfile.lock, Cargo.lock, conan.lock,
+// Package.resolved or Podfile.lock.
+func Parse(lockfilePath string) ([]Package, error) {
+	parse, err := getParser(filepath.Base(lockfilePath))
+	if err != nil {
+		return nil, err
+	}
+	content, err := os.ReadFile(lockfilePath)
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	packages, err := parse(content)
+	if err != nil {
+		return nil, errorutils.CheckErrorf("failed to parse the lockfile '%s': %s", lockfilePath, err.Error())
+	}
+	return packages, nil
+}
+
+func getParser(fileName string) (parser, error) {
+	switch {
+	case fileName == "package-lock.json" || fileName == "npm-shrinkwrap.json":
+		return parseNpmLock, nil
+	case fileName == "go.sum":
+		return parseGoSum, nil
+	case strings.Contains(fileName, "requirements") && strings.HasSuffix(fileName, ".txt"):
+		return parseRequirements, nil
+	case fileName == "poetry.lock":
+		return parsePoetryLock, nil
+	case fileName == "pdm.lock":
+		return parsePdmLock, nil
+	case fileName == "Gemfile.lock":
+		return parseGemfileLock, nil
+	case fileName == "Cargo.lock":
+		return parseCargoLock, nil
+	case fileName == "conan.lock":
+		return parseConanLock, nil
+	case fileName == "Package.resolved":
+		return parsePackageResolved, nil
+	case fileName == "Podfile.lock":
+		return parsePodfileLock, nil
+	}
+	return nil, errorutils.CheckErrorf("unsupported lockfile '%s'. The supported lockfiles are package-lock.json, npm-shrinkwrap.json, go.sum, requirements.txt, poetry.lock, pdm.lock, Gemfile.lock, Cargo.lock, conan.lock, Package.resolved and Podfile.lock", fileName)
+}
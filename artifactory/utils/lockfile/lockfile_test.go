@@ -0,0 +1,401 @@
+package lockfile
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	buildinfo "github.com/jfrog/build-info-go/entities"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	serviceutils "github.com/jfrog/jfrog-client-go/artifactory/services/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	sha256A = "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+	sha256B = "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"
+)
+
+func packageIds(packages []Package) []string {
+	var ids []string
+	for _, lockPackage := range packages {
+		ids = append(ids, lockPackage.Type+":"+lockPackage.Id())
+	}
+	return ids
+}
+
+func TestParseNpmLock(t *testing.T) {
+	packages, err := parseNpmLock([]byte(`{
+  "lockfileVersion": 3,
+  "packages": {
+    "": {"name": "app", "version": "1.0.0"},
+    "node_modules/@types/node": {"version": "20.1.0", "resolved": "https://registry.npmjs.org/@types/node/-/node-20.1.0.tgz"},
+    "node_modules/debug": {"version": "4.3.4"},
+    "node_modules/express/node_modules/debug": {"version": "2.6.9"},
+    "node_modules/local-lib": {"resolved": "packages/local-lib", "link": true},
+    "node_modules/forked": {"version": "git+ssh://git@github.com/org/forked.git#abc"}
+  }
+}`))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"npm:@types/node:20.1.0", "npm:debug:2.6.9", "npm:debug:4.3.4"}, packageIds(packages))
+	assert.Equal(t, []string{"node-20.1.0.tgz"}, packages[0].FileNames)
+	assert.Equal(t, "@types/node/-", packages[0].Path)
+
+	// Lockfiles of version 1 list the dependencies as a tree.
+	packages, err = parseNpmLock([]byte(`{"lockfileVersion": 1, "dependencies": {"express": {"version": "4.18.2", "dependencies": {"debug": {"version": "2.6.9"}}}}}`))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"npm:debug:2.6.9", "npm:express:4.18.2"}, packageIds(packages))
+}
+
+func TestParseGoSum(t *testing.T) {
+	packages, err := parseGoSum([]byte(`github.com/BurntSushi/toml v1.5.0 h1:W5quZX/G/csjUnuI8SUYlsHs9M38FC7znL0lIO+DvMg=
+github.com/BurntSushi/toml v1.5.0/go.mod h1:ukJfTF/6rtPPRCnwkur4qwRxa8vTRFBF0uk2lLoLwho=
+golang.org/x/mod v0.30.0/go.mod h1:lAsf5O2EvJeSFMiBxXDki7sCgAxEUcZHXoXMKT4GJKc=
+`))
+	require.NoError(t, err)
+	require.Equal(t, []string{"go:github.com/BurntSushi/toml:v1.5.0"}, packageIds(packages))
+	assert.Equal(t, "github.com/!burnt!sushi/toml/@v", packages[0].Path)
+	assert.Equal(t, []string{"v1.5.0.zip"}, packages[0].FileNames)
+}
+
+func TestParseRequirements(t *testing.T) {
+	packages, err := parseRequirements([]byte(`# Generated by pip-compile
+--index-url https://example.com/simple
+Django==4.2.7 \
+    --hash=sha256:` + sha256A + ` \
+    --hash=sha256:` + sha256B + `
+    # via -r requirements.in
+requests[socks] == 2.31.0 ; python_version >= "3.8"  # pinned
+flask>=2.0
+-e ./local
+`))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"pypi:django:4.2.7", "pypi:requests:2.31.0"}, packageIds(packages))
+	assert.Equal(t, []string{sha256A, sha256B}, packages[0].Sha256)
+	assert.Equal(t, []string{"django-4.2.7*", "Django-4.2.7*"}, packages[0].FileNames)
+	assert.Empty(t, packages[1].Sha256)
+}
+
+func TestParsePoetryLock(t *testing.T) {
+	packages, err := parsePoetryLock([]byte(`
+[[package]]
+name = "Typing_Extensions"
+version = "4.8.0"
+files = [
+    {file = "typing_extensions-4.8.0-py3-none-any.whl", hash = "sha256:` + sha256A + `"},
+]
+
+[package.dependencies]
+Old = ">=1.0"
+
+[[package]]
+name = "my-lib"
+version = "0.1.0"
+
+[package.source]
+type = "directory"
+url = "../my-lib"
+
+[[package]]
+name = "old"
+version = "1.0"
+
+[metadata.files]
+old = [
+    {file = "old-1.0.tar.gz", hash = "sha256:` + sha256B + `"},
+]
+`))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"pypi:typing-extensions:4.8.0", "pypi:old:1.0"}, packageIds(packages))
+	assert.Equal(t, []string{sha256A}, packages[0].Sha256)
+	assert.Equal(t, []string{"old"}, packages[0].Dependencies)
+	assert.Equal(t, []string{sha256B}, packages[1].Sha256)
+}
+
+func TestParsePdmLock(t *testing.T) {
+	packages, err := parsePdmLock([]byte(`
+[metadata]
+lock_version = "4.4.1"
+
+[[package]]
+name = "requests"
+version = "2.31.0"
+dependencies = [
+    "certifi>=2017.4.17",
+    "charset-normalizer<4,>=2",
+]
+files = [
+    {file = "requests-2.31.0-py3-none-any.whl", hash = "sha256:` + sha256A + `"},
+]
+
+[[package]]
+name = "requests"
+version = "2.31.0"
+extras = ["socks"]
+dependencies = [
+    "PySocks!=1.5.7,>=1.5.6",
+    "requests==2.31.0",
+]
+files = [
+    {file = "requests-2.31.0-py3-none-any.whl", hash = "sha256:` + sha256A + `"},
+]
+
+[[package]]
+name = "Charset_Normalizer"
+version = "3.3.2"
+files = [
+    {file = "charset_normalizer-3.3.2.tar.gz", hash = "sha256:` + sha256B + `"},
+]
+
+[[package]]
+name = "my-lib"
+version = "0.1.0"
+path = "../my-lib"
+`))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"pypi:requests:2.31.0", "pypi:charset-normalizer:3.3.2"}, packageIds(packages))
+	assert.Equal(t, []string{sha256A}, packages[0].Sha256)
+	assert.Equal(t, []string{"certifi", "charset-normalizer", "pysocks"}, packages[0].Dependencies)
+	assert.Equal(t, []string{sha256B}, packages[1].Sha256)
+}
+
+func TestParseGemfileLock(t *testing.T) {
+	packages, err := parseGemfileLock([]byte(`GIT
+  remote: https://github.com/org/forked.git
+  specs:
+    forked (0.1.0)
+
+GEM
+  remote: https://rubygems.org/
+  specs:
+    nokogiri (1.15.4-x86_64-linux)
+      racc (~> 1.4)
+    racc (1.7.1)
+
+PLATFORMS
+  x86_64-linux
+`))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"gem:nokogiri:1.15.4-x86_64-linux", "gem:racc:1.7.1"}, packageIds(packages))
+	assert.Equal(t, []string{"nokogiri-1.15.4-x86_64-linux.gem"}, packages[0].FileNames)
+}
+
+func TestParseCargoLock(t *testing.T) {
+	packages, err := parseCargoLock([]byte(`version = 3
+
+[[package]]
+name = "app"
+version = "0.1.0"
+
+[[package]]
+name = "serde"
+version = "1.0.190"
+source = "registry+https://github.com/rust-lang/crates.io-index"
+checksum = "` + sha256A + `"
+dependencies = [
+ "serde_derive",
+ "syn 2.0.39 (registry+https://github.com/rust-lang/crates.io-index)",
+]
+
+[[package]]
+name = "forked"
+version = "0.2.0"
+source = "git+https://github.com/org/forked#abc"
+`))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"cargo:serde:1.0.190"}, packageIds(packages))
+	assert.Equal(t, []string{sha256A}, packages[0].Sha256)
+	assert.Equal(t, []string{"serde_derive", "syn"}, packages[0].Dependencies)
+}
+
+func TestParseConanLock(t *testing.T) {
+	packages, err := parseConanLock([]byte(`{
+    "version": "0.5",
+    "requires": [
+        "zlib/1.2.13#97d5730b529b4224045fe7090592d4c1%1692672717.68",
+        "poco/1.12.4@acme/stable#8b2d1e0f9a7c6b5d4e3f2a1b0c9d8e7f%1692672717.049"
+    ],
+    "build_requires": ["cmake/3.27.4#b5e4c0e5d2e6a4a1c3f8e9d7b6a5c4d3%1692672716.5"]
+}`))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"conan:cmake:3.27.4", "conan:poco:1.12.4", "conan:zlib:1.2.13"}, packageIds(packages))
+	assert.Equal(t, "acme/poco/1.12.4/stable/8b2d1e0f9a7c6b5d4e3f2a1b0c9d8e7f/export", packages[1].Path)
+	assert.Equal(t, "_/zlib/1.2.13/_/97d5730b529b4224045fe7090592d4c1/export", packages[2].Path)
+	assert.True(t, packages[2].Matches("conan-remote/_/zlib/1.2.13/_/97d5730b529b4224045fe7090592d4c1/export", "conanfile.py", ""))
+
+	// Conan 1.x lockfiles record the graph, in which revisions are optional.
+	packages, err = parseConanLock([]byte(`{
+    "version": "0.4",
+    "graph_lock": {
+        "nodes": {
+            "0": {"path": "conanfile.txt", "requires": ["1"]},
+            "1": {"ref": "openssl/1.1.1t", "requires": ["2"]},
+            "2": {"ref": "zlib/1.2.13"}
+        }
+    }
+}`))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"conan:openssl:1.1.1t", "conan:zlib:1.2.13"}, packageIds(packages))
+	assert.Equal(t, []string{"zlib"}, packages[0].Dependencies)
+	assert.Equal(t, "_/zlib/1.2.13/_/0/export", packages[1].Path)
+}
+
+func TestParsePackageResolved(t *testing.T) {
+	packages, err := parsePackageResolved([]byte(`{
+  "pins" : [
+    {
+      "identity" : "apple.swift-argument-parser",
+      "kind" : "registry",
+      "location" : "",
+      "state" : {"version" : "1.2.3"}
+    },
+    {
+      "identity" : "swift-log",
+      "kind" : "remoteSourceControl",
+      "location" : "https://github.com/apple/swift-log.git",
+      "state" : {"revision" : "532d8b529501fb73a2455b179e0bbb6d49b652ed", "version" : "1.5.3"}
+    }
+  ],
+  "version" : 2
+}`))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"swift:apple.swift-argument-parser:1.2.3"}, packageIds(packages))
+	assert.Equal(t, []string{"swift-argument-parser-1.2.3.zip"}, packages[0].FileNames)
+}
+
+func TestParsePodfileLock(t *testing.T) {
+	packages, err := parsePodfileLock([]byte(`PODS:
+  - Alamofire (5.8.1)
+  - Firebase/Core (10.18.0):
+    - Firebase/CoreOnly
+    - FirebaseAnalytics (= 10.18.0)
+  - Firebase/CoreOnly (10.18.0):
+    - FirebaseCore (= 10.18.0)
+  - FirebaseAnalytics (10.18.0)
+  - FirebaseCore (10.18.0)
+  - MyLib (0.1.0)
+
+DEPENDENCIES:
+  - Alamofire (~> 5.8)
+  - Firebase/Core
+  - MyLib (from ` + "`../MyLib`" + `)
+
+EXTERNAL SOURCES:
+  MyLib:
+    :path: "../MyLib"
+
+COCOAPODS: 1.14.3
+`))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"cocoapods:Alamofire:5.8.1", "cocoapods:Firebase:10.18.0", "cocoapods:FirebaseAnalytics:10.18.0", "cocoapods:FirebaseCore:10.18.0"}, packageIds(packages))
+	assert.Equal(t, []string{"FirebaseAnalytics", "FirebaseCore"}, packages[1].Dependencies)
+	assert.Equal(t, []string{"Firebase-10.18.0.tar.gz"}, packages[1].FileNames)
+}
+
+func TestParse(t *testing.T) {
+	lockfilePath := filepath.Join(t.TempDir(), "dev-requirements.txt")
+	require.NoError(t, os.WriteFile(lockfilePath, []byte("pytest==7.4.3\n"), 0600))
+	packages, err := Parse(lockfilePath)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"pypi:pytest:7.4.3"}, packageIds(packages))
+
+	_, err = Parse(filepath.Join(t.TempDir(), "yarn.lock"))
+	assert.ErrorContains(t, err, "unsupported lockfile")
+}
+
+func TestSetRequestedBy(t *testing.T) {
+	packages := []Package{
+		{Name: "requests", Version: "2.31.0", Dependencies: []string{"certifi", "urllib3"}},
+		{Name: "certifi", Version: "2023.7.22"},
+		{Name: "urllib3", Version: "2.0.7"},
+		{Name: "pytest", Version: "7.4.3"},
+	}
+	// The files of urllib3 weren't found in Artifactory.
+	dependencies := []buildinfo.Dependency{{Id: "requests:2.31.0"}, {Id: "certifi:2023.7.22"}, {Id: "pytest:7.4.3"}}
+	SetRequestedBy(dependencies, packages, "my-app:1.0.0")
+
+	assert.Equal(t, [][]string{{"my-app:1.0.0"}}, dependencies[0].RequestedBy)
+	assert.Equal(t, [][]string{{"requests:2.31.0", "my-app:1.0.0"}}, dependencies[1].RequestedBy)
+	assert.Equal(t, [][]string{{"my-app:1.0.0"}}, dependencies[2].RequestedBy)
+}
+
+type aqlServicesManager struct {
+	artifactory.EmptyArtifactoryServicesManager
+	results []serviceutils.ResultItem
+	queries []string
+}
+
+func (asm *aqlServicesManager) Aql(query string) (io.ReadCloser, error) {
+	asm.queries = append(asm.queries, query)
+	content, err := json.Marshal(serviceutils.AqlSearchResult{Results: asm.results})
+	if err != nil {
+		return nil, err
+	}
+	return io.NopCloser(strings.NewReader(string(content))), nil
+}
+
+func TestResolve(t *testing.T) {
+	packages := []Package{
+		{Type: Npm, Name: "debug", Version: "4.3.4", FileNames: []string{"debug-4.3.4.tgz"}, Path: "debug/-"},
+		{Type: Cargo, Name: "serde", Version: "1.0.190", FileNames: []string{"serde-1.0.190.crate"}, Sha256: []string{sha256A}},
+		{Type: Gem, Name: "racc", Version: "1.7.1", FileNames: []string{"racc-1.7.1.gem"}},
+	}
+	servicesManager := &aqlServicesManager{results: []serviceutils.ResultItem{
+		{Repo: "npm-remote-cache", Path: "other/-", Name: "debug-4.3.4.tgz", Actual_Sha1: "wrong-path"},
+		{Repo: "npm-remote-cache", Path: "debug/-", Name: "debug-4.3.4.tgz", Actual_Sha1: "debug-sha1", Actual_Md5: "debug-md5"},
+		{Repo: "cargo-remote-cache", Path: "crates/serde", Name: "serde-1.0.190.crate", Actual_Sha1: "serde-sha1", Sha256: sha256A},
+	}}
+	dependencies, unresolved, err := Resolve(servicesManager, packages, []string{"npm-remote-cache", "cargo-remote-cache"})
+	require.NoError(t, err)
+	assert.Equal(t, []buildinfo.Dependency{
+		{Id: "debug:4.3.4", Type: Npm, Checksum: buildinfo.Checksum{Sha1: "debug-sha1", Md5: "debug-md5"}},
+		{Id: "serde:1.0.190", Type: Cargo, Checksum: buildinfo.Checksum{Sha1: "serde-sha1", Sha256: sha256A}},
+	}, dependencies)
+	assert.Equal(t, []Package{packages[2]}, unresolved)
+	require.Len(t, servicesManager.queries, 1)
+	assert.Contains(t, servicesManager.queries[0], `{"sha256":"`+sha256A+`"}`)
+	assert.Contains(t, servicesManager.queries[0], `{"path":{"$match":"*debug/-"}}`)
+	assert.Contains(t, servicesManager.queries[0], `{"repo":"npm-remote-cache"}`)
+}
+
+func TestCollectDependencies(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/search/aql", r.URL.Path)
+		_, _ = w.Write([]byte(`{"results": [{"repo": "cargo-remote-cache", "path": "crates/serde", "name": "serde-1.0.190.crate", "actual_sha1": "serde-sha1", "sha256": "` + sha256A + `"}]}`))
+	}))
+	defer server.Close()
+	lockfilePath := filepath.Join(t.TempDir(), "Cargo.lock")
+	require.NoError(t, os.WriteFile(lockfilePath, []byte(`version = 3
+
+[[package]]
+name = "serde"
+version = "1.0.190"
+source = "registry+https://github.com/rust-lang/crates.io-index"
+checksum = "`+sha256A+`"
+
+[[package]]
+name = "missing"
+version = "0.1.0"
+source = "registry+https://github.com/rust-lang/crates.io-index"
+checksum = "`+sha256B+`"
+`), 0644))
+
+	dependencies, err := CollectDependencies(&config.ServerDetails{ArtifactoryUrl: server.URL + "/"}, lockfilePath, "app:0.1.0")
+	require.NoError(t, err)
+	assert.Equal(t, []buildinfo.Dependency{{
+		Id:          "serde:1.0.190",
+		Type:        Cargo,
+		Checksum:    buildinfo.Checksum{Sha1: "serde-sha1", Sha256: sha256A},
+		RequestedBy: [][]string{{"app:0.1.0"}},
+	}}, dependencies)
+
+	// Nothing is saved without a lockfile.
+	assert.NoError(t, SaveModule(nil, nil, filepath.Join(t.TempDir(), "Cargo.lock"), "app:0.1.0", Cargo))
+}
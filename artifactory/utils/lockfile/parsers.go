@@ -0,0 +1,505 @@
+package lockfile
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"net/url"
+	"path"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/jfrog/jfrog-client-go/utils/log"
+	"github.com/pelletier/go-toml/v2"
+	"golang.org/x/mod/module"
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	// A pinned requirement, such as 'requests[socks]==2.31.0 ; python_version >= "3.8"'.
+	pinnedRequirementPattern = regexp.MustCompile(`^([A-Za-z0-9][A-Za-z0-9._-]*)\s*(?:\[[^\]]*])?\s*===?\s*([^\s;,]+)`)
+	requirementHashPattern   = regexp.MustCompile(`--hash[=\s]sha256:([0-9a-fA-F]{64})`)
+	// A gem in the specs of the GEM section, such as '    nokogiri (1.15.4-x86_64-linux)'. Its dependencies are indented further.
+	gemSpecPattern     = regexp.MustCompile(`^    ([^\s(]+) \(([^)]+)\)$`)
+	pypiNameSeparators = regexp.MustCompile(`[-_.]+`)
+	// The name of the package of a requirement, such as 'charset-normalizer' in 'charset-normalizer<4,>=2'.
+	pypiRequirementNamePattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._-]*`)
+	// A pod in the PODS section of a Podfile.lock, such as 'Firebase/Core (10.18.0)', or a dependency of a pod, such as
+	// 'FirebaseAnalytics (= 10.18.0)'.
+	podPattern = regexp.MustCompile(`^([^\s(/]+)(?:/\S*)?(?: \(([^)]+)\))?$`)
+)
+
+type npmLock struct {
+	Packages     map[string]npmLockPackage    `json:"packages"`
+	Dependencies map[string]npmLockDependency `json:"dependencies"`
+}
+
+type npmLockPackage struct {
+	Name     string `json:"name"`
+	Version  string `json:"version"`
+	Resolved string `json:"resolved"`
+	Link     bool   `json:"link"`
+}
+
+type npmLockDependency struct {
+	Version      string                       `json:"version"`
+	Resolved     string                       `json:"resolved"`
+	Dependencies map[string]npmLockDependency `json:"dependencies"`
+}
+
+// Reads the packages of a package-lock.json. Lockfiles of version 2 and above list the installed packages by their
+// paths under node_modules, and older lockfiles list them as a tree of dependencies.
+func parseNpmLock(content []byte) ([]Package, error) {
+	var lock npmLock
+	if err := json.Unmarshal(content, &lock); err != nil {
+		return nil, err
+	}
+	packages := make(map[string]Package)
+	for packagePath, lockPackage := range lock.Packages {
+		if packagePath == "" || lockPackage.Link || lockPackage.Version == "" {
+			continue
+		}
+		name := lockPackage.Name
+		if name == "" {
+			name = packagePath[strings.LastIndex(packagePath, "node_modules/")+len("node_modules/"):]
+		}
+		addNpmPackage(packages, name, lockPackage.Version, lockPackage.Resolved)
+	}
+	if len(lock.Packages) == 0 {
+		addNpmDependencies(packages, lock.Dependencies)
+	}
+	return sortedPackages(packages), nil
+}
+
+func addNpmDependencies(packages map[string]Package, dependencies map[string]npmLockDependency) {
+	for name, dependency := range dependencies {
+		addNpmPackage(packages, name, dependency.Version, dependency.Resolved)
+		addNpmDependencies(packages, dependency.Dependencies)
+	}
+}
+
+func addNpmPackage(packages map[string]Package, name, version, resolved string) {
+	// Packages installed from git, local directories or aliases aren't in the registry.
+	if strings.Contains(version, ":") {
+		log.Debug("Skipping the npm package", name, "which isn't installed from a registry:", version)
+		return
+	}
+	fileName := path.Base(name) + "-" + version + ".tgz"
+	if resolvedUrl, err := url.Parse(resolved); err == nil && strings.HasSuffix(resolvedUrl.Path, ".tgz") {
+		fileName = path.Base(resolvedUrl.Path)
+	}
+	npmPackage := Package{Type: Npm, Name: name, Version: version, FileNames: []string{fileName}, Path: name + "/-"}
+	packages[npmPackage.Id()] = npmPackage
+}
+
+// Reads the modules of a go.sum. The modules whose go.mod file only is listed aren't downloaded by the build.
+func parseGoSum(content []byte) ([]Package, error) {
+	packages := make(map[string]Package)
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 3 || strings.HasSuffix(fields[1], "/go.mod") {
+			continue
+		}
+		modulePath, version := fields[0], fields[1]
+		escapedPath, err := module.EscapePath(modulePath)
+		if err != nil {
+			return nil, err
+		}
+		escapedVersion, err := module.EscapeVersion(version)
+		if err != nil {
+			return nil, err
+		}
+		goPackage := Package{Type: Go, Name: modulePath, Version: version, FileNames: []string{escapedVersion + ".zip"}, Path: escapedPath + "/@v"}
+		packages[goPackage.Id()] = goPackage
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return sortedPackages(packages), nil
+}
+
+// Reads the pinned requirements of a requirements.txt, such as generated by 'pip freeze' or 'pip-compile'.
+// Requirements which aren't pinned to a version are skipped.
+func parseRequirements(content []byte) ([]Package, error) {
+	var packages []Package
+	// Join the lines continued by a backslash, as the hashes of a requirement are usually listed on separate lines.
+	requirements := strings.ReplaceAll(strings.ReplaceAll(string(content), "\r\n", "\n"), "\\\n", " ")
+	for _, requirement := range strings.Split(requirements, "\n") {
+		// Comments start a line, or follow whitespace.
+		requirement, _, _ = strings.Cut(strings.TrimSpace(requirement), " #")
+		if requirement == "" || strings.HasPrefix(requirement, "#") || strings.HasPrefix(requirement, "-") {
+			continue
+		}
+		match := pinnedRequirementPattern.FindStringSubmatch(requirement)
+		if match == nil {
+			log.Debug("Skipping the requirement which isn't pinned to a version:", requirement)
+			continue
+		}
+		pypiPackage := newPypiPackage(match[1], match[2])
+		for _, hashMatch := range requirementHashPattern.FindAllStringSubmatch(requirement, -1) {
+			pypiPackage.Sha256 = append(pypiPackage.Sha256, strings.ToLower(hashMatch[1]))
+		}
+		packages = append(packages, pypiPackage)
+	}
+	return packages, nil
+}
+
+type poetryFile struct {
+	File string `toml:"file"`
+	Hash string `toml:"hash"`
+}
+
+type poetryLock struct {
+	Package []struct {
+		Name         string         `toml:"name"`
+		Version      string         `toml:"version"`
+		Files        []poetryFile   `toml:"files"`
+		Dependencies map[string]any `toml:"dependencies"`
+		Source       *struct {
+			Type string `toml:"type"`
+		} `toml:"source"`
+	} `toml:"package"`
+	// The files of the packages in lockfiles created by Poetry 1.4 and below.
+	Metadata struct {
+		Files map[string][]poetryFile `toml:"files"`
+	} `toml:"metadata"`
+}
+
+// Reads the packages of a poetry.lock, which are found by the checksums of their distribution files.
+func parsePoetryLock(content []byte) ([]Package, error) {
+	var lock poetryLock
+	if err := toml.Unmarshal(content, &lock); err != nil {
+		return nil, err
+	}
+	var packages []Package
+	for _, lockPackage := range lock.Package {
+		// Packages installed from git, local directories or files aren't in the package index.
+		if lockPackage.Source != nil && lockPackage.Source.Type != "legacy" {
+			log.Debug("Skipping the Poetry package", lockPackage.Name, "which is installed from a", lockPackage.Source.Type)
+			continue
+		}
+		pypiPackage := newPypiPackage(lockPackage.Name, lockPackage.Version)
+		files := lockPackage.Files
+		if len(files) == 0 {
+			files = lock.Metadata.Files[lockPackage.Name]
+		}
+		pypiPackage.Sha256 = getPypiFilesSha256(files)
+		for dependency := range lockPackage.Dependencies {
+			pypiPackage.Dependencies = append(pypiPackage.Dependencies, normalizePypiName(dependency))
+		}
+		slices.Sort(pypiPackage.Dependencies)
+		packages = append(packages, pypiPackage)
+	}
+	return packages, nil
+}
+
+type pdmLock struct {
+	Package []struct {
+		Name         string       `toml:"name"`
+		Version      string       `toml:"version"`
+		Files        []poetryFile `toml:"files"`
+		Dependencies []string     `toml:"dependencies"`
+		// Packages installed from local directories or files, URLs or VCS aren't in the package index.
+		Path string `toml:"path"`
+		Url  string `toml:"url"`
+		Git  string `toml:"git"`
+	} `toml:"package"`
+}
+
+// Reads the packages of a pdm.lock, which are found by the checksums of their distribution files. A package installed
+// with extras is locked once per set of extras, so its entries are merged.
+func parsePdmLock(content []byte) ([]Package, error) {
+	var lock pdmLock
+	if err := toml.Unmarshal(content, &lock); err != nil {
+		return nil, err
+	}
+	var packages []Package
+	for _, lockPackage := range lock.Package {
+		if lockPackage.Path != "" || lockPackage.Url != "" || lockPackage.Git != "" {
+			log.Debug("Skipping the PDM package", lockPackage.Name, "which isn't installed from a package index")
+			continue
+		}
+		pypiPackage := newPypiPackage(lockPackage.Name, lockPackage.Version)
+		index := slices.IndexFunc(packages, func(p Package) bool { return p.Id() == pypiPackage.Id() })
+		if index == -1 {
+			pypiPackage.Sha256 = getPypiFilesSha256(lockPackage.Files)
+			packages = append(packages, pypiPackage)
+			index = len(packages) - 1
+		}
+		for _, requirement := range lockPackage.Dependencies {
+			match := pypiRequirementNamePattern.FindString(requirement)
+			if dependency := normalizePypiName(match); match != "" && dependency != pypiPackage.Name && !slices.Contains(packages[index].Dependencies, dependency) {
+				packages[index].Dependencies = append(packages[index].Dependencies, dependency)
+			}
+		}
+	}
+	return packages, nil
+}
+
+func getPypiFilesSha256(files []poetryFile) (checksums []string) {
+	for _, file := range files {
+		if checksum, found := strings.CutPrefix(file.Hash, "sha256:"); found {
+			checksums = append(checksums, checksum)
+		}
+	}
+	return
+}
+
+func normalizePypiName(name string) string {
+	return strings.ToLower(pypiNameSeparators.ReplaceAllString(name, "-"))
+}
+
+// Returns a package of the Python package index, named by its normalized name. Its distribution files are named by the
+// name with underscores (wheels), or as it's spelled in the package's metadata (source distributions).
+func newPypiPackage(name, version string) Package {
+	normalizedName := normalizePypiName(name)
+	var fileNames []string
+	for _, fileName := range []string{strings.ReplaceAll(normalizedName, "-", "_"), normalizedName, name} {
+		if fileName += "-" + version + "*"; !slices.Contains(fileNames, fileName) {
+			fileNames = append(fileNames, fileName)
+		}
+	}
+	return Package{Type: Pypi, Name: normalizedName, Version: version, FileNames: fileNames}
+}
+
+// Reads the gems of the GEM section of a Gemfile.lock. Gems of the GIT and PATH sections aren't in the gem source.
+func parseGemfileLock(content []byte) ([]Package, error) {
+	var packages []Package
+	inGemSection := false
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if line != "" && !strings.HasPrefix(line, " ") {
+			inGemSection = line == "GEM"
+			continue
+		}
+		if !inGemSection {
+			continue
+		}
+		if match := gemSpecPattern.FindStringSubmatch(line); match != nil {
+			packages = append(packages, Package{Type: Gem, Name: match[1], Version: match[2], FileNames: []string{match[1] + "-" + match[2] + ".gem"}})
+		}
+	}
+	return packages, scanner.Err()
+}
+
+type cargoLock struct {
+	Package []struct {
+		Name     string `toml:"name"`
+		Version  string `toml:"version"`
+		Source   string `toml:"source"`
+		Checksum string `toml:"checksum"`
+		// The dependencies of the crate, as "<name>", or "<name> <version>" if the lockfile has several versions of the
+		// crate, optionally followed by " (<source>)".
+		Dependencies []string `toml:"dependencies"`
+	} `toml:"package"`
+}
+
+// Reads the crates of a Cargo.lock. The crates of the workspace have no source, and crates from git have no checksum.
+func parseCargoLock(content []byte) ([]Package, error) {
+	var lock cargoLock
+	if err := toml.Unmarshal(content, &lock); err != nil {
+		return nil, err
+	}
+	var packages []Package
+	for _, crate := range lock.Package {
+		if !strings.HasPrefix(crate.Source, "registry+") && !strings.HasPrefix(crate.Source, "sparse+") {
+			continue
+		}
+		cargoPackage := Package{Type: Cargo, Name: crate.Name, Version: crate.Version, FileNames: []string{crate.Name + "-" + crate.Version + ".crate"}}
+		if crate.Checksum != "" {
+			cargoPackage.Sha256 = []string{crate.Checksum}
+		}
+		for _, dependency := range crate.Dependencies {
+			cargoPackage.Dependencies = append(cargoPackage.Dependencies, strings.Fields(dependency)[0])
+		}
+		packages = append(packages, cargoPackage)
+	}
+	return packages, nil
+}
+
+type conanLock struct {
+	// Conan 2.x lockfiles list the references of the locked recipes.
+	Requires       []string `json:"requires"`
+	BuildRequires  []string `json:"build_requires"`
+	PythonRequires []string `json:"python_requires"`
+	// Conan 1.x lockfiles record the graph of the locked recipes.
+	GraphLock struct {
+		Nodes map[string]conanLockNode `json:"nodes"`
+	} `json:"graph_lock"`
+}
+
+type conanLockNode struct {
+	Ref           string   `json:"ref"`
+	Path          string   `json:"path"`
+	Requires      []string `json:"requires"`
+	BuildRequires []string `json:"build_requires"`
+}
+
+// Reads the recipes of a conan.lock of Conan 2.x, or of the graph of a conan.lock of Conan 1.x. The recipes are found by
+// their conanfile.py, in the export folder of their revision.
+func parseConanLock(content []byte) ([]Package, error) {
+	var lock conanLock
+	if err := json.Unmarshal(content, &lock); err != nil {
+		return nil, err
+	}
+	packages := map[string]Package{}
+	for _, reference := range slices.Concat(lock.Requires, lock.BuildRequires, lock.PythonRequires) {
+		addConanPackage(packages, reference, nil)
+	}
+	nodes := lock.GraphLock.Nodes
+	for _, node := range nodes {
+		// The node of the consumer has the path of its conanfile instead of a reference.
+		if node.Ref == "" || node.Path != "" {
+			continue
+		}
+		var dependencies []string
+		for _, nodeId := range slices.Concat(node.Requires, node.BuildRequires) {
+			if dependency, found := nodes[nodeId]; found && dependency.Path == "" {
+				name, _, _ := strings.Cut(dependency.Ref, "/")
+				dependencies = append(dependencies, name)
+			}
+		}
+		addConanPackage(packages, node.Ref, dependencies)
+	}
+	return sortedPackages(packages), nil
+}
+
+// Adds the recipe of a reference, such as 'zlib/1.2.13#97d5730b529b4224045fe7090592d4c1%1692672717.68' or
+// 'poco/1.9.4@user/stable#rrev'. Conan stores the recipes in '<user>/<name>/<version>/<channel>/<revision>/export',
+// in which a missing user and channel are '_', and a missing revision is '0'. A recipe which is in the graph several
+// times, such as in both the host and the build contexts, is added once with all its dependencies.
+func addConanPackage(packages map[string]Package, reference string, dependencies []string) {
+	reference, _, _ = strings.Cut(reference, "%")
+	recipe, revision, _ := strings.Cut(reference, "#")
+	nameVersion, userChannel, _ := strings.Cut(recipe, "@")
+	name, version, found := strings.Cut(nameVersion, "/")
+	if !found {
+		log.Debug("Skipping the invalid Conan reference", reference)
+		return
+	}
+	user, channel, _ := strings.Cut(userChannel, "/")
+	if user == "" {
+		user, channel = "_", "_"
+	}
+	if revision == "" {
+		revision = "0"
+	}
+	conanPackage := Package{
+		Type:      Conan,
+		Name:      name,
+		Version:   version,
+		FileNames: []string{"conanfile.py"},
+		Path:      path.Join(user, name, version, channel, revision, "export"),
+	}
+	conanPackage.Dependencies = slices.Concat(packages[conanPackage.Id()].Dependencies, dependencies)
+	slices.Sort(conanPackage.Dependencies)
+	conanPackage.Dependencies = slices.Compact(conanPackage.Dependencies)
+	packages[conanPackage.Id()] = conanPackage
+}
+
+type packageResolved struct {
+	// Version 1 of Package.resolved wraps the pins in an object, and versions 2 and 3 list them at the root.
+	Object struct {
+		Pins []packageResolvedPin `json:"pins"`
+	} `json:"object"`
+	Pins []packageResolvedPin `json:"pins"`
+}
+
+type packageResolvedPin struct {
+	Identity string `json:"identity"`
+	Kind     string `json:"kind"`
+	State    struct {
+		Version string `json:"version"`
+	} `json:"state"`
+}
+
+// Reads the packages of a Package.resolved, which are resolved from a registry. Their identity is <scope>.<name>, and
+// their source archive is <name>-<version>.zip. Packages resolved from source control aren't in the registry.
+func parsePackageResolved(content []byte) ([]Package, error) {
+	var resolved packageResolved
+	if err := json.Unmarshal(content, &resolved); err != nil {
+		return nil, err
+	}
+	var packages []Package
+	for _, pin := range slices.Concat(resolved.Object.Pins, resolved.Pins) {
+		if pin.Kind != "registry" || pin.State.Version == "" {
+			continue
+		}
+		_, name, found := strings.Cut(pin.Identity, ".")
+		if !found {
+			log.Debug("Skipping the invalid Swift package identity", pin.Identity)
+			continue
+		}
+		packages = append(packages, Package{Type: Swift, Name: pin.Identity, Version: pin.State.Version, FileNames: []string{name + "-" + pin.State.Version + ".zip"}})
+	}
+	return packages, nil
+}
+
+type podfileLock struct {
+	// The pods, each as '<pod> (<version>)', or as a map from it to the pod's dependencies.
+	Pods []any `yaml:"PODS"`
+	// The pods which are installed from git or from a local path, rather than from a spec repo.
+	ExternalSources map[string]any `yaml:"EXTERNAL SOURCES"`
+}
+
+// Reads the pods of a Podfile.lock, which are installed from a spec repo. The subspecs of a pod, such as
+// 'Firebase/Core', are part of its root pod, whose source archive is <pod>-<version>.tar.gz.
+func parsePodfileLock(content []byte) ([]Package, error) {
+	var lock podfileLock
+	if err := yaml.Unmarshal(content, &lock); err != nil {
+		return nil, err
+	}
+	packages := map[string]Package{}
+	for _, pod := range lock.Pods {
+		var spec string
+		var dependencies []string
+		switch value := pod.(type) {
+		case string:
+			spec = value
+		case map[string]any:
+			for key, podDependencies := range value {
+				spec = key
+				list, _ := podDependencies.([]any)
+				for _, dependency := range list {
+					if dependencySpec, ok := dependency.(string); ok {
+						if match := podPattern.FindStringSubmatch(dependencySpec); match != nil {
+							dependencies = append(dependencies, match[1])
+						}
+					}
+				}
+			}
+		}
+		match := podPattern.FindStringSubmatch(spec)
+		if match == nil || match[2] == "" {
+			log.Debug("Skipping the invalid pod", spec)
+			continue
+		}
+		name, version := match[1], match[2]
+		if _, external := lock.ExternalSources[name]; external {
+			continue
+		}
+		podPackage := Package{Type: Pods, Name: name, Version: version, FileNames: []string{name + "-" + version + ".tar.gz"}}
+		// Subspecs depend on each other and on their root pod, which aren't dependencies of the root pod.
+		dependencies = slices.DeleteFunc(dependencies, func(dependency string) bool { return dependency == name })
+		podPackage.Dependencies = slices.Concat(packages[podPackage.Id()].Dependencies, dependencies)
+		slices.Sort(podPackage.Dependencies)
+		podPackage.Dependencies = slices.Compact(podPackage.Dependencies)
+		packages[podPackage.Id()] = podPackage
+	}
+	return sortedPackages(packages), nil
+}
+
+func sortedPackages(packages map[string]Package) []Package {
+	sorted := make([]Package, 0, len(packages))
+	for _, lockPackage := range packages {
+		sorted = append(sorted, lockPackage)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Id() < sorted[j].Id()
+	})
+	return sorted
+}
@@ -0,0 +1,127 @@
+package lockfile
+
+import (
+	"strings"
+
+	buildinfo "github.com/jfrog/build-info-go/entities"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/aql"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	serviceutils "github.com/jfrog/jfrog-client-go/artifactory/services/utils"
+)
+
+// The number of packages searched by each query.
+const resolveBatchSize = 100
+
+// Resolve finds the files of the packages in Artifactory, in the provided repositories or in all the repositories if none
+// are provided. It returns the dependencies of the found packages, with the checksums of their files, and the packages
+// which weren't found. If several files of a package are found, the first in the order of their paths is used.
+func Resolve(servicesManager artifactory.ArtifactoryServicesManager, packages []Package, repos []string) (dependencies []buildinfo.Dependency, unresolved []Package, err error) {
+	for start := 0; start < len(packages); start += resolveBatchSize {
+		batch := packages[start:min(start+resolveBatchSize, len(packages))]
+		resolved := make([]*serviceutils.ResultItem, len(batch))
+		query, err := createResolveQuery(batch, repos)
+		if err != nil {
+			return nil, nil, err
+		}
+		err = aql.SearchItems(servicesManager, query, aql.DefaultPageSize, func(item *serviceutils.ResultItem) error {
+			for i, batchPackage := range batch {
+				if resolved[i] == nil && batchPackage.Matches(item.Path, item.Name, item.Sha256) {
+					resolved[i] = item
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		for i, item := range resolved {
+			if item == nil {
+				unresolved = append(unresolved, batch[i])
+				continue
+			}
+			dependencies = append(dependencies, buildinfo.Dependency{
+				Id:   batch[i].Id(),
+				Type: batch[i].Type,
+				Checksum: buildinfo.Checksum{
+					Sha1:   item.Actual_Sha1,
+					Md5:    item.Actual_Md5,
+					Sha256: item.Sha256,
+				},
+			})
+		}
+	}
+	return dependencies, unresolved, nil
+}
+
+func createResolveQuery(packages []Package, repos []string) (string, error) {
+	packagesCriteria := make([]aql.Criteria, 0, len(packages))
+	for _, lockPackage := range packages {
+		packagesCriteria = append(packagesCriteria, lockPackage.criteria())
+	}
+	criteria := []aql.Criteria{aql.Field("type", "file"), aql.Or(packagesCriteria...)}
+	if len(repos) > 0 {
+		reposCriteria := make([]aql.Criteria, 0, len(repos))
+		for _, repo := range repos {
+			reposCriteria = append(reposCriteria, aql.Field("repo", repo))
+		}
+		criteria = append(criteria, aql.Or(reposCriteria...))
+	}
+	return aql.Find("items", aql.And(criteria...)).
+		Include("repo", "path", "name", "actual_sha1", "actual_md5", "sha256").
+		SortAsc("repo", "path", "name").
+		String()
+}
+
+// Returns the AQL criteria of the package's files, which are a superset of the files it matches.
+func (p Package) criteria() aql.Criteria {
+	if len(p.Sha256) > 0 {
+		checksumsCriteria := make([]aql.Criteria, 0, len(p.Sha256))
+		for _, checksum := range p.Sha256 {
+			checksumsCriteria = append(checksumsCriteria, aql.Field("sha256", checksum))
+		}
+		return aql.Or(checksumsCriteria...)
+	}
+	namesCriteria := make([]aql.Criteria, 0, len(p.FileNames))
+	for _, fileName := range p.FileNames {
+		namesCriteria = append(namesCriteria, aql.Field("name", aql.Match(fileName)))
+	}
+	if p.Path == "" {
+		return aql.Or(namesCriteria...)
+	}
+	return aql.And(aql.Or(namesCriteria...), aql.Field("path", aql.Match("*"+p.Path)))
+}
+
+// SetRequestedBy sets the paths through which the dependencies are requested by the module. Packages which aren't
+// dependencies of any other package are considered the direct dependencies of the module.
+func SetRequestedBy(dependencies []buildinfo.Dependency, packages []Package, moduleId string) {
+	dependenciesByName := make(map[string]*buildinfo.Dependency, len(dependencies))
+	for i := range dependencies {
+		name, _, _ := strings.Cut(dependencies[i].Id, ":")
+		dependenciesByName[name] = &dependencies[i]
+	}
+	graph := map[string][]string{}
+	requested := map[string]bool{}
+	for _, lockPackage := range packages {
+		graph[lockPackage.Id()] = lockPackage.Dependencies
+		for _, dependency := range lockPackage.Dependencies {
+			requested[dependency] = true
+		}
+	}
+	for _, lockPackage := range packages {
+		if !requested[lockPackage.Name] {
+			graph[moduleId] = append(graph[moduleId], lockPackage.Name)
+		}
+	}
+	addRequestedBy(buildinfo.Dependency{Id: moduleId, RequestedBy: [][]string{{}}}, graph, dependenciesByName)
+}
+
+func addRequestedBy(parent buildinfo.Dependency, graph map[string][]string, dependenciesByName map[string]*buildinfo.Dependency) {
+	for _, childName := range graph[parent.Id] {
+		child, found := dependenciesByName[childName]
+		if !found || child.NodeHasLoop() || len(child.RequestedBy) >= buildinfo.RequestedByMaxLength {
+			continue
+		}
+		child.UpdateRequestedBy(parent.Id, parent.RequestedBy)
+		addRequestedBy(*child, graph, dependenciesByName)
+	}
+}
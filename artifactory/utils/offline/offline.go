@@ -0,0 +1,291 @@
+package offline
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
+	"github.com/jfrog/jfrog-client-go/http/jfroghttpclient"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const (
+	// OfflineModeEnv selects the offline mode. In the record mode, the responses of the servers are recorded to the
+	// offline cache. In the replay mode, the recorded responses are returned, and no request is sent to the servers.
+	OfflineModeEnv = "JFROG_CLI_OFFLINE_MODE"
+	// OfflineCacheEnv overrides the dir of the recorded responses, which is offline-cache in the JFrog home dir by default.
+	OfflineCacheEnv = "JFROG_CLI_OFFLINE_CACHE"
+
+	RecordMode = "record"
+	ReplayMode = "replay"
+
+	offlineCacheDirName = "offline-cache"
+	// Larger responses, like downloaded artifacts, aren't recorded.
+	maxRecordedBodySize = 1 << 20
+	sha256Header        = "X-Checksum-Sha256"
+)
+
+var (
+	// The APIs which create tokens, API keys and encrypted passwords.
+	secretApiPaths = []string{"/api/security/token", "/api/security/apiKey", "/api/security/encryptedPassword", "/api/v1/tokens", "/api/v1/oidc/token"}
+	secretHeaders  = []string{"Set-Cookie", "Authorization", "Proxy-Authorization", "WWW-Authenticate", "X-JFrog-Art-Api"}
+)
+
+// RecordedResponse is a response of a server, which is recorded to a file of the offline cache.
+type RecordedResponse struct {
+	Method string      `json:"method"`
+	Url    string      `json:"url"`
+	Status int         `json:"status"`
+	Header http.Header `json:"header,omitempty"`
+	Body   []byte      `json:"body,omitempty"`
+}
+
+// GetMode returns the offline mode, or an empty string if the requests are sent to the servers as usual.
+func GetMode() (string, error) {
+	mode := strings.ToLower(strings.TrimSpace(os.Getenv(OfflineModeEnv)))
+	switch mode {
+	case "", RecordMode, ReplayMode:
+		return mode, nil
+	}
+	return "", errorutils.CheckErrorf("invalid %s '%s'. The mode should be %s or %s", OfflineModeEnv, mode, RecordMode, ReplayMode)
+}
+
+// GetCacheDir returns the dir of the recorded responses.
+func GetCacheDir() (string, error) {
+	if cacheDir := os.Getenv(OfflineCacheEnv); cacheDir != "" {
+		return cacheDir, nil
+	}
+	homeDir, err := coreutils.GetJfrogHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(homeDir, offlineCacheDirName), nil
+}
+
+// Attach wraps the transport of the client, so that the responses it receives are recorded, or replayed without
+// sending the requests. It does nothing if the offline mode is disabled.
+func Attach(client *jfroghttpclient.JfrogHttpClient) error {
+	if client == nil || client.GetHttpClient() == nil {
+		return nil
+	}
+	return AttachToHttpClient(client.GetHttpClient().GetClient())
+}
+
+// AttachToHttpClient wraps the transport of the http client like Attach.
+func AttachToHttpClient(httpClient *http.Client) error {
+	mode, err := GetMode()
+	if err != nil || mode == "" || httpClient == nil {
+		return err
+	}
+	if _, attached := httpClient.Transport.(*transport); attached {
+		return nil
+	}
+	cacheDir, err := GetCacheDir()
+	if err != nil {
+		return err
+	}
+	next := httpClient.Transport
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	httpClient.Transport = &transport{next: next, mode: mode, cacheDir: cacheDir}
+	return nil
+}
+
+type transport struct {
+	next     http.RoundTripper
+	mode     string
+	cacheDir string
+}
+
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.mode == ReplayMode {
+		bodyHash, err := hashReplayedRequestBody(req)
+		if err != nil {
+			return nil, err
+		}
+		return replay(req, t.getResponsePath(req, bodyHash))
+	}
+	req, bodyHash, err := hashRecordedRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := t.next.RoundTrip(req)
+	if err != nil || isSecret(req) {
+		return resp, err
+	}
+	// The body is read up to the maximal recorded size, and the rest of it is left to be streamed to the caller.
+	prefix, err := io.ReadAll(io.LimitReader(resp.Body, maxRecordedBodySize+1))
+	if err != nil {
+		return nil, errorutils.CheckError(errors.Join(err, resp.Body.Close()))
+	}
+	if len(prefix) > maxRecordedBodySize {
+		log.Debug("The response of", req.Method, req.URL.Redacted(), "is too large to be recorded")
+		resp.Body = &multiReadCloser{Reader: io.MultiReader(bytes.NewReader(prefix), resp.Body), Closer: resp.Body}
+		return resp, nil
+	}
+	if err = resp.Body.Close(); err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(prefix))
+	recorded := &RecordedResponse{Method: req.Method, Url: req.URL.Redacted(), Status: resp.StatusCode, Header: withoutSecretHeaders(resp.Header), Body: prefix}
+	if err = record(t.getResponsePath(req, bodyHash), recorded); err != nil {
+		log.Warn("Failed to record the response of", req.Method, req.URL.Redacted()+":", err.Error())
+	}
+	return resp, nil
+}
+
+// getResponsePath returns the path to which the response of the request is recorded. Requests with the same method,
+// URL and body have the same response, regardless of their credentials.
+func (t *transport) getResponsePath(req *http.Request, bodyHash []byte) string {
+	hash := sha256.New()
+	hash.Write([]byte(req.Method + "\n" + req.URL.String() + "\n"))
+	hash.Write(bodyHash)
+	return filepath.Join(t.cacheDir, hex.EncodeToString(hash.Sum(nil))+".json")
+}
+
+// hashReplayedRequestBody hashes the body of a request which isn't sent, so its body is consumed.
+func hashReplayedRequestBody(req *http.Request) ([]byte, error) {
+	if bodyHash, hashed := getBodyChecksum(req); hashed {
+		return bodyHash, nil
+	}
+	hash := sha256.New()
+	_, err := io.Copy(hash, req.Body)
+	return hash.Sum(nil), errorutils.CheckError(errors.Join(err, req.Body.Close()))
+}
+
+// hashRecordedRequestBody hashes the body of a request which is sent to the server. If the body can't be read again,
+// it is streamed to a temp file while it is hashed, and the returned request sends the temp file instead.
+func hashRecordedRequestBody(req *http.Request) (*http.Request, []byte, error) {
+	if bodyHash, hashed := getBodyChecksum(req); hashed {
+		return req, bodyHash, nil
+	}
+	hash := sha256.New()
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, nil, errorutils.CheckError(err)
+		}
+		_, err = io.Copy(hash, body)
+		return req, hash.Sum(nil), errorutils.CheckError(errors.Join(err, body.Close()))
+	}
+	bodyFile, err := os.CreateTemp("", "jfrog-offline-body-*")
+	if err != nil {
+		return nil, nil, errors.Join(errorutils.CheckError(err), req.Body.Close())
+	}
+	body := &tempFileBody{bodyFile}
+	_, err = io.Copy(io.MultiWriter(bodyFile, hash), req.Body)
+	if err = errors.Join(err, req.Body.Close()); err == nil {
+		_, err = bodyFile.Seek(0, io.SeekStart)
+	}
+	if err != nil {
+		return nil, nil, errorutils.CheckError(errors.Join(err, body.Close()))
+	}
+	sentReq := req.Clone(req.Context())
+	sentReq.Body = body
+	return sentReq, hash.Sum(nil), nil
+}
+
+// getBodyChecksum returns the checksum of the body which is sent in the headers of the uploads, or true if the
+// request has no body. The body of an upload doesn't need to be read to be hashed.
+func getBodyChecksum(req *http.Request) ([]byte, bool) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, true
+	}
+	if checksum := req.Header.Get(sha256Header); checksum != "" {
+		return []byte(checksum), true
+	}
+	return nil, false
+}
+
+// isSecret returns true for the requests whose responses contain tokens or other secrets, which aren't recorded.
+func isSecret(req *http.Request) bool {
+	for _, secretPath := range secretApiPaths {
+		if strings.Contains(req.URL.Path, secretPath) {
+			return true
+		}
+	}
+	return false
+}
+
+func withoutSecretHeaders(header http.Header) http.Header {
+	header = header.Clone()
+	for _, secretHeader := range secretHeaders {
+		header.Del(secretHeader)
+	}
+	return header
+}
+
+// tempFileBody is the body of a request, which is read from a temp file. The temp file is removed when the transport
+// closes the body.
+type tempFileBody struct {
+	*os.File
+}
+
+func (tfb *tempFileBody) Close() error {
+	return errors.Join(tfb.File.Close(), os.Remove(tfb.Name()))
+}
+
+type multiReadCloser struct {
+	io.Reader
+	io.Closer
+}
+
+func record(responsePath string, recorded *RecordedResponse) error {
+	content, err := json.Marshal(recorded)
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	if err = os.MkdirAll(filepath.Dir(responsePath), 0700); err != nil {
+		return errorutils.CheckError(err)
+	}
+	// Concurrent requests may record the same response, so it's written to a temp file which replaces the recorded file.
+	tempFile, err := os.CreateTemp(filepath.Dir(responsePath), filepath.Base(responsePath)+".*.tmp")
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	_, err = tempFile.Write(content)
+	if err = errors.Join(err, tempFile.Close()); err != nil {
+		return errorutils.CheckError(errors.Join(err, os.Remove(tempFile.Name())))
+	}
+	return errorutils.CheckError(os.Rename(tempFile.Name(), responsePath))
+}
+
+func replay(req *http.Request, responsePath string) (*http.Response, error) {
+	content, err := os.ReadFile(responsePath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, errorutils.CheckErrorf("no response of %s %s was recorded. Record it by running the command with %s=%s", req.Method, req.URL.Redacted(), OfflineModeEnv, RecordMode)
+		}
+		return nil, errorutils.CheckError(err)
+	}
+	recorded := &RecordedResponse{}
+	if err = json.Unmarshal(content, recorded); err != nil {
+		return nil, errorutils.CheckErrorf("failed to parse the recorded response '%s': %s", responsePath, err.Error())
+	}
+	if recorded.Header == nil {
+		recorded.Header = http.Header{}
+	}
+	log.Debug("Replaying the recorded response of", req.Method, req.URL.Redacted())
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", recorded.Status, http.StatusText(recorded.Status)),
+		StatusCode:    recorded.Status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        recorded.Header,
+		Body:          io.NopCloser(bytes.NewReader(recorded.Body)),
+		ContentLength: int64(len(recorded.Body)),
+		Request:       req,
+	}, nil
+}
@@ -0,0 +1,144 @@
+package offline
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/jfrog/jfrog-client-go/http/httpclient"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func post(t *testing.T, client *http.Client, url, body string) (*http.Response, string, error) {
+	resp, err := client.Post(url, "text/plain", strings.NewReader(body))
+	if err != nil {
+		return nil, "", err
+	}
+	responseBody, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	return resp, string(responseBody), nil
+}
+
+func TestRecordAndReplay(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		w.Header().Set("X-Request-Id", "request-id")
+		w.WriteHeader(http.StatusCreated)
+		_, err = w.Write([]byte("results of " + string(body)))
+		assert.NoError(t, err)
+	}))
+	t.Setenv(OfflineCacheEnv, t.TempDir())
+
+	t.Setenv(OfflineModeEnv, RecordMode)
+	client, err := httpclient.ClientBuilder().Build()
+	require.NoError(t, err)
+	require.NoError(t, AttachToHttpClient(client.GetClient()))
+	resp, body, err := post(t, client.GetClient(), server.URL+"/api/search/aql", "items.find()")
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, resp.StatusCode)
+	assert.Equal(t, "results of items.find()", body)
+	server.Close()
+
+	t.Setenv(OfflineModeEnv, ReplayMode)
+	client, err = httpclient.ClientBuilder().Build()
+	require.NoError(t, err)
+	require.NoError(t, AttachToHttpClient(client.GetClient()))
+	resp, body, err = post(t, client.GetClient(), server.URL+"/api/search/aql", "items.find()")
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, resp.StatusCode)
+	assert.Equal(t, "request-id", resp.Header.Get("X-Request-Id"))
+	assert.Equal(t, "results of items.find()", body)
+	assert.Equal(t, 1, requests)
+
+	// A request with another body wasn't recorded.
+	_, _, err = post(t, client.GetClient(), server.URL+"/api/search/aql", "builds.find()")
+	assert.ErrorContains(t, err, "no response of POST "+server.URL+"/api/search/aql was recorded")
+}
+
+func TestGetMode(t *testing.T) {
+	t.Setenv(OfflineModeEnv, "")
+	mode, err := GetMode()
+	assert.NoError(t, err)
+	assert.Empty(t, mode)
+
+	t.Setenv(OfflineModeEnv, "Replay")
+	mode, err = GetMode()
+	assert.NoError(t, err)
+	assert.Equal(t, ReplayMode, mode)
+
+	t.Setenv(OfflineModeEnv, "offline")
+	_, err = GetMode()
+	assert.Error(t, err)
+}
+
+func TestAttachDisabled(t *testing.T) {
+	t.Setenv(OfflineModeEnv, "")
+	httpClient := &http.Client{}
+	require.NoError(t, AttachToHttpClient(httpClient))
+	assert.Nil(t, httpClient.Transport)
+}
+
+func TestRecordSkipsSecretsAndLargeResponses(t *testing.T) {
+	largeBody := strings.Repeat("a", maxRecordedBodySize+1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, err := io.Copy(io.Discard, r.Body)
+		assert.NoError(t, err)
+		w.Header().Set("Set-Cookie", "session=secret")
+		if r.URL.Path == "/large" {
+			_, err = w.Write([]byte(largeBody))
+		} else {
+			_, err = w.Write([]byte("response of " + r.URL.Path))
+		}
+		assert.NoError(t, err)
+	}))
+	defer server.Close()
+	cacheDir := t.TempDir()
+	t.Setenv(OfflineCacheEnv, cacheDir)
+	t.Setenv(OfflineModeEnv, RecordMode)
+	client, err := httpclient.ClientBuilder().Build()
+	require.NoError(t, err)
+	require.NoError(t, AttachToHttpClient(client.GetClient()))
+
+	// The body can't be read again, so it's hashed while it's streamed to the server.
+	_, body, err := post(t, client.GetClient(), server.URL+"/small", "")
+	require.NoError(t, err)
+	assert.Equal(t, "response of /small", body)
+	resp, err := client.GetClient().Post(server.URL+"/streamed", "text/plain", io.MultiReader(strings.NewReader("streamed body")))
+	require.NoError(t, err)
+	assert.NoError(t, resp.Body.Close())
+	_, body, err = post(t, client.GetClient(), server.URL+"/large", "")
+	require.NoError(t, err)
+	assert.Equal(t, largeBody, body)
+	_, _, err = post(t, client.GetClient(), server.URL+"/access/api/v1/tokens", "")
+	require.NoError(t, err)
+
+	recordedFiles, err := os.ReadDir(cacheDir)
+	require.NoError(t, err)
+	require.Len(t, recordedFiles, 2)
+	for _, recordedFile := range recordedFiles {
+		content, err := os.ReadFile(filepath.Join(cacheDir, recordedFile.Name()))
+		require.NoError(t, err)
+		assert.NotContains(t, string(content), "session=secret")
+	}
+
+	t.Setenv(OfflineModeEnv, ReplayMode)
+	client, err = httpclient.ClientBuilder().Build()
+	require.NoError(t, err)
+	require.NoError(t, AttachToHttpClient(client.GetClient()))
+	resp, err = client.GetClient().Post(server.URL+"/streamed", "text/plain", io.MultiReader(strings.NewReader("streamed body")))
+	require.NoError(t, err)
+	assert.NoError(t, resp.Body.Close())
+	_, _, err = post(t, client.GetClient(), server.URL+"/large", "")
+	assert.ErrorContains(t, err, "was recorded")
+	_, _, err = post(t, client.GetClient(), server.URL+"/access/api/v1/tokens", "")
+	assert.ErrorContains(t, err, "was recorded")
+}
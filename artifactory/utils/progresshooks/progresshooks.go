@@ -0,0 +1,170 @@
+package progresshooks
+
+import (
+	"io"
+	"sync"
+
+	ioUtils "github.com/jfrog/jfrog-client-go/utils/io"
+)
+
+// Hooks are invoked by the progress manager returned by Wrap, for every file transferred by the services manager.
+type Hooks struct {
+	// Called when the transfer of a file starts.
+	OnNewReader func(total int64, label, path string)
+	// Wraps the stream of a transferred file.
+	WrapReader func(reader io.Reader) io.Reader
+	// Called after each read from the stream of a transferred file, with the number of bytes read so far.
+	OnRead func(path string, transferred, total int64)
+}
+
+// Wrap returns a progress manager which invokes the hooks before delegating to the provided progress manager.
+// The provided progress manager may be nil, in which case only the hooks are invoked.
+func Wrap(progress ioUtils.ProgressMgr, hooks Hooks) ioUtils.ProgressMgr {
+	return &hookedProgressMgr{progress: progress, hooks: hooks}
+}
+
+type hookedProgressMgr struct {
+	progress ioUtils.ProgressMgr
+	hooks    Hooks
+	mutex    sync.Mutex
+	lastId   int
+}
+
+func (p *hookedProgressMgr) NewProgressReader(total int64, label, path string) ioUtils.Progress {
+	if p.hooks.OnNewReader != nil {
+		p.hooks.OnNewReader(total, label, path)
+	}
+	var progress ioUtils.Progress
+	if p.progress != nil {
+		progress = p.progress.NewProgressReader(total, label, path)
+	} else {
+		p.mutex.Lock()
+		p.lastId++
+		progress = &nopProgress{id: p.lastId}
+		p.mutex.Unlock()
+	}
+	return p.wrap(progress, total, path)
+}
+
+func (p *hookedProgressMgr) SetMergingState(replacedBarId int, useSpinner bool) ioUtils.Progress {
+	if p.progress != nil {
+		return p.progress.SetMergingState(replacedBarId, useSpinner)
+	}
+	return &nopProgress{id: replacedBarId}
+}
+
+func (p *hookedProgressMgr) GetProgress(id int) ioUtils.Progress {
+	if p.progress != nil {
+		return p.progress.GetProgress(id)
+	}
+	return &nopProgress{id: id}
+}
+
+func (p *hookedProgressMgr) wrap(progress ioUtils.Progress, total int64, path string) ioUtils.Progress {
+	if (p.hooks.WrapReader == nil && p.hooks.OnRead == nil) || progress == nil {
+		return progress
+	}
+	return &hookedProgress{Progress: progress, hooks: p.hooks, total: total, path: path}
+}
+
+func (p *hookedProgressMgr) RemoveProgress(id int) {
+	if p.progress != nil {
+		p.progress.RemoveProgress(id)
+	}
+}
+
+func (p *hookedProgressMgr) ClearProgress() {
+	if clearer, ok := p.progress.(interface{ ClearProgress() }); ok {
+		clearer.ClearProgress()
+	}
+}
+
+func (p *hookedProgressMgr) IncrementGeneralProgress() {
+	if p.progress != nil {
+		p.progress.IncrementGeneralProgress()
+	}
+}
+
+func (p *hookedProgressMgr) IncGeneralProgressTotalBy(n int64) {
+	if p.progress != nil {
+		p.progress.IncGeneralProgressTotalBy(n)
+	}
+}
+
+func (p *hookedProgressMgr) InitProgressReaders() {
+	if p.progress != nil {
+		p.progress.InitProgressReaders()
+	}
+}
+
+func (p *hookedProgressMgr) SetHeadlineMsg(msg string) {
+	if p.progress != nil {
+		p.progress.SetHeadlineMsg(msg)
+	}
+}
+
+func (p *hookedProgressMgr) ClearHeadlineMsg() {
+	if p.progress != nil {
+		p.progress.ClearHeadlineMsg()
+	}
+}
+
+func (p *hookedProgressMgr) Quit() error {
+	if p.progress != nil {
+		return p.progress.Quit()
+	}
+	return nil
+}
+
+// Progress indicator which wraps the transferred stream before passing it to the original indicator.
+type hookedProgress struct {
+	ioUtils.Progress
+	hooks Hooks
+	total int64
+	path  string
+}
+
+func (hp *hookedProgress) ActionWithProgress(reader io.Reader) io.Reader {
+	if hp.hooks.WrapReader != nil {
+		reader = hp.hooks.WrapReader(reader)
+	}
+	if hp.hooks.OnRead != nil {
+		reader = &countingReader{reader: reader, onRead: hp.hooks.OnRead, total: hp.total, path: hp.path}
+	}
+	return hp.Progress.ActionWithProgress(reader)
+}
+
+// Reader which reports the number of bytes read so far.
+type countingReader struct {
+	reader      io.Reader
+	onRead      func(path string, transferred, total int64)
+	transferred int64
+	total       int64
+	path        string
+}
+
+func (cr *countingReader) Read(p []byte) (n int, err error) {
+	n, err = cr.reader.Read(p)
+	if n > 0 {
+		cr.transferred += int64(n)
+		cr.onRead(cr.path, cr.transferred, cr.total)
+	}
+	return
+}
+
+// Progress indicator used when no progress manager was provided.
+type nopProgress struct {
+	id int
+}
+
+func (np *nopProgress) ActionWithProgress(reader io.Reader) io.Reader {
+	return reader
+}
+
+func (np *nopProgress) SetProgress(int64) {}
+
+func (np *nopProgress) Abort() {}
+
+func (np *nopProgress) GetId() int {
+	return np.id
+}
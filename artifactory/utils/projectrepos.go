@@ -0,0 +1,83 @@
+package utils
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// ProjectRepoName returns the physical name of a repository of the project, which is the logical name prefixed by the project key.
+// Names which are already prefixed are returned unchanged.
+func ProjectRepoName(projectKey, repo string) string {
+	if projectKey == "" || repo == "" || strings.HasPrefix(repo, projectKey+"-") {
+		return repo
+	}
+	return projectKey + "-" + repo
+}
+
+// ProjectRepoPath replaces the repository of an Artifactory path (repo/path/to/file) with its physical name in the project.
+func ProjectRepoPath(projectKey, repoPath string) string {
+	repo, relativePath, found := strings.Cut(repoPath, "/")
+	repo = ProjectRepoName(projectKey, repo)
+	if !found {
+		return repo
+	}
+	return repo + "/" + relativePath
+}
+
+type projectRepoDetails struct {
+	Key        string `json:"key"`
+	ProjectKey string `json:"projectKey"`
+}
+
+// ProjectRepoResolver translates logical repository names to the physical names of the project's repositories.
+// A name is translated only if a repository with the prefixed name exists on the server. Otherwise, the name is kept,
+// since it may refer to a repository shared with the project.
+type ProjectRepoResolver struct {
+	servicesManager artifactory.ArtifactoryServicesManager
+	projectKey      string
+	resolved        map[string]string
+}
+
+func NewProjectRepoResolver(servicesManager artifactory.ArtifactoryServicesManager, projectKey string) *ProjectRepoResolver {
+	return &ProjectRepoResolver{servicesManager: servicesManager, projectKey: projectKey, resolved: make(map[string]string)}
+}
+
+// Resolve returns the physical name of the repository.
+// Returns an error if the prefixed repository exists, but is assigned to another project on the server.
+func (prr *ProjectRepoResolver) Resolve(repo string) (string, error) {
+	if prr.projectKey == "" || repo == "" || strings.ContainsAny(repo, "*?") {
+		return repo, nil
+	}
+	if physicalRepo, ok := prr.resolved[repo]; ok {
+		return physicalRepo, nil
+	}
+	physicalRepo := ProjectRepoName(prr.projectKey, repo)
+	repoDetails := &projectRepoDetails{}
+	if err := prr.servicesManager.GetRepository(physicalRepo, repoDetails); err != nil {
+		log.Debug(fmt.Sprintf("The repository '%s' wasn't found in the project '%s', using '%s': %s", physicalRepo, prr.projectKey, repo, err.Error()))
+		prr.resolved[repo] = repo
+		return repo, nil
+	}
+	if repoDetails.ProjectKey != "" && repoDetails.ProjectKey != prr.projectKey {
+		return "", errorutils.CheckErrorf("the repository '%s' is assigned to the project '%s' rather than to '%s'. Make sure the project key matches the prefix of its repositories", physicalRepo, repoDetails.ProjectKey, prr.projectKey)
+	}
+	if physicalRepo != repo {
+		log.Debug(fmt.Sprintf("Using the repository '%s' of the project '%s' for '%s'", physicalRepo, prr.projectKey, repo))
+	}
+	prr.resolved[repo] = physicalRepo
+	return physicalRepo, nil
+}
+
+// ResolvePath replaces the repository of an Artifactory path (repo/path/to/file) with its physical name.
+func (prr *ProjectRepoResolver) ResolvePath(repoPath string) (string, error) {
+	repo, relativePath, found := strings.Cut(repoPath, "/")
+	physicalRepo, err := prr.Resolve(repo)
+	if err != nil || !found {
+		return physicalRepo, err
+	}
+	return physicalRepo + "/" + relativePath, nil
+}
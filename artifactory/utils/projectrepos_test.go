@@ -0,0 +1,60 @@
+package utils
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/stretchr/testify/assert"
+)
+
+type projectReposServicesManager struct {
+	artifactory.EmptyArtifactoryServicesManager
+	repoProjects map[string]string
+}
+
+func (m *projectReposServicesManager) GetRepository(repoKey string, repoDetails interface{}) error {
+	projectKey, ok := m.repoProjects[repoKey]
+	if !ok {
+		return errors.New("repository not found")
+	}
+	details := repoDetails.(*projectRepoDetails)
+	details.Key = repoKey
+	details.ProjectKey = projectKey
+	return nil
+}
+
+func TestProjectRepoName(t *testing.T) {
+	assert.Equal(t, "proj-generic-local", ProjectRepoName("proj", "generic-local"))
+	assert.Equal(t, "proj-generic-local", ProjectRepoName("proj", "proj-generic-local"))
+	assert.Equal(t, "generic-local", ProjectRepoName("", "generic-local"))
+	assert.Equal(t, "proj-generic-local/a/b.zip", ProjectRepoPath("proj", "generic-local/a/b.zip"))
+	assert.Equal(t, "proj-generic-local", ProjectRepoPath("proj", "generic-local"))
+}
+
+func TestProjectRepoResolver(t *testing.T) {
+	resolver := NewProjectRepoResolver(&projectReposServicesManager{repoProjects: map[string]string{
+		"proj-generic-local": "proj",
+		"proj-other-local":   "other",
+	}}, "proj")
+
+	repo, err := resolver.Resolve("generic-local")
+	assert.NoError(t, err)
+	assert.Equal(t, "proj-generic-local", repo)
+
+	// Repositories which don't exist in the project, such as shared repositories, are kept.
+	repo, err = resolver.Resolve("shared-local")
+	assert.NoError(t, err)
+	assert.Equal(t, "shared-local", repo)
+
+	repoPath, err := resolver.ResolvePath("generic-local/a/*.zip")
+	assert.NoError(t, err)
+	assert.Equal(t, "proj-generic-local/a/*.zip", repoPath)
+
+	repoPath, err = resolver.ResolvePath("generic-*/a/*.zip")
+	assert.NoError(t, err)
+	assert.Equal(t, "generic-*/a/*.zip", repoPath)
+
+	_, err = resolver.Resolve("other-local")
+	assert.ErrorContains(t, err, "is assigned to the project 'other'")
+}
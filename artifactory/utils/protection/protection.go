@@ -0,0 +1,120 @@
+package protection
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/aql"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	serviceutils "github.com/jfrog/jfrog-client-go/artifactory/services/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+)
+
+const (
+	// ImmutableProperty marks the artifacts which may no longer be deleted or moved. Its value is 'true'.
+	ImmutableProperty = "lifecycle.immutable"
+	// LegalHoldProperty marks the artifacts which are under a legal hold. Its value identifies the hold, such as a case number.
+	LegalHoldProperty = "lifecycle.legal-hold"
+)
+
+// The maximal number of protected items listed by the error of a denied operation.
+const maxListedItems = 10
+
+// Item is an artifact or a folder which is immutable or under a legal hold.
+type Item struct {
+	Path   string `json:"path"`
+	Reason string `json:"reason"`
+}
+
+var protections = []struct {
+	property string
+	value    any
+	reason   string
+}{
+	{ImmutableProperty, "true", "immutable"},
+	{LegalHoldProperty, aql.Match("*"), "under legal hold"},
+}
+
+// FindProtected returns the protected items affected by an operation on the paths, such as 'repo/dir/file.zip' or
+// 'repo/dir', sorted by their path. An item is affected if it's one of the paths, if it's under one of them, or if
+// it's a folder containing one of them.
+func FindProtected(servicesManager artifactory.ArtifactoryServicesManager, paths []string) ([]Item, error) {
+	trimmed := make([]string, 0, len(paths))
+	repos := make(map[string]bool)
+	for _, itemPath := range paths {
+		itemPath = strings.Trim(itemPath, "/")
+		if itemPath == "" {
+			continue
+		}
+		trimmed = append(trimmed, itemPath)
+		repo, _, _ := strings.Cut(itemPath, "/")
+		repos[repo] = true
+	}
+	if len(trimmed) == 0 {
+		return nil, nil
+	}
+	repoCriteria := make([]aql.Criteria, 0, len(repos))
+	for repo := range repos {
+		repoCriteria = append(repoCriteria, aql.Field("repo", repo))
+	}
+	sort.Slice(repoCriteria, func(i, j int) bool {
+		return repoCriteria[i]["repo"].(string) < repoCriteria[j]["repo"].(string)
+	})
+
+	reasons := make(map[string][]string)
+	for _, protection := range protections {
+		query, err := aql.Find("items", aql.And(aql.Or(repoCriteria...), aql.Field("type", "any"), aql.Field("@"+protection.property, protection.value))).
+			Include("repo", "path", "name", "type").
+			SortAsc("repo", "path", "name").
+			String()
+		if err != nil {
+			return nil, err
+		}
+		err = aql.SearchItems(servicesManager, query, aql.DefaultPageSize, func(item *serviceutils.ResultItem) error {
+			protectedPath := path.Join(item.Repo, item.Path, item.Name)
+			if isAffected(protectedPath, trimmed) {
+				reasons[protectedPath] = append(reasons[protectedPath], protection.reason)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	items := make([]Item, 0, len(reasons))
+	for protectedPath, itemReasons := range reasons {
+		items = append(items, Item{Path: protectedPath, Reason: strings.Join(itemReasons, " and ")})
+	}
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].Path < items[j].Path
+	})
+	return items, nil
+}
+
+func isAffected(protectedPath string, paths []string) bool {
+	for _, itemPath := range paths {
+		if protectedPath == itemPath || strings.HasPrefix(protectedPath, itemPath+"/") || strings.HasPrefix(itemPath, protectedPath+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// VerifyNotProtected returns an error listing the protected items if the operation, such as 'delete', would affect any.
+func VerifyNotProtected(servicesManager artifactory.ArtifactoryServicesManager, operation string, paths []string) error {
+	items, err := FindProtected(servicesManager, paths)
+	if err != nil || len(items) == 0 {
+		return err
+	}
+	var listed strings.Builder
+	for i, item := range items {
+		if i == maxListedItems {
+			listed.WriteString(fmt.Sprintf("\n  ... and %d more", len(items)-maxListedItems))
+			break
+		}
+		listed.WriteString(fmt.Sprintf("\n  %s (%s)", item.Path, item.Reason))
+	}
+	return errorutils.CheckErrorf("the %s was denied, since %d of the items it affects are immutable or under legal hold:%s", operation, len(items), listed.String())
+}
@@ -0,0 +1,78 @@
+package protection
+
+import (
+	"encoding/json"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	serviceutils "github.com/jfrog/jfrog-client-go/artifactory/services/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// protectedServicesManager returns the items of the property searched by each query.
+type protectedServicesManager struct {
+	artifactory.EmptyArtifactoryServicesManager
+	items   map[string][]serviceutils.ResultItem
+	queries []string
+}
+
+func (psm *protectedServicesManager) Aql(query string) (io.ReadCloser, error) {
+	psm.queries = append(psm.queries, query)
+	var results []serviceutils.ResultItem
+	for property, items := range psm.items {
+		if strings.Contains(query, `"@`+property+`"`) {
+			results = items
+		}
+	}
+	content, err := json.Marshal(serviceutils.AqlSearchResult{Results: results})
+	if err != nil {
+		return nil, err
+	}
+	return io.NopCloser(strings.NewReader(string(content))), nil
+}
+
+func TestFindProtected(t *testing.T) {
+	servicesManager := &protectedServicesManager{items: map[string][]serviceutils.ResultItem{
+		ImmutableProperty: {
+			{Repo: "libs-local", Path: "org/app/1.0", Name: "app.jar", Type: "file"},
+			{Repo: "libs-local", Path: "org/other", Name: "other.jar", Type: "file"},
+		},
+		LegalHoldProperty: {
+			{Repo: "libs-local", Path: "org/app/1.0", Name: "app.jar", Type: "file"},
+			{Repo: "libs-local", Path: "org", Name: "frozen", Type: "folder"},
+		},
+	}}
+	tests := []struct {
+		name     string
+		paths    []string
+		expected []Item
+	}{
+		{"file", []string{"libs-local/org/app/1.0/app.jar"}, []Item{{"libs-local/org/app/1.0/app.jar", "immutable and under legal hold"}}},
+		{"parent folder", []string{"libs-local/org/app/"}, []Item{{"libs-local/org/app/1.0/app.jar", "immutable and under legal hold"}}},
+		{"file in protected folder", []string{"libs-local/org/frozen/a/b.zip"}, []Item{{"libs-local/org/frozen", "under legal hold"}}},
+		{"repository", []string{"libs-local"}, []Item{
+			{"libs-local/org/app/1.0/app.jar", "immutable and under legal hold"},
+			{"libs-local/org/frozen", "under legal hold"},
+			{"libs-local/org/other/other.jar", "immutable"},
+		}},
+		{"unprotected", []string{"libs-local/org/app/2.0/app.jar", "libs-local/org/frozen-copy"}, []Item{}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			items, err := FindProtected(servicesManager, test.paths)
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, items)
+		})
+	}
+	require.NotEmpty(t, servicesManager.queries)
+	assert.Contains(t, servicesManager.queries[0], `{"repo":"libs-local"}`)
+	assert.Contains(t, servicesManager.queries[0], `{"type":"any"}`)
+
+	err := VerifyNotProtected(servicesManager, "delete", []string{"libs-local/org/other/"})
+	assert.ErrorContains(t, err, "the delete was denied, since 1 of the items it affects are immutable or under legal hold")
+	assert.ErrorContains(t, err, "libs-local/org/other/other.jar (immutable)")
+	assert.NoError(t, VerifyNotProtected(servicesManager, "delete", []string{"libs-local/org/app/2.0/"}))
+}
@@ -0,0 +1,134 @@
+package ratelimit
+
+import (
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/progresshooks"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	ioUtils "github.com/jfrog/jfrog-client-go/utils/io"
+)
+
+var rateUnits = map[string]int64{
+	"":   1,
+	"B":  1,
+	"K":  1024,
+	"KB": 1024,
+	"M":  1024 * 1024,
+	"MB": 1024 * 1024,
+	"G":  1024 * 1024 * 1024,
+	"GB": 1024 * 1024 * 1024,
+}
+
+// ParseRate parses a transfer rate such as "512KB", "10MB" or "1G" (per second) into bytes per second.
+// An empty string means no limit, and is parsed as 0.
+func ParseRate(rate string) (int64, error) {
+	rate = strings.ToUpper(strings.TrimSpace(rate))
+	rate = strings.TrimSuffix(strings.TrimSuffix(rate, "/S"), "PS")
+	if rate == "" {
+		return 0, nil
+	}
+	unitIndex := strings.IndexFunc(rate, func(r rune) bool { return r < '0' || r > '9' })
+	if unitIndex == -1 {
+		unitIndex = len(rate)
+	}
+	multiplier, ok := rateUnits[rate[unitIndex:]]
+	if !ok || unitIndex == 0 {
+		return 0, errorutils.CheckErrorf("invalid rate '%s'. The rate should be a number of bytes per second, optionally followed by a unit (KB, MB or GB)", rate)
+	}
+	value, err := strconv.ParseInt(rate[:unitIndex], 10, 64)
+	if err != nil || value <= 0 {
+		return 0, errorutils.CheckErrorf("invalid rate '%s'. The rate should be a positive number", rate)
+	}
+	return value * multiplier, nil
+}
+
+// Limiter is a token bucket which allows up to bytesPerSecond bytes per second, with bursts of up to one second worth of bytes.
+// A Limiter may be shared between goroutines.
+type Limiter struct {
+	bytesPerSecond int64
+	tokens         float64
+	lastRefill     time.Time
+	mutex          sync.Mutex
+	// Used by tests to avoid waiting for real time to pass.
+	now   func() time.Time
+	sleep func(time.Duration)
+}
+
+func NewLimiter(bytesPerSecond int64) *Limiter {
+	return &Limiter{bytesPerSecond: bytesPerSecond, tokens: float64(bytesPerSecond), lastRefill: time.Now(), now: time.Now, sleep: time.Sleep}
+}
+
+// WaitN blocks until n bytes may be transferred.
+func (l *Limiter) WaitN(n int) {
+	if l == nil || n <= 0 {
+		return
+	}
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	now := l.now()
+	l.tokens += now.Sub(l.lastRefill).Seconds() * float64(l.bytesPerSecond)
+	if l.tokens > float64(l.bytesPerSecond) {
+		l.tokens = float64(l.bytesPerSecond)
+	}
+	l.lastRefill = now
+	l.tokens -= float64(n)
+	if l.tokens < 0 {
+		// Waiting while holding the lock makes the other transfers sharing this limiter wait in line.
+		wait := time.Duration(-l.tokens / float64(l.bytesPerSecond) * float64(time.Second))
+		l.sleep(wait)
+		l.tokens = 0
+		l.lastRefill = l.now()
+	}
+}
+
+// Limits the rate of the wrapped reader by both its own limiter and the limiter shared by all transfers.
+type limitedReader struct {
+	reader   io.Reader
+	limiters []*Limiter
+	maxRead  int
+}
+
+func (lr *limitedReader) Read(p []byte) (int, error) {
+	if len(p) > lr.maxRead {
+		p = p[:lr.maxRead]
+	}
+	n, err := lr.reader.Read(p)
+	for _, limiter := range lr.limiters {
+		limiter.WaitN(n)
+	}
+	return n, err
+}
+
+// WrapProgress returns a progress manager which limits the rate of each transferred file to perTransfer bytes per second,
+// and the total rate of all concurrent transfers to global bytes per second. A zero rate means no limit.
+// The provided progress manager may be nil. Returns it as is if no limit was set.
+func WrapProgress(progress ioUtils.ProgressMgr, perTransfer, global int64) ioUtils.ProgressMgr {
+	if perTransfer <= 0 && global <= 0 {
+		return progress
+	}
+	var globalLimiter *Limiter
+	if global > 0 {
+		globalLimiter = NewLimiter(global)
+	}
+	return progresshooks.Wrap(progress, progresshooks.Hooks{
+		WrapReader: func(reader io.Reader) io.Reader {
+			limited := &limitedReader{reader: reader}
+			for _, rate := range []int64{perTransfer, global} {
+				if rate > 0 && (limited.maxRead == 0 || int(rate) < limited.maxRead) {
+					limited.maxRead = int(rate)
+				}
+			}
+			if perTransfer > 0 {
+				limited.limiters = append(limited.limiters, NewLimiter(perTransfer))
+			}
+			if globalLimiter != nil {
+				limited.limiters = append(limited.limiters, globalLimiter)
+			}
+			return limited
+		},
+	})
+}
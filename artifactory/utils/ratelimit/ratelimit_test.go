@@ -0,0 +1,77 @@
+package ratelimit
+
+import (
+	"bytes"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseRate(t *testing.T) {
+	tests := []struct {
+		rate        string
+		expected    int64
+		expectError bool
+	}{
+		{"", 0, false},
+		{"100", 100, false},
+		{"512KB", 512 * 1024, false},
+		{"10mb", 10 * 1024 * 1024, false},
+		{"1G", 1024 * 1024 * 1024, false},
+		{"2MB/s", 2 * 1024 * 1024, false},
+		{"MB", 0, true},
+		{"10TB", 0, true},
+		{"0", 0, true},
+		{"-5KB", 0, true},
+	}
+	for _, test := range tests {
+		t.Run(test.rate, func(t *testing.T) {
+			rate, err := ParseRate(test.rate)
+			if test.expectError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, rate)
+		})
+	}
+}
+
+func TestLimiterWaitN(t *testing.T) {
+	now := time.Now()
+	var slept time.Duration
+	limiter := NewLimiter(100)
+	limiter.now = func() time.Time { return now }
+	limiter.lastRefill = now
+	limiter.sleep = func(d time.Duration) {
+		slept += d
+		now = now.Add(d)
+	}
+
+	// The initial burst is allowed without waiting.
+	limiter.WaitN(100)
+	assert.Zero(t, slept)
+
+	// Another 50 bytes require half a second.
+	limiter.WaitN(50)
+	assert.Equal(t, 500*time.Millisecond, slept)
+
+	// A second later the bucket is full again.
+	now = now.Add(time.Second)
+	limiter.WaitN(100)
+	assert.Equal(t, 500*time.Millisecond, slept)
+}
+
+func TestWrapProgress(t *testing.T) {
+	assert.Nil(t, WrapProgress(nil, 0, 0))
+
+	progress := WrapProgress(nil, 1024*1024, 0)
+	assert.NotNil(t, progress)
+	content := bytes.Repeat([]byte("a"), 1024)
+	reader := progress.NewProgressReader(int64(len(content)), "Downloading", "file").ActionWithProgress(bytes.NewReader(content))
+	result, err := io.ReadAll(reader)
+	assert.NoError(t, err)
+	assert.Equal(t, content, result)
+}
@@ -0,0 +1,152 @@
+package serverproxy
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
+	"github.com/jfrog/jfrog-client-go/http/jfroghttpclient"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const (
+	// ProxyConfigEnv overrides the path of the proxy configuration file, which is proxies.json in the JFrog home dir by
+	// default.
+	ProxyConfigEnv = "JFROG_CLI_PROXY_CONFIG"
+
+	// DirectProxy is the URL of a proxy which connects to the server directly, ignoring the proxy environment variables.
+	DirectProxy = "direct"
+
+	proxyConfigFileName = "proxies.json"
+)
+
+var supportedSchemes = []string{"http", "https", "socks5", "socks5h"}
+
+// ProxyConfig is the content of the proxy configuration file, which holds the proxies of the servers by their IDs. The
+// proxy of a server overrides the HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment variables. For example:
+//
+//	{
+//	  "servers": {
+//	    "my-server": {
+//	      "url": "socks5://proxy.acme.com:1080",
+//	      "user": "proxy-user",
+//	      "password": "proxy-password"
+//	    },
+//	    "internal-server": {
+//	      "url": "direct"
+//	    }
+//	  }
+//	}
+type ProxyConfig struct {
+	Servers map[string]*ServerProxy `json:"servers,omitempty"`
+}
+
+// ServerProxy is the proxy of a server. The URL is an HTTP, HTTPS or SOCKS5 proxy URL, or 'direct'. The credentials of
+// the proxy may be set either in the URL or by the user and the password.
+type ServerProxy struct {
+	Url      string `json:"url"`
+	User     string `json:"user,omitempty"`
+	Password string `json:"password,omitempty"`
+}
+
+// GetProxyConfigPath returns the path of the proxy configuration file.
+func GetProxyConfigPath() (string, error) {
+	if configPath := os.Getenv(ProxyConfigEnv); configPath != "" {
+		return configPath, nil
+	}
+	homeDir, err := coreutils.GetJfrogHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(homeDir, proxyConfigFileName), nil
+}
+
+// GetServerProxy returns the proxy of the server, or nil if the server has none.
+func GetServerProxy(serverId string) (*ServerProxy, error) {
+	if serverId == "" {
+		return nil, nil
+	}
+	configPath, err := GetProxyConfigPath()
+	if err != nil {
+		return nil, err
+	}
+	content, err := os.ReadFile(configPath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, errorutils.CheckError(err)
+	}
+	proxyConfig := &ProxyConfig{}
+	decoder := json.NewDecoder(bytes.NewReader(content))
+	decoder.DisallowUnknownFields()
+	if err = decoder.Decode(proxyConfig); err != nil {
+		return nil, errorutils.CheckErrorf("failed to parse the proxy configuration file '%s': %s", configPath, err.Error())
+	}
+	return proxyConfig.Servers[serverId], nil
+}
+
+// Apply routes the requests of the client through the proxy of the server. It should be called before other transports
+// wrap the transport of the client.
+func Apply(client *jfroghttpclient.JfrogHttpClient, serverDetails *config.ServerDetails) error {
+	if client == nil || client.GetHttpClient() == nil {
+		return nil
+	}
+	return ApplyToHttpClient(client.GetHttpClient().GetClient(), serverDetails)
+}
+
+// ApplyToHttpClient routes the requests of the http client through the proxy of the server.
+func ApplyToHttpClient(client *http.Client, serverDetails *config.ServerDetails) error {
+	if client == nil || serverDetails == nil {
+		return nil
+	}
+	serverProxy, err := GetServerProxy(serverDetails.ServerId)
+	if err != nil || serverProxy == nil {
+		return err
+	}
+	transport, ok := client.Transport.(*http.Transport)
+	if !ok {
+		return errorutils.CheckErrorf("failed to apply the proxy of the server '%s' to a client with a custom transport", serverDetails.ServerId)
+	}
+	proxyUrl, err := serverProxy.parseUrl()
+	if err != nil {
+		return err
+	}
+	if proxyUrl == nil {
+		log.Debug("Connecting to the server", serverDetails.ServerId, "directly")
+		transport.Proxy = nil
+		return nil
+	}
+	log.Debug("Connecting to the server", serverDetails.ServerId, "through the proxy", proxyUrl.Redacted())
+	// The transport authenticates to HTTP proxies by the Proxy-Authorization header, and to SOCKS5 proxies by the
+	// username/password method, with the credentials of the URL.
+	transport.Proxy = http.ProxyURL(proxyUrl)
+	return nil
+}
+
+// parseUrl returns the URL of the proxy with its credentials, or nil for a direct connection.
+func (sp *ServerProxy) parseUrl() (*url.URL, error) {
+	if strings.EqualFold(sp.Url, DirectProxy) {
+		return nil, nil
+	}
+	proxyUrl, err := url.Parse(sp.Url)
+	if err != nil {
+		return nil, errorutils.CheckErrorf("invalid proxy URL '%s': %s", sp.Url, err.Error())
+	}
+	if !slices.Contains(supportedSchemes, strings.ToLower(proxyUrl.Scheme)) || proxyUrl.Host == "" {
+		return nil, errorutils.CheckErrorf("invalid proxy URL '%s'. The URL should be '%s' or start with %s://, followed by the host of the proxy", sp.Url, DirectProxy, strings.Join(supportedSchemes, "://, "))
+	}
+	if sp.User != "" {
+		proxyUrl.User = url.UserPassword(sp.User, sp.Password)
+	}
+	return proxyUrl, nil
+}
@@ -0,0 +1,174 @@
+package servertls
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
+	"github.com/jfrog/jfrog-client-go/http/jfroghttpclient"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const (
+	// TlsConfigEnv overrides the path of the TLS configuration file, which is tls.json in the JFrog home dir by default.
+	TlsConfigEnv = "JFROG_CLI_TLS_CONFIG"
+
+	tlsConfigFileName = "tls.json"
+)
+
+// TlsConfig is the content of the TLS configuration file, which holds the CA bundles of the servers by their IDs. The
+// client certificate and the TLS verification are configured on the server itself, with 'jf c add'. For example:
+//
+//	{
+//	  "servers": {
+//	    "my-server": {
+//	      "caBundlePath": "/etc/pki/jfrog/ca-bundle.pem"
+//	    }
+//	  }
+//	}
+type TlsConfig struct {
+	Servers map[string]*ServerTls `json:"servers,omitempty"`
+}
+
+// ServerTls is the TLS configuration of a server. The CA bundle is trusted in addition to the system and the JFrog CLI
+// certificates.
+type ServerTls struct {
+	CaBundlePath string `json:"caBundlePath,omitempty"`
+}
+
+// GetTlsConfigPath returns the path of the TLS configuration file.
+func GetTlsConfigPath() (string, error) {
+	if configPath := os.Getenv(TlsConfigEnv); configPath != "" {
+		return configPath, nil
+	}
+	homeDir, err := coreutils.GetJfrogHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(homeDir, tlsConfigFileName), nil
+}
+
+// GetServerTls returns the TLS configuration of the server, or nil if the server has none.
+func GetServerTls(serverId string) (*ServerTls, error) {
+	if serverId == "" {
+		return nil, nil
+	}
+	configPath, err := GetTlsConfigPath()
+	if err != nil {
+		return nil, err
+	}
+	content, err := os.ReadFile(configPath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, errorutils.CheckError(err)
+	}
+	tlsConfig := &TlsConfig{}
+	decoder := json.NewDecoder(bytes.NewReader(content))
+	decoder.DisallowUnknownFields()
+	if err = decoder.Decode(tlsConfig); err != nil {
+		return nil, errorutils.CheckErrorf("failed to parse the TLS configuration file '%s': %s", configPath, err.Error())
+	}
+	return tlsConfig.Servers[serverId], nil
+}
+
+// Apply configures the TLS of the client by the TLS configuration of the server. It should be called before other
+// transports wrap the transport of the client.
+func Apply(client *jfroghttpclient.JfrogHttpClient, serverDetails *config.ServerDetails) error {
+	if client == nil || client.GetHttpClient() == nil {
+		return nil
+	}
+	return ApplyToHttpClient(client.GetHttpClient().GetClient(), serverDetails)
+}
+
+// ApplyToHttpClient configures the TLS of the http client by the client certificate and the TLS verification of the
+// server details, and by the TLS configuration of the server.
+func ApplyToHttpClient(client *http.Client, serverDetails *config.ServerDetails) error {
+	if client == nil || serverDetails == nil {
+		return nil
+	}
+	serverTls, err := GetServerTls(serverDetails.ServerId)
+	if err != nil {
+		return err
+	}
+	if serverTls == nil {
+		if serverDetails.ClientCertPath == "" && !serverDetails.InsecureTls {
+			return nil
+		}
+		serverTls = &ServerTls{}
+	}
+	transport, ok := client.Transport.(*http.Transport)
+	if !ok {
+		return errorutils.CheckErrorf("failed to apply the TLS configuration of the server '%s' to a client with a custom transport", serverDetails.ServerId)
+	}
+	tlsConfig, err := serverTls.apply(transport.TLSClientConfig, serverDetails)
+	if err != nil {
+		return err
+	}
+	log.Debug("Applying the TLS configuration of the server", serverDetails.ServerId)
+	transport.TLSClientConfig = tlsConfig
+	return nil
+}
+
+// apply returns a copy of the TLS configuration of a transport, with the CA bundle, and the client certificate and the
+// verification of the server details.
+func (st *ServerTls) apply(base *tls.Config, serverDetails *config.ServerDetails) (*tls.Config, error) {
+	var tlsConfig *tls.Config
+	if base != nil {
+		tlsConfig = base.Clone()
+	} else {
+		tlsConfig = &tls.Config{MinVersion: tls.VersionTLS12}
+	}
+	if serverDetails.InsecureTls {
+		tlsConfig.InsecureSkipVerify = true
+	}
+	if st.CaBundlePath != "" {
+		rootCAs, err := st.loadCaBundle(tlsConfig.RootCAs)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.RootCAs = rootCAs
+	}
+	if serverDetails.ClientCertPath != "" {
+		// The key may be included in the client certificate file.
+		keyPath := serverDetails.ClientCertKeyPath
+		if keyPath == "" {
+			keyPath = serverDetails.ClientCertPath
+		}
+		certificate, err := tls.LoadX509KeyPair(serverDetails.ClientCertPath, keyPath)
+		if err != nil {
+			return nil, errorutils.CheckErrorf("failed to load the client certificate '%s': %s", serverDetails.ClientCertPath, err.Error())
+		}
+		tlsConfig.Certificates = []tls.Certificate{certificate}
+	}
+	return tlsConfig, nil
+}
+
+// loadCaBundle returns the trusted certificates with the certificates of the CA bundle. If the transport trusts the
+// system certificates only, its pool is nil.
+func (st *ServerTls) loadCaBundle(rootCAs *x509.CertPool) (*x509.CertPool, error) {
+	if rootCAs != nil {
+		rootCAs = rootCAs.Clone()
+	} else if systemCAs, err := x509.SystemCertPool(); err == nil {
+		rootCAs = systemCAs
+	} else {
+		rootCAs = x509.NewCertPool()
+	}
+	caBundle, err := os.ReadFile(st.CaBundlePath)
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	if !rootCAs.AppendCertsFromPEM(caBundle) {
+		return nil, errorutils.CheckErrorf("no certificates were found in the CA bundle '%s'", st.CaBundlePath)
+	}
+	return rootCAs, nil
+}
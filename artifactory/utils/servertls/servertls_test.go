@@ -0,0 +1,132 @@
+package servertls
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/http/httpclient"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// createCertificate creates a certificate signed by the parent, or a self-signed CA if the parent is nil, and writes
+// the certificate and its key to PEM files.
+func createCertificate(t *testing.T, name string, parent *x509.Certificate, parentKey *ecdsa.PrivateKey) (*x509.Certificate, *ecdsa.PrivateKey, string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+	}
+	if parent == nil {
+		template.IsCA = true
+		template.BasicConstraintsValid = true
+		parent, parentKey = template, key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+	require.NoError(t, err)
+	certificate, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+	certPath := filepath.Join(t.TempDir(), name+".pem")
+	keyPath := filepath.Join(t.TempDir(), name+".key")
+	require.NoError(t, os.WriteFile(certPath, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600))
+	require.NoError(t, os.WriteFile(keyPath, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0600))
+	return certificate, key, certPath, keyPath
+}
+
+func writeTlsConfig(t *testing.T, content string) {
+	configPath := filepath.Join(t.TempDir(), tlsConfigFileName)
+	require.NoError(t, os.WriteFile(configPath, []byte(content), 0600))
+	t.Setenv(TlsConfigEnv, configPath)
+}
+
+func TestApplyMutualTls(t *testing.T) {
+	ca, caKey, caPath, _ := createCertificate(t, "ca", nil, nil)
+	_, _, serverCertPath, serverKeyPath := createCertificate(t, "server", ca, caKey)
+	_, _, clientCertPath, clientKeyPath := createCertificate(t, "client", ca, caKey)
+
+	serverCertificate, err := tls.LoadX509KeyPair(serverCertPath, serverKeyPath)
+	require.NoError(t, err)
+	clientCAs := x509.NewCertPool()
+	clientCAs.AddCert(ca)
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "client", r.TLS.PeerCertificates[0].Subject.CommonName)
+	}))
+	server.TLS = &tls.Config{Certificates: []tls.Certificate{serverCertificate}, ClientCAs: clientCAs, ClientAuth: tls.RequireAndVerifyClientCert}
+	server.StartTLS()
+	defer server.Close()
+
+	writeTlsConfig(t, `{"servers": {"private-pki": {"caBundlePath": "`+filepath.ToSlash(caPath)+`"}}}`)
+
+	// Without the TLS configuration of the server, neither the server nor the client is trusted.
+	client, err := httpclient.ClientBuilder().Build()
+	require.NoError(t, err)
+	require.NoError(t, ApplyToHttpClient(client.GetClient(), &config.ServerDetails{ServerId: "other"}))
+	_, err = client.GetClient().Get(server.URL)
+	assert.Error(t, err)
+
+	client, err = httpclient.ClientBuilder().Build()
+	require.NoError(t, err)
+	require.NoError(t, ApplyToHttpClient(client.GetClient(), &config.ServerDetails{ServerId: "private-pki", ClientCertPath: clientCertPath, ClientCertKeyPath: clientKeyPath}))
+	resp, err := client.GetClient().Get(server.URL)
+	require.NoError(t, err)
+	assert.NoError(t, resp.Body.Close())
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func TestApplyInsecureTls(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+	defer server.Close()
+	t.Setenv(TlsConfigEnv, filepath.Join(t.TempDir(), tlsConfigFileName))
+
+	client, err := httpclient.ClientBuilder().Build()
+	require.NoError(t, err)
+	require.NoError(t, ApplyToHttpClient(client.GetClient(), &config.ServerDetails{ServerId: "self-signed", InsecureTls: true}))
+	resp, err := client.GetClient().Get(server.URL)
+	require.NoError(t, err)
+	assert.NoError(t, resp.Body.Close())
+}
+
+func TestGetServerTls(t *testing.T) {
+	t.Setenv(TlsConfigEnv, filepath.Join(t.TempDir(), tlsConfigFileName))
+	serverTls, err := GetServerTls("server")
+	assert.NoError(t, err)
+	assert.Nil(t, serverTls)
+
+	writeTlsConfig(t, `{"servers": {"server": {"caBundlePath": "ca.pem"}}}`)
+	serverTls, err = GetServerTls("server")
+	assert.NoError(t, err)
+	assert.Equal(t, &ServerTls{CaBundlePath: "ca.pem"}, serverTls)
+
+	writeTlsConfig(t, `{"servers": {"server": {"clientCertPath": "client.pem"}}}`)
+	_, err = GetServerTls("server")
+	assert.ErrorContains(t, err, "failed to parse the TLS configuration file")
+}
+
+func TestApplyInvalidCaBundle(t *testing.T) {
+	caPath := filepath.Join(t.TempDir(), "ca.pem")
+	require.NoError(t, os.WriteFile(caPath, []byte("not a certificate"), 0600))
+	_, err := (&ServerTls{CaBundlePath: caPath}).apply(nil, &config.ServerDetails{})
+	assert.ErrorContains(t, err, "no certificates were found in the CA bundle")
+}
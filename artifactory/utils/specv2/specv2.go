@@ -0,0 +1,387 @@
+// Package specv2 reads file specs of schema version 2, and resolves them to the file specs used by the commands.
+//
+// A v2 spec is a JSON object with the following fields:
+//
+//	{
+//	  "version": 2,
+//	  "extends": ["base.json"],
+//	  "vars": {"repo": "libs-local"},
+//	  "groups": {"binaries": {"pattern": "out/bin/*", "exclusions": ["*.tmp"]}},
+//	  "files": [{"use": ["binaries"], "target": "${repo}/bin/"}]
+//	}
+//
+// Spec files listed in "extends" are resolved relative to the extending spec. Their vars and groups are inherited, and
+// their files precede the files of the extending spec. A file uses the fields of its groups, in order, and overrides
+// them with its own fields, except for "exclusions" and "include", which are concatenated.
+// Variables are referenced as ${name} in any string field, and are resolved from the --spec-vars option, the "vars"
+// of the spec and the environment, in this order. $${name} is kept as the literal ${name}.
+package specv2
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+
+	commonCliUtils "github.com/jfrog/jfrog-cli-core/v2/common/cliutils"
+	"github.com/jfrog/jfrog-cli-core/v2/common/spec"
+	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+)
+
+const (
+	SchemaVersion = 2
+
+	useField = "use"
+)
+
+// Fields whose values are concatenated, rather than overridden, when a file uses groups.
+var composedFields = map[string]bool{"exclusions": true, "include": true}
+
+var (
+	varPattern     = regexp.MustCompile(`\$?\$\{([^}]*)\}`)
+	versionPattern = regexp.MustCompile(`"version"\s*:\s*2\b`)
+)
+
+type specV2 struct {
+	Version int                                   `json:"version"`
+	Extends []string                              `json:"extends,omitempty"`
+	Vars    map[string]string                     `json:"vars,omitempty"`
+	Groups  map[string]map[string]json.RawMessage `json:"groups,omitempty"`
+	Files   []map[string]json.RawMessage          `json:"files"`
+}
+
+// resolvedSpec holds a spec after its extended specs are merged into it.
+type resolvedSpec struct {
+	vars   map[string]string
+	groups map[string]map[string]json.RawMessage
+	// The files, with the path of the spec file and the index in it, for error positions.
+	files []positionedFile
+}
+
+type positionedFile struct {
+	fields   map[string]json.RawMessage
+	position string
+}
+
+// CreateSpecFromFile reads the spec file. Specs of schema version 2 are resolved and validated,
+// and other specs are read as before.
+func CreateSpecFromFile(specFilePath string, specVars map[string]string) (*spec.SpecFiles, error) {
+	content, err := os.ReadFile(specFilePath)
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	if !IsSchemaV2(content) {
+		return spec.CreateSpecFromFile(specFilePath, specVars)
+	}
+	resolved, err := resolveSpecFile(specFilePath, nil)
+	if err != nil {
+		return nil, err
+	}
+	return resolved.toSpecFiles(specVars)
+}
+
+// IsSchemaV2 returns true if the content is a JSON object whose version is 2.
+// Malformed content is also detected by its version field, so that syntax errors in v2 specs are reported by position.
+func IsSchemaV2(content []byte) bool {
+	var header struct {
+		Version json.RawMessage `json:"version"`
+	}
+	if json.Unmarshal(content, &header) != nil {
+		return versionPattern.Match(content)
+	}
+	return string(header.Version) == fmt.Sprint(SchemaVersion)
+}
+
+// Reads the spec file and merges the specs it extends into it. chain holds the specs being resolved, to detect cycles.
+func resolveSpecFile(specFilePath string, chain []string) (*resolvedSpec, error) {
+	absPath, err := filepath.Abs(specFilePath)
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	for _, extending := range chain {
+		if extending == absPath {
+			return nil, errorutils.CheckErrorf("%s: circular 'extends' through %s", absPath, strings.Join(chain, " -> "))
+		}
+	}
+	chain = append(chain, absPath)
+	content, err := os.ReadFile(absPath)
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	parsed, err := parseSpecV2(absPath, content)
+	if err != nil {
+		return nil, err
+	}
+
+	resolved := &resolvedSpec{vars: make(map[string]string), groups: make(map[string]map[string]json.RawMessage)}
+	for _, extended := range parsed.Extends {
+		if !filepath.IsAbs(extended) {
+			extended = filepath.Join(filepath.Dir(absPath), extended)
+		}
+		parent, err := resolveSpecFile(extended, chain)
+		if err != nil {
+			return nil, err
+		}
+		resolved.merge(parent)
+	}
+	resolved.merge(&resolvedSpec{vars: parsed.Vars, groups: parsed.Groups})
+	for i, file := range parsed.Files {
+		resolved.files = append(resolved.files, positionedFile{fields: file, position: fmt.Sprintf("%s: files[%d]", absPath, i)})
+	}
+	return resolved, nil
+}
+
+func (rs *resolvedSpec) merge(other *resolvedSpec) {
+	for name, value := range other.vars {
+		rs.vars[name] = value
+	}
+	for name, group := range other.groups {
+		rs.groups[name] = group
+	}
+	rs.files = append(rs.files, other.files...)
+}
+
+// Parses the spec strictly, reporting the position of syntax errors, type errors and unknown fields.
+func parseSpecV2(specFilePath string, content []byte) (*specV2, error) {
+	decoder := json.NewDecoder(bytes.NewReader(content))
+	decoder.DisallowUnknownFields()
+	parsed := new(specV2)
+	if err := decoder.Decode(parsed); err != nil {
+		return nil, errorutils.CheckError(describeDecodeError(specFilePath, content, err))
+	}
+	if len(parsed.Files) == 0 && len(parsed.Extends) == 0 {
+		return nil, errorutils.CheckErrorf("%s: a spec must have 'files' or 'extends'", specFilePath)
+	}
+	for name, group := range parsed.Groups {
+		if err := validateFields(group, false); err != nil {
+			return nil, errorutils.CheckErrorf("%s: groups.%s: %s", specFilePath, name, err.Error())
+		}
+	}
+	for i, file := range parsed.Files {
+		if err := validateFields(file, true); err != nil {
+			return nil, errorutils.CheckErrorf("%s: files[%d]: %s", specFilePath, i, err.Error())
+		}
+	}
+	return parsed, nil
+}
+
+func describeDecodeError(specFilePath string, content []byte, err error) error {
+	var syntaxErr *json.SyntaxError
+	if errors.As(err, &syntaxErr) {
+		return fmt.Errorf("%s:%s: %s", specFilePath, lineAndColumn(content, syntaxErr.Offset), syntaxErr.Error())
+	}
+	var typeErr *json.UnmarshalTypeError
+	if errors.As(err, &typeErr) {
+		return fmt.Errorf("%s:%s: the field '%s' must be of type %s, not %s", specFilePath, lineAndColumn(content, typeErr.Offset), typeErr.Field, typeErr.Type, typeErr.Value)
+	}
+	return fmt.Errorf("%s: %s", specFilePath, err.Error())
+}
+
+// Returns the 1-based line and column of the last byte read by the decoder, at the given offset.
+func lineAndColumn(content []byte, offset int64) string {
+	offset = max(min(offset, int64(len(content)))-1, 0)
+	before := content[:offset]
+	line := bytes.Count(before, []byte("\n")) + 1
+	column := int(offset) - bytes.LastIndexByte(before, '\n')
+	return fmt.Sprintf("%d:%d", line, column)
+}
+
+// Validates the field names of a file or a group against the fields of a file spec. Only files can use groups.
+func validateFields(fields map[string]json.RawMessage, allowUse bool) error {
+	known := knownFileFields()
+	for name := range fields {
+		if name == useField && allowUse {
+			continue
+		}
+		if !known[strings.ToLower(name)] {
+			return fmt.Errorf("unknown field '%s'", name)
+		}
+	}
+	return nil
+}
+
+// Returns the lowercase names of the fields of a file spec, as matched by the JSON decoder.
+func knownFileFields() map[string]bool {
+	known := make(map[string]bool)
+	fileType := reflect.TypeOf(spec.File{})
+	for i := 0; i < fileType.NumField(); i++ {
+		field := fileType.Field(i)
+		name := field.Name
+		if tag, _, _ := strings.Cut(field.Tag.Get("json"), ","); tag != "" {
+			name = tag
+		}
+		known[strings.ToLower(name)] = true
+	}
+	return known
+}
+
+func (rs *resolvedSpec) toSpecFiles(specVars map[string]string) (*spec.SpecFiles, error) {
+	specFiles := new(spec.SpecFiles)
+	for _, file := range rs.files {
+		fields, err := rs.composeFile(file.fields)
+		if err != nil {
+			return nil, errorutils.CheckErrorf("%s: %s", file.position, err.Error())
+		}
+		var values map[string]interface{}
+		if err = unmarshalFields(fields, &values); err != nil {
+			return nil, errorutils.CheckErrorf("%s: %s", file.position, err.Error())
+		}
+		for name, value := range values {
+			if values[name], err = rs.interpolate(value, name, specVars); err != nil {
+				return nil, errorutils.CheckErrorf("%s.%s", file.position, err.Error())
+			}
+		}
+		content, err := json.Marshal(values)
+		if err != nil {
+			return nil, errorutils.CheckError(err)
+		}
+		var specFile spec.File
+		if err = json.Unmarshal(content, &specFile); err != nil {
+			return nil, errorutils.CheckErrorf("%s: %s", file.position, err.Error())
+		}
+		specFiles.Files = append(specFiles.Files, specFile)
+	}
+	return specFiles, nil
+}
+
+// Merges the fields of the groups used by the file, in order, and then the fields of the file itself.
+func (rs *resolvedSpec) composeFile(file map[string]json.RawMessage) (map[string]json.RawMessage, error) {
+	var groupNames []string
+	if use, ok := file[useField]; ok {
+		if err := json.Unmarshal(use, &groupNames); err != nil {
+			return nil, fmt.Errorf("'%s' must be a list of group names", useField)
+		}
+	}
+	composed := make(map[string]json.RawMessage)
+	for _, groupName := range groupNames {
+		group, ok := rs.groups[groupName]
+		if !ok {
+			return nil, fmt.Errorf("unknown group '%s'. Available groups: %s", groupName, strings.Join(rs.groupNames(), ", "))
+		}
+		if err := composeFields(composed, group); err != nil {
+			return nil, err
+		}
+	}
+	delete(file, useField)
+	if err := composeFields(composed, file); err != nil {
+		return nil, err
+	}
+	return composed, nil
+}
+
+func composeFields(composed, fields map[string]json.RawMessage) error {
+	for name, value := range fields {
+		key := strings.ToLower(name)
+		existing, ok := composed[key]
+		if !ok || !composedFields[key] {
+			composed[key] = value
+			continue
+		}
+		var existingValues, values []string
+		if err := json.Unmarshal(existing, &existingValues); err != nil {
+			return fmt.Errorf("'%s' must be a list of strings", name)
+		}
+		if err := json.Unmarshal(value, &values); err != nil {
+			return fmt.Errorf("'%s' must be a list of strings", name)
+		}
+		merged, err := json.Marshal(append(existingValues, values...))
+		if err != nil {
+			return err
+		}
+		composed[key] = merged
+	}
+	return nil
+}
+
+func (rs *resolvedSpec) groupNames() []string {
+	var names []string
+	for name := range rs.groups {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func unmarshalFields(fields map[string]json.RawMessage, values *map[string]interface{}) error {
+	content, err := json.Marshal(fields)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(content, values)
+}
+
+// Replaces the variables in all the strings of the value. position is the path of the value, for error messages.
+func (rs *resolvedSpec) interpolate(value interface{}, position string, specVars map[string]string) (interface{}, error) {
+	switch typed := value.(type) {
+	case string:
+		return rs.interpolateString(typed, position, specVars)
+	case []interface{}:
+		for i, item := range typed {
+			var err error
+			if typed[i], err = rs.interpolate(item, fmt.Sprintf("%s[%d]", position, i), specVars); err != nil {
+				return nil, err
+			}
+		}
+	case map[string]interface{}:
+		for key, item := range typed {
+			var err error
+			if typed[key], err = rs.interpolate(item, position+"."+key, specVars); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return value, nil
+}
+
+func (rs *resolvedSpec) interpolateString(value, position string, specVars map[string]string) (string, error) {
+	var err error
+	interpolated := varPattern.ReplaceAllStringFunc(value, func(reference string) string {
+		if strings.HasPrefix(reference, "$$") {
+			return reference[1:]
+		}
+		name := varPattern.FindStringSubmatch(reference)[1]
+		if resolved, ok := rs.lookupVar(name, specVars); ok {
+			return resolved
+		}
+		if err == nil {
+			err = fmt.Errorf("%s: undefined variable '%s'. Define it in 'vars', with --spec-vars or as an environment variable", position, name)
+		}
+		return reference
+	})
+	return interpolated, err
+}
+
+func (rs *resolvedSpec) lookupVar(name string, specVars map[string]string) (string, bool) {
+	if value, ok := specVars[name]; ok {
+		return value, true
+	}
+	if value, ok := rs.vars[name]; ok {
+		return value, true
+	}
+	return os.LookupEnv(name)
+}
+
+// GetSpec reads the spec file of the '--spec' option like the common GetSpec, with the support of v2 specs.
+func GetSpec(c *components.Context, isDownload, overrideFieldsIfSet bool) (*spec.SpecFiles, error) {
+	specFiles, err := CreateSpecFromFile(c.GetStringFlagValue("spec"), coreutils.SpecVarsStringToMap(c.GetStringFlagValue("spec-vars")))
+	if err != nil {
+		return nil, err
+	}
+	for i := 0; i < len(specFiles.Files); i++ {
+		if isDownload {
+			specFiles.Get(i).Pattern = strings.TrimPrefix(specFiles.Get(i).Pattern, "/")
+		}
+		if overrideFieldsIfSet {
+			commonCliUtils.OverrideFieldsIfSet(specFiles.Get(i), c)
+		}
+	}
+	return specFiles, nil
+}
@@ -0,0 +1,78 @@
+package specv2
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeSpec(t *testing.T, dir, name, content string) string {
+	specPath := filepath.Join(dir, name)
+	require.NoError(t, os.WriteFile(specPath, []byte(content), 0644))
+	return specPath
+}
+
+func TestCreateSpecFromFileV2(t *testing.T) {
+	dir := t.TempDir()
+	writeSpec(t, dir, "base.json", `{
+  "version": 2,
+  "vars": {"repo": "libs-local", "env": "dev"},
+  "groups": {
+    "binaries": {"pattern": "out/bin/*", "exclusions": ["*.tmp"]},
+    "no-logs": {"exclusions": ["*.log"], "flat": "true"}
+  },
+  "files": [{"pattern": "README.md", "target": "${repo}/docs/"}]
+}`)
+	t.Setenv("SPEC_V2_BUILD", "42")
+	specPath := writeSpec(t, dir, "spec.json", `{
+  "version": 2,
+  "extends": ["base.json"],
+  "vars": {"env": "prod"},
+  "files": [{"use": ["binaries", "no-logs"], "target": "${repo}/${env}/${SPEC_V2_BUILD}/", "flat": "false", "exclusions": ["$${literal}"]}]
+}`)
+
+	specFiles, err := CreateSpecFromFile(specPath, map[string]string{"repo": "generic-local"})
+	require.NoError(t, err)
+	require.Len(t, specFiles.Files, 2)
+	assert.Equal(t, "README.md", specFiles.Files[0].Pattern)
+	assert.Equal(t, "generic-local/docs/", specFiles.Files[0].Target)
+	assert.Equal(t, "out/bin/*", specFiles.Files[1].Pattern)
+	assert.Equal(t, "generic-local/prod/42/", specFiles.Files[1].Target)
+	assert.Equal(t, "false", specFiles.Files[1].Flat)
+	assert.Equal(t, []string{"*.tmp", "*.log", "${literal}"}, specFiles.Files[1].Exclusions)
+}
+
+func TestCreateSpecFromFileV1(t *testing.T) {
+	specPath := writeSpec(t, t.TempDir(), "spec.json", `{"files": [{"pattern": "a/*", "target": "${repo}/"}]}`)
+	specFiles, err := CreateSpecFromFile(specPath, map[string]string{"repo": "generic-local"})
+	require.NoError(t, err)
+	assert.Equal(t, "generic-local/", specFiles.Files[0].Target)
+}
+
+func TestCreateSpecFromFileV2Errors(t *testing.T) {
+	dir := t.TempDir()
+	tests := []struct {
+		name     string
+		content  string
+		expected string
+	}{
+		{"syntax", "{\"version\": 2,\n \"files\": [}", "spec.json:2:12"},
+		{"type", "{\"version\": 2,\n \"vars\": []}", "spec.json:2:10: the field 'vars' must be of type"},
+		{"unknown top-level field", `{"version": 2, "file": []}`, `unknown field "file"`},
+		{"unknown file field", `{"version": 2, "files": [{"patern": "a"}]}`, "files[0]: unknown field 'patern'"},
+		{"unknown group field", `{"version": 2, "groups": {"g": {"use": ["x"]}}, "files": [{"use": ["g"]}]}`, "groups.g: unknown field 'use'"},
+		{"unknown group", `{"version": 2, "files": [{"use": ["missing"]}]}`, "files[0]: unknown group 'missing'"},
+		{"undefined variable", `{"version": 2, "files": [{"pattern": "a", "exclusions": ["${SPEC_V2_UNDEFINED}"]}]}`, "files[0].exclusions[0]: undefined variable 'SPEC_V2_UNDEFINED'"},
+		{"circular extends", `{"version": 2, "extends": ["spec.json"]}`, "circular 'extends'"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			specPath := writeSpec(t, dir, "spec.json", test.content)
+			_, err := CreateSpecFromFile(specPath, nil)
+			assert.ErrorContains(t, err, test.expected)
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package telemetry
+
+import (
+	"strings"
+
+	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+)
+
+const (
+	CommandsCounter      = "jfrog.cli.commands"
+	TransferFilesCounter = "jfrog.cli.transfer.files"
+)
+
+// InstrumentCommands wraps the actions of the commands of the namespace, so that each execution is traced by the root
+// span of the trace and counted, and the telemetry is exported when the command ends.
+func InstrumentCommands(namespace string, commands []components.Command) []components.Command {
+	for i := range commands {
+		action := commands[i].Action
+		if action == nil {
+			continue
+		}
+		commandName := strings.TrimSpace(strings.Join([]string{"jf", namespace, commands[i].Name}, " "))
+		commands[i].Action = func(c *components.Context) (err error) {
+			if !IsEnabled() {
+				return action(c)
+			}
+			span := startCommandSpan(commandName, String("jfrog.cli.command", commandName))
+			defer func() {
+				span.End(err)
+				AddCounter(CommandsCounter, "1", 1, String("jfrog.cli.command", commandName), Bool("error", err != nil))
+				Flush()
+			}()
+			return action(c)
+		}
+	}
+	return commands
+}
+
+// AddTransferredFiles counts the files which an operation, such as an upload or a download, transferred or failed to.
+func AddTransferredFiles(operation string, succeeded, failed int) {
+	if succeeded > 0 {
+		AddCounter(TransferFilesCounter, "1", int64(succeeded), String("operation", operation), String("status", "success"))
+	}
+	if failed > 0 {
+		AddCounter(TransferFilesCounter, "1", int64(failed), String("operation", operation), String("status", "failure"))
+	}
+}
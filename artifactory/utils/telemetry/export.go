@@ -0,0 +1,225 @@
+package telemetry
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const (
+	tracesPath    = "/v1/traces"
+	metricsPath   = "/v1/metrics"
+	scopeName     = "github.com/jfrog/jfrog-cli-artifactory"
+	exportTimeout = 10 * time.Second
+	// Cumulative aggregation temporality of the sums, as numbered by OTLP.
+	cumulativeTemporality = 2
+	statusCodeOk          = 1
+	statusCodeError       = 2
+)
+
+type exportConfig struct {
+	tracesEndpoint  string
+	metricsEndpoint string
+	headers         map[string]string
+}
+
+// loadExportConfig reads the configuration of the OTLP exporter from the OpenTelemetry environment variables. It
+// returns nil if no endpoint is configured, if the SDK is disabled, or if the protocol isn't supported.
+func loadExportConfig() *exportConfig {
+	if isTrue(os.Getenv(sdkDisabledEnv)) {
+		return nil
+	}
+	config := &exportConfig{
+		tracesEndpoint:  os.Getenv(otlpTracesEndpointEnv),
+		metricsEndpoint: os.Getenv(otlpMetricsEndpointEnv),
+		headers:         parseHeaders(os.Getenv(otlpHeadersEnv)),
+	}
+	if endpoint := strings.TrimSuffix(os.Getenv(otlpEndpointEnv), "/"); endpoint != "" {
+		if config.tracesEndpoint == "" {
+			config.tracesEndpoint = endpoint + tracesPath
+		}
+		if config.metricsEndpoint == "" {
+			config.metricsEndpoint = endpoint + metricsPath
+		}
+	}
+	if config.tracesEndpoint == "" && config.metricsEndpoint == "" {
+		return nil
+	}
+	if protocol := os.Getenv(otlpProtocolEnv); protocol != "" && protocol != otlpProtocolHttpJson {
+		log.Warn(fmt.Sprintf("The OTLP protocol '%s' isn't supported, so the telemetry isn't exported. Set %s to '%s'.", protocol, otlpProtocolEnv, otlpProtocolHttpJson))
+		return nil
+	}
+	return config
+}
+
+// parseHeaders parses the headers of the OTLP requests, which are a comma-separated list of URL-encoded key=value pairs.
+func parseHeaders(headers string) map[string]string {
+	parsed := map[string]string{}
+	for _, header := range strings.Split(headers, ",") {
+		key, value, found := strings.Cut(header, "=")
+		if !found {
+			continue
+		}
+		if unescaped, err := url.QueryUnescape(strings.TrimSpace(value)); err == nil {
+			value = unescaped
+		}
+		parsed[strings.TrimSpace(key)] = value
+	}
+	return parsed
+}
+
+// Flush exports the ended spans and the counters to the configured OTLP endpoints. The exported spans are discarded.
+// Failing to export is logged, and doesn't fail the command.
+func Flush() {
+	telemetry := getState()
+	if telemetry == nil {
+		return
+	}
+	telemetry.mutex.Lock()
+	spans := telemetry.endedSpans
+	telemetry.endedSpans = nil
+	tracesRequest := telemetry.tracesRequest(spans)
+	metricsRequest := telemetry.metricsRequest(time.Now())
+	hasCounters := len(telemetry.counterOrder) > 0
+	telemetry.mutex.Unlock()
+
+	if telemetry.config.tracesEndpoint != "" && len(spans) > 0 {
+		if err := telemetry.export(telemetry.config.tracesEndpoint, tracesRequest); err != nil {
+			log.Debug("Failed to export the traces:", err.Error())
+		}
+	}
+	if telemetry.config.metricsEndpoint != "" && hasCounters {
+		if err := telemetry.export(telemetry.config.metricsEndpoint, metricsRequest); err != nil {
+			log.Debug("Failed to export the metrics:", err.Error())
+		}
+	}
+}
+
+func (t *telemetryState) export(endpoint string, request any) error {
+	body, err := json.Marshal(request)
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	req, err := http.NewRequest(http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for key, value := range t.config.headers {
+		req.Header.Set(key, value)
+	}
+	resp, err := (&http.Client{Timeout: exportTimeout}).Do(req)
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	if resp.StatusCode >= http.StatusBadRequest {
+		return errorutils.CheckErrorf("the OTLP endpoint %s responded with %s", endpoint, resp.Status)
+	}
+	return nil
+}
+
+func (t *telemetryState) resource() map[string]any {
+	return map[string]any{"attributes": encodeAttributes([]Attribute{String("service.name", getServiceName())})}
+}
+
+func (t *telemetryState) tracesRequest(spans []*Span) map[string]any {
+	encodedSpans := make([]map[string]any, 0, len(spans))
+	for _, span := range spans {
+		status := map[string]any{"code": statusCodeOk}
+		if span.err != nil {
+			status = map[string]any{"code": statusCodeError, "message": span.err.Error()}
+		}
+		encodedSpan := map[string]any{
+			"traceId":           t.traceId,
+			"spanId":            span.spanId,
+			"name":              span.name,
+			"kind":              span.kind,
+			"startTimeUnixNano": unixNano(span.start),
+			"endTimeUnixNano":   unixNano(span.end),
+			"attributes":        encodeAttributes(span.attributes),
+			"status":            status,
+		}
+		if span.parentSpanId != "" {
+			encodedSpan["parentSpanId"] = span.parentSpanId
+		}
+		encodedSpans = append(encodedSpans, encodedSpan)
+	}
+	return map[string]any{"resourceSpans": []map[string]any{{
+		"resource":   t.resource(),
+		"scopeSpans": []map[string]any{{"scope": map[string]any{"name": scopeName}, "spans": encodedSpans}},
+	}}}
+}
+
+func (t *telemetryState) metricsRequest(now time.Time) map[string]any {
+	// The data points of a counter with different attributes are exported as a single metric.
+	var names []string
+	dataPoints := map[string][]map[string]any{}
+	units := map[string]string{}
+	for _, key := range t.counterOrder {
+		current := t.counters[key]
+		if _, exists := dataPoints[current.name]; !exists {
+			names = append(names, current.name)
+			units[current.name] = current.unit
+		}
+		dataPoints[current.name] = append(dataPoints[current.name], map[string]any{
+			"attributes":        encodeAttributes(current.attributes),
+			"startTimeUnixNano": unixNano(t.startTime),
+			"timeUnixNano":      unixNano(now),
+			"asInt":             strconv.FormatInt(current.value, 10),
+		})
+	}
+	metrics := make([]map[string]any, 0, len(names))
+	for _, name := range names {
+		metrics = append(metrics, map[string]any{
+			"name": name,
+			"unit": units[name],
+			"sum": map[string]any{
+				"dataPoints":             dataPoints[name],
+				"aggregationTemporality": cumulativeTemporality,
+				"isMonotonic":            true,
+			},
+		})
+	}
+	return map[string]any{"resourceMetrics": []map[string]any{{
+		"resource":     t.resource(),
+		"scopeMetrics": []map[string]any{{"scope": map[string]any{"name": scopeName}, "metrics": metrics}},
+	}}}
+}
+
+func encodeAttributes(attributes []Attribute) []map[string]any {
+	encoded := make([]map[string]any, 0, len(attributes))
+	for _, attribute := range attributes {
+		var value map[string]any
+		switch typed := attribute.Value.(type) {
+		case int64:
+			value = map[string]any{"intValue": strconv.FormatInt(typed, 10)}
+		case bool:
+			value = map[string]any{"boolValue": typed}
+		default:
+			value = map[string]any{"stringValue": toString(typed)}
+		}
+		encoded = append(encoded, map[string]any{"key": attribute.Key, "value": value})
+	}
+	return encoded
+}
+
+func toString(value any) string {
+	return fmt.Sprint(value)
+}
+
+// unixNano returns the time in nanoseconds since the epoch, which OTLP encodes as a string in json.
+func unixNano(t time.Time) string {
+	return strconv.FormatInt(t.UnixNano(), 10)
+}
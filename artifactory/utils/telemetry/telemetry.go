@@ -0,0 +1,214 @@
+package telemetry
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// The OpenTelemetry environment variables, which configure the export of the spans and the metrics.
+const (
+	otlpEndpointEnv        = "OTEL_EXPORTER_OTLP_ENDPOINT"
+	otlpTracesEndpointEnv  = "OTEL_EXPORTER_OTLP_TRACES_ENDPOINT"
+	otlpMetricsEndpointEnv = "OTEL_EXPORTER_OTLP_METRICS_ENDPOINT"
+	otlpHeadersEnv         = "OTEL_EXPORTER_OTLP_HEADERS"
+	otlpProtocolEnv        = "OTEL_EXPORTER_OTLP_PROTOCOL"
+	serviceNameEnv         = "OTEL_SERVICE_NAME"
+	sdkDisabledEnv         = "OTEL_SDK_DISABLED"
+
+	defaultServiceName = "jfrog-cli-artifactory"
+	// The spans and the metrics are exported as OTLP/HTTP json.
+	otlpProtocolHttpJson = "http/json"
+)
+
+type SpanKind int
+
+// The kinds of the spans, as numbered by OTLP.
+const (
+	SpanKindInternal SpanKind = 1
+	SpanKindClient   SpanKind = 3
+)
+
+// Attribute is a key-value pair which describes a span or a data point of a counter.
+type Attribute struct {
+	Key   string
+	Value any
+}
+
+func String(key, value string) Attribute {
+	return Attribute{Key: key, Value: value}
+}
+
+func Int(key string, value int64) Attribute {
+	return Attribute{Key: key, Value: value}
+}
+
+func Bool(key string, value bool) Attribute {
+	return Attribute{Key: key, Value: value}
+}
+
+// Span is a timed operation in the trace of the command. A nil span, which is started while telemetry is disabled,
+// may be used and ended like any other span.
+type Span struct {
+	name         string
+	kind         SpanKind
+	spanId       string
+	parentSpanId string
+	start        time.Time
+	end          time.Time
+	attributes   []Attribute
+	err          error
+}
+
+type counterKey struct {
+	name       string
+	attributes string
+}
+
+type counter struct {
+	name       string
+	unit       string
+	attributes []Attribute
+	value      int64
+}
+
+type telemetryState struct {
+	config       *exportConfig
+	traceId      string
+	startTime    time.Time
+	commandSpan  *Span
+	endedSpans   []*Span
+	counters     map[counterKey]*counter
+	counterOrder []counterKey
+	mutex        sync.Mutex
+}
+
+var (
+	state     *telemetryState
+	stateOnce sync.Once
+)
+
+// getState returns the state of the telemetry of the process, or nil if the export isn't configured.
+func getState() *telemetryState {
+	stateOnce.Do(func() {
+		config := loadExportConfig()
+		if config == nil {
+			return
+		}
+		state = &telemetryState{
+			config:    config,
+			traceId:   newId(16),
+			startTime: time.Now(),
+			counters:  map[counterKey]*counter{},
+		}
+	})
+	return state
+}
+
+// IsEnabled returns true if an OTLP endpoint is configured, to which the spans and the metrics are exported.
+func IsEnabled() bool {
+	return getState() != nil
+}
+
+// StartSpan starts a span, whose parent is the span of the running command.
+func StartSpan(name string, kind SpanKind, attributes ...Attribute) *Span {
+	telemetry := getState()
+	if telemetry == nil {
+		return nil
+	}
+	span := &Span{name: name, kind: kind, spanId: newId(8), start: time.Now(), attributes: attributes}
+	telemetry.mutex.Lock()
+	defer telemetry.mutex.Unlock()
+	if telemetry.commandSpan != nil {
+		span.parentSpanId = telemetry.commandSpan.spanId
+	}
+	return span
+}
+
+// startCommandSpan starts the root span of the trace, which is the parent of the spans started while the command runs.
+func startCommandSpan(name string, attributes ...Attribute) *Span {
+	span := StartSpan(name, SpanKindInternal, attributes...)
+	if span != nil {
+		state.mutex.Lock()
+		state.commandSpan = span
+		state.mutex.Unlock()
+	}
+	return span
+}
+
+func (s *Span) SetAttributes(attributes ...Attribute) {
+	if s == nil {
+		return
+	}
+	state.mutex.Lock()
+	defer state.mutex.Unlock()
+	s.attributes = append(s.attributes, attributes...)
+}
+
+// End ends the span, whose status is an error if err isn't nil.
+func (s *Span) End(err error) {
+	if s == nil {
+		return
+	}
+	state.mutex.Lock()
+	defer state.mutex.Unlock()
+	s.end = time.Now()
+	s.err = err
+	state.endedSpans = append(state.endedSpans, s)
+	if state.commandSpan == s {
+		state.commandSpan = nil
+	}
+}
+
+// traceParent returns the W3C traceparent of the span, which propagates the trace to the server.
+func (s *Span) traceParent() string {
+	return "00-" + state.traceId + "-" + s.spanId + "-01"
+}
+
+// AddCounter adds the value to the counter with the name and the attributes. The counters are exported as cumulative
+// sums.
+func AddCounter(name, unit string, value int64, attributes ...Attribute) {
+	telemetry := getState()
+	if telemetry == nil {
+		return
+	}
+	key := counterKey{name: name, attributes: attributesKey(attributes)}
+	telemetry.mutex.Lock()
+	defer telemetry.mutex.Unlock()
+	current, exists := telemetry.counters[key]
+	if !exists {
+		current = &counter{name: name, unit: unit, attributes: attributes}
+		telemetry.counters[key] = current
+		telemetry.counterOrder = append(telemetry.counterOrder, key)
+	}
+	current.value += value
+}
+
+func attributesKey(attributes []Attribute) string {
+	var key strings.Builder
+	for _, attribute := range attributes {
+		key.WriteString(attribute.Key + "=" + toString(attribute.Value) + ";")
+	}
+	return key.String()
+}
+
+func newId(size int) string {
+	id := make([]byte, size)
+	// crypto/rand never returns an error.
+	_, _ = rand.Read(id)
+	return hex.EncodeToString(id)
+}
+
+func isTrue(value string) bool {
+	return strings.EqualFold(strings.TrimSpace(value), "true")
+}
+
+func getServiceName() string {
+	if serviceName := os.Getenv(serviceNameEnv); serviceName != "" {
+		return serviceName
+	}
+	return defaultServiceName
+}
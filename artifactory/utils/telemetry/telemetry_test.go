@@ -0,0 +1,98 @@
+package telemetry
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+	"github.com/jfrog/jfrog-client-go/http/jfroghttpclient"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func resetState(t *testing.T) {
+	state = nil
+	stateOnce = sync.Once{}
+	t.Cleanup(func() {
+		state = nil
+		stateOnce = sync.Once{}
+	})
+}
+
+func TestDisabled(t *testing.T) {
+	t.Setenv(otlpEndpointEnv, "")
+	resetState(t)
+	assert.False(t, IsEnabled())
+	span := StartSpan("span", SpanKindInternal)
+	assert.Nil(t, span)
+	// A nil span may be used like any other span.
+	span.SetAttributes(String("key", "value"))
+	span.End(nil)
+	AddCounter(CommandsCounter, "1", 1)
+	Flush()
+}
+
+func TestInstrumentCommands(t *testing.T) {
+	exported := map[string]map[string]any{}
+	var exportMutex sync.Mutex
+	collector := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+		var request map[string]any
+		assert.NoError(t, json.Unmarshal(body, &request))
+		exportMutex.Lock()
+		exported[r.URL.Path] = request
+		exportMutex.Unlock()
+	}))
+	defer collector.Close()
+	var traceParent string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		traceParent = r.Header.Get(traceParentHeader)
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+	t.Setenv(otlpEndpointEnv, collector.URL)
+	t.Setenv(otlpHeadersEnv, "Authorization=Bearer%20token")
+	resetState(t)
+
+	commands := InstrumentCommands("rt", []components.Command{{Name: "upload", Action: func(*components.Context) error {
+		client, err := jfroghttpclient.JfrogClientBuilder().Build()
+		if err != nil {
+			return err
+		}
+		Attach(client)
+		resp, err := client.GetHttpClient().GetClient().Post(server.URL+"/generic-local/file.txt", "text/plain", strings.NewReader("content"))
+		if err != nil {
+			return err
+		}
+		AddTransferredFiles("upload", 1, 0)
+		return resp.Body.Close()
+	}}})
+	require.NoError(t, commands[0].Action(&components.Context{}))
+
+	spans := exported[tracesPath]["resourceSpans"].([]any)[0].(map[string]any)["scopeSpans"].([]any)[0].(map[string]any)["spans"].([]any)
+	require.Len(t, spans, 2)
+	httpSpan, commandSpan := spans[0].(map[string]any), spans[1].(map[string]any)
+	assert.Equal(t, "HTTP POST", httpSpan["name"])
+	assert.Equal(t, "jf rt upload", commandSpan["name"])
+	assert.Equal(t, commandSpan["spanId"], httpSpan["parentSpanId"])
+	assert.Equal(t, "00-"+httpSpan["traceId"].(string)+"-"+httpSpan["spanId"].(string)+"-01", traceParent)
+
+	metrics := exported[metricsPath]["resourceMetrics"].([]any)[0].(map[string]any)["scopeMetrics"].([]any)[0].(map[string]any)["metrics"].([]any)
+	var names []string
+	for _, metric := range metrics {
+		names = append(names, metric.(map[string]any)["name"].(string))
+	}
+	assert.ElementsMatch(t, []string{HttpBytesCounter, HttpRequestsCounter, TransferFilesCounter, CommandsCounter}, names)
+}
+
+func TestParseHeaders(t *testing.T) {
+	assert.Equal(t, map[string]string{"api-key": "secret", "tenant": "a b"}, parseHeaders("api-key=secret, tenant=a%20b,invalid"))
+	assert.Empty(t, parseHeaders(""))
+}
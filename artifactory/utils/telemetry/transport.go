@@ -0,0 +1,68 @@
+package telemetry
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/jfrog/jfrog-client-go/http/jfroghttpclient"
+)
+
+const (
+	traceParentHeader = "traceparent"
+
+	HttpRequestsCounter = "jfrog.cli.http.requests"
+	HttpBytesCounter    = "jfrog.cli.http.bytes"
+)
+
+// Attach wraps the transport of the client, so that every request it sends is traced by a client span, and propagates
+// the trace to the server by the W3C traceparent header. It does nothing if telemetry is disabled.
+func Attach(client *jfroghttpclient.JfrogHttpClient) {
+	if client == nil || client.GetHttpClient() == nil {
+		return
+	}
+	AttachToHttpClient(client.GetHttpClient().GetClient())
+}
+
+// AttachToHttpClient wraps the transport of the http client like Attach.
+func AttachToHttpClient(httpClient *http.Client) {
+	if !IsEnabled() || httpClient == nil {
+		return
+	}
+	if _, traced := httpClient.Transport.(*transport); traced {
+		return
+	}
+	next := httpClient.Transport
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	httpClient.Transport = &transport{next: next}
+}
+
+type transport struct {
+	next http.RoundTripper
+}
+
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	span := StartSpan("HTTP "+req.Method, SpanKindClient,
+		String("http.request.method", req.Method),
+		String("url.full", req.URL.Redacted()),
+		String("server.address", req.URL.Hostname()))
+	// The request mustn't be modified by a transport, so the header is set on a clone.
+	req = req.Clone(req.Context())
+	req.Header.Set(traceParentHeader, span.traceParent())
+	resp, err := t.next.RoundTrip(req)
+	status := "error"
+	if resp != nil {
+		status = strconv.Itoa(resp.StatusCode)
+		span.SetAttributes(Int("http.response.status_code", int64(resp.StatusCode)))
+		if resp.ContentLength > 0 {
+			AddCounter(HttpBytesCounter, "By", resp.ContentLength, String("direction", "received"))
+		}
+	}
+	if req.ContentLength > 0 {
+		AddCounter(HttpBytesCounter, "By", req.ContentLength, String("direction", "sent"))
+	}
+	AddCounter(HttpRequestsCounter, "1", 1, String("http.request.method", req.Method), String("http.response.status_code", status))
+	span.End(err)
+	return resp, err
+}
@@ -0,0 +1,52 @@
+package throttle
+
+import (
+	"strconv"
+
+	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+)
+
+const (
+	retriesFlag = "throttle-retries"
+	maxWaitFlag = "throttle-max-wait"
+)
+
+// ConfigureCommands adds the throttling options to the commands, which override the throttling settings of the
+// environment for the requests the command sends. Commands which don't parse their flags are left as is.
+func ConfigureCommands(commands []components.Command) []components.Command {
+	for i := range commands {
+		action := commands[i].Action
+		if action == nil || commands[i].SkipFlagParsing {
+			continue
+		}
+		commands[i].Flags = append(commands[i].Flags,
+			components.NewStringFlag(retriesFlag, "[Default: "+strconv.Itoa(DefaultRetries)+"] The number of times a request which Artifactory throttles (429, or 503 with Retry-After) is retried. Set to 0 to disable the retries. Overrides the "+ThrottleRetriesEnv+" environment variable.", components.SetMandatoryFalse()),
+			components.NewStringFlag(maxWaitFlag, "[Default: "+strconv.Itoa(int(DefaultMaxWait.Seconds()))+"] The maximal number of seconds to wait before retrying a throttled request, even if Artifactory asks to wait longer by the Retry-After header. Overrides the "+ThrottleMaxWaitEnv+" environment variable.", components.SetMandatoryFalse()))
+		commands[i].Action = func(c *components.Context) error {
+			if err := applyFlags(c); err != nil {
+				return err
+			}
+			return action(c)
+		}
+	}
+	return commands
+}
+
+func applyFlags(c *components.Context) error {
+	if c.IsFlagSet(retriesFlag) {
+		retries, err := strconv.Atoi(c.GetStringFlagValue(retriesFlag))
+		if err != nil || retries < 0 {
+			return errorutils.CheckErrorf("the '--%s' option should have a non-negative numeric value", retriesFlag)
+		}
+		OverrideRetries(retries)
+	}
+	if c.IsFlagSet(maxWaitFlag) {
+		maxWait, err := ParseMaxWait(c.GetStringFlagValue(maxWaitFlag))
+		if err != nil {
+			return errorutils.CheckErrorf("invalid '--%s' option value: %s", maxWaitFlag, err.Error())
+		}
+		OverrideMaxWait(maxWait)
+	}
+	return nil
+}
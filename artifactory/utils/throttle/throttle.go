@@ -0,0 +1,214 @@
+package throttle
+
+import (
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jfrog/jfrog-client-go/http/jfroghttpclient"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const (
+	// ThrottleRetriesEnv overrides the number of times a throttled request is retried. Zero disables the retries.
+	ThrottleRetriesEnv = "JFROG_CLI_THROTTLE_RETRIES"
+	// ThrottleMaxWaitEnv overrides the maximal number of seconds waited before retrying a throttled request.
+	ThrottleMaxWaitEnv = "JFROG_CLI_THROTTLE_MAX_WAIT"
+
+	DefaultRetries = 5
+	DefaultMaxWait = time.Minute
+	// The backoff of the first retry, if the response has no Retry-After header. It's doubled on every retry.
+	initialBackoff   = time.Second
+	retryAfterHeader = "Retry-After"
+)
+
+// Settings configure the retries of throttled requests.
+type Settings struct {
+	Retries int
+	MaxWait time.Duration
+}
+
+// The settings of the command, which override the environment.
+var (
+	overriddenRetries *int
+	overriddenMaxWait time.Duration
+	overridesMutex    sync.Mutex
+)
+
+// OverrideRetries overrides the retries of the environment for the requests sent from now on.
+func OverrideRetries(retries int) {
+	overridesMutex.Lock()
+	defer overridesMutex.Unlock()
+	overriddenRetries = &retries
+}
+
+// OverrideMaxWait overrides the maximal wait of the environment for the requests sent from now on.
+func OverrideMaxWait(maxWait time.Duration) {
+	overridesMutex.Lock()
+	defer overridesMutex.Unlock()
+	overriddenMaxWait = maxWait
+}
+
+// GetSettings returns the settings of the environment, or their defaults, with the overrides of the command.
+func GetSettings() (settings Settings, err error) {
+	settings = Settings{Retries: DefaultRetries, MaxWait: DefaultMaxWait}
+	if value := os.Getenv(ThrottleRetriesEnv); value != "" {
+		if settings.Retries, err = strconv.Atoi(value); err != nil || settings.Retries < 0 {
+			return Settings{}, errorutils.CheckErrorf("invalid %s '%s'. The value should be a non-negative number", ThrottleRetriesEnv, value)
+		}
+	}
+	if value := os.Getenv(ThrottleMaxWaitEnv); value != "" {
+		if settings.MaxWait, err = ParseMaxWait(value); err != nil {
+			return Settings{}, errorutils.CheckErrorf("invalid %s '%s'. %s", ThrottleMaxWaitEnv, value, err.Error())
+		}
+	}
+	overridesMutex.Lock()
+	defer overridesMutex.Unlock()
+	if overriddenRetries != nil {
+		settings.Retries = *overriddenRetries
+	}
+	if overriddenMaxWait > 0 {
+		settings.MaxWait = overriddenMaxWait
+	}
+	return settings, nil
+}
+
+// ParseMaxWait parses a number of seconds, or a duration such as "90s" or "2m".
+func ParseMaxWait(value string) (time.Duration, error) {
+	value = strings.TrimSpace(value)
+	if seconds, err := strconv.Atoi(value); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second, nil
+	}
+	if duration, err := time.ParseDuration(value); err == nil && duration > 0 {
+		return duration, nil
+	}
+	return 0, errorutils.CheckErrorf("the value should be a positive number of seconds, or a duration such as 90s")
+}
+
+// Attach wraps the transport of the client, so that the requests which the server throttles are retried after the
+// time the server asks for, or after an exponential backoff. It does nothing if the retries are disabled.
+func Attach(client *jfroghttpclient.JfrogHttpClient) error {
+	if client == nil || client.GetHttpClient() == nil {
+		return nil
+	}
+	return AttachToHttpClient(client.GetHttpClient().GetClient())
+}
+
+// AttachToHttpClient wraps the transport of the http client like Attach.
+func AttachToHttpClient(httpClient *http.Client) error {
+	settings, err := GetSettings()
+	if err != nil || settings.Retries == 0 || httpClient == nil {
+		return err
+	}
+	if _, attached := httpClient.Transport.(*transport); attached {
+		return nil
+	}
+	next := httpClient.Transport
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	httpClient.Transport = &transport{next: next, settings: settings, sleep: sleep}
+	return nil
+}
+
+type transport struct {
+	next     http.RoundTripper
+	settings Settings
+	// Used by tests to avoid waiting for real time to pass.
+	sleep func(req *http.Request, wait time.Duration) error
+}
+
+// RoundTrip retries the requests answered with 429, and with 503 with a Retry-After header. Requests whose body can't
+// be sent again, such as uploads of files, aren't retried.
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.next.RoundTrip(req)
+	for attempt := 0; attempt < t.settings.Retries; attempt++ {
+		if err != nil || !isThrottled(resp) || !isReplayable(req) {
+			return resp, err
+		}
+		wait := t.getWait(resp, attempt)
+		log.Warn("The request", req.Method, req.URL.Redacted(), "was throttled with status", resp.Status+". Retrying in", wait.Round(time.Millisecond).String()+"...")
+		// The body is drained, so that the connection is reused.
+		_, _ = io.Copy(io.Discard, resp.Body)
+		if err = resp.Body.Close(); err != nil {
+			return nil, errorutils.CheckError(err)
+		}
+		if err = t.sleep(req, wait); err != nil {
+			return nil, err
+		}
+		if req, err = rewind(req); err != nil {
+			return nil, err
+		}
+		resp, err = t.next.RoundTrip(req)
+	}
+	return resp, err
+}
+
+func isThrottled(resp *http.Response) bool {
+	return resp.StatusCode == http.StatusTooManyRequests || (resp.StatusCode == http.StatusServiceUnavailable && resp.Header.Get(retryAfterHeader) != "")
+}
+
+func isReplayable(req *http.Request) bool {
+	return req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+}
+
+// Returns a copy of the request with a new body.
+func rewind(req *http.Request) (*http.Request, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return req, nil
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	req = req.Clone(req.Context())
+	req.Body = body
+	return req, nil
+}
+
+// Returns the time the server asked to wait for by the Retry-After header, or else the exponential backoff of the
+// attempt with jitter, up to the maximal wait.
+func (t *transport) getWait(resp *http.Response, attempt int) time.Duration {
+	wait, ok := parseRetryAfter(resp.Header.Get(retryAfterHeader), time.Now())
+	if !ok {
+		backoff := initialBackoff << attempt
+		if backoff <= 0 || backoff > t.settings.MaxWait {
+			backoff = t.settings.MaxWait
+		}
+		// Half of the backoff is random, so that the concurrent requests which were throttled together are spread.
+		wait = backoff/2 + rand.N(backoff/2+1)
+	}
+	return min(wait, t.settings.MaxWait)
+}
+
+// Parses a Retry-After value, which is either a number of seconds or an HTTP date.
+func parseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return time.Duration(max(seconds, 0)) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		return max(date.Sub(now), 0), true
+	}
+	return 0, false
+}
+
+func sleep(req *http.Request, wait time.Duration) error {
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-req.Context().Done():
+		return req.Context().Err()
+	}
+}
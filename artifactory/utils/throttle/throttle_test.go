@@ -0,0 +1,149 @@
+package throttle
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetSettings(t *testing.T) {
+	settings, err := GetSettings()
+	require.NoError(t, err)
+	assert.Equal(t, Settings{Retries: DefaultRetries, MaxWait: DefaultMaxWait}, settings)
+
+	t.Setenv(ThrottleRetriesEnv, "2")
+	t.Setenv(ThrottleMaxWaitEnv, "90s")
+	settings, err = GetSettings()
+	require.NoError(t, err)
+	assert.Equal(t, Settings{Retries: 2, MaxWait: 90 * time.Second}, settings)
+
+	// The command overrides the environment
+	defer func() {
+		overriddenRetries, overriddenMaxWait = nil, 0
+	}()
+	OverrideRetries(0)
+	OverrideMaxWait(10 * time.Second)
+	settings, err = GetSettings()
+	require.NoError(t, err)
+	assert.Equal(t, Settings{Retries: 0, MaxWait: 10 * time.Second}, settings)
+
+	t.Setenv(ThrottleMaxWaitEnv, "-1")
+	_, err = GetSettings()
+	assert.ErrorContains(t, err, ThrottleMaxWaitEnv)
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	tests := []struct {
+		value    string
+		expected time.Duration
+		ok       bool
+	}{
+		{"", 0, false},
+		{"120", 2 * time.Minute, true},
+		{"-3", 0, true},
+		{now.Add(30 * time.Second).Format(http.TimeFormat), 30 * time.Second, true},
+		{now.Add(-time.Hour).Format(http.TimeFormat), 0, true},
+		{"soon", 0, false},
+	}
+	for _, test := range tests {
+		t.Run(test.value, func(t *testing.T) {
+			wait, ok := parseRetryAfter(test.value, now)
+			assert.Equal(t, test.ok, ok)
+			assert.Equal(t, test.expected, wait)
+		})
+	}
+}
+
+func TestGetWait(t *testing.T) {
+	throttled := &transport{settings: Settings{Retries: 10, MaxWait: 5 * time.Second}}
+	resp := &http.Response{Header: http.Header{}}
+	for attempt := 0; attempt < 10; attempt++ {
+		backoff := min(initialBackoff<<attempt, 5*time.Second)
+		wait := throttled.getWait(resp, attempt)
+		assert.GreaterOrEqual(t, wait, backoff/2)
+		assert.LessOrEqual(t, wait, backoff)
+	}
+
+	// The Retry-After header is honored up to the maximal wait
+	resp.Header.Set(retryAfterHeader, "2")
+	assert.Equal(t, 2*time.Second, throttled.getWait(resp, 3))
+	resp.Header.Set(retryAfterHeader, "60")
+	assert.Equal(t, 5*time.Second, throttled.getWait(resp, 0))
+}
+
+func TestTransport(t *testing.T) {
+	var requests int
+	var bodies []string
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		bodies = append(bodies, string(body))
+		throttledTimes, err := strconv.Atoi(r.URL.Query().Get("throttled"))
+		assert.NoError(t, err)
+		if requests <= throttledTimes {
+			if r.URL.Query().Get("status") == "503" {
+				w.Header().Set(retryAfterHeader, r.URL.Query().Get("retry-after"))
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer testServer.Close()
+
+	var waits []time.Duration
+	httpClient := &http.Client{Transport: &transport{next: http.DefaultTransport, settings: Settings{Retries: 3, MaxWait: time.Minute},
+		sleep: func(_ *http.Request, wait time.Duration) error {
+			waits = append(waits, wait)
+			return nil
+		}}}
+	send := func(method, query, body string) int {
+		requests, bodies, waits = 0, nil, nil
+		var bodyReader io.Reader
+		if body != "" {
+			bodyReader = strings.NewReader(body)
+		}
+		req, err := http.NewRequest(method, testServer.URL+"?"+query, bodyReader)
+		require.NoError(t, err)
+		if body != "" && strings.HasPrefix(query, "stream") {
+			// A body which can't be sent again, like the body of an uploaded file
+			req.GetBody = nil
+		}
+		resp, err := httpClient.Do(req)
+		require.NoError(t, err)
+		assert.NoError(t, resp.Body.Close())
+		return resp.StatusCode
+	}
+
+	// Throttled requests are retried with their body
+	assert.Equal(t, http.StatusOK, send(http.MethodPost, "throttled=2", "payload"))
+	assert.Equal(t, []string{"payload", "payload", "payload"}, bodies)
+	assert.Len(t, waits, 2)
+
+	// The Retry-After of a 503 response is honored
+	assert.Equal(t, http.StatusOK, send(http.MethodGet, "throttled=1&status=503&retry-after=7", ""))
+	assert.Equal(t, []time.Duration{7 * time.Second}, waits)
+
+	// A 503 response without Retry-After isn't a throttled one
+	assert.Equal(t, http.StatusServiceUnavailable, send(http.MethodGet, "throttled=1&status=503", ""))
+	assert.Equal(t, 1, requests)
+
+	// The retries are limited
+	assert.Equal(t, http.StatusTooManyRequests, send(http.MethodGet, "throttled=10", ""))
+	assert.Equal(t, 4, requests)
+
+	// Requests whose body can't be sent again aren't retried
+	assert.Equal(t, http.StatusTooManyRequests, send(http.MethodPut, "stream&throttled=1", "content"))
+	assert.Equal(t, 1, requests)
+}
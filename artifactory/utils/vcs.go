@@ -3,10 +3,14 @@ package utils
 import (
 	"errors"
 	buildinfo "github.com/jfrog/build-info-go/entities"
+	"github.com/jfrog/gofrog/datastructures"
 	gofrogcmd "github.com/jfrog/gofrog/io"
-	"github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/cienv"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clientconfig"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clierrors"
 	"github.com/jfrog/jfrog-cli-core/v2/common/build"
 	utilsconfig "github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/artifactory"
 	"github.com/jfrog/jfrog-client-go/artifactory/services"
 	artclientutils "github.com/jfrog/jfrog-client-go/artifactory/services/utils"
 	clientutils "github.com/jfrog/jfrog-client-go/utils"
@@ -17,12 +21,15 @@ import (
 	"os"
 	"os/exec"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 )
 
 const (
 	revisionRangeErrPrefix = "fatal: Invalid revision range"
+
+	RevisionRangeErrorCode clierrors.Code = "REVISION_RANGE"
 )
 
 type BuildAndVcsDetails interface {
@@ -32,10 +39,19 @@ type BuildAndVcsDetails interface {
 }
 
 type GitLogDetails struct {
+	// Maximum number of commits to log. Zero or less means no limit.
 	LogLimit     int
 	PrettyFormat string
 	// Optional
 	DotGitPath string
+	// Optional - list the files changed by each commit after the commit's formatted line.
+	NameOnly bool
+	// Optional - a revision, tag or ref expression, such as 'v1.2.0' or 'HEAD~10', which the log starts from, instead
+	// of the VCS revision of the latest build.
+	FromRef string
+	// Optional - a glob pattern of tags, such as 'v*'. The log starts from the latest matching tag reachable from HEAD,
+	// excluding the tags of HEAD itself, instead of the VCS revision of the latest build. Ignored if FromRef is set.
+	FromTagPattern string
 }
 
 // ParseGitLogFromLastBuild Parses git commits from the last build's VCS revision.
@@ -46,11 +62,16 @@ func ParseGitLogFromLastBuild(serverDetails *utilsconfig.ServerDetails, buildCon
 		return err
 	}
 
-	// Get latest build's VCS revision from Artifactory.
-	lastVcsRevision, err := getLatestVcsRevision(serverDetails, buildConfiguration, vcsUrl)
+	lastVcsRevision, fromGit, err := resolveGitRangeStart(gitDetails)
 	if err != nil {
 		return err
 	}
+	if !fromGit {
+		// Get latest build's VCS revision from Artifactory.
+		if lastVcsRevision, err = getLatestVcsRevision(serverDetails, buildConfiguration, vcsUrl); err != nil {
+			return err
+		}
+	}
 	return ParseGitLogFromLastVcsRevision(gitDetails, logRegExp, lastVcsRevision)
 }
 
@@ -64,14 +85,156 @@ func GetPlainGitLogFromPreviousBuild(serverDetails *utilsconfig.ServerDetails, b
 		return "", err
 	}
 
-	lastVcsRevision, err := getVcsFromPreviousBuild(serverDetails, buildConfiguration, vcsUrl)
+	lastVcsRevision, fromGit, err := resolveGitRangeStart(gitDetails)
+	if err != nil {
+		return "", err
+	}
+	if !fromGit {
+		if lastVcsRevision, err = getVcsFromPreviousBuild(serverDetails, buildConfiguration, vcsUrl); err != nil {
+			return "", err
+		}
+	}
+
+	return getPlainGitLogFromLastVcsRevision(gitDetails, lastVcsRevision)
+}
+
+// GetPlainGitLogFromLastBuild Returns the git log output since the VCS revision of the latest build.
+// Calls git log with a custom format, and returns the output as is.
+// Return RevisionRangeError if revision isn't found (due to git history modification).
+func GetPlainGitLogFromLastBuild(serverDetails *utilsconfig.ServerDetails, buildConfiguration *build.BuildConfiguration, gitDetails GitLogDetails) (string, error) {
+	vcsUrl, err := validateGitAndGetVcsUrl(&gitDetails)
 	if err != nil {
 		return "", err
 	}
 
+	lastVcsRevision, fromGit, err := resolveGitRangeStart(gitDetails)
+	if err != nil {
+		return "", err
+	}
+	if !fromGit {
+		if lastVcsRevision, err = getLatestVcsRevision(serverDetails, buildConfiguration, vcsUrl); err != nil {
+			return "", err
+		}
+	}
 	return getPlainGitLogFromLastVcsRevision(gitDetails, lastVcsRevision)
 }
 
+// GetChangedFilesFromLastBuild Returns the paths of the files changed since the VCS revision of the latest build, relative to the root of the repository.
+// If the build has no matching VCS revision, all the files in the git history are returned.
+// Return RevisionRangeError if revision isn't found (due to git history modification).
+func GetChangedFilesFromLastBuild(serverDetails *utilsconfig.ServerDetails, buildConfiguration *build.BuildConfiguration, gitDetails GitLogDetails) ([]string, error) {
+	vcsUrl, err := validateGitAndGetVcsUrl(&gitDetails)
+	if err != nil {
+		return nil, err
+	}
+
+	lastVcsRevision, fromGit, err := resolveGitRangeStart(gitDetails)
+	if err != nil {
+		return nil, err
+	}
+	if !fromGit {
+		if lastVcsRevision, err = getLatestVcsRevision(serverDetails, buildConfiguration, vcsUrl); err != nil {
+			return nil, err
+		}
+	}
+
+	gitDetails.PrettyFormat = "format:"
+	gitDetails.NameOnly = true
+	gitLog, err := getPlainGitLogFromLastVcsRevision(gitDetails, lastVcsRevision)
+	if err != nil {
+		return nil, err
+	}
+	return parseChangedFiles(gitLog), nil
+}
+
+// Returns the unique, sorted, non-empty lines of a 'git log --name-only' output.
+func parseChangedFiles(gitLog string) []string {
+	changedFiles := datastructures.MakeSet[string]()
+	for _, line := range strings.Split(gitLog, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			changedFiles.Add(line)
+		}
+	}
+	files := changedFiles.ToSlice()
+	sort.Strings(files)
+	return files
+}
+
+// GetLatestGitTag Returns the latest tag reachable from HEAD, in the repository containing the provided .git directory.
+// If dotGitPath is empty, the .git directory is searched for in the current directory and its parents.
+func GetLatestGitTag(dotGitPath string) (string, error) {
+	if _, err := exec.LookPath("git"); err != nil {
+		return "", errorutils.CheckError(err)
+	}
+	dotGitPath, err := GetDotGit(dotGitPath)
+	if err != nil {
+		return "", err
+	}
+	tag, err := runGit(dotGitPath, "describe", "--tags", "--abbrev=0")
+	if err != nil {
+		return "", errorutils.CheckErrorf("failed getting the latest git tag: %s", err.Error())
+	}
+	return tag, nil
+}
+
+// Returns the commit which the log starts from, resolved from the ref or the tag pattern of the git details. Returns
+// false if neither is set, so the log starts from the VCS revision of a build. An empty revision starts the log from
+// the first commit, when no tag matches the pattern.
+func resolveGitRangeStart(gitDetails GitLogDetails) (revision string, fromGit bool, err error) {
+	ref := gitDetails.FromRef
+	if ref == "" && gitDetails.FromTagPattern != "" {
+		if ref, err = getLatestMatchingTag(gitDetails.DotGitPath, gitDetails.FromTagPattern); err != nil || ref == "" {
+			return "", true, err
+		}
+		log.Info("Collecting the git log from the tag", ref)
+	}
+	if ref == "" {
+		return "", false, nil
+	}
+	// Annotated tags are peeled to the commits they tag.
+	revision, err = runGit(gitDetails.DotGitPath, "rev-parse", "--verify", "--quiet", ref+"^{commit}")
+	if err != nil {
+		log.Debug(err.Error())
+		return "", true, errorutils.CheckErrorf("'%s' isn't a valid git revision", ref)
+	}
+	return revision, true, nil
+}
+
+// Returns the latest tag reachable from HEAD which matches the glob pattern, excluding the tags of HEAD itself, so that
+// a release tagged at HEAD is compared with the previous release. Returns an empty tag if no tag matches.
+func getLatestMatchingTag(dotGitPath, pattern string) (string, error) {
+	headTags, err := runGit(dotGitPath, "tag", "--points-at", "HEAD")
+	if err != nil {
+		return "", errorutils.CheckErrorf("failed listing the git tags of HEAD: %s", err.Error())
+	}
+	args := []string{"describe", "--tags", "--abbrev=0", "--match", pattern}
+	for _, tag := range strings.Fields(headTags) {
+		args = append(args, "--exclude", tag)
+	}
+	tag, err := runGit(dotGitPath, append(args, "HEAD")...)
+	if err != nil {
+		log.Info("No git tag matching '" + pattern + "' was found, so the git log is collected from the first commit.")
+		log.Debug(err.Error())
+		return "", nil
+	}
+	return tag, nil
+}
+
+// Runs git in the repository of the .git directory, and returns its trimmed output, or its error output as an error.
+func runGit(dotGitPath string, args ...string) (string, error) {
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dotGitPath
+	output, err := cmd.Output()
+	if err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) && len(exitErr.Stderr) > 0 {
+			return "", errors.New(strings.TrimSpace(string(exitErr.Stderr)))
+		}
+		return "", err
+	}
+	return strings.TrimSpace(string(output)), nil
+}
+
 func GetLastBuildLink(serverDetails *utilsconfig.ServerDetails, buildConfiguration *build.BuildConfiguration) (string, error) {
 	lastPublishedBuildInfo, err := getPreviousBuild(serverDetails, buildConfiguration, 0)
 	if err != nil {
@@ -134,30 +297,30 @@ func GetDotGit(providedDotGitPath string) (string, error) {
 
 // Gets the vcs revision from the latest build in Artifactory.
 func getLatestVcsRevision(serverDetails *utilsconfig.ServerDetails, buildConfiguration *build.BuildConfiguration, vcsUrl string) (string, error) {
-	buildInfo, err := getLatestBuildInfo(serverDetails, buildConfiguration)
+	// Create services manager to get build-info from Artifactory.
+	sm, err := clientconfig.CreateServiceManager(serverDetails, -1, 0, false)
+	if err != nil {
+		return "", err
+	}
+	buildInfo, err := getLatestBuildInfo(sm, buildConfiguration)
 	if err != nil {
 		return "", err
 	}
 
-	return getMatchingRevisionFromBuild(buildInfo, vcsUrl), nil
+	return getMatchingRevisionFromBuildTree(buildInfo, vcsUrl, newPublishedBuildInfoGetter(sm, buildConfiguration.GetProject()))
 }
 
 // Gets the vcs revision from the build in position "previousBuildPos" in Artifactory. previousBuildPos = 0 is the latest build.
 // previousBuildPos must be 0 or larger.
 func getVcsFromPreviousBuild(serverDetails *utilsconfig.ServerDetails, buildConfiguration *build.BuildConfiguration, vcsUrl string) (string, error) {
-	buildInfo, err := getPreviousBuildsCommit(serverDetails, buildConfiguration)
-	if err != nil {
-		return "", err
-	}
-
-	return getMatchingRevisionFromBuild(&buildInfo.BuildInfo, vcsUrl), nil
+	return getPreviousBuildsCommit(serverDetails, buildConfiguration, vcsUrl)
 }
 
 // Returns the vcs revision that matches th provided vcs url.
 func getMatchingRevisionFromBuild(buildInfo *buildinfo.BuildInfo, vcsUrl string) string {
 	lastVcsRevision := ""
 	for _, vcs := range buildInfo.VcsList {
-		if vcs.Url == vcsUrl {
+		if cienv.NormalizeVcsUrl(vcs.Url) == cienv.NormalizeVcsUrl(vcsUrl) {
 			lastVcsRevision = vcs.Revision
 			break
 		}
@@ -165,14 +328,62 @@ func getMatchingRevisionFromBuild(buildInfo *buildinfo.BuildInfo, vcsUrl string)
 	return lastVcsRevision
 }
 
-// Returns build info, or empty build info struct if not found.
-func getLatestBuildInfo(serverDetails *utilsconfig.ServerDetails, buildConfiguration *build.BuildConfiguration) (*buildinfo.BuildInfo, error) {
-	// Create services manager to get build-info from Artifactory.
-	sm, err := utils.CreateServiceManager(serverDetails, -1, 0, false)
-	if err != nil {
-		return nil, err
+// The maximal depth of nested aggregated builds searched for a vcs revision.
+const maxChildBuildsDepth = 5
+
+// Gets a published build-info by its name and number. Returns nil if the build is not found.
+type publishedBuildInfoGetter func(buildName, buildNumber string) (*buildinfo.BuildInfo, error)
+
+func newPublishedBuildInfoGetter(sm artifactory.ArtifactoryServicesManager, projectKey string) publishedBuildInfoGetter {
+	return func(buildName, buildNumber string) (*buildinfo.BuildInfo, error) {
+		publishedBuildInfo, found, err := sm.GetBuildInfo(services.BuildInfoParams{BuildName: buildName, BuildNumber: buildNumber, ProjectKey: projectKey})
+		if err != nil || !found {
+			return nil, err
+		}
+		return &publishedBuildInfo.BuildInfo, nil
+	}
+}
+
+// Returns the vcs revision that matches the provided vcs url. An aggregated build usually has no vcs details of its own,
+// so if the build has no matching revision, its child builds are searched in the order they were appended.
+func getMatchingRevisionFromBuildTree(buildInfo *buildinfo.BuildInfo, vcsUrl string, getBuildInfo publishedBuildInfoGetter) (string, error) {
+	visited := datastructures.MakeSet[string]()
+	visited.Add(buildInfo.Name + "/" + buildInfo.Number)
+	return getMatchingRevisionFromChildBuilds(buildInfo, vcsUrl, getBuildInfo, visited, 0)
+}
+
+func getMatchingRevisionFromChildBuilds(buildInfo *buildinfo.BuildInfo, vcsUrl string, getBuildInfo publishedBuildInfoGetter, visited *datastructures.Set[string], depth int) (string, error) {
+	if revision := getMatchingRevisionFromBuild(buildInfo, vcsUrl); revision != "" || depth == maxChildBuildsDepth {
+		return revision, nil
+	}
+	for _, module := range buildInfo.Modules {
+		if module.Type != buildinfo.Build || visited.Exists(module.Id) {
+			continue
+		}
+		visited.Add(module.Id)
+		separator := strings.LastIndex(module.Id, "/")
+		if separator <= 0 {
+			log.Debug("Skipping the child build with the invalid id:", module.Id)
+			continue
+		}
+		childBuildInfo, err := getBuildInfo(module.Id[:separator], module.Id[separator+1:])
+		if err != nil {
+			return "", err
+		}
+		if childBuildInfo == nil {
+			log.Debug("The child build", module.Id, "of", buildInfo.Name+"/"+buildInfo.Number, "was not found")
+			continue
+		}
+		revision, err := getMatchingRevisionFromChildBuilds(childBuildInfo, vcsUrl, getBuildInfo, visited, depth+1)
+		if err != nil || revision != "" {
+			return revision, err
+		}
 	}
+	return "", nil
+}
 
+// Returns build info, or empty build info struct if not found.
+func getLatestBuildInfo(sm artifactory.ArtifactoryServicesManager, buildConfiguration *build.BuildConfiguration) (*buildinfo.BuildInfo, error) {
 	// Get latest build-info from Artifactory.
 	buildName, err := buildConfiguration.GetBuildName()
 	if err != nil {
@@ -199,7 +410,7 @@ func getPreviousBuild(serverDetails *utilsconfig.ServerDetails, buildConfigurati
 	}
 
 	// Create services manager to get build-info from Artifactory.
-	sm, err := utils.CreateServiceManager(serverDetails, -1, 0, false)
+	sm, err := clientconfig.CreateServiceManager(serverDetails, -1, 0, false)
 	if err != nil {
 		return nil, err
 	}
@@ -236,60 +447,59 @@ func getPreviousBuild(serverDetails *utilsconfig.ServerDetails, buildConfigurati
 	return publishedBuildInfo, nil
 }
 
-// Retrieves the build information of the first build that has a different VCS commit hash compared to the latest build.
+// Retrieves the vcs revision of the first build that has a different VCS commit hash compared to the latest build.
 // Iterates through previous builds in descending order until it finds a build with a different commit hash.
-// Returns an empty build info struct if no such build is found or if there are no previous builds available.
-func getPreviousBuildsCommit(serverDetails *utilsconfig.ServerDetails, buildConfiguration *build.BuildConfiguration) (*buildinfo.PublishedBuildInfo, error) {
+// The commit hashes are those matching the provided vcs url, searched in the child builds of aggregated builds too.
+// Returns an empty revision if there are no previous builds available.
+func getPreviousBuildsCommit(serverDetails *utilsconfig.ServerDetails, buildConfiguration *build.BuildConfiguration, vcsUrl string) (string, error) {
 	// Create services manager to get build-info from Artifactory.
-	sm, err := utils.CreateServiceManager(serverDetails, -1, 0, false)
+	sm, err := clientconfig.CreateServiceManager(serverDetails, -1, 0, false)
 	if err != nil {
-		return nil, err
+		return "", err
 	}
 
 	buildName, err := buildConfiguration.GetBuildName()
 	if err != nil {
-		return nil, err
+		return "", err
 	}
 	projectKey := buildConfiguration.GetProject()
 	buildInfoParams := services.BuildInfoParams{BuildName: buildName, ProjectKey: projectKey}
 
 	runs, found, err := sm.GetBuildRuns(buildInfoParams)
 	if err != nil {
-		return nil, err
+		return "", err
 	}
 	// Return if build not found, or not enough build runs were returned to match the requested previous position.
 	if !found || len(runs.BuildsNumbers) == 0 {
-		return &buildinfo.PublishedBuildInfo{}, nil
-	}
-
-	// Take the first log to get the reference for the first builds commit
-	lastBuildInfoParams := services.BuildInfoParams{BuildName: buildName, ProjectKey: projectKey}
-	lastBuildInfoParams.BuildNumber = strings.TrimPrefix(runs.BuildsNumbers[0].Uri, "/")
-	lastPublishedBuildInfo, found, err := sm.GetBuildInfo(lastBuildInfoParams)
-	if err != nil {
-		return nil, err
+		return "", nil
 	}
-	// If build was deleted between requests.
-	if !found {
-		return &buildinfo.PublishedBuildInfo{}, nil
-	}
-	for _, run := range runs.BuildsNumbers {
-		buildInfoParams.BuildNumber = strings.TrimPrefix(run.Uri, "/")
 
-		publishedBuildInfo, found, err := sm.GetBuildInfo(buildInfoParams)
+	getBuildInfo := newPublishedBuildInfoGetter(sm, projectKey)
+	var lastRevision string
+	for i, run := range runs.BuildsNumbers {
+		buildInfo, err := getBuildInfo(buildName, strings.TrimPrefix(run.Uri, "/"))
 		if err != nil {
-			return nil, err
+			return "", err
 		}
 		// If build was deleted between requests.
-		if !found {
-			return &buildinfo.PublishedBuildInfo{}, nil
+		if buildInfo == nil {
+			return "", nil
+		}
+		revision, err := getMatchingRevisionFromBuildTree(buildInfo, vcsUrl, getBuildInfo)
+		if err != nil {
+			return "", err
+		}
+		// Take the first build to get the reference for the latest commit.
+		if i == 0 {
+			lastRevision = revision
+			continue
 		}
-		// If the commit hash is different from the last build, return the build info
-		if publishedBuildInfo.BuildInfo.VcsList[0].Revision != lastPublishedBuildInfo.BuildInfo.VcsList[0].Revision {
-			return publishedBuildInfo, nil
+		// If the commit hash is different from the last build, return it
+		if revision != lastRevision {
+			return revision, nil
 		}
 	}
-	return nil, errors.New("no previous builds commit has found")
+	return "", errors.New("no previous builds commit has found")
 }
 
 func convertToUiLink(info *buildinfo.PublishedBuildInfo) (string, error) {
@@ -344,7 +554,7 @@ func validateGitAndGetVcsUrl(gitDetails *GitLogDetails) (string, error) {
 
 func prepareGitLogCommand(gitDetails GitLogDetails, lastVcsRevision string) (logCmd *LogCmd, cleanupFunc func() error, err error) {
 	// Get log with limit, starting from the latest commit.
-	logCmd = &LogCmd{logLimit: gitDetails.LogLimit, lastVcsRevision: lastVcsRevision, prettyFormat: gitDetails.PrettyFormat}
+	logCmd = &LogCmd{logLimit: gitDetails.LogLimit, lastVcsRevision: lastVcsRevision, prettyFormat: gitDetails.PrettyFormat, nameOnly: gitDetails.NameOnly}
 
 	// Change working dir to where .git is.
 	wd, err := os.Getwd()
@@ -408,6 +618,12 @@ func getVcsUrl(dotGitPath string) (string, error) {
 	if err := gitManager.ReadConfig(); err != nil {
 		return "", err
 	}
+	if gitManager.GetUrl() == "" {
+		// Some CI systems check out the repository without a remote.
+		if ciEnv := cienv.Detect(); ciEnv != nil {
+			return ciEnv.VcsUrl, nil
+		}
+	}
 	return gitManager.GetUrl(), nil
 }
 
@@ -415,12 +631,19 @@ type LogCmd struct {
 	logLimit        int
 	lastVcsRevision string
 	prettyFormat    string
+	nameOnly        bool
 }
 
 func (logCmd *LogCmd) GetCmd() *exec.Cmd {
 	var cmd []string
 	cmd = append(cmd, "git")
-	cmd = append(cmd, "log", "--pretty="+logCmd.prettyFormat, "-"+strconv.Itoa(logCmd.logLimit))
+	cmd = append(cmd, "log", "--pretty="+logCmd.prettyFormat)
+	if logCmd.logLimit > 0 {
+		cmd = append(cmd, "-"+strconv.Itoa(logCmd.logLimit))
+	}
+	if logCmd.nameOnly {
+		cmd = append(cmd, "--name-only")
+	}
 	if logCmd.lastVcsRevision != "" {
 		cmd = append(cmd, logCmd.lastVcsRevision+"..")
 	}
@@ -447,3 +670,11 @@ type RevisionRangeError struct {
 func (err RevisionRangeError) Error() string {
 	return err.ErrorMsg
 }
+
+func (err RevisionRangeError) Code() clierrors.Code {
+	return RevisionRangeErrorCode
+}
+
+func (err RevisionRangeError) Hint() string {
+	return "The revision was probably removed from the git history by a squash, a rebase or a force push. Set the start of the range by --from-ref or --from-tag-pattern."
+}
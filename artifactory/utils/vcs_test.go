@@ -1,8 +1,12 @@
 package utils
 
 import (
+	buildinfo "github.com/jfrog/build-info-go/entities"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clierrors"
 	"github.com/jfrog/jfrog-cli-core/v2/utils/tests"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"os/exec"
 	"path/filepath"
 	"strings"
 	"testing"
@@ -23,6 +27,10 @@ func TestGetPlainGitLogFromLastVcsRevision(t *testing.T) {
 	// Expect an RevisionRangeError error when revision doesn't exist.
 	_, err := getPlainGitLogFromLastVcsRevision(gitDetails, "1111111111111111111111111111111111111111")
 	assert.ErrorAs(t, err, &RevisionRangeError{})
+	var categorized clierrors.CategorizedError
+	if assert.ErrorAs(t, err, &categorized) {
+		assert.Equal(t, RevisionRangeErrorCode, categorized.Code())
+	}
 }
 
 func runGitLogAndCountCommits(t *testing.T, gitDetails GitLogDetails, vcsRevision string, expectedCommits int) {
@@ -31,3 +39,112 @@ func runGitLogAndCountCommits(t *testing.T, gitDetails GitLogDetails, vcsRevisio
 	commits := strings.Split(strings.TrimSpace(gitLog), "\n")
 	assert.Len(t, commits, expectedCommits)
 }
+
+func TestParseChangedFiles(t *testing.T) {
+	gitLog := "\nsrc/b.go\nsrc/a.go\n\n\nsrc/a.go\nREADME.md\n"
+	assert.Equal(t, []string{"README.md", "src/a.go", "src/b.go"}, parseChangedFiles(gitLog))
+	assert.Empty(t, parseChangedFiles(""))
+}
+
+func TestGetMatchingRevisionFromBuildTree(t *testing.T) {
+	vcsUrl := "https://github.com/org/app.git"
+	childModule := func(id string) buildinfo.Module {
+		return buildinfo.Module{Type: buildinfo.Build, Id: id}
+	}
+	publishedBuilds := map[string]*buildinfo.BuildInfo{
+		"lib/3":         {Name: "lib", Number: "3", VcsList: []buildinfo.Vcs{{Url: "https://github.com/org/lib.git", Revision: "lib-rev"}}},
+		"team/app/7":    {Name: "team/app", Number: "7", VcsList: []buildinfo.Vcs{{Url: "https://github.com/org/App", Revision: "app-rev"}}},
+		"umbrella-a/2":  {Name: "umbrella-a", Number: "2", Modules: []buildinfo.Module{childModule("lib/3"), childModule("team/app/7")}},
+		"umbrella-b/1":  {Name: "umbrella-b", Number: "1", Modules: []buildinfo.Module{childModule("umbrella-b/1")}},
+		"not-published": nil,
+	}
+	var fetched []string
+	getBuildInfo := func(buildName, buildNumber string) (*buildinfo.BuildInfo, error) {
+		fetched = append(fetched, buildName+"/"+buildNumber)
+		return publishedBuilds[buildName+"/"+buildNumber], nil
+	}
+
+	// The build's own revision is used without fetching child builds.
+	revision, err := getMatchingRevisionFromBuildTree(publishedBuilds["team/app/7"], vcsUrl, getBuildInfo)
+	assert.NoError(t, err)
+	assert.Equal(t, "app-rev", revision)
+	assert.Empty(t, fetched)
+
+	// Nested child builds are searched, including missing builds and build names with slashes.
+	parent := &buildinfo.BuildInfo{Name: "parent", Number: "1", Modules: []buildinfo.Module{{Id: "module"}, childModule("not/published"), childModule("umbrella-a/2")}}
+	revision, err = getMatchingRevisionFromBuildTree(parent, vcsUrl, getBuildInfo)
+	assert.NoError(t, err)
+	assert.Equal(t, "app-rev", revision)
+	assert.Equal(t, []string{"not/published", "umbrella-a/2", "lib/3", "team/app/7"}, fetched)
+
+	// A build referencing itself isn't fetched again.
+	fetched = nil
+	revision, err = getMatchingRevisionFromBuildTree(publishedBuilds["umbrella-b/1"], vcsUrl, getBuildInfo)
+	assert.NoError(t, err)
+	assert.Empty(t, revision)
+	assert.Empty(t, fetched)
+}
+
+// Creates a git repository with a commit per message, and returns its .git directory and the revisions of the commits.
+func createGitRepo(t *testing.T, messages ...string) (string, []string) {
+	dir := t.TempDir()
+	git := func(args ...string) string {
+		cmd := exec.Command("git", append([]string{"-c", "user.name=test", "-c", "user.email=test@example.com"}, args...)...)
+		cmd.Dir = dir
+		output, err := cmd.CombinedOutput()
+		require.NoError(t, err, string(output))
+		return strings.TrimSpace(string(output))
+	}
+	git("init", "--quiet")
+	var revisions []string
+	for _, message := range messages {
+		git("commit", "--quiet", "--allow-empty", "-m", message)
+		revisions = append(revisions, git("rev-parse", "HEAD"))
+	}
+	return filepath.Join(dir, ".git"), revisions
+}
+
+func TestResolveGitRangeStart(t *testing.T) {
+	dotGitPath, revisions := createGitRepo(t, "first", "second", "third", "fourth")
+	tag := func(args ...string) {
+		cmd := exec.Command("git", append([]string{"-c", "user.name=test", "-c", "user.email=test@example.com", "tag"}, args...)...)
+		cmd.Dir = dotGitPath
+		output, err := cmd.CombinedOutput()
+		require.NoError(t, err, string(output))
+	}
+
+	// Without a ref or a tag pattern, the range starts from the build's revision.
+	_, fromGit, err := resolveGitRangeStart(GitLogDetails{DotGitPath: dotGitPath})
+	require.NoError(t, err)
+	assert.False(t, fromGit)
+
+	// No tag matches the pattern, so the log starts from the first commit.
+	revision, fromGit, err := resolveGitRangeStart(GitLogDetails{DotGitPath: dotGitPath, FromTagPattern: "v*"})
+	require.NoError(t, err)
+	assert.True(t, fromGit)
+	assert.Empty(t, revision)
+
+	tag("-a", "v1.0.0", "-m", "release 1.0.0", revisions[1])
+	tag("other", revisions[2])
+	// The annotated tag is resolved to its commit.
+	revision, _, err = resolveGitRangeStart(GitLogDetails{DotGitPath: dotGitPath, FromTagPattern: "v*"})
+	require.NoError(t, err)
+	assert.Equal(t, revisions[1], revision)
+
+	// The tag of HEAD is excluded, so a release tagged at HEAD starts from the previous release.
+	tag("v1.1.0", revisions[3])
+	revision, _, err = resolveGitRangeStart(GitLogDetails{DotGitPath: dotGitPath, FromTagPattern: "v*"})
+	require.NoError(t, err)
+	assert.Equal(t, revisions[1], revision)
+
+	// A ref expression takes precedence over the tag pattern.
+	revision, _, err = resolveGitRangeStart(GitLogDetails{DotGitPath: dotGitPath, FromRef: "HEAD~3", FromTagPattern: "v*"})
+	require.NoError(t, err)
+	assert.Equal(t, revisions[0], revision)
+	gitLog, err := getPlainGitLogFromLastVcsRevision(GitLogDetails{DotGitPath: dotGitPath, PrettyFormat: "format:%s"}, revision)
+	require.NoError(t, err)
+	assert.Equal(t, "fourth\nthird\nsecond", strings.TrimSpace(gitLog))
+
+	_, _, err = resolveGitRangeStart(GitLogDetails{DotGitPath: dotGitPath, FromRef: "missing"})
+	assert.ErrorContains(t, err, "'missing' isn't a valid git revision")
+}
@@ -2,9 +2,13 @@ package cli
 
 import (
 	artifactoryCLI "github.com/jfrog/jfrog-cli-artifactory/artifactory/cli"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clierrors"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/telemetry"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/throttle"
 	distributionCLI "github.com/jfrog/jfrog-cli-artifactory/distribution/cli"
 	ideCLI "github.com/jfrog/jfrog-cli-artifactory/ide/cli"
 	"github.com/jfrog/jfrog-cli-artifactory/lifecycle"
+	"github.com/jfrog/jfrog-cli-artifactory/sbom"
 	"github.com/jfrog/jfrog-cli-core/v2/common/cliutils"
 	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
 )
@@ -17,22 +21,34 @@ func GetJfrogCliArtifactoryApp() components.App {
 	app.Subcommands = append(app.Subcommands, components.Namespace{
 		Name:        string(cliutils.Ds),
 		Description: "Distribution V1 commands.",
-		Commands:    distributionCLI.GetCommands(),
+		Commands:    wrapCommands(string(cliutils.Ds), distributionCLI.GetCommands()),
 		Category:    "Command Namespaces",
 	})
 	app.Subcommands = append(app.Subcommands, components.Namespace{
 		Name:        string(cliutils.Rt),
 		Description: "Artifactory commands.",
-		Commands:    artifactoryCLI.GetCommands(),
+		Commands:    wrapCommands(string(cliutils.Rt), artifactoryCLI.GetCommands()),
 		Category:    "Command Namespaces",
 	})
 	app.Subcommands = append(app.Subcommands, components.Namespace{
 		Name:        "ide",
 		Description: "IDE commands.",
-		Commands:    ideCLI.GetCommands(),
+		Commands:    wrapCommands("ide", ideCLI.GetCommands()),
 		Category:    "Command Namespaces",
 	})
-	app.Commands = append(app.Commands, lifecycle.GetCommands()...)
+	app.Subcommands = append(app.Subcommands, components.Namespace{
+		Name:        "sbom",
+		Description: "SBOM commands.",
+		Commands:    wrapCommands("sbom", sbom.GetCommands()),
+		Category:    "Command Namespaces",
+	})
+	app.Commands = append(app.Commands, wrapCommands("", lifecycle.GetCommands())...)
 
 	return app
 }
+
+// wrapCommands adds the throttling options to the commands of the namespace, renders the hints of their categorized
+// errors, and instruments them by the telemetry.
+func wrapCommands(namespace string, commands []components.Command) []components.Command {
+	return telemetry.InstrumentCommands(namespace, clierrors.RenderCommands(throttle.ConfigureCommands(commands)))
+}
@@ -9,14 +9,25 @@ const (
 	ReleaseBundleV1Delete     = "release-bundle-v1-delete"
 
 	// Lifecycle Commands
-	ReleaseBundleCreate       = "release-bundle-create"
-	ReleaseBundleUpdate       = "release-bundle-update"
-	ReleaseBundleFinalize     = "release-bundle-finalize"
-	ReleaseBundlePromote      = "release-bundle-promote"
-	ReleaseBundleDistribute   = "release-bundle-distribute"
-	ReleaseBundleDeleteLocal  = "release-bundle-delete-local"
-	ReleaseBundleDeleteRemote = "release-bundle-delete-remote"
-	ReleaseBundleExport       = "release-bundle-export"
-	ReleaseBundleImport       = "release-bundle-import"
-	ReleaseBundleAnnotate     = "release-bundle-annotate"
+	ReleaseBundleCreate             = "release-bundle-create"
+	ReleaseBundleUpdate             = "release-bundle-update"
+	ReleaseBundleFinalize           = "release-bundle-finalize"
+	ReleaseBundlePromote            = "release-bundle-promote"
+	ReleaseBundleDistribute         = "release-bundle-distribute"
+	ReleaseBundleDeleteLocal        = "release-bundle-delete-local"
+	ReleaseBundleDeleteRemote       = "release-bundle-delete-remote"
+	ReleaseBundleExport             = "release-bundle-export"
+	ReleaseBundleImport             = "release-bundle-import"
+	ReleaseBundleAnnotate           = "release-bundle-annotate"
+	ReleaseBundleDistributionStatus = "release-bundle-distribution-status"
+	ReleaseBundleContents           = "release-bundle-contents"
+	ReleaseBundleDiff               = "release-bundle-diff"
+	SigningKeyUpload                = "signing-key-upload"
+	SigningKeyList                  = "signing-key-list"
+	SigningKeyPropagate             = "signing-key-propagate"
+	SigningKeyDelete                = "signing-key-delete"
+
+	// SBOM Commands
+	SbomGenerate = "sbom-generate"
+	SbomPublish  = "sbom-publish"
 )
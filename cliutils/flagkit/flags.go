@@ -30,6 +30,8 @@ const (
 	Upload                 = "upload"
 	Download               = "download"
 	DirectDownload         = "direct-download"
+	VerifyDownload         = "verify-download"
+	SyncCmd                = "sync"
 	Move                   = "move"
 	Copy                   = "copy"
 	Delete                 = "delete"
@@ -40,8 +42,11 @@ const (
 	BuildScanLegacy        = "build-scan-legacy"
 	BuildPromote           = "build-promote"
 	BuildDiscard           = "build-discard"
+	BuildExport            = "build-export"
+	BuildScanGate          = "build-scan-gate"
 	BuildAddDependencies   = "build-add-dependencies"
 	BuildAddGit            = "build-add-git"
+	BuildAffectedModules   = "build-affected-modules"
 	BuildCollectEnv        = "build-collect-env"
 	GitLfsClean            = "git-lfs-clean"
 	Mvn                    = "mvn"
@@ -51,6 +56,10 @@ const (
 	Gradle                 = "gradle"
 	GradleConfig           = "gradle-config"
 	DockerPromote          = "docker-promote"
+	DockerCleanup          = "docker-cleanup"
+	Cleanup                = "cleanup"
+	Immutable              = "immutable"
+	LegalHold              = "legal-hold"
 	Docker                 = "docker"
 	DockerPush             = "docker-push"
 	DockerPull             = "docker-pull"
@@ -62,6 +71,7 @@ const (
 	NpmInstallCi           = "npm-install-ci"
 	NpmPublish             = "npm-publish"
 	PnpmConfig             = "pnpm-config"
+	Pnpm                   = "pnpm"
 	YarnConfig             = "yarn-config"
 	Yarn                   = "yarn"
 	NugetConfig            = "nuget-config"
@@ -80,12 +90,64 @@ const (
 	PipenvInstall          = "pipenv-install"
 	PoetryConfig           = "poetry-config"
 	Poetry                 = "poetry"
+	Pdm                    = "pdm"
+	Conan                  = "conan"
+	Helm                   = "helm"
+	Cargo                  = "cargo"
+	Swift                  = "swift"
+	Pod                    = "pod"
 	Ping                   = "ping"
+	HealthCheck            = "health-check"
+	RtProxy                = "rt-proxy"
 	RtCurl                 = "rt-curl"
 	TemplateConsumer       = "template-consumer"
 	RepoDelete             = "repo-delete"
+	RepoApply              = "repo-apply"
+	RepoExport             = "repo-export"
+	FederationConvert      = "federation-convert"
+	FederationMemberAdd    = "federation-member-add"
+	FederationMemberRemove = "federation-member-remove"
+	FederationSync         = "federation-sync"
+	FederationStatus       = "federation-status"
+	ResolveTrace           = "resolve-trace"
+	Whence                 = "whence"
+	EvidenceCreate         = "evidence-create"
+	EvidenceCreateBulk     = "evidence-create-bulk"
+	EvidenceExport         = "evidence-export"
+	EvidenceVerify         = "evidence-verify"
+	EvidenceKeyGenerate    = "evidence-key-generate"
+	EvidenceKeyUpload      = "evidence-key-upload"
+	EvidenceKeyList        = "evidence-key-list"
+	EvidenceKeyRotate      = "evidence-key-rotate"
+	EvidenceImportGithub   = "evidence-import-github"
+	EvidenceExportGithub   = "evidence-export-github"
 	ReplicationDelete      = "replication-delete"
+	ReplicationStatus      = "replication-status"
+	TransferFilesStatus    = "transfer-files-status"
 	PermissionTargetDelete = "permission-target-delete"
+	PermissionTargetImport = "permission-target-import"
+	PermissionTargetExport = "permission-target-export"
+	ProjectApply           = "project-apply"
+	ProjectExport          = "project-export"
+	ProjectDelete          = "project-delete"
+	// #nosec G101 -- False positive - no hardcoded credentials.
+	TokenCreate       = "token-create"
+	OidcTokenExchange = "oidc-token-exchange"
+	TokenRefresh      = "token-refresh"
+	TokenRevoke       = "token-revoke"
+	WebhookCreate     = "webhook-create"
+	WebhookList       = "webhook-list"
+	WebhookDelete     = "webhook-delete"
+	WebhookListen     = "webhook-listen"
+	StorageReport     = "storage-report"
+	DebDeploy         = "deb-deploy"
+	RpmDeploy         = "rpm-deploy"
+	OciPush           = "oci-push"
+	OciPull           = "oci-pull"
+	OciReferrers      = "oci-referrers"
+	OciSign           = "oci-sign"
+	OciVerify         = "oci-verify"
+	MvnDeployFile     = "maven-deploy-file"
 	// #nosec G101 -- False positive - no hardcoded credentials.
 	ArtifactoryAccessTokenCreate = "artifactory-access-token-create"
 	UserCreate                   = "user-create"
@@ -94,6 +156,12 @@ const (
 	GroupCreate                  = "group-create"
 	GroupAddUsers                = "group-add-users"
 	GroupDelete                  = "group-delete"
+	UserUpdate                   = "user-update"
+	GroupUpdate                  = "group-update"
+	UsersList                    = "users-list"
+	GroupsList                   = "groups-list"
+	UsersExport                  = "users-export"
+	UsersImport                  = "users-import"
 	passphrase                   = "passphrase"
 
 	// ReleaseBundleSearch command flags
@@ -173,6 +241,30 @@ const (
 	publicGpgKey            = "gpg-key"
 	archiveEntries          = "archive-entries"
 	detailedSummary         = "detailed-summary"
+	rateLimit               = "rate-limit"
+	globalRateLimit         = "global-rate-limit"
+	adaptiveThreads         = "adaptive-threads"
+	minThreads              = "min-threads"
+	encryptionKey           = "encryption-key"
+	encryptionKeyCommand    = "encryption-key-command"
+	preserveSymlinks        = "preserve-symlinks"
+	dedup                   = "dedup"
+	dedupRepos              = "dedup-repos"
+	uploadServers           = "servers"
+	allOrNothing            = "all-or-nothing"
+	atomicUpload            = "atomic"
+	stagingRepo             = "staging-repo"
+	watchDebounce           = "watch-debounce"
+	extractEntries          = "extract-entries"
+	downloadOutput          = "output"
+	syncDirection           = "direction"
+	syncDeleteMissing       = "delete-missing"
+	aqlFile                 = "aql-file"
+	searchJsonl             = "jsonl"
+	searchCountOnly         = "count-only"
+	onConflict              = "on-conflict"
+	mergeProps              = "merge-props"
+	preserveStats           = "preserve-stats"
 	archive                 = "archive"
 	syncDeletesQuiet        = syncDeletes + "-" + quiet
 	antFlag                 = "ant"
@@ -200,10 +292,12 @@ const (
 	uploadSyncDeletes = uploadPrefix + syncDeletes
 	uploadArchive     = uploadPrefix + archive
 	uploadMinSplit    = uploadPrefix + MinSplit
+	uploadWatch       = uploadPrefix + Watch
 	uploadSplitCount  = uploadPrefix + SplitCount
 	deb               = "deb"
 	symlinks          = "symlinks"
 	uploadAnt         = uploadPrefix + antFlag
+	deltaManifest     = "delta-manifest"
 
 	// Unique download flags
 	downloadPrefix       = "download-"
@@ -217,6 +311,14 @@ const (
 	downloadSplitCount   = downloadPrefix + SplitCount
 	validateSymlinks     = "validate-symlinks"
 	skipChecksum         = "skip-checksum"
+	delta                = "delta"
+	verify               = "verify"
+	verifyFailure        = "verify-failure"
+	verifyRetries        = "verify-retries"
+	latestVersion        = "latest"
+	versionRange         = "version-range"
+	downloadCacheDir     = "cache-dir"
+	downloadCacheMaxSize = "cache-max-size"
 
 	// Unique move flags
 	movePrefix       = "move-"
@@ -270,17 +372,35 @@ const (
 	collectGitInfo     = "collect-git-info"
 	dotGitPath         = "dot-git-path"
 	gitConfigFilePath  = "git-config-file-path"
+	buildState         = "build-state"
+
+	// Unique build-append flags
+	childBuilds = "children"
+
+	// Unique build-collect-env flags
+	envIncludeRegex = "env-include-regex"
+	envExcludeRegex = "env-exclude-regex"
+	maskSecrets     = "mask-secrets"
 
 	// Unique build-add-dependencies flags
-	badPrefix    = "bad-"
-	badDryRun    = badPrefix + dryRun
-	badRecursive = badPrefix + Recursive
-	badRegexp    = badPrefix + regexpFlag
-	badFromRt    = badPrefix + fromRt
-	badModule    = badPrefix + module
+	badPrefix     = "bad-"
+	badDryRun     = badPrefix + dryRun
+	badRecursive  = badPrefix + Recursive
+	badRegexp     = badPrefix + regexpFlag
+	badFromRt     = badPrefix + fromRt
+	badModule     = badPrefix + module
+	badLockfile   = badPrefix + "lockfile"
+	lockfileRepos = "lockfile-repos"
 
 	// Unique build-add-git flags
-	configFlag = "config"
+	configFlag        = "config"
+	fromRef           = "from-ref"
+	fromTagPattern    = "from-tag-pattern"
+	changelogProperty = "changelog-property"
+	changelogTarget   = "changelog-target"
+
+	// Unique build-affected-modules flags
+	modulesManifest = "manifest"
 
 	// Unique build-scan flags
 	fail = "fail"
@@ -294,6 +414,9 @@ const (
 	includeDependencies = "include-dependencies"
 	copyFlag            = "copy"
 	failFast            = "fail-fast"
+	promotionChain      = "chain"
+	bprEvidenceKey      = buildPromotePrefix + "evidence-key"
+	bprEvidenceKeyAlias = buildPromotePrefix + "evidence-key-alias"
 
 	Async = "async"
 
@@ -307,6 +430,17 @@ const (
 
 	repo = "repo"
 
+	// Unique build-export flags
+	includeArtifacts = "include-artifacts"
+
+	// Unique build-scan-gate flags
+	buildScanGatePrefix = "bsg-"
+	bsgFormat           = buildScanGatePrefix + Format
+	bsgVuln             = buildScanGatePrefix + vuln
+	failOnSeverity      = "fail-on-severity"
+	scanTimeout         = "scan-timeout"
+	rescan              = "rescan"
+
 	// Unique git-lfs-clean flags
 	glcPrefix = "glc-"
 	glcDryRun = glcPrefix + dryRun
@@ -314,6 +448,135 @@ const (
 	glcRepo   = glcPrefix + repo
 	refs      = "refs"
 
+	// Unique health-check flags
+	healthCheckPrefix = "hc-"
+	hcRepo            = healthCheckPrefix + repo
+	maxClockSkew      = "max-clock-skew"
+
+	// Unique evidence flags
+	evidencePrefix = "evd-"
+	evdKey         = evidencePrefix + "key"
+	evdKeyAlias    = evidencePrefix + "key-alias"
+	evdBundle      = evidencePrefix + "bundle"
+	evdBundleIn    = evidencePrefix + "bundle-in"
+	evdSubjectFile = evidencePrefix + "subject-file"
+	evdPublicKeys  = evidencePrefix + "public-keys"
+	evdKeyType     = evidencePrefix + "key-type"
+	evdSubjectPath = evidencePrefix + "subject-repo-path"
+	evdRepo        = evidencePrefix + repo
+	evdOutput      = evidencePrefix + "output"
+
+	// Unique evidence-create flags
+	evidenceCreatePrefix = "evc-"
+	evcKey               = evidenceCreatePrefix + "key"
+	evcKeyAlias          = evidenceCreatePrefix + "key-alias"
+	evcPredicateType     = evidenceCreatePrefix + "predicate-type"
+	evcSubjectPath       = evidenceCreatePrefix + "subject-repo-path"
+	evcPackageType       = evidenceCreatePrefix + "package-type"
+	evcPackageRepo       = evidenceCreatePrefix + "package-repo"
+	evcPackageName       = evidenceCreatePrefix + "package-name"
+	evcPackageVersion    = evidenceCreatePrefix + "package-version"
+
+	// Unique repo-apply flags
+	repoApplyPrefix = "rap-"
+	rapDryRun       = repoApplyPrefix + dryRun
+
+	// Unique federation-sync flags
+	mirror = "mirror"
+
+	// Unique token lifecycle flags
+	tokenPrefix    = "tk-"
+	tkScope        = tokenPrefix + "scope"
+	tkDescription  = tokenPrefix + "description"
+	tkExpiry       = tokenPrefix + Expiry
+	tkRefreshable  = tokenPrefix + Refreshable
+	tkAudience     = tokenPrefix + Audience
+	tkSaveAs       = tokenPrefix + "save-as"
+	tkOidcProvider = tokenPrefix + "oidc-provider"
+	tkProviderName = tokenPrefix + "provider-name"
+	tkIdTokenEnv   = tokenPrefix + "id-token-env"
+	tkRefreshToken = tokenPrefix + "refresh-token"
+
+	// Unique webhook flags
+	webhookPrefix = "wh-"
+	whDomain      = webhookPrefix + "domain"
+	whEventTypes  = webhookPrefix + "event-types"
+	whNames       = webhookPrefix + "names"
+	whSecret      = webhookPrefix + "secret"
+	whDescription = webhookPrefix + "description"
+	whTunnelUrl   = webhookPrefix + "tunnel-url"
+	whPort        = webhookPrefix + port
+
+	// Unique storage report flags
+	storageReportPrefix = "sr-"
+	srGroupBy           = storageReportPrefix + "group-by"
+	srSort              = storageReportPrefix + "sort"
+	srMinSize           = storageReportPrefix + "min-size"
+	srFailAbove         = storageReportPrefix + "fail-above"
+	srFormat            = storageReportPrefix + Format
+	srRecalculate       = storageReportPrefix + "recalculate"
+	srWaitTimeout       = storageReportPrefix + "wait-timeout"
+
+	// Unique Debian and RPM deploy flags
+	packageDeployPrefix = "pd-"
+	pdDistribution      = packageDeployPrefix + "distribution"
+	pdComponent         = packageDeployPrefix + "component"
+	pdArchitecture      = packageDeployPrefix + "architecture"
+	pdNoWait            = packageDeployPrefix + "no-wait"
+	pdWaitTimeout       = packageDeployPrefix + "wait-timeout"
+
+	// Structured output flags
+	outputFormat  = "output-" + Format
+	summaryOutput = "summary-output"
+
+	// Dry-run flag of the mutating commands
+	mutatingDryRun = "mutating-" + dryRun
+
+	// Unique curl flags
+	curlPrefix   = "curl-"
+	curlSpec     = curlPrefix + specFlag
+	curlSpecVars = curlPrefix + specVars
+
+	// Unique sbom flags
+	sbomPrefix          = "sbom-"
+	sbomFormat          = sbomPrefix + Format
+	sbomOutput          = sbomPrefix + "output"
+	sbomPublished       = sbomPrefix + "published"
+	sbomTarget          = sbomPrefix + target
+	sbomSubjectRepoPath = sbomPrefix + "subject-repo-path"
+	sbomPackageType     = sbomPrefix + "package-type"
+	sbomPackageRepo     = sbomPrefix + "package-repo"
+	sbomPackageName     = sbomPrefix + "package-name"
+	sbomPackageVersion  = sbomPrefix + "package-version"
+	sbomKey             = sbomPrefix + "key"
+	sbomKeyAlias        = sbomPrefix + "key-alias"
+	sbomOutputFormat    = sbomPrefix + "output-format"
+
+	// Unique OCI artifact flags
+	ociPrefix       = "oci-"
+	ociArtifactType = ociPrefix + "artifact-type"
+	ociAnnotations  = ociPrefix + "annotations"
+	ociSubject      = ociPrefix + "subject"
+	ociSignKey      = ociPrefix + "sign-key"
+	ociVerifyKey    = ociPrefix + "verify-key"
+
+	// Unique maven-deploy-file flags
+	mdfPrefix      = "mdf-"
+	mdfGroupId     = mdfPrefix + "group-id"
+	mdfArtifactId  = mdfPrefix + "artifact-id"
+	mdfVersion     = mdfPrefix + "version"
+	mdfClassifier  = mdfPrefix + "classifier"
+	mdfPackaging   = mdfPrefix + "packaging"
+	mdfAttach      = mdfPrefix + "attach"
+	mdfLayout      = mdfPrefix + "layout"
+	mdfGeneratePom = mdfPrefix + "generate-pom"
+
+	// Unique proxy flags
+	proxyPrefix = "prx-"
+	prxRepo     = proxyPrefix + repo
+	port        = "port"
+	allowWrites = "allow-writes"
+
 	// Build tool config flags
 	global          = "global"
 	serverIdResolve = "server-id-resolve"
@@ -337,6 +600,9 @@ const (
 	ivyDescPattern      = "ivy-desc-pattern"
 	ivyArtifactsPattern = "ivy-artifacts-pattern"
 
+	// Unique gradle flags
+	printInitScript = "print-init-script"
+
 	// Build tool flags
 	deploymentThreads = "deployment-threads"
 	skipLogin         = "skip-login"
@@ -349,8 +615,29 @@ const (
 	targetTag           = "target-tag"
 	dockerPromoteCopy   = dockerPromotePrefix + Copy
 
+	// Unique docker cleanup flags
+	dockerCleanupPrefix = "dcl-"
+	dclDryRun           = dockerCleanupPrefix + dryRun
+	keepLast            = "keep-last"
+	keepSemver          = "keep-semver"
+	keepPulledWithin    = "keep-pulled-within"
+	keepTags            = "keep-tags"
+	cleanupRules        = "rules"
+	evidenceRecord      = "evidence-record"
+
+	// Unique cleanup flags
+	cleanupPrefix       = "cln-"
+	clnDryRun           = cleanupPrefix + dryRun
+	maxDeletesPerSecond = "max-deletes-per-second"
+	auditTarget         = "audit-target"
+
+	// Unique immutable and legal-hold flags
+	protectRelease = "release"
+	legalHoldCase  = "case"
+
 	// Unique build docker create
-	imageFile = "image-file"
+	imageFile  = "image-file"
+	baseImages = "base-images"
 
 	// Unique oc start-build flags
 	ocStartBuildPrefix = "oc-start-build-"
@@ -361,18 +648,46 @@ const (
 	npmDetailedSummary = npmPrefix + detailedSummary
 	runNative          = "run-native"
 	npmWorkspaces      = "workspaces"
+	npmWorkspace       = "workspace"
+	provenanceKey      = "provenance-key"
+	provenanceKeyAlias = "provenance-key-alias"
 
 	// Unique nuget/dotnet config flags
 	nugetV2                  = "nuget-v2"
 	allowInsecureConnections = "allow-insecure-connections"
 
+	// Unique pdm flags
+	pdmPrefix = "pdm-"
+	pdmRepo   = pdmPrefix + repo
+
+	// Unique conan flags
+	conanPrefix = "conan-"
+	conanRepo   = conanPrefix + repo
+
+	// Unique cargo flags
+	cargoPrefix = "cargo-"
+	cargoRepo   = cargoPrefix + repo
+
+	// Unique swift flags
+	swiftPrefix = "swift-"
+	swiftRepo   = swiftPrefix + repo
+
+	// Unique pod flags
+	podPrefix = "pod-"
+	podRepo   = podPrefix + repo
+
 	// Unique go flags
-	noFallback = "no-fallback"
+	noFallback     = "no-fallback"
+	privateModules = "private-modules"
 
 	// Unique Terraform flags
-	namespace = "namespace"
-	provider  = "provider"
-	Tag       = "tag"
+	namespace       = "namespace"
+	provider        = "provider"
+	Tag             = "tag"
+	terraformPrefix = "terraform-"
+	terraformType   = terraformPrefix + "type"
+	terraformDist   = terraformPrefix + "dist"
+	terraformGpgKey = terraformPrefix + publicGpgKey
 
 	// Template user flags
 	vars = "vars"
@@ -385,6 +700,21 @@ const (
 	Replace        = "replace"
 	Admin          = "admin"
 
+	// Unique user-update and group-update flags
+	userUpdatePrefix  = "uu-"
+	uuEmail           = userUpdatePrefix + "email"
+	uuNewPassword     = userUpdatePrefix + "new-password"
+	uuAdmin           = userUpdatePrefix + Admin
+	groupUpdatePrefix = "gu-"
+	guDescription     = groupUpdatePrefix + "description"
+	guAutoJoin        = groupUpdatePrefix + "auto-join"
+	guAdminPrivileges = groupUpdatePrefix + "admin-privileges"
+	usersFilterGroup  = "group"
+	usersFilterRealm  = "realm"
+	usersFilterAdmins = "admins"
+	usersListPrefix   = "ul-"
+	ulFormat          = usersListPrefix + Format
+
 	// Mutual *-access-token-create flags
 	Groups      = "groups"
 	GrantAdmin  = "grant-admin"
@@ -476,6 +806,7 @@ const (
 	lcReleaseBundles         = lifecyclePrefix + ReleaseBundles
 	SigningKey               = "signing-key"
 	lcSigningKey             = lifecyclePrefix + SigningKey
+	SigningKeyType           = "signing-key-type"
 	PathMappingPattern       = "mapping-pattern"
 	lcPathMappingPattern     = lifecyclePrefix + PathMappingPattern
 	PathMappingTarget        = "mapping-target"
@@ -484,6 +815,14 @@ const (
 	lcIncludeRepos           = lifecyclePrefix + IncludeRepos
 	lcExcludeRepos           = lifecyclePrefix + ExcludeRepos
 	PromotionType            = "promotion-type"
+	Watch                    = "watch"
+	lcFormat                 = lifecyclePrefix + Format
+	WithManifest             = "with-manifest"
+	ArchiveManifest          = "manifest"
+	lcArchiveManifest        = lifecyclePrefix + ArchiveManifest
+	TrackerId                = "tracker-id"
+	RequireEvidence          = "require-evidence"
+	GateReport               = "gate-report"
 	lcTag                    = lifecyclePrefix + Tag
 	lcProperties             = lifecyclePrefix + Properties
 	DeleteProperty           = "del-prop"
@@ -491,7 +830,17 @@ const (
 	SourceTypeReleaseBundles = "source-type-release-bundles"
 	SourceTypeBuilds         = "source-type-builds"
 	Draft                    = "draft"
+	AutoVersion              = "auto-version"
 	AddSources               = "add"
+
+	// Unique signing key flags
+	signingKeyPrefix = "sk-"
+	skType           = signingKeyPrefix + "type"
+	skAlias          = signingKeyPrefix + "alias"
+	PublicKey        = "public-key"
+	PrivateKey       = "private-key"
+	skPassphrase     = signingKeyPrefix + passphrase
+	Edges            = "edges"
 )
 
 var commandFlags = map[string][]string{
@@ -516,8 +865,8 @@ var commandFlags = map[string][]string{
 		site, city, countryCodes, sync, maxWaitMinutes, InsecureTls, deleteFromDist, deleteQuiet,
 	},
 	cmddefs.ReleaseBundleCreate: {
-		platformUrl, user, password, accessToken, serverId, lcSigningKey, lcSync, lcProject, lcBuilds, lcReleaseBundles,
-		specFlag, specVars, BuildName, BuildNumber, SourceTypeReleaseBundles, SourceTypeBuilds, Draft,
+		platformUrl, user, password, accessToken, serverId, lcSigningKey, SigningKeyType, lcSync, lcProject, lcBuilds, lcReleaseBundles,
+		specFlag, specVars, BuildName, BuildNumber, SourceTypeReleaseBundles, SourceTypeBuilds, Draft, AutoVersion, outputFormat,
 	},
 	cmddefs.ReleaseBundleUpdate: {
 		platformUrl, user, password, accessToken, serverId, lcSync, lcProject,
@@ -528,14 +877,35 @@ var commandFlags = map[string][]string{
 	},
 	cmddefs.ReleaseBundlePromote: {
 		platformUrl, user, password, accessToken, serverId, lcSigningKey, lcSync, lcProject, lcIncludeRepos,
-		lcExcludeRepos, PromotionType,
+		lcExcludeRepos, PromotionType, RequireEvidence, GateReport, outputFormat, mutatingDryRun,
 	},
 	cmddefs.ReleaseBundleDistribute: {
 		platformUrl, user, password, accessToken, serverId, lcProject, DistRules, site, city, countryCodes,
-		lcDryRun, CreateRepo, lcPathMappingPattern, lcPathMappingTarget, lcSync, maxWaitMinutes,
+		lcDryRun, CreateRepo, lcPathMappingPattern, lcPathMappingTarget, lcSync, maxWaitMinutes, outputFormat,
+	},
+	cmddefs.ReleaseBundleDistributionStatus: {
+		platformUrl, user, password, accessToken, serverId, lcProject, TrackerId, Watch, maxWaitMinutes,
+	},
+	cmddefs.ReleaseBundleContents: {
+		platformUrl, user, password, accessToken, serverId, lcProject, lcFormat,
+	},
+	cmddefs.ReleaseBundleDiff: {
+		platformUrl, user, password, accessToken, serverId, lcProject, lcFormat,
+	},
+	cmddefs.SigningKeyUpload: {
+		platformUrl, user, password, accessToken, serverId, skType, skAlias, PublicKey, PrivateKey, skPassphrase,
+	},
+	cmddefs.SigningKeyList: {
+		platformUrl, user, password, accessToken, serverId, lcFormat,
+	},
+	cmddefs.SigningKeyPropagate: {
+		platformUrl, user, password, accessToken, serverId, Edges,
+	},
+	cmddefs.SigningKeyDelete: {
+		platformUrl, user, password, accessToken, serverId, deleteQuiet,
 	},
 	cmddefs.ReleaseBundleDeleteLocal: {
-		platformUrl, user, password, accessToken, serverId, deleteQuiet, lcSync, lcProject,
+		platformUrl, user, password, accessToken, serverId, deleteQuiet, lcSync, lcProject, mutatingDryRun,
 	},
 	cmddefs.ReleaseBundleDeleteRemote: {
 		platformUrl, user, password, accessToken, serverId, deleteQuiet, lcDryRun, DistRules, site, city, countryCodes,
@@ -543,13 +913,21 @@ var commandFlags = map[string][]string{
 	},
 	cmddefs.ReleaseBundleExport: {
 		platformUrl, user, password, accessToken, serverId, lcPathMappingTarget, lcPathMappingPattern, Project,
-		downloadMinSplit, downloadSplitCount,
+		downloadMinSplit, downloadSplitCount, WithManifest,
 	},
 	cmddefs.ReleaseBundleImport: {
-		user, password, accessToken, serverId, platformUrl,
+		user, password, accessToken, serverId, platformUrl, lcArchiveManifest,
 	},
 	cmddefs.ReleaseBundleAnnotate: {
 		platformUrl, user, password, accessToken, serverId, lcProject, lcTag, lcProperties, lcDeleteProperties, propsRecursive,
+		mutatingDryRun,
+	},
+	cmddefs.SbomGenerate: {
+		url, user, password, accessToken, serverId, Project, sbomFormat, sbomOutput, sbomPublished,
+	},
+	cmddefs.SbomPublish: {
+		url, user, password, accessToken, serverId, Project, sbomFormat, sbomOutput, sbomPublished, sbomTarget,
+		sbomSubjectRepoPath, sbomPackageType, sbomPackageRepo, sbomPackageName, sbomPackageVersion, sbomKey, sbomKeyAlias, sbomOutputFormat,
 	},
 	AddConfig: {
 		interactive, EncPassword, configPlatformUrl, configRtUrl, configDistUrl, configXrUrl, configMcUrl, configPlUrl, configUser, configPassword, configAccessToken, sshKeyPath, sshPassphrase, ClientCertPath,
@@ -567,7 +945,9 @@ var commandFlags = map[string][]string{
 		ClientCertKeyPath, specFlag, specVars, BuildName, BuildNumber, module, uploadExclusions, deb,
 		uploadRecursive, uploadFlat, uploadRegexp, retries, retryWaitTime, dryRun, uploadExplode, symlinks, includeDirs,
 		failNoOp, threads, uploadSyncDeletes, syncDeletesQuiet, InsecureTls, detailedSummary, Project,
-		uploadAnt, uploadArchive, uploadMinSplit, uploadSplitCount, chunkSize,
+		uploadAnt, uploadArchive, uploadMinSplit, uploadSplitCount, chunkSize, deltaManifest, rateLimit, globalRateLimit, adaptiveThreads, minThreads,
+		encryptionKey, encryptionKeyCommand, preserveSymlinks, dedup, dedupRepos, outputFormat, summaryOutput, uploadServers,
+		allOrNothing, atomicUpload, stagingRepo, uploadWatch, watchDebounce,
 	},
 	Download: {
 		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, ClientCertPath,
@@ -575,65 +955,73 @@ var commandFlags = map[string][]string{
 		sortOrder, limit, offset, downloadRecursive, downloadFlat, build, includeDeps, excludeArtifacts, downloadMinSplit, downloadSplitCount,
 		retries, retryWaitTime, dryRun, downloadExplode, bypassArchiveInspection, validateSymlinks, bundle, publicGpgKey, includeDirs,
 		downloadProps, downloadExcludeProps, failNoOp, threads, archiveEntries, downloadSyncDeletes, syncDeletesQuiet, InsecureTls, detailedSummary, Project,
-		skipChecksum,
+		skipChecksum, delta, rateLimit, globalRateLimit, encryptionKey, encryptionKeyCommand, preserveSymlinks, extractEntries, adaptiveThreads, minThreads,
+		downloadOutput, aqlFile, outputFormat, summaryOutput, verify, verifyFailure, verifyRetries, latestVersion, versionRange,
+		downloadCacheDir, downloadCacheMaxSize,
 	},
 	DirectDownload: {
 		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, ClientCertPath,
 		ClientCertKeyPath, specFlag, specVars, BuildName, BuildNumber, module, exclusions,
 		downloadRecursive, downloadFlat, build, includeDeps, excludeArtifacts, downloadMinSplit, downloadSplitCount,
 		retries, retryWaitTime, dryRun, downloadExplode, threads, downloadSyncDeletes, syncDeletesQuiet, skipChecksum, failNoOp, detailedSummary, Project,
-		bypassArchiveInspection, validateSymlinks, InsecureTls, bundle,
+		bypassArchiveInspection, validateSymlinks, InsecureTls, bundle, rateLimit, globalRateLimit,
 	},
 	Move: {
 		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, ClientCertPath,
 		ClientCertKeyPath, specFlag, specVars, exclusions, sortBy, sortOrder, limit, offset, moveRecursive,
 		moveFlat, dryRun, build, includeDeps, excludeArtifacts, moveProps, moveExcludeProps, failNoOp, threads, archiveEntries,
-		InsecureTls, retries, retryWaitTime, Project,
+		InsecureTls, retries, retryWaitTime, Project, onConflict, mergeProps, preserveStats, summaryOutput,
 	},
 	Copy: {
 		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, ClientCertPath,
 		ClientCertKeyPath, specFlag, specVars, exclusions, sortBy, sortOrder, limit, offset, copyRecursive,
 		copyFlat, dryRun, build, includeDeps, excludeArtifacts, bundle, copyProps, copyExcludeProps, failNoOp, threads,
-		archiveEntries, InsecureTls, retries, retryWaitTime, Project,
+		archiveEntries, InsecureTls, retries, retryWaitTime, Project, onConflict, mergeProps, preserveStats, summaryOutput,
 	},
 	Delete: {
 		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, ClientCertPath,
 		ClientCertKeyPath, specFlag, specVars, exclusions, sortBy, sortOrder, limit, offset,
 		deleteRecursive, dryRun, build, includeDeps, excludeArtifacts, deleteQuiet, deleteProps, deleteExcludeProps, failNoOp, threads, archiveEntries,
-		InsecureTls, retries, retryWaitTime, Project,
+		InsecureTls, retries, retryWaitTime, Project, aqlFile, summaryOutput,
 	},
 	Search: {
 		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, ClientCertPath,
 		ClientCertKeyPath, specFlag, specVars, exclusions, sortBy, sortOrder, limit, offset,
 		searchRecursive, build, includeDeps, excludeArtifacts, count, bundle, includeDirs, searchProps, searchExcludeProps, failNoOp, archiveEntries,
-		InsecureTls, searchTransitive, retries, retryWaitTime, Project, searchInclude,
+		InsecureTls, searchTransitive, retries, retryWaitTime, Project, searchInclude, aqlFile, searchJsonl, searchCountOnly, outputFormat,
 	},
 	Properties: {
 		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, ClientCertPath,
 		ClientCertKeyPath, specFlag, specVars, exclusions, sortBy, sortOrder, limit, offset,
 		propsRecursive, build, includeDeps, excludeArtifacts, bundle, includeDirs, failNoOp, threads, archiveEntries, propsProps, propsExcludeProps,
-		InsecureTls, retries, retryWaitTime, Project, repoOnly,
+		InsecureTls, retries, retryWaitTime, Project, repoOnly, dryRun, detailedSummary, aqlFile,
 	},
 	BuildPublish: {
 		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, buildUrl, bpDryRun,
 		envInclude, envExclude, InsecureTls, Project, bpDetailedSummary, bpOverwrite, collectEnv, collectGitInfo, gitConfigFilePath, dotGitPath,
+		maxDays, maxBuilds, excludeBuilds, deleteArtifacts, buildState, outputFormat, summaryOutput,
 	},
 	BuildAppend: {
 		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, buildUrl, bpDryRun,
-		envInclude, envExclude, InsecureTls, Project,
+		envInclude, envExclude, InsecureTls, Project, childBuilds,
 	},
 	BuildAddDependencies: {
-		specFlag, specVars, uploadExclusions, badRecursive, badRegexp, badDryRun, Project, badFromRt, serverId, badModule,
+		specFlag, specVars, uploadExclusions, badRecursive, badRegexp, badDryRun, Project, badFromRt, serverId, badModule, buildState,
+		badLockfile, lockfileRepos, failNoOp,
 	},
 	BuildAddGit: {
-		configFlag, serverId, Project,
+		configFlag, serverId, Project, fromRef, fromTagPattern, changelogProperty, changelogTarget,
+	},
+	BuildAffectedModules: {
+		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, ClientCertPath,
+		ClientCertKeyPath, InsecureTls, Project, modulesManifest, fromRef, fromTagPattern,
 	},
 	BuildCollectEnv: {
-		Project,
+		Project, buildState, serverId, envIncludeRegex, envExcludeRegex, maskSecrets,
 	},
 	BuildDockerCreate: {
 		BuildName, BuildNumber, module, url, user, password, accessToken, sshPassphrase, sshKeyPath,
-		serverId, imageFile, Project,
+		serverId, imageFile, baseImages, Project,
 	},
 	OcStartBuild: {
 		BuildName, BuildNumber, module, Project, serverId, ocStartBuildRepo,
@@ -644,11 +1032,20 @@ var commandFlags = map[string][]string{
 	},
 	BuildPromote: {
 		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, Status, comment,
-		sourceRepo, includeDependencies, copyFlag, failFast, bprDryRun, bprProps, InsecureTls, Project,
+		sourceRepo, includeDependencies, copyFlag, failFast, bprDryRun, bprProps, InsecureTls, Project, promotionChain,
+		bprEvidenceKey, bprEvidenceKeyAlias,
 	},
 	BuildDiscard: {
 		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, maxDays, maxBuilds,
-		excludeBuilds, deleteArtifacts, bdiAsync, InsecureTls, Project,
+		excludeBuilds, deleteArtifacts, bdiAsync, InsecureTls, Project, mutatingDryRun,
+	},
+	BuildExport: {
+		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, includeArtifacts, threads,
+		InsecureTls, Project,
+	},
+	BuildScanGate: {
+		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, bsgFormat, bsgVuln, failOnSeverity,
+		scanTimeout, rescan, InsecureTls, Project,
 	},
 	GitLfsClean: {
 		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, refs, glcRepo, glcDryRun,
@@ -671,7 +1068,7 @@ var commandFlags = map[string][]string{
 		BuildName, BuildNumber, deploymentThreads, InsecureTls, Project, detailedSummary, xrayScan, xrOutput,
 	},
 	Gradle: {
-		BuildName, BuildNumber, deploymentThreads, Project, detailedSummary, xrayScan, xrOutput,
+		BuildName, BuildNumber, deploymentThreads, Project, detailedSummary, xrayScan, xrOutput, printInitScript,
 	},
 	Docker: {
 		BuildName, BuildNumber, module, Project,
@@ -687,7 +1084,34 @@ var commandFlags = map[string][]string{
 	},
 	DockerPromote: {
 		targetDockerImage, sourceTag, targetTag, dockerPromoteCopy, url, user, password, accessToken, sshPassphrase, sshKeyPath,
-		serverId,
+		serverId, mutatingDryRun,
+	},
+	VerifyDownload: {
+		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, ClientCertPath, ClientCertKeyPath, InsecureTls,
+	},
+	SyncCmd: {
+		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, ClientCertPath, ClientCertKeyPath, InsecureTls,
+		syncDirection, syncDeleteMissing, dryRun, threads,
+	},
+	DockerCleanup: {
+		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, ClientCertPath, ClientCertKeyPath, InsecureTls,
+		keepLast, keepSemver, keepPulledWithin, keepTags, cleanupRules, dclDryRun, evidenceRecord,
+	},
+	Cleanup: {
+		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, ClientCertPath, ClientCertKeyPath, InsecureTls,
+		clnDryRun, threads, maxDeletesPerSecond, auditTarget,
+	},
+	Immutable: {
+		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, ClientCertPath,
+		ClientCertKeyPath, specFlag, specVars, exclusions, sortBy, sortOrder, limit, offset,
+		propsRecursive, build, includeDeps, excludeArtifacts, bundle, includeDirs, failNoOp, threads, archiveEntries, propsProps, propsExcludeProps,
+		InsecureTls, retries, retryWaitTime, Project, repoOnly, dryRun, detailedSummary, aqlFile, protectRelease,
+	},
+	LegalHold: {
+		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, ClientCertPath,
+		ClientCertKeyPath, specFlag, specVars, exclusions, sortBy, sortOrder, limit, offset,
+		propsRecursive, build, includeDeps, excludeArtifacts, bundle, includeDirs, failNoOp, threads, archiveEntries, propsProps, propsExcludeProps,
+		InsecureTls, retries, retryWaitTime, Project, repoOnly, dryRun, detailedSummary, aqlFile, protectRelease, legalHoldCase,
 	},
 	ContainerPush: {
 		BuildName, BuildNumber, module, url, user, password, accessToken, sshPassphrase, sshKeyPath,
@@ -701,14 +1125,18 @@ var commandFlags = map[string][]string{
 		global, serverIdResolve, serverIdDeploy, repoResolve, repoDeploy,
 	},
 	NpmInstallCi: {
-		BuildName, BuildNumber, module, Project, runNative,
+		BuildName, BuildNumber, module, Project, runNative, npmWorkspaces, npmWorkspace,
 	},
 	NpmPublish: {
-		BuildName, BuildNumber, module, Project, npmDetailedSummary, xrayScan, xrOutput, runNative, npmWorkspaces,
+		BuildName, BuildNumber, module, Project, npmDetailedSummary, xrayScan, xrOutput, runNative, npmWorkspaces, npmWorkspace,
+		provenanceKey, provenanceKeyAlias,
 	},
 	PnpmConfig: {
 		global, serverIdResolve, repoResolve,
 	},
+	Pnpm: {
+		BuildName, BuildNumber, module, Project,
+	},
 	YarnConfig: {
 		global, serverIdResolve, repoResolve,
 	},
@@ -734,13 +1162,13 @@ var commandFlags = map[string][]string{
 		url, user, password, accessToken, BuildName, BuildNumber, module, Project, detailedSummary, goPublishExclusions,
 	},
 	Go: {
-		BuildName, BuildNumber, module, Project, noFallback,
+		BuildName, BuildNumber, module, Project, noFallback, privateModules,
 	},
 	TerraformConfig: {
-		global, serverIdDeploy, repoDeploy,
+		global, serverIdResolve, repoResolve, serverIdDeploy, repoDeploy,
 	},
 	Terraform: {
-		namespace, provider, Tag, exclusions,
+		namespace, provider, Tag, exclusions, terraformType, terraformDist, terraformGpgKey,
 		BuildName, BuildNumber, module, Project,
 	},
 	Twine: {
@@ -750,8 +1178,16 @@ var commandFlags = map[string][]string{
 		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, ClientCertPath,
 		ClientCertKeyPath, InsecureTls,
 	},
+	HealthCheck: {
+		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, ClientCertPath,
+		ClientCertKeyPath, InsecureTls, hcRepo, maxClockSkew, outputFormat,
+	},
 	RtCurl: {
-		serverId,
+		serverId, curlSpec, curlSpecVars,
+	},
+	RtProxy: {
+		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, ClientCertPath,
+		ClientCertKeyPath, InsecureTls, port, prxRepo, allowWrites, BuildName, BuildNumber, module, Project,
 	},
 	PipConfig: {
 		global, serverIdResolve, serverIdDeploy, repoResolve, repoDeploy,
@@ -771,22 +1207,204 @@ var commandFlags = map[string][]string{
 	Poetry: {
 		BuildName, BuildNumber, module, Project,
 	},
+	Pdm: {
+		serverId, pdmRepo, BuildName, BuildNumber, module, Project,
+	},
+	Conan: {
+		serverId, conanRepo, BuildName, BuildNumber, Project,
+	},
+	Helm: {
+		serverId, BuildName, BuildNumber, Project, provenanceKey, provenanceKeyAlias,
+	},
+	Cargo: {
+		serverId, cargoRepo, BuildName, BuildNumber, module, Project,
+	},
+	Swift: {
+		serverId, swiftRepo, BuildName, BuildNumber, module, Project,
+	},
+	Pod: {
+		serverId, podRepo, BuildName, BuildNumber, module, Project,
+	},
 	TemplateConsumer: {
 		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, ClientCertPath,
-		ClientCertKeyPath, vars,
+		ClientCertKeyPath, vars, Project,
 	},
 	RepoDelete: {
 		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, ClientCertPath,
-		ClientCertKeyPath, deleteQuiet,
+		ClientCertKeyPath, deleteQuiet, mutatingDryRun,
+	},
+	RepoApply: {
+		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, ClientCertPath,
+		ClientCertKeyPath, vars, rapDryRun,
+	},
+	RepoExport: {
+		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, ClientCertPath,
+		ClientCertKeyPath,
+	},
+	FederationConvert: {
+		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, ClientCertPath,
+		ClientCertKeyPath,
+	},
+	FederationMemberAdd: {
+		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, ClientCertPath,
+		ClientCertKeyPath, mutatingDryRun,
+	},
+	FederationMemberRemove: {
+		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, ClientCertPath,
+		ClientCertKeyPath, deleteQuiet, mutatingDryRun,
+	},
+	FederationSync: {
+		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, ClientCertPath,
+		ClientCertKeyPath, mirror,
+	},
+	FederationStatus: {
+		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, ClientCertPath,
+		ClientCertKeyPath,
+	},
+	ResolveTrace: {
+		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, ClientCertPath,
+		ClientCertKeyPath, outputFormat,
+	},
+	Whence: {
+		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, ClientCertPath,
+		ClientCertKeyPath, outputFormat,
+	},
+	EvidenceCreate: {
+		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, ClientCertPath,
+		ClientCertKeyPath, evcPredicateType, evcSubjectPath, evcPackageType, evcPackageRepo, evcPackageName, evcPackageVersion,
+		evcKey, evcKeyAlias,
+	},
+	EvidenceCreateBulk: {
+		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, ClientCertPath,
+		ClientCertKeyPath, evdKey, evdKeyAlias, threads, outputFormat,
+	},
+	EvidenceExport: {
+		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, ClientCertPath,
+		ClientCertKeyPath, evdBundle,
+	},
+	EvidenceVerify: {
+		evdBundleIn, evdSubjectFile, evdPublicKeys, outputFormat,
+	},
+	EvidenceKeyGenerate: {
+		evdKeyType,
+	},
+	EvidenceKeyUpload: {
+		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, ClientCertPath,
+		ClientCertKeyPath,
+	},
+	EvidenceKeyList: {
+		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, ClientCertPath,
+		ClientCertKeyPath, outputFormat,
+	},
+	EvidenceKeyRotate: {
+		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, ClientCertPath,
+		ClientCertKeyPath, evdKeyType,
+	},
+	EvidenceImportGithub: {
+		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, ClientCertPath,
+		ClientCertKeyPath, evdSubjectPath, evdRepo, outputFormat,
+	},
+	EvidenceExportGithub: {
+		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, ClientCertPath,
+		ClientCertKeyPath, evdOutput,
 	},
 	ReplicationDelete: {
 		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, ClientCertPath,
-		ClientCertKeyPath, deleteQuiet,
+		ClientCertKeyPath, deleteQuiet, mutatingDryRun,
+	},
+	ReplicationStatus: {
+		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, ClientCertPath,
+		ClientCertKeyPath,
+	},
+	TransferFilesStatus: {
+		outputFormat,
 	},
 	PermissionTargetDelete: {
 		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, ClientCertPath,
 		ClientCertKeyPath, deleteQuiet,
 	},
+	PermissionTargetImport: {
+		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, ClientCertPath,
+		ClientCertKeyPath,
+	},
+	PermissionTargetExport: {
+		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, ClientCertPath,
+		ClientCertKeyPath,
+	},
+	ProjectApply: {
+		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, ClientCertPath,
+		ClientCertKeyPath,
+	},
+	ProjectExport: {
+		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, ClientCertPath,
+		ClientCertKeyPath,
+	},
+	ProjectDelete: {
+		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, ClientCertPath,
+		ClientCertKeyPath, deleteQuiet, mutatingDryRun,
+	},
+	TokenCreate: {
+		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, ClientCertPath,
+		ClientCertKeyPath, tkScope, Project, tkDescription, tkExpiry, tkRefreshable, tkAudience, tkSaveAs,
+	},
+	OidcTokenExchange: {
+		url, serverId, ClientCertPath, ClientCertKeyPath, tkOidcProvider, tkProviderName, tkAudience, Project, tkIdTokenEnv,
+		tkSaveAs,
+	},
+	TokenRefresh: {
+		url, accessToken, serverId, ClientCertPath, ClientCertKeyPath, tkRefreshToken, tkSaveAs,
+	},
+	TokenRevoke: {
+		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, ClientCertPath,
+		ClientCertKeyPath, mutatingDryRun,
+	},
+	WebhookCreate: {
+		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, ClientCertPath,
+		ClientCertKeyPath, whDomain, whEventTypes, whNames, whSecret, whDescription,
+	},
+	WebhookList: {
+		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, ClientCertPath,
+		ClientCertKeyPath,
+	},
+	WebhookDelete: {
+		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, ClientCertPath,
+		ClientCertKeyPath, deleteQuiet, mutatingDryRun,
+	},
+	WebhookListen: {
+		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, ClientCertPath,
+		ClientCertKeyPath, whTunnelUrl, whPort, whDomain, whEventTypes, whNames,
+	},
+	StorageReport: {
+		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, ClientCertPath,
+		ClientCertKeyPath, srGroupBy, srSort, srMinSize, srFailAbove, srFormat, srRecalculate, srWaitTimeout,
+	},
+	DebDeploy: {
+		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, ClientCertPath,
+		ClientCertKeyPath, pdDistribution, pdComponent, pdArchitecture, pdNoWait, pdWaitTimeout,
+	},
+	RpmDeploy: {
+		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, ClientCertPath,
+		ClientCertKeyPath, pdNoWait, pdWaitTimeout,
+	},
+	OciPush: {
+		url, user, password, accessToken, serverId, ociArtifactType, ociAnnotations, ociSubject,
+	},
+	OciPull: {
+		url, user, password, accessToken, serverId,
+	},
+	OciReferrers: {
+		url, user, password, accessToken, serverId, ociArtifactType,
+	},
+	OciSign: {
+		url, user, password, accessToken, serverId, ociSignKey, ociAnnotations,
+	},
+	OciVerify: {
+		url, user, password, accessToken, serverId, ociVerifyKey,
+	},
+	MvnDeployFile: {
+		url, user, password, accessToken, serverId, mdfGroupId, mdfArtifactId, mdfVersion, mdfClassifier, mdfPackaging,
+		mdfAttach, mdfLayout, mdfGeneratePom, BuildName, BuildNumber, module, Project, dryRun,
+	},
 	ArtifactoryAccessTokenCreate: {
 		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, ClientCertPath,
 		ClientCertKeyPath, rtAtcGroups, rtAtcGrantAdmin, rtAtcExpiry, rtAtcRefreshable, rtAtcAudience,
@@ -813,6 +1431,28 @@ var commandFlags = map[string][]string{
 	GroupDelete: {
 		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, deleteQuiet,
 	},
+	UserUpdate: {
+		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId,
+		uuEmail, uuNewPassword, uuAdmin, UsersGroups,
+	},
+	GroupUpdate: {
+		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId,
+		guDescription, guAutoJoin, guAdminPrivileges,
+	},
+	UsersList: {
+		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId,
+		usersFilterGroup, usersFilterRealm, usersFilterAdmins, ulFormat,
+	},
+	GroupsList: {
+		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId, ulFormat,
+	},
+	UsersExport: {
+		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId,
+		usersFilterGroup, usersFilterRealm, usersFilterAdmins,
+	},
+	UsersImport: {
+		url, user, password, accessToken, sshPassphrase, sshKeyPath, serverId,
+	},
 	ReleaseBundleSearch: {
 		Format, OrderBy, FilterBy, OrderAsc, Limit, Offset, Includes, Project,
 	},
@@ -830,7 +1470,7 @@ var flagsMap = map[string]components.Flag{
 	serverId:          components.NewStringFlag(serverId, "Server ID configured using the 'jf config' command.", components.SetMandatoryFalse()),
 	ClientCertPath:    components.NewStringFlag(ClientCertPath, "Client certificate file in PEM format.", components.SetMandatoryFalse()),
 	ClientCertKeyPath: components.NewStringFlag(ClientCertKeyPath, "Private key file for the client certificate in PEM format.", components.SetMandatoryFalse()),
-	specFlag:          components.NewStringFlag(specFlag, "Path to a File Spec.", components.SetMandatoryFalse()),
+	specFlag:          components.NewStringFlag(specFlag, "Path to a File Spec. File Specs with \"version\": 2 support reusable pattern groups, extending other File Specs and variables defined in the spec or in the environment.", components.SetMandatoryFalse()),
 	specVars:          components.NewStringFlag(specVars, "List of semicolon-separated(;) variables in the form of \"key1=value1;key2=value2;...\" (wrapped by quotes) to be replaced in the File Spec. In the File Spec, the variables should be used as follows: ${key1}.", components.SetMandatoryFalse()),
 	BuildName:         components.NewStringFlag(BuildName, "Providing this option will collect and record build info for this build name. Build number option is mandatory when this option is provided.", components.SetMandatoryFalse()),
 	BuildNumber:       components.NewStringFlag(BuildNumber, "Providing this option will collect and record build info for this build number. Build name option is mandatory when this option is provided.", components.SetMandatoryFalse()),
@@ -841,8 +1481,12 @@ var flagsMap = map[string]components.Flag{
 	InsecureTls:       components.NewBoolFlag(InsecureTls, "Set to true to skip TLS certificates verification.", components.WithBoolDefaultValueFalse()),
 	detailedSummary:   components.NewBoolFlag(detailedSummary, "Set to true to include a list of the affected files in the command summary.", components.WithBoolDefaultValueFalse()),
 	Project:           components.NewStringFlag(Project, "JFrog Artifactory project key.", components.SetMandatoryFalse()),
-	failNoOp:          components.NewBoolFlag(failNoOp, "Set to true if you'd like the command to return exit code 2 in case of no files are affected.", components.WithBoolDefaultValueFalse()),
+	failNoOp:          components.NewBoolFlag(failNoOp, "Set to true if you'd like the command to return exit code 2 in case of no files are affected. Set the JFROG_CLI_FAIL_NO_OP environment variable to true to enable it for all the commands. The other exit codes are: 0 for success, 1 for other errors, 3 for a vulnerable build, 4 for a partial failure, 5 for an authentication or permission error, 6 when not found, 7 for a conflict, 8 when a quota is exceeded and 9 for a network error.", components.WithBoolDefaultValueFalse()),
 	threads:           components.NewStringFlag(threads, "[Default: "+strconv.Itoa(commonCliUtils.Threads)+"] Number of working threads.", components.SetMandatoryFalse()),
+	rateLimit:         components.NewStringFlag(rateLimit, "Maximum transfer rate of each file, in bytes per second. The value may end with KB, MB or GB (for example: 500KB). Not limited by default.", components.SetMandatoryFalse()),
+	adaptiveThreads:   components.NewBoolFlag(adaptiveThreads, "[Default: false] Set to true to adapt the number of concurrent requests to the load of Artifactory, between --min-threads and --threads. The number is reduced when Artifactory throttles the requests (429 or 503) or responds slower, and increased gradually otherwise.", components.WithBoolDefaultValueFalse()),
+	minThreads:        components.NewStringFlag(minThreads, "[Default: 1] The number of concurrent requests the adaptive threads start from and never drop below. Can be used only with --adaptive-threads.", components.SetMandatoryFalse()),
+	globalRateLimit:   components.NewStringFlag(globalRateLimit, "Maximum total transfer rate of all the files transferred concurrently, in bytes per second. The value may end with KB, MB or GB (for example: 10MB). Not limited by default.", components.SetMandatoryFalse()),
 	syncDeletesQuiet:  components.NewBoolFlag(quiet, "[Default: $CI] Set to true to skip the sync-deletes confirmation message.", components.WithBoolDefaultValueFalse()),
 	sortBy:            components.NewStringFlag(sortBy, "List of semicolon-separated(;) fields to sort by. The fields must be part of the 'items' AQL domain. For more information, see %sjfrog-artifactory-documentation/artifactory-query-language.", components.SetMandatoryFalse()),
 	FilterBy:          components.NewStringFlag(FilterBy, "Filter by Defines a filter using a prefix of the Release Bundle name.", components.SetMandatoryFalse()),
@@ -851,9 +1495,48 @@ var flagsMap = map[string]components.Flag{
 	Includes:          components.NewStringFlag(Includes, "Either messages: Returns any error messages generated when creating the Release Bundle version.or permissions: Returns the permission settings for promoting, distributing, and deleting these Release Bundle versions.", components.SetMandatoryFalse()),
 	bundle:            components.NewStringFlag(bundle, "If specified, only artifacts of the specified bundle are matched. The value format is bundle-name/bundle-version.", components.SetMandatoryFalse()),
 	imageFile:         components.NewStringFlag(imageFile, "[Mandatory] Path to a file which includes one line in the following format: <IMAGE-TAG>@sha256:<MANIFEST-SHA256>.", components.SetMandatoryTrue()),
+	baseImages:        components.NewStringFlag(baseImages, "A list of comma-separated base images of the image, such as 'acme.jfrog.io/docker-remote/alpine:3.19', which are added to the build-info as dependencies. The base images recorded in the provenance of a buildx or BuildKit metadata file are added automatically.", components.SetMandatoryFalse()),
 	ocStartBuildRepo:  components.NewStringFlag(repo, "[Mandatory] The name of the repository to which the image was pushed.", components.SetMandatoryTrue()),
 	runNative:         components.NewBoolFlag(runNative, "Set to true if you'd like to use the native client configurations. Note: This flag would invoke native client behind the scenes, has performance implications and does not support deployment view and detailed summary.", components.WithBoolDefaultValueFalse()),
-	npmWorkspaces:     components.NewBoolFlag(npmWorkspaces, "Set to true if you'd like to use npm workspaces.", components.WithBoolDefaultValueFalse()),
+	npmWorkspaces:     components.NewBoolFlag(npmWorkspaces, "Set to true if you'd like to use npm workspaces. When publishing, each public package of the workspace is published, and added to the build-info as a module of its own.", components.WithBoolDefaultValueFalse()),
+	npmWorkspace:      components.NewStringFlag(npmWorkspace, "The name or path of an npm workspace package to install or publish. Can be repeated to select several packages.", components.SetMandatoryFalse()),
+
+	// npm publish and helm push provenance flags
+	provenanceKey:      components.NewStringFlag(provenanceKey, "Path to an unencrypted ECDSA, RSA or Ed25519 private key in PEM format. If provided, a signed SLSA provenance of each published package version is attached to it as evidence.", components.SetMandatoryFalse()),
+	provenanceKeyAlias: components.NewStringFlag(provenanceKeyAlias, "The alias of the public key in the platform, which verifies the provenance evidence.", components.SetMandatoryFalse()),
+
+	// Client-side encryption flags
+	encryptionKey:        components.NewStringFlag(encryptionKey, "Path to a file containing a 256-bit master key, raw or base64 encoded. On upload, the files are encrypted with AES-GCM before they are deployed, and the data key wrapped by the master key is stored as a property of the artifacts. On download, encrypted files are decrypted.", components.SetMandatoryFalse()),
+	encryptionKeyCommand: components.NewStringFlag(encryptionKeyCommand, "Command which wraps and unwraps the data keys of encrypted files, for example a script calling a KMS. It is run with a 'wrap' or 'unwrap' argument, reads the base64 encoded input from stdin and writes the base64 encoded output to stdout. Can be used instead of --encryption-key.", components.SetMandatoryFalse()),
+
+	preserveSymlinks: components.NewBoolFlag(preserveSymlinks, "Set to true to upload symbolic links as links, including dangling links and links with relative targets. The target of each link and the sha256 of its target are stored as properties of the artifact. On download, the links are recreated with their original targets. Use --validate-symlinks to verify the targets after download.", components.WithBoolDefaultValueFalse()),
+
+	dedup:      components.NewBoolFlag(dedup, "Set to true to search Artifactory for the content of the files before the upload. Files whose content already exists in Artifactory are deployed by checksum, without transferring their content.", components.WithBoolDefaultValueFalse()),
+	dedupRepos: components.NewStringFlag(dedupRepos, "List of semicolon-separated repositories in which the content of the files is searched when --dedup is set. If not set, all the repositories are searched.", components.SetMandatoryFalse()),
+
+	uploadServers: components.NewStringFlag(uploadServers, "List of comma-separated server IDs to which the files are uploaded in parallel, instead of the server of --server-id. The result of the upload is reported per server.", components.SetMandatoryFalse()),
+	allOrNothing:  components.NewBoolFlag(allOrNothing, "Set to true with --servers to delete the uploaded files from all the servers if the upload to any of them fails. Files which existed before the upload are overwritten and kept.", components.WithBoolDefaultValueFalse()),
+
+	atomicUpload: components.NewBoolFlag(atomicUpload, "Set to true to upload the files to a temporary staging directory, and move them to their targets only after all of them were uploaded, so that consumers never see a partially uploaded set of files. If any file fails to upload, none of the files is moved and the staging directory is deleted.", components.WithBoolDefaultValueFalse()),
+	stagingRepo:  components.NewStringFlag(stagingRepo, "[Default: the target repository] The repository in which the files are staged when --atomic is set. The files are staged under its '.jfrog-staging' directory.", components.SetMandatoryFalse()),
+
+	uploadWatch:   components.NewBoolFlag(Watch, "Set to true to keep watching the directories of the patterns after the upload, and upload each file which is created or changed, until the command is interrupted. Deleted files aren't deleted from Artifactory.", components.WithBoolDefaultValueFalse()),
+	watchDebounce: components.NewStringFlag(watchDebounce, "[Default: 500ms] The time with no further changes after which the changed files are uploaded when --watch is set, such as 500ms or 2s.", components.SetMandatoryFalse()),
+
+	extractEntries: components.NewStringFlag(extractEntries, "List of semicolon-separated(;) paths of entries to download from the matched archives, rather than downloading the whole archives. The entries are extracted by Artifactory and downloaded under the target path. Wildcards are supported for archives uploaded with the --archive option, whose entries are listed in an embedded manifest.", components.SetMandatoryFalse()),
+	downloadOutput: components.NewStringFlag(downloadOutput, "Set to '-' to stream a single artifact to the standard output instead of downloading it, for example to pipe it to another tool. The checksum of the streamed content is verified, and a mismatch fails the command.", components.SetMandatoryFalse()),
+
+	syncDirection:     components.NewStringFlag(syncDirection, "[Default: push] The direction of the sync. 'push' uploads the local files which are new or changed, 'pull' downloads the files in Artifactory which are new or changed, and 'both' transfers new files in both directions and changed files from the side on which they were modified last.", components.SetMandatoryFalse()),
+	syncDeleteMissing: components.NewBoolFlag(syncDeleteMissing, "Set to true to delete the files which exist only on the target side of a push or pull sync.", components.WithBoolDefaultValueFalse()),
+
+	aqlFile: components.NewStringFlag(aqlFile, "Path to a file holding a raw AQL query of the items domain, whose results are used instead of a pattern or a spec. Unless the query sets its own offset or limit, the results are fetched in pages, so result sets beyond the server's limit are fully returned. Sort the results in the query to keep the pages consistent.", components.SetMandatoryFalse()),
+
+	searchJsonl:     components.NewBoolFlag(searchJsonl, "Set to true to print each result as a JSON object on its own line (JSON Lines) as soon as it is fetched, rather than a JSON array once the search is done. Pattern searches are fetched in pages, and their results don't include properties.", components.WithBoolDefaultValueFalse()),
+	searchCountOnly: components.NewBoolFlag(searchCountOnly, "Set to true to display only the total of files or folders found, counting the results as they are fetched rather than keeping them. Pattern searches are fetched in pages.", components.WithBoolDefaultValueFalse()),
+
+	onConflict:    components.NewStringFlag(onConflict, "[Default: overwrite] What to do with the files whose target already exists. 'overwrite' replaces the target, 'skip' leaves the source in place, 'fail' copies or moves nothing, and 'rename' adds a numeric suffix to the target name. Unless the strategy is 'overwrite' and no other option is set, the files are transferred one by one, and the completed transfers are rolled back if any fails.", components.SetMandatoryFalse()),
+	mergeProps:    components.NewBoolFlag(mergeProps, "Set to true to keep the properties of an overwritten target which the source doesn't have.", components.WithBoolDefaultValueFalse()),
+	preserveStats: components.NewBoolFlag(preserveStats, "Set to true to keep the download statistics of the source as the 'stats.downloadCount' and 'stats.lastDownloaded' properties of the target.", components.WithBoolDefaultValueFalse()),
 
 	// Config specific commands flags
 	interactive:       components.NewBoolFlag(interactive, "[Default: true, unless $CI is true] Set to false if you do not want the config command to be interactive. If true, the --url option becomes optional.", components.WithBoolDefaultValueFalse()),
@@ -895,6 +1578,14 @@ var flagsMap = map[string]components.Flag{
 	archiveEntries:          components.NewStringFlag(archiveEntries, "This option is no longer supported since version 7.90.5 of Artifactory. If specified, only archive artifacts containing entries matching this pattern are matched. You can use wildcards to specify multiple artifacts.", components.SetMandatoryFalse()),
 	downloadSyncDeletes:     components.NewStringFlag(syncDeletes, "Specific path in the local file system, under which to sync dependencies after the download. After the download, this path will include only the dependencies downloaded during this download operation. The other files under this path will be deleted.", components.SetMandatoryFalse()),
 	skipChecksum:            components.NewBoolFlag(skipChecksum, "Set to true to skip checksum verification when downloading.", components.WithBoolDefaultValueFalse()),
+	verify:                  components.NewStringFlag(verify, "[Default: sha256] The checksum algorithm by which the downloaded files are verified against their checksums in Artifactory. Can be one of 'sha256', 'sha1', 'md5' or 'none'. The results are reported in the summary.", components.SetMandatoryFalse()),
+	verifyFailure:           components.NewStringFlag(verifyFailure, "[Default: fail] What is done with a downloaded file whose checksum doesn't match. Can be one of 'fail', 'retry', which downloads the file again up to --verify-retries times, or 'quarantine', which renames the file with the '.quarantine' suffix. The download fails if a file still mismatches.", components.SetMandatoryFalse()),
+	verifyRetries:           components.NewStringFlag(verifyRetries, "[Default: 3] The number of times a mismatching file is downloaded again with --verify-failure=retry.", components.SetMandatoryFalse()),
+	latestVersion:           components.NewBoolFlag(latestVersion, "Set to true to download only the artifacts of the newest version matching the pattern, rather than all the matching artifacts. The version of an artifact is taken from its version property, such as 'version' or 'npm.version', or otherwise from the deepest directory of its path which is a version, or from its file name. Pre-release versions are skipped unless --version-range includes them.", components.WithBoolDefaultValueFalse()),
+	versionRange:            components.NewStringFlag(versionRange, "A semver range, such as '^1.4', '~1.2.3' or '>=1.2, <2.0', within which the newest version is downloaded. Implies --latest.", components.SetMandatoryFalse()),
+	downloadCacheDir:        components.NewStringFlag(downloadCacheDir, "[Default: $JFROG_CLI_DOWNLOAD_CACHE] A directory in which the downloaded files are cached by their sha256. Artifacts found in the cache are copied from it after a HEAD request validates them, rather than downloaded again.", components.SetMandatoryFalse()),
+	downloadCacheMaxSize:    components.NewStringFlag(downloadCacheMaxSize, "[Default: 10240] The size of the download cache in megabytes, beyond which the least recently used files are evicted.", components.SetMandatoryFalse()),
+	delta:                   components.NewBoolFlag(delta, "Set to true to update an existing local file by downloading only the blocks that changed. Requires a block manifest deployed alongside the artifact using the upload command's --delta-manifest option.", components.WithBoolDefaultValueFalse()),
 
 	// Upload specific commands flags
 	uploadTargetProps: components.NewStringFlag(targetProps, "List of semicolon-separated(;) properties in the form of \"key1=value1;key2=value2;...\". Those properties will be attached to the uploaded artifacts.", components.SetMandatoryFalse()),
@@ -907,10 +1598,11 @@ var flagsMap = map[string]components.Flag{
 	symlinks:          components.NewBoolFlag(symlinks, "Set to true to preserve symbolic links structure in Artifactory.", components.WithBoolDefaultValueFalse()),
 	uploadSyncDeletes: components.NewStringFlag(syncDeletes, "Specific path in Artifactory, under which to sync artifacts after the upload. After the upload, this path will include only the artifacts uploaded during this upload operation. The other files under this path will be deleted.", components.SetMandatoryFalse()),
 	uploadAnt:         components.NewBoolFlag(antFlag, "Set to true to use an ant pattern instead of wildcards expression to collect files to upload.", components.WithBoolDefaultValueFalse()),
-	uploadArchive:     components.NewStringFlag(archive, "Set to \"zip\" to pack and deploy the files to Artifactory inside a ZIP archive. Currently, the only packaging format supported is zip.", components.SetMandatoryFalse()),
+	uploadArchive:     components.NewStringFlag(archive, "Set to \"zip\" or \"tar.gz\" to pack and deploy the files to Artifactory inside an archive. The archive is streamed to Artifactory without being stored on disk, and includes a manifest of the entries and their checksums, which is also recorded in the properties of the archive.", components.SetMandatoryFalse()),
 	uploadMinSplit:    components.NewStringFlag(MinSplit, "[Default: "+strconv.Itoa(UploadMinSplitMb)+"] The minimum file size in MiB required to attempt a multi-part upload. This option, as well as the functionality of multi-part upload, requires Artifactory with S3 or GCP storage.", components.SetMandatoryFalse()),
 	uploadSplitCount:  components.NewStringFlag(SplitCount, "[Default: "+strconv.Itoa(UploadSplitCount)+"] The maximum number of parts that can be concurrently uploaded per file during a multi-part upload. Set to 0 to disable multi-part upload. This option, as well as the functionality of multi-part upload, requires Artifactory with S3 or GCP storage.", components.SetMandatoryFalse()),
 	chunkSize:         components.NewStringFlag(chunkSize, "[Default: "+strconv.Itoa(UploadChunkSizeMb)+"] The upload chunk size in MiB that can be concurrently uploaded during a multi-part upload. This option, as well as the functionality of multi-part upload, requires Artifactory with S3 or GCP storage.", components.SetMandatoryFalse()),
+	deltaManifest:     components.NewBoolFlag(deltaManifest, "Set to true to deploy a block manifest alongside each uploaded file, allowing later downloads to fetch only the changed blocks with the download command's --delta option.", components.WithBoolDefaultValueFalse()),
 
 	// Move specific commands flags
 	moveRecursive:    components.NewBoolFlag(Recursive, "[Default: true] Set to false if you do not wish to move artifacts inside sub-folders in Artifactory.", components.WithBoolDefaultValueFalse()),
@@ -956,15 +1648,29 @@ var flagsMap = map[string]components.Flag{
 	dotGitPath:        components.NewStringFlag(dotGitPath, "Path to the .git directory. If not provided, the .git directory will be searched in the current working directory or its parent directories. Only respected when collect-git-info is enabled.", components.SetMandatoryFalse()),
 	gitConfigFilePath: components.NewStringFlag(gitConfigFilePath, "Path to the git configuration file. Only respected when collect-git-info is enabled.", components.SetMandatoryFalse()),
 
+	// Build Collect Env specific commands flags
+	envIncludeRegex: components.NewStringFlag(envIncludeRegex, "List of semicolon-separated(;) regular expressions. Only environment variables whose names match one of them are collected.", components.SetMandatoryFalse()),
+	envExcludeRegex: components.NewStringFlag(envExcludeRegex, "List of semicolon-separated(;) regular expressions. Environment variables whose names match one of them are not collected.", components.SetMandatoryFalse()),
+	maskSecrets:     components.NewBoolFlag(maskSecrets, "[Default: true] Set to false to collect the values of environment variables which look like secrets, by their names, known token formats or random-looking values, instead of masking them.", components.WithBoolDefaultValueTrue()),
+
 	// Build Add Dependencies specific commands flags
-	badRecursive: components.NewBoolFlag(Recursive, "[Default: true] Set to false if you do not wish to collect artifacts in sub-folders to be added to the build info.", components.WithBoolDefaultValueFalse()),
-	badRegexp:    components.NewBoolFlag(regexpFlag, "Set to true to use a regular expression instead of wildcards expression to collect files to be added to the build info."),
-	badDryRun:    components.NewBoolFlag(dryRun, "Set to true to only get a summary of the dependencies that will be added to the build info.", components.WithBoolDefaultValueFalse()),
-	badFromRt:    components.NewBoolFlag(fromRt, "Set true to search the files in Artifactory, rather than on the local file system. The --regexp option is not supported when --from-rt is set to true.", components.WithBoolDefaultValueFalse()),
-	badModule:    components.NewStringFlag(module, "Optional module name in the build-info for adding the dependency.", components.SetMandatoryFalse()),
+	badRecursive:  components.NewBoolFlag(Recursive, "[Default: true] Set to false if you do not wish to collect artifacts in sub-folders to be added to the build info.", components.WithBoolDefaultValueFalse()),
+	badRegexp:     components.NewBoolFlag(regexpFlag, "Set to true to use a regular expression instead of wildcards expression to collect files to be added to the build info."),
+	badDryRun:     components.NewBoolFlag(dryRun, "Set to true to only get a summary of the dependencies that will be added to the build info.", components.WithBoolDefaultValueFalse()),
+	badFromRt:     components.NewBoolFlag(fromRt, "Set true to search the files in Artifactory, rather than on the local file system. The --regexp option is not supported when --from-rt is set to true.", components.WithBoolDefaultValueFalse()),
+	badModule:     components.NewStringFlag(module, "Optional module name in the build-info for adding the dependency.", components.SetMandatoryFalse()),
+	badLockfile:   components.NewStringFlag("lockfile", "Path to a lockfile of a package manager, whose packages are added as dependencies with the checksums of their files in Artifactory, instead of the files matching a pattern. The supported lockfiles are package-lock.json, npm-shrinkwrap.json, go.sum, requirements.txt, poetry.lock, pdm.lock, Gemfile.lock, Cargo.lock, conan.lock, Package.resolved and Podfile.lock.", components.SetMandatoryFalse()),
+	lockfileRepos: components.NewStringFlag(lockfileRepos, "List of comma-separated(,) repositories in which the packages of the lockfile are searched. If not set, all the repositories are searched.", components.SetMandatoryFalse()),
 
 	// Build Add Git specific commands flags
-	configFlag: components.NewStringFlag(configFlag, "Path to a configuration file.", components.SetMandatoryFalse()),
+	configFlag:        components.NewStringFlag(configFlag, "Path to a configuration file.", components.SetMandatoryFalse()),
+	fromRef:           components.NewStringFlag(fromRef, "A git revision, tag or ref expression, such as v1.2.0 or HEAD~10, which the commits are collected from, instead of the revision of the latest published build.", components.SetMandatoryFalse()),
+	fromTagPattern:    components.NewStringFlag(fromTagPattern, "A glob pattern of git tags, such as 'v*'. The commits are collected from the latest matching tag reachable from HEAD, excluding the tags of HEAD itself, instead of from the revision of the latest published build.", components.SetMandatoryFalse()),
+	changelogProperty: components.NewStringFlag(changelogProperty, "The name of a build property, such as 'vcs.changelog', which the changelog of the collected commits is published as, in markdown.", components.SetMandatoryFalse()),
+	changelogTarget:   components.NewStringFlag(changelogTarget, "A path in Artifactory, such as 'docs-local/my-app/1.0/CHANGELOG.md', which the changelog of the collected commits is uploaded to in markdown, and added to the build-info as an artifact. A path ending with a slash is a folder, which the changelog is uploaded to as CHANGELOG.md.", components.SetMandatoryFalse()),
+
+	// Build Affected Modules specific commands flags
+	modulesManifest: components.NewStringFlag(modulesManifest, "[Default: .jfrog/modules.yaml] Path to a YAML manifest mapping each module to the path globs it owns, and optionally to the modules it depends on.", components.SetMandatoryFalse()),
 
 	// BuildScanLegacy specific commands flags
 	fail: components.NewBoolFlag(fail, "Set to true if you'd like the command to return exit code 2 in case of no files are affected.", components.WithBoolDefaultValueFalse()),
@@ -978,6 +1684,11 @@ var flagsMap = map[string]components.Flag{
 	failFast:            components.NewBoolFlag(failFast, "[Default: true] If true, fail and abort the operation upon receiving an error.", components.WithBoolDefaultValueFalse()),
 	bprDryRun:           components.NewBoolFlag(dryRun, "If true, promotion is only simulated. The build is not promoted.", components.WithBoolDefaultValueFalse()),
 	bprProps:            components.NewStringFlag(props, "List of semicolon-separated(;) properties in the form of \"key1=value1;key2=value2;...\" to be attached to the build artifacts.", components.SetMandatoryFalse()),
+	buildState:          components.NewStringFlag(buildState, "[Default: $JFROG_CLI_BUILD_STATE] URL of a backend to share the collected build-info with the build commands running on other machines. The supported URLs are artifactory://<repository>[/<path>], s3://<bucket>[/<prefix>], gs://<bucket>[/<prefix>] and redis[s]://[[<user>]:<password>@]<host>[:<port>][/<db>]. S3 and GCS are accessed with the AWS and Google Cloud credentials of the environment.", components.SetMandatoryFalse()),
+	childBuilds:         components.NewStringFlag(childBuilds, "List of semicolon-separated(;) published builds in the form of \"name1/number1[@started1];name2/number2...\", to append to an aggregated build instead of a single build. Set the start time of a build, in the format of its build-info, to avoid fetching it from Artifactory.", components.SetMandatoryFalse()),
+	promotionChain:      components.NewStringFlag(promotionChain, "List of semicolon-separated(;) promotion steps in the form of \"repo1[:status1[:comment1]];repo2...\", to promote the build through instead of a single target repository. Each step promotes from the target of the previous one, with its own status and comment if set. If a step fails, the previous steps are rolled back.", components.SetMandatoryFalse()),
+	bprEvidenceKey:      components.NewStringFlag("evidence-key", "Path to an unencrypted ECDSA, RSA or Ed25519 private key in PEM format, which signs the promotion and the properties it sets as evidence attached to the build-info. If not provided, no evidence is attached.", components.SetMandatoryFalse()),
+	bprEvidenceKeyAlias: components.NewStringFlag("evidence-key-alias", "The alias of the public key in the platform, which verifies the promotion evidence.", components.SetMandatoryFalse()),
 
 	// BuildDiscard specific commands flags
 	maxDays:         components.NewStringFlag(maxDays, "The maximum number of days to keep builds in Artifactory.", components.SetMandatoryFalse()),
@@ -986,12 +1697,110 @@ var flagsMap = map[string]components.Flag{
 	deleteArtifacts: components.NewBoolFlag(deleteArtifacts, "If set to true, automatically removes build artifacts stored in Artifactory.", components.WithBoolDefaultValueFalse()),
 	bdiAsync:        components.NewBoolFlag(Async, "If set to true, build discard will run asynchronously and will not wait for response.", components.WithBoolDefaultValueFalse()),
 
+	// BuildExport specific commands flags
+	includeArtifacts: components.NewBoolFlag(includeArtifacts, "Set to true to also download the artifacts of the build, by their paths in their repositories, to the 'artifacts' directory under the target path.", components.WithBoolDefaultValueFalse()),
+
+	// BuildScanGate specific commands flags
+	bsgFormat:      components.NewStringFlag(Format, "[Default: table] Defines the output format of the scan results. Acceptable values are: table, json and sarif.", components.SetMandatoryFalse()),
+	bsgVuln:        components.NewBoolFlag(vuln, "Set to true to include all the vulnerabilities of the build, regardless of the policies configured in Xray, in the results and in the severity check.", components.WithBoolDefaultValueFalse()),
+	failOnSeverity: components.NewStringFlag(failOnSeverity, "Fail if the scan finds a violation, or a vulnerability when --vuln is set, of this severity or above. Acceptable values are: Low, Medium, High and Critical. If not set, the command fails if the build violates an Xray policy with the \"Fail build\" rule.", components.SetMandatoryFalse()),
+	scanTimeout:    components.NewStringFlag(scanTimeout, "[Default: 10] The maximal number of minutes to wait for the build to be available to Xray and for its scan to complete.", components.SetMandatoryFalse()),
+	rescan:         components.NewBoolFlag(rescan, "Set to true to scan the build again, even if it was already scanned.", components.WithBoolDefaultValueFalse()),
+
 	// GitLfsClean specific commands flags
 	refs:      components.NewStringFlag(refs, "[Default: refs/remotes/*] List of comma-separated(,) Git references in the form of \"ref1,ref2,...\" which should be preserved.", components.SetMandatoryFalse()),
 	glcRepo:   components.NewStringFlag(repo, "Local Git LFS repository which should be cleaned. If omitted, this is detected from the Git repository.", components.SetMandatoryFalse()),
 	glcDryRun: components.NewBoolFlag(dryRun, "If true, cleanup is only simulated. No files are actually deleted.", components.WithBoolDefaultValueFalse()),
 	glcQuiet:  components.NewBoolFlag(quiet, "[Default: $CI] Set to true to skip the delete confirmation message.", components.WithBoolDefaultValueFalse()),
 
+	hcRepo:       components.NewStringFlag(repo, "Repository to which a canary file is uploaded, downloaded and deleted, to check the read and write permissions. If omitted, these checks are skipped.", components.SetMandatoryFalse()),
+	maxClockSkew: components.NewStringFlag(maxClockSkew, "[Default: 1m] The largest allowed difference between the local clock and the clock of the server, such as 30s or 2m.", components.SetMandatoryFalse()),
+
+	evdKey:         components.NewStringFlag("key", "Path to an unencrypted ECDSA, RSA or Ed25519 private key in PEM format, which signs the evidence whose manifest item sets no key.", components.SetMandatoryFalse()),
+	evdKeyAlias:    components.NewStringFlag("key-alias", "The alias of the public key in the platform, which verifies the evidence whose manifest item sets no key alias.", components.SetMandatoryFalse()),
+	evdBundle:      components.NewStringFlag("bundle", "Path of the exported bundle. If not provided, the bundle is written to <subject name>.evidence.json in the current directory.", components.SetMandatoryFalse()),
+	evdBundleIn:    components.NewStringFlag("bundle", "[Mandatory] Path of an evidence bundle, exported by the evidence-export command.", components.SetMandatoryTrue()),
+	evdSubjectFile: components.NewStringFlag("subject-file", "Path to a local copy of the subject, whose SHA-256 checksum should match the subject of the bundle.", components.SetMandatoryFalse()),
+	evdPublicKeys:  components.NewStringFlag("public-keys", "List of comma-separated(,) paths to PEM public keys to trust. If provided, the public keys of the bundle are ignored.", components.SetMandatoryFalse()),
+	evdKeyType:     components.NewStringFlag("key-type", "[Default: ed25519] The type of the generated key pair. Acceptable values are: ed25519, ecdsa and rsa.", components.SetMandatoryFalse()),
+	evdSubjectPath: components.NewStringFlag("subject-repo-path", "The repository path of the artifact to which the attestations are attached. If not provided, the artifact is found by the sha256 digest of the subject of each attestation.", components.SetMandatoryFalse()),
+	evdRepo:        components.NewStringFlag(repo, "The repository in which the artifacts are found by the sha256 digests of the subjects of the attestations.", components.SetMandatoryFalse()),
+	evdOutput:      components.NewStringFlag("output", "Path of the exported file. If not provided, the file is written to sha256:<digest>.jsonl in the current directory, like the files downloaded by 'gh attestation download'.", components.SetMandatoryFalse()),
+
+	evcKey:            components.NewStringFlag("key", "[Mandatory] Path to an unencrypted ECDSA, RSA or Ed25519 private key in PEM format, which signs the evidence.", components.SetMandatoryTrue()),
+	evcKeyAlias:       components.NewStringFlag("key-alias", "The alias of the public key in the platform, which verifies the evidence.", components.SetMandatoryFalse()),
+	evcPredicateType:  components.NewStringFlag("predicate-type", "[Mandatory] The type of the predicate, such as https://slsa.dev/provenance/v1.", components.SetMandatoryTrue()),
+	evcSubjectPath:    components.NewStringFlag("subject-repo-path", "The repository path of the artifact to which the evidence is attached. Either this option or the package options should be provided.", components.SetMandatoryFalse()),
+	evcPackageType:    components.NewStringFlag("package-type", "The package type of the package to which the evidence is attached. Acceptable values are: docker, maven, npm, pypi and generic. If not provided, the package type of the repository is used.", components.SetMandatoryFalse()),
+	evcPackageRepo:    components.NewStringFlag("package-repo", "The repository of the package to which the evidence is attached.", components.SetMandatoryFalse()),
+	evcPackageName:    components.NewStringFlag("package-name", "The name of the package to which the evidence is attached, instead of --subject-repo-path. The name of a maven package is <groupId>:<artifactId>.", components.SetMandatoryFalse()),
+	evcPackageVersion: components.NewStringFlag("package-version", "The version of the package to which the evidence is attached. The version of a docker image is its tag.", components.SetMandatoryFalse()),
+
+	// Repo apply specific commands flags
+	rapDryRun: components.NewBoolFlag(dryRun, "Set to true to only print the repositories to create and the field-level changes of the repositories to update.", components.WithBoolDefaultValueFalse()),
+
+	// Federation specific commands flags
+	mirror: components.NewStringFlag(mirror, "The URL of the member repository to synchronize with, for example https://acme.jfrog.io/artifactory/generic-federated. If not provided, the repository is synchronized with all of its members.", components.SetMandatoryFalse()),
+
+	// Token lifecycle specific commands flags
+	tkScope:        components.NewStringFlag("scope", "[Default: applied-permissions/user] The scope of the token, for example 'applied-permissions/groups:readers,deployers'.", components.SetMandatoryFalse()),
+	tkDescription:  components.NewStringFlag("description", "A free text describing the token.", components.SetMandatoryFalse()),
+	tkExpiry:       components.NewStringFlag(Expiry, "The time in seconds for which the token will be valid. To specify a token that never expires, set to zero. If not set, the default expiry of the JFrog Platform is used.", components.SetMandatoryFalse()),
+	tkRefreshable:  components.NewBoolFlag(Refreshable, "Set to true if you'd like the token to be refreshable. A refresh token will also be returned in order to be used to generate a new token once it expires.", components.WithBoolDefaultValueFalse()),
+	tkAudience:     components.NewStringFlag(Audience, "The audience of the token.", components.SetMandatoryFalse()),
+	tkSaveAs:       components.NewStringFlag("save-as", "A server ID to save the token to in the JFrog CLI configuration. If the server isn't configured, it is added with the URL of the command. If not set, the token is printed.", components.SetMandatoryFalse()),
+	tkOidcProvider: components.NewStringFlag("oidc-provider", "[Default: detected] The CI system which issues the identity token: github, gitlab or circleci.", components.SetMandatoryFalse()),
+	tkProviderName: components.NewStringFlag("provider-name", "[Mandatory] The name of the OIDC integration in the JFrog Platform.", components.SetMandatoryTrue()),
+	tkIdTokenEnv:   components.NewStringFlag("id-token-env", "An environment variable holding the identity token, instead of obtaining it from the CI system. With GitLab, the identity token is read from the JFROG_OIDC_TOKEN variable by default.", components.SetMandatoryFalse()),
+	tkRefreshToken: components.NewStringFlag("refresh-token", "The refresh token to use. If not set, the refresh token of the configured server is used.", components.SetMandatoryFalse()),
+
+	// Webhook specific commands flags
+	whDomain:      components.NewStringFlag("domain", "[Default: artifact] The domain of the events: artifact, build, release_bundle or distribution.", components.SetMandatoryFalse()),
+	whEventTypes:  components.NewStringFlag("event-types", "A list of comma-separated event types of the domain, for example 'deployed,deleted'. If not set, all the event types of the domain are subscribed to.", components.SetMandatoryFalse()),
+	whNames:       components.NewStringFlag("names", "A list of comma-separated repositories, builds or release bundles, according to the domain, whose events trigger the webhook. If not set, the events of all of them do.", components.SetMandatoryFalse()),
+	whSecret:      components.NewStringFlag("secret", "A secret which the webhook sends in the X-JFrog-Event-Auth header, to authenticate the events.", components.SetMandatoryFalse()),
+	whDescription: components.NewStringFlag("description", "A free text describing the webhook.", components.SetMandatoryFalse()),
+	whTunnelUrl:   components.NewStringFlag("tunnel-url", "[Mandatory] A public URL which forwards to the local port of the listener, such as the URL of an ngrok tunnel.", components.SetMandatoryTrue()),
+	whPort:        components.NewStringFlag(port, "[Default: 8090] Local port on which the listener listens.", components.SetMandatoryFalse()),
+
+	// Storage report specific commands flags
+	srGroupBy:     components.NewStringFlag("group-by", "[Default: repo] Aggregates the storage usage by repo, package-type or project.", components.SetMandatoryFalse()),
+	srSort:        components.NewStringFlag("sort", "[Default: size] Sorts the report by size or files, in descending order, or by name.", components.SetMandatoryFalse()),
+	srMinSize:     components.NewStringFlag("min-size", "Leaves out of the report the usages smaller than this size, for example '500MB'. The supported units are KB, MB, GB and TB.", components.SetMandatoryFalse()),
+	srFailAbove:   components.NewStringFlag("fail-above", "Fails the command if any usage exceeds this size, for example '100GB'. The supported units are KB, MB, GB and TB.", components.SetMandatoryFalse()),
+	srFormat:      components.NewStringFlag(Format, "[Default: table] Defines the output format of the report. Acceptable values are: table, json and csv.", components.SetMandatoryFalse()),
+	srRecalculate: components.NewBoolFlag("recalculate", "[Default: false] Set to true to recalculate the storage summary, and wait for the recalculated summary before reporting.", components.WithBoolDefaultValueFalse()),
+	srWaitTimeout: components.NewStringFlag("wait-timeout", "[Default: 10] The maximal number of minutes to wait for the recalculated storage summary. If it isn't available by then, the current summary is reported.", components.SetMandatoryFalse()),
+
+	// Debian and RPM deploy specific commands flags
+	pdDistribution: components.NewStringFlag("distribution", "[Mandatory] Comma-separated list of the distributions of the Debian packages, such as 'jammy'.", components.SetMandatoryFalse()),
+	pdComponent:    components.NewStringFlag("component", "[Default: main] The component of the Debian packages.", components.SetMandatoryFalse()),
+	pdArchitecture: components.NewStringFlag("architecture", "The architecture of the Debian packages. If not provided, it's read from their names, which are <name>_<version>_<architecture>.deb.", components.SetMandatoryFalse()),
+	pdNoWait:       components.NewBoolFlag("no-wait", "[Default: false] Set to true to return after triggering the recalculation of the metadata of the repository, without waiting for it to index the deployed packages.", components.WithBoolDefaultValueFalse()),
+	pdWaitTimeout:  components.NewStringFlag("wait-timeout", "[Default: 10] The maximal number of minutes to wait for the metadata of the repository to index the deployed packages.", components.SetMandatoryFalse()),
+
+	// OCI artifact specific commands flags
+	ociArtifactType: components.NewStringFlag("artifact-type", "The artifact type, for example 'application/spdx+json'. When pushing, defaults to 'application/vnd.unknown.artifact.v1'. When listing referrers, only the referrers of this type are listed.", components.SetMandatoryFalse()),
+	ociAnnotations:  components.NewStringFlag("annotations", "Annotations of the artifact manifest in the form of \"key1=value1;key2=value2\".", components.SetMandatoryFalse()),
+	ociSignKey:      components.NewStringFlag("key", "[Mandatory] Path to an unencrypted ECDSA, RSA or Ed25519 private key in PEM format. The key which signs evidence can be used.", components.SetMandatoryTrue()),
+	ociVerifyKey:    components.NewStringFlag("key", "[Mandatory] Path to the public key in PEM format, which verifies the signatures.", components.SetMandatoryTrue()),
+	ociSubject:      components.NewStringFlag("subject", "The tag or digest of an artifact in the same repository, which the pushed artifact refers to, such as the image described by an SBOM.", components.SetMandatoryFalse()),
+
+	// maven-deploy-file specific commands flags
+	mdfGroupId:     components.NewStringFlag("group-id", "[Mandatory] The group ID of the deployed file.", components.SetMandatoryTrue()),
+	mdfArtifactId:  components.NewStringFlag("artifact-id", "[Mandatory] The artifact ID of the deployed file.", components.SetMandatoryTrue()),
+	mdfVersion:     components.NewStringFlag("version", "[Mandatory] The version of the deployed file.", components.SetMandatoryTrue()),
+	mdfClassifier:  components.NewStringFlag("classifier", "The classifier of the deployed file, for example 'linux-x86_64'.", components.SetMandatoryFalse()),
+	mdfPackaging:   components.NewStringFlag("packaging", "[Default: the extension of the file] The packaging of the artifact in the generated POM.", components.SetMandatoryFalse()),
+	mdfAttach:      components.NewStringFlag("attach", "Files to deploy with the same coordinates and their own classifiers, in the form of \"classifier1=path1;classifier2=path2\", for example \"sources=lib-sources.jar;javadoc=lib-javadoc.jar\".", components.SetMandatoryFalse()),
+	mdfLayout:      components.NewStringFlag("layout", "[Default: '[orgPath]/[module]/[baseRev]/[module]-[baseRev](-[classifier]).[ext]'] The path pattern of the deployed files, for repositories with a custom layout. Supports the [org], [orgPath], [module], [baseRev], [classifier], [ext] and [type] tokens. Parts in parentheses are omitted when their tokens are empty.", components.SetMandatoryFalse()),
+	mdfGeneratePom: components.NewBoolFlag("generate-pom", "[Default: true] Set to false to skip generating and deploying a minimal POM, for example when the POM is deployed separately.", components.WithBoolDefaultValueTrue()),
+
+	// Proxy specific commands flags
+	prxRepo:     components.NewStringFlag(repo, "Path in Artifactory to which the root of the proxy is mapped, for example 'npm-virtual', or 'api/npm/npm-virtual' to serve the npm API of the repository. If omitted, the root of the proxy is mapped to the Artifactory URL.", components.SetMandatoryFalse()),
+	port:        components.NewStringFlag(port, "[Mandatory] Local port on which the proxy listens.", components.SetMandatoryTrue()),
+	allowWrites: components.NewBoolFlag(allowWrites, "Set to true to forward requests which modify Artifactory, like PUT and DELETE. By default, only GET and HEAD requests are forwarded.", components.WithBoolDefaultValueFalse()),
+
 	// Config commands flags
 	global:          components.NewBoolFlag(global, "Set to true if you'd like the configuration to be global (for all projects). Specific projects can override the global configuration.", components.WithBoolDefaultValueFalse()),
 	serverIdResolve: components.NewStringFlag(serverIdResolve, "Artifactory server ID for resolution. The server should be configured using the 'jfrog c add' command.", components.SetMandatoryFalse()),
@@ -1015,6 +1824,9 @@ var flagsMap = map[string]components.Flag{
 	ivyDescPattern:      components.NewStringFlag(ivyDescPattern, "[Default: '[organization]/[module]/ivy-[revision].xml' Set the deployed Ivy descriptor pattern.", components.SetMandatoryFalse()),
 	ivyArtifactsPattern: components.NewStringFlag(ivyArtifactsPattern, "[Default: '[organization]/[module]/[revision]/[artifact]-[revision](-[classifier]).[ext]' Set the deployed Ivy artifacts pattern.", components.SetMandatoryFalse()),
 
+	// Gradle specific commands flags
+	printInitScript: components.NewBoolFlag(printInitScript, "Set to true to print the init script which resolves the plugins and the dependencies of the build, and publishes its artifacts, through the configured resolution repository, without running the build. The script can be inspected, or passed to Gradle with '--init-script'.", components.WithBoolDefaultValueFalse()),
+
 	// Mvn and Gradle specific commands flags
 	deploymentThreads: components.NewStringFlag(threads, "[Default: "+strconv.Itoa(commonCliUtils.Threads)+"] Number of threads for uploading build artifacts.", components.SetMandatoryFalse()),
 	xrayScan:          components.NewBoolFlag(xrayScan, "Set if you'd like all files to be scanned by Xray on the local file system prior to the upload, and skip the upload if any of the files are found vulnerable.", components.WithBoolDefaultValueFalse()),
@@ -1034,22 +1846,56 @@ var flagsMap = map[string]components.Flag{
 
 	// DockerPromote specific commands flags
 	targetDockerImage: components.NewStringFlag("target-docker-image", "Docker target image name.", components.SetMandatoryFalse()),
-	sourceTag:         components.NewStringFlag("source-tag", "The tag name to promote.", components.SetMandatoryFalse()),
+	sourceTag:         components.NewStringFlag("source-tag", "The tag name to promote. If not specified, all the tags of the image are promoted.", components.SetMandatoryFalse()),
 	targetTag:         components.NewStringFlag("target-tag", "The target tag to assign the image after promotion.", components.SetMandatoryFalse()),
 	dockerPromoteCopy: components.NewBoolFlag("copy", "If set true, the Docker image is copied to the target repository, otherwise it is moved.", components.WithBoolDefaultValueFalse()),
 
+	dclDryRun:        components.NewBoolFlag(dryRun, "Set to true to only report which tags would be kept and which would be deleted.", components.WithBoolDefaultValueFalse()),
+	keepLast:         components.NewStringFlag(keepLast, "Number of most recently created tags to keep for each image.", components.SetMandatoryFalse()),
+	keepSemver:       components.NewBoolFlag(keepSemver, "Set to true to keep tags which are semantic versions, such as 1.2.3 or v1.2.3.", components.WithBoolDefaultValueFalse()),
+	keepPulledWithin: components.NewStringFlag(keepPulledWithin, "Keep tags pulled within this period, for example 90d or 48h.", components.SetMandatoryFalse()),
+	keepTags:         components.NewStringFlag(keepTags, "List of semicolon-separated(;) patterns of tags to always keep, for example 'latest;release-*'. Patterns are matched against the tag, and against the image followed by '/' and the tag.", components.SetMandatoryFalse()),
+	cleanupRules:     components.NewStringFlag(cleanupRules, "Path to a YAML file with the keep rules, under a 'keep' section with the 'last', 'semver', 'pulledWithin' and 'tags' keys. Options provided in the command line override the file.", components.SetMandatoryFalse()),
+	evidenceRecord:   components.NewStringFlag(evidenceRecord, "Path to a local JSON file, to which a record of the cleanup is appended.", components.SetMandatoryFalse()),
+
+	clnDryRun:           components.NewBoolFlag(dryRun, "Set to true to only print the plan of the deletion.", components.WithBoolDefaultValueFalse()),
+	maxDeletesPerSecond: components.NewStringFlag(maxDeletesPerSecond, "The maximal number of files deleted per second, to limit the load of the cleanup on Artifactory. If not set, the deletion isn't throttled.", components.SetMandatoryFalse()),
+	auditTarget:         components.NewStringFlag(auditTarget, "Artifactory folder, for example 'audit-local/cleanup/', to which the audit log of the cleanup is uploaded as a JSON file, listing the policies and the deleted files.", components.SetMandatoryFalse()),
+
+	protectRelease: components.NewBoolFlag(protectRelease, "Set to true to release the artifacts from the protection, rather than protect them.", components.WithBoolDefaultValueFalse()),
+	legalHoldCase:  components.NewStringFlag(legalHoldCase, "Identifier of the legal hold, such as a case number, stored as the value of the 'lifecycle.legal-hold' property. It can't contain commas, semicolons or equal signs.", components.SetMandatoryFalse()),
+
 	allowInsecureConnections: components.NewBoolFlag(allowInsecureConnections, "Set to true if you wish to configure NuGet sources with unsecured connections. This is recommended for testing purposes only.", components.WithBoolDefaultValueFalse()),
 	npmDetailedSummary:       components.NewBoolFlag(detailedSummary, "Set to true to include a list of the affected files in the command summary.", components.WithBoolDefaultValueFalse()),
 	nugetV2:                  components.NewBoolFlag(nugetV2, "Set to true if you'd like to use the NuGet V2 protocol when restoring packages from Artifactory.", components.WithBoolDefaultValueFalse()),
 
+	// Pdm specific commands flags
+	pdmRepo: components.NewStringFlag(repo, "PyPI repository in Artifactory from which the packages are resolved, and to which they're published.", components.SetMandatoryFalse()),
+
+	// Conan specific commands flags
+	conanRepo: components.NewStringFlag(repo, "Conan repository in Artifactory, which is configured as a Conan remote named after it, and used as the remote of the command if no other remote is specified. Requires Conan 2.x.", components.SetMandatoryFalse()),
+
+	// Cargo specific commands flags
+	cargoRepo: components.NewStringFlag(repo, "Cargo repository in Artifactory, which is configured as a sparse registry that replaces crates.io, and to which the crates are published.", components.SetMandatoryFalse()),
+
+	// Swift specific commands flags
+	swiftRepo: components.NewStringFlag(repo, "Swift repository in Artifactory, which is set as the package registry, and to which the packages are published.", components.SetMandatoryFalse()),
+
+	// Pod specific commands flags
+	podRepo: components.NewStringFlag(repo, "CocoaPods repository in Artifactory, which is added as a spec repo by the cocoapods-art plugin, and to which the pods are published.", components.SetMandatoryFalse()),
+
 	// GoPublish specific commands flags
 	goPublishExclusions: components.NewStringFlag(exclusions, "List of semicolon-separated(;) exclusions. Exclusions can include the * and the ? wildcards.", components.SetMandatoryFalse()),
 	noFallback:          components.NewBoolFlag(noFallback, "Set to true to avoid downloading packages from the VCS, if they are missing in Artifactory.", components.WithBoolDefaultValueFalse()),
+	privateModules:      components.NewStringFlag(privateModules, "Comma-separated list of module path patterns of private modules hosted in Artifactory, which are added to GONOSUMDB and GOPRIVATE. If not provided, the patterns are derived from the path of the main module.", components.SetMandatoryFalse()),
 
 	// Terraform specific commands flags
 	namespace:       components.NewStringFlag(namespace, "[Mandatory] Terraform namespace.", components.SetMandatoryTrue()),
 	provider:        components.NewStringFlag(provider, "[Mandatory] Terraform provider.", components.SetMandatoryTrue()),
 	Tag:             components.NewStringFlag(Tag, "[Mandatory] Terraform package tag.", components.SetMandatoryTrue()),
+	terraformType:   components.NewStringFlag("type", "The type of the published Terraform package, module or provider.", components.WithStrDefaultValue("module")),
+	terraformDist:   components.NewStringFlag("dist", "The directory of the provider's archives, which are named terraform-provider-<type>_<version>_<os>_<arch>.zip.", components.WithStrDefaultValue("dist")),
+	terraformGpgKey: components.NewStringFlag(publicGpgKey, "The ID of the GPG key which signs the SHA256SUMS file of the provider.", components.SetMandatoryFalse()),
 	IncludeProjects: components.NewStringFlag(IncludeProjects, "List of semicolon-separated(;) JFrog Project keys to include in the transfer. You can use wildcards to specify patterns for the JFrog Project keys.", components.SetMandatoryFalse()),
 	ExcludeProjects: components.NewStringFlag(ExcludeProjects, "List of semicolon-separated(;) JFrog Projects to exclude from the transfer. You can use wildcards to specify patterns for the project keys.", components.SetMandatoryFalse()),
 
@@ -1074,6 +1920,20 @@ var flagsMap = map[string]components.Flag{
 	// GroupCreate
 	Replace: components.NewBoolFlag(Replace, "Set to true if you'd like existing groups to be replaced.", components.WithBoolDefaultValueFalse()),
 
+	// UserUpdate and GroupUpdate
+	uuEmail:           components.NewStringFlag("email", "The new email address of the user.", components.SetMandatoryFalse()),
+	uuNewPassword:     components.NewStringFlag("new-password", "The new password of the user.", components.SetMandatoryFalse()),
+	uuAdmin:           components.NewStringFlag(Admin, "Set to true or false to grant or revoke the admin permission of the user.", components.SetMandatoryFalse()),
+	guDescription:     components.NewStringFlag("description", "The new description of the group.", components.SetMandatoryFalse()),
+	guAutoJoin:        components.NewStringFlag("auto-join", "Set to true or false to set whether new users join the group automatically.", components.SetMandatoryFalse()),
+	guAdminPrivileges: components.NewStringFlag("admin-privileges", "Set to true or false to set whether the members of the group are admins.", components.SetMandatoryFalse()),
+
+	// UsersList and UsersExport
+	usersFilterGroup:  components.NewStringFlag(usersFilterGroup, "Select only the members of this group.", components.SetMandatoryFalse()),
+	usersFilterRealm:  components.NewStringFlag(usersFilterRealm, "Select only the users of this realm, such as 'internal' or 'ldap'.", components.SetMandatoryFalse()),
+	usersFilterAdmins: components.NewBoolFlag(usersFilterAdmins, "Set to true to select only the admins.", components.WithBoolDefaultValueFalse()),
+	ulFormat:          components.NewStringFlag(Format, "[Default: table] Defines the output format. Acceptable values are: table and json.", components.SetMandatoryFalse()),
+
 	distUrl:              components.NewStringFlag(url, "JFrog Distribution URL. (example: https://acme.jfrog.io/distribution)", components.SetMandatoryFalse()),
 	targetProps:          components.NewStringFlag(targetProps, "List of semicolon-separated(;) properties, in the form of \"key1=value1;key2=value2;...\" to be added to the artifacts after distribution of the release bundle.", components.SetMandatoryFalse()),
 	rbDryRun:             components.NewBoolFlag(dryRun, "Set to true to disable communication with JFrog Distribution.", components.WithBoolDefaultValueFalse()),
@@ -1098,6 +1958,13 @@ var flagsMap = map[string]components.Flag{
 	lcBuilds:             components.NewStringFlag(Builds, "Path to a JSON file containing information of the source builds from which to create a release bundle.", components.SetHiddenStrFlag(), components.SetMandatoryFalse()),
 	lcReleaseBundles:     components.NewStringFlag(ReleaseBundles, "Path to a JSON file containing information of the source release bundles from which to create a release bundle.", components.SetHiddenStrFlag(), components.SetMandatoryFalse()),
 	lcSigningKey:         components.NewStringFlag(SigningKey, "The GPG/RSA key-pair name given in Artifactory. If the key isn't provided, the command creates or uses the default key.", components.SetMandatoryFalse()),
+	skType:               components.NewStringFlag("type", "The type of the key pair, GPG or RSA.", components.WithStrDefaultValue("GPG")),
+	skAlias:              components.NewStringFlag("alias", "The alias of the key pair. If not provided, the name of the key pair is used.", components.SetMandatoryFalse()),
+	PublicKey:            components.NewStringFlag(PublicKey, "[Mandatory] Path to the file of the public key.", components.SetMandatoryTrue()),
+	PrivateKey:           components.NewStringFlag(PrivateKey, "[Mandatory] Path to the file of the private key.", components.SetMandatoryTrue()),
+	skPassphrase:         components.NewStringFlag(passphrase, "The passphrase of the private key.", components.SetMandatoryFalse()),
+	Edges:                components.NewStringFlag(Edges, "[Mandatory] List of semicolon-separated(;) server IDs of the edge nodes, as configured by the 'jf config' command.", components.SetMandatoryTrue()),
+	SigningKeyType:       components.NewStringFlag(SigningKeyType, "The expected type of the key-pair provided by --signing-key, GPG or RSA. The key-pair is validated before the release bundle is created.", components.SetMandatoryFalse()),
 	lcPathMappingPattern: components.NewStringFlag(PathMappingPattern, "Specify along with "+PathMappingTarget+" to distribute artifacts to a different path on the edge node. You can use wildcards to specify multiple artifacts.", components.SetMandatoryFalse()),
 	lcPathMappingTarget: components.NewStringFlag(PathMappingTarget, "The target path for distributed artifacts on the edge node. If not specified, the artifacts will have the same path and name on the edge node, as on the source Artifactory server. "+
 		"For flexibility in specifying the distribution path, you can include placeholders in the form of {1}, {2} which are replaced by corresponding tokens in the pattern path that are enclosed in parenthesis.` `", components.SetMandatoryFalse()),
@@ -1107,13 +1974,41 @@ var flagsMap = map[string]components.Flag{
 	lcExcludeRepos:           components.NewStringFlag(ExcludeRepos, "List of semicolon-separated(;) repositories to exclude from the promotion.` `", components.SetMandatoryFalse()),
 	platformUrl:              components.NewStringFlag(url, "JFrog platform URL. (example: https://acme.jfrog.io)` `", components.SetMandatoryFalse()),
 	PromotionType:            components.NewStringFlag(PromotionType, "The promotion type. Can be one of 'copy' or 'move'.", components.WithStrDefaultValue("copy")),
+	WithManifest:             components.NewBoolFlag(WithManifest, "Set to true to write a manifest with the checksums of the archive and of the release bundle artifacts, their properties and the checksum of the signed release bundle, next to the archive. The manifest is used by the import command to verify the archive and the imported release bundle.", components.WithBoolDefaultValueFalse()),
+	lcArchiveManifest:        components.NewStringFlag(ArchiveManifest, "Path to the manifest written by the export command with --with-manifest. If provided, the archive is verified before it's imported, and the signature, checksums and properties of the imported release bundle are verified after it's imported.", components.SetMandatoryFalse()),
+	lcFormat:                 components.NewStringFlag(Format, "[Default: table] Defines the output format of the command. Acceptable values are: table, json and yaml.", components.SetMandatoryFalse()),
+	Watch:                    components.NewBoolFlag(Watch, "Set to true to wait for the distribution to complete, rendering the progress of each target site. The wait is limited by --max-wait-minutes.", components.WithBoolDefaultValueFalse()),
+	TrackerId:                components.NewStringFlag(TrackerId, "The ID of the distribution tracker. If not provided, the latest distribution of the release bundle version is used.", components.SetMandatoryFalse()),
+	RequireEvidence:          components.NewStringFlag(RequireEvidence, "List of semicolon-separated(;) predicate types of the evidence which must be attached to the release bundle and verified before it's promoted. The aliases 'tests', 'scan' and 'approval' can be used for the test results, vulnerability scan and approval predicate types.", components.SetMandatoryFalse()),
+	GateReport:               components.NewStringFlag(GateReport, "Path to a file to which the JSON report of the required evidence checks is written. If not provided, the report is logged.", components.SetMandatoryFalse()),
 	lcTag:                    components.NewStringFlag(Tag, "Tag to put on Release Bundle version.", components.SetMandatoryFalse()),
 	lcProperties:             components.NewStringFlag(Properties, "Properties to put on the of Manifest Release Bundle version.", components.SetMandatoryFalse()),
 	lcDeleteProperties:       components.NewStringFlag(DeleteProperty, "Properties to be deleted on the of Manifest Release Bundle version.", components.SetMandatoryFalse()),
 	SourceTypeReleaseBundles: components.NewStringFlag(SourceTypeReleaseBundles, "List of semicolon-seperated(;) release bundles in the form of 'name=releaseBundleName1, version=version1; name=releaseBundleName2, version=version2' to be included in the new bundle.", components.SetMandatoryFalse()),
-	SourceTypeBuilds:         components.NewStringFlag(SourceTypeBuilds, "List of semicolon-separated(;) builds in the form of 'name=buildName1, id=runID1, include-deps=true; name=buildName2, id=runID2' to be included in the new bundle.", components.SetMandatoryFalse()),
+	SourceTypeBuilds:         components.NewStringFlag(SourceTypeBuilds, "List of semicolon-separated(;) builds in the form of 'name=buildName1, id=runID1, include-deps=true; name=buildName2, id=runID2' to be included in the new bundle. Add 'include=pattern' or 'exclude=pattern' to a build to include only its artifacts matching the include pattern and not matching the exclude pattern.", components.SetMandatoryFalse()),
+	AutoVersion:              components.NewStringFlag(AutoVersion, "[Optional] Derive the version of the new release bundle instead of providing it as an argument. Possible values: 'patch', 'minor' and 'major' to increment the latest existing semantic version of the bundle, or 'from-git-tag' to use the latest git tag.", components.SetMandatoryFalse()),
 	Draft:                    components.NewBoolFlag(Draft, "Set to true to create the release bundle as a draft. A draft release bundle can be updated and finalized later.", components.WithBoolDefaultValueFalse()),
 	AddSources:               components.NewBoolFlag(AddSources, "Add sources to an existing draft release bundle.", components.WithBoolDefaultValueFalse()),
+
+	outputFormat:   components.NewStringFlag(Format, "Defines the output format of the result of the command. Acceptable values are: json, yaml and table. The json and yaml formats have a versioned schema.", components.SetMandatoryFalse()),
+	mutatingDryRun: components.NewBoolFlag(dryRun, "Set to true to resolve the targets of the command and log the exact operations it would perform, without performing them.", components.WithBoolDefaultValueFalse()),
+	summaryOutput:  components.NewStringFlag(summaryOutput, "Path of a file to which a json summary of the command is written, with the operation, the number of succeeded and failed items, the total bytes, the duration, the server and the SHA-256 checksums.", components.SetMandatoryFalse()),
+
+	curlSpec:     components.NewStringFlag(specFlag, "Path to a YAML curl spec, which describes the method, path, query, headers and body of the request, and the pagination of its response. If provided, the request is sent without cUrl, and the pages of a response with continuation tokens are merged.", components.SetMandatoryFalse()),
+	curlSpecVars: components.NewStringFlag(specVars, "List of semicolon-separated(;) variables in the form of \"key1=value1;key2=value2;...\" (wrapped by quotes) to be replaced in the curl spec and in its body file. In the spec, the variables should be used as follows: ${key1}.", components.SetMandatoryFalse()),
+
+	sbomFormat:          components.NewStringFlag(Format, "[Default: cyclonedx] The format of the SBOM. Acceptable values are: cyclonedx and spdx.", components.SetMandatoryFalse()),
+	sbomOutput:          components.NewStringFlag("output", "Path of the SBOM file. If not provided, the SBOM is written to <build name>-<build number>.<format>.json in the current directory.", components.SetMandatoryFalse()),
+	sbomPublished:       components.NewBoolFlag("published", "Set to true to generate the SBOM from the build-info published to Artifactory, rather than from the build-info collected locally.", components.WithBoolDefaultValueFalse()),
+	sbomTarget:          components.NewStringFlag(target, "[Mandatory] The repository path to which the SBOM is uploaded. If it ends with a '/', the SBOM file is uploaded to this folder.", components.SetMandatoryFalse()),
+	sbomSubjectRepoPath: components.NewStringFlag("subject-repo-path", "The repository path of the artifact to which the SBOM is attached as evidence. If not provided, the SBOM is attached to the uploaded SBOM file.", components.SetMandatoryFalse()),
+	sbomPackageType:     components.NewStringFlag("package-type", "The package type of the package to which the SBOM is attached as evidence. Acceptable values are: docker, maven, npm, pypi and generic. If not provided, the package type of the repository is used.", components.SetMandatoryFalse()),
+	sbomPackageRepo:     components.NewStringFlag("package-repo", "The repository of the package to which the SBOM is attached as evidence.", components.SetMandatoryFalse()),
+	sbomPackageName:     components.NewStringFlag("package-name", "The name of the package to which the SBOM is attached as evidence, instead of --subject-repo-path. The name of a maven package is <groupId>:<artifactId>.", components.SetMandatoryFalse()),
+	sbomPackageVersion:  components.NewStringFlag("package-version", "The version of the package to which the SBOM is attached as evidence. The version of a docker image is its tag.", components.SetMandatoryFalse()),
+	sbomKey:             components.NewStringFlag("key", "Path to an unencrypted ECDSA, RSA or Ed25519 private key in PEM format, which signs the SBOM evidence. If not provided, the SBOM isn't attached as evidence.", components.SetMandatoryFalse()),
+	sbomKeyAlias:        components.NewStringFlag("key-alias", "The alias of the public key in the platform, which verifies the SBOM evidence.", components.SetMandatoryFalse()),
+	sbomOutputFormat:    components.NewStringFlag("output-format", "Defines the output format of the attached evidence. Acceptable values are: json, yaml and table. The json and yaml formats have a versioned schema.", components.SetMandatoryFalse()),
 }
 
 func GetCommandFlags(cmdKey string) []components.Flag {
@@ -6,6 +6,8 @@ import (
 	"path/filepath"
 	"strings"
 
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/credentials"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/specv2"
 	"github.com/jfrog/jfrog-cli-artifactory/cliutils/cmddefs"
 	"github.com/jfrog/jfrog-cli-artifactory/cliutils/flagkit"
 	distributionCommands "github.com/jfrog/jfrog-cli-artifactory/distribution/commands"
@@ -88,7 +90,7 @@ func releaseBundleCreateCmd(c *components.Context) error {
 	var releaseBundleCreateSpec *spec.SpecFiles
 	var err error
 	if c.IsFlagSet("spec") {
-		releaseBundleCreateSpec, err = cliutils.GetSpec(c, true, true)
+		releaseBundleCreateSpec, err = specv2.GetSpec(c, true, true)
 	} else {
 		releaseBundleCreateSpec = createDefaultReleaseBundleSpec(c)
 	}
@@ -130,7 +132,7 @@ func releaseBundleUpdateCmd(c *components.Context) error {
 	var releaseBundleUpdateSpec *spec.SpecFiles
 	var err error
 	if c.IsFlagSet("spec") {
-		releaseBundleUpdateSpec, err = cliutils.GetSpec(c, true, true)
+		releaseBundleUpdateSpec, err = specv2.GetSpec(c, true, true)
 	} else {
 		releaseBundleUpdateSpec = createDefaultReleaseBundleSpec(c)
 	}
@@ -282,7 +284,7 @@ func createReleaseBundleCreateUpdateParams(c *components.Context, bundleName, bu
 }
 
 func createDistributionDetailsByFlags(c *components.Context) (*config.ServerDetails, error) {
-	dsDetails, err := pluginsCommon.CreateServerDetailsWithConfigOffer(c, true, cliutils.Ds)
+	dsDetails, err := credentials.CreateServerDetailsWithConfigOffer(c, true, cliutils.Ds)
 	if err != nil {
 		return nil, err
 	}
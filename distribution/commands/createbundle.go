@@ -1,7 +1,7 @@
 package commands
 
 import (
-	"github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clientconfig"
 	"github.com/jfrog/jfrog-cli-core/v2/common/spec"
 	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
 	"github.com/jfrog/jfrog-client-go/distribution/services"
@@ -43,7 +43,7 @@ func (cb *CreateBundleCommand) SetDryRun(dryRun bool) *CreateBundleCommand {
 }
 
 func (cb *CreateBundleCommand) Run() error {
-	servicesManager, err := utils.CreateDistributionServiceManager(cb.serverDetails, cb.dryRun)
+	servicesManager, err := clientconfig.CreateDistributionServiceManager(cb.serverDetails, cb.dryRun)
 	if err != nil {
 		return err
 	}
@@ -5,7 +5,7 @@ import (
 	"fmt"
 	"github.com/jfrog/jfrog-client-go/utils/distribution"
 
-	"github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clientconfig"
 	"github.com/jfrog/jfrog-cli-core/v2/common/spec"
 	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
 	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
@@ -53,7 +53,7 @@ func (db *DeleteReleaseBundleCommand) SetQuiet(quiet bool) *DeleteReleaseBundleC
 }
 
 func (db *DeleteReleaseBundleCommand) Run() error {
-	servicesManager, err := utils.CreateDistributionServiceManager(db.serverDetails, db.dryRun)
+	servicesManager, err := clientconfig.CreateDistributionServiceManager(db.serverDetails, db.dryRun)
 	if err != nil {
 		return err
 	}
@@ -1,7 +1,7 @@
 package commands
 
 import (
-	"github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clientconfig"
 	"github.com/jfrog/jfrog-cli-core/v2/common/spec"
 	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
 	"github.com/jfrog/jfrog-client-go/utils/distribution"
@@ -57,7 +57,7 @@ func (db *DistributeReleaseBundleV1Command) SetAutoCreateRepo(autoCreateRepo boo
 }
 
 func (db *DistributeReleaseBundleV1Command) Run() error {
-	servicesManager, err := utils.CreateDistributionServiceManager(db.serverDetails, db.dryRun)
+	servicesManager, err := clientconfig.CreateDistributionServiceManager(db.serverDetails, db.dryRun)
 	if err != nil {
 		return err
 	}
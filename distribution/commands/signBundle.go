@@ -1,7 +1,7 @@
 package commands
 
 import (
-	"github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clientconfig"
 	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
 	"github.com/jfrog/jfrog-client-go/distribution/services"
 	clientutils "github.com/jfrog/jfrog-client-go/utils"
@@ -29,7 +29,7 @@ func (sb *SignBundleCommand) SetReleaseBundleSignParams(params services.SignBund
 }
 
 func (sb *SignBundleCommand) Run() error {
-	servicesManager, err := utils.CreateDistributionServiceManager(sb.serverDetails, false)
+	servicesManager, err := clientconfig.CreateDistributionServiceManager(sb.serverDetails, false)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,126 @@
+// Package attestation creates in-toto statements, signs them as DSSE envelopes and attaches them as evidence to
+// artifacts in Artifactory.
+package attestation
+
+import (
+	"crypto"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clientconfig"
+	"github.com/jfrog/jfrog-cli-artifactory/evidence/signing"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	evidenceServices "github.com/jfrog/jfrog-client-go/evidence/services"
+	clientutils "github.com/jfrog/jfrog-client-go/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+)
+
+const (
+	StatementType = "https://in-toto.io/Statement/v1"
+	// The payload type of DSSE envelopes which hold in-toto statements.
+	PayloadType = "application/vnd.in-toto+json"
+)
+
+// Statement is an in-toto statement about one or more subjects.
+type Statement struct {
+	Type          string    `json:"_type"`
+	Subject       []Subject `json:"subject"`
+	PredicateType string    `json:"predicateType"`
+	Predicate     any       `json:"predicate"`
+}
+
+type Subject struct {
+	Name   string            `json:"name"`
+	Digest map[string]string `json:"digest"`
+}
+
+// Envelope is a DSSE envelope, which signs the payload together with its type.
+type Envelope struct {
+	// The base64 encoded payload.
+	Payload     string      `json:"payload"`
+	PayloadType string      `json:"payloadType"`
+	Signatures  []Signature `json:"signatures"`
+}
+
+type Signature struct {
+	KeyId string `json:"keyid"`
+	// The base64 encoded signature.
+	Sig string `json:"sig"`
+}
+
+func NewStatement(predicateType string, predicate any, subjects ...Subject) *Statement {
+	return &Statement{Type: StatementType, Subject: subjects, PredicateType: predicateType, Predicate: predicate}
+}
+
+// Sign signs the statement as a DSSE envelope. The key ID is optional, and is used by Artifactory to find the public
+// key which verifies the signature.
+func Sign(statement *Statement, signer signing.Signer, keyId string) (*Envelope, error) {
+	payload, err := json.Marshal(statement)
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	signature, err := signer.Sign(preAuthEncoding(PayloadType, payload))
+	if err != nil {
+		return nil, err
+	}
+	return &Envelope{
+		Payload:     base64.StdEncoding.EncodeToString(payload),
+		PayloadType: PayloadType,
+		Signatures:  []Signature{{KeyId: keyId, Sig: base64.StdEncoding.EncodeToString(signature)}},
+	}, nil
+}
+
+// VerifyEnvelope verifies the signature of the envelope by the public key, and returns the statement it signs. The
+// envelope is verified if any of its signatures is made by the key.
+func VerifyEnvelope(envelope *Envelope, publicKey crypto.PublicKey) (*Statement, error) {
+	if envelope.PayloadType != PayloadType {
+		return nil, errorutils.CheckErrorf("the payload type of the envelope is '%s' rather than '%s'", envelope.PayloadType, PayloadType)
+	}
+	payload, err := base64.StdEncoding.DecodeString(envelope.Payload)
+	if err != nil {
+		return nil, errorutils.CheckErrorf("failed to decode the payload of the envelope: %s", err.Error())
+	}
+	verifyErr := errorutils.CheckErrorf("the envelope has no signatures")
+	for _, signature := range envelope.Signatures {
+		var sig []byte
+		if sig, err = base64.StdEncoding.DecodeString(signature.Sig); err != nil {
+			verifyErr = errorutils.CheckErrorf("failed to decode a signature of the envelope: %s", err.Error())
+			continue
+		}
+		if verifyErr = signing.Verify(publicKey, preAuthEncoding(envelope.PayloadType, payload), sig); verifyErr == nil {
+			break
+		}
+	}
+	if verifyErr != nil {
+		return nil, verifyErr
+	}
+	statement := new(Statement)
+	if err = json.Unmarshal(payload, statement); err != nil {
+		return nil, errorutils.CheckErrorf("failed to parse the statement of the envelope: %s", err.Error())
+	}
+	return statement, nil
+}
+
+// preAuthEncoding returns the bytes which are signed by DSSE, as defined by the DSSE protocol.
+func preAuthEncoding(payloadType string, payload []byte) []byte {
+	return append([]byte(fmt.Sprintf("DSSEv1 %d %s %d ", len(payloadType), payloadType, len(payload))), payload...)
+}
+
+// Upload attaches the signed envelope as evidence to the artifact in the repository path.
+func Upload(serverDetails *config.ServerDetails, subjectRepoPath string, envelope *Envelope) error {
+	content, err := json.Marshal(envelope)
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	evidenceDetails := *serverDetails
+	if evidenceDetails.EvidenceUrl == "" {
+		evidenceDetails.EvidenceUrl = clientutils.AddTrailingSlashIfNeeded(serverDetails.Url) + "evidence/"
+	}
+	evidenceManager, err := clientconfig.CreateEvidenceServiceManager(&evidenceDetails, false)
+	if err != nil {
+		return err
+	}
+	_, err = evidenceManager.UploadEvidence(evidenceServices.EvidenceDetails{SubjectUri: subjectRepoPath, DSSEFileRaw: content})
+	return err
+}
@@ -0,0 +1,75 @@
+package attestation
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-artifactory/evidence/signing"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSign(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	keyBytes, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+	keyPath := filepath.Join(t.TempDir(), "private.pem")
+	require.NoError(t, os.WriteFile(keyPath, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyBytes}), 0600))
+	signer, err := signing.LoadSigner(keyPath)
+	require.NoError(t, err)
+
+	statement := NewStatement("https://slsa.dev/provenance/v1", map[string]string{"builder": "test"},
+		Subject{Name: "pkg:npm/left-pad@1.0.0", Digest: map[string]string{"sha256": "abc"}})
+	envelope, err := Sign(statement, signer, "my-key")
+	require.NoError(t, err)
+	assert.Equal(t, PayloadType, envelope.PayloadType)
+	require.Len(t, envelope.Signatures, 1)
+	assert.Equal(t, "my-key", envelope.Signatures[0].KeyId)
+
+	payload, err := base64.StdEncoding.DecodeString(envelope.Payload)
+	require.NoError(t, err)
+	var decoded Statement
+	require.NoError(t, json.Unmarshal(payload, &decoded))
+	assert.Equal(t, StatementType, decoded.Type)
+	assert.Equal(t, statement.Subject, decoded.Subject)
+
+	signature, err := base64.StdEncoding.DecodeString(envelope.Signatures[0].Sig)
+	require.NoError(t, err)
+	assert.NoError(t, signing.Verify(key.Public(), preAuthEncoding(PayloadType, payload), signature))
+	assert.Error(t, signing.Verify(key.Public(), payload, signature))
+}
+
+func TestUpload(t *testing.T) {
+	var uploadedPath string
+	var uploaded Envelope
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		uploadedPath = r.URL.Path
+		content, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		assert.NoError(t, json.Unmarshal(content, &uploaded))
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer testServer.Close()
+
+	envelope := &Envelope{Payload: "e30=", PayloadType: PayloadType, Signatures: []Signature{{Sig: "c2ln"}}}
+	require.NoError(t, Upload(&config.ServerDetails{Url: testServer.URL + "/"}, "npm-local/left-pad/-/left-pad-1.0.0.tgz", envelope))
+	assert.Equal(t, "/evidence/api/v1/subject/npm-local/left-pad/-/left-pad-1.0.0.tgz", uploadedPath)
+	assert.Equal(t, *envelope, uploaded)
+}
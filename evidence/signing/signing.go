@@ -0,0 +1,182 @@
+// Package signing loads the keys which sign evidence and container signatures, so that a single key pair covers both.
+package signing
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"strings"
+
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+)
+
+// Signer signs payloads with a private key. ECDSA and RSA keys sign the SHA-256 digest of the payload, and Ed25519
+// keys sign the payload itself.
+type Signer interface {
+	Sign(payload []byte) ([]byte, error)
+	Public() crypto.PublicKey
+}
+
+type keySigner struct {
+	key crypto.Signer
+}
+
+func (ks *keySigner) Sign(payload []byte) ([]byte, error) {
+	if _, isEd25519 := ks.key.(ed25519.PrivateKey); isEd25519 {
+		signature, err := ks.key.Sign(rand.Reader, payload, crypto.Hash(0))
+		return signature, errorutils.CheckError(err)
+	}
+	digest := sha256.Sum256(payload)
+	signature, err := ks.key.Sign(rand.Reader, digest[:], crypto.SHA256)
+	return signature, errorutils.CheckError(err)
+}
+
+func (ks *keySigner) Public() crypto.PublicKey {
+	return ks.key.Public()
+}
+
+// The types of the key pairs which GenerateKey creates.
+const (
+	Ed25519Key = "ed25519"
+	EcdsaKey   = "ecdsa"
+	RsaKey     = "rsa"
+)
+
+// GenerateKey creates a key pair, and returns the unencrypted private key in PKCS #8 PEM format and the public key in
+// PKIX PEM format. ECDSA keys use the P-256 curve, and RSA keys are 3072 bits long.
+func GenerateKey(keyType string) (privatePem, publicPem []byte, err error) {
+	var key crypto.Signer
+	switch strings.ToLower(keyType) {
+	case Ed25519Key:
+		_, key, err = ed25519.GenerateKey(rand.Reader)
+	case EcdsaKey:
+		key, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	case RsaKey:
+		key, err = rsa.GenerateKey(rand.Reader, 3072)
+	default:
+		return nil, nil, errorutils.CheckErrorf("the key type '%s' isn't supported. The supported types are %s, %s and %s", keyType, Ed25519Key, EcdsaKey, RsaKey)
+	}
+	if err != nil {
+		return nil, nil, errorutils.CheckError(err)
+	}
+	privateBytes, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return nil, nil, errorutils.CheckError(err)
+	}
+	publicPem, err = EncodePublicKey(key.Public())
+	if err != nil {
+		return nil, nil, err
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: privateBytes}), publicPem, nil
+}
+
+// EncodePublicKey encodes a public key in PKIX PEM format.
+func EncodePublicKey(publicKey crypto.PublicKey) ([]byte, error) {
+	publicBytes, err := x509.MarshalPKIXPublicKey(publicKey)
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicBytes}), nil
+}
+
+// LoadSigner loads an unencrypted ECDSA, RSA or Ed25519 private key from a PEM file.
+func LoadSigner(keyPath string) (Signer, error) {
+	block, err := readPemBlock(keyPath)
+	if err != nil {
+		return nil, err
+	}
+	var key any
+	switch block.Type {
+	case "PRIVATE KEY":
+		key, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	case "EC PRIVATE KEY":
+		key, err = x509.ParseECPrivateKey(block.Bytes)
+	case "RSA PRIVATE KEY":
+		key, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	default:
+		if strings.Contains(block.Type, "ENCRYPTED") {
+			return nil, errorutils.CheckErrorf("the key '%s' is encrypted. Only unencrypted PEM keys are supported", keyPath)
+		}
+		return nil, errorutils.CheckErrorf("the file '%s' holds a %s rather than a private key", keyPath, block.Type)
+	}
+	if err != nil {
+		return nil, errorutils.CheckErrorf("failed to parse the private key '%s': %s", keyPath, err.Error())
+	}
+	switch signer := key.(type) {
+	case *ecdsa.PrivateKey, *rsa.PrivateKey, ed25519.PrivateKey:
+		return &keySigner{key: signer.(crypto.Signer)}, nil
+	}
+	return nil, errorutils.CheckErrorf("the key type of '%s' isn't supported. The supported types are ECDSA, RSA and Ed25519", keyPath)
+}
+
+// LoadPublicKey loads a public key from a PEM file. The public key of a private key file is loaded as well.
+func LoadPublicKey(keyPath string) (crypto.PublicKey, error) {
+	block, err := readPemBlock(keyPath)
+	if err != nil {
+		return nil, err
+	}
+	if block.Type != "PUBLIC KEY" {
+		signer, err := LoadSigner(keyPath)
+		if err != nil {
+			return nil, err
+		}
+		return signer.Public(), nil
+	}
+	publicKey, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, errorutils.CheckErrorf("failed to parse the public key '%s': %s", keyPath, err.Error())
+	}
+	return publicKey, nil
+}
+
+// ParsePublicKey parses a public key in PEM format, such as a trusted key of the platform.
+func ParsePublicKey(content []byte) (crypto.PublicKey, error) {
+	block, _ := pem.Decode(content)
+	if block == nil || block.Type != "PUBLIC KEY" {
+		return nil, errorutils.CheckErrorf("the content isn't a PEM public key")
+	}
+	publicKey, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, errorutils.CheckErrorf("failed to parse the public key: %s", err.Error())
+	}
+	return publicKey, nil
+}
+
+// Verify verifies a signature of the payload, made by the Signer of the private key.
+func Verify(publicKey crypto.PublicKey, payload, signature []byte) error {
+	digest := sha256.Sum256(payload)
+	verified := false
+	switch key := publicKey.(type) {
+	case *ecdsa.PublicKey:
+		verified = ecdsa.VerifyASN1(key, digest[:], signature)
+	case *rsa.PublicKey:
+		verified = rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], signature) == nil
+	case ed25519.PublicKey:
+		verified = ed25519.Verify(key, payload, signature)
+	default:
+		return errorutils.CheckErrorf("unsupported public key type %T", publicKey)
+	}
+	if !verified {
+		return errorutils.CheckErrorf("invalid signature")
+	}
+	return nil
+}
+
+func readPemBlock(keyPath string) (*pem.Block, error) {
+	content, err := os.ReadFile(keyPath)
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	block, _ := pem.Decode(content)
+	if block == nil {
+		return nil, errorutils.CheckErrorf("the file '%s' isn't a PEM key", keyPath)
+	}
+	return block, nil
+}
@@ -0,0 +1,95 @@
+package signing
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writePemKeys(t *testing.T, privateKey crypto.Signer) (privateKeyPath, publicKeyPath string) {
+	dir := t.TempDir()
+	privateBytes, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	require.NoError(t, err)
+	publicBytes, err := x509.MarshalPKIXPublicKey(privateKey.Public())
+	require.NoError(t, err)
+	privateKeyPath = filepath.Join(dir, "private.pem")
+	publicKeyPath = filepath.Join(dir, "public.pem")
+	require.NoError(t, os.WriteFile(privateKeyPath, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: privateBytes}), 0600))
+	require.NoError(t, os.WriteFile(publicKeyPath, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicBytes}), 0644))
+	return
+}
+
+func TestSignVerify(t *testing.T) {
+	ecdsaKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	_, ed25519Key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	payload := []byte(`{"critical":{}}`)
+	for name, key := range map[string]crypto.Signer{"ecdsa": ecdsaKey, "rsa": rsaKey, "ed25519": ed25519Key} {
+		t.Run(name, func(t *testing.T) {
+			privateKeyPath, publicKeyPath := writePemKeys(t, key)
+			signer, err := LoadSigner(privateKeyPath)
+			require.NoError(t, err)
+			signature, err := signer.Sign(payload)
+			require.NoError(t, err)
+
+			publicKey, err := LoadPublicKey(publicKeyPath)
+			require.NoError(t, err)
+			assert.NoError(t, Verify(publicKey, payload, signature))
+			assert.ErrorContains(t, Verify(publicKey, []byte(`{"critical":{"tampered":true}}`), signature), "invalid signature")
+
+			// The public key of a private key file is loaded as well.
+			publicKey, err = LoadPublicKey(privateKeyPath)
+			require.NoError(t, err)
+			assert.NoError(t, Verify(publicKey, payload, signature))
+		})
+	}
+}
+
+func TestLoadSignerErrors(t *testing.T) {
+	dir := t.TempDir()
+	encryptedKeyPath := filepath.Join(dir, "cosign.key")
+	require.NoError(t, os.WriteFile(encryptedKeyPath, pem.EncodeToMemory(&pem.Block{Type: "ENCRYPTED SIGSTORE PRIVATE KEY", Bytes: []byte("key")}), 0600))
+	_, err := LoadSigner(encryptedKeyPath)
+	assert.ErrorContains(t, err, "is encrypted")
+
+	notPemPath := filepath.Join(dir, "key.txt")
+	require.NoError(t, os.WriteFile(notPemPath, []byte("key"), 0600))
+	_, err = LoadSigner(notPemPath)
+	assert.ErrorContains(t, err, "isn't a PEM key")
+}
+
+func TestGenerateKey(t *testing.T) {
+	for _, keyType := range []string{Ed25519Key, EcdsaKey} {
+		t.Run(keyType, func(t *testing.T) {
+			privatePem, publicPem, err := GenerateKey(keyType)
+			require.NoError(t, err)
+			privateKeyPath := filepath.Join(t.TempDir(), "evidence.key")
+			require.NoError(t, os.WriteFile(privateKeyPath, privatePem, 0600))
+			signer, err := LoadSigner(privateKeyPath)
+			require.NoError(t, err)
+			signature, err := signer.Sign([]byte("payload"))
+			require.NoError(t, err)
+
+			publicKey, err := ParsePublicKey(publicPem)
+			require.NoError(t, err)
+			assert.NoError(t, Verify(publicKey, []byte("payload"), signature))
+		})
+	}
+	_, _, err := GenerateKey("dsa")
+	assert.ErrorContains(t, err, "isn't supported")
+}
@@ -0,0 +1,248 @@
+// Package subject resolves the artifacts which evidence is attached to.
+package subject
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clierrors"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// The package types whose versions can be resolved to a subject.
+const (
+	Docker  = "docker"
+	Maven   = "maven"
+	Npm     = "npm"
+	Pypi    = "pypi"
+	Generic = "generic"
+)
+
+// The manifest media types which are accepted when resolving the digest of a docker image.
+var dockerManifestTypes = []string{
+	"application/vnd.oci.image.index.v1+json",
+	"application/vnd.docker.distribution.manifest.list.v2+json",
+	"application/vnd.oci.image.manifest.v1+json",
+	"application/vnd.docker.distribution.manifest.v2+json",
+}
+
+// Package is a package version in a repository, by its coordinates. The name of a maven package is its
+// <groupId>:<artifactId>, and the version of a docker image is its tag.
+type Package struct {
+	// Optional - detected by the package type of the repository if empty.
+	Type    string `yaml:"type,omitempty" json:"type,omitempty"`
+	Repo    string `yaml:"repo" json:"repo"`
+	Name    string `yaml:"name" json:"name"`
+	Version string `yaml:"version" json:"version"`
+}
+
+func (p Package) String() string {
+	return p.Name + "@" + p.Version + " in " + p.Repo
+}
+
+// NewPackage returns the package of the coordinates given by the package options, or nil if none is given. The
+// repository, name and version are required once any coordinate is given.
+func NewPackage(pkgType, repo, name, version string) (*Package, error) {
+	pkg := &Package{Type: pkgType, Repo: repo, Name: name, Version: version}
+	if *pkg == (Package{}) {
+		return nil, nil
+	}
+	if pkg.Repo == "" || pkg.Name == "" || pkg.Version == "" {
+		return nil, errorutils.CheckErrorf("the --package-repo, --package-name and --package-version options are required to attach evidence to a package")
+	}
+	return pkg, nil
+}
+
+// ResolvePackage returns the repository path and the SHA-256 checksum of the artifact which represents the package
+// version, through the native API of its package type: the manifest of a docker image, the main artifact of a maven
+// version, the tarball of an npm version, the distribution of a pypi version, or the single file under
+// <name>/<version>/ of a generic repository.
+func ResolvePackage(servicesManager artifactory.ArtifactoryServicesManager, pkg Package) (repoPath, sha256 string, err error) {
+	if pkg.Repo == "" || pkg.Name == "" || pkg.Version == "" {
+		return "", "", errorutils.CheckErrorf("the repository, name and version of the package are required")
+	}
+	if pkg.Type == "" {
+		if pkg.Type, err = getRepoPackageType(servicesManager, pkg.Repo); err != nil {
+			return "", "", err
+		}
+	}
+	switch strings.ToLower(pkg.Type) {
+	case Docker:
+		repoPath, sha256, err = resolveDockerImage(servicesManager, pkg)
+	case Maven:
+		repoPath, err = resolveMavenArtifact(servicesManager, pkg)
+	case Npm:
+		repoPath, err = resolveNpmTarball(servicesManager, pkg)
+	case Pypi:
+		repoPath, err = resolveSingleItem(servicesManager, pkg, fmt.Sprintf(`{"repo":%q,"@pypi.name":%q,"@pypi.version":%q}`, pkg.Repo, pkg.Name, pkg.Version))
+	case Generic:
+		repoPath, err = resolveSingleItem(servicesManager, pkg, fmt.Sprintf(`{"repo":%q,"path":%q}`, pkg.Repo, pkg.Name+"/"+pkg.Version))
+	default:
+		return "", "", errorutils.CheckErrorf("resolving %s packages isn't supported. The supported package types are %s, %s, %s, %s and %s",
+			pkg.Type, Docker, Maven, Npm, Pypi, Generic)
+	}
+	if err != nil || sha256 != "" {
+		return repoPath, sha256, err
+	}
+	fileInfo, err := servicesManager.FileInfo(repoPath)
+	if err != nil {
+		return "", "", err
+	}
+	log.Debug("Resolved", pkg.String(), "to", repoPath)
+	return repoPath, fileInfo.Checksums.Sha256, nil
+}
+
+func getRepoPackageType(servicesManager artifactory.ArtifactoryServicesManager, repo string) (string, error) {
+	var repoDetails struct {
+		PackageType string `json:"packageType"`
+	}
+	if err := servicesManager.GetRepository(repo, &repoDetails); err != nil {
+		return "", err
+	}
+	return repoDetails.PackageType, nil
+}
+
+// Resolves the image to its manifest, or to its manifest list for a multi-platform image, whose digest is the SHA-256
+// checksum of the manifest file.
+func resolveDockerImage(servicesManager artifactory.ArtifactoryServicesManager, pkg Package) (string, string, error) {
+	serviceDetails := servicesManager.GetConfig().GetServiceDetails()
+	httpClientDetails := serviceDetails.CreateHttpClientDetails()
+	httpClientDetails.Headers["Accept"] = strings.Join(dockerManifestTypes, ", ")
+	manifestUrl := fmt.Sprintf("%sapi/docker/%s/v2/%s/manifests/%s", serviceDetails.GetUrl(), url.PathEscape(pkg.Repo), pkg.Name, url.PathEscape(pkg.Version))
+	resp, body, err := servicesManager.Client().SendHead(manifestUrl, &httpClientDetails)
+	if err != nil {
+		return "", "", err
+	}
+	if err = clierrors.CheckResponseStatusWithBody(resp, body, http.StatusOK); err != nil {
+		return "", "", err
+	}
+	digest := resp.Header.Get("Docker-Content-Digest")
+	if !strings.HasPrefix(digest, "sha256:") {
+		return "", "", errorutils.CheckErrorf("the manifest of %s has no sha256 digest", pkg.String())
+	}
+	manifestFile := "manifest.json"
+	if contentType := resp.Header.Get("Content-Type"); strings.Contains(contentType, "list") || strings.Contains(contentType, "index") {
+		manifestFile = "list.manifest.json"
+	}
+	return path.Join(pkg.Repo, pkg.Name, pkg.Version, manifestFile), strings.TrimPrefix(digest, "sha256:"), nil
+}
+
+// Resolves the GAV to its main artifact, such as its jar, or to its pom if it has none.
+func resolveMavenArtifact(servicesManager artifactory.ArtifactoryServicesManager, pkg Package) (string, error) {
+	groupId, artifactId, found := strings.Cut(pkg.Name, ":")
+	if !found || groupId == "" || artifactId == "" {
+		return "", errorutils.CheckErrorf("the name of a maven package should be <groupId>:<artifactId>, but got '%s'", pkg.Name)
+	}
+	serviceDetails := servicesManager.GetConfig().GetServiceDetails()
+	httpClientDetails := serviceDetails.CreateHttpClientDetails()
+	query := url.Values{"g": {groupId}, "a": {artifactId}, "v": {pkg.Version}, "repos": {pkg.Repo}}
+	resp, body, _, err := servicesManager.Client().SendGet(serviceDetails.GetUrl()+"api/search/gavc?"+query.Encode(), true, &httpClientDetails)
+	if err != nil {
+		return "", err
+	}
+	if err = clierrors.CheckResponseStatusWithBody(resp, body, http.StatusOK); err != nil {
+		return "", err
+	}
+	var result struct {
+		Results []struct {
+			Uri string `json:"uri"`
+		} `json:"results"`
+	}
+	if err = json.Unmarshal(body, &result); err != nil {
+		return "", errorutils.CheckError(err)
+	}
+	baseName := artifactId + "-" + pkg.Version + "."
+	var pomPath string
+	for _, item := range result.Results {
+		// The URIs are storage API URIs of the artifacts.
+		_, repoPath, found := strings.Cut(item.Uri, "/api/storage/")
+		name := path.Base(repoPath)
+		if !found || !strings.HasPrefix(name, baseName) {
+			continue
+		}
+		switch extension := strings.TrimPrefix(name, baseName); extension {
+		case "pom":
+			pomPath = repoPath
+		case "md5", "sha1", "sha256", "sha512", "asc":
+		default:
+			if !strings.Contains(extension, ".") {
+				return repoPath, nil
+			}
+		}
+	}
+	if pomPath == "" {
+		return "", errorutils.CheckErrorf("%s was not found", pkg.String())
+	}
+	return pomPath, nil
+}
+
+// Resolves the npm package version to its tarball, by the metadata of the version.
+func resolveNpmTarball(servicesManager artifactory.ArtifactoryServicesManager, pkg Package) (string, error) {
+	serviceDetails := servicesManager.GetConfig().GetServiceDetails()
+	httpClientDetails := serviceDetails.CreateHttpClientDetails()
+	// The slash of a scoped package name is kept, as the npm registry API expects.
+	apiPrefix := "api/npm/" + pkg.Repo + "/"
+	resp, body, _, err := servicesManager.Client().SendGet(serviceDetails.GetUrl()+apiPrefix+pkg.Name+"/"+url.PathEscape(pkg.Version), true, &httpClientDetails)
+	if err != nil {
+		return "", err
+	}
+	if err = clierrors.CheckResponseStatusWithBody(resp, body, http.StatusOK); err != nil {
+		return "", err
+	}
+	var metadata struct {
+		Dist struct {
+			Tarball string `json:"tarball"`
+		} `json:"dist"`
+	}
+	if err = json.Unmarshal(body, &metadata); err != nil {
+		return "", errorutils.CheckError(err)
+	}
+	_, tarballPath, found := strings.Cut(metadata.Dist.Tarball, "/"+apiPrefix)
+	if !found {
+		return "", errorutils.CheckErrorf("the tarball URL '%s' of %s isn't in the repository", metadata.Dist.Tarball, pkg.String())
+	}
+	tarballPath, err = url.PathUnescape(tarballPath)
+	if err != nil {
+		return "", errorutils.CheckError(err)
+	}
+	return pkg.Repo + "/" + tarballPath, nil
+}
+
+// Resolves the package version to the single file which matches the AQL criteria.
+func resolveSingleItem(servicesManager artifactory.ArtifactoryServicesManager, pkg Package, criteria string) (repoPath string, err error) {
+	reader, err := servicesManager.Aql(fmt.Sprintf(`items.find(%s).include("repo","path","name")`, criteria))
+	if err != nil {
+		return "", err
+	}
+	defer func() {
+		err = errors.Join(err, errorutils.CheckError(reader.Close()))
+	}()
+	var result struct {
+		Results []struct {
+			Repo string `json:"repo"`
+			Path string `json:"path"`
+			Name string `json:"name"`
+		} `json:"results"`
+	}
+	if err = json.NewDecoder(reader).Decode(&result); err != nil {
+		return "", errorutils.CheckError(err)
+	}
+	var repoPaths []string
+	for _, item := range result.Results {
+		repoPaths = append(repoPaths, path.Join(item.Repo, item.Path, item.Name))
+	}
+	switch len(repoPaths) {
+	case 0:
+		return "", errorutils.CheckErrorf("%s was not found", pkg.String())
+	case 1:
+		return repoPaths[0], nil
+	}
+	return "", errorutils.CheckErrorf("%s has %d files: %s. Provide the repository path of the subject instead", pkg.String(), len(repoPaths), strings.Join(repoPaths, ", "))
+}
@@ -0,0 +1,100 @@
+package subject
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	rtUtils "github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolvePackage(t *testing.T) {
+	var testServerUrl string
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body string
+		switch {
+		case r.URL.Path == "/api/repositories/npm-local":
+			body = `{"key":"npm-local","packageType":"npm"}`
+		case r.URL.Path == "/api/docker/docker-local/v2/org/app/manifests/1.0":
+			w.Header().Set("Docker-Content-Digest", "sha256:manifest")
+			w.Header().Set("Content-Type", "application/vnd.oci.image.index.v1+json")
+		case r.URL.Path == "/api/search/gavc":
+			assert.Equal(t, "org.acme", r.URL.Query().Get("g"))
+			body = `{"results":[
+				{"uri":"` + testServerUrl + `/api/storage/maven-local/org/acme/app/1.0/app-1.0.pom"},
+				{"uri":"` + testServerUrl + `/api/storage/maven-local/org/acme/app/1.0/app-1.0.jar.sha1"},
+				{"uri":"` + testServerUrl + `/api/storage/maven-local/org/acme/app/1.0/app-1.0.jar"}]}`
+		case r.URL.Path == "/api/npm/npm-local/@acme/app/1.2.3":
+			body = `{"dist":{"tarball":"` + testServerUrl + `/api/npm/npm-local/@acme/app/-/@acme/app-1.2.3.tgz"}}`
+		case r.URL.Path == "/api/search/aql":
+			query, err := io.ReadAll(r.Body)
+			assert.NoError(t, err)
+			switch {
+			case strings.Contains(string(query), `"@pypi.version":"2.0"`):
+				body = `{"results":[{"repo":"pypi-local","path":"app/2.0","name":"app-2.0.tar.gz"},{"repo":"pypi-local","path":"app/2.0","name":"app-2.0-py3-none-any.whl"}]}`
+			case strings.Contains(string(query), `"@pypi.version":"1.0"`):
+				body = `{"results":[{"repo":"pypi-local","path":"app/1.0","name":"app-1.0-py3-none-any.whl"}]}`
+			default:
+				body = `{"results":[]}`
+			}
+		case strings.HasPrefix(r.URL.Path, "/api/storage/"):
+			body = `{"checksums":{"sha256":"sha256-of-` + strings.TrimPrefix(r.URL.Path, "/api/storage/") + `"}}`
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, err := w.Write([]byte(body))
+		assert.NoError(t, err)
+	}))
+	defer testServer.Close()
+	testServerUrl = testServer.URL
+	servicesManager, err := rtUtils.CreateServiceManager(&config.ServerDetails{ArtifactoryUrl: testServer.URL + "/"}, 0, 0, false)
+	require.NoError(t, err)
+
+	tests := []struct {
+		pkg              Package
+		expectedRepoPath string
+		expectedSha256   string
+	}{
+		// The digest of a multi-platform image is the checksum of its manifest list
+		{Package{Docker, "docker-local", "org/app", "1.0"}, "docker-local/org/app/1.0/list.manifest.json", "manifest"},
+		// The jar is preferred over the pom, and the checksum files are skipped
+		{Package{Maven, "maven-local", "org.acme:app", "1.0"}, "maven-local/org/acme/app/1.0/app-1.0.jar", "sha256-of-maven-local/org/acme/app/1.0/app-1.0.jar"},
+		// The package type is detected by the repository
+		{Package{"", "npm-local", "@acme/app", "1.2.3"}, "npm-local/@acme/app/-/@acme/app-1.2.3.tgz", "sha256-of-npm-local/@acme/app/-/@acme/app-1.2.3.tgz"},
+		{Package{Pypi, "pypi-local", "app", "1.0"}, "pypi-local/app/1.0/app-1.0-py3-none-any.whl", "sha256-of-pypi-local/app/1.0/app-1.0-py3-none-any.whl"},
+	}
+	for _, test := range tests {
+		t.Run(test.pkg.String(), func(t *testing.T) {
+			repoPath, sha256, err := ResolvePackage(servicesManager, test.pkg)
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedRepoPath, repoPath)
+			assert.Equal(t, test.expectedSha256, sha256)
+		})
+	}
+
+	_, _, err = ResolvePackage(servicesManager, Package{Pypi, "pypi-local", "app", "2.0"})
+	assert.ErrorContains(t, err, "has 2 files")
+	_, _, err = ResolvePackage(servicesManager, Package{Generic, "generic-local", "app", "1.0"})
+	assert.ErrorContains(t, err, "was not found")
+	_, _, err = ResolvePackage(servicesManager, Package{Maven, "maven-local", "app", "1.0"})
+	assert.ErrorContains(t, err, "<groupId>:<artifactId>")
+	_, _, err = ResolvePackage(servicesManager, Package{"conan", "conan-local", "app", "1.0"})
+	assert.ErrorContains(t, err, "isn't supported")
+}
+
+func TestNewPackage(t *testing.T) {
+	pkg, err := NewPackage("", "", "", "")
+	assert.NoError(t, err)
+	assert.Nil(t, pkg)
+	pkg, err = NewPackage("", "npm-local", "app", "1.0")
+	assert.NoError(t, err)
+	assert.Equal(t, &Package{Repo: "npm-local", Name: "app", Version: "1.0"}, pkg)
+	_, err = NewPackage(Npm, "npm-local", "app", "")
+	assert.ErrorContains(t, err, "--package-version")
+}
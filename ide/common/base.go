@@ -5,8 +5,8 @@ import (
 	"net/url"
 	"strings"
 
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/credentials"
 	"github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
-	pluginsCommon "github.com/jfrog/jfrog-cli-core/v2/plugins/common"
 	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
 	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
 	"github.com/jfrog/jfrog-client-go/utils/log"
@@ -15,7 +15,7 @@ import (
 // GetServerDetails retrieves server configuration from flags or default config
 func GetServerDetails(c *components.Context) (*config.ServerDetails, error) {
 	if HasServerConfigFlags(c) {
-		return pluginsCommon.CreateArtifactoryDetailsByFlags(c)
+		return credentials.CreateArtifactoryDetailsByFlags(c)
 	}
 	rtDetails, err := config.GetDefaultServerConf()
 	if err != nil {
@@ -24,7 +24,7 @@ func GetServerDetails(c *components.Context) (*config.ServerDetails, error) {
 	if rtDetails.ArtifactoryUrl == "" && rtDetails.Url == "" {
 		return nil, fmt.Errorf("no Artifactory URL configured")
 	}
-	return rtDetails, nil
+	return rtDetails, credentials.Resolve(rtDetails)
 }
 
 // HasServerConfigFlags checks if any server configuration flags are provided
@@ -6,23 +6,34 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/jfrog/jfrog-cli-artifactory/artifactory/cli"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/formats"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/specv2"
 	rbsearch "github.com/jfrog/jfrog-cli-artifactory/lifecycle/docs/rbsearch"
 
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/credentials"
 	"github.com/jfrog/jfrog-cli-artifactory/cliutils/cmddefs"
 	"github.com/jfrog/jfrog-cli-artifactory/cliutils/distribution"
 	"github.com/jfrog/jfrog-cli-artifactory/cliutils/flagkit"
 	lifecycle "github.com/jfrog/jfrog-cli-artifactory/lifecycle/commands"
 	rbAnnotate "github.com/jfrog/jfrog-cli-artifactory/lifecycle/docs/annotate"
+	rbContents "github.com/jfrog/jfrog-cli-artifactory/lifecycle/docs/contents"
 	rbCreate "github.com/jfrog/jfrog-cli-artifactory/lifecycle/docs/create"
 	rbDeleteLocal "github.com/jfrog/jfrog-cli-artifactory/lifecycle/docs/deletelocal"
 	rbDeleteRemote "github.com/jfrog/jfrog-cli-artifactory/lifecycle/docs/deleteremote"
+	rbDiff "github.com/jfrog/jfrog-cli-artifactory/lifecycle/docs/diff"
 	rbDistribute "github.com/jfrog/jfrog-cli-artifactory/lifecycle/docs/distribute"
+	rbDistributionStatus "github.com/jfrog/jfrog-cli-artifactory/lifecycle/docs/distributionstatus"
 	rbExport "github.com/jfrog/jfrog-cli-artifactory/lifecycle/docs/export"
 	rbFinalize "github.com/jfrog/jfrog-cli-artifactory/lifecycle/docs/finalize"
 	rbImport "github.com/jfrog/jfrog-cli-artifactory/lifecycle/docs/importbundle"
 	rbPromote "github.com/jfrog/jfrog-cli-artifactory/lifecycle/docs/promote"
+	signingKeyDelete "github.com/jfrog/jfrog-cli-artifactory/lifecycle/docs/signingkeydelete"
+	signingKeyList "github.com/jfrog/jfrog-cli-artifactory/lifecycle/docs/signingkeylist"
+	signingKeyPropagate "github.com/jfrog/jfrog-cli-artifactory/lifecycle/docs/signingkeypropagate"
+	signingKeyUpload "github.com/jfrog/jfrog-cli-artifactory/lifecycle/docs/signingkeyupload"
 	rbUpdate "github.com/jfrog/jfrog-cli-artifactory/lifecycle/docs/update"
 	artifactoryUtils "github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
 	commonCliUtils "github.com/jfrog/jfrog-cli-core/v2/common/cliutils"
@@ -90,6 +101,15 @@ func GetCommands() []components.Command {
 			Category:    lcCategory,
 			Action:      distribute,
 		},
+		{
+			Name:        cmddefs.ReleaseBundleDistributionStatus,
+			Aliases:     []string{"rbds"},
+			Flags:       flagkit.GetCommandFlags(cmddefs.ReleaseBundleDistributionStatus),
+			Description: rbDistributionStatus.GetDescription(),
+			Arguments:   rbDistributionStatus.GetArguments(),
+			Category:    lcCategory,
+			Action:      distributionStatus,
+		},
 		{
 			Name:        "release-bundle-export",
 			Aliases:     []string{"rbe"},
@@ -144,6 +164,59 @@ func GetCommands() []components.Command {
 			Category:    lcCategory,
 			Action:      releaseBundleSearch,
 		},
+		{
+			Name:        cmddefs.ReleaseBundleContents,
+			Aliases:     []string{"rbls"},
+			Flags:       flagkit.GetCommandFlags(cmddefs.ReleaseBundleContents),
+			Description: rbContents.GetDescription(),
+			Arguments:   rbContents.GetArguments(),
+			Category:    lcCategory,
+			Action:      releaseBundleContents,
+		},
+		{
+			Name:        cmddefs.ReleaseBundleDiff,
+			Aliases:     []string{"rbdiff"},
+			Flags:       flagkit.GetCommandFlags(cmddefs.ReleaseBundleDiff),
+			Description: rbDiff.GetDescription(),
+			Arguments:   rbDiff.GetArguments(),
+			Category:    lcCategory,
+			Action:      releaseBundleDiff,
+		},
+		{
+			Name:        cmddefs.SigningKeyUpload,
+			Aliases:     []string{"sku"},
+			Flags:       flagkit.GetCommandFlags(cmddefs.SigningKeyUpload),
+			Description: signingKeyUpload.GetDescription(),
+			Arguments:   signingKeyUpload.GetArguments(),
+			Category:    lcCategory,
+			Action:      signingKeyUploadCmd,
+		},
+		{
+			Name:        cmddefs.SigningKeyList,
+			Aliases:     []string{"skl"},
+			Flags:       flagkit.GetCommandFlags(cmddefs.SigningKeyList),
+			Description: signingKeyList.GetDescription(),
+			Category:    lcCategory,
+			Action:      signingKeyListCmd,
+		},
+		{
+			Name:        cmddefs.SigningKeyPropagate,
+			Aliases:     []string{"skp"},
+			Flags:       flagkit.GetCommandFlags(cmddefs.SigningKeyPropagate),
+			Description: signingKeyPropagate.GetDescription(),
+			Arguments:   signingKeyPropagate.GetArguments(),
+			Category:    lcCategory,
+			Action:      signingKeyPropagateCmd,
+		},
+		{
+			Name:        cmddefs.SigningKeyDelete,
+			Aliases:     []string{"skdel"},
+			Flags:       flagkit.GetCommandFlags(cmddefs.SigningKeyDelete),
+			Description: signingKeyDelete.GetDescription(),
+			Arguments:   signingKeyDelete.GetArguments(),
+			Category:    lcCategory,
+			Action:      signingKeyDeleteCmd,
+		},
 	}
 }
 
@@ -152,13 +225,31 @@ func validateCreateReleaseBundleContext(c *components.Context) error {
 		return err
 	}
 
-	if len(c.Arguments) != 2 {
-		return pluginsCommon.WrongNumberOfArgumentsHandler(c)
+	if err := validateAutoVersion(c); err != nil {
+		return err
 	}
 
 	return assertValidCreationMethod(c)
 }
 
+// The release bundle version argument must be omitted when the version is derived by the auto-version option.
+func validateAutoVersion(c *components.Context) error {
+	if !c.IsFlagSet(flagkit.AutoVersion) {
+		if len(c.Arguments) != 2 {
+			return pluginsCommon.WrongNumberOfArgumentsHandler(c)
+		}
+		return nil
+	}
+	if len(c.Arguments) == 2 {
+		return errorutils.CheckErrorf("the release bundle version argument cannot be provided together with the --%s option", flagkit.AutoVersion)
+	}
+	if len(c.Arguments) != 1 {
+		return pluginsCommon.WrongNumberOfArgumentsHandler(c)
+	}
+	_, err := lifecycle.ParseAutoVersionPolicy(c.GetStringFlagValue(flagkit.AutoVersion))
+	return err
+}
+
 func assertValidCreationMethod(c *components.Context) error {
 	// Determine the methods provided
 	monoReleaseBundleSource := []bool{
@@ -194,10 +285,11 @@ func validateCreationMethods(c *components.Context, regularMethodsCount int, mul
 		if err := multipleSourcesSupported(c); err != nil {
 			return err
 		}
-		if regularMethodsCount > 0 {
-			errMsg := fmt.Sprintf("only multiple sources must be supplied: --%s, --%s,\n"+
+		// The multiple sources can be combined with the sources of a spec, but not with the deprecated options.
+		if c.IsFlagSet(flagkit.Builds) || c.IsFlagSet(flagkit.ReleaseBundles) {
+			errMsg := fmt.Sprintf("only multiple sources must be supplied: --%s, --%s and optionally --%s,\n"+
 				"or one of: --%s, --%s or --%s",
-				flagkit.SourceTypeReleaseBundles, flagkit.SourceTypeBuilds,
+				flagkit.SourceTypeReleaseBundles, flagkit.SourceTypeBuilds, "spec",
 				"spec", flagkit.Builds, flagkit.ReleaseBundles)
 			return errorutils.CheckError(errors.New(errMsg))
 		}
@@ -249,12 +341,24 @@ func create(c *components.Context) (err error) {
 	if err != nil {
 		return
 	}
+	var releaseBundleVersion string
+	if c.GetNumberOfArgs() > 1 {
+		releaseBundleVersion = c.GetArgumentAt(1)
+	}
 	createCmd := lifecycle.NewReleaseBundleCreateCommand().SetServerDetails(lcDetails).SetReleaseBundleName(c.GetArgumentAt(0)).
-		SetReleaseBundleVersion(c.GetArgumentAt(1)).SetSigningKeyName(c.GetStringFlagValue(flagkit.SigningKey)).
+		SetReleaseBundleVersion(releaseBundleVersion).SetAutoVersion(lifecycle.AutoVersionPolicy(c.GetStringFlagValue(flagkit.AutoVersion))).SetSigningKeyName(c.GetStringFlagValue(flagkit.SigningKey)).
 		SetSync(c.GetBoolFlagValue(flagkit.Sync)).SetDraft(c.GetBoolFlagValue(flagkit.Draft)).
 		SetReleaseBundleProject(pluginsCommon.GetProject(c)).SetSpec(creationSpec).
 		SetBuildsSpecPath(c.GetStringFlagValue(flagkit.Builds)).SetReleaseBundlesSpecPath(c.GetStringFlagValue(flagkit.ReleaseBundles))
 
+	if c.GetStringFlagValue(flagkit.SigningKeyType) != "" {
+		signingKeyType, err := lifecycle.ParseSigningKeyType(c.GetStringFlagValue(flagkit.SigningKeyType))
+		if err != nil {
+			return err
+		}
+		createCmd.SetSigningKeyType(signingKeyType)
+	}
+
 	err = lifecycle.ValidateFeatureSupportedVersion(lcDetails, minArtifactoryVersionForMultiSourceSupport)
 	// err == nil means new flags are supported and may be added to createCmd
 	if err == nil {
@@ -262,7 +366,9 @@ func create(c *components.Context) (err error) {
 			SetBuildsSources(c.GetStringFlagValue(flagkit.SourceTypeBuilds))
 	}
 
-	return commands.Exec(createCmd)
+	return execWithOperationOutput(c, createCmd, func() *formats.ReleaseBundleOperation {
+		return createCmd.OperationOutput("create")
+	})
 }
 
 func validateUpdateReleaseBundleContext(c *components.Context) error {
@@ -303,7 +409,7 @@ func update(c *components.Context) (err error) {
 
 	var updateSpec *speccore.SpecFiles
 	if c.IsFlagSet("spec") {
-		updateSpec, err = commonCliUtils.GetSpec(c, true, false)
+		updateSpec, err = specv2.GetSpec(c, true, false)
 		if err != nil {
 			return
 		}
@@ -377,7 +483,7 @@ func getReleaseBundleCreationSpec(c *components.Context) (*speccore.SpecFiles, e
 
 	// Check if the "spec" flag is set - if so, return the spec
 	if c.IsFlagSet("spec") {
-		return commonCliUtils.GetSpec(c, true, false)
+		return specv2.GetSpec(c, true, false)
 	}
 
 	// Else - create a spec from the buildName and buildnumber flags or env vars
@@ -418,8 +524,15 @@ func promote(c *components.Context) error {
 		SetReleaseBundleVersion(c.GetArgumentAt(1)).SetEnvironment(c.GetArgumentAt(2)).SetSigningKeyName(c.GetStringFlagValue(flagkit.SigningKey)).
 		SetSync(c.GetBoolFlagValue(flagkit.Sync)).SetReleaseBundleProject(pluginsCommon.GetProject(c)).
 		SetIncludeReposPatterns(splitRepos(c, flagkit.IncludeRepos)).SetExcludeReposPatterns(splitRepos(c, flagkit.ExcludeRepos)).
-		SetPromotionType(c.GetStringFlagValue(flagkit.PromotionType))
-	return commands.Exec(promoteCmd)
+		SetPromotionType(c.GetStringFlagValue(flagkit.PromotionType)).
+		SetRequiredEvidence(splitRepos(c, flagkit.RequireEvidence)).SetGateReportPath(c.GetStringFlagValue(flagkit.GateReport)).
+		SetDryRun(c.GetBoolFlagValue("dry-run"))
+	return execWithOperationOutput(c, promoteCmd, func() *formats.ReleaseBundleOperation {
+		output := promoteCmd.OperationOutput("promote")
+		output.Environment = c.GetArgumentAt(2)
+		output.DryRun = c.GetBoolFlagValue("dry-run")
+		return output
+	})
 }
 
 func distribute(c *components.Context) error {
@@ -448,7 +561,176 @@ func distribute(c *components.Context) error {
 		SetPathMappingTarget(c.GetStringFlagValue(flagkit.PathMappingTarget)).
 		SetSync(c.GetBoolFlagValue(flagkit.Sync)).
 		SetMaxWaitMinutes(maxWaitMinutes)
-	return commands.Exec(distributeCmd)
+	return execWithOperationOutput(c, distributeCmd, func() *formats.ReleaseBundleOperation {
+		output := distributeCmd.OperationOutput("distribute")
+		output.DryRun = c.GetBoolFlagValue("dry-run")
+		return output
+	})
+}
+
+// execWithOperationOutput runs a command which operates on a release bundle version, and prints the operation in the
+// format requested by the --format flag, if any.
+func execWithOperationOutput(c *components.Context, command commands.Command, output func() *formats.ReleaseBundleOperation) error {
+	outputFormat, err := formats.ParseFormat(c.GetStringFlagValue(flagkit.Format))
+	if err != nil {
+		return err
+	}
+	if err = commands.Exec(command); err != nil || outputFormat == "" {
+		return err
+	}
+	return formats.Print(outputFormat, formats.ReleaseBundleOperationKind, output())
+}
+
+func distributionStatus(c *components.Context) error {
+	if show, err := pluginsCommon.ShowCmdHelpIfNeeded(c, c.Arguments); show || err != nil {
+		return err
+	}
+
+	if len(c.Arguments) != 2 {
+		return pluginsCommon.WrongNumberOfArgumentsHandler(c)
+	}
+
+	lcDetails, err := createLifecycleDetailsByFlags(c)
+	if err != nil {
+		return err
+	}
+	maxWaitMinutes, err := c.GetDefaultIntFlagValueIfNotSet("max-wait-minutes", 60)
+	if err != nil {
+		return err
+	}
+
+	statusCmd := lifecycle.NewReleaseBundleDistributionStatusCommand().SetServerDetails(lcDetails).
+		SetReleaseBundleName(c.GetArgumentAt(0)).SetReleaseBundleVersion(c.GetArgumentAt(1)).
+		SetReleaseBundleProject(pluginsCommon.GetProject(c)).SetTrackerId(c.GetStringFlagValue(flagkit.TrackerId)).
+		SetWatch(c.GetBoolFlagValue(flagkit.Watch)).SetTimeout(time.Duration(maxWaitMinutes) * time.Minute)
+	return commands.Exec(statusCmd)
+}
+
+func releaseBundleContents(c *components.Context) error {
+	if show, err := pluginsCommon.ShowCmdHelpIfNeeded(c, c.Arguments); show || err != nil {
+		return err
+	}
+
+	if len(c.Arguments) != 2 {
+		return pluginsCommon.WrongNumberOfArgumentsHandler(c)
+	}
+
+	lcDetails, err := createLifecycleDetailsByFlags(c)
+	if err != nil {
+		return err
+	}
+
+	contentsCmd := lifecycle.NewReleaseBundleContentsCommand().SetServerDetails(lcDetails).
+		SetReleaseBundleName(c.GetArgumentAt(0)).SetReleaseBundleVersion(c.GetArgumentAt(1)).
+		SetReleaseBundleProject(pluginsCommon.GetProject(c)).SetOutputFormat(c.GetStringFlagValue(flagkit.Format))
+	return commands.Exec(contentsCmd)
+}
+
+func releaseBundleDiff(c *components.Context) error {
+	if show, err := pluginsCommon.ShowCmdHelpIfNeeded(c, c.Arguments); show || err != nil {
+		return err
+	}
+
+	if len(c.Arguments) != 3 {
+		return pluginsCommon.WrongNumberOfArgumentsHandler(c)
+	}
+
+	lcDetails, err := createLifecycleDetailsByFlags(c)
+	if err != nil {
+		return err
+	}
+
+	diffCmd := lifecycle.NewReleaseBundleDiffCommand().SetServerDetails(lcDetails).
+		SetReleaseBundleName(c.GetArgumentAt(0)).SetFromVersion(c.GetArgumentAt(1)).SetToVersion(c.GetArgumentAt(2)).
+		SetReleaseBundleProject(pluginsCommon.GetProject(c)).SetOutputFormat(c.GetStringFlagValue(flagkit.Format))
+	return commands.Exec(diffCmd)
+}
+
+func signingKeyUploadCmd(c *components.Context) error {
+	if show, err := pluginsCommon.ShowCmdHelpIfNeeded(c, c.Arguments); show || err != nil {
+		return err
+	}
+
+	if len(c.Arguments) != 1 {
+		return pluginsCommon.WrongNumberOfArgumentsHandler(c)
+	}
+
+	lcDetails, err := createLifecycleDetailsByFlags(c)
+	if err != nil {
+		return err
+	}
+
+	uploadCmd := lifecycle.NewSigningKeyUploadCommand().SetServerDetails(lcDetails).SetKeyName(c.GetArgumentAt(0)).
+		SetKeyType(c.GetStringFlagValue("type")).SetAlias(c.GetStringFlagValue("alias")).
+		SetPublicKeyPath(c.GetStringFlagValue(flagkit.PublicKey)).SetPrivateKeyPath(c.GetStringFlagValue(flagkit.PrivateKey)).
+		SetPassphrase(c.GetStringFlagValue("passphrase"))
+	return commands.Exec(uploadCmd)
+}
+
+func signingKeyListCmd(c *components.Context) error {
+	if len(c.Arguments) != 0 {
+		return pluginsCommon.WrongNumberOfArgumentsHandler(c)
+	}
+
+	lcDetails, err := createLifecycleDetailsByFlags(c)
+	if err != nil {
+		return err
+	}
+
+	listCmd := lifecycle.NewSigningKeyListCommand().SetServerDetails(lcDetails).SetOutputFormat(c.GetStringFlagValue(flagkit.Format))
+	return commands.Exec(listCmd)
+}
+
+func signingKeyPropagateCmd(c *components.Context) error {
+	if show, err := pluginsCommon.ShowCmdHelpIfNeeded(c, c.Arguments); show || err != nil {
+		return err
+	}
+
+	if len(c.Arguments) != 1 {
+		return pluginsCommon.WrongNumberOfArgumentsHandler(c)
+	}
+
+	lcDetails, err := createLifecycleDetailsByFlags(c)
+	if err != nil {
+		return err
+	}
+
+	var edges []*config.ServerDetails
+	for _, edgeId := range strings.Split(c.GetStringFlagValue(flagkit.Edges), ";") {
+		if edgeId = strings.TrimSpace(edgeId); edgeId == "" {
+			continue
+		}
+		edgeDetails, err := credentials.GetSpecificConfig(edgeId, false, true)
+		if err != nil {
+			return err
+		}
+		edges = append(edges, edgeDetails)
+	}
+	if len(edges) == 0 {
+		return errorutils.CheckErrorf("the --%s option must include at least one server ID", flagkit.Edges)
+	}
+
+	propagateCmd := lifecycle.NewSigningKeyPropagateCommand().SetServerDetails(lcDetails).SetKeyName(c.GetArgumentAt(0)).SetEdges(edges)
+	return commands.Exec(propagateCmd)
+}
+
+func signingKeyDeleteCmd(c *components.Context) error {
+	if show, err := pluginsCommon.ShowCmdHelpIfNeeded(c, c.Arguments); show || err != nil {
+		return err
+	}
+
+	if len(c.Arguments) != 1 {
+		return pluginsCommon.WrongNumberOfArgumentsHandler(c)
+	}
+
+	lcDetails, err := createLifecycleDetailsByFlags(c)
+	if err != nil {
+		return err
+	}
+
+	deleteCmd := lifecycle.NewSigningKeyDeleteCommand().SetServerDetails(lcDetails).SetKeyName(c.GetArgumentAt(0)).
+		SetQuiet(pluginsCommon.GetQuietValue(c))
+	return commands.Exec(deleteCmd)
 }
 
 func deleteLocal(c *components.Context) error {
@@ -477,7 +759,8 @@ func deleteLocal(c *components.Context) error {
 		SetEnvironment(environment).
 		SetQuiet(pluginsCommon.GetQuietValue(c)).
 		SetReleaseBundleProject(pluginsCommon.GetProject(c)).
-		SetSync(c.GetBoolFlagValue(flagkit.Sync))
+		SetSync(c.GetBoolFlagValue(flagkit.Sync)).
+		SetDryRun(c.GetBoolFlagValue("dry-run"))
 	return commands.Exec(deleteCmd)
 }
 
@@ -533,7 +816,8 @@ func export(c *components.Context) error {
 	exportCmd.
 		SetServerDetails(lcDetails).
 		SetReleaseBundleExportModifications(modifications).
-		SetDownloadConfiguration(*downloadConfig)
+		SetDownloadConfiguration(*downloadConfig).
+		SetWriteManifest(c.GetBoolFlagValue(flagkit.WithManifest))
 
 	return commands.Exec(exportCmd)
 }
@@ -557,7 +841,8 @@ func releaseBundleImport(c *components.Context) error {
 	}
 	importCmd.
 		SetServerDetails(rtDetails).
-		SetFilepath(c.GetArgumentAt(0))
+		SetFilepath(c.GetArgumentAt(0)).
+		SetManifestPath(c.GetStringFlagValue(flagkit.ArchiveManifest))
 
 	return commands.Exec(importCmd)
 }
@@ -598,7 +883,8 @@ func annotate(c *components.Context) error {
 		SetTag(c.GetStringFlagValue(flagkit.Tag), tagExist).
 		SetProps(c.GetStringFlagValue(flagkit.Properties)).
 		DeleteProps(c.GetStringFlagValue(flagkit.DeleteProperty)).
-		SetRecursive(c.GetBoolFlagValue(flagkit.Recursive), c.IsFlagSet(flagkit.Recursive))
+		SetRecursive(c.GetBoolFlagValue(flagkit.Recursive), c.IsFlagSet(flagkit.Recursive)).
+		SetDryRun(c.GetBoolFlagValue("dry-run"))
 	return commands.Exec(annotateCmd)
 }
 
@@ -617,7 +903,7 @@ func validateDistributeCommand(c *components.Context) error {
 }
 
 func createLifecycleDetailsByFlags(c *components.Context) (*config.ServerDetails, error) {
-	lcDetails, err := pluginsCommon.CreateServerDetailsWithConfigOffer(c, true, commonCliUtils.Platform)
+	lcDetails, err := credentials.CreateServerDetailsWithConfigOffer(c, true, commonCliUtils.Platform)
 	if err != nil {
 		return nil, err
 	}
@@ -710,6 +996,7 @@ func getDocumentationMessage() string {
 func PlatformToLifecycleUrls(lcDetails *config.ServerDetails) {
 	lcDetails.ArtifactoryUrl = utils.AddTrailingSlashIfNeeded(lcDetails.Url) + "artifactory/"
 	lcDetails.LifecycleUrl = utils.AddTrailingSlashIfNeeded(lcDetails.Url) + "lifecycle/"
+	lcDetails.OnemodelUrl = utils.AddTrailingSlashIfNeeded(lcDetails.Url) + "onemodel/"
 	lcDetails.Url = ""
 }
 
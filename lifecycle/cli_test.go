@@ -41,6 +41,13 @@ func TestValidateCreateReleaseBundleContext(t *testing.T) {
 			"spec=/path/to/file", flagkit.SigningKey + "=key"}, false},
 		{"builds with draft flag", []string{"name", "version"}, []string{
 			flagkit.Builds + "=/path/to/file", flagkit.SigningKey + "=key"}, false},
+		// Auto version tests - the version argument must be omitted
+		{"auto version without version", []string{"name"}, []string{
+			"spec=/path/to/file", flagkit.AutoVersion + "=patch"}, false},
+		{"auto version with version", []string{"name", "version"}, []string{
+			"spec=/path/to/file", flagkit.AutoVersion + "=patch"}, true},
+		{"auto version unsupported policy", []string{"name"}, []string{
+			"spec=/path/to/file", flagkit.AutoVersion + "=build"}, true},
 	}
 
 	for _, test := range testRuns {
@@ -0,0 +1,186 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/jfrog/gofrog/crypto"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+)
+
+const (
+	ArchiveManifestSuffix = ".manifest.json"
+	// The maximal number of mismatches listed in a verification error.
+	maxReportedMismatches = 10
+)
+
+// ReleaseBundleArchiveManifest describes an exported release bundle archive, so that the archive and the release bundle
+// imported from it can be verified on a disconnected Artifactory instance.
+type ReleaseBundleArchiveManifest struct {
+	ReleaseBundleName    string `json:"releaseBundleName"`
+	ReleaseBundleVersion string `json:"releaseBundleVersion"`
+	ProjectKey           string `json:"projectKey,omitempty"`
+	ArchiveSha256        string `json:"archiveSha256"`
+	// The checksum of the signed release bundle manifest, which is identical on the target if the signature is preserved.
+	SignedManifestSha256 string             `json:"signedManifestSha256"`
+	Artifacts            []ArchivedArtifact `json:"artifacts"`
+}
+
+type ArchivedArtifact struct {
+	Path       string              `json:"path"`
+	Sha256     string              `json:"sha256"`
+	Properties map[string][]string `json:"properties,omitempty"`
+}
+
+// getArchiveManifestPath returns the path of the manifest of an archive downloaded to the target path. If the target is a
+// directory, the manifest is named after the release bundle.
+func getArchiveManifestPath(targetPath, name, version string) string {
+	if strings.HasSuffix(targetPath, "/") || strings.HasSuffix(targetPath, string(filepath.Separator)) {
+		return filepath.Join(targetPath, name+"-"+version+ArchiveManifestSuffix)
+	}
+	return targetPath + ArchiveManifestSuffix
+}
+
+func getSha256(rtServicesManager artifactory.ArtifactoryServicesManager, path string) (string, error) {
+	fileInfo, err := rtServicesManager.FileInfo(path)
+	if err != nil {
+		return "", err
+	}
+	return fileInfo.Checksums.Sha256, nil
+}
+
+// createArchiveManifest describes the release bundle version and its exported archive, by its path in Artifactory.
+func createArchiveManifest(rtServicesManager artifactory.ArtifactoryServicesManager, recordGetter releaseBundleRecordGetter, name, version, projectKey, archivePath string) (*ReleaseBundleArchiveManifest, error) {
+	manifest := &ReleaseBundleArchiveManifest{ReleaseBundleName: name, ReleaseBundleVersion: version, ProjectKey: projectKey, Artifacts: []ArchivedArtifact{}}
+	var err error
+	if manifest.ArchiveSha256, err = getSha256(rtServicesManager, archivePath); err != nil {
+		return nil, err
+	}
+	if manifest.SignedManifestSha256, err = getSha256(rtServicesManager, buildManifestPath(projectKey, name, version)); err != nil {
+		return nil, err
+	}
+	record, err := recordGetter.GetReleaseBundleRecord(name, version, projectKey)
+	if err != nil {
+		return nil, err
+	}
+	for _, artifact := range record.Artifacts {
+		archived := ArchivedArtifact{Path: artifact.Path, Sha256: artifact.Checksum}
+		for _, property := range artifact.Properties {
+			if archived.Properties == nil {
+				archived.Properties = make(map[string][]string)
+			}
+			archived.Properties[property.Key] = property.Values
+		}
+		manifest.Artifacts = append(manifest.Artifacts, archived)
+	}
+	sort.Slice(manifest.Artifacts, func(i, j int) bool {
+		return manifest.Artifacts[i].Path < manifest.Artifacts[j].Path
+	})
+	return manifest, nil
+}
+
+func writeArchiveManifest(manifest *ReleaseBundleArchiveManifest, manifestPath string) error {
+	content, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	if err = os.MkdirAll(filepath.Dir(manifestPath), 0755); err != nil {
+		return errorutils.CheckError(err)
+	}
+	return errorutils.CheckError(os.WriteFile(manifestPath, content, 0644))
+}
+
+func readArchiveManifest(manifestPath string) (*ReleaseBundleArchiveManifest, error) {
+	content, err := os.ReadFile(manifestPath)
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	manifest := &ReleaseBundleArchiveManifest{}
+	if err = json.Unmarshal(content, manifest); err != nil {
+		return nil, errorutils.CheckErrorf("failed to parse the release bundle archive manifest %s: %s", manifestPath, err.Error())
+	}
+	return manifest, nil
+}
+
+// verifyArchiveChecksum verifies that the archive wasn't modified since it was exported.
+func verifyArchiveChecksum(archivePath string, manifest *ReleaseBundleArchiveManifest) error {
+	checksums, err := crypto.GetFileChecksums(archivePath, crypto.SHA256)
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	if checksums[crypto.SHA256] != manifest.ArchiveSha256 {
+		return errorutils.CheckErrorf("the SHA-256 checksum of %s is %s, while %s was exported", archivePath, checksums[crypto.SHA256], manifest.ArchiveSha256)
+	}
+	return nil
+}
+
+// verifyImportedReleaseBundle verifies that the release bundle imported to the target has the signature, artifact
+// checksums and properties of the exported release bundle.
+func verifyImportedReleaseBundle(rtServicesManager artifactory.ArtifactoryServicesManager, recordGetter releaseBundleRecordGetter, manifest *ReleaseBundleArchiveManifest) error {
+	var mismatches []string
+	name, version, projectKey := manifest.ReleaseBundleName, manifest.ReleaseBundleVersion, manifest.ProjectKey
+	signedManifestSha256, err := getSha256(rtServicesManager, buildManifestPath(projectKey, name, version))
+	if err != nil {
+		return err
+	}
+	if signedManifestSha256 != manifest.SignedManifestSha256 {
+		mismatches = append(mismatches, "the signed release bundle manifest differs from the exported one")
+	}
+	record, err := recordGetter.GetReleaseBundleRecord(name, version, projectKey)
+	if err != nil {
+		return err
+	}
+	imported := make(map[string]ArchivedArtifact)
+	for _, artifact := range record.Artifacts {
+		importedArtifact := ArchivedArtifact{Path: artifact.Path, Sha256: artifact.Checksum, Properties: make(map[string][]string)}
+		for _, property := range artifact.Properties {
+			importedArtifact.Properties[property.Key] = property.Values
+		}
+		imported[artifact.Path] = importedArtifact
+	}
+	for _, artifact := range manifest.Artifacts {
+		importedArtifact, exists := imported[artifact.Path]
+		switch {
+		case !exists:
+			mismatches = append(mismatches, fmt.Sprintf("%s is missing", artifact.Path))
+		case importedArtifact.Sha256 != artifact.Sha256:
+			mismatches = append(mismatches, fmt.Sprintf("%s has the SHA-256 checksum %s instead of %s", artifact.Path, importedArtifact.Sha256, artifact.Sha256))
+		default:
+			keys := make([]string, 0, len(artifact.Properties))
+			for key := range artifact.Properties {
+				keys = append(keys, key)
+			}
+			sort.Strings(keys)
+			for _, key := range keys {
+				if !containsAll(importedArtifact.Properties[key], artifact.Properties[key]) {
+					mismatches = append(mismatches, fmt.Sprintf("%s is missing values of the property %s", artifact.Path, key))
+				}
+			}
+		}
+	}
+	if len(mismatches) == 0 {
+		return nil
+	}
+	if len(mismatches) > maxReportedMismatches {
+		mismatches = append(mismatches[:maxReportedMismatches], fmt.Sprintf("and %d more", len(mismatches)-maxReportedMismatches))
+	}
+	return errorutils.CheckErrorf("the imported release bundle %s/%s doesn't match the exported one:\n%s", name, version, strings.Join(mismatches, "\n"))
+}
+
+func containsAll(values, required []string) bool {
+	existing := make(map[string]bool)
+	for _, value := range values {
+		existing[value] = true
+	}
+	for _, value := range required {
+		if !existing[value] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,75 @@
+package commands
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/jfrog/jfrog-client-go/artifactory/services/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeChecksumsServicesManager returns the SHA-256 checksums of files by their paths.
+type fakeChecksumsServicesManager struct {
+	artifactory.EmptyArtifactoryServicesManager
+	checksums map[string]string
+}
+
+func (f *fakeChecksumsServicesManager) FileInfo(path string) (*utils.FileInfo, error) {
+	fileInfo := &utils.FileInfo{Path: path}
+	fileInfo.Checksums.Sha256 = f.checksums[path]
+	return fileInfo, nil
+}
+
+func TestGetArchiveManifestPath(t *testing.T) {
+	assert.Equal(t, filepath.Join("out", "app-1.0.manifest.json"), getArchiveManifestPath("out/", "app", "1.0"))
+	assert.Equal(t, "out/app.zip.manifest.json", getArchiveManifestPath("out/app.zip", "app", "1.0"))
+}
+
+func TestArchiveManifest(t *testing.T) {
+	source := &fakeChecksumsServicesManager{checksums: map[string]string{
+		"release-bundles-v2/exports/app-1.0.zip":             "archive-sha",
+		"release-bundles-v2/app/1.0/release-bundle.json.evd": "signed-sha",
+	}}
+	manifest, err := createArchiveManifest(source, testRecords, "app", "1.0", "", "release-bundles-v2/exports/app-1.0.zip")
+	require.NoError(t, err)
+	assert.Equal(t, "archive-sha", manifest.ArchiveSha256)
+	assert.Equal(t, "signed-sha", manifest.SignedManifestSha256)
+	if assert.Len(t, manifest.Artifacts, 3) {
+		assert.Equal(t, ArchivedArtifact{Path: "libs/app/app.jar", Sha256: "aaa", Properties: map[string][]string{"build.name": {"app"}, "build.number": {"1"}}}, manifest.Artifacts[0])
+		assert.Nil(t, manifest.Artifacts[2].Properties)
+	}
+
+	manifestPath := filepath.Join(t.TempDir(), "app-1.0.manifest.json")
+	require.NoError(t, writeArchiveManifest(manifest, manifestPath))
+	read, err := readArchiveManifest(manifestPath)
+	require.NoError(t, err)
+	assert.Equal(t, manifest, read)
+
+	// The imported release bundle matches the exported one.
+	assert.NoError(t, verifyImportedReleaseBundle(source, testRecords, manifest))
+
+	// The imported release bundle has a different signed manifest and different artifacts.
+	target := &fakeChecksumsServicesManager{checksums: map[string]string{"release-bundles-v2/app/1.0/release-bundle.json.evd": "other-sha"}}
+	err = verifyImportedReleaseBundle(target, fakeRecordGetter{"1.0": testRecords["2.0"]}, manifest)
+	assert.EqualError(t, err, "the imported release bundle app/1.0 doesn't match the exported one:\n"+
+		"the signed release bundle manifest differs from the exported one\n"+
+		"libs/app/app.jar has the SHA-256 checksum ddd instead of aaa\n"+
+		"libs/app/app.pom is missing values of the property build.number\n"+
+		"libs/lib/lib.jar is missing")
+}
+
+func TestVerifyArchiveChecksum(t *testing.T) {
+	archivePath := filepath.Join(t.TempDir(), "app-1.0.zip")
+	require.NoError(t, os.WriteFile(archivePath, []byte("archive"), 0644))
+	sha256Sum := sha256.Sum256([]byte("archive"))
+	manifest := &ReleaseBundleArchiveManifest{ArchiveSha256: hex.EncodeToString(sha256Sum[:])}
+	assert.NoError(t, verifyArchiveChecksum(archivePath, manifest))
+
+	manifest.ArchiveSha256 = "modified"
+	assert.ErrorContains(t, verifyArchiveChecksum(archivePath, manifest), "while modified was exported")
+}
@@ -3,6 +3,7 @@ package commands
 import (
 	"fmt"
 	"github.com/jfrog/gofrog/log"
+	artifactoryutils "github.com/jfrog/jfrog-cli-artifactory/artifactory/utils"
 	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
 	"github.com/jfrog/jfrog-client-go/artifactory/services/utils"
 	"github.com/jfrog/jfrog-client-go/lifecycle"
@@ -20,6 +21,7 @@ type ReleaseBundleAnnotateCommand struct {
 	deleteProps               string
 	deletePropsExist          bool
 	recursive                 bool
+	dryRun                    bool
 	validateVersionFunc       func(*config.ServerDetails, string) error
 	getPrerequisitesFunc      func() (*lifecycle.LifecycleServicesManager, services.ReleaseBundleDetails, services.CommonOptionalQueryParams, error)
 	annotateReleaseBundleFunc func(*ReleaseBundleAnnotateCommand, *lifecycle.LifecycleServicesManager,
@@ -88,6 +90,12 @@ func (rba *ReleaseBundleAnnotateCommand) DeleteProps(deleteProps string) *Releas
 	return rba
 }
 
+// SetDryRun sets the command to log the annotation, without annotating the release bundle.
+func (rba *ReleaseBundleAnnotateCommand) SetDryRun(dryRun bool) *ReleaseBundleAnnotateCommand {
+	rba.dryRun = dryRun
+	return rba
+}
+
 func (rba *ReleaseBundleAnnotateCommand) ServerDetails() (*config.ServerDetails, error) {
 	return rba.serverDetails, nil
 }
@@ -106,6 +114,11 @@ func (rba *ReleaseBundleAnnotateCommand) Run() error {
 		return err
 	}
 
+	if rba.dryRun {
+		rba.logAnnotation(rbDetails)
+		return nil
+	}
+
 	err = rba.annotateReleaseBundleFunc(rba, servicesManager, rbDetails, queryParams)
 	if err != nil {
 		return err
@@ -115,6 +128,19 @@ func (rba *ReleaseBundleAnnotateCommand) Run() error {
 	return nil
 }
 
+func (rba *ReleaseBundleAnnotateCommand) logAnnotation(details services.ReleaseBundleDetails) {
+	subject := fmt.Sprintf("release bundle '%s/%s'", details.ReleaseBundleName, details.ReleaseBundleVersion)
+	if rba.tagExist {
+		artifactoryutils.LogDryRun(fmt.Sprintf("Setting the tag of the %s to '%s'", subject, rba.tag))
+	}
+	if rba.propsExist {
+		artifactoryutils.LogDryRun(fmt.Sprintf("Setting the properties '%s' on the %s, recursive: %t", rba.props, subject, rba.recursive))
+	}
+	if rba.deletePropsExist {
+		artifactoryutils.LogDryRun(fmt.Sprintf("Deleting the properties '%s' from the %s, recursive: %t", rba.deleteProps, subject, rba.recursive))
+	}
+}
+
 func DefaultAnnotateReleaseBundle(rba *ReleaseBundleAnnotateCommand, manager *lifecycle.LifecycleServicesManager,
 	details services.ReleaseBundleDetails, params services.CommonOptionalQueryParams) error {
 	return rba.annotateReleaseBundle(manager, details, params, rba)
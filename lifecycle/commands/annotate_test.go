@@ -111,3 +111,28 @@ func TestReleaseBundleAnnotateCommand_Run(t *testing.T) {
 	err := cmd.Run()
 	assert.NoError(t, err)
 }
+
+func TestReleaseBundleAnnotateCommand_RunDryRun(t *testing.T) {
+	cmd := NewReleaseBundleAnnotateCommand().
+		SetServerDetails(&config.ServerDetails{ArtifactoryUrl: "https://artifactory.example.com"}).
+		SetReleaseBundleName("example-release-bundle").
+		SetReleaseBundleVersion("1.0.0").
+		SetTag("example-tag", true).
+		SetDryRun(true)
+	cmd.validateVersionFunc = func(*config.ServerDetails, string) error {
+		return nil
+	}
+	cmd.getPrerequisitesFunc = func() (*lifecycle.LifecycleServicesManager, services.ReleaseBundleDetails, services.CommonOptionalQueryParams, error) {
+		return &lifecycle.LifecycleServicesManager{}, services.ReleaseBundleDetails{ReleaseBundleName: "example-release-bundle", ReleaseBundleVersion: "1.0.0"},
+			services.CommonOptionalQueryParams{}, nil
+	}
+	annotated := false
+	cmd.annotateReleaseBundleFunc = func(*ReleaseBundleAnnotateCommand, *lifecycle.LifecycleServicesManager,
+		services.ReleaseBundleDetails, services.CommonOptionalQueryParams) error {
+		annotated = true
+		return nil
+	}
+
+	assert.NoError(t, cmd.Run())
+	assert.False(t, annotated)
+}
@@ -0,0 +1,140 @@
+package commands
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils"
+	"github.com/jfrog/jfrog-client-go/lifecycle"
+	"github.com/jfrog/jfrog-client-go/lifecycle/services"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+	"golang.org/x/mod/semver"
+)
+
+type AutoVersionPolicy string
+
+const (
+	AutoVersionPatch      AutoVersionPolicy = "patch"
+	AutoVersionMinor      AutoVersionPolicy = "minor"
+	AutoVersionMajor      AutoVersionPolicy = "major"
+	AutoVersionFromGitTag AutoVersionPolicy = "from-git-tag"
+
+	// The version used when no previous semantic version of the release bundle exists.
+	initialAutoVersion = "1.0.0"
+	// The number of versions fetched in each search request.
+	autoVersionSearchPageSize = 250
+)
+
+func ParseAutoVersionPolicy(policy string) (AutoVersionPolicy, error) {
+	switch AutoVersionPolicy(policy) {
+	case AutoVersionPatch, AutoVersionMinor, AutoVersionMajor, AutoVersionFromGitTag:
+		return AutoVersionPolicy(policy), nil
+	default:
+		return "", errorutils.CheckErrorf("unsupported auto version policy '%s'. Possible values are: %s, %s, %s and %s",
+			policy, AutoVersionPatch, AutoVersionMinor, AutoVersionMajor, AutoVersionFromGitTag)
+	}
+}
+
+// Resolves the version of the release bundle to create according to the auto version policy.
+func (rbc *ReleaseBundleCreateCommand) resolveAutoVersion(servicesManager *lifecycle.LifecycleServicesManager) (string, error) {
+	if rbc.autoVersion == AutoVersionFromGitTag {
+		tag, err := utils.GetLatestGitTag("")
+		if err != nil {
+			return "", err
+		}
+		return versionFromGitTag(tag), nil
+	}
+	existingVersions, err := getExistingReleaseBundleVersions(servicesManager, rbc.releaseBundleName, rbc.rbProjectKey)
+	if err != nil {
+		return "", err
+	}
+	return NextSemanticVersion(existingVersions, rbc.autoVersion)
+}
+
+func getExistingReleaseBundleVersions(servicesManager *lifecycle.LifecycleServicesManager, releaseBundleName, project string) ([]string, error) {
+	var versions []string
+	for offset := 0; ; offset += autoVersionSearchPageSize {
+		queryParams := services.GetSearchOptionalQueryParams{Offset: offset, Limit: autoVersionSearchPageSize, Project: project}
+		response, err := servicesManager.ReleaseBundlesSearchVersions(releaseBundleName, queryParams)
+		if err != nil {
+			return nil, err
+		}
+		for _, releaseBundle := range response.ReleaseBundles {
+			versions = append(versions, releaseBundle.ReleaseBundleVersion)
+		}
+		if len(response.ReleaseBundles) < autoVersionSearchPageSize {
+			return versions, nil
+		}
+	}
+}
+
+// NextSemanticVersion returns the version following the highest semantic version in existingVersions, according to the policy.
+// Versions which are not semantic versions are ignored. A 'v' prefix is kept if the highest version has one.
+func NextSemanticVersion(existingVersions []string, policy AutoVersionPolicy) (string, error) {
+	latest, prefix := "", ""
+	for _, version := range existingVersions {
+		canonical, versionPrefix := toSemver(version)
+		if canonical == "" {
+			log.Debug("Ignoring release bundle version '" + version + "', which is not a semantic version.")
+			continue
+		}
+		if latest == "" || semver.Compare(canonical, latest) > 0 {
+			latest, prefix = canonical, versionPrefix
+		}
+	}
+	if latest == "" {
+		return initialAutoVersion, nil
+	}
+
+	// The version core of a canonical semantic version is in the form of vMAJOR.MINOR.PATCH.
+	core := strings.TrimSuffix(strings.TrimSuffix(latest, semver.Build(latest)), semver.Prerelease(latest))
+	parts := strings.Split(strings.TrimPrefix(core, "v"), ".")
+	numbers := make([]int, len(parts))
+	for i, part := range parts {
+		number, err := strconv.Atoi(part)
+		if err != nil {
+			return "", errorutils.CheckErrorf("failed parsing version '%s': %s", latest, err.Error())
+		}
+		numbers[i] = number
+	}
+	major, minor, patch := numbers[0], numbers[1], numbers[2]
+	switch policy {
+	case AutoVersionMajor:
+		major, minor, patch = major+1, 0, 0
+	case AutoVersionMinor:
+		minor, patch = minor+1, 0
+	case AutoVersionPatch:
+		// A pre-release is followed by its release.
+		if semver.Prerelease(latest) == "" {
+			patch++
+		}
+	default:
+		return "", errorutils.CheckErrorf("auto version policy '%s' does not derive the version from existing versions", policy)
+	}
+	return fmt.Sprintf("%s%d.%d.%d", prefix, major, minor, patch), nil
+}
+
+// Returns the canonical form of a full semantic version (vMAJOR.MINOR.PATCH), and whether it was prefixed with 'v'.
+// Returns an empty string if the version is not a full semantic version.
+func toSemver(version string) (canonical, prefix string) {
+	if strings.HasPrefix(version, "v") {
+		prefix = "v"
+	}
+	withPrefix := "v" + strings.TrimPrefix(version, "v")
+	// Versions such as 'v1.2' are valid but are not full semantic versions.
+	core := strings.TrimSuffix(strings.TrimSuffix(withPrefix, semver.Build(withPrefix)), semver.Prerelease(withPrefix))
+	if !semver.IsValid(withPrefix) || strings.Count(core, ".") != 2 {
+		return "", ""
+	}
+	return semver.Canonical(withPrefix), prefix
+}
+
+// Returns the release bundle version matching a git tag, removing the 'v' prefix of semantic version tags.
+func versionFromGitTag(tag string) string {
+	if canonical, _ := toSemver(tag); canonical != "" {
+		return strings.TrimPrefix(tag, "v")
+	}
+	return tag
+}
@@ -0,0 +1,52 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNextSemanticVersion(t *testing.T) {
+	testCases := []struct {
+		name     string
+		existing []string
+		policy   AutoVersionPolicy
+		expected string
+	}{
+		{"no versions", nil, AutoVersionPatch, "1.0.0"},
+		{"no semantic versions", []string{"latest", "1.2"}, AutoVersionMinor, "1.0.0"},
+		{"patch", []string{"1.0.0", "1.2.3", "1.10.0"}, AutoVersionPatch, "1.10.1"},
+		{"minor", []string{"1.2.3", "0.9.9"}, AutoVersionMinor, "1.3.0"},
+		{"major", []string{"1.2.3", "2.0.1"}, AutoVersionMajor, "3.0.0"},
+		{"keep prefix", []string{"v1.2.3", "1.0.0"}, AutoVersionPatch, "v1.2.4"},
+		{"patch after prerelease", []string{"1.2.2", "1.2.3-rc.1"}, AutoVersionPatch, "1.2.3"},
+		{"minor after prerelease", []string{"1.2.3-rc.1"}, AutoVersionMinor, "1.3.0"},
+		{"ignore build metadata", []string{"1.2.3+build.5"}, AutoVersionPatch, "1.2.4"},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			version, err := NextSemanticVersion(testCase.existing, testCase.policy)
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.expected, version)
+		})
+	}
+}
+
+func TestNextSemanticVersionFromGitTagPolicy(t *testing.T) {
+	_, err := NextSemanticVersion([]string{"1.0.0"}, AutoVersionFromGitTag)
+	assert.Error(t, err)
+}
+
+func TestParseAutoVersionPolicy(t *testing.T) {
+	policy, err := ParseAutoVersionPolicy("from-git-tag")
+	assert.NoError(t, err)
+	assert.Equal(t, AutoVersionFromGitTag, policy)
+	_, err = ParseAutoVersionPolicy("latest")
+	assert.Error(t, err)
+}
+
+func TestVersionFromGitTag(t *testing.T) {
+	assert.Equal(t, "1.2.3", versionFromGitTag("v1.2.3"))
+	assert.Equal(t, "1.2.3", versionFromGitTag("1.2.3"))
+	assert.Equal(t, "release-7", versionFromGitTag("release-7"))
+}
@@ -1,12 +1,17 @@
 package commands
 
 import (
+	"errors"
 	"fmt"
 	"path"
 
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/formats"
+	artifactoryutils "github.com/jfrog/jfrog-cli-artifactory/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clientconfig"
 	"github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
 	"github.com/jfrog/jfrog-cli-core/v2/common/spec"
 	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/artifactory"
 	rtServices "github.com/jfrog/jfrog-client-go/artifactory/services"
 	rtServicesUtils "github.com/jfrog/jfrog-client-go/artifactory/services/utils"
 	"github.com/jfrog/jfrog-client-go/lifecycle"
@@ -33,6 +38,17 @@ type releaseBundleCmd struct {
 	rbProjectKey         string
 }
 
+// OperationOutput returns the structured output of the operation on the release bundle version, once it has run.
+func (rbc *releaseBundleCmd) OperationOutput(operation string) *formats.ReleaseBundleOperation {
+	return &formats.ReleaseBundleOperation{
+		Operation: operation,
+		Name:      rbc.releaseBundleName,
+		Version:   rbc.releaseBundleVersion,
+		Project:   rbc.rbProjectKey,
+		Sync:      rbc.sync,
+	}
+}
+
 func (rbc *releaseBundleCmd) getPrerequisites() (servicesManager *lifecycle.LifecycleServicesManager,
 	rbDetails services.ReleaseBundleDetails, queryParams services.CommonOptionalQueryParams, err error) {
 	return rbc.initPrerequisites()
@@ -47,7 +63,7 @@ func (rbp *ReleaseBundlePromoteCommand) getPromotionPrerequisites() (servicesMan
 
 func (rbc *releaseBundleCmd) initPrerequisites() (servicesManager *lifecycle.LifecycleServicesManager,
 	rbDetails services.ReleaseBundleDetails, queryParams services.CommonOptionalQueryParams, err error) {
-	servicesManager, err = utils.CreateLifecycleServiceManager(rbc.serverDetails, false)
+	servicesManager, err = clientconfig.CreateLifecycleServiceManager(rbc.serverDetails, false)
 	if err != nil {
 		return
 	}
@@ -63,8 +79,21 @@ func (rbc *releaseBundleCmd) initPrerequisites() (servicesManager *lifecycle.Lif
 	return
 }
 
+// checkReleaseBundleExists returns an error if the release bundle version doesn't exist, so that a dry run fails where
+// the operation would.
+func (rbc *releaseBundleCmd) checkReleaseBundleExists(servicesManager *lifecycle.LifecycleServicesManager) error {
+	exists, err := servicesManager.IsReleaseBundleExist(rbc.releaseBundleName, rbc.releaseBundleVersion, rbc.rbProjectKey)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return errorutils.CheckErrorf("the release bundle '%s/%s' doesn't exist", rbc.releaseBundleName, rbc.releaseBundleVersion)
+	}
+	return nil
+}
+
 func validateArtifactoryVersion(serverDetails *config.ServerDetails, minVersion string) error {
-	rtServiceManager, err := utils.CreateServiceManager(serverDetails, 3, 0, false)
+	rtServiceManager, err := clientconfig.CreateServiceManager(serverDetails, 3, 0, false)
 	if err != nil {
 		return err
 	}
@@ -116,7 +145,7 @@ func buildManifestPath(projectKey, name, version string) string {
 
 // getAqlService creates an AQL service for querying Artifactory
 func getAqlService(serverDetails *config.ServerDetails) (*rtServices.AqlService, error) {
-	rtServiceManager, err := utils.CreateServiceManager(serverDetails, 3, 0, false)
+	rtServiceManager, err := clientconfig.CreateServiceManager(serverDetails, 3, 0, false)
 	if err != nil {
 		return nil, err
 	}
@@ -140,10 +169,14 @@ func getBuildDetailsFromIdentifier(serverDetails *config.ServerDetails, buildIde
 	return buildName, buildNumber, nil
 }
 
-// getArtifactFilesFromSpec filters spec files to return only those with a Pattern (artifacts)
+// getArtifactFilesFromSpec filters spec files to return only those with a Pattern (artifacts), and the builds filtered
+// by exclusions only, whose pattern defaults to all their artifacts
 func getArtifactFilesFromSpec(files []spec.File) []spec.File {
 	artifactFiles := make([]spec.File, 0, len(files))
 	for _, file := range files {
+		if isFilteredBuildFile(file) && file.Pattern == "" {
+			file.Pattern = "*"
+		}
 		if file.Pattern != "" {
 			artifactFiles = append(artifactFiles, file)
 		}
@@ -151,6 +184,58 @@ func getArtifactFilesFromSpec(files []spec.File) []spec.File {
 	return artifactFiles
 }
 
+// isFilteredBuildFile returns true if the spec file includes only the build artifacts matching its pattern, or excludes
+// the artifacts matching its exclusions. Such builds are resolved to their matching artifacts.
+func isFilteredBuildFile(file spec.File) bool {
+	return file.Build != "" && (file.Pattern != "" || len(file.Exclusions) > 0 && file.Exclusions[0] != "")
+}
+
+// resolveProjectArtifactFiles translates the repositories of the spec files' patterns to the project's repositories.
+// The patterns of builds are matched against their artifacts in any repository, and aren't translated.
+func resolveProjectArtifactFiles(rtServicesManager artifactory.ArtifactoryServicesManager, projectKey string, files []spec.File) ([]spec.File, error) {
+	if projectKey == "" {
+		return files, nil
+	}
+	resolver := artifactoryutils.NewProjectRepoResolver(rtServicesManager, projectKey)
+	for i := range files {
+		if files[i].Build != "" {
+			continue
+		}
+		resolvedPattern, err := resolver.ResolvePath(files[i].Pattern)
+		if err != nil {
+			return nil, err
+		}
+		files[i].Pattern = resolvedPattern
+	}
+	return files, nil
+}
+
+// searchArtifactsSource searches the artifacts matching the spec files, and returns them as an artifacts source
+func searchArtifactsSource(serverDetails *config.ServerDetails, projectKey string, files []spec.File) (artifactsSource services.CreateFromArtifacts, err error) {
+	rtServicesManager, err := clientconfig.CreateServiceManager(serverDetails, 3, 0, false)
+	if err != nil {
+		return artifactsSource, err
+	}
+
+	artifactFiles, err := resolveProjectArtifactFiles(rtServicesManager, projectKey, files)
+	if err != nil {
+		return artifactsSource, err
+	}
+
+	searchResults, callbackFunc, err := utils.SearchFilesBySpecs(rtServicesManager, artifactFiles)
+	if err != nil {
+		return artifactsSource, err
+	}
+
+	defer func() {
+		if callbackFunc != nil {
+			err = errors.Join(err, callbackFunc())
+		}
+	}()
+
+	return aqlResultToArtifactsSource(searchResults)
+}
+
 // aqlResultToArtifactsSource converts AQL search results to CreateFromArtifacts source
 func aqlResultToArtifactsSource(readers []*content.ContentReader) (artifactsSource services.CreateFromArtifacts, err error) {
 	// Allocate buffer once outside the loops to avoid unnecessary heap allocations on every iteration
@@ -198,7 +283,7 @@ func convertSpecToReleaseBundlesSource(files []spec.File) (services.CreateFromRe
 func convertSpecToBuildsSource(serverDetails *config.ServerDetails, files []spec.File) (services.CreateFromBuildsSource, error) {
 	var buildsSource services.CreateFromBuildsSource
 	for _, file := range files {
-		if file.Build == "" {
+		if file.Build == "" || isFilteredBuildFile(file) {
 			continue
 		}
 		buildName, buildNumber, err := getBuildDetailsFromIdentifier(serverDetails, file.Build, file.Project)
@@ -124,6 +124,15 @@ func TestGetArtifactFilesFromSpec(t *testing.T) {
 			expectedCount: 3,
 			expectedFiles: []string{"repo1/*.jar", "repo2/*.war", "repo3/*.zip"},
 		},
+		{
+			name: "includes filtered builds",
+			inputFiles: []spec.File{
+				{Build: "my-build/123", Pattern: "repo/*.jar"},
+				{Build: "my-build/124", Exclusions: []string{"*.pom"}},
+			},
+			expectedCount: 2,
+			expectedFiles: []string{"repo/*.jar", "*"},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -0,0 +1,201 @@
+package commands
+
+import (
+	"net/url"
+	"path"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/formats"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
+	"github.com/jfrog/jfrog-client-go/lifecycle/services"
+)
+
+const (
+	releaseBundleRecordsApi = "api/v2/release_bundle/records"
+	// The properties of the artifacts deployed by builds.
+	buildNamePropertyKey   = "build.name"
+	buildNumberPropertyKey = "build.number"
+)
+
+// releaseBundleRecordGetter gets the records of release bundle versions.
+type releaseBundleRecordGetter interface {
+	GetReleaseBundleRecord(name, version, projectKey string) (*services.ReleaseBundleSpecResponse, error)
+}
+
+func (lac *lifecycleApiClient) GetReleaseBundleRecord(name, version, projectKey string) (*services.ReleaseBundleSpecResponse, error) {
+	record := &services.ReleaseBundleSpecResponse{}
+	err := lac.get(path.Join(releaseBundleRecordsApi, url.PathEscape(name), url.PathEscape(version)), projectKey, record)
+	return record, err
+}
+
+// ReleaseBundleContents is the content of a release bundle version: its artifacts and the builds which produced them.
+type ReleaseBundleContents struct {
+	ReleaseBundleName    string                  `json:"releaseBundleName"`
+	ReleaseBundleVersion string                  `json:"releaseBundleVersion"`
+	CreatedBy            string                  `json:"createdBy,omitempty"`
+	Created              time.Time               `json:"created"`
+	Artifacts            []ReleaseBundleArtifact `json:"artifacts"`
+	SourceBuilds         []SourceBuild           `json:"sourceBuilds"`
+}
+
+type ReleaseBundleArtifact struct {
+	Path             string `json:"path"`
+	Sha256           string `json:"sha256"`
+	SourceRepository string `json:"sourceRepository,omitempty"`
+	PackageType      string `json:"packageType,omitempty"`
+	Size             int    `json:"size"`
+}
+
+type SourceBuild struct {
+	Name   string `json:"name"`
+	Number string `json:"number"`
+}
+
+// getReleaseBundleContents returns the artifacts of the release bundle version ordered by their paths, and the builds
+// which produced them by the build properties of the artifacts.
+func getReleaseBundleContents(recordGetter releaseBundleRecordGetter, name, version, projectKey string) (*ReleaseBundleContents, error) {
+	record, err := recordGetter.GetReleaseBundleRecord(name, version, projectKey)
+	if err != nil {
+		return nil, err
+	}
+	contents := &ReleaseBundleContents{
+		ReleaseBundleName:    name,
+		ReleaseBundleVersion: version,
+		CreatedBy:            record.CreatedBy,
+		Created:              record.Created,
+		Artifacts:            []ReleaseBundleArtifact{},
+		SourceBuilds:         []SourceBuild{},
+	}
+	addedBuilds := make(map[SourceBuild]bool)
+	for _, artifact := range record.Artifacts {
+		contents.Artifacts = append(contents.Artifacts, ReleaseBundleArtifact{
+			Path:             artifact.Path,
+			Sha256:           artifact.Checksum,
+			SourceRepository: artifact.SourceRepositoryKey,
+			PackageType:      artifact.PackageType,
+			Size:             artifact.Size,
+		})
+		var buildNames, buildNumbers []string
+		for _, property := range artifact.Properties {
+			switch property.Key {
+			case buildNamePropertyKey:
+				buildNames = property.Values
+			case buildNumberPropertyKey:
+				buildNumbers = property.Values
+			}
+		}
+		for i := 0; i < len(buildNames) && i < len(buildNumbers); i++ {
+			build := SourceBuild{Name: buildNames[i], Number: buildNumbers[i]}
+			if !addedBuilds[build] {
+				addedBuilds[build] = true
+				contents.SourceBuilds = append(contents.SourceBuilds, build)
+			}
+		}
+	}
+	sort.Slice(contents.Artifacts, func(i, j int) bool {
+		return contents.Artifacts[i].Path < contents.Artifacts[j].Path
+	})
+	sort.Slice(contents.SourceBuilds, func(i, j int) bool {
+		if contents.SourceBuilds[i].Name != contents.SourceBuilds[j].Name {
+			return contents.SourceBuilds[i].Name < contents.SourceBuilds[j].Name
+		}
+		return contents.SourceBuilds[i].Number < contents.SourceBuilds[j].Number
+	})
+	return contents, nil
+}
+
+func newReleaseBundleRecordGetter(recordGetter releaseBundleRecordGetter, serverDetails *config.ServerDetails) (releaseBundleRecordGetter, error) {
+	if recordGetter != nil {
+		return recordGetter, nil
+	}
+	return newLifecycleApiClient(serverDetails)
+}
+
+// ReleaseBundleContentsCommand lists the artifacts of a release bundle version, their checksums and their source builds.
+type ReleaseBundleContentsCommand struct {
+	releaseBundleCmd
+	format       string
+	recordGetter releaseBundleRecordGetter
+}
+
+func NewReleaseBundleContentsCommand() *ReleaseBundleContentsCommand {
+	return &ReleaseBundleContentsCommand{}
+}
+
+func (rbl *ReleaseBundleContentsCommand) SetServerDetails(serverDetails *config.ServerDetails) *ReleaseBundleContentsCommand {
+	rbl.serverDetails = serverDetails
+	return rbl
+}
+
+func (rbl *ReleaseBundleContentsCommand) SetReleaseBundleName(releaseBundleName string) *ReleaseBundleContentsCommand {
+	rbl.releaseBundleName = releaseBundleName
+	return rbl
+}
+
+func (rbl *ReleaseBundleContentsCommand) SetReleaseBundleVersion(releaseBundleVersion string) *ReleaseBundleContentsCommand {
+	rbl.releaseBundleVersion = releaseBundleVersion
+	return rbl
+}
+
+func (rbl *ReleaseBundleContentsCommand) SetReleaseBundleProject(rbProjectKey string) *ReleaseBundleContentsCommand {
+	rbl.rbProjectKey = rbProjectKey
+	return rbl
+}
+
+func (rbl *ReleaseBundleContentsCommand) SetOutputFormat(format string) *ReleaseBundleContentsCommand {
+	rbl.format = format
+	return rbl
+}
+
+func (rbl *ReleaseBundleContentsCommand) CommandName() string {
+	return "rb_contents"
+}
+
+func (rbl *ReleaseBundleContentsCommand) ServerDetails() (*config.ServerDetails, error) {
+	return rbl.serverDetails, nil
+}
+
+func (rbl *ReleaseBundleContentsCommand) Run() error {
+	recordGetter, err := newReleaseBundleRecordGetter(rbl.recordGetter, rbl.serverDetails)
+	if err != nil {
+		return err
+	}
+	contents, err := getReleaseBundleContents(recordGetter, rbl.releaseBundleName, rbl.releaseBundleVersion, rbl.rbProjectKey)
+	if err != nil {
+		return err
+	}
+	if outputFormat := formats.Format(rbl.format); outputFormat == formats.JsonFormat || outputFormat == formats.YamlFormat {
+		return formats.Print(outputFormat, formats.ReleaseBundleContentsKind, contents)
+	}
+	return printReleaseBundleContentsTables(contents)
+}
+
+type artifactRow struct {
+	Path   string `col-name:"Path"`
+	Sha256 string `col-name:"SHA-256"`
+	Size   string `col-name:"Size"`
+}
+
+type sourceBuildRow struct {
+	Name   string `col-name:"Build Name"`
+	Number string `col-name:"Build Number"`
+}
+
+func printReleaseBundleContentsTables(contents *ReleaseBundleContents) error {
+	var artifactRows []artifactRow
+	for _, artifact := range contents.Artifacts {
+		artifactRows = append(artifactRows, artifactRow{Path: artifact.Path, Sha256: artifact.Sha256, Size: strconv.Itoa(artifact.Size)})
+	}
+	title := "Release Bundle " + contents.ReleaseBundleName + "/" + contents.ReleaseBundleVersion + " Artifacts"
+	if err := coreutils.PrintTable(artifactRows, title, "No artifacts found", false); err != nil {
+		return err
+	}
+	var buildRows []sourceBuildRow
+	for _, build := range contents.SourceBuilds {
+		buildRows = append(buildRows, sourceBuildRow(build))
+	}
+	return coreutils.PrintTable(buildRows, "Source Builds", "No source builds found", false)
+}
@@ -0,0 +1,47 @@
+package commands
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/jfrog/jfrog-client-go/lifecycle/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeRecordGetter returns the records of release bundle versions, given in JSON by their versions.
+type fakeRecordGetter map[string]string
+
+func (f fakeRecordGetter) GetReleaseBundleRecord(_, version, _ string) (*services.ReleaseBundleSpecResponse, error) {
+	record := &services.ReleaseBundleSpecResponse{}
+	return record, json.Unmarshal([]byte(f[version]), record)
+}
+
+var testRecords = fakeRecordGetter{
+	"1.0": `{"created_by":"admin","artifacts":[
+		{"path":"libs/app/app.jar","checksum":"aaa","source_repository_key":"libs","size":10,
+			"properties":[{"key":"build.name","values":["app"]},{"key":"build.number","values":["1"]}]},
+		{"path":"libs/app/app.pom","checksum":"bbb","size":2,
+			"properties":[{"key":"build.name","values":["app"]},{"key":"build.number","values":["1"]}]},
+		{"path":"libs/lib/lib.jar","checksum":"ccc","size":5}
+	]}`,
+	"2.0": `{"created_by":"admin","artifacts":[
+		{"path":"libs/app/app.pom","checksum":"bbb","size":2,
+			"properties":[{"key":"build.name","values":["app"]},{"key":"build.number","values":["2"]}]},
+		{"path":"libs/app/app.jar","checksum":"ddd","size":12,
+			"properties":[{"key":"build.name","values":["app"]},{"key":"build.number","values":["2"]}]},
+		{"path":"libs/app/app-sources.jar","checksum":"eee","size":4}
+	]}`,
+}
+
+func TestGetReleaseBundleContents(t *testing.T) {
+	contents, err := getReleaseBundleContents(testRecords, "app", "2.0", "")
+	require.NoError(t, err)
+	assert.Equal(t, "admin", contents.CreatedBy)
+	assert.Equal(t, []ReleaseBundleArtifact{
+		{Path: "libs/app/app-sources.jar", Sha256: "eee", Size: 4},
+		{Path: "libs/app/app.jar", Sha256: "ddd", Size: 12},
+		{Path: "libs/app/app.pom", Sha256: "bbb", Size: 2},
+	}, contents.Artifacts)
+	assert.Equal(t, []SourceBuild{{Name: "app", Number: "2"}}, contents.SourceBuilds)
+}
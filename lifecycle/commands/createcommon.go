@@ -6,6 +6,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clientconfig"
 	"github.com/jfrog/jfrog-cli-core/v2/common/spec"
 	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
 	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
@@ -33,8 +34,10 @@ type ReleaseBundleSources struct {
 type ReleaseBundleCreateCommand struct {
 	releaseBundleCmd
 	signingKeyName string
+	signingKeyType string
 	spec           *spec.SpecFiles
 	draft          bool
+	autoVersion    AutoVersionPolicy
 	// Backward compatibility:
 	buildsSpecPath         string
 	releaseBundlesSpecPath string
@@ -67,6 +70,12 @@ func (rbc *ReleaseBundleCreateCommand) SetSigningKeyName(signingKeyName string)
 	return rbc
 }
 
+// SetSigningKeyType sets the expected type of the signing key, GPG or RSA, which is validated before the release bundle is created.
+func (rbc *ReleaseBundleCreateCommand) SetSigningKeyType(signingKeyType string) *ReleaseBundleCreateCommand {
+	rbc.signingKeyType = signingKeyType
+	return rbc
+}
+
 func (rbc *ReleaseBundleCreateCommand) SetSync(sync bool) *ReleaseBundleCreateCommand {
 	rbc.sync = sync
 	return rbc
@@ -87,6 +96,12 @@ func (rbc *ReleaseBundleCreateCommand) SetDraft(draft bool) *ReleaseBundleCreate
 	return rbc
 }
 
+// SetAutoVersion sets the policy used to derive the version of the release bundle, instead of setting it explicitly.
+func (rbc *ReleaseBundleCreateCommand) SetAutoVersion(autoVersion AutoVersionPolicy) *ReleaseBundleCreateCommand {
+	rbc.autoVersion = autoVersion
+	return rbc
+}
+
 // Deprecated
 func (rbc *ReleaseBundleCreateCommand) SetBuildsSpecPath(buildsSpecPath string) *ReleaseBundleCreateCommand {
 	rbc.buildsSpecPath = buildsSpecPath
@@ -143,11 +158,24 @@ func (rbc *ReleaseBundleCreateCommand) Run() error {
 		}
 	}
 
+	if err := rbc.validateSigningKey(); err != nil {
+		return err
+	}
+
 	servicesManager, rbDetails, queryParams, err := rbc.getPrerequisites()
 	if err != nil {
 		return err
 	}
 
+	if rbc.autoVersion != "" {
+		if rbc.releaseBundleVersion, err = rbc.resolveAutoVersion(servicesManager); err != nil {
+			return err
+		}
+		rbDetails.ReleaseBundleVersion = rbc.releaseBundleVersion
+		log.Info(fmt.Sprintf("Creating release bundle '%s' with version '%s' according to the '%s' auto version policy.",
+			rbc.releaseBundleName, rbc.releaseBundleVersion, rbc.autoVersion))
+	}
+
 	var isReleaseBundleCreationWithMultiSourcesSupported bool
 	if err = ValidateFeatureSupportedVersion(rbc.serverDetails, minArtifactoryVersionForMultiSourceAndPackagesSupport); err != nil {
 		isReleaseBundleCreationWithMultiSourcesSupported = false
@@ -160,7 +188,8 @@ func (rbc *ReleaseBundleCreateCommand) Run() error {
 		return err
 	}
 
-	if sourceTypes != nil && isSingleSourceType(sourceTypes) {
+	// Sources from the command-line options are combined with the spec's sources.
+	if sourceTypes != nil && isSingleSourceType(sourceTypes) && !rbc.atLeastASingleMultiSourceRBDefinedFromCommand() {
 		switch sourceTypes[0] {
 		case services.Aql:
 			return rbc.createFromAql(servicesManager, rbDetails, queryParams)
@@ -190,6 +219,20 @@ func (rbc *ReleaseBundleCreateCommand) Run() error {
 	return errorutils.CheckErrorf("release bundle creation failed, unable to identify source for creation")
 }
 
+func (rbc *ReleaseBundleCreateCommand) validateSigningKey() error {
+	if rbc.signingKeyName == "" {
+		if rbc.signingKeyType != "" {
+			return errorutils.CheckErrorf("a signing key type was provided without the name of the signing key")
+		}
+		return nil
+	}
+	rtServicesManager, err := clientconfig.CreateServiceManager(rbc.serverDetails, 3, 0, false)
+	if err != nil {
+		return err
+	}
+	return validateSigningKey(rtServicesManager, rbc.signingKeyName, rbc.signingKeyType)
+}
+
 func updateReleaseBundleRepoKeyWithProject(sources []services.RbSource) {
 	if len(sources) == 0 || sources[0].SourceType != "release_bundles" {
 		return
@@ -225,6 +268,9 @@ func buildRbBuildsSources(sourcesStr, projectKey string, sources []services.RbSo
 	buildEntries := strings.Split(sourcesStr, ";")
 	for _, entry := range buildEntries {
 		buildInfoMap := parseKeyValueString(entry)
+		if isFilteredBuildEntry(buildInfoMap) {
+			continue
+		}
 		includeDepStr := buildInfoMap["include-deps"]
 		includeDep, _ := strconv.ParseBool(includeDepStr)
 		buildSources = append(buildSources, services.BuildSource{
@@ -243,6 +289,74 @@ func buildRbBuildsSources(sourcesStr, projectKey string, sources []services.RbSo
 	return sources
 }
 
+// Builds in the form of 'name=buildName, id=runID, include=pattern, exclude=pattern' include only their artifacts which
+// match the include pattern and don't match the exclude pattern.
+func isFilteredBuildEntry(buildInfoMap map[string]string) bool {
+	return buildInfoMap["include"] != "" || buildInfoMap["exclude"] != ""
+}
+
+// getFilteredBuildFiles returns the spec files which search the artifacts of the filtered builds of the sources.
+func getFilteredBuildFiles(sourcesStr, projectKey string) []spec.File {
+	var files []spec.File
+	for _, entry := range strings.Split(sourcesStr, ";") {
+		buildInfoMap := parseKeyValueString(entry)
+		if !isFilteredBuildEntry(buildInfoMap) {
+			continue
+		}
+		build := buildInfoMap["name"]
+		if buildInfoMap["id"] != "" {
+			build += "/" + buildInfoMap["id"]
+		}
+		file := spec.File{Build: build, Pattern: buildInfoMap["include"], IncludeDeps: buildInfoMap["include-deps"], Project: projectKey}
+		if file.Pattern == "" {
+			file.Pattern = "*"
+		}
+		if buildInfoMap["exclude"] != "" {
+			file.Exclusions = []string{buildInfoMap["exclude"]}
+		}
+		files = append(files, file)
+	}
+	return files
+}
+
+// appendFilteredBuildsSource appends the artifacts of the filtered builds of the sources, as an artifacts source.
+func appendFilteredBuildsSource(serverDetails *config.ServerDetails, sourcesStr, projectKey string, sources []services.RbSource) ([]services.RbSource, error) {
+	files := getFilteredBuildFiles(sourcesStr, projectKey)
+	if len(files) == 0 {
+		return sources, nil
+	}
+	artifactsSource, err := searchArtifactsSource(serverDetails, projectKey, files)
+	if err != nil {
+		return nil, err
+	}
+	if len(artifactsSource.Artifacts) == 0 {
+		return nil, errorutils.CheckErrorf("no artifacts matching the include and exclude patterns were found in the provided builds")
+	}
+	return append(sources, services.RbSource{SourceType: services.Artifacts, Artifacts: artifactsSource.Artifacts}), nil
+}
+
+// mergeRbSources merges the sources of the same type, in the order of their first appearance.
+func mergeRbSources(sources []services.RbSource) ([]services.RbSource, error) {
+	var merged []services.RbSource
+	indexes := make(map[services.SourceType]int)
+	for _, source := range sources {
+		i, exists := indexes[source.SourceType]
+		if !exists {
+			indexes[source.SourceType] = len(merged)
+			merged = append(merged, source)
+			continue
+		}
+		if source.SourceType == services.Aql {
+			return nil, errorutils.CheckErrorf(singleAqlErrMsg)
+		}
+		merged[i].Builds = append(merged[i].Builds, source.Builds...)
+		merged[i].ReleaseBundles = append(merged[i].ReleaseBundles, source.ReleaseBundles...)
+		merged[i].Artifacts = append(merged[i].Artifacts, source.Artifacts...)
+		merged[i].Packages = append(merged[i].Packages, source.Packages...)
+	}
+	return merged, nil
+}
+
 func buildRbReleaseBundlesSources(sourcesStr, projectKey string, sources []services.RbSource) []services.RbSource {
 	var releaseBundleSources []services.ReleaseBundleSource
 	bundleEntries := strings.Split(sourcesStr, ";")
@@ -263,11 +377,14 @@ func buildRbReleaseBundlesSources(sourcesStr, projectKey string, sources []servi
 	return sources
 }
 
-func buildReleaseBundleSourcesParams(rbc *ReleaseBundleCreateCommand) (sources []services.RbSource) {
+func buildReleaseBundleSourcesParams(rbc *ReleaseBundleCreateCommand) (sources []services.RbSource, err error) {
 
 	// Process Builds
 	if rbc.sourcesBuilds != "" {
 		sources = buildRbBuildsSources(rbc.sourcesBuilds, rbc.rbProjectKey, sources)
+		if sources, err = appendFilteredBuildsSource(rbc.serverDetails, rbc.sourcesBuilds, rbc.rbProjectKey, sources); err != nil {
+			return nil, err
+		}
 	}
 
 	// Process Release Bundles
@@ -275,7 +392,7 @@ func buildReleaseBundleSourcesParams(rbc *ReleaseBundleCreateCommand) (sources [
 		sources = buildRbReleaseBundlesSources(rbc.sourcesReleaseBundles, rbc.rbProjectKey, sources)
 	}
 
-	return sources
+	return sources, nil
 }
 
 func buildReleaseBundleSourcesParamsFromSpec(rbc *ReleaseBundleCreateCommand, detectedSources []services.SourceType) ([]services.RbSource, error) {
@@ -430,17 +547,28 @@ func (rbc *ReleaseBundleCreateCommand) createAqlQueryFromSpec() (aql string) {
 	return aql
 }
 
+// getMultipleSourcesIfDefined returns the sources defined by the command-line options and by the spec, combined.
 func (rbc *ReleaseBundleCreateCommand) getMultipleSourcesIfDefined() ([]services.RbSource, error) {
+	var sources []services.RbSource
 	if rbc.atLeastASingleMultiSourceRBDefinedFromCommand() {
-		return buildReleaseBundleSourcesParams(rbc), nil
-	} else {
-		detectedCreationSources, err := rbc.multiSourcesDefinedFromSpec()
+		commandSources, err := buildReleaseBundleSourcesParams(rbc)
 		if err != nil {
 			return nil, err
 		}
-
-		return buildReleaseBundleSourcesParamsFromSpec(rbc, detectedCreationSources)
+		sources = append(sources, commandSources...)
+		if rbc.spec == nil {
+			return mergeRbSources(sources)
+		}
 	}
+	detectedCreationSources, err := rbc.multiSourcesDefinedFromSpec()
+	if err != nil {
+		return nil, err
+	}
+	specSources, err := buildReleaseBundleSourcesParamsFromSpec(rbc, detectedCreationSources)
+	if err != nil {
+		return nil, err
+	}
+	return mergeRbSources(append(sources, specSources...))
 }
 
 func detectSourceTypesFromSpec(files []spec.File, multiSrcAndPackageSupported bool) ([]services.SourceType, error) {
@@ -560,8 +688,10 @@ func validateFile(file spec.File, packageAndMultiSourceSupported bool) (services
 			"release bundle creation file spec only supports the following fields: " +
 			"'aql', 'build', 'includeDeps', 'bundle', 'project', 'pattern', 'exclusions', 'props', 'excludeProps' and 'recursive'")
 	}
+	// A build with a pattern includes only its matching artifacts.
+	isFilteredBuild := isBuild && (isPattern || isExclusions)
 	if !packageAndMultiSourceSupported {
-		if coreutils.SumTrueValues([]bool{isAql, isBuild, isBundle, isPattern}) != 1 {
+		if coreutils.SumTrueValues([]bool{isAql, isBuild, isBundle, isPattern && !isFilteredBuild}) != 1 {
 			return "", errorutils.CheckErrorf("exactly one creation source should be defined per file (aql, builds, release bundles or pattern (artifacts))")
 		}
 	}
@@ -571,13 +701,17 @@ func validateFile(file spec.File, packageAndMultiSourceSupported bool) (services
 		return services.Aql,
 			validateCreationSource([]bool{isIncludeDeps, isProject, isExclusions, isProps, isExcludeProps, !isRecursive},
 				"aql creation source supports no other fields")
+	case isFilteredBuild:
+		return services.Artifacts,
+			validateCreationSource([]bool{isBundle, isProps, isExcludeProps, !isRecursive},
+				"builds creation source with a pattern or exclusions only supports the 'includeDeps' and 'project' fields")
 	case isBuild:
 		return services.Builds,
 			validateCreationSource([]bool{isExclusions, isProps, isExcludeProps, !isRecursive},
 				"builds creation source only supports the 'includeDeps' and 'project' fields")
 	case isBundle:
 		return services.ReleaseBundles,
-			validateCreationSource([]bool{isIncludeDeps, isExclusions, isProps, isExcludeProps, !isRecursive},
+			validateCreationSource([]bool{isIncludeDeps, isPattern, isExclusions, isProps, isExcludeProps, !isRecursive},
 				"release bundles creation source only supports the 'project' field")
 	case isPattern:
 		return services.Artifacts,
@@ -95,6 +95,9 @@ func TestValidateFile(t *testing.T) {
 		{"invalid bundles", spec.File{Bundle: "name/number", IncludeDeps: "true"}, true, ""},
 		{"invalid artifacts", spec.File{Pattern: "repo/path/file", Project: "proj"}, true, ""},
 		{"invalid source", spec.File{Package: "abc", Version: "ver"}, true, ""},
+		{"filtered build", spec.File{Build: "name/number", Pattern: "repo/*.jar", Exclusions: []string{"*-sources.jar"}}, false, services.Artifacts},
+		{"build with exclusions only", spec.File{Build: "name/number", Exclusions: []string{"*.pom"}}, false, services.Artifacts},
+		{"bundle with pattern", spec.File{Bundle: "name/number", Pattern: "repo/*.jar"}, true, ""},
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.testName, func(t *testing.T) {
@@ -208,3 +211,39 @@ func TestUpdateReleaseBundleRepoKeyWithProject(t *testing.T) {
 		})
 	}
 }
+
+func TestGetFilteredBuildFiles(t *testing.T) {
+	sources := "name=build1, id=1, include=repo/*.jar, exclude=*-sources.jar; name=build2, id=2; name=build3, exclude=*.pom, include-deps=true"
+	files := getFilteredBuildFiles(sources, "proj")
+	assert.Equal(t, []spec.File{
+		{Build: "build1/1", Pattern: "repo/*.jar", Exclusions: []string{"*-sources.jar"}, Project: "proj"},
+		{Build: "build3", Pattern: "*", Exclusions: []string{"*.pom"}, IncludeDeps: "true", Project: "proj"},
+	}, files)
+
+	// The filtered builds aren't added as builds sources.
+	rbSources := buildRbBuildsSources(sources, "", nil)
+	if assert.Len(t, rbSources, 1) && assert.Len(t, rbSources[0].Builds, 1) {
+		assert.Equal(t, "build2", rbSources[0].Builds[0].BuildName)
+	}
+	assert.Empty(t, buildRbBuildsSources("name=build1, id=1, include=repo/*.jar", "", nil))
+}
+
+func TestMergeRbSources(t *testing.T) {
+	sources := []services.RbSource{
+		{SourceType: services.Builds, Builds: []services.BuildSource{{BuildName: "build1"}}},
+		{SourceType: services.Artifacts, Artifacts: []services.ArtifactSource{{Path: "repo/a.jar"}}},
+		{SourceType: services.Builds, Builds: []services.BuildSource{{BuildName: "build2"}}},
+		{SourceType: services.Artifacts, Artifacts: []services.ArtifactSource{{Path: "repo/b.jar"}}},
+		{SourceType: services.Aql, Aql: "items.find()"},
+	}
+	merged, err := mergeRbSources(sources)
+	assert.NoError(t, err)
+	assert.Equal(t, []services.RbSource{
+		{SourceType: services.Builds, Builds: []services.BuildSource{{BuildName: "build1"}, {BuildName: "build2"}}},
+		{SourceType: services.Artifacts, Artifacts: []services.ArtifactSource{{Path: "repo/a.jar"}, {Path: "repo/b.jar"}}},
+		{SourceType: services.Aql, Aql: "items.find()"},
+	}, merged)
+
+	_, err = mergeRbSources(append(sources, services.RbSource{SourceType: services.Aql, Aql: "items.find()"}))
+	assert.EqualError(t, err, singleAqlErrMsg)
+}
@@ -1,9 +1,6 @@
 package commands
 
 import (
-	"errors"
-
-	"github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
 	"github.com/jfrog/jfrog-client-go/lifecycle"
 	"github.com/jfrog/jfrog-client-go/lifecycle/services"
 )
@@ -20,26 +17,5 @@ func (rbc *ReleaseBundleCreateCommand) createFromArtifacts(lcServicesManager *li
 }
 
 func (rbc *ReleaseBundleCreateCommand) createArtifactSourceFromSpec() (services.CreateFromArtifacts, error) {
-	var artifactsSource services.CreateFromArtifacts
-	rtServicesManager, err := utils.CreateServiceManager(rbc.serverDetails, 3, 0, false)
-	if err != nil {
-		return artifactsSource, err
-	}
-
-	searchResults, callbackFunc, err := utils.SearchFilesBySpecs(rtServicesManager, getArtifactFilesFromSpec(rbc.spec.Files))
-	if err != nil {
-		return artifactsSource, err
-	}
-
-	defer func() {
-		if callbackFunc != nil {
-			err = errors.Join(err, callbackFunc())
-		}
-	}()
-
-	artifactsSource, err = aqlResultToArtifactsSource(searchResults)
-	if err != nil {
-		return artifactsSource, err
-	}
-	return artifactsSource, nil
+	return searchArtifactsSource(rbc.serverDetails, rbc.rbProjectKey, getArtifactFilesFromSpec(rbc.spec.Files))
 }
@@ -3,6 +3,7 @@ package commands
 import (
 	"errors"
 	"fmt"
+	artifactoryutils "github.com/jfrog/jfrog-cli-artifactory/artifactory/utils"
 	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
 	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
 	"github.com/jfrog/jfrog-client-go/lifecycle"
@@ -15,6 +16,7 @@ type ReleaseBundleDeleteCommand struct {
 	releaseBundleCmd
 	environment string
 	quiet       bool
+	dryRun      bool
 }
 
 func NewReleaseBundleDeleteCommand() *ReleaseBundleDeleteCommand {
@@ -56,6 +58,12 @@ func (rbd *ReleaseBundleDeleteCommand) SetQuiet(quiet bool) *ReleaseBundleDelete
 	return rbd
 }
 
+// SetDryRun sets the command to log the deletion, without deleting the release bundle or its promotions.
+func (rbd *ReleaseBundleDeleteCommand) SetDryRun(dryRun bool) *ReleaseBundleDeleteCommand {
+	rbd.dryRun = dryRun
+	return rbd
+}
+
 func (rbd *ReleaseBundleDeleteCommand) CommandName() string {
 	return "rb_delete"
 }
@@ -84,7 +92,7 @@ func (rbd *ReleaseBundleDeleteCommand) deletePromotionsOnly(servicesManager *lif
 	rbDetails services.ReleaseBundleDetails, commonQueryParams services.CommonOptionalQueryParams) error {
 
 	deletionSubject := fmt.Sprintf("all promotions to environment '%s' of release bundle '%s/%s'", rbd.environment, rbd.releaseBundleName, rbd.releaseBundleVersion)
-	if !rbd.confirmDelete(deletionSubject) {
+	if !rbd.dryRun && !rbd.confirmDelete(deletionSubject) {
 		return nil
 	}
 
@@ -97,6 +105,11 @@ func (rbd *ReleaseBundleDeleteCommand) deletePromotionsOnly(servicesManager *lif
 	fail := 0
 	for _, promotion := range response.Promotions {
 		if strings.EqualFold(promotion.Environment, rbd.environment) {
+			if rbd.dryRun {
+				artifactoryutils.LogDryRun(fmt.Sprintf("Deleting the promotion to environment '%s' created at %s", promotion.Environment, promotion.CreatedMillis.String()))
+				success++
+				continue
+			}
 			if curErr := servicesManager.DeleteReleaseBundleVersionPromotion(rbDetails, commonQueryParams, promotion.CreatedMillis.String()); curErr != nil {
 				err = errors.Join(err, curErr)
 				fail++
@@ -107,7 +120,7 @@ func (rbd *ReleaseBundleDeleteCommand) deletePromotionsOnly(servicesManager *lif
 	}
 	if success == 0 && fail == 0 {
 		log.Info(fmt.Sprintf("No promotions were found for environment '%s'", rbd.environment))
-	} else {
+	} else if !rbd.dryRun {
 		log.Info(fmt.Sprintf("Promotions deleted successfully: %d, failed: %d", success, fail))
 	}
 
@@ -117,6 +130,13 @@ func (rbd *ReleaseBundleDeleteCommand) deletePromotionsOnly(servicesManager *lif
 func (rbd *ReleaseBundleDeleteCommand) deleteLocalReleaseBundle(servicesManager *lifecycle.LifecycleServicesManager,
 	rbDetails services.ReleaseBundleDetails, queryParams services.CommonOptionalQueryParams) error {
 	deletionSubject := fmt.Sprintf("release bundle '%s/%s' locally with all its promotions", rbd.releaseBundleName, rbd.releaseBundleVersion)
+	if rbd.dryRun {
+		if err := rbd.checkReleaseBundleExists(servicesManager); err != nil {
+			return err
+		}
+		artifactoryutils.LogDryRun("Deleting the " + deletionSubject)
+		return nil
+	}
 	if !rbd.confirmDelete(deletionSubject) {
 		return nil
 	}
@@ -0,0 +1,186 @@
+package commands
+
+import (
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/formats"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
+)
+
+// ReleaseBundleDiff lists the changes between two versions of a release bundle.
+type ReleaseBundleDiff struct {
+	ReleaseBundleName string                  `json:"releaseBundleName"`
+	FromVersion       string                  `json:"fromVersion"`
+	ToVersion         string                  `json:"toVersion"`
+	Added             []ReleaseBundleArtifact `json:"added"`
+	Removed           []ReleaseBundleArtifact `json:"removed"`
+	// Artifacts with the same path in both versions, but with different checksums.
+	Changed       []ChangedArtifact `json:"changed"`
+	AddedBuilds   []SourceBuild     `json:"addedBuilds"`
+	RemovedBuilds []SourceBuild     `json:"removedBuilds"`
+}
+
+type ChangedArtifact struct {
+	Path       string `json:"path"`
+	FromSha256 string `json:"fromSha256"`
+	ToSha256   string `json:"toSha256"`
+	FromSize   int    `json:"fromSize"`
+	ToSize     int    `json:"toSize"`
+}
+
+// diffReleaseBundleContents compares the contents of two versions. The artifacts are compared by their paths and
+// checksums, and the results keep the order of the artifacts in the contents.
+func diffReleaseBundleContents(from, to *ReleaseBundleContents) *ReleaseBundleDiff {
+	diff := &ReleaseBundleDiff{
+		ReleaseBundleName: from.ReleaseBundleName,
+		FromVersion:       from.ReleaseBundleVersion,
+		ToVersion:         to.ReleaseBundleVersion,
+		Added:             []ReleaseBundleArtifact{},
+		Removed:           []ReleaseBundleArtifact{},
+		Changed:           []ChangedArtifact{},
+		AddedBuilds:       []SourceBuild{},
+		RemovedBuilds:     []SourceBuild{},
+	}
+	fromArtifacts := make(map[string]ReleaseBundleArtifact)
+	for _, artifact := range from.Artifacts {
+		fromArtifacts[artifact.Path] = artifact
+	}
+	toArtifacts := make(map[string]bool)
+	for _, artifact := range to.Artifacts {
+		toArtifacts[artifact.Path] = true
+		fromArtifact, exists := fromArtifacts[artifact.Path]
+		switch {
+		case !exists:
+			diff.Added = append(diff.Added, artifact)
+		case fromArtifact.Sha256 != artifact.Sha256:
+			diff.Changed = append(diff.Changed, ChangedArtifact{
+				Path:       artifact.Path,
+				FromSha256: fromArtifact.Sha256,
+				ToSha256:   artifact.Sha256,
+				FromSize:   fromArtifact.Size,
+				ToSize:     artifact.Size,
+			})
+		}
+	}
+	for _, artifact := range from.Artifacts {
+		if !toArtifacts[artifact.Path] {
+			diff.Removed = append(diff.Removed, artifact)
+		}
+	}
+	diff.AddedBuilds = subtractBuilds(to.SourceBuilds, from.SourceBuilds)
+	diff.RemovedBuilds = subtractBuilds(from.SourceBuilds, to.SourceBuilds)
+	return diff
+}
+
+// Returns the builds which aren't in the subtrahend.
+func subtractBuilds(builds, subtrahend []SourceBuild) []SourceBuild {
+	excluded := make(map[SourceBuild]bool)
+	for _, build := range subtrahend {
+		excluded[build] = true
+	}
+	result := []SourceBuild{}
+	for _, build := range builds {
+		if !excluded[build] {
+			result = append(result, build)
+		}
+	}
+	return result
+}
+
+// ReleaseBundleDiffCommand prints the artifacts and source builds which were added, removed or changed between two
+// versions of a release bundle.
+type ReleaseBundleDiffCommand struct {
+	releaseBundleCmd
+	toVersion    string
+	format       string
+	recordGetter releaseBundleRecordGetter
+}
+
+func NewReleaseBundleDiffCommand() *ReleaseBundleDiffCommand {
+	return &ReleaseBundleDiffCommand{}
+}
+
+func (rbdf *ReleaseBundleDiffCommand) SetServerDetails(serverDetails *config.ServerDetails) *ReleaseBundleDiffCommand {
+	rbdf.serverDetails = serverDetails
+	return rbdf
+}
+
+func (rbdf *ReleaseBundleDiffCommand) SetReleaseBundleName(releaseBundleName string) *ReleaseBundleDiffCommand {
+	rbdf.releaseBundleName = releaseBundleName
+	return rbdf
+}
+
+// SetFromVersion sets the version to compare from, usually the version currently promoted.
+func (rbdf *ReleaseBundleDiffCommand) SetFromVersion(fromVersion string) *ReleaseBundleDiffCommand {
+	rbdf.releaseBundleVersion = fromVersion
+	return rbdf
+}
+
+func (rbdf *ReleaseBundleDiffCommand) SetToVersion(toVersion string) *ReleaseBundleDiffCommand {
+	rbdf.toVersion = toVersion
+	return rbdf
+}
+
+func (rbdf *ReleaseBundleDiffCommand) SetReleaseBundleProject(rbProjectKey string) *ReleaseBundleDiffCommand {
+	rbdf.rbProjectKey = rbProjectKey
+	return rbdf
+}
+
+func (rbdf *ReleaseBundleDiffCommand) SetOutputFormat(format string) *ReleaseBundleDiffCommand {
+	rbdf.format = format
+	return rbdf
+}
+
+func (rbdf *ReleaseBundleDiffCommand) CommandName() string {
+	return "rb_diff"
+}
+
+func (rbdf *ReleaseBundleDiffCommand) ServerDetails() (*config.ServerDetails, error) {
+	return rbdf.serverDetails, nil
+}
+
+func (rbdf *ReleaseBundleDiffCommand) Run() error {
+	recordGetter, err := newReleaseBundleRecordGetter(rbdf.recordGetter, rbdf.serverDetails)
+	if err != nil {
+		return err
+	}
+	from, err := getReleaseBundleContents(recordGetter, rbdf.releaseBundleName, rbdf.releaseBundleVersion, rbdf.rbProjectKey)
+	if err != nil {
+		return err
+	}
+	to, err := getReleaseBundleContents(recordGetter, rbdf.releaseBundleName, rbdf.toVersion, rbdf.rbProjectKey)
+	if err != nil {
+		return err
+	}
+	diff := diffReleaseBundleContents(from, to)
+	if outputFormat := formats.Format(rbdf.format); outputFormat == formats.JsonFormat || outputFormat == formats.YamlFormat {
+		return formats.Print(outputFormat, formats.ReleaseBundleDiffKind, diff)
+	}
+	return printReleaseBundleDiffTable(diff)
+}
+
+type diffRow struct {
+	Change string `col-name:"Change"`
+	Path   string `col-name:"Path / Build"`
+	Sha256 string `col-name:"SHA-256"`
+}
+
+func printReleaseBundleDiffTable(diff *ReleaseBundleDiff) error {
+	var rows []diffRow
+	for _, artifact := range diff.Added {
+		rows = append(rows, diffRow{Change: "Added", Path: artifact.Path, Sha256: artifact.Sha256})
+	}
+	for _, artifact := range diff.Removed {
+		rows = append(rows, diffRow{Change: "Removed", Path: artifact.Path, Sha256: artifact.Sha256})
+	}
+	for _, artifact := range diff.Changed {
+		rows = append(rows, diffRow{Change: "Changed", Path: artifact.Path, Sha256: artifact.FromSha256 + " -> " + artifact.ToSha256})
+	}
+	for _, build := range diff.AddedBuilds {
+		rows = append(rows, diffRow{Change: "Added build", Path: build.Name + "/" + build.Number})
+	}
+	for _, build := range diff.RemovedBuilds {
+		rows = append(rows, diffRow{Change: "Removed build", Path: build.Name + "/" + build.Number})
+	}
+	title := "Release Bundle " + diff.ReleaseBundleName + " changes from " + diff.FromVersion + " to " + diff.ToVersion
+	return coreutils.PrintTable(rows, title, "The release bundle versions have the same contents", false)
+}
@@ -0,0 +1,33 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffReleaseBundleContents(t *testing.T) {
+	from, err := getReleaseBundleContents(testRecords, "app", "1.0", "")
+	require.NoError(t, err)
+	to, err := getReleaseBundleContents(testRecords, "app", "2.0", "")
+	require.NoError(t, err)
+
+	diff := diffReleaseBundleContents(from, to)
+	assert.Equal(t, &ReleaseBundleDiff{
+		ReleaseBundleName: "app",
+		FromVersion:       "1.0",
+		ToVersion:         "2.0",
+		Added:             []ReleaseBundleArtifact{{Path: "libs/app/app-sources.jar", Sha256: "eee", Size: 4}},
+		Removed:           []ReleaseBundleArtifact{{Path: "libs/lib/lib.jar", Sha256: "ccc", Size: 5}},
+		Changed:           []ChangedArtifact{{Path: "libs/app/app.jar", FromSha256: "aaa", ToSha256: "ddd", FromSize: 10, ToSize: 12}},
+		AddedBuilds:       []SourceBuild{{Name: "app", Number: "2"}},
+		RemovedBuilds:     []SourceBuild{{Name: "app", Number: "1"}},
+	}, diff)
+
+	diff = diffReleaseBundleContents(to, to)
+	assert.Empty(t, diff.Added)
+	assert.Empty(t, diff.Removed)
+	assert.Empty(t, diff.Changed)
+	assert.Empty(t, diff.AddedBuilds)
+}
@@ -0,0 +1,271 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	clientUtils "github.com/jfrog/jfrog-client-go/utils"
+	"github.com/jfrog/jfrog-client-go/utils/distribution"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const (
+	distributionTrackersApi        = "api/v2/distribution/trackers"
+	DefaultDistributionWaitTimeout = 60 * time.Minute
+	distributionStatusPollInterval = 10 * time.Second
+)
+
+// distributionTracker gets the trackers of the distributions of release bundle versions.
+type distributionTracker interface {
+	// Returns the IDs of the trackers of all the distributions of the release bundle version.
+	GetTrackerIds(name, version, projectKey string) ([]json.Number, error)
+	GetDistributionStatus(name, version, projectKey string, trackerId json.Number) (*distribution.DistributionStatusResponse, error)
+}
+
+// DistributionStatusReport is the machine-readable status of a release bundle distribution to each of its target sites.
+type DistributionStatusReport struct {
+	ReleaseBundleName    string                   `json:"releaseBundleName"`
+	ReleaseBundleVersion string                   `json:"releaseBundleVersion"`
+	TrackerId            string                   `json:"trackerId"`
+	Status               string                   `json:"status"`
+	Sites                []SiteDistributionStatus `json:"sites"`
+}
+
+type SiteDistributionStatus struct {
+	Name             string   `json:"name"`
+	ServiceId        string   `json:"serviceId,omitempty"`
+	Type             string   `json:"type,omitempty"`
+	Status           string   `json:"status"`
+	Success          bool     `json:"success"`
+	DistributedFiles int64    `json:"distributedFiles"`
+	TotalFiles       int64    `json:"totalFiles"`
+	DistributedBytes int64    `json:"distributedBytes"`
+	TotalBytes       int64    `json:"totalBytes"`
+	Error            string   `json:"error,omitempty"`
+	FileErrors       []string `json:"fileErrors,omitempty"`
+}
+
+// ReleaseBundleDistributionStatusCommand prints the status of a release bundle distribution to each of its target
+// sites, and optionally waits for the distribution to complete.
+type ReleaseBundleDistributionStatusCommand struct {
+	releaseBundleCmd
+	trackerId string
+	watch     bool
+	timeout   time.Duration
+	tracker   distributionTracker
+	sleep     func(time.Duration)
+}
+
+func NewReleaseBundleDistributionStatusCommand() *ReleaseBundleDistributionStatusCommand {
+	return &ReleaseBundleDistributionStatusCommand{timeout: DefaultDistributionWaitTimeout, sleep: time.Sleep}
+}
+
+func (rbds *ReleaseBundleDistributionStatusCommand) SetServerDetails(serverDetails *config.ServerDetails) *ReleaseBundleDistributionStatusCommand {
+	rbds.serverDetails = serverDetails
+	return rbds
+}
+
+func (rbds *ReleaseBundleDistributionStatusCommand) SetReleaseBundleName(releaseBundleName string) *ReleaseBundleDistributionStatusCommand {
+	rbds.releaseBundleName = releaseBundleName
+	return rbds
+}
+
+func (rbds *ReleaseBundleDistributionStatusCommand) SetReleaseBundleVersion(releaseBundleVersion string) *ReleaseBundleDistributionStatusCommand {
+	rbds.releaseBundleVersion = releaseBundleVersion
+	return rbds
+}
+
+func (rbds *ReleaseBundleDistributionStatusCommand) SetReleaseBundleProject(rbProjectKey string) *ReleaseBundleDistributionStatusCommand {
+	rbds.rbProjectKey = rbProjectKey
+	return rbds
+}
+
+// SetTrackerId sets the tracker of the distribution. If not set, the latest distribution of the release bundle version is used.
+func (rbds *ReleaseBundleDistributionStatusCommand) SetTrackerId(trackerId string) *ReleaseBundleDistributionStatusCommand {
+	rbds.trackerId = trackerId
+	return rbds
+}
+
+func (rbds *ReleaseBundleDistributionStatusCommand) SetWatch(watch bool) *ReleaseBundleDistributionStatusCommand {
+	rbds.watch = watch
+	return rbds
+}
+
+func (rbds *ReleaseBundleDistributionStatusCommand) SetTimeout(timeout time.Duration) *ReleaseBundleDistributionStatusCommand {
+	rbds.timeout = timeout
+	return rbds
+}
+
+func (rbds *ReleaseBundleDistributionStatusCommand) CommandName() string {
+	return "rb_distribution_status"
+}
+
+func (rbds *ReleaseBundleDistributionStatusCommand) ServerDetails() (*config.ServerDetails, error) {
+	return rbds.serverDetails, nil
+}
+
+func (rbds *ReleaseBundleDistributionStatusCommand) Run() error {
+	if rbds.tracker == nil {
+		tracker, err := newLifecycleDistributionTracker(rbds.serverDetails)
+		if err != nil {
+			return err
+		}
+		rbds.tracker = tracker
+	}
+	report, err := rbds.getStatus()
+	if err != nil {
+		return err
+	}
+	content, err := json.Marshal(report)
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	log.Output(clientUtils.IndentJson(content))
+	return checkDistributionStatusReport(report, rbds.watch, rbds.timeout)
+}
+
+// getStatus gets the status of the distribution, and waits for it to complete in watch mode, rendering the progress of
+// each site as it changes. If the timeout is reached, the last status is returned.
+func (rbds *ReleaseBundleDistributionStatusCommand) getStatus() (*DistributionStatusReport, error) {
+	trackerId := json.Number(rbds.trackerId)
+	if trackerId == "" {
+		latest, err := rbds.getLatestTrackerId()
+		if err != nil {
+			return nil, err
+		}
+		trackerId = latest
+	}
+	deadline := time.Now().Add(rbds.timeout)
+	lastProgress := ""
+	for {
+		status, err := rbds.tracker.GetDistributionStatus(rbds.releaseBundleName, rbds.releaseBundleVersion, rbds.rbProjectKey, trackerId)
+		if err != nil {
+			return nil, err
+		}
+		report := toDistributionStatusReport(rbds.releaseBundleName, rbds.releaseBundleVersion, trackerId, status)
+		if !rbds.watch || isDistributionDone(report.Status) || !time.Now().Before(deadline) {
+			return report, nil
+		}
+		if progress := renderDistributionProgress(report); progress != lastProgress {
+			log.Info(progress)
+			lastProgress = progress
+		}
+		rbds.sleep(min(distributionStatusPollInterval, time.Until(deadline)))
+	}
+}
+
+func (rbds *ReleaseBundleDistributionStatusCommand) getLatestTrackerId() (json.Number, error) {
+	trackerIds, err := rbds.tracker.GetTrackerIds(rbds.releaseBundleName, rbds.releaseBundleVersion, rbds.rbProjectKey)
+	if err != nil {
+		return "", err
+	}
+	var latest json.Number
+	var latestId int64 = -1
+	for _, trackerId := range trackerIds {
+		id, err := trackerId.Int64()
+		if err != nil {
+			return "", errorutils.CheckErrorf("received an invalid distribution tracker ID '%s'", trackerId)
+		}
+		if id > latestId {
+			latest, latestId = trackerId, id
+		}
+	}
+	if latest == "" {
+		return "", errorutils.CheckErrorf("release bundle %s/%s has not been distributed", rbds.releaseBundleName, rbds.releaseBundleVersion)
+	}
+	return latest, nil
+}
+
+func toDistributionStatusReport(name, version string, trackerId json.Number, status *distribution.DistributionStatusResponse) *DistributionStatusReport {
+	report := &DistributionStatusReport{ReleaseBundleName: name, ReleaseBundleVersion: version, TrackerId: trackerId.String(), Status: string(status.Status), Sites: []SiteDistributionStatus{}}
+	for _, site := range status.Sites {
+		siteStatus := SiteDistributionStatus{
+			Name:       site.TargetArtifactory.Name,
+			ServiceId:  site.TargetArtifactory.ServiceId,
+			Type:       site.TargetArtifactory.Type,
+			Status:     string(site.Status),
+			Success:    strings.EqualFold(string(site.Status), string(distribution.Completed)),
+			Error:      site.Error,
+			FileErrors: site.FileErrors,
+		}
+		siteStatus.DistributedFiles, _ = site.DistributedFiles.Int64()
+		siteStatus.TotalFiles, _ = site.TotalFiles.Int64()
+		siteStatus.DistributedBytes, _ = site.DistributedBytes.Int64()
+		siteStatus.TotalBytes, _ = site.TotalBytes.Int64()
+		report.Sites = append(report.Sites, siteStatus)
+	}
+	return report
+}
+
+func isDistributionDone(status string) bool {
+	return strings.EqualFold(status, string(distribution.Completed)) || strings.EqualFold(status, string(distribution.Failed))
+}
+
+func renderDistributionProgress(report *DistributionStatusReport) string {
+	builder := &strings.Builder{}
+	fmt.Fprintf(builder, "Distribution %s of %s/%s: %s", report.TrackerId, report.ReleaseBundleName, report.ReleaseBundleVersion, report.Status)
+	for _, site := range report.Sites {
+		fmt.Fprintf(builder, "\n  %s: %s (%d/%d files", site.Name, site.Status, site.DistributedFiles, site.TotalFiles)
+		if site.TotalBytes > 0 {
+			fmt.Fprintf(builder, ", %d%%", site.DistributedBytes*100/site.TotalBytes)
+		}
+		builder.WriteString(")")
+	}
+	return builder.String()
+}
+
+// checkDistributionStatusReport returns an error if the distribution failed, or if it didn't complete in watch mode.
+func checkDistributionStatusReport(report *DistributionStatusReport, watch bool, timeout time.Duration) error {
+	if strings.EqualFold(report.Status, string(distribution.Failed)) {
+		var failedSites []string
+		for _, site := range report.Sites {
+			if !site.Success {
+				failedSites = append(failedSites, site.Name)
+			}
+		}
+		return errorutils.CheckErrorf("the distribution of release bundle %s/%s failed to: %s", report.ReleaseBundleName, report.ReleaseBundleVersion, strings.Join(failedSites, ", "))
+	}
+	if watch && !isDistributionDone(report.Status) {
+		return errorutils.CheckErrorf("timed out after %s waiting for the distribution of release bundle %s/%s to complete", timeout, report.ReleaseBundleName, report.ReleaseBundleVersion)
+	}
+	return nil
+}
+
+// lifecycleDistributionTracker gets the distribution trackers with the lifecycle REST API.
+type lifecycleDistributionTracker struct {
+	*lifecycleApiClient
+}
+
+func newLifecycleDistributionTracker(serverDetails *config.ServerDetails) (*lifecycleDistributionTracker, error) {
+	apiClient, err := newLifecycleApiClient(serverDetails)
+	if err != nil {
+		return nil, err
+	}
+	return &lifecycleDistributionTracker{apiClient}, nil
+}
+
+func (ldt *lifecycleDistributionTracker) GetTrackerIds(name, version, projectKey string) ([]json.Number, error) {
+	var trackers []struct {
+		TrackerId json.Number `json:"distribution_tracker_id"`
+	}
+	if err := ldt.get(path.Join(distributionTrackersApi, url.PathEscape(name), url.PathEscape(version)), projectKey, &trackers); err != nil {
+		return nil, err
+	}
+	var trackerIds []json.Number
+	for _, tracker := range trackers {
+		trackerIds = append(trackerIds, tracker.TrackerId)
+	}
+	return trackerIds, nil
+}
+
+func (ldt *lifecycleDistributionTracker) GetDistributionStatus(name, version, projectKey string, trackerId json.Number) (*distribution.DistributionStatusResponse, error) {
+	status := &distribution.DistributionStatusResponse{}
+	err := ldt.get(path.Join(distributionTrackersApi, url.PathEscape(name), url.PathEscape(version), trackerId.String()), projectKey, status)
+	return status, err
+}
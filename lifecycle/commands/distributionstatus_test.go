@@ -0,0 +1,111 @@
+package commands
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/jfrog/jfrog-client-go/utils/distribution"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeDistributionTracker struct {
+	trackerIds []json.Number
+	// The statuses returned by successive requests. The last one is returned once the others are exhausted.
+	statuses         []*distribution.DistributionStatusResponse
+	statusRequests   int
+	requestedTracker json.Number
+}
+
+func (f *fakeDistributionTracker) GetTrackerIds(_, _, _ string) ([]json.Number, error) {
+	return f.trackerIds, nil
+}
+
+func (f *fakeDistributionTracker) GetDistributionStatus(_, _, _ string, trackerId json.Number) (*distribution.DistributionStatusResponse, error) {
+	f.requestedTracker = trackerId
+	status := f.statuses[min(f.statusRequests, len(f.statuses)-1)]
+	f.statusRequests++
+	return status, nil
+}
+
+func newDistributionStatus(status distribution.DistributionStatus, sites ...distribution.DistributionSiteStatus) *distribution.DistributionStatusResponse {
+	return &distribution.DistributionStatusResponse{Status: status, Sites: sites}
+}
+
+func newSiteStatus(name string, status distribution.DistributionStatus, distributedFiles string) distribution.DistributionSiteStatus {
+	return distribution.DistributionSiteStatus{
+		Status:            status,
+		TargetArtifactory: distribution.TargetArtifactory{Name: name, Type: "edge"},
+		DistributedFiles:  json.Number(distributedFiles),
+		TotalFiles:        "4",
+		DistributedBytes:  json.Number(distributedFiles + "00"),
+		TotalBytes:        "400",
+	}
+}
+
+func newTestDistributionStatusCommand(tracker *fakeDistributionTracker) *ReleaseBundleDistributionStatusCommand {
+	rbds := NewReleaseBundleDistributionStatusCommand().SetReleaseBundleName("app").SetReleaseBundleVersion("1.0")
+	rbds.tracker = tracker
+	rbds.sleep = func(time.Duration) {}
+	return rbds
+}
+
+func TestDistributionStatusLatestTracker(t *testing.T) {
+	tracker := &fakeDistributionTracker{
+		trackerIds: []json.Number{"7", "12", "9"},
+		statuses:   []*distribution.DistributionStatusResponse{newDistributionStatus(distribution.InProgress, newSiteStatus("edge-1", distribution.InProgress, "1"))},
+	}
+	report, err := newTestDistributionStatusCommand(tracker).getStatus()
+	require.NoError(t, err)
+	assert.Equal(t, json.Number("12"), tracker.requestedTracker)
+	assert.Equal(t, 1, tracker.statusRequests)
+	assert.Equal(t, &DistributionStatusReport{
+		ReleaseBundleName:    "app",
+		ReleaseBundleVersion: "1.0",
+		TrackerId:            "12",
+		Status:               string(distribution.InProgress),
+		Sites: []SiteDistributionStatus{{Name: "edge-1", Type: "edge", Status: string(distribution.InProgress),
+			DistributedFiles: 1, TotalFiles: 4, DistributedBytes: 100, TotalBytes: 400}},
+	}, report)
+	assert.NoError(t, checkDistributionStatusReport(report, false, 0))
+
+	_, err = newTestDistributionStatusCommand(&fakeDistributionTracker{}).getStatus()
+	assert.EqualError(t, err, "release bundle app/1.0 has not been distributed")
+}
+
+func TestDistributionStatusWatch(t *testing.T) {
+	tracker := &fakeDistributionTracker{statuses: []*distribution.DistributionStatusResponse{
+		newDistributionStatus(distribution.InProgress, newSiteStatus("edge-1", distribution.InProgress, "1"), newSiteStatus("edge-2", distribution.InQueue, "0")),
+		newDistributionStatus(distribution.InProgress, newSiteStatus("edge-1", distribution.Completed, "4"), newSiteStatus("edge-2", distribution.InProgress, "2")),
+		newDistributionStatus(distribution.Failed, newSiteStatus("edge-1", distribution.Completed, "4"), newSiteStatus("edge-2", distribution.Failed, "2")),
+	}}
+	rbds := newTestDistributionStatusCommand(tracker).SetTrackerId("5").SetWatch(true)
+	report, err := rbds.getStatus()
+	require.NoError(t, err)
+	assert.Equal(t, 3, tracker.statusRequests)
+	assert.Equal(t, json.Number("5"), tracker.requestedTracker)
+	if assert.Len(t, report.Sites, 2) {
+		assert.True(t, report.Sites[0].Success)
+		assert.False(t, report.Sites[1].Success)
+	}
+	assert.EqualError(t, checkDistributionStatusReport(report, true, rbds.timeout), "the distribution of release bundle app/1.0 failed to: edge-2")
+}
+
+func TestDistributionStatusWatchTimeout(t *testing.T) {
+	tracker := &fakeDistributionTracker{statuses: []*distribution.DistributionStatusResponse{
+		newDistributionStatus(distribution.InProgress, newSiteStatus("edge-1", distribution.InProgress, "1")),
+	}}
+	rbds := newTestDistributionStatusCommand(tracker).SetTrackerId("5").SetWatch(true).SetTimeout(0)
+	report, err := rbds.getStatus()
+	require.NoError(t, err)
+	assert.Equal(t, 1, tracker.statusRequests)
+	assert.EqualError(t, checkDistributionStatusReport(report, true, 0), "timed out after 0s waiting for the distribution of release bundle app/1.0 to complete")
+}
+
+func TestRenderDistributionProgress(t *testing.T) {
+	report := toDistributionStatusReport("app", "1.0", "5", newDistributionStatus(distribution.InProgress,
+		newSiteStatus("edge-1", distribution.Completed, "4"), newSiteStatus("edge-2", distribution.InProgress, "1")))
+	assert.Equal(t, "Distribution 5 of app/1.0: In progress\n  edge-1: Completed (4/4 files, 100%)\n  edge-2: In progress (1/4 files, 25%)",
+		renderDistributionProgress(report))
+}
@@ -1,6 +1,7 @@
 package commands
 
 import (
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clientconfig"
 	artUtils "github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
 	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
 	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
@@ -19,6 +20,8 @@ type ReleaseBundleExportCommand struct {
 	modifications          services.Modifications
 	downloadConfigurations artUtils.DownloadConfiguration
 	targetPath             string
+	// Whether to write the manifest used to verify the archive and the imported release bundle.
+	writeManifest bool
 }
 
 func (rbe *ReleaseBundleExportCommand) Run() (err error) {
@@ -42,9 +45,34 @@ func (rbe *ReleaseBundleExportCommand) Run() (err error) {
 		return
 	}
 	log.Info("Successfully Downloaded Release Bundle archive")
+	if rbe.writeManifest {
+		return rbe.writeArchiveManifest(exportResponse)
+	}
 	return
 }
 
+func (rbe *ReleaseBundleExportCommand) writeArchiveManifest(exportResponse services.ReleaseBundleExportedStatusResponse) error {
+	artifactoryServiceManager, err := createArtifactoryServiceManager(rbe.serverDetails)
+	if err != nil {
+		return err
+	}
+	apiClient, err := newLifecycleApiClient(rbe.serverDetails)
+	if err != nil {
+		return err
+	}
+	manifest, err := createArchiveManifest(artifactoryServiceManager, apiClient, rbe.releaseBundleName, rbe.releaseBundleVersion,
+		rbe.rbProjectKey, strings.TrimPrefix(exportResponse.RelativeUrl, "/"))
+	if err != nil {
+		return err
+	}
+	manifestPath := getArchiveManifestPath(rbe.targetPath, rbe.releaseBundleName, rbe.releaseBundleVersion)
+	if err = writeArchiveManifest(manifest, manifestPath); err != nil {
+		return err
+	}
+	log.Info("Wrote the manifest of the Release Bundle archive to", manifestPath)
+	return nil
+}
+
 // Download the exported release bundle using artifactory service manager
 func (rbe *ReleaseBundleExportCommand) downloadReleaseBundle(exportResponse services.ReleaseBundleExportedStatusResponse, downloadConfiguration artUtils.DownloadConfiguration) (downloaded int, failed int, err error) {
 	downloadParams := artServices.DownloadParams{
@@ -111,6 +139,11 @@ func (rbe *ReleaseBundleExportCommand) SetTargetPath(target string) *ReleaseBund
 	return rbe
 }
 
+func (rbe *ReleaseBundleExportCommand) SetWriteManifest(writeManifest bool) *ReleaseBundleExportCommand {
+	rbe.writeManifest = writeManifest
+	return rbe
+}
+
 func createArtifactoryServiceManager(artDetails *config.ServerDetails) (artifactory.ArtifactoryServicesManager, error) {
 	certsPath, err := coreutils.GetJfrogCertsDir()
 	if err != nil {
@@ -129,5 +162,9 @@ func createArtifactoryServiceManager(artDetails *config.ServerDetails) (artifact
 	if err != nil {
 		return nil, err
 	}
-	return artifactory.New(serviceConfig)
+	servicesManager, err := artifactory.New(serviceConfig)
+	if err != nil {
+		return nil, err
+	}
+	return servicesManager, clientconfig.ConfigureClient(servicesManager.Client(), artDetails)
 }
@@ -2,7 +2,7 @@ package commands
 
 import (
 	"fmt"
-	"github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clientconfig"
 	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
 	"github.com/jfrog/jfrog-client-go/utils/io/fileutils"
 	"github.com/jfrog/jfrog-client-go/utils/log"
@@ -11,6 +11,8 @@ import (
 type ReleaseBundleImportCommand struct {
 	releaseBundleCmd
 	filePath string
+	// The manifest written when the archive was exported, to verify the archive and the imported release bundle.
+	manifestPath string
 }
 
 func (rbi *ReleaseBundleImportCommand) ServerDetails() (*config.ServerDetails, error) {
@@ -34,11 +36,16 @@ func (rbi *ReleaseBundleImportCommand) SetFilepath(filePath string) *ReleaseBund
 	return rbi
 }
 
+func (rbi *ReleaseBundleImportCommand) SetManifestPath(manifestPath string) *ReleaseBundleImportCommand {
+	rbi.manifestPath = manifestPath
+	return rbi
+}
+
 func (rbi *ReleaseBundleImportCommand) Run() (err error) {
 	if err = validateArtifactoryVersionSupported(rbi.serverDetails); err != nil {
 		return
 	}
-	artService, err := utils.CreateServiceManager(rbi.serverDetails, 3, 0, false)
+	artService, err := clientconfig.CreateServiceManager(rbi.serverDetails, 3, 0, false)
 	if err != nil {
 		return
 	}
@@ -51,10 +58,32 @@ func (rbi *ReleaseBundleImportCommand) Run() (err error) {
 		return fmt.Errorf("file not found: %s", rbi.filePath)
 	}
 
+	var manifest *ReleaseBundleArchiveManifest
+	if rbi.manifestPath != "" {
+		if manifest, err = readArchiveManifest(rbi.manifestPath); err != nil {
+			return
+		}
+		if err = verifyArchiveChecksum(rbi.filePath, manifest); err != nil {
+			return
+		}
+	}
+
 	log.Info("Importing the release bundle archive...")
 	if err = artService.ImportReleaseBundle(rbi.filePath); err != nil {
 		return
 	}
 	log.Info("Successfully imported the release bundle archive")
+	if manifest == nil {
+		return
+	}
+
+	apiClient, err := newLifecycleApiClient(rbi.serverDetails)
+	if err != nil {
+		return
+	}
+	if err = verifyImportedReleaseBundle(artService, apiClient, manifest); err != nil {
+		return
+	}
+	log.Info("Verified the signature, checksums and properties of the imported release bundle")
 	return
 }
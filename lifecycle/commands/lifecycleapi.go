@@ -0,0 +1,50 @@
+package commands
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clientconfig"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clierrors"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/auth"
+	"github.com/jfrog/jfrog-client-go/http/jfroghttpclient"
+	clientUtils "github.com/jfrog/jfrog-client-go/utils"
+	"github.com/jfrog/jfrog-client-go/utils/distribution"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+)
+
+// lifecycleApiClient sends requests to the lifecycle REST APIs which aren't provided by the lifecycle services manager.
+type lifecycleApiClient struct {
+	client    *jfroghttpclient.JfrogHttpClient
+	lcDetails auth.ServiceDetails
+}
+
+func newLifecycleApiClient(serverDetails *config.ServerDetails) (*lifecycleApiClient, error) {
+	servicesManager, err := clientconfig.CreateLifecycleServiceManager(serverDetails, false)
+	if err != nil {
+		return nil, err
+	}
+	lcDetails, err := serverDetails.CreateLifecycleAuthConfig()
+	if err != nil {
+		return nil, err
+	}
+	return &lifecycleApiClient{client: servicesManager.Client(), lcDetails: lcDetails}, nil
+}
+
+// get sends a GET request to the REST API of the project, and unmarshals the response into the result.
+func (lac *lifecycleApiClient) get(restApi, projectKey string, result any) error {
+	requestFullUrl, err := clientUtils.BuildUrl(lac.lcDetails.GetUrl(), restApi, distribution.GetProjectQueryParam(projectKey))
+	if err != nil {
+		return err
+	}
+	httpClientDetails := lac.lcDetails.CreateHttpClientDetails()
+	resp, body, _, err := lac.client.SendGet(requestFullUrl, true, &httpClientDetails)
+	if err != nil {
+		return err
+	}
+	if err = clierrors.CheckResponseStatusWithBody(resp, body, http.StatusOK); err != nil {
+		return err
+	}
+	return errorutils.CheckError(json.Unmarshal(body, result))
+}
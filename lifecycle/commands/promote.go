@@ -2,9 +2,17 @@ package commands
 
 import (
 	"encoding/json"
+	"fmt"
+	"strings"
+
+	artifactoryutils "github.com/jfrog/jfrog-cli-artifactory/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clientconfig"
 	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/lifecycle"
 	"github.com/jfrog/jfrog-client-go/lifecycle/services"
+	"github.com/jfrog/jfrog-client-go/onemodel"
 	"github.com/jfrog/jfrog-client-go/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
 	"github.com/jfrog/jfrog-client-go/utils/log"
 )
 
@@ -15,6 +23,11 @@ type ReleaseBundlePromoteCommand struct {
 	includeReposPatterns []string
 	excludeReposPatterns []string
 	promotionType        string
+	// The predicate types of the evidence which must be attached to the release bundle and verified before it's promoted.
+	requiredEvidence []string
+	gateReportPath   string
+	evidenceQuerier  onemodel.Manager
+	dryRun           bool
 }
 
 func NewReleaseBundlePromoteCommand() *ReleaseBundlePromoteCommand {
@@ -71,6 +84,23 @@ func (rbp *ReleaseBundlePromoteCommand) SetPromotionType(promotionType string) *
 	return rbp
 }
 
+func (rbp *ReleaseBundlePromoteCommand) SetRequiredEvidence(requiredEvidence []string) *ReleaseBundlePromoteCommand {
+	rbp.requiredEvidence = requiredEvidence
+	return rbp
+}
+
+// SetGateReportPath sets the file to which the evidence gate report is written. If not set, the report is logged.
+func (rbp *ReleaseBundlePromoteCommand) SetGateReportPath(gateReportPath string) *ReleaseBundlePromoteCommand {
+	rbp.gateReportPath = gateReportPath
+	return rbp
+}
+
+// SetDryRun sets the command to log the promotion, without promoting the release bundle.
+func (rbp *ReleaseBundlePromoteCommand) SetDryRun(dryRun bool) *ReleaseBundlePromoteCommand {
+	rbp.dryRun = dryRun
+	return rbp
+}
+
 func (rbp *ReleaseBundlePromoteCommand) CommandName() string {
 	return "rb_promote"
 }
@@ -84,6 +114,12 @@ func (rbp *ReleaseBundlePromoteCommand) Run() error {
 		return err
 	}
 
+	if len(rbp.requiredEvidence) > 0 {
+		if err := rbp.checkEvidenceGate(); err != nil {
+			return err
+		}
+	}
+
 	servicesManager, rbDetails, queryParams, err := rbp.getPromotionPrerequisites()
 
 	if err != nil {
@@ -95,6 +131,9 @@ func (rbp *ReleaseBundlePromoteCommand) Run() error {
 		IncludedRepositoryKeys: rbp.includeReposPatterns,
 		ExcludedRepositoryKeys: rbp.excludeReposPatterns,
 	}
+	if rbp.dryRun {
+		return rbp.logPromotion(servicesManager, queryParams)
+	}
 
 	promotionResp, err := servicesManager.PromoteReleaseBundle(rbDetails, queryParams, rbp.signingKeyName, promotionParams)
 	if err != nil {
@@ -107,3 +146,53 @@ func (rbp *ReleaseBundlePromoteCommand) Run() error {
 	log.Output(utils.IndentJson(content))
 	return nil
 }
+
+func (rbp *ReleaseBundlePromoteCommand) logPromotion(servicesManager *lifecycle.LifecycleServicesManager, queryParams services.CommonOptionalQueryParams) error {
+	if err := rbp.checkReleaseBundleExists(servicesManager); err != nil {
+		return err
+	}
+	operation := fmt.Sprintf("Promoting the release bundle '%s/%s' to the environment '%s'", rbp.releaseBundleName, rbp.releaseBundleVersion, rbp.environment)
+	if queryParams.PromotionType != "" {
+		operation += fmt.Sprintf(" with the promotion type '%s'", queryParams.PromotionType)
+	}
+	if len(rbp.includeReposPatterns) > 0 {
+		operation += fmt.Sprintf(", including the repositories '%s'", strings.Join(rbp.includeReposPatterns, ","))
+	}
+	if len(rbp.excludeReposPatterns) > 0 {
+		operation += fmt.Sprintf(", excluding the repositories '%s'", strings.Join(rbp.excludeReposPatterns, ","))
+	}
+	artifactoryutils.LogDryRun(operation)
+	return nil
+}
+
+// checkEvidenceGate refuses the promotion if any of the required evidence is missing or isn't verified.
+func (rbp *ReleaseBundlePromoteCommand) checkEvidenceGate() error {
+	if rbp.evidenceQuerier == nil {
+		evidenceQuerier, err := clientconfig.CreateOnemodelServiceManager(rbp.serverDetails, false)
+		if err != nil {
+			return err
+		}
+		rbp.evidenceQuerier = evidenceQuerier
+	}
+	evidence, err := getReleaseBundleEvidence(rbp.evidenceQuerier, rbp.rbProjectKey, rbp.releaseBundleName, rbp.releaseBundleVersion)
+	if err != nil {
+		return err
+	}
+	report := &EvidenceGateReport{ReleaseBundleName: rbp.releaseBundleName, ReleaseBundleVersion: rbp.releaseBundleVersion, Environment: rbp.environment}
+	report.Checks, report.Passed = checkEvidenceGate(evidence, rbp.requiredEvidence)
+	if err = writeEvidenceGateReport(report, rbp.gateReportPath); err != nil {
+		return err
+	}
+	if !report.Passed {
+		var failed []string
+		for _, check := range report.Checks {
+			if !check.Passed {
+				failed = append(failed, check.PredicateType+" ("+check.Reason+")")
+			}
+		}
+		return errorutils.CheckErrorf("the promotion of release bundle %s/%s to %s was refused. Required evidence: %s",
+			rbp.releaseBundleName, rbp.releaseBundleVersion, rbp.environment, strings.Join(failed, ", "))
+	}
+	log.Info("All the required evidence of the release bundle was verified.")
+	return nil
+}
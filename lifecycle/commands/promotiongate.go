@@ -0,0 +1,143 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/jfrog/jfrog-client-go/onemodel"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// Aliases of the predicate types of commonly required evidence.
+var evidencePredicateTypeAliases = map[string]string{
+	"tests":    "https://in-toto.io/attestation/test-result/v0.1",
+	"scan":     "https://in-toto.io/attestation/vulns/v0.1",
+	"approval": "https://jfrog.com/evidence/approval/v1",
+}
+
+// EvidenceGateReport is the machine-readable result of checking the evidence required to promote a release bundle.
+type EvidenceGateReport struct {
+	ReleaseBundleName    string              `json:"releaseBundleName"`
+	ReleaseBundleVersion string              `json:"releaseBundleVersion"`
+	Environment          string              `json:"environment"`
+	Passed               bool                `json:"passed"`
+	Checks               []EvidenceGateCheck `json:"checks"`
+}
+
+// EvidenceGateCheck is the result of checking a single required predicate type.
+type EvidenceGateCheck struct {
+	PredicateType string `json:"predicateType"`
+	Passed        bool   `json:"passed"`
+	// The paths of the verified evidence of the predicate type.
+	Evidence []string `json:"evidence,omitempty"`
+	Reason   string   `json:"reason,omitempty"`
+}
+
+type rbEvidence struct {
+	Path          string `json:"path"`
+	PredicateType string `json:"predicateType"`
+	PredicateSlug string `json:"predicateSlug"`
+	Verified      bool   `json:"verified"`
+}
+
+type rbEvidenceResponse struct {
+	Data struct {
+		ReleaseBundleVersion struct {
+			GetVersion *struct {
+				EvidenceConnection struct {
+					Edges []struct {
+						Node rbEvidence `json:"node"`
+					} `json:"edges"`
+				} `json:"evidenceConnection"`
+			} `json:"getVersion"`
+		} `json:"releaseBundleVersion"`
+	} `json:"data"`
+	Errors []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+// ResolvePredicateType returns the predicate type of an alias: tests, scan or approval, or the given predicate type otherwise.
+func ResolvePredicateType(predicateType string) string {
+	if resolved, ok := evidencePredicateTypeAliases[strings.ToLower(predicateType)]; ok {
+		return resolved
+	}
+	return predicateType
+}
+
+// getReleaseBundleEvidence queries the evidence attached to the release bundle version.
+func getReleaseBundleEvidence(querier onemodel.Manager, projectKey, name, version string) ([]rbEvidence, error) {
+	query := fmt.Sprintf("{ releaseBundleVersion { getVersion(repositoryKey: %q, name: %q, version: %q) "+
+		"{ evidenceConnection { edges { node { path predicateType predicateSlug verified } } } } } }", buildRepoKey(projectKey), name, version)
+	content, err := json.Marshal(map[string]string{"query": query})
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	body, err := querier.GraphqlQuery(content)
+	if err != nil {
+		return nil, err
+	}
+	var response rbEvidenceResponse
+	if err = json.Unmarshal(body, &response); err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	if len(response.Errors) > 0 {
+		return nil, errorutils.CheckErrorf("failed to get the evidence of release bundle %s/%s: %s", name, version, response.Errors[0].Message)
+	}
+	rbVersion := response.Data.ReleaseBundleVersion.GetVersion
+	if rbVersion == nil {
+		return nil, errorutils.CheckErrorf("release bundle %s/%s was not found", name, version)
+	}
+	var evidence []rbEvidence
+	for _, edge := range rbVersion.EvidenceConnection.Edges {
+		evidence = append(evidence, edge.Node)
+	}
+	return evidence, nil
+}
+
+// checkEvidenceGate checks that each of the required predicate types has verified evidence. The predicate types may be
+// given by their full type, by their slug, or by an alias.
+func checkEvidenceGate(evidence []rbEvidence, requiredPredicateTypes []string) (checks []EvidenceGateCheck, passed bool) {
+	passed = true
+	for _, required := range requiredPredicateTypes {
+		predicateType := ResolvePredicateType(required)
+		check := EvidenceGateCheck{PredicateType: predicateType}
+		found := false
+		for _, e := range evidence {
+			if e.PredicateType != predicateType && e.PredicateSlug != predicateType {
+				continue
+			}
+			found = true
+			if e.Verified {
+				check.Evidence = append(check.Evidence, e.Path)
+			}
+		}
+		switch {
+		case !found:
+			check.Reason = "no evidence of the predicate type was found"
+		case len(check.Evidence) == 0:
+			check.Reason = "the evidence of the predicate type failed verification"
+		default:
+			check.Passed = true
+		}
+		passed = passed && check.Passed
+		checks = append(checks, check)
+	}
+	return
+}
+
+// writeEvidenceGateReport writes the report to the file, or logs it if no file is provided.
+func writeEvidenceGateReport(report *EvidenceGateReport, reportPath string) error {
+	content, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	if reportPath == "" {
+		log.Info("Evidence gate report:\n" + string(content))
+		return nil
+	}
+	return errorutils.CheckError(os.WriteFile(reportPath, content, 0644))
+}
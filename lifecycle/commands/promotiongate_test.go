@@ -0,0 +1,80 @@
+package commands
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeEvidenceQuerier struct {
+	response string
+	query    string
+}
+
+func (f *fakeEvidenceQuerier) GraphqlQuery(query []byte) ([]byte, error) {
+	f.query = string(query)
+	return []byte(f.response), nil
+}
+
+const rbEvidenceResponseJson = `{"data":{"releaseBundleVersion":{"getVersion":{"evidenceConnection":{"edges":[
+	{"node":{"path":"release-bundles-v2/app/1.0/evidence/tests.json","predicateType":"https://in-toto.io/attestation/test-result/v0.1","predicateSlug":"test-result","verified":true}},
+	{"node":{"path":"release-bundles-v2/app/1.0/evidence/scan.json","predicateType":"https://in-toto.io/attestation/vulns/v0.1","predicateSlug":"vulns","verified":false}}
+]}}}}}`
+
+func TestGetReleaseBundleEvidence(t *testing.T) {
+	querier := &fakeEvidenceQuerier{response: rbEvidenceResponseJson}
+	evidence, err := getReleaseBundleEvidence(querier, "proj", "app", "1.0")
+	require.NoError(t, err)
+	assert.Len(t, evidence, 2)
+	assert.Contains(t, querier.query, `repositoryKey: \"proj-release-bundles-v2\", name: \"app\", version: \"1.0\"`)
+
+	querier.response = `{"data":{"releaseBundleVersion":{"getVersion":null}}}`
+	_, err = getReleaseBundleEvidence(querier, "", "app", "2.0")
+	assert.EqualError(t, err, "release bundle app/2.0 was not found")
+
+	querier.response = `{"errors":[{"message":"unauthorized"}]}`
+	_, err = getReleaseBundleEvidence(querier, "", "app", "1.0")
+	assert.EqualError(t, err, "failed to get the evidence of release bundle app/1.0: unauthorized")
+}
+
+func TestCheckEvidenceGate(t *testing.T) {
+	evidence, err := getReleaseBundleEvidence(&fakeEvidenceQuerier{response: rbEvidenceResponseJson}, "", "app", "1.0")
+	require.NoError(t, err)
+
+	checks, passed := checkEvidenceGate(evidence, []string{"tests", "test-result"})
+	assert.True(t, passed)
+	assert.Len(t, checks, 2)
+
+	checks, passed = checkEvidenceGate(evidence, []string{"tests", "scan", "approval"})
+	assert.False(t, passed)
+	assert.Equal(t, []EvidenceGateCheck{
+		{PredicateType: "https://in-toto.io/attestation/test-result/v0.1", Passed: true, Evidence: []string{"release-bundles-v2/app/1.0/evidence/tests.json"}},
+		{PredicateType: "https://in-toto.io/attestation/vulns/v0.1", Reason: "the evidence of the predicate type failed verification"},
+		{PredicateType: "https://jfrog.com/evidence/approval/v1", Reason: "no evidence of the predicate type was found"},
+	}, checks)
+}
+
+func TestPromoteEvidenceGate(t *testing.T) {
+	reportPath := filepath.Join(t.TempDir(), "report.json")
+	rbp := NewReleaseBundlePromoteCommand().SetReleaseBundleName("app").SetReleaseBundleVersion("1.0").SetEnvironment("PROD").
+		SetRequiredEvidence([]string{"tests", "scan"}).SetGateReportPath(reportPath)
+	rbp.evidenceQuerier = &fakeEvidenceQuerier{response: rbEvidenceResponseJson}
+	err := rbp.checkEvidenceGate()
+	assert.EqualError(t, err, "the promotion of release bundle app/1.0 to PROD was refused. Required evidence: "+
+		"https://in-toto.io/attestation/vulns/v0.1 (the evidence of the predicate type failed verification)")
+
+	content, err := os.ReadFile(reportPath)
+	require.NoError(t, err)
+	var report EvidenceGateReport
+	require.NoError(t, json.Unmarshal(content, &report))
+	assert.False(t, report.Passed)
+	assert.Equal(t, "PROD", report.Environment)
+	assert.Len(t, report.Checks, 2)
+
+	rbp.SetRequiredEvidence([]string{"tests"})
+	assert.NoError(t, rbp.checkEvidenceGate())
+}
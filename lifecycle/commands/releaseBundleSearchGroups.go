@@ -4,8 +4,8 @@ import (
 	"encoding/json"
 	"errors"
 	"github.com/jedib0t/go-pretty/v6/text"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clientconfig"
 	"github.com/jfrog/jfrog-cli-artifactory/stats"
-	rtUtils "github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
 	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
 	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
 	"github.com/jfrog/jfrog-client-go/lifecycle/services"
@@ -71,7 +71,7 @@ func (sgc *SearchGroupCommand) ServerDetails() (*config.ServerDetails, error) {
 }
 
 func (sgc *SearchGroupCommand) Run() error {
-	lcServicesManager, err := rtUtils.CreateLifecycleServiceManager(sgc.serverDetails, false)
+	lcServicesManager, err := clientconfig.CreateLifecycleServiceManager(sgc.serverDetails, false)
 	if err != nil {
 		return err
 	}
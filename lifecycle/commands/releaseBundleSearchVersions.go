@@ -4,8 +4,8 @@ import (
 	"encoding/json"
 	"errors"
 	"github.com/jedib0t/go-pretty/v6/text"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clientconfig"
 	"github.com/jfrog/jfrog-cli-artifactory/stats"
-	rtUtils "github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
 	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
 	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
 	"github.com/jfrog/jfrog-client-go/lifecycle/services"
@@ -93,7 +93,7 @@ func (svc *SearchVersionsCommand) ServerDetails() (*config.ServerDetails, error)
 }
 
 func (svc *SearchVersionsCommand) Run() error {
-	lcServicesManager, err := rtUtils.CreateLifecycleServiceManager(svc.serverDetails, false)
+	lcServicesManager, err := clientconfig.CreateLifecycleServiceManager(svc.serverDetails, false)
 	if err != nil {
 		return err
 	}
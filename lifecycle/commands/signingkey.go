@@ -0,0 +1,448 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/formats"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clientconfig"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clierrors"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/coreutils"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	clientUtils "github.com/jfrog/jfrog-client-go/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// The types of the key pairs which can sign release bundles.
+const (
+	GpgSigningKey = "GPG"
+	RsaSigningKey = "RSA"
+)
+
+const (
+	keyPairApi     = "api/security/keypair/"
+	trustedKeysApi = "api/security/keys/trusted"
+)
+
+type keyPair struct {
+	PairName   string `json:"pairName,omitempty"`
+	PairType   string `json:"pairType,omitempty"`
+	Alias      string `json:"alias,omitempty"`
+	PublicKey  string `json:"publicKey,omitempty"`
+	PrivateKey string `json:"privateKey,omitempty"`
+	Passphrase string `json:"passphrase,omitempty"`
+}
+
+// ParseSigningKeyType returns the key pair type by its case-insensitive name, GPG or RSA.
+func ParseSigningKeyType(keyType string) (string, error) {
+	switch upperKeyType := strings.ToUpper(keyType); upperKeyType {
+	case GpgSigningKey, RsaSigningKey:
+		return upperKeyType, nil
+	}
+	return "", errorutils.CheckErrorf("invalid signing key type '%s'. The valid types are %s and %s", keyType, GpgSigningKey, RsaSigningKey)
+}
+
+// validateSigningKey verifies that the key pair exists in Artifactory, and that it's of the expected type, if provided,
+// so that the release bundle isn't submitted with a key which can't sign it.
+func validateSigningKey(rtServicesManager artifactory.ArtifactoryServicesManager, keyName, keyType string) error {
+	pair, err := getKeyPair(rtServicesManager, keyName)
+	if err != nil {
+		return err
+	}
+	pairType := strings.ToUpper(pair.PairType)
+	if pairType != GpgSigningKey && pairType != RsaSigningKey {
+		return errorutils.CheckErrorf("the key pair '%s' of type '%s' can't sign release bundles. Only %s and %s key pairs are supported", keyName, pair.PairType, GpgSigningKey, RsaSigningKey)
+	}
+	if keyType != "" && pairType != keyType {
+		return errorutils.CheckErrorf("the signing key '%s' is a %s key pair, while a %s key pair was expected", keyName, pairType, keyType)
+	}
+	return nil
+}
+
+// getKeyPair returns the key pair, without its private key, or an error if it doesn't exist.
+func getKeyPair(rtServicesManager artifactory.ArtifactoryServicesManager, keyName string) (*keyPair, error) {
+	rtDetails := rtServicesManager.GetConfig().GetServiceDetails()
+	httpClientDetails := rtDetails.CreateHttpClientDetails()
+	resp, body, _, err := rtServicesManager.Client().SendGet(clientUtils.AddTrailingSlashIfNeeded(rtDetails.GetUrl())+keyPairApi+url.PathEscape(keyName), true, &httpClientDetails)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, errorutils.CheckErrorf("the signing key '%s' was not found in Artifactory", keyName)
+	}
+	if err = clierrors.CheckResponseStatusWithBody(resp, body, http.StatusOK); err != nil {
+		return nil, err
+	}
+	pair := new(keyPair)
+	return pair, errorutils.CheckError(json.Unmarshal(body, pair))
+}
+
+// SigningKeyUploadCommand uploads a GPG or RSA key pair to Artifactory, so that it can sign release bundles.
+type SigningKeyUploadCommand struct {
+	serverDetails  *config.ServerDetails
+	keyName        string
+	keyType        string
+	alias          string
+	publicKeyPath  string
+	privateKeyPath string
+	passphrase     string
+}
+
+func NewSigningKeyUploadCommand() *SigningKeyUploadCommand {
+	return &SigningKeyUploadCommand{keyType: GpgSigningKey}
+}
+
+func (sku *SigningKeyUploadCommand) SetServerDetails(serverDetails *config.ServerDetails) *SigningKeyUploadCommand {
+	sku.serverDetails = serverDetails
+	return sku
+}
+
+func (sku *SigningKeyUploadCommand) SetKeyName(keyName string) *SigningKeyUploadCommand {
+	sku.keyName = keyName
+	return sku
+}
+
+// SetKeyType sets the type of the key pair, GPG or RSA.
+func (sku *SigningKeyUploadCommand) SetKeyType(keyType string) *SigningKeyUploadCommand {
+	sku.keyType = keyType
+	return sku
+}
+
+// SetAlias sets the alias of the key pair. If not set, the name of the key pair is used.
+func (sku *SigningKeyUploadCommand) SetAlias(alias string) *SigningKeyUploadCommand {
+	sku.alias = alias
+	return sku
+}
+
+func (sku *SigningKeyUploadCommand) SetPublicKeyPath(publicKeyPath string) *SigningKeyUploadCommand {
+	sku.publicKeyPath = publicKeyPath
+	return sku
+}
+
+func (sku *SigningKeyUploadCommand) SetPrivateKeyPath(privateKeyPath string) *SigningKeyUploadCommand {
+	sku.privateKeyPath = privateKeyPath
+	return sku
+}
+
+func (sku *SigningKeyUploadCommand) SetPassphrase(passphrase string) *SigningKeyUploadCommand {
+	sku.passphrase = passphrase
+	return sku
+}
+
+func (sku *SigningKeyUploadCommand) CommandName() string {
+	return "rb_signing_key_upload"
+}
+
+func (sku *SigningKeyUploadCommand) ServerDetails() (*config.ServerDetails, error) {
+	return sku.serverDetails, nil
+}
+
+func (sku *SigningKeyUploadCommand) Run() error {
+	keyType, err := ParseSigningKeyType(sku.keyType)
+	if err != nil {
+		return err
+	}
+	pair := keyPair{PairName: sku.keyName, PairType: keyType, Alias: sku.alias, Passphrase: sku.passphrase}
+	if pair.Alias == "" {
+		pair.Alias = sku.keyName
+	}
+	if pair.PublicKey, err = readKeyFile(sku.publicKeyPath); err != nil {
+		return err
+	}
+	if pair.PrivateKey, err = readKeyFile(sku.privateKeyPath); err != nil {
+		return err
+	}
+	rtServicesManager, err := clientconfig.CreateServiceManager(sku.serverDetails, 3, 0, false)
+	if err != nil {
+		return err
+	}
+	content, err := json.Marshal(pair)
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	rtDetails := rtServicesManager.GetConfig().GetServiceDetails()
+	httpClientDetails := rtDetails.CreateHttpClientDetails()
+	httpClientDetails.SetContentTypeApplicationJson()
+	resp, body, err := rtServicesManager.Client().SendPost(clientUtils.AddTrailingSlashIfNeeded(rtDetails.GetUrl())+strings.TrimSuffix(keyPairApi, "/"), content, &httpClientDetails)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode == http.StatusConflict {
+		return errorutils.CheckErrorf("a key pair named '%s' already exists in Artifactory", sku.keyName)
+	}
+	if err = clierrors.CheckResponseStatusWithBody(resp, body, http.StatusOK, http.StatusCreated); err != nil {
+		return err
+	}
+	log.Info(fmt.Sprintf("Uploaded the %s signing key '%s'.", keyType, sku.keyName))
+	return nil
+}
+
+func readKeyFile(path string) (string, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return "", errorutils.CheckError(err)
+	}
+	return string(content), nil
+}
+
+// SigningKeys are the key pairs which can sign release bundles.
+type SigningKeys []SigningKey
+
+type SigningKey struct {
+	Name  string `json:"name"`
+	Type  string `json:"type"`
+	Alias string `json:"alias,omitempty"`
+}
+
+type signingKeyRow struct {
+	Name  string `col-name:"Name"`
+	Type  string `col-name:"Type"`
+	Alias string `col-name:"Alias"`
+}
+
+func (sk SigningKeys) Tables() []formats.Table {
+	var rows []signingKeyRow
+	for _, key := range sk {
+		rows = append(rows, signingKeyRow(key))
+	}
+	return []formats.Table{{Title: "Signing Keys", Rows: rows, EmptyMessage: "No signing keys found"}}
+}
+
+// SigningKeyListCommand lists the GPG and RSA key pairs of Artifactory.
+type SigningKeyListCommand struct {
+	serverDetails *config.ServerDetails
+	format        string
+}
+
+func NewSigningKeyListCommand() *SigningKeyListCommand {
+	return &SigningKeyListCommand{}
+}
+
+func (skl *SigningKeyListCommand) SetServerDetails(serverDetails *config.ServerDetails) *SigningKeyListCommand {
+	skl.serverDetails = serverDetails
+	return skl
+}
+
+func (skl *SigningKeyListCommand) SetOutputFormat(format string) *SigningKeyListCommand {
+	skl.format = format
+	return skl
+}
+
+func (skl *SigningKeyListCommand) CommandName() string {
+	return "rb_signing_key_list"
+}
+
+func (skl *SigningKeyListCommand) ServerDetails() (*config.ServerDetails, error) {
+	return skl.serverDetails, nil
+}
+
+func (skl *SigningKeyListCommand) Run() error {
+	outputFormat, err := formats.ParseFormat(skl.format)
+	if err != nil {
+		return err
+	}
+	rtServicesManager, err := clientconfig.CreateServiceManager(skl.serverDetails, 3, 0, false)
+	if err != nil {
+		return err
+	}
+	keys, err := listSigningKeys(rtServicesManager)
+	if err != nil {
+		return err
+	}
+	if outputFormat == "" {
+		outputFormat = formats.TableFormat
+	}
+	return formats.Print(outputFormat, formats.SigningKeysKind, keys)
+}
+
+// listSigningKeys returns the key pairs which can sign release bundles, sorted by their name.
+func listSigningKeys(rtServicesManager artifactory.ArtifactoryServicesManager) (SigningKeys, error) {
+	rtDetails := rtServicesManager.GetConfig().GetServiceDetails()
+	httpClientDetails := rtDetails.CreateHttpClientDetails()
+	resp, body, _, err := rtServicesManager.Client().SendGet(clientUtils.AddTrailingSlashIfNeeded(rtDetails.GetUrl())+strings.TrimSuffix(keyPairApi, "/"), true, &httpClientDetails)
+	if err != nil {
+		return nil, err
+	}
+	if err = clierrors.CheckResponseStatusWithBody(resp, body, http.StatusOK); err != nil {
+		return nil, err
+	}
+	var pairs []keyPair
+	if err = json.Unmarshal(body, &pairs); err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	keys := SigningKeys{}
+	for _, pair := range pairs {
+		if pairType := strings.ToUpper(pair.PairType); pairType == GpgSigningKey || pairType == RsaSigningKey {
+			keys = append(keys, SigningKey{Name: pair.PairName, Type: pairType, Alias: pair.Alias})
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].Name < keys[j].Name
+	})
+	return keys, nil
+}
+
+// SigningKeyPropagateCommand adds the public key of a key pair to the trusted keys of edge nodes, so that they can
+// verify the release bundles signed by it.
+type SigningKeyPropagateCommand struct {
+	serverDetails *config.ServerDetails
+	keyName       string
+	edges         []*config.ServerDetails
+}
+
+func NewSigningKeyPropagateCommand() *SigningKeyPropagateCommand {
+	return &SigningKeyPropagateCommand{}
+}
+
+func (skp *SigningKeyPropagateCommand) SetServerDetails(serverDetails *config.ServerDetails) *SigningKeyPropagateCommand {
+	skp.serverDetails = serverDetails
+	return skp
+}
+
+func (skp *SigningKeyPropagateCommand) SetKeyName(keyName string) *SigningKeyPropagateCommand {
+	skp.keyName = keyName
+	return skp
+}
+
+// SetEdges sets the configured servers of the edge nodes.
+func (skp *SigningKeyPropagateCommand) SetEdges(edges []*config.ServerDetails) *SigningKeyPropagateCommand {
+	skp.edges = edges
+	return skp
+}
+
+func (skp *SigningKeyPropagateCommand) CommandName() string {
+	return "rb_signing_key_propagate"
+}
+
+func (skp *SigningKeyPropagateCommand) ServerDetails() (*config.ServerDetails, error) {
+	return skp.serverDetails, nil
+}
+
+// Run propagates the key to all the edges, and returns an error listing the edges to which it failed.
+func (skp *SigningKeyPropagateCommand) Run() error {
+	rtServicesManager, err := clientconfig.CreateServiceManager(skp.serverDetails, 3, 0, false)
+	if err != nil {
+		return err
+	}
+	pair, err := getKeyPair(rtServicesManager, skp.keyName)
+	if err != nil {
+		return err
+	}
+	if pair.PublicKey == "" {
+		return errorutils.CheckErrorf("the public key of the signing key '%s' is not available", skp.keyName)
+	}
+	alias := pair.Alias
+	if alias == "" {
+		alias = skp.keyName
+	}
+	var failedEdges []string
+	for _, edge := range skp.edges {
+		if err = addTrustedKey(edge, alias, pair.PublicKey); err != nil {
+			log.Error(fmt.Sprintf("Failed to propagate the signing key '%s' to %s: %s", skp.keyName, edge.ServerId, err.Error()))
+			failedEdges = append(failedEdges, edge.ServerId)
+		}
+	}
+	if len(failedEdges) > 0 {
+		return errorutils.CheckErrorf("failed to propagate the signing key '%s' to: %s", skp.keyName, strings.Join(failedEdges, ", "))
+	}
+	return nil
+}
+
+// addTrustedKey adds the public key to the trusted keys of the edge. A key which is already trusted under the alias
+// is kept.
+func addTrustedKey(edge *config.ServerDetails, alias, publicKey string) error {
+	edgeDetails := *edge
+	if edgeDetails.ArtifactoryUrl == "" && edgeDetails.Url != "" {
+		edgeDetails.ArtifactoryUrl = clientUtils.AddTrailingSlashIfNeeded(edgeDetails.Url) + "artifactory/"
+	}
+	edgeServicesManager, err := clientconfig.CreateServiceManager(&edgeDetails, 3, 0, false)
+	if err != nil {
+		return err
+	}
+	content, err := json.Marshal(map[string]string{"alias": alias, "public_key": publicKey})
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	rtDetails := edgeServicesManager.GetConfig().GetServiceDetails()
+	httpClientDetails := rtDetails.CreateHttpClientDetails()
+	httpClientDetails.SetContentTypeApplicationJson()
+	resp, body, err := edgeServicesManager.Client().SendPost(clientUtils.AddTrailingSlashIfNeeded(rtDetails.GetUrl())+trustedKeysApi, content, &httpClientDetails)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode == http.StatusConflict {
+		log.Info(fmt.Sprintf("The key '%s' is already trusted by %s.", alias, edge.ServerId))
+		return nil
+	}
+	if err = clierrors.CheckResponseStatusWithBody(resp, body, http.StatusOK, http.StatusCreated); err != nil {
+		return err
+	}
+	log.Info(fmt.Sprintf("Propagated the key '%s' to %s.", alias, edge.ServerId))
+	return nil
+}
+
+// SigningKeyDeleteCommand deletes a key pair from Artifactory.
+type SigningKeyDeleteCommand struct {
+	serverDetails *config.ServerDetails
+	keyName       string
+	quiet         bool
+}
+
+func NewSigningKeyDeleteCommand() *SigningKeyDeleteCommand {
+	return &SigningKeyDeleteCommand{}
+}
+
+func (skd *SigningKeyDeleteCommand) SetServerDetails(serverDetails *config.ServerDetails) *SigningKeyDeleteCommand {
+	skd.serverDetails = serverDetails
+	return skd
+}
+
+func (skd *SigningKeyDeleteCommand) SetKeyName(keyName string) *SigningKeyDeleteCommand {
+	skd.keyName = keyName
+	return skd
+}
+
+func (skd *SigningKeyDeleteCommand) SetQuiet(quiet bool) *SigningKeyDeleteCommand {
+	skd.quiet = quiet
+	return skd
+}
+
+func (skd *SigningKeyDeleteCommand) CommandName() string {
+	return "rb_signing_key_delete"
+}
+
+func (skd *SigningKeyDeleteCommand) ServerDetails() (*config.ServerDetails, error) {
+	return skd.serverDetails, nil
+}
+
+func (skd *SigningKeyDeleteCommand) Run() error {
+	rtServicesManager, err := clientconfig.CreateServiceManager(skd.serverDetails, 3, 0, false)
+	if err != nil {
+		return err
+	}
+	if _, err = getKeyPair(rtServicesManager, skd.keyName); err != nil {
+		return err
+	}
+	if !skd.quiet && !coreutils.AskYesNo(fmt.Sprintf("Are you sure you want to delete the signing key '%s'? "+
+		"Release bundles signed by it can't be verified after it's deleted.\n"+avoidConfirmationMsg, skd.keyName), false) {
+		return nil
+	}
+	rtDetails := rtServicesManager.GetConfig().GetServiceDetails()
+	httpClientDetails := rtDetails.CreateHttpClientDetails()
+	resp, body, err := rtServicesManager.Client().SendDelete(clientUtils.AddTrailingSlashIfNeeded(rtDetails.GetUrl())+keyPairApi+url.PathEscape(skd.keyName), nil, &httpClientDetails)
+	if err != nil {
+		return err
+	}
+	if err = clierrors.CheckResponseStatusWithBody(resp, body, http.StatusOK, http.StatusNoContent); err != nil {
+		return err
+	}
+	log.Info(fmt.Sprintf("Deleted the signing key '%s'.", skd.keyName))
+	return nil
+}
@@ -0,0 +1,134 @@
+package commands
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSigningKeyType(t *testing.T) {
+	keyType, err := ParseSigningKeyType("gpg")
+	assert.NoError(t, err)
+	assert.Equal(t, GpgSigningKey, keyType)
+	keyType, err = ParseSigningKeyType("RSA")
+	assert.NoError(t, err)
+	assert.Equal(t, RsaSigningKey, keyType)
+	_, err = ParseSigningKeyType("ssh")
+	assert.Error(t, err)
+}
+
+func TestValidateSigningKey(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/security/keypair/gpg-key":
+			_, _ = w.Write([]byte(`{"pairName":"gpg-key","pairType":"GPG"}`))
+		case "/api/security/keypair/ssh-key":
+			_, _ = w.Write([]byte(`{"pairName":"ssh-key","pairType":"SSH"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	rtServicesManager, err := utils.CreateServiceManager(&config.ServerDetails{ArtifactoryUrl: server.URL + "/"}, 0, 0, false)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name    string
+		keyName string
+		keyType string
+		errMsg  string
+	}{
+		{"any type", "gpg-key", "", ""},
+		{"matching type", "gpg-key", GpgSigningKey, ""},
+		{"mismatching type", "gpg-key", RsaSigningKey, "the signing key 'gpg-key' is a GPG key pair, while a RSA key pair was expected"},
+		{"unsupported type", "ssh-key", "", "the key pair 'ssh-key' of type 'SSH' can't sign release bundles. Only GPG and RSA key pairs are supported"},
+		{"not found", "missing-key", "", "the signing key 'missing-key' was not found in Artifactory"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateSigningKey(rtServicesManager, tc.keyName, tc.keyType)
+			if tc.errMsg == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tc.errMsg)
+			}
+		})
+	}
+}
+
+func TestListSigningKeys(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/security/keypair", r.URL.Path)
+		_, _ = w.Write([]byte(`[{"pairName":"rsa-key","pairType":"RSA","alias":"rsa"},{"pairName":"ssh-key","pairType":"SSH"},{"pairName":"gpg-key","pairType":"gpg"}]`))
+	}))
+	defer server.Close()
+	rtServicesManager, err := utils.CreateServiceManager(&config.ServerDetails{ArtifactoryUrl: server.URL + "/"}, 0, 0, false)
+	require.NoError(t, err)
+
+	keys, err := listSigningKeys(rtServicesManager)
+	require.NoError(t, err)
+	assert.Equal(t, SigningKeys{{Name: "gpg-key", Type: GpgSigningKey}, {Name: "rsa-key", Type: RsaSigningKey, Alias: "rsa"}}, keys)
+}
+
+func TestSigningKeyUploadCommand(t *testing.T) {
+	var uploaded string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/api/security/keypair", r.URL.Path)
+		content, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		uploaded = string(content)
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+	keysDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(keysDir, "public.asc"), []byte("public"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(keysDir, "private.asc"), []byte("private"), 0600))
+
+	uploadCmd := NewSigningKeyUploadCommand().SetServerDetails(&config.ServerDetails{ArtifactoryUrl: server.URL + "/"}).SetKeyName("gpg-key").
+		SetPublicKeyPath(filepath.Join(keysDir, "public.asc")).SetPrivateKeyPath(filepath.Join(keysDir, "private.asc")).SetPassphrase("secret")
+	require.NoError(t, uploadCmd.Run())
+	assert.JSONEq(t, `{"pairName":"gpg-key","pairType":"GPG","alias":"gpg-key","publicKey":"public","privateKey":"private","passphrase":"secret"}`, uploaded)
+
+	assert.ErrorContains(t, uploadCmd.SetKeyType("ssh").Run(), "invalid signing key type 'ssh'")
+}
+
+func TestSigningKeyPropagateCommand(t *testing.T) {
+	source := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"pairName":"gpg-key","pairType":"GPG","alias":"release","publicKey":"public"}`))
+	}))
+	defer source.Close()
+	var trusted []string
+	edge := func(status int) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.True(t, strings.HasSuffix(r.URL.Path, "/api/security/keys/trusted"), r.URL.Path)
+			content, err := io.ReadAll(r.Body)
+			assert.NoError(t, err)
+			trusted = append(trusted, string(content))
+			w.WriteHeader(status)
+		}))
+	}
+	createdEdge, conflictEdge, failedEdge := edge(http.StatusCreated), edge(http.StatusConflict), edge(http.StatusInternalServerError)
+	defer createdEdge.Close()
+	defer conflictEdge.Close()
+	defer failedEdge.Close()
+
+	// The Artifactory URL of edge-1 is derived from its platform URL.
+	propagateCmd := NewSigningKeyPropagateCommand().SetServerDetails(&config.ServerDetails{ArtifactoryUrl: source.URL + "/"}).SetKeyName("gpg-key").
+		SetEdges([]*config.ServerDetails{{ServerId: "edge-1", Url: createdEdge.URL + "/"}, {ServerId: "edge-2", ArtifactoryUrl: conflictEdge.URL + "/"}})
+	require.NoError(t, propagateCmd.Run())
+	require.Len(t, trusted, 2)
+	assert.JSONEq(t, `{"alias":"release","public_key":"public"}`, trusted[0])
+
+	propagateCmd.SetEdges([]*config.ServerDetails{{ServerId: "edge-3", ArtifactoryUrl: failedEdge.URL + "/"}})
+	assert.ErrorContains(t, propagateCmd.Run(), "failed to propagate the signing key 'gpg-key' to: edge-3")
+}
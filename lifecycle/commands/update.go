@@ -3,7 +3,6 @@ package commands
 import (
 	"errors"
 
-	coreUtils "github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
 	"github.com/jfrog/jfrog-cli-core/v2/common/spec"
 	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
 	"github.com/jfrog/jfrog-client-go/lifecycle/services"
@@ -100,7 +99,7 @@ func (rbu *ReleaseBundleUpdateCommand) Run() error {
 func (rbu *ReleaseBundleUpdateCommand) getAddSources() ([]services.RbSource, error) {
 	// First check if sources are provided via command-line flags
 	if rbu.sourcesBuilds != "" || rbu.sourcesReleaseBundles != "" {
-		return rbu.buildSourcesFromCommandFlags(), nil
+		return rbu.buildSourcesFromCommandFlags()
 	}
 
 	// Otherwise, parse from spec file
@@ -111,18 +110,22 @@ func (rbu *ReleaseBundleUpdateCommand) getAddSources() ([]services.RbSource, err
 	return rbu.buildSourcesFromSpec()
 }
 
-func (rbu *ReleaseBundleUpdateCommand) buildSourcesFromCommandFlags() []services.RbSource {
+func (rbu *ReleaseBundleUpdateCommand) buildSourcesFromCommandFlags() ([]services.RbSource, error) {
 	var sources []services.RbSource
+	var err error
 
 	if rbu.sourcesBuilds != "" {
 		sources = buildRbBuildsSources(rbu.sourcesBuilds, rbu.rbProjectKey, sources)
+		if sources, err = appendFilteredBuildsSource(rbu.serverDetails, rbu.sourcesBuilds, rbu.rbProjectKey, sources); err != nil {
+			return nil, err
+		}
 	}
 
 	if rbu.sourcesReleaseBundles != "" {
 		sources = buildRbReleaseBundlesSources(rbu.sourcesReleaseBundles, rbu.rbProjectKey, sources)
 	}
 
-	return sources
+	return sources, nil
 }
 
 func (rbu *ReleaseBundleUpdateCommand) buildSourcesFromSpec() ([]services.RbSource, error) {
@@ -191,26 +194,5 @@ func (rbu *ReleaseBundleUpdateCommand) convertSpecToSources(detectedSources []se
 }
 
 func (rbu *ReleaseBundleUpdateCommand) createArtifactSourceFromSpec() (services.CreateFromArtifacts, error) {
-	var artifactsSource services.CreateFromArtifacts
-	rtServicesManager, err := coreUtils.CreateServiceManager(rbu.serverDetails, 3, 0, false)
-	if err != nil {
-		return artifactsSource, err
-	}
-
-	searchResults, callbackFunc, err := coreUtils.SearchFilesBySpecs(rtServicesManager, getArtifactFilesFromSpec(rbu.spec.Files))
-	if err != nil {
-		return artifactsSource, err
-	}
-
-	defer func() {
-		if callbackFunc != nil {
-			err = errors.Join(err, callbackFunc())
-		}
-	}()
-
-	artifactsSource, err = aqlResultToArtifactsSource(searchResults)
-	if err != nil {
-		return artifactsSource, err
-	}
-	return artifactsSource, nil
+	return searchArtifactsSource(rbu.serverDetails, rbu.rbProjectKey, getArtifactFilesFromSpec(rbu.spec.Files))
 }
@@ -0,0 +1,16 @@
+package contents
+
+import "github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+
+var Usage = []string{"rbls [command options] <release bundle name> <release bundle version>"}
+
+func GetDescription() string {
+	return "List the artifacts of a release bundle version, with their checksums and the builds which produced them."
+}
+
+func GetArguments() []components.Argument {
+	return []components.Argument{
+		{Name: "release bundle name", Description: "Name of the Release Bundle."},
+		{Name: "release bundle version", Description: "Version of the Release Bundle."},
+	}
+}
@@ -2,7 +2,8 @@ package create
 
 import "github.com/jfrog/jfrog-cli-core/v2/plugins/components"
 
-var Usage = []string{"rbc [command options] <release bundle name> <release bundle version>"}
+var Usage = []string{"rbc [command options] <release bundle name> <release bundle version>",
+	"rbc --auto-version=<patch|minor|major|from-git-tag> [command options] <release bundle name>"}
 
 func GetDescription() string {
 	return "Create a release bundle from builds or from existing release bundles"
@@ -11,6 +12,6 @@ func GetDescription() string {
 func GetArguments() []components.Argument {
 	return []components.Argument{
 		{Name: "release bundle name", Description: "Name of the newly created Release Bundle."},
-		{Name: "release bundle version", Description: "Version of the newly created Release Bundle."},
+		{Name: "release bundle version", Description: "Version of the newly created Release Bundle. Omit it when the --auto-version option is used."},
 	}
 }
@@ -0,0 +1,17 @@
+package diff
+
+import "github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+
+var Usage = []string{"rbdiff [command options] <release bundle name> <from version> <to version>"}
+
+func GetDescription() string {
+	return "List the artifacts and source builds which were added, removed or changed between two versions of a release bundle."
+}
+
+func GetArguments() []components.Argument {
+	return []components.Argument{
+		{Name: "release bundle name", Description: "Name of the Release Bundle."},
+		{Name: "from version", Description: "The version to compare from, such as the version currently promoted."},
+		{Name: "to version", Description: "The version to compare to."},
+	}
+}
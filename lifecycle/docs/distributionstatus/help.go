@@ -0,0 +1,16 @@
+package distributionstatus
+
+import "github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+
+var Usage = []string{"rbds [command options] <release bundle name> <release bundle version>"}
+
+func GetDescription() string {
+	return "Get the status of a release bundle distribution to each of its target sites, and optionally wait for it to complete."
+}
+
+func GetArguments() []components.Argument {
+	return []components.Argument{
+		{Name: "release bundle name", Description: "Name of the distributed Release Bundle."},
+		{Name: "release bundle version", Description: "Version of the distributed Release Bundle."},
+	}
+}
@@ -5,7 +5,7 @@ import "github.com/jfrog/jfrog-cli-core/v2/plugins/components"
 var Usage = []string{"rbe <release bundle name> <release bundle version> [target pattern]"}
 
 func GetDescription() string {
-	return "Triggers the Export process and downloads the Release Bundle archive"
+	return "Triggers the Export process and downloads the Release Bundle archive, optionally with a manifest to verify the archive and the imported release bundle on a disconnected Artifactory instance"
 }
 
 func GetArguments() []components.Argument {
@@ -5,7 +5,7 @@ import "github.com/jfrog/jfrog-cli-core/v2/plugins/components"
 var Usage = []string{"rbi [command options] <path to archive>"}
 
 func GetDescription() string {
-	return "Import a local release bundle archive to Artifactory"
+	return "Import a local release bundle archive to Artifactory, optionally verifying it against the manifest written by the export command"
 }
 
 func GetArguments() []components.Argument {
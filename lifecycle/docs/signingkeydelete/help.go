@@ -0,0 +1,15 @@
+package signingkeydelete
+
+import "github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+
+var Usage = []string{"skdel [command options] <key name>"}
+
+func GetDescription() string {
+	return "Delete a key pair from Artifactory."
+}
+
+func GetArguments() []components.Argument {
+	return []components.Argument{
+		{Name: "key name", Description: "The name of the key pair to delete."},
+	}
+}
@@ -0,0 +1,7 @@
+package signingkeylist
+
+var Usage = []string{"skl [command options]"}
+
+func GetDescription() string {
+	return "List the GPG and RSA key pairs of Artifactory, which can sign release bundles."
+}
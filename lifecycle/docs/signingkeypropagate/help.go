@@ -0,0 +1,15 @@
+package signingkeypropagate
+
+import "github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+
+var Usage = []string{"skp [command options] --edges=<server IDs> <key name>"}
+
+func GetDescription() string {
+	return "Add the public key of a key pair to the trusted keys of edge nodes, so that they can verify the release bundles signed by it."
+}
+
+func GetArguments() []components.Argument {
+	return []components.Argument{
+		{Name: "key name", Description: "The name of the key pair in Artifactory."},
+	}
+}
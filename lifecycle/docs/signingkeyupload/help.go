@@ -0,0 +1,15 @@
+package signingkeyupload
+
+import "github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+
+var Usage = []string{"sku [command options] --public-key=<path> --private-key=<path> <key name>"}
+
+func GetDescription() string {
+	return "Upload a GPG or RSA key pair to Artifactory, to be used for signing release bundles."
+}
+
+func GetArguments() []components.Argument {
+	return []components.Argument{
+		{Name: "key name", Description: "The name of the key pair in Artifactory."},
+	}
+}
@@ -0,0 +1,109 @@
+package sbom
+
+import (
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/formats"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/credentials"
+	"github.com/jfrog/jfrog-cli-artifactory/cliutils/cmddefs"
+	"github.com/jfrog/jfrog-cli-artifactory/cliutils/flagkit"
+	"github.com/jfrog/jfrog-cli-artifactory/evidence/subject"
+	sbom "github.com/jfrog/jfrog-cli-artifactory/sbom/commands"
+	sbomGenerate "github.com/jfrog/jfrog-cli-artifactory/sbom/docs/generate"
+	sbomPublish "github.com/jfrog/jfrog-cli-artifactory/sbom/docs/publish"
+	"github.com/jfrog/jfrog-cli-core/v2/common/commands"
+	pluginsCommon "github.com/jfrog/jfrog-cli-core/v2/plugins/common"
+	"github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+)
+
+const sbomCategory = "SBOM"
+
+func GetCommands() []components.Command {
+	return []components.Command{
+		{
+			Name:        "generate",
+			Aliases:     []string{"g"},
+			Flags:       flagkit.GetCommandFlags(cmddefs.SbomGenerate),
+			Description: sbomGenerate.GetDescription(),
+			Arguments:   sbomGenerate.GetArguments(),
+			Category:    sbomCategory,
+			Action:      generate,
+		},
+		{
+			Name:        "publish",
+			Aliases:     []string{"p"},
+			Flags:       flagkit.GetCommandFlags(cmddefs.SbomPublish),
+			Description: sbomPublish.GetDescription(),
+			Arguments:   sbomPublish.GetArguments(),
+			Category:    sbomCategory,
+			Action:      publish,
+		},
+	}
+}
+
+func generate(c *components.Context) error {
+	generateCmd, err := initGenerateCmd(c)
+	if err != nil {
+		return err
+	}
+	return commands.Exec(generateCmd)
+}
+
+func publish(c *components.Context) error {
+	generateCmd, err := initGenerateCmd(c)
+	if err != nil {
+		return err
+	}
+	if !c.IsFlagSet("target") {
+		return errorutils.CheckErrorf("the --target option is mandatory")
+	}
+	outputFormat, err := formats.ParseFormat(c.GetStringFlagValue("output-format"))
+	if err != nil {
+		return err
+	}
+	subjectPackage, err := getSubjectPackage(c)
+	if err != nil {
+		return err
+	}
+	publishCmd := sbom.NewSbomPublishCommand().
+		SetTarget(c.GetStringFlagValue("target")).
+		SetSubjectRepoPath(c.GetStringFlagValue("subject-repo-path")).
+		SetSubjectPackage(subjectPackage).
+		SetKeyPath(c.GetStringFlagValue("key")).
+		SetKeyAlias(c.GetStringFlagValue("key-alias")).
+		SetOutputFormat(outputFormat)
+	publishCmd.SbomGenerateCommand = generateCmd
+	return commands.Exec(publishCmd)
+}
+
+// Returns the package whose coordinates are provided as the subject of the evidence, or nil if none are provided.
+func getSubjectPackage(c *components.Context) (*subject.Package, error) {
+	pkg, err := subject.NewPackage(c.GetStringFlagValue("package-type"), c.GetStringFlagValue("package-repo"),
+		c.GetStringFlagValue("package-name"), c.GetStringFlagValue("package-version"))
+	if pkg != nil && c.IsFlagSet("subject-repo-path") {
+		return nil, errorutils.CheckErrorf("the --subject-repo-path option can't be used with the package options")
+	}
+	return pkg, err
+}
+
+func initGenerateCmd(c *components.Context) (*sbom.SbomGenerateCommand, error) {
+	if c.GetNumberOfArgs() != 0 && c.GetNumberOfArgs() != 2 {
+		return nil, pluginsCommon.WrongNumberOfArgumentsHandler(c)
+	}
+	format := sbom.CycloneDxFormat
+	if c.IsFlagSet("format") {
+		format = c.GetStringFlagValue("format")
+		if format != sbom.CycloneDxFormat && format != sbom.SpdxFormat {
+			return nil, errorutils.CheckErrorf("the --format option must be %s or %s", sbom.CycloneDxFormat, sbom.SpdxFormat)
+		}
+	}
+	serverDetails, err := credentials.CreateArtifactoryDetailsByFlags(c)
+	if err != nil {
+		return nil, err
+	}
+	return sbom.NewSbomGenerateCommand().
+		SetServerDetails(serverDetails).
+		SetBuildConfiguration(pluginsCommon.CreateBuildConfiguration(c)).
+		SetFormat(format).
+		SetOutputPath(c.GetStringFlagValue("output")).
+		SetPublished(c.GetBoolFlagValue("published")), nil
+}
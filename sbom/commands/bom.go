@@ -0,0 +1,309 @@
+package commands
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"time"
+
+	buildinfo "github.com/jfrog/build-info-go/entities"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+)
+
+const (
+	CycloneDxFormat = "cyclonedx"
+	SpdxFormat      = "spdx"
+
+	// The predicate types of SBOM evidence, which are the SBOM documents.
+	CycloneDxPredicateType = "https://cyclonedx.org/bom"
+	SpdxPredicateType      = "https://spdx.dev/Document"
+
+	toolName           = "jfrog-cli"
+	cycloneDxVersion   = "1.5"
+	spdxVersion        = "SPDX-2.3"
+	spdxDocumentId     = "SPDXRef-DOCUMENT"
+	spdxBuildId        = "SPDXRef-Build"
+	spdxNoAssertion    = "NOASSERTION"
+	spdxNamespaceBase  = "https://jfrog.com/spdx/"
+	componentLibrary   = "library"
+	componentApp       = "application"
+	sbomFileNameFormat = "%s-%s.%s.json"
+)
+
+// bomComponent is a module or a dependency of the build, with the components it depends on.
+type bomComponent struct {
+	// The reference of the component in the SBOM, which is its package URL, or its id if it has none.
+	ref       string
+	name      string
+	version   string
+	purl      string
+	isModule  bool
+	checksum  buildinfo.Checksum
+	dependsOn []string
+}
+
+// bomGraph is the graph of the modules of a build and their dependencies, from which an SBOM is generated. A module
+// which is a dependency of another module is a single component.
+type bomGraph struct {
+	buildName   string
+	buildNumber string
+	// The references of the modules, which the build depends on.
+	modules    []string
+	components []*bomComponent
+	byRef      map[string]*bomComponent
+}
+
+func newBomGraph(buildInfo *buildinfo.BuildInfo) *bomGraph {
+	graph := &bomGraph{buildName: buildInfo.Name, buildNumber: buildInfo.Number, byRef: map[string]*bomComponent{}}
+	for _, module := range buildInfo.Modules {
+		moduleComponent := graph.addComponent(parseDependencyId(module.Type, module.Id), module.Id)
+		moduleComponent.isModule = true
+		if !slices.Contains(graph.modules, moduleComponent.ref) {
+			graph.modules = append(graph.modules, moduleComponent.ref)
+		}
+		refsById := map[string]string{module.Id: moduleComponent.ref}
+		for _, dependency := range module.Dependencies {
+			dependencyComponent := graph.addComponent(parseDependencyId(module.Type, dependency.Id), dependency.Id)
+			if dependencyComponent.checksum.IsEmpty() {
+				dependencyComponent.checksum = dependency.Checksum
+			}
+			refsById[dependency.Id] = dependencyComponent.ref
+		}
+		for _, dependency := range module.Dependencies {
+			dependencyRef := refsById[dependency.Id]
+			// A dependency which isn't requested by another dependency of the module is a direct dependency.
+			parents := []string{module.Id}
+			if len(dependency.RequestedBy) > 0 {
+				parents = nil
+				for _, path := range dependency.RequestedBy {
+					if len(path) > 0 {
+						parents = append(parents, path[0])
+					}
+				}
+			}
+			for _, parentId := range parents {
+				parentRef, found := refsById[parentId]
+				if !found {
+					parentRef = moduleComponent.ref
+				}
+				parent := graph.byRef[parentRef]
+				if parentRef != dependencyRef && !slices.Contains(parent.dependsOn, dependencyRef) {
+					parent.dependsOn = append(parent.dependsOn, dependencyRef)
+				}
+			}
+		}
+	}
+	return graph
+}
+
+// addComponent returns the component of the reference, which is added if it wasn't already.
+func (bg *bomGraph) addComponent(parsed component, id string) *bomComponent {
+	ref := parsed.purl
+	if ref == "" {
+		ref = id
+	}
+	if existing, found := bg.byRef[ref]; found {
+		return existing
+	}
+	added := &bomComponent{ref: ref, name: parsed.name, version: parsed.version, purl: parsed.purl}
+	bg.components = append(bg.components, added)
+	bg.byRef[ref] = added
+	return added
+}
+
+// The reference of the build, which is the root component of the SBOM.
+func (bg *bomGraph) buildRef() string {
+	return "build:" + bg.buildName + "/" + bg.buildNumber
+}
+
+// GenerateSbom generates the SBOM of the modules of the build and their dependencies, in the CycloneDX or SPDX JSON
+// format.
+func GenerateSbom(buildInfo *buildinfo.BuildInfo, format string, timestamp time.Time) ([]byte, error) {
+	graph := newBomGraph(buildInfo)
+	var sbom any
+	switch format {
+	case CycloneDxFormat:
+		sbom = graph.toCycloneDx(timestamp)
+	case SpdxFormat:
+		sbom = graph.toSpdx(timestamp)
+	default:
+		return nil, errorutils.CheckErrorf("unsupported SBOM format '%s'. The supported formats are %s and %s", format, CycloneDxFormat, SpdxFormat)
+	}
+	content, err := json.MarshalIndent(sbom, "", "  ")
+	return content, errorutils.CheckError(err)
+}
+
+// GetSbomFileName returns the name of the SBOM file of the build: <build name>-<build number>.<format>.json.
+func GetSbomFileName(buildName, buildNumber, format string) string {
+	return fmt.Sprintf(sbomFileNameFormat, buildName, buildNumber, format)
+}
+
+// GetPredicateType returns the predicate type of the evidence of an SBOM of the format.
+func GetPredicateType(format string) string {
+	if format == SpdxFormat {
+		return SpdxPredicateType
+	}
+	return CycloneDxPredicateType
+}
+
+// newUuid returns a random (version 4) UUID.
+func newUuid() string {
+	var uuid [16]byte
+	_, _ = rand.Read(uuid[:])
+	uuid[6] = (uuid[6] & 0x0f) | 0x40
+	uuid[8] = (uuid[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", uuid[0:4], uuid[4:6], uuid[6:8], uuid[8:10], uuid[10:])
+}
+
+// The CycloneDX JSON document, by https://cyclonedx.org/docs/1.5/json.
+type cycloneDxBom struct {
+	BomFormat    string                `json:"bomFormat"`
+	SpecVersion  string                `json:"specVersion"`
+	SerialNumber string                `json:"serialNumber"`
+	Version      int                   `json:"version"`
+	Metadata     cycloneDxMetadata     `json:"metadata"`
+	Components   []cycloneDxComponent  `json:"components"`
+	Dependencies []cycloneDxDependency `json:"dependencies"`
+}
+
+type cycloneDxMetadata struct {
+	Timestamp string `json:"timestamp"`
+	Tools     struct {
+		Components []cycloneDxComponent `json:"components"`
+	} `json:"tools"`
+	Component cycloneDxComponent `json:"component"`
+}
+
+type cycloneDxComponent struct {
+	Type    string          `json:"type"`
+	BomRef  string          `json:"bom-ref,omitempty"`
+	Name    string          `json:"name"`
+	Version string          `json:"version,omitempty"`
+	Purl    string          `json:"purl,omitempty"`
+	Hashes  []cycloneDxHash `json:"hashes,omitempty"`
+}
+
+type cycloneDxHash struct {
+	Alg     string `json:"alg"`
+	Content string `json:"content"`
+}
+
+type cycloneDxDependency struct {
+	Ref       string   `json:"ref"`
+	DependsOn []string `json:"dependsOn"`
+}
+
+func (bg *bomGraph) toCycloneDx(timestamp time.Time) *cycloneDxBom {
+	bom := &cycloneDxBom{BomFormat: "CycloneDX", SpecVersion: cycloneDxVersion, SerialNumber: "urn:uuid:" + newUuid(), Version: 1}
+	bom.Metadata.Timestamp = timestamp.UTC().Format(time.RFC3339)
+	bom.Metadata.Tools.Components = []cycloneDxComponent{{Type: componentApp, Name: toolName}}
+	bom.Metadata.Component = cycloneDxComponent{Type: componentApp, BomRef: bg.buildRef(), Name: bg.buildName, Version: bg.buildNumber}
+	bom.Components = []cycloneDxComponent{}
+	bom.Dependencies = []cycloneDxDependency{{Ref: bg.buildRef(), DependsOn: bg.modules}}
+	for _, bc := range bg.components {
+		componentType := componentLibrary
+		if bc.isModule {
+			componentType = componentApp
+		}
+		cdxComponent := cycloneDxComponent{Type: componentType, BomRef: bc.ref, Name: bc.name, Version: bc.version, Purl: bc.purl}
+		for _, hash := range []cycloneDxHash{{"SHA-1", bc.checksum.Sha1}, {"SHA-256", bc.checksum.Sha256}, {"MD5", bc.checksum.Md5}} {
+			if hash.Content != "" {
+				cdxComponent.Hashes = append(cdxComponent.Hashes, hash)
+			}
+		}
+		bom.Components = append(bom.Components, cdxComponent)
+		bom.Dependencies = append(bom.Dependencies, cycloneDxDependency{Ref: bc.ref, DependsOn: nonNil(bc.dependsOn)})
+	}
+	return bom
+}
+
+// The SPDX JSON document, by https://spdx.github.io/spdx-spec/v2.3.
+type spdxDocument struct {
+	SpdxVersion       string             `json:"spdxVersion"`
+	DataLicense       string             `json:"dataLicense"`
+	SpdxId            string             `json:"SPDXID"`
+	Name              string             `json:"name"`
+	DocumentNamespace string             `json:"documentNamespace"`
+	CreationInfo      spdxCreationInfo   `json:"creationInfo"`
+	Packages          []spdxPackage      `json:"packages"`
+	Relationships     []spdxRelationship `json:"relationships"`
+}
+
+type spdxCreationInfo struct {
+	Created  string   `json:"created"`
+	Creators []string `json:"creators"`
+}
+
+type spdxPackage struct {
+	SpdxId           string            `json:"SPDXID"`
+	Name             string            `json:"name"`
+	VersionInfo      string            `json:"versionInfo,omitempty"`
+	DownloadLocation string            `json:"downloadLocation"`
+	FilesAnalyzed    bool              `json:"filesAnalyzed"`
+	Checksums        []spdxChecksum    `json:"checksums,omitempty"`
+	ExternalRefs     []spdxExternalRef `json:"externalRefs,omitempty"`
+}
+
+type spdxChecksum struct {
+	Algorithm     string `json:"algorithm"`
+	ChecksumValue string `json:"checksumValue"`
+}
+
+type spdxExternalRef struct {
+	ReferenceCategory string `json:"referenceCategory"`
+	ReferenceType     string `json:"referenceType"`
+	ReferenceLocator  string `json:"referenceLocator"`
+}
+
+type spdxRelationship struct {
+	SpdxElementId      string `json:"spdxElementId"`
+	RelationshipType   string `json:"relationshipType"`
+	RelatedSpdxElement string `json:"relatedSpdxElement"`
+}
+
+func (bg *bomGraph) toSpdx(timestamp time.Time) *spdxDocument {
+	name := bg.buildName + "/" + bg.buildNumber
+	document := &spdxDocument{
+		SpdxVersion:       spdxVersion,
+		DataLicense:       "CC0-1.0",
+		SpdxId:            spdxDocumentId,
+		Name:              name,
+		DocumentNamespace: spdxNamespaceBase + name + "-" + newUuid(),
+		CreationInfo:      spdxCreationInfo{Created: timestamp.UTC().Format(time.RFC3339), Creators: []string{"Tool: " + toolName}},
+		Packages:          []spdxPackage{{SpdxId: spdxBuildId, Name: bg.buildName, VersionInfo: bg.buildNumber, DownloadLocation: spdxNoAssertion}},
+		Relationships:     []spdxRelationship{{SpdxElementId: spdxDocumentId, RelationshipType: "DESCRIBES", RelatedSpdxElement: spdxBuildId}},
+	}
+	// The SPDX ids of the components, which may only have letters, numbers, '.' and '-', are numbered.
+	spdxIds := make(map[string]string, len(bg.components))
+	for i, bc := range bg.components {
+		spdxIds[bc.ref] = fmt.Sprintf("SPDXRef-Package-%d", i+1)
+		spdxPkg := spdxPackage{SpdxId: spdxIds[bc.ref], Name: bc.name, VersionInfo: bc.version, DownloadLocation: spdxNoAssertion}
+		for _, checksum := range []spdxChecksum{{"SHA1", bc.checksum.Sha1}, {"SHA256", bc.checksum.Sha256}, {"MD5", bc.checksum.Md5}} {
+			if checksum.ChecksumValue != "" {
+				spdxPkg.Checksums = append(spdxPkg.Checksums, checksum)
+			}
+		}
+		if bc.purl != "" {
+			spdxPkg.ExternalRefs = []spdxExternalRef{{ReferenceCategory: "PACKAGE-MANAGER", ReferenceType: "purl", ReferenceLocator: bc.purl}}
+		}
+		document.Packages = append(document.Packages, spdxPkg)
+	}
+	for _, moduleRef := range bg.modules {
+		document.Relationships = append(document.Relationships, spdxRelationship{SpdxElementId: spdxBuildId, RelationshipType: "DEPENDS_ON", RelatedSpdxElement: spdxIds[moduleRef]})
+	}
+	for _, bc := range bg.components {
+		for _, dependencyRef := range bc.dependsOn {
+			document.Relationships = append(document.Relationships, spdxRelationship{SpdxElementId: spdxIds[bc.ref], RelationshipType: "DEPENDS_ON", RelatedSpdxElement: spdxIds[dependencyRef]})
+		}
+	}
+	return document
+}
+
+// CycloneDX expects empty lists rather than nulls.
+func nonNil(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
@@ -0,0 +1,130 @@
+package commands
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	buildinfo "github.com/jfrog/build-info-go/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDependencyId(t *testing.T) {
+	testCases := []struct {
+		moduleType buildinfo.ModuleType
+		id         string
+		expected   component
+	}{
+		{buildinfo.Maven, "org.slf4j:slf4j-api:2.0.9", component{"org.slf4j:slf4j-api", "2.0.9", "pkg:maven/org.slf4j/slf4j-api@2.0.9"}},
+		{buildinfo.Npm, "@types/node:20.1.0", component{"@types/node", "20.1.0", "pkg:npm/%40types/node@20.1.0"}},
+		{buildinfo.Go, "github.com/jfrog/gofrog:v1.7.6", component{"github.com/jfrog/gofrog", "v1.7.6", "pkg:golang/github.com/jfrog/gofrog@v1.7.6"}},
+		{buildinfo.Python, "Flask_Cors:4.0.0", component{"Flask_Cors", "4.0.0", "pkg:pypi/flask-cors@4.0.0"}},
+		{"swift", "apple.swift-log:1.5.3", component{"apple.swift-log", "1.5.3", "pkg:swift/apple/swift-log@1.5.3"}},
+		{buildinfo.Generic, "archive.zip", component{"archive.zip", "", "pkg:generic/archive.zip"}},
+		{buildinfo.Docker, "sha256:0123abcd", component{"sha256:0123abcd", "", ""}},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.id, func(t *testing.T) {
+			assert.Equal(t, testCase.expected, parseDependencyId(testCase.moduleType, testCase.id))
+		})
+	}
+}
+
+// A build of an npm application, which depends on an npm library module of the same build.
+func createTestBuildInfo() *buildinfo.BuildInfo {
+	return &buildinfo.BuildInfo{
+		Name:   "my-build",
+		Number: "7",
+		Modules: []buildinfo.Module{
+			{
+				Id:   "app:1.0.0",
+				Type: buildinfo.Npm,
+				Dependencies: []buildinfo.Dependency{
+					{Id: "lib:2.0.0", Checksum: buildinfo.Checksum{Sha1: "lib-sha1", Sha256: "lib-sha256"}},
+					{Id: "express:4.18.2", Checksum: buildinfo.Checksum{Sha1: "express-sha1", Md5: "express-md5"}},
+					{Id: "ms:2.1.3", RequestedBy: [][]string{{"express:4.18.2", "app:1.0.0"}}},
+				},
+			},
+			{
+				Id:   "lib:2.0.0",
+				Type: buildinfo.Npm,
+				Dependencies: []buildinfo.Dependency{
+					{Id: "ms:2.1.3", Checksum: buildinfo.Checksum{Sha1: "ms-sha1"}, RequestedBy: [][]string{{"lib:2.0.0"}}},
+				},
+			},
+		},
+	}
+}
+
+func TestNewBomGraph(t *testing.T) {
+	graph := newBomGraph(createTestBuildInfo())
+	assert.Equal(t, []string{"pkg:npm/app@1.0.0", "pkg:npm/lib@2.0.0"}, graph.modules)
+	require.Len(t, graph.components, 4)
+	app := graph.byRef["pkg:npm/app@1.0.0"]
+	assert.True(t, app.isModule)
+	assert.Equal(t, []string{"pkg:npm/lib@2.0.0", "pkg:npm/express@4.18.2"}, app.dependsOn)
+	// The library module is a single component, which has the checksum of the dependency.
+	lib := graph.byRef["pkg:npm/lib@2.0.0"]
+	assert.True(t, lib.isModule)
+	assert.Equal(t, "lib-sha256", lib.checksum.Sha256)
+	assert.Equal(t, []string{"pkg:npm/ms@2.1.3"}, lib.dependsOn)
+	assert.Equal(t, []string{"pkg:npm/ms@2.1.3"}, graph.byRef["pkg:npm/express@4.18.2"].dependsOn)
+	ms := graph.byRef["pkg:npm/ms@2.1.3"]
+	assert.False(t, ms.isModule)
+	assert.Equal(t, "ms-sha1", ms.checksum.Sha1)
+}
+
+func TestGenerateCycloneDx(t *testing.T) {
+	content, err := GenerateSbom(createTestBuildInfo(), CycloneDxFormat, time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC))
+	require.NoError(t, err)
+	bom := &cycloneDxBom{}
+	require.NoError(t, json.Unmarshal(content, bom))
+	assert.Equal(t, "CycloneDX", bom.BomFormat)
+	assert.Equal(t, cycloneDxVersion, bom.SpecVersion)
+	assert.Regexp(t, `^urn:uuid:[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`, bom.SerialNumber)
+	assert.Equal(t, "2026-01-02T03:04:05Z", bom.Metadata.Timestamp)
+	assert.Equal(t, "my-build", bom.Metadata.Component.Name)
+	require.Len(t, bom.Components, 4)
+	assert.Equal(t, componentApp, bom.Components[0].Type)
+	express := bom.Components[2]
+	assert.Equal(t, componentLibrary, express.Type)
+	assert.Equal(t, "pkg:npm/express@4.18.2", express.Purl)
+	assert.Equal(t, []cycloneDxHash{{"SHA-1", "express-sha1"}, {"MD5", "express-md5"}}, express.Hashes)
+	assert.Equal(t, cycloneDxDependency{Ref: "build:my-build/7", DependsOn: []string{"pkg:npm/app@1.0.0", "pkg:npm/lib@2.0.0"}}, bom.Dependencies[0])
+	assert.Equal(t, cycloneDxDependency{Ref: "pkg:npm/ms@2.1.3", DependsOn: []string{}}, bom.Dependencies[4])
+}
+
+func TestGenerateSpdx(t *testing.T) {
+	content, err := GenerateSbom(createTestBuildInfo(), SpdxFormat, time.Now())
+	require.NoError(t, err)
+	document := &spdxDocument{}
+	require.NoError(t, json.Unmarshal(content, document))
+	assert.Equal(t, spdxVersion, document.SpdxVersion)
+	assert.Contains(t, document.DocumentNamespace, spdxNamespaceBase+"my-build/7-")
+	require.Len(t, document.Packages, 5)
+	lib := document.Packages[2]
+	assert.Equal(t, "SPDXRef-Package-2", lib.SpdxId)
+	assert.Equal(t, []spdxChecksum{{"SHA1", "lib-sha1"}, {"SHA256", "lib-sha256"}}, lib.Checksums)
+	assert.Equal(t, []spdxExternalRef{{"PACKAGE-MANAGER", "purl", "pkg:npm/lib@2.0.0"}}, lib.ExternalRefs)
+	assert.Equal(t, []spdxRelationship{
+		{spdxDocumentId, "DESCRIBES", spdxBuildId},
+		{spdxBuildId, "DEPENDS_ON", "SPDXRef-Package-1"},
+		{spdxBuildId, "DEPENDS_ON", "SPDXRef-Package-2"},
+		{"SPDXRef-Package-1", "DEPENDS_ON", "SPDXRef-Package-2"},
+		{"SPDXRef-Package-1", "DEPENDS_ON", "SPDXRef-Package-3"},
+		{"SPDXRef-Package-2", "DEPENDS_ON", "SPDXRef-Package-4"},
+		{"SPDXRef-Package-3", "DEPENDS_ON", "SPDXRef-Package-4"},
+	}, document.Relationships)
+}
+
+func TestGenerateSbomUnsupportedFormat(t *testing.T) {
+	_, err := GenerateSbom(createTestBuildInfo(), "swid", time.Now())
+	assert.ErrorContains(t, err, "unsupported SBOM format")
+}
+
+func TestGetTargetPath(t *testing.T) {
+	assert.Equal(t, "sboms/my-build-7.cyclonedx.json", getTargetPath("sboms", "my-build-7.cyclonedx.json"))
+	assert.Equal(t, "sboms/my-build/my-build-7.cyclonedx.json", getTargetPath("/sboms/my-build/", "my-build-7.cyclonedx.json"))
+	assert.Equal(t, "sboms/my-build/sbom.json", getTargetPath("sboms/my-build/sbom.json", "my-build-7.cyclonedx.json"))
+}
@@ -0,0 +1,135 @@
+package commands
+
+import (
+	"os"
+	"time"
+
+	buildinfo "github.com/jfrog/build-info-go/entities"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clientconfig"
+	"github.com/jfrog/jfrog-cli-core/v2/common/build"
+	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-client-go/artifactory/services"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// SbomGenerateCommand generates the SBOM of the dependencies of a build, which were collected into its build-info by
+// the package manager commands, for all package managers.
+type SbomGenerateCommand struct {
+	serverDetails      *config.ServerDetails
+	buildConfiguration *build.BuildConfiguration
+	format             string
+	outputPath         string
+	// If true, the build-info is read from Artifactory rather than from the locally collected build-info.
+	published bool
+}
+
+func NewSbomGenerateCommand() *SbomGenerateCommand {
+	return &SbomGenerateCommand{format: CycloneDxFormat}
+}
+
+func (sgc *SbomGenerateCommand) SetServerDetails(serverDetails *config.ServerDetails) *SbomGenerateCommand {
+	sgc.serverDetails = serverDetails
+	return sgc
+}
+
+func (sgc *SbomGenerateCommand) SetBuildConfiguration(buildConfiguration *build.BuildConfiguration) *SbomGenerateCommand {
+	sgc.buildConfiguration = buildConfiguration
+	return sgc
+}
+
+// SetFormat sets the format of the SBOM, cyclonedx or spdx.
+func (sgc *SbomGenerateCommand) SetFormat(format string) *SbomGenerateCommand {
+	sgc.format = format
+	return sgc
+}
+
+// SetOutputPath sets the path of the SBOM file. By default, it is <build name>-<build number>.<format>.json in the
+// working directory.
+func (sgc *SbomGenerateCommand) SetOutputPath(outputPath string) *SbomGenerateCommand {
+	sgc.outputPath = outputPath
+	return sgc
+}
+
+func (sgc *SbomGenerateCommand) SetPublished(published bool) *SbomGenerateCommand {
+	sgc.published = published
+	return sgc
+}
+
+func (sgc *SbomGenerateCommand) ServerDetails() (*config.ServerDetails, error) {
+	return sgc.serverDetails, nil
+}
+
+func (sgc *SbomGenerateCommand) CommandName() string {
+	return "sbom_generate"
+}
+
+func (sgc *SbomGenerateCommand) Run() error {
+	_, err := sgc.generate()
+	return err
+}
+
+// generate writes the SBOM file, and returns its path.
+func (sgc *SbomGenerateCommand) generate() (string, error) {
+	buildInfo, err := sgc.getBuildInfo()
+	if err != nil {
+		return "", err
+	}
+	content, err := GenerateSbom(buildInfo, sgc.format, time.Now())
+	if err != nil {
+		return "", err
+	}
+	outputPath := sgc.outputPath
+	if outputPath == "" {
+		outputPath = GetSbomFileName(buildInfo.Name, buildInfo.Number, sgc.format)
+	}
+	if err = os.WriteFile(outputPath, content, 0644); err != nil {
+		return "", errorutils.CheckError(err)
+	}
+	log.Info("Generated the", sgc.format, "SBOM of the build", buildInfo.Name+"/"+buildInfo.Number, "to", outputPath)
+	return outputPath, nil
+}
+
+// getBuildInfo returns the build-info collected locally for the build, or published to Artifactory.
+func (sgc *SbomGenerateCommand) getBuildInfo() (*buildinfo.BuildInfo, error) {
+	if err := sgc.buildConfiguration.ValidateBuildParams(); err != nil {
+		return nil, err
+	}
+	buildName, err := sgc.buildConfiguration.GetBuildName()
+	if err != nil {
+		return nil, err
+	}
+	buildNumber, err := sgc.buildConfiguration.GetBuildNumber()
+	if err != nil {
+		return nil, err
+	}
+	project := sgc.buildConfiguration.GetProject()
+	if sgc.published {
+		servicesManager, err := clientconfig.CreateServiceManager(sgc.serverDetails, -1, 0, false)
+		if err != nil {
+			return nil, err
+		}
+		params := services.BuildInfoParams{BuildName: buildName, BuildNumber: buildNumber, ProjectKey: project}
+		publishedBuildInfo, found, err := servicesManager.GetBuildInfo(params)
+		if err != nil {
+			return nil, err
+		}
+		if !found {
+			return nil, errorutils.CheckErrorf("the build %s/%s was not found in Artifactory", buildName, buildNumber)
+		}
+		return &publishedBuildInfo.BuildInfo, nil
+	}
+	buildInfoService := build.CreateBuildInfoService()
+	collectedBuild, err := buildInfoService.GetOrCreateBuildWithProject(buildName, buildNumber, project)
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	buildInfo, err := collectedBuild.ToBuildInfo()
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	if len(buildInfo.Modules) == 0 {
+		return nil, errorutils.CheckErrorf("no build-info was collected for the build %s/%s. Use --published to generate the SBOM of a published build", buildName, buildNumber)
+	}
+	return buildInfo, nil
+}
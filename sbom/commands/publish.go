@@ -0,0 +1,189 @@
+package commands
+
+import (
+	"encoding/json"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/jfrog/gofrog/crypto"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/formats"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clientconfig"
+	"github.com/jfrog/jfrog-cli-artifactory/evidence/attestation"
+	"github.com/jfrog/jfrog-cli-artifactory/evidence/signing"
+	"github.com/jfrog/jfrog-cli-artifactory/evidence/subject"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/jfrog/jfrog-client-go/artifactory/services"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// SbomPublishCommand generates the SBOM of a build, uploads it to Artifactory, and attaches it as signed evidence to
+// the uploaded SBOM, or to another artifact, such as the package the build produced.
+type SbomPublishCommand struct {
+	*SbomGenerateCommand
+	// The repository path of the uploaded SBOM. If it ends with a '/', the SBOM is uploaded to this folder.
+	target          string
+	subjectRepoPath string
+	subjectPackage  *subject.Package
+	keyPath         string
+	keyAlias        string
+	outputFormat    formats.Format
+}
+
+func NewSbomPublishCommand() *SbomPublishCommand {
+	return &SbomPublishCommand{SbomGenerateCommand: NewSbomGenerateCommand()}
+}
+
+func (spc *SbomPublishCommand) SetTarget(target string) *SbomPublishCommand {
+	spc.target = target
+	return spc
+}
+
+// SetSubjectRepoPath sets the repository path of the artifact which the SBOM evidence is attached to. By default, it
+// is the uploaded SBOM.
+func (spc *SbomPublishCommand) SetSubjectRepoPath(subjectRepoPath string) *SbomPublishCommand {
+	spc.subjectRepoPath = subjectRepoPath
+	return spc
+}
+
+// SetSubjectPackage sets the package version which the SBOM evidence is attached to, instead of a repository path. Its
+// artifact is resolved through the native API of its package type.
+func (spc *SbomPublishCommand) SetSubjectPackage(subjectPackage *subject.Package) *SbomPublishCommand {
+	spc.subjectPackage = subjectPackage
+	return spc
+}
+
+// SetKeyPath sets the path of the private key which signs the evidence. Without a key, no evidence is attached.
+func (spc *SbomPublishCommand) SetKeyPath(keyPath string) *SbomPublishCommand {
+	spc.keyPath = keyPath
+	return spc
+}
+
+func (spc *SbomPublishCommand) SetKeyAlias(keyAlias string) *SbomPublishCommand {
+	spc.keyAlias = keyAlias
+	return spc
+}
+
+// SetOutputFormat sets the format in which the attached evidence is printed. By default, it isn't printed.
+func (spc *SbomPublishCommand) SetOutputFormat(outputFormat formats.Format) *SbomPublishCommand {
+	spc.outputFormat = outputFormat
+	return spc
+}
+
+func (spc *SbomPublishCommand) CommandName() string {
+	return "sbom_publish"
+}
+
+func (spc *SbomPublishCommand) Run() error {
+	if spc.target == "" {
+		return errorutils.CheckErrorf("a target repository path is required for publishing the SBOM")
+	}
+	sbomPath, err := spc.generate()
+	if err != nil {
+		return err
+	}
+	targetPath := getTargetPath(spc.target, filepath.Base(sbomPath))
+	if err = spc.upload(sbomPath, targetPath); err != nil {
+		return err
+	}
+	if spc.keyPath == "" {
+		log.Warn("The SBOM was not attached as evidence. Provide --key to sign and attach it.")
+		return nil
+	}
+	return spc.attachEvidence(sbomPath, targetPath)
+}
+
+// getTargetPath returns the repository path of the uploaded SBOM file.
+func getTargetPath(target, fileName string) string {
+	target = strings.TrimPrefix(target, "/")
+	if strings.HasSuffix(target, "/") || !strings.Contains(target, "/") {
+		return path.Join(target, fileName)
+	}
+	return target
+}
+
+func (spc *SbomPublishCommand) upload(sbomPath, targetPath string) error {
+	servicesManager, err := clientconfig.CreateServiceManager(spc.serverDetails, -1, 0, false)
+	if err != nil {
+		return err
+	}
+	uploadParams := services.NewUploadParams()
+	uploadParams.Pattern = filepath.ToSlash(sbomPath)
+	uploadParams.Target = targetPath
+	uploadParams.Flat = true
+	summary, err := servicesManager.UploadFilesWithSummary(artifactory.UploadServiceOptions{}, uploadParams)
+	if err != nil {
+		return err
+	}
+	if summary.ArtifactsDetailsReader != nil {
+		_ = summary.ArtifactsDetailsReader.Close()
+	}
+	if summary.TotalSucceeded == 0 {
+		return errorutils.CheckErrorf("failed to upload the SBOM to %s", targetPath)
+	}
+	log.Info("Uploaded the SBOM to", targetPath)
+	return nil
+}
+
+// attachEvidence signs the SBOM as the predicate of an in-toto statement about the subject, and attaches it as
+// evidence to the subject.
+func (spc *SbomPublishCommand) attachEvidence(sbomPath, targetPath string) error {
+	signer, err := signing.LoadSigner(spc.keyPath)
+	if err != nil {
+		return err
+	}
+	content, err := os.ReadFile(sbomPath)
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	subjectRepoPath, subjectSha256, err := spc.getSubject(sbomPath, targetPath)
+	if err != nil {
+		return err
+	}
+	subject := attestation.Subject{Name: path.Base(subjectRepoPath), Digest: map[string]string{"sha256": subjectSha256}}
+	statement := attestation.NewStatement(GetPredicateType(spc.format), json.RawMessage(content), subject)
+	envelope, err := attestation.Sign(statement, signer, spc.keyAlias)
+	if err != nil {
+		return err
+	}
+	if err = attestation.Upload(spc.serverDetails, subjectRepoPath, envelope); err != nil {
+		return errorutils.CheckErrorf("failed to attach the SBOM as evidence to '%s': %s", subjectRepoPath, err.Error())
+	}
+	log.Info("Attached the SBOM as evidence to", subjectRepoPath)
+	if spc.outputFormat == "" {
+		return nil
+	}
+	return formats.Print(spc.outputFormat, formats.EvidenceKind, &formats.Evidence{
+		SubjectRepoPath:   subjectRepoPath,
+		SubjectSha256:     subjectSha256,
+		PredicateType:     statement.PredicateType,
+		PredicateRepoPath: targetPath,
+		Signed:            true,
+	})
+}
+
+// getSubject returns the repository path and the SHA-256 checksum of the artifact the evidence is attached to.
+func (spc *SbomPublishCommand) getSubject(sbomPath, targetPath string) (string, string, error) {
+	if spc.subjectRepoPath == "" && spc.subjectPackage == nil {
+		checksums, err := crypto.GetFileChecksums(sbomPath, crypto.SHA256)
+		if err != nil {
+			return "", "", errorutils.CheckError(err)
+		}
+		return targetPath, checksums[crypto.SHA256], nil
+	}
+	servicesManager, err := clientconfig.CreateServiceManager(spc.serverDetails, -1, 0, false)
+	if err != nil {
+		return "", "", err
+	}
+	if spc.subjectPackage != nil {
+		return subject.ResolvePackage(servicesManager, *spc.subjectPackage)
+	}
+	subjectRepoPath := strings.TrimPrefix(spc.subjectRepoPath, "/")
+	fileInfo, err := servicesManager.FileInfo(subjectRepoPath)
+	if err != nil {
+		return "", "", err
+	}
+	return subjectRepoPath, fileInfo.Checksums.Sha256, nil
+}
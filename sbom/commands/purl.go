@@ -0,0 +1,93 @@
+package commands
+
+import (
+	"net/url"
+	"strings"
+
+	buildinfo "github.com/jfrog/build-info-go/entities"
+)
+
+// The package URL types of the module types of the build-info, by https://github.com/package-url/purl-spec.
+var purlTypes = map[buildinfo.ModuleType]string{
+	buildinfo.Maven:     "maven",
+	buildinfo.Gradle:    "maven",
+	buildinfo.Npm:       "npm",
+	buildinfo.Nuget:     "nuget",
+	buildinfo.Go:        "golang",
+	buildinfo.Python:    "pypi",
+	buildinfo.Helm:      "helm",
+	buildinfo.Terraform: "terraform",
+	buildinfo.Conan:     "conan",
+	"gem":               "gem",
+	"cargo":             "cargo",
+	"swift":             "swift",
+	"cocoapods":         "cocoapods",
+}
+
+// component is a package of the build, identified by its name and version.
+type component struct {
+	name    string
+	version string
+	purl    string
+}
+
+// parseDependencyId returns the component of a dependency of a module of the type. The id of a dependency is
+// <name>:<version>, in which the name of a Maven dependency is <group>:<artifact>. The dependencies of Docker images
+// are their layers, which are identified by their digests and have no package URL.
+func parseDependencyId(moduleType buildinfo.ModuleType, id string) component {
+	if strings.HasPrefix(id, "sha256:") || strings.HasPrefix(id, "sha256__") {
+		return component{name: id}
+	}
+	name, version := id, ""
+	if i := strings.LastIndex(id, ":"); i > 0 {
+		name, version = id[:i], id[i+1:]
+	}
+	return component{name: name, version: version, purl: createPurl(moduleType, name, version)}
+}
+
+// createPurl returns the package URL of the package, whose type is generic if its module type has no package URL
+// type. The namespace of the package, such as the group of a Maven package or the scope of an npm package, precedes its
+// name.
+func createPurl(moduleType buildinfo.ModuleType, name, version string) string {
+	purlType, found := purlTypes[moduleType]
+	if !found {
+		purlType = "generic"
+	}
+	var namespace string
+	switch purlType {
+	case "maven":
+		namespace, name, _ = strings.Cut(name, ":")
+		if name == "" {
+			namespace, name = "", namespace
+		}
+	case "swift":
+		namespace, name, _ = strings.Cut(name, ".")
+		if name == "" {
+			namespace, name = "", namespace
+		}
+	case "pypi":
+		name = strings.ToLower(strings.ReplaceAll(name, "_", "-"))
+	default:
+		if i := strings.LastIndex(name, "/"); i > 0 {
+			namespace, name = name[:i], name[i+1:]
+		}
+	}
+	purl := "pkg:" + purlType + "/"
+	if namespace != "" {
+		var escapedSegments []string
+		for _, segment := range strings.Split(namespace, "/") {
+			escapedSegments = append(escapedSegments, escapePurlSegment(segment))
+		}
+		purl += strings.Join(escapedSegments, "/") + "/"
+	}
+	purl += escapePurlSegment(name)
+	if version != "" {
+		purl += "@" + escapePurlSegment(version)
+	}
+	return purl
+}
+
+// escapePurlSegment percent-encodes a segment of a package URL, including the '@' of npm scopes.
+func escapePurlSegment(segment string) string {
+	return strings.ReplaceAll(url.PathEscape(segment), "@", "%40")
+}
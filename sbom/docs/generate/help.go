@@ -0,0 +1,16 @@
+package generate
+
+import "github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+
+var Usage = []string{"sbom generate [command options] [build name] [build number]"}
+
+func GetDescription() string {
+	return "Generate a CycloneDX or SPDX SBOM of the dependencies collected into the build-info of a build."
+}
+
+func GetArguments() []components.Argument {
+	return []components.Argument{
+		{Name: "build name", Description: "Build name. If not provided, the JFROG_CLI_BUILD_NAME environment variable is used."},
+		{Name: "build number", Description: "Build number. If not provided, the JFROG_CLI_BUILD_NUMBER environment variable is used."},
+	}
+}
@@ -0,0 +1,16 @@
+package publish
+
+import "github.com/jfrog/jfrog-cli-core/v2/plugins/components"
+
+var Usage = []string{"sbom publish [command options] --target=<repository path> [build name] [build number]"}
+
+func GetDescription() string {
+	return "Generate the SBOM of a build, upload it to Artifactory, and attach it as signed evidence."
+}
+
+func GetArguments() []components.Argument {
+	return []components.Argument{
+		{Name: "build name", Description: "Build name. If not provided, the JFROG_CLI_BUILD_NAME environment variable is used."},
+		{Name: "build number", Description: "Build number. If not provided, the JFROG_CLI_BUILD_NUMBER environment variable is used."},
+	}
+}
@@ -5,8 +5,8 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/jfrog/jfrog-cli-core/v2/artifactory/utils"
-	"github.com/jfrog/jfrog-cli-core/v2/utils/config"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/clientconfig"
+	"github.com/jfrog/jfrog-cli-artifactory/artifactory/utils/credentials"
 	"github.com/jfrog/jfrog-client-go/access"
 	"github.com/jfrog/jfrog-client-go/artifactory"
 	"github.com/jfrog/jfrog-client-go/artifactory/services"
@@ -108,29 +108,29 @@ func (sa *ArtifactoryStats) SetDisplayLimit(displayLimit int) *ArtifactoryStats
 }
 
 func (sa *ArtifactoryStats) Run() error {
-	serverDetails, err := config.GetSpecificConfig(sa.ServerId, true, false)
+	serverDetails, err := credentials.GetSpecificConfig(sa.ServerId, true, false)
 	if err != nil {
 		return err
 	}
 	if sa.AccessToken != "" {
 		serverDetails.AccessToken = sa.AccessToken
 	}
-	servicesManager, err := utils.CreateServiceManager(serverDetails, -1, 0, false)
+	servicesManager, err := clientconfig.CreateServiceManager(serverDetails, -1, 0, false)
 	if err != nil {
 		return err
 	}
 	sa.ServicesManager = servicesManager
-	accessManager, err := utils.CreateAccessServiceManager(serverDetails, false)
+	accessManager, err := clientconfig.CreateAccessServiceManager(serverDetails, false)
 	if err != nil {
 		return err
 	}
 	sa.AccessManager = *accessManager
-	lifecycleServicesManager, err := utils.CreateLifecycleServiceManager(serverDetails, false)
+	lifecycleServicesManager, err := clientconfig.CreateLifecycleServiceManager(serverDetails, false)
 	if err != nil {
 		return err
 	}
 	sa.LifecycleServiceManager = *lifecycleServicesManager
-	jpdServiceManager, err := utils.CreateJPDServiceManager(serverDetails, false)
+	jpdServiceManager, err := clientconfig.CreateJPDServiceManager(serverDetails, false)
 	if err != nil {
 		return err
 	}